@@ -6,6 +6,9 @@ CREATE TABLE IF NOT EXISTS exercises (
     initial_code TEXT NOT NULL,
     solution TEXT NOT NULL,
     difficulty VARCHAR(20) NOT NULL CHECK (difficulty IN ('easy', 'medium', 'hard')),
+    language VARCHAR(20) NOT NULL DEFAULT 'javascript' CHECK (language IN ('javascript', 'starlark')),
+    tags TEXT[] NOT NULL DEFAULT '{}', -- Lowercase topics the exercise covers
+    version INTEGER NOT NULL DEFAULT 1 CHECK (version > 0), -- Latest version, bumped by every change to the exercise or its tests
     created_by BIGINT NOT NULL, -- Reference to users.id from auth service
     created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
     updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
@@ -16,46 +19,199 @@ CREATE TABLE IF NOT EXISTS tests (
     input TEXT NOT NULL,
     output TEXT NOT NULL,
     public BOOLEAN NOT NULL DEFAULT FALSE,
+    weight INTEGER NOT NULL DEFAULT 1 CHECK (weight > 0), -- Points awarded for passing the test
+    input_format VARCHAR(10) NOT NULL DEFAULT 'text' CHECK (input_format IN ('text', 'json')),
+    comparison VARCHAR(20) NOT NULL DEFAULT 'exact' CHECK (comparison IN ('exact', 'trimmed', 'numeric', 'json', 'unordered', 'regex')),
+    tolerance DOUBLE PRECISION NOT NULL DEFAULT 0 CHECK (tolerance >= 0), -- Only used by numeric comparison
     exercise_id BIGINT NOT NULL REFERENCES exercises(id) ON DELETE CASCADE,
     created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
     updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
 );
 
+-- Create exercise versions table, immutable snapshots of an exercise and its tests
+CREATE TABLE IF NOT EXISTS exercise_versions (
+    exercise_id BIGINT NOT NULL REFERENCES exercises(id) ON DELETE CASCADE,
+    version INTEGER NOT NULL CHECK (version > 0),
+    snapshot JSONB NOT NULL, -- Fields of the exercise and its tests as of this version
+    created_by BIGINT NOT NULL, -- Reference to users.id from auth service
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (exercise_id, version)
+);
+
+-- Create hints table, revealed to students in position order
+CREATE TABLE IF NOT EXISTS hints (
+    id BIGSERIAL PRIMARY KEY,
+    exercise_id BIGINT NOT NULL REFERENCES exercises(id) ON DELETE CASCADE,
+    position INTEGER NOT NULL CHECK (position > 0),
+    content TEXT NOT NULL,
+    unlock_after_attempts INTEGER CHECK (unlock_after_attempts > 0), -- Failed attempts needed to unlock the hint
+    unlock_after_seconds INTEGER CHECK (unlock_after_seconds > 0), -- Seconds since the student started the exercise
+    penalty DOUBLE PRECISION NOT NULL DEFAULT 0 CHECK (penalty BETWEEN 0 AND 100), -- Points taken off once revealed
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    UNIQUE (exercise_id, position)
+);
+
+-- Create assignments table, ordered sets of exercises open for a period of time
+CREATE TABLE IF NOT EXISTS assignments (
+    id BIGSERIAL PRIMARY KEY,
+    title VARCHAR(200) NOT NULL,
+    description TEXT NOT NULL DEFAULT '',
+    opens_at TIMESTAMPTZ NOT NULL,
+    closes_at TIMESTAMPTZ NOT NULL,
+    allow_late BOOLEAN NOT NULL DEFAULT FALSE, -- Whether attempts made after closes_at are accepted as late
+    created_by BIGINT NOT NULL, -- Reference to users.id from auth service
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CHECK (closes_at > opens_at)
+);
+
+CREATE TABLE IF NOT EXISTS assignment_exercises (
+    assignment_id BIGINT NOT NULL REFERENCES assignments(id) ON DELETE CASCADE,
+    exercise_id BIGINT NOT NULL REFERENCES exercises(id) ON DELETE CASCADE,
+    position INTEGER NOT NULL CHECK (position > 0),
+    PRIMARY KEY (assignment_id, exercise_id),
+    UNIQUE (assignment_id, position)
+);
+
 -- Create answers table
 CREATE TABLE IF NOT EXISTS answers (
     id BIGSERIAL PRIMARY KEY,
     exercise_id BIGINT NOT NULL REFERENCES exercises(id) ON DELETE CASCADE,
     user_id BIGINT NOT NULL, -- Reference to users.id from auth service
     completed BOOLEAN NOT NULL DEFAULT FALSE,
+    best_score DOUBLE PRECISION NOT NULL DEFAULT 0 CHECK (best_score BETWEEN 0 AND 100), -- Percentage of the test weight passed
+    best_attempt_id BIGINT, -- References attempts(id), added once the attempts table exists
+    hints_used INTEGER NOT NULL DEFAULT 0 CHECK (hints_used >= 0), -- Hints revealed to the student
+    hint_penalty DOUBLE PRECISION NOT NULL DEFAULT 0 CHECK (hint_penalty BETWEEN 0 AND 100), -- Points taken off the score of every attempt
     created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
     updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
     UNIQUE (exercise_id, user_id)
 );
 
+-- Create hint usages table, the hints revealed to each student
+CREATE TABLE IF NOT EXISTS hint_usages (
+    answer_id BIGINT NOT NULL REFERENCES answers(id) ON DELETE CASCADE,
+    hint_id BIGINT NOT NULL REFERENCES hints(id) ON DELETE CASCADE,
+    revealed_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (answer_id, hint_id)
+);
+
 -- Create attempts table
 CREATE TABLE IF NOT EXISTS attempts (
     id BIGSERIAL PRIMARY KEY,
     answer_id BIGINT NOT NULL REFERENCES answers(id) ON DELETE CASCADE,
     code TEXT NOT NULL,
     success BOOLEAN NOT NULL DEFAULT FALSE,
+    status VARCHAR(20) NOT NULL DEFAULT 'failed' CHECK (status IN ('pending', 'passed', 'failed', 'error', 'timeout', 'resource_exceeded')), -- pending while graded in the background
+    points INTEGER NOT NULL DEFAULT 0 CHECK (points >= 0), -- Weight of the tests passed
+    max_points INTEGER NOT NULL DEFAULT 0 CHECK (max_points >= points), -- Weight of all the tests run
+    score DOUBLE PRECISION NOT NULL DEFAULT 0 CHECK (score BETWEEN 0 AND 100), -- Percentage of max_points earned
+    late BOOLEAN NOT NULL DEFAULT FALSE, -- Made after the assignments of the exercise closed
+    exercise_version INTEGER NOT NULL DEFAULT 1, -- Version of the exercise the attempt was graded against
+    message TEXT NOT NULL DEFAULT '', -- Outcome of the attempt as told to the student
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+ALTER TABLE answers DROP CONSTRAINT IF EXISTS answers_best_attempt_id_fkey;
+ALTER TABLE answers ADD CONSTRAINT answers_best_attempt_id_fkey
+    FOREIGN KEY (best_attempt_id) REFERENCES attempts(id) ON DELETE SET NULL;
+
+-- Create attempt test results table
+CREATE TABLE IF NOT EXISTS attempt_test_results (
+    id BIGSERIAL PRIMARY KEY,
+    attempt_id BIGINT NOT NULL REFERENCES attempts(id) ON DELETE CASCADE,
+    test_id BIGINT REFERENCES tests(id) ON DELETE SET NULL,
+    status VARCHAR(20) NOT NULL CHECK (status IN ('passed', 'failed', 'error', 'timeout', 'resource_exceeded')),
+    actual_output TEXT NOT NULL DEFAULT '',
+    expected_output TEXT, -- Only stored for public tests
+    error_message TEXT,
+    console_output TEXT NOT NULL DEFAULT '',
+    stack_trace TEXT, -- Only stored when the code threw
+    duration_ms INTEGER NOT NULL DEFAULT 0,
     created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
 );
 
+-- Create plagiarism checks table, one row per checked exercise
+CREATE TABLE IF NOT EXISTS plagiarism_checks (
+    exercise_id BIGINT PRIMARY KEY REFERENCES exercises(id) ON DELETE CASCADE,
+    checked_at TIMESTAMPTZ NOT NULL -- Attempts made after this time are not checked yet
+);
+
+-- Create plagiarism matches table, suspiciously similar attempts of two students
+CREATE TABLE IF NOT EXISTS plagiarism_matches (
+    id BIGSERIAL PRIMARY KEY,
+    exercise_id BIGINT NOT NULL REFERENCES exercises(id) ON DELETE CASCADE,
+    attempt_a_id BIGINT NOT NULL REFERENCES attempts(id) ON DELETE CASCADE,
+    user_a_id BIGINT NOT NULL,
+    attempt_b_id BIGINT NOT NULL REFERENCES attempts(id) ON DELETE CASCADE,
+    user_b_id BIGINT NOT NULL,
+    similarity DOUBLE PRECISION NOT NULL CHECK (similarity BETWEEN 0 AND 100),
+    regions JSONB NOT NULL DEFAULT '[]', -- Matching line ranges of both attempts
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    UNIQUE (attempt_a_id, attempt_b_id)
+);
+
+-- Create regrades table, runs of the attempts of an exercise against its current tests
+CREATE TABLE IF NOT EXISTS regrades (
+    id BIGSERIAL PRIMARY KEY,
+    exercise_id BIGINT NOT NULL REFERENCES exercises(id) ON DELETE CASCADE,
+    exercise_version INTEGER NOT NULL, -- Version of the exercise the attempts are regraded against
+    status VARCHAR(20) NOT NULL DEFAULT 'running' CHECK (status IN ('running', 'finished', 'failed')),
+    total_attempts INTEGER NOT NULL CHECK (total_attempts >= 0),
+    regraded_attempts INTEGER NOT NULL DEFAULT 0, -- Attempts processed so far, errored ones included
+    errored_attempts INTEGER NOT NULL DEFAULT 0, -- Attempts that could not be run and kept their outcome
+    newly_passed_attempts INTEGER NOT NULL DEFAULT 0,
+    newly_failed_attempts INTEGER NOT NULL DEFAULT 0,
+    error_message TEXT, -- Why the regrade failed
+    created_by BIGINT NOT NULL, -- Reference to users.id from auth service
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(), -- Bumped on every attempt processed
+    finished_at TIMESTAMPTZ
+);
+
+-- Create regrade changes table, the students whose outcome a regrade flipped
+CREATE TABLE IF NOT EXISTS regrade_changes (
+    regrade_id BIGINT NOT NULL REFERENCES regrades(id) ON DELETE CASCADE,
+    user_id BIGINT NOT NULL, -- Reference to users.id from auth service
+    completed BOOLEAN NOT NULL, -- Whether the student passes after the regrade
+    previous_score DOUBLE PRECISION NOT NULL,
+    score DOUBLE PRECISION NOT NULL,
+    PRIMARY KEY (regrade_id, user_id)
+);
+
 -- Create indexes for performance
 CREATE INDEX IF NOT EXISTS idx_exercises_difficulty ON exercises(difficulty);
 CREATE INDEX IF NOT EXISTS idx_exercises_created_by ON exercises(created_by);
-CREATE INDEX IF NOT EXISTS idx_exercises_created_at ON exercises(created_at);
+CREATE INDEX IF NOT EXISTS idx_exercises_created_at ON exercises(created_at, id);
+CREATE INDEX IF NOT EXISTS idx_exercises_tags ON exercises USING GIN (tags);
+CREATE INDEX IF NOT EXISTS idx_exercises_search ON exercises USING GIN (to_tsvector('simple', title || ' ' || description));
 
 CREATE INDEX IF NOT EXISTS idx_tests_exercise_id ON tests(exercise_id);
 CREATE INDEX IF NOT EXISTS idx_tests_public ON tests(public);
 
+CREATE INDEX IF NOT EXISTS idx_hints_exercise_id ON hints(exercise_id);
+
+CREATE INDEX IF NOT EXISTS idx_assignments_closes_at ON assignments(closes_at);
+CREATE INDEX IF NOT EXISTS idx_assignment_exercises_exercise_id ON assignment_exercises(exercise_id);
+
 CREATE INDEX IF NOT EXISTS idx_answers_exercise_id ON answers(exercise_id);
 CREATE INDEX IF NOT EXISTS idx_answers_user_id ON answers(user_id);
 CREATE INDEX IF NOT EXISTS idx_answers_completed ON answers(completed);
 
 CREATE INDEX IF NOT EXISTS idx_attempts_answer_id ON attempts(answer_id);
 CREATE INDEX IF NOT EXISTS idx_attempts_success ON attempts(success);
+CREATE INDEX IF NOT EXISTS idx_attempts_status ON attempts(status);
 CREATE INDEX IF NOT EXISTS idx_attempts_created_at ON attempts(created_at);
+CREATE INDEX IF NOT EXISTS idx_attempts_exercise_version ON attempts(answer_id, exercise_version);
+
+CREATE INDEX IF NOT EXISTS idx_attempt_test_results_attempt_id ON attempt_test_results(attempt_id);
+CREATE INDEX IF NOT EXISTS idx_attempt_test_results_test_id ON attempt_test_results(test_id);
+
+CREATE INDEX IF NOT EXISTS idx_plagiarism_matches_exercise_id ON plagiarism_matches(exercise_id);
+
+CREATE INDEX IF NOT EXISTS idx_regrades_exercise_id ON regrades(exercise_id, status);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_regrades_running ON regrades(exercise_id) WHERE status = 'running';
 
 -- Seed data for exercises
 DO $$
@@ -63,6 +219,7 @@ DECLARE
   i INT;
   exercise_id BIGINT;
   answer_id BIGINT;
+  attempt_id BIGINT;
   titles TEXT[] := ARRAY[
     'Sumar números desde un string',
     'Restar dos números',
@@ -114,25 +271,68 @@ BEGIN
       ('10,20', '30', TRUE, exercise_id),
       ('-5,5', '0', FALSE, exercise_id);
 
+    -- Registrar la primera versión del ejercicio
+    INSERT INTO exercise_versions (exercise_id, version, snapshot, created_by)
+    SELECT e.id, e.version, jsonb_build_object(
+      'title', e.title,
+      'description', e.description,
+      'initial_code', e.initial_code,
+      'solution', e.solution,
+      'difficulty', e.difficulty,
+      'language', e.language,
+      'tags', to_jsonb(e.tags),
+      'tests', (
+        SELECT jsonb_agg(jsonb_build_object(
+          'id', t.id,
+          'input', t.input,
+          'output', t.output,
+          'public', t.public,
+          'weight', t.weight,
+          'input_format', t.input_format,
+          'comparison', t.comparison,
+          'tolerance', t.tolerance
+        ) ORDER BY t.created_at, t.id)
+        FROM tests t WHERE t.exercise_id = e.id
+      )
+    ), e.created_by
+    FROM exercises e WHERE e.id = exercise_id;
+
+    -- Insertar pistas
+    INSERT INTO hints (exercise_id, position, content, unlock_after_attempts, unlock_after_seconds, penalty) VALUES
+      (exercise_id, 1, 'Separa el string por la coma con input.split(",").', NULL, NULL, 0),
+      (exercise_id, 2, 'Convierte cada parte a número con map(Number).', 2, 300, 10);
+
     -- Insertar respuesta
     INSERT INTO answers (exercise_id, user_id, completed)
     VALUES (exercise_id, 1, TRUE)
     RETURNING id INTO answer_id;
 
     -- Insertar intento exitoso
-    INSERT INTO attempts (answer_id, code, success)
+    INSERT INTO attempts (answer_id, code, success, status, points, max_points, score)
     VALUES (
       answer_id,
       solutions[i],
-      TRUE
-    );
+      TRUE,
+      'passed',
+      3,
+      3,
+      100
+    ) RETURNING id INTO attempt_id;
+
+    -- Registrar el mejor puntaje
+    UPDATE answers SET best_score = 100, best_attempt_id = attempt_id
+    WHERE id = answer_id;
 
     -- Insertar intento fallido
-    INSERT INTO attempts (answer_id, code, success)
+    INSERT INTO attempts (answer_id, code, success, status, points, max_points, score)
     VALUES (
       answer_id,
       incorrect_solutions[i],
-      FALSE
+      FALSE,
+      'failed',
+      0,
+      3,
+      0
     );
   END LOOP;
 END;
@@ -1,5 +1,5 @@
 -- Knowledge Service Database Schema - Simplified
--- Simple form system: teachers create forms, students submit once and get a score
+-- Simple form system: teachers create forms, students submit attempts and get a score
 
 -- Tests table - stores form metadata (simplified)
 DROP TABLE IF EXISTS tests CASCADE;
@@ -7,44 +7,117 @@ CREATE TABLE tests (
     id BIGSERIAL PRIMARY KEY,
     title VARCHAR(255) NOT NULL,
     created_by BIGINT NOT NULL,
+    duration_minutes INTEGER CHECK (duration_minutes > 0), -- NULL for untimed tests
+    max_attempts INTEGER NOT NULL DEFAULT 1 CHECK (max_attempts >= 0), -- 0 for unlimited attempts
+    cooldown_minutes INTEGER NOT NULL DEFAULT 0 CHECK (cooldown_minutes >= 0), -- wait between attempts
+    score_policy VARCHAR(10) NOT NULL DEFAULT 'best'
+        CHECK (score_policy IN ('best', 'latest', 'average')), -- which attempt score counts
+    reveal_answers BOOLEAN NOT NULL DEFAULT TRUE, -- show correct answers after each attempt
+    shuffle_questions BOOLEAN NOT NULL DEFAULT FALSE, -- show questions in a different order to each student
+    shuffle_options BOOLEAN NOT NULL DEFAULT FALSE, -- show options in a different order to each student
     created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW() NOT NULL
 );
 
--- Questions table - stores questions for tests (simplified, no JSON)
+-- Question banks table - reusable sets of questions a teacher draws tests from
+CREATE TABLE IF NOT EXISTS question_banks (
+    id BIGSERIAL PRIMARY KEY,
+    title VARCHAR(255) NOT NULL,
+    created_by BIGINT NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW() NOT NULL
+);
+
+-- Questions table - stores questions for tests, or for question banks where
+-- they are tagged by topic and difficulty. Which columns hold the answer
+-- depends on the question type:
+--   multiple_choice, true_false: options, correct_options holds the one correct index
+--   multiple_select: options, correct_options holds every correct index
+--   ordering: options, correct_options holds the indexes in the correct order
+--   numeric: numeric_answer, tolerance
+--   short_text: accepted_answers, patterns where * matches any text
 CREATE TABLE IF NOT EXISTS questions (
     id BIGSERIAL PRIMARY KEY,
-    test_id BIGINT NOT NULL REFERENCES tests(id) ON DELETE CASCADE,
+    test_id BIGINT REFERENCES tests(id) ON DELETE CASCADE, -- NULL for bank questions
+    bank_id BIGINT REFERENCES question_banks(id) ON DELETE CASCADE, -- NULL for test questions
     question_text TEXT NOT NULL,
-    option_a TEXT NOT NULL,
-    option_b TEXT NOT NULL,
-    option_c TEXT NOT NULL,
-    option_d TEXT NOT NULL,
-    correct_answer INTEGER NOT NULL CHECK (correct_answer BETWEEN 0 AND 3), -- 0=A, 1=B, 2=C, 3=D
+    question_type VARCHAR(20) NOT NULL DEFAULT 'multiple_choice'
+        CHECK (question_type IN ('multiple_choice', 'multiple_select', 'true_false', 'numeric', 'short_text', 'ordering')),
+    options TEXT[] NOT NULL DEFAULT '{}',
+    correct_options INTEGER[] NOT NULL DEFAULT '{}',
+    numeric_answer DOUBLE PRECISION,
+    tolerance DOUBLE PRECISION NOT NULL DEFAULT 0 CHECK (tolerance >= 0),
+    accepted_answers TEXT[] NOT NULL DEFAULT '{}',
     question_order INTEGER NOT NULL CHECK (question_order > 0),
+    topic VARCHAR(100) NOT NULL DEFAULT '',
+    difficulty VARCHAR(10) NOT NULL DEFAULT 'medium'
+        CHECK (difficulty IN ('easy', 'medium', 'hard')),
+
+    -- Every question belongs to either a test or a bank
+    CHECK ((test_id IS NULL) <> (bank_id IS NULL)),
+    -- Ensure unique ordering within each test and bank
+    UNIQUE(test_id, question_order),
+    UNIQUE(bank_id, question_order)
+);
 
-    -- Ensure unique ordering within each test
-    UNIQUE(test_id, question_order)
+-- Draw rules table - questions each attempt at a test draws from a bank at
+-- random, on top of the questions of the test itself
+CREATE TABLE IF NOT EXISTS draw_rules (
+    id BIGSERIAL PRIMARY KEY,
+    test_id BIGINT NOT NULL REFERENCES tests(id) ON DELETE CASCADE,
+    bank_id BIGINT NOT NULL REFERENCES question_banks(id) ON DELETE CASCADE,
+    topic VARCHAR(100), -- NULL for any topic
+    difficulty VARCHAR(10) CHECK (difficulty IN ('easy', 'medium', 'hard')), -- NULL for any difficulty
+    question_count INTEGER NOT NULL CHECK (question_count > 0)
 );
 
--- Test submissions table - tracks when users take tests (simplified)
+-- Test submissions table - the numbered attempts of users at tests. An attempt
+-- starts in_progress and becomes submitted, or expired when its deadline passes.
 CREATE TABLE IF NOT EXISTS test_submissions (
     id BIGSERIAL PRIMARY KEY,
     test_id BIGINT NOT NULL REFERENCES tests(id) ON DELETE CASCADE,
     user_id BIGINT NOT NULL, -- Reference to users.id from auth service
-    score DECIMAL(5,2) NOT NULL, -- percentage score
-    submitted_at TIMESTAMP WITH TIME ZONE DEFAULT NOW() NOT NULL,
+    attempt_number INTEGER NOT NULL CHECK (attempt_number > 0),
+    score DECIMAL(5,2), -- percentage score, NULL while in progress
+    submitted_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(), -- NULL while in progress
+    status VARCHAR(20) NOT NULL DEFAULT 'submitted'
+        CHECK (status IN ('in_progress', 'submitted', 'expired')),
+    started_at TIMESTAMP WITH TIME ZONE DEFAULT NOW() NOT NULL,
+    deadline TIMESTAMP WITH TIME ZONE, -- NULL for untimed tests
+    -- Shuffle settings of the test when the attempt started, and the seed its
+    -- order was drawn from. The order itself is kept in attempt_questions.
+    shuffle_questions BOOLEAN NOT NULL DEFAULT FALSE,
+    shuffle_options BOOLEAN NOT NULL DEFAULT FALSE,
+    shuffle_seed BIGINT NOT NULL DEFAULT 0,
+
+    -- Ensure attempts are numbered once per user per test
+    UNIQUE(test_id, user_id, attempt_number)
+);
 
-    -- Ensure one submission per user per test
-    UNIQUE(test_id, user_id)
+-- Attempt questions table - the questions of an attempt started with
+-- StartTest, including any drawn from question banks, and the order they and
+-- their options are shown in, fixed when the attempt starts. Questions given
+-- in an attempt cannot be deleted on their own so its history is kept;
+-- deleting the whole test still removes its attempts along with them.
+CREATE TABLE IF NOT EXISTS attempt_questions (
+    submission_id BIGINT NOT NULL REFERENCES test_submissions(id) ON DELETE CASCADE,
+    question_id BIGINT NOT NULL REFERENCES questions(id),
+    position INTEGER NOT NULL CHECK (position > 0), -- position of the question as shown to the student
+    option_order INTEGER[] NOT NULL DEFAULT '{}', -- canonical index of each option as shown, empty when not shuffled
+
+    PRIMARY KEY (submission_id, question_id)
 );
 
 -- Answer submissions table - individual answers for each question (simplified)
 CREATE TABLE IF NOT EXISTS answer_submissions (
     id BIGSERIAL PRIMARY KEY,
     submission_id BIGINT NOT NULL REFERENCES test_submissions(id) ON DELETE CASCADE,
-    question_id BIGINT NOT NULL REFERENCES questions(id) ON DELETE CASCADE,
-    selected_answer INTEGER NOT NULL CHECK (selected_answer BETWEEN 0 AND 3),
+    question_id BIGINT NOT NULL REFERENCES questions(id), -- answered questions cannot be deleted on their own
+    selected_options INTEGER[] NOT NULL DEFAULT '{}',
+    numeric_answer DOUBLE PRECISION,
+    text_answer TEXT,
+    credit DOUBLE PRECISION NOT NULL CHECK (credit BETWEEN 0 AND 1), -- fraction of the question earned
     is_correct BOOLEAN NOT NULL,
+    position INTEGER NOT NULL CHECK (position > 0), -- position of the question as shown to the student
+    option_order INTEGER[] NOT NULL DEFAULT '{}', -- canonical index of each option as shown, empty when not shuffled
 
     -- Ensure one answer per question per submission
     UNIQUE(submission_id, question_id)
@@ -52,8 +125,12 @@ CREATE TABLE IF NOT EXISTS answer_submissions (
 
 -- === INDEXES FOR PERFORMANCE ===
 CREATE INDEX IF NOT EXISTS idx_questions_test_id ON questions(test_id);
+CREATE INDEX IF NOT EXISTS idx_questions_bank_id ON questions(bank_id);
+CREATE INDEX IF NOT EXISTS idx_draw_rules_test_id ON draw_rules(test_id);
 CREATE INDEX IF NOT EXISTS idx_submissions_test_id ON test_submissions(test_id);
 CREATE INDEX IF NOT EXISTS idx_submissions_user_id ON test_submissions(user_id);
+-- Only one attempt per user per test can be in progress
+CREATE UNIQUE INDEX IF NOT EXISTS idx_submissions_in_progress ON test_submissions(test_id, user_id) WHERE status = 'in_progress';
 CREATE INDEX IF NOT EXISTS idx_answers_submission_id ON answer_submissions(submission_id);
 
 
@@ -61,60 +138,96 @@ CREATE INDEX IF NOT EXISTS idx_answers_submission_id ON answer_submissions(submi
 DO $$
 DECLARE
   test_id BIGINT;
+  bank_id BIGINT;
 BEGIN
   -- 1. Matemáticas básicas
   INSERT INTO tests (title, created_by) VALUES
     ('Test de Matemáticas Básicas', 1)
     RETURNING id INTO test_id;
 
-  INSERT INTO questions (test_id, question_text, option_a, option_b, option_c, option_d, correct_answer, question_order) VALUES
-    (test_id, '¿Cuánto es 7 + 5?', '10', '12', '13', '11', 1, 1),
-    (test_id, '¿Cuál es el doble de 8?', '14', '12', '16', '18', 2, 2),
-    (test_id, '¿Cuánto es 9 x 3?', '27', '18', '21', '24', 0, 3),
-    (test_id, '¿Cuál es la raíz cuadrada de 81?', '7', '8', '9', '10', 2, 4);
+  INSERT INTO questions (test_id, question_text, options, correct_options, question_order) VALUES
+    (test_id, '¿Cuánto es 7 + 5?', ARRAY['10', '12', '13', '11'], '{1}', 1),
+    (test_id, '¿Cuál es el doble de 8?', ARRAY['14', '12', '16', '18'], '{2}', 2),
+    (test_id, '¿Cuánto es 9 x 3?', ARRAY['27', '18', '21', '24'], '{0}', 3),
+    (test_id, '¿Cuál es la raíz cuadrada de 81?', ARRAY['7', '8', '9', '10'], '{2}', 4);
 
   -- 2. Historia universal
   INSERT INTO tests (title, created_by) VALUES
     ('Test de Historia Universal', 1)
     RETURNING id INTO test_id;
 
-  INSERT INTO questions (test_id, question_text, option_a, option_b, option_c, option_d, correct_answer, question_order) VALUES
-    (test_id, '¿En qué año ocurrió la Revolución Francesa?', '1789', '1492', '1810', '1776', 0, 1),
-    (test_id, '¿Quién fue el primer emperador romano?', 'Julio César', 'Nerón', 'Augusto', 'Trajano', 2, 2),
-    (test_id, '¿Qué civilización construyó las pirámides de Egipto?', 'Romana', 'Egipcia', 'Maya', 'Griega', 1, 3),
-    (test_id, '¿Cuál fue el detonante de la Primera Guerra Mundial?', 'La caída de Berlín', 'El asesinato del archiduque Francisco Fernando', 'La Revolución Rusa', 'La invasión a Polonia', 1, 4);
+  INSERT INTO questions (test_id, question_text, options, correct_options, question_order) VALUES
+    (test_id, '¿En qué año ocurrió la Revolución Francesa?', ARRAY['1789', '1492', '1810', '1776'], '{0}', 1),
+    (test_id, '¿Quién fue el primer emperador romano?', ARRAY['Julio César', 'Nerón', 'Augusto', 'Trajano'], '{2}', 2),
+    (test_id, '¿Qué civilización construyó las pirámides de Egipto?', ARRAY['Romana', 'Egipcia', 'Maya', 'Griega'], '{1}', 3),
+    (test_id, '¿Cuál fue el detonante de la Primera Guerra Mundial?', ARRAY['La caída de Berlín', 'El asesinato del archiduque Francisco Fernando', 'La Revolución Rusa', 'La invasión a Polonia'], '{1}', 4);
 
   -- 3. Ciencias naturales
   INSERT INTO tests (title, created_by) VALUES
     ('Test de Ciencias Naturales', 1)
     RETURNING id INTO test_id;
 
-  INSERT INTO questions (test_id, question_text, option_a, option_b, option_c, option_d, correct_answer, question_order) VALUES
-    (test_id, '¿Cuál es el planeta más cercano al Sol?', 'Venus', 'Mercurio', 'Tierra', 'Marte', 1, 1),
-    (test_id, '¿Qué órgano bombea sangre por todo el cuerpo?', 'Pulmón', 'Riñón', 'Hígado', 'Corazón', 3, 2),
-    (test_id, '¿Qué gas respiramos del aire para vivir?', 'Oxígeno', 'Hidrógeno', 'Nitrógeno', 'Dióxido de carbono', 0, 3),
-    (test_id, '¿Cómo se llama el proceso por el cual las plantas hacen su alimento?', 'Digestión', 'Evaporación', 'Fotosíntesis', 'Respiración', 2, 4);
+  INSERT INTO questions (test_id, question_text, options, correct_options, question_order) VALUES
+    (test_id, '¿Cuál es el planeta más cercano al Sol?', ARRAY['Venus', 'Mercurio', 'Tierra', 'Marte'], '{1}', 1),
+    (test_id, '¿Qué órgano bombea sangre por todo el cuerpo?', ARRAY['Pulmón', 'Riñón', 'Hígado', 'Corazón'], '{3}', 2),
+    (test_id, '¿Qué gas respiramos del aire para vivir?', ARRAY['Oxígeno', 'Hidrógeno', 'Nitrógeno', 'Dióxido de carbono'], '{0}', 3),
+    (test_id, '¿Cómo se llama el proceso por el cual las plantas hacen su alimento?', ARRAY['Digestión', 'Evaporación', 'Fotosíntesis', 'Respiración'], '{2}', 4);
 
   -- 4. Lengua y gramática
   INSERT INTO tests (title, created_by) VALUES
     ('Test de Lengua y Gramática', 1)
     RETURNING id INTO test_id;
 
-  INSERT INTO questions (test_id, question_text, option_a, option_b, option_c, option_d, correct_answer, question_order) VALUES
-    (test_id, '¿Cuál es un sinónimo de “feliz”?', 'Triste', 'Contento', 'Aburrido', 'Cansado', 1, 1),
-    (test_id, '¿Cuál es el sujeto en la frase “El perro corre rápido”?', 'Corre', 'El', 'Perro', 'Rápido', 2, 2),
-    (test_id, '¿Qué tipo de palabra es “rápidamente”?', 'Adjetivo', 'Sustantivo', 'Verbo', 'Adverbio', 3, 3),
-    (test_id, '¿Qué signo se usa al final de una pregunta?', 'Punto', 'Coma', 'Signo de interrogación', 'Dos puntos', 2, 4);
+  INSERT INTO questions (test_id, question_text, options, correct_options, question_order) VALUES
+    (test_id, '¿Cuál es un sinónimo de “feliz”?', ARRAY['Triste', 'Contento', 'Aburrido', 'Cansado'], '{1}', 1),
+    (test_id, '¿Cuál es el sujeto en la frase “El perro corre rápido”?', ARRAY['Corre', 'El', 'Perro', 'Rápido'], '{2}', 2),
+    (test_id, '¿Qué tipo de palabra es “rápidamente”?', ARRAY['Adjetivo', 'Sustantivo', 'Verbo', 'Adverbio'], '{3}', 3),
+    (test_id, '¿Qué signo se usa al final de una pregunta?', ARRAY['Punto', 'Coma', 'Signo de interrogación', 'Dos puntos'], '{2}', 4);
 
   -- 5. Tecnología y computación
   INSERT INTO tests (title, created_by) VALUES
     ('Test de Tecnología y Computación', 1)
     RETURNING id INTO test_id;
 
-  INSERT INTO questions (test_id, question_text, option_a, option_b, option_c, option_d, correct_answer, question_order) VALUES
-    (test_id, '¿Qué significa “CPU”?', 'Unidad Central de Procesamiento', 'Computadora Personal Única', 'Procesador Universal', 'Centro de Usuario Principal', 0, 1),
-    (test_id, '¿Qué lenguaje se usa comúnmente para páginas web?', 'Python', 'HTML', 'C++', 'SQL', 1, 2),
-    (test_id, '¿Cuál es un sistema operativo?', 'Chrome', 'Facebook', 'Linux', 'Google', 2, 3),
-    (test_id, '¿Qué es un byte?', 'Unidad de imagen', 'Tipo de virus', 'Unidad de almacenamiento', 'Archivo musical', 2, 4);
+  INSERT INTO questions (test_id, question_text, options, correct_options, question_order) VALUES
+    (test_id, '¿Qué significa “CPU”?', ARRAY['Unidad Central de Procesamiento', 'Computadora Personal Única', 'Procesador Universal', 'Centro de Usuario Principal'], '{0}', 1),
+    (test_id, '¿Qué lenguaje se usa comúnmente para páginas web?', ARRAY['Python', 'HTML', 'C++', 'SQL'], '{1}', 2),
+    (test_id, '¿Cuál es un sistema operativo?', ARRAY['Chrome', 'Facebook', 'Linux', 'Google'], '{2}', 3),
+    (test_id, '¿Qué es un byte?', ARRAY['Unidad de imagen', 'Tipo de virus', 'Unidad de almacenamiento', 'Archivo musical'], '{2}', 4);
+
+  -- 6. Repaso general, with every question type
+  INSERT INTO tests (title, created_by) VALUES
+    ('Test de Repaso General', 1)
+    RETURNING id INTO test_id;
+
+  INSERT INTO questions (test_id, question_text, question_type, options, correct_options, numeric_answer, tolerance, accepted_answers, question_order) VALUES
+    (test_id, '¿Cuál es la capital de Francia?', 'multiple_choice', ARRAY['Madrid', 'París', 'Roma'], '{1}', NULL, 0, '{}', 1),
+    (test_id, '¿Cuáles de estos números son primos?', 'multiple_select', ARRAY['2', '4', '7', '9', '11'], '{0,2,4}', NULL, 0, '{}', 2),
+    (test_id, 'El agua hierve a 100 °C al nivel del mar.', 'true_false', ARRAY['Verdadero', 'Falso'], '{0}', NULL, 0, '{}', 3),
+    (test_id, '¿Cuánto vale pi con dos decimales?', 'numeric', '{}', '{}', 3.14, 0.01, '{}', 4),
+    (test_id, '¿Qué gas producen las plantas en la fotosíntesis?', 'short_text', '{}', '{}', NULL, 0, ARRAY['oxigeno', 'oxígeno', 'o2'], 5),
+    (test_id, 'Ordena los planetas del más cercano al más lejano del Sol.', 'ordering', ARRAY['Tierra', 'Mercurio', 'Marte', 'Venus'], '{1,3,0,2}', NULL, 0, '{}', 6);
+
+  -- 7. Examen de matemáticas, drawn from a question bank
+  INSERT INTO question_banks (title, created_by) VALUES
+    ('Banco de Matemáticas', 1)
+    RETURNING id INTO bank_id;
+
+  INSERT INTO questions (bank_id, question_text, options, correct_options, topic, difficulty, question_order) VALUES
+    (bank_id, '¿Cuánto vale x si x + 3 = 7?', ARRAY['3', '4', '5', '10'], '{1}', 'algebra', 'easy', 1),
+    (bank_id, '¿Cuánto vale x si 2x = 10?', ARRAY['2', '5', '8', '20'], '{1}', 'algebra', 'easy', 2),
+    (bank_id, '¿Cuánto vale x si x - 4 = 2?', ARRAY['2', '6', '8', '-2'], '{1}', 'algebra', 'easy', 3),
+    (bank_id, '¿Cuánto vale x si 3x + 2 = 2x + 7?', ARRAY['3', '5', '7', '9'], '{1}', 'algebra', 'hard', 4),
+    (bank_id, '¿Cuántos grados suman los ángulos internos de un triángulo?', ARRAY['90', '180', '270', '360'], '{1}', 'geometria', 'easy', 5),
+    (bank_id, '¿Cuál es el área de un círculo de radio 2?', ARRAY['2π', '4π', '8π', 'π'], '{1}', 'geometria', 'hard', 6),
+    (bank_id, '¿Cuánto mide la diagonal de un cuadrado de lado 1?', ARRAY['1', '√2', '2', '√3'], '{1}', 'geometria', 'hard', 7);
+
+  INSERT INTO tests (title, created_by) VALUES
+    ('Examen de Matemáticas', 1)
+    RETURNING id INTO test_id;
+
+  INSERT INTO draw_rules (test_id, bank_id, topic, difficulty, question_count) VALUES
+    (test_id, bank_id, 'algebra', 'easy', 2),
+    (test_id, bank_id, 'geometria', 'hard', 1);
 END;
 $$;
@@ -7,6 +7,7 @@ import (
 	"sync"
 	"time"
 
+	"github.com/gorilla/websocket"
 	codelab "github.com/ynoacamino/infra-sustainable-classrooms/services/codelab/gen/codelab"
 	codelabsvr "github.com/ynoacamino/infra-sustainable-classrooms/services/codelab/gen/http/codelab/server"
 	"goa.design/clue/debug"
@@ -29,7 +30,9 @@ func handleHTTPServer(ctx context.Context, u *url.URL, codelabEndpoints *codelab
 
 	var codelabServer *codelabsvr.Server
 	eh := errorHandler(ctx)
-	codelabServer = codelabsvr.New(codelabEndpoints, mux, dec, enc, eh, nil)
+	// Attempt events are streamed over WebSockets
+	upgrader := &websocket.Upgrader{}
+	codelabServer = codelabsvr.New(codelabEndpoints, mux, dec, enc, eh, nil, upgrader, nil)
 
 	codelabsvr.Mount(mux, codelabServer)
 
@@ -8,12 +8,18 @@ import (
 	"os/signal"
 	"sync"
 	"syscall"
+	"time"
 
 	"github.com/ynoacamino/infra-sustainable-classrooms/services/codelab/config"
 	"github.com/ynoacamino/infra-sustainable-classrooms/services/codelab/gen/codelab"
 	"github.com/ynoacamino/infra-sustainable-classrooms/services/codelab/internal/connections"
 	codelabapi "github.com/ynoacamino/infra-sustainable-classrooms/services/codelab/internal/controllers"
+	"github.com/ynoacamino/infra-sustainable-classrooms/services/codelab/internal/grading"
+	"github.com/ynoacamino/infra-sustainable-classrooms/services/codelab/internal/plagiarism"
+	"github.com/ynoacamino/infra-sustainable-classrooms/services/codelab/internal/ratelimit"
 	"github.com/ynoacamino/infra-sustainable-classrooms/services/codelab/internal/repositories"
+	"github.com/ynoacamino/infra-sustainable-classrooms/services/codelab/internal/sandbox"
+	"github.com/ynoacamino/infra-sustainable-classrooms/services/codelab/internal/services"
 	"goa.design/clue/debug"
 	"goa.design/clue/log"
 )
@@ -45,11 +51,78 @@ func main() {
 	}
 	defer grpccoon.Close()
 
+	// Redis only backs the rate limiter, which lets executions through while
+	// it is unreachable
+	redisClient, err := connections.ConnectRedis(cfg)
+	if err != nil {
+		log.Errorf(ctx, err, "Redis is unreachable, code executions are not rate limited until it is back")
+	} else {
+		log.Printf(ctx, "Redis connection established")
+	}
+	defer redisClient.Close()
+
 	// Initialize repository manager
 	reposManager := repositories.NewRepositoryManager(pool, grpccoon)
 	defer reposManager.Close()
 
-	var codelabSvc codelab.Service = codelabapi.NewCodelab(reposManager)
+	// Attempts left pending by a replica that stopped would never be graded
+	closed, err := codelabapi.FailStalePendingAttempts(ctx, reposManager.AttemptsRepo)
+	if err != nil {
+		log.Errorf(ctx, err, "failed to close stale pending attempts")
+	} else if closed > 0 {
+		log.Printf(ctx, "closed %d stale pending attempts", closed)
+	}
+
+	// Initialize sandbox executor for student code
+	executor := sandbox.NewExecutor(sandbox.Options{
+		Workers:      cfg.ExecutionWorkers,
+		QueueTimeout: cfg.ExecutionQueueTimeout,
+		Limits: sandbox.Limits{
+			TestTimeout:      cfg.ExecutionTestTimeout,
+			TotalTimeout:     cfg.ExecutionTotalTimeout,
+			MaxCallStackSize: cfg.ExecutionMaxStack,
+			MaxMemoryBytes:   uint64(cfg.ExecutionMaxMemoryMB) << 20,
+			MaxConsoleBytes:  cfg.ExecutionMaxConsoleKB << 10,
+		},
+	})
+
+	// Initialize grading queue for submitted attempts, with a worker for
+	// every execution slot
+	gradingQueue := grading.NewQueue(cfg.ExecutionWorkers, cfg.SubmissionQueueSize)
+	gradingQueue.Start(cfg.Ctx)
+	defer gradingQueue.Stop()
+
+	// Initialize regrade queue, running one regrade at a time with its
+	// attempts spread over a pool of workers
+	regradeQueue := grading.NewQueue(1, cfg.RegradeQueueSize)
+	regradeQueue.Start(cfg.Ctx)
+	defer regradeQueue.Stop()
+	regradePool := grading.NewPool(cfg.RegradeWorkers)
+
+	// Initialize rate limiter shared by every replica through Redis
+	rateLimiter, err := ratelimit.NewLimiter(redisClient, ratelimit.Config{
+		Rules: map[ratelimit.Action]ratelimit.Rule{
+			ratelimit.ActionAttempt: {Limit: cfg.AttemptRateLimit, Window: cfg.RateLimitWindow, Cooldown: cfg.AttemptCooldown},
+			ratelimit.ActionRun:     {Limit: cfg.RunRateLimit, Window: cfg.RateLimitWindow, Cooldown: cfg.RunCooldown},
+		},
+		MaxConcurrent: cfg.MaxConcurrentExecutions,
+	})
+	if err != nil {
+		log.Fatal(ctx, fmt.Errorf("failed to create rate limiter: %w", err))
+	}
+
+	// Initialize plagiarism service for periodic similarity checks
+	plagiarismService := services.NewPlagiarismServiceWithConfig(cfg.Ctx, reposManager, services.PlagiarismServiceConfig{
+		Threshold: float64(cfg.PlagiarismThreshold),
+		Options:   plagiarism.DefaultOptions(),
+	})
+
+	// Start plagiarism service with configured interval
+	plagiarismInterval := time.Duration(cfg.PlagiarismCheckIntervalSeconds) * time.Second
+	plagiarismService.Start(plagiarismInterval)
+	defer plagiarismService.Stop()
+
+	var codelabSvc codelab.Service = codelabapi.NewCodelab(reposManager, executor, gradingQueue, regradeQueue, regradePool, rateLimiter)
 
 	var codelabEndpoints *codelab.Endpoints
 	codelabEndpoints = codelab.NewEndpoints(codelabSvc)
@@ -21,6 +21,34 @@ const (
 	APP_ENV   = "APP_ENV"
 
 	PROFILES_GRPC_ADDRESS = "PROFILES_GRPC_ADDRESS"
+
+	REDIS_ENDPOINT = "REDIS_ENDPOINT"
+
+	// Code execution configuration
+	EXECUTION_WORKERS          = "EXECUTION_WORKERS"
+	EXECUTION_QUEUE_TIMEOUT_MS = "EXECUTION_QUEUE_TIMEOUT_MS"
+	EXECUTION_TEST_TIMEOUT_MS  = "EXECUTION_TEST_TIMEOUT_MS"
+	EXECUTION_TOTAL_TIMEOUT_MS = "EXECUTION_TOTAL_TIMEOUT_MS"
+	EXECUTION_MAX_STACK_DEPTH  = "EXECUTION_MAX_STACK_DEPTH"
+	EXECUTION_MAX_MEMORY_MB    = "EXECUTION_MAX_MEMORY_MB"
+	EXECUTION_MAX_CONSOLE_KB   = "EXECUTION_MAX_CONSOLE_KB"
+
+	// Background grading configuration
+	SUBMISSION_QUEUE_SIZE = "SUBMISSION_QUEUE_SIZE"
+	REGRADE_WORKERS       = "REGRADE_WORKERS"
+	REGRADE_QUEUE_SIZE    = "REGRADE_QUEUE_SIZE"
+
+	// Rate limiting configuration
+	RATE_LIMIT_WINDOW_SECONDS = "RATE_LIMIT_WINDOW_SECONDS"
+	ATTEMPT_RATE_LIMIT        = "ATTEMPT_RATE_LIMIT"
+	ATTEMPT_COOLDOWN_SECONDS  = "ATTEMPT_COOLDOWN_SECONDS"
+	RUN_RATE_LIMIT            = "RUN_RATE_LIMIT"
+	RUN_COOLDOWN_SECONDS      = "RUN_COOLDOWN_SECONDS"
+	MAX_CONCURRENT_EXECUTIONS = "MAX_CONCURRENT_EXECUTIONS"
+
+	// Plagiarism detection configuration
+	PLAGIARISM_CHECK_INTERVAL_SECONDS = "PLAGIARISM_CHECK_INTERVAL_SECONDS"
+	PLAGIARISM_THRESHOLD              = "PLAGIARISM_THRESHOLD"
 )
 
 type DBConfig struct {
@@ -30,6 +58,11 @@ type DBConfig struct {
 	MinConns         int
 }
 
+type RedisConfig struct {
+	Ctx      context.Context
+	Endpoint string
+}
+
 type ConnectGRPCConfig struct {
 	GrpcAddress string
 }
@@ -49,11 +82,40 @@ type Config struct {
 	// gRPC configuration
 	ProfilesGRPCAddress string
 
+	// Redis configuration
+	RedisEndpoint string
+
 	TOTPIssuer      string
 	SessionDuration time.Duration
 
 	// Security
 	BackupCodesCount int
+
+	// Code execution configuration
+	ExecutionWorkers      int
+	ExecutionQueueTimeout time.Duration
+	ExecutionTestTimeout  time.Duration
+	ExecutionTotalTimeout time.Duration
+	ExecutionMaxStack     int
+	ExecutionMaxMemoryMB  int
+	ExecutionMaxConsoleKB int
+
+	// Background grading configuration
+	SubmissionQueueSize int
+	RegradeWorkers      int
+	RegradeQueueSize    int
+
+	// Rate limiting configuration
+	RateLimitWindow         time.Duration
+	AttemptRateLimit        int
+	AttemptCooldown         time.Duration
+	RunRateLimit            int
+	RunCooldown             time.Duration
+	MaxConcurrentExecutions int
+
+	// Plagiarism detection configuration
+	PlagiarismCheckIntervalSeconds int
+	PlagiarismThreshold            int
 }
 
 func NewConfig() (*Config, error) {
@@ -79,9 +141,74 @@ func NewConfig() (*Config, error) {
 	// gRPC configuration
 	profilesGRPCAddress := getEnvOrDefault(PROFILES_GRPC_ADDRESS, fmt.Sprintf("localhost:%s", grpcPort))
 
+	// Redis configuration
+	redisEndpoint := getEnvOrDefault(REDIS_ENDPOINT, "redis:6379")
+	if redisEndpoint == "" {
+		return nil, fmt.Errorf("redis configuration is incomplete")
+	}
+
 	// Parse boolean and numeric values
 	debug := parseBoolOrDefault(DBG, false)
 
+	// Code execution configuration
+	executionWorkers := parseIntOrDefault(EXECUTION_WORKERS, 4)
+	executionQueueTimeout := time.Duration(parseIntOrDefault(EXECUTION_QUEUE_TIMEOUT_MS, 5000)) * time.Millisecond
+	executionTestTimeout := time.Duration(parseIntOrDefault(EXECUTION_TEST_TIMEOUT_MS, 2000)) * time.Millisecond
+	executionTotalTimeout := time.Duration(parseIntOrDefault(EXECUTION_TOTAL_TIMEOUT_MS, 10000)) * time.Millisecond
+	executionMaxStack := parseIntOrDefault(EXECUTION_MAX_STACK_DEPTH, 1024)
+	executionMaxMemoryMB := parseIntOrDefault(EXECUTION_MAX_MEMORY_MB, 64)
+	executionMaxConsoleKB := parseIntOrDefault(EXECUTION_MAX_CONSOLE_KB, 16)
+	if executionWorkers <= 0 {
+		return nil, fmt.Errorf("execution workers (%d) must be greater than zero", executionWorkers)
+	}
+	if executionTotalTimeout < executionTestTimeout {
+		return nil, fmt.Errorf("execution total timeout (%v) cannot be less than test timeout (%v)", executionTotalTimeout, executionTestTimeout)
+	}
+
+	// Background grading configuration
+	submissionQueueSize := parseIntOrDefault(SUBMISSION_QUEUE_SIZE, 100)
+	if submissionQueueSize <= 0 {
+		return nil, fmt.Errorf("submission queue size (%d) must be greater than zero", submissionQueueSize)
+	}
+	regradeWorkers := parseIntOrDefault(REGRADE_WORKERS, 2)
+	regradeQueueSize := parseIntOrDefault(REGRADE_QUEUE_SIZE, 10)
+	if regradeWorkers <= 0 || regradeWorkers > executionWorkers {
+		return nil, fmt.Errorf("regrade workers (%d) must be between one and the execution workers (%d)", regradeWorkers, executionWorkers)
+	}
+	if regradeQueueSize <= 0 {
+		return nil, fmt.Errorf("regrade queue size (%d) must be greater than zero", regradeQueueSize)
+	}
+
+	// Rate limiting configuration
+	rateLimitWindow := time.Duration(parseIntOrDefault(RATE_LIMIT_WINDOW_SECONDS, 60)) * time.Second
+	attemptRateLimit := parseIntOrDefault(ATTEMPT_RATE_LIMIT, 10)
+	attemptCooldown := time.Duration(parseIntOrDefault(ATTEMPT_COOLDOWN_SECONDS, 5)) * time.Second
+	runRateLimit := parseIntOrDefault(RUN_RATE_LIMIT, 30)
+	runCooldown := time.Duration(parseIntOrDefault(RUN_COOLDOWN_SECONDS, 1)) * time.Second
+	maxConcurrentExecutions := parseIntOrDefault(MAX_CONCURRENT_EXECUTIONS, 16)
+	if rateLimitWindow <= 0 {
+		return nil, fmt.Errorf("rate limit window (%v) must be greater than zero", rateLimitWindow)
+	}
+	if attemptRateLimit <= 0 || runRateLimit <= 0 {
+		return nil, fmt.Errorf("rate limits (attempts %d, runs %d) must be greater than zero", attemptRateLimit, runRateLimit)
+	}
+	if attemptCooldown < 0 || attemptCooldown > rateLimitWindow || runCooldown < 0 || runCooldown > rateLimitWindow {
+		return nil, fmt.Errorf("cooldowns (attempts %v, runs %v) must be between zero and the rate limit window (%v)", attemptCooldown, runCooldown, rateLimitWindow)
+	}
+	if maxConcurrentExecutions <= 0 {
+		return nil, fmt.Errorf("max concurrent executions (%d) must be greater than zero", maxConcurrentExecutions)
+	}
+
+	// Plagiarism detection configuration
+	plagiarismCheckIntervalSeconds := parseIntOrDefault(PLAGIARISM_CHECK_INTERVAL_SECONDS, 300)
+	plagiarismThreshold := parseIntOrDefault(PLAGIARISM_THRESHOLD, 70)
+	if plagiarismCheckIntervalSeconds <= 0 {
+		return nil, fmt.Errorf("plagiarism check interval (%d) must be greater than zero", plagiarismCheckIntervalSeconds)
+	}
+	if plagiarismThreshold < 0 || plagiarismThreshold > 100 {
+		return nil, fmt.Errorf("plagiarism threshold (%d) must be between 0 and 100", plagiarismThreshold)
+	}
+
 	format := goaLog.FormatTerminal
 
 	ctx := goaLog.Context(context.Background(), goaLog.WithFormat(format))
@@ -95,6 +222,7 @@ func NewConfig() (*Config, error) {
 	goaLog.Print(ctx, goaLog.KV{K: "http-port", V: httpPort})
 	goaLog.Print(ctx, goaLog.KV{K: "grpc-port", V: grpcPort})
 	goaLog.Print(ctx, goaLog.KV{K: "environment", V: environment})
+	goaLog.Print(ctx, goaLog.KV{K: "execution-workers", V: executionWorkers})
 
 	return &Config{
 		DatabaseURL:         databaseURL,
@@ -106,6 +234,29 @@ func NewConfig() (*Config, error) {
 		MaxConns:            max_conns,
 		MinConns:            min_conns,
 		ProfilesGRPCAddress: profilesGRPCAddress,
+		RedisEndpoint:       redisEndpoint,
+
+		ExecutionWorkers:      executionWorkers,
+		ExecutionQueueTimeout: executionQueueTimeout,
+		ExecutionTestTimeout:  executionTestTimeout,
+		ExecutionTotalTimeout: executionTotalTimeout,
+		ExecutionMaxStack:     executionMaxStack,
+		ExecutionMaxMemoryMB:  executionMaxMemoryMB,
+		ExecutionMaxConsoleKB: executionMaxConsoleKB,
+
+		SubmissionQueueSize: submissionQueueSize,
+		RegradeWorkers:      regradeWorkers,
+		RegradeQueueSize:    regradeQueueSize,
+
+		RateLimitWindow:         rateLimitWindow,
+		AttemptRateLimit:        attemptRateLimit,
+		AttemptCooldown:         attemptCooldown,
+		RunRateLimit:            runRateLimit,
+		RunCooldown:             runCooldown,
+		MaxConcurrentExecutions: maxConcurrentExecutions,
+
+		PlagiarismCheckIntervalSeconds: plagiarismCheckIntervalSeconds,
+		PlagiarismThreshold:            plagiarismThreshold,
 	}, nil
 }
 
@@ -113,6 +264,13 @@ func (c *Config) IsProduction() bool {
 	return c.Environment == "production"
 }
 
+func (c *Config) GetRedisConfig() *RedisConfig {
+	return &RedisConfig{
+		Ctx:      c.Ctx,
+		Endpoint: c.RedisEndpoint,
+	}
+}
+
 func (c *Config) GetDatabaseConfig() *DBConfig {
 	return &DBConfig{
 		ConnectionString: c.DatabaseURL,
@@ -21,12 +21,13 @@ var _ = Service("codelab", func() {
 	// Global error definitions for the service
 
 	Error("invalid_input", String, "Invalid input parameters")
-	Error("rate_limited", String, "Too many requests")
+	Error("rate_limited", RateLimit, "Too many requests")
 	Error("service_unavailable", String, "Service temporarily unavailable")
 	Error("unauthorized", String, "Unauthorized access")
 	Error("permission_denied", String, "Permission denied (only teachers)")
 	Error("not_found", String, "Resource not found")
 	Error("internal_error", String, "Internal server error")
+	Error("solution_failed", SolutionFailure, "Exercise solution does not pass its tests")
 
 	// ========================================
 	// EXERCISE CRUD ENDPOINTS (for professors)
@@ -44,6 +45,7 @@ var _ = Service("codelab", func() {
 			Cookie("session_token:session")
 			Response(StatusCreated)
 			Response("invalid_input", StatusBadRequest)
+			Response("solution_failed", StatusUnprocessableEntity)
 			Response("unauthorized", StatusUnauthorized)
 			Response("service_unavailable", StatusServiceUnavailable)
 		})
@@ -75,19 +77,48 @@ var _ = Service("codelab", func() {
 	})
 
 	Method("ListExercises", func() {
-		Description("List all exercises with solutions (professors only)")
+		Description("Search exercises with solutions, newest first (professors only)")
 
 		Payload(func() {
 			Field(1, "session_token", String, "Authentication session token")
+			Field(2, "search", String, "Words to look for in the title and description", func() {
+				Example("recursion")
+				MaxLength(200)
+			})
+			Field(3, "difficulty", String, "Only exercises of this difficulty", func() {
+				Enum("easy", "medium", "hard")
+			})
+			Field(4, "tags", ArrayOf(String), "Only exercises tagged with all these topics", func() {
+				Example([]string{"recursion"})
+			})
+			Field(5, "created_by", Int64, "Only exercises created by this user", func() {
+				Example(123)
+			})
+			Field(6, "cursor", String, "Cursor of the page to return, from a previous page")
+			Field(7, "limit", Int, "Maximum number of exercises to return", func() {
+				Minimum(1)
+				Maximum(100)
+				Default(50)
+			})
 			Required("session_token")
 		})
 
-		Result(ArrayOf(Exercise))
+		Result(ExercisePage)
 
 		HTTP(func() {
 			GET("/exercises")
 			Cookie("session_token:session")
-			Response(StatusOK)
+			Param("search")
+			Param("difficulty")
+			Param("tags:tag")
+			Param("created_by:author")
+			Param("cursor")
+			Param("limit")
+			Response(StatusOK, func() {
+				Header("next_cursor:X-Next-Cursor")
+				Body("exercises")
+			})
+			Response("invalid_input", StatusBadRequest)
 			Response("not_found", StatusNotFound)
 			Response("unauthorized", StatusUnauthorized)
 			Response("service_unavailable", StatusServiceUnavailable)
@@ -95,8 +126,27 @@ var _ = Service("codelab", func() {
 		})
 	})
 
+	Method("ListTags", func() {
+		Description("List the topics exercises are tagged with (professors and students)")
+
+		Payload(func() {
+			Field(1, "session_token", String, "Authentication session token")
+			Required("session_token")
+		})
+
+		Result(ArrayOf(TagCount))
+
+		HTTP(func() {
+			GET("/tags")
+			Cookie("session_token:session")
+			Response(StatusOK)
+			Response("unauthorized", StatusUnauthorized)
+			Response("service_unavailable", StatusServiceUnavailable)
+		})
+	})
+
 	Method("UpdateExercise", func() {
-		Description("Update an exercise (professors only)")
+		Description("Update an exercise, saved as a new version. Answers keep their status until the exercise is regraded (professors only)")
 
 		Payload(func() {
 			Field(1, "id", Int64, "Exercise ID", func() {
@@ -114,6 +164,7 @@ var _ = Service("codelab", func() {
 			Cookie("session_token:session")
 			Response(StatusOK)
 			Response("not_found", StatusNotFound)
+			Response("solution_failed", StatusUnprocessableEntity)
 			Response("unauthorized", StatusUnauthorized)
 			Response("service_unavailable", StatusServiceUnavailable)
 			Response("permission_denied", StatusForbidden)
@@ -146,12 +197,74 @@ var _ = Service("codelab", func() {
 		})
 	})
 
+	// ========================================
+	// IMPORT / EXPORT ENDPOINTS (for professors)
+	// ========================================
+
+	Method("ExportExercises", func() {
+		Description("Export exercises with their tests and solutions as a bundle (professors only)")
+
+		Payload(func() {
+			Field(1, "exercise_ids", ArrayOf(Int64), "IDs of the exercises to export", func() {
+				Example([]int64{1, 2})
+				MinLength(1)
+			})
+			Field(2, "format", String, "Bundle format: a JSON document or a zip or gzipped tar archive", func() {
+				Example("json")
+				Enum("json", "zip", "tar.gz")
+				Default("json")
+			})
+			Field(3, "session_token", String, "Authentication session token")
+
+			Required("session_token", "exercise_ids")
+		})
+
+		Result(ExerciseBundleFile)
+
+		HTTP(func() {
+			GET("/exercises/export")
+			Param("exercise_ids")
+			Param("format")
+			Cookie("session_token:session")
+			Response(StatusOK)
+			Response("not_found", StatusNotFound)
+			Response("unauthorized", StatusUnauthorized)
+			Response("service_unavailable", StatusServiceUnavailable)
+			Response("permission_denied", StatusForbidden)
+			Response("invalid_input", StatusBadRequest)
+		})
+	})
+
+	Method("ImportExercises", func() {
+		Description("Import the exercises of a bundle once every solution passes its tests (professors only)")
+
+		Payload(func() {
+			Field(1, "content", Bytes, "Bundle in any of the export formats")
+			Field(2, "session_token", String, "Authentication session token")
+
+			Required("session_token", "content")
+		})
+
+		Result(ImportedExercises)
+
+		HTTP(func() {
+			POST("/exercises/import")
+			Cookie("session_token:session")
+			Response(StatusCreated)
+			Response("invalid_input", StatusBadRequest)
+			Response("solution_failed", StatusUnprocessableEntity)
+			Response("unauthorized", StatusUnauthorized)
+			Response("service_unavailable", StatusServiceUnavailable)
+			Response("permission_denied", StatusForbidden)
+		})
+	})
+
 	// ========================================
 	// TEST CRUD ENDPOINTS (for professors)
 	// ========================================
 
 	Method("CreateTest", func() {
-		Description("Create a new test case for an exercise (professors only)")
+		Description("Create a new test case for an exercise, saved as a new version of the exercise (professors only)")
 
 		Payload(CreateTestPayload)
 
@@ -162,6 +275,7 @@ var _ = Service("codelab", func() {
 			Cookie("session_token:session")
 			Response(StatusCreated)
 			Response("invalid_input", StatusBadRequest)
+			Response("solution_failed", StatusUnprocessableEntity)
 			Response("unauthorized", StatusUnauthorized)
 			Response("service_unavailable", StatusServiceUnavailable)
 			Response("permission_denied", StatusForbidden)
@@ -194,7 +308,7 @@ var _ = Service("codelab", func() {
 	})
 
 	Method("UpdateTest", func() {
-		Description("Update a test case (professors only)")
+		Description("Update a test case, saved as a new version of its exercise (professors only)")
 
 		Payload(func() {
 			Field(1, "id", Int64, "Test ID", func() {
@@ -213,6 +327,7 @@ var _ = Service("codelab", func() {
 			Response(StatusOK)
 			Response("not_found", StatusNotFound)
 			Response("invalid_input", StatusBadRequest)
+			Response("solution_failed", StatusUnprocessableEntity)
 			Response("unauthorized", StatusUnauthorized)
 			Response("service_unavailable", StatusServiceUnavailable)
 			Response("permission_denied", StatusForbidden)
@@ -220,7 +335,7 @@ var _ = Service("codelab", func() {
 	})
 
 	Method("DeleteTest", func() {
-		Description("Delete a test case (professors only)")
+		Description("Delete a test case, saved as a new version of its exercise (professors only)")
 
 		Payload(func() {
 			Field(1, "id", Int64, "Test ID", func() {
@@ -246,25 +361,25 @@ var _ = Service("codelab", func() {
 	})
 
 	// ========================================
-	// STUDENT ENDPOINTS (read exercises, submit attempts)
+	// VERSION ENDPOINTS (for professors)
 	// ========================================
 
-	Method("GetExerciseForStudent", func() {
-		Description("Get exercise by ID without solution (students)")
+	Method("ListExerciseVersions", func() {
+		Description("List the versions of an exercise, newest first (professors only)")
 
 		Payload(func() {
-			Field(1, "id", Int64, "Exercise ID", func() {
+			Field(1, "exercise_id", Int64, "Exercise ID", func() {
 				Example(1)
 			})
 			Field(2, "session_token", String, "Authentication session token")
 
-			Required("session_token", "id")
+			Required("session_token", "exercise_id")
 		})
 
-		Result(ExerciseForStudents)
+		Result(ArrayOf(ExerciseVersion))
 
 		HTTP(func() {
-			GET("/student/exercises/{id}")
+			GET("/exercises/{exercise_id}/versions")
 			Cookie("session_token:session")
 			Response(StatusOK)
 			Response("not_found", StatusNotFound)
@@ -275,66 +390,763 @@ var _ = Service("codelab", func() {
 		})
 	})
 
-	Method("ListExercisesForStudents", func() {
-		Description("List all exercises without solutions (students)")
+	Method("DiffExerciseVersions", func() {
+		Description("Compare two versions of an exercise and its tests (professors only)")
 
 		Payload(func() {
-			Field(1, "session_token", String, "Authentication session token")
-			Required("session_token")
+			Field(1, "exercise_id", Int64, "Exercise ID", func() {
+				Example(1)
+			})
+			Field(2, "from", Int32, "Older version", func() {
+				Example(1)
+				Minimum(1)
+			})
+			Field(3, "to", Int32, "Newer version", func() {
+				Example(2)
+				Minimum(1)
+			})
+			Field(4, "session_token", String, "Authentication session token")
+
+			Required("session_token", "exercise_id", "from", "to")
 		})
 
-		Result(ArrayOf(ExerciseForStudentsListView))
+		Result(ExerciseVersionDiff)
 
 		HTTP(func() {
-			GET("/student/exercises")
+			GET("/exercises/{exercise_id}/versions/diff")
+			Param("from")
+			Param("to")
 			Cookie("session_token:session")
 			Response(StatusOK)
+			Response("not_found", StatusNotFound)
 			Response("unauthorized", StatusUnauthorized)
+			Response("service_unavailable", StatusServiceUnavailable)
+			Response("permission_denied", StatusForbidden)
+			Response("invalid_input", StatusBadRequest)
+		})
+	})
+
+	Method("RollbackExercise", func() {
+		Description("Restore an exercise and its tests as of a version, saved as a new version. Answers keep their status until the exercise is regraded (professors only)")
+
+		Payload(func() {
+			Field(1, "exercise_id", Int64, "Exercise ID", func() {
+				Example(1)
+			})
+			Field(2, "version", Int32, "Version to restore", func() {
+				Example(1)
+				Minimum(1)
+			})
+			Field(3, "session_token", String, "Authentication session token")
+
+			Required("session_token", "exercise_id", "version")
+		})
+
+		Result(SimpleResponse)
+
+		HTTP(func() {
+			POST("/exercises/{exercise_id}/versions/{version}/rollback")
+			Cookie("session_token:session")
+			Response(StatusOK)
 			Response("not_found", StatusNotFound)
+			Response("solution_failed", StatusUnprocessableEntity)
+			Response("unauthorized", StatusUnauthorized)
 			Response("service_unavailable", StatusServiceUnavailable)
 			Response("permission_denied", StatusForbidden)
 			Response("invalid_input", StatusBadRequest)
 		})
 	})
 
-	Method("CreateAttempt", func() {
-		Description("Submit a code attempt for an exercise (students)")
+	// ========================================
+	// REGRADE ENDPOINTS (for professors)
+	// ========================================
 
-		Payload(CreateAttemptPayload)
+	Method("RegradeExercise", func() {
+		Description("Run every graded attempt of an exercise again against its current tests in the background, updating the outcome of attempts and answers (professors only)")
+
+		Payload(func() {
+			Field(1, "exercise_id", Int64, "Exercise ID", func() {
+				Example(1)
+			})
+			Field(2, "session_token", String, "Authentication session token")
+
+			Required("session_token", "exercise_id")
+		})
+
+		Result(Regrade)
+
+		HTTP(func() {
+			POST("/exercises/{exercise_id}/regrade")
+			Cookie("session_token:session")
+			Response(StatusAccepted)
+			Response("not_found", StatusNotFound)
+			Response("unauthorized", StatusUnauthorized)
+			Response("service_unavailable", StatusServiceUnavailable)
+			Response("permission_denied", StatusForbidden)
+			Response("invalid_input", StatusBadRequest)
+		})
+	})
+
+	Method("GetRegrade", func() {
+		Description("Get the progress of a regrade and, once finished, the students whose outcome changed (professors only)")
+
+		Payload(func() {
+			Field(1, "id", Int64, "Regrade ID", func() {
+				Example(1)
+			})
+			Field(2, "session_token", String, "Authentication session token")
+
+			Required("session_token", "id")
+		})
+
+		Result(Regrade)
+
+		HTTP(func() {
+			GET("/regrades/{id}")
+			Cookie("session_token:session")
+			Response(StatusOK)
+			Response("not_found", StatusNotFound)
+			Response("unauthorized", StatusUnauthorized)
+			Response("service_unavailable", StatusServiceUnavailable)
+			Response("permission_denied", StatusForbidden)
+			Response("invalid_input", StatusBadRequest)
+		})
+	})
+
+	// ========================================
+	// HINT CRUD ENDPOINTS (for professors)
+	// ========================================
+
+	Method("CreateHint", func() {
+		Description("Add a hint after the last hint of an exercise (professors only)")
+
+		Payload(CreateHintPayload)
 
 		Result(SimpleResponse)
 
 		HTTP(func() {
-			POST("/attempts")
+			POST("/hints")
 			Cookie("session_token:session")
 			Response(StatusCreated)
+			Response("not_found", StatusNotFound)
 			Response("invalid_input", StatusBadRequest)
 			Response("unauthorized", StatusUnauthorized)
-			Response("not_found", StatusNotFound)
 			Response("service_unavailable", StatusServiceUnavailable)
 			Response("permission_denied", StatusForbidden)
 		})
 	})
 
-	Method("GetAttemptsByUserAndExercise", func() {
-		Description("Get user's attempts for a specific exercise (students)")
+	Method("GetHintsByExercise", func() {
+		Description("Get the hints of an exercise in the order they are revealed (professors only)")
 
 		Payload(func() {
-			Field(1, "user_id", Int64, "User ID", func() {
-				Example(123)
+			Field(1, "exercise_id", Int64, "Exercise ID", func() {
+				Example(1)
 			})
-			Field(2, "exercise_id", Int64, "Exercise ID", func() {
+			Field(2, "session_token", String, "Authentication session token")
+			Required("session_token", "exercise_id")
+		})
+
+		Result(ArrayOf(Hint))
+
+		HTTP(func() {
+			GET("/exercises/{exercise_id}/hints")
+			Cookie("session_token:session")
+			Response(StatusOK)
+			Response("not_found", StatusNotFound)
+			Response("unauthorized", StatusUnauthorized)
+			Response("service_unavailable", StatusServiceUnavailable)
+			Response("permission_denied", StatusForbidden)
+			Response("invalid_input", StatusBadRequest)
+		})
+	})
+
+	Method("UpdateHint", func() {
+		Description("Update a hint (professors only)")
+
+		Payload(func() {
+			Field(1, "id", Int64, "Hint ID", func() {
 				Example(1)
 			})
+			Field(2, "hint", UpdateHintPayload, "Hint data to update")
 			Field(3, "session_token", String, "Authentication session token")
+			Required("session_token", "id", "hint")
+		})
 
-			Required("user_id", "exercise_id", "session_token")
+		Result(SimpleResponse)
+
+		HTTP(func() {
+			PUT("/hints/{id}")
+			Cookie("session_token:session")
+			Response(StatusOK)
+			Response("not_found", StatusNotFound)
+			Response("invalid_input", StatusBadRequest)
+			Response("unauthorized", StatusUnauthorized)
+			Response("service_unavailable", StatusServiceUnavailable)
+			Response("permission_denied", StatusForbidden)
 		})
+	})
 
-		Result(ArrayOf(Attempt))
+	Method("DeleteHint", func() {
+		Description("Delete a hint (professors only)")
+
+		Payload(func() {
+			Field(1, "id", Int64, "Hint ID", func() {
+				Example(1)
+			})
+			Field(2, "session_token", String, "Authentication session token")
+
+			Required("session_token", "id")
+		})
+
+		Result(SimpleResponse)
 
 		HTTP(func() {
-			GET("/student/users/{user_id}/exercises/{exercise_id}/attempts")
+			DELETE("/hints/{id}")
+			Cookie("session_token:session")
+			Response(StatusOK)
+			Response("not_found", StatusNotFound)
+			Response("unauthorized", StatusUnauthorized)
+			Response("service_unavailable", StatusServiceUnavailable)
+			Response("permission_denied", StatusForbidden)
+			Response("invalid_input", StatusBadRequest)
+		})
+	})
+
+	// ========================================
+	// ASSIGNMENT ENDPOINTS (for professors)
+	// ========================================
+
+	Method("CreateAssignment", func() {
+		Description("Create an assignment grouping exercises in order (professors only)")
+
+		Payload(CreateAssignmentPayload)
+
+		Result(SimpleResponse)
+
+		HTTP(func() {
+			POST("/assignments")
+			Cookie("session_token:session")
+			Response(StatusCreated)
+			Response("not_found", StatusNotFound)
+			Response("invalid_input", StatusBadRequest)
+			Response("unauthorized", StatusUnauthorized)
+			Response("service_unavailable", StatusServiceUnavailable)
+			Response("permission_denied", StatusForbidden)
+		})
+	})
+
+	Method("GetAssignment", func() {
+		Description("Get assignment by ID with its exercises (professors only)")
+
+		Payload(func() {
+			Field(1, "id", Int64, "Assignment ID", func() {
+				Example(1)
+			})
+			Field(2, "session_token", String, "Authentication session token")
+
+			Required("session_token", "id")
+		})
+
+		Result(Assignment)
+
+		HTTP(func() {
+			GET("/assignments/{id}")
+			Cookie("session_token:session")
+			Response(StatusOK)
+			Response("not_found", StatusNotFound)
+			Response("unauthorized", StatusUnauthorized)
+			Response("service_unavailable", StatusServiceUnavailable)
+			Response("permission_denied", StatusForbidden)
+			Response("invalid_input", StatusBadRequest)
+		})
+	})
+
+	Method("ListAssignments", func() {
+		Description("List all assignments, soonest deadline first (professors only)")
+
+		Payload(func() {
+			Field(1, "session_token", String, "Authentication session token")
+			Required("session_token")
+		})
+
+		Result(ArrayOf(Assignment))
+
+		HTTP(func() {
+			GET("/assignments")
+			Cookie("session_token:session")
+			Response(StatusOK)
+			Response("unauthorized", StatusUnauthorized)
+			Response("service_unavailable", StatusServiceUnavailable)
+			Response("permission_denied", StatusForbidden)
+			Response("invalid_input", StatusBadRequest)
+		})
+	})
+
+	Method("UpdateAssignment", func() {
+		Description("Update an assignment and replace its exercises (professors only)")
+
+		Payload(func() {
+			Field(1, "id", Int64, "Assignment ID", func() {
+				Example(1)
+			})
+			Field(2, "assignment", UpdateAssignmentPayload, "Assignment data to update")
+			Field(3, "session_token", String, "Authentication session token")
+			Required("session_token", "id", "assignment")
+		})
+
+		Result(SimpleResponse)
+
+		HTTP(func() {
+			PUT("/assignments/{id}")
+			Cookie("session_token:session")
+			Response(StatusOK)
+			Response("not_found", StatusNotFound)
+			Response("invalid_input", StatusBadRequest)
+			Response("unauthorized", StatusUnauthorized)
+			Response("service_unavailable", StatusServiceUnavailable)
+			Response("permission_denied", StatusForbidden)
+		})
+	})
+
+	Method("DeleteAssignment", func() {
+		Description("Delete an assignment, keeping its exercises (professors only)")
+
+		Payload(func() {
+			Field(1, "id", Int64, "Assignment ID", func() {
+				Example(1)
+			})
+			Field(2, "session_token", String, "Authentication session token")
+
+			Required("session_token", "id")
+		})
+
+		Result(SimpleResponse)
+
+		HTTP(func() {
+			DELETE("/assignments/{id}")
+			Cookie("session_token:session")
+			Response(StatusOK)
+			Response("not_found", StatusNotFound)
+			Response("unauthorized", StatusUnauthorized)
+			Response("service_unavailable", StatusServiceUnavailable)
+			Response("permission_denied", StatusForbidden)
+			Response("invalid_input", StatusBadRequest)
+		})
+	})
+
+	Method("GetAssignmentGradebook", func() {
+		Description("Get the grades of every student on an assignment (professors only)")
+
+		Payload(func() {
+			Field(1, "id", Int64, "Assignment ID", func() {
+				Example(1)
+			})
+			Field(2, "session_token", String, "Authentication session token")
+
+			Required("session_token", "id")
+		})
+
+		Result(AssignmentGradebook)
+
+		HTTP(func() {
+			GET("/assignments/{id}/gradebook")
+			Cookie("session_token:session")
+			Response(StatusOK)
+			Response("not_found", StatusNotFound)
+			Response("unauthorized", StatusUnauthorized)
+			Response("service_unavailable", StatusServiceUnavailable)
+			Response("permission_denied", StatusForbidden)
+			Response("invalid_input", StatusBadRequest)
+		})
+	})
+
+	// ========================================
+	// ANALYTICS ENDPOINTS (for professors)
+	// ========================================
+
+	Method("GetExerciseStats", func() {
+		Description("Get completion and attempt statistics of an exercise (professors only)")
+
+		Payload(func() {
+			Field(1, "exercise_id", Int64, "Exercise ID", func() {
+				Example(1)
+			})
+			Field(2, "session_token", String, "Authentication session token")
+
+			Required("session_token", "exercise_id")
+		})
+
+		Result(ExerciseStats)
+
+		HTTP(func() {
+			GET("/exercises/{exercise_id}/stats")
+			Cookie("session_token:session")
+			Response(StatusOK)
+			Response("not_found", StatusNotFound)
+			Response("unauthorized", StatusUnauthorized)
+			Response("service_unavailable", StatusServiceUnavailable)
+			Response("permission_denied", StatusForbidden)
+			Response("invalid_input", StatusBadRequest)
+		})
+	})
+
+	Method("GetStudentExerciseStats", func() {
+		Description("Get the progress and attempts of a student on an exercise (professors only)")
+
+		Payload(func() {
+			Field(1, "exercise_id", Int64, "Exercise ID", func() {
+				Example(1)
+			})
+			Field(2, "user_id", Int64, "Student user ID", func() {
+				Example(123)
+			})
+			Field(3, "session_token", String, "Authentication session token")
+
+			Required("session_token", "exercise_id", "user_id")
+		})
+
+		Result(StudentExerciseStats)
+
+		HTTP(func() {
+			GET("/exercises/{exercise_id}/stats/students/{user_id}")
+			Cookie("session_token:session")
+			Response(StatusOK)
+			Response("not_found", StatusNotFound)
+			Response("unauthorized", StatusUnauthorized)
+			Response("service_unavailable", StatusServiceUnavailable)
+			Response("permission_denied", StatusForbidden)
+			Response("invalid_input", StatusBadRequest)
+		})
+	})
+
+	Method("GetPlagiarismReport", func() {
+		Description("Get the pairs of students with suspiciously similar successful attempts on an exercise (professors only)")
+
+		Payload(func() {
+			Field(1, "exercise_id", Int64, "Exercise ID", func() {
+				Example(1)
+			})
+			Field(2, "min_similarity", Float64, "Only return pairs at least this similar (0-100)", func() {
+				Default(0)
+				Minimum(0)
+				Maximum(100)
+				Example(80.0)
+			})
+			Field(3, "session_token", String, "Authentication session token")
+
+			Required("session_token", "exercise_id")
+		})
+
+		Result(PlagiarismReport)
+
+		HTTP(func() {
+			GET("/exercises/{exercise_id}/plagiarism")
+			Param("min_similarity")
+			Cookie("session_token:session")
+			Response(StatusOK)
+			Response("not_found", StatusNotFound)
+			Response("unauthorized", StatusUnauthorized)
+			Response("service_unavailable", StatusServiceUnavailable)
+			Response("permission_denied", StatusForbidden)
+			Response("invalid_input", StatusBadRequest)
+		})
+	})
+
+	// ========================================
+	// STUDENT ENDPOINTS (read exercises, submit attempts)
+	// ========================================
+
+	Method("GetExerciseForStudent", func() {
+		Description("Get exercise by ID without solution (students)")
+
+		Payload(func() {
+			Field(1, "id", Int64, "Exercise ID", func() {
+				Example(1)
+			})
+			Field(2, "session_token", String, "Authentication session token")
+
+			Required("session_token", "id")
+		})
+
+		Result(ExerciseForStudents)
+
+		HTTP(func() {
+			GET("/student/exercises/{id}")
+			Cookie("session_token:session")
+			Response(StatusOK)
+			Response("not_found", StatusNotFound)
+			Response("unauthorized", StatusUnauthorized)
+			Response("service_unavailable", StatusServiceUnavailable)
+			Response("permission_denied", StatusForbidden)
+			Response("invalid_input", StatusBadRequest)
+		})
+	})
+
+	Method("ListExercisesForStudents", func() {
+		Description("Search exercises without solutions, newest first (students)")
+
+		Payload(func() {
+			Field(1, "session_token", String, "Authentication session token")
+			Field(2, "search", String, "Words to look for in the title and description", func() {
+				Example("recursion")
+				MaxLength(200)
+			})
+			Field(3, "difficulty", String, "Only exercises of this difficulty", func() {
+				Enum("easy", "medium", "hard")
+			})
+			Field(4, "tags", ArrayOf(String), "Only exercises tagged with all these topics", func() {
+				Example([]string{"recursion"})
+			})
+			Field(5, "created_by", Int64, "Only exercises created by this user", func() {
+				Example(123)
+			})
+			Field(6, "cursor", String, "Cursor of the page to return, from a previous page")
+			Field(7, "limit", Int, "Maximum number of exercises to return", func() {
+				Minimum(1)
+				Maximum(100)
+				Default(50)
+			})
+			Field(8, "completed", Boolean, "Only exercises the student has, or has not, completed", func() {
+				Example(false)
+			})
+			Required("session_token")
+		})
+
+		Result(ExerciseForStudentsPage)
+
+		HTTP(func() {
+			GET("/student/exercises")
+			Cookie("session_token:session")
+			Param("search")
+			Param("difficulty")
+			Param("tags:tag")
+			Param("created_by:author")
+			Param("cursor")
+			Param("limit")
+			Param("completed")
+			Response(StatusOK, func() {
+				Header("next_cursor:X-Next-Cursor")
+				Body("exercises")
+			})
+			Response("unauthorized", StatusUnauthorized)
+			Response("not_found", StatusNotFound)
+			Response("service_unavailable", StatusServiceUnavailable)
+			Response("permission_denied", StatusForbidden)
+			Response("invalid_input", StatusBadRequest)
+		})
+	})
+
+	Method("CreateAttempt", func() {
+		Description("Submit a code attempt for an exercise (students)")
+
+		Payload(CreateAttemptPayload)
+
+		Result(SimpleResponse)
+
+		HTTP(func() {
+			POST("/attempts")
+			Cookie("session_token:session")
+			Response(StatusCreated)
+			Response("invalid_input", StatusBadRequest)
+			Response("unauthorized", StatusUnauthorized)
+			Response("not_found", StatusNotFound)
+			Response("service_unavailable", StatusServiceUnavailable)
+			Response("permission_denied", StatusForbidden)
+			Response("rate_limited", StatusTooManyRequests, func() {
+				Header("retry_after:Retry-After")
+			})
+		})
+	})
+
+	Method("SubmitAttempt", func() {
+		Description("Queue a code attempt to be graded in the background and return its ID right away (students)")
+
+		Payload(CreateAttemptPayload)
+
+		Result(AttemptSubmission)
+
+		HTTP(func() {
+			POST("/submissions")
+			Cookie("session_token:session")
+			Response(StatusAccepted)
+			Response("invalid_input", StatusBadRequest)
+			Response("unauthorized", StatusUnauthorized)
+			Response("not_found", StatusNotFound)
+			Response("service_unavailable", StatusServiceUnavailable)
+			Response("permission_denied", StatusForbidden)
+			Response("rate_limited", StatusTooManyRequests, func() {
+				Header("retry_after:Retry-After")
+			})
+		})
+	})
+
+	Method("StreamAttemptEvents", func() {
+		Description("Follow the grading of a submitted attempt test by test over a WebSocket (students)")
+
+		Payload(func() {
+			Field(1, "id", Int64, "Attempt ID", func() {
+				Example(1)
+			})
+			Field(2, "session_token", String, "Authentication session token")
+
+			Required("session_token", "id")
+		})
+
+		StreamingResult(AttemptEvent)
+
+		HTTP(func() {
+			GET("/submissions/{id}/events")
+			Cookie("session_token:session")
+			Response(StatusOK)
+			Response("not_found", StatusNotFound)
+			Response("unauthorized", StatusUnauthorized)
+			Response("service_unavailable", StatusServiceUnavailable)
+			Response("permission_denied", StatusForbidden)
+			Response("invalid_input", StatusBadRequest)
+		})
+	})
+
+	Method("RunCode", func() {
+		Description("Run code against the public tests or custom inputs without submitting it (students)")
+
+		Payload(RunCodePayload)
+
+		Result(RunCodeResult)
+
+		HTTP(func() {
+			POST("/student/exercises/{exercise_id}/run")
+			Cookie("session_token:session")
+			Response(StatusOK)
+			Response("invalid_input", StatusBadRequest)
+			Response("unauthorized", StatusUnauthorized)
+			Response("not_found", StatusNotFound)
+			Response("service_unavailable", StatusServiceUnavailable)
+			Response("permission_denied", StatusForbidden)
+			Response("rate_limited", StatusTooManyRequests, func() {
+				Header("retry_after:Retry-After")
+			})
+		})
+	})
+
+	Method("GetAttemptsByUserAndExercise", func() {
+		Description("Get user's attempts for a specific exercise (students)")
+
+		Payload(func() {
+			Field(1, "user_id", Int64, "User ID", func() {
+				Example(123)
+			})
+			Field(2, "exercise_id", Int64, "Exercise ID", func() {
+				Example(1)
+			})
+			Field(3, "session_token", String, "Authentication session token")
+
+			Required("user_id", "exercise_id", "session_token")
+		})
+
+		Result(ArrayOf(Attempt))
+
+		HTTP(func() {
+			GET("/student/users/{user_id}/exercises/{exercise_id}/attempts")
+			Cookie("session_token:session")
+			Response(StatusOK)
+			Response("not_found", StatusNotFound)
+			Response("unauthorized", StatusUnauthorized)
+			Response("service_unavailable", StatusServiceUnavailable)
+			Response("permission_denied", StatusForbidden)
+			Response("invalid_input", StatusBadRequest)
+		})
+	})
+
+	Method("GetHintsForStudent", func() {
+		Description("Get the hints of an exercise, showing the content of the revealed ones and when the others unlock (students)")
+
+		Payload(func() {
+			Field(1, "exercise_id", Int64, "Exercise ID", func() {
+				Example(1)
+			})
+			Field(2, "session_token", String, "Authentication session token")
+
+			Required("session_token", "exercise_id")
+		})
+
+		Result(ArrayOf(StudentHint))
+
+		HTTP(func() {
+			GET("/student/exercises/{exercise_id}/hints")
+			Cookie("session_token:session")
+			Response(StatusOK)
+			Response("not_found", StatusNotFound)
+			Response("unauthorized", StatusUnauthorized)
+			Response("service_unavailable", StatusServiceUnavailable)
+			Response("permission_denied", StatusForbidden)
+			Response("invalid_input", StatusBadRequest)
+		})
+	})
+
+	Method("RequestHint", func() {
+		Description("Reveal the next hint of an exercise once it is unlocked (students)")
+
+		Payload(func() {
+			Field(1, "exercise_id", Int64, "Exercise ID", func() {
+				Example(1)
+			})
+			Field(2, "session_token", String, "Authentication session token")
+
+			Required("session_token", "exercise_id")
+		})
+
+		Result(StudentHint)
+
+		HTTP(func() {
+			POST("/student/exercises/{exercise_id}/hints")
+			Cookie("session_token:session")
+			Response(StatusCreated)
+			Response("not_found", StatusNotFound)
+			Response("invalid_input", StatusBadRequest)
+			Response("unauthorized", StatusUnauthorized)
+			Response("service_unavailable", StatusServiceUnavailable)
+			Response("permission_denied", StatusForbidden)
+		})
+	})
+
+	Method("ListAssignmentsForStudents", func() {
+		Description("List all assignments with their exercises, soonest deadline first (students)")
+
+		Payload(func() {
+			Field(1, "session_token", String, "Authentication session token")
+			Required("session_token")
+		})
+
+		Result(ArrayOf(Assignment))
+
+		HTTP(func() {
+			GET("/student/assignments")
+			Cookie("session_token:session")
+			Response(StatusOK)
+			Response("unauthorized", StatusUnauthorized)
+			Response("service_unavailable", StatusServiceUnavailable)
+			Response("permission_denied", StatusForbidden)
+			Response("invalid_input", StatusBadRequest)
+		})
+	})
+
+	Method("GetAssignmentProgress", func() {
+		Description("Get the progress of the student on every exercise of an assignment (students)")
+
+		Payload(func() {
+			Field(1, "id", Int64, "Assignment ID", func() {
+				Example(1)
+			})
+			Field(2, "session_token", String, "Authentication session token")
+
+			Required("session_token", "id")
+		})
+
+		Result(AssignmentProgress)
+
+		HTTP(func() {
+			GET("/student/assignments/{id}/progress")
 			Cookie("session_token:session")
 			Response(StatusOK)
 			Response("not_found", StatusNotFound)
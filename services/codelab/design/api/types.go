@@ -47,7 +47,18 @@ var Exercise = Type("Exercise", func() {
 		Example(1672531200000)
 	})
 
-	Required("id", "title", "description", "initial_code", "solution", "difficulty", "created_by", "created_at", "updated_at")
+	Field(10, "language", String, "Programming language the exercise is solved in", func() {
+		Example("javascript")
+		Enum("javascript", "starlark")
+	})
+	Field(11, "tags", ArrayOf(String), "Topics the exercise covers", func() {
+		Example([]string{"recursion", "strings"})
+	})
+	Field(12, "version", Int32, "Latest version, bumped by every change to the exercise or its tests", func() {
+		Example(3)
+	})
+
+	Required("id", "title", "description", "initial_code", "solution", "difficulty", "language", "tags", "version", "created_by", "created_at", "updated_at")
 })
 
 // ExerciseForStudents represents an exercise without the solution (for students)
@@ -90,7 +101,18 @@ var ExerciseForStudents = Type("ExerciseForStudents", func() {
 		Example(1672531200000)
 	})
 
-	Required("id", "title", "description", "initial_code", "difficulty", "tests", "attempts", "answer", "created_by", "created_at", "updated_at")
+	Field(12, "language", String, "Programming language the exercise is solved in", func() {
+		Example("javascript")
+		Enum("javascript", "starlark")
+	})
+	Field(13, "tags", ArrayOf(String), "Topics the exercise covers", func() {
+		Example([]string{"recursion", "strings"})
+	})
+	Field(14, "version", Int32, "Version new attempts are graded against", func() {
+		Example(3)
+	})
+
+	Required("id", "title", "description", "initial_code", "difficulty", "language", "tags", "version", "tests", "attempts", "answer", "created_by", "created_at", "updated_at")
 })
 
 var ExerciseForStudentsListView = Type("ExerciseForStudentsListView", func() {
@@ -123,7 +145,15 @@ var ExerciseForStudentsListView = Type("ExerciseForStudentsListView", func() {
 		Example(1672531200000)
 	})
 
-	Required("id", "title", "description", "difficulty", "created_by", "created_at", "updated_at")
+	Field(9, "language", String, "Programming language the exercise is solved in", func() {
+		Example("javascript")
+		Enum("javascript", "starlark")
+	})
+	Field(10, "tags", ArrayOf(String), "Topics the exercise covers", func() {
+		Example([]string{"recursion", "strings"})
+	})
+
+	Required("id", "title", "description", "difficulty", "language", "tags", "completed", "created_by", "created_at", "updated_at")
 })
 
 // Test represents a test case for an exercise
@@ -151,8 +181,92 @@ var Test = Type("Test", func() {
 	Field(7, "updated_at", Int64, "Last update timestamp", func() {
 		Example(1672531200000)
 	})
+	Field(8, "input_format", String, "How the input is passed to solution: a raw string or a JSON array of arguments", func() {
+		Example("text")
+		Enum("text", "json")
+	})
+	Field(9, "comparison", String, "How the output is compared to the expected output", func() {
+		Example("exact")
+		Enum("exact", "trimmed", "numeric", "json", "unordered", "regex")
+	})
+	Field(10, "tolerance", Float64, "Allowed absolute difference for numeric comparison", func() {
+		Example(0.001)
+		Minimum(0)
+	})
+	Field(11, "weight", Int32, "Points awarded for passing the test", func() {
+		Example(1)
+		Minimum(1)
+	})
+
+	Required("id", "input", "output", "public", "input_format", "comparison", "tolerance", "weight", "exercise_id", "created_at", "updated_at")
+})
+
+// Hint is a tip teachers attach to an exercise, revealed to students in order
+var Hint = Type("Hint", func() {
+	Description("A hint of an exercise with the rules that unlock it")
+
+	Field(1, "id", Int64, "Hint ID", func() {
+		Example(1)
+	})
+	Field(2, "exercise_id", Int64, "Associated exercise ID", func() {
+		Example(1)
+	})
+	Field(3, "position", Int32, "Order in which the hint is revealed, starting at 1", func() {
+		Example(1)
+	})
+	Field(4, "content", String, "Hint text", func() {
+		Example("Split the input on the comma")
+	})
+	Field(5, "unlock_after_attempts", Int32, "Failed attempts after which the hint unlocks", func() {
+		Example(2)
+	})
+	Field(6, "unlock_after_seconds", Int32, "Seconds since the student started the exercise after which the hint unlocks", func() {
+		Example(300)
+	})
+	Field(7, "penalty", Float64, "Points taken off the score of the attempts made once the hint is revealed", func() {
+		Example(10.0)
+	})
+	Field(8, "created_at", Int64, "Creation timestamp", func() {
+		Example(1672531200000)
+	})
+	Field(9, "updated_at", Int64, "Last update timestamp", func() {
+		Example(1672531200000)
+	})
+
+	Required("id", "exercise_id", "position", "content", "penalty", "created_at", "updated_at")
+})
+
+// StudentHint is a hint as seen by a student, its content hidden until revealed
+var StudentHint = Type("StudentHint", func() {
+	Description("A hint of an exercise as seen by a student")
+
+	Field(1, "id", Int64, "Hint ID", func() {
+		Example(1)
+	})
+	Field(2, "position", Int32, "Order in which the hint is revealed, starting at 1", func() {
+		Example(1)
+	})
+	Field(3, "status", String, "Whether the hint was revealed, can be requested next or is still locked", func() {
+		Example("available")
+		Enum("revealed", "available", "locked")
+	})
+	Field(4, "content", String, "Hint text, only sent once revealed", func() {
+		Example("Split the input on the comma")
+	})
+	Field(5, "penalty", Float64, "Points taken off the score of the attempts made once the hint is revealed", func() {
+		Example(10.0)
+	})
+	Field(6, "attempts_remaining", Int32, "Failed attempts still needed to unlock the hint", func() {
+		Example(1)
+	})
+	Field(7, "unlocks_at", Int64, "Timestamp at which the hint unlocks by time", func() {
+		Example(1672531500000)
+	})
+	Field(8, "revealed_at", Int64, "Timestamp at which the hint was revealed", func() {
+		Example(1672531600000)
+	})
 
-	Required("id", "input", "output", "public", "exercise_id", "created_at", "updated_at")
+	Required("id", "position", "status", "penalty")
 })
 
 // Answer represents a student's answer/participation in an exercise
@@ -177,8 +291,22 @@ var Answer = Type("Answer", func() {
 	Field(6, "updated_at", Int64, "Last update timestamp", func() {
 		Example(1672531200000)
 	})
+	Field(7, "best_score", Float64, "Best score reached by an attempt, as a percentage of the test weight", func() {
+		Example(75.0)
+		Minimum(0)
+		Maximum(100)
+	})
+	Field(8, "best_attempt_id", Int64, "Attempt that reached the best score, missing until an attempt is made", func() {
+		Example(1)
+	})
+	Field(9, "hints_used", Int32, "Hints revealed to the student", func() {
+		Example(1)
+	})
+	Field(10, "hint_penalty", Float64, "Points taken off the score of every attempt for the hints revealed", func() {
+		Example(10.0)
+	})
 
-	Required("id", "exercise_id", "user_id", "completed", "created_at", "updated_at")
+	Required("id", "exercise_id", "user_id", "completed", "best_score", "hints_used", "hint_penalty", "created_at", "updated_at")
 })
 
 // Attempt represents a code submission attempt
@@ -200,8 +328,65 @@ var Attempt = Type("Attempt", func() {
 	Field(5, "created_at", Int64, "Creation timestamp", func() {
 		Example(1672531200000)
 	})
+	Field(6, "status", String, "Execution outcome of the attempt, pending while it is graded in the background", func() {
+		Example("passed")
+		Enum("pending", "passed", "failed", "error", "timeout", "resource_exceeded")
+	})
+	Field(7, "test_results", ArrayOf(AttemptTestResult), "Per test outcome of the attempt", func() {
+		Description("Result of running the attempt against each test of the exercise")
+	})
+	Field(8, "points", Int32, "Weight of the tests the attempt passed", func() {
+		Example(3)
+	})
+	Field(9, "max_points", Int32, "Weight of all the tests the attempt ran against", func() {
+		Example(4)
+	})
+	Field(10, "score", Float64, "Percentage of max_points earned", func() {
+		Example(75.0)
+		Minimum(0)
+		Maximum(100)
+	})
+	Field(11, "late", Boolean, "Whether the attempt was made after the assignments of the exercise closed", func() {
+		Example(false)
+	})
+	Field(12, "exercise_version", Int32, "Version of the exercise the attempt was graded against", func() {
+		Example(2)
+	})
+
+	Required("id", "answer_id", "code", "success", "created_at", "status", "points", "max_points", "score", "late", "exercise_version")
+})
+
+// AttemptTestResult represents the outcome of an attempt on a single test
+var AttemptTestResult = Type("AttemptTestResult", func() {
+	Description("The outcome of running an attempt against a single test")
+
+	Field(1, "test_id", Int64, "Test ID, missing if the test was deleted", func() {
+		Example(1)
+	})
+	Field(2, "status", String, "Outcome of the test", func() {
+		Example("failed")
+		Enum("passed", "failed", "error", "timeout", "resource_exceeded")
+	})
+	Field(3, "actual_output", String, "Output produced by the submitted code", func() {
+		Example("15")
+	})
+	Field(4, "expected_output", String, "Expected output, only shown for public tests", func() {
+		Example("8")
+	})
+	Field(5, "error", String, "Error raised while running the test", func() {
+		Example("ReferenceError: x is not defined")
+	})
+	Field(6, "console_output", String, "Output written to the console while running the test, with warnings and errors prefixed by their level", func() {
+		Example("debug: 5 3\n[warn] input is empty\n")
+	})
+	Field(7, "duration_ms", Int64, "Time spent running the test in milliseconds", func() {
+		Example(3)
+	})
+	Field(8, "stack_trace", String, "Stack trace through the submitted code when it threw, with positions in the submitted code", func() {
+		Example("TypeError: Cannot read property 'length' of undefined\n    at solution (solution.js:2:16)")
+	})
 
-	Required("id", "answer_id", "code", "success", "created_at")
+	Required("status", "actual_output", "console_output", "duration_ms")
 })
 
 // CreateExercisePayload for creating a new exercise
@@ -229,6 +414,15 @@ var CreateExercisePayload = Type("CreateExercisePayload", func() {
 		Example(123)
 	})
 	Field(7, "session_token", String, "Authentication session token")
+	Field(8, "language", String, "Programming language the exercise is solved in", func() {
+		Example("javascript")
+		Enum("javascript", "starlark")
+		Default("javascript")
+	})
+	Field(9, "tags", ArrayOf(String), "Topics the exercise covers, stored in lowercase", func() {
+		Example([]string{"recursion", "strings"})
+		MaxLength(10)
+	})
 
 	Required("session_token", "title", "description", "initial_code", "solution", "difficulty", "created_by")
 })
@@ -254,6 +448,14 @@ var UpdateExercisePayload = Type("UpdateExercisePayload", func() {
 		Example("easy")
 		Enum("easy", "medium", "hard")
 	})
+	Field(6, "language", String, "Programming language the exercise is solved in, unchanged if omitted", func() {
+		Example("javascript")
+		Enum("javascript", "starlark")
+	})
+	Field(7, "tags", ArrayOf(String), "Topics the exercise covers, unchanged if omitted", func() {
+		Example([]string{"recursion", "strings"})
+		MaxLength(10)
+	})
 
 	Required("title", "description", "initial_code", "solution", "difficulty")
 })
@@ -275,6 +477,26 @@ var CreateTestPayload = Type("CreateTestPayload", func() {
 		Example(1)
 	})
 	Field(5, "session_token", String, "Authentication session token")
+	Field(6, "input_format", String, "How the input is passed to solution: a raw string or a JSON array of arguments", func() {
+		Example("text")
+		Enum("text", "json")
+		Default("text")
+	})
+	Field(7, "comparison", String, "How the output is compared to the expected output", func() {
+		Example("exact")
+		Enum("exact", "trimmed", "numeric", "json", "unordered", "regex")
+		Default("exact")
+	})
+	Field(8, "tolerance", Float64, "Allowed absolute difference for numeric comparison", func() {
+		Example(0.001)
+		Minimum(0)
+		Default(0)
+	})
+	Field(9, "weight", Int32, "Points awarded for passing the test", func() {
+		Example(1)
+		Minimum(1)
+		Default(1)
+	})
 
 	Required("session_token", "input", "output", "public", "exercise_id")
 })
@@ -292,10 +514,86 @@ var UpdateTestPayload = Type("UpdateTestPayload", func() {
 	Field(3, "public", Boolean, "Whether test is visible to students", func() {
 		Example(true)
 	})
+	Field(4, "input_format", String, "How the input is passed to solution: a raw string or a JSON array of arguments", func() {
+		Example("text")
+		Enum("text", "json")
+		Default("text")
+	})
+	Field(5, "comparison", String, "How the output is compared to the expected output", func() {
+		Example("exact")
+		Enum("exact", "trimmed", "numeric", "json", "unordered", "regex")
+		Default("exact")
+	})
+	Field(6, "tolerance", Float64, "Allowed absolute difference for numeric comparison", func() {
+		Example(0.001)
+		Minimum(0)
+		Default(0)
+	})
+	Field(7, "weight", Int32, "Points awarded for passing the test", func() {
+		Example(1)
+		Minimum(1)
+		Default(1)
+	})
 
 	Required("input", "output", "public")
 })
 
+// CreateHintPayload for adding a hint to an exercise
+var CreateHintPayload = Type("CreateHintPayload", func() {
+	Description("Payload for adding a hint after the last hint of an exercise")
+
+	Field(1, "exercise_id", Int64, "Associated exercise ID", func() {
+		Example(1)
+	})
+	Field(2, "content", String, "Hint text", func() {
+		Example("Split the input on the comma")
+		MinLength(1)
+	})
+	Field(3, "unlock_after_attempts", Int32, "Failed attempts after which the hint unlocks", func() {
+		Example(2)
+		Minimum(1)
+	})
+	Field(4, "unlock_after_seconds", Int32, "Seconds since the student started the exercise after which the hint unlocks", func() {
+		Example(300)
+		Minimum(1)
+	})
+	Field(5, "penalty", Float64, "Points taken off the score of the attempts made once the hint is revealed", func() {
+		Example(10.0)
+		Minimum(0)
+		Maximum(100)
+		Default(0)
+	})
+	Field(6, "session_token", String, "Authentication session token")
+
+	Required("session_token", "exercise_id", "content")
+})
+
+// UpdateHintPayload for updating a hint
+var UpdateHintPayload = Type("UpdateHintPayload", func() {
+	Description("Payload for updating a hint, a missing unlock rule is removed")
+
+	Field(1, "content", String, "Hint text", func() {
+		Example("Split the input on the comma")
+		MinLength(1)
+	})
+	Field(2, "unlock_after_attempts", Int32, "Failed attempts after which the hint unlocks", func() {
+		Example(2)
+		Minimum(1)
+	})
+	Field(3, "unlock_after_seconds", Int32, "Seconds since the student started the exercise after which the hint unlocks", func() {
+		Example(300)
+		Minimum(1)
+	})
+	Field(4, "penalty", Float64, "Points taken off the score of the attempts made once the hint is revealed", func() {
+		Example(10.0)
+		Minimum(0)
+		Maximum(100)
+		Default(0)
+	})
+
+	Required("content")
+})
+
 // CreateAttemptPayload for creating a new attempt
 var CreateAttemptPayload = Type("CreateAttemptPayload", func() {
 	Description("Payload for creating a new attempt")
@@ -313,3 +611,762 @@ var CreateAttemptPayload = Type("CreateAttemptPayload", func() {
 
 	Required("session_token", "exercise_id", "code", "success")
 })
+
+// RunCodePayload for running code without submitting an attempt
+var RunCodePayload = Type("RunCodePayload", func() {
+	Description("Payload for running code against public tests or custom inputs")
+
+	Field(1, "exercise_id", Int64, "Exercise ID", func() {
+		Example(1)
+	})
+	Field(2, "code", String, "Code to run", func() {
+		Example("function solution(input) { return input * 2; }")
+	})
+	Field(3, "inputs", ArrayOf(String), "Custom inputs to run instead of the public tests", func() {
+		Example([]string{"5", "3"})
+		MaxLength(20)
+	})
+	Field(4, "session_token", String, "Authentication session token")
+	Field(5, "input_format", String, "How custom inputs are passed to solution: a raw string or a JSON array of arguments", func() {
+		Example("text")
+		Enum("text", "json")
+		Default("text")
+	})
+
+	Required("session_token", "exercise_id", "code")
+})
+
+// RunCaseResult represents the outcome of running code on a single input
+var RunCaseResult = Type("RunCaseResult", func() {
+	Description("The outcome of running code on a single input")
+
+	Field(1, "test_id", Int64, "Public test ID, missing for custom inputs", func() {
+		Example(1)
+	})
+	Field(2, "input", String, "Input given to the code", func() {
+		Example("5")
+	})
+	Field(3, "status", String, "Outcome of the run, completed for custom inputs that ran without errors", func() {
+		Example("passed")
+		Enum("passed", "failed", "completed", "error", "timeout", "resource_exceeded")
+	})
+	Field(4, "output", String, "Output produced by the code", func() {
+		Example("10")
+	})
+	Field(5, "expected_output", String, "Expected output, missing for custom inputs", func() {
+		Example("10")
+	})
+	Field(6, "error", String, "Error raised while running the code", func() {
+		Example("ReferenceError: x is not defined")
+	})
+	Field(7, "console_output", String, "Output written to the console while running the code, with warnings and errors prefixed by their level", func() {
+		Example("")
+	})
+	Field(8, "duration_ms", Int64, "Time spent running the code in milliseconds", func() {
+		Example(3)
+	})
+	Field(9, "stack_trace", String, "Stack trace through the code when it threw, with positions in the code", func() {
+		Example("TypeError: Cannot read property 'length' of undefined\n    at solution (solution.js:2:16)")
+	})
+
+	Required("input", "status", "output", "console_output", "duration_ms")
+})
+
+// RunCodeResult is the outcome of a dry run
+var RunCodeResult = Type("RunCodeResult", func() {
+	Description("Outcome of running code without submitting an attempt")
+
+	Field(1, "status", String, "Overall outcome of the run", func() {
+		Example("passed")
+		Enum("passed", "failed", "completed", "error", "timeout", "resource_exceeded")
+	})
+	Field(2, "results", ArrayOf(RunCaseResult), "Outcome for each input")
+
+	Required("status", "results")
+})
+
+// FailingTest counts how often the attempts of an exercise failed a test
+var FailingTest = Type("FailingTest", func() {
+	Description("A test of the exercise and how many attempts failed it")
+
+	Field(1, "test_id", Int64, "Test ID", func() {
+		Example(1)
+	})
+	Field(2, "input", String, "Test input", func() {
+		Example("5, 3")
+	})
+	Field(3, "public", Boolean, "Whether test is visible to students", func() {
+		Example(false)
+	})
+	Field(4, "failures", Int64, "Number of attempts that did not pass the test", func() {
+		Example(12)
+	})
+
+	Required("test_id", "input", "public", "failures")
+})
+
+// StudentExerciseStatus summarizes the progress of a student on an exercise
+var StudentExerciseStatus = Type("StudentExerciseStatus", func() {
+	Description("Progress of a student on an exercise")
+
+	Field(1, "user_id", Int64, "Student user ID", func() {
+		Example(123)
+	})
+	Field(2, "status", String, "Whether the student has not attempted, is working on or has completed the exercise", func() {
+		Example("in_progress")
+		Enum("not_started", "in_progress", "completed")
+	})
+	Field(3, "attempts", Int64, "Number of attempts made", func() {
+		Example(4)
+	})
+	Field(4, "best_score", Float64, "Best score reached by an attempt", func() {
+		Example(75.0)
+	})
+	Field(5, "attempts_to_success", Int64, "Attempts made up to and including the first successful one", func() {
+		Example(3)
+	})
+	Field(6, "first_attempt_at", Int64, "Timestamp of the first attempt", func() {
+		Example(1672531200000)
+	})
+	Field(7, "last_attempt_at", Int64, "Timestamp of the latest attempt", func() {
+		Example(1672534800000)
+	})
+	Field(8, "completed_at", Int64, "Timestamp of the first successful attempt", func() {
+		Example(1672534800000)
+	})
+	Field(9, "time_to_completion_ms", Int64, "Time from the first attempt to the first successful one in milliseconds", func() {
+		Example(3600000)
+	})
+	Field(10, "hints_used", Int32, "Hints revealed to the student", func() {
+		Example(1)
+	})
+
+	Required("user_id", "status", "attempts", "best_score", "hints_used")
+})
+
+// ExerciseStats summarizes how a class is doing on an exercise
+var ExerciseStats = Type("ExerciseStats", func() {
+	Description("Completion and attempt statistics of an exercise")
+
+	Field(1, "exercise_id", Int64, "Exercise ID", func() {
+		Example(1)
+	})
+	Field(2, "total_students", Int64, "Students that started the exercise", func() {
+		Example(30)
+	})
+	Field(3, "completed_students", Int64, "Students that completed the exercise", func() {
+		Example(21)
+	})
+	Field(4, "completion_rate", Float64, "Percentage of the students that completed the exercise", func() {
+		Example(70.0)
+	})
+	Field(5, "total_attempts", Int64, "Attempts made by every student", func() {
+		Example(96)
+	})
+	Field(6, "successful_attempts", Int64, "Attempts that passed every test", func() {
+		Example(25)
+	})
+	Field(7, "median_attempts_to_success", Float64, "Median attempts students needed to pass, missing until a student passes", func() {
+		Example(3.0)
+	})
+	Field(8, "median_time_to_completion_ms", Int64, "Median time from the first attempt to the first successful one in milliseconds", func() {
+		Example(1800000)
+	})
+	Field(9, "most_failed_test", FailingTest, "Test failed by the most attempts, missing if no test was failed")
+	Field(10, "students", ArrayOf(StudentExerciseStatus), "Progress of every student that started the exercise")
+	Field(11, "hints_used", Int64, "Hints revealed to every student", func() {
+		Example(12)
+	})
+
+	Required("exercise_id", "total_students", "completed_students", "completion_rate", "total_attempts", "successful_attempts", "hints_used", "students")
+})
+
+// StudentExerciseStats details the progress of a single student on an exercise
+var StudentExerciseStats = Type("StudentExerciseStats", func() {
+	Description("Progress of a student on an exercise with every attempt made")
+
+	Field(1, "exercise_id", Int64, "Exercise ID", func() {
+		Example(1)
+	})
+	Field(2, "summary", StudentExerciseStatus, "Progress of the student")
+	Field(3, "attempts", ArrayOf(Attempt), "Attempts of the student with their test results, oldest first")
+
+	Required("exercise_id", "summary", "attempts")
+})
+
+// PlagiarismRegion locates code shared by two attempts
+var PlagiarismRegion = Type("PlagiarismRegion", func() {
+	Description("Lines of two attempts holding the same code once names and formatting are ignored")
+
+	Field(1, "start_line_a", Int, "First line in the first attempt", func() {
+		Example(2)
+	})
+	Field(2, "end_line_a", Int, "Last line in the first attempt", func() {
+		Example(6)
+	})
+	Field(3, "start_line_b", Int, "First line in the second attempt", func() {
+		Example(3)
+	})
+	Field(4, "end_line_b", Int, "Last line in the second attempt", func() {
+		Example(7)
+	})
+
+	Required("start_line_a", "end_line_a", "start_line_b", "end_line_b")
+})
+
+// PlagiarismPair is the most similar pair of successful attempts of two students
+var PlagiarismPair = Type("PlagiarismPair", func() {
+	Description("Suspiciously similar successful attempts of two students")
+
+	Field(1, "attempt_a_id", Int64, "Attempt of the first student", func() {
+		Example(10)
+	})
+	Field(2, "user_a_id", Int64, "First student user ID", func() {
+		Example(123)
+	})
+	Field(3, "attempt_b_id", Int64, "Attempt of the second student", func() {
+		Example(14)
+	})
+	Field(4, "user_b_id", Int64, "Second student user ID", func() {
+		Example(456)
+	})
+	Field(5, "similarity", Float64, "Percentage of the code fingerprints both attempts share", func() {
+		Example(87.5)
+	})
+	Field(6, "regions", ArrayOf(PlagiarismRegion), "Matching regions of both attempts")
+
+	Required("attempt_a_id", "user_a_id", "attempt_b_id", "user_b_id", "similarity", "regions")
+})
+
+// PlagiarismReport lists the suspicious pairs found by the last check of an exercise
+var PlagiarismReport = Type("PlagiarismReport", func() {
+	Description("Result of the last plagiarism check of an exercise")
+
+	Field(1, "exercise_id", Int64, "Exercise ID", func() {
+		Example(1)
+	})
+	Field(2, "checked_at", Int64, "Timestamp of the last check, missing if the exercise was not checked yet", func() {
+		Example(1672534800000)
+	})
+	Field(3, "pairs", ArrayOf(PlagiarismPair), "Suspicious pairs, most similar first")
+
+	Required("exercise_id", "pairs")
+})
+
+// ExerciseBundleFile is an exported bundle of exercises
+var ExerciseBundleFile = Type("ExerciseBundleFile", func() {
+	Description("Versioned bundle holding exercises with their tests and solutions")
+
+	Field(1, "filename", String, "Suggested file name", func() {
+		Example("exercises-20250101.json")
+	})
+	Field(2, "format", String, "Bundle format", func() {
+		Example("json")
+		Enum("json", "zip", "tar.gz")
+	})
+	Field(3, "content", Bytes, "Bundle content")
+
+	Required("filename", "format", "content")
+})
+
+// ImportedExercises lists the exercises created from a bundle
+var ImportedExercises = Type("ImportedExercises", func() {
+	Description("Exercises created by an import")
+
+	Field(1, "success", Boolean, "Operation success status")
+	Field(2, "message", String, "Response message")
+	Field(3, "exercise_ids", ArrayOf(Int64), "IDs of the created exercises, in bundle order", func() {
+		Example([]int64{7, 8})
+	})
+
+	Required("success", "message", "exercise_ids")
+})
+
+// SolutionTestFailure describes a test the reference solution does not pass
+var SolutionTestFailure = Type("SolutionTestFailure", func() {
+	Description("A test the exercise solution does not pass")
+
+	Field(1, "test_id", Int64, "Test ID, missing for a test that is not saved yet", func() {
+		Example(1)
+	})
+	Field(2, "input", String, "Test input", func() {
+		Example("5, 3")
+	})
+	Field(3, "expected_output", String, "Expected output", func() {
+		Example("8")
+	})
+	Field(4, "actual_output", String, "Output of the solution", func() {
+		Example("53")
+	})
+	Field(5, "status", String, "How the test finished", func() {
+		Example("failed")
+		Enum("failed", "error", "timeout", "resource_exceeded")
+	})
+	Field(6, "error_message", String, "Error raised by the solution", func() {
+		Example("TypeError: input.split is not a function")
+	})
+
+	Required("input", "expected_output", "actual_output", "status")
+})
+
+// SolutionFailure is returned when a change leaves an exercise whose own
+// solution does not pass its tests
+var SolutionFailure = Type("SolutionFailure", func() {
+	Description("The exercise solution does not pass its tests")
+
+	Field(1, "message", String, "Error message", func() {
+		Example("Solution does not pass its tests: Test failed: expected 8, got 53 (2 of 3 tests passed)")
+	})
+	Field(2, "failing_tests", ArrayOf(SolutionTestFailure), "Tests the solution does not pass")
+
+	Required("message", "failing_tests")
+})
+
+// AssignmentExercise is an exercise of an assignment
+var AssignmentExercise = Type("AssignmentExercise", func() {
+	Description("An exercise of an assignment")
+
+	Field(1, "exercise_id", Int64, "Exercise ID", func() {
+		Example(1)
+	})
+	Field(2, "position", Int32, "Order of the exercise in the assignment, starting at 1", func() {
+		Example(1)
+	})
+	Field(3, "title", String, "Exercise title", func() {
+		Example("Sum Two Numbers")
+	})
+	Field(4, "difficulty", String, "Exercise difficulty", func() {
+		Example("easy")
+		Enum("easy", "medium", "hard")
+	})
+	Field(5, "language", String, "Language the exercise is solved in", func() {
+		Example("javascript")
+		Enum("javascript", "starlark")
+	})
+
+	Required("exercise_id", "position", "title", "difficulty", "language")
+})
+
+// Assignment groups exercises in order and is open for a period of time
+var Assignment = Type("Assignment", func() {
+	Description("An ordered set of exercises with a deadline")
+
+	Field(1, "id", Int64, "Assignment ID", func() {
+		Example(1)
+	})
+	Field(2, "title", String, "Assignment title", func() {
+		Example("Arithmetic")
+	})
+	Field(3, "description", String, "Assignment description", func() {
+		Example("Solve the arithmetic exercises before Friday")
+	})
+	Field(4, "opens_at", Int64, "Timestamp from which attempts are accepted", func() {
+		Example(1672531200000)
+	})
+	Field(5, "closes_at", Int64, "Timestamp after which attempts are rejected or flagged as late", func() {
+		Example(1673136000000)
+	})
+	Field(6, "allow_late", Boolean, "Whether attempts made after closes_at are accepted as late", func() {
+		Example(false)
+	})
+	Field(7, "status", String, "Whether the assignment has not opened yet, is open or has closed", func() {
+		Example("open")
+		Enum("upcoming", "open", "closed")
+	})
+	Field(8, "exercises", ArrayOf(AssignmentExercise), "Exercises of the assignment in order")
+	Field(9, "created_by", Int64, "Creator user ID", func() {
+		Example(1)
+	})
+	Field(10, "created_at", Int64, "Creation timestamp", func() {
+		Example(1672531200000)
+	})
+	Field(11, "updated_at", Int64, "Last update timestamp", func() {
+		Example(1672531200000)
+	})
+
+	Required("id", "title", "description", "opens_at", "closes_at", "allow_late", "status", "exercises", "created_by", "created_at", "updated_at")
+})
+
+// CreateAssignmentPayload for creating a new assignment
+var CreateAssignmentPayload = Type("CreateAssignmentPayload", func() {
+	Description("Payload for creating a new assignment")
+
+	Field(1, "title", String, "Assignment title", func() {
+		Example("Arithmetic")
+		MinLength(1)
+		MaxLength(200)
+	})
+	Field(2, "description", String, "Assignment description", func() {
+		Example("Solve the arithmetic exercises before Friday")
+		Default("")
+	})
+	Field(3, "opens_at", Int64, "Timestamp from which attempts are accepted", func() {
+		Example(1672531200000)
+	})
+	Field(4, "closes_at", Int64, "Timestamp after which attempts are rejected or flagged as late", func() {
+		Example(1673136000000)
+	})
+	Field(5, "allow_late", Boolean, "Whether attempts made after closes_at are accepted as late", func() {
+		Example(false)
+		Default(false)
+	})
+	Field(6, "exercise_ids", ArrayOf(Int64), "Exercises of the assignment in order", func() {
+		Example([]int64{1, 2, 3})
+		MinLength(1)
+	})
+	Field(7, "session_token", String, "Authentication session token")
+
+	Required("session_token", "title", "opens_at", "closes_at", "exercise_ids")
+})
+
+// UpdateAssignmentPayload for updating an assignment
+var UpdateAssignmentPayload = Type("UpdateAssignmentPayload", func() {
+	Description("Payload for updating an assignment, replacing its exercises")
+
+	Field(1, "title", String, "Assignment title", func() {
+		Example("Arithmetic")
+		MinLength(1)
+		MaxLength(200)
+	})
+	Field(2, "description", String, "Assignment description", func() {
+		Example("Solve the arithmetic exercises before Friday")
+		Default("")
+	})
+	Field(3, "opens_at", Int64, "Timestamp from which attempts are accepted", func() {
+		Example(1672531200000)
+	})
+	Field(4, "closes_at", Int64, "Timestamp after which attempts are rejected or flagged as late", func() {
+		Example(1673136000000)
+	})
+	Field(5, "allow_late", Boolean, "Whether attempts made after closes_at are accepted as late", func() {
+		Example(false)
+		Default(false)
+	})
+	Field(6, "exercise_ids", ArrayOf(Int64), "Exercises of the assignment in order", func() {
+		Example([]int64{1, 2, 3})
+		MinLength(1)
+	})
+
+	Required("title", "opens_at", "closes_at", "exercise_ids")
+})
+
+// ExerciseGrade is how a student did on an exercise of an assignment
+var ExerciseGrade = Type("ExerciseGrade", func() {
+	Description("Progress of a student on an exercise of an assignment")
+
+	Field(1, "exercise_id", Int64, "Exercise ID", func() {
+		Example(1)
+	})
+	Field(2, "status", String, "Whether the student has not attempted, is working on, completed the exercise before the deadline or only after it", func() {
+		Example("completed")
+		Enum("not_started", "in_progress", "completed", "completed_late")
+	})
+	Field(3, "attempts", Int64, "Number of attempts made", func() {
+		Example(3)
+	})
+	Field(4, "late_attempts", Int64, "Attempts made after the assignment closed", func() {
+		Example(0)
+	})
+	Field(5, "best_score", Float64, "Best score reached before the assignment closed", func() {
+		Example(100.0)
+	})
+	Field(6, "best_score_with_late", Float64, "Best score reached counting late attempts", func() {
+		Example(100.0)
+	})
+
+	Required("exercise_id", "status", "attempts", "late_attempts", "best_score", "best_score_with_late")
+})
+
+// StudentAssignmentGrade is how a student did on every exercise of an assignment
+var StudentAssignmentGrade = Type("StudentAssignmentGrade", func() {
+	Description("Progress of a student on an assignment")
+
+	Field(1, "user_id", Int64, "Student user ID", func() {
+		Example(123)
+	})
+	Field(2, "score", Float64, "Average best score over every exercise before the assignment closed", func() {
+		Example(66.7)
+	})
+	Field(3, "score_with_late", Float64, "Average best score over every exercise counting late attempts", func() {
+		Example(83.3)
+	})
+	Field(4, "completed_exercises", Int64, "Exercises completed before the assignment closed", func() {
+		Example(2)
+	})
+	Field(5, "total_exercises", Int64, "Exercises of the assignment", func() {
+		Example(3)
+	})
+	Field(6, "exercises", ArrayOf(ExerciseGrade), "Progress on every exercise in assignment order")
+
+	Required("user_id", "score", "score_with_late", "completed_exercises", "total_exercises", "exercises")
+})
+
+// AssignmentGradebook lists the grades of every student on an assignment
+var AssignmentGradebook = Type("AssignmentGradebook", func() {
+	Description("Grades of every student that started an exercise of an assignment")
+
+	Field(1, "assignment", Assignment, "The assignment")
+	Field(2, "students", ArrayOf(StudentAssignmentGrade), "Grades of every student, by user ID")
+
+	Required("assignment", "students")
+})
+
+// AssignmentProgress is the progress of the requesting student on an assignment
+var AssignmentProgress = Type("AssignmentProgress", func() {
+	Description("Progress of a student on an assignment")
+
+	Field(1, "assignment", Assignment, "The assignment")
+	Field(2, "progress", StudentAssignmentGrade, "Progress of the student")
+
+	Required("assignment", "progress")
+})
+
+// AttemptSubmission is returned when an attempt is queued for grading
+var AttemptSubmission = Type("AttemptSubmission", func() {
+	Description("An attempt queued to be graded in the background")
+
+	Field(1, "attempt_id", Int64, "ID of the attempt, used to follow its grading", func() {
+		Example(1)
+	})
+	Field(2, "total_tests", Int64, "Number of tests the attempt runs against", func() {
+		Example(4)
+	})
+	Field(3, "late", Boolean, "Whether the attempt was made after the assignments of the exercise closed", func() {
+		Example(false)
+	})
+
+	Required("attempt_id", "total_tests", "late")
+})
+
+// AttemptEvent is a step in the grading of an attempt
+var AttemptEvent = Type("AttemptEvent", func() {
+	Description("A step in the grading of an attempt")
+
+	Field(1, "type", String, "What happened: queued, started running, finished a test or finished grading", func() {
+		Example("test")
+		Enum("queued", "running", "test", "finished")
+	})
+	Field(2, "attempt_id", Int64, "Attempt ID", func() {
+		Example(1)
+	})
+	Field(3, "total_tests", Int64, "Number of tests the attempt runs against", func() {
+		Example(4)
+	})
+	Field(4, "test_index", Int64, "Position of the finished test, starting at 0, for test events", func() {
+		Example(0)
+	})
+	Field(5, "test_result", AttemptTestResult, "Outcome of the finished test, for test events")
+	Field(6, "status", String, "Execution outcome of the attempt, for finished events", func() {
+		Example("passed")
+		Enum("passed", "failed", "error", "timeout", "resource_exceeded")
+	})
+	Field(7, "success", Boolean, "Whether the attempt passed every test, for finished events", func() {
+		Example(true)
+	})
+	Field(8, "points", Int32, "Weight of the tests the attempt passed, for finished events", func() {
+		Example(3)
+	})
+	Field(9, "max_points", Int32, "Weight of all the tests the attempt ran against, for finished events", func() {
+		Example(4)
+	})
+	Field(10, "score", Float64, "Percentage of max_points earned, for finished events", func() {
+		Example(75.0)
+	})
+	Field(11, "message", String, "Summary of the outcome, for finished events", func() {
+		Example("All tests passed successfully")
+	})
+
+	Required("type", "attempt_id", "total_tests")
+})
+
+// ExercisePage is a page of the exercises matching a search
+var ExercisePage = Type("ExercisePage", func() {
+	Description("A page of exercises, newest first")
+
+	Field(1, "exercises", ArrayOf(Exercise), "Exercises in the page")
+	Field(2, "next_cursor", String, "Cursor of the next page, missing on the last page", func() {
+		Example("MTY3MjUzMTIwMDAwMDAwMDox")
+	})
+
+	Required("exercises")
+})
+
+// ExerciseForStudentsPage is a page of the exercises matching a student search
+var ExerciseForStudentsPage = Type("ExerciseForStudentsPage", func() {
+	Description("A page of exercises available to students, newest first")
+
+	Field(1, "exercises", ArrayOf(ExerciseForStudentsListView), "Exercises in the page")
+	Field(2, "next_cursor", String, "Cursor of the next page, missing on the last page", func() {
+		Example("MTY3MjUzMTIwMDAwMDAwMDox")
+	})
+
+	Required("exercises")
+})
+
+// TagCount is a topic of the catalog and how many exercises cover it
+var TagCount = Type("TagCount", func() {
+	Description("A topic used to tag exercises")
+
+	Field(1, "tag", String, "Topic name", func() {
+		Example("recursion")
+	})
+	Field(2, "exercises", Int64, "Number of exercises tagged with the topic", func() {
+		Example(12)
+	})
+
+	Required("tag", "exercises")
+})
+
+// ExerciseVersion is a saved version of an exercise
+var ExerciseVersion = Type("ExerciseVersion", func() {
+	Description("An immutable version of an exercise and its tests")
+
+	Field(1, "version", Int32, "Version number", func() {
+		Example(2)
+	})
+	Field(2, "created_by", Int64, "ID of user whose change created the version", func() {
+		Example(123)
+	})
+	Field(3, "created_at", Int64, "Creation timestamp", func() {
+		Example(1672531200000)
+	})
+	Field(4, "attempts", Int64, "Number of attempts graded against the version", func() {
+		Example(42)
+	})
+
+	Required("version", "created_by", "created_at", "attempts")
+})
+
+// FieldChange is a field with different values in two versions
+var FieldChange = Type("FieldChange", func() {
+	Description("A field changed between two versions")
+
+	Field(1, "field", String, "Field name", func() {
+		Example("output")
+	})
+	Field(2, "from", String, "Value in the older version, empty for added tests", func() {
+		Example("5")
+	})
+	Field(3, "to", String, "Value in the newer version, empty for removed tests", func() {
+		Example("6")
+	})
+
+	Required("field", "from", "to")
+})
+
+// TestChange is a test that changed between two versions
+var TestChange = Type("TestChange", func() {
+	Description("A test added, removed or modified between two versions")
+
+	Field(1, "test_id", Int64, "Test ID", func() {
+		Example(1)
+	})
+	Field(2, "change", String, "How the test changed", func() {
+		Example("modified")
+		Enum("added", "removed", "modified")
+	})
+	Field(3, "fields", ArrayOf(FieldChange), "Fields that changed, every field for added and removed tests")
+
+	Required("test_id", "change", "fields")
+})
+
+// ExerciseVersionDiff is what changed between two versions of an exercise
+var ExerciseVersionDiff = Type("ExerciseVersionDiff", func() {
+	Description("Changes between two versions of an exercise")
+
+	Field(1, "exercise_id", Int64, "Exercise ID", func() {
+		Example(1)
+	})
+	Field(2, "from_version", Int32, "Older version", func() {
+		Example(1)
+	})
+	Field(3, "to_version", Int32, "Newer version", func() {
+		Example(2)
+	})
+	Field(4, "fields", ArrayOf(FieldChange), "Fields of the exercise that changed")
+	Field(5, "tests", ArrayOf(TestChange), "Tests that changed")
+
+	Required("exercise_id", "from_version", "to_version", "fields", "tests")
+})
+
+// RegradeStudentChange is a student whose outcome flipped in a regrade
+var RegradeStudentChange = Type("RegradeStudentChange", func() {
+	Description("A student that passes or fails an exercise after a regrade, unlike before it")
+
+	Field(1, "user_id", Int64, "Student user ID", func() {
+		Example(456)
+	})
+	Field(2, "previous_score", Float64, "Best score before the regrade", func() {
+		Example(50.0)
+	})
+	Field(3, "score", Float64, "Best score after the regrade", func() {
+		Example(100.0)
+	})
+
+	Required("user_id", "previous_score", "score")
+})
+
+// Regrade is a run of the attempts of an exercise against its current tests
+var Regrade = Type("Regrade", func() {
+	Description("A run of the stored attempts of an exercise against its current tests, and what it changed")
+
+	Field(1, "id", Int64, "Regrade ID", func() {
+		Example(1)
+	})
+	Field(2, "exercise_id", Int64, "Exercise ID", func() {
+		Example(1)
+	})
+	Field(3, "exercise_version", Int32, "Version of the exercise the attempts are regraded against", func() {
+		Example(3)
+	})
+	Field(4, "status", String, "Whether the regrade is still running", func() {
+		Example("running")
+		Enum("running", "finished", "failed")
+	})
+	Field(5, "total_attempts", Int32, "Number of attempts to regrade", func() {
+		Example(120)
+	})
+	Field(6, "regraded_attempts", Int32, "Number of attempts processed so far", func() {
+		Example(80)
+	})
+	Field(7, "errored_attempts", Int32, "Number of attempts that could not be run and kept their previous outcome", func() {
+		Example(0)
+	})
+	Field(8, "newly_passed_attempts", Int32, "Number of attempts that failed before and pass now", func() {
+		Example(12)
+	})
+	Field(9, "newly_failed_attempts", Int32, "Number of attempts that passed before and fail now", func() {
+		Example(1)
+	})
+	Field(10, "newly_passed", ArrayOf(RegradeStudentChange), "Students that did not pass the exercise before and pass it now, once finished")
+	Field(11, "newly_failed", ArrayOf(RegradeStudentChange), "Students that passed the exercise before and no longer pass it, once finished")
+	Field(12, "error", String, "Why the regrade failed", func() {
+		Example("Regrade was interrupted")
+	})
+	Field(13, "created_by", Int64, "ID of user who started the regrade", func() {
+		Example(123)
+	})
+	Field(14, "created_at", Int64, "Start timestamp", func() {
+		Example(1672531200000)
+	})
+	Field(15, "finished_at", Int64, "End timestamp, once no longer running", func() {
+		Example(1672531260000)
+	})
+
+	Required("id", "exercise_id", "exercise_version", "status", "total_attempts", "regraded_attempts", "errored_attempts", "newly_passed_attempts", "newly_failed_attempts", "newly_passed", "newly_failed", "created_by", "created_at")
+})
+
+// RateLimit is returned when a user runs code too often or too many
+// executions are already running
+var RateLimit = Type("RateLimit", func() {
+	Description("Too many executions, try again later")
+
+	Field(1, "message", String, "Why the request was limited", func() {
+		Example("Wait a few seconds between attempts")
+	})
+	Field(2, "retry_after", Int64, "Seconds to wait before trying again", func() {
+		Example(5)
+	})
+
+	Required("message", "retry_after")
+})
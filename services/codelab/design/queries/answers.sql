@@ -26,6 +26,40 @@ UPDATE answers SET
     updated_at = NOW()
 WHERE exercise_id = $1 AND user_id = $2;
 
+-- name: UpdateAnswerBestScore :exec
+UPDATE answers SET
+    best_score = $2,
+    best_attempt_id = $3,
+    updated_at = NOW()
+WHERE id = $1 AND (best_attempt_id IS NULL OR best_score < $2);
+
+-- name: RecordAnswerHintUsage :exec
+UPDATE answers SET
+    hints_used = hints_used + 1,
+    hint_penalty = LEAST(hint_penalty + $2, 100),
+    updated_at = NOW()
+WHERE id = $1;
+
+-- name: RecalculateAnswerScore :one
+UPDATE answers SET
+    best_attempt_id = (
+        SELECT a.id FROM attempts a
+        WHERE a.answer_id = answers.id AND a.status <> 'pending'
+        ORDER BY a.score DESC, a.id
+        LIMIT 1
+    ),
+    best_score = COALESCE((
+        SELECT MAX(a.score) FROM attempts a
+        WHERE a.answer_id = answers.id AND a.status <> 'pending'
+    ), 0),
+    completed = EXISTS (
+        SELECT 1 FROM attempts a
+        WHERE a.answer_id = answers.id AND a.success = true
+    ),
+    updated_at = NOW()
+WHERE id = $1
+RETURNING *;
+
 -- name: CountCompletedAnswersByExercise :one
 SELECT COUNT(*) FROM answers 
 WHERE exercise_id = $1 AND completed = true;
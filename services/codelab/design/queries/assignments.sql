@@ -0,0 +1,66 @@
+-- name: CreateAssignment :one
+INSERT INTO assignments (title, description, opens_at, closes_at, allow_late, created_by)
+VALUES ($1, $2, $3, $4, $5, $6)
+RETURNING id;
+
+-- name: GetAssignmentById :one
+SELECT * FROM assignments WHERE id = $1;
+
+-- name: ListAssignments :many
+SELECT * FROM assignments ORDER BY closes_at, id;
+
+-- name: UpdateAssignment :exec
+UPDATE assignments SET
+    title = $2,
+    description = $3,
+    opens_at = $4,
+    closes_at = $5,
+    allow_late = $6,
+    updated_at = NOW()
+WHERE id = $1;
+
+-- name: DeleteAssignment :exec
+DELETE FROM assignments WHERE id = $1;
+
+-- name: AddAssignmentExercise :exec
+INSERT INTO assignment_exercises (assignment_id, exercise_id, position)
+VALUES ($1, $2, $3);
+
+-- name: DeleteAssignmentExercises :exec
+DELETE FROM assignment_exercises WHERE assignment_id = $1;
+
+-- name: GetAssignmentExercises :many
+SELECT ae.position, e.id, e.title, e.difficulty, e.language FROM assignment_exercises ae
+JOIN exercises e ON e.id = ae.exercise_id
+WHERE ae.assignment_id = $1
+ORDER BY ae.position;
+
+-- name: ListAssignmentExercises :many
+SELECT ae.assignment_id, ae.position, e.id, e.title, e.difficulty, e.language FROM assignment_exercises ae
+JOIN exercises e ON e.id = ae.exercise_id
+ORDER BY ae.assignment_id, ae.position;
+
+-- name: GetAssignmentsByExercise :many
+SELECT a.* FROM assignments a
+JOIN assignment_exercises ae ON ae.assignment_id = a.id
+WHERE ae.exercise_id = $1
+ORDER BY a.closes_at;
+
+-- name: GetAssignmentGrades :many
+-- Attempts made before the assignment opened do not count towards it
+SELECT
+    ans.user_id,
+    ans.exercise_id,
+    COUNT(a.id) AS attempts,
+    COUNT(a.id) FILTER (WHERE a.created_at > asg.closes_at) AS late_attempts,
+    COALESCE(MAX(a.score) FILTER (WHERE a.created_at <= asg.closes_at), 0)::DOUBLE PRECISION AS best_score,
+    COALESCE(MAX(a.score), 0)::DOUBLE PRECISION AS best_score_with_late,
+    COALESCE(BOOL_OR(a.success) FILTER (WHERE a.created_at <= asg.closes_at), FALSE)::BOOLEAN AS completed,
+    COALESCE(BOOL_OR(a.success), FALSE)::BOOLEAN AS completed_with_late
+FROM assignments asg
+JOIN assignment_exercises ae ON ae.assignment_id = asg.id
+JOIN answers ans ON ans.exercise_id = ae.exercise_id
+LEFT JOIN attempts a ON a.answer_id = ans.id AND a.created_at >= asg.opens_at
+WHERE asg.id = sqlc.arg('assignment_id') AND (sqlc.narg('user_id')::BIGINT IS NULL OR ans.user_id = sqlc.narg('user_id'))
+GROUP BY ans.user_id, ans.exercise_id
+ORDER BY ans.user_id, ans.exercise_id;
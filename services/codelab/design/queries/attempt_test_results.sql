@@ -0,0 +1,26 @@
+-- name: CreateAttemptTestResult :exec
+INSERT INTO attempt_test_results (attempt_id, test_id, status, actual_output, expected_output, error_message, console_output, stack_trace, duration_ms)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9);
+
+-- name: DeleteTestResultsByAttempt :exec
+DELETE FROM attempt_test_results WHERE attempt_id = $1;
+
+-- name: GetTestFailureCountsByExercise :many
+SELECT t.id, t.input, t.public, COUNT(*) AS failures
+FROM attempt_test_results r
+JOIN tests t ON r.test_id = t.id
+WHERE t.exercise_id = $1 AND r.status <> 'passed'
+GROUP BY t.id
+ORDER BY failures DESC, t.id;
+
+-- name: GetTestResultsByAttempt :many
+SELECT * FROM attempt_test_results
+WHERE attempt_id = $1
+ORDER BY id;
+
+-- name: GetTestResultsByUserAndExercise :many
+SELECT r.* FROM attempt_test_results r
+JOIN attempts a ON r.attempt_id = a.id
+JOIN answers ans ON a.answer_id = ans.id
+WHERE ans.user_id = $1 AND ans.exercise_id = $2
+ORDER BY r.attempt_id, r.id;
@@ -1,10 +1,47 @@
--- name: CreateAttempt :exec
-INSERT INTO attempts (answer_id, code, success)
-VALUES ($1, $2, $3);
+-- name: CreateAttempt :one
+INSERT INTO attempts (answer_id, code, success, status, points, max_points, score, late, exercise_version, message)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+RETURNING *;
 
 -- name: GetAttempt :one
 SELECT * FROM attempts WHERE id = $1;
 
+-- name: GetAttemptByUser :one
+SELECT a.* FROM attempts a
+JOIN answers ans ON a.answer_id = ans.id
+WHERE a.id = $1 AND ans.user_id = $2;
+
+-- name: UpdateAttemptResult :exec
+UPDATE attempts SET
+    success = $2,
+    status = $3,
+    points = $4,
+    max_points = $5,
+    score = $6,
+    message = $7
+WHERE id = $1;
+
+-- name: RegradeAttempt :exec
+UPDATE attempts SET
+    success = $2,
+    status = $3,
+    points = $4,
+    max_points = $5,
+    score = $6,
+    exercise_version = $7,
+    message = $8
+WHERE id = $1;
+
+-- name: DeleteAttempt :exec
+DELETE FROM attempts WHERE id = $1;
+
+-- name: FailStalePendingAttempts :execrows
+-- Attempts pending for ten minutes were lost by a replica that stopped
+UPDATE attempts SET
+    status = 'error',
+    message = $1
+WHERE status = 'pending' AND created_at < NOW() - INTERVAL '10 minutes';
+
 -- name: GetAttemptsByAnswer :many
 SELECT * FROM attempts 
 WHERE answer_id = $1 
@@ -46,6 +83,13 @@ SELECT
     a.answer_id,
     a.code,
     a.success,
+    a.status,
+    a.points,
+    a.max_points,
+    a.score,
+    a.late,
+    a.exercise_version,
+    a.message,
     a.created_at,
     ans.user_id,
     ans.exercise_id,
@@ -61,6 +105,13 @@ SELECT
     a.answer_id,
     a.code,
     a.success,
+    a.status,
+    a.points,
+    a.max_points,
+    a.score,
+    a.late,
+    a.exercise_version,
+    a.message,
     a.created_at
 FROM attempts a
 JOIN answers ans ON a.answer_id = ans.id
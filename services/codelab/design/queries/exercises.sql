@@ -1,9 +1,10 @@
--- name: CreateExercise :exec
+-- name: CreateExercise :one
 INSERT INTO exercises (
-    title, description, initial_code, solution, difficulty, created_by
+    title, description, initial_code, solution, difficulty, language, tags, created_by
 ) VALUES (
-    $1, $2, $3, $4, $5, $6
-);
+    $1, $2, $3, $4, $5, $6, $7, $8
+)
+RETURNING id;
 
 -- name: GetExerciseById :one
 SELECT * FROM exercises WHERE id = $1;
@@ -15,26 +16,54 @@ SELECT
     description,
     initial_code,
     difficulty,
+    language,
+    tags,
+    version,
     created_by,
     created_at,
     updated_at
 FROM exercises WHERE id = $1;
 
 -- name: ListExercises :many
-SELECT * FROM exercises ORDER BY created_at DESC;
+SELECT * FROM exercises
+WHERE (sqlc.narg('search')::TEXT IS NULL OR to_tsvector('simple', title || ' ' || description) @@ websearch_to_tsquery('simple', sqlc.narg('search')))
+    AND (sqlc.narg('difficulty')::VARCHAR IS NULL OR difficulty = sqlc.narg('difficulty'))
+    AND tags @> COALESCE(sqlc.narg('tags')::TEXT[], '{}')
+    AND (sqlc.narg('created_by')::BIGINT IS NULL OR created_by = sqlc.narg('created_by'))
+    AND (sqlc.narg('after_created_at')::TIMESTAMPTZ IS NULL OR (created_at, id) < (sqlc.narg('after_created_at'), sqlc.narg('after_id')::BIGINT))
+ORDER BY created_at DESC, id DESC
+LIMIT sqlc.arg('row_limit');
 
 -- name: ListExercisesToResolve :many
 SELECT
-    id,
-    title,
-    description,
-    initial_code,
-    difficulty,
-    created_by,
-    created_at,
-    updated_at
-FROM exercises
-ORDER BY created_at DESC;
+    e.id,
+    e.title,
+    e.description,
+    e.initial_code,
+    e.difficulty,
+    e.language,
+    e.tags,
+    e.version,
+    e.created_by,
+    e.created_at,
+    e.updated_at,
+    COALESCE(ans.completed, FALSE)::BOOLEAN AS completed
+FROM exercises e
+LEFT JOIN answers ans ON ans.exercise_id = e.id AND ans.user_id = sqlc.arg('user_id')
+WHERE (sqlc.narg('search')::TEXT IS NULL OR to_tsvector('simple', e.title || ' ' || e.description) @@ websearch_to_tsquery('simple', sqlc.narg('search')))
+    AND (sqlc.narg('difficulty')::VARCHAR IS NULL OR e.difficulty = sqlc.narg('difficulty'))
+    AND e.tags @> COALESCE(sqlc.narg('tags')::TEXT[], '{}')
+    AND (sqlc.narg('created_by')::BIGINT IS NULL OR e.created_by = sqlc.narg('created_by'))
+    AND (sqlc.narg('completed')::BOOLEAN IS NULL OR COALESCE(ans.completed, FALSE) = sqlc.narg('completed'))
+    AND (sqlc.narg('after_created_at')::TIMESTAMPTZ IS NULL OR (e.created_at, e.id) < (sqlc.narg('after_created_at'), sqlc.narg('after_id')::BIGINT))
+ORDER BY e.created_at DESC, e.id DESC
+LIMIT sqlc.arg('row_limit');
+
+-- name: ListTags :many
+SELECT tag::TEXT AS tag, COUNT(*) AS exercises
+FROM exercises, unnest(tags) AS tag
+GROUP BY tag
+ORDER BY tag;
 
 -- name: UpdateExercise :exec
 UPDATE exercises SET
@@ -43,8 +72,38 @@ UPDATE exercises SET
     initial_code = $4,
     solution = $5,
     difficulty = $6,
+    language = $7,
+    tags = $8,
     updated_at = NOW()
 WHERE id = $1;
 
+-- name: BumpExerciseVersion :one
+UPDATE exercises SET
+    version = version + 1,
+    updated_at = NOW()
+WHERE id = $1
+RETURNING *;
+
+-- name: CreateExerciseVersion :exec
+INSERT INTO exercise_versions (exercise_id, version, snapshot, created_by)
+VALUES ($1, $2, $3, $4);
+
+-- name: GetExerciseVersion :one
+SELECT * FROM exercise_versions
+WHERE exercise_id = $1 AND version = $2;
+
+-- name: ListExerciseVersions :many
+SELECT
+    v.version,
+    v.created_by,
+    v.created_at,
+    COUNT(a.id) AS attempts
+FROM exercise_versions v
+LEFT JOIN answers ans ON ans.exercise_id = v.exercise_id
+LEFT JOIN attempts a ON a.answer_id = ans.id AND a.exercise_version = v.version
+WHERE v.exercise_id = $1
+GROUP BY v.version, v.created_by, v.created_at
+ORDER BY v.version DESC;
+
 -- name: DeleteExercise :exec
 DELETE FROM exercises WHERE id = $1;
\ No newline at end of file
@@ -0,0 +1,33 @@
+-- name: CreateHint :exec
+INSERT INTO hints (exercise_id, position, content, unlock_after_attempts, unlock_after_seconds, penalty)
+VALUES ($1, (SELECT COALESCE(MAX(position), 0) + 1 FROM hints WHERE exercise_id = $1), $2, $3, $4, $5);
+
+-- name: GetHintById :one
+SELECT * FROM hints WHERE id = $1;
+
+-- name: GetHintsByExercise :many
+SELECT * FROM hints
+WHERE exercise_id = $1
+ORDER BY position;
+
+-- name: UpdateHint :exec
+UPDATE hints SET
+    content = $2,
+    unlock_after_attempts = $3,
+    unlock_after_seconds = $4,
+    penalty = $5,
+    updated_at = NOW()
+WHERE id = $1;
+
+-- name: DeleteHint :exec
+DELETE FROM hints WHERE id = $1;
+
+-- name: CreateHintUsage :execrows
+INSERT INTO hint_usages (answer_id, hint_id)
+VALUES ($1, $2)
+ON CONFLICT (answer_id, hint_id) DO NOTHING;
+
+-- name: GetHintUsagesByAnswer :many
+SELECT * FROM hint_usages
+WHERE answer_id = $1
+ORDER BY revealed_at;
@@ -0,0 +1,39 @@
+-- name: ListExercisesPendingPlagiarismCheck :many
+SELECT e.id, e.initial_code FROM exercises e
+LEFT JOIN plagiarism_checks c ON c.exercise_id = e.id
+WHERE e.language = 'javascript' AND EXISTS (
+    SELECT 1 FROM attempts a
+    JOIN answers ans ON a.answer_id = ans.id
+    WHERE ans.exercise_id = e.id AND a.success = true
+    AND (c.checked_at IS NULL OR a.created_at > c.checked_at)
+)
+ORDER BY e.id;
+
+-- name: GetSuccessfulAttemptsByExercise :many
+SELECT a.id, a.code, ans.user_id FROM attempts a
+JOIN answers ans ON a.answer_id = ans.id
+WHERE ans.exercise_id = $1 AND a.success = true
+ORDER BY a.id;
+
+-- name: DeletePlagiarismMatchesByExercise :exec
+DELETE FROM plagiarism_matches WHERE exercise_id = $1;
+
+-- name: CreatePlagiarismMatch :exec
+INSERT INTO plagiarism_matches (exercise_id, attempt_a_id, user_a_id, attempt_b_id, user_b_id, similarity, regions)
+VALUES ($1, $2, $3, $4, $5, $6, $7);
+
+-- name: ListPlagiarismMatchesByExercise :many
+SELECT * FROM plagiarism_matches
+WHERE exercise_id = $1 AND similarity >= $2
+ORDER BY similarity DESC, id;
+
+-- name: UpsertPlagiarismCheck :exec
+INSERT INTO plagiarism_checks (exercise_id, checked_at)
+VALUES ($1, $2)
+ON CONFLICT (exercise_id) DO UPDATE SET checked_at = EXCLUDED.checked_at;
+
+-- name: GetPlagiarismCheck :one
+SELECT * FROM plagiarism_checks WHERE exercise_id = $1;
+
+-- name: DeletePlagiarismCheck :exec
+DELETE FROM plagiarism_checks WHERE exercise_id = $1;
@@ -0,0 +1,49 @@
+-- name: CreateRegrade :one
+INSERT INTO regrades (exercise_id, exercise_version, total_attempts, created_by)
+VALUES ($1, $2, $3, $4)
+RETURNING *;
+
+-- name: GetRegradeById :one
+SELECT * FROM regrades WHERE id = $1;
+
+-- name: GetRunningRegradeByExercise :one
+-- Regrades that made no progress for ten minutes were interrupted
+SELECT * FROM regrades
+WHERE exercise_id = $1 AND status = 'running' AND updated_at > NOW() - INTERVAL '10 minutes'
+ORDER BY id DESC
+LIMIT 1;
+
+-- name: FailInterruptedRegrades :exec
+-- Closes the regrades of an exercise that made no progress for ten minutes
+UPDATE regrades SET
+    status = 'failed',
+    error_message = $2,
+    updated_at = NOW(),
+    finished_at = NOW()
+WHERE exercise_id = $1 AND status = 'running' AND updated_at <= NOW() - INTERVAL '10 minutes';
+
+-- name: AddRegradeProgress :exec
+UPDATE regrades SET
+    regraded_attempts = regraded_attempts + 1,
+    errored_attempts = errored_attempts + sqlc.arg('errored')::INTEGER,
+    newly_passed_attempts = newly_passed_attempts + sqlc.arg('newly_passed')::INTEGER,
+    newly_failed_attempts = newly_failed_attempts + sqlc.arg('newly_failed')::INTEGER,
+    updated_at = NOW()
+WHERE id = sqlc.arg('id');
+
+-- name: FinishRegrade :exec
+UPDATE regrades SET
+    status = $2,
+    error_message = $3,
+    updated_at = NOW(),
+    finished_at = NOW()
+WHERE id = $1;
+
+-- name: CreateRegradeChange :exec
+INSERT INTO regrade_changes (regrade_id, user_id, completed, previous_score, score)
+VALUES ($1, $2, $3, $4, $5);
+
+-- name: ListRegradeChanges :many
+SELECT * FROM regrade_changes
+WHERE regrade_id = $1
+ORDER BY user_id;
@@ -1,6 +1,9 @@
 -- name: CreateTest :exec
-INSERT INTO tests (input, output, public, exercise_id)
-VALUES ($1, $2, $3, $4);
+INSERT INTO tests (input, output, public, weight, input_format, comparison, tolerance, exercise_id)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8);
+
+-- name: GetTestById :one
+SELECT * FROM tests WHERE id = $1;
 
 -- name: GetTestsByExercise :many
 SELECT * FROM tests 
@@ -22,6 +25,10 @@ UPDATE tests SET
     input = $2,
     output = $3,
     public = $4,
+    weight = $5,
+    input_format = $6,
+    comparison = $7,
+    tolerance = $8,
     updated_at = NOW()
 WHERE id = $1;
 
@@ -20,35 +20,91 @@ type Client struct {
 	CreateExerciseEndpoint               goa.Endpoint
 	GetExerciseEndpoint                  goa.Endpoint
 	ListExercisesEndpoint                goa.Endpoint
+	ListTagsEndpoint                     goa.Endpoint
 	UpdateExerciseEndpoint               goa.Endpoint
 	DeleteExerciseEndpoint               goa.Endpoint
+	ExportExercisesEndpoint              goa.Endpoint
+	ImportExercisesEndpoint              goa.Endpoint
 	CreateTestEndpoint                   goa.Endpoint
 	GetTestsByExerciseEndpoint           goa.Endpoint
 	UpdateTestEndpoint                   goa.Endpoint
 	DeleteTestEndpoint                   goa.Endpoint
+	ListExerciseVersionsEndpoint         goa.Endpoint
+	DiffExerciseVersionsEndpoint         goa.Endpoint
+	RollbackExerciseEndpoint             goa.Endpoint
+	RegradeExerciseEndpoint              goa.Endpoint
+	GetRegradeEndpoint                   goa.Endpoint
+	CreateHintEndpoint                   goa.Endpoint
+	GetHintsByExerciseEndpoint           goa.Endpoint
+	UpdateHintEndpoint                   goa.Endpoint
+	DeleteHintEndpoint                   goa.Endpoint
+	CreateAssignmentEndpoint             goa.Endpoint
+	GetAssignmentEndpoint                goa.Endpoint
+	ListAssignmentsEndpoint              goa.Endpoint
+	UpdateAssignmentEndpoint             goa.Endpoint
+	DeleteAssignmentEndpoint             goa.Endpoint
+	GetAssignmentGradebookEndpoint       goa.Endpoint
+	GetExerciseStatsEndpoint             goa.Endpoint
+	GetStudentExerciseStatsEndpoint      goa.Endpoint
+	GetPlagiarismReportEndpoint          goa.Endpoint
 	GetExerciseForStudentEndpoint        goa.Endpoint
 	ListExercisesForStudentsEndpoint     goa.Endpoint
 	CreateAttemptEndpoint                goa.Endpoint
+	SubmitAttemptEndpoint                goa.Endpoint
+	StreamAttemptEventsEndpoint          goa.Endpoint
+	RunCodeEndpoint                      goa.Endpoint
 	GetAttemptsByUserAndExerciseEndpoint goa.Endpoint
+	GetHintsForStudentEndpoint           goa.Endpoint
+	RequestHintEndpoint                  goa.Endpoint
+	ListAssignmentsForStudentsEndpoint   goa.Endpoint
+	GetAssignmentProgressEndpoint        goa.Endpoint
 	GetAnswerByUserAndExerciseEndpoint   goa.Endpoint
 }
 
 // NewClient initializes a "codelab" service client given the endpoints.
-func NewClient(createExercise, getExercise, listExercises, updateExercise, deleteExercise, createTest, getTestsByExercise, updateTest, deleteTest, getExerciseForStudent, listExercisesForStudents, createAttempt, getAttemptsByUserAndExercise, getAnswerByUserAndExercise goa.Endpoint) *Client {
+func NewClient(createExercise, getExercise, listExercises, listTags, updateExercise, deleteExercise, exportExercises, importExercises, createTest, getTestsByExercise, updateTest, deleteTest, listExerciseVersions, diffExerciseVersions, rollbackExercise, regradeExercise, getRegrade, createHint, getHintsByExercise, updateHint, deleteHint, createAssignment, getAssignment, listAssignments, updateAssignment, deleteAssignment, getAssignmentGradebook, getExerciseStats, getStudentExerciseStats, getPlagiarismReport, getExerciseForStudent, listExercisesForStudents, createAttempt, submitAttempt, streamAttemptEvents, runCode, getAttemptsByUserAndExercise, getHintsForStudent, requestHint, listAssignmentsForStudents, getAssignmentProgress, getAnswerByUserAndExercise goa.Endpoint) *Client {
 	return &Client{
 		CreateExerciseEndpoint:               createExercise,
 		GetExerciseEndpoint:                  getExercise,
 		ListExercisesEndpoint:                listExercises,
+		ListTagsEndpoint:                     listTags,
 		UpdateExerciseEndpoint:               updateExercise,
 		DeleteExerciseEndpoint:               deleteExercise,
+		ExportExercisesEndpoint:              exportExercises,
+		ImportExercisesEndpoint:              importExercises,
 		CreateTestEndpoint:                   createTest,
 		GetTestsByExerciseEndpoint:           getTestsByExercise,
 		UpdateTestEndpoint:                   updateTest,
 		DeleteTestEndpoint:                   deleteTest,
+		ListExerciseVersionsEndpoint:         listExerciseVersions,
+		DiffExerciseVersionsEndpoint:         diffExerciseVersions,
+		RollbackExerciseEndpoint:             rollbackExercise,
+		RegradeExerciseEndpoint:              regradeExercise,
+		GetRegradeEndpoint:                   getRegrade,
+		CreateHintEndpoint:                   createHint,
+		GetHintsByExerciseEndpoint:           getHintsByExercise,
+		UpdateHintEndpoint:                   updateHint,
+		DeleteHintEndpoint:                   deleteHint,
+		CreateAssignmentEndpoint:             createAssignment,
+		GetAssignmentEndpoint:                getAssignment,
+		ListAssignmentsEndpoint:              listAssignments,
+		UpdateAssignmentEndpoint:             updateAssignment,
+		DeleteAssignmentEndpoint:             deleteAssignment,
+		GetAssignmentGradebookEndpoint:       getAssignmentGradebook,
+		GetExerciseStatsEndpoint:             getExerciseStats,
+		GetStudentExerciseStatsEndpoint:      getStudentExerciseStats,
+		GetPlagiarismReportEndpoint:          getPlagiarismReport,
 		GetExerciseForStudentEndpoint:        getExerciseForStudent,
 		ListExercisesForStudentsEndpoint:     listExercisesForStudents,
 		CreateAttemptEndpoint:                createAttempt,
+		SubmitAttemptEndpoint:                submitAttempt,
+		StreamAttemptEventsEndpoint:          streamAttemptEvents,
+		RunCodeEndpoint:                      runCode,
 		GetAttemptsByUserAndExerciseEndpoint: getAttemptsByUserAndExercise,
+		GetHintsForStudentEndpoint:           getHintsForStudent,
+		RequestHintEndpoint:                  requestHint,
+		ListAssignmentsForStudentsEndpoint:   listAssignmentsForStudents,
+		GetAssignmentProgressEndpoint:        getAssignmentProgress,
 		GetAnswerByUserAndExerciseEndpoint:   getAnswerByUserAndExercise,
 	}
 }
@@ -56,12 +112,13 @@ func NewClient(createExercise, getExercise, listExercises, updateExercise, delet
 // CreateExercise calls the "CreateExercise" endpoint of the "codelab" service.
 // CreateExercise may return the following errors:
 //   - "invalid_input" (type InvalidInput)
-//   - "rate_limited" (type RateLimited)
+//   - "rate_limited" (type *RateLimit): Too many requests
 //   - "service_unavailable" (type ServiceUnavailable)
 //   - "unauthorized" (type Unauthorized)
 //   - "permission_denied" (type PermissionDenied)
 //   - "not_found" (type NotFound)
 //   - "internal_error" (type InternalError)
+//   - "solution_failed" (type *SolutionFailure): Exercise solution does not pass its tests
 //   - error: internal error
 func (c *Client) CreateExercise(ctx context.Context, p *CreateExercisePayload) (res *SimpleResponse, err error) {
 	var ires any
@@ -75,12 +132,13 @@ func (c *Client) CreateExercise(ctx context.Context, p *CreateExercisePayload) (
 // GetExercise calls the "GetExercise" endpoint of the "codelab" service.
 // GetExercise may return the following errors:
 //   - "invalid_input" (type InvalidInput)
-//   - "rate_limited" (type RateLimited)
+//   - "rate_limited" (type *RateLimit): Too many requests
 //   - "service_unavailable" (type ServiceUnavailable)
 //   - "unauthorized" (type Unauthorized)
 //   - "permission_denied" (type PermissionDenied)
 //   - "not_found" (type NotFound)
 //   - "internal_error" (type InternalError)
+//   - "solution_failed" (type *SolutionFailure): Exercise solution does not pass its tests
 //   - error: internal error
 func (c *Client) GetExercise(ctx context.Context, p *GetExercisePayload) (res *Exercise, err error) {
 	var ires any
@@ -94,31 +152,53 @@ func (c *Client) GetExercise(ctx context.Context, p *GetExercisePayload) (res *E
 // ListExercises calls the "ListExercises" endpoint of the "codelab" service.
 // ListExercises may return the following errors:
 //   - "invalid_input" (type InvalidInput)
-//   - "rate_limited" (type RateLimited)
+//   - "rate_limited" (type *RateLimit): Too many requests
 //   - "service_unavailable" (type ServiceUnavailable)
 //   - "unauthorized" (type Unauthorized)
 //   - "permission_denied" (type PermissionDenied)
 //   - "not_found" (type NotFound)
 //   - "internal_error" (type InternalError)
+//   - "solution_failed" (type *SolutionFailure): Exercise solution does not pass its tests
 //   - error: internal error
-func (c *Client) ListExercises(ctx context.Context, p *ListExercisesPayload) (res []*Exercise, err error) {
+func (c *Client) ListExercises(ctx context.Context, p *ListExercisesPayload) (res *ExercisePage, err error) {
 	var ires any
 	ires, err = c.ListExercisesEndpoint(ctx, p)
 	if err != nil {
 		return
 	}
-	return ires.([]*Exercise), nil
+	return ires.(*ExercisePage), nil
+}
+
+// ListTags calls the "ListTags" endpoint of the "codelab" service.
+// ListTags may return the following errors:
+//   - "invalid_input" (type InvalidInput)
+//   - "rate_limited" (type *RateLimit): Too many requests
+//   - "service_unavailable" (type ServiceUnavailable)
+//   - "unauthorized" (type Unauthorized)
+//   - "permission_denied" (type PermissionDenied)
+//   - "not_found" (type NotFound)
+//   - "internal_error" (type InternalError)
+//   - "solution_failed" (type *SolutionFailure): Exercise solution does not pass its tests
+//   - error: internal error
+func (c *Client) ListTags(ctx context.Context, p *ListTagsPayload) (res []*TagCount, err error) {
+	var ires any
+	ires, err = c.ListTagsEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.([]*TagCount), nil
 }
 
 // UpdateExercise calls the "UpdateExercise" endpoint of the "codelab" service.
 // UpdateExercise may return the following errors:
 //   - "invalid_input" (type InvalidInput)
-//   - "rate_limited" (type RateLimited)
+//   - "rate_limited" (type *RateLimit): Too many requests
 //   - "service_unavailable" (type ServiceUnavailable)
 //   - "unauthorized" (type Unauthorized)
 //   - "permission_denied" (type PermissionDenied)
 //   - "not_found" (type NotFound)
 //   - "internal_error" (type InternalError)
+//   - "solution_failed" (type *SolutionFailure): Exercise solution does not pass its tests
 //   - error: internal error
 func (c *Client) UpdateExercise(ctx context.Context, p *UpdateExercisePayload2) (res *SimpleResponse, err error) {
 	var ires any
@@ -132,12 +212,13 @@ func (c *Client) UpdateExercise(ctx context.Context, p *UpdateExercisePayload2)
 // DeleteExercise calls the "DeleteExercise" endpoint of the "codelab" service.
 // DeleteExercise may return the following errors:
 //   - "invalid_input" (type InvalidInput)
-//   - "rate_limited" (type RateLimited)
+//   - "rate_limited" (type *RateLimit): Too many requests
 //   - "service_unavailable" (type ServiceUnavailable)
 //   - "unauthorized" (type Unauthorized)
 //   - "permission_denied" (type PermissionDenied)
 //   - "not_found" (type NotFound)
 //   - "internal_error" (type InternalError)
+//   - "solution_failed" (type *SolutionFailure): Exercise solution does not pass its tests
 //   - error: internal error
 func (c *Client) DeleteExercise(ctx context.Context, p *DeleteExercisePayload) (res *SimpleResponse, err error) {
 	var ires any
@@ -148,15 +229,58 @@ func (c *Client) DeleteExercise(ctx context.Context, p *DeleteExercisePayload) (
 	return ires.(*SimpleResponse), nil
 }
 
+// ExportExercises calls the "ExportExercises" endpoint of the "codelab"
+// service.
+// ExportExercises may return the following errors:
+//   - "invalid_input" (type InvalidInput)
+//   - "rate_limited" (type *RateLimit): Too many requests
+//   - "service_unavailable" (type ServiceUnavailable)
+//   - "unauthorized" (type Unauthorized)
+//   - "permission_denied" (type PermissionDenied)
+//   - "not_found" (type NotFound)
+//   - "internal_error" (type InternalError)
+//   - "solution_failed" (type *SolutionFailure): Exercise solution does not pass its tests
+//   - error: internal error
+func (c *Client) ExportExercises(ctx context.Context, p *ExportExercisesPayload) (res *ExerciseBundleFile, err error) {
+	var ires any
+	ires, err = c.ExportExercisesEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*ExerciseBundleFile), nil
+}
+
+// ImportExercises calls the "ImportExercises" endpoint of the "codelab"
+// service.
+// ImportExercises may return the following errors:
+//   - "invalid_input" (type InvalidInput)
+//   - "rate_limited" (type *RateLimit): Too many requests
+//   - "service_unavailable" (type ServiceUnavailable)
+//   - "unauthorized" (type Unauthorized)
+//   - "permission_denied" (type PermissionDenied)
+//   - "not_found" (type NotFound)
+//   - "internal_error" (type InternalError)
+//   - "solution_failed" (type *SolutionFailure): Exercise solution does not pass its tests
+//   - error: internal error
+func (c *Client) ImportExercises(ctx context.Context, p *ImportExercisesPayload) (res *ImportedExercises, err error) {
+	var ires any
+	ires, err = c.ImportExercisesEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*ImportedExercises), nil
+}
+
 // CreateTest calls the "CreateTest" endpoint of the "codelab" service.
 // CreateTest may return the following errors:
 //   - "invalid_input" (type InvalidInput)
-//   - "rate_limited" (type RateLimited)
+//   - "rate_limited" (type *RateLimit): Too many requests
 //   - "service_unavailable" (type ServiceUnavailable)
 //   - "unauthorized" (type Unauthorized)
 //   - "permission_denied" (type PermissionDenied)
 //   - "not_found" (type NotFound)
 //   - "internal_error" (type InternalError)
+//   - "solution_failed" (type *SolutionFailure): Exercise solution does not pass its tests
 //   - error: internal error
 func (c *Client) CreateTest(ctx context.Context, p *CreateTestPayload) (res *SimpleResponse, err error) {
 	var ires any
@@ -171,12 +295,13 @@ func (c *Client) CreateTest(ctx context.Context, p *CreateTestPayload) (res *Sim
 // service.
 // GetTestsByExercise may return the following errors:
 //   - "invalid_input" (type InvalidInput)
-//   - "rate_limited" (type RateLimited)
+//   - "rate_limited" (type *RateLimit): Too many requests
 //   - "service_unavailable" (type ServiceUnavailable)
 //   - "unauthorized" (type Unauthorized)
 //   - "permission_denied" (type PermissionDenied)
 //   - "not_found" (type NotFound)
 //   - "internal_error" (type InternalError)
+//   - "solution_failed" (type *SolutionFailure): Exercise solution does not pass its tests
 //   - error: internal error
 func (c *Client) GetTestsByExercise(ctx context.Context, p *GetTestsByExercisePayload) (res []*Test, err error) {
 	var ires any
@@ -190,12 +315,13 @@ func (c *Client) GetTestsByExercise(ctx context.Context, p *GetTestsByExercisePa
 // UpdateTest calls the "UpdateTest" endpoint of the "codelab" service.
 // UpdateTest may return the following errors:
 //   - "invalid_input" (type InvalidInput)
-//   - "rate_limited" (type RateLimited)
+//   - "rate_limited" (type *RateLimit): Too many requests
 //   - "service_unavailable" (type ServiceUnavailable)
 //   - "unauthorized" (type Unauthorized)
 //   - "permission_denied" (type PermissionDenied)
 //   - "not_found" (type NotFound)
 //   - "internal_error" (type InternalError)
+//   - "solution_failed" (type *SolutionFailure): Exercise solution does not pass its tests
 //   - error: internal error
 func (c *Client) UpdateTest(ctx context.Context, p *UpdateTestPayload2) (res *SimpleResponse, err error) {
 	var ires any
@@ -209,12 +335,13 @@ func (c *Client) UpdateTest(ctx context.Context, p *UpdateTestPayload2) (res *Si
 // DeleteTest calls the "DeleteTest" endpoint of the "codelab" service.
 // DeleteTest may return the following errors:
 //   - "invalid_input" (type InvalidInput)
-//   - "rate_limited" (type RateLimited)
+//   - "rate_limited" (type *RateLimit): Too many requests
 //   - "service_unavailable" (type ServiceUnavailable)
 //   - "unauthorized" (type Unauthorized)
 //   - "permission_denied" (type PermissionDenied)
 //   - "not_found" (type NotFound)
 //   - "internal_error" (type InternalError)
+//   - "solution_failed" (type *SolutionFailure): Exercise solution does not pass its tests
 //   - error: internal error
 func (c *Client) DeleteTest(ctx context.Context, p *DeleteTestPayload) (res *SimpleResponse, err error) {
 	var ires any
@@ -225,16 +352,390 @@ func (c *Client) DeleteTest(ctx context.Context, p *DeleteTestPayload) (res *Sim
 	return ires.(*SimpleResponse), nil
 }
 
+// ListExerciseVersions calls the "ListExerciseVersions" endpoint of the
+// "codelab" service.
+// ListExerciseVersions may return the following errors:
+//   - "invalid_input" (type InvalidInput)
+//   - "rate_limited" (type *RateLimit): Too many requests
+//   - "service_unavailable" (type ServiceUnavailable)
+//   - "unauthorized" (type Unauthorized)
+//   - "permission_denied" (type PermissionDenied)
+//   - "not_found" (type NotFound)
+//   - "internal_error" (type InternalError)
+//   - "solution_failed" (type *SolutionFailure): Exercise solution does not pass its tests
+//   - error: internal error
+func (c *Client) ListExerciseVersions(ctx context.Context, p *ListExerciseVersionsPayload) (res []*ExerciseVersion, err error) {
+	var ires any
+	ires, err = c.ListExerciseVersionsEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.([]*ExerciseVersion), nil
+}
+
+// DiffExerciseVersions calls the "DiffExerciseVersions" endpoint of the
+// "codelab" service.
+// DiffExerciseVersions may return the following errors:
+//   - "invalid_input" (type InvalidInput)
+//   - "rate_limited" (type *RateLimit): Too many requests
+//   - "service_unavailable" (type ServiceUnavailable)
+//   - "unauthorized" (type Unauthorized)
+//   - "permission_denied" (type PermissionDenied)
+//   - "not_found" (type NotFound)
+//   - "internal_error" (type InternalError)
+//   - "solution_failed" (type *SolutionFailure): Exercise solution does not pass its tests
+//   - error: internal error
+func (c *Client) DiffExerciseVersions(ctx context.Context, p *DiffExerciseVersionsPayload) (res *ExerciseVersionDiff, err error) {
+	var ires any
+	ires, err = c.DiffExerciseVersionsEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*ExerciseVersionDiff), nil
+}
+
+// RollbackExercise calls the "RollbackExercise" endpoint of the "codelab"
+// service.
+// RollbackExercise may return the following errors:
+//   - "invalid_input" (type InvalidInput)
+//   - "rate_limited" (type *RateLimit): Too many requests
+//   - "service_unavailable" (type ServiceUnavailable)
+//   - "unauthorized" (type Unauthorized)
+//   - "permission_denied" (type PermissionDenied)
+//   - "not_found" (type NotFound)
+//   - "internal_error" (type InternalError)
+//   - "solution_failed" (type *SolutionFailure): Exercise solution does not pass its tests
+//   - error: internal error
+func (c *Client) RollbackExercise(ctx context.Context, p *RollbackExercisePayload) (res *SimpleResponse, err error) {
+	var ires any
+	ires, err = c.RollbackExerciseEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*SimpleResponse), nil
+}
+
+// RegradeExercise calls the "RegradeExercise" endpoint of the "codelab"
+// service.
+// RegradeExercise may return the following errors:
+//   - "invalid_input" (type InvalidInput)
+//   - "rate_limited" (type *RateLimit): Too many requests
+//   - "service_unavailable" (type ServiceUnavailable)
+//   - "unauthorized" (type Unauthorized)
+//   - "permission_denied" (type PermissionDenied)
+//   - "not_found" (type NotFound)
+//   - "internal_error" (type InternalError)
+//   - "solution_failed" (type *SolutionFailure): Exercise solution does not pass its tests
+//   - error: internal error
+func (c *Client) RegradeExercise(ctx context.Context, p *RegradeExercisePayload) (res *Regrade, err error) {
+	var ires any
+	ires, err = c.RegradeExerciseEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*Regrade), nil
+}
+
+// GetRegrade calls the "GetRegrade" endpoint of the "codelab" service.
+// GetRegrade may return the following errors:
+//   - "invalid_input" (type InvalidInput)
+//   - "rate_limited" (type *RateLimit): Too many requests
+//   - "service_unavailable" (type ServiceUnavailable)
+//   - "unauthorized" (type Unauthorized)
+//   - "permission_denied" (type PermissionDenied)
+//   - "not_found" (type NotFound)
+//   - "internal_error" (type InternalError)
+//   - "solution_failed" (type *SolutionFailure): Exercise solution does not pass its tests
+//   - error: internal error
+func (c *Client) GetRegrade(ctx context.Context, p *GetRegradePayload) (res *Regrade, err error) {
+	var ires any
+	ires, err = c.GetRegradeEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*Regrade), nil
+}
+
+// CreateHint calls the "CreateHint" endpoint of the "codelab" service.
+// CreateHint may return the following errors:
+//   - "invalid_input" (type InvalidInput)
+//   - "rate_limited" (type *RateLimit): Too many requests
+//   - "service_unavailable" (type ServiceUnavailable)
+//   - "unauthorized" (type Unauthorized)
+//   - "permission_denied" (type PermissionDenied)
+//   - "not_found" (type NotFound)
+//   - "internal_error" (type InternalError)
+//   - "solution_failed" (type *SolutionFailure): Exercise solution does not pass its tests
+//   - error: internal error
+func (c *Client) CreateHint(ctx context.Context, p *CreateHintPayload) (res *SimpleResponse, err error) {
+	var ires any
+	ires, err = c.CreateHintEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*SimpleResponse), nil
+}
+
+// GetHintsByExercise calls the "GetHintsByExercise" endpoint of the "codelab"
+// service.
+// GetHintsByExercise may return the following errors:
+//   - "invalid_input" (type InvalidInput)
+//   - "rate_limited" (type *RateLimit): Too many requests
+//   - "service_unavailable" (type ServiceUnavailable)
+//   - "unauthorized" (type Unauthorized)
+//   - "permission_denied" (type PermissionDenied)
+//   - "not_found" (type NotFound)
+//   - "internal_error" (type InternalError)
+//   - "solution_failed" (type *SolutionFailure): Exercise solution does not pass its tests
+//   - error: internal error
+func (c *Client) GetHintsByExercise(ctx context.Context, p *GetHintsByExercisePayload) (res []*Hint, err error) {
+	var ires any
+	ires, err = c.GetHintsByExerciseEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.([]*Hint), nil
+}
+
+// UpdateHint calls the "UpdateHint" endpoint of the "codelab" service.
+// UpdateHint may return the following errors:
+//   - "invalid_input" (type InvalidInput)
+//   - "rate_limited" (type *RateLimit): Too many requests
+//   - "service_unavailable" (type ServiceUnavailable)
+//   - "unauthorized" (type Unauthorized)
+//   - "permission_denied" (type PermissionDenied)
+//   - "not_found" (type NotFound)
+//   - "internal_error" (type InternalError)
+//   - "solution_failed" (type *SolutionFailure): Exercise solution does not pass its tests
+//   - error: internal error
+func (c *Client) UpdateHint(ctx context.Context, p *UpdateHintPayload2) (res *SimpleResponse, err error) {
+	var ires any
+	ires, err = c.UpdateHintEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*SimpleResponse), nil
+}
+
+// DeleteHint calls the "DeleteHint" endpoint of the "codelab" service.
+// DeleteHint may return the following errors:
+//   - "invalid_input" (type InvalidInput)
+//   - "rate_limited" (type *RateLimit): Too many requests
+//   - "service_unavailable" (type ServiceUnavailable)
+//   - "unauthorized" (type Unauthorized)
+//   - "permission_denied" (type PermissionDenied)
+//   - "not_found" (type NotFound)
+//   - "internal_error" (type InternalError)
+//   - "solution_failed" (type *SolutionFailure): Exercise solution does not pass its tests
+//   - error: internal error
+func (c *Client) DeleteHint(ctx context.Context, p *DeleteHintPayload) (res *SimpleResponse, err error) {
+	var ires any
+	ires, err = c.DeleteHintEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*SimpleResponse), nil
+}
+
+// CreateAssignment calls the "CreateAssignment" endpoint of the "codelab"
+// service.
+// CreateAssignment may return the following errors:
+//   - "invalid_input" (type InvalidInput)
+//   - "rate_limited" (type *RateLimit): Too many requests
+//   - "service_unavailable" (type ServiceUnavailable)
+//   - "unauthorized" (type Unauthorized)
+//   - "permission_denied" (type PermissionDenied)
+//   - "not_found" (type NotFound)
+//   - "internal_error" (type InternalError)
+//   - "solution_failed" (type *SolutionFailure): Exercise solution does not pass its tests
+//   - error: internal error
+func (c *Client) CreateAssignment(ctx context.Context, p *CreateAssignmentPayload) (res *SimpleResponse, err error) {
+	var ires any
+	ires, err = c.CreateAssignmentEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*SimpleResponse), nil
+}
+
+// GetAssignment calls the "GetAssignment" endpoint of the "codelab" service.
+// GetAssignment may return the following errors:
+//   - "invalid_input" (type InvalidInput)
+//   - "rate_limited" (type *RateLimit): Too many requests
+//   - "service_unavailable" (type ServiceUnavailable)
+//   - "unauthorized" (type Unauthorized)
+//   - "permission_denied" (type PermissionDenied)
+//   - "not_found" (type NotFound)
+//   - "internal_error" (type InternalError)
+//   - "solution_failed" (type *SolutionFailure): Exercise solution does not pass its tests
+//   - error: internal error
+func (c *Client) GetAssignment(ctx context.Context, p *GetAssignmentPayload) (res *Assignment, err error) {
+	var ires any
+	ires, err = c.GetAssignmentEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*Assignment), nil
+}
+
+// ListAssignments calls the "ListAssignments" endpoint of the "codelab"
+// service.
+// ListAssignments may return the following errors:
+//   - "invalid_input" (type InvalidInput)
+//   - "rate_limited" (type *RateLimit): Too many requests
+//   - "service_unavailable" (type ServiceUnavailable)
+//   - "unauthorized" (type Unauthorized)
+//   - "permission_denied" (type PermissionDenied)
+//   - "not_found" (type NotFound)
+//   - "internal_error" (type InternalError)
+//   - "solution_failed" (type *SolutionFailure): Exercise solution does not pass its tests
+//   - error: internal error
+func (c *Client) ListAssignments(ctx context.Context, p *ListAssignmentsPayload) (res []*Assignment, err error) {
+	var ires any
+	ires, err = c.ListAssignmentsEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.([]*Assignment), nil
+}
+
+// UpdateAssignment calls the "UpdateAssignment" endpoint of the "codelab"
+// service.
+// UpdateAssignment may return the following errors:
+//   - "invalid_input" (type InvalidInput)
+//   - "rate_limited" (type *RateLimit): Too many requests
+//   - "service_unavailable" (type ServiceUnavailable)
+//   - "unauthorized" (type Unauthorized)
+//   - "permission_denied" (type PermissionDenied)
+//   - "not_found" (type NotFound)
+//   - "internal_error" (type InternalError)
+//   - "solution_failed" (type *SolutionFailure): Exercise solution does not pass its tests
+//   - error: internal error
+func (c *Client) UpdateAssignment(ctx context.Context, p *UpdateAssignmentPayload2) (res *SimpleResponse, err error) {
+	var ires any
+	ires, err = c.UpdateAssignmentEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*SimpleResponse), nil
+}
+
+// DeleteAssignment calls the "DeleteAssignment" endpoint of the "codelab"
+// service.
+// DeleteAssignment may return the following errors:
+//   - "invalid_input" (type InvalidInput)
+//   - "rate_limited" (type *RateLimit): Too many requests
+//   - "service_unavailable" (type ServiceUnavailable)
+//   - "unauthorized" (type Unauthorized)
+//   - "permission_denied" (type PermissionDenied)
+//   - "not_found" (type NotFound)
+//   - "internal_error" (type InternalError)
+//   - "solution_failed" (type *SolutionFailure): Exercise solution does not pass its tests
+//   - error: internal error
+func (c *Client) DeleteAssignment(ctx context.Context, p *DeleteAssignmentPayload) (res *SimpleResponse, err error) {
+	var ires any
+	ires, err = c.DeleteAssignmentEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*SimpleResponse), nil
+}
+
+// GetAssignmentGradebook calls the "GetAssignmentGradebook" endpoint of the
+// "codelab" service.
+// GetAssignmentGradebook may return the following errors:
+//   - "invalid_input" (type InvalidInput)
+//   - "rate_limited" (type *RateLimit): Too many requests
+//   - "service_unavailable" (type ServiceUnavailable)
+//   - "unauthorized" (type Unauthorized)
+//   - "permission_denied" (type PermissionDenied)
+//   - "not_found" (type NotFound)
+//   - "internal_error" (type InternalError)
+//   - "solution_failed" (type *SolutionFailure): Exercise solution does not pass its tests
+//   - error: internal error
+func (c *Client) GetAssignmentGradebook(ctx context.Context, p *GetAssignmentGradebookPayload) (res *AssignmentGradebook, err error) {
+	var ires any
+	ires, err = c.GetAssignmentGradebookEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*AssignmentGradebook), nil
+}
+
+// GetExerciseStats calls the "GetExerciseStats" endpoint of the "codelab"
+// service.
+// GetExerciseStats may return the following errors:
+//   - "invalid_input" (type InvalidInput)
+//   - "rate_limited" (type *RateLimit): Too many requests
+//   - "service_unavailable" (type ServiceUnavailable)
+//   - "unauthorized" (type Unauthorized)
+//   - "permission_denied" (type PermissionDenied)
+//   - "not_found" (type NotFound)
+//   - "internal_error" (type InternalError)
+//   - "solution_failed" (type *SolutionFailure): Exercise solution does not pass its tests
+//   - error: internal error
+func (c *Client) GetExerciseStats(ctx context.Context, p *GetExerciseStatsPayload) (res *ExerciseStats, err error) {
+	var ires any
+	ires, err = c.GetExerciseStatsEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*ExerciseStats), nil
+}
+
+// GetStudentExerciseStats calls the "GetStudentExerciseStats" endpoint of the
+// "codelab" service.
+// GetStudentExerciseStats may return the following errors:
+//   - "invalid_input" (type InvalidInput)
+//   - "rate_limited" (type *RateLimit): Too many requests
+//   - "service_unavailable" (type ServiceUnavailable)
+//   - "unauthorized" (type Unauthorized)
+//   - "permission_denied" (type PermissionDenied)
+//   - "not_found" (type NotFound)
+//   - "internal_error" (type InternalError)
+//   - "solution_failed" (type *SolutionFailure): Exercise solution does not pass its tests
+//   - error: internal error
+func (c *Client) GetStudentExerciseStats(ctx context.Context, p *GetStudentExerciseStatsPayload) (res *StudentExerciseStats, err error) {
+	var ires any
+	ires, err = c.GetStudentExerciseStatsEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*StudentExerciseStats), nil
+}
+
+// GetPlagiarismReport calls the "GetPlagiarismReport" endpoint of the
+// "codelab" service.
+// GetPlagiarismReport may return the following errors:
+//   - "invalid_input" (type InvalidInput)
+//   - "rate_limited" (type *RateLimit): Too many requests
+//   - "service_unavailable" (type ServiceUnavailable)
+//   - "unauthorized" (type Unauthorized)
+//   - "permission_denied" (type PermissionDenied)
+//   - "not_found" (type NotFound)
+//   - "internal_error" (type InternalError)
+//   - "solution_failed" (type *SolutionFailure): Exercise solution does not pass its tests
+//   - error: internal error
+func (c *Client) GetPlagiarismReport(ctx context.Context, p *GetPlagiarismReportPayload) (res *PlagiarismReport, err error) {
+	var ires any
+	ires, err = c.GetPlagiarismReportEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*PlagiarismReport), nil
+}
+
 // GetExerciseForStudent calls the "GetExerciseForStudent" endpoint of the
 // "codelab" service.
 // GetExerciseForStudent may return the following errors:
 //   - "invalid_input" (type InvalidInput)
-//   - "rate_limited" (type RateLimited)
+//   - "rate_limited" (type *RateLimit): Too many requests
 //   - "service_unavailable" (type ServiceUnavailable)
 //   - "unauthorized" (type Unauthorized)
 //   - "permission_denied" (type PermissionDenied)
 //   - "not_found" (type NotFound)
 //   - "internal_error" (type InternalError)
+//   - "solution_failed" (type *SolutionFailure): Exercise solution does not pass its tests
 //   - error: internal error
 func (c *Client) GetExerciseForStudent(ctx context.Context, p *GetExerciseForStudentPayload) (res *ExerciseForStudents, err error) {
 	var ires any
@@ -249,31 +750,33 @@ func (c *Client) GetExerciseForStudent(ctx context.Context, p *GetExerciseForStu
 // the "codelab" service.
 // ListExercisesForStudents may return the following errors:
 //   - "invalid_input" (type InvalidInput)
-//   - "rate_limited" (type RateLimited)
+//   - "rate_limited" (type *RateLimit): Too many requests
 //   - "service_unavailable" (type ServiceUnavailable)
 //   - "unauthorized" (type Unauthorized)
 //   - "permission_denied" (type PermissionDenied)
 //   - "not_found" (type NotFound)
 //   - "internal_error" (type InternalError)
+//   - "solution_failed" (type *SolutionFailure): Exercise solution does not pass its tests
 //   - error: internal error
-func (c *Client) ListExercisesForStudents(ctx context.Context, p *ListExercisesForStudentsPayload) (res []*ExerciseForStudentsListView, err error) {
+func (c *Client) ListExercisesForStudents(ctx context.Context, p *ListExercisesForStudentsPayload) (res *ExerciseForStudentsPage, err error) {
 	var ires any
 	ires, err = c.ListExercisesForStudentsEndpoint(ctx, p)
 	if err != nil {
 		return
 	}
-	return ires.([]*ExerciseForStudentsListView), nil
+	return ires.(*ExerciseForStudentsPage), nil
 }
 
 // CreateAttempt calls the "CreateAttempt" endpoint of the "codelab" service.
 // CreateAttempt may return the following errors:
 //   - "invalid_input" (type InvalidInput)
-//   - "rate_limited" (type RateLimited)
+//   - "rate_limited" (type *RateLimit): Too many requests
 //   - "service_unavailable" (type ServiceUnavailable)
 //   - "unauthorized" (type Unauthorized)
 //   - "permission_denied" (type PermissionDenied)
 //   - "not_found" (type NotFound)
 //   - "internal_error" (type InternalError)
+//   - "solution_failed" (type *SolutionFailure): Exercise solution does not pass its tests
 //   - error: internal error
 func (c *Client) CreateAttempt(ctx context.Context, p *CreateAttemptPayload) (res *SimpleResponse, err error) {
 	var ires any
@@ -284,16 +787,78 @@ func (c *Client) CreateAttempt(ctx context.Context, p *CreateAttemptPayload) (re
 	return ires.(*SimpleResponse), nil
 }
 
+// SubmitAttempt calls the "SubmitAttempt" endpoint of the "codelab" service.
+// SubmitAttempt may return the following errors:
+//   - "invalid_input" (type InvalidInput)
+//   - "rate_limited" (type *RateLimit): Too many requests
+//   - "service_unavailable" (type ServiceUnavailable)
+//   - "unauthorized" (type Unauthorized)
+//   - "permission_denied" (type PermissionDenied)
+//   - "not_found" (type NotFound)
+//   - "internal_error" (type InternalError)
+//   - "solution_failed" (type *SolutionFailure): Exercise solution does not pass its tests
+//   - error: internal error
+func (c *Client) SubmitAttempt(ctx context.Context, p *CreateAttemptPayload) (res *AttemptSubmission, err error) {
+	var ires any
+	ires, err = c.SubmitAttemptEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*AttemptSubmission), nil
+}
+
+// StreamAttemptEvents calls the "StreamAttemptEvents" endpoint of the
+// "codelab" service.
+// StreamAttemptEvents may return the following errors:
+//   - "invalid_input" (type InvalidInput)
+//   - "rate_limited" (type *RateLimit): Too many requests
+//   - "service_unavailable" (type ServiceUnavailable)
+//   - "unauthorized" (type Unauthorized)
+//   - "permission_denied" (type PermissionDenied)
+//   - "not_found" (type NotFound)
+//   - "internal_error" (type InternalError)
+//   - "solution_failed" (type *SolutionFailure): Exercise solution does not pass its tests
+//   - error: internal error
+func (c *Client) StreamAttemptEvents(ctx context.Context, p *StreamAttemptEventsPayload) (res StreamAttemptEventsClientStream, err error) {
+	var ires any
+	ires, err = c.StreamAttemptEventsEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(StreamAttemptEventsClientStream), nil
+}
+
+// RunCode calls the "RunCode" endpoint of the "codelab" service.
+// RunCode may return the following errors:
+//   - "invalid_input" (type InvalidInput)
+//   - "rate_limited" (type *RateLimit): Too many requests
+//   - "service_unavailable" (type ServiceUnavailable)
+//   - "unauthorized" (type Unauthorized)
+//   - "permission_denied" (type PermissionDenied)
+//   - "not_found" (type NotFound)
+//   - "internal_error" (type InternalError)
+//   - "solution_failed" (type *SolutionFailure): Exercise solution does not pass its tests
+//   - error: internal error
+func (c *Client) RunCode(ctx context.Context, p *RunCodePayload) (res *RunCodeResult, err error) {
+	var ires any
+	ires, err = c.RunCodeEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*RunCodeResult), nil
+}
+
 // GetAttemptsByUserAndExercise calls the "GetAttemptsByUserAndExercise"
 // endpoint of the "codelab" service.
 // GetAttemptsByUserAndExercise may return the following errors:
 //   - "invalid_input" (type InvalidInput)
-//   - "rate_limited" (type RateLimited)
+//   - "rate_limited" (type *RateLimit): Too many requests
 //   - "service_unavailable" (type ServiceUnavailable)
 //   - "unauthorized" (type Unauthorized)
 //   - "permission_denied" (type PermissionDenied)
 //   - "not_found" (type NotFound)
 //   - "internal_error" (type InternalError)
+//   - "solution_failed" (type *SolutionFailure): Exercise solution does not pass its tests
 //   - error: internal error
 func (c *Client) GetAttemptsByUserAndExercise(ctx context.Context, p *GetAttemptsByUserAndExercisePayload) (res []*Attempt, err error) {
 	var ires any
@@ -304,16 +869,100 @@ func (c *Client) GetAttemptsByUserAndExercise(ctx context.Context, p *GetAttempt
 	return ires.([]*Attempt), nil
 }
 
+// GetHintsForStudent calls the "GetHintsForStudent" endpoint of the "codelab"
+// service.
+// GetHintsForStudent may return the following errors:
+//   - "invalid_input" (type InvalidInput)
+//   - "rate_limited" (type *RateLimit): Too many requests
+//   - "service_unavailable" (type ServiceUnavailable)
+//   - "unauthorized" (type Unauthorized)
+//   - "permission_denied" (type PermissionDenied)
+//   - "not_found" (type NotFound)
+//   - "internal_error" (type InternalError)
+//   - "solution_failed" (type *SolutionFailure): Exercise solution does not pass its tests
+//   - error: internal error
+func (c *Client) GetHintsForStudent(ctx context.Context, p *GetHintsForStudentPayload) (res []*StudentHint, err error) {
+	var ires any
+	ires, err = c.GetHintsForStudentEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.([]*StudentHint), nil
+}
+
+// RequestHint calls the "RequestHint" endpoint of the "codelab" service.
+// RequestHint may return the following errors:
+//   - "invalid_input" (type InvalidInput)
+//   - "rate_limited" (type *RateLimit): Too many requests
+//   - "service_unavailable" (type ServiceUnavailable)
+//   - "unauthorized" (type Unauthorized)
+//   - "permission_denied" (type PermissionDenied)
+//   - "not_found" (type NotFound)
+//   - "internal_error" (type InternalError)
+//   - "solution_failed" (type *SolutionFailure): Exercise solution does not pass its tests
+//   - error: internal error
+func (c *Client) RequestHint(ctx context.Context, p *RequestHintPayload) (res *StudentHint, err error) {
+	var ires any
+	ires, err = c.RequestHintEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*StudentHint), nil
+}
+
+// ListAssignmentsForStudents calls the "ListAssignmentsForStudents" endpoint
+// of the "codelab" service.
+// ListAssignmentsForStudents may return the following errors:
+//   - "invalid_input" (type InvalidInput)
+//   - "rate_limited" (type *RateLimit): Too many requests
+//   - "service_unavailable" (type ServiceUnavailable)
+//   - "unauthorized" (type Unauthorized)
+//   - "permission_denied" (type PermissionDenied)
+//   - "not_found" (type NotFound)
+//   - "internal_error" (type InternalError)
+//   - "solution_failed" (type *SolutionFailure): Exercise solution does not pass its tests
+//   - error: internal error
+func (c *Client) ListAssignmentsForStudents(ctx context.Context, p *ListAssignmentsForStudentsPayload) (res []*Assignment, err error) {
+	var ires any
+	ires, err = c.ListAssignmentsForStudentsEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.([]*Assignment), nil
+}
+
+// GetAssignmentProgress calls the "GetAssignmentProgress" endpoint of the
+// "codelab" service.
+// GetAssignmentProgress may return the following errors:
+//   - "invalid_input" (type InvalidInput)
+//   - "rate_limited" (type *RateLimit): Too many requests
+//   - "service_unavailable" (type ServiceUnavailable)
+//   - "unauthorized" (type Unauthorized)
+//   - "permission_denied" (type PermissionDenied)
+//   - "not_found" (type NotFound)
+//   - "internal_error" (type InternalError)
+//   - "solution_failed" (type *SolutionFailure): Exercise solution does not pass its tests
+//   - error: internal error
+func (c *Client) GetAssignmentProgress(ctx context.Context, p *GetAssignmentProgressPayload) (res *AssignmentProgress, err error) {
+	var ires any
+	ires, err = c.GetAssignmentProgressEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*AssignmentProgress), nil
+}
+
 // GetAnswerByUserAndExercise calls the "GetAnswerByUserAndExercise" endpoint
 // of the "codelab" service.
 // GetAnswerByUserAndExercise may return the following errors:
 //   - "invalid_input" (type InvalidInput)
-//   - "rate_limited" (type RateLimited)
+//   - "rate_limited" (type *RateLimit): Too many requests
 //   - "service_unavailable" (type ServiceUnavailable)
 //   - "unauthorized" (type Unauthorized)
 //   - "permission_denied" (type PermissionDenied)
 //   - "not_found" (type NotFound)
 //   - "internal_error" (type InternalError)
+//   - "solution_failed" (type *SolutionFailure): Exercise solution does not pass its tests
 //   - error: internal error
 func (c *Client) GetAnswerByUserAndExercise(ctx context.Context, p *GetAnswerByUserAndExercisePayload) (res *Answer, err error) {
 	var ires any
@@ -20,35 +20,101 @@ type Endpoints struct {
 	CreateExercise               goa.Endpoint
 	GetExercise                  goa.Endpoint
 	ListExercises                goa.Endpoint
+	ListTags                     goa.Endpoint
 	UpdateExercise               goa.Endpoint
 	DeleteExercise               goa.Endpoint
+	ExportExercises              goa.Endpoint
+	ImportExercises              goa.Endpoint
 	CreateTest                   goa.Endpoint
 	GetTestsByExercise           goa.Endpoint
 	UpdateTest                   goa.Endpoint
 	DeleteTest                   goa.Endpoint
+	ListExerciseVersions         goa.Endpoint
+	DiffExerciseVersions         goa.Endpoint
+	RollbackExercise             goa.Endpoint
+	RegradeExercise              goa.Endpoint
+	GetRegrade                   goa.Endpoint
+	CreateHint                   goa.Endpoint
+	GetHintsByExercise           goa.Endpoint
+	UpdateHint                   goa.Endpoint
+	DeleteHint                   goa.Endpoint
+	CreateAssignment             goa.Endpoint
+	GetAssignment                goa.Endpoint
+	ListAssignments              goa.Endpoint
+	UpdateAssignment             goa.Endpoint
+	DeleteAssignment             goa.Endpoint
+	GetAssignmentGradebook       goa.Endpoint
+	GetExerciseStats             goa.Endpoint
+	GetStudentExerciseStats      goa.Endpoint
+	GetPlagiarismReport          goa.Endpoint
 	GetExerciseForStudent        goa.Endpoint
 	ListExercisesForStudents     goa.Endpoint
 	CreateAttempt                goa.Endpoint
+	SubmitAttempt                goa.Endpoint
+	StreamAttemptEvents          goa.Endpoint
+	RunCode                      goa.Endpoint
 	GetAttemptsByUserAndExercise goa.Endpoint
+	GetHintsForStudent           goa.Endpoint
+	RequestHint                  goa.Endpoint
+	ListAssignmentsForStudents   goa.Endpoint
+	GetAssignmentProgress        goa.Endpoint
 	GetAnswerByUserAndExercise   goa.Endpoint
 }
 
+// StreamAttemptEventsEndpointInput holds both the payload and the server
+// stream of the "StreamAttemptEvents" method.
+type StreamAttemptEventsEndpointInput struct {
+	// Payload is the method payload.
+	Payload *StreamAttemptEventsPayload
+	// Stream is the server stream used by the "StreamAttemptEvents" method to send
+	// data.
+	Stream StreamAttemptEventsServerStream
+}
+
 // NewEndpoints wraps the methods of the "codelab" service with endpoints.
 func NewEndpoints(s Service) *Endpoints {
 	return &Endpoints{
 		CreateExercise:               NewCreateExerciseEndpoint(s),
 		GetExercise:                  NewGetExerciseEndpoint(s),
 		ListExercises:                NewListExercisesEndpoint(s),
+		ListTags:                     NewListTagsEndpoint(s),
 		UpdateExercise:               NewUpdateExerciseEndpoint(s),
 		DeleteExercise:               NewDeleteExerciseEndpoint(s),
+		ExportExercises:              NewExportExercisesEndpoint(s),
+		ImportExercises:              NewImportExercisesEndpoint(s),
 		CreateTest:                   NewCreateTestEndpoint(s),
 		GetTestsByExercise:           NewGetTestsByExerciseEndpoint(s),
 		UpdateTest:                   NewUpdateTestEndpoint(s),
 		DeleteTest:                   NewDeleteTestEndpoint(s),
+		ListExerciseVersions:         NewListExerciseVersionsEndpoint(s),
+		DiffExerciseVersions:         NewDiffExerciseVersionsEndpoint(s),
+		RollbackExercise:             NewRollbackExerciseEndpoint(s),
+		RegradeExercise:              NewRegradeExerciseEndpoint(s),
+		GetRegrade:                   NewGetRegradeEndpoint(s),
+		CreateHint:                   NewCreateHintEndpoint(s),
+		GetHintsByExercise:           NewGetHintsByExerciseEndpoint(s),
+		UpdateHint:                   NewUpdateHintEndpoint(s),
+		DeleteHint:                   NewDeleteHintEndpoint(s),
+		CreateAssignment:             NewCreateAssignmentEndpoint(s),
+		GetAssignment:                NewGetAssignmentEndpoint(s),
+		ListAssignments:              NewListAssignmentsEndpoint(s),
+		UpdateAssignment:             NewUpdateAssignmentEndpoint(s),
+		DeleteAssignment:             NewDeleteAssignmentEndpoint(s),
+		GetAssignmentGradebook:       NewGetAssignmentGradebookEndpoint(s),
+		GetExerciseStats:             NewGetExerciseStatsEndpoint(s),
+		GetStudentExerciseStats:      NewGetStudentExerciseStatsEndpoint(s),
+		GetPlagiarismReport:          NewGetPlagiarismReportEndpoint(s),
 		GetExerciseForStudent:        NewGetExerciseForStudentEndpoint(s),
 		ListExercisesForStudents:     NewListExercisesForStudentsEndpoint(s),
 		CreateAttempt:                NewCreateAttemptEndpoint(s),
+		SubmitAttempt:                NewSubmitAttemptEndpoint(s),
+		StreamAttemptEvents:          NewStreamAttemptEventsEndpoint(s),
+		RunCode:                      NewRunCodeEndpoint(s),
 		GetAttemptsByUserAndExercise: NewGetAttemptsByUserAndExerciseEndpoint(s),
+		GetHintsForStudent:           NewGetHintsForStudentEndpoint(s),
+		RequestHint:                  NewRequestHintEndpoint(s),
+		ListAssignmentsForStudents:   NewListAssignmentsForStudentsEndpoint(s),
+		GetAssignmentProgress:        NewGetAssignmentProgressEndpoint(s),
 		GetAnswerByUserAndExercise:   NewGetAnswerByUserAndExerciseEndpoint(s),
 	}
 }
@@ -58,16 +124,44 @@ func (e *Endpoints) Use(m func(goa.Endpoint) goa.Endpoint) {
 	e.CreateExercise = m(e.CreateExercise)
 	e.GetExercise = m(e.GetExercise)
 	e.ListExercises = m(e.ListExercises)
+	e.ListTags = m(e.ListTags)
 	e.UpdateExercise = m(e.UpdateExercise)
 	e.DeleteExercise = m(e.DeleteExercise)
+	e.ExportExercises = m(e.ExportExercises)
+	e.ImportExercises = m(e.ImportExercises)
 	e.CreateTest = m(e.CreateTest)
 	e.GetTestsByExercise = m(e.GetTestsByExercise)
 	e.UpdateTest = m(e.UpdateTest)
 	e.DeleteTest = m(e.DeleteTest)
+	e.ListExerciseVersions = m(e.ListExerciseVersions)
+	e.DiffExerciseVersions = m(e.DiffExerciseVersions)
+	e.RollbackExercise = m(e.RollbackExercise)
+	e.RegradeExercise = m(e.RegradeExercise)
+	e.GetRegrade = m(e.GetRegrade)
+	e.CreateHint = m(e.CreateHint)
+	e.GetHintsByExercise = m(e.GetHintsByExercise)
+	e.UpdateHint = m(e.UpdateHint)
+	e.DeleteHint = m(e.DeleteHint)
+	e.CreateAssignment = m(e.CreateAssignment)
+	e.GetAssignment = m(e.GetAssignment)
+	e.ListAssignments = m(e.ListAssignments)
+	e.UpdateAssignment = m(e.UpdateAssignment)
+	e.DeleteAssignment = m(e.DeleteAssignment)
+	e.GetAssignmentGradebook = m(e.GetAssignmentGradebook)
+	e.GetExerciseStats = m(e.GetExerciseStats)
+	e.GetStudentExerciseStats = m(e.GetStudentExerciseStats)
+	e.GetPlagiarismReport = m(e.GetPlagiarismReport)
 	e.GetExerciseForStudent = m(e.GetExerciseForStudent)
 	e.ListExercisesForStudents = m(e.ListExercisesForStudents)
 	e.CreateAttempt = m(e.CreateAttempt)
+	e.SubmitAttempt = m(e.SubmitAttempt)
+	e.StreamAttemptEvents = m(e.StreamAttemptEvents)
+	e.RunCode = m(e.RunCode)
 	e.GetAttemptsByUserAndExercise = m(e.GetAttemptsByUserAndExercise)
+	e.GetHintsForStudent = m(e.GetHintsForStudent)
+	e.RequestHint = m(e.RequestHint)
+	e.ListAssignmentsForStudents = m(e.ListAssignmentsForStudents)
+	e.GetAssignmentProgress = m(e.GetAssignmentProgress)
 	e.GetAnswerByUserAndExercise = m(e.GetAnswerByUserAndExercise)
 }
 
@@ -98,6 +192,15 @@ func NewListExercisesEndpoint(s Service) goa.Endpoint {
 	}
 }
 
+// NewListTagsEndpoint returns an endpoint function that calls the method
+// "ListTags" of service "codelab".
+func NewListTagsEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*ListTagsPayload)
+		return s.ListTags(ctx, p)
+	}
+}
+
 // NewUpdateExerciseEndpoint returns an endpoint function that calls the method
 // "UpdateExercise" of service "codelab".
 func NewUpdateExerciseEndpoint(s Service) goa.Endpoint {
@@ -116,6 +219,24 @@ func NewDeleteExerciseEndpoint(s Service) goa.Endpoint {
 	}
 }
 
+// NewExportExercisesEndpoint returns an endpoint function that calls the
+// method "ExportExercises" of service "codelab".
+func NewExportExercisesEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*ExportExercisesPayload)
+		return s.ExportExercises(ctx, p)
+	}
+}
+
+// NewImportExercisesEndpoint returns an endpoint function that calls the
+// method "ImportExercises" of service "codelab".
+func NewImportExercisesEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*ImportExercisesPayload)
+		return s.ImportExercises(ctx, p)
+	}
+}
+
 // NewCreateTestEndpoint returns an endpoint function that calls the method
 // "CreateTest" of service "codelab".
 func NewCreateTestEndpoint(s Service) goa.Endpoint {
@@ -152,6 +273,168 @@ func NewDeleteTestEndpoint(s Service) goa.Endpoint {
 	}
 }
 
+// NewListExerciseVersionsEndpoint returns an endpoint function that calls the
+// method "ListExerciseVersions" of service "codelab".
+func NewListExerciseVersionsEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*ListExerciseVersionsPayload)
+		return s.ListExerciseVersions(ctx, p)
+	}
+}
+
+// NewDiffExerciseVersionsEndpoint returns an endpoint function that calls the
+// method "DiffExerciseVersions" of service "codelab".
+func NewDiffExerciseVersionsEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*DiffExerciseVersionsPayload)
+		return s.DiffExerciseVersions(ctx, p)
+	}
+}
+
+// NewRollbackExerciseEndpoint returns an endpoint function that calls the
+// method "RollbackExercise" of service "codelab".
+func NewRollbackExerciseEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*RollbackExercisePayload)
+		return s.RollbackExercise(ctx, p)
+	}
+}
+
+// NewRegradeExerciseEndpoint returns an endpoint function that calls the
+// method "RegradeExercise" of service "codelab".
+func NewRegradeExerciseEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*RegradeExercisePayload)
+		return s.RegradeExercise(ctx, p)
+	}
+}
+
+// NewGetRegradeEndpoint returns an endpoint function that calls the method
+// "GetRegrade" of service "codelab".
+func NewGetRegradeEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*GetRegradePayload)
+		return s.GetRegrade(ctx, p)
+	}
+}
+
+// NewCreateHintEndpoint returns an endpoint function that calls the method
+// "CreateHint" of service "codelab".
+func NewCreateHintEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*CreateHintPayload)
+		return s.CreateHint(ctx, p)
+	}
+}
+
+// NewGetHintsByExerciseEndpoint returns an endpoint function that calls the
+// method "GetHintsByExercise" of service "codelab".
+func NewGetHintsByExerciseEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*GetHintsByExercisePayload)
+		return s.GetHintsByExercise(ctx, p)
+	}
+}
+
+// NewUpdateHintEndpoint returns an endpoint function that calls the method
+// "UpdateHint" of service "codelab".
+func NewUpdateHintEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*UpdateHintPayload2)
+		return s.UpdateHint(ctx, p)
+	}
+}
+
+// NewDeleteHintEndpoint returns an endpoint function that calls the method
+// "DeleteHint" of service "codelab".
+func NewDeleteHintEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*DeleteHintPayload)
+		return s.DeleteHint(ctx, p)
+	}
+}
+
+// NewCreateAssignmentEndpoint returns an endpoint function that calls the
+// method "CreateAssignment" of service "codelab".
+func NewCreateAssignmentEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*CreateAssignmentPayload)
+		return s.CreateAssignment(ctx, p)
+	}
+}
+
+// NewGetAssignmentEndpoint returns an endpoint function that calls the method
+// "GetAssignment" of service "codelab".
+func NewGetAssignmentEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*GetAssignmentPayload)
+		return s.GetAssignment(ctx, p)
+	}
+}
+
+// NewListAssignmentsEndpoint returns an endpoint function that calls the
+// method "ListAssignments" of service "codelab".
+func NewListAssignmentsEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*ListAssignmentsPayload)
+		return s.ListAssignments(ctx, p)
+	}
+}
+
+// NewUpdateAssignmentEndpoint returns an endpoint function that calls the
+// method "UpdateAssignment" of service "codelab".
+func NewUpdateAssignmentEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*UpdateAssignmentPayload2)
+		return s.UpdateAssignment(ctx, p)
+	}
+}
+
+// NewDeleteAssignmentEndpoint returns an endpoint function that calls the
+// method "DeleteAssignment" of service "codelab".
+func NewDeleteAssignmentEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*DeleteAssignmentPayload)
+		return s.DeleteAssignment(ctx, p)
+	}
+}
+
+// NewGetAssignmentGradebookEndpoint returns an endpoint function that calls
+// the method "GetAssignmentGradebook" of service "codelab".
+func NewGetAssignmentGradebookEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*GetAssignmentGradebookPayload)
+		return s.GetAssignmentGradebook(ctx, p)
+	}
+}
+
+// NewGetExerciseStatsEndpoint returns an endpoint function that calls the
+// method "GetExerciseStats" of service "codelab".
+func NewGetExerciseStatsEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*GetExerciseStatsPayload)
+		return s.GetExerciseStats(ctx, p)
+	}
+}
+
+// NewGetStudentExerciseStatsEndpoint returns an endpoint function that calls
+// the method "GetStudentExerciseStats" of service "codelab".
+func NewGetStudentExerciseStatsEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*GetStudentExerciseStatsPayload)
+		return s.GetStudentExerciseStats(ctx, p)
+	}
+}
+
+// NewGetPlagiarismReportEndpoint returns an endpoint function that calls the
+// method "GetPlagiarismReport" of service "codelab".
+func NewGetPlagiarismReportEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*GetPlagiarismReportPayload)
+		return s.GetPlagiarismReport(ctx, p)
+	}
+}
+
 // NewGetExerciseForStudentEndpoint returns an endpoint function that calls the
 // method "GetExerciseForStudent" of service "codelab".
 func NewGetExerciseForStudentEndpoint(s Service) goa.Endpoint {
@@ -179,6 +462,33 @@ func NewCreateAttemptEndpoint(s Service) goa.Endpoint {
 	}
 }
 
+// NewSubmitAttemptEndpoint returns an endpoint function that calls the method
+// "SubmitAttempt" of service "codelab".
+func NewSubmitAttemptEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*CreateAttemptPayload)
+		return s.SubmitAttempt(ctx, p)
+	}
+}
+
+// NewStreamAttemptEventsEndpoint returns an endpoint function that calls the
+// method "StreamAttemptEvents" of service "codelab".
+func NewStreamAttemptEventsEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		ep := req.(*StreamAttemptEventsEndpointInput)
+		return nil, s.StreamAttemptEvents(ctx, ep.Payload, ep.Stream)
+	}
+}
+
+// NewRunCodeEndpoint returns an endpoint function that calls the method
+// "RunCode" of service "codelab".
+func NewRunCodeEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*RunCodePayload)
+		return s.RunCode(ctx, p)
+	}
+}
+
 // NewGetAttemptsByUserAndExerciseEndpoint returns an endpoint function that
 // calls the method "GetAttemptsByUserAndExercise" of service "codelab".
 func NewGetAttemptsByUserAndExerciseEndpoint(s Service) goa.Endpoint {
@@ -188,6 +498,42 @@ func NewGetAttemptsByUserAndExerciseEndpoint(s Service) goa.Endpoint {
 	}
 }
 
+// NewGetHintsForStudentEndpoint returns an endpoint function that calls the
+// method "GetHintsForStudent" of service "codelab".
+func NewGetHintsForStudentEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*GetHintsForStudentPayload)
+		return s.GetHintsForStudent(ctx, p)
+	}
+}
+
+// NewRequestHintEndpoint returns an endpoint function that calls the method
+// "RequestHint" of service "codelab".
+func NewRequestHintEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*RequestHintPayload)
+		return s.RequestHint(ctx, p)
+	}
+}
+
+// NewListAssignmentsForStudentsEndpoint returns an endpoint function that
+// calls the method "ListAssignmentsForStudents" of service "codelab".
+func NewListAssignmentsForStudentsEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*ListAssignmentsForStudentsPayload)
+		return s.ListAssignmentsForStudents(ctx, p)
+	}
+}
+
+// NewGetAssignmentProgressEndpoint returns an endpoint function that calls the
+// method "GetAssignmentProgress" of service "codelab".
+func NewGetAssignmentProgressEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*GetAssignmentProgressPayload)
+		return s.GetAssignmentProgress(ctx, p)
+	}
+}
+
 // NewGetAnswerByUserAndExerciseEndpoint returns an endpoint function that
 // calls the method "GetAnswerByUserAndExercise" of service "codelab".
 func NewGetAnswerByUserAndExerciseEndpoint(s Service) goa.Endpoint {
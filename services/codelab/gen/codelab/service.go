@@ -19,28 +19,96 @@ type Service interface {
 	CreateExercise(context.Context, *CreateExercisePayload) (res *SimpleResponse, err error)
 	// Get exercise by ID with solution (professors only)
 	GetExercise(context.Context, *GetExercisePayload) (res *Exercise, err error)
-	// List all exercises with solutions (professors only)
-	ListExercises(context.Context, *ListExercisesPayload) (res []*Exercise, err error)
-	// Update an exercise (professors only)
+	// Search exercises with solutions, newest first (professors only)
+	ListExercises(context.Context, *ListExercisesPayload) (res *ExercisePage, err error)
+	// List the topics exercises are tagged with (professors and students)
+	ListTags(context.Context, *ListTagsPayload) (res []*TagCount, err error)
+	// Update an exercise, saved as a new version. Answers keep their status until
+	// the exercise is regraded (professors only)
 	UpdateExercise(context.Context, *UpdateExercisePayload2) (res *SimpleResponse, err error)
 	// Delete an exercise (professors only)
 	DeleteExercise(context.Context, *DeleteExercisePayload) (res *SimpleResponse, err error)
-	// Create a new test case for an exercise (professors only)
+	// Export exercises with their tests and solutions as a bundle (professors only)
+	ExportExercises(context.Context, *ExportExercisesPayload) (res *ExerciseBundleFile, err error)
+	// Import the exercises of a bundle once every solution passes its tests
+	// (professors only)
+	ImportExercises(context.Context, *ImportExercisesPayload) (res *ImportedExercises, err error)
+	// Create a new test case for an exercise, saved as a new version of the
+	// exercise (professors only)
 	CreateTest(context.Context, *CreateTestPayload) (res *SimpleResponse, err error)
 	// Get all test cases for an exercise (professors only)
 	GetTestsByExercise(context.Context, *GetTestsByExercisePayload) (res []*Test, err error)
-	// Update a test case (professors only)
+	// Update a test case, saved as a new version of its exercise (professors only)
 	UpdateTest(context.Context, *UpdateTestPayload2) (res *SimpleResponse, err error)
-	// Delete a test case (professors only)
+	// Delete a test case, saved as a new version of its exercise (professors only)
 	DeleteTest(context.Context, *DeleteTestPayload) (res *SimpleResponse, err error)
+	// List the versions of an exercise, newest first (professors only)
+	ListExerciseVersions(context.Context, *ListExerciseVersionsPayload) (res []*ExerciseVersion, err error)
+	// Compare two versions of an exercise and its tests (professors only)
+	DiffExerciseVersions(context.Context, *DiffExerciseVersionsPayload) (res *ExerciseVersionDiff, err error)
+	// Restore an exercise and its tests as of a version, saved as a new version.
+	// Answers keep their status until the exercise is regraded (professors only)
+	RollbackExercise(context.Context, *RollbackExercisePayload) (res *SimpleResponse, err error)
+	// Run every graded attempt of an exercise again against its current tests in
+	// the background, updating the outcome of attempts and answers (professors
+	// only)
+	RegradeExercise(context.Context, *RegradeExercisePayload) (res *Regrade, err error)
+	// Get the progress of a regrade and, once finished, the students whose outcome
+	// changed (professors only)
+	GetRegrade(context.Context, *GetRegradePayload) (res *Regrade, err error)
+	// Add a hint after the last hint of an exercise (professors only)
+	CreateHint(context.Context, *CreateHintPayload) (res *SimpleResponse, err error)
+	// Get the hints of an exercise in the order they are revealed (professors only)
+	GetHintsByExercise(context.Context, *GetHintsByExercisePayload) (res []*Hint, err error)
+	// Update a hint (professors only)
+	UpdateHint(context.Context, *UpdateHintPayload2) (res *SimpleResponse, err error)
+	// Delete a hint (professors only)
+	DeleteHint(context.Context, *DeleteHintPayload) (res *SimpleResponse, err error)
+	// Create an assignment grouping exercises in order (professors only)
+	CreateAssignment(context.Context, *CreateAssignmentPayload) (res *SimpleResponse, err error)
+	// Get assignment by ID with its exercises (professors only)
+	GetAssignment(context.Context, *GetAssignmentPayload) (res *Assignment, err error)
+	// List all assignments, soonest deadline first (professors only)
+	ListAssignments(context.Context, *ListAssignmentsPayload) (res []*Assignment, err error)
+	// Update an assignment and replace its exercises (professors only)
+	UpdateAssignment(context.Context, *UpdateAssignmentPayload2) (res *SimpleResponse, err error)
+	// Delete an assignment, keeping its exercises (professors only)
+	DeleteAssignment(context.Context, *DeleteAssignmentPayload) (res *SimpleResponse, err error)
+	// Get the grades of every student on an assignment (professors only)
+	GetAssignmentGradebook(context.Context, *GetAssignmentGradebookPayload) (res *AssignmentGradebook, err error)
+	// Get completion and attempt statistics of an exercise (professors only)
+	GetExerciseStats(context.Context, *GetExerciseStatsPayload) (res *ExerciseStats, err error)
+	// Get the progress and attempts of a student on an exercise (professors only)
+	GetStudentExerciseStats(context.Context, *GetStudentExerciseStatsPayload) (res *StudentExerciseStats, err error)
+	// Get the pairs of students with suspiciously similar successful attempts on
+	// an exercise (professors only)
+	GetPlagiarismReport(context.Context, *GetPlagiarismReportPayload) (res *PlagiarismReport, err error)
 	// Get exercise by ID without solution (students)
 	GetExerciseForStudent(context.Context, *GetExerciseForStudentPayload) (res *ExerciseForStudents, err error)
-	// List all exercises without solutions (students)
-	ListExercisesForStudents(context.Context, *ListExercisesForStudentsPayload) (res []*ExerciseForStudentsListView, err error)
+	// Search exercises without solutions, newest first (students)
+	ListExercisesForStudents(context.Context, *ListExercisesForStudentsPayload) (res *ExerciseForStudentsPage, err error)
 	// Submit a code attempt for an exercise (students)
 	CreateAttempt(context.Context, *CreateAttemptPayload) (res *SimpleResponse, err error)
+	// Queue a code attempt to be graded in the background and return its ID right
+	// away (students)
+	SubmitAttempt(context.Context, *CreateAttemptPayload) (res *AttemptSubmission, err error)
+	// Follow the grading of a submitted attempt test by test over a WebSocket
+	// (students)
+	StreamAttemptEvents(context.Context, *StreamAttemptEventsPayload, StreamAttemptEventsServerStream) (err error)
+	// Run code against the public tests or custom inputs without submitting it
+	// (students)
+	RunCode(context.Context, *RunCodePayload) (res *RunCodeResult, err error)
 	// Get user's attempts for a specific exercise (students)
 	GetAttemptsByUserAndExercise(context.Context, *GetAttemptsByUserAndExercisePayload) (res []*Attempt, err error)
+	// Get the hints of an exercise, showing the content of the revealed ones and
+	// when the others unlock (students)
+	GetHintsForStudent(context.Context, *GetHintsForStudentPayload) (res []*StudentHint, err error)
+	// Reveal the next hint of an exercise once it is unlocked (students)
+	RequestHint(context.Context, *RequestHintPayload) (res *StudentHint, err error)
+	// List all assignments with their exercises, soonest deadline first (students)
+	ListAssignmentsForStudents(context.Context, *ListAssignmentsForStudentsPayload) (res []*Assignment, err error)
+	// Get the progress of the student on every exercise of an assignment (students)
+	GetAssignmentProgress(context.Context, *GetAssignmentProgressPayload) (res *AssignmentProgress, err error)
 	// Get user's answer for a specific exercise
 	GetAnswerByUserAndExercise(context.Context, *GetAnswerByUserAndExercisePayload) (res *Answer, err error)
 }
@@ -59,7 +127,28 @@ const ServiceName = "codelab"
 // MethodNames lists the service method names as defined in the design. These
 // are the same values that are set in the endpoint request contexts under the
 // MethodKey key.
-var MethodNames = [14]string{"CreateExercise", "GetExercise", "ListExercises", "UpdateExercise", "DeleteExercise", "CreateTest", "GetTestsByExercise", "UpdateTest", "DeleteTest", "GetExerciseForStudent", "ListExercisesForStudents", "CreateAttempt", "GetAttemptsByUserAndExercise", "GetAnswerByUserAndExercise"}
+var MethodNames = [42]string{"CreateExercise", "GetExercise", "ListExercises", "ListTags", "UpdateExercise", "DeleteExercise", "ExportExercises", "ImportExercises", "CreateTest", "GetTestsByExercise", "UpdateTest", "DeleteTest", "ListExerciseVersions", "DiffExerciseVersions", "RollbackExercise", "RegradeExercise", "GetRegrade", "CreateHint", "GetHintsByExercise", "UpdateHint", "DeleteHint", "CreateAssignment", "GetAssignment", "ListAssignments", "UpdateAssignment", "DeleteAssignment", "GetAssignmentGradebook", "GetExerciseStats", "GetStudentExerciseStats", "GetPlagiarismReport", "GetExerciseForStudent", "ListExercisesForStudents", "CreateAttempt", "SubmitAttempt", "StreamAttemptEvents", "RunCode", "GetAttemptsByUserAndExercise", "GetHintsForStudent", "RequestHint", "ListAssignmentsForStudents", "GetAssignmentProgress", "GetAnswerByUserAndExercise"}
+
+// StreamAttemptEventsServerStream is the interface a "StreamAttemptEvents"
+// endpoint server stream must satisfy.
+type StreamAttemptEventsServerStream interface {
+	// Send streams instances of "AttemptEvent".
+	Send(*AttemptEvent) error
+	// SendWithContext streams instances of "AttemptEvent" with context.
+	SendWithContext(context.Context, *AttemptEvent) error
+	// Close closes the stream.
+	Close() error
+}
+
+// StreamAttemptEventsClientStream is the interface a "StreamAttemptEvents"
+// endpoint client stream must satisfy.
+type StreamAttemptEventsClientStream interface {
+	// Recv reads instances of "AttemptEvent" from the stream.
+	Recv() (*AttemptEvent, error)
+	// RecvWithContext reads instances of "AttemptEvent" from the stream with
+	// context.
+	RecvWithContext(context.Context) (*AttemptEvent, error)
+}
 
 // Answer is the result type of the codelab service GetAnswerByUserAndExercise
 // method.
@@ -76,6 +165,72 @@ type Answer struct {
 	CreatedAt int64
 	// Last update timestamp
 	UpdatedAt int64
+	// Best score reached by an attempt, as a percentage of the test weight
+	BestScore float64
+	// Attempt that reached the best score, missing until an attempt is made
+	BestAttemptID *int64
+	// Hints revealed to the student
+	HintsUsed int32
+	// Points taken off the score of every attempt for the hints revealed
+	HintPenalty float64
+}
+
+// Assignment is the result type of the codelab service GetAssignment method.
+type Assignment struct {
+	// Assignment ID
+	ID int64
+	// Assignment title
+	Title string
+	// Assignment description
+	Description string
+	// Timestamp from which attempts are accepted
+	OpensAt int64
+	// Timestamp after which attempts are rejected or flagged as late
+	ClosesAt int64
+	// Whether attempts made after closes_at are accepted as late
+	AllowLate bool
+	// Whether the assignment has not opened yet, is open or has closed
+	Status string
+	// Exercises of the assignment in order
+	Exercises []*AssignmentExercise
+	// Creator user ID
+	CreatedBy int64
+	// Creation timestamp
+	CreatedAt int64
+	// Last update timestamp
+	UpdatedAt int64
+}
+
+// An exercise of an assignment
+type AssignmentExercise struct {
+	// Exercise ID
+	ExerciseID int64
+	// Order of the exercise in the assignment, starting at 1
+	Position int32
+	// Exercise title
+	Title string
+	// Exercise difficulty
+	Difficulty string
+	// Language the exercise is solved in
+	Language string
+}
+
+// AssignmentGradebook is the result type of the codelab service
+// GetAssignmentGradebook method.
+type AssignmentGradebook struct {
+	// The assignment
+	Assignment *Assignment
+	// Grades of every student, by user ID
+	Students []*StudentAssignmentGrade
+}
+
+// AssignmentProgress is the result type of the codelab service
+// GetAssignmentProgress method.
+type AssignmentProgress struct {
+	// The assignment
+	Assignment *Assignment
+	// Progress of the student
+	Progress *StudentAssignmentGrade
 }
 
 // A code submission attempt for an answer
@@ -90,6 +245,100 @@ type Attempt struct {
 	Success bool
 	// Creation timestamp
 	CreatedAt int64
+	// Execution outcome of the attempt, pending while it is graded in the
+	// background
+	Status string
+	// Result of running the attempt against each test of the exercise
+	TestResults []*AttemptTestResult
+	// Weight of the tests the attempt passed
+	Points int32
+	// Weight of all the tests the attempt ran against
+	MaxPoints int32
+	// Percentage of max_points earned
+	Score float64
+	// Whether the attempt was made after the assignments of the exercise closed
+	Late bool
+	// Version of the exercise the attempt was graded against
+	ExerciseVersion int32
+}
+
+// AttemptEvent is the result type of the codelab service StreamAttemptEvents
+// method.
+type AttemptEvent struct {
+	// What happened: queued, started running, finished a test or finished grading
+	Type string
+	// Attempt ID
+	AttemptID int64
+	// Number of tests the attempt runs against
+	TotalTests int64
+	// Position of the finished test, starting at 0, for test events
+	TestIndex *int64
+	// Outcome of the finished test, for test events
+	TestResult *AttemptTestResult
+	// Execution outcome of the attempt, for finished events
+	Status *string
+	// Whether the attempt passed every test, for finished events
+	Success *bool
+	// Weight of the tests the attempt passed, for finished events
+	Points *int32
+	// Weight of all the tests the attempt ran against, for finished events
+	MaxPoints *int32
+	// Percentage of max_points earned, for finished events
+	Score *float64
+	// Summary of the outcome, for finished events
+	Message *string
+}
+
+// AttemptSubmission is the result type of the codelab service SubmitAttempt
+// method.
+type AttemptSubmission struct {
+	// ID of the attempt, used to follow its grading
+	AttemptID int64
+	// Number of tests the attempt runs against
+	TotalTests int64
+	// Whether the attempt was made after the assignments of the exercise closed
+	Late bool
+}
+
+// The outcome of running an attempt against a single test
+type AttemptTestResult struct {
+	// Test ID, missing if the test was deleted
+	TestID *int64
+	// Outcome of the test
+	Status string
+	// Output produced by the submitted code
+	ActualOutput string
+	// Expected output, only shown for public tests
+	ExpectedOutput *string
+	// Error raised while running the test
+	Error *string
+	// Output written to the console while running the test, with warnings and
+	// errors prefixed by their level
+	ConsoleOutput string
+	// Time spent running the test in milliseconds
+	DurationMs int64
+	// Stack trace through the submitted code when it threw, with positions in the
+	// submitted code
+	StackTrace *string
+}
+
+// CreateAssignmentPayload is the payload type of the codelab service
+// CreateAssignment method.
+type CreateAssignmentPayload struct {
+	// Assignment title
+	Title string
+	// Assignment description
+	Description string
+	// Timestamp from which attempts are accepted
+	OpensAt int64
+	// Timestamp after which attempts are rejected or flagged as late
+	ClosesAt int64
+	// Whether attempts made after closes_at are accepted as late
+	AllowLate bool
+	// Exercises of the assignment in order
+	ExerciseIds []int64
+	// Authentication session token
+	SessionToken string
 }
 
 // CreateAttemptPayload is the payload type of the codelab service
@@ -122,6 +371,27 @@ type CreateExercisePayload struct {
 	CreatedBy int64
 	// Authentication session token
 	SessionToken string
+	// Programming language the exercise is solved in
+	Language string
+	// Topics the exercise covers, stored in lowercase
+	Tags []string
+}
+
+// CreateHintPayload is the payload type of the codelab service CreateHint
+// method.
+type CreateHintPayload struct {
+	// Associated exercise ID
+	ExerciseID int64
+	// Hint text
+	Content string
+	// Failed attempts after which the hint unlocks
+	UnlockAfterAttempts *int32
+	// Seconds since the student started the exercise after which the hint unlocks
+	UnlockAfterSeconds *int32
+	// Points taken off the score of the attempts made once the hint is revealed
+	Penalty float64
+	// Authentication session token
+	SessionToken string
 }
 
 // CreateTestPayload is the payload type of the codelab service CreateTest
@@ -137,6 +407,24 @@ type CreateTestPayload struct {
 	ExerciseID int64
 	// Authentication session token
 	SessionToken string
+	// How the input is passed to solution: a raw string or a JSON array of
+	// arguments
+	InputFormat string
+	// How the output is compared to the expected output
+	Comparison string
+	// Allowed absolute difference for numeric comparison
+	Tolerance float64
+	// Points awarded for passing the test
+	Weight int32
+}
+
+// DeleteAssignmentPayload is the payload type of the codelab service
+// DeleteAssignment method.
+type DeleteAssignmentPayload struct {
+	// Assignment ID
+	ID int64
+	// Authentication session token
+	SessionToken string
 }
 
 // DeleteExercisePayload is the payload type of the codelab service
@@ -148,6 +436,15 @@ type DeleteExercisePayload struct {
 	SessionToken string
 }
 
+// DeleteHintPayload is the payload type of the codelab service DeleteHint
+// method.
+type DeleteHintPayload struct {
+	// Hint ID
+	ID int64
+	// Authentication session token
+	SessionToken string
+}
+
 // DeleteTestPayload is the payload type of the codelab service DeleteTest
 // method.
 type DeleteTestPayload struct {
@@ -157,6 +454,19 @@ type DeleteTestPayload struct {
 	SessionToken string
 }
 
+// DiffExerciseVersionsPayload is the payload type of the codelab service
+// DiffExerciseVersions method.
+type DiffExerciseVersionsPayload struct {
+	// Exercise ID
+	ExerciseID int64
+	// Older version
+	From int32
+	// Newer version
+	To int32
+	// Authentication session token
+	SessionToken string
+}
+
 // Exercise is the result type of the codelab service GetExercise method.
 type Exercise struct {
 	// Exercise ID
@@ -177,6 +487,23 @@ type Exercise struct {
 	CreatedAt int64
 	// Last update timestamp
 	UpdatedAt int64
+	// Programming language the exercise is solved in
+	Language string
+	// Topics the exercise covers
+	Tags []string
+	// Latest version, bumped by every change to the exercise or its tests
+	Version int32
+}
+
+// ExerciseBundleFile is the result type of the codelab service ExportExercises
+// method.
+type ExerciseBundleFile struct {
+	// Suggested file name
+	Filename string
+	// Bundle format
+	Format string
+	// Bundle content
+	Content []byte
 }
 
 // ExerciseForStudents is the result type of the codelab service
@@ -204,6 +531,12 @@ type ExerciseForStudents struct {
 	CreatedAt int64
 	// Last update timestamp
 	UpdatedAt int64
+	// Programming language the exercise is solved in
+	Language string
+	// Topics the exercise covers
+	Tags []string
+	// Version new attempts are graded against
+	Version int32
 }
 
 // View for listing exercises available to students
@@ -217,13 +550,139 @@ type ExerciseForStudentsListView struct {
 	// Exercise difficulty level
 	Difficulty string
 	// Whether the exercise is completed by the student
-	Completed *bool
+	Completed bool
 	// ID of user who created the exercise
 	CreatedBy int64
 	// Creation timestamp
 	CreatedAt int64
 	// Last update timestamp
 	UpdatedAt int64
+	// Programming language the exercise is solved in
+	Language string
+	// Topics the exercise covers
+	Tags []string
+}
+
+// ExerciseForStudentsPage is the result type of the codelab service
+// ListExercisesForStudents method.
+type ExerciseForStudentsPage struct {
+	// Exercises in the page
+	Exercises []*ExerciseForStudentsListView
+	// Cursor of the next page, missing on the last page
+	NextCursor *string
+}
+
+// Progress of a student on an exercise of an assignment
+type ExerciseGrade struct {
+	// Exercise ID
+	ExerciseID int64
+	// Whether the student has not attempted, is working on, completed the exercise
+	// before the deadline or only after it
+	Status string
+	// Number of attempts made
+	Attempts int64
+	// Attempts made after the assignment closed
+	LateAttempts int64
+	// Best score reached before the assignment closed
+	BestScore float64
+	// Best score reached counting late attempts
+	BestScoreWithLate float64
+}
+
+// ExercisePage is the result type of the codelab service ListExercises method.
+type ExercisePage struct {
+	// Exercises in the page
+	Exercises []*Exercise
+	// Cursor of the next page, missing on the last page
+	NextCursor *string
+}
+
+// ExerciseStats is the result type of the codelab service GetExerciseStats
+// method.
+type ExerciseStats struct {
+	// Exercise ID
+	ExerciseID int64
+	// Students that started the exercise
+	TotalStudents int64
+	// Students that completed the exercise
+	CompletedStudents int64
+	// Percentage of the students that completed the exercise
+	CompletionRate float64
+	// Attempts made by every student
+	TotalAttempts int64
+	// Attempts that passed every test
+	SuccessfulAttempts int64
+	// Median attempts students needed to pass, missing until a student passes
+	MedianAttemptsToSuccess *float64
+	// Median time from the first attempt to the first successful one in
+	// milliseconds
+	MedianTimeToCompletionMs *int64
+	// Test failed by the most attempts, missing if no test was failed
+	MostFailedTest *FailingTest
+	// Progress of every student that started the exercise
+	Students []*StudentExerciseStatus
+	// Hints revealed to every student
+	HintsUsed int64
+}
+
+// An immutable version of an exercise and its tests
+type ExerciseVersion struct {
+	// Version number
+	Version int32
+	// ID of user whose change created the version
+	CreatedBy int64
+	// Creation timestamp
+	CreatedAt int64
+	// Number of attempts graded against the version
+	Attempts int64
+}
+
+// ExerciseVersionDiff is the result type of the codelab service
+// DiffExerciseVersions method.
+type ExerciseVersionDiff struct {
+	// Exercise ID
+	ExerciseID int64
+	// Older version
+	FromVersion int32
+	// Newer version
+	ToVersion int32
+	// Fields of the exercise that changed
+	Fields []*FieldChange
+	// Tests that changed
+	Tests []*TestChange
+}
+
+// ExportExercisesPayload is the payload type of the codelab service
+// ExportExercises method.
+type ExportExercisesPayload struct {
+	// IDs of the exercises to export
+	ExerciseIds []int64
+	// Bundle format: a JSON document or a zip or gzipped tar archive
+	Format string
+	// Authentication session token
+	SessionToken string
+}
+
+// A test of the exercise and how many attempts failed it
+type FailingTest struct {
+	// Test ID
+	TestID int64
+	// Test input
+	Input string
+	// Whether test is visible to students
+	Public bool
+	// Number of attempts that did not pass the test
+	Failures int64
+}
+
+// A field changed between two versions
+type FieldChange struct {
+	// Field name
+	Field string
+	// Value in the older version, empty for added tests
+	From string
+	// Value in the newer version, empty for removed tests
+	To string
 }
 
 // GetAnswerByUserAndExercisePayload is the payload type of the codelab service
@@ -237,6 +696,33 @@ type GetAnswerByUserAndExercisePayload struct {
 	SessionToken string
 }
 
+// GetAssignmentGradebookPayload is the payload type of the codelab service
+// GetAssignmentGradebook method.
+type GetAssignmentGradebookPayload struct {
+	// Assignment ID
+	ID int64
+	// Authentication session token
+	SessionToken string
+}
+
+// GetAssignmentPayload is the payload type of the codelab service
+// GetAssignment method.
+type GetAssignmentPayload struct {
+	// Assignment ID
+	ID int64
+	// Authentication session token
+	SessionToken string
+}
+
+// GetAssignmentProgressPayload is the payload type of the codelab service
+// GetAssignmentProgress method.
+type GetAssignmentProgressPayload struct {
+	// Assignment ID
+	ID int64
+	// Authentication session token
+	SessionToken string
+}
+
 // GetAttemptsByUserAndExercisePayload is the payload type of the codelab
 // service GetAttemptsByUserAndExercise method.
 type GetAttemptsByUserAndExercisePayload struct {
@@ -266,6 +752,64 @@ type GetExercisePayload struct {
 	SessionToken string
 }
 
+// GetExerciseStatsPayload is the payload type of the codelab service
+// GetExerciseStats method.
+type GetExerciseStatsPayload struct {
+	// Exercise ID
+	ExerciseID int64
+	// Authentication session token
+	SessionToken string
+}
+
+// GetHintsByExercisePayload is the payload type of the codelab service
+// GetHintsByExercise method.
+type GetHintsByExercisePayload struct {
+	// Exercise ID
+	ExerciseID int64
+	// Authentication session token
+	SessionToken string
+}
+
+// GetHintsForStudentPayload is the payload type of the codelab service
+// GetHintsForStudent method.
+type GetHintsForStudentPayload struct {
+	// Exercise ID
+	ExerciseID int64
+	// Authentication session token
+	SessionToken string
+}
+
+// GetPlagiarismReportPayload is the payload type of the codelab service
+// GetPlagiarismReport method.
+type GetPlagiarismReportPayload struct {
+	// Exercise ID
+	ExerciseID int64
+	// Only return pairs at least this similar (0-100)
+	MinSimilarity float64
+	// Authentication session token
+	SessionToken string
+}
+
+// GetRegradePayload is the payload type of the codelab service GetRegrade
+// method.
+type GetRegradePayload struct {
+	// Regrade ID
+	ID int64
+	// Authentication session token
+	SessionToken string
+}
+
+// GetStudentExerciseStatsPayload is the payload type of the codelab service
+// GetStudentExerciseStats method.
+type GetStudentExerciseStatsPayload struct {
+	// Exercise ID
+	ExerciseID int64
+	// Student user ID
+	UserID int64
+	// Authentication session token
+	SessionToken string
+}
+
 // GetTestsByExercisePayload is the payload type of the codelab service
 // GetTestsByExercise method.
 type GetTestsByExercisePayload struct {
@@ -275,11 +819,90 @@ type GetTestsByExercisePayload struct {
 	SessionToken string
 }
 
+// A hint of an exercise with the rules that unlock it
+type Hint struct {
+	// Hint ID
+	ID int64
+	// Associated exercise ID
+	ExerciseID int64
+	// Order in which the hint is revealed, starting at 1
+	Position int32
+	// Hint text
+	Content string
+	// Failed attempts after which the hint unlocks
+	UnlockAfterAttempts *int32
+	// Seconds since the student started the exercise after which the hint unlocks
+	UnlockAfterSeconds *int32
+	// Points taken off the score of the attempts made once the hint is revealed
+	Penalty float64
+	// Creation timestamp
+	CreatedAt int64
+	// Last update timestamp
+	UpdatedAt int64
+}
+
+// ImportExercisesPayload is the payload type of the codelab service
+// ImportExercises method.
+type ImportExercisesPayload struct {
+	// Bundle in any of the export formats
+	Content []byte
+	// Authentication session token
+	SessionToken string
+}
+
+// ImportedExercises is the result type of the codelab service ImportExercises
+// method.
+type ImportedExercises struct {
+	// Operation success status
+	Success bool
+	// Response message
+	Message string
+	// IDs of the created exercises, in bundle order
+	ExerciseIds []int64
+}
+
+// ListAssignmentsForStudentsPayload is the payload type of the codelab service
+// ListAssignmentsForStudents method.
+type ListAssignmentsForStudentsPayload struct {
+	// Authentication session token
+	SessionToken string
+}
+
+// ListAssignmentsPayload is the payload type of the codelab service
+// ListAssignments method.
+type ListAssignmentsPayload struct {
+	// Authentication session token
+	SessionToken string
+}
+
+// ListExerciseVersionsPayload is the payload type of the codelab service
+// ListExerciseVersions method.
+type ListExerciseVersionsPayload struct {
+	// Exercise ID
+	ExerciseID int64
+	// Authentication session token
+	SessionToken string
+}
+
 // ListExercisesForStudentsPayload is the payload type of the codelab service
 // ListExercisesForStudents method.
 type ListExercisesForStudentsPayload struct {
 	// Authentication session token
 	SessionToken string
+	// Words to look for in the title and description
+	Search *string
+	// Only exercises of this difficulty
+	Difficulty *string
+	// Only exercises tagged with all these topics
+	Tags []string
+	// Only exercises created by this user
+	CreatedBy *int64
+	// Cursor of the page to return, from a previous page
+	Cursor *string
+	// Maximum number of exercises to return
+	Limit int
+	// Only exercises the student has, or has not, completed
+	Completed *bool
 }
 
 // ListExercisesPayload is the payload type of the codelab service
@@ -287,6 +910,191 @@ type ListExercisesForStudentsPayload struct {
 type ListExercisesPayload struct {
 	// Authentication session token
 	SessionToken string
+	// Words to look for in the title and description
+	Search *string
+	// Only exercises of this difficulty
+	Difficulty *string
+	// Only exercises tagged with all these topics
+	Tags []string
+	// Only exercises created by this user
+	CreatedBy *int64
+	// Cursor of the page to return, from a previous page
+	Cursor *string
+	// Maximum number of exercises to return
+	Limit int
+}
+
+// ListTagsPayload is the payload type of the codelab service ListTags method.
+type ListTagsPayload struct {
+	// Authentication session token
+	SessionToken string
+}
+
+// Suspiciously similar successful attempts of two students
+type PlagiarismPair struct {
+	// Attempt of the first student
+	AttemptAID int64
+	// First student user ID
+	UserAID int64
+	// Attempt of the second student
+	AttemptBID int64
+	// Second student user ID
+	UserBID int64
+	// Percentage of the code fingerprints both attempts share
+	Similarity float64
+	// Matching regions of both attempts
+	Regions []*PlagiarismRegion
+}
+
+// Lines of two attempts holding the same code once names and formatting are
+// ignored
+type PlagiarismRegion struct {
+	// First line in the first attempt
+	StartLineA int
+	// Last line in the first attempt
+	EndLineA int
+	// First line in the second attempt
+	StartLineB int
+	// Last line in the second attempt
+	EndLineB int
+}
+
+// PlagiarismReport is the result type of the codelab service
+// GetPlagiarismReport method.
+type PlagiarismReport struct {
+	// Exercise ID
+	ExerciseID int64
+	// Timestamp of the last check, missing if the exercise was not checked yet
+	CheckedAt *int64
+	// Suspicious pairs, most similar first
+	Pairs []*PlagiarismPair
+}
+
+// Too many executions, try again later
+type RateLimit struct {
+	// Why the request was limited
+	Message string
+	// Seconds to wait before trying again
+	RetryAfter int64
+}
+
+// Regrade is the result type of the codelab service RegradeExercise method.
+type Regrade struct {
+	// Regrade ID
+	ID int64
+	// Exercise ID
+	ExerciseID int64
+	// Version of the exercise the attempts are regraded against
+	ExerciseVersion int32
+	// Whether the regrade is still running
+	Status string
+	// Number of attempts to regrade
+	TotalAttempts int32
+	// Number of attempts processed so far
+	RegradedAttempts int32
+	// Number of attempts that could not be run and kept their previous outcome
+	ErroredAttempts int32
+	// Number of attempts that failed before and pass now
+	NewlyPassedAttempts int32
+	// Number of attempts that passed before and fail now
+	NewlyFailedAttempts int32
+	// Students that did not pass the exercise before and pass it now, once finished
+	NewlyPassed []*RegradeStudentChange
+	// Students that passed the exercise before and no longer pass it, once finished
+	NewlyFailed []*RegradeStudentChange
+	// Why the regrade failed
+	Error *string
+	// ID of user who started the regrade
+	CreatedBy int64
+	// Start timestamp
+	CreatedAt int64
+	// End timestamp, once no longer running
+	FinishedAt *int64
+}
+
+// RegradeExercisePayload is the payload type of the codelab service
+// RegradeExercise method.
+type RegradeExercisePayload struct {
+	// Exercise ID
+	ExerciseID int64
+	// Authentication session token
+	SessionToken string
+}
+
+// A student that passes or fails an exercise after a regrade, unlike before it
+type RegradeStudentChange struct {
+	// Student user ID
+	UserID int64
+	// Best score before the regrade
+	PreviousScore float64
+	// Best score after the regrade
+	Score float64
+}
+
+// RequestHintPayload is the payload type of the codelab service RequestHint
+// method.
+type RequestHintPayload struct {
+	// Exercise ID
+	ExerciseID int64
+	// Authentication session token
+	SessionToken string
+}
+
+// RollbackExercisePayload is the payload type of the codelab service
+// RollbackExercise method.
+type RollbackExercisePayload struct {
+	// Exercise ID
+	ExerciseID int64
+	// Version to restore
+	Version int32
+	// Authentication session token
+	SessionToken string
+}
+
+// The outcome of running code on a single input
+type RunCaseResult struct {
+	// Public test ID, missing for custom inputs
+	TestID *int64
+	// Input given to the code
+	Input string
+	// Outcome of the run, completed for custom inputs that ran without errors
+	Status string
+	// Output produced by the code
+	Output string
+	// Expected output, missing for custom inputs
+	ExpectedOutput *string
+	// Error raised while running the code
+	Error *string
+	// Output written to the console while running the code, with warnings and
+	// errors prefixed by their level
+	ConsoleOutput string
+	// Time spent running the code in milliseconds
+	DurationMs int64
+	// Stack trace through the code when it threw, with positions in the code
+	StackTrace *string
+}
+
+// RunCodePayload is the payload type of the codelab service RunCode method.
+type RunCodePayload struct {
+	// Exercise ID
+	ExerciseID int64
+	// Code to run
+	Code string
+	// Custom inputs to run instead of the public tests
+	Inputs []string
+	// Authentication session token
+	SessionToken string
+	// How custom inputs are passed to solution: a raw string or a JSON array of
+	// arguments
+	InputFormat string
+}
+
+// RunCodeResult is the result type of the codelab service RunCode method.
+type RunCodeResult struct {
+	// Overall outcome of the run
+	Status string
+	// Outcome for each input
+	Results []*RunCaseResult
 }
 
 // SimpleResponse is the result type of the codelab service CreateExercise
@@ -298,6 +1106,119 @@ type SimpleResponse struct {
 	Message string
 }
 
+// The exercise solution does not pass its tests
+type SolutionFailure struct {
+	// Error message
+	Message string
+	// Tests the solution does not pass
+	FailingTests []*SolutionTestFailure
+}
+
+// A test the exercise solution does not pass
+type SolutionTestFailure struct {
+	// Test ID, missing for a test that is not saved yet
+	TestID *int64
+	// Test input
+	Input string
+	// Expected output
+	ExpectedOutput string
+	// Output of the solution
+	ActualOutput string
+	// How the test finished
+	Status string
+	// Error raised by the solution
+	ErrorMessage *string
+}
+
+// StreamAttemptEventsPayload is the payload type of the codelab service
+// StreamAttemptEvents method.
+type StreamAttemptEventsPayload struct {
+	// Attempt ID
+	ID int64
+	// Authentication session token
+	SessionToken string
+}
+
+// Progress of a student on an assignment
+type StudentAssignmentGrade struct {
+	// Student user ID
+	UserID int64
+	// Average best score over every exercise before the assignment closed
+	Score float64
+	// Average best score over every exercise counting late attempts
+	ScoreWithLate float64
+	// Exercises completed before the assignment closed
+	CompletedExercises int64
+	// Exercises of the assignment
+	TotalExercises int64
+	// Progress on every exercise in assignment order
+	Exercises []*ExerciseGrade
+}
+
+// StudentExerciseStats is the result type of the codelab service
+// GetStudentExerciseStats method.
+type StudentExerciseStats struct {
+	// Exercise ID
+	ExerciseID int64
+	// Progress of the student
+	Summary *StudentExerciseStatus
+	// Attempts of the student with their test results, oldest first
+	Attempts []*Attempt
+}
+
+// Progress of a student on an exercise
+type StudentExerciseStatus struct {
+	// Student user ID
+	UserID int64
+	// Whether the student has not attempted, is working on or has completed the
+	// exercise
+	Status string
+	// Number of attempts made
+	Attempts int64
+	// Best score reached by an attempt
+	BestScore float64
+	// Attempts made up to and including the first successful one
+	AttemptsToSuccess *int64
+	// Timestamp of the first attempt
+	FirstAttemptAt *int64
+	// Timestamp of the latest attempt
+	LastAttemptAt *int64
+	// Timestamp of the first successful attempt
+	CompletedAt *int64
+	// Time from the first attempt to the first successful one in milliseconds
+	TimeToCompletionMs *int64
+	// Hints revealed to the student
+	HintsUsed int32
+}
+
+// StudentHint is the result type of the codelab service RequestHint method.
+type StudentHint struct {
+	// Hint ID
+	ID int64
+	// Order in which the hint is revealed, starting at 1
+	Position int32
+	// Whether the hint was revealed, can be requested next or is still locked
+	Status string
+	// Hint text, only sent once revealed
+	Content *string
+	// Points taken off the score of the attempts made once the hint is revealed
+	Penalty float64
+	// Failed attempts still needed to unlock the hint
+	AttemptsRemaining *int32
+	// Timestamp at which the hint unlocks by time
+	UnlocksAt *int64
+	// Timestamp at which the hint was revealed
+	RevealedAt *int64
+}
+
+// A topic used to tag exercises
+type TagCount struct {
+	// Topic name
+	Tag string
+	// Number of exercises tagged with the topic
+	Exercises int64
+}
+
 // A test case with input and expected output
 type Test struct {
 	// Test ID
@@ -314,6 +1235,52 @@ type Test struct {
 	CreatedAt int64
 	// Last update timestamp
 	UpdatedAt int64
+	// How the input is passed to solution: a raw string or a JSON array of
+	// arguments
+	InputFormat string
+	// How the output is compared to the expected output
+	Comparison string
+	// Allowed absolute difference for numeric comparison
+	Tolerance float64
+	// Points awarded for passing the test
+	Weight int32
+}
+
+// A test added, removed or modified between two versions
+type TestChange struct {
+	// Test ID
+	TestID int64
+	// How the test changed
+	Change string
+	// Fields that changed, every field for added and removed tests
+	Fields []*FieldChange
+}
+
+// Payload for updating an assignment, replacing its exercises
+type UpdateAssignmentPayload struct {
+	// Assignment title
+	Title string
+	// Assignment description
+	Description string
+	// Timestamp from which attempts are accepted
+	OpensAt int64
+	// Timestamp after which attempts are rejected or flagged as late
+	ClosesAt int64
+	// Whether attempts made after closes_at are accepted as late
+	AllowLate bool
+	// Exercises of the assignment in order
+	ExerciseIds []int64
+}
+
+// UpdateAssignmentPayload2 is the payload type of the codelab service
+// UpdateAssignment method.
+type UpdateAssignmentPayload2 struct {
+	// Assignment ID
+	ID int64
+	// Assignment data to update
+	Assignment *UpdateAssignmentPayload
+	// Authentication session token
+	SessionToken string
 }
 
 // Payload for updating an exercise
@@ -328,6 +1295,10 @@ type UpdateExercisePayload struct {
 	Solution string
 	// Exercise difficulty level
 	Difficulty string
+	// Programming language the exercise is solved in, unchanged if omitted
+	Language *string
+	// Topics the exercise covers, unchanged if omitted
+	Tags []string
 }
 
 // UpdateExercisePayload2 is the payload type of the codelab service
@@ -341,6 +1312,29 @@ type UpdateExercisePayload2 struct {
 	SessionToken string
 }
 
+// Payload for updating a hint, a missing unlock rule is removed
+type UpdateHintPayload struct {
+	// Hint text
+	Content string
+	// Failed attempts after which the hint unlocks
+	UnlockAfterAttempts *int32
+	// Seconds since the student started the exercise after which the hint unlocks
+	UnlockAfterSeconds *int32
+	// Points taken off the score of the attempts made once the hint is revealed
+	Penalty float64
+}
+
+// UpdateHintPayload2 is the payload type of the codelab service UpdateHint
+// method.
+type UpdateHintPayload2 struct {
+	// Hint ID
+	ID int64
+	// Hint data to update
+	Hint *UpdateHintPayload
+	// Authentication session token
+	SessionToken string
+}
+
 // Payload for updating a test
 type UpdateTestPayload struct {
 	// Test input
@@ -349,6 +1343,15 @@ type UpdateTestPayload struct {
 	Output string
 	// Whether test is visible to students
 	Public bool
+	// How the input is passed to solution: a raw string or a JSON array of
+	// arguments
+	InputFormat string
+	// How the output is compared to the expected output
+	Comparison string
+	// Allowed absolute difference for numeric comparison
+	Tolerance float64
+	// Points awarded for passing the test
+	Weight int32
 }
 
 // UpdateTestPayload2 is the payload type of the codelab service UpdateTest
@@ -374,15 +1377,63 @@ type NotFound string
 // Permission denied (only teachers)
 type PermissionDenied string
 
-// Too many requests
-type RateLimited string
-
 // Service temporarily unavailable
 type ServiceUnavailable string
 
 // Unauthorized access
 type Unauthorized string
 
+// Error returns an error description.
+func (e *RateLimit) Error() string {
+	return "Too many executions, try again later"
+}
+
+// ErrorName returns "RateLimit".
+//
+// Deprecated: Use GoaErrorName - https://github.com/goadesign/goa/issues/3105
+func (e *RateLimit) ErrorName() string {
+	return e.GoaErrorName()
+}
+
+// GoaErrorName returns "RateLimit".
+func (e *RateLimit) GoaErrorName() string {
+	return "rate_limited"
+}
+
+// Error returns an error description.
+func (e *SolutionFailure) Error() string {
+	return "The exercise solution does not pass its tests"
+}
+
+// ErrorName returns "SolutionFailure".
+//
+// Deprecated: Use GoaErrorName - https://github.com/goadesign/goa/issues/3105
+func (e *SolutionFailure) ErrorName() string {
+	return e.GoaErrorName()
+}
+
+// GoaErrorName returns "SolutionFailure".
+func (e *SolutionFailure) GoaErrorName() string {
+	return "solution_failed"
+}
+
+// Error returns an error description.
+func (e *SolutionTestFailure) Error() string {
+	return "A test the exercise solution does not pass"
+}
+
+// ErrorName returns "SolutionTestFailure".
+//
+// Deprecated: Use GoaErrorName - https://github.com/goadesign/goa/issues/3105
+func (e *SolutionTestFailure) ErrorName() string {
+	return e.GoaErrorName()
+}
+
+// GoaErrorName returns "SolutionTestFailure".
+func (e *SolutionTestFailure) GoaErrorName() string {
+	return "SolutionTestFailure"
+}
+
 // Error returns an error description.
 func (e InternalError) Error() string {
 	return "Internal server error"
@@ -451,23 +1502,6 @@ func (e PermissionDenied) GoaErrorName() string {
 	return "permission_denied"
 }
 
-// Error returns an error description.
-func (e RateLimited) Error() string {
-	return "Too many requests"
-}
-
-// ErrorName returns "rate_limited".
-//
-// Deprecated: Use GoaErrorName - https://github.com/goadesign/goa/issues/3105
-func (e RateLimited) ErrorName() string {
-	return e.GoaErrorName()
-}
-
-// GoaErrorName returns "rate_limited".
-func (e RateLimited) GoaErrorName() string {
-	return "rate_limited"
-}
-
 // Error returns an error description.
 func (e ServiceUnavailable) Error() string {
 	return "Service temporarily unavailable"
@@ -7,6 +7,8 @@ package codelabdb
 
 import (
 	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
 )
 
 const checkIfAnswerExists = `-- name: CheckIfAnswerExists :one
@@ -67,7 +69,7 @@ func (q *Queries) CreateAnswer(ctx context.Context, arg CreateAnswerParams) erro
 }
 
 const getAnswerByUserAndExercise = `-- name: GetAnswerByUserAndExercise :one
-SELECT id, exercise_id, user_id, completed, created_at, updated_at FROM answers 
+SELECT id, exercise_id, user_id, completed, best_score, best_attempt_id, hints_used, hint_penalty, created_at, updated_at FROM answers 
 WHERE exercise_id = $1 AND user_id = $2
 `
 
@@ -84,6 +86,10 @@ func (q *Queries) GetAnswerByUserAndExercise(ctx context.Context, arg GetAnswerB
 		&i.ExerciseID,
 		&i.UserID,
 		&i.Completed,
+		&i.BestScore,
+		&i.BestAttemptID,
+		&i.HintsUsed,
+		&i.HintPenalty,
 		&i.CreatedAt,
 		&i.UpdatedAt,
 	)
@@ -91,7 +97,7 @@ func (q *Queries) GetAnswerByUserAndExercise(ctx context.Context, arg GetAnswerB
 }
 
 const listAnswersByExercise = `-- name: ListAnswersByExercise :many
-SELECT id, exercise_id, user_id, completed, created_at, updated_at FROM answers 
+SELECT id, exercise_id, user_id, completed, best_score, best_attempt_id, hints_used, hint_penalty, created_at, updated_at FROM answers 
 WHERE exercise_id = $1
 ORDER BY updated_at DESC
 `
@@ -110,6 +116,10 @@ func (q *Queries) ListAnswersByExercise(ctx context.Context, exerciseID int64) (
 			&i.ExerciseID,
 			&i.UserID,
 			&i.Completed,
+			&i.BestScore,
+			&i.BestAttemptID,
+			&i.HintsUsed,
+			&i.HintPenalty,
 			&i.CreatedAt,
 			&i.UpdatedAt,
 		); err != nil {
@@ -124,7 +134,7 @@ func (q *Queries) ListAnswersByExercise(ctx context.Context, exerciseID int64) (
 }
 
 const listAnswersByUser = `-- name: ListAnswersByUser :many
-SELECT id, exercise_id, user_id, completed, created_at, updated_at FROM answers 
+SELECT id, exercise_id, user_id, completed, best_score, best_attempt_id, hints_used, hint_penalty, created_at, updated_at FROM answers 
 WHERE user_id = $1 
 ORDER BY updated_at DESC
 `
@@ -143,6 +153,10 @@ func (q *Queries) ListAnswersByUser(ctx context.Context, userID int64) ([]Answer
 			&i.ExerciseID,
 			&i.UserID,
 			&i.Completed,
+			&i.BestScore,
+			&i.BestAttemptID,
+			&i.HintsUsed,
+			&i.HintPenalty,
 			&i.CreatedAt,
 			&i.UpdatedAt,
 		); err != nil {
@@ -156,6 +170,82 @@ func (q *Queries) ListAnswersByUser(ctx context.Context, userID int64) ([]Answer
 	return items, nil
 }
 
+const recordAnswerHintUsage = `-- name: RecordAnswerHintUsage :exec
+UPDATE answers SET
+    hints_used = hints_used + 1,
+    hint_penalty = LEAST(hint_penalty + $2, 100),
+    updated_at = NOW()
+WHERE id = $1
+`
+
+type RecordAnswerHintUsageParams struct {
+	ID          int64
+	HintPenalty float64
+}
+
+func (q *Queries) RecordAnswerHintUsage(ctx context.Context, arg RecordAnswerHintUsageParams) error {
+	_, err := q.db.Exec(ctx, recordAnswerHintUsage, arg.ID, arg.HintPenalty)
+	return err
+}
+
+const recalculateAnswerScore = `-- name: RecalculateAnswerScore :one
+UPDATE answers SET
+    best_attempt_id = (
+        SELECT a.id FROM attempts a
+        WHERE a.answer_id = answers.id AND a.status <> 'pending'
+        ORDER BY a.score DESC, a.id
+        LIMIT 1
+    ),
+    best_score = COALESCE((
+        SELECT MAX(a.score) FROM attempts a
+        WHERE a.answer_id = answers.id AND a.status <> 'pending'
+    ), 0),
+    completed = EXISTS (
+        SELECT 1 FROM attempts a
+        WHERE a.answer_id = answers.id AND a.success = true
+    ),
+    updated_at = NOW()
+WHERE id = $1
+RETURNING id, exercise_id, user_id, completed, best_score, best_attempt_id, hints_used, hint_penalty, created_at, updated_at
+`
+
+func (q *Queries) RecalculateAnswerScore(ctx context.Context, id int64) (Answer, error) {
+	row := q.db.QueryRow(ctx, recalculateAnswerScore, id)
+	var i Answer
+	err := row.Scan(
+		&i.ID,
+		&i.ExerciseID,
+		&i.UserID,
+		&i.Completed,
+		&i.BestScore,
+		&i.BestAttemptID,
+		&i.HintsUsed,
+		&i.HintPenalty,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const updateAnswerBestScore = `-- name: UpdateAnswerBestScore :exec
+UPDATE answers SET
+    best_score = $2,
+    best_attempt_id = $3,
+    updated_at = NOW()
+WHERE id = $1 AND (best_attempt_id IS NULL OR best_score < $2)
+`
+
+type UpdateAnswerBestScoreParams struct {
+	ID            int64
+	BestScore     float64
+	BestAttemptID pgtype.Int8
+}
+
+func (q *Queries) UpdateAnswerBestScore(ctx context.Context, arg UpdateAnswerBestScoreParams) error {
+	_, err := q.db.Exec(ctx, updateAnswerBestScore, arg.ID, arg.BestScore, arg.BestAttemptID)
+	return err
+}
+
 const updateAnswerCompleted = `-- name: UpdateAnswerCompleted :exec
 UPDATE answers SET
     completed = $3,
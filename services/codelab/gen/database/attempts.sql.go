@@ -61,24 +61,83 @@ func (q *Queries) CountTotalAttemptsByExercise(ctx context.Context, exerciseID i
 	return count, err
 }
 
-const createAttempt = `-- name: CreateAttempt :exec
-INSERT INTO attempts (answer_id, code, success)
-VALUES ($1, $2, $3)
+const createAttempt = `-- name: CreateAttempt :one
+INSERT INTO attempts (answer_id, code, success, status, points, max_points, score, late, exercise_version, message)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+RETURNING id, answer_id, code, success, status, points, max_points, score, late, exercise_version, message, created_at
 `
 
 type CreateAttemptParams struct {
-	AnswerID int64
-	Code     string
-	Success  bool
+	AnswerID        int64
+	Code            string
+	Success         bool
+	Status          string
+	Points          int32
+	MaxPoints       int32
+	Score           float64
+	Late            bool
+	ExerciseVersion int32
+	Message         string
+}
+
+func (q *Queries) CreateAttempt(ctx context.Context, arg CreateAttemptParams) (Attempt, error) {
+	row := q.db.QueryRow(ctx, createAttempt,
+		arg.AnswerID,
+		arg.Code,
+		arg.Success,
+		arg.Status,
+		arg.Points,
+		arg.MaxPoints,
+		arg.Score,
+		arg.Late,
+		arg.ExerciseVersion,
+		arg.Message,
+	)
+	var i Attempt
+	err := row.Scan(
+		&i.ID,
+		&i.AnswerID,
+		&i.Code,
+		&i.Success,
+		&i.Status,
+		&i.Points,
+		&i.MaxPoints,
+		&i.Score,
+		&i.Late,
+		&i.ExerciseVersion,
+		&i.Message,
+		&i.CreatedAt,
+	)
+	return i, err
 }
 
-func (q *Queries) CreateAttempt(ctx context.Context, arg CreateAttemptParams) error {
-	_, err := q.db.Exec(ctx, createAttempt, arg.AnswerID, arg.Code, arg.Success)
+const deleteAttempt = `-- name: DeleteAttempt :exec
+DELETE FROM attempts WHERE id = $1
+`
+
+func (q *Queries) DeleteAttempt(ctx context.Context, id int64) error {
+	_, err := q.db.Exec(ctx, deleteAttempt, id)
 	return err
 }
 
+const failStalePendingAttempts = `-- name: FailStalePendingAttempts :execrows
+UPDATE attempts SET
+    status = 'error',
+    message = $1
+WHERE status = 'pending' AND created_at < NOW() - INTERVAL '10 minutes'
+`
+
+// Attempts pending for ten minutes were lost by a replica that stopped
+func (q *Queries) FailStalePendingAttempts(ctx context.Context, message string) (int64, error) {
+	result, err := q.db.Exec(ctx, failStalePendingAttempts, message)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
 const getAttempt = `-- name: GetAttempt :one
-SELECT id, answer_id, code, success, created_at FROM attempts WHERE id = $1
+SELECT id, answer_id, code, success, status, points, max_points, score, late, exercise_version, message, created_at FROM attempts WHERE id = $1
 `
 
 func (q *Queries) GetAttempt(ctx context.Context, id int64) (Attempt, error) {
@@ -89,13 +148,51 @@ func (q *Queries) GetAttempt(ctx context.Context, id int64) (Attempt, error) {
 		&i.AnswerID,
 		&i.Code,
 		&i.Success,
+		&i.Status,
+		&i.Points,
+		&i.MaxPoints,
+		&i.Score,
+		&i.Late,
+		&i.ExerciseVersion,
+		&i.Message,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getAttemptByUser = `-- name: GetAttemptByUser :one
+SELECT a.id, a.answer_id, a.code, a.success, a.status, a.points, a.max_points, a.score, a.late, a.exercise_version, a.message, a.created_at FROM attempts a
+JOIN answers ans ON a.answer_id = ans.id
+WHERE a.id = $1 AND ans.user_id = $2
+`
+
+type GetAttemptByUserParams struct {
+	ID     int64
+	UserID int64
+}
+
+func (q *Queries) GetAttemptByUser(ctx context.Context, arg GetAttemptByUserParams) (Attempt, error) {
+	row := q.db.QueryRow(ctx, getAttemptByUser, arg.ID, arg.UserID)
+	var i Attempt
+	err := row.Scan(
+		&i.ID,
+		&i.AnswerID,
+		&i.Code,
+		&i.Success,
+		&i.Status,
+		&i.Points,
+		&i.MaxPoints,
+		&i.Score,
+		&i.Late,
+		&i.ExerciseVersion,
+		&i.Message,
 		&i.CreatedAt,
 	)
 	return i, err
 }
 
 const getAttemptsByAnswer = `-- name: GetAttemptsByAnswer :many
-SELECT id, answer_id, code, success, created_at FROM attempts 
+SELECT id, answer_id, code, success, status, points, max_points, score, late, exercise_version, message, created_at FROM attempts 
 WHERE answer_id = $1 
 ORDER BY created_at DESC
 `
@@ -114,6 +211,13 @@ func (q *Queries) GetAttemptsByAnswer(ctx context.Context, answerID int64) ([]At
 			&i.AnswerID,
 			&i.Code,
 			&i.Success,
+			&i.Status,
+			&i.Points,
+			&i.MaxPoints,
+			&i.Score,
+			&i.Late,
+			&i.ExerciseVersion,
+			&i.Message,
 			&i.CreatedAt,
 		); err != nil {
 			return nil, err
@@ -127,7 +231,7 @@ func (q *Queries) GetAttemptsByAnswer(ctx context.Context, answerID int64) ([]At
 }
 
 const getAttemptsByUserAndExercise = `-- name: GetAttemptsByUserAndExercise :many
-SELECT a.id, a.answer_id, a.code, a.success, a.created_at FROM attempts a
+SELECT a.id, a.answer_id, a.code, a.success, a.status, a.points, a.max_points, a.score, a.late, a.exercise_version, a.message, a.created_at FROM attempts a
 JOIN answers ans ON a.answer_id = ans.id
 WHERE ans.user_id = $1 AND ans.exercise_id = $2
 ORDER BY a.created_at DESC
@@ -152,6 +256,13 @@ func (q *Queries) GetAttemptsByUserAndExercise(ctx context.Context, arg GetAttem
 			&i.AnswerID,
 			&i.Code,
 			&i.Success,
+			&i.Status,
+			&i.Points,
+			&i.MaxPoints,
+			&i.Score,
+			&i.Late,
+			&i.ExerciseVersion,
+			&i.Message,
 			&i.CreatedAt,
 		); err != nil {
 			return nil, err
@@ -170,6 +281,13 @@ SELECT
     a.answer_id,
     a.code,
     a.success,
+    a.status,
+    a.points,
+    a.max_points,
+    a.score,
+    a.late,
+    a.exercise_version,
+    a.message,
     a.created_at,
     ans.user_id,
     ans.exercise_id,
@@ -185,6 +303,13 @@ type GetAttemptsWithAnswerInfoRow struct {
 	AnswerID        int64
 	Code            string
 	Success         bool
+	Status          string
+	Points          int32
+	MaxPoints       int32
+	Score           float64
+	Late            bool
+	ExerciseVersion int32
+	Message         string
 	CreatedAt       pgtype.Timestamptz
 	UserID          int64
 	ExerciseID      int64
@@ -205,6 +330,13 @@ func (q *Queries) GetAttemptsWithAnswerInfo(ctx context.Context, exerciseID int6
 			&i.AnswerID,
 			&i.Code,
 			&i.Success,
+			&i.Status,
+			&i.Points,
+			&i.MaxPoints,
+			&i.Score,
+			&i.Late,
+			&i.ExerciseVersion,
+			&i.Message,
 			&i.CreatedAt,
 			&i.UserID,
 			&i.ExerciseID,
@@ -221,7 +353,7 @@ func (q *Queries) GetAttemptsWithAnswerInfo(ctx context.Context, exerciseID int6
 }
 
 const getLatestAttemptByAnswer = `-- name: GetLatestAttemptByAnswer :one
-SELECT id, answer_id, code, success, created_at FROM attempts 
+SELECT id, answer_id, code, success, status, points, max_points, score, late, exercise_version, message, created_at FROM attempts 
 WHERE answer_id = $1 
 ORDER BY created_at DESC 
 LIMIT 1
@@ -235,6 +367,13 @@ func (q *Queries) GetLatestAttemptByAnswer(ctx context.Context, answerID int64)
 		&i.AnswerID,
 		&i.Code,
 		&i.Success,
+		&i.Status,
+		&i.Points,
+		&i.MaxPoints,
+		&i.Score,
+		&i.Late,
+		&i.ExerciseVersion,
+		&i.Message,
 		&i.CreatedAt,
 	)
 	return i, err
@@ -246,6 +385,13 @@ SELECT
     a.answer_id,
     a.code,
     a.success,
+    a.status,
+    a.points,
+    a.max_points,
+    a.score,
+    a.late,
+    a.exercise_version,
+    a.message,
     a.created_at
 FROM attempts a
 JOIN answers ans ON a.answer_id = ans.id
@@ -272,6 +418,13 @@ func (q *Queries) GetUserAttemptsForExercise(ctx context.Context, arg GetUserAtt
 			&i.AnswerID,
 			&i.Code,
 			&i.Success,
+			&i.Status,
+			&i.Points,
+			&i.MaxPoints,
+			&i.Score,
+			&i.Late,
+			&i.ExerciseVersion,
+			&i.Message,
 			&i.CreatedAt,
 		); err != nil {
 			return nil, err
@@ -283,3 +436,74 @@ func (q *Queries) GetUserAttemptsForExercise(ctx context.Context, arg GetUserAtt
 	}
 	return items, nil
 }
+
+const regradeAttempt = `-- name: RegradeAttempt :exec
+UPDATE attempts SET
+    success = $2,
+    status = $3,
+    points = $4,
+    max_points = $5,
+    score = $6,
+    exercise_version = $7,
+    message = $8
+WHERE id = $1
+`
+
+type RegradeAttemptParams struct {
+	ID              int64
+	Success         bool
+	Status          string
+	Points          int32
+	MaxPoints       int32
+	Score           float64
+	ExerciseVersion int32
+	Message         string
+}
+
+func (q *Queries) RegradeAttempt(ctx context.Context, arg RegradeAttemptParams) error {
+	_, err := q.db.Exec(ctx, regradeAttempt,
+		arg.ID,
+		arg.Success,
+		arg.Status,
+		arg.Points,
+		arg.MaxPoints,
+		arg.Score,
+		arg.ExerciseVersion,
+		arg.Message,
+	)
+	return err
+}
+
+const updateAttemptResult = `-- name: UpdateAttemptResult :exec
+UPDATE attempts SET
+    success = $2,
+    status = $3,
+    points = $4,
+    max_points = $5,
+    score = $6,
+    message = $7
+WHERE id = $1
+`
+
+type UpdateAttemptResultParams struct {
+	ID        int64
+	Success   bool
+	Status    string
+	Points    int32
+	MaxPoints int32
+	Score     float64
+	Message   string
+}
+
+func (q *Queries) UpdateAttemptResult(ctx context.Context, arg UpdateAttemptResultParams) error {
+	_, err := q.db.Exec(ctx, updateAttemptResult,
+		arg.ID,
+		arg.Success,
+		arg.Status,
+		arg.Points,
+		arg.MaxPoints,
+		arg.Score,
+		arg.Message,
+	)
+	return err
+}
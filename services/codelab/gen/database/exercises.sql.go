@@ -11,12 +11,41 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
-const createExercise = `-- name: CreateExercise :exec
+const bumpExerciseVersion = `-- name: BumpExerciseVersion :one
+UPDATE exercises SET
+    version = version + 1,
+    updated_at = NOW()
+WHERE id = $1
+RETURNING id, title, description, initial_code, solution, difficulty, language, tags, version, created_by, created_at, updated_at
+`
+
+func (q *Queries) BumpExerciseVersion(ctx context.Context, id int64) (Exercise, error) {
+	row := q.db.QueryRow(ctx, bumpExerciseVersion, id)
+	var i Exercise
+	err := row.Scan(
+		&i.ID,
+		&i.Title,
+		&i.Description,
+		&i.InitialCode,
+		&i.Solution,
+		&i.Difficulty,
+		&i.Language,
+		&i.Tags,
+		&i.Version,
+		&i.CreatedBy,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const createExercise = `-- name: CreateExercise :one
 INSERT INTO exercises (
-    title, description, initial_code, solution, difficulty, created_by
+    title, description, initial_code, solution, difficulty, language, tags, created_by
 ) VALUES (
-    $1, $2, $3, $4, $5, $6
+    $1, $2, $3, $4, $5, $6, $7, $8
 )
+RETURNING id
 `
 
 type CreateExerciseParams struct {
@@ -25,16 +54,44 @@ type CreateExerciseParams struct {
 	InitialCode string
 	Solution    string
 	Difficulty  string
+	Language    string
+	Tags        []string
 	CreatedBy   int64
 }
 
-func (q *Queries) CreateExercise(ctx context.Context, arg CreateExerciseParams) error {
-	_, err := q.db.Exec(ctx, createExercise,
+func (q *Queries) CreateExercise(ctx context.Context, arg CreateExerciseParams) (int64, error) {
+	row := q.db.QueryRow(ctx, createExercise,
 		arg.Title,
 		arg.Description,
 		arg.InitialCode,
 		arg.Solution,
 		arg.Difficulty,
+		arg.Language,
+		arg.Tags,
+		arg.CreatedBy,
+	)
+	var id int64
+	err := row.Scan(&id)
+	return id, err
+}
+
+const createExerciseVersion = `-- name: CreateExerciseVersion :exec
+INSERT INTO exercise_versions (exercise_id, version, snapshot, created_by)
+VALUES ($1, $2, $3, $4)
+`
+
+type CreateExerciseVersionParams struct {
+	ExerciseID int64
+	Version    int32
+	Snapshot   []byte
+	CreatedBy  int64
+}
+
+func (q *Queries) CreateExerciseVersion(ctx context.Context, arg CreateExerciseVersionParams) error {
+	_, err := q.db.Exec(ctx, createExerciseVersion,
+		arg.ExerciseID,
+		arg.Version,
+		arg.Snapshot,
 		arg.CreatedBy,
 	)
 	return err
@@ -50,7 +107,7 @@ func (q *Queries) DeleteExercise(ctx context.Context, id int64) error {
 }
 
 const getExerciseById = `-- name: GetExerciseById :one
-SELECT id, title, description, initial_code, solution, difficulty, created_by, created_at, updated_at FROM exercises WHERE id = $1
+SELECT id, title, description, initial_code, solution, difficulty, language, tags, version, created_by, created_at, updated_at FROM exercises WHERE id = $1
 `
 
 func (q *Queries) GetExerciseById(ctx context.Context, id int64) (Exercise, error) {
@@ -63,6 +120,9 @@ func (q *Queries) GetExerciseById(ctx context.Context, id int64) (Exercise, erro
 		&i.InitialCode,
 		&i.Solution,
 		&i.Difficulty,
+		&i.Language,
+		&i.Tags,
+		&i.Version,
 		&i.CreatedBy,
 		&i.CreatedAt,
 		&i.UpdatedAt,
@@ -77,6 +137,9 @@ SELECT
     description,
     initial_code,
     difficulty,
+    language,
+    tags,
+    version,
     created_by,
     created_at,
     updated_at
@@ -89,6 +152,9 @@ type GetExerciseToResolveByIdRow struct {
 	Description string
 	InitialCode string
 	Difficulty  string
+	Language    string
+	Tags        []string
+	Version     int32
 	CreatedBy   int64
 	CreatedAt   pgtype.Timestamptz
 	UpdatedAt   pgtype.Timestamptz
@@ -103,6 +169,9 @@ func (q *Queries) GetExerciseToResolveById(ctx context.Context, id int64) (GetEx
 		&i.Description,
 		&i.InitialCode,
 		&i.Difficulty,
+		&i.Language,
+		&i.Tags,
+		&i.Version,
 		&i.CreatedBy,
 		&i.CreatedAt,
 		&i.UpdatedAt,
@@ -110,12 +179,106 @@ func (q *Queries) GetExerciseToResolveById(ctx context.Context, id int64) (GetEx
 	return i, err
 }
 
+const getExerciseVersion = `-- name: GetExerciseVersion :one
+SELECT exercise_id, version, snapshot, created_by, created_at FROM exercise_versions
+WHERE exercise_id = $1 AND version = $2
+`
+
+type GetExerciseVersionParams struct {
+	ExerciseID int64
+	Version    int32
+}
+
+func (q *Queries) GetExerciseVersion(ctx context.Context, arg GetExerciseVersionParams) (ExerciseVersion, error) {
+	row := q.db.QueryRow(ctx, getExerciseVersion, arg.ExerciseID, arg.Version)
+	var i ExerciseVersion
+	err := row.Scan(
+		&i.ExerciseID,
+		&i.Version,
+		&i.Snapshot,
+		&i.CreatedBy,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const listExerciseVersions = `-- name: ListExerciseVersions :many
+SELECT
+    v.version,
+    v.created_by,
+    v.created_at,
+    COUNT(a.id) AS attempts
+FROM exercise_versions v
+LEFT JOIN answers ans ON ans.exercise_id = v.exercise_id
+LEFT JOIN attempts a ON a.answer_id = ans.id AND a.exercise_version = v.version
+WHERE v.exercise_id = $1
+GROUP BY v.version, v.created_by, v.created_at
+ORDER BY v.version DESC
+`
+
+type ListExerciseVersionsRow struct {
+	Version   int32
+	CreatedBy int64
+	CreatedAt pgtype.Timestamptz
+	Attempts  int64
+}
+
+func (q *Queries) ListExerciseVersions(ctx context.Context, exerciseID int64) ([]ListExerciseVersionsRow, error) {
+	rows, err := q.db.Query(ctx, listExerciseVersions, exerciseID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListExerciseVersionsRow
+	for rows.Next() {
+		var i ListExerciseVersionsRow
+		if err := rows.Scan(
+			&i.Version,
+			&i.CreatedBy,
+			&i.CreatedAt,
+			&i.Attempts,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listExercises = `-- name: ListExercises :many
-SELECT id, title, description, initial_code, solution, difficulty, created_by, created_at, updated_at FROM exercises ORDER BY created_at DESC
+SELECT id, title, description, initial_code, solution, difficulty, language, tags, version, created_by, created_at, updated_at FROM exercises
+WHERE ($1::TEXT IS NULL OR to_tsvector('simple', title || ' ' || description) @@ websearch_to_tsquery('simple', $1))
+    AND ($2::VARCHAR IS NULL OR difficulty = $2)
+    AND tags @> COALESCE($3::TEXT[], '{}')
+    AND ($4::BIGINT IS NULL OR created_by = $4)
+    AND ($5::TIMESTAMPTZ IS NULL OR (created_at, id) < ($5, $6::BIGINT))
+ORDER BY created_at DESC, id DESC
+LIMIT $7
 `
 
-func (q *Queries) ListExercises(ctx context.Context) ([]Exercise, error) {
-	rows, err := q.db.Query(ctx, listExercises)
+type ListExercisesParams struct {
+	Search         pgtype.Text
+	Difficulty     pgtype.Text
+	Tags           []string
+	CreatedBy      pgtype.Int8
+	AfterCreatedAt pgtype.Timestamptz
+	AfterID        pgtype.Int8
+	RowLimit       int32
+}
+
+func (q *Queries) ListExercises(ctx context.Context, arg ListExercisesParams) ([]Exercise, error) {
+	rows, err := q.db.Query(ctx, listExercises,
+		arg.Search,
+		arg.Difficulty,
+		arg.Tags,
+		arg.CreatedBy,
+		arg.AfterCreatedAt,
+		arg.AfterID,
+		arg.RowLimit,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -130,6 +293,9 @@ func (q *Queries) ListExercises(ctx context.Context) ([]Exercise, error) {
 			&i.InitialCode,
 			&i.Solution,
 			&i.Difficulty,
+			&i.Language,
+			&i.Tags,
+			&i.Version,
 			&i.CreatedBy,
 			&i.CreatedAt,
 			&i.UpdatedAt,
@@ -146,31 +312,69 @@ func (q *Queries) ListExercises(ctx context.Context) ([]Exercise, error) {
 
 const listExercisesToResolve = `-- name: ListExercisesToResolve :many
 SELECT
-    id,
-    title,
-    description,
-    initial_code,
-    difficulty,
-    created_by,
-    created_at,
-    updated_at
-FROM exercises
-ORDER BY created_at DESC
+    e.id,
+    e.title,
+    e.description,
+    e.initial_code,
+    e.difficulty,
+    e.language,
+    e.tags,
+    e.version,
+    e.created_by,
+    e.created_at,
+    e.updated_at,
+    COALESCE(ans.completed, FALSE)::BOOLEAN AS completed
+FROM exercises e
+LEFT JOIN answers ans ON ans.exercise_id = e.id AND ans.user_id = $1
+WHERE ($2::TEXT IS NULL OR to_tsvector('simple', e.title || ' ' || e.description) @@ websearch_to_tsquery('simple', $2))
+    AND ($3::VARCHAR IS NULL OR e.difficulty = $3)
+    AND e.tags @> COALESCE($4::TEXT[], '{}')
+    AND ($5::BIGINT IS NULL OR e.created_by = $5)
+    AND ($6::BOOLEAN IS NULL OR COALESCE(ans.completed, FALSE) = $6)
+    AND ($7::TIMESTAMPTZ IS NULL OR (e.created_at, e.id) < ($7, $8::BIGINT))
+ORDER BY e.created_at DESC, e.id DESC
+LIMIT $9
 `
 
+type ListExercisesToResolveParams struct {
+	UserID         int64
+	Search         pgtype.Text
+	Difficulty     pgtype.Text
+	Tags           []string
+	CreatedBy      pgtype.Int8
+	Completed      pgtype.Bool
+	AfterCreatedAt pgtype.Timestamptz
+	AfterID        pgtype.Int8
+	RowLimit       int32
+}
+
 type ListExercisesToResolveRow struct {
 	ID          int64
 	Title       string
 	Description string
 	InitialCode string
 	Difficulty  string
+	Language    string
+	Tags        []string
+	Version     int32
 	CreatedBy   int64
 	CreatedAt   pgtype.Timestamptz
 	UpdatedAt   pgtype.Timestamptz
+	Completed   bool
 }
 
-func (q *Queries) ListExercisesToResolve(ctx context.Context) ([]ListExercisesToResolveRow, error) {
-	rows, err := q.db.Query(ctx, listExercisesToResolve)
+func (q *Queries) ListExercisesToResolve(ctx context.Context, arg ListExercisesToResolveParams) ([]ListExercisesToResolveRow, error) {
+	rows, err := q.db.Query(ctx, listExercisesToResolve,
+		arg.UserID,
+		arg.Search,
+		arg.Difficulty,
+		arg.Tags,
+		arg.CreatedBy,
+		arg.Completed,
+		arg.AfterCreatedAt,
+		arg.AfterID,
+		arg.RowLimit,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -184,9 +388,13 @@ func (q *Queries) ListExercisesToResolve(ctx context.Context) ([]ListExercisesTo
 			&i.Description,
 			&i.InitialCode,
 			&i.Difficulty,
+			&i.Language,
+			&i.Tags,
+			&i.Version,
 			&i.CreatedBy,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.Completed,
 		); err != nil {
 			return nil, err
 		}
@@ -198,6 +406,38 @@ func (q *Queries) ListExercisesToResolve(ctx context.Context) ([]ListExercisesTo
 	return items, nil
 }
 
+const listTags = `-- name: ListTags :many
+SELECT tag::TEXT AS tag, COUNT(*) AS exercises
+FROM exercises, unnest(tags) AS tag
+GROUP BY tag
+ORDER BY tag
+`
+
+type ListTagsRow struct {
+	Tag       string
+	Exercises int64
+}
+
+func (q *Queries) ListTags(ctx context.Context) ([]ListTagsRow, error) {
+	rows, err := q.db.Query(ctx, listTags)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListTagsRow
+	for rows.Next() {
+		var i ListTagsRow
+		if err := rows.Scan(&i.Tag, &i.Exercises); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const updateExercise = `-- name: UpdateExercise :exec
 UPDATE exercises SET
     title = $2,
@@ -205,6 +445,8 @@ UPDATE exercises SET
     initial_code = $4,
     solution = $5,
     difficulty = $6,
+    language = $7,
+    tags = $8,
     updated_at = NOW()
 WHERE id = $1
 `
@@ -216,6 +458,8 @@ type UpdateExerciseParams struct {
 	InitialCode string
 	Solution    string
 	Difficulty  string
+	Language    string
+	Tags        []string
 }
 
 func (q *Queries) UpdateExercise(ctx context.Context, arg UpdateExerciseParams) error {
@@ -226,6 +470,8 @@ func (q *Queries) UpdateExercise(ctx context.Context, arg UpdateExerciseParams)
 		arg.InitialCode,
 		arg.Solution,
 		arg.Difficulty,
+		arg.Language,
+		arg.Tags,
 	)
 	return err
 }
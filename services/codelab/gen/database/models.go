@@ -9,20 +9,63 @@ import (
 )
 
 type Answer struct {
-	ID         int64
-	ExerciseID int64
-	UserID     int64
-	Completed  bool
-	CreatedAt  pgtype.Timestamptz
-	UpdatedAt  pgtype.Timestamptz
+	ID            int64
+	ExerciseID    int64
+	UserID        int64
+	Completed     bool
+	BestScore     float64
+	BestAttemptID pgtype.Int8
+	HintsUsed     int32
+	HintPenalty   float64
+	CreatedAt     pgtype.Timestamptz
+	UpdatedAt     pgtype.Timestamptz
+}
+
+type Assignment struct {
+	ID          int64
+	Title       string
+	Description string
+	OpensAt     pgtype.Timestamptz
+	ClosesAt    pgtype.Timestamptz
+	AllowLate   bool
+	CreatedBy   int64
+	CreatedAt   pgtype.Timestamptz
+	UpdatedAt   pgtype.Timestamptz
+}
+
+type AssignmentExercise struct {
+	AssignmentID int64
+	ExerciseID   int64
+	Position     int32
 }
 
 type Attempt struct {
-	ID        int64
-	AnswerID  int64
-	Code      string
-	Success   bool
-	CreatedAt pgtype.Timestamptz
+	ID              int64
+	AnswerID        int64
+	Code            string
+	Success         bool
+	Status          string
+	Points          int32
+	MaxPoints       int32
+	Score           float64
+	Late            bool
+	ExerciseVersion int32
+	Message         string
+	CreatedAt       pgtype.Timestamptz
+}
+
+type AttemptTestResult struct {
+	ID             int64
+	AttemptID      int64
+	TestID         pgtype.Int8
+	Status         string
+	ActualOutput   string
+	ExpectedOutput pgtype.Text
+	ErrorMessage   pgtype.Text
+	ConsoleOutput  string
+	StackTrace     pgtype.Text
+	DurationMs     int32
+	CreatedAt      pgtype.Timestamptz
 }
 
 type Exercise struct {
@@ -32,17 +75,92 @@ type Exercise struct {
 	InitialCode string
 	Solution    string
 	Difficulty  string
+	Language    string
+	Tags        []string
+	Version     int32
 	CreatedBy   int64
 	CreatedAt   pgtype.Timestamptz
 	UpdatedAt   pgtype.Timestamptz
 }
 
-type Test struct {
+type ExerciseVersion struct {
+	ExerciseID int64
+	Version    int32
+	Snapshot   []byte
+	CreatedBy  int64
+	CreatedAt  pgtype.Timestamptz
+}
+
+type Hint struct {
+	ID                  int64
+	ExerciseID          int64
+	Position            int32
+	Content             string
+	UnlockAfterAttempts pgtype.Int4
+	UnlockAfterSeconds  pgtype.Int4
+	Penalty             float64
+	CreatedAt           pgtype.Timestamptz
+	UpdatedAt           pgtype.Timestamptz
+}
+
+type HintUsage struct {
+	AnswerID   int64
+	HintID     int64
+	RevealedAt pgtype.Timestamptz
+}
+
+type PlagiarismCheck struct {
+	ExerciseID int64
+	CheckedAt  pgtype.Timestamptz
+}
+
+type PlagiarismMatch struct {
 	ID         int64
-	Input      string
-	Output     string
-	Public     bool
 	ExerciseID int64
+	AttemptAID int64
+	UserAID    int64
+	AttemptBID int64
+	UserBID    int64
+	Similarity float64
+	Regions    []byte
 	CreatedAt  pgtype.Timestamptz
-	UpdatedAt  pgtype.Timestamptz
+}
+
+type Regrade struct {
+	ID                  int64
+	ExerciseID          int64
+	ExerciseVersion     int32
+	Status              string
+	TotalAttempts       int32
+	RegradedAttempts    int32
+	ErroredAttempts     int32
+	NewlyPassedAttempts int32
+	NewlyFailedAttempts int32
+	ErrorMessage        pgtype.Text
+	CreatedBy           int64
+	CreatedAt           pgtype.Timestamptz
+	UpdatedAt           pgtype.Timestamptz
+	FinishedAt          pgtype.Timestamptz
+}
+
+type RegradeChange struct {
+	RegradeID     int64
+	UserID        int64
+	Completed     bool
+	PreviousScore float64
+	Score         float64
+}
+
+type Test struct {
+	ID          int64
+	Input       string
+	Output      string
+	Public      bool
+	Weight      int32
+	InputFormat string
+	Comparison  string
+	Tolerance   float64
+	ExerciseID  int64
+	CreatedAt   pgtype.Timestamptz
+	UpdatedAt   pgtype.Timestamptz
 }
@@ -10,15 +10,19 @@ import (
 )
 
 const createTest = `-- name: CreateTest :exec
-INSERT INTO tests (input, output, public, exercise_id)
-VALUES ($1, $2, $3, $4)
+INSERT INTO tests (input, output, public, weight, input_format, comparison, tolerance, exercise_id)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
 `
 
 type CreateTestParams struct {
-	Input      string
-	Output     string
-	Public     bool
-	ExerciseID int64
+	Input       string
+	Output      string
+	Public      bool
+	Weight      int32
+	InputFormat string
+	Comparison  string
+	Tolerance   float64
+	ExerciseID  int64
 }
 
 func (q *Queries) CreateTest(ctx context.Context, arg CreateTestParams) error {
@@ -26,6 +30,10 @@ func (q *Queries) CreateTest(ctx context.Context, arg CreateTestParams) error {
 		arg.Input,
 		arg.Output,
 		arg.Public,
+		arg.Weight,
+		arg.InputFormat,
+		arg.Comparison,
+		arg.Tolerance,
 		arg.ExerciseID,
 	)
 	return err
@@ -50,7 +58,7 @@ func (q *Queries) DeleteTestsByExercise(ctx context.Context, exerciseID int64) e
 }
 
 const getHiddenTestsByExercise = `-- name: GetHiddenTestsByExercise :many
-SELECT id, input, output, public, exercise_id, created_at, updated_at FROM tests 
+SELECT id, input, output, public, weight, input_format, comparison, tolerance, exercise_id, created_at, updated_at FROM tests 
 WHERE exercise_id = $1 AND public = false 
 ORDER BY created_at
 `
@@ -69,6 +77,10 @@ func (q *Queries) GetHiddenTestsByExercise(ctx context.Context, exerciseID int64
 			&i.Input,
 			&i.Output,
 			&i.Public,
+			&i.Weight,
+			&i.InputFormat,
+			&i.Comparison,
+			&i.Tolerance,
 			&i.ExerciseID,
 			&i.CreatedAt,
 			&i.UpdatedAt,
@@ -84,7 +96,7 @@ func (q *Queries) GetHiddenTestsByExercise(ctx context.Context, exerciseID int64
 }
 
 const getPublicTestsByExercise = `-- name: GetPublicTestsByExercise :many
-SELECT id, input, output, public, exercise_id, created_at, updated_at FROM tests 
+SELECT id, input, output, public, weight, input_format, comparison, tolerance, exercise_id, created_at, updated_at FROM tests 
 WHERE exercise_id = $1 AND public = true 
 ORDER BY created_at
 `
@@ -103,6 +115,10 @@ func (q *Queries) GetPublicTestsByExercise(ctx context.Context, exerciseID int64
 			&i.Input,
 			&i.Output,
 			&i.Public,
+			&i.Weight,
+			&i.InputFormat,
+			&i.Comparison,
+			&i.Tolerance,
 			&i.ExerciseID,
 			&i.CreatedAt,
 			&i.UpdatedAt,
@@ -117,8 +133,31 @@ func (q *Queries) GetPublicTestsByExercise(ctx context.Context, exerciseID int64
 	return items, nil
 }
 
+const getTestById = `-- name: GetTestById :one
+SELECT id, input, output, public, weight, input_format, comparison, tolerance, exercise_id, created_at, updated_at FROM tests WHERE id = $1
+`
+
+func (q *Queries) GetTestById(ctx context.Context, id int64) (Test, error) {
+	row := q.db.QueryRow(ctx, getTestById, id)
+	var i Test
+	err := row.Scan(
+		&i.ID,
+		&i.Input,
+		&i.Output,
+		&i.Public,
+		&i.Weight,
+		&i.InputFormat,
+		&i.Comparison,
+		&i.Tolerance,
+		&i.ExerciseID,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
 const getTestsByExercise = `-- name: GetTestsByExercise :many
-SELECT id, input, output, public, exercise_id, created_at, updated_at FROM tests 
+SELECT id, input, output, public, weight, input_format, comparison, tolerance, exercise_id, created_at, updated_at FROM tests 
 WHERE exercise_id = $1 
 ORDER BY created_at
 `
@@ -137,6 +176,10 @@ func (q *Queries) GetTestsByExercise(ctx context.Context, exerciseID int64) ([]T
 			&i.Input,
 			&i.Output,
 			&i.Public,
+			&i.Weight,
+			&i.InputFormat,
+			&i.Comparison,
+			&i.Tolerance,
 			&i.ExerciseID,
 			&i.CreatedAt,
 			&i.UpdatedAt,
@@ -156,15 +199,23 @@ UPDATE tests SET
     input = $2,
     output = $3,
     public = $4,
+    weight = $5,
+    input_format = $6,
+    comparison = $7,
+    tolerance = $8,
     updated_at = NOW()
 WHERE id = $1
 `
 
 type UpdateTestParams struct {
-	ID     int64
-	Input  string
-	Output string
-	Public bool
+	ID          int64
+	Input       string
+	Output      string
+	Public      bool
+	Weight      int32
+	InputFormat string
+	Comparison  string
+	Tolerance   float64
 }
 
 func (q *Queries) UpdateTest(ctx context.Context, arg UpdateTestParams) error {
@@ -173,6 +224,10 @@ func (q *Queries) UpdateTest(ctx context.Context, arg UpdateTestParams) error {
 		arg.Input,
 		arg.Output,
 		arg.Public,
+		arg.Weight,
+		arg.InputFormat,
+		arg.Comparison,
+		arg.Tolerance,
 	)
 	return err
 }
@@ -24,7 +24,7 @@ import (
 //
 //	command (subcommand1|subcommand2|...)
 func UsageCommands() string {
-	return `codelab (create-exercise|get-exercise|list-exercises|update-exercise|delete-exercise|create-test|get-tests-by-exercise|update-test|delete-test|get-exercise-for-student|list-exercises-for-students|create-attempt|get-attempts-by-user-and-exercise|get-answer-by-user-and-exercise)
+	return `codelab (create-exercise|get-exercise|list-exercises|list-tags|update-exercise|delete-exercise|export-exercises|import-exercises|create-test|get-tests-by-exercise|update-test|delete-test|list-exercise-versions|diff-exercise-versions|rollback-exercise|regrade-exercise|get-regrade|create-hint|get-hints-by-exercise|update-hint|delete-hint|create-assignment|get-assignment|list-assignments|update-assignment|delete-assignment|get-assignment-gradebook|get-exercise-stats|get-student-exercise-stats|get-plagiarism-report|get-exercise-for-student|list-exercises-for-students|create-attempt|submit-attempt|stream-attempt-events|run-code|get-attempts-by-user-and-exercise|get-hints-for-student|request-hint|list-assignments-for-students|get-assignment-progress|get-answer-by-user-and-exercise)
 `
 }
 
@@ -35,9 +35,14 @@ func UsageExamples() string {
       "description": "Write a function that returns the sum of two numbers",
       "difficulty": "easy",
       "initial_code": "def sum_two_numbers(a, b):\n    # Write your code here\n    pass",
+      "language": "javascript",
       "solution": "def sum_two_numbers(a, b):\n    return a + b",
+      "tags": [
+         "recursion",
+         "strings"
+      ],
       "title": "Sum Two Numbers"
-   }' --session-token "Molestiae et."` + "\n" +
+   }' --session-token "Ut eum nisi."` + "\n" +
 		""
 }
 
@@ -49,6 +54,8 @@ func ParseEndpoint(
 	enc func(*http.Request) goahttp.Encoder,
 	dec func(*http.Response) goahttp.Decoder,
 	restore bool,
+	dialer goahttp.Dialer,
+	codelabConfigurer *codelabc.ConnConfigurer,
 ) (goa.Endpoint, any, error) {
 	var (
 		codelabFlags = flag.NewFlagSet("codelab", flag.ContinueOnError)
@@ -62,8 +69,17 @@ func ParseEndpoint(
 		codelabGetExerciseSessionTokenFlag = codelabGetExerciseFlags.String("session-token", "REQUIRED", "")
 
 		codelabListExercisesFlags            = flag.NewFlagSet("list-exercises", flag.ExitOnError)
+		codelabListExercisesSearchFlag       = codelabListExercisesFlags.String("search", "", "")
+		codelabListExercisesDifficultyFlag   = codelabListExercisesFlags.String("difficulty", "", "")
+		codelabListExercisesTagsFlag         = codelabListExercisesFlags.String("tags", "", "")
+		codelabListExercisesCreatedByFlag    = codelabListExercisesFlags.String("created-by", "", "")
+		codelabListExercisesCursorFlag       = codelabListExercisesFlags.String("cursor", "", "")
+		codelabListExercisesLimitFlag        = codelabListExercisesFlags.String("limit", "50", "")
 		codelabListExercisesSessionTokenFlag = codelabListExercisesFlags.String("session-token", "REQUIRED", "")
 
+		codelabListTagsFlags            = flag.NewFlagSet("list-tags", flag.ExitOnError)
+		codelabListTagsSessionTokenFlag = codelabListTagsFlags.String("session-token", "REQUIRED", "")
+
 		codelabUpdateExerciseFlags            = flag.NewFlagSet("update-exercise", flag.ExitOnError)
 		codelabUpdateExerciseBodyFlag         = codelabUpdateExerciseFlags.String("body", "REQUIRED", "")
 		codelabUpdateExerciseIDFlag           = codelabUpdateExerciseFlags.String("id", "REQUIRED", "Exercise ID")
@@ -73,6 +89,15 @@ func ParseEndpoint(
 		codelabDeleteExerciseIDFlag           = codelabDeleteExerciseFlags.String("id", "REQUIRED", "Exercise ID")
 		codelabDeleteExerciseSessionTokenFlag = codelabDeleteExerciseFlags.String("session-token", "REQUIRED", "")
 
+		codelabExportExercisesFlags            = flag.NewFlagSet("export-exercises", flag.ExitOnError)
+		codelabExportExercisesExerciseIdsFlag  = codelabExportExercisesFlags.String("exercise-ids", "REQUIRED", "")
+		codelabExportExercisesFormatFlag       = codelabExportExercisesFlags.String("format", "json", "")
+		codelabExportExercisesSessionTokenFlag = codelabExportExercisesFlags.String("session-token", "REQUIRED", "")
+
+		codelabImportExercisesFlags            = flag.NewFlagSet("import-exercises", flag.ExitOnError)
+		codelabImportExercisesBodyFlag         = codelabImportExercisesFlags.String("body", "REQUIRED", "")
+		codelabImportExercisesSessionTokenFlag = codelabImportExercisesFlags.String("session-token", "REQUIRED", "")
+
 		codelabCreateTestFlags            = flag.NewFlagSet("create-test", flag.ExitOnError)
 		codelabCreateTestBodyFlag         = codelabCreateTestFlags.String("body", "REQUIRED", "")
 		codelabCreateTestSessionTokenFlag = codelabCreateTestFlags.String("session-token", "REQUIRED", "")
@@ -90,22 +115,135 @@ func ParseEndpoint(
 		codelabDeleteTestIDFlag           = codelabDeleteTestFlags.String("id", "REQUIRED", "Test ID")
 		codelabDeleteTestSessionTokenFlag = codelabDeleteTestFlags.String("session-token", "REQUIRED", "")
 
+		codelabListExerciseVersionsFlags            = flag.NewFlagSet("list-exercise-versions", flag.ExitOnError)
+		codelabListExerciseVersionsExerciseIDFlag   = codelabListExerciseVersionsFlags.String("exercise-id", "REQUIRED", "Exercise ID")
+		codelabListExerciseVersionsSessionTokenFlag = codelabListExerciseVersionsFlags.String("session-token", "REQUIRED", "")
+
+		codelabDiffExerciseVersionsFlags            = flag.NewFlagSet("diff-exercise-versions", flag.ExitOnError)
+		codelabDiffExerciseVersionsExerciseIDFlag   = codelabDiffExerciseVersionsFlags.String("exercise-id", "REQUIRED", "Exercise ID")
+		codelabDiffExerciseVersionsFromFlag         = codelabDiffExerciseVersionsFlags.String("from", "REQUIRED", "")
+		codelabDiffExerciseVersionsToFlag           = codelabDiffExerciseVersionsFlags.String("to", "REQUIRED", "")
+		codelabDiffExerciseVersionsSessionTokenFlag = codelabDiffExerciseVersionsFlags.String("session-token", "REQUIRED", "")
+
+		codelabRollbackExerciseFlags            = flag.NewFlagSet("rollback-exercise", flag.ExitOnError)
+		codelabRollbackExerciseExerciseIDFlag   = codelabRollbackExerciseFlags.String("exercise-id", "REQUIRED", "Exercise ID")
+		codelabRollbackExerciseVersionFlag      = codelabRollbackExerciseFlags.String("version", "REQUIRED", "Version to restore")
+		codelabRollbackExerciseSessionTokenFlag = codelabRollbackExerciseFlags.String("session-token", "REQUIRED", "")
+
+		codelabRegradeExerciseFlags            = flag.NewFlagSet("regrade-exercise", flag.ExitOnError)
+		codelabRegradeExerciseExerciseIDFlag   = codelabRegradeExerciseFlags.String("exercise-id", "REQUIRED", "Exercise ID")
+		codelabRegradeExerciseSessionTokenFlag = codelabRegradeExerciseFlags.String("session-token", "REQUIRED", "")
+
+		codelabGetRegradeFlags            = flag.NewFlagSet("get-regrade", flag.ExitOnError)
+		codelabGetRegradeIDFlag           = codelabGetRegradeFlags.String("id", "REQUIRED", "Regrade ID")
+		codelabGetRegradeSessionTokenFlag = codelabGetRegradeFlags.String("session-token", "REQUIRED", "")
+
+		codelabCreateHintFlags            = flag.NewFlagSet("create-hint", flag.ExitOnError)
+		codelabCreateHintBodyFlag         = codelabCreateHintFlags.String("body", "REQUIRED", "")
+		codelabCreateHintSessionTokenFlag = codelabCreateHintFlags.String("session-token", "REQUIRED", "")
+
+		codelabGetHintsByExerciseFlags            = flag.NewFlagSet("get-hints-by-exercise", flag.ExitOnError)
+		codelabGetHintsByExerciseExerciseIDFlag   = codelabGetHintsByExerciseFlags.String("exercise-id", "REQUIRED", "Exercise ID")
+		codelabGetHintsByExerciseSessionTokenFlag = codelabGetHintsByExerciseFlags.String("session-token", "REQUIRED", "")
+
+		codelabUpdateHintFlags            = flag.NewFlagSet("update-hint", flag.ExitOnError)
+		codelabUpdateHintBodyFlag         = codelabUpdateHintFlags.String("body", "REQUIRED", "")
+		codelabUpdateHintIDFlag           = codelabUpdateHintFlags.String("id", "REQUIRED", "Hint ID")
+		codelabUpdateHintSessionTokenFlag = codelabUpdateHintFlags.String("session-token", "REQUIRED", "")
+
+		codelabDeleteHintFlags            = flag.NewFlagSet("delete-hint", flag.ExitOnError)
+		codelabDeleteHintIDFlag           = codelabDeleteHintFlags.String("id", "REQUIRED", "Hint ID")
+		codelabDeleteHintSessionTokenFlag = codelabDeleteHintFlags.String("session-token", "REQUIRED", "")
+
+		codelabCreateAssignmentFlags            = flag.NewFlagSet("create-assignment", flag.ExitOnError)
+		codelabCreateAssignmentBodyFlag         = codelabCreateAssignmentFlags.String("body", "REQUIRED", "")
+		codelabCreateAssignmentSessionTokenFlag = codelabCreateAssignmentFlags.String("session-token", "REQUIRED", "")
+
+		codelabGetAssignmentFlags            = flag.NewFlagSet("get-assignment", flag.ExitOnError)
+		codelabGetAssignmentIDFlag           = codelabGetAssignmentFlags.String("id", "REQUIRED", "Assignment ID")
+		codelabGetAssignmentSessionTokenFlag = codelabGetAssignmentFlags.String("session-token", "REQUIRED", "")
+
+		codelabListAssignmentsFlags            = flag.NewFlagSet("list-assignments", flag.ExitOnError)
+		codelabListAssignmentsSessionTokenFlag = codelabListAssignmentsFlags.String("session-token", "REQUIRED", "")
+
+		codelabUpdateAssignmentFlags            = flag.NewFlagSet("update-assignment", flag.ExitOnError)
+		codelabUpdateAssignmentBodyFlag         = codelabUpdateAssignmentFlags.String("body", "REQUIRED", "")
+		codelabUpdateAssignmentIDFlag           = codelabUpdateAssignmentFlags.String("id", "REQUIRED", "Assignment ID")
+		codelabUpdateAssignmentSessionTokenFlag = codelabUpdateAssignmentFlags.String("session-token", "REQUIRED", "")
+
+		codelabDeleteAssignmentFlags            = flag.NewFlagSet("delete-assignment", flag.ExitOnError)
+		codelabDeleteAssignmentIDFlag           = codelabDeleteAssignmentFlags.String("id", "REQUIRED", "Assignment ID")
+		codelabDeleteAssignmentSessionTokenFlag = codelabDeleteAssignmentFlags.String("session-token", "REQUIRED", "")
+
+		codelabGetAssignmentGradebookFlags            = flag.NewFlagSet("get-assignment-gradebook", flag.ExitOnError)
+		codelabGetAssignmentGradebookIDFlag           = codelabGetAssignmentGradebookFlags.String("id", "REQUIRED", "Assignment ID")
+		codelabGetAssignmentGradebookSessionTokenFlag = codelabGetAssignmentGradebookFlags.String("session-token", "REQUIRED", "")
+
+		codelabGetExerciseStatsFlags            = flag.NewFlagSet("get-exercise-stats", flag.ExitOnError)
+		codelabGetExerciseStatsExerciseIDFlag   = codelabGetExerciseStatsFlags.String("exercise-id", "REQUIRED", "Exercise ID")
+		codelabGetExerciseStatsSessionTokenFlag = codelabGetExerciseStatsFlags.String("session-token", "REQUIRED", "")
+
+		codelabGetStudentExerciseStatsFlags            = flag.NewFlagSet("get-student-exercise-stats", flag.ExitOnError)
+		codelabGetStudentExerciseStatsExerciseIDFlag   = codelabGetStudentExerciseStatsFlags.String("exercise-id", "REQUIRED", "Exercise ID")
+		codelabGetStudentExerciseStatsUserIDFlag       = codelabGetStudentExerciseStatsFlags.String("user-id", "REQUIRED", "Student user ID")
+		codelabGetStudentExerciseStatsSessionTokenFlag = codelabGetStudentExerciseStatsFlags.String("session-token", "REQUIRED", "")
+
+		codelabGetPlagiarismReportFlags             = flag.NewFlagSet("get-plagiarism-report", flag.ExitOnError)
+		codelabGetPlagiarismReportExerciseIDFlag    = codelabGetPlagiarismReportFlags.String("exercise-id", "REQUIRED", "Exercise ID")
+		codelabGetPlagiarismReportMinSimilarityFlag = codelabGetPlagiarismReportFlags.String("min-similarity", "", "")
+		codelabGetPlagiarismReportSessionTokenFlag  = codelabGetPlagiarismReportFlags.String("session-token", "REQUIRED", "")
+
 		codelabGetExerciseForStudentFlags            = flag.NewFlagSet("get-exercise-for-student", flag.ExitOnError)
 		codelabGetExerciseForStudentIDFlag           = codelabGetExerciseForStudentFlags.String("id", "REQUIRED", "Exercise ID")
 		codelabGetExerciseForStudentSessionTokenFlag = codelabGetExerciseForStudentFlags.String("session-token", "REQUIRED", "")
 
 		codelabListExercisesForStudentsFlags            = flag.NewFlagSet("list-exercises-for-students", flag.ExitOnError)
+		codelabListExercisesForStudentsSearchFlag       = codelabListExercisesForStudentsFlags.String("search", "", "")
+		codelabListExercisesForStudentsDifficultyFlag   = codelabListExercisesForStudentsFlags.String("difficulty", "", "")
+		codelabListExercisesForStudentsTagsFlag         = codelabListExercisesForStudentsFlags.String("tags", "", "")
+		codelabListExercisesForStudentsCreatedByFlag    = codelabListExercisesForStudentsFlags.String("created-by", "", "")
+		codelabListExercisesForStudentsCursorFlag       = codelabListExercisesForStudentsFlags.String("cursor", "", "")
+		codelabListExercisesForStudentsLimitFlag        = codelabListExercisesForStudentsFlags.String("limit", "50", "")
+		codelabListExercisesForStudentsCompletedFlag    = codelabListExercisesForStudentsFlags.String("completed", "", "")
 		codelabListExercisesForStudentsSessionTokenFlag = codelabListExercisesForStudentsFlags.String("session-token", "REQUIRED", "")
 
 		codelabCreateAttemptFlags            = flag.NewFlagSet("create-attempt", flag.ExitOnError)
 		codelabCreateAttemptBodyFlag         = codelabCreateAttemptFlags.String("body", "REQUIRED", "")
 		codelabCreateAttemptSessionTokenFlag = codelabCreateAttemptFlags.String("session-token", "REQUIRED", "")
 
+		codelabSubmitAttemptFlags            = flag.NewFlagSet("submit-attempt", flag.ExitOnError)
+		codelabSubmitAttemptBodyFlag         = codelabSubmitAttemptFlags.String("body", "REQUIRED", "")
+		codelabSubmitAttemptSessionTokenFlag = codelabSubmitAttemptFlags.String("session-token", "REQUIRED", "")
+
+		codelabStreamAttemptEventsFlags            = flag.NewFlagSet("stream-attempt-events", flag.ExitOnError)
+		codelabStreamAttemptEventsIDFlag           = codelabStreamAttemptEventsFlags.String("id", "REQUIRED", "Attempt ID")
+		codelabStreamAttemptEventsSessionTokenFlag = codelabStreamAttemptEventsFlags.String("session-token", "REQUIRED", "")
+
+		codelabRunCodeFlags            = flag.NewFlagSet("run-code", flag.ExitOnError)
+		codelabRunCodeBodyFlag         = codelabRunCodeFlags.String("body", "REQUIRED", "")
+		codelabRunCodeExerciseIDFlag   = codelabRunCodeFlags.String("exercise-id", "REQUIRED", "Exercise ID")
+		codelabRunCodeSessionTokenFlag = codelabRunCodeFlags.String("session-token", "REQUIRED", "")
+
 		codelabGetAttemptsByUserAndExerciseFlags            = flag.NewFlagSet("get-attempts-by-user-and-exercise", flag.ExitOnError)
 		codelabGetAttemptsByUserAndExerciseUserIDFlag       = codelabGetAttemptsByUserAndExerciseFlags.String("user-id", "REQUIRED", "User ID")
 		codelabGetAttemptsByUserAndExerciseExerciseIDFlag   = codelabGetAttemptsByUserAndExerciseFlags.String("exercise-id", "REQUIRED", "Exercise ID")
 		codelabGetAttemptsByUserAndExerciseSessionTokenFlag = codelabGetAttemptsByUserAndExerciseFlags.String("session-token", "REQUIRED", "")
 
+		codelabGetHintsForStudentFlags            = flag.NewFlagSet("get-hints-for-student", flag.ExitOnError)
+		codelabGetHintsForStudentExerciseIDFlag   = codelabGetHintsForStudentFlags.String("exercise-id", "REQUIRED", "Exercise ID")
+		codelabGetHintsForStudentSessionTokenFlag = codelabGetHintsForStudentFlags.String("session-token", "REQUIRED", "")
+
+		codelabRequestHintFlags            = flag.NewFlagSet("request-hint", flag.ExitOnError)
+		codelabRequestHintExerciseIDFlag   = codelabRequestHintFlags.String("exercise-id", "REQUIRED", "Exercise ID")
+		codelabRequestHintSessionTokenFlag = codelabRequestHintFlags.String("session-token", "REQUIRED", "")
+
+		codelabListAssignmentsForStudentsFlags            = flag.NewFlagSet("list-assignments-for-students", flag.ExitOnError)
+		codelabListAssignmentsForStudentsSessionTokenFlag = codelabListAssignmentsForStudentsFlags.String("session-token", "REQUIRED", "")
+
+		codelabGetAssignmentProgressFlags            = flag.NewFlagSet("get-assignment-progress", flag.ExitOnError)
+		codelabGetAssignmentProgressIDFlag           = codelabGetAssignmentProgressFlags.String("id", "REQUIRED", "Assignment ID")
+		codelabGetAssignmentProgressSessionTokenFlag = codelabGetAssignmentProgressFlags.String("session-token", "REQUIRED", "")
+
 		codelabGetAnswerByUserAndExerciseFlags            = flag.NewFlagSet("get-answer-by-user-and-exercise", flag.ExitOnError)
 		codelabGetAnswerByUserAndExerciseUserIDFlag       = codelabGetAnswerByUserAndExerciseFlags.String("user-id", "REQUIRED", "User ID")
 		codelabGetAnswerByUserAndExerciseExerciseIDFlag   = codelabGetAnswerByUserAndExerciseFlags.String("exercise-id", "REQUIRED", "Exercise ID")
@@ -115,16 +253,44 @@ func ParseEndpoint(
 	codelabCreateExerciseFlags.Usage = codelabCreateExerciseUsage
 	codelabGetExerciseFlags.Usage = codelabGetExerciseUsage
 	codelabListExercisesFlags.Usage = codelabListExercisesUsage
+	codelabListTagsFlags.Usage = codelabListTagsUsage
 	codelabUpdateExerciseFlags.Usage = codelabUpdateExerciseUsage
 	codelabDeleteExerciseFlags.Usage = codelabDeleteExerciseUsage
+	codelabExportExercisesFlags.Usage = codelabExportExercisesUsage
+	codelabImportExercisesFlags.Usage = codelabImportExercisesUsage
 	codelabCreateTestFlags.Usage = codelabCreateTestUsage
 	codelabGetTestsByExerciseFlags.Usage = codelabGetTestsByExerciseUsage
 	codelabUpdateTestFlags.Usage = codelabUpdateTestUsage
 	codelabDeleteTestFlags.Usage = codelabDeleteTestUsage
+	codelabListExerciseVersionsFlags.Usage = codelabListExerciseVersionsUsage
+	codelabDiffExerciseVersionsFlags.Usage = codelabDiffExerciseVersionsUsage
+	codelabRollbackExerciseFlags.Usage = codelabRollbackExerciseUsage
+	codelabRegradeExerciseFlags.Usage = codelabRegradeExerciseUsage
+	codelabGetRegradeFlags.Usage = codelabGetRegradeUsage
+	codelabCreateHintFlags.Usage = codelabCreateHintUsage
+	codelabGetHintsByExerciseFlags.Usage = codelabGetHintsByExerciseUsage
+	codelabUpdateHintFlags.Usage = codelabUpdateHintUsage
+	codelabDeleteHintFlags.Usage = codelabDeleteHintUsage
+	codelabCreateAssignmentFlags.Usage = codelabCreateAssignmentUsage
+	codelabGetAssignmentFlags.Usage = codelabGetAssignmentUsage
+	codelabListAssignmentsFlags.Usage = codelabListAssignmentsUsage
+	codelabUpdateAssignmentFlags.Usage = codelabUpdateAssignmentUsage
+	codelabDeleteAssignmentFlags.Usage = codelabDeleteAssignmentUsage
+	codelabGetAssignmentGradebookFlags.Usage = codelabGetAssignmentGradebookUsage
+	codelabGetExerciseStatsFlags.Usage = codelabGetExerciseStatsUsage
+	codelabGetStudentExerciseStatsFlags.Usage = codelabGetStudentExerciseStatsUsage
+	codelabGetPlagiarismReportFlags.Usage = codelabGetPlagiarismReportUsage
 	codelabGetExerciseForStudentFlags.Usage = codelabGetExerciseForStudentUsage
 	codelabListExercisesForStudentsFlags.Usage = codelabListExercisesForStudentsUsage
 	codelabCreateAttemptFlags.Usage = codelabCreateAttemptUsage
+	codelabSubmitAttemptFlags.Usage = codelabSubmitAttemptUsage
+	codelabStreamAttemptEventsFlags.Usage = codelabStreamAttemptEventsUsage
+	codelabRunCodeFlags.Usage = codelabRunCodeUsage
 	codelabGetAttemptsByUserAndExerciseFlags.Usage = codelabGetAttemptsByUserAndExerciseUsage
+	codelabGetHintsForStudentFlags.Usage = codelabGetHintsForStudentUsage
+	codelabRequestHintFlags.Usage = codelabRequestHintUsage
+	codelabListAssignmentsForStudentsFlags.Usage = codelabListAssignmentsForStudentsUsage
+	codelabGetAssignmentProgressFlags.Usage = codelabGetAssignmentProgressUsage
 	codelabGetAnswerByUserAndExerciseFlags.Usage = codelabGetAnswerByUserAndExerciseUsage
 
 	if err := flag.CommandLine.Parse(os.Args[1:]); err != nil {
@@ -170,12 +336,21 @@ func ParseEndpoint(
 			case "list-exercises":
 				epf = codelabListExercisesFlags
 
+			case "list-tags":
+				epf = codelabListTagsFlags
+
 			case "update-exercise":
 				epf = codelabUpdateExerciseFlags
 
 			case "delete-exercise":
 				epf = codelabDeleteExerciseFlags
 
+			case "export-exercises":
+				epf = codelabExportExercisesFlags
+
+			case "import-exercises":
+				epf = codelabImportExercisesFlags
+
 			case "create-test":
 				epf = codelabCreateTestFlags
 
@@ -188,6 +363,60 @@ func ParseEndpoint(
 			case "delete-test":
 				epf = codelabDeleteTestFlags
 
+			case "list-exercise-versions":
+				epf = codelabListExerciseVersionsFlags
+
+			case "diff-exercise-versions":
+				epf = codelabDiffExerciseVersionsFlags
+
+			case "rollback-exercise":
+				epf = codelabRollbackExerciseFlags
+
+			case "regrade-exercise":
+				epf = codelabRegradeExerciseFlags
+
+			case "get-regrade":
+				epf = codelabGetRegradeFlags
+
+			case "create-hint":
+				epf = codelabCreateHintFlags
+
+			case "get-hints-by-exercise":
+				epf = codelabGetHintsByExerciseFlags
+
+			case "update-hint":
+				epf = codelabUpdateHintFlags
+
+			case "delete-hint":
+				epf = codelabDeleteHintFlags
+
+			case "create-assignment":
+				epf = codelabCreateAssignmentFlags
+
+			case "get-assignment":
+				epf = codelabGetAssignmentFlags
+
+			case "list-assignments":
+				epf = codelabListAssignmentsFlags
+
+			case "update-assignment":
+				epf = codelabUpdateAssignmentFlags
+
+			case "delete-assignment":
+				epf = codelabDeleteAssignmentFlags
+
+			case "get-assignment-gradebook":
+				epf = codelabGetAssignmentGradebookFlags
+
+			case "get-exercise-stats":
+				epf = codelabGetExerciseStatsFlags
+
+			case "get-student-exercise-stats":
+				epf = codelabGetStudentExerciseStatsFlags
+
+			case "get-plagiarism-report":
+				epf = codelabGetPlagiarismReportFlags
+
 			case "get-exercise-for-student":
 				epf = codelabGetExerciseForStudentFlags
 
@@ -197,9 +426,30 @@ func ParseEndpoint(
 			case "create-attempt":
 				epf = codelabCreateAttemptFlags
 
+			case "submit-attempt":
+				epf = codelabSubmitAttemptFlags
+
+			case "stream-attempt-events":
+				epf = codelabStreamAttemptEventsFlags
+
+			case "run-code":
+				epf = codelabRunCodeFlags
+
 			case "get-attempts-by-user-and-exercise":
 				epf = codelabGetAttemptsByUserAndExerciseFlags
 
+			case "get-hints-for-student":
+				epf = codelabGetHintsForStudentFlags
+
+			case "request-hint":
+				epf = codelabRequestHintFlags
+
+			case "list-assignments-for-students":
+				epf = codelabListAssignmentsForStudentsFlags
+
+			case "get-assignment-progress":
+				epf = codelabGetAssignmentProgressFlags
+
 			case "get-answer-by-user-and-exercise":
 				epf = codelabGetAnswerByUserAndExerciseFlags
 
@@ -226,7 +476,7 @@ func ParseEndpoint(
 	{
 		switch svcn {
 		case "codelab":
-			c := codelabc.NewClient(scheme, host, doer, enc, dec, restore)
+			c := codelabc.NewClient(scheme, host, doer, enc, dec, restore, dialer, codelabConfigurer)
 			switch epn {
 			case "create-exercise":
 				endpoint = c.CreateExercise()
@@ -236,13 +486,22 @@ func ParseEndpoint(
 				data, err = codelabc.BuildGetExercisePayload(*codelabGetExerciseIDFlag, *codelabGetExerciseSessionTokenFlag)
 			case "list-exercises":
 				endpoint = c.ListExercises()
-				data, err = codelabc.BuildListExercisesPayload(*codelabListExercisesSessionTokenFlag)
+				data, err = codelabc.BuildListExercisesPayload(*codelabListExercisesSearchFlag, *codelabListExercisesDifficultyFlag, *codelabListExercisesTagsFlag, *codelabListExercisesCreatedByFlag, *codelabListExercisesCursorFlag, *codelabListExercisesLimitFlag, *codelabListExercisesSessionTokenFlag)
+			case "list-tags":
+				endpoint = c.ListTags()
+				data, err = codelabc.BuildListTagsPayload(*codelabListTagsSessionTokenFlag)
 			case "update-exercise":
 				endpoint = c.UpdateExercise()
 				data, err = codelabc.BuildUpdateExercisePayload(*codelabUpdateExerciseBodyFlag, *codelabUpdateExerciseIDFlag, *codelabUpdateExerciseSessionTokenFlag)
 			case "delete-exercise":
 				endpoint = c.DeleteExercise()
 				data, err = codelabc.BuildDeleteExercisePayload(*codelabDeleteExerciseIDFlag, *codelabDeleteExerciseSessionTokenFlag)
+			case "export-exercises":
+				endpoint = c.ExportExercises()
+				data, err = codelabc.BuildExportExercisesPayload(*codelabExportExercisesExerciseIdsFlag, *codelabExportExercisesFormatFlag, *codelabExportExercisesSessionTokenFlag)
+			case "import-exercises":
+				endpoint = c.ImportExercises()
+				data, err = codelabc.BuildImportExercisesPayload(*codelabImportExercisesBodyFlag, *codelabImportExercisesSessionTokenFlag)
 			case "create-test":
 				endpoint = c.CreateTest()
 				data, err = codelabc.BuildCreateTestPayload(*codelabCreateTestBodyFlag, *codelabCreateTestSessionTokenFlag)
@@ -255,18 +514,93 @@ func ParseEndpoint(
 			case "delete-test":
 				endpoint = c.DeleteTest()
 				data, err = codelabc.BuildDeleteTestPayload(*codelabDeleteTestIDFlag, *codelabDeleteTestSessionTokenFlag)
+			case "list-exercise-versions":
+				endpoint = c.ListExerciseVersions()
+				data, err = codelabc.BuildListExerciseVersionsPayload(*codelabListExerciseVersionsExerciseIDFlag, *codelabListExerciseVersionsSessionTokenFlag)
+			case "diff-exercise-versions":
+				endpoint = c.DiffExerciseVersions()
+				data, err = codelabc.BuildDiffExerciseVersionsPayload(*codelabDiffExerciseVersionsExerciseIDFlag, *codelabDiffExerciseVersionsFromFlag, *codelabDiffExerciseVersionsToFlag, *codelabDiffExerciseVersionsSessionTokenFlag)
+			case "rollback-exercise":
+				endpoint = c.RollbackExercise()
+				data, err = codelabc.BuildRollbackExercisePayload(*codelabRollbackExerciseExerciseIDFlag, *codelabRollbackExerciseVersionFlag, *codelabRollbackExerciseSessionTokenFlag)
+			case "regrade-exercise":
+				endpoint = c.RegradeExercise()
+				data, err = codelabc.BuildRegradeExercisePayload(*codelabRegradeExerciseExerciseIDFlag, *codelabRegradeExerciseSessionTokenFlag)
+			case "get-regrade":
+				endpoint = c.GetRegrade()
+				data, err = codelabc.BuildGetRegradePayload(*codelabGetRegradeIDFlag, *codelabGetRegradeSessionTokenFlag)
+			case "create-hint":
+				endpoint = c.CreateHint()
+				data, err = codelabc.BuildCreateHintPayload(*codelabCreateHintBodyFlag, *codelabCreateHintSessionTokenFlag)
+			case "get-hints-by-exercise":
+				endpoint = c.GetHintsByExercise()
+				data, err = codelabc.BuildGetHintsByExercisePayload(*codelabGetHintsByExerciseExerciseIDFlag, *codelabGetHintsByExerciseSessionTokenFlag)
+			case "update-hint":
+				endpoint = c.UpdateHint()
+				data, err = codelabc.BuildUpdateHintPayload(*codelabUpdateHintBodyFlag, *codelabUpdateHintIDFlag, *codelabUpdateHintSessionTokenFlag)
+			case "delete-hint":
+				endpoint = c.DeleteHint()
+				data, err = codelabc.BuildDeleteHintPayload(*codelabDeleteHintIDFlag, *codelabDeleteHintSessionTokenFlag)
+			case "create-assignment":
+				endpoint = c.CreateAssignment()
+				data, err = codelabc.BuildCreateAssignmentPayload(*codelabCreateAssignmentBodyFlag, *codelabCreateAssignmentSessionTokenFlag)
+			case "get-assignment":
+				endpoint = c.GetAssignment()
+				data, err = codelabc.BuildGetAssignmentPayload(*codelabGetAssignmentIDFlag, *codelabGetAssignmentSessionTokenFlag)
+			case "list-assignments":
+				endpoint = c.ListAssignments()
+				data, err = codelabc.BuildListAssignmentsPayload(*codelabListAssignmentsSessionTokenFlag)
+			case "update-assignment":
+				endpoint = c.UpdateAssignment()
+				data, err = codelabc.BuildUpdateAssignmentPayload(*codelabUpdateAssignmentBodyFlag, *codelabUpdateAssignmentIDFlag, *codelabUpdateAssignmentSessionTokenFlag)
+			case "delete-assignment":
+				endpoint = c.DeleteAssignment()
+				data, err = codelabc.BuildDeleteAssignmentPayload(*codelabDeleteAssignmentIDFlag, *codelabDeleteAssignmentSessionTokenFlag)
+			case "get-assignment-gradebook":
+				endpoint = c.GetAssignmentGradebook()
+				data, err = codelabc.BuildGetAssignmentGradebookPayload(*codelabGetAssignmentGradebookIDFlag, *codelabGetAssignmentGradebookSessionTokenFlag)
+			case "get-exercise-stats":
+				endpoint = c.GetExerciseStats()
+				data, err = codelabc.BuildGetExerciseStatsPayload(*codelabGetExerciseStatsExerciseIDFlag, *codelabGetExerciseStatsSessionTokenFlag)
+			case "get-student-exercise-stats":
+				endpoint = c.GetStudentExerciseStats()
+				data, err = codelabc.BuildGetStudentExerciseStatsPayload(*codelabGetStudentExerciseStatsExerciseIDFlag, *codelabGetStudentExerciseStatsUserIDFlag, *codelabGetStudentExerciseStatsSessionTokenFlag)
+			case "get-plagiarism-report":
+				endpoint = c.GetPlagiarismReport()
+				data, err = codelabc.BuildGetPlagiarismReportPayload(*codelabGetPlagiarismReportExerciseIDFlag, *codelabGetPlagiarismReportMinSimilarityFlag, *codelabGetPlagiarismReportSessionTokenFlag)
 			case "get-exercise-for-student":
 				endpoint = c.GetExerciseForStudent()
 				data, err = codelabc.BuildGetExerciseForStudentPayload(*codelabGetExerciseForStudentIDFlag, *codelabGetExerciseForStudentSessionTokenFlag)
 			case "list-exercises-for-students":
 				endpoint = c.ListExercisesForStudents()
-				data, err = codelabc.BuildListExercisesForStudentsPayload(*codelabListExercisesForStudentsSessionTokenFlag)
+				data, err = codelabc.BuildListExercisesForStudentsPayload(*codelabListExercisesForStudentsSearchFlag, *codelabListExercisesForStudentsDifficultyFlag, *codelabListExercisesForStudentsTagsFlag, *codelabListExercisesForStudentsCreatedByFlag, *codelabListExercisesForStudentsCursorFlag, *codelabListExercisesForStudentsLimitFlag, *codelabListExercisesForStudentsCompletedFlag, *codelabListExercisesForStudentsSessionTokenFlag)
 			case "create-attempt":
 				endpoint = c.CreateAttempt()
 				data, err = codelabc.BuildCreateAttemptPayload(*codelabCreateAttemptBodyFlag, *codelabCreateAttemptSessionTokenFlag)
+			case "submit-attempt":
+				endpoint = c.SubmitAttempt()
+				data, err = codelabc.BuildSubmitAttemptPayload(*codelabSubmitAttemptBodyFlag, *codelabSubmitAttemptSessionTokenFlag)
+			case "stream-attempt-events":
+				endpoint = c.StreamAttemptEvents()
+				data, err = codelabc.BuildStreamAttemptEventsPayload(*codelabStreamAttemptEventsIDFlag, *codelabStreamAttemptEventsSessionTokenFlag)
+			case "run-code":
+				endpoint = c.RunCode()
+				data, err = codelabc.BuildRunCodePayload(*codelabRunCodeBodyFlag, *codelabRunCodeExerciseIDFlag, *codelabRunCodeSessionTokenFlag)
 			case "get-attempts-by-user-and-exercise":
 				endpoint = c.GetAttemptsByUserAndExercise()
 				data, err = codelabc.BuildGetAttemptsByUserAndExercisePayload(*codelabGetAttemptsByUserAndExerciseUserIDFlag, *codelabGetAttemptsByUserAndExerciseExerciseIDFlag, *codelabGetAttemptsByUserAndExerciseSessionTokenFlag)
+			case "get-hints-for-student":
+				endpoint = c.GetHintsForStudent()
+				data, err = codelabc.BuildGetHintsForStudentPayload(*codelabGetHintsForStudentExerciseIDFlag, *codelabGetHintsForStudentSessionTokenFlag)
+			case "request-hint":
+				endpoint = c.RequestHint()
+				data, err = codelabc.BuildRequestHintPayload(*codelabRequestHintExerciseIDFlag, *codelabRequestHintSessionTokenFlag)
+			case "list-assignments-for-students":
+				endpoint = c.ListAssignmentsForStudents()
+				data, err = codelabc.BuildListAssignmentsForStudentsPayload(*codelabListAssignmentsForStudentsSessionTokenFlag)
+			case "get-assignment-progress":
+				endpoint = c.GetAssignmentProgress()
+				data, err = codelabc.BuildGetAssignmentProgressPayload(*codelabGetAssignmentProgressIDFlag, *codelabGetAssignmentProgressSessionTokenFlag)
 			case "get-answer-by-user-and-exercise":
 				endpoint = c.GetAnswerByUserAndExercise()
 				data, err = codelabc.BuildGetAnswerByUserAndExercisePayload(*codelabGetAnswerByUserAndExerciseUserIDFlag, *codelabGetAnswerByUserAndExerciseExerciseIDFlag, *codelabGetAnswerByUserAndExerciseSessionTokenFlag)
@@ -289,17 +623,45 @@ Usage:
 COMMAND:
     create-exercise: Create a new coding exercise (professors only)
     get-exercise: Get exercise by ID with solution (professors only)
-    list-exercises: List all exercises with solutions (professors only)
-    update-exercise: Update an exercise (professors only)
+    list-exercises: Search exercises with solutions, newest first (professors only)
+    list-tags: List the topics exercises are tagged with (professors and students)
+    update-exercise: Update an exercise, saved as a new version. Answers keep their status until the exercise is regraded (professors only)
     delete-exercise: Delete an exercise (professors only)
-    create-test: Create a new test case for an exercise (professors only)
+    export-exercises: Export exercises with their tests and solutions as a bundle (professors only)
+    import-exercises: Import the exercises of a bundle once every solution passes its tests (professors only)
+    create-test: Create a new test case for an exercise, saved as a new version of the exercise (professors only)
     get-tests-by-exercise: Get all test cases for an exercise (professors only)
-    update-test: Update a test case (professors only)
-    delete-test: Delete a test case (professors only)
+    update-test: Update a test case, saved as a new version of its exercise (professors only)
+    delete-test: Delete a test case, saved as a new version of its exercise (professors only)
+    list-exercise-versions: List the versions of an exercise, newest first (professors only)
+    diff-exercise-versions: Compare two versions of an exercise and its tests (professors only)
+    rollback-exercise: Restore an exercise and its tests as of a version, saved as a new version. Answers keep their status until the exercise is regraded (professors only)
+    regrade-exercise: Run every graded attempt of an exercise again against its current tests in the background, updating the outcome of attempts and answers (professors only)
+    get-regrade: Get the progress of a regrade and, once finished, the students whose outcome changed (professors only)
+    create-hint: Add a hint after the last hint of an exercise (professors only)
+    get-hints-by-exercise: Get the hints of an exercise in the order they are revealed (professors only)
+    update-hint: Update a hint (professors only)
+    delete-hint: Delete a hint (professors only)
+    create-assignment: Create an assignment grouping exercises in order (professors only)
+    get-assignment: Get assignment by ID with its exercises (professors only)
+    list-assignments: List all assignments, soonest deadline first (professors only)
+    update-assignment: Update an assignment and replace its exercises (professors only)
+    delete-assignment: Delete an assignment, keeping its exercises (professors only)
+    get-assignment-gradebook: Get the grades of every student on an assignment (professors only)
+    get-exercise-stats: Get completion and attempt statistics of an exercise (professors only)
+    get-student-exercise-stats: Get the progress and attempts of a student on an exercise (professors only)
+    get-plagiarism-report: Get the pairs of students with suspiciously similar successful attempts on an exercise (professors only)
     get-exercise-for-student: Get exercise by ID without solution (students)
-    list-exercises-for-students: List all exercises without solutions (students)
+    list-exercises-for-students: Search exercises without solutions, newest first (students)
     create-attempt: Submit a code attempt for an exercise (students)
+    submit-attempt: Queue a code attempt to be graded in the background and return its ID right away (students)
+    stream-attempt-events: Follow the grading of a submitted attempt test by test over a WebSocket (students)
+    run-code: Run code against the public tests or custom inputs without submitting it (students)
     get-attempts-by-user-and-exercise: Get user's attempts for a specific exercise (students)
+    get-hints-for-student: Get the hints of an exercise, showing the content of the revealed ones and when the others unlock (students)
+    request-hint: Reveal the next hint of an exercise once it is unlocked (students)
+    list-assignments-for-students: List all assignments with their exercises, soonest deadline first (students)
+    get-assignment-progress: Get the progress of the student on every exercise of an assignment (students)
     get-answer-by-user-and-exercise: Get user's answer for a specific exercise
 
 Additional help:
@@ -319,9 +681,14 @@ Example:
       "description": "Write a function that returns the sum of two numbers",
       "difficulty": "easy",
       "initial_code": "def sum_two_numbers(a, b):\n    # Write your code here\n    pass",
+      "language": "javascript",
       "solution": "def sum_two_numbers(a, b):\n    return a + b",
+      "tags": [
+         "recursion",
+         "strings"
+      ],
       "title": "Sum Two Numbers"
-   }' --session-token "Molestiae et."
+   }' --session-token "Ut eum nisi."
 `, os.Args[0])
 }
 
@@ -333,25 +700,44 @@ Get exercise by ID with solution (professors only)
     -session-token STRING: 
 
 Example:
-    %[1]s codelab get-exercise --id 1 --session-token "Quis cupiditate possimus molestiae doloremque dolores fugiat."
+    %[1]s codelab get-exercise --id 1 --session-token "Ducimus quas."
 `, os.Args[0])
 }
 
 func codelabListExercisesUsage() {
-	fmt.Fprintf(os.Stderr, `%[1]s [flags] codelab list-exercises -session-token STRING
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] codelab list-exercises -search STRING -difficulty STRING -tags JSON -created-by INT64 -cursor STRING -limit INT -session-token STRING
+
+Search exercises with solutions, newest first (professors only)
+    -search STRING: 
+    -difficulty STRING: 
+    -tags JSON: 
+    -created-by INT64: 
+    -cursor STRING: 
+    -limit INT: 
+    -session-token STRING: 
+
+Example:
+    %[1]s codelab list-exercises --search "recursion" --difficulty "medium" --tags '[
+      "recursion"
+   ]' --created-by 123 --cursor "Architecto incidunt eius aut et molestiae pariatur." --limit 18 --session-token "Earum qui qui placeat eum vel."
+`, os.Args[0])
+}
 
-List all exercises with solutions (professors only)
+func codelabListTagsUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] codelab list-tags -session-token STRING
+
+List the topics exercises are tagged with (professors and students)
     -session-token STRING: 
 
 Example:
-    %[1]s codelab list-exercises --session-token "Nulla et praesentium quo accusantium ut doloribus."
+    %[1]s codelab list-tags --session-token "Ratione distinctio nihil asperiores."
 `, os.Args[0])
 }
 
 func codelabUpdateExerciseUsage() {
 	fmt.Fprintf(os.Stderr, `%[1]s [flags] codelab update-exercise -body JSON -id INT64 -session-token STRING
 
-Update an exercise (professors only)
+Update an exercise, saved as a new version. Answers keep their status until the exercise is regraded (professors only)
     -body JSON: 
     -id INT64: Exercise ID
     -session-token STRING: 
@@ -362,10 +748,15 @@ Example:
          "description": "Write a function that returns the sum of two numbers",
          "difficulty": "easy",
          "initial_code": "def sum_two_numbers(a, b):\n    # Write your code here\n    pass",
+         "language": "javascript",
          "solution": "def sum_two_numbers(a, b):\n    return a + b",
+         "tags": [
+            "recursion",
+            "strings"
+         ],
          "title": "Sum Two Numbers"
       }
-   }' --id 1 --session-token "Totam minima odit saepe tempore."
+   }' --id 1 --session-token "Debitis error asperiores."
 `, os.Args[0])
 }
 
@@ -377,24 +768,58 @@ Delete an exercise (professors only)
     -session-token STRING: 
 
 Example:
-    %[1]s codelab delete-exercise --id 1 --session-token "Ut eum nisi."
+    %[1]s codelab delete-exercise --id 1 --session-token "Veritatis qui in."
+`, os.Args[0])
+}
+
+func codelabExportExercisesUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] codelab export-exercises -exercise-ids JSON -format STRING -session-token STRING
+
+Export exercises with their tests and solutions as a bundle (professors only)
+    -exercise-ids JSON: 
+    -format STRING: 
+    -session-token STRING: 
+
+Example:
+    %[1]s codelab export-exercises --exercise-ids '[
+      1,
+      2
+   ]' --format "json" --session-token "Corporis hic qui omnis iste atque architecto."
+`, os.Args[0])
+}
+
+func codelabImportExercisesUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] codelab import-exercises -body JSON -session-token STRING
+
+Import the exercises of a bundle once every solution passes its tests (professors only)
+    -body JSON: 
+    -session-token STRING: 
+
+Example:
+    %[1]s codelab import-exercises --body '{
+      "content": "SWxsbyBlc3QgZXN0IGZhY2lsaXMgdm9sdXB0YXRlcy4="
+   }' --session-token "Tempore provident dignissimos eum impedit dolore."
 `, os.Args[0])
 }
 
 func codelabCreateTestUsage() {
 	fmt.Fprintf(os.Stderr, `%[1]s [flags] codelab create-test -body JSON -session-token STRING
 
-Create a new test case for an exercise (professors only)
+Create a new test case for an exercise, saved as a new version of the exercise (professors only)
     -body JSON: 
     -session-token STRING: 
 
 Example:
     %[1]s codelab create-test --body '{
+      "comparison": "exact",
       "exercise_id": 1,
       "input": "5, 3",
+      "input_format": "text",
       "output": "8",
-      "public": true
-   }' --session-token "Enim iure."
+      "public": true,
+      "tolerance": 0.001,
+      "weight": 1
+   }' --session-token "Ullam quo non illo."
 `, os.Args[0])
 }
 
@@ -406,14 +831,14 @@ Get all test cases for an exercise (professors only)
     -session-token STRING: 
 
 Example:
-    %[1]s codelab get-tests-by-exercise --exercise-id 1 --session-token "Porro molestiae voluptates."
+    %[1]s codelab get-tests-by-exercise --exercise-id 1 --session-token "Fugit est repellat."
 `, os.Args[0])
 }
 
 func codelabUpdateTestUsage() {
 	fmt.Fprintf(os.Stderr, `%[1]s [flags] codelab update-test -body JSON -id INT64 -session-token STRING
 
-Update a test case (professors only)
+Update a test case, saved as a new version of its exercise (professors only)
     -body JSON: 
     -id INT64: Test ID
     -session-token STRING: 
@@ -421,23 +846,286 @@ Update a test case (professors only)
 Example:
     %[1]s codelab update-test --body '{
       "test": {
+         "comparison": "exact",
          "input": "5, 3",
+         "input_format": "text",
          "output": "8",
-         "public": true
+         "public": true,
+         "tolerance": 0.001,
+         "weight": 1
       }
-   }' --id 1 --session-token "At sed dolores nobis animi delectus et."
+   }' --id 1 --session-token "A numquam alias quia quas."
 `, os.Args[0])
 }
 
 func codelabDeleteTestUsage() {
 	fmt.Fprintf(os.Stderr, `%[1]s [flags] codelab delete-test -id INT64 -session-token STRING
 
-Delete a test case (professors only)
+Delete a test case, saved as a new version of its exercise (professors only)
     -id INT64: Test ID
     -session-token STRING: 
 
 Example:
-    %[1]s codelab delete-test --id 1 --session-token "Cumque illo mollitia nostrum suscipit molestiae."
+    %[1]s codelab delete-test --id 1 --session-token "Est eius qui reiciendis necessitatibus id similique."
+`, os.Args[0])
+}
+
+func codelabListExerciseVersionsUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] codelab list-exercise-versions -exercise-id INT64 -session-token STRING
+
+List the versions of an exercise, newest first (professors only)
+    -exercise-id INT64: Exercise ID
+    -session-token STRING: 
+
+Example:
+    %[1]s codelab list-exercise-versions --exercise-id 1 --session-token "Unde a."
+`, os.Args[0])
+}
+
+func codelabDiffExerciseVersionsUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] codelab diff-exercise-versions -exercise-id INT64 -from INT32 -to INT32 -session-token STRING
+
+Compare two versions of an exercise and its tests (professors only)
+    -exercise-id INT64: Exercise ID
+    -from INT32: 
+    -to INT32: 
+    -session-token STRING: 
+
+Example:
+    %[1]s codelab diff-exercise-versions --exercise-id 1 --from 1 --to 2 --session-token "Adipisci saepe nobis dolores quas."
+`, os.Args[0])
+}
+
+func codelabRollbackExerciseUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] codelab rollback-exercise -exercise-id INT64 -version INT32 -session-token STRING
+
+Restore an exercise and its tests as of a version, saved as a new version. Answers keep their status until the exercise is regraded (professors only)
+    -exercise-id INT64: Exercise ID
+    -version INT32: Version to restore
+    -session-token STRING: 
+
+Example:
+    %[1]s codelab rollback-exercise --exercise-id 1 --version 1 --session-token "Fuga est ea libero ab vero aut."
+`, os.Args[0])
+}
+
+func codelabRegradeExerciseUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] codelab regrade-exercise -exercise-id INT64 -session-token STRING
+
+Run every graded attempt of an exercise again against its current tests in the background, updating the outcome of attempts and answers (professors only)
+    -exercise-id INT64: Exercise ID
+    -session-token STRING: 
+
+Example:
+    %[1]s codelab regrade-exercise --exercise-id 1 --session-token "Vitae qui consectetur magnam quia."
+`, os.Args[0])
+}
+
+func codelabGetRegradeUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] codelab get-regrade -id INT64 -session-token STRING
+
+Get the progress of a regrade and, once finished, the students whose outcome changed (professors only)
+    -id INT64: Regrade ID
+    -session-token STRING: 
+
+Example:
+    %[1]s codelab get-regrade --id 1 --session-token "Id suscipit consectetur nam."
+`, os.Args[0])
+}
+
+func codelabCreateHintUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] codelab create-hint -body JSON -session-token STRING
+
+Add a hint after the last hint of an exercise (professors only)
+    -body JSON: 
+    -session-token STRING: 
+
+Example:
+    %[1]s codelab create-hint --body '{
+      "content": "Split the input on the comma",
+      "exercise_id": 1,
+      "penalty": 10,
+      "unlock_after_attempts": 2,
+      "unlock_after_seconds": 300
+   }' --session-token "Autem repellendus."
+`, os.Args[0])
+}
+
+func codelabGetHintsByExerciseUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] codelab get-hints-by-exercise -exercise-id INT64 -session-token STRING
+
+Get the hints of an exercise in the order they are revealed (professors only)
+    -exercise-id INT64: Exercise ID
+    -session-token STRING: 
+
+Example:
+    %[1]s codelab get-hints-by-exercise --exercise-id 1 --session-token "Recusandae corrupti vitae nam adipisci nesciunt praesentium."
+`, os.Args[0])
+}
+
+func codelabUpdateHintUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] codelab update-hint -body JSON -id INT64 -session-token STRING
+
+Update a hint (professors only)
+    -body JSON: 
+    -id INT64: Hint ID
+    -session-token STRING: 
+
+Example:
+    %[1]s codelab update-hint --body '{
+      "hint": {
+         "content": "Split the input on the comma",
+         "penalty": 10,
+         "unlock_after_attempts": 2,
+         "unlock_after_seconds": 300
+      }
+   }' --id 1 --session-token "Impedit nam consequatur consectetur dolor."
+`, os.Args[0])
+}
+
+func codelabDeleteHintUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] codelab delete-hint -id INT64 -session-token STRING
+
+Delete a hint (professors only)
+    -id INT64: Hint ID
+    -session-token STRING: 
+
+Example:
+    %[1]s codelab delete-hint --id 1 --session-token "Vel cupiditate et."
+`, os.Args[0])
+}
+
+func codelabCreateAssignmentUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] codelab create-assignment -body JSON -session-token STRING
+
+Create an assignment grouping exercises in order (professors only)
+    -body JSON: 
+    -session-token STRING: 
+
+Example:
+    %[1]s codelab create-assignment --body '{
+      "allow_late": false,
+      "closes_at": 1673136000000,
+      "description": "Solve the arithmetic exercises before Friday",
+      "exercise_ids": [
+         1,
+         2,
+         3
+      ],
+      "opens_at": 1672531200000,
+      "title": "Arithmetic"
+   }' --session-token "Ratione eveniet laudantium sequi sed dignissimos similique."
+`, os.Args[0])
+}
+
+func codelabGetAssignmentUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] codelab get-assignment -id INT64 -session-token STRING
+
+Get assignment by ID with its exercises (professors only)
+    -id INT64: Assignment ID
+    -session-token STRING: 
+
+Example:
+    %[1]s codelab get-assignment --id 1 --session-token "Quia incidunt voluptatem dolorem incidunt."
+`, os.Args[0])
+}
+
+func codelabListAssignmentsUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] codelab list-assignments -session-token STRING
+
+List all assignments, soonest deadline first (professors only)
+    -session-token STRING: 
+
+Example:
+    %[1]s codelab list-assignments --session-token "Ea accusamus."
+`, os.Args[0])
+}
+
+func codelabUpdateAssignmentUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] codelab update-assignment -body JSON -id INT64 -session-token STRING
+
+Update an assignment and replace its exercises (professors only)
+    -body JSON: 
+    -id INT64: Assignment ID
+    -session-token STRING: 
+
+Example:
+    %[1]s codelab update-assignment --body '{
+      "assignment": {
+         "allow_late": false,
+         "closes_at": 1673136000000,
+         "description": "Solve the arithmetic exercises before Friday",
+         "exercise_ids": [
+            1,
+            2,
+            3
+         ],
+         "opens_at": 1672531200000,
+         "title": "Arithmetic"
+      }
+   }' --id 1 --session-token "Quia officia eius repudiandae labore."
+`, os.Args[0])
+}
+
+func codelabDeleteAssignmentUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] codelab delete-assignment -id INT64 -session-token STRING
+
+Delete an assignment, keeping its exercises (professors only)
+    -id INT64: Assignment ID
+    -session-token STRING: 
+
+Example:
+    %[1]s codelab delete-assignment --id 1 --session-token "Molestiae commodi dolor voluptates qui dicta."
+`, os.Args[0])
+}
+
+func codelabGetAssignmentGradebookUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] codelab get-assignment-gradebook -id INT64 -session-token STRING
+
+Get the grades of every student on an assignment (professors only)
+    -id INT64: Assignment ID
+    -session-token STRING: 
+
+Example:
+    %[1]s codelab get-assignment-gradebook --id 1 --session-token "Autem aliquid."
+`, os.Args[0])
+}
+
+func codelabGetExerciseStatsUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] codelab get-exercise-stats -exercise-id INT64 -session-token STRING
+
+Get completion and attempt statistics of an exercise (professors only)
+    -exercise-id INT64: Exercise ID
+    -session-token STRING: 
+
+Example:
+    %[1]s codelab get-exercise-stats --exercise-id 1 --session-token "Iusto quia earum molestias rem incidunt."
+`, os.Args[0])
+}
+
+func codelabGetStudentExerciseStatsUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] codelab get-student-exercise-stats -exercise-id INT64 -user-id INT64 -session-token STRING
+
+Get the progress and attempts of a student on an exercise (professors only)
+    -exercise-id INT64: Exercise ID
+    -user-id INT64: Student user ID
+    -session-token STRING: 
+
+Example:
+    %[1]s codelab get-student-exercise-stats --exercise-id 1 --user-id 123 --session-token "Et eligendi."
+`, os.Args[0])
+}
+
+func codelabGetPlagiarismReportUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] codelab get-plagiarism-report -exercise-id INT64 -min-similarity FLOAT64 -session-token STRING
+
+Get the pairs of students with suspiciously similar successful attempts on an exercise (professors only)
+    -exercise-id INT64: Exercise ID
+    -min-similarity FLOAT64: 
+    -session-token STRING: 
+
+Example:
+    %[1]s codelab get-plagiarism-report --exercise-id 1 --min-similarity 80 --session-token "Et adipisci id quis fugiat veritatis sunt."
 `, os.Args[0])
 }
 
@@ -449,18 +1137,27 @@ Get exercise by ID without solution (students)
     -session-token STRING: 
 
 Example:
-    %[1]s codelab get-exercise-for-student --id 1 --session-token "Accusantium adipisci."
+    %[1]s codelab get-exercise-for-student --id 1 --session-token "Iste asperiores."
 `, os.Args[0])
 }
 
 func codelabListExercisesForStudentsUsage() {
-	fmt.Fprintf(os.Stderr, `%[1]s [flags] codelab list-exercises-for-students -session-token STRING
-
-List all exercises without solutions (students)
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] codelab list-exercises-for-students -search STRING -difficulty STRING -tags JSON -created-by INT64 -cursor STRING -limit INT -completed BOOL -session-token STRING
+
+Search exercises without solutions, newest first (students)
+    -search STRING: 
+    -difficulty STRING: 
+    -tags JSON: 
+    -created-by INT64: 
+    -cursor STRING: 
+    -limit INT: 
+    -completed BOOL: 
     -session-token STRING: 
 
 Example:
-    %[1]s codelab list-exercises-for-students --session-token "Iusto eveniet dicta molestias voluptates quis."
+    %[1]s codelab list-exercises-for-students --search "recursion" --difficulty "hard" --tags '[
+      "recursion"
+   ]' --created-by 123 --cursor "Omnis ea." --limit 49 --completed false --session-token "Ad est saepe incidunt natus harum."
 `, os.Args[0])
 }
 
@@ -476,7 +1173,55 @@ Example:
       "code": "def sum_two_numbers(a, b):\n    return a + b",
       "exercise_id": 1,
       "success": true
-   }' --session-token "Quia ratione nostrum necessitatibus animi quo esse."
+   }' --session-token "Perferendis et pariatur quisquam ut possimus cum."
+`, os.Args[0])
+}
+
+func codelabSubmitAttemptUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] codelab submit-attempt -body JSON -session-token STRING
+
+Queue a code attempt to be graded in the background and return its ID right away (students)
+    -body JSON: 
+    -session-token STRING: 
+
+Example:
+    %[1]s codelab submit-attempt --body '{
+      "code": "def sum_two_numbers(a, b):\n    return a + b",
+      "exercise_id": 1,
+      "success": true
+   }' --session-token "Tempora sed et molestias in nam."
+`, os.Args[0])
+}
+
+func codelabStreamAttemptEventsUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] codelab stream-attempt-events -id INT64 -session-token STRING
+
+Follow the grading of a submitted attempt test by test over a WebSocket (students)
+    -id INT64: Attempt ID
+    -session-token STRING: 
+
+Example:
+    %[1]s codelab stream-attempt-events --id 1 --session-token "Itaque quia vel sint maxime."
+`, os.Args[0])
+}
+
+func codelabRunCodeUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] codelab run-code -body JSON -exercise-id INT64 -session-token STRING
+
+Run code against the public tests or custom inputs without submitting it (students)
+    -body JSON: 
+    -exercise-id INT64: Exercise ID
+    -session-token STRING: 
+
+Example:
+    %[1]s codelab run-code --body '{
+      "code": "function solution(input) { return input * 2; }",
+      "input_format": "text",
+      "inputs": [
+         "5",
+         "3"
+      ]
+   }' --exercise-id 1 --session-token "Accusamus totam."
 `, os.Args[0])
 }
 
@@ -489,7 +1234,54 @@ Get user's attempts for a specific exercise (students)
     -session-token STRING: 
 
 Example:
-    %[1]s codelab get-attempts-by-user-and-exercise --user-id 123 --exercise-id 1 --session-token "Sed sed et."
+    %[1]s codelab get-attempts-by-user-and-exercise --user-id 123 --exercise-id 1 --session-token "Dolore nisi fugiat porro molestiae impedit."
+`, os.Args[0])
+}
+
+func codelabGetHintsForStudentUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] codelab get-hints-for-student -exercise-id INT64 -session-token STRING
+
+Get the hints of an exercise, showing the content of the revealed ones and when the others unlock (students)
+    -exercise-id INT64: Exercise ID
+    -session-token STRING: 
+
+Example:
+    %[1]s codelab get-hints-for-student --exercise-id 1 --session-token "Dolores unde et."
+`, os.Args[0])
+}
+
+func codelabRequestHintUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] codelab request-hint -exercise-id INT64 -session-token STRING
+
+Reveal the next hint of an exercise once it is unlocked (students)
+    -exercise-id INT64: Exercise ID
+    -session-token STRING: 
+
+Example:
+    %[1]s codelab request-hint --exercise-id 1 --session-token "Ipsa nesciunt atque."
+`, os.Args[0])
+}
+
+func codelabListAssignmentsForStudentsUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] codelab list-assignments-for-students -session-token STRING
+
+List all assignments with their exercises, soonest deadline first (students)
+    -session-token STRING: 
+
+Example:
+    %[1]s codelab list-assignments-for-students --session-token "Quis eligendi."
+`, os.Args[0])
+}
+
+func codelabGetAssignmentProgressUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] codelab get-assignment-progress -id INT64 -session-token STRING
+
+Get the progress of the student on every exercise of an assignment (students)
+    -id INT64: Assignment ID
+    -session-token STRING: 
+
+Example:
+    %[1]s codelab get-assignment-progress --id 1 --session-token "Quisquam repudiandae qui."
 `, os.Args[0])
 }
 
@@ -502,6 +1294,6 @@ Get user's answer for a specific exercise
     -session-token STRING: 
 
 Example:
-    %[1]s codelab get-answer-by-user-and-exercise --user-id 123 --exercise-id 1 --session-token "Aut nisi."
+    %[1]s codelab get-answer-by-user-and-exercise --user-id 123 --exercise-id 1 --session-token "Magni sed."
 `, os.Args[0])
 }
@@ -27,7 +27,7 @@ func BuildCreateExercisePayload(codelabCreateExerciseBody string, codelabCreateE
 	{
 		err = json.Unmarshal([]byte(codelabCreateExerciseBody), &body)
 		if err != nil {
-			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"created_by\": 123,\n      \"description\": \"Write a function that returns the sum of two numbers\",\n      \"difficulty\": \"easy\",\n      \"initial_code\": \"def sum_two_numbers(a, b):\\n    # Write your code here\\n    pass\",\n      \"solution\": \"def sum_two_numbers(a, b):\\n    return a + b\",\n      \"title\": \"Sum Two Numbers\"\n   }'")
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"created_by\": 123,\n      \"description\": \"Write a function that returns the sum of two numbers\",\n      \"difficulty\": \"easy\",\n      \"initial_code\": \"def sum_two_numbers(a, b):\\n    # Write your code here\\n    pass\",\n      \"language\": \"javascript\",\n      \"solution\": \"def sum_two_numbers(a, b):\\n    return a + b\",\n      \"tags\": [\n         \"recursion\",\n         \"strings\"\n      ],\n      \"title\": \"Sum Two Numbers\"\n   }'")
 		}
 		if utf8.RuneCountInString(body.Title) > 200 {
 			err = goa.MergeErrors(err, goa.InvalidLengthError("body.title", body.Title, utf8.RuneCountInString(body.Title), 200, false))
@@ -35,6 +35,12 @@ func BuildCreateExercisePayload(codelabCreateExerciseBody string, codelabCreateE
 		if !(body.Difficulty == "easy" || body.Difficulty == "medium" || body.Difficulty == "hard") {
 			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.difficulty", body.Difficulty, []any{"easy", "medium", "hard"}))
 		}
+		if !(body.Language == "javascript" || body.Language == "starlark") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.language", body.Language, []any{"javascript", "starlark"}))
+		}
+		if len(body.Tags) > 10 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.tags", body.Tags, len(body.Tags), 10, false))
+		}
 		if err != nil {
 			return nil, err
 		}
@@ -50,6 +56,19 @@ func BuildCreateExercisePayload(codelabCreateExerciseBody string, codelabCreateE
 		Solution:    body.Solution,
 		Difficulty:  body.Difficulty,
 		CreatedBy:   body.CreatedBy,
+		Language:    body.Language,
+	}
+	{
+		var zero string
+		if v.Language == zero {
+			v.Language = "javascript"
+		}
+	}
+	if body.Tags != nil {
+		v.Tags = make([]string, len(body.Tags))
+		for i, val := range body.Tags {
+			v.Tags[i] = val
+		}
 	}
 	v.SessionToken = sessionToken
 
@@ -80,12 +99,101 @@ func BuildGetExercisePayload(codelabGetExerciseID string, codelabGetExerciseSess
 
 // BuildListExercisesPayload builds the payload for the codelab ListExercises
 // endpoint from CLI flags.
-func BuildListExercisesPayload(codelabListExercisesSessionToken string) (*codelab.ListExercisesPayload, error) {
+func BuildListExercisesPayload(codelabListExercisesSearch string, codelabListExercisesDifficulty string, codelabListExercisesTags string, codelabListExercisesCreatedBy string, codelabListExercisesCursor string, codelabListExercisesLimit string, codelabListExercisesSessionToken string) (*codelab.ListExercisesPayload, error) {
+	var err error
+	var search *string
+	{
+		if codelabListExercisesSearch != "" {
+			search = &codelabListExercisesSearch
+			if utf8.RuneCountInString(*search) > 200 {
+				err = goa.MergeErrors(err, goa.InvalidLengthError("search", *search, utf8.RuneCountInString(*search), 200, false))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var difficulty *string
+	{
+		if codelabListExercisesDifficulty != "" {
+			difficulty = &codelabListExercisesDifficulty
+			if !(*difficulty == "easy" || *difficulty == "medium" || *difficulty == "hard") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("difficulty", *difficulty, []any{"easy", "medium", "hard"}))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var tags []string
+	{
+		if codelabListExercisesTags != "" {
+			err = json.Unmarshal([]byte(codelabListExercisesTags), &tags)
+			if err != nil {
+				return nil, fmt.Errorf("invalid JSON for tags, \nerror: %s, \nexample of valid JSON:\n%s", err, "'[\n      \"recursion\"\n   ]'")
+			}
+		}
+	}
+	var createdBy *int64
+	{
+		if codelabListExercisesCreatedBy != "" {
+			val, err := strconv.ParseInt(codelabListExercisesCreatedBy, 10, 64)
+			createdBy = &val
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for createdBy, must be INT64")
+			}
+		}
+	}
+	var cursor *string
+	{
+		if codelabListExercisesCursor != "" {
+			cursor = &codelabListExercisesCursor
+		}
+	}
+	var limit int
+	{
+		if codelabListExercisesLimit != "" {
+			var v int64
+			v, err = strconv.ParseInt(codelabListExercisesLimit, 10, strconv.IntSize)
+			limit = int(v)
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for limit, must be INT")
+			}
+			if limit < 1 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("limit", limit, 1, true))
+			}
+			if limit > 100 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("limit", limit, 100, false))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
 	var sessionToken string
 	{
 		sessionToken = codelabListExercisesSessionToken
 	}
 	v := &codelab.ListExercisesPayload{}
+	v.Search = search
+	v.Difficulty = difficulty
+	v.Tags = tags
+	v.CreatedBy = createdBy
+	v.Cursor = cursor
+	v.Limit = limit
+	v.SessionToken = sessionToken
+
+	return v, nil
+}
+
+// BuildListTagsPayload builds the payload for the codelab ListTags endpoint
+// from CLI flags.
+func BuildListTagsPayload(codelabListTagsSessionToken string) (*codelab.ListTagsPayload, error) {
+	var sessionToken string
+	{
+		sessionToken = codelabListTagsSessionToken
+	}
+	v := &codelab.ListTagsPayload{}
 	v.SessionToken = sessionToken
 
 	return v, nil
@@ -99,7 +207,7 @@ func BuildUpdateExercisePayload(codelabUpdateExerciseBody string, codelabUpdateE
 	{
 		err = json.Unmarshal([]byte(codelabUpdateExerciseBody), &body)
 		if err != nil {
-			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"exercise\": {\n         \"description\": \"Write a function that returns the sum of two numbers\",\n         \"difficulty\": \"easy\",\n         \"initial_code\": \"def sum_two_numbers(a, b):\\n    # Write your code here\\n    pass\",\n         \"solution\": \"def sum_two_numbers(a, b):\\n    return a + b\",\n         \"title\": \"Sum Two Numbers\"\n      }\n   }'")
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"exercise\": {\n         \"description\": \"Write a function that returns the sum of two numbers\",\n         \"difficulty\": \"easy\",\n         \"initial_code\": \"def sum_two_numbers(a, b):\\n    # Write your code here\\n    pass\",\n         \"language\": \"javascript\",\n         \"solution\": \"def sum_two_numbers(a, b):\\n    return a + b\",\n         \"tags\": [\n            \"recursion\",\n            \"strings\"\n         ],\n         \"title\": \"Sum Two Numbers\"\n      }\n   }'")
 		}
 		if body.Exercise == nil {
 			err = goa.MergeErrors(err, goa.MissingFieldError("exercise", "body"))
@@ -156,6 +264,76 @@ func BuildDeleteExercisePayload(codelabDeleteExerciseID string, codelabDeleteExe
 	return v, nil
 }
 
+// BuildExportExercisesPayload builds the payload for the codelab
+// ExportExercises endpoint from CLI flags.
+func BuildExportExercisesPayload(codelabExportExercisesExerciseIds string, codelabExportExercisesFormat string, codelabExportExercisesSessionToken string) (*codelab.ExportExercisesPayload, error) {
+	var err error
+	var exerciseIds []int64
+	{
+		err = json.Unmarshal([]byte(codelabExportExercisesExerciseIds), &exerciseIds)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for exerciseIds, \nerror: %s, \nexample of valid JSON:\n%s", err, "'[\n      1,\n      2\n   ]'")
+		}
+		if len(exerciseIds) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("exercise_ids", exerciseIds, len(exerciseIds), 1, true))
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	var format string
+	{
+		if codelabExportExercisesFormat != "" {
+			format = codelabExportExercisesFormat
+			if !(format == "json" || format == "zip" || format == "tar.gz") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("format", format, []any{"json", "zip", "tar.gz"}))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var sessionToken string
+	{
+		sessionToken = codelabExportExercisesSessionToken
+	}
+	v := &codelab.ExportExercisesPayload{}
+	v.ExerciseIds = exerciseIds
+	v.Format = format
+	v.SessionToken = sessionToken
+
+	return v, nil
+}
+
+// BuildImportExercisesPayload builds the payload for the codelab
+// ImportExercises endpoint from CLI flags.
+func BuildImportExercisesPayload(codelabImportExercisesBody string, codelabImportExercisesSessionToken string) (*codelab.ImportExercisesPayload, error) {
+	var err error
+	var body ImportExercisesRequestBody
+	{
+		err = json.Unmarshal([]byte(codelabImportExercisesBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"content\": \"SWxsbyBlc3QgZXN0IGZhY2lsaXMgdm9sdXB0YXRlcy4=\"\n   }'")
+		}
+		if body.Content == nil {
+			err = goa.MergeErrors(err, goa.MissingFieldError("content", "body"))
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	var sessionToken string
+	{
+		sessionToken = codelabImportExercisesSessionToken
+	}
+	v := &codelab.ImportExercisesPayload{
+		Content: body.Content,
+	}
+	v.SessionToken = sessionToken
+
+	return v, nil
+}
+
 // BuildCreateTestPayload builds the payload for the codelab CreateTest
 // endpoint from CLI flags.
 func BuildCreateTestPayload(codelabCreateTestBody string, codelabCreateTestSessionToken string) (*codelab.CreateTestPayload, error) {
@@ -164,7 +342,22 @@ func BuildCreateTestPayload(codelabCreateTestBody string, codelabCreateTestSessi
 	{
 		err = json.Unmarshal([]byte(codelabCreateTestBody), &body)
 		if err != nil {
-			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"exercise_id\": 1,\n      \"input\": \"5, 3\",\n      \"output\": \"8\",\n      \"public\": true\n   }'")
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"comparison\": \"exact\",\n      \"exercise_id\": 1,\n      \"input\": \"5, 3\",\n      \"input_format\": \"text\",\n      \"output\": \"8\",\n      \"public\": true,\n      \"tolerance\": 0.001,\n      \"weight\": 1\n   }'")
+		}
+		if !(body.InputFormat == "text" || body.InputFormat == "json") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.input_format", body.InputFormat, []any{"text", "json"}))
+		}
+		if !(body.Comparison == "exact" || body.Comparison == "trimmed" || body.Comparison == "numeric" || body.Comparison == "json" || body.Comparison == "unordered" || body.Comparison == "regex") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.comparison", body.Comparison, []any{"exact", "trimmed", "numeric", "json", "unordered", "regex"}))
+		}
+		if body.Tolerance < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.tolerance", body.Tolerance, 0, true))
+		}
+		if body.Weight < 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.weight", body.Weight, 1, true))
+		}
+		if err != nil {
+			return nil, err
 		}
 	}
 	var sessionToken string
@@ -172,10 +365,38 @@ func BuildCreateTestPayload(codelabCreateTestBody string, codelabCreateTestSessi
 		sessionToken = codelabCreateTestSessionToken
 	}
 	v := &codelab.CreateTestPayload{
-		Input:      body.Input,
-		Output:     body.Output,
-		Public:     body.Public,
-		ExerciseID: body.ExerciseID,
+		Input:       body.Input,
+		Output:      body.Output,
+		Public:      body.Public,
+		ExerciseID:  body.ExerciseID,
+		InputFormat: body.InputFormat,
+		Comparison:  body.Comparison,
+		Tolerance:   body.Tolerance,
+		Weight:      body.Weight,
+	}
+	{
+		var zero string
+		if v.InputFormat == zero {
+			v.InputFormat = "text"
+		}
+	}
+	{
+		var zero string
+		if v.Comparison == zero {
+			v.Comparison = "exact"
+		}
+	}
+	{
+		var zero float64
+		if v.Tolerance == zero {
+			v.Tolerance = 0
+		}
+	}
+	{
+		var zero int32
+		if v.Weight == zero {
+			v.Weight = 1
+		}
 	}
 	v.SessionToken = sessionToken
 
@@ -212,11 +433,16 @@ func BuildUpdateTestPayload(codelabUpdateTestBody string, codelabUpdateTestID st
 	{
 		err = json.Unmarshal([]byte(codelabUpdateTestBody), &body)
 		if err != nil {
-			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"test\": {\n         \"input\": \"5, 3\",\n         \"output\": \"8\",\n         \"public\": true\n      }\n   }'")
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"test\": {\n         \"comparison\": \"exact\",\n         \"input\": \"5, 3\",\n         \"input_format\": \"text\",\n         \"output\": \"8\",\n         \"public\": true,\n         \"tolerance\": 0.001,\n         \"weight\": 1\n      }\n   }'")
 		}
 		if body.Test == nil {
 			err = goa.MergeErrors(err, goa.MissingFieldError("test", "body"))
 		}
+		if body.Test != nil {
+			if err2 := ValidateUpdateTestPayloadRequestBody(body.Test); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
 		if err != nil {
 			return nil, err
 		}
@@ -264,96 +490,940 @@ func BuildDeleteTestPayload(codelabDeleteTestID string, codelabDeleteTestSession
 	return v, nil
 }
 
-// BuildGetExerciseForStudentPayload builds the payload for the codelab
-// GetExerciseForStudent endpoint from CLI flags.
-func BuildGetExerciseForStudentPayload(codelabGetExerciseForStudentID string, codelabGetExerciseForStudentSessionToken string) (*codelab.GetExerciseForStudentPayload, error) {
+// BuildListExerciseVersionsPayload builds the payload for the codelab
+// ListExerciseVersions endpoint from CLI flags.
+func BuildListExerciseVersionsPayload(codelabListExerciseVersionsExerciseID string, codelabListExerciseVersionsSessionToken string) (*codelab.ListExerciseVersionsPayload, error) {
 	var err error
-	var id int64
+	var exerciseID int64
 	{
-		id, err = strconv.ParseInt(codelabGetExerciseForStudentID, 10, 64)
+		exerciseID, err = strconv.ParseInt(codelabListExerciseVersionsExerciseID, 10, 64)
 		if err != nil {
-			return nil, fmt.Errorf("invalid value for id, must be INT64")
+			return nil, fmt.Errorf("invalid value for exerciseID, must be INT64")
 		}
 	}
 	var sessionToken string
 	{
-		sessionToken = codelabGetExerciseForStudentSessionToken
+		sessionToken = codelabListExerciseVersionsSessionToken
 	}
-	v := &codelab.GetExerciseForStudentPayload{}
-	v.ID = id
+	v := &codelab.ListExerciseVersionsPayload{}
+	v.ExerciseID = exerciseID
 	v.SessionToken = sessionToken
 
 	return v, nil
 }
 
-// BuildListExercisesForStudentsPayload builds the payload for the codelab
-// ListExercisesForStudents endpoint from CLI flags.
-func BuildListExercisesForStudentsPayload(codelabListExercisesForStudentsSessionToken string) (*codelab.ListExercisesForStudentsPayload, error) {
+// BuildDiffExerciseVersionsPayload builds the payload for the codelab
+// DiffExerciseVersions endpoint from CLI flags.
+func BuildDiffExerciseVersionsPayload(codelabDiffExerciseVersionsExerciseID string, codelabDiffExerciseVersionsFrom string, codelabDiffExerciseVersionsTo string, codelabDiffExerciseVersionsSessionToken string) (*codelab.DiffExerciseVersionsPayload, error) {
+	var err error
+	var exerciseID int64
+	{
+		exerciseID, err = strconv.ParseInt(codelabDiffExerciseVersionsExerciseID, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value for exerciseID, must be INT64")
+		}
+	}
+	var from int32
+	{
+		var v int64
+		v, err = strconv.ParseInt(codelabDiffExerciseVersionsFrom, 10, 32)
+		from = int32(v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value for from, must be INT32")
+		}
+		if from < 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("from", from, 1, true))
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	var to int32
+	{
+		var v int64
+		v, err = strconv.ParseInt(codelabDiffExerciseVersionsTo, 10, 32)
+		to = int32(v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value for to, must be INT32")
+		}
+		if to < 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("to", to, 1, true))
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
 	var sessionToken string
 	{
-		sessionToken = codelabListExercisesForStudentsSessionToken
+		sessionToken = codelabDiffExerciseVersionsSessionToken
 	}
-	v := &codelab.ListExercisesForStudentsPayload{}
+	v := &codelab.DiffExerciseVersionsPayload{}
+	v.ExerciseID = exerciseID
+	v.From = from
+	v.To = to
 	v.SessionToken = sessionToken
 
 	return v, nil
 }
 
-// BuildCreateAttemptPayload builds the payload for the codelab CreateAttempt
-// endpoint from CLI flags.
-func BuildCreateAttemptPayload(codelabCreateAttemptBody string, codelabCreateAttemptSessionToken string) (*codelab.CreateAttemptPayload, error) {
+// BuildRollbackExercisePayload builds the payload for the codelab
+// RollbackExercise endpoint from CLI flags.
+func BuildRollbackExercisePayload(codelabRollbackExerciseExerciseID string, codelabRollbackExerciseVersion string, codelabRollbackExerciseSessionToken string) (*codelab.RollbackExercisePayload, error) {
 	var err error
-	var body CreateAttemptRequestBody
+	var exerciseID int64
 	{
-		err = json.Unmarshal([]byte(codelabCreateAttemptBody), &body)
+		exerciseID, err = strconv.ParseInt(codelabRollbackExerciseExerciseID, 10, 64)
 		if err != nil {
-			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"code\": \"def sum_two_numbers(a, b):\\n    return a + b\",\n      \"exercise_id\": 1,\n      \"success\": true\n   }'")
+			return nil, fmt.Errorf("invalid value for exerciseID, must be INT64")
+		}
+	}
+	var version int32
+	{
+		var v int64
+		v, err = strconv.ParseInt(codelabRollbackExerciseVersion, 10, 32)
+		version = int32(v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value for version, must be INT32")
+		}
+		if version < 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("version", version, 1, true))
+		}
+		if err != nil {
+			return nil, err
 		}
 	}
 	var sessionToken string
 	{
-		sessionToken = codelabCreateAttemptSessionToken
+		sessionToken = codelabRollbackExerciseSessionToken
 	}
-	v := &codelab.CreateAttemptPayload{
-		ExerciseID: body.ExerciseID,
-		Code:       body.Code,
-		Success:    body.Success,
+	v := &codelab.RollbackExercisePayload{}
+	v.ExerciseID = exerciseID
+	v.Version = version
+	v.SessionToken = sessionToken
+
+	return v, nil
+}
+
+// BuildRegradeExercisePayload builds the payload for the codelab
+// RegradeExercise endpoint from CLI flags.
+func BuildRegradeExercisePayload(codelabRegradeExerciseExerciseID string, codelabRegradeExerciseSessionToken string) (*codelab.RegradeExercisePayload, error) {
+	var err error
+	var exerciseID int64
+	{
+		exerciseID, err = strconv.ParseInt(codelabRegradeExerciseExerciseID, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value for exerciseID, must be INT64")
+		}
 	}
+	var sessionToken string
+	{
+		sessionToken = codelabRegradeExerciseSessionToken
+	}
+	v := &codelab.RegradeExercisePayload{}
+	v.ExerciseID = exerciseID
 	v.SessionToken = sessionToken
 
 	return v, nil
 }
 
-// BuildGetAttemptsByUserAndExercisePayload builds the payload for the codelab
-// GetAttemptsByUserAndExercise endpoint from CLI flags.
-func BuildGetAttemptsByUserAndExercisePayload(codelabGetAttemptsByUserAndExerciseUserID string, codelabGetAttemptsByUserAndExerciseExerciseID string, codelabGetAttemptsByUserAndExerciseSessionToken string) (*codelab.GetAttemptsByUserAndExercisePayload, error) {
+// BuildGetRegradePayload builds the payload for the codelab GetRegrade
+// endpoint from CLI flags.
+func BuildGetRegradePayload(codelabGetRegradeID string, codelabGetRegradeSessionToken string) (*codelab.GetRegradePayload, error) {
 	var err error
-	var userID int64
+	var id int64
 	{
-		userID, err = strconv.ParseInt(codelabGetAttemptsByUserAndExerciseUserID, 10, 64)
+		id, err = strconv.ParseInt(codelabGetRegradeID, 10, 64)
 		if err != nil {
-			return nil, fmt.Errorf("invalid value for userID, must be INT64")
+			return nil, fmt.Errorf("invalid value for id, must be INT64")
+		}
+	}
+	var sessionToken string
+	{
+		sessionToken = codelabGetRegradeSessionToken
+	}
+	v := &codelab.GetRegradePayload{}
+	v.ID = id
+	v.SessionToken = sessionToken
+
+	return v, nil
+}
+
+// BuildCreateHintPayload builds the payload for the codelab CreateHint
+// endpoint from CLI flags.
+func BuildCreateHintPayload(codelabCreateHintBody string, codelabCreateHintSessionToken string) (*codelab.CreateHintPayload, error) {
+	var err error
+	var body CreateHintRequestBody
+	{
+		err = json.Unmarshal([]byte(codelabCreateHintBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"content\": \"Split the input on the comma\",\n      \"exercise_id\": 1,\n      \"penalty\": 10,\n      \"unlock_after_attempts\": 2,\n      \"unlock_after_seconds\": 300\n   }'")
+		}
+		if utf8.RuneCountInString(body.Content) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.content", body.Content, utf8.RuneCountInString(body.Content), 1, true))
+		}
+		if body.UnlockAfterAttempts != nil {
+			if *body.UnlockAfterAttempts < 1 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("body.unlock_after_attempts", *body.UnlockAfterAttempts, 1, true))
+			}
+		}
+		if body.UnlockAfterSeconds != nil {
+			if *body.UnlockAfterSeconds < 1 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("body.unlock_after_seconds", *body.UnlockAfterSeconds, 1, true))
+			}
+		}
+		if body.Penalty < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.penalty", body.Penalty, 0, true))
+		}
+		if body.Penalty > 100 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.penalty", body.Penalty, 100, false))
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	var sessionToken string
+	{
+		sessionToken = codelabCreateHintSessionToken
+	}
+	v := &codelab.CreateHintPayload{
+		ExerciseID:          body.ExerciseID,
+		Content:             body.Content,
+		UnlockAfterAttempts: body.UnlockAfterAttempts,
+		UnlockAfterSeconds:  body.UnlockAfterSeconds,
+		Penalty:             body.Penalty,
+	}
+	{
+		var zero float64
+		if v.Penalty == zero {
+			v.Penalty = 0
 		}
 	}
+	v.SessionToken = sessionToken
+
+	return v, nil
+}
+
+// BuildGetHintsByExercisePayload builds the payload for the codelab
+// GetHintsByExercise endpoint from CLI flags.
+func BuildGetHintsByExercisePayload(codelabGetHintsByExerciseExerciseID string, codelabGetHintsByExerciseSessionToken string) (*codelab.GetHintsByExercisePayload, error) {
+	var err error
 	var exerciseID int64
 	{
-		exerciseID, err = strconv.ParseInt(codelabGetAttemptsByUserAndExerciseExerciseID, 10, 64)
+		exerciseID, err = strconv.ParseInt(codelabGetHintsByExerciseExerciseID, 10, 64)
 		if err != nil {
 			return nil, fmt.Errorf("invalid value for exerciseID, must be INT64")
 		}
 	}
 	var sessionToken string
 	{
-		sessionToken = codelabGetAttemptsByUserAndExerciseSessionToken
+		sessionToken = codelabGetHintsByExerciseSessionToken
 	}
-	v := &codelab.GetAttemptsByUserAndExercisePayload{}
-	v.UserID = userID
+	v := &codelab.GetHintsByExercisePayload{}
 	v.ExerciseID = exerciseID
 	v.SessionToken = sessionToken
 
 	return v, nil
 }
 
+// BuildUpdateHintPayload builds the payload for the codelab UpdateHint
+// endpoint from CLI flags.
+func BuildUpdateHintPayload(codelabUpdateHintBody string, codelabUpdateHintID string, codelabUpdateHintSessionToken string) (*codelab.UpdateHintPayload2, error) {
+	var err error
+	var body UpdateHintRequestBody
+	{
+		err = json.Unmarshal([]byte(codelabUpdateHintBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"hint\": {\n         \"content\": \"Split the input on the comma\",\n         \"penalty\": 10,\n         \"unlock_after_attempts\": 2,\n         \"unlock_after_seconds\": 300\n      }\n   }'")
+		}
+		if body.Hint == nil {
+			err = goa.MergeErrors(err, goa.MissingFieldError("hint", "body"))
+		}
+		if body.Hint != nil {
+			if err2 := ValidateUpdateHintPayloadRequestBody(body.Hint); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	var id int64
+	{
+		id, err = strconv.ParseInt(codelabUpdateHintID, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value for id, must be INT64")
+		}
+	}
+	var sessionToken string
+	{
+		sessionToken = codelabUpdateHintSessionToken
+	}
+	v := &codelab.UpdateHintPayload2{}
+	if body.Hint != nil {
+		v.Hint = marshalUpdateHintPayloadRequestBodyToCodelabUpdateHintPayload(body.Hint)
+	}
+	v.ID = id
+	v.SessionToken = sessionToken
+
+	return v, nil
+}
+
+// BuildDeleteHintPayload builds the payload for the codelab DeleteHint
+// endpoint from CLI flags.
+func BuildDeleteHintPayload(codelabDeleteHintID string, codelabDeleteHintSessionToken string) (*codelab.DeleteHintPayload, error) {
+	var err error
+	var id int64
+	{
+		id, err = strconv.ParseInt(codelabDeleteHintID, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value for id, must be INT64")
+		}
+	}
+	var sessionToken string
+	{
+		sessionToken = codelabDeleteHintSessionToken
+	}
+	v := &codelab.DeleteHintPayload{}
+	v.ID = id
+	v.SessionToken = sessionToken
+
+	return v, nil
+}
+
+// BuildCreateAssignmentPayload builds the payload for the codelab
+// CreateAssignment endpoint from CLI flags.
+func BuildCreateAssignmentPayload(codelabCreateAssignmentBody string, codelabCreateAssignmentSessionToken string) (*codelab.CreateAssignmentPayload, error) {
+	var err error
+	var body CreateAssignmentRequestBody
+	{
+		err = json.Unmarshal([]byte(codelabCreateAssignmentBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"allow_late\": false,\n      \"closes_at\": 1673136000000,\n      \"description\": \"Solve the arithmetic exercises before Friday\",\n      \"exercise_ids\": [\n         1,\n         2,\n         3\n      ],\n      \"opens_at\": 1672531200000,\n      \"title\": \"Arithmetic\"\n   }'")
+		}
+		if body.ExerciseIds == nil {
+			err = goa.MergeErrors(err, goa.MissingFieldError("exercise_ids", "body"))
+		}
+		if utf8.RuneCountInString(body.Title) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.title", body.Title, utf8.RuneCountInString(body.Title), 1, true))
+		}
+		if utf8.RuneCountInString(body.Title) > 200 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.title", body.Title, utf8.RuneCountInString(body.Title), 200, false))
+		}
+		if len(body.ExerciseIds) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.exercise_ids", body.ExerciseIds, len(body.ExerciseIds), 1, true))
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	var sessionToken string
+	{
+		sessionToken = codelabCreateAssignmentSessionToken
+	}
+	v := &codelab.CreateAssignmentPayload{
+		Title:       body.Title,
+		Description: body.Description,
+		OpensAt:     body.OpensAt,
+		ClosesAt:    body.ClosesAt,
+		AllowLate:   body.AllowLate,
+	}
+	{
+		var zero string
+		if v.Description == zero {
+			v.Description = ""
+		}
+	}
+	{
+		var zero bool
+		if v.AllowLate == zero {
+			v.AllowLate = false
+		}
+	}
+	if body.ExerciseIds != nil {
+		v.ExerciseIds = make([]int64, len(body.ExerciseIds))
+		for i, val := range body.ExerciseIds {
+			v.ExerciseIds[i] = val
+		}
+	} else {
+		v.ExerciseIds = []int64{}
+	}
+	v.SessionToken = sessionToken
+
+	return v, nil
+}
+
+// BuildGetAssignmentPayload builds the payload for the codelab GetAssignment
+// endpoint from CLI flags.
+func BuildGetAssignmentPayload(codelabGetAssignmentID string, codelabGetAssignmentSessionToken string) (*codelab.GetAssignmentPayload, error) {
+	var err error
+	var id int64
+	{
+		id, err = strconv.ParseInt(codelabGetAssignmentID, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value for id, must be INT64")
+		}
+	}
+	var sessionToken string
+	{
+		sessionToken = codelabGetAssignmentSessionToken
+	}
+	v := &codelab.GetAssignmentPayload{}
+	v.ID = id
+	v.SessionToken = sessionToken
+
+	return v, nil
+}
+
+// BuildListAssignmentsPayload builds the payload for the codelab
+// ListAssignments endpoint from CLI flags.
+func BuildListAssignmentsPayload(codelabListAssignmentsSessionToken string) (*codelab.ListAssignmentsPayload, error) {
+	var sessionToken string
+	{
+		sessionToken = codelabListAssignmentsSessionToken
+	}
+	v := &codelab.ListAssignmentsPayload{}
+	v.SessionToken = sessionToken
+
+	return v, nil
+}
+
+// BuildUpdateAssignmentPayload builds the payload for the codelab
+// UpdateAssignment endpoint from CLI flags.
+func BuildUpdateAssignmentPayload(codelabUpdateAssignmentBody string, codelabUpdateAssignmentID string, codelabUpdateAssignmentSessionToken string) (*codelab.UpdateAssignmentPayload2, error) {
+	var err error
+	var body UpdateAssignmentRequestBody
+	{
+		err = json.Unmarshal([]byte(codelabUpdateAssignmentBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"assignment\": {\n         \"allow_late\": false,\n         \"closes_at\": 1673136000000,\n         \"description\": \"Solve the arithmetic exercises before Friday\",\n         \"exercise_ids\": [\n            1,\n            2,\n            3\n         ],\n         \"opens_at\": 1672531200000,\n         \"title\": \"Arithmetic\"\n      }\n   }'")
+		}
+		if body.Assignment == nil {
+			err = goa.MergeErrors(err, goa.MissingFieldError("assignment", "body"))
+		}
+		if body.Assignment != nil {
+			if err2 := ValidateUpdateAssignmentPayloadRequestBody(body.Assignment); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	var id int64
+	{
+		id, err = strconv.ParseInt(codelabUpdateAssignmentID, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value for id, must be INT64")
+		}
+	}
+	var sessionToken string
+	{
+		sessionToken = codelabUpdateAssignmentSessionToken
+	}
+	v := &codelab.UpdateAssignmentPayload2{}
+	if body.Assignment != nil {
+		v.Assignment = marshalUpdateAssignmentPayloadRequestBodyToCodelabUpdateAssignmentPayload(body.Assignment)
+	}
+	v.ID = id
+	v.SessionToken = sessionToken
+
+	return v, nil
+}
+
+// BuildDeleteAssignmentPayload builds the payload for the codelab
+// DeleteAssignment endpoint from CLI flags.
+func BuildDeleteAssignmentPayload(codelabDeleteAssignmentID string, codelabDeleteAssignmentSessionToken string) (*codelab.DeleteAssignmentPayload, error) {
+	var err error
+	var id int64
+	{
+		id, err = strconv.ParseInt(codelabDeleteAssignmentID, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value for id, must be INT64")
+		}
+	}
+	var sessionToken string
+	{
+		sessionToken = codelabDeleteAssignmentSessionToken
+	}
+	v := &codelab.DeleteAssignmentPayload{}
+	v.ID = id
+	v.SessionToken = sessionToken
+
+	return v, nil
+}
+
+// BuildGetAssignmentGradebookPayload builds the payload for the codelab
+// GetAssignmentGradebook endpoint from CLI flags.
+func BuildGetAssignmentGradebookPayload(codelabGetAssignmentGradebookID string, codelabGetAssignmentGradebookSessionToken string) (*codelab.GetAssignmentGradebookPayload, error) {
+	var err error
+	var id int64
+	{
+		id, err = strconv.ParseInt(codelabGetAssignmentGradebookID, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value for id, must be INT64")
+		}
+	}
+	var sessionToken string
+	{
+		sessionToken = codelabGetAssignmentGradebookSessionToken
+	}
+	v := &codelab.GetAssignmentGradebookPayload{}
+	v.ID = id
+	v.SessionToken = sessionToken
+
+	return v, nil
+}
+
+// BuildGetExerciseStatsPayload builds the payload for the codelab
+// GetExerciseStats endpoint from CLI flags.
+func BuildGetExerciseStatsPayload(codelabGetExerciseStatsExerciseID string, codelabGetExerciseStatsSessionToken string) (*codelab.GetExerciseStatsPayload, error) {
+	var err error
+	var exerciseID int64
+	{
+		exerciseID, err = strconv.ParseInt(codelabGetExerciseStatsExerciseID, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value for exerciseID, must be INT64")
+		}
+	}
+	var sessionToken string
+	{
+		sessionToken = codelabGetExerciseStatsSessionToken
+	}
+	v := &codelab.GetExerciseStatsPayload{}
+	v.ExerciseID = exerciseID
+	v.SessionToken = sessionToken
+
+	return v, nil
+}
+
+// BuildGetStudentExerciseStatsPayload builds the payload for the codelab
+// GetStudentExerciseStats endpoint from CLI flags.
+func BuildGetStudentExerciseStatsPayload(codelabGetStudentExerciseStatsExerciseID string, codelabGetStudentExerciseStatsUserID string, codelabGetStudentExerciseStatsSessionToken string) (*codelab.GetStudentExerciseStatsPayload, error) {
+	var err error
+	var exerciseID int64
+	{
+		exerciseID, err = strconv.ParseInt(codelabGetStudentExerciseStatsExerciseID, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value for exerciseID, must be INT64")
+		}
+	}
+	var userID int64
+	{
+		userID, err = strconv.ParseInt(codelabGetStudentExerciseStatsUserID, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value for userID, must be INT64")
+		}
+	}
+	var sessionToken string
+	{
+		sessionToken = codelabGetStudentExerciseStatsSessionToken
+	}
+	v := &codelab.GetStudentExerciseStatsPayload{}
+	v.ExerciseID = exerciseID
+	v.UserID = userID
+	v.SessionToken = sessionToken
+
+	return v, nil
+}
+
+// BuildGetPlagiarismReportPayload builds the payload for the codelab
+// GetPlagiarismReport endpoint from CLI flags.
+func BuildGetPlagiarismReportPayload(codelabGetPlagiarismReportExerciseID string, codelabGetPlagiarismReportMinSimilarity string, codelabGetPlagiarismReportSessionToken string) (*codelab.GetPlagiarismReportPayload, error) {
+	var err error
+	var exerciseID int64
+	{
+		exerciseID, err = strconv.ParseInt(codelabGetPlagiarismReportExerciseID, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value for exerciseID, must be INT64")
+		}
+	}
+	var minSimilarity float64
+	{
+		if codelabGetPlagiarismReportMinSimilarity != "" {
+			minSimilarity, err = strconv.ParseFloat(codelabGetPlagiarismReportMinSimilarity, 64)
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for minSimilarity, must be FLOAT64")
+			}
+			if minSimilarity < 0 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("min_similarity", minSimilarity, 0, true))
+			}
+			if minSimilarity > 100 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("min_similarity", minSimilarity, 100, false))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var sessionToken string
+	{
+		sessionToken = codelabGetPlagiarismReportSessionToken
+	}
+	v := &codelab.GetPlagiarismReportPayload{}
+	v.ExerciseID = exerciseID
+	v.MinSimilarity = minSimilarity
+	v.SessionToken = sessionToken
+
+	return v, nil
+}
+
+// BuildGetExerciseForStudentPayload builds the payload for the codelab
+// GetExerciseForStudent endpoint from CLI flags.
+func BuildGetExerciseForStudentPayload(codelabGetExerciseForStudentID string, codelabGetExerciseForStudentSessionToken string) (*codelab.GetExerciseForStudentPayload, error) {
+	var err error
+	var id int64
+	{
+		id, err = strconv.ParseInt(codelabGetExerciseForStudentID, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value for id, must be INT64")
+		}
+	}
+	var sessionToken string
+	{
+		sessionToken = codelabGetExerciseForStudentSessionToken
+	}
+	v := &codelab.GetExerciseForStudentPayload{}
+	v.ID = id
+	v.SessionToken = sessionToken
+
+	return v, nil
+}
+
+// BuildListExercisesForStudentsPayload builds the payload for the codelab
+// ListExercisesForStudents endpoint from CLI flags.
+func BuildListExercisesForStudentsPayload(codelabListExercisesForStudentsSearch string, codelabListExercisesForStudentsDifficulty string, codelabListExercisesForStudentsTags string, codelabListExercisesForStudentsCreatedBy string, codelabListExercisesForStudentsCursor string, codelabListExercisesForStudentsLimit string, codelabListExercisesForStudentsCompleted string, codelabListExercisesForStudentsSessionToken string) (*codelab.ListExercisesForStudentsPayload, error) {
+	var err error
+	var search *string
+	{
+		if codelabListExercisesForStudentsSearch != "" {
+			search = &codelabListExercisesForStudentsSearch
+			if utf8.RuneCountInString(*search) > 200 {
+				err = goa.MergeErrors(err, goa.InvalidLengthError("search", *search, utf8.RuneCountInString(*search), 200, false))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var difficulty *string
+	{
+		if codelabListExercisesForStudentsDifficulty != "" {
+			difficulty = &codelabListExercisesForStudentsDifficulty
+			if !(*difficulty == "easy" || *difficulty == "medium" || *difficulty == "hard") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("difficulty", *difficulty, []any{"easy", "medium", "hard"}))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var tags []string
+	{
+		if codelabListExercisesForStudentsTags != "" {
+			err = json.Unmarshal([]byte(codelabListExercisesForStudentsTags), &tags)
+			if err != nil {
+				return nil, fmt.Errorf("invalid JSON for tags, \nerror: %s, \nexample of valid JSON:\n%s", err, "'[\n      \"recursion\"\n   ]'")
+			}
+		}
+	}
+	var createdBy *int64
+	{
+		if codelabListExercisesForStudentsCreatedBy != "" {
+			val, err := strconv.ParseInt(codelabListExercisesForStudentsCreatedBy, 10, 64)
+			createdBy = &val
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for createdBy, must be INT64")
+			}
+		}
+	}
+	var cursor *string
+	{
+		if codelabListExercisesForStudentsCursor != "" {
+			cursor = &codelabListExercisesForStudentsCursor
+		}
+	}
+	var limit int
+	{
+		if codelabListExercisesForStudentsLimit != "" {
+			var v int64
+			v, err = strconv.ParseInt(codelabListExercisesForStudentsLimit, 10, strconv.IntSize)
+			limit = int(v)
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for limit, must be INT")
+			}
+			if limit < 1 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("limit", limit, 1, true))
+			}
+			if limit > 100 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("limit", limit, 100, false))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var completed *bool
+	{
+		if codelabListExercisesForStudentsCompleted != "" {
+			var val bool
+			val, err = strconv.ParseBool(codelabListExercisesForStudentsCompleted)
+			completed = &val
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for completed, must be BOOL")
+			}
+		}
+	}
+	var sessionToken string
+	{
+		sessionToken = codelabListExercisesForStudentsSessionToken
+	}
+	v := &codelab.ListExercisesForStudentsPayload{}
+	v.Search = search
+	v.Difficulty = difficulty
+	v.Tags = tags
+	v.CreatedBy = createdBy
+	v.Cursor = cursor
+	v.Limit = limit
+	v.Completed = completed
+	v.SessionToken = sessionToken
+
+	return v, nil
+}
+
+// BuildCreateAttemptPayload builds the payload for the codelab CreateAttempt
+// endpoint from CLI flags.
+func BuildCreateAttemptPayload(codelabCreateAttemptBody string, codelabCreateAttemptSessionToken string) (*codelab.CreateAttemptPayload, error) {
+	var err error
+	var body CreateAttemptRequestBody
+	{
+		err = json.Unmarshal([]byte(codelabCreateAttemptBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"code\": \"def sum_two_numbers(a, b):\\n    return a + b\",\n      \"exercise_id\": 1,\n      \"success\": true\n   }'")
+		}
+	}
+	var sessionToken string
+	{
+		sessionToken = codelabCreateAttemptSessionToken
+	}
+	v := &codelab.CreateAttemptPayload{
+		ExerciseID: body.ExerciseID,
+		Code:       body.Code,
+		Success:    body.Success,
+	}
+	v.SessionToken = sessionToken
+
+	return v, nil
+}
+
+// BuildSubmitAttemptPayload builds the payload for the codelab SubmitAttempt
+// endpoint from CLI flags.
+func BuildSubmitAttemptPayload(codelabSubmitAttemptBody string, codelabSubmitAttemptSessionToken string) (*codelab.CreateAttemptPayload, error) {
+	var err error
+	var body SubmitAttemptRequestBody
+	{
+		err = json.Unmarshal([]byte(codelabSubmitAttemptBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"code\": \"def sum_two_numbers(a, b):\\n    return a + b\",\n      \"exercise_id\": 1,\n      \"success\": true\n   }'")
+		}
+	}
+	var sessionToken string
+	{
+		sessionToken = codelabSubmitAttemptSessionToken
+	}
+	v := &codelab.CreateAttemptPayload{
+		ExerciseID: body.ExerciseID,
+		Code:       body.Code,
+		Success:    body.Success,
+	}
+	v.SessionToken = sessionToken
+
+	return v, nil
+}
+
+// BuildStreamAttemptEventsPayload builds the payload for the codelab
+// StreamAttemptEvents endpoint from CLI flags.
+func BuildStreamAttemptEventsPayload(codelabStreamAttemptEventsID string, codelabStreamAttemptEventsSessionToken string) (*codelab.StreamAttemptEventsPayload, error) {
+	var err error
+	var id int64
+	{
+		id, err = strconv.ParseInt(codelabStreamAttemptEventsID, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value for id, must be INT64")
+		}
+	}
+	var sessionToken string
+	{
+		sessionToken = codelabStreamAttemptEventsSessionToken
+	}
+	v := &codelab.StreamAttemptEventsPayload{}
+	v.ID = id
+	v.SessionToken = sessionToken
+
+	return v, nil
+}
+
+// BuildRunCodePayload builds the payload for the codelab RunCode endpoint from
+// CLI flags.
+func BuildRunCodePayload(codelabRunCodeBody string, codelabRunCodeExerciseID string, codelabRunCodeSessionToken string) (*codelab.RunCodePayload, error) {
+	var err error
+	var body RunCodeRequestBody
+	{
+		err = json.Unmarshal([]byte(codelabRunCodeBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"code\": \"function solution(input) { return input * 2; }\",\n      \"input_format\": \"text\",\n      \"inputs\": [\n         \"5\",\n         \"3\"\n      ]\n   }'")
+		}
+		if len(body.Inputs) > 20 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.inputs", body.Inputs, len(body.Inputs), 20, false))
+		}
+		if !(body.InputFormat == "text" || body.InputFormat == "json") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.input_format", body.InputFormat, []any{"text", "json"}))
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	var exerciseID int64
+	{
+		exerciseID, err = strconv.ParseInt(codelabRunCodeExerciseID, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value for exerciseID, must be INT64")
+		}
+	}
+	var sessionToken string
+	{
+		sessionToken = codelabRunCodeSessionToken
+	}
+	v := &codelab.RunCodePayload{
+		Code:        body.Code,
+		InputFormat: body.InputFormat,
+	}
+	if body.Inputs != nil {
+		v.Inputs = make([]string, len(body.Inputs))
+		for i, val := range body.Inputs {
+			v.Inputs[i] = val
+		}
+	}
+	{
+		var zero string
+		if v.InputFormat == zero {
+			v.InputFormat = "text"
+		}
+	}
+	v.ExerciseID = exerciseID
+	v.SessionToken = sessionToken
+
+	return v, nil
+}
+
+// BuildGetAttemptsByUserAndExercisePayload builds the payload for the codelab
+// GetAttemptsByUserAndExercise endpoint from CLI flags.
+func BuildGetAttemptsByUserAndExercisePayload(codelabGetAttemptsByUserAndExerciseUserID string, codelabGetAttemptsByUserAndExerciseExerciseID string, codelabGetAttemptsByUserAndExerciseSessionToken string) (*codelab.GetAttemptsByUserAndExercisePayload, error) {
+	var err error
+	var userID int64
+	{
+		userID, err = strconv.ParseInt(codelabGetAttemptsByUserAndExerciseUserID, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value for userID, must be INT64")
+		}
+	}
+	var exerciseID int64
+	{
+		exerciseID, err = strconv.ParseInt(codelabGetAttemptsByUserAndExerciseExerciseID, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value for exerciseID, must be INT64")
+		}
+	}
+	var sessionToken string
+	{
+		sessionToken = codelabGetAttemptsByUserAndExerciseSessionToken
+	}
+	v := &codelab.GetAttemptsByUserAndExercisePayload{}
+	v.UserID = userID
+	v.ExerciseID = exerciseID
+	v.SessionToken = sessionToken
+
+	return v, nil
+}
+
+// BuildGetHintsForStudentPayload builds the payload for the codelab
+// GetHintsForStudent endpoint from CLI flags.
+func BuildGetHintsForStudentPayload(codelabGetHintsForStudentExerciseID string, codelabGetHintsForStudentSessionToken string) (*codelab.GetHintsForStudentPayload, error) {
+	var err error
+	var exerciseID int64
+	{
+		exerciseID, err = strconv.ParseInt(codelabGetHintsForStudentExerciseID, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value for exerciseID, must be INT64")
+		}
+	}
+	var sessionToken string
+	{
+		sessionToken = codelabGetHintsForStudentSessionToken
+	}
+	v := &codelab.GetHintsForStudentPayload{}
+	v.ExerciseID = exerciseID
+	v.SessionToken = sessionToken
+
+	return v, nil
+}
+
+// BuildRequestHintPayload builds the payload for the codelab RequestHint
+// endpoint from CLI flags.
+func BuildRequestHintPayload(codelabRequestHintExerciseID string, codelabRequestHintSessionToken string) (*codelab.RequestHintPayload, error) {
+	var err error
+	var exerciseID int64
+	{
+		exerciseID, err = strconv.ParseInt(codelabRequestHintExerciseID, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value for exerciseID, must be INT64")
+		}
+	}
+	var sessionToken string
+	{
+		sessionToken = codelabRequestHintSessionToken
+	}
+	v := &codelab.RequestHintPayload{}
+	v.ExerciseID = exerciseID
+	v.SessionToken = sessionToken
+
+	return v, nil
+}
+
+// BuildListAssignmentsForStudentsPayload builds the payload for the codelab
+// ListAssignmentsForStudents endpoint from CLI flags.
+func BuildListAssignmentsForStudentsPayload(codelabListAssignmentsForStudentsSessionToken string) (*codelab.ListAssignmentsForStudentsPayload, error) {
+	var sessionToken string
+	{
+		sessionToken = codelabListAssignmentsForStudentsSessionToken
+	}
+	v := &codelab.ListAssignmentsForStudentsPayload{}
+	v.SessionToken = sessionToken
+
+	return v, nil
+}
+
+// BuildGetAssignmentProgressPayload builds the payload for the codelab
+// GetAssignmentProgress endpoint from CLI flags.
+func BuildGetAssignmentProgressPayload(codelabGetAssignmentProgressID string, codelabGetAssignmentProgressSessionToken string) (*codelab.GetAssignmentProgressPayload, error) {
+	var err error
+	var id int64
+	{
+		id, err = strconv.ParseInt(codelabGetAssignmentProgressID, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value for id, must be INT64")
+		}
+	}
+	var sessionToken string
+	{
+		sessionToken = codelabGetAssignmentProgressSessionToken
+	}
+	v := &codelab.GetAssignmentProgressPayload{}
+	v.ID = id
+	v.SessionToken = sessionToken
+
+	return v, nil
+}
+
 // BuildGetAnswerByUserAndExercisePayload builds the payload for the codelab
 // GetAnswerByUserAndExercise endpoint from CLI flags.
 func BuildGetAnswerByUserAndExercisePayload(codelabGetAnswerByUserAndExerciseUserID string, codelabGetAnswerByUserAndExerciseExerciseID string, codelabGetAnswerByUserAndExerciseSessionToken string) (*codelab.GetAnswerByUserAndExercisePayload, error) {
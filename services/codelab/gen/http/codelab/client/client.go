@@ -12,7 +12,9 @@ package client
 import (
 	"context"
 	"net/http"
+	"time"
 
+	"github.com/gorilla/websocket"
 	goahttp "goa.design/goa/v3/http"
 	goa "goa.design/goa/v3/pkg"
 )
@@ -31,6 +33,10 @@ type Client struct {
 	// ListExercises endpoint.
 	ListExercisesDoer goahttp.Doer
 
+	// ListTags Doer is the HTTP client used to make requests to the ListTags
+	// endpoint.
+	ListTagsDoer goahttp.Doer
+
 	// UpdateExercise Doer is the HTTP client used to make requests to the
 	// UpdateExercise endpoint.
 	UpdateExerciseDoer goahttp.Doer
@@ -39,6 +45,14 @@ type Client struct {
 	// DeleteExercise endpoint.
 	DeleteExerciseDoer goahttp.Doer
 
+	// ExportExercises Doer is the HTTP client used to make requests to the
+	// ExportExercises endpoint.
+	ExportExercisesDoer goahttp.Doer
+
+	// ImportExercises Doer is the HTTP client used to make requests to the
+	// ImportExercises endpoint.
+	ImportExercisesDoer goahttp.Doer
+
 	// CreateTest Doer is the HTTP client used to make requests to the CreateTest
 	// endpoint.
 	CreateTestDoer goahttp.Doer
@@ -55,6 +69,78 @@ type Client struct {
 	// endpoint.
 	DeleteTestDoer goahttp.Doer
 
+	// ListExerciseVersions Doer is the HTTP client used to make requests to the
+	// ListExerciseVersions endpoint.
+	ListExerciseVersionsDoer goahttp.Doer
+
+	// DiffExerciseVersions Doer is the HTTP client used to make requests to the
+	// DiffExerciseVersions endpoint.
+	DiffExerciseVersionsDoer goahttp.Doer
+
+	// RollbackExercise Doer is the HTTP client used to make requests to the
+	// RollbackExercise endpoint.
+	RollbackExerciseDoer goahttp.Doer
+
+	// RegradeExercise Doer is the HTTP client used to make requests to the
+	// RegradeExercise endpoint.
+	RegradeExerciseDoer goahttp.Doer
+
+	// GetRegrade Doer is the HTTP client used to make requests to the GetRegrade
+	// endpoint.
+	GetRegradeDoer goahttp.Doer
+
+	// CreateHint Doer is the HTTP client used to make requests to the CreateHint
+	// endpoint.
+	CreateHintDoer goahttp.Doer
+
+	// GetHintsByExercise Doer is the HTTP client used to make requests to the
+	// GetHintsByExercise endpoint.
+	GetHintsByExerciseDoer goahttp.Doer
+
+	// UpdateHint Doer is the HTTP client used to make requests to the UpdateHint
+	// endpoint.
+	UpdateHintDoer goahttp.Doer
+
+	// DeleteHint Doer is the HTTP client used to make requests to the DeleteHint
+	// endpoint.
+	DeleteHintDoer goahttp.Doer
+
+	// CreateAssignment Doer is the HTTP client used to make requests to the
+	// CreateAssignment endpoint.
+	CreateAssignmentDoer goahttp.Doer
+
+	// GetAssignment Doer is the HTTP client used to make requests to the
+	// GetAssignment endpoint.
+	GetAssignmentDoer goahttp.Doer
+
+	// ListAssignments Doer is the HTTP client used to make requests to the
+	// ListAssignments endpoint.
+	ListAssignmentsDoer goahttp.Doer
+
+	// UpdateAssignment Doer is the HTTP client used to make requests to the
+	// UpdateAssignment endpoint.
+	UpdateAssignmentDoer goahttp.Doer
+
+	// DeleteAssignment Doer is the HTTP client used to make requests to the
+	// DeleteAssignment endpoint.
+	DeleteAssignmentDoer goahttp.Doer
+
+	// GetAssignmentGradebook Doer is the HTTP client used to make requests to the
+	// GetAssignmentGradebook endpoint.
+	GetAssignmentGradebookDoer goahttp.Doer
+
+	// GetExerciseStats Doer is the HTTP client used to make requests to the
+	// GetExerciseStats endpoint.
+	GetExerciseStatsDoer goahttp.Doer
+
+	// GetStudentExerciseStats Doer is the HTTP client used to make requests to the
+	// GetStudentExerciseStats endpoint.
+	GetStudentExerciseStatsDoer goahttp.Doer
+
+	// GetPlagiarismReport Doer is the HTTP client used to make requests to the
+	// GetPlagiarismReport endpoint.
+	GetPlagiarismReportDoer goahttp.Doer
+
 	// GetExerciseForStudent Doer is the HTTP client used to make requests to the
 	// GetExerciseForStudent endpoint.
 	GetExerciseForStudentDoer goahttp.Doer
@@ -67,10 +153,38 @@ type Client struct {
 	// CreateAttempt endpoint.
 	CreateAttemptDoer goahttp.Doer
 
+	// SubmitAttempt Doer is the HTTP client used to make requests to the
+	// SubmitAttempt endpoint.
+	SubmitAttemptDoer goahttp.Doer
+
+	// StreamAttemptEvents Doer is the HTTP client used to make requests to the
+	// StreamAttemptEvents endpoint.
+	StreamAttemptEventsDoer goahttp.Doer
+
+	// RunCode Doer is the HTTP client used to make requests to the RunCode
+	// endpoint.
+	RunCodeDoer goahttp.Doer
+
 	// GetAttemptsByUserAndExercise Doer is the HTTP client used to make requests
 	// to the GetAttemptsByUserAndExercise endpoint.
 	GetAttemptsByUserAndExerciseDoer goahttp.Doer
 
+	// GetHintsForStudent Doer is the HTTP client used to make requests to the
+	// GetHintsForStudent endpoint.
+	GetHintsForStudentDoer goahttp.Doer
+
+	// RequestHint Doer is the HTTP client used to make requests to the RequestHint
+	// endpoint.
+	RequestHintDoer goahttp.Doer
+
+	// ListAssignmentsForStudents Doer is the HTTP client used to make requests to
+	// the ListAssignmentsForStudents endpoint.
+	ListAssignmentsForStudentsDoer goahttp.Doer
+
+	// GetAssignmentProgress Doer is the HTTP client used to make requests to the
+	// GetAssignmentProgress endpoint.
+	GetAssignmentProgressDoer goahttp.Doer
+
 	// GetAnswerByUserAndExercise Doer is the HTTP client used to make requests to
 	// the GetAnswerByUserAndExercise endpoint.
 	GetAnswerByUserAndExerciseDoer goahttp.Doer
@@ -79,10 +193,12 @@ type Client struct {
 	// decoding so they can be read again.
 	RestoreResponseBody bool
 
-	scheme  string
-	host    string
-	encoder func(*http.Request) goahttp.Encoder
-	decoder func(*http.Response) goahttp.Decoder
+	scheme     string
+	host       string
+	encoder    func(*http.Request) goahttp.Encoder
+	decoder    func(*http.Response) goahttp.Decoder
+	dialer     goahttp.Dialer
+	configurer *ConnConfigurer
 }
 
 // NewClient instantiates HTTP clients for all the codelab service servers.
@@ -93,27 +209,62 @@ func NewClient(
 	enc func(*http.Request) goahttp.Encoder,
 	dec func(*http.Response) goahttp.Decoder,
 	restoreBody bool,
+	dialer goahttp.Dialer,
+	cfn *ConnConfigurer,
 ) *Client {
+	if cfn == nil {
+		cfn = &ConnConfigurer{}
+	}
 	return &Client{
 		CreateExerciseDoer:               doer,
 		GetExerciseDoer:                  doer,
 		ListExercisesDoer:                doer,
+		ListTagsDoer:                     doer,
 		UpdateExerciseDoer:               doer,
 		DeleteExerciseDoer:               doer,
+		ExportExercisesDoer:              doer,
+		ImportExercisesDoer:              doer,
 		CreateTestDoer:                   doer,
 		GetTestsByExerciseDoer:           doer,
 		UpdateTestDoer:                   doer,
 		DeleteTestDoer:                   doer,
+		ListExerciseVersionsDoer:         doer,
+		DiffExerciseVersionsDoer:         doer,
+		RollbackExerciseDoer:             doer,
+		RegradeExerciseDoer:              doer,
+		GetRegradeDoer:                   doer,
+		CreateHintDoer:                   doer,
+		GetHintsByExerciseDoer:           doer,
+		UpdateHintDoer:                   doer,
+		DeleteHintDoer:                   doer,
+		CreateAssignmentDoer:             doer,
+		GetAssignmentDoer:                doer,
+		ListAssignmentsDoer:              doer,
+		UpdateAssignmentDoer:             doer,
+		DeleteAssignmentDoer:             doer,
+		GetAssignmentGradebookDoer:       doer,
+		GetExerciseStatsDoer:             doer,
+		GetStudentExerciseStatsDoer:      doer,
+		GetPlagiarismReportDoer:          doer,
 		GetExerciseForStudentDoer:        doer,
 		ListExercisesForStudentsDoer:     doer,
 		CreateAttemptDoer:                doer,
+		SubmitAttemptDoer:                doer,
+		StreamAttemptEventsDoer:          doer,
+		RunCodeDoer:                      doer,
 		GetAttemptsByUserAndExerciseDoer: doer,
+		GetHintsForStudentDoer:           doer,
+		RequestHintDoer:                  doer,
+		ListAssignmentsForStudentsDoer:   doer,
+		GetAssignmentProgressDoer:        doer,
 		GetAnswerByUserAndExerciseDoer:   doer,
 		RestoreResponseBody:              restoreBody,
 		scheme:                           scheme,
 		host:                             host,
 		decoder:                          dec,
 		encoder:                          enc,
+		dialer:                           dialer,
+		configurer:                       cfn,
 	}
 }
 
@@ -189,6 +340,30 @@ func (c *Client) ListExercises() goa.Endpoint {
 	}
 }
 
+// ListTags returns an endpoint that makes HTTP requests to the codelab service
+// ListTags server.
+func (c *Client) ListTags() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeListTagsRequest(c.encoder)
+		decodeResponse = DecodeListTagsResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildListTagsRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.ListTagsDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("codelab", "ListTags", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
 // UpdateExercise returns an endpoint that makes HTTP requests to the codelab
 // service UpdateExercise server.
 func (c *Client) UpdateExercise() goa.Endpoint {
@@ -237,6 +412,54 @@ func (c *Client) DeleteExercise() goa.Endpoint {
 	}
 }
 
+// ExportExercises returns an endpoint that makes HTTP requests to the codelab
+// service ExportExercises server.
+func (c *Client) ExportExercises() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeExportExercisesRequest(c.encoder)
+		decodeResponse = DecodeExportExercisesResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildExportExercisesRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.ExportExercisesDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("codelab", "ExportExercises", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// ImportExercises returns an endpoint that makes HTTP requests to the codelab
+// service ImportExercises server.
+func (c *Client) ImportExercises() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeImportExercisesRequest(c.encoder)
+		decodeResponse = DecodeImportExercisesResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildImportExercisesRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.ImportExercisesDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("codelab", "ImportExercises", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
 // CreateTest returns an endpoint that makes HTTP requests to the codelab
 // service CreateTest server.
 func (c *Client) CreateTest() goa.Endpoint {
@@ -333,6 +556,438 @@ func (c *Client) DeleteTest() goa.Endpoint {
 	}
 }
 
+// ListExerciseVersions returns an endpoint that makes HTTP requests to the
+// codelab service ListExerciseVersions server.
+func (c *Client) ListExerciseVersions() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeListExerciseVersionsRequest(c.encoder)
+		decodeResponse = DecodeListExerciseVersionsResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildListExerciseVersionsRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.ListExerciseVersionsDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("codelab", "ListExerciseVersions", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// DiffExerciseVersions returns an endpoint that makes HTTP requests to the
+// codelab service DiffExerciseVersions server.
+func (c *Client) DiffExerciseVersions() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeDiffExerciseVersionsRequest(c.encoder)
+		decodeResponse = DecodeDiffExerciseVersionsResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildDiffExerciseVersionsRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.DiffExerciseVersionsDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("codelab", "DiffExerciseVersions", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// RollbackExercise returns an endpoint that makes HTTP requests to the codelab
+// service RollbackExercise server.
+func (c *Client) RollbackExercise() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeRollbackExerciseRequest(c.encoder)
+		decodeResponse = DecodeRollbackExerciseResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildRollbackExerciseRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.RollbackExerciseDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("codelab", "RollbackExercise", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// RegradeExercise returns an endpoint that makes HTTP requests to the codelab
+// service RegradeExercise server.
+func (c *Client) RegradeExercise() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeRegradeExerciseRequest(c.encoder)
+		decodeResponse = DecodeRegradeExerciseResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildRegradeExerciseRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.RegradeExerciseDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("codelab", "RegradeExercise", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// GetRegrade returns an endpoint that makes HTTP requests to the codelab
+// service GetRegrade server.
+func (c *Client) GetRegrade() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeGetRegradeRequest(c.encoder)
+		decodeResponse = DecodeGetRegradeResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildGetRegradeRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.GetRegradeDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("codelab", "GetRegrade", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// CreateHint returns an endpoint that makes HTTP requests to the codelab
+// service CreateHint server.
+func (c *Client) CreateHint() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeCreateHintRequest(c.encoder)
+		decodeResponse = DecodeCreateHintResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildCreateHintRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.CreateHintDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("codelab", "CreateHint", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// GetHintsByExercise returns an endpoint that makes HTTP requests to the
+// codelab service GetHintsByExercise server.
+func (c *Client) GetHintsByExercise() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeGetHintsByExerciseRequest(c.encoder)
+		decodeResponse = DecodeGetHintsByExerciseResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildGetHintsByExerciseRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.GetHintsByExerciseDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("codelab", "GetHintsByExercise", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// UpdateHint returns an endpoint that makes HTTP requests to the codelab
+// service UpdateHint server.
+func (c *Client) UpdateHint() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeUpdateHintRequest(c.encoder)
+		decodeResponse = DecodeUpdateHintResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildUpdateHintRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.UpdateHintDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("codelab", "UpdateHint", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// DeleteHint returns an endpoint that makes HTTP requests to the codelab
+// service DeleteHint server.
+func (c *Client) DeleteHint() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeDeleteHintRequest(c.encoder)
+		decodeResponse = DecodeDeleteHintResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildDeleteHintRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.DeleteHintDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("codelab", "DeleteHint", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// CreateAssignment returns an endpoint that makes HTTP requests to the codelab
+// service CreateAssignment server.
+func (c *Client) CreateAssignment() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeCreateAssignmentRequest(c.encoder)
+		decodeResponse = DecodeCreateAssignmentResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildCreateAssignmentRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.CreateAssignmentDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("codelab", "CreateAssignment", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// GetAssignment returns an endpoint that makes HTTP requests to the codelab
+// service GetAssignment server.
+func (c *Client) GetAssignment() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeGetAssignmentRequest(c.encoder)
+		decodeResponse = DecodeGetAssignmentResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildGetAssignmentRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.GetAssignmentDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("codelab", "GetAssignment", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// ListAssignments returns an endpoint that makes HTTP requests to the codelab
+// service ListAssignments server.
+func (c *Client) ListAssignments() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeListAssignmentsRequest(c.encoder)
+		decodeResponse = DecodeListAssignmentsResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildListAssignmentsRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.ListAssignmentsDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("codelab", "ListAssignments", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// UpdateAssignment returns an endpoint that makes HTTP requests to the codelab
+// service UpdateAssignment server.
+func (c *Client) UpdateAssignment() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeUpdateAssignmentRequest(c.encoder)
+		decodeResponse = DecodeUpdateAssignmentResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildUpdateAssignmentRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.UpdateAssignmentDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("codelab", "UpdateAssignment", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// DeleteAssignment returns an endpoint that makes HTTP requests to the codelab
+// service DeleteAssignment server.
+func (c *Client) DeleteAssignment() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeDeleteAssignmentRequest(c.encoder)
+		decodeResponse = DecodeDeleteAssignmentResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildDeleteAssignmentRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.DeleteAssignmentDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("codelab", "DeleteAssignment", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// GetAssignmentGradebook returns an endpoint that makes HTTP requests to the
+// codelab service GetAssignmentGradebook server.
+func (c *Client) GetAssignmentGradebook() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeGetAssignmentGradebookRequest(c.encoder)
+		decodeResponse = DecodeGetAssignmentGradebookResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildGetAssignmentGradebookRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.GetAssignmentGradebookDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("codelab", "GetAssignmentGradebook", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// GetExerciseStats returns an endpoint that makes HTTP requests to the codelab
+// service GetExerciseStats server.
+func (c *Client) GetExerciseStats() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeGetExerciseStatsRequest(c.encoder)
+		decodeResponse = DecodeGetExerciseStatsResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildGetExerciseStatsRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.GetExerciseStatsDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("codelab", "GetExerciseStats", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// GetStudentExerciseStats returns an endpoint that makes HTTP requests to the
+// codelab service GetStudentExerciseStats server.
+func (c *Client) GetStudentExerciseStats() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeGetStudentExerciseStatsRequest(c.encoder)
+		decodeResponse = DecodeGetStudentExerciseStatsResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildGetStudentExerciseStatsRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.GetStudentExerciseStatsDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("codelab", "GetStudentExerciseStats", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// GetPlagiarismReport returns an endpoint that makes HTTP requests to the
+// codelab service GetPlagiarismReport server.
+func (c *Client) GetPlagiarismReport() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeGetPlagiarismReportRequest(c.encoder)
+		decodeResponse = DecodeGetPlagiarismReportResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildGetPlagiarismReportRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.GetPlagiarismReportDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("codelab", "GetPlagiarismReport", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
 // GetExerciseForStudent returns an endpoint that makes HTTP requests to the
 // codelab service GetExerciseForStudent server.
 func (c *Client) GetExerciseForStudent() goa.Endpoint {
@@ -405,6 +1060,96 @@ func (c *Client) CreateAttempt() goa.Endpoint {
 	}
 }
 
+// SubmitAttempt returns an endpoint that makes HTTP requests to the codelab
+// service SubmitAttempt server.
+func (c *Client) SubmitAttempt() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeSubmitAttemptRequest(c.encoder)
+		decodeResponse = DecodeSubmitAttemptResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildSubmitAttemptRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.SubmitAttemptDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("codelab", "SubmitAttempt", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// StreamAttemptEvents returns an endpoint that makes HTTP requests to the
+// codelab service StreamAttemptEvents server.
+func (c *Client) StreamAttemptEvents() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeStreamAttemptEventsRequest(c.encoder)
+		decodeResponse = DecodeStreamAttemptEventsResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildStreamAttemptEventsRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		conn, resp, err := c.dialer.DialContext(ctx, req.URL.String(), req.Header)
+		if err != nil {
+			if resp != nil {
+				return decodeResponse(resp)
+			}
+			return nil, goahttp.ErrRequestError("codelab", "StreamAttemptEvents", err)
+		}
+		if c.configurer.StreamAttemptEventsFn != nil {
+			var cancel context.CancelFunc
+			ctx, cancel = context.WithCancel(ctx)
+			conn = c.configurer.StreamAttemptEventsFn(conn, cancel)
+		}
+		go func() {
+			<-ctx.Done()
+			conn.WriteControl(
+				websocket.CloseMessage,
+				websocket.FormatCloseMessage(websocket.CloseNormalClosure, "client closing connection"),
+				time.Now().Add(time.Second),
+			)
+			conn.Close()
+		}()
+		stream := &StreamAttemptEventsClientStream{conn: conn}
+		return stream, nil
+	}
+}
+
+// RunCode returns an endpoint that makes HTTP requests to the codelab service
+// RunCode server.
+func (c *Client) RunCode() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeRunCodeRequest(c.encoder)
+		decodeResponse = DecodeRunCodeResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildRunCodeRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.RunCodeDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("codelab", "RunCode", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
 // GetAttemptsByUserAndExercise returns an endpoint that makes HTTP requests to
 // the codelab service GetAttemptsByUserAndExercise server.
 func (c *Client) GetAttemptsByUserAndExercise() goa.Endpoint {
@@ -429,6 +1174,102 @@ func (c *Client) GetAttemptsByUserAndExercise() goa.Endpoint {
 	}
 }
 
+// GetHintsForStudent returns an endpoint that makes HTTP requests to the
+// codelab service GetHintsForStudent server.
+func (c *Client) GetHintsForStudent() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeGetHintsForStudentRequest(c.encoder)
+		decodeResponse = DecodeGetHintsForStudentResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildGetHintsForStudentRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.GetHintsForStudentDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("codelab", "GetHintsForStudent", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// RequestHint returns an endpoint that makes HTTP requests to the codelab
+// service RequestHint server.
+func (c *Client) RequestHint() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeRequestHintRequest(c.encoder)
+		decodeResponse = DecodeRequestHintResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildRequestHintRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.RequestHintDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("codelab", "RequestHint", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// ListAssignmentsForStudents returns an endpoint that makes HTTP requests to
+// the codelab service ListAssignmentsForStudents server.
+func (c *Client) ListAssignmentsForStudents() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeListAssignmentsForStudentsRequest(c.encoder)
+		decodeResponse = DecodeListAssignmentsForStudentsResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildListAssignmentsForStudentsRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.ListAssignmentsForStudentsDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("codelab", "ListAssignmentsForStudents", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// GetAssignmentProgress returns an endpoint that makes HTTP requests to the
+// codelab service GetAssignmentProgress server.
+func (c *Client) GetAssignmentProgress() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeGetAssignmentProgressRequest(c.encoder)
+		decodeResponse = DecodeGetAssignmentProgressResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildGetAssignmentProgressRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.GetAssignmentProgressDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("codelab", "GetAssignmentProgress", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
 // GetAnswerByUserAndExercise returns an endpoint that makes HTTP requests to
 // the codelab service GetAnswerByUserAndExercise server.
 func (c *Client) GetAnswerByUserAndExercise() goa.Endpoint {
@@ -12,9 +12,11 @@ package client
 import (
 	"bytes"
 	"context"
+	"fmt"
 	"io"
 	"net/http"
 	"net/url"
+	"strconv"
 
 	codelab "github.com/ynoacamino/infra-sustainable-classrooms/services/codelab/gen/codelab"
 	goahttp "goa.design/goa/v3/http"
@@ -63,6 +65,7 @@ func EncodeCreateExerciseRequest(encoder func(*http.Request) goahttp.Encoder) fu
 // codelab CreateExercise endpoint. restoreBody controls whether the response
 // body should be restored after having been read.
 // DecodeCreateExerciseResponse may return the following errors:
+//   - "solution_failed" (type *codelab.SolutionFailure): http.StatusUnprocessableEntity
 //   - "invalid_input" (type codelab.InvalidInput): http.StatusBadRequest
 //   - "service_unavailable" (type codelab.ServiceUnavailable): http.StatusServiceUnavailable
 //   - "unauthorized" (type codelab.Unauthorized): http.StatusUnauthorized
@@ -97,6 +100,20 @@ func DecodeCreateExerciseResponse(decoder func(*http.Response) goahttp.Decoder,
 			}
 			res := NewCreateExerciseSimpleResponseCreated(&body)
 			return res, nil
+		case http.StatusUnprocessableEntity:
+			var (
+				body CreateExerciseSolutionFailedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("codelab", "CreateExercise", err)
+			}
+			err = ValidateCreateExerciseSolutionFailedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("codelab", "CreateExercise", err)
+			}
+			return nil, NewCreateExerciseSolutionFailed(&body)
 		case http.StatusBadRequest:
 			var (
 				body string
@@ -294,6 +311,24 @@ func EncodeListExercisesRequest(encoder func(*http.Request) goahttp.Encoder) fun
 				Value: v,
 			})
 		}
+		values := req.URL.Query()
+		if p.Search != nil {
+			values.Add("search", *p.Search)
+		}
+		if p.Difficulty != nil {
+			values.Add("difficulty", *p.Difficulty)
+		}
+		for _, value := range p.Tags {
+			values.Add("tag", value)
+		}
+		if p.CreatedBy != nil {
+			values.Add("author", fmt.Sprintf("%v", *p.CreatedBy))
+		}
+		if p.Cursor != nil {
+			values.Add("cursor", *p.Cursor)
+		}
+		values.Add("limit", fmt.Sprintf("%v", p.Limit))
+		req.URL.RawQuery = values.Encode()
 		return nil
 	}
 }
@@ -302,6 +337,7 @@ func EncodeListExercisesRequest(encoder func(*http.Request) goahttp.Encoder) fun
 // codelab ListExercises endpoint. restoreBody controls whether the response
 // body should be restored after having been read.
 // DecodeListExercisesResponse may return the following errors:
+//   - "invalid_input" (type codelab.InvalidInput): http.StatusBadRequest
 //   - "not_found" (type codelab.NotFound): http.StatusNotFound
 //   - "permission_denied" (type codelab.PermissionDenied): http.StatusForbidden
 //   - "service_unavailable" (type codelab.ServiceUnavailable): http.StatusServiceUnavailable
@@ -333,7 +369,7 @@ func DecodeListExercisesResponse(decoder func(*http.Response) goahttp.Decoder, r
 			}
 			for _, e := range body {
 				if e != nil {
-					if err2 := ValidateExerciseResponse(e); err2 != nil {
+					if err2 := ValidateExercise(e); err2 != nil {
 						err = goa.MergeErrors(err, err2)
 					}
 				}
@@ -341,8 +377,25 @@ func DecodeListExercisesResponse(decoder func(*http.Response) goahttp.Decoder, r
 			if err != nil {
 				return nil, goahttp.ErrValidationError("codelab", "ListExercises", err)
 			}
-			res := NewListExercisesExerciseOK(body)
+			var (
+				nextCursor *string
+			)
+			nextCursorRaw := resp.Header.Get("X-Next-Cursor")
+			if nextCursorRaw != "" {
+				nextCursor = &nextCursorRaw
+			}
+			res := NewListExercisesExercisePageOK(body, nextCursor)
 			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("codelab", "ListExercises", err)
+			}
+			return nil, NewListExercisesInvalidInput(body)
 		case http.StatusNotFound:
 			var (
 				body string
@@ -390,6 +443,110 @@ func DecodeListExercisesResponse(decoder func(*http.Response) goahttp.Decoder, r
 	}
 }
 
+// BuildListTagsRequest instantiates a HTTP request object with method and path
+// set to call the "codelab" service "ListTags" endpoint
+func (c *Client) BuildListTagsRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: ListTagsCodelabPath()}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("codelab", "ListTags", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeListTagsRequest returns an encoder for requests sent to the codelab
+// ListTags server.
+func EncodeListTagsRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*codelab.ListTagsPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("codelab", "ListTags", "*codelab.ListTagsPayload", v)
+		}
+		{
+			v := p.SessionToken
+			req.AddCookie(&http.Cookie{
+				Name:  "session",
+				Value: v,
+			})
+		}
+		return nil
+	}
+}
+
+// DecodeListTagsResponse returns a decoder for responses returned by the
+// codelab ListTags endpoint. restoreBody controls whether the response body
+// should be restored after having been read.
+// DecodeListTagsResponse may return the following errors:
+//   - "service_unavailable" (type codelab.ServiceUnavailable): http.StatusServiceUnavailable
+//   - "unauthorized" (type codelab.Unauthorized): http.StatusUnauthorized
+//   - error: internal error
+func DecodeListTagsResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body ListTagsResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("codelab", "ListTags", err)
+			}
+			for _, e := range body {
+				if e != nil {
+					if err2 := ValidateTagCountResponse(e); err2 != nil {
+						err = goa.MergeErrors(err, err2)
+					}
+				}
+			}
+			if err != nil {
+				return nil, goahttp.ErrValidationError("codelab", "ListTags", err)
+			}
+			res := NewListTagsTagCountOK(body)
+			return res, nil
+		case http.StatusServiceUnavailable:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("codelab", "ListTags", err)
+			}
+			return nil, NewListTagsServiceUnavailable(body)
+		case http.StatusUnauthorized:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("codelab", "ListTags", err)
+			}
+			return nil, NewListTagsUnauthorized(body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("codelab", "ListTags", resp.StatusCode, string(body))
+		}
+	}
+}
+
 // BuildUpdateExerciseRequest instantiates a HTTP request object with method
 // and path set to call the "codelab" service "UpdateExercise" endpoint
 func (c *Client) BuildUpdateExerciseRequest(ctx context.Context, v any) (*http.Request, error) {
@@ -442,6 +599,7 @@ func EncodeUpdateExerciseRequest(encoder func(*http.Request) goahttp.Encoder) fu
 // codelab UpdateExercise endpoint. restoreBody controls whether the response
 // body should be restored after having been read.
 // DecodeUpdateExerciseResponse may return the following errors:
+//   - "solution_failed" (type *codelab.SolutionFailure): http.StatusUnprocessableEntity
 //   - "invalid_input" (type codelab.InvalidInput): http.StatusBadRequest
 //   - "not_found" (type codelab.NotFound): http.StatusNotFound
 //   - "permission_denied" (type codelab.PermissionDenied): http.StatusForbidden
@@ -478,6 +636,20 @@ func DecodeUpdateExerciseResponse(decoder func(*http.Response) goahttp.Decoder,
 			}
 			res := NewUpdateExerciseSimpleResponseOK(&body)
 			return res, nil
+		case http.StatusUnprocessableEntity:
+			var (
+				body UpdateExerciseSolutionFailedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("codelab", "UpdateExercise", err)
+			}
+			err = ValidateUpdateExerciseSolutionFailedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("codelab", "UpdateExercise", err)
+			}
+			return nil, NewUpdateExerciseSolutionFailed(&body)
 		case http.StatusBadRequest:
 			var (
 				body string
@@ -676,13 +848,13 @@ func DecodeDeleteExerciseResponse(decoder func(*http.Response) goahttp.Decoder,
 	}
 }
 
-// BuildCreateTestRequest instantiates a HTTP request object with method and
-// path set to call the "codelab" service "CreateTest" endpoint
-func (c *Client) BuildCreateTestRequest(ctx context.Context, v any) (*http.Request, error) {
-	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: CreateTestCodelabPath()}
-	req, err := http.NewRequest("POST", u.String(), nil)
+// BuildExportExercisesRequest instantiates a HTTP request object with method
+// and path set to call the "codelab" service "ExportExercises" endpoint
+func (c *Client) BuildExportExercisesRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: ExportExercisesCodelabPath()}
+	req, err := http.NewRequest("GET", u.String(), nil)
 	if err != nil {
-		return nil, goahttp.ErrInvalidURL("codelab", "CreateTest", u.String(), err)
+		return nil, goahttp.ErrInvalidURL("codelab", "ExportExercises", u.String(), err)
 	}
 	if ctx != nil {
 		req = req.WithContext(ctx)
@@ -691,13 +863,13 @@ func (c *Client) BuildCreateTestRequest(ctx context.Context, v any) (*http.Reque
 	return req, nil
 }
 
-// EncodeCreateTestRequest returns an encoder for requests sent to the codelab
-// CreateTest server.
-func EncodeCreateTestRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+// EncodeExportExercisesRequest returns an encoder for requests sent to the
+// codelab ExportExercises server.
+func EncodeExportExercisesRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
 	return func(req *http.Request, v any) error {
-		p, ok := v.(*codelab.CreateTestPayload)
+		p, ok := v.(*codelab.ExportExercisesPayload)
 		if !ok {
-			return goahttp.ErrInvalidType("codelab", "CreateTest", "*codelab.CreateTestPayload", v)
+			return goahttp.ErrInvalidType("codelab", "ExportExercises", "*codelab.ExportExercisesPayload", v)
 		}
 		{
 			v := p.SessionToken
@@ -706,24 +878,28 @@ func EncodeCreateTestRequest(encoder func(*http.Request) goahttp.Encoder) func(*
 				Value: v,
 			})
 		}
-		body := NewCreateTestRequestBody(p)
-		if err := encoder(req).Encode(&body); err != nil {
-			return goahttp.ErrEncodingError("codelab", "CreateTest", err)
+		values := req.URL.Query()
+		for _, value := range p.ExerciseIds {
+			valueStr := strconv.FormatInt(value, 10)
+			values.Add("exercise_ids", valueStr)
 		}
+		values.Add("format", p.Format)
+		req.URL.RawQuery = values.Encode()
 		return nil
 	}
 }
 
-// DecodeCreateTestResponse returns a decoder for responses returned by the
-// codelab CreateTest endpoint. restoreBody controls whether the response body
-// should be restored after having been read.
-// DecodeCreateTestResponse may return the following errors:
+// DecodeExportExercisesResponse returns a decoder for responses returned by
+// the codelab ExportExercises endpoint. restoreBody controls whether the
+// response body should be restored after having been read.
+// DecodeExportExercisesResponse may return the following errors:
 //   - "invalid_input" (type codelab.InvalidInput): http.StatusBadRequest
+//   - "not_found" (type codelab.NotFound): http.StatusNotFound
 //   - "permission_denied" (type codelab.PermissionDenied): http.StatusForbidden
 //   - "service_unavailable" (type codelab.ServiceUnavailable): http.StatusServiceUnavailable
 //   - "unauthorized" (type codelab.Unauthorized): http.StatusUnauthorized
 //   - error: internal error
-func DecodeCreateTestResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+func DecodeExportExercisesResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
 	return func(resp *http.Response) (any, error) {
 		if restoreBody {
 			b, err := io.ReadAll(resp.Body)
@@ -738,20 +914,20 @@ func DecodeCreateTestResponse(decoder func(*http.Response) goahttp.Decoder, rest
 			defer resp.Body.Close()
 		}
 		switch resp.StatusCode {
-		case http.StatusCreated:
+		case http.StatusOK:
 			var (
-				body CreateTestResponseBody
+				body ExportExercisesResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("codelab", "CreateTest", err)
+				return nil, goahttp.ErrDecodingError("codelab", "ExportExercises", err)
 			}
-			err = ValidateCreateTestResponseBody(&body)
+			err = ValidateExportExercisesResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("codelab", "CreateTest", err)
+				return nil, goahttp.ErrValidationError("codelab", "ExportExercises", err)
 			}
-			res := NewCreateTestSimpleResponseCreated(&body)
+			res := NewExportExercisesExerciseBundleFileOK(&body)
 			return res, nil
 		case http.StatusBadRequest:
 			var (
@@ -760,9 +936,19 @@ func DecodeCreateTestResponse(decoder func(*http.Response) goahttp.Decoder, rest
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("codelab", "CreateTest", err)
+				return nil, goahttp.ErrDecodingError("codelab", "ExportExercises", err)
 			}
-			return nil, NewCreateTestInvalidInput(body)
+			return nil, NewExportExercisesInvalidInput(body)
+		case http.StatusNotFound:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("codelab", "ExportExercises", err)
+			}
+			return nil, NewExportExercisesNotFound(body)
 		case http.StatusForbidden:
 			var (
 				body string
@@ -770,9 +956,9 @@ func DecodeCreateTestResponse(decoder func(*http.Response) goahttp.Decoder, rest
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("codelab", "CreateTest", err)
+				return nil, goahttp.ErrDecodingError("codelab", "ExportExercises", err)
 			}
-			return nil, NewCreateTestPermissionDenied(body)
+			return nil, NewExportExercisesPermissionDenied(body)
 		case http.StatusServiceUnavailable:
 			var (
 				body string
@@ -780,9 +966,9 @@ func DecodeCreateTestResponse(decoder func(*http.Response) goahttp.Decoder, rest
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("codelab", "CreateTest", err)
+				return nil, goahttp.ErrDecodingError("codelab", "ExportExercises", err)
 			}
-			return nil, NewCreateTestServiceUnavailable(body)
+			return nil, NewExportExercisesServiceUnavailable(body)
 		case http.StatusUnauthorized:
 			var (
 				body string
@@ -790,34 +976,23 @@ func DecodeCreateTestResponse(decoder func(*http.Response) goahttp.Decoder, rest
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("codelab", "CreateTest", err)
+				return nil, goahttp.ErrDecodingError("codelab", "ExportExercises", err)
 			}
-			return nil, NewCreateTestUnauthorized(body)
+			return nil, NewExportExercisesUnauthorized(body)
 		default:
 			body, _ := io.ReadAll(resp.Body)
-			return nil, goahttp.ErrInvalidResponse("codelab", "CreateTest", resp.StatusCode, string(body))
+			return nil, goahttp.ErrInvalidResponse("codelab", "ExportExercises", resp.StatusCode, string(body))
 		}
 	}
 }
 
-// BuildGetTestsByExerciseRequest instantiates a HTTP request object with
-// method and path set to call the "codelab" service "GetTestsByExercise"
-// endpoint
-func (c *Client) BuildGetTestsByExerciseRequest(ctx context.Context, v any) (*http.Request, error) {
-	var (
-		exerciseID int64
-	)
-	{
-		p, ok := v.(*codelab.GetTestsByExercisePayload)
-		if !ok {
-			return nil, goahttp.ErrInvalidType("codelab", "GetTestsByExercise", "*codelab.GetTestsByExercisePayload", v)
-		}
-		exerciseID = p.ExerciseID
-	}
-	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: GetTestsByExerciseCodelabPath(exerciseID)}
-	req, err := http.NewRequest("GET", u.String(), nil)
+// BuildImportExercisesRequest instantiates a HTTP request object with method
+// and path set to call the "codelab" service "ImportExercises" endpoint
+func (c *Client) BuildImportExercisesRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: ImportExercisesCodelabPath()}
+	req, err := http.NewRequest("POST", u.String(), nil)
 	if err != nil {
-		return nil, goahttp.ErrInvalidURL("codelab", "GetTestsByExercise", u.String(), err)
+		return nil, goahttp.ErrInvalidURL("codelab", "ImportExercises", u.String(), err)
 	}
 	if ctx != nil {
 		req = req.WithContext(ctx)
@@ -826,13 +1001,13 @@ func (c *Client) BuildGetTestsByExerciseRequest(ctx context.Context, v any) (*ht
 	return req, nil
 }
 
-// EncodeGetTestsByExerciseRequest returns an encoder for requests sent to the
-// codelab GetTestsByExercise server.
-func EncodeGetTestsByExerciseRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+// EncodeImportExercisesRequest returns an encoder for requests sent to the
+// codelab ImportExercises server.
+func EncodeImportExercisesRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
 	return func(req *http.Request, v any) error {
-		p, ok := v.(*codelab.GetTestsByExercisePayload)
+		p, ok := v.(*codelab.ImportExercisesPayload)
 		if !ok {
-			return goahttp.ErrInvalidType("codelab", "GetTestsByExercise", "*codelab.GetTestsByExercisePayload", v)
+			return goahttp.ErrInvalidType("codelab", "ImportExercises", "*codelab.ImportExercisesPayload", v)
 		}
 		{
 			v := p.SessionToken
@@ -841,21 +1016,25 @@ func EncodeGetTestsByExerciseRequest(encoder func(*http.Request) goahttp.Encoder
 				Value: v,
 			})
 		}
+		body := NewImportExercisesRequestBody(p)
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("codelab", "ImportExercises", err)
+		}
 		return nil
 	}
 }
 
-// DecodeGetTestsByExerciseResponse returns a decoder for responses returned by
-// the codelab GetTestsByExercise endpoint. restoreBody controls whether the
+// DecodeImportExercisesResponse returns a decoder for responses returned by
+// the codelab ImportExercises endpoint. restoreBody controls whether the
 // response body should be restored after having been read.
-// DecodeGetTestsByExerciseResponse may return the following errors:
+// DecodeImportExercisesResponse may return the following errors:
+//   - "solution_failed" (type *codelab.SolutionFailure): http.StatusUnprocessableEntity
 //   - "invalid_input" (type codelab.InvalidInput): http.StatusBadRequest
-//   - "not_found" (type codelab.NotFound): http.StatusNotFound
 //   - "permission_denied" (type codelab.PermissionDenied): http.StatusForbidden
 //   - "service_unavailable" (type codelab.ServiceUnavailable): http.StatusServiceUnavailable
 //   - "unauthorized" (type codelab.Unauthorized): http.StatusUnauthorized
 //   - error: internal error
-func DecodeGetTestsByExerciseResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+func DecodeImportExercisesResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
 	return func(resp *http.Response) (any, error) {
 		if restoreBody {
 			b, err := io.ReadAll(resp.Body)
@@ -870,47 +1049,45 @@ func DecodeGetTestsByExerciseResponse(decoder func(*http.Response) goahttp.Decod
 			defer resp.Body.Close()
 		}
 		switch resp.StatusCode {
-		case http.StatusOK:
+		case http.StatusCreated:
 			var (
-				body GetTestsByExerciseResponseBody
+				body ImportExercisesResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("codelab", "GetTestsByExercise", err)
-			}
-			for _, e := range body {
-				if e != nil {
-					if err2 := ValidateTestResponse(e); err2 != nil {
-						err = goa.MergeErrors(err, err2)
-					}
-				}
+				return nil, goahttp.ErrDecodingError("codelab", "ImportExercises", err)
 			}
+			err = ValidateImportExercisesResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("codelab", "GetTestsByExercise", err)
+				return nil, goahttp.ErrValidationError("codelab", "ImportExercises", err)
 			}
-			res := NewGetTestsByExerciseTestOK(body)
+			res := NewImportExercisesImportedExercisesCreated(&body)
 			return res, nil
-		case http.StatusBadRequest:
+		case http.StatusUnprocessableEntity:
 			var (
-				body string
+				body ImportExercisesSolutionFailedResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("codelab", "GetTestsByExercise", err)
+				return nil, goahttp.ErrDecodingError("codelab", "ImportExercises", err)
 			}
-			return nil, NewGetTestsByExerciseInvalidInput(body)
-		case http.StatusNotFound:
+			err = ValidateImportExercisesSolutionFailedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("codelab", "ImportExercises", err)
+			}
+			return nil, NewImportExercisesSolutionFailed(&body)
+		case http.StatusBadRequest:
 			var (
 				body string
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("codelab", "GetTestsByExercise", err)
+				return nil, goahttp.ErrDecodingError("codelab", "ImportExercises", err)
 			}
-			return nil, NewGetTestsByExerciseNotFound(body)
+			return nil, NewImportExercisesInvalidInput(body)
 		case http.StatusForbidden:
 			var (
 				body string
@@ -918,9 +1095,9 @@ func DecodeGetTestsByExerciseResponse(decoder func(*http.Response) goahttp.Decod
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("codelab", "GetTestsByExercise", err)
+				return nil, goahttp.ErrDecodingError("codelab", "ImportExercises", err)
 			}
-			return nil, NewGetTestsByExercisePermissionDenied(body)
+			return nil, NewImportExercisesPermissionDenied(body)
 		case http.StatusServiceUnavailable:
 			var (
 				body string
@@ -928,9 +1105,9 @@ func DecodeGetTestsByExerciseResponse(decoder func(*http.Response) goahttp.Decod
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("codelab", "GetTestsByExercise", err)
+				return nil, goahttp.ErrDecodingError("codelab", "ImportExercises", err)
 			}
-			return nil, NewGetTestsByExerciseServiceUnavailable(body)
+			return nil, NewImportExercisesServiceUnavailable(body)
 		case http.StatusUnauthorized:
 			var (
 				body string
@@ -938,33 +1115,23 @@ func DecodeGetTestsByExerciseResponse(decoder func(*http.Response) goahttp.Decod
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("codelab", "GetTestsByExercise", err)
+				return nil, goahttp.ErrDecodingError("codelab", "ImportExercises", err)
 			}
-			return nil, NewGetTestsByExerciseUnauthorized(body)
+			return nil, NewImportExercisesUnauthorized(body)
 		default:
 			body, _ := io.ReadAll(resp.Body)
-			return nil, goahttp.ErrInvalidResponse("codelab", "GetTestsByExercise", resp.StatusCode, string(body))
+			return nil, goahttp.ErrInvalidResponse("codelab", "ImportExercises", resp.StatusCode, string(body))
 		}
 	}
 }
 
-// BuildUpdateTestRequest instantiates a HTTP request object with method and
-// path set to call the "codelab" service "UpdateTest" endpoint
-func (c *Client) BuildUpdateTestRequest(ctx context.Context, v any) (*http.Request, error) {
-	var (
-		id int64
-	)
-	{
-		p, ok := v.(*codelab.UpdateTestPayload2)
-		if !ok {
-			return nil, goahttp.ErrInvalidType("codelab", "UpdateTest", "*codelab.UpdateTestPayload2", v)
-		}
-		id = p.ID
-	}
-	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: UpdateTestCodelabPath(id)}
-	req, err := http.NewRequest("PUT", u.String(), nil)
+// BuildCreateTestRequest instantiates a HTTP request object with method and
+// path set to call the "codelab" service "CreateTest" endpoint
+func (c *Client) BuildCreateTestRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: CreateTestCodelabPath()}
+	req, err := http.NewRequest("POST", u.String(), nil)
 	if err != nil {
-		return nil, goahttp.ErrInvalidURL("codelab", "UpdateTest", u.String(), err)
+		return nil, goahttp.ErrInvalidURL("codelab", "CreateTest", u.String(), err)
 	}
 	if ctx != nil {
 		req = req.WithContext(ctx)
@@ -973,13 +1140,310 @@ func (c *Client) BuildUpdateTestRequest(ctx context.Context, v any) (*http.Reque
 	return req, nil
 }
 
-// EncodeUpdateTestRequest returns an encoder for requests sent to the codelab
-// UpdateTest server.
-func EncodeUpdateTestRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+// EncodeCreateTestRequest returns an encoder for requests sent to the codelab
+// CreateTest server.
+func EncodeCreateTestRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
 	return func(req *http.Request, v any) error {
-		p, ok := v.(*codelab.UpdateTestPayload2)
+		p, ok := v.(*codelab.CreateTestPayload)
 		if !ok {
-			return goahttp.ErrInvalidType("codelab", "UpdateTest", "*codelab.UpdateTestPayload2", v)
+			return goahttp.ErrInvalidType("codelab", "CreateTest", "*codelab.CreateTestPayload", v)
+		}
+		{
+			v := p.SessionToken
+			req.AddCookie(&http.Cookie{
+				Name:  "session",
+				Value: v,
+			})
+		}
+		body := NewCreateTestRequestBody(p)
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("codelab", "CreateTest", err)
+		}
+		return nil
+	}
+}
+
+// DecodeCreateTestResponse returns a decoder for responses returned by the
+// codelab CreateTest endpoint. restoreBody controls whether the response body
+// should be restored after having been read.
+// DecodeCreateTestResponse may return the following errors:
+//   - "solution_failed" (type *codelab.SolutionFailure): http.StatusUnprocessableEntity
+//   - "invalid_input" (type codelab.InvalidInput): http.StatusBadRequest
+//   - "permission_denied" (type codelab.PermissionDenied): http.StatusForbidden
+//   - "service_unavailable" (type codelab.ServiceUnavailable): http.StatusServiceUnavailable
+//   - "unauthorized" (type codelab.Unauthorized): http.StatusUnauthorized
+//   - error: internal error
+func DecodeCreateTestResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusCreated:
+			var (
+				body CreateTestResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("codelab", "CreateTest", err)
+			}
+			err = ValidateCreateTestResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("codelab", "CreateTest", err)
+			}
+			res := NewCreateTestSimpleResponseCreated(&body)
+			return res, nil
+		case http.StatusUnprocessableEntity:
+			var (
+				body CreateTestSolutionFailedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("codelab", "CreateTest", err)
+			}
+			err = ValidateCreateTestSolutionFailedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("codelab", "CreateTest", err)
+			}
+			return nil, NewCreateTestSolutionFailed(&body)
+		case http.StatusBadRequest:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("codelab", "CreateTest", err)
+			}
+			return nil, NewCreateTestInvalidInput(body)
+		case http.StatusForbidden:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("codelab", "CreateTest", err)
+			}
+			return nil, NewCreateTestPermissionDenied(body)
+		case http.StatusServiceUnavailable:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("codelab", "CreateTest", err)
+			}
+			return nil, NewCreateTestServiceUnavailable(body)
+		case http.StatusUnauthorized:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("codelab", "CreateTest", err)
+			}
+			return nil, NewCreateTestUnauthorized(body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("codelab", "CreateTest", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildGetTestsByExerciseRequest instantiates a HTTP request object with
+// method and path set to call the "codelab" service "GetTestsByExercise"
+// endpoint
+func (c *Client) BuildGetTestsByExerciseRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		exerciseID int64
+	)
+	{
+		p, ok := v.(*codelab.GetTestsByExercisePayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("codelab", "GetTestsByExercise", "*codelab.GetTestsByExercisePayload", v)
+		}
+		exerciseID = p.ExerciseID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: GetTestsByExerciseCodelabPath(exerciseID)}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("codelab", "GetTestsByExercise", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeGetTestsByExerciseRequest returns an encoder for requests sent to the
+// codelab GetTestsByExercise server.
+func EncodeGetTestsByExerciseRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*codelab.GetTestsByExercisePayload)
+		if !ok {
+			return goahttp.ErrInvalidType("codelab", "GetTestsByExercise", "*codelab.GetTestsByExercisePayload", v)
+		}
+		{
+			v := p.SessionToken
+			req.AddCookie(&http.Cookie{
+				Name:  "session",
+				Value: v,
+			})
+		}
+		return nil
+	}
+}
+
+// DecodeGetTestsByExerciseResponse returns a decoder for responses returned by
+// the codelab GetTestsByExercise endpoint. restoreBody controls whether the
+// response body should be restored after having been read.
+// DecodeGetTestsByExerciseResponse may return the following errors:
+//   - "invalid_input" (type codelab.InvalidInput): http.StatusBadRequest
+//   - "not_found" (type codelab.NotFound): http.StatusNotFound
+//   - "permission_denied" (type codelab.PermissionDenied): http.StatusForbidden
+//   - "service_unavailable" (type codelab.ServiceUnavailable): http.StatusServiceUnavailable
+//   - "unauthorized" (type codelab.Unauthorized): http.StatusUnauthorized
+//   - error: internal error
+func DecodeGetTestsByExerciseResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body GetTestsByExerciseResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("codelab", "GetTestsByExercise", err)
+			}
+			for _, e := range body {
+				if e != nil {
+					if err2 := ValidateTestResponse(e); err2 != nil {
+						err = goa.MergeErrors(err, err2)
+					}
+				}
+			}
+			if err != nil {
+				return nil, goahttp.ErrValidationError("codelab", "GetTestsByExercise", err)
+			}
+			res := NewGetTestsByExerciseTestOK(body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("codelab", "GetTestsByExercise", err)
+			}
+			return nil, NewGetTestsByExerciseInvalidInput(body)
+		case http.StatusNotFound:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("codelab", "GetTestsByExercise", err)
+			}
+			return nil, NewGetTestsByExerciseNotFound(body)
+		case http.StatusForbidden:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("codelab", "GetTestsByExercise", err)
+			}
+			return nil, NewGetTestsByExercisePermissionDenied(body)
+		case http.StatusServiceUnavailable:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("codelab", "GetTestsByExercise", err)
+			}
+			return nil, NewGetTestsByExerciseServiceUnavailable(body)
+		case http.StatusUnauthorized:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("codelab", "GetTestsByExercise", err)
+			}
+			return nil, NewGetTestsByExerciseUnauthorized(body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("codelab", "GetTestsByExercise", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildUpdateTestRequest instantiates a HTTP request object with method and
+// path set to call the "codelab" service "UpdateTest" endpoint
+func (c *Client) BuildUpdateTestRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		id int64
+	)
+	{
+		p, ok := v.(*codelab.UpdateTestPayload2)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("codelab", "UpdateTest", "*codelab.UpdateTestPayload2", v)
+		}
+		id = p.ID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: UpdateTestCodelabPath(id)}
+	req, err := http.NewRequest("PUT", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("codelab", "UpdateTest", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeUpdateTestRequest returns an encoder for requests sent to the codelab
+// UpdateTest server.
+func EncodeUpdateTestRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*codelab.UpdateTestPayload2)
+		if !ok {
+			return goahttp.ErrInvalidType("codelab", "UpdateTest", "*codelab.UpdateTestPayload2", v)
 		}
 		{
 			v := p.SessionToken
@@ -990,23 +1454,3679 @@ func EncodeUpdateTestRequest(encoder func(*http.Request) goahttp.Encoder) func(*
 		}
 		body := NewUpdateTestRequestBody(p)
 		if err := encoder(req).Encode(&body); err != nil {
-			return goahttp.ErrEncodingError("codelab", "UpdateTest", err)
+			return goahttp.ErrEncodingError("codelab", "UpdateTest", err)
+		}
+		return nil
+	}
+}
+
+// DecodeUpdateTestResponse returns a decoder for responses returned by the
+// codelab UpdateTest endpoint. restoreBody controls whether the response body
+// should be restored after having been read.
+// DecodeUpdateTestResponse may return the following errors:
+//   - "solution_failed" (type *codelab.SolutionFailure): http.StatusUnprocessableEntity
+//   - "invalid_input" (type codelab.InvalidInput): http.StatusBadRequest
+//   - "not_found" (type codelab.NotFound): http.StatusNotFound
+//   - "permission_denied" (type codelab.PermissionDenied): http.StatusForbidden
+//   - "service_unavailable" (type codelab.ServiceUnavailable): http.StatusServiceUnavailable
+//   - "unauthorized" (type codelab.Unauthorized): http.StatusUnauthorized
+//   - error: internal error
+func DecodeUpdateTestResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body UpdateTestResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("codelab", "UpdateTest", err)
+			}
+			err = ValidateUpdateTestResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("codelab", "UpdateTest", err)
+			}
+			res := NewUpdateTestSimpleResponseOK(&body)
+			return res, nil
+		case http.StatusUnprocessableEntity:
+			var (
+				body UpdateTestSolutionFailedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("codelab", "UpdateTest", err)
+			}
+			err = ValidateUpdateTestSolutionFailedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("codelab", "UpdateTest", err)
+			}
+			return nil, NewUpdateTestSolutionFailed(&body)
+		case http.StatusBadRequest:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("codelab", "UpdateTest", err)
+			}
+			return nil, NewUpdateTestInvalidInput(body)
+		case http.StatusNotFound:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("codelab", "UpdateTest", err)
+			}
+			return nil, NewUpdateTestNotFound(body)
+		case http.StatusForbidden:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("codelab", "UpdateTest", err)
+			}
+			return nil, NewUpdateTestPermissionDenied(body)
+		case http.StatusServiceUnavailable:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("codelab", "UpdateTest", err)
+			}
+			return nil, NewUpdateTestServiceUnavailable(body)
+		case http.StatusUnauthorized:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("codelab", "UpdateTest", err)
+			}
+			return nil, NewUpdateTestUnauthorized(body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("codelab", "UpdateTest", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildDeleteTestRequest instantiates a HTTP request object with method and
+// path set to call the "codelab" service "DeleteTest" endpoint
+func (c *Client) BuildDeleteTestRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		id int64
+	)
+	{
+		p, ok := v.(*codelab.DeleteTestPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("codelab", "DeleteTest", "*codelab.DeleteTestPayload", v)
+		}
+		id = p.ID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: DeleteTestCodelabPath(id)}
+	req, err := http.NewRequest("DELETE", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("codelab", "DeleteTest", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeDeleteTestRequest returns an encoder for requests sent to the codelab
+// DeleteTest server.
+func EncodeDeleteTestRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*codelab.DeleteTestPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("codelab", "DeleteTest", "*codelab.DeleteTestPayload", v)
+		}
+		{
+			v := p.SessionToken
+			req.AddCookie(&http.Cookie{
+				Name:  "session",
+				Value: v,
+			})
+		}
+		return nil
+	}
+}
+
+// DecodeDeleteTestResponse returns a decoder for responses returned by the
+// codelab DeleteTest endpoint. restoreBody controls whether the response body
+// should be restored after having been read.
+// DecodeDeleteTestResponse may return the following errors:
+//   - "invalid_input" (type codelab.InvalidInput): http.StatusBadRequest
+//   - "not_found" (type codelab.NotFound): http.StatusNotFound
+//   - "permission_denied" (type codelab.PermissionDenied): http.StatusForbidden
+//   - "service_unavailable" (type codelab.ServiceUnavailable): http.StatusServiceUnavailable
+//   - "unauthorized" (type codelab.Unauthorized): http.StatusUnauthorized
+//   - error: internal error
+func DecodeDeleteTestResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body DeleteTestResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("codelab", "DeleteTest", err)
+			}
+			err = ValidateDeleteTestResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("codelab", "DeleteTest", err)
+			}
+			res := NewDeleteTestSimpleResponseOK(&body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("codelab", "DeleteTest", err)
+			}
+			return nil, NewDeleteTestInvalidInput(body)
+		case http.StatusNotFound:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("codelab", "DeleteTest", err)
+			}
+			return nil, NewDeleteTestNotFound(body)
+		case http.StatusForbidden:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("codelab", "DeleteTest", err)
+			}
+			return nil, NewDeleteTestPermissionDenied(body)
+		case http.StatusServiceUnavailable:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("codelab", "DeleteTest", err)
+			}
+			return nil, NewDeleteTestServiceUnavailable(body)
+		case http.StatusUnauthorized:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("codelab", "DeleteTest", err)
+			}
+			return nil, NewDeleteTestUnauthorized(body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("codelab", "DeleteTest", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildListExerciseVersionsRequest instantiates a HTTP request object with
+// method and path set to call the "codelab" service "ListExerciseVersions"
+// endpoint
+func (c *Client) BuildListExerciseVersionsRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		exerciseID int64
+	)
+	{
+		p, ok := v.(*codelab.ListExerciseVersionsPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("codelab", "ListExerciseVersions", "*codelab.ListExerciseVersionsPayload", v)
+		}
+		exerciseID = p.ExerciseID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: ListExerciseVersionsCodelabPath(exerciseID)}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("codelab", "ListExerciseVersions", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeListExerciseVersionsRequest returns an encoder for requests sent to
+// the codelab ListExerciseVersions server.
+func EncodeListExerciseVersionsRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*codelab.ListExerciseVersionsPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("codelab", "ListExerciseVersions", "*codelab.ListExerciseVersionsPayload", v)
+		}
+		{
+			v := p.SessionToken
+			req.AddCookie(&http.Cookie{
+				Name:  "session",
+				Value: v,
+			})
+		}
+		return nil
+	}
+}
+
+// DecodeListExerciseVersionsResponse returns a decoder for responses returned
+// by the codelab ListExerciseVersions endpoint. restoreBody controls whether
+// the response body should be restored after having been read.
+// DecodeListExerciseVersionsResponse may return the following errors:
+//   - "invalid_input" (type codelab.InvalidInput): http.StatusBadRequest
+//   - "not_found" (type codelab.NotFound): http.StatusNotFound
+//   - "permission_denied" (type codelab.PermissionDenied): http.StatusForbidden
+//   - "service_unavailable" (type codelab.ServiceUnavailable): http.StatusServiceUnavailable
+//   - "unauthorized" (type codelab.Unauthorized): http.StatusUnauthorized
+//   - error: internal error
+func DecodeListExerciseVersionsResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body ListExerciseVersionsResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("codelab", "ListExerciseVersions", err)
+			}
+			for _, e := range body {
+				if e != nil {
+					if err2 := ValidateExerciseVersionResponse(e); err2 != nil {
+						err = goa.MergeErrors(err, err2)
+					}
+				}
+			}
+			if err != nil {
+				return nil, goahttp.ErrValidationError("codelab", "ListExerciseVersions", err)
+			}
+			res := NewListExerciseVersionsExerciseVersionOK(body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("codelab", "ListExerciseVersions", err)
+			}
+			return nil, NewListExerciseVersionsInvalidInput(body)
+		case http.StatusNotFound:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("codelab", "ListExerciseVersions", err)
+			}
+			return nil, NewListExerciseVersionsNotFound(body)
+		case http.StatusForbidden:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("codelab", "ListExerciseVersions", err)
+			}
+			return nil, NewListExerciseVersionsPermissionDenied(body)
+		case http.StatusServiceUnavailable:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("codelab", "ListExerciseVersions", err)
+			}
+			return nil, NewListExerciseVersionsServiceUnavailable(body)
+		case http.StatusUnauthorized:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("codelab", "ListExerciseVersions", err)
+			}
+			return nil, NewListExerciseVersionsUnauthorized(body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("codelab", "ListExerciseVersions", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildDiffExerciseVersionsRequest instantiates a HTTP request object with
+// method and path set to call the "codelab" service "DiffExerciseVersions"
+// endpoint
+func (c *Client) BuildDiffExerciseVersionsRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		exerciseID int64
+	)
+	{
+		p, ok := v.(*codelab.DiffExerciseVersionsPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("codelab", "DiffExerciseVersions", "*codelab.DiffExerciseVersionsPayload", v)
+		}
+		exerciseID = p.ExerciseID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: DiffExerciseVersionsCodelabPath(exerciseID)}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("codelab", "DiffExerciseVersions", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeDiffExerciseVersionsRequest returns an encoder for requests sent to
+// the codelab DiffExerciseVersions server.
+func EncodeDiffExerciseVersionsRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*codelab.DiffExerciseVersionsPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("codelab", "DiffExerciseVersions", "*codelab.DiffExerciseVersionsPayload", v)
+		}
+		{
+			v := p.SessionToken
+			req.AddCookie(&http.Cookie{
+				Name:  "session",
+				Value: v,
+			})
+		}
+		values := req.URL.Query()
+		values.Add("from", fmt.Sprintf("%v", p.From))
+		values.Add("to", fmt.Sprintf("%v", p.To))
+		req.URL.RawQuery = values.Encode()
+		return nil
+	}
+}
+
+// DecodeDiffExerciseVersionsResponse returns a decoder for responses returned
+// by the codelab DiffExerciseVersions endpoint. restoreBody controls whether
+// the response body should be restored after having been read.
+// DecodeDiffExerciseVersionsResponse may return the following errors:
+//   - "invalid_input" (type codelab.InvalidInput): http.StatusBadRequest
+//   - "not_found" (type codelab.NotFound): http.StatusNotFound
+//   - "permission_denied" (type codelab.PermissionDenied): http.StatusForbidden
+//   - "service_unavailable" (type codelab.ServiceUnavailable): http.StatusServiceUnavailable
+//   - "unauthorized" (type codelab.Unauthorized): http.StatusUnauthorized
+//   - error: internal error
+func DecodeDiffExerciseVersionsResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body DiffExerciseVersionsResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("codelab", "DiffExerciseVersions", err)
+			}
+			err = ValidateDiffExerciseVersionsResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("codelab", "DiffExerciseVersions", err)
+			}
+			res := NewDiffExerciseVersionsExerciseVersionDiffOK(&body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("codelab", "DiffExerciseVersions", err)
+			}
+			return nil, NewDiffExerciseVersionsInvalidInput(body)
+		case http.StatusNotFound:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("codelab", "DiffExerciseVersions", err)
+			}
+			return nil, NewDiffExerciseVersionsNotFound(body)
+		case http.StatusForbidden:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("codelab", "DiffExerciseVersions", err)
+			}
+			return nil, NewDiffExerciseVersionsPermissionDenied(body)
+		case http.StatusServiceUnavailable:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("codelab", "DiffExerciseVersions", err)
+			}
+			return nil, NewDiffExerciseVersionsServiceUnavailable(body)
+		case http.StatusUnauthorized:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("codelab", "DiffExerciseVersions", err)
+			}
+			return nil, NewDiffExerciseVersionsUnauthorized(body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("codelab", "DiffExerciseVersions", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildRollbackExerciseRequest instantiates a HTTP request object with method
+// and path set to call the "codelab" service "RollbackExercise" endpoint
+func (c *Client) BuildRollbackExerciseRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		exerciseID int64
+		version    int32
+	)
+	{
+		p, ok := v.(*codelab.RollbackExercisePayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("codelab", "RollbackExercise", "*codelab.RollbackExercisePayload", v)
+		}
+		exerciseID = p.ExerciseID
+		version = p.Version
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: RollbackExerciseCodelabPath(exerciseID, version)}
+	req, err := http.NewRequest("POST", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("codelab", "RollbackExercise", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeRollbackExerciseRequest returns an encoder for requests sent to the
+// codelab RollbackExercise server.
+func EncodeRollbackExerciseRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*codelab.RollbackExercisePayload)
+		if !ok {
+			return goahttp.ErrInvalidType("codelab", "RollbackExercise", "*codelab.RollbackExercisePayload", v)
+		}
+		{
+			v := p.SessionToken
+			req.AddCookie(&http.Cookie{
+				Name:  "session",
+				Value: v,
+			})
+		}
+		return nil
+	}
+}
+
+// DecodeRollbackExerciseResponse returns a decoder for responses returned by
+// the codelab RollbackExercise endpoint. restoreBody controls whether the
+// response body should be restored after having been read.
+// DecodeRollbackExerciseResponse may return the following errors:
+//   - "solution_failed" (type *codelab.SolutionFailure): http.StatusUnprocessableEntity
+//   - "invalid_input" (type codelab.InvalidInput): http.StatusBadRequest
+//   - "not_found" (type codelab.NotFound): http.StatusNotFound
+//   - "permission_denied" (type codelab.PermissionDenied): http.StatusForbidden
+//   - "service_unavailable" (type codelab.ServiceUnavailable): http.StatusServiceUnavailable
+//   - "unauthorized" (type codelab.Unauthorized): http.StatusUnauthorized
+//   - error: internal error
+func DecodeRollbackExerciseResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body RollbackExerciseResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("codelab", "RollbackExercise", err)
+			}
+			err = ValidateRollbackExerciseResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("codelab", "RollbackExercise", err)
+			}
+			res := NewRollbackExerciseSimpleResponseOK(&body)
+			return res, nil
+		case http.StatusUnprocessableEntity:
+			var (
+				body RollbackExerciseSolutionFailedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("codelab", "RollbackExercise", err)
+			}
+			err = ValidateRollbackExerciseSolutionFailedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("codelab", "RollbackExercise", err)
+			}
+			return nil, NewRollbackExerciseSolutionFailed(&body)
+		case http.StatusBadRequest:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("codelab", "RollbackExercise", err)
+			}
+			return nil, NewRollbackExerciseInvalidInput(body)
+		case http.StatusNotFound:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("codelab", "RollbackExercise", err)
+			}
+			return nil, NewRollbackExerciseNotFound(body)
+		case http.StatusForbidden:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("codelab", "RollbackExercise", err)
+			}
+			return nil, NewRollbackExercisePermissionDenied(body)
+		case http.StatusServiceUnavailable:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("codelab", "RollbackExercise", err)
+			}
+			return nil, NewRollbackExerciseServiceUnavailable(body)
+		case http.StatusUnauthorized:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("codelab", "RollbackExercise", err)
+			}
+			return nil, NewRollbackExerciseUnauthorized(body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("codelab", "RollbackExercise", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildRegradeExerciseRequest instantiates a HTTP request object with method
+// and path set to call the "codelab" service "RegradeExercise" endpoint
+func (c *Client) BuildRegradeExerciseRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		exerciseID int64
+	)
+	{
+		p, ok := v.(*codelab.RegradeExercisePayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("codelab", "RegradeExercise", "*codelab.RegradeExercisePayload", v)
+		}
+		exerciseID = p.ExerciseID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: RegradeExerciseCodelabPath(exerciseID)}
+	req, err := http.NewRequest("POST", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("codelab", "RegradeExercise", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeRegradeExerciseRequest returns an encoder for requests sent to the
+// codelab RegradeExercise server.
+func EncodeRegradeExerciseRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*codelab.RegradeExercisePayload)
+		if !ok {
+			return goahttp.ErrInvalidType("codelab", "RegradeExercise", "*codelab.RegradeExercisePayload", v)
+		}
+		{
+			v := p.SessionToken
+			req.AddCookie(&http.Cookie{
+				Name:  "session",
+				Value: v,
+			})
+		}
+		return nil
+	}
+}
+
+// DecodeRegradeExerciseResponse returns a decoder for responses returned by
+// the codelab RegradeExercise endpoint. restoreBody controls whether the
+// response body should be restored after having been read.
+// DecodeRegradeExerciseResponse may return the following errors:
+//   - "invalid_input" (type codelab.InvalidInput): http.StatusBadRequest
+//   - "not_found" (type codelab.NotFound): http.StatusNotFound
+//   - "permission_denied" (type codelab.PermissionDenied): http.StatusForbidden
+//   - "service_unavailable" (type codelab.ServiceUnavailable): http.StatusServiceUnavailable
+//   - "unauthorized" (type codelab.Unauthorized): http.StatusUnauthorized
+//   - error: internal error
+func DecodeRegradeExerciseResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusAccepted:
+			var (
+				body RegradeExerciseResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("codelab", "RegradeExercise", err)
+			}
+			err = ValidateRegradeExerciseResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("codelab", "RegradeExercise", err)
+			}
+			res := NewRegradeExerciseRegradeAccepted(&body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("codelab", "RegradeExercise", err)
+			}
+			return nil, NewRegradeExerciseInvalidInput(body)
+		case http.StatusNotFound:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("codelab", "RegradeExercise", err)
+			}
+			return nil, NewRegradeExerciseNotFound(body)
+		case http.StatusForbidden:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("codelab", "RegradeExercise", err)
+			}
+			return nil, NewRegradeExercisePermissionDenied(body)
+		case http.StatusServiceUnavailable:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("codelab", "RegradeExercise", err)
+			}
+			return nil, NewRegradeExerciseServiceUnavailable(body)
+		case http.StatusUnauthorized:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("codelab", "RegradeExercise", err)
+			}
+			return nil, NewRegradeExerciseUnauthorized(body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("codelab", "RegradeExercise", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildGetRegradeRequest instantiates a HTTP request object with method and
+// path set to call the "codelab" service "GetRegrade" endpoint
+func (c *Client) BuildGetRegradeRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		id int64
+	)
+	{
+		p, ok := v.(*codelab.GetRegradePayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("codelab", "GetRegrade", "*codelab.GetRegradePayload", v)
+		}
+		id = p.ID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: GetRegradeCodelabPath(id)}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("codelab", "GetRegrade", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeGetRegradeRequest returns an encoder for requests sent to the codelab
+// GetRegrade server.
+func EncodeGetRegradeRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*codelab.GetRegradePayload)
+		if !ok {
+			return goahttp.ErrInvalidType("codelab", "GetRegrade", "*codelab.GetRegradePayload", v)
+		}
+		{
+			v := p.SessionToken
+			req.AddCookie(&http.Cookie{
+				Name:  "session",
+				Value: v,
+			})
+		}
+		return nil
+	}
+}
+
+// DecodeGetRegradeResponse returns a decoder for responses returned by the
+// codelab GetRegrade endpoint. restoreBody controls whether the response body
+// should be restored after having been read.
+// DecodeGetRegradeResponse may return the following errors:
+//   - "invalid_input" (type codelab.InvalidInput): http.StatusBadRequest
+//   - "not_found" (type codelab.NotFound): http.StatusNotFound
+//   - "permission_denied" (type codelab.PermissionDenied): http.StatusForbidden
+//   - "service_unavailable" (type codelab.ServiceUnavailable): http.StatusServiceUnavailable
+//   - "unauthorized" (type codelab.Unauthorized): http.StatusUnauthorized
+//   - error: internal error
+func DecodeGetRegradeResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body GetRegradeResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("codelab", "GetRegrade", err)
+			}
+			err = ValidateGetRegradeResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("codelab", "GetRegrade", err)
+			}
+			res := NewGetRegradeRegradeOK(&body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("codelab", "GetRegrade", err)
+			}
+			return nil, NewGetRegradeInvalidInput(body)
+		case http.StatusNotFound:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("codelab", "GetRegrade", err)
+			}
+			return nil, NewGetRegradeNotFound(body)
+		case http.StatusForbidden:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("codelab", "GetRegrade", err)
+			}
+			return nil, NewGetRegradePermissionDenied(body)
+		case http.StatusServiceUnavailable:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("codelab", "GetRegrade", err)
+			}
+			return nil, NewGetRegradeServiceUnavailable(body)
+		case http.StatusUnauthorized:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("codelab", "GetRegrade", err)
+			}
+			return nil, NewGetRegradeUnauthorized(body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("codelab", "GetRegrade", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildCreateHintRequest instantiates a HTTP request object with method and
+// path set to call the "codelab" service "CreateHint" endpoint
+func (c *Client) BuildCreateHintRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: CreateHintCodelabPath()}
+	req, err := http.NewRequest("POST", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("codelab", "CreateHint", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeCreateHintRequest returns an encoder for requests sent to the codelab
+// CreateHint server.
+func EncodeCreateHintRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*codelab.CreateHintPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("codelab", "CreateHint", "*codelab.CreateHintPayload", v)
+		}
+		{
+			v := p.SessionToken
+			req.AddCookie(&http.Cookie{
+				Name:  "session",
+				Value: v,
+			})
+		}
+		body := NewCreateHintRequestBody(p)
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("codelab", "CreateHint", err)
+		}
+		return nil
+	}
+}
+
+// DecodeCreateHintResponse returns a decoder for responses returned by the
+// codelab CreateHint endpoint. restoreBody controls whether the response body
+// should be restored after having been read.
+// DecodeCreateHintResponse may return the following errors:
+//   - "invalid_input" (type codelab.InvalidInput): http.StatusBadRequest
+//   - "not_found" (type codelab.NotFound): http.StatusNotFound
+//   - "permission_denied" (type codelab.PermissionDenied): http.StatusForbidden
+//   - "service_unavailable" (type codelab.ServiceUnavailable): http.StatusServiceUnavailable
+//   - "unauthorized" (type codelab.Unauthorized): http.StatusUnauthorized
+//   - error: internal error
+func DecodeCreateHintResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusCreated:
+			var (
+				body CreateHintResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("codelab", "CreateHint", err)
+			}
+			err = ValidateCreateHintResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("codelab", "CreateHint", err)
+			}
+			res := NewCreateHintSimpleResponseCreated(&body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("codelab", "CreateHint", err)
+			}
+			return nil, NewCreateHintInvalidInput(body)
+		case http.StatusNotFound:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("codelab", "CreateHint", err)
+			}
+			return nil, NewCreateHintNotFound(body)
+		case http.StatusForbidden:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("codelab", "CreateHint", err)
+			}
+			return nil, NewCreateHintPermissionDenied(body)
+		case http.StatusServiceUnavailable:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("codelab", "CreateHint", err)
+			}
+			return nil, NewCreateHintServiceUnavailable(body)
+		case http.StatusUnauthorized:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("codelab", "CreateHint", err)
+			}
+			return nil, NewCreateHintUnauthorized(body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("codelab", "CreateHint", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildGetHintsByExerciseRequest instantiates a HTTP request object with
+// method and path set to call the "codelab" service "GetHintsByExercise"
+// endpoint
+func (c *Client) BuildGetHintsByExerciseRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		exerciseID int64
+	)
+	{
+		p, ok := v.(*codelab.GetHintsByExercisePayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("codelab", "GetHintsByExercise", "*codelab.GetHintsByExercisePayload", v)
+		}
+		exerciseID = p.ExerciseID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: GetHintsByExerciseCodelabPath(exerciseID)}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("codelab", "GetHintsByExercise", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeGetHintsByExerciseRequest returns an encoder for requests sent to the
+// codelab GetHintsByExercise server.
+func EncodeGetHintsByExerciseRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*codelab.GetHintsByExercisePayload)
+		if !ok {
+			return goahttp.ErrInvalidType("codelab", "GetHintsByExercise", "*codelab.GetHintsByExercisePayload", v)
+		}
+		{
+			v := p.SessionToken
+			req.AddCookie(&http.Cookie{
+				Name:  "session",
+				Value: v,
+			})
+		}
+		return nil
+	}
+}
+
+// DecodeGetHintsByExerciseResponse returns a decoder for responses returned by
+// the codelab GetHintsByExercise endpoint. restoreBody controls whether the
+// response body should be restored after having been read.
+// DecodeGetHintsByExerciseResponse may return the following errors:
+//   - "invalid_input" (type codelab.InvalidInput): http.StatusBadRequest
+//   - "not_found" (type codelab.NotFound): http.StatusNotFound
+//   - "permission_denied" (type codelab.PermissionDenied): http.StatusForbidden
+//   - "service_unavailable" (type codelab.ServiceUnavailable): http.StatusServiceUnavailable
+//   - "unauthorized" (type codelab.Unauthorized): http.StatusUnauthorized
+//   - error: internal error
+func DecodeGetHintsByExerciseResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body GetHintsByExerciseResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("codelab", "GetHintsByExercise", err)
+			}
+			for _, e := range body {
+				if e != nil {
+					if err2 := ValidateHintResponse(e); err2 != nil {
+						err = goa.MergeErrors(err, err2)
+					}
+				}
+			}
+			if err != nil {
+				return nil, goahttp.ErrValidationError("codelab", "GetHintsByExercise", err)
+			}
+			res := NewGetHintsByExerciseHintOK(body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("codelab", "GetHintsByExercise", err)
+			}
+			return nil, NewGetHintsByExerciseInvalidInput(body)
+		case http.StatusNotFound:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("codelab", "GetHintsByExercise", err)
+			}
+			return nil, NewGetHintsByExerciseNotFound(body)
+		case http.StatusForbidden:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("codelab", "GetHintsByExercise", err)
+			}
+			return nil, NewGetHintsByExercisePermissionDenied(body)
+		case http.StatusServiceUnavailable:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("codelab", "GetHintsByExercise", err)
+			}
+			return nil, NewGetHintsByExerciseServiceUnavailable(body)
+		case http.StatusUnauthorized:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("codelab", "GetHintsByExercise", err)
+			}
+			return nil, NewGetHintsByExerciseUnauthorized(body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("codelab", "GetHintsByExercise", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildUpdateHintRequest instantiates a HTTP request object with method and
+// path set to call the "codelab" service "UpdateHint" endpoint
+func (c *Client) BuildUpdateHintRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		id int64
+	)
+	{
+		p, ok := v.(*codelab.UpdateHintPayload2)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("codelab", "UpdateHint", "*codelab.UpdateHintPayload2", v)
+		}
+		id = p.ID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: UpdateHintCodelabPath(id)}
+	req, err := http.NewRequest("PUT", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("codelab", "UpdateHint", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeUpdateHintRequest returns an encoder for requests sent to the codelab
+// UpdateHint server.
+func EncodeUpdateHintRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*codelab.UpdateHintPayload2)
+		if !ok {
+			return goahttp.ErrInvalidType("codelab", "UpdateHint", "*codelab.UpdateHintPayload2", v)
+		}
+		{
+			v := p.SessionToken
+			req.AddCookie(&http.Cookie{
+				Name:  "session",
+				Value: v,
+			})
+		}
+		body := NewUpdateHintRequestBody(p)
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("codelab", "UpdateHint", err)
+		}
+		return nil
+	}
+}
+
+// DecodeUpdateHintResponse returns a decoder for responses returned by the
+// codelab UpdateHint endpoint. restoreBody controls whether the response body
+// should be restored after having been read.
+// DecodeUpdateHintResponse may return the following errors:
+//   - "invalid_input" (type codelab.InvalidInput): http.StatusBadRequest
+//   - "not_found" (type codelab.NotFound): http.StatusNotFound
+//   - "permission_denied" (type codelab.PermissionDenied): http.StatusForbidden
+//   - "service_unavailable" (type codelab.ServiceUnavailable): http.StatusServiceUnavailable
+//   - "unauthorized" (type codelab.Unauthorized): http.StatusUnauthorized
+//   - error: internal error
+func DecodeUpdateHintResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body UpdateHintResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("codelab", "UpdateHint", err)
+			}
+			err = ValidateUpdateHintResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("codelab", "UpdateHint", err)
+			}
+			res := NewUpdateHintSimpleResponseOK(&body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("codelab", "UpdateHint", err)
+			}
+			return nil, NewUpdateHintInvalidInput(body)
+		case http.StatusNotFound:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("codelab", "UpdateHint", err)
+			}
+			return nil, NewUpdateHintNotFound(body)
+		case http.StatusForbidden:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("codelab", "UpdateHint", err)
+			}
+			return nil, NewUpdateHintPermissionDenied(body)
+		case http.StatusServiceUnavailable:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("codelab", "UpdateHint", err)
+			}
+			return nil, NewUpdateHintServiceUnavailable(body)
+		case http.StatusUnauthorized:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("codelab", "UpdateHint", err)
+			}
+			return nil, NewUpdateHintUnauthorized(body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("codelab", "UpdateHint", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildDeleteHintRequest instantiates a HTTP request object with method and
+// path set to call the "codelab" service "DeleteHint" endpoint
+func (c *Client) BuildDeleteHintRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		id int64
+	)
+	{
+		p, ok := v.(*codelab.DeleteHintPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("codelab", "DeleteHint", "*codelab.DeleteHintPayload", v)
+		}
+		id = p.ID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: DeleteHintCodelabPath(id)}
+	req, err := http.NewRequest("DELETE", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("codelab", "DeleteHint", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeDeleteHintRequest returns an encoder for requests sent to the codelab
+// DeleteHint server.
+func EncodeDeleteHintRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*codelab.DeleteHintPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("codelab", "DeleteHint", "*codelab.DeleteHintPayload", v)
+		}
+		{
+			v := p.SessionToken
+			req.AddCookie(&http.Cookie{
+				Name:  "session",
+				Value: v,
+			})
+		}
+		return nil
+	}
+}
+
+// DecodeDeleteHintResponse returns a decoder for responses returned by the
+// codelab DeleteHint endpoint. restoreBody controls whether the response body
+// should be restored after having been read.
+// DecodeDeleteHintResponse may return the following errors:
+//   - "invalid_input" (type codelab.InvalidInput): http.StatusBadRequest
+//   - "not_found" (type codelab.NotFound): http.StatusNotFound
+//   - "permission_denied" (type codelab.PermissionDenied): http.StatusForbidden
+//   - "service_unavailable" (type codelab.ServiceUnavailable): http.StatusServiceUnavailable
+//   - "unauthorized" (type codelab.Unauthorized): http.StatusUnauthorized
+//   - error: internal error
+func DecodeDeleteHintResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body DeleteHintResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("codelab", "DeleteHint", err)
+			}
+			err = ValidateDeleteHintResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("codelab", "DeleteHint", err)
+			}
+			res := NewDeleteHintSimpleResponseOK(&body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("codelab", "DeleteHint", err)
+			}
+			return nil, NewDeleteHintInvalidInput(body)
+		case http.StatusNotFound:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("codelab", "DeleteHint", err)
+			}
+			return nil, NewDeleteHintNotFound(body)
+		case http.StatusForbidden:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("codelab", "DeleteHint", err)
+			}
+			return nil, NewDeleteHintPermissionDenied(body)
+		case http.StatusServiceUnavailable:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("codelab", "DeleteHint", err)
+			}
+			return nil, NewDeleteHintServiceUnavailable(body)
+		case http.StatusUnauthorized:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("codelab", "DeleteHint", err)
+			}
+			return nil, NewDeleteHintUnauthorized(body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("codelab", "DeleteHint", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildCreateAssignmentRequest instantiates a HTTP request object with method
+// and path set to call the "codelab" service "CreateAssignment" endpoint
+func (c *Client) BuildCreateAssignmentRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: CreateAssignmentCodelabPath()}
+	req, err := http.NewRequest("POST", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("codelab", "CreateAssignment", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeCreateAssignmentRequest returns an encoder for requests sent to the
+// codelab CreateAssignment server.
+func EncodeCreateAssignmentRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*codelab.CreateAssignmentPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("codelab", "CreateAssignment", "*codelab.CreateAssignmentPayload", v)
+		}
+		{
+			v := p.SessionToken
+			req.AddCookie(&http.Cookie{
+				Name:  "session",
+				Value: v,
+			})
+		}
+		body := NewCreateAssignmentRequestBody(p)
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("codelab", "CreateAssignment", err)
+		}
+		return nil
+	}
+}
+
+// DecodeCreateAssignmentResponse returns a decoder for responses returned by
+// the codelab CreateAssignment endpoint. restoreBody controls whether the
+// response body should be restored after having been read.
+// DecodeCreateAssignmentResponse may return the following errors:
+//   - "invalid_input" (type codelab.InvalidInput): http.StatusBadRequest
+//   - "not_found" (type codelab.NotFound): http.StatusNotFound
+//   - "permission_denied" (type codelab.PermissionDenied): http.StatusForbidden
+//   - "service_unavailable" (type codelab.ServiceUnavailable): http.StatusServiceUnavailable
+//   - "unauthorized" (type codelab.Unauthorized): http.StatusUnauthorized
+//   - error: internal error
+func DecodeCreateAssignmentResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusCreated:
+			var (
+				body CreateAssignmentResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("codelab", "CreateAssignment", err)
+			}
+			err = ValidateCreateAssignmentResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("codelab", "CreateAssignment", err)
+			}
+			res := NewCreateAssignmentSimpleResponseCreated(&body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("codelab", "CreateAssignment", err)
+			}
+			return nil, NewCreateAssignmentInvalidInput(body)
+		case http.StatusNotFound:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("codelab", "CreateAssignment", err)
+			}
+			return nil, NewCreateAssignmentNotFound(body)
+		case http.StatusForbidden:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("codelab", "CreateAssignment", err)
+			}
+			return nil, NewCreateAssignmentPermissionDenied(body)
+		case http.StatusServiceUnavailable:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("codelab", "CreateAssignment", err)
+			}
+			return nil, NewCreateAssignmentServiceUnavailable(body)
+		case http.StatusUnauthorized:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("codelab", "CreateAssignment", err)
+			}
+			return nil, NewCreateAssignmentUnauthorized(body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("codelab", "CreateAssignment", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildGetAssignmentRequest instantiates a HTTP request object with method and
+// path set to call the "codelab" service "GetAssignment" endpoint
+func (c *Client) BuildGetAssignmentRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		id int64
+	)
+	{
+		p, ok := v.(*codelab.GetAssignmentPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("codelab", "GetAssignment", "*codelab.GetAssignmentPayload", v)
+		}
+		id = p.ID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: GetAssignmentCodelabPath(id)}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("codelab", "GetAssignment", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeGetAssignmentRequest returns an encoder for requests sent to the
+// codelab GetAssignment server.
+func EncodeGetAssignmentRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*codelab.GetAssignmentPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("codelab", "GetAssignment", "*codelab.GetAssignmentPayload", v)
+		}
+		{
+			v := p.SessionToken
+			req.AddCookie(&http.Cookie{
+				Name:  "session",
+				Value: v,
+			})
+		}
+		return nil
+	}
+}
+
+// DecodeGetAssignmentResponse returns a decoder for responses returned by the
+// codelab GetAssignment endpoint. restoreBody controls whether the response
+// body should be restored after having been read.
+// DecodeGetAssignmentResponse may return the following errors:
+//   - "invalid_input" (type codelab.InvalidInput): http.StatusBadRequest
+//   - "not_found" (type codelab.NotFound): http.StatusNotFound
+//   - "permission_denied" (type codelab.PermissionDenied): http.StatusForbidden
+//   - "service_unavailable" (type codelab.ServiceUnavailable): http.StatusServiceUnavailable
+//   - "unauthorized" (type codelab.Unauthorized): http.StatusUnauthorized
+//   - error: internal error
+func DecodeGetAssignmentResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body GetAssignmentResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("codelab", "GetAssignment", err)
+			}
+			err = ValidateGetAssignmentResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("codelab", "GetAssignment", err)
+			}
+			res := NewGetAssignmentAssignmentOK(&body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("codelab", "GetAssignment", err)
+			}
+			return nil, NewGetAssignmentInvalidInput(body)
+		case http.StatusNotFound:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("codelab", "GetAssignment", err)
+			}
+			return nil, NewGetAssignmentNotFound(body)
+		case http.StatusForbidden:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("codelab", "GetAssignment", err)
+			}
+			return nil, NewGetAssignmentPermissionDenied(body)
+		case http.StatusServiceUnavailable:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("codelab", "GetAssignment", err)
+			}
+			return nil, NewGetAssignmentServiceUnavailable(body)
+		case http.StatusUnauthorized:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("codelab", "GetAssignment", err)
+			}
+			return nil, NewGetAssignmentUnauthorized(body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("codelab", "GetAssignment", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildListAssignmentsRequest instantiates a HTTP request object with method
+// and path set to call the "codelab" service "ListAssignments" endpoint
+func (c *Client) BuildListAssignmentsRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: ListAssignmentsCodelabPath()}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("codelab", "ListAssignments", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeListAssignmentsRequest returns an encoder for requests sent to the
+// codelab ListAssignments server.
+func EncodeListAssignmentsRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*codelab.ListAssignmentsPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("codelab", "ListAssignments", "*codelab.ListAssignmentsPayload", v)
+		}
+		{
+			v := p.SessionToken
+			req.AddCookie(&http.Cookie{
+				Name:  "session",
+				Value: v,
+			})
+		}
+		return nil
+	}
+}
+
+// DecodeListAssignmentsResponse returns a decoder for responses returned by
+// the codelab ListAssignments endpoint. restoreBody controls whether the
+// response body should be restored after having been read.
+// DecodeListAssignmentsResponse may return the following errors:
+//   - "invalid_input" (type codelab.InvalidInput): http.StatusBadRequest
+//   - "permission_denied" (type codelab.PermissionDenied): http.StatusForbidden
+//   - "service_unavailable" (type codelab.ServiceUnavailable): http.StatusServiceUnavailable
+//   - "unauthorized" (type codelab.Unauthorized): http.StatusUnauthorized
+//   - error: internal error
+func DecodeListAssignmentsResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body ListAssignmentsResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("codelab", "ListAssignments", err)
+			}
+			for _, e := range body {
+				if e != nil {
+					if err2 := ValidateAssignmentResponse(e); err2 != nil {
+						err = goa.MergeErrors(err, err2)
+					}
+				}
+			}
+			if err != nil {
+				return nil, goahttp.ErrValidationError("codelab", "ListAssignments", err)
+			}
+			res := NewListAssignmentsAssignmentOK(body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("codelab", "ListAssignments", err)
+			}
+			return nil, NewListAssignmentsInvalidInput(body)
+		case http.StatusForbidden:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("codelab", "ListAssignments", err)
+			}
+			return nil, NewListAssignmentsPermissionDenied(body)
+		case http.StatusServiceUnavailable:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("codelab", "ListAssignments", err)
+			}
+			return nil, NewListAssignmentsServiceUnavailable(body)
+		case http.StatusUnauthorized:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("codelab", "ListAssignments", err)
+			}
+			return nil, NewListAssignmentsUnauthorized(body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("codelab", "ListAssignments", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildUpdateAssignmentRequest instantiates a HTTP request object with method
+// and path set to call the "codelab" service "UpdateAssignment" endpoint
+func (c *Client) BuildUpdateAssignmentRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		id int64
+	)
+	{
+		p, ok := v.(*codelab.UpdateAssignmentPayload2)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("codelab", "UpdateAssignment", "*codelab.UpdateAssignmentPayload2", v)
+		}
+		id = p.ID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: UpdateAssignmentCodelabPath(id)}
+	req, err := http.NewRequest("PUT", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("codelab", "UpdateAssignment", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeUpdateAssignmentRequest returns an encoder for requests sent to the
+// codelab UpdateAssignment server.
+func EncodeUpdateAssignmentRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*codelab.UpdateAssignmentPayload2)
+		if !ok {
+			return goahttp.ErrInvalidType("codelab", "UpdateAssignment", "*codelab.UpdateAssignmentPayload2", v)
+		}
+		{
+			v := p.SessionToken
+			req.AddCookie(&http.Cookie{
+				Name:  "session",
+				Value: v,
+			})
+		}
+		body := NewUpdateAssignmentRequestBody(p)
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("codelab", "UpdateAssignment", err)
+		}
+		return nil
+	}
+}
+
+// DecodeUpdateAssignmentResponse returns a decoder for responses returned by
+// the codelab UpdateAssignment endpoint. restoreBody controls whether the
+// response body should be restored after having been read.
+// DecodeUpdateAssignmentResponse may return the following errors:
+//   - "invalid_input" (type codelab.InvalidInput): http.StatusBadRequest
+//   - "not_found" (type codelab.NotFound): http.StatusNotFound
+//   - "permission_denied" (type codelab.PermissionDenied): http.StatusForbidden
+//   - "service_unavailable" (type codelab.ServiceUnavailable): http.StatusServiceUnavailable
+//   - "unauthorized" (type codelab.Unauthorized): http.StatusUnauthorized
+//   - error: internal error
+func DecodeUpdateAssignmentResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body UpdateAssignmentResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("codelab", "UpdateAssignment", err)
+			}
+			err = ValidateUpdateAssignmentResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("codelab", "UpdateAssignment", err)
+			}
+			res := NewUpdateAssignmentSimpleResponseOK(&body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("codelab", "UpdateAssignment", err)
+			}
+			return nil, NewUpdateAssignmentInvalidInput(body)
+		case http.StatusNotFound:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("codelab", "UpdateAssignment", err)
+			}
+			return nil, NewUpdateAssignmentNotFound(body)
+		case http.StatusForbidden:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("codelab", "UpdateAssignment", err)
+			}
+			return nil, NewUpdateAssignmentPermissionDenied(body)
+		case http.StatusServiceUnavailable:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("codelab", "UpdateAssignment", err)
+			}
+			return nil, NewUpdateAssignmentServiceUnavailable(body)
+		case http.StatusUnauthorized:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("codelab", "UpdateAssignment", err)
+			}
+			return nil, NewUpdateAssignmentUnauthorized(body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("codelab", "UpdateAssignment", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildDeleteAssignmentRequest instantiates a HTTP request object with method
+// and path set to call the "codelab" service "DeleteAssignment" endpoint
+func (c *Client) BuildDeleteAssignmentRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		id int64
+	)
+	{
+		p, ok := v.(*codelab.DeleteAssignmentPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("codelab", "DeleteAssignment", "*codelab.DeleteAssignmentPayload", v)
+		}
+		id = p.ID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: DeleteAssignmentCodelabPath(id)}
+	req, err := http.NewRequest("DELETE", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("codelab", "DeleteAssignment", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeDeleteAssignmentRequest returns an encoder for requests sent to the
+// codelab DeleteAssignment server.
+func EncodeDeleteAssignmentRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*codelab.DeleteAssignmentPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("codelab", "DeleteAssignment", "*codelab.DeleteAssignmentPayload", v)
+		}
+		{
+			v := p.SessionToken
+			req.AddCookie(&http.Cookie{
+				Name:  "session",
+				Value: v,
+			})
+		}
+		return nil
+	}
+}
+
+// DecodeDeleteAssignmentResponse returns a decoder for responses returned by
+// the codelab DeleteAssignment endpoint. restoreBody controls whether the
+// response body should be restored after having been read.
+// DecodeDeleteAssignmentResponse may return the following errors:
+//   - "invalid_input" (type codelab.InvalidInput): http.StatusBadRequest
+//   - "not_found" (type codelab.NotFound): http.StatusNotFound
+//   - "permission_denied" (type codelab.PermissionDenied): http.StatusForbidden
+//   - "service_unavailable" (type codelab.ServiceUnavailable): http.StatusServiceUnavailable
+//   - "unauthorized" (type codelab.Unauthorized): http.StatusUnauthorized
+//   - error: internal error
+func DecodeDeleteAssignmentResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body DeleteAssignmentResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("codelab", "DeleteAssignment", err)
+			}
+			err = ValidateDeleteAssignmentResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("codelab", "DeleteAssignment", err)
+			}
+			res := NewDeleteAssignmentSimpleResponseOK(&body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("codelab", "DeleteAssignment", err)
+			}
+			return nil, NewDeleteAssignmentInvalidInput(body)
+		case http.StatusNotFound:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("codelab", "DeleteAssignment", err)
+			}
+			return nil, NewDeleteAssignmentNotFound(body)
+		case http.StatusForbidden:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("codelab", "DeleteAssignment", err)
+			}
+			return nil, NewDeleteAssignmentPermissionDenied(body)
+		case http.StatusServiceUnavailable:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("codelab", "DeleteAssignment", err)
+			}
+			return nil, NewDeleteAssignmentServiceUnavailable(body)
+		case http.StatusUnauthorized:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("codelab", "DeleteAssignment", err)
+			}
+			return nil, NewDeleteAssignmentUnauthorized(body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("codelab", "DeleteAssignment", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildGetAssignmentGradebookRequest instantiates a HTTP request object with
+// method and path set to call the "codelab" service "GetAssignmentGradebook"
+// endpoint
+func (c *Client) BuildGetAssignmentGradebookRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		id int64
+	)
+	{
+		p, ok := v.(*codelab.GetAssignmentGradebookPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("codelab", "GetAssignmentGradebook", "*codelab.GetAssignmentGradebookPayload", v)
+		}
+		id = p.ID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: GetAssignmentGradebookCodelabPath(id)}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("codelab", "GetAssignmentGradebook", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeGetAssignmentGradebookRequest returns an encoder for requests sent to
+// the codelab GetAssignmentGradebook server.
+func EncodeGetAssignmentGradebookRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*codelab.GetAssignmentGradebookPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("codelab", "GetAssignmentGradebook", "*codelab.GetAssignmentGradebookPayload", v)
+		}
+		{
+			v := p.SessionToken
+			req.AddCookie(&http.Cookie{
+				Name:  "session",
+				Value: v,
+			})
+		}
+		return nil
+	}
+}
+
+// DecodeGetAssignmentGradebookResponse returns a decoder for responses
+// returned by the codelab GetAssignmentGradebook endpoint. restoreBody
+// controls whether the response body should be restored after having been read.
+// DecodeGetAssignmentGradebookResponse may return the following errors:
+//   - "invalid_input" (type codelab.InvalidInput): http.StatusBadRequest
+//   - "not_found" (type codelab.NotFound): http.StatusNotFound
+//   - "permission_denied" (type codelab.PermissionDenied): http.StatusForbidden
+//   - "service_unavailable" (type codelab.ServiceUnavailable): http.StatusServiceUnavailable
+//   - "unauthorized" (type codelab.Unauthorized): http.StatusUnauthorized
+//   - error: internal error
+func DecodeGetAssignmentGradebookResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body GetAssignmentGradebookResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("codelab", "GetAssignmentGradebook", err)
+			}
+			err = ValidateGetAssignmentGradebookResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("codelab", "GetAssignmentGradebook", err)
+			}
+			res := NewGetAssignmentGradebookAssignmentGradebookOK(&body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("codelab", "GetAssignmentGradebook", err)
+			}
+			return nil, NewGetAssignmentGradebookInvalidInput(body)
+		case http.StatusNotFound:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("codelab", "GetAssignmentGradebook", err)
+			}
+			return nil, NewGetAssignmentGradebookNotFound(body)
+		case http.StatusForbidden:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("codelab", "GetAssignmentGradebook", err)
+			}
+			return nil, NewGetAssignmentGradebookPermissionDenied(body)
+		case http.StatusServiceUnavailable:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("codelab", "GetAssignmentGradebook", err)
+			}
+			return nil, NewGetAssignmentGradebookServiceUnavailable(body)
+		case http.StatusUnauthorized:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("codelab", "GetAssignmentGradebook", err)
+			}
+			return nil, NewGetAssignmentGradebookUnauthorized(body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("codelab", "GetAssignmentGradebook", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildGetExerciseStatsRequest instantiates a HTTP request object with method
+// and path set to call the "codelab" service "GetExerciseStats" endpoint
+func (c *Client) BuildGetExerciseStatsRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		exerciseID int64
+	)
+	{
+		p, ok := v.(*codelab.GetExerciseStatsPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("codelab", "GetExerciseStats", "*codelab.GetExerciseStatsPayload", v)
+		}
+		exerciseID = p.ExerciseID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: GetExerciseStatsCodelabPath(exerciseID)}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("codelab", "GetExerciseStats", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeGetExerciseStatsRequest returns an encoder for requests sent to the
+// codelab GetExerciseStats server.
+func EncodeGetExerciseStatsRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*codelab.GetExerciseStatsPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("codelab", "GetExerciseStats", "*codelab.GetExerciseStatsPayload", v)
+		}
+		{
+			v := p.SessionToken
+			req.AddCookie(&http.Cookie{
+				Name:  "session",
+				Value: v,
+			})
+		}
+		return nil
+	}
+}
+
+// DecodeGetExerciseStatsResponse returns a decoder for responses returned by
+// the codelab GetExerciseStats endpoint. restoreBody controls whether the
+// response body should be restored after having been read.
+// DecodeGetExerciseStatsResponse may return the following errors:
+//   - "invalid_input" (type codelab.InvalidInput): http.StatusBadRequest
+//   - "not_found" (type codelab.NotFound): http.StatusNotFound
+//   - "permission_denied" (type codelab.PermissionDenied): http.StatusForbidden
+//   - "service_unavailable" (type codelab.ServiceUnavailable): http.StatusServiceUnavailable
+//   - "unauthorized" (type codelab.Unauthorized): http.StatusUnauthorized
+//   - error: internal error
+func DecodeGetExerciseStatsResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body GetExerciseStatsResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("codelab", "GetExerciseStats", err)
+			}
+			err = ValidateGetExerciseStatsResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("codelab", "GetExerciseStats", err)
+			}
+			res := NewGetExerciseStatsExerciseStatsOK(&body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("codelab", "GetExerciseStats", err)
+			}
+			return nil, NewGetExerciseStatsInvalidInput(body)
+		case http.StatusNotFound:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("codelab", "GetExerciseStats", err)
+			}
+			return nil, NewGetExerciseStatsNotFound(body)
+		case http.StatusForbidden:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("codelab", "GetExerciseStats", err)
+			}
+			return nil, NewGetExerciseStatsPermissionDenied(body)
+		case http.StatusServiceUnavailable:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("codelab", "GetExerciseStats", err)
+			}
+			return nil, NewGetExerciseStatsServiceUnavailable(body)
+		case http.StatusUnauthorized:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("codelab", "GetExerciseStats", err)
+			}
+			return nil, NewGetExerciseStatsUnauthorized(body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("codelab", "GetExerciseStats", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildGetStudentExerciseStatsRequest instantiates a HTTP request object with
+// method and path set to call the "codelab" service "GetStudentExerciseStats"
+// endpoint
+func (c *Client) BuildGetStudentExerciseStatsRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		exerciseID int64
+		userID     int64
+	)
+	{
+		p, ok := v.(*codelab.GetStudentExerciseStatsPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("codelab", "GetStudentExerciseStats", "*codelab.GetStudentExerciseStatsPayload", v)
+		}
+		exerciseID = p.ExerciseID
+		userID = p.UserID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: GetStudentExerciseStatsCodelabPath(exerciseID, userID)}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("codelab", "GetStudentExerciseStats", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeGetStudentExerciseStatsRequest returns an encoder for requests sent to
+// the codelab GetStudentExerciseStats server.
+func EncodeGetStudentExerciseStatsRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*codelab.GetStudentExerciseStatsPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("codelab", "GetStudentExerciseStats", "*codelab.GetStudentExerciseStatsPayload", v)
+		}
+		{
+			v := p.SessionToken
+			req.AddCookie(&http.Cookie{
+				Name:  "session",
+				Value: v,
+			})
+		}
+		return nil
+	}
+}
+
+// DecodeGetStudentExerciseStatsResponse returns a decoder for responses
+// returned by the codelab GetStudentExerciseStats endpoint. restoreBody
+// controls whether the response body should be restored after having been read.
+// DecodeGetStudentExerciseStatsResponse may return the following errors:
+//   - "invalid_input" (type codelab.InvalidInput): http.StatusBadRequest
+//   - "not_found" (type codelab.NotFound): http.StatusNotFound
+//   - "permission_denied" (type codelab.PermissionDenied): http.StatusForbidden
+//   - "service_unavailable" (type codelab.ServiceUnavailable): http.StatusServiceUnavailable
+//   - "unauthorized" (type codelab.Unauthorized): http.StatusUnauthorized
+//   - error: internal error
+func DecodeGetStudentExerciseStatsResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body GetStudentExerciseStatsResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("codelab", "GetStudentExerciseStats", err)
+			}
+			err = ValidateGetStudentExerciseStatsResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("codelab", "GetStudentExerciseStats", err)
+			}
+			res := NewGetStudentExerciseStatsStudentExerciseStatsOK(&body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("codelab", "GetStudentExerciseStats", err)
+			}
+			return nil, NewGetStudentExerciseStatsInvalidInput(body)
+		case http.StatusNotFound:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("codelab", "GetStudentExerciseStats", err)
+			}
+			return nil, NewGetStudentExerciseStatsNotFound(body)
+		case http.StatusForbidden:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("codelab", "GetStudentExerciseStats", err)
+			}
+			return nil, NewGetStudentExerciseStatsPermissionDenied(body)
+		case http.StatusServiceUnavailable:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("codelab", "GetStudentExerciseStats", err)
+			}
+			return nil, NewGetStudentExerciseStatsServiceUnavailable(body)
+		case http.StatusUnauthorized:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("codelab", "GetStudentExerciseStats", err)
+			}
+			return nil, NewGetStudentExerciseStatsUnauthorized(body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("codelab", "GetStudentExerciseStats", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildGetPlagiarismReportRequest instantiates a HTTP request object with
+// method and path set to call the "codelab" service "GetPlagiarismReport"
+// endpoint
+func (c *Client) BuildGetPlagiarismReportRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		exerciseID int64
+	)
+	{
+		p, ok := v.(*codelab.GetPlagiarismReportPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("codelab", "GetPlagiarismReport", "*codelab.GetPlagiarismReportPayload", v)
+		}
+		exerciseID = p.ExerciseID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: GetPlagiarismReportCodelabPath(exerciseID)}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("codelab", "GetPlagiarismReport", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeGetPlagiarismReportRequest returns an encoder for requests sent to the
+// codelab GetPlagiarismReport server.
+func EncodeGetPlagiarismReportRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*codelab.GetPlagiarismReportPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("codelab", "GetPlagiarismReport", "*codelab.GetPlagiarismReportPayload", v)
+		}
+		{
+			v := p.SessionToken
+			req.AddCookie(&http.Cookie{
+				Name:  "session",
+				Value: v,
+			})
+		}
+		values := req.URL.Query()
+		values.Add("min_similarity", fmt.Sprintf("%v", p.MinSimilarity))
+		req.URL.RawQuery = values.Encode()
+		return nil
+	}
+}
+
+// DecodeGetPlagiarismReportResponse returns a decoder for responses returned
+// by the codelab GetPlagiarismReport endpoint. restoreBody controls whether
+// the response body should be restored after having been read.
+// DecodeGetPlagiarismReportResponse may return the following errors:
+//   - "invalid_input" (type codelab.InvalidInput): http.StatusBadRequest
+//   - "not_found" (type codelab.NotFound): http.StatusNotFound
+//   - "permission_denied" (type codelab.PermissionDenied): http.StatusForbidden
+//   - "service_unavailable" (type codelab.ServiceUnavailable): http.StatusServiceUnavailable
+//   - "unauthorized" (type codelab.Unauthorized): http.StatusUnauthorized
+//   - error: internal error
+func DecodeGetPlagiarismReportResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body GetPlagiarismReportResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("codelab", "GetPlagiarismReport", err)
+			}
+			err = ValidateGetPlagiarismReportResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("codelab", "GetPlagiarismReport", err)
+			}
+			res := NewGetPlagiarismReportPlagiarismReportOK(&body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("codelab", "GetPlagiarismReport", err)
+			}
+			return nil, NewGetPlagiarismReportInvalidInput(body)
+		case http.StatusNotFound:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("codelab", "GetPlagiarismReport", err)
+			}
+			return nil, NewGetPlagiarismReportNotFound(body)
+		case http.StatusForbidden:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("codelab", "GetPlagiarismReport", err)
+			}
+			return nil, NewGetPlagiarismReportPermissionDenied(body)
+		case http.StatusServiceUnavailable:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("codelab", "GetPlagiarismReport", err)
+			}
+			return nil, NewGetPlagiarismReportServiceUnavailable(body)
+		case http.StatusUnauthorized:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("codelab", "GetPlagiarismReport", err)
+			}
+			return nil, NewGetPlagiarismReportUnauthorized(body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("codelab", "GetPlagiarismReport", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildGetExerciseForStudentRequest instantiates a HTTP request object with
+// method and path set to call the "codelab" service "GetExerciseForStudent"
+// endpoint
+func (c *Client) BuildGetExerciseForStudentRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		id int64
+	)
+	{
+		p, ok := v.(*codelab.GetExerciseForStudentPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("codelab", "GetExerciseForStudent", "*codelab.GetExerciseForStudentPayload", v)
+		}
+		id = p.ID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: GetExerciseForStudentCodelabPath(id)}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("codelab", "GetExerciseForStudent", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeGetExerciseForStudentRequest returns an encoder for requests sent to
+// the codelab GetExerciseForStudent server.
+func EncodeGetExerciseForStudentRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*codelab.GetExerciseForStudentPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("codelab", "GetExerciseForStudent", "*codelab.GetExerciseForStudentPayload", v)
+		}
+		{
+			v := p.SessionToken
+			req.AddCookie(&http.Cookie{
+				Name:  "session",
+				Value: v,
+			})
+		}
+		return nil
+	}
+}
+
+// DecodeGetExerciseForStudentResponse returns a decoder for responses returned
+// by the codelab GetExerciseForStudent endpoint. restoreBody controls whether
+// the response body should be restored after having been read.
+// DecodeGetExerciseForStudentResponse may return the following errors:
+//   - "invalid_input" (type codelab.InvalidInput): http.StatusBadRequest
+//   - "not_found" (type codelab.NotFound): http.StatusNotFound
+//   - "permission_denied" (type codelab.PermissionDenied): http.StatusForbidden
+//   - "service_unavailable" (type codelab.ServiceUnavailable): http.StatusServiceUnavailable
+//   - "unauthorized" (type codelab.Unauthorized): http.StatusUnauthorized
+//   - error: internal error
+func DecodeGetExerciseForStudentResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body GetExerciseForStudentResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("codelab", "GetExerciseForStudent", err)
+			}
+			err = ValidateGetExerciseForStudentResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("codelab", "GetExerciseForStudent", err)
+			}
+			res := NewGetExerciseForStudentExerciseForStudentsOK(&body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("codelab", "GetExerciseForStudent", err)
+			}
+			return nil, NewGetExerciseForStudentInvalidInput(body)
+		case http.StatusNotFound:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("codelab", "GetExerciseForStudent", err)
+			}
+			return nil, NewGetExerciseForStudentNotFound(body)
+		case http.StatusForbidden:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("codelab", "GetExerciseForStudent", err)
+			}
+			return nil, NewGetExerciseForStudentPermissionDenied(body)
+		case http.StatusServiceUnavailable:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("codelab", "GetExerciseForStudent", err)
+			}
+			return nil, NewGetExerciseForStudentServiceUnavailable(body)
+		case http.StatusUnauthorized:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("codelab", "GetExerciseForStudent", err)
+			}
+			return nil, NewGetExerciseForStudentUnauthorized(body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("codelab", "GetExerciseForStudent", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildListExercisesForStudentsRequest instantiates a HTTP request object with
+// method and path set to call the "codelab" service "ListExercisesForStudents"
+// endpoint
+func (c *Client) BuildListExercisesForStudentsRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: ListExercisesForStudentsCodelabPath()}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("codelab", "ListExercisesForStudents", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeListExercisesForStudentsRequest returns an encoder for requests sent
+// to the codelab ListExercisesForStudents server.
+func EncodeListExercisesForStudentsRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*codelab.ListExercisesForStudentsPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("codelab", "ListExercisesForStudents", "*codelab.ListExercisesForStudentsPayload", v)
+		}
+		{
+			v := p.SessionToken
+			req.AddCookie(&http.Cookie{
+				Name:  "session",
+				Value: v,
+			})
+		}
+		values := req.URL.Query()
+		if p.Search != nil {
+			values.Add("search", *p.Search)
+		}
+		if p.Difficulty != nil {
+			values.Add("difficulty", *p.Difficulty)
+		}
+		for _, value := range p.Tags {
+			values.Add("tag", value)
+		}
+		if p.CreatedBy != nil {
+			values.Add("author", fmt.Sprintf("%v", *p.CreatedBy))
+		}
+		if p.Cursor != nil {
+			values.Add("cursor", *p.Cursor)
+		}
+		values.Add("limit", fmt.Sprintf("%v", p.Limit))
+		if p.Completed != nil {
+			values.Add("completed", fmt.Sprintf("%v", *p.Completed))
+		}
+		req.URL.RawQuery = values.Encode()
+		return nil
+	}
+}
+
+// DecodeListExercisesForStudentsResponse returns a decoder for responses
+// returned by the codelab ListExercisesForStudents endpoint. restoreBody
+// controls whether the response body should be restored after having been read.
+// DecodeListExercisesForStudentsResponse may return the following errors:
+//   - "invalid_input" (type codelab.InvalidInput): http.StatusBadRequest
+//   - "not_found" (type codelab.NotFound): http.StatusNotFound
+//   - "permission_denied" (type codelab.PermissionDenied): http.StatusForbidden
+//   - "service_unavailable" (type codelab.ServiceUnavailable): http.StatusServiceUnavailable
+//   - "unauthorized" (type codelab.Unauthorized): http.StatusUnauthorized
+//   - error: internal error
+func DecodeListExercisesForStudentsResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body ListExercisesForStudentsResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("codelab", "ListExercisesForStudents", err)
+			}
+			for _, e := range body {
+				if e != nil {
+					if err2 := ValidateExerciseForStudentsListView(e); err2 != nil {
+						err = goa.MergeErrors(err, err2)
+					}
+				}
+			}
+			if err != nil {
+				return nil, goahttp.ErrValidationError("codelab", "ListExercisesForStudents", err)
+			}
+			var (
+				nextCursor *string
+			)
+			nextCursorRaw := resp.Header.Get("X-Next-Cursor")
+			if nextCursorRaw != "" {
+				nextCursor = &nextCursorRaw
+			}
+			res := NewListExercisesForStudentsExerciseForStudentsPageOK(body, nextCursor)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("codelab", "ListExercisesForStudents", err)
+			}
+			return nil, NewListExercisesForStudentsInvalidInput(body)
+		case http.StatusNotFound:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("codelab", "ListExercisesForStudents", err)
+			}
+			return nil, NewListExercisesForStudentsNotFound(body)
+		case http.StatusForbidden:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("codelab", "ListExercisesForStudents", err)
+			}
+			return nil, NewListExercisesForStudentsPermissionDenied(body)
+		case http.StatusServiceUnavailable:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("codelab", "ListExercisesForStudents", err)
+			}
+			return nil, NewListExercisesForStudentsServiceUnavailable(body)
+		case http.StatusUnauthorized:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("codelab", "ListExercisesForStudents", err)
+			}
+			return nil, NewListExercisesForStudentsUnauthorized(body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("codelab", "ListExercisesForStudents", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildCreateAttemptRequest instantiates a HTTP request object with method and
+// path set to call the "codelab" service "CreateAttempt" endpoint
+func (c *Client) BuildCreateAttemptRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: CreateAttemptCodelabPath()}
+	req, err := http.NewRequest("POST", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("codelab", "CreateAttempt", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeCreateAttemptRequest returns an encoder for requests sent to the
+// codelab CreateAttempt server.
+func EncodeCreateAttemptRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*codelab.CreateAttemptPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("codelab", "CreateAttempt", "*codelab.CreateAttemptPayload", v)
+		}
+		{
+			v := p.SessionToken
+			req.AddCookie(&http.Cookie{
+				Name:  "session",
+				Value: v,
+			})
+		}
+		body := NewCreateAttemptRequestBody(p)
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("codelab", "CreateAttempt", err)
+		}
+		return nil
+	}
+}
+
+// DecodeCreateAttemptResponse returns a decoder for responses returned by the
+// codelab CreateAttempt endpoint. restoreBody controls whether the response
+// body should be restored after having been read.
+// DecodeCreateAttemptResponse may return the following errors:
+//   - "rate_limited" (type *codelab.RateLimit): http.StatusTooManyRequests
+//   - "invalid_input" (type codelab.InvalidInput): http.StatusBadRequest
+//   - "not_found" (type codelab.NotFound): http.StatusNotFound
+//   - "permission_denied" (type codelab.PermissionDenied): http.StatusForbidden
+//   - "service_unavailable" (type codelab.ServiceUnavailable): http.StatusServiceUnavailable
+//   - "unauthorized" (type codelab.Unauthorized): http.StatusUnauthorized
+//   - error: internal error
+func DecodeCreateAttemptResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusCreated:
+			var (
+				body CreateAttemptResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("codelab", "CreateAttempt", err)
+			}
+			err = ValidateCreateAttemptResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("codelab", "CreateAttempt", err)
+			}
+			res := NewCreateAttemptSimpleResponseCreated(&body)
+			return res, nil
+		case http.StatusTooManyRequests:
+			var (
+				body CreateAttemptRateLimitedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("codelab", "CreateAttempt", err)
+			}
+			err = ValidateCreateAttemptRateLimitedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("codelab", "CreateAttempt", err)
+			}
+			var (
+				retryAfter int64
+			)
+			{
+				retryAfterRaw := resp.Header.Get("Retry-After")
+				if retryAfterRaw == "" {
+					return nil, goahttp.ErrValidationError("codelab", "CreateAttempt", goa.MissingFieldError("retry_after", "header"))
+				}
+				v, err2 := strconv.ParseInt(retryAfterRaw, 10, 64)
+				if err2 != nil {
+					err = goa.MergeErrors(err, goa.InvalidFieldTypeError("retry_after", retryAfterRaw, "integer"))
+				}
+				retryAfter = v
+			}
+			if err != nil {
+				return nil, goahttp.ErrValidationError("codelab", "CreateAttempt", err)
+			}
+			return nil, NewCreateAttemptRateLimited(&body, retryAfter)
+		case http.StatusBadRequest:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("codelab", "CreateAttempt", err)
+			}
+			return nil, NewCreateAttemptInvalidInput(body)
+		case http.StatusNotFound:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("codelab", "CreateAttempt", err)
+			}
+			return nil, NewCreateAttemptNotFound(body)
+		case http.StatusForbidden:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("codelab", "CreateAttempt", err)
+			}
+			return nil, NewCreateAttemptPermissionDenied(body)
+		case http.StatusServiceUnavailable:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("codelab", "CreateAttempt", err)
+			}
+			return nil, NewCreateAttemptServiceUnavailable(body)
+		case http.StatusUnauthorized:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("codelab", "CreateAttempt", err)
+			}
+			return nil, NewCreateAttemptUnauthorized(body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("codelab", "CreateAttempt", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildSubmitAttemptRequest instantiates a HTTP request object with method and
+// path set to call the "codelab" service "SubmitAttempt" endpoint
+func (c *Client) BuildSubmitAttemptRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: SubmitAttemptCodelabPath()}
+	req, err := http.NewRequest("POST", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("codelab", "SubmitAttempt", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeSubmitAttemptRequest returns an encoder for requests sent to the
+// codelab SubmitAttempt server.
+func EncodeSubmitAttemptRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*codelab.CreateAttemptPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("codelab", "SubmitAttempt", "*codelab.CreateAttemptPayload", v)
+		}
+		{
+			v := p.SessionToken
+			req.AddCookie(&http.Cookie{
+				Name:  "session",
+				Value: v,
+			})
+		}
+		body := NewSubmitAttemptRequestBody(p)
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("codelab", "SubmitAttempt", err)
+		}
+		return nil
+	}
+}
+
+// DecodeSubmitAttemptResponse returns a decoder for responses returned by the
+// codelab SubmitAttempt endpoint. restoreBody controls whether the response
+// body should be restored after having been read.
+// DecodeSubmitAttemptResponse may return the following errors:
+//   - "rate_limited" (type *codelab.RateLimit): http.StatusTooManyRequests
+//   - "invalid_input" (type codelab.InvalidInput): http.StatusBadRequest
+//   - "not_found" (type codelab.NotFound): http.StatusNotFound
+//   - "permission_denied" (type codelab.PermissionDenied): http.StatusForbidden
+//   - "service_unavailable" (type codelab.ServiceUnavailable): http.StatusServiceUnavailable
+//   - "unauthorized" (type codelab.Unauthorized): http.StatusUnauthorized
+//   - error: internal error
+func DecodeSubmitAttemptResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusAccepted:
+			var (
+				body SubmitAttemptResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("codelab", "SubmitAttempt", err)
+			}
+			err = ValidateSubmitAttemptResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("codelab", "SubmitAttempt", err)
+			}
+			res := NewSubmitAttemptAttemptSubmissionAccepted(&body)
+			return res, nil
+		case http.StatusTooManyRequests:
+			var (
+				body SubmitAttemptRateLimitedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("codelab", "SubmitAttempt", err)
+			}
+			err = ValidateSubmitAttemptRateLimitedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("codelab", "SubmitAttempt", err)
+			}
+			var (
+				retryAfter int64
+			)
+			{
+				retryAfterRaw := resp.Header.Get("Retry-After")
+				if retryAfterRaw == "" {
+					return nil, goahttp.ErrValidationError("codelab", "SubmitAttempt", goa.MissingFieldError("retry_after", "header"))
+				}
+				v, err2 := strconv.ParseInt(retryAfterRaw, 10, 64)
+				if err2 != nil {
+					err = goa.MergeErrors(err, goa.InvalidFieldTypeError("retry_after", retryAfterRaw, "integer"))
+				}
+				retryAfter = v
+			}
+			if err != nil {
+				return nil, goahttp.ErrValidationError("codelab", "SubmitAttempt", err)
+			}
+			return nil, NewSubmitAttemptRateLimited(&body, retryAfter)
+		case http.StatusBadRequest:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("codelab", "SubmitAttempt", err)
+			}
+			return nil, NewSubmitAttemptInvalidInput(body)
+		case http.StatusNotFound:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("codelab", "SubmitAttempt", err)
+			}
+			return nil, NewSubmitAttemptNotFound(body)
+		case http.StatusForbidden:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("codelab", "SubmitAttempt", err)
+			}
+			return nil, NewSubmitAttemptPermissionDenied(body)
+		case http.StatusServiceUnavailable:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("codelab", "SubmitAttempt", err)
+			}
+			return nil, NewSubmitAttemptServiceUnavailable(body)
+		case http.StatusUnauthorized:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("codelab", "SubmitAttempt", err)
+			}
+			return nil, NewSubmitAttemptUnauthorized(body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("codelab", "SubmitAttempt", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildStreamAttemptEventsRequest instantiates a HTTP request object with
+// method and path set to call the "codelab" service "StreamAttemptEvents"
+// endpoint
+func (c *Client) BuildStreamAttemptEventsRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		id int64
+	)
+	{
+		p, ok := v.(*codelab.StreamAttemptEventsPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("codelab", "StreamAttemptEvents", "*codelab.StreamAttemptEventsPayload", v)
+		}
+		id = p.ID
+	}
+	scheme := c.scheme
+	switch c.scheme {
+	case "http":
+		scheme = "ws"
+	case "https":
+		scheme = "wss"
+	}
+	u := &url.URL{Scheme: scheme, Host: c.host, Path: StreamAttemptEventsCodelabPath(id)}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("codelab", "StreamAttemptEvents", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeStreamAttemptEventsRequest returns an encoder for requests sent to the
+// codelab StreamAttemptEvents server.
+func EncodeStreamAttemptEventsRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*codelab.StreamAttemptEventsPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("codelab", "StreamAttemptEvents", "*codelab.StreamAttemptEventsPayload", v)
+		}
+		{
+			v := p.SessionToken
+			req.AddCookie(&http.Cookie{
+				Name:  "session",
+				Value: v,
+			})
+		}
+		return nil
+	}
+}
+
+// DecodeStreamAttemptEventsResponse returns a decoder for responses returned
+// by the codelab StreamAttemptEvents endpoint. restoreBody controls whether
+// the response body should be restored after having been read.
+// DecodeStreamAttemptEventsResponse may return the following errors:
+//   - "invalid_input" (type codelab.InvalidInput): http.StatusBadRequest
+//   - "not_found" (type codelab.NotFound): http.StatusNotFound
+//   - "permission_denied" (type codelab.PermissionDenied): http.StatusForbidden
+//   - "service_unavailable" (type codelab.ServiceUnavailable): http.StatusServiceUnavailable
+//   - "unauthorized" (type codelab.Unauthorized): http.StatusUnauthorized
+//   - error: internal error
+func DecodeStreamAttemptEventsResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body StreamAttemptEventsResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("codelab", "StreamAttemptEvents", err)
+			}
+			err = ValidateStreamAttemptEventsResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("codelab", "StreamAttemptEvents", err)
+			}
+			res := NewStreamAttemptEventsAttemptEventOK(&body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("codelab", "StreamAttemptEvents", err)
+			}
+			return nil, NewStreamAttemptEventsInvalidInput(body)
+		case http.StatusNotFound:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("codelab", "StreamAttemptEvents", err)
+			}
+			return nil, NewStreamAttemptEventsNotFound(body)
+		case http.StatusForbidden:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("codelab", "StreamAttemptEvents", err)
+			}
+			return nil, NewStreamAttemptEventsPermissionDenied(body)
+		case http.StatusServiceUnavailable:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("codelab", "StreamAttemptEvents", err)
+			}
+			return nil, NewStreamAttemptEventsServiceUnavailable(body)
+		case http.StatusUnauthorized:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("codelab", "StreamAttemptEvents", err)
+			}
+			return nil, NewStreamAttemptEventsUnauthorized(body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("codelab", "StreamAttemptEvents", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildRunCodeRequest instantiates a HTTP request object with method and path
+// set to call the "codelab" service "RunCode" endpoint
+func (c *Client) BuildRunCodeRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		exerciseID int64
+	)
+	{
+		p, ok := v.(*codelab.RunCodePayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("codelab", "RunCode", "*codelab.RunCodePayload", v)
+		}
+		exerciseID = p.ExerciseID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: RunCodeCodelabPath(exerciseID)}
+	req, err := http.NewRequest("POST", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("codelab", "RunCode", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeRunCodeRequest returns an encoder for requests sent to the codelab
+// RunCode server.
+func EncodeRunCodeRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*codelab.RunCodePayload)
+		if !ok {
+			return goahttp.ErrInvalidType("codelab", "RunCode", "*codelab.RunCodePayload", v)
+		}
+		{
+			v := p.SessionToken
+			req.AddCookie(&http.Cookie{
+				Name:  "session",
+				Value: v,
+			})
+		}
+		body := NewRunCodeRequestBody(p)
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("codelab", "RunCode", err)
 		}
 		return nil
 	}
 }
 
-// DecodeUpdateTestResponse returns a decoder for responses returned by the
-// codelab UpdateTest endpoint. restoreBody controls whether the response body
+// DecodeRunCodeResponse returns a decoder for responses returned by the
+// codelab RunCode endpoint. restoreBody controls whether the response body
 // should be restored after having been read.
-// DecodeUpdateTestResponse may return the following errors:
+// DecodeRunCodeResponse may return the following errors:
+//   - "rate_limited" (type *codelab.RateLimit): http.StatusTooManyRequests
 //   - "invalid_input" (type codelab.InvalidInput): http.StatusBadRequest
 //   - "not_found" (type codelab.NotFound): http.StatusNotFound
 //   - "permission_denied" (type codelab.PermissionDenied): http.StatusForbidden
 //   - "service_unavailable" (type codelab.ServiceUnavailable): http.StatusServiceUnavailable
 //   - "unauthorized" (type codelab.Unauthorized): http.StatusUnauthorized
 //   - error: internal error
-func DecodeUpdateTestResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+func DecodeRunCodeResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
 	return func(resp *http.Response) (any, error) {
 		if restoreBody {
 			b, err := io.ReadAll(resp.Body)
@@ -1023,19 +5143,50 @@ func DecodeUpdateTestResponse(decoder func(*http.Response) goahttp.Decoder, rest
 		switch resp.StatusCode {
 		case http.StatusOK:
 			var (
-				body UpdateTestResponseBody
+				body RunCodeResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("codelab", "UpdateTest", err)
+				return nil, goahttp.ErrDecodingError("codelab", "RunCode", err)
 			}
-			err = ValidateUpdateTestResponseBody(&body)
+			err = ValidateRunCodeResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("codelab", "UpdateTest", err)
+				return nil, goahttp.ErrValidationError("codelab", "RunCode", err)
 			}
-			res := NewUpdateTestSimpleResponseOK(&body)
+			res := NewRunCodeResultOK(&body)
 			return res, nil
+		case http.StatusTooManyRequests:
+			var (
+				body RunCodeRateLimitedResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("codelab", "RunCode", err)
+			}
+			err = ValidateRunCodeRateLimitedResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("codelab", "RunCode", err)
+			}
+			var (
+				retryAfter int64
+			)
+			{
+				retryAfterRaw := resp.Header.Get("Retry-After")
+				if retryAfterRaw == "" {
+					return nil, goahttp.ErrValidationError("codelab", "RunCode", goa.MissingFieldError("retry_after", "header"))
+				}
+				v, err2 := strconv.ParseInt(retryAfterRaw, 10, 64)
+				if err2 != nil {
+					err = goa.MergeErrors(err, goa.InvalidFieldTypeError("retry_after", retryAfterRaw, "integer"))
+				}
+				retryAfter = v
+			}
+			if err != nil {
+				return nil, goahttp.ErrValidationError("codelab", "RunCode", err)
+			}
+			return nil, NewRunCodeRateLimited(&body, retryAfter)
 		case http.StatusBadRequest:
 			var (
 				body string
@@ -1043,9 +5194,9 @@ func DecodeUpdateTestResponse(decoder func(*http.Response) goahttp.Decoder, rest
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("codelab", "UpdateTest", err)
+				return nil, goahttp.ErrDecodingError("codelab", "RunCode", err)
 			}
-			return nil, NewUpdateTestInvalidInput(body)
+			return nil, NewRunCodeInvalidInput(body)
 		case http.StatusNotFound:
 			var (
 				body string
@@ -1053,9 +5204,9 @@ func DecodeUpdateTestResponse(decoder func(*http.Response) goahttp.Decoder, rest
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("codelab", "UpdateTest", err)
+				return nil, goahttp.ErrDecodingError("codelab", "RunCode", err)
 			}
-			return nil, NewUpdateTestNotFound(body)
+			return nil, NewRunCodeNotFound(body)
 		case http.StatusForbidden:
 			var (
 				body string
@@ -1063,9 +5214,9 @@ func DecodeUpdateTestResponse(decoder func(*http.Response) goahttp.Decoder, rest
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("codelab", "UpdateTest", err)
+				return nil, goahttp.ErrDecodingError("codelab", "RunCode", err)
 			}
-			return nil, NewUpdateTestPermissionDenied(body)
+			return nil, NewRunCodePermissionDenied(body)
 		case http.StatusServiceUnavailable:
 			var (
 				body string
@@ -1073,9 +5224,9 @@ func DecodeUpdateTestResponse(decoder func(*http.Response) goahttp.Decoder, rest
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("codelab", "UpdateTest", err)
+				return nil, goahttp.ErrDecodingError("codelab", "RunCode", err)
 			}
-			return nil, NewUpdateTestServiceUnavailable(body)
+			return nil, NewRunCodeServiceUnavailable(body)
 		case http.StatusUnauthorized:
 			var (
 				body string
@@ -1083,33 +5234,36 @@ func DecodeUpdateTestResponse(decoder func(*http.Response) goahttp.Decoder, rest
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("codelab", "UpdateTest", err)
+				return nil, goahttp.ErrDecodingError("codelab", "RunCode", err)
 			}
-			return nil, NewUpdateTestUnauthorized(body)
+			return nil, NewRunCodeUnauthorized(body)
 		default:
 			body, _ := io.ReadAll(resp.Body)
-			return nil, goahttp.ErrInvalidResponse("codelab", "UpdateTest", resp.StatusCode, string(body))
+			return nil, goahttp.ErrInvalidResponse("codelab", "RunCode", resp.StatusCode, string(body))
 		}
 	}
 }
 
-// BuildDeleteTestRequest instantiates a HTTP request object with method and
-// path set to call the "codelab" service "DeleteTest" endpoint
-func (c *Client) BuildDeleteTestRequest(ctx context.Context, v any) (*http.Request, error) {
+// BuildGetAttemptsByUserAndExerciseRequest instantiates a HTTP request object
+// with method and path set to call the "codelab" service
+// "GetAttemptsByUserAndExercise" endpoint
+func (c *Client) BuildGetAttemptsByUserAndExerciseRequest(ctx context.Context, v any) (*http.Request, error) {
 	var (
-		id int64
+		userID     int64
+		exerciseID int64
 	)
 	{
-		p, ok := v.(*codelab.DeleteTestPayload)
+		p, ok := v.(*codelab.GetAttemptsByUserAndExercisePayload)
 		if !ok {
-			return nil, goahttp.ErrInvalidType("codelab", "DeleteTest", "*codelab.DeleteTestPayload", v)
+			return nil, goahttp.ErrInvalidType("codelab", "GetAttemptsByUserAndExercise", "*codelab.GetAttemptsByUserAndExercisePayload", v)
 		}
-		id = p.ID
+		userID = p.UserID
+		exerciseID = p.ExerciseID
 	}
-	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: DeleteTestCodelabPath(id)}
-	req, err := http.NewRequest("DELETE", u.String(), nil)
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: GetAttemptsByUserAndExerciseCodelabPath(userID, exerciseID)}
+	req, err := http.NewRequest("GET", u.String(), nil)
 	if err != nil {
-		return nil, goahttp.ErrInvalidURL("codelab", "DeleteTest", u.String(), err)
+		return nil, goahttp.ErrInvalidURL("codelab", "GetAttemptsByUserAndExercise", u.String(), err)
 	}
 	if ctx != nil {
 		req = req.WithContext(ctx)
@@ -1118,13 +5272,13 @@ func (c *Client) BuildDeleteTestRequest(ctx context.Context, v any) (*http.Reque
 	return req, nil
 }
 
-// EncodeDeleteTestRequest returns an encoder for requests sent to the codelab
-// DeleteTest server.
-func EncodeDeleteTestRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+// EncodeGetAttemptsByUserAndExerciseRequest returns an encoder for requests
+// sent to the codelab GetAttemptsByUserAndExercise server.
+func EncodeGetAttemptsByUserAndExerciseRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
 	return func(req *http.Request, v any) error {
-		p, ok := v.(*codelab.DeleteTestPayload)
+		p, ok := v.(*codelab.GetAttemptsByUserAndExercisePayload)
 		if !ok {
-			return goahttp.ErrInvalidType("codelab", "DeleteTest", "*codelab.DeleteTestPayload", v)
+			return goahttp.ErrInvalidType("codelab", "GetAttemptsByUserAndExercise", "*codelab.GetAttemptsByUserAndExercisePayload", v)
 		}
 		{
 			v := p.SessionToken
@@ -1137,17 +5291,17 @@ func EncodeDeleteTestRequest(encoder func(*http.Request) goahttp.Encoder) func(*
 	}
 }
 
-// DecodeDeleteTestResponse returns a decoder for responses returned by the
-// codelab DeleteTest endpoint. restoreBody controls whether the response body
-// should be restored after having been read.
-// DecodeDeleteTestResponse may return the following errors:
+// DecodeGetAttemptsByUserAndExerciseResponse returns a decoder for responses
+// returned by the codelab GetAttemptsByUserAndExercise endpoint. restoreBody
+// controls whether the response body should be restored after having been read.
+// DecodeGetAttemptsByUserAndExerciseResponse may return the following errors:
 //   - "invalid_input" (type codelab.InvalidInput): http.StatusBadRequest
 //   - "not_found" (type codelab.NotFound): http.StatusNotFound
 //   - "permission_denied" (type codelab.PermissionDenied): http.StatusForbidden
 //   - "service_unavailable" (type codelab.ServiceUnavailable): http.StatusServiceUnavailable
 //   - "unauthorized" (type codelab.Unauthorized): http.StatusUnauthorized
 //   - error: internal error
-func DecodeDeleteTestResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+func DecodeGetAttemptsByUserAndExerciseResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
 	return func(resp *http.Response) (any, error) {
 		if restoreBody {
 			b, err := io.ReadAll(resp.Body)
@@ -1164,18 +5318,24 @@ func DecodeDeleteTestResponse(decoder func(*http.Response) goahttp.Decoder, rest
 		switch resp.StatusCode {
 		case http.StatusOK:
 			var (
-				body DeleteTestResponseBody
+				body GetAttemptsByUserAndExerciseResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("codelab", "DeleteTest", err)
+				return nil, goahttp.ErrDecodingError("codelab", "GetAttemptsByUserAndExercise", err)
+			}
+			for _, e := range body {
+				if e != nil {
+					if err2 := ValidateAttemptResponse(e); err2 != nil {
+						err = goa.MergeErrors(err, err2)
+					}
+				}
 			}
-			err = ValidateDeleteTestResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("codelab", "DeleteTest", err)
+				return nil, goahttp.ErrValidationError("codelab", "GetAttemptsByUserAndExercise", err)
 			}
-			res := NewDeleteTestSimpleResponseOK(&body)
+			res := NewGetAttemptsByUserAndExerciseAttemptOK(body)
 			return res, nil
 		case http.StatusBadRequest:
 			var (
@@ -1184,9 +5344,9 @@ func DecodeDeleteTestResponse(decoder func(*http.Response) goahttp.Decoder, rest
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("codelab", "DeleteTest", err)
+				return nil, goahttp.ErrDecodingError("codelab", "GetAttemptsByUserAndExercise", err)
 			}
-			return nil, NewDeleteTestInvalidInput(body)
+			return nil, NewGetAttemptsByUserAndExerciseInvalidInput(body)
 		case http.StatusNotFound:
 			var (
 				body string
@@ -1194,9 +5354,9 @@ func DecodeDeleteTestResponse(decoder func(*http.Response) goahttp.Decoder, rest
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("codelab", "DeleteTest", err)
+				return nil, goahttp.ErrDecodingError("codelab", "GetAttemptsByUserAndExercise", err)
 			}
-			return nil, NewDeleteTestNotFound(body)
+			return nil, NewGetAttemptsByUserAndExerciseNotFound(body)
 		case http.StatusForbidden:
 			var (
 				body string
@@ -1204,9 +5364,9 @@ func DecodeDeleteTestResponse(decoder func(*http.Response) goahttp.Decoder, rest
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("codelab", "DeleteTest", err)
+				return nil, goahttp.ErrDecodingError("codelab", "GetAttemptsByUserAndExercise", err)
 			}
-			return nil, NewDeleteTestPermissionDenied(body)
+			return nil, NewGetAttemptsByUserAndExercisePermissionDenied(body)
 		case http.StatusServiceUnavailable:
 			var (
 				body string
@@ -1214,9 +5374,9 @@ func DecodeDeleteTestResponse(decoder func(*http.Response) goahttp.Decoder, rest
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("codelab", "DeleteTest", err)
+				return nil, goahttp.ErrDecodingError("codelab", "GetAttemptsByUserAndExercise", err)
 			}
-			return nil, NewDeleteTestServiceUnavailable(body)
+			return nil, NewGetAttemptsByUserAndExerciseServiceUnavailable(body)
 		case http.StatusUnauthorized:
 			var (
 				body string
@@ -1224,34 +5384,34 @@ func DecodeDeleteTestResponse(decoder func(*http.Response) goahttp.Decoder, rest
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("codelab", "DeleteTest", err)
+				return nil, goahttp.ErrDecodingError("codelab", "GetAttemptsByUserAndExercise", err)
 			}
-			return nil, NewDeleteTestUnauthorized(body)
+			return nil, NewGetAttemptsByUserAndExerciseUnauthorized(body)
 		default:
 			body, _ := io.ReadAll(resp.Body)
-			return nil, goahttp.ErrInvalidResponse("codelab", "DeleteTest", resp.StatusCode, string(body))
+			return nil, goahttp.ErrInvalidResponse("codelab", "GetAttemptsByUserAndExercise", resp.StatusCode, string(body))
 		}
 	}
 }
 
-// BuildGetExerciseForStudentRequest instantiates a HTTP request object with
-// method and path set to call the "codelab" service "GetExerciseForStudent"
+// BuildGetHintsForStudentRequest instantiates a HTTP request object with
+// method and path set to call the "codelab" service "GetHintsForStudent"
 // endpoint
-func (c *Client) BuildGetExerciseForStudentRequest(ctx context.Context, v any) (*http.Request, error) {
+func (c *Client) BuildGetHintsForStudentRequest(ctx context.Context, v any) (*http.Request, error) {
 	var (
-		id int64
+		exerciseID int64
 	)
 	{
-		p, ok := v.(*codelab.GetExerciseForStudentPayload)
+		p, ok := v.(*codelab.GetHintsForStudentPayload)
 		if !ok {
-			return nil, goahttp.ErrInvalidType("codelab", "GetExerciseForStudent", "*codelab.GetExerciseForStudentPayload", v)
+			return nil, goahttp.ErrInvalidType("codelab", "GetHintsForStudent", "*codelab.GetHintsForStudentPayload", v)
 		}
-		id = p.ID
+		exerciseID = p.ExerciseID
 	}
-	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: GetExerciseForStudentCodelabPath(id)}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: GetHintsForStudentCodelabPath(exerciseID)}
 	req, err := http.NewRequest("GET", u.String(), nil)
 	if err != nil {
-		return nil, goahttp.ErrInvalidURL("codelab", "GetExerciseForStudent", u.String(), err)
+		return nil, goahttp.ErrInvalidURL("codelab", "GetHintsForStudent", u.String(), err)
 	}
 	if ctx != nil {
 		req = req.WithContext(ctx)
@@ -1260,13 +5420,13 @@ func (c *Client) BuildGetExerciseForStudentRequest(ctx context.Context, v any) (
 	return req, nil
 }
 
-// EncodeGetExerciseForStudentRequest returns an encoder for requests sent to
-// the codelab GetExerciseForStudent server.
-func EncodeGetExerciseForStudentRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+// EncodeGetHintsForStudentRequest returns an encoder for requests sent to the
+// codelab GetHintsForStudent server.
+func EncodeGetHintsForStudentRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
 	return func(req *http.Request, v any) error {
-		p, ok := v.(*codelab.GetExerciseForStudentPayload)
+		p, ok := v.(*codelab.GetHintsForStudentPayload)
 		if !ok {
-			return goahttp.ErrInvalidType("codelab", "GetExerciseForStudent", "*codelab.GetExerciseForStudentPayload", v)
+			return goahttp.ErrInvalidType("codelab", "GetHintsForStudent", "*codelab.GetHintsForStudentPayload", v)
 		}
 		{
 			v := p.SessionToken
@@ -1279,17 +5439,17 @@ func EncodeGetExerciseForStudentRequest(encoder func(*http.Request) goahttp.Enco
 	}
 }
 
-// DecodeGetExerciseForStudentResponse returns a decoder for responses returned
-// by the codelab GetExerciseForStudent endpoint. restoreBody controls whether
-// the response body should be restored after having been read.
-// DecodeGetExerciseForStudentResponse may return the following errors:
+// DecodeGetHintsForStudentResponse returns a decoder for responses returned by
+// the codelab GetHintsForStudent endpoint. restoreBody controls whether the
+// response body should be restored after having been read.
+// DecodeGetHintsForStudentResponse may return the following errors:
 //   - "invalid_input" (type codelab.InvalidInput): http.StatusBadRequest
 //   - "not_found" (type codelab.NotFound): http.StatusNotFound
 //   - "permission_denied" (type codelab.PermissionDenied): http.StatusForbidden
 //   - "service_unavailable" (type codelab.ServiceUnavailable): http.StatusServiceUnavailable
 //   - "unauthorized" (type codelab.Unauthorized): http.StatusUnauthorized
 //   - error: internal error
-func DecodeGetExerciseForStudentResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+func DecodeGetHintsForStudentResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
 	return func(resp *http.Response) (any, error) {
 		if restoreBody {
 			b, err := io.ReadAll(resp.Body)
@@ -1306,18 +5466,24 @@ func DecodeGetExerciseForStudentResponse(decoder func(*http.Response) goahttp.De
 		switch resp.StatusCode {
 		case http.StatusOK:
 			var (
-				body GetExerciseForStudentResponseBody
+				body GetHintsForStudentResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("codelab", "GetExerciseForStudent", err)
+				return nil, goahttp.ErrDecodingError("codelab", "GetHintsForStudent", err)
+			}
+			for _, e := range body {
+				if e != nil {
+					if err2 := ValidateStudentHintResponse(e); err2 != nil {
+						err = goa.MergeErrors(err, err2)
+					}
+				}
 			}
-			err = ValidateGetExerciseForStudentResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("codelab", "GetExerciseForStudent", err)
+				return nil, goahttp.ErrValidationError("codelab", "GetHintsForStudent", err)
 			}
-			res := NewGetExerciseForStudentExerciseForStudentsOK(&body)
+			res := NewGetHintsForStudentStudentHintOK(body)
 			return res, nil
 		case http.StatusBadRequest:
 			var (
@@ -1326,9 +5492,9 @@ func DecodeGetExerciseForStudentResponse(decoder func(*http.Response) goahttp.De
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("codelab", "GetExerciseForStudent", err)
+				return nil, goahttp.ErrDecodingError("codelab", "GetHintsForStudent", err)
 			}
-			return nil, NewGetExerciseForStudentInvalidInput(body)
+			return nil, NewGetHintsForStudentInvalidInput(body)
 		case http.StatusNotFound:
 			var (
 				body string
@@ -1336,9 +5502,9 @@ func DecodeGetExerciseForStudentResponse(decoder func(*http.Response) goahttp.De
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("codelab", "GetExerciseForStudent", err)
+				return nil, goahttp.ErrDecodingError("codelab", "GetHintsForStudent", err)
 			}
-			return nil, NewGetExerciseForStudentNotFound(body)
+			return nil, NewGetHintsForStudentNotFound(body)
 		case http.StatusForbidden:
 			var (
 				body string
@@ -1346,9 +5512,9 @@ func DecodeGetExerciseForStudentResponse(decoder func(*http.Response) goahttp.De
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("codelab", "GetExerciseForStudent", err)
+				return nil, goahttp.ErrDecodingError("codelab", "GetHintsForStudent", err)
 			}
-			return nil, NewGetExerciseForStudentPermissionDenied(body)
+			return nil, NewGetHintsForStudentPermissionDenied(body)
 		case http.StatusServiceUnavailable:
 			var (
 				body string
@@ -1356,9 +5522,9 @@ func DecodeGetExerciseForStudentResponse(decoder func(*http.Response) goahttp.De
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("codelab", "GetExerciseForStudent", err)
+				return nil, goahttp.ErrDecodingError("codelab", "GetHintsForStudent", err)
 			}
-			return nil, NewGetExerciseForStudentServiceUnavailable(body)
+			return nil, NewGetHintsForStudentServiceUnavailable(body)
 		case http.StatusUnauthorized:
 			var (
 				body string
@@ -1366,24 +5532,33 @@ func DecodeGetExerciseForStudentResponse(decoder func(*http.Response) goahttp.De
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("codelab", "GetExerciseForStudent", err)
+				return nil, goahttp.ErrDecodingError("codelab", "GetHintsForStudent", err)
 			}
-			return nil, NewGetExerciseForStudentUnauthorized(body)
+			return nil, NewGetHintsForStudentUnauthorized(body)
 		default:
 			body, _ := io.ReadAll(resp.Body)
-			return nil, goahttp.ErrInvalidResponse("codelab", "GetExerciseForStudent", resp.StatusCode, string(body))
+			return nil, goahttp.ErrInvalidResponse("codelab", "GetHintsForStudent", resp.StatusCode, string(body))
 		}
 	}
 }
 
-// BuildListExercisesForStudentsRequest instantiates a HTTP request object with
-// method and path set to call the "codelab" service "ListExercisesForStudents"
-// endpoint
-func (c *Client) BuildListExercisesForStudentsRequest(ctx context.Context, v any) (*http.Request, error) {
-	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: ListExercisesForStudentsCodelabPath()}
-	req, err := http.NewRequest("GET", u.String(), nil)
+// BuildRequestHintRequest instantiates a HTTP request object with method and
+// path set to call the "codelab" service "RequestHint" endpoint
+func (c *Client) BuildRequestHintRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		exerciseID int64
+	)
+	{
+		p, ok := v.(*codelab.RequestHintPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("codelab", "RequestHint", "*codelab.RequestHintPayload", v)
+		}
+		exerciseID = p.ExerciseID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: RequestHintCodelabPath(exerciseID)}
+	req, err := http.NewRequest("POST", u.String(), nil)
 	if err != nil {
-		return nil, goahttp.ErrInvalidURL("codelab", "ListExercisesForStudents", u.String(), err)
+		return nil, goahttp.ErrInvalidURL("codelab", "RequestHint", u.String(), err)
 	}
 	if ctx != nil {
 		req = req.WithContext(ctx)
@@ -1392,13 +5567,13 @@ func (c *Client) BuildListExercisesForStudentsRequest(ctx context.Context, v any
 	return req, nil
 }
 
-// EncodeListExercisesForStudentsRequest returns an encoder for requests sent
-// to the codelab ListExercisesForStudents server.
-func EncodeListExercisesForStudentsRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+// EncodeRequestHintRequest returns an encoder for requests sent to the codelab
+// RequestHint server.
+func EncodeRequestHintRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
 	return func(req *http.Request, v any) error {
-		p, ok := v.(*codelab.ListExercisesForStudentsPayload)
+		p, ok := v.(*codelab.RequestHintPayload)
 		if !ok {
-			return goahttp.ErrInvalidType("codelab", "ListExercisesForStudents", "*codelab.ListExercisesForStudentsPayload", v)
+			return goahttp.ErrInvalidType("codelab", "RequestHint", "*codelab.RequestHintPayload", v)
 		}
 		{
 			v := p.SessionToken
@@ -1411,17 +5586,17 @@ func EncodeListExercisesForStudentsRequest(encoder func(*http.Request) goahttp.E
 	}
 }
 
-// DecodeListExercisesForStudentsResponse returns a decoder for responses
-// returned by the codelab ListExercisesForStudents endpoint. restoreBody
-// controls whether the response body should be restored after having been read.
-// DecodeListExercisesForStudentsResponse may return the following errors:
+// DecodeRequestHintResponse returns a decoder for responses returned by the
+// codelab RequestHint endpoint. restoreBody controls whether the response body
+// should be restored after having been read.
+// DecodeRequestHintResponse may return the following errors:
 //   - "invalid_input" (type codelab.InvalidInput): http.StatusBadRequest
 //   - "not_found" (type codelab.NotFound): http.StatusNotFound
 //   - "permission_denied" (type codelab.PermissionDenied): http.StatusForbidden
 //   - "service_unavailable" (type codelab.ServiceUnavailable): http.StatusServiceUnavailable
 //   - "unauthorized" (type codelab.Unauthorized): http.StatusUnauthorized
 //   - error: internal error
-func DecodeListExercisesForStudentsResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+func DecodeRequestHintResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
 	return func(resp *http.Response) (any, error) {
 		if restoreBody {
 			b, err := io.ReadAll(resp.Body)
@@ -1436,26 +5611,20 @@ func DecodeListExercisesForStudentsResponse(decoder func(*http.Response) goahttp
 			defer resp.Body.Close()
 		}
 		switch resp.StatusCode {
-		case http.StatusOK:
+		case http.StatusCreated:
 			var (
-				body ListExercisesForStudentsResponseBody
+				body RequestHintResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("codelab", "ListExercisesForStudents", err)
-			}
-			for _, e := range body {
-				if e != nil {
-					if err2 := ValidateExerciseForStudentsListViewResponse(e); err2 != nil {
-						err = goa.MergeErrors(err, err2)
-					}
-				}
+				return nil, goahttp.ErrDecodingError("codelab", "RequestHint", err)
 			}
+			err = ValidateRequestHintResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("codelab", "ListExercisesForStudents", err)
+				return nil, goahttp.ErrValidationError("codelab", "RequestHint", err)
 			}
-			res := NewListExercisesForStudentsExerciseForStudentsListViewOK(body)
+			res := NewRequestHintStudentHintCreated(&body)
 			return res, nil
 		case http.StatusBadRequest:
 			var (
@@ -1464,9 +5633,9 @@ func DecodeListExercisesForStudentsResponse(decoder func(*http.Response) goahttp
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("codelab", "ListExercisesForStudents", err)
+				return nil, goahttp.ErrDecodingError("codelab", "RequestHint", err)
 			}
-			return nil, NewListExercisesForStudentsInvalidInput(body)
+			return nil, NewRequestHintInvalidInput(body)
 		case http.StatusNotFound:
 			var (
 				body string
@@ -1474,9 +5643,9 @@ func DecodeListExercisesForStudentsResponse(decoder func(*http.Response) goahttp
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("codelab", "ListExercisesForStudents", err)
+				return nil, goahttp.ErrDecodingError("codelab", "RequestHint", err)
 			}
-			return nil, NewListExercisesForStudentsNotFound(body)
+			return nil, NewRequestHintNotFound(body)
 		case http.StatusForbidden:
 			var (
 				body string
@@ -1484,9 +5653,9 @@ func DecodeListExercisesForStudentsResponse(decoder func(*http.Response) goahttp
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("codelab", "ListExercisesForStudents", err)
+				return nil, goahttp.ErrDecodingError("codelab", "RequestHint", err)
 			}
-			return nil, NewListExercisesForStudentsPermissionDenied(body)
+			return nil, NewRequestHintPermissionDenied(body)
 		case http.StatusServiceUnavailable:
 			var (
 				body string
@@ -1494,9 +5663,9 @@ func DecodeListExercisesForStudentsResponse(decoder func(*http.Response) goahttp
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("codelab", "ListExercisesForStudents", err)
+				return nil, goahttp.ErrDecodingError("codelab", "RequestHint", err)
 			}
-			return nil, NewListExercisesForStudentsServiceUnavailable(body)
+			return nil, NewRequestHintServiceUnavailable(body)
 		case http.StatusUnauthorized:
 			var (
 				body string
@@ -1504,23 +5673,24 @@ func DecodeListExercisesForStudentsResponse(decoder func(*http.Response) goahttp
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("codelab", "ListExercisesForStudents", err)
+				return nil, goahttp.ErrDecodingError("codelab", "RequestHint", err)
 			}
-			return nil, NewListExercisesForStudentsUnauthorized(body)
+			return nil, NewRequestHintUnauthorized(body)
 		default:
 			body, _ := io.ReadAll(resp.Body)
-			return nil, goahttp.ErrInvalidResponse("codelab", "ListExercisesForStudents", resp.StatusCode, string(body))
+			return nil, goahttp.ErrInvalidResponse("codelab", "RequestHint", resp.StatusCode, string(body))
 		}
 	}
 }
 
-// BuildCreateAttemptRequest instantiates a HTTP request object with method and
-// path set to call the "codelab" service "CreateAttempt" endpoint
-func (c *Client) BuildCreateAttemptRequest(ctx context.Context, v any) (*http.Request, error) {
-	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: CreateAttemptCodelabPath()}
-	req, err := http.NewRequest("POST", u.String(), nil)
+// BuildListAssignmentsForStudentsRequest instantiates a HTTP request object
+// with method and path set to call the "codelab" service
+// "ListAssignmentsForStudents" endpoint
+func (c *Client) BuildListAssignmentsForStudentsRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: ListAssignmentsForStudentsCodelabPath()}
+	req, err := http.NewRequest("GET", u.String(), nil)
 	if err != nil {
-		return nil, goahttp.ErrInvalidURL("codelab", "CreateAttempt", u.String(), err)
+		return nil, goahttp.ErrInvalidURL("codelab", "ListAssignmentsForStudents", u.String(), err)
 	}
 	if ctx != nil {
 		req = req.WithContext(ctx)
@@ -1529,13 +5699,13 @@ func (c *Client) BuildCreateAttemptRequest(ctx context.Context, v any) (*http.Re
 	return req, nil
 }
 
-// EncodeCreateAttemptRequest returns an encoder for requests sent to the
-// codelab CreateAttempt server.
-func EncodeCreateAttemptRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+// EncodeListAssignmentsForStudentsRequest returns an encoder for requests sent
+// to the codelab ListAssignmentsForStudents server.
+func EncodeListAssignmentsForStudentsRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
 	return func(req *http.Request, v any) error {
-		p, ok := v.(*codelab.CreateAttemptPayload)
+		p, ok := v.(*codelab.ListAssignmentsForStudentsPayload)
 		if !ok {
-			return goahttp.ErrInvalidType("codelab", "CreateAttempt", "*codelab.CreateAttemptPayload", v)
+			return goahttp.ErrInvalidType("codelab", "ListAssignmentsForStudents", "*codelab.ListAssignmentsForStudentsPayload", v)
 		}
 		{
 			v := p.SessionToken
@@ -1544,25 +5714,20 @@ func EncodeCreateAttemptRequest(encoder func(*http.Request) goahttp.Encoder) fun
 				Value: v,
 			})
 		}
-		body := NewCreateAttemptRequestBody(p)
-		if err := encoder(req).Encode(&body); err != nil {
-			return goahttp.ErrEncodingError("codelab", "CreateAttempt", err)
-		}
 		return nil
 	}
 }
 
-// DecodeCreateAttemptResponse returns a decoder for responses returned by the
-// codelab CreateAttempt endpoint. restoreBody controls whether the response
-// body should be restored after having been read.
-// DecodeCreateAttemptResponse may return the following errors:
+// DecodeListAssignmentsForStudentsResponse returns a decoder for responses
+// returned by the codelab ListAssignmentsForStudents endpoint. restoreBody
+// controls whether the response body should be restored after having been read.
+// DecodeListAssignmentsForStudentsResponse may return the following errors:
 //   - "invalid_input" (type codelab.InvalidInput): http.StatusBadRequest
-//   - "not_found" (type codelab.NotFound): http.StatusNotFound
 //   - "permission_denied" (type codelab.PermissionDenied): http.StatusForbidden
 //   - "service_unavailable" (type codelab.ServiceUnavailable): http.StatusServiceUnavailable
 //   - "unauthorized" (type codelab.Unauthorized): http.StatusUnauthorized
 //   - error: internal error
-func DecodeCreateAttemptResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+func DecodeListAssignmentsForStudentsResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
 	return func(resp *http.Response) (any, error) {
 		if restoreBody {
 			b, err := io.ReadAll(resp.Body)
@@ -1577,20 +5742,26 @@ func DecodeCreateAttemptResponse(decoder func(*http.Response) goahttp.Decoder, r
 			defer resp.Body.Close()
 		}
 		switch resp.StatusCode {
-		case http.StatusCreated:
+		case http.StatusOK:
 			var (
-				body CreateAttemptResponseBody
+				body ListAssignmentsForStudentsResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("codelab", "CreateAttempt", err)
+				return nil, goahttp.ErrDecodingError("codelab", "ListAssignmentsForStudents", err)
+			}
+			for _, e := range body {
+				if e != nil {
+					if err2 := ValidateAssignmentResponse(e); err2 != nil {
+						err = goa.MergeErrors(err, err2)
+					}
+				}
 			}
-			err = ValidateCreateAttemptResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("codelab", "CreateAttempt", err)
+				return nil, goahttp.ErrValidationError("codelab", "ListAssignmentsForStudents", err)
 			}
-			res := NewCreateAttemptSimpleResponseCreated(&body)
+			res := NewListAssignmentsForStudentsAssignmentOK(body)
 			return res, nil
 		case http.StatusBadRequest:
 			var (
@@ -1599,19 +5770,9 @@ func DecodeCreateAttemptResponse(decoder func(*http.Response) goahttp.Decoder, r
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("codelab", "CreateAttempt", err)
-			}
-			return nil, NewCreateAttemptInvalidInput(body)
-		case http.StatusNotFound:
-			var (
-				body string
-				err  error
-			)
-			err = decoder(resp).Decode(&body)
-			if err != nil {
-				return nil, goahttp.ErrDecodingError("codelab", "CreateAttempt", err)
+				return nil, goahttp.ErrDecodingError("codelab", "ListAssignmentsForStudents", err)
 			}
-			return nil, NewCreateAttemptNotFound(body)
+			return nil, NewListAssignmentsForStudentsInvalidInput(body)
 		case http.StatusForbidden:
 			var (
 				body string
@@ -1619,9 +5780,9 @@ func DecodeCreateAttemptResponse(decoder func(*http.Response) goahttp.Decoder, r
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("codelab", "CreateAttempt", err)
+				return nil, goahttp.ErrDecodingError("codelab", "ListAssignmentsForStudents", err)
 			}
-			return nil, NewCreateAttemptPermissionDenied(body)
+			return nil, NewListAssignmentsForStudentsPermissionDenied(body)
 		case http.StatusServiceUnavailable:
 			var (
 				body string
@@ -1629,9 +5790,9 @@ func DecodeCreateAttemptResponse(decoder func(*http.Response) goahttp.Decoder, r
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("codelab", "CreateAttempt", err)
+				return nil, goahttp.ErrDecodingError("codelab", "ListAssignmentsForStudents", err)
 			}
-			return nil, NewCreateAttemptServiceUnavailable(body)
+			return nil, NewListAssignmentsForStudentsServiceUnavailable(body)
 		case http.StatusUnauthorized:
 			var (
 				body string
@@ -1639,36 +5800,34 @@ func DecodeCreateAttemptResponse(decoder func(*http.Response) goahttp.Decoder, r
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("codelab", "CreateAttempt", err)
+				return nil, goahttp.ErrDecodingError("codelab", "ListAssignmentsForStudents", err)
 			}
-			return nil, NewCreateAttemptUnauthorized(body)
+			return nil, NewListAssignmentsForStudentsUnauthorized(body)
 		default:
 			body, _ := io.ReadAll(resp.Body)
-			return nil, goahttp.ErrInvalidResponse("codelab", "CreateAttempt", resp.StatusCode, string(body))
+			return nil, goahttp.ErrInvalidResponse("codelab", "ListAssignmentsForStudents", resp.StatusCode, string(body))
 		}
 	}
 }
 
-// BuildGetAttemptsByUserAndExerciseRequest instantiates a HTTP request object
-// with method and path set to call the "codelab" service
-// "GetAttemptsByUserAndExercise" endpoint
-func (c *Client) BuildGetAttemptsByUserAndExerciseRequest(ctx context.Context, v any) (*http.Request, error) {
+// BuildGetAssignmentProgressRequest instantiates a HTTP request object with
+// method and path set to call the "codelab" service "GetAssignmentProgress"
+// endpoint
+func (c *Client) BuildGetAssignmentProgressRequest(ctx context.Context, v any) (*http.Request, error) {
 	var (
-		userID     int64
-		exerciseID int64
+		id int64
 	)
 	{
-		p, ok := v.(*codelab.GetAttemptsByUserAndExercisePayload)
+		p, ok := v.(*codelab.GetAssignmentProgressPayload)
 		if !ok {
-			return nil, goahttp.ErrInvalidType("codelab", "GetAttemptsByUserAndExercise", "*codelab.GetAttemptsByUserAndExercisePayload", v)
+			return nil, goahttp.ErrInvalidType("codelab", "GetAssignmentProgress", "*codelab.GetAssignmentProgressPayload", v)
 		}
-		userID = p.UserID
-		exerciseID = p.ExerciseID
+		id = p.ID
 	}
-	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: GetAttemptsByUserAndExerciseCodelabPath(userID, exerciseID)}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: GetAssignmentProgressCodelabPath(id)}
 	req, err := http.NewRequest("GET", u.String(), nil)
 	if err != nil {
-		return nil, goahttp.ErrInvalidURL("codelab", "GetAttemptsByUserAndExercise", u.String(), err)
+		return nil, goahttp.ErrInvalidURL("codelab", "GetAssignmentProgress", u.String(), err)
 	}
 	if ctx != nil {
 		req = req.WithContext(ctx)
@@ -1677,13 +5836,13 @@ func (c *Client) BuildGetAttemptsByUserAndExerciseRequest(ctx context.Context, v
 	return req, nil
 }
 
-// EncodeGetAttemptsByUserAndExerciseRequest returns an encoder for requests
-// sent to the codelab GetAttemptsByUserAndExercise server.
-func EncodeGetAttemptsByUserAndExerciseRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+// EncodeGetAssignmentProgressRequest returns an encoder for requests sent to
+// the codelab GetAssignmentProgress server.
+func EncodeGetAssignmentProgressRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
 	return func(req *http.Request, v any) error {
-		p, ok := v.(*codelab.GetAttemptsByUserAndExercisePayload)
+		p, ok := v.(*codelab.GetAssignmentProgressPayload)
 		if !ok {
-			return goahttp.ErrInvalidType("codelab", "GetAttemptsByUserAndExercise", "*codelab.GetAttemptsByUserAndExercisePayload", v)
+			return goahttp.ErrInvalidType("codelab", "GetAssignmentProgress", "*codelab.GetAssignmentProgressPayload", v)
 		}
 		{
 			v := p.SessionToken
@@ -1696,17 +5855,17 @@ func EncodeGetAttemptsByUserAndExerciseRequest(encoder func(*http.Request) goaht
 	}
 }
 
-// DecodeGetAttemptsByUserAndExerciseResponse returns a decoder for responses
-// returned by the codelab GetAttemptsByUserAndExercise endpoint. restoreBody
-// controls whether the response body should be restored after having been read.
-// DecodeGetAttemptsByUserAndExerciseResponse may return the following errors:
+// DecodeGetAssignmentProgressResponse returns a decoder for responses returned
+// by the codelab GetAssignmentProgress endpoint. restoreBody controls whether
+// the response body should be restored after having been read.
+// DecodeGetAssignmentProgressResponse may return the following errors:
 //   - "invalid_input" (type codelab.InvalidInput): http.StatusBadRequest
 //   - "not_found" (type codelab.NotFound): http.StatusNotFound
 //   - "permission_denied" (type codelab.PermissionDenied): http.StatusForbidden
 //   - "service_unavailable" (type codelab.ServiceUnavailable): http.StatusServiceUnavailable
 //   - "unauthorized" (type codelab.Unauthorized): http.StatusUnauthorized
 //   - error: internal error
-func DecodeGetAttemptsByUserAndExerciseResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+func DecodeGetAssignmentProgressResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
 	return func(resp *http.Response) (any, error) {
 		if restoreBody {
 			b, err := io.ReadAll(resp.Body)
@@ -1723,24 +5882,18 @@ func DecodeGetAttemptsByUserAndExerciseResponse(decoder func(*http.Response) goa
 		switch resp.StatusCode {
 		case http.StatusOK:
 			var (
-				body GetAttemptsByUserAndExerciseResponseBody
+				body GetAssignmentProgressResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("codelab", "GetAttemptsByUserAndExercise", err)
-			}
-			for _, e := range body {
-				if e != nil {
-					if err2 := ValidateAttemptResponse(e); err2 != nil {
-						err = goa.MergeErrors(err, err2)
-					}
-				}
+				return nil, goahttp.ErrDecodingError("codelab", "GetAssignmentProgress", err)
 			}
+			err = ValidateGetAssignmentProgressResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("codelab", "GetAttemptsByUserAndExercise", err)
+				return nil, goahttp.ErrValidationError("codelab", "GetAssignmentProgress", err)
 			}
-			res := NewGetAttemptsByUserAndExerciseAttemptOK(body)
+			res := NewGetAssignmentProgressAssignmentProgressOK(&body)
 			return res, nil
 		case http.StatusBadRequest:
 			var (
@@ -1749,9 +5902,9 @@ func DecodeGetAttemptsByUserAndExerciseResponse(decoder func(*http.Response) goa
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("codelab", "GetAttemptsByUserAndExercise", err)
+				return nil, goahttp.ErrDecodingError("codelab", "GetAssignmentProgress", err)
 			}
-			return nil, NewGetAttemptsByUserAndExerciseInvalidInput(body)
+			return nil, NewGetAssignmentProgressInvalidInput(body)
 		case http.StatusNotFound:
 			var (
 				body string
@@ -1759,9 +5912,9 @@ func DecodeGetAttemptsByUserAndExerciseResponse(decoder func(*http.Response) goa
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("codelab", "GetAttemptsByUserAndExercise", err)
+				return nil, goahttp.ErrDecodingError("codelab", "GetAssignmentProgress", err)
 			}
-			return nil, NewGetAttemptsByUserAndExerciseNotFound(body)
+			return nil, NewGetAssignmentProgressNotFound(body)
 		case http.StatusForbidden:
 			var (
 				body string
@@ -1769,9 +5922,9 @@ func DecodeGetAttemptsByUserAndExerciseResponse(decoder func(*http.Response) goa
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("codelab", "GetAttemptsByUserAndExercise", err)
+				return nil, goahttp.ErrDecodingError("codelab", "GetAssignmentProgress", err)
 			}
-			return nil, NewGetAttemptsByUserAndExercisePermissionDenied(body)
+			return nil, NewGetAssignmentProgressPermissionDenied(body)
 		case http.StatusServiceUnavailable:
 			var (
 				body string
@@ -1779,9 +5932,9 @@ func DecodeGetAttemptsByUserAndExerciseResponse(decoder func(*http.Response) goa
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("codelab", "GetAttemptsByUserAndExercise", err)
+				return nil, goahttp.ErrDecodingError("codelab", "GetAssignmentProgress", err)
 			}
-			return nil, NewGetAttemptsByUserAndExerciseServiceUnavailable(body)
+			return nil, NewGetAssignmentProgressServiceUnavailable(body)
 		case http.StatusUnauthorized:
 			var (
 				body string
@@ -1789,12 +5942,12 @@ func DecodeGetAttemptsByUserAndExerciseResponse(decoder func(*http.Response) goa
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("codelab", "GetAttemptsByUserAndExercise", err)
+				return nil, goahttp.ErrDecodingError("codelab", "GetAssignmentProgress", err)
 			}
-			return nil, NewGetAttemptsByUserAndExerciseUnauthorized(body)
+			return nil, NewGetAssignmentProgressUnauthorized(body)
 		default:
 			body, _ := io.ReadAll(resp.Body)
-			return nil, goahttp.ErrInvalidResponse("codelab", "GetAttemptsByUserAndExercise", resp.StatusCode, string(body))
+			return nil, goahttp.ErrInvalidResponse("codelab", "GetAssignmentProgress", resp.StatusCode, string(body))
 		}
 	}
 }
@@ -1943,9 +6096,25 @@ func DecodeGetAnswerByUserAndExerciseResponse(decoder func(*http.Response) goaht
 	}
 }
 
-// unmarshalExerciseResponseToCodelabExercise builds a value of type
-// *codelab.Exercise from a value of type *ExerciseResponse.
-func unmarshalExerciseResponseToCodelabExercise(v *ExerciseResponse) *codelab.Exercise {
+// unmarshalSolutionTestFailureResponseBodyToCodelabSolutionTestFailure builds
+// a value of type *codelab.SolutionTestFailure from a value of type
+// *SolutionTestFailureResponseBody.
+func unmarshalSolutionTestFailureResponseBodyToCodelabSolutionTestFailure(v *SolutionTestFailureResponseBody) *codelab.SolutionTestFailure {
+	res := &codelab.SolutionTestFailure{
+		TestID:         v.TestID,
+		Input:          *v.Input,
+		ExpectedOutput: *v.ExpectedOutput,
+		ActualOutput:   *v.ActualOutput,
+		Status:         *v.Status,
+		ErrorMessage:   v.ErrorMessage,
+	}
+
+	return res
+}
+
+// unmarshalExerciseToCodelabExercise builds a value of type *codelab.Exercise
+// from a value of type *Exercise.
+func unmarshalExerciseToCodelabExercise(v *Exercise) *codelab.Exercise {
 	res := &codelab.Exercise{
 		ID:          *v.ID,
 		Title:       *v.Title,
@@ -1956,6 +6125,23 @@ func unmarshalExerciseResponseToCodelabExercise(v *ExerciseResponse) *codelab.Ex
 		CreatedBy:   *v.CreatedBy,
 		CreatedAt:   *v.CreatedAt,
 		UpdatedAt:   *v.UpdatedAt,
+		Language:    *v.Language,
+		Version:     *v.Version,
+	}
+	res.Tags = make([]string, len(v.Tags))
+	for i, val := range v.Tags {
+		res.Tags[i] = val
+	}
+
+	return res
+}
+
+// unmarshalTagCountResponseToCodelabTagCount builds a value of type
+// *codelab.TagCount from a value of type *TagCountResponse.
+func unmarshalTagCountResponseToCodelabTagCount(v *TagCountResponse) *codelab.TagCount {
+	res := &codelab.TagCount{
+		Tag:       *v.Tag,
+		Exercises: *v.Exercises,
 	}
 
 	return res
@@ -1971,6 +6157,13 @@ func marshalCodelabUpdateExercisePayloadToUpdateExercisePayloadRequestBody(v *co
 		InitialCode: v.InitialCode,
 		Solution:    v.Solution,
 		Difficulty:  v.Difficulty,
+		Language:    v.Language,
+	}
+	if v.Tags != nil {
+		res.Tags = make([]string, len(v.Tags))
+		for i, val := range v.Tags {
+			res.Tags[i] = val
+		}
 	}
 
 	return res
@@ -1986,6 +6179,13 @@ func marshalUpdateExercisePayloadRequestBodyToCodelabUpdateExercisePayload(v *Up
 		InitialCode: v.InitialCode,
 		Solution:    v.Solution,
 		Difficulty:  v.Difficulty,
+		Language:    v.Language,
+	}
+	if v.Tags != nil {
+		res.Tags = make([]string, len(v.Tags))
+		for i, val := range v.Tags {
+			res.Tags[i] = val
+		}
 	}
 
 	return res
@@ -1995,13 +6195,17 @@ func marshalUpdateExercisePayloadRequestBodyToCodelabUpdateExercisePayload(v *Up
 // a value of type *TestResponse.
 func unmarshalTestResponseToCodelabTest(v *TestResponse) *codelab.Test {
 	res := &codelab.Test{
-		ID:         *v.ID,
-		Input:      *v.Input,
-		Output:     *v.Output,
-		Public:     *v.Public,
-		ExerciseID: *v.ExerciseID,
-		CreatedAt:  *v.CreatedAt,
-		UpdatedAt:  *v.UpdatedAt,
+		ID:          *v.ID,
+		Input:       *v.Input,
+		Output:      *v.Output,
+		Public:      *v.Public,
+		ExerciseID:  *v.ExerciseID,
+		CreatedAt:   *v.CreatedAt,
+		UpdatedAt:   *v.UpdatedAt,
+		InputFormat: *v.InputFormat,
+		Comparison:  *v.Comparison,
+		Tolerance:   *v.Tolerance,
+		Weight:      *v.Weight,
 	}
 
 	return res
@@ -2012,9 +6216,37 @@ func unmarshalTestResponseToCodelabTest(v *TestResponse) *codelab.Test {
 // *codelab.UpdateTestPayload.
 func marshalCodelabUpdateTestPayloadToUpdateTestPayloadRequestBody(v *codelab.UpdateTestPayload) *UpdateTestPayloadRequestBody {
 	res := &UpdateTestPayloadRequestBody{
-		Input:  v.Input,
-		Output: v.Output,
-		Public: v.Public,
+		Input:       v.Input,
+		Output:      v.Output,
+		Public:      v.Public,
+		InputFormat: v.InputFormat,
+		Comparison:  v.Comparison,
+		Tolerance:   v.Tolerance,
+		Weight:      v.Weight,
+	}
+	{
+		var zero string
+		if res.InputFormat == zero {
+			res.InputFormat = "text"
+		}
+	}
+	{
+		var zero string
+		if res.Comparison == zero {
+			res.Comparison = "exact"
+		}
+	}
+	{
+		var zero float64
+		if res.Tolerance == zero {
+			res.Tolerance = 0
+		}
+	}
+	{
+		var zero int32
+		if res.Weight == zero {
+			res.Weight = 1
+		}
 	}
 
 	return res
@@ -2025,25 +6257,364 @@ func marshalCodelabUpdateTestPayloadToUpdateTestPayloadRequestBody(v *codelab.Up
 // *UpdateTestPayloadRequestBody.
 func marshalUpdateTestPayloadRequestBodyToCodelabUpdateTestPayload(v *UpdateTestPayloadRequestBody) *codelab.UpdateTestPayload {
 	res := &codelab.UpdateTestPayload{
-		Input:  v.Input,
-		Output: v.Output,
-		Public: v.Public,
+		Input:       v.Input,
+		Output:      v.Output,
+		Public:      v.Public,
+		InputFormat: v.InputFormat,
+		Comparison:  v.Comparison,
+		Tolerance:   v.Tolerance,
+		Weight:      v.Weight,
+	}
+	{
+		var zero string
+		if res.InputFormat == zero {
+			res.InputFormat = "text"
+		}
+	}
+	{
+		var zero string
+		if res.Comparison == zero {
+			res.Comparison = "exact"
+		}
+	}
+	{
+		var zero float64
+		if res.Tolerance == zero {
+			res.Tolerance = 0
+		}
+	}
+	{
+		var zero int32
+		if res.Weight == zero {
+			res.Weight = 1
+		}
 	}
 
 	return res
 }
 
-// unmarshalTestResponseBodyToCodelabTest builds a value of type *codelab.Test
-// from a value of type *TestResponseBody.
-func unmarshalTestResponseBodyToCodelabTest(v *TestResponseBody) *codelab.Test {
-	res := &codelab.Test{
-		ID:         *v.ID,
-		Input:      *v.Input,
-		Output:     *v.Output,
-		Public:     *v.Public,
+// unmarshalExerciseVersionResponseToCodelabExerciseVersion builds a value of
+// type *codelab.ExerciseVersion from a value of type *ExerciseVersionResponse.
+func unmarshalExerciseVersionResponseToCodelabExerciseVersion(v *ExerciseVersionResponse) *codelab.ExerciseVersion {
+	res := &codelab.ExerciseVersion{
+		Version:   *v.Version,
+		CreatedBy: *v.CreatedBy,
+		CreatedAt: *v.CreatedAt,
+		Attempts:  *v.Attempts,
+	}
+
+	return res
+}
+
+// unmarshalFieldChangeResponseBodyToCodelabFieldChange builds a value of type
+// *codelab.FieldChange from a value of type *FieldChangeResponseBody.
+func unmarshalFieldChangeResponseBodyToCodelabFieldChange(v *FieldChangeResponseBody) *codelab.FieldChange {
+	res := &codelab.FieldChange{
+		Field: *v.Field,
+		From:  *v.From,
+		To:    *v.To,
+	}
+
+	return res
+}
+
+// unmarshalTestChangeResponseBodyToCodelabTestChange builds a value of type
+// *codelab.TestChange from a value of type *TestChangeResponseBody.
+func unmarshalTestChangeResponseBodyToCodelabTestChange(v *TestChangeResponseBody) *codelab.TestChange {
+	res := &codelab.TestChange{
+		TestID: *v.TestID,
+		Change: *v.Change,
+	}
+	res.Fields = make([]*codelab.FieldChange, len(v.Fields))
+	for i, val := range v.Fields {
+		res.Fields[i] = unmarshalFieldChangeResponseBodyToCodelabFieldChange(val)
+	}
+
+	return res
+}
+
+// unmarshalRegradeStudentChangeResponseBodyToCodelabRegradeStudentChange
+// builds a value of type *codelab.RegradeStudentChange from a value of type
+// *RegradeStudentChangeResponseBody.
+func unmarshalRegradeStudentChangeResponseBodyToCodelabRegradeStudentChange(v *RegradeStudentChangeResponseBody) *codelab.RegradeStudentChange {
+	res := &codelab.RegradeStudentChange{
+		UserID:        *v.UserID,
+		PreviousScore: *v.PreviousScore,
+		Score:         *v.Score,
+	}
+
+	return res
+}
+
+// unmarshalHintResponseToCodelabHint builds a value of type *codelab.Hint from
+// a value of type *HintResponse.
+func unmarshalHintResponseToCodelabHint(v *HintResponse) *codelab.Hint {
+	res := &codelab.Hint{
+		ID:                  *v.ID,
+		ExerciseID:          *v.ExerciseID,
+		Position:            *v.Position,
+		Content:             *v.Content,
+		UnlockAfterAttempts: v.UnlockAfterAttempts,
+		UnlockAfterSeconds:  v.UnlockAfterSeconds,
+		Penalty:             *v.Penalty,
+		CreatedAt:           *v.CreatedAt,
+		UpdatedAt:           *v.UpdatedAt,
+	}
+
+	return res
+}
+
+// marshalCodelabUpdateHintPayloadToUpdateHintPayloadRequestBody builds a value
+// of type *UpdateHintPayloadRequestBody from a value of type
+// *codelab.UpdateHintPayload.
+func marshalCodelabUpdateHintPayloadToUpdateHintPayloadRequestBody(v *codelab.UpdateHintPayload) *UpdateHintPayloadRequestBody {
+	res := &UpdateHintPayloadRequestBody{
+		Content:             v.Content,
+		UnlockAfterAttempts: v.UnlockAfterAttempts,
+		UnlockAfterSeconds:  v.UnlockAfterSeconds,
+		Penalty:             v.Penalty,
+	}
+	{
+		var zero float64
+		if res.Penalty == zero {
+			res.Penalty = 0
+		}
+	}
+
+	return res
+}
+
+// marshalUpdateHintPayloadRequestBodyToCodelabUpdateHintPayload builds a value
+// of type *codelab.UpdateHintPayload from a value of type
+// *UpdateHintPayloadRequestBody.
+func marshalUpdateHintPayloadRequestBodyToCodelabUpdateHintPayload(v *UpdateHintPayloadRequestBody) *codelab.UpdateHintPayload {
+	res := &codelab.UpdateHintPayload{
+		Content:             v.Content,
+		UnlockAfterAttempts: v.UnlockAfterAttempts,
+		UnlockAfterSeconds:  v.UnlockAfterSeconds,
+		Penalty:             v.Penalty,
+	}
+	{
+		var zero float64
+		if res.Penalty == zero {
+			res.Penalty = 0
+		}
+	}
+
+	return res
+}
+
+// unmarshalAssignmentExerciseResponseBodyToCodelabAssignmentExercise builds a
+// value of type *codelab.AssignmentExercise from a value of type
+// *AssignmentExerciseResponseBody.
+func unmarshalAssignmentExerciseResponseBodyToCodelabAssignmentExercise(v *AssignmentExerciseResponseBody) *codelab.AssignmentExercise {
+	res := &codelab.AssignmentExercise{
+		ExerciseID: *v.ExerciseID,
+		Position:   *v.Position,
+		Title:      *v.Title,
+		Difficulty: *v.Difficulty,
+		Language:   *v.Language,
+	}
+
+	return res
+}
+
+// unmarshalAssignmentResponseToCodelabAssignment builds a value of type
+// *codelab.Assignment from a value of type *AssignmentResponse.
+func unmarshalAssignmentResponseToCodelabAssignment(v *AssignmentResponse) *codelab.Assignment {
+	res := &codelab.Assignment{
+		ID:          *v.ID,
+		Title:       *v.Title,
+		Description: *v.Description,
+		OpensAt:     *v.OpensAt,
+		ClosesAt:    *v.ClosesAt,
+		AllowLate:   *v.AllowLate,
+		Status:      *v.Status,
+		CreatedBy:   *v.CreatedBy,
+		CreatedAt:   *v.CreatedAt,
+		UpdatedAt:   *v.UpdatedAt,
+	}
+	res.Exercises = make([]*codelab.AssignmentExercise, len(v.Exercises))
+	for i, val := range v.Exercises {
+		res.Exercises[i] = unmarshalAssignmentExerciseResponseToCodelabAssignmentExercise(val)
+	}
+
+	return res
+}
+
+// unmarshalAssignmentExerciseResponseToCodelabAssignmentExercise builds a
+// value of type *codelab.AssignmentExercise from a value of type
+// *AssignmentExerciseResponse.
+func unmarshalAssignmentExerciseResponseToCodelabAssignmentExercise(v *AssignmentExerciseResponse) *codelab.AssignmentExercise {
+	res := &codelab.AssignmentExercise{
 		ExerciseID: *v.ExerciseID,
-		CreatedAt:  *v.CreatedAt,
-		UpdatedAt:  *v.UpdatedAt,
+		Position:   *v.Position,
+		Title:      *v.Title,
+		Difficulty: *v.Difficulty,
+		Language:   *v.Language,
+	}
+
+	return res
+}
+
+// marshalCodelabUpdateAssignmentPayloadToUpdateAssignmentPayloadRequestBody
+// builds a value of type *UpdateAssignmentPayloadRequestBody from a value of
+// type *codelab.UpdateAssignmentPayload.
+func marshalCodelabUpdateAssignmentPayloadToUpdateAssignmentPayloadRequestBody(v *codelab.UpdateAssignmentPayload) *UpdateAssignmentPayloadRequestBody {
+	res := &UpdateAssignmentPayloadRequestBody{
+		Title:       v.Title,
+		Description: v.Description,
+		OpensAt:     v.OpensAt,
+		ClosesAt:    v.ClosesAt,
+		AllowLate:   v.AllowLate,
+	}
+	{
+		var zero string
+		if res.Description == zero {
+			res.Description = ""
+		}
+	}
+	{
+		var zero bool
+		if res.AllowLate == zero {
+			res.AllowLate = false
+		}
+	}
+	if v.ExerciseIds != nil {
+		res.ExerciseIds = make([]int64, len(v.ExerciseIds))
+		for i, val := range v.ExerciseIds {
+			res.ExerciseIds[i] = val
+		}
+	} else {
+		res.ExerciseIds = []int64{}
+	}
+
+	return res
+}
+
+// marshalUpdateAssignmentPayloadRequestBodyToCodelabUpdateAssignmentPayload
+// builds a value of type *codelab.UpdateAssignmentPayload from a value of type
+// *UpdateAssignmentPayloadRequestBody.
+func marshalUpdateAssignmentPayloadRequestBodyToCodelabUpdateAssignmentPayload(v *UpdateAssignmentPayloadRequestBody) *codelab.UpdateAssignmentPayload {
+	res := &codelab.UpdateAssignmentPayload{
+		Title:       v.Title,
+		Description: v.Description,
+		OpensAt:     v.OpensAt,
+		ClosesAt:    v.ClosesAt,
+		AllowLate:   v.AllowLate,
+	}
+	{
+		var zero string
+		if res.Description == zero {
+			res.Description = ""
+		}
+	}
+	{
+		var zero bool
+		if res.AllowLate == zero {
+			res.AllowLate = false
+		}
+	}
+	if v.ExerciseIds != nil {
+		res.ExerciseIds = make([]int64, len(v.ExerciseIds))
+		for i, val := range v.ExerciseIds {
+			res.ExerciseIds[i] = val
+		}
+	} else {
+		res.ExerciseIds = []int64{}
+	}
+
+	return res
+}
+
+// unmarshalAssignmentResponseBodyToCodelabAssignment builds a value of type
+// *codelab.Assignment from a value of type *AssignmentResponseBody.
+func unmarshalAssignmentResponseBodyToCodelabAssignment(v *AssignmentResponseBody) *codelab.Assignment {
+	res := &codelab.Assignment{
+		ID:          *v.ID,
+		Title:       *v.Title,
+		Description: *v.Description,
+		OpensAt:     *v.OpensAt,
+		ClosesAt:    *v.ClosesAt,
+		AllowLate:   *v.AllowLate,
+		Status:      *v.Status,
+		CreatedBy:   *v.CreatedBy,
+		CreatedAt:   *v.CreatedAt,
+		UpdatedAt:   *v.UpdatedAt,
+	}
+	res.Exercises = make([]*codelab.AssignmentExercise, len(v.Exercises))
+	for i, val := range v.Exercises {
+		res.Exercises[i] = unmarshalAssignmentExerciseResponseBodyToCodelabAssignmentExercise(val)
+	}
+
+	return res
+}
+
+// unmarshalStudentAssignmentGradeResponseBodyToCodelabStudentAssignmentGrade
+// builds a value of type *codelab.StudentAssignmentGrade from a value of type
+// *StudentAssignmentGradeResponseBody.
+func unmarshalStudentAssignmentGradeResponseBodyToCodelabStudentAssignmentGrade(v *StudentAssignmentGradeResponseBody) *codelab.StudentAssignmentGrade {
+	res := &codelab.StudentAssignmentGrade{
+		UserID:             *v.UserID,
+		Score:              *v.Score,
+		ScoreWithLate:      *v.ScoreWithLate,
+		CompletedExercises: *v.CompletedExercises,
+		TotalExercises:     *v.TotalExercises,
+	}
+	res.Exercises = make([]*codelab.ExerciseGrade, len(v.Exercises))
+	for i, val := range v.Exercises {
+		res.Exercises[i] = unmarshalExerciseGradeResponseBodyToCodelabExerciseGrade(val)
+	}
+
+	return res
+}
+
+// unmarshalExerciseGradeResponseBodyToCodelabExerciseGrade builds a value of
+// type *codelab.ExerciseGrade from a value of type *ExerciseGradeResponseBody.
+func unmarshalExerciseGradeResponseBodyToCodelabExerciseGrade(v *ExerciseGradeResponseBody) *codelab.ExerciseGrade {
+	res := &codelab.ExerciseGrade{
+		ExerciseID:        *v.ExerciseID,
+		Status:            *v.Status,
+		Attempts:          *v.Attempts,
+		LateAttempts:      *v.LateAttempts,
+		BestScore:         *v.BestScore,
+		BestScoreWithLate: *v.BestScoreWithLate,
+	}
+
+	return res
+}
+
+// unmarshalFailingTestResponseBodyToCodelabFailingTest builds a value of type
+// *codelab.FailingTest from a value of type *FailingTestResponseBody.
+func unmarshalFailingTestResponseBodyToCodelabFailingTest(v *FailingTestResponseBody) *codelab.FailingTest {
+	if v == nil {
+		return nil
+	}
+	res := &codelab.FailingTest{
+		TestID:   *v.TestID,
+		Input:    *v.Input,
+		Public:   *v.Public,
+		Failures: *v.Failures,
+	}
+
+	return res
+}
+
+// unmarshalStudentExerciseStatusResponseBodyToCodelabStudentExerciseStatus
+// builds a value of type *codelab.StudentExerciseStatus from a value of type
+// *StudentExerciseStatusResponseBody.
+func unmarshalStudentExerciseStatusResponseBodyToCodelabStudentExerciseStatus(v *StudentExerciseStatusResponseBody) *codelab.StudentExerciseStatus {
+	res := &codelab.StudentExerciseStatus{
+		UserID:             *v.UserID,
+		Status:             *v.Status,
+		Attempts:           *v.Attempts,
+		BestScore:          *v.BestScore,
+		AttemptsToSuccess:  v.AttemptsToSuccess,
+		FirstAttemptAt:     v.FirstAttemptAt,
+		LastAttemptAt:      v.LastAttemptAt,
+		CompletedAt:        v.CompletedAt,
+		TimeToCompletionMs: v.TimeToCompletionMs,
+		HintsUsed:          *v.HintsUsed,
 	}
 
 	return res
@@ -2053,11 +6624,97 @@ func unmarshalTestResponseBodyToCodelabTest(v *TestResponseBody) *codelab.Test {
 // *codelab.Attempt from a value of type *AttemptResponseBody.
 func unmarshalAttemptResponseBodyToCodelabAttempt(v *AttemptResponseBody) *codelab.Attempt {
 	res := &codelab.Attempt{
-		ID:        *v.ID,
-		AnswerID:  *v.AnswerID,
-		Code:      *v.Code,
-		Success:   *v.Success,
-		CreatedAt: *v.CreatedAt,
+		ID:              *v.ID,
+		AnswerID:        *v.AnswerID,
+		Code:            *v.Code,
+		Success:         *v.Success,
+		CreatedAt:       *v.CreatedAt,
+		Status:          *v.Status,
+		Points:          *v.Points,
+		MaxPoints:       *v.MaxPoints,
+		Score:           *v.Score,
+		Late:            *v.Late,
+		ExerciseVersion: *v.ExerciseVersion,
+	}
+	if v.TestResults != nil {
+		res.TestResults = make([]*codelab.AttemptTestResult, len(v.TestResults))
+		for i, val := range v.TestResults {
+			res.TestResults[i] = unmarshalAttemptTestResultResponseBodyToCodelabAttemptTestResult(val)
+		}
+	}
+
+	return res
+}
+
+// unmarshalAttemptTestResultResponseBodyToCodelabAttemptTestResult builds a
+// value of type *codelab.AttemptTestResult from a value of type
+// *AttemptTestResultResponseBody.
+func unmarshalAttemptTestResultResponseBodyToCodelabAttemptTestResult(v *AttemptTestResultResponseBody) *codelab.AttemptTestResult {
+	if v == nil {
+		return nil
+	}
+	res := &codelab.AttemptTestResult{
+		TestID:         v.TestID,
+		Status:         *v.Status,
+		ActualOutput:   *v.ActualOutput,
+		ExpectedOutput: v.ExpectedOutput,
+		Error:          v.Error,
+		ConsoleOutput:  *v.ConsoleOutput,
+		DurationMs:     *v.DurationMs,
+		StackTrace:     v.StackTrace,
+	}
+
+	return res
+}
+
+// unmarshalPlagiarismPairResponseBodyToCodelabPlagiarismPair builds a value of
+// type *codelab.PlagiarismPair from a value of type
+// *PlagiarismPairResponseBody.
+func unmarshalPlagiarismPairResponseBodyToCodelabPlagiarismPair(v *PlagiarismPairResponseBody) *codelab.PlagiarismPair {
+	res := &codelab.PlagiarismPair{
+		AttemptAID: *v.AttemptAID,
+		UserAID:    *v.UserAID,
+		AttemptBID: *v.AttemptBID,
+		UserBID:    *v.UserBID,
+		Similarity: *v.Similarity,
+	}
+	res.Regions = make([]*codelab.PlagiarismRegion, len(v.Regions))
+	for i, val := range v.Regions {
+		res.Regions[i] = unmarshalPlagiarismRegionResponseBodyToCodelabPlagiarismRegion(val)
+	}
+
+	return res
+}
+
+// unmarshalPlagiarismRegionResponseBodyToCodelabPlagiarismRegion builds a
+// value of type *codelab.PlagiarismRegion from a value of type
+// *PlagiarismRegionResponseBody.
+func unmarshalPlagiarismRegionResponseBodyToCodelabPlagiarismRegion(v *PlagiarismRegionResponseBody) *codelab.PlagiarismRegion {
+	res := &codelab.PlagiarismRegion{
+		StartLineA: *v.StartLineA,
+		EndLineA:   *v.EndLineA,
+		StartLineB: *v.StartLineB,
+		EndLineB:   *v.EndLineB,
+	}
+
+	return res
+}
+
+// unmarshalTestResponseBodyToCodelabTest builds a value of type *codelab.Test
+// from a value of type *TestResponseBody.
+func unmarshalTestResponseBodyToCodelabTest(v *TestResponseBody) *codelab.Test {
+	res := &codelab.Test{
+		ID:          *v.ID,
+		Input:       *v.Input,
+		Output:      *v.Output,
+		Public:      *v.Public,
+		ExerciseID:  *v.ExerciseID,
+		CreatedAt:   *v.CreatedAt,
+		UpdatedAt:   *v.UpdatedAt,
+		InputFormat: *v.InputFormat,
+		Comparison:  *v.Comparison,
+		Tolerance:   *v.Tolerance,
+		Weight:      *v.Weight,
 	}
 
 	return res
@@ -2067,30 +6724,57 @@ func unmarshalAttemptResponseBodyToCodelabAttempt(v *AttemptResponseBody) *codel
 // *codelab.Answer from a value of type *AnswerResponseBody.
 func unmarshalAnswerResponseBodyToCodelabAnswer(v *AnswerResponseBody) *codelab.Answer {
 	res := &codelab.Answer{
-		ID:         *v.ID,
-		ExerciseID: *v.ExerciseID,
-		UserID:     *v.UserID,
-		Completed:  *v.Completed,
-		CreatedAt:  *v.CreatedAt,
-		UpdatedAt:  *v.UpdatedAt,
+		ID:            *v.ID,
+		ExerciseID:    *v.ExerciseID,
+		UserID:        *v.UserID,
+		Completed:     *v.Completed,
+		CreatedAt:     *v.CreatedAt,
+		UpdatedAt:     *v.UpdatedAt,
+		BestScore:     *v.BestScore,
+		BestAttemptID: v.BestAttemptID,
+		HintsUsed:     *v.HintsUsed,
+		HintPenalty:   *v.HintPenalty,
 	}
 
 	return res
 }
 
-// unmarshalExerciseForStudentsListViewResponseToCodelabExerciseForStudentsListView
+// unmarshalExerciseForStudentsListViewToCodelabExerciseForStudentsListView
 // builds a value of type *codelab.ExerciseForStudentsListView from a value of
-// type *ExerciseForStudentsListViewResponse.
-func unmarshalExerciseForStudentsListViewResponseToCodelabExerciseForStudentsListView(v *ExerciseForStudentsListViewResponse) *codelab.ExerciseForStudentsListView {
+// type *ExerciseForStudentsListView.
+func unmarshalExerciseForStudentsListViewToCodelabExerciseForStudentsListView(v *ExerciseForStudentsListView) *codelab.ExerciseForStudentsListView {
 	res := &codelab.ExerciseForStudentsListView{
 		ID:          *v.ID,
 		Title:       *v.Title,
 		Description: *v.Description,
 		Difficulty:  *v.Difficulty,
-		Completed:   v.Completed,
+		Completed:   *v.Completed,
 		CreatedBy:   *v.CreatedBy,
 		CreatedAt:   *v.CreatedAt,
 		UpdatedAt:   *v.UpdatedAt,
+		Language:    *v.Language,
+	}
+	res.Tags = make([]string, len(v.Tags))
+	for i, val := range v.Tags {
+		res.Tags[i] = val
+	}
+
+	return res
+}
+
+// unmarshalRunCaseResultResponseBodyToCodelabRunCaseResult builds a value of
+// type *codelab.RunCaseResult from a value of type *RunCaseResultResponseBody.
+func unmarshalRunCaseResultResponseBodyToCodelabRunCaseResult(v *RunCaseResultResponseBody) *codelab.RunCaseResult {
+	res := &codelab.RunCaseResult{
+		TestID:         v.TestID,
+		Input:          *v.Input,
+		Status:         *v.Status,
+		Output:         *v.Output,
+		ExpectedOutput: v.ExpectedOutput,
+		Error:          v.Error,
+		ConsoleOutput:  *v.ConsoleOutput,
+		DurationMs:     *v.DurationMs,
+		StackTrace:     v.StackTrace,
 	}
 
 	return res
@@ -2100,11 +6784,61 @@ func unmarshalExerciseForStudentsListViewResponseToCodelabExerciseForStudentsLis
 // *codelab.Attempt from a value of type *AttemptResponse.
 func unmarshalAttemptResponseToCodelabAttempt(v *AttemptResponse) *codelab.Attempt {
 	res := &codelab.Attempt{
-		ID:        *v.ID,
-		AnswerID:  *v.AnswerID,
-		Code:      *v.Code,
-		Success:   *v.Success,
-		CreatedAt: *v.CreatedAt,
+		ID:              *v.ID,
+		AnswerID:        *v.AnswerID,
+		Code:            *v.Code,
+		Success:         *v.Success,
+		CreatedAt:       *v.CreatedAt,
+		Status:          *v.Status,
+		Points:          *v.Points,
+		MaxPoints:       *v.MaxPoints,
+		Score:           *v.Score,
+		Late:            *v.Late,
+		ExerciseVersion: *v.ExerciseVersion,
+	}
+	if v.TestResults != nil {
+		res.TestResults = make([]*codelab.AttemptTestResult, len(v.TestResults))
+		for i, val := range v.TestResults {
+			res.TestResults[i] = unmarshalAttemptTestResultResponseToCodelabAttemptTestResult(val)
+		}
+	}
+
+	return res
+}
+
+// unmarshalAttemptTestResultResponseToCodelabAttemptTestResult builds a value
+// of type *codelab.AttemptTestResult from a value of type
+// *AttemptTestResultResponse.
+func unmarshalAttemptTestResultResponseToCodelabAttemptTestResult(v *AttemptTestResultResponse) *codelab.AttemptTestResult {
+	if v == nil {
+		return nil
+	}
+	res := &codelab.AttemptTestResult{
+		TestID:         v.TestID,
+		Status:         *v.Status,
+		ActualOutput:   *v.ActualOutput,
+		ExpectedOutput: v.ExpectedOutput,
+		Error:          v.Error,
+		ConsoleOutput:  *v.ConsoleOutput,
+		DurationMs:     *v.DurationMs,
+		StackTrace:     v.StackTrace,
+	}
+
+	return res
+}
+
+// unmarshalStudentHintResponseToCodelabStudentHint builds a value of type
+// *codelab.StudentHint from a value of type *StudentHintResponse.
+func unmarshalStudentHintResponseToCodelabStudentHint(v *StudentHintResponse) *codelab.StudentHint {
+	res := &codelab.StudentHint{
+		ID:                *v.ID,
+		Position:          *v.Position,
+		Status:            *v.Status,
+		Content:           v.Content,
+		Penalty:           *v.Penalty,
+		AttemptsRemaining: v.AttemptsRemaining,
+		UnlocksAt:         v.UnlocksAt,
+		RevealedAt:        v.RevealedAt,
 	}
 
 	return res
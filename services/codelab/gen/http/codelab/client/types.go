@@ -31,6 +31,10 @@ type CreateExerciseRequestBody struct {
 	Difficulty string `form:"difficulty" json:"difficulty" xml:"difficulty"`
 	// ID of user creating the exercise
 	CreatedBy int64 `form:"created_by" json:"created_by" xml:"created_by"`
+	// Programming language the exercise is solved in
+	Language string `form:"language" json:"language" xml:"language"`
+	// Topics the exercise covers, stored in lowercase
+	Tags []string `form:"tags,omitempty" json:"tags,omitempty" xml:"tags,omitempty"`
 }
 
 // UpdateExerciseRequestBody is the type of the "codelab" service
@@ -40,6 +44,13 @@ type UpdateExerciseRequestBody struct {
 	Exercise *UpdateExercisePayloadRequestBody `form:"exercise" json:"exercise" xml:"exercise"`
 }
 
+// ImportExercisesRequestBody is the type of the "codelab" service
+// "ImportExercises" endpoint HTTP request body.
+type ImportExercisesRequestBody struct {
+	// Bundle in any of the export formats
+	Content []byte `form:"content" json:"content" xml:"content"`
+}
+
 // CreateTestRequestBody is the type of the "codelab" service "CreateTest"
 // endpoint HTTP request body.
 type CreateTestRequestBody struct {
@@ -51,6 +62,15 @@ type CreateTestRequestBody struct {
 	Public bool `form:"public" json:"public" xml:"public"`
 	// Associated exercise ID
 	ExerciseID int64 `form:"exercise_id" json:"exercise_id" xml:"exercise_id"`
+	// How the input is passed to solution: a raw string or a JSON array of
+	// arguments
+	InputFormat string `form:"input_format" json:"input_format" xml:"input_format"`
+	// How the output is compared to the expected output
+	Comparison string `form:"comparison" json:"comparison" xml:"comparison"`
+	// Allowed absolute difference for numeric comparison
+	Tolerance float64 `form:"tolerance" json:"tolerance" xml:"tolerance"`
+	// Points awarded for passing the test
+	Weight int32 `form:"weight" json:"weight" xml:"weight"`
 }
 
 // UpdateTestRequestBody is the type of the "codelab" service "UpdateTest"
@@ -60,6 +80,52 @@ type UpdateTestRequestBody struct {
 	Test *UpdateTestPayloadRequestBody `form:"test" json:"test" xml:"test"`
 }
 
+// CreateHintRequestBody is the type of the "codelab" service "CreateHint"
+// endpoint HTTP request body.
+type CreateHintRequestBody struct {
+	// Associated exercise ID
+	ExerciseID int64 `form:"exercise_id" json:"exercise_id" xml:"exercise_id"`
+	// Hint text
+	Content string `form:"content" json:"content" xml:"content"`
+	// Failed attempts after which the hint unlocks
+	UnlockAfterAttempts *int32 `form:"unlock_after_attempts,omitempty" json:"unlock_after_attempts,omitempty" xml:"unlock_after_attempts,omitempty"`
+	// Seconds since the student started the exercise after which the hint unlocks
+	UnlockAfterSeconds *int32 `form:"unlock_after_seconds,omitempty" json:"unlock_after_seconds,omitempty" xml:"unlock_after_seconds,omitempty"`
+	// Points taken off the score of the attempts made once the hint is revealed
+	Penalty float64 `form:"penalty" json:"penalty" xml:"penalty"`
+}
+
+// UpdateHintRequestBody is the type of the "codelab" service "UpdateHint"
+// endpoint HTTP request body.
+type UpdateHintRequestBody struct {
+	// Hint data to update
+	Hint *UpdateHintPayloadRequestBody `form:"hint" json:"hint" xml:"hint"`
+}
+
+// CreateAssignmentRequestBody is the type of the "codelab" service
+// "CreateAssignment" endpoint HTTP request body.
+type CreateAssignmentRequestBody struct {
+	// Assignment title
+	Title string `form:"title" json:"title" xml:"title"`
+	// Assignment description
+	Description string `form:"description" json:"description" xml:"description"`
+	// Timestamp from which attempts are accepted
+	OpensAt int64 `form:"opens_at" json:"opens_at" xml:"opens_at"`
+	// Timestamp after which attempts are rejected or flagged as late
+	ClosesAt int64 `form:"closes_at" json:"closes_at" xml:"closes_at"`
+	// Whether attempts made after closes_at are accepted as late
+	AllowLate bool `form:"allow_late" json:"allow_late" xml:"allow_late"`
+	// Exercises of the assignment in order
+	ExerciseIds []int64 `form:"exercise_ids" json:"exercise_ids" xml:"exercise_ids"`
+}
+
+// UpdateAssignmentRequestBody is the type of the "codelab" service
+// "UpdateAssignment" endpoint HTTP request body.
+type UpdateAssignmentRequestBody struct {
+	// Assignment data to update
+	Assignment *UpdateAssignmentPayloadRequestBody `form:"assignment" json:"assignment" xml:"assignment"`
+}
+
 // CreateAttemptRequestBody is the type of the "codelab" service
 // "CreateAttempt" endpoint HTTP request body.
 type CreateAttemptRequestBody struct {
@@ -71,6 +137,29 @@ type CreateAttemptRequestBody struct {
 	Success bool `form:"success" json:"success" xml:"success"`
 }
 
+// SubmitAttemptRequestBody is the type of the "codelab" service
+// "SubmitAttempt" endpoint HTTP request body.
+type SubmitAttemptRequestBody struct {
+	// Associated exercise ID
+	ExerciseID int64 `form:"exercise_id" json:"exercise_id" xml:"exercise_id"`
+	// Submitted code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Whether the attempt was successful
+	Success bool `form:"success" json:"success" xml:"success"`
+}
+
+// RunCodeRequestBody is the type of the "codelab" service "RunCode" endpoint
+// HTTP request body.
+type RunCodeRequestBody struct {
+	// Code to run
+	Code string `form:"code" json:"code" xml:"code"`
+	// Custom inputs to run instead of the public tests
+	Inputs []string `form:"inputs,omitempty" json:"inputs,omitempty" xml:"inputs,omitempty"`
+	// How custom inputs are passed to solution: a raw string or a JSON array of
+	// arguments
+	InputFormat string `form:"input_format" json:"input_format" xml:"input_format"`
+}
+
 // CreateExerciseResponseBody is the type of the "codelab" service
 // "CreateExercise" endpoint HTTP response body.
 type CreateExerciseResponseBody struct {
@@ -101,11 +190,21 @@ type GetExerciseResponseBody struct {
 	CreatedAt *int64 `form:"created_at,omitempty" json:"created_at,omitempty" xml:"created_at,omitempty"`
 	// Last update timestamp
 	UpdatedAt *int64 `form:"updated_at,omitempty" json:"updated_at,omitempty" xml:"updated_at,omitempty"`
+	// Programming language the exercise is solved in
+	Language *string `form:"language,omitempty" json:"language,omitempty" xml:"language,omitempty"`
+	// Topics the exercise covers
+	Tags []string `form:"tags,omitempty" json:"tags,omitempty" xml:"tags,omitempty"`
+	// Latest version, bumped by every change to the exercise or its tests
+	Version *int32 `form:"version,omitempty" json:"version,omitempty" xml:"version,omitempty"`
 }
 
 // ListExercisesResponseBody is the type of the "codelab" service
 // "ListExercises" endpoint HTTP response body.
-type ListExercisesResponseBody []*ExerciseResponse
+type ListExercisesResponseBody []*Exercise
+
+// ListTagsResponseBody is the type of the "codelab" service "ListTags"
+// endpoint HTTP response body.
+type ListTagsResponseBody []*TagCountResponse
 
 // UpdateExerciseResponseBody is the type of the "codelab" service
 // "UpdateExercise" endpoint HTTP response body.
@@ -125,6 +224,28 @@ type DeleteExerciseResponseBody struct {
 	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
 }
 
+// ExportExercisesResponseBody is the type of the "codelab" service
+// "ExportExercises" endpoint HTTP response body.
+type ExportExercisesResponseBody struct {
+	// Suggested file name
+	Filename *string `form:"filename,omitempty" json:"filename,omitempty" xml:"filename,omitempty"`
+	// Bundle format
+	Format *string `form:"format,omitempty" json:"format,omitempty" xml:"format,omitempty"`
+	// Bundle content
+	Content []byte `form:"content,omitempty" json:"content,omitempty" xml:"content,omitempty"`
+}
+
+// ImportExercisesResponseBody is the type of the "codelab" service
+// "ImportExercises" endpoint HTTP response body.
+type ImportExercisesResponseBody struct {
+	// Operation success status
+	Success *bool `form:"success,omitempty" json:"success,omitempty" xml:"success,omitempty"`
+	// Response message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// IDs of the created exercises, in bundle order
+	ExerciseIds []int64 `form:"exercise_ids,omitempty" json:"exercise_ids,omitempty" xml:"exercise_ids,omitempty"`
+}
+
 // CreateTestResponseBody is the type of the "codelab" service "CreateTest"
 // endpoint HTTP response body.
 type CreateTestResponseBody struct {
@@ -156,6 +277,252 @@ type DeleteTestResponseBody struct {
 	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
 }
 
+// ListExerciseVersionsResponseBody is the type of the "codelab" service
+// "ListExerciseVersions" endpoint HTTP response body.
+type ListExerciseVersionsResponseBody []*ExerciseVersionResponse
+
+// DiffExerciseVersionsResponseBody is the type of the "codelab" service
+// "DiffExerciseVersions" endpoint HTTP response body.
+type DiffExerciseVersionsResponseBody struct {
+	// Exercise ID
+	ExerciseID *int64 `form:"exercise_id,omitempty" json:"exercise_id,omitempty" xml:"exercise_id,omitempty"`
+	// Older version
+	FromVersion *int32 `form:"from_version,omitempty" json:"from_version,omitempty" xml:"from_version,omitempty"`
+	// Newer version
+	ToVersion *int32 `form:"to_version,omitempty" json:"to_version,omitempty" xml:"to_version,omitempty"`
+	// Fields of the exercise that changed
+	Fields []*FieldChangeResponseBody `form:"fields,omitempty" json:"fields,omitempty" xml:"fields,omitempty"`
+	// Tests that changed
+	Tests []*TestChangeResponseBody `form:"tests,omitempty" json:"tests,omitempty" xml:"tests,omitempty"`
+}
+
+// RollbackExerciseResponseBody is the type of the "codelab" service
+// "RollbackExercise" endpoint HTTP response body.
+type RollbackExerciseResponseBody struct {
+	// Operation success status
+	Success *bool `form:"success,omitempty" json:"success,omitempty" xml:"success,omitempty"`
+	// Response message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// RegradeExerciseResponseBody is the type of the "codelab" service
+// "RegradeExercise" endpoint HTTP response body.
+type RegradeExerciseResponseBody struct {
+	// Regrade ID
+	ID *int64 `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Exercise ID
+	ExerciseID *int64 `form:"exercise_id,omitempty" json:"exercise_id,omitempty" xml:"exercise_id,omitempty"`
+	// Version of the exercise the attempts are regraded against
+	ExerciseVersion *int32 `form:"exercise_version,omitempty" json:"exercise_version,omitempty" xml:"exercise_version,omitempty"`
+	// Whether the regrade is still running
+	Status *string `form:"status,omitempty" json:"status,omitempty" xml:"status,omitempty"`
+	// Number of attempts to regrade
+	TotalAttempts *int32 `form:"total_attempts,omitempty" json:"total_attempts,omitempty" xml:"total_attempts,omitempty"`
+	// Number of attempts processed so far
+	RegradedAttempts *int32 `form:"regraded_attempts,omitempty" json:"regraded_attempts,omitempty" xml:"regraded_attempts,omitempty"`
+	// Number of attempts that could not be run and kept their previous outcome
+	ErroredAttempts *int32 `form:"errored_attempts,omitempty" json:"errored_attempts,omitempty" xml:"errored_attempts,omitempty"`
+	// Number of attempts that failed before and pass now
+	NewlyPassedAttempts *int32 `form:"newly_passed_attempts,omitempty" json:"newly_passed_attempts,omitempty" xml:"newly_passed_attempts,omitempty"`
+	// Number of attempts that passed before and fail now
+	NewlyFailedAttempts *int32 `form:"newly_failed_attempts,omitempty" json:"newly_failed_attempts,omitempty" xml:"newly_failed_attempts,omitempty"`
+	// Students that did not pass the exercise before and pass it now, once finished
+	NewlyPassed []*RegradeStudentChangeResponseBody `form:"newly_passed,omitempty" json:"newly_passed,omitempty" xml:"newly_passed,omitempty"`
+	// Students that passed the exercise before and no longer pass it, once finished
+	NewlyFailed []*RegradeStudentChangeResponseBody `form:"newly_failed,omitempty" json:"newly_failed,omitempty" xml:"newly_failed,omitempty"`
+	// Why the regrade failed
+	Error *string `form:"error,omitempty" json:"error,omitempty" xml:"error,omitempty"`
+	// ID of user who started the regrade
+	CreatedBy *int64 `form:"created_by,omitempty" json:"created_by,omitempty" xml:"created_by,omitempty"`
+	// Start timestamp
+	CreatedAt *int64 `form:"created_at,omitempty" json:"created_at,omitempty" xml:"created_at,omitempty"`
+	// End timestamp, once no longer running
+	FinishedAt *int64 `form:"finished_at,omitempty" json:"finished_at,omitempty" xml:"finished_at,omitempty"`
+}
+
+// GetRegradeResponseBody is the type of the "codelab" service "GetRegrade"
+// endpoint HTTP response body.
+type GetRegradeResponseBody struct {
+	// Regrade ID
+	ID *int64 `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Exercise ID
+	ExerciseID *int64 `form:"exercise_id,omitempty" json:"exercise_id,omitempty" xml:"exercise_id,omitempty"`
+	// Version of the exercise the attempts are regraded against
+	ExerciseVersion *int32 `form:"exercise_version,omitempty" json:"exercise_version,omitempty" xml:"exercise_version,omitempty"`
+	// Whether the regrade is still running
+	Status *string `form:"status,omitempty" json:"status,omitempty" xml:"status,omitempty"`
+	// Number of attempts to regrade
+	TotalAttempts *int32 `form:"total_attempts,omitempty" json:"total_attempts,omitempty" xml:"total_attempts,omitempty"`
+	// Number of attempts processed so far
+	RegradedAttempts *int32 `form:"regraded_attempts,omitempty" json:"regraded_attempts,omitempty" xml:"regraded_attempts,omitempty"`
+	// Number of attempts that could not be run and kept their previous outcome
+	ErroredAttempts *int32 `form:"errored_attempts,omitempty" json:"errored_attempts,omitempty" xml:"errored_attempts,omitempty"`
+	// Number of attempts that failed before and pass now
+	NewlyPassedAttempts *int32 `form:"newly_passed_attempts,omitempty" json:"newly_passed_attempts,omitempty" xml:"newly_passed_attempts,omitempty"`
+	// Number of attempts that passed before and fail now
+	NewlyFailedAttempts *int32 `form:"newly_failed_attempts,omitempty" json:"newly_failed_attempts,omitempty" xml:"newly_failed_attempts,omitempty"`
+	// Students that did not pass the exercise before and pass it now, once finished
+	NewlyPassed []*RegradeStudentChangeResponseBody `form:"newly_passed,omitempty" json:"newly_passed,omitempty" xml:"newly_passed,omitempty"`
+	// Students that passed the exercise before and no longer pass it, once finished
+	NewlyFailed []*RegradeStudentChangeResponseBody `form:"newly_failed,omitempty" json:"newly_failed,omitempty" xml:"newly_failed,omitempty"`
+	// Why the regrade failed
+	Error *string `form:"error,omitempty" json:"error,omitempty" xml:"error,omitempty"`
+	// ID of user who started the regrade
+	CreatedBy *int64 `form:"created_by,omitempty" json:"created_by,omitempty" xml:"created_by,omitempty"`
+	// Start timestamp
+	CreatedAt *int64 `form:"created_at,omitempty" json:"created_at,omitempty" xml:"created_at,omitempty"`
+	// End timestamp, once no longer running
+	FinishedAt *int64 `form:"finished_at,omitempty" json:"finished_at,omitempty" xml:"finished_at,omitempty"`
+}
+
+// CreateHintResponseBody is the type of the "codelab" service "CreateHint"
+// endpoint HTTP response body.
+type CreateHintResponseBody struct {
+	// Operation success status
+	Success *bool `form:"success,omitempty" json:"success,omitempty" xml:"success,omitempty"`
+	// Response message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// GetHintsByExerciseResponseBody is the type of the "codelab" service
+// "GetHintsByExercise" endpoint HTTP response body.
+type GetHintsByExerciseResponseBody []*HintResponse
+
+// UpdateHintResponseBody is the type of the "codelab" service "UpdateHint"
+// endpoint HTTP response body.
+type UpdateHintResponseBody struct {
+	// Operation success status
+	Success *bool `form:"success,omitempty" json:"success,omitempty" xml:"success,omitempty"`
+	// Response message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// DeleteHintResponseBody is the type of the "codelab" service "DeleteHint"
+// endpoint HTTP response body.
+type DeleteHintResponseBody struct {
+	// Operation success status
+	Success *bool `form:"success,omitempty" json:"success,omitempty" xml:"success,omitempty"`
+	// Response message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// CreateAssignmentResponseBody is the type of the "codelab" service
+// "CreateAssignment" endpoint HTTP response body.
+type CreateAssignmentResponseBody struct {
+	// Operation success status
+	Success *bool `form:"success,omitempty" json:"success,omitempty" xml:"success,omitempty"`
+	// Response message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// GetAssignmentResponseBody is the type of the "codelab" service
+// "GetAssignment" endpoint HTTP response body.
+type GetAssignmentResponseBody struct {
+	// Assignment ID
+	ID *int64 `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Assignment title
+	Title *string `form:"title,omitempty" json:"title,omitempty" xml:"title,omitempty"`
+	// Assignment description
+	Description *string `form:"description,omitempty" json:"description,omitempty" xml:"description,omitempty"`
+	// Timestamp from which attempts are accepted
+	OpensAt *int64 `form:"opens_at,omitempty" json:"opens_at,omitempty" xml:"opens_at,omitempty"`
+	// Timestamp after which attempts are rejected or flagged as late
+	ClosesAt *int64 `form:"closes_at,omitempty" json:"closes_at,omitempty" xml:"closes_at,omitempty"`
+	// Whether attempts made after closes_at are accepted as late
+	AllowLate *bool `form:"allow_late,omitempty" json:"allow_late,omitempty" xml:"allow_late,omitempty"`
+	// Whether the assignment has not opened yet, is open or has closed
+	Status *string `form:"status,omitempty" json:"status,omitempty" xml:"status,omitempty"`
+	// Exercises of the assignment in order
+	Exercises []*AssignmentExerciseResponseBody `form:"exercises,omitempty" json:"exercises,omitempty" xml:"exercises,omitempty"`
+	// Creator user ID
+	CreatedBy *int64 `form:"created_by,omitempty" json:"created_by,omitempty" xml:"created_by,omitempty"`
+	// Creation timestamp
+	CreatedAt *int64 `form:"created_at,omitempty" json:"created_at,omitempty" xml:"created_at,omitempty"`
+	// Last update timestamp
+	UpdatedAt *int64 `form:"updated_at,omitempty" json:"updated_at,omitempty" xml:"updated_at,omitempty"`
+}
+
+// ListAssignmentsResponseBody is the type of the "codelab" service
+// "ListAssignments" endpoint HTTP response body.
+type ListAssignmentsResponseBody []*AssignmentResponse
+
+// UpdateAssignmentResponseBody is the type of the "codelab" service
+// "UpdateAssignment" endpoint HTTP response body.
+type UpdateAssignmentResponseBody struct {
+	// Operation success status
+	Success *bool `form:"success,omitempty" json:"success,omitempty" xml:"success,omitempty"`
+	// Response message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// DeleteAssignmentResponseBody is the type of the "codelab" service
+// "DeleteAssignment" endpoint HTTP response body.
+type DeleteAssignmentResponseBody struct {
+	// Operation success status
+	Success *bool `form:"success,omitempty" json:"success,omitempty" xml:"success,omitempty"`
+	// Response message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// GetAssignmentGradebookResponseBody is the type of the "codelab" service
+// "GetAssignmentGradebook" endpoint HTTP response body.
+type GetAssignmentGradebookResponseBody struct {
+	// The assignment
+	Assignment *AssignmentResponseBody `form:"assignment,omitempty" json:"assignment,omitempty" xml:"assignment,omitempty"`
+	// Grades of every student, by user ID
+	Students []*StudentAssignmentGradeResponseBody `form:"students,omitempty" json:"students,omitempty" xml:"students,omitempty"`
+}
+
+// GetExerciseStatsResponseBody is the type of the "codelab" service
+// "GetExerciseStats" endpoint HTTP response body.
+type GetExerciseStatsResponseBody struct {
+	// Exercise ID
+	ExerciseID *int64 `form:"exercise_id,omitempty" json:"exercise_id,omitempty" xml:"exercise_id,omitempty"`
+	// Students that started the exercise
+	TotalStudents *int64 `form:"total_students,omitempty" json:"total_students,omitempty" xml:"total_students,omitempty"`
+	// Students that completed the exercise
+	CompletedStudents *int64 `form:"completed_students,omitempty" json:"completed_students,omitempty" xml:"completed_students,omitempty"`
+	// Percentage of the students that completed the exercise
+	CompletionRate *float64 `form:"completion_rate,omitempty" json:"completion_rate,omitempty" xml:"completion_rate,omitempty"`
+	// Attempts made by every student
+	TotalAttempts *int64 `form:"total_attempts,omitempty" json:"total_attempts,omitempty" xml:"total_attempts,omitempty"`
+	// Attempts that passed every test
+	SuccessfulAttempts *int64 `form:"successful_attempts,omitempty" json:"successful_attempts,omitempty" xml:"successful_attempts,omitempty"`
+	// Median attempts students needed to pass, missing until a student passes
+	MedianAttemptsToSuccess *float64 `form:"median_attempts_to_success,omitempty" json:"median_attempts_to_success,omitempty" xml:"median_attempts_to_success,omitempty"`
+	// Median time from the first attempt to the first successful one in
+	// milliseconds
+	MedianTimeToCompletionMs *int64 `form:"median_time_to_completion_ms,omitempty" json:"median_time_to_completion_ms,omitempty" xml:"median_time_to_completion_ms,omitempty"`
+	// Test failed by the most attempts, missing if no test was failed
+	MostFailedTest *FailingTestResponseBody `form:"most_failed_test,omitempty" json:"most_failed_test,omitempty" xml:"most_failed_test,omitempty"`
+	// Progress of every student that started the exercise
+	Students []*StudentExerciseStatusResponseBody `form:"students,omitempty" json:"students,omitempty" xml:"students,omitempty"`
+	// Hints revealed to every student
+	HintsUsed *int64 `form:"hints_used,omitempty" json:"hints_used,omitempty" xml:"hints_used,omitempty"`
+}
+
+// GetStudentExerciseStatsResponseBody is the type of the "codelab" service
+// "GetStudentExerciseStats" endpoint HTTP response body.
+type GetStudentExerciseStatsResponseBody struct {
+	// Exercise ID
+	ExerciseID *int64 `form:"exercise_id,omitempty" json:"exercise_id,omitempty" xml:"exercise_id,omitempty"`
+	// Progress of the student
+	Summary *StudentExerciseStatusResponseBody `form:"summary,omitempty" json:"summary,omitempty" xml:"summary,omitempty"`
+	// Attempts of the student with their test results, oldest first
+	Attempts []*AttemptResponseBody `form:"attempts,omitempty" json:"attempts,omitempty" xml:"attempts,omitempty"`
+}
+
+// GetPlagiarismReportResponseBody is the type of the "codelab" service
+// "GetPlagiarismReport" endpoint HTTP response body.
+type GetPlagiarismReportResponseBody struct {
+	// Exercise ID
+	ExerciseID *int64 `form:"exercise_id,omitempty" json:"exercise_id,omitempty" xml:"exercise_id,omitempty"`
+	// Timestamp of the last check, missing if the exercise was not checked yet
+	CheckedAt *int64 `form:"checked_at,omitempty" json:"checked_at,omitempty" xml:"checked_at,omitempty"`
+	// Suspicious pairs, most similar first
+	Pairs []*PlagiarismPairResponseBody `form:"pairs,omitempty" json:"pairs,omitempty" xml:"pairs,omitempty"`
+}
+
 // GetExerciseForStudentResponseBody is the type of the "codelab" service
 // "GetExerciseForStudent" endpoint HTTP response body.
 type GetExerciseForStudentResponseBody struct {
@@ -181,11 +548,17 @@ type GetExerciseForStudentResponseBody struct {
 	CreatedAt *int64 `form:"created_at,omitempty" json:"created_at,omitempty" xml:"created_at,omitempty"`
 	// Last update timestamp
 	UpdatedAt *int64 `form:"updated_at,omitempty" json:"updated_at,omitempty" xml:"updated_at,omitempty"`
+	// Programming language the exercise is solved in
+	Language *string `form:"language,omitempty" json:"language,omitempty" xml:"language,omitempty"`
+	// Topics the exercise covers
+	Tags []string `form:"tags,omitempty" json:"tags,omitempty" xml:"tags,omitempty"`
+	// Version new attempts are graded against
+	Version *int32 `form:"version,omitempty" json:"version,omitempty" xml:"version,omitempty"`
 }
 
 // ListExercisesForStudentsResponseBody is the type of the "codelab" service
 // "ListExercisesForStudents" endpoint HTTP response body.
-type ListExercisesForStudentsResponseBody []*ExerciseForStudentsListViewResponse
+type ListExercisesForStudentsResponseBody []*ExerciseForStudentsListView
 
 // CreateAttemptResponseBody is the type of the "codelab" service
 // "CreateAttempt" endpoint HTTP response body.
@@ -196,10 +569,95 @@ type CreateAttemptResponseBody struct {
 	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
 }
 
+// SubmitAttemptResponseBody is the type of the "codelab" service
+// "SubmitAttempt" endpoint HTTP response body.
+type SubmitAttemptResponseBody struct {
+	// ID of the attempt, used to follow its grading
+	AttemptID *int64 `form:"attempt_id,omitempty" json:"attempt_id,omitempty" xml:"attempt_id,omitempty"`
+	// Number of tests the attempt runs against
+	TotalTests *int64 `form:"total_tests,omitempty" json:"total_tests,omitempty" xml:"total_tests,omitempty"`
+	// Whether the attempt was made after the assignments of the exercise closed
+	Late *bool `form:"late,omitempty" json:"late,omitempty" xml:"late,omitempty"`
+}
+
+// StreamAttemptEventsResponseBody is the type of the "codelab" service
+// "StreamAttemptEvents" endpoint HTTP response body.
+type StreamAttemptEventsResponseBody struct {
+	// What happened: queued, started running, finished a test or finished grading
+	Type *string `form:"type,omitempty" json:"type,omitempty" xml:"type,omitempty"`
+	// Attempt ID
+	AttemptID *int64 `form:"attempt_id,omitempty" json:"attempt_id,omitempty" xml:"attempt_id,omitempty"`
+	// Number of tests the attempt runs against
+	TotalTests *int64 `form:"total_tests,omitempty" json:"total_tests,omitempty" xml:"total_tests,omitempty"`
+	// Position of the finished test, starting at 0, for test events
+	TestIndex *int64 `form:"test_index,omitempty" json:"test_index,omitempty" xml:"test_index,omitempty"`
+	// Outcome of the finished test, for test events
+	TestResult *AttemptTestResultResponseBody `form:"test_result,omitempty" json:"test_result,omitempty" xml:"test_result,omitempty"`
+	// Execution outcome of the attempt, for finished events
+	Status *string `form:"status,omitempty" json:"status,omitempty" xml:"status,omitempty"`
+	// Whether the attempt passed every test, for finished events
+	Success *bool `form:"success,omitempty" json:"success,omitempty" xml:"success,omitempty"`
+	// Weight of the tests the attempt passed, for finished events
+	Points *int32 `form:"points,omitempty" json:"points,omitempty" xml:"points,omitempty"`
+	// Weight of all the tests the attempt ran against, for finished events
+	MaxPoints *int32 `form:"max_points,omitempty" json:"max_points,omitempty" xml:"max_points,omitempty"`
+	// Percentage of max_points earned, for finished events
+	Score *float64 `form:"score,omitempty" json:"score,omitempty" xml:"score,omitempty"`
+	// Summary of the outcome, for finished events
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// RunCodeResponseBody is the type of the "codelab" service "RunCode" endpoint
+// HTTP response body.
+type RunCodeResponseBody struct {
+	// Overall outcome of the run
+	Status *string `form:"status,omitempty" json:"status,omitempty" xml:"status,omitempty"`
+	// Outcome for each input
+	Results []*RunCaseResultResponseBody `form:"results,omitempty" json:"results,omitempty" xml:"results,omitempty"`
+}
+
 // GetAttemptsByUserAndExerciseResponseBody is the type of the "codelab"
 // service "GetAttemptsByUserAndExercise" endpoint HTTP response body.
 type GetAttemptsByUserAndExerciseResponseBody []*AttemptResponse
 
+// GetHintsForStudentResponseBody is the type of the "codelab" service
+// "GetHintsForStudent" endpoint HTTP response body.
+type GetHintsForStudentResponseBody []*StudentHintResponse
+
+// RequestHintResponseBody is the type of the "codelab" service "RequestHint"
+// endpoint HTTP response body.
+type RequestHintResponseBody struct {
+	// Hint ID
+	ID *int64 `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Order in which the hint is revealed, starting at 1
+	Position *int32 `form:"position,omitempty" json:"position,omitempty" xml:"position,omitempty"`
+	// Whether the hint was revealed, can be requested next or is still locked
+	Status *string `form:"status,omitempty" json:"status,omitempty" xml:"status,omitempty"`
+	// Hint text, only sent once revealed
+	Content *string `form:"content,omitempty" json:"content,omitempty" xml:"content,omitempty"`
+	// Points taken off the score of the attempts made once the hint is revealed
+	Penalty *float64 `form:"penalty,omitempty" json:"penalty,omitempty" xml:"penalty,omitempty"`
+	// Failed attempts still needed to unlock the hint
+	AttemptsRemaining *int32 `form:"attempts_remaining,omitempty" json:"attempts_remaining,omitempty" xml:"attempts_remaining,omitempty"`
+	// Timestamp at which the hint unlocks by time
+	UnlocksAt *int64 `form:"unlocks_at,omitempty" json:"unlocks_at,omitempty" xml:"unlocks_at,omitempty"`
+	// Timestamp at which the hint was revealed
+	RevealedAt *int64 `form:"revealed_at,omitempty" json:"revealed_at,omitempty" xml:"revealed_at,omitempty"`
+}
+
+// ListAssignmentsForStudentsResponseBody is the type of the "codelab" service
+// "ListAssignmentsForStudents" endpoint HTTP response body.
+type ListAssignmentsForStudentsResponseBody []*AssignmentResponse
+
+// GetAssignmentProgressResponseBody is the type of the "codelab" service
+// "GetAssignmentProgress" endpoint HTTP response body.
+type GetAssignmentProgressResponseBody struct {
+	// The assignment
+	Assignment *AssignmentResponseBody `form:"assignment,omitempty" json:"assignment,omitempty" xml:"assignment,omitempty"`
+	// Progress of the student
+	Progress *StudentAssignmentGradeResponseBody `form:"progress,omitempty" json:"progress,omitempty" xml:"progress,omitempty"`
+}
+
 // GetAnswerByUserAndExerciseResponseBody is the type of the "codelab" service
 // "GetAnswerByUserAndExercise" endpoint HTTP response body.
 type GetAnswerByUserAndExerciseResponseBody struct {
@@ -215,10 +673,114 @@ type GetAnswerByUserAndExerciseResponseBody struct {
 	CreatedAt *int64 `form:"created_at,omitempty" json:"created_at,omitempty" xml:"created_at,omitempty"`
 	// Last update timestamp
 	UpdatedAt *int64 `form:"updated_at,omitempty" json:"updated_at,omitempty" xml:"updated_at,omitempty"`
+	// Best score reached by an attempt, as a percentage of the test weight
+	BestScore *float64 `form:"best_score,omitempty" json:"best_score,omitempty" xml:"best_score,omitempty"`
+	// Attempt that reached the best score, missing until an attempt is made
+	BestAttemptID *int64 `form:"best_attempt_id,omitempty" json:"best_attempt_id,omitempty" xml:"best_attempt_id,omitempty"`
+	// Hints revealed to the student
+	HintsUsed *int32 `form:"hints_used,omitempty" json:"hints_used,omitempty" xml:"hints_used,omitempty"`
+	// Points taken off the score of every attempt for the hints revealed
+	HintPenalty *float64 `form:"hint_penalty,omitempty" json:"hint_penalty,omitempty" xml:"hint_penalty,omitempty"`
+}
+
+// CreateExerciseSolutionFailedResponseBody is the type of the "codelab"
+// service "CreateExercise" endpoint HTTP response body for the
+// "solution_failed" error.
+type CreateExerciseSolutionFailedResponseBody struct {
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Tests the solution does not pass
+	FailingTests []*SolutionTestFailureResponseBody `form:"failing_tests,omitempty" json:"failing_tests,omitempty" xml:"failing_tests,omitempty"`
+}
+
+// UpdateExerciseSolutionFailedResponseBody is the type of the "codelab"
+// service "UpdateExercise" endpoint HTTP response body for the
+// "solution_failed" error.
+type UpdateExerciseSolutionFailedResponseBody struct {
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Tests the solution does not pass
+	FailingTests []*SolutionTestFailureResponseBody `form:"failing_tests,omitempty" json:"failing_tests,omitempty" xml:"failing_tests,omitempty"`
+}
+
+// ImportExercisesSolutionFailedResponseBody is the type of the "codelab"
+// service "ImportExercises" endpoint HTTP response body for the
+// "solution_failed" error.
+type ImportExercisesSolutionFailedResponseBody struct {
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Tests the solution does not pass
+	FailingTests []*SolutionTestFailureResponseBody `form:"failing_tests,omitempty" json:"failing_tests,omitempty" xml:"failing_tests,omitempty"`
+}
+
+// CreateTestSolutionFailedResponseBody is the type of the "codelab" service
+// "CreateTest" endpoint HTTP response body for the "solution_failed" error.
+type CreateTestSolutionFailedResponseBody struct {
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Tests the solution does not pass
+	FailingTests []*SolutionTestFailureResponseBody `form:"failing_tests,omitempty" json:"failing_tests,omitempty" xml:"failing_tests,omitempty"`
+}
+
+// UpdateTestSolutionFailedResponseBody is the type of the "codelab" service
+// "UpdateTest" endpoint HTTP response body for the "solution_failed" error.
+type UpdateTestSolutionFailedResponseBody struct {
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Tests the solution does not pass
+	FailingTests []*SolutionTestFailureResponseBody `form:"failing_tests,omitempty" json:"failing_tests,omitempty" xml:"failing_tests,omitempty"`
 }
 
-// ExerciseResponse is used to define fields on response body types.
-type ExerciseResponse struct {
+// RollbackExerciseSolutionFailedResponseBody is the type of the "codelab"
+// service "RollbackExercise" endpoint HTTP response body for the
+// "solution_failed" error.
+type RollbackExerciseSolutionFailedResponseBody struct {
+	// Error message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+	// Tests the solution does not pass
+	FailingTests []*SolutionTestFailureResponseBody `form:"failing_tests,omitempty" json:"failing_tests,omitempty" xml:"failing_tests,omitempty"`
+}
+
+// CreateAttemptRateLimitedResponseBody is the type of the "codelab" service
+// "CreateAttempt" endpoint HTTP response body for the "rate_limited" error.
+type CreateAttemptRateLimitedResponseBody struct {
+	// Why the request was limited
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// SubmitAttemptRateLimitedResponseBody is the type of the "codelab" service
+// "SubmitAttempt" endpoint HTTP response body for the "rate_limited" error.
+type SubmitAttemptRateLimitedResponseBody struct {
+	// Why the request was limited
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// RunCodeRateLimitedResponseBody is the type of the "codelab" service
+// "RunCode" endpoint HTTP response body for the "rate_limited" error.
+type RunCodeRateLimitedResponseBody struct {
+	// Why the request was limited
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// SolutionTestFailureResponseBody is used to define fields on response body
+// types.
+type SolutionTestFailureResponseBody struct {
+	// Test ID, missing for a test that is not saved yet
+	TestID *int64 `form:"test_id,omitempty" json:"test_id,omitempty" xml:"test_id,omitempty"`
+	// Test input
+	Input *string `form:"input,omitempty" json:"input,omitempty" xml:"input,omitempty"`
+	// Expected output
+	ExpectedOutput *string `form:"expected_output,omitempty" json:"expected_output,omitempty" xml:"expected_output,omitempty"`
+	// Output of the solution
+	ActualOutput *string `form:"actual_output,omitempty" json:"actual_output,omitempty" xml:"actual_output,omitempty"`
+	// How the test finished
+	Status *string `form:"status,omitempty" json:"status,omitempty" xml:"status,omitempty"`
+	// Error raised by the solution
+	ErrorMessage *string `form:"error_message,omitempty" json:"error_message,omitempty" xml:"error_message,omitempty"`
+}
+
+// Exercise is used to define fields on response body types.
+type Exercise struct {
 	// Exercise ID
 	ID *int64 `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
 	// Exercise title
@@ -237,6 +799,20 @@ type ExerciseResponse struct {
 	CreatedAt *int64 `form:"created_at,omitempty" json:"created_at,omitempty" xml:"created_at,omitempty"`
 	// Last update timestamp
 	UpdatedAt *int64 `form:"updated_at,omitempty" json:"updated_at,omitempty" xml:"updated_at,omitempty"`
+	// Programming language the exercise is solved in
+	Language *string `form:"language,omitempty" json:"language,omitempty" xml:"language,omitempty"`
+	// Topics the exercise covers
+	Tags []string `form:"tags,omitempty" json:"tags,omitempty" xml:"tags,omitempty"`
+	// Latest version, bumped by every change to the exercise or its tests
+	Version *int32 `form:"version,omitempty" json:"version,omitempty" xml:"version,omitempty"`
+}
+
+// TagCountResponse is used to define fields on response body types.
+type TagCountResponse struct {
+	// Topic name
+	Tag *string `form:"tag,omitempty" json:"tag,omitempty" xml:"tag,omitempty"`
+	// Number of exercises tagged with the topic
+	Exercises *int64 `form:"exercises,omitempty" json:"exercises,omitempty" xml:"exercises,omitempty"`
 }
 
 // UpdateExercisePayloadRequestBody is used to define fields on request body
@@ -252,6 +828,10 @@ type UpdateExercisePayloadRequestBody struct {
 	Solution string `form:"solution" json:"solution" xml:"solution"`
 	// Exercise difficulty level
 	Difficulty string `form:"difficulty" json:"difficulty" xml:"difficulty"`
+	// Programming language the exercise is solved in, unchanged if omitted
+	Language *string `form:"language,omitempty" json:"language,omitempty" xml:"language,omitempty"`
+	// Topics the exercise covers, unchanged if omitted
+	Tags []string `form:"tags,omitempty" json:"tags,omitempty" xml:"tags,omitempty"`
 }
 
 // TestResponse is used to define fields on response body types.
@@ -270,6 +850,15 @@ type TestResponse struct {
 	CreatedAt *int64 `form:"created_at,omitempty" json:"created_at,omitempty" xml:"created_at,omitempty"`
 	// Last update timestamp
 	UpdatedAt *int64 `form:"updated_at,omitempty" json:"updated_at,omitempty" xml:"updated_at,omitempty"`
+	// How the input is passed to solution: a raw string or a JSON array of
+	// arguments
+	InputFormat *string `form:"input_format,omitempty" json:"input_format,omitempty" xml:"input_format,omitempty"`
+	// How the output is compared to the expected output
+	Comparison *string `form:"comparison,omitempty" json:"comparison,omitempty" xml:"comparison,omitempty"`
+	// Allowed absolute difference for numeric comparison
+	Tolerance *float64 `form:"tolerance,omitempty" json:"tolerance,omitempty" xml:"tolerance,omitempty"`
+	// Points awarded for passing the test
+	Weight *int32 `form:"weight,omitempty" json:"weight,omitempty" xml:"weight,omitempty"`
 }
 
 // UpdateTestPayloadRequestBody is used to define fields on request body types.
@@ -280,70 +869,185 @@ type UpdateTestPayloadRequestBody struct {
 	Output string `form:"output" json:"output" xml:"output"`
 	// Whether test is visible to students
 	Public bool `form:"public" json:"public" xml:"public"`
+	// How the input is passed to solution: a raw string or a JSON array of
+	// arguments
+	InputFormat string `form:"input_format" json:"input_format" xml:"input_format"`
+	// How the output is compared to the expected output
+	Comparison string `form:"comparison" json:"comparison" xml:"comparison"`
+	// Allowed absolute difference for numeric comparison
+	Tolerance float64 `form:"tolerance" json:"tolerance" xml:"tolerance"`
+	// Points awarded for passing the test
+	Weight int32 `form:"weight" json:"weight" xml:"weight"`
 }
 
-// TestResponseBody is used to define fields on response body types.
-type TestResponseBody struct {
+// ExerciseVersionResponse is used to define fields on response body types.
+type ExerciseVersionResponse struct {
+	// Version number
+	Version *int32 `form:"version,omitempty" json:"version,omitempty" xml:"version,omitempty"`
+	// ID of user whose change created the version
+	CreatedBy *int64 `form:"created_by,omitempty" json:"created_by,omitempty" xml:"created_by,omitempty"`
+	// Creation timestamp
+	CreatedAt *int64 `form:"created_at,omitempty" json:"created_at,omitempty" xml:"created_at,omitempty"`
+	// Number of attempts graded against the version
+	Attempts *int64 `form:"attempts,omitempty" json:"attempts,omitempty" xml:"attempts,omitempty"`
+}
+
+// FieldChangeResponseBody is used to define fields on response body types.
+type FieldChangeResponseBody struct {
+	// Field name
+	Field *string `form:"field,omitempty" json:"field,omitempty" xml:"field,omitempty"`
+	// Value in the older version, empty for added tests
+	From *string `form:"from,omitempty" json:"from,omitempty" xml:"from,omitempty"`
+	// Value in the newer version, empty for removed tests
+	To *string `form:"to,omitempty" json:"to,omitempty" xml:"to,omitempty"`
+}
+
+// TestChangeResponseBody is used to define fields on response body types.
+type TestChangeResponseBody struct {
 	// Test ID
+	TestID *int64 `form:"test_id,omitempty" json:"test_id,omitempty" xml:"test_id,omitempty"`
+	// How the test changed
+	Change *string `form:"change,omitempty" json:"change,omitempty" xml:"change,omitempty"`
+	// Fields that changed, every field for added and removed tests
+	Fields []*FieldChangeResponseBody `form:"fields,omitempty" json:"fields,omitempty" xml:"fields,omitempty"`
+}
+
+// RegradeStudentChangeResponseBody is used to define fields on response body
+// types.
+type RegradeStudentChangeResponseBody struct {
+	// Student user ID
+	UserID *int64 `form:"user_id,omitempty" json:"user_id,omitempty" xml:"user_id,omitempty"`
+	// Best score before the regrade
+	PreviousScore *float64 `form:"previous_score,omitempty" json:"previous_score,omitempty" xml:"previous_score,omitempty"`
+	// Best score after the regrade
+	Score *float64 `form:"score,omitempty" json:"score,omitempty" xml:"score,omitempty"`
+}
+
+// HintResponse is used to define fields on response body types.
+type HintResponse struct {
+	// Hint ID
 	ID *int64 `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
-	// Test input
-	Input *string `form:"input,omitempty" json:"input,omitempty" xml:"input,omitempty"`
-	// Expected output
-	Output *string `form:"output,omitempty" json:"output,omitempty" xml:"output,omitempty"`
-	// Whether test is visible to students
-	Public *bool `form:"public,omitempty" json:"public,omitempty" xml:"public,omitempty"`
 	// Associated exercise ID
 	ExerciseID *int64 `form:"exercise_id,omitempty" json:"exercise_id,omitempty" xml:"exercise_id,omitempty"`
+	// Order in which the hint is revealed, starting at 1
+	Position *int32 `form:"position,omitempty" json:"position,omitempty" xml:"position,omitempty"`
+	// Hint text
+	Content *string `form:"content,omitempty" json:"content,omitempty" xml:"content,omitempty"`
+	// Failed attempts after which the hint unlocks
+	UnlockAfterAttempts *int32 `form:"unlock_after_attempts,omitempty" json:"unlock_after_attempts,omitempty" xml:"unlock_after_attempts,omitempty"`
+	// Seconds since the student started the exercise after which the hint unlocks
+	UnlockAfterSeconds *int32 `form:"unlock_after_seconds,omitempty" json:"unlock_after_seconds,omitempty" xml:"unlock_after_seconds,omitempty"`
+	// Points taken off the score of the attempts made once the hint is revealed
+	Penalty *float64 `form:"penalty,omitempty" json:"penalty,omitempty" xml:"penalty,omitempty"`
 	// Creation timestamp
 	CreatedAt *int64 `form:"created_at,omitempty" json:"created_at,omitempty" xml:"created_at,omitempty"`
 	// Last update timestamp
 	UpdatedAt *int64 `form:"updated_at,omitempty" json:"updated_at,omitempty" xml:"updated_at,omitempty"`
 }
 
-// AttemptResponseBody is used to define fields on response body types.
-type AttemptResponseBody struct {
-	// Attempt ID
-	ID *int64 `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
-	// Associated answer ID
-	AnswerID *int64 `form:"answer_id,omitempty" json:"answer_id,omitempty" xml:"answer_id,omitempty"`
-	// Submitted code
-	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
-	// Whether the attempt was successful
-	Success *bool `form:"success,omitempty" json:"success,omitempty" xml:"success,omitempty"`
-	// Creation timestamp
-	CreatedAt *int64 `form:"created_at,omitempty" json:"created_at,omitempty" xml:"created_at,omitempty"`
+// UpdateHintPayloadRequestBody is used to define fields on request body types.
+type UpdateHintPayloadRequestBody struct {
+	// Hint text
+	Content string `form:"content" json:"content" xml:"content"`
+	// Failed attempts after which the hint unlocks
+	UnlockAfterAttempts *int32 `form:"unlock_after_attempts,omitempty" json:"unlock_after_attempts,omitempty" xml:"unlock_after_attempts,omitempty"`
+	// Seconds since the student started the exercise after which the hint unlocks
+	UnlockAfterSeconds *int32 `form:"unlock_after_seconds,omitempty" json:"unlock_after_seconds,omitempty" xml:"unlock_after_seconds,omitempty"`
+	// Points taken off the score of the attempts made once the hint is revealed
+	Penalty float64 `form:"penalty" json:"penalty" xml:"penalty"`
 }
 
-// AnswerResponseBody is used to define fields on response body types.
-type AnswerResponseBody struct {
-	// Answer ID
-	ID *int64 `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
-	// Associated exercise ID
+// AssignmentExerciseResponseBody is used to define fields on response body
+// types.
+type AssignmentExerciseResponseBody struct {
+	// Exercise ID
 	ExerciseID *int64 `form:"exercise_id,omitempty" json:"exercise_id,omitempty" xml:"exercise_id,omitempty"`
-	// Student user ID
-	UserID *int64 `form:"user_id,omitempty" json:"user_id,omitempty" xml:"user_id,omitempty"`
-	// Whether the exercise is completed
-	Completed *bool `form:"completed,omitempty" json:"completed,omitempty" xml:"completed,omitempty"`
+	// Order of the exercise in the assignment, starting at 1
+	Position *int32 `form:"position,omitempty" json:"position,omitempty" xml:"position,omitempty"`
+	// Exercise title
+	Title *string `form:"title,omitempty" json:"title,omitempty" xml:"title,omitempty"`
+	// Exercise difficulty
+	Difficulty *string `form:"difficulty,omitempty" json:"difficulty,omitempty" xml:"difficulty,omitempty"`
+	// Language the exercise is solved in
+	Language *string `form:"language,omitempty" json:"language,omitempty" xml:"language,omitempty"`
+}
+
+// AssignmentResponse is used to define fields on response body types.
+type AssignmentResponse struct {
+	// Assignment ID
+	ID *int64 `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Assignment title
+	Title *string `form:"title,omitempty" json:"title,omitempty" xml:"title,omitempty"`
+	// Assignment description
+	Description *string `form:"description,omitempty" json:"description,omitempty" xml:"description,omitempty"`
+	// Timestamp from which attempts are accepted
+	OpensAt *int64 `form:"opens_at,omitempty" json:"opens_at,omitempty" xml:"opens_at,omitempty"`
+	// Timestamp after which attempts are rejected or flagged as late
+	ClosesAt *int64 `form:"closes_at,omitempty" json:"closes_at,omitempty" xml:"closes_at,omitempty"`
+	// Whether attempts made after closes_at are accepted as late
+	AllowLate *bool `form:"allow_late,omitempty" json:"allow_late,omitempty" xml:"allow_late,omitempty"`
+	// Whether the assignment has not opened yet, is open or has closed
+	Status *string `form:"status,omitempty" json:"status,omitempty" xml:"status,omitempty"`
+	// Exercises of the assignment in order
+	Exercises []*AssignmentExerciseResponse `form:"exercises,omitempty" json:"exercises,omitempty" xml:"exercises,omitempty"`
+	// Creator user ID
+	CreatedBy *int64 `form:"created_by,omitempty" json:"created_by,omitempty" xml:"created_by,omitempty"`
 	// Creation timestamp
 	CreatedAt *int64 `form:"created_at,omitempty" json:"created_at,omitempty" xml:"created_at,omitempty"`
 	// Last update timestamp
 	UpdatedAt *int64 `form:"updated_at,omitempty" json:"updated_at,omitempty" xml:"updated_at,omitempty"`
 }
 
-// ExerciseForStudentsListViewResponse is used to define fields on response
-// body types.
-type ExerciseForStudentsListViewResponse struct {
+// AssignmentExerciseResponse is used to define fields on response body types.
+type AssignmentExerciseResponse struct {
 	// Exercise ID
-	ID *int64 `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	ExerciseID *int64 `form:"exercise_id,omitempty" json:"exercise_id,omitempty" xml:"exercise_id,omitempty"`
+	// Order of the exercise in the assignment, starting at 1
+	Position *int32 `form:"position,omitempty" json:"position,omitempty" xml:"position,omitempty"`
 	// Exercise title
 	Title *string `form:"title,omitempty" json:"title,omitempty" xml:"title,omitempty"`
-	// Exercise description
-	Description *string `form:"description,omitempty" json:"description,omitempty" xml:"description,omitempty"`
-	// Exercise difficulty level
+	// Exercise difficulty
 	Difficulty *string `form:"difficulty,omitempty" json:"difficulty,omitempty" xml:"difficulty,omitempty"`
-	// Whether the exercise is completed by the student
-	Completed *bool `form:"completed,omitempty" json:"completed,omitempty" xml:"completed,omitempty"`
-	// ID of user who created the exercise
+	// Language the exercise is solved in
+	Language *string `form:"language,omitempty" json:"language,omitempty" xml:"language,omitempty"`
+}
+
+// UpdateAssignmentPayloadRequestBody is used to define fields on request body
+// types.
+type UpdateAssignmentPayloadRequestBody struct {
+	// Assignment title
+	Title string `form:"title" json:"title" xml:"title"`
+	// Assignment description
+	Description string `form:"description" json:"description" xml:"description"`
+	// Timestamp from which attempts are accepted
+	OpensAt int64 `form:"opens_at" json:"opens_at" xml:"opens_at"`
+	// Timestamp after which attempts are rejected or flagged as late
+	ClosesAt int64 `form:"closes_at" json:"closes_at" xml:"closes_at"`
+	// Whether attempts made after closes_at are accepted as late
+	AllowLate bool `form:"allow_late" json:"allow_late" xml:"allow_late"`
+	// Exercises of the assignment in order
+	ExerciseIds []int64 `form:"exercise_ids" json:"exercise_ids" xml:"exercise_ids"`
+}
+
+// AssignmentResponseBody is used to define fields on response body types.
+type AssignmentResponseBody struct {
+	// Assignment ID
+	ID *int64 `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Assignment title
+	Title *string `form:"title,omitempty" json:"title,omitempty" xml:"title,omitempty"`
+	// Assignment description
+	Description *string `form:"description,omitempty" json:"description,omitempty" xml:"description,omitempty"`
+	// Timestamp from which attempts are accepted
+	OpensAt *int64 `form:"opens_at,omitempty" json:"opens_at,omitempty" xml:"opens_at,omitempty"`
+	// Timestamp after which attempts are rejected or flagged as late
+	ClosesAt *int64 `form:"closes_at,omitempty" json:"closes_at,omitempty" xml:"closes_at,omitempty"`
+	// Whether attempts made after closes_at are accepted as late
+	AllowLate *bool `form:"allow_late,omitempty" json:"allow_late,omitempty" xml:"allow_late,omitempty"`
+	// Whether the assignment has not opened yet, is open or has closed
+	Status *string `form:"status,omitempty" json:"status,omitempty" xml:"status,omitempty"`
+	// Exercises of the assignment in order
+	Exercises []*AssignmentExerciseResponseBody `form:"exercises,omitempty" json:"exercises,omitempty" xml:"exercises,omitempty"`
+	// Creator user ID
 	CreatedBy *int64 `form:"created_by,omitempty" json:"created_by,omitempty" xml:"created_by,omitempty"`
 	// Creation timestamp
 	CreatedAt *int64 `form:"created_at,omitempty" json:"created_at,omitempty" xml:"created_at,omitempty"`
@@ -351,10 +1055,260 @@ type ExerciseForStudentsListViewResponse struct {
 	UpdatedAt *int64 `form:"updated_at,omitempty" json:"updated_at,omitempty" xml:"updated_at,omitempty"`
 }
 
-// AttemptResponse is used to define fields on response body types.
-type AttemptResponse struct {
-	// Attempt ID
-	ID *int64 `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+// StudentAssignmentGradeResponseBody is used to define fields on response body
+// types.
+type StudentAssignmentGradeResponseBody struct {
+	// Student user ID
+	UserID *int64 `form:"user_id,omitempty" json:"user_id,omitempty" xml:"user_id,omitempty"`
+	// Average best score over every exercise before the assignment closed
+	Score *float64 `form:"score,omitempty" json:"score,omitempty" xml:"score,omitempty"`
+	// Average best score over every exercise counting late attempts
+	ScoreWithLate *float64 `form:"score_with_late,omitempty" json:"score_with_late,omitempty" xml:"score_with_late,omitempty"`
+	// Exercises completed before the assignment closed
+	CompletedExercises *int64 `form:"completed_exercises,omitempty" json:"completed_exercises,omitempty" xml:"completed_exercises,omitempty"`
+	// Exercises of the assignment
+	TotalExercises *int64 `form:"total_exercises,omitempty" json:"total_exercises,omitempty" xml:"total_exercises,omitempty"`
+	// Progress on every exercise in assignment order
+	Exercises []*ExerciseGradeResponseBody `form:"exercises,omitempty" json:"exercises,omitempty" xml:"exercises,omitempty"`
+}
+
+// ExerciseGradeResponseBody is used to define fields on response body types.
+type ExerciseGradeResponseBody struct {
+	// Exercise ID
+	ExerciseID *int64 `form:"exercise_id,omitempty" json:"exercise_id,omitempty" xml:"exercise_id,omitempty"`
+	// Whether the student has not attempted, is working on, completed the exercise
+	// before the deadline or only after it
+	Status *string `form:"status,omitempty" json:"status,omitempty" xml:"status,omitempty"`
+	// Number of attempts made
+	Attempts *int64 `form:"attempts,omitempty" json:"attempts,omitempty" xml:"attempts,omitempty"`
+	// Attempts made after the assignment closed
+	LateAttempts *int64 `form:"late_attempts,omitempty" json:"late_attempts,omitempty" xml:"late_attempts,omitempty"`
+	// Best score reached before the assignment closed
+	BestScore *float64 `form:"best_score,omitempty" json:"best_score,omitempty" xml:"best_score,omitempty"`
+	// Best score reached counting late attempts
+	BestScoreWithLate *float64 `form:"best_score_with_late,omitempty" json:"best_score_with_late,omitempty" xml:"best_score_with_late,omitempty"`
+}
+
+// FailingTestResponseBody is used to define fields on response body types.
+type FailingTestResponseBody struct {
+	// Test ID
+	TestID *int64 `form:"test_id,omitempty" json:"test_id,omitempty" xml:"test_id,omitempty"`
+	// Test input
+	Input *string `form:"input,omitempty" json:"input,omitempty" xml:"input,omitempty"`
+	// Whether test is visible to students
+	Public *bool `form:"public,omitempty" json:"public,omitempty" xml:"public,omitempty"`
+	// Number of attempts that did not pass the test
+	Failures *int64 `form:"failures,omitempty" json:"failures,omitempty" xml:"failures,omitempty"`
+}
+
+// StudentExerciseStatusResponseBody is used to define fields on response body
+// types.
+type StudentExerciseStatusResponseBody struct {
+	// Student user ID
+	UserID *int64 `form:"user_id,omitempty" json:"user_id,omitempty" xml:"user_id,omitempty"`
+	// Whether the student has not attempted, is working on or has completed the
+	// exercise
+	Status *string `form:"status,omitempty" json:"status,omitempty" xml:"status,omitempty"`
+	// Number of attempts made
+	Attempts *int64 `form:"attempts,omitempty" json:"attempts,omitempty" xml:"attempts,omitempty"`
+	// Best score reached by an attempt
+	BestScore *float64 `form:"best_score,omitempty" json:"best_score,omitempty" xml:"best_score,omitempty"`
+	// Attempts made up to and including the first successful one
+	AttemptsToSuccess *int64 `form:"attempts_to_success,omitempty" json:"attempts_to_success,omitempty" xml:"attempts_to_success,omitempty"`
+	// Timestamp of the first attempt
+	FirstAttemptAt *int64 `form:"first_attempt_at,omitempty" json:"first_attempt_at,omitempty" xml:"first_attempt_at,omitempty"`
+	// Timestamp of the latest attempt
+	LastAttemptAt *int64 `form:"last_attempt_at,omitempty" json:"last_attempt_at,omitempty" xml:"last_attempt_at,omitempty"`
+	// Timestamp of the first successful attempt
+	CompletedAt *int64 `form:"completed_at,omitempty" json:"completed_at,omitempty" xml:"completed_at,omitempty"`
+	// Time from the first attempt to the first successful one in milliseconds
+	TimeToCompletionMs *int64 `form:"time_to_completion_ms,omitempty" json:"time_to_completion_ms,omitempty" xml:"time_to_completion_ms,omitempty"`
+	// Hints revealed to the student
+	HintsUsed *int32 `form:"hints_used,omitempty" json:"hints_used,omitempty" xml:"hints_used,omitempty"`
+}
+
+// AttemptResponseBody is used to define fields on response body types.
+type AttemptResponseBody struct {
+	// Attempt ID
+	ID *int64 `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Associated answer ID
+	AnswerID *int64 `form:"answer_id,omitempty" json:"answer_id,omitempty" xml:"answer_id,omitempty"`
+	// Submitted code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Whether the attempt was successful
+	Success *bool `form:"success,omitempty" json:"success,omitempty" xml:"success,omitempty"`
+	// Creation timestamp
+	CreatedAt *int64 `form:"created_at,omitempty" json:"created_at,omitempty" xml:"created_at,omitempty"`
+	// Execution outcome of the attempt, pending while it is graded in the
+	// background
+	Status *string `form:"status,omitempty" json:"status,omitempty" xml:"status,omitempty"`
+	// Result of running the attempt against each test of the exercise
+	TestResults []*AttemptTestResultResponseBody `form:"test_results,omitempty" json:"test_results,omitempty" xml:"test_results,omitempty"`
+	// Weight of the tests the attempt passed
+	Points *int32 `form:"points,omitempty" json:"points,omitempty" xml:"points,omitempty"`
+	// Weight of all the tests the attempt ran against
+	MaxPoints *int32 `form:"max_points,omitempty" json:"max_points,omitempty" xml:"max_points,omitempty"`
+	// Percentage of max_points earned
+	Score *float64 `form:"score,omitempty" json:"score,omitempty" xml:"score,omitempty"`
+	// Whether the attempt was made after the assignments of the exercise closed
+	Late *bool `form:"late,omitempty" json:"late,omitempty" xml:"late,omitempty"`
+	// Version of the exercise the attempt was graded against
+	ExerciseVersion *int32 `form:"exercise_version,omitempty" json:"exercise_version,omitempty" xml:"exercise_version,omitempty"`
+}
+
+// AttemptTestResultResponseBody is used to define fields on response body
+// types.
+type AttemptTestResultResponseBody struct {
+	// Test ID, missing if the test was deleted
+	TestID *int64 `form:"test_id,omitempty" json:"test_id,omitempty" xml:"test_id,omitempty"`
+	// Outcome of the test
+	Status *string `form:"status,omitempty" json:"status,omitempty" xml:"status,omitempty"`
+	// Output produced by the submitted code
+	ActualOutput *string `form:"actual_output,omitempty" json:"actual_output,omitempty" xml:"actual_output,omitempty"`
+	// Expected output, only shown for public tests
+	ExpectedOutput *string `form:"expected_output,omitempty" json:"expected_output,omitempty" xml:"expected_output,omitempty"`
+	// Error raised while running the test
+	Error *string `form:"error,omitempty" json:"error,omitempty" xml:"error,omitempty"`
+	// Output written to the console while running the test, with warnings and
+	// errors prefixed by their level
+	ConsoleOutput *string `form:"console_output,omitempty" json:"console_output,omitempty" xml:"console_output,omitempty"`
+	// Time spent running the test in milliseconds
+	DurationMs *int64 `form:"duration_ms,omitempty" json:"duration_ms,omitempty" xml:"duration_ms,omitempty"`
+	// Stack trace through the submitted code when it threw, with positions in the
+	// submitted code
+	StackTrace *string `form:"stack_trace,omitempty" json:"stack_trace,omitempty" xml:"stack_trace,omitempty"`
+}
+
+// PlagiarismPairResponseBody is used to define fields on response body types.
+type PlagiarismPairResponseBody struct {
+	// Attempt of the first student
+	AttemptAID *int64 `form:"attempt_a_id,omitempty" json:"attempt_a_id,omitempty" xml:"attempt_a_id,omitempty"`
+	// First student user ID
+	UserAID *int64 `form:"user_a_id,omitempty" json:"user_a_id,omitempty" xml:"user_a_id,omitempty"`
+	// Attempt of the second student
+	AttemptBID *int64 `form:"attempt_b_id,omitempty" json:"attempt_b_id,omitempty" xml:"attempt_b_id,omitempty"`
+	// Second student user ID
+	UserBID *int64 `form:"user_b_id,omitempty" json:"user_b_id,omitempty" xml:"user_b_id,omitempty"`
+	// Percentage of the code fingerprints both attempts share
+	Similarity *float64 `form:"similarity,omitempty" json:"similarity,omitempty" xml:"similarity,omitempty"`
+	// Matching regions of both attempts
+	Regions []*PlagiarismRegionResponseBody `form:"regions,omitempty" json:"regions,omitempty" xml:"regions,omitempty"`
+}
+
+// PlagiarismRegionResponseBody is used to define fields on response body types.
+type PlagiarismRegionResponseBody struct {
+	// First line in the first attempt
+	StartLineA *int `form:"start_line_a,omitempty" json:"start_line_a,omitempty" xml:"start_line_a,omitempty"`
+	// Last line in the first attempt
+	EndLineA *int `form:"end_line_a,omitempty" json:"end_line_a,omitempty" xml:"end_line_a,omitempty"`
+	// First line in the second attempt
+	StartLineB *int `form:"start_line_b,omitempty" json:"start_line_b,omitempty" xml:"start_line_b,omitempty"`
+	// Last line in the second attempt
+	EndLineB *int `form:"end_line_b,omitempty" json:"end_line_b,omitempty" xml:"end_line_b,omitempty"`
+}
+
+// TestResponseBody is used to define fields on response body types.
+type TestResponseBody struct {
+	// Test ID
+	ID *int64 `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Test input
+	Input *string `form:"input,omitempty" json:"input,omitempty" xml:"input,omitempty"`
+	// Expected output
+	Output *string `form:"output,omitempty" json:"output,omitempty" xml:"output,omitempty"`
+	// Whether test is visible to students
+	Public *bool `form:"public,omitempty" json:"public,omitempty" xml:"public,omitempty"`
+	// Associated exercise ID
+	ExerciseID *int64 `form:"exercise_id,omitempty" json:"exercise_id,omitempty" xml:"exercise_id,omitempty"`
+	// Creation timestamp
+	CreatedAt *int64 `form:"created_at,omitempty" json:"created_at,omitempty" xml:"created_at,omitempty"`
+	// Last update timestamp
+	UpdatedAt *int64 `form:"updated_at,omitempty" json:"updated_at,omitempty" xml:"updated_at,omitempty"`
+	// How the input is passed to solution: a raw string or a JSON array of
+	// arguments
+	InputFormat *string `form:"input_format,omitempty" json:"input_format,omitempty" xml:"input_format,omitempty"`
+	// How the output is compared to the expected output
+	Comparison *string `form:"comparison,omitempty" json:"comparison,omitempty" xml:"comparison,omitempty"`
+	// Allowed absolute difference for numeric comparison
+	Tolerance *float64 `form:"tolerance,omitempty" json:"tolerance,omitempty" xml:"tolerance,omitempty"`
+	// Points awarded for passing the test
+	Weight *int32 `form:"weight,omitempty" json:"weight,omitempty" xml:"weight,omitempty"`
+}
+
+// AnswerResponseBody is used to define fields on response body types.
+type AnswerResponseBody struct {
+	// Answer ID
+	ID *int64 `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Associated exercise ID
+	ExerciseID *int64 `form:"exercise_id,omitempty" json:"exercise_id,omitempty" xml:"exercise_id,omitempty"`
+	// Student user ID
+	UserID *int64 `form:"user_id,omitempty" json:"user_id,omitempty" xml:"user_id,omitempty"`
+	// Whether the exercise is completed
+	Completed *bool `form:"completed,omitempty" json:"completed,omitempty" xml:"completed,omitempty"`
+	// Creation timestamp
+	CreatedAt *int64 `form:"created_at,omitempty" json:"created_at,omitempty" xml:"created_at,omitempty"`
+	// Last update timestamp
+	UpdatedAt *int64 `form:"updated_at,omitempty" json:"updated_at,omitempty" xml:"updated_at,omitempty"`
+	// Best score reached by an attempt, as a percentage of the test weight
+	BestScore *float64 `form:"best_score,omitempty" json:"best_score,omitempty" xml:"best_score,omitempty"`
+	// Attempt that reached the best score, missing until an attempt is made
+	BestAttemptID *int64 `form:"best_attempt_id,omitempty" json:"best_attempt_id,omitempty" xml:"best_attempt_id,omitempty"`
+	// Hints revealed to the student
+	HintsUsed *int32 `form:"hints_used,omitempty" json:"hints_used,omitempty" xml:"hints_used,omitempty"`
+	// Points taken off the score of every attempt for the hints revealed
+	HintPenalty *float64 `form:"hint_penalty,omitempty" json:"hint_penalty,omitempty" xml:"hint_penalty,omitempty"`
+}
+
+// ExerciseForStudentsListView is used to define fields on response body types.
+type ExerciseForStudentsListView struct {
+	// Exercise ID
+	ID *int64 `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Exercise title
+	Title *string `form:"title,omitempty" json:"title,omitempty" xml:"title,omitempty"`
+	// Exercise description
+	Description *string `form:"description,omitempty" json:"description,omitempty" xml:"description,omitempty"`
+	// Exercise difficulty level
+	Difficulty *string `form:"difficulty,omitempty" json:"difficulty,omitempty" xml:"difficulty,omitempty"`
+	// Whether the exercise is completed by the student
+	Completed *bool `form:"completed,omitempty" json:"completed,omitempty" xml:"completed,omitempty"`
+	// ID of user who created the exercise
+	CreatedBy *int64 `form:"created_by,omitempty" json:"created_by,omitempty" xml:"created_by,omitempty"`
+	// Creation timestamp
+	CreatedAt *int64 `form:"created_at,omitempty" json:"created_at,omitempty" xml:"created_at,omitempty"`
+	// Last update timestamp
+	UpdatedAt *int64 `form:"updated_at,omitempty" json:"updated_at,omitempty" xml:"updated_at,omitempty"`
+	// Programming language the exercise is solved in
+	Language *string `form:"language,omitempty" json:"language,omitempty" xml:"language,omitempty"`
+	// Topics the exercise covers
+	Tags []string `form:"tags,omitempty" json:"tags,omitempty" xml:"tags,omitempty"`
+}
+
+// RunCaseResultResponseBody is used to define fields on response body types.
+type RunCaseResultResponseBody struct {
+	// Public test ID, missing for custom inputs
+	TestID *int64 `form:"test_id,omitempty" json:"test_id,omitempty" xml:"test_id,omitempty"`
+	// Input given to the code
+	Input *string `form:"input,omitempty" json:"input,omitempty" xml:"input,omitempty"`
+	// Outcome of the run, completed for custom inputs that ran without errors
+	Status *string `form:"status,omitempty" json:"status,omitempty" xml:"status,omitempty"`
+	// Output produced by the code
+	Output *string `form:"output,omitempty" json:"output,omitempty" xml:"output,omitempty"`
+	// Expected output, missing for custom inputs
+	ExpectedOutput *string `form:"expected_output,omitempty" json:"expected_output,omitempty" xml:"expected_output,omitempty"`
+	// Error raised while running the code
+	Error *string `form:"error,omitempty" json:"error,omitempty" xml:"error,omitempty"`
+	// Output written to the console while running the code, with warnings and
+	// errors prefixed by their level
+	ConsoleOutput *string `form:"console_output,omitempty" json:"console_output,omitempty" xml:"console_output,omitempty"`
+	// Time spent running the code in milliseconds
+	DurationMs *int64 `form:"duration_ms,omitempty" json:"duration_ms,omitempty" xml:"duration_ms,omitempty"`
+	// Stack trace through the code when it threw, with positions in the code
+	StackTrace *string `form:"stack_trace,omitempty" json:"stack_trace,omitempty" xml:"stack_trace,omitempty"`
+}
+
+// AttemptResponse is used to define fields on response body types.
+type AttemptResponse struct {
+	// Attempt ID
+	ID *int64 `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
 	// Associated answer ID
 	AnswerID *int64 `form:"answer_id,omitempty" json:"answer_id,omitempty" xml:"answer_id,omitempty"`
 	// Submitted code
@@ -363,6 +1317,63 @@ type AttemptResponse struct {
 	Success *bool `form:"success,omitempty" json:"success,omitempty" xml:"success,omitempty"`
 	// Creation timestamp
 	CreatedAt *int64 `form:"created_at,omitempty" json:"created_at,omitempty" xml:"created_at,omitempty"`
+	// Execution outcome of the attempt, pending while it is graded in the
+	// background
+	Status *string `form:"status,omitempty" json:"status,omitempty" xml:"status,omitempty"`
+	// Result of running the attempt against each test of the exercise
+	TestResults []*AttemptTestResultResponse `form:"test_results,omitempty" json:"test_results,omitempty" xml:"test_results,omitempty"`
+	// Weight of the tests the attempt passed
+	Points *int32 `form:"points,omitempty" json:"points,omitempty" xml:"points,omitempty"`
+	// Weight of all the tests the attempt ran against
+	MaxPoints *int32 `form:"max_points,omitempty" json:"max_points,omitempty" xml:"max_points,omitempty"`
+	// Percentage of max_points earned
+	Score *float64 `form:"score,omitempty" json:"score,omitempty" xml:"score,omitempty"`
+	// Whether the attempt was made after the assignments of the exercise closed
+	Late *bool `form:"late,omitempty" json:"late,omitempty" xml:"late,omitempty"`
+	// Version of the exercise the attempt was graded against
+	ExerciseVersion *int32 `form:"exercise_version,omitempty" json:"exercise_version,omitempty" xml:"exercise_version,omitempty"`
+}
+
+// AttemptTestResultResponse is used to define fields on response body types.
+type AttemptTestResultResponse struct {
+	// Test ID, missing if the test was deleted
+	TestID *int64 `form:"test_id,omitempty" json:"test_id,omitempty" xml:"test_id,omitempty"`
+	// Outcome of the test
+	Status *string `form:"status,omitempty" json:"status,omitempty" xml:"status,omitempty"`
+	// Output produced by the submitted code
+	ActualOutput *string `form:"actual_output,omitempty" json:"actual_output,omitempty" xml:"actual_output,omitempty"`
+	// Expected output, only shown for public tests
+	ExpectedOutput *string `form:"expected_output,omitempty" json:"expected_output,omitempty" xml:"expected_output,omitempty"`
+	// Error raised while running the test
+	Error *string `form:"error,omitempty" json:"error,omitempty" xml:"error,omitempty"`
+	// Output written to the console while running the test, with warnings and
+	// errors prefixed by their level
+	ConsoleOutput *string `form:"console_output,omitempty" json:"console_output,omitempty" xml:"console_output,omitempty"`
+	// Time spent running the test in milliseconds
+	DurationMs *int64 `form:"duration_ms,omitempty" json:"duration_ms,omitempty" xml:"duration_ms,omitempty"`
+	// Stack trace through the submitted code when it threw, with positions in the
+	// submitted code
+	StackTrace *string `form:"stack_trace,omitempty" json:"stack_trace,omitempty" xml:"stack_trace,omitempty"`
+}
+
+// StudentHintResponse is used to define fields on response body types.
+type StudentHintResponse struct {
+	// Hint ID
+	ID *int64 `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Order in which the hint is revealed, starting at 1
+	Position *int32 `form:"position,omitempty" json:"position,omitempty" xml:"position,omitempty"`
+	// Whether the hint was revealed, can be requested next or is still locked
+	Status *string `form:"status,omitempty" json:"status,omitempty" xml:"status,omitempty"`
+	// Hint text, only sent once revealed
+	Content *string `form:"content,omitempty" json:"content,omitempty" xml:"content,omitempty"`
+	// Points taken off the score of the attempts made once the hint is revealed
+	Penalty *float64 `form:"penalty,omitempty" json:"penalty,omitempty" xml:"penalty,omitempty"`
+	// Failed attempts still needed to unlock the hint
+	AttemptsRemaining *int32 `form:"attempts_remaining,omitempty" json:"attempts_remaining,omitempty" xml:"attempts_remaining,omitempty"`
+	// Timestamp at which the hint unlocks by time
+	UnlocksAt *int64 `form:"unlocks_at,omitempty" json:"unlocks_at,omitempty" xml:"unlocks_at,omitempty"`
+	// Timestamp at which the hint was revealed
+	RevealedAt *int64 `form:"revealed_at,omitempty" json:"revealed_at,omitempty" xml:"revealed_at,omitempty"`
 }
 
 // NewCreateExerciseRequestBody builds the HTTP request body from the payload
@@ -375,6 +1386,19 @@ func NewCreateExerciseRequestBody(p *codelab.CreateExercisePayload) *CreateExerc
 		Solution:    p.Solution,
 		Difficulty:  p.Difficulty,
 		CreatedBy:   p.CreatedBy,
+		Language:    p.Language,
+	}
+	{
+		var zero string
+		if body.Language == zero {
+			body.Language = "javascript"
+		}
+	}
+	if p.Tags != nil {
+		body.Tags = make([]string, len(p.Tags))
+		for i, val := range p.Tags {
+			body.Tags[i] = val
+		}
 	}
 	return body
 }
@@ -389,14 +1413,51 @@ func NewUpdateExerciseRequestBody(p *codelab.UpdateExercisePayload2) *UpdateExer
 	return body
 }
 
+// NewImportExercisesRequestBody builds the HTTP request body from the payload
+// of the "ImportExercises" endpoint of the "codelab" service.
+func NewImportExercisesRequestBody(p *codelab.ImportExercisesPayload) *ImportExercisesRequestBody {
+	body := &ImportExercisesRequestBody{
+		Content: p.Content,
+	}
+	return body
+}
+
 // NewCreateTestRequestBody builds the HTTP request body from the payload of
 // the "CreateTest" endpoint of the "codelab" service.
 func NewCreateTestRequestBody(p *codelab.CreateTestPayload) *CreateTestRequestBody {
 	body := &CreateTestRequestBody{
-		Input:      p.Input,
-		Output:     p.Output,
-		Public:     p.Public,
-		ExerciseID: p.ExerciseID,
+		Input:       p.Input,
+		Output:      p.Output,
+		Public:      p.Public,
+		ExerciseID:  p.ExerciseID,
+		InputFormat: p.InputFormat,
+		Comparison:  p.Comparison,
+		Tolerance:   p.Tolerance,
+		Weight:      p.Weight,
+	}
+	{
+		var zero string
+		if body.InputFormat == zero {
+			body.InputFormat = "text"
+		}
+	}
+	{
+		var zero string
+		if body.Comparison == zero {
+			body.Comparison = "exact"
+		}
+	}
+	{
+		var zero float64
+		if body.Tolerance == zero {
+			body.Tolerance = 0
+		}
+	}
+	{
+		var zero int32
+		if body.Weight == zero {
+			body.Weight = 1
+		}
 	}
 	return body
 }
@@ -411,6 +1472,78 @@ func NewUpdateTestRequestBody(p *codelab.UpdateTestPayload2) *UpdateTestRequestB
 	return body
 }
 
+// NewCreateHintRequestBody builds the HTTP request body from the payload of
+// the "CreateHint" endpoint of the "codelab" service.
+func NewCreateHintRequestBody(p *codelab.CreateHintPayload) *CreateHintRequestBody {
+	body := &CreateHintRequestBody{
+		ExerciseID:          p.ExerciseID,
+		Content:             p.Content,
+		UnlockAfterAttempts: p.UnlockAfterAttempts,
+		UnlockAfterSeconds:  p.UnlockAfterSeconds,
+		Penalty:             p.Penalty,
+	}
+	{
+		var zero float64
+		if body.Penalty == zero {
+			body.Penalty = 0
+		}
+	}
+	return body
+}
+
+// NewUpdateHintRequestBody builds the HTTP request body from the payload of
+// the "UpdateHint" endpoint of the "codelab" service.
+func NewUpdateHintRequestBody(p *codelab.UpdateHintPayload2) *UpdateHintRequestBody {
+	body := &UpdateHintRequestBody{}
+	if p.Hint != nil {
+		body.Hint = marshalCodelabUpdateHintPayloadToUpdateHintPayloadRequestBody(p.Hint)
+	}
+	return body
+}
+
+// NewCreateAssignmentRequestBody builds the HTTP request body from the payload
+// of the "CreateAssignment" endpoint of the "codelab" service.
+func NewCreateAssignmentRequestBody(p *codelab.CreateAssignmentPayload) *CreateAssignmentRequestBody {
+	body := &CreateAssignmentRequestBody{
+		Title:       p.Title,
+		Description: p.Description,
+		OpensAt:     p.OpensAt,
+		ClosesAt:    p.ClosesAt,
+		AllowLate:   p.AllowLate,
+	}
+	{
+		var zero string
+		if body.Description == zero {
+			body.Description = ""
+		}
+	}
+	{
+		var zero bool
+		if body.AllowLate == zero {
+			body.AllowLate = false
+		}
+	}
+	if p.ExerciseIds != nil {
+		body.ExerciseIds = make([]int64, len(p.ExerciseIds))
+		for i, val := range p.ExerciseIds {
+			body.ExerciseIds[i] = val
+		}
+	} else {
+		body.ExerciseIds = []int64{}
+	}
+	return body
+}
+
+// NewUpdateAssignmentRequestBody builds the HTTP request body from the payload
+// of the "UpdateAssignment" endpoint of the "codelab" service.
+func NewUpdateAssignmentRequestBody(p *codelab.UpdateAssignmentPayload2) *UpdateAssignmentRequestBody {
+	body := &UpdateAssignmentRequestBody{}
+	if p.Assignment != nil {
+		body.Assignment = marshalCodelabUpdateAssignmentPayloadToUpdateAssignmentPayloadRequestBody(p.Assignment)
+	}
+	return body
+}
+
 // NewCreateAttemptRequestBody builds the HTTP request body from the payload of
 // the "CreateAttempt" endpoint of the "codelab" service.
 func NewCreateAttemptRequestBody(p *codelab.CreateAttemptPayload) *CreateAttemptRequestBody {
@@ -422,6 +1555,39 @@ func NewCreateAttemptRequestBody(p *codelab.CreateAttemptPayload) *CreateAttempt
 	return body
 }
 
+// NewSubmitAttemptRequestBody builds the HTTP request body from the payload of
+// the "SubmitAttempt" endpoint of the "codelab" service.
+func NewSubmitAttemptRequestBody(p *codelab.CreateAttemptPayload) *SubmitAttemptRequestBody {
+	body := &SubmitAttemptRequestBody{
+		ExerciseID: p.ExerciseID,
+		Code:       p.Code,
+		Success:    p.Success,
+	}
+	return body
+}
+
+// NewRunCodeRequestBody builds the HTTP request body from the payload of the
+// "RunCode" endpoint of the "codelab" service.
+func NewRunCodeRequestBody(p *codelab.RunCodePayload) *RunCodeRequestBody {
+	body := &RunCodeRequestBody{
+		Code:        p.Code,
+		InputFormat: p.InputFormat,
+	}
+	if p.Inputs != nil {
+		body.Inputs = make([]string, len(p.Inputs))
+		for i, val := range p.Inputs {
+			body.Inputs[i] = val
+		}
+	}
+	{
+		var zero string
+		if body.InputFormat == zero {
+			body.InputFormat = "text"
+		}
+	}
+	return body
+}
+
 // NewCreateExerciseSimpleResponseCreated builds a "codelab" service
 // "CreateExercise" endpoint result from a HTTP "Created" response.
 func NewCreateExerciseSimpleResponseCreated(body *CreateExerciseResponseBody) *codelab.SimpleResponse {
@@ -433,6 +1599,20 @@ func NewCreateExerciseSimpleResponseCreated(body *CreateExerciseResponseBody) *c
 	return v
 }
 
+// NewCreateExerciseSolutionFailed builds a codelab service CreateExercise
+// endpoint solution_failed error.
+func NewCreateExerciseSolutionFailed(body *CreateExerciseSolutionFailedResponseBody) *codelab.SolutionFailure {
+	v := &codelab.SolutionFailure{
+		Message: *body.Message,
+	}
+	v.FailingTests = make([]*codelab.SolutionTestFailure, len(body.FailingTests))
+	for i, val := range body.FailingTests {
+		v.FailingTests[i] = unmarshalSolutionTestFailureResponseBodyToCodelabSolutionTestFailure(val)
+	}
+
+	return v
+}
+
 // NewCreateExerciseInvalidInput builds a codelab service CreateExercise
 // endpoint invalid_input error.
 func NewCreateExerciseInvalidInput(body string) codelab.InvalidInput {
@@ -470,6 +1650,12 @@ func NewGetExerciseExerciseOK(body *GetExerciseResponseBody) *codelab.Exercise {
 		CreatedBy:   *body.CreatedBy,
 		CreatedAt:   *body.CreatedAt,
 		UpdatedAt:   *body.UpdatedAt,
+		Language:    *body.Language,
+		Version:     *body.Version,
+	}
+	v.Tags = make([]string, len(body.Tags))
+	for i, val := range body.Tags {
+		v.Tags[i] = val
 	}
 
 	return v
@@ -507,13 +1693,25 @@ func NewGetExerciseUnauthorized(body string) codelab.Unauthorized {
 	return v
 }
 
-// NewListExercisesExerciseOK builds a "codelab" service "ListExercises"
+// NewListExercisesExercisePageOK builds a "codelab" service "ListExercises"
 // endpoint result from a HTTP "OK" response.
-func NewListExercisesExerciseOK(body []*ExerciseResponse) []*codelab.Exercise {
+func NewListExercisesExercisePageOK(body []*Exercise, nextCursor *string) *codelab.ExercisePage {
 	v := make([]*codelab.Exercise, len(body))
 	for i, val := range body {
-		v[i] = unmarshalExerciseResponseToCodelabExercise(val)
+		v[i] = unmarshalExerciseToCodelabExercise(val)
+	}
+	res := &codelab.ExercisePage{
+		Exercises: v,
 	}
+	res.NextCursor = nextCursor
+
+	return res
+}
+
+// NewListExercisesInvalidInput builds a codelab service ListExercises endpoint
+// invalid_input error.
+func NewListExercisesInvalidInput(body string) codelab.InvalidInput {
+	v := codelab.InvalidInput(body)
 
 	return v
 }
@@ -550,6 +1748,33 @@ func NewListExercisesUnauthorized(body string) codelab.Unauthorized {
 	return v
 }
 
+// NewListTagsTagCountOK builds a "codelab" service "ListTags" endpoint result
+// from a HTTP "OK" response.
+func NewListTagsTagCountOK(body []*TagCountResponse) []*codelab.TagCount {
+	v := make([]*codelab.TagCount, len(body))
+	for i, val := range body {
+		v[i] = unmarshalTagCountResponseToCodelabTagCount(val)
+	}
+
+	return v
+}
+
+// NewListTagsServiceUnavailable builds a codelab service ListTags endpoint
+// service_unavailable error.
+func NewListTagsServiceUnavailable(body string) codelab.ServiceUnavailable {
+	v := codelab.ServiceUnavailable(body)
+
+	return v
+}
+
+// NewListTagsUnauthorized builds a codelab service ListTags endpoint
+// unauthorized error.
+func NewListTagsUnauthorized(body string) codelab.Unauthorized {
+	v := codelab.Unauthorized(body)
+
+	return v
+}
+
 // NewUpdateExerciseSimpleResponseOK builds a "codelab" service
 // "UpdateExercise" endpoint result from a HTTP "OK" response.
 func NewUpdateExerciseSimpleResponseOK(body *UpdateExerciseResponseBody) *codelab.SimpleResponse {
@@ -561,6 +1786,20 @@ func NewUpdateExerciseSimpleResponseOK(body *UpdateExerciseResponseBody) *codela
 	return v
 }
 
+// NewUpdateExerciseSolutionFailed builds a codelab service UpdateExercise
+// endpoint solution_failed error.
+func NewUpdateExerciseSolutionFailed(body *UpdateExerciseSolutionFailedResponseBody) *codelab.SolutionFailure {
+	v := &codelab.SolutionFailure{
+		Message: *body.Message,
+	}
+	v.FailingTests = make([]*codelab.SolutionTestFailure, len(body.FailingTests))
+	for i, val := range body.FailingTests {
+		v.FailingTests[i] = unmarshalSolutionTestFailureResponseBodyToCodelabSolutionTestFailure(val)
+	}
+
+	return v
+}
+
 // NewUpdateExerciseInvalidInput builds a codelab service UpdateExercise
 // endpoint invalid_input error.
 func NewUpdateExerciseInvalidInput(body string) codelab.InvalidInput {
@@ -652,79 +1891,206 @@ func NewDeleteExerciseUnauthorized(body string) codelab.Unauthorized {
 	return v
 }
 
-// NewCreateTestSimpleResponseCreated builds a "codelab" service "CreateTest"
-// endpoint result from a HTTP "Created" response.
-func NewCreateTestSimpleResponseCreated(body *CreateTestResponseBody) *codelab.SimpleResponse {
-	v := &codelab.SimpleResponse{
-		Success: *body.Success,
-		Message: *body.Message,
+// NewExportExercisesExerciseBundleFileOK builds a "codelab" service
+// "ExportExercises" endpoint result from a HTTP "OK" response.
+func NewExportExercisesExerciseBundleFileOK(body *ExportExercisesResponseBody) *codelab.ExerciseBundleFile {
+	v := &codelab.ExerciseBundleFile{
+		Filename: *body.Filename,
+		Format:   *body.Format,
+		Content:  body.Content,
 	}
 
 	return v
 }
 
-// NewCreateTestInvalidInput builds a codelab service CreateTest endpoint
-// invalid_input error.
-func NewCreateTestInvalidInput(body string) codelab.InvalidInput {
+// NewExportExercisesInvalidInput builds a codelab service ExportExercises
+// endpoint invalid_input error.
+func NewExportExercisesInvalidInput(body string) codelab.InvalidInput {
 	v := codelab.InvalidInput(body)
 
 	return v
 }
 
-// NewCreateTestPermissionDenied builds a codelab service CreateTest endpoint
-// permission_denied error.
-func NewCreateTestPermissionDenied(body string) codelab.PermissionDenied {
+// NewExportExercisesNotFound builds a codelab service ExportExercises endpoint
+// not_found error.
+func NewExportExercisesNotFound(body string) codelab.NotFound {
+	v := codelab.NotFound(body)
+
+	return v
+}
+
+// NewExportExercisesPermissionDenied builds a codelab service ExportExercises
+// endpoint permission_denied error.
+func NewExportExercisesPermissionDenied(body string) codelab.PermissionDenied {
 	v := codelab.PermissionDenied(body)
 
 	return v
 }
 
-// NewCreateTestServiceUnavailable builds a codelab service CreateTest endpoint
-// service_unavailable error.
-func NewCreateTestServiceUnavailable(body string) codelab.ServiceUnavailable {
+// NewExportExercisesServiceUnavailable builds a codelab service
+// ExportExercises endpoint service_unavailable error.
+func NewExportExercisesServiceUnavailable(body string) codelab.ServiceUnavailable {
 	v := codelab.ServiceUnavailable(body)
 
 	return v
 }
 
-// NewCreateTestUnauthorized builds a codelab service CreateTest endpoint
-// unauthorized error.
-func NewCreateTestUnauthorized(body string) codelab.Unauthorized {
+// NewExportExercisesUnauthorized builds a codelab service ExportExercises
+// endpoint unauthorized error.
+func NewExportExercisesUnauthorized(body string) codelab.Unauthorized {
 	v := codelab.Unauthorized(body)
 
 	return v
 }
 
-// NewGetTestsByExerciseTestOK builds a "codelab" service "GetTestsByExercise"
-// endpoint result from a HTTP "OK" response.
-func NewGetTestsByExerciseTestOK(body []*TestResponse) []*codelab.Test {
-	v := make([]*codelab.Test, len(body))
-	for i, val := range body {
-		v[i] = unmarshalTestResponseToCodelabTest(val)
+// NewImportExercisesImportedExercisesCreated builds a "codelab" service
+// "ImportExercises" endpoint result from a HTTP "Created" response.
+func NewImportExercisesImportedExercisesCreated(body *ImportExercisesResponseBody) *codelab.ImportedExercises {
+	v := &codelab.ImportedExercises{
+		Success: *body.Success,
+		Message: *body.Message,
+	}
+	v.ExerciseIds = make([]int64, len(body.ExerciseIds))
+	for i, val := range body.ExerciseIds {
+		v.ExerciseIds[i] = val
 	}
 
 	return v
 }
 
-// NewGetTestsByExerciseInvalidInput builds a codelab service
-// GetTestsByExercise endpoint invalid_input error.
-func NewGetTestsByExerciseInvalidInput(body string) codelab.InvalidInput {
-	v := codelab.InvalidInput(body)
+// NewImportExercisesSolutionFailed builds a codelab service ImportExercises
+// endpoint solution_failed error.
+func NewImportExercisesSolutionFailed(body *ImportExercisesSolutionFailedResponseBody) *codelab.SolutionFailure {
+	v := &codelab.SolutionFailure{
+		Message: *body.Message,
+	}
+	v.FailingTests = make([]*codelab.SolutionTestFailure, len(body.FailingTests))
+	for i, val := range body.FailingTests {
+		v.FailingTests[i] = unmarshalSolutionTestFailureResponseBodyToCodelabSolutionTestFailure(val)
+	}
 
 	return v
 }
 
-// NewGetTestsByExerciseNotFound builds a codelab service GetTestsByExercise
-// endpoint not_found error.
-func NewGetTestsByExerciseNotFound(body string) codelab.NotFound {
-	v := codelab.NotFound(body)
+// NewImportExercisesInvalidInput builds a codelab service ImportExercises
+// endpoint invalid_input error.
+func NewImportExercisesInvalidInput(body string) codelab.InvalidInput {
+	v := codelab.InvalidInput(body)
 
 	return v
 }
 
-// NewGetTestsByExercisePermissionDenied builds a codelab service
-// GetTestsByExercise endpoint permission_denied error.
-func NewGetTestsByExercisePermissionDenied(body string) codelab.PermissionDenied {
+// NewImportExercisesPermissionDenied builds a codelab service ImportExercises
+// endpoint permission_denied error.
+func NewImportExercisesPermissionDenied(body string) codelab.PermissionDenied {
+	v := codelab.PermissionDenied(body)
+
+	return v
+}
+
+// NewImportExercisesServiceUnavailable builds a codelab service
+// ImportExercises endpoint service_unavailable error.
+func NewImportExercisesServiceUnavailable(body string) codelab.ServiceUnavailable {
+	v := codelab.ServiceUnavailable(body)
+
+	return v
+}
+
+// NewImportExercisesUnauthorized builds a codelab service ImportExercises
+// endpoint unauthorized error.
+func NewImportExercisesUnauthorized(body string) codelab.Unauthorized {
+	v := codelab.Unauthorized(body)
+
+	return v
+}
+
+// NewCreateTestSimpleResponseCreated builds a "codelab" service "CreateTest"
+// endpoint result from a HTTP "Created" response.
+func NewCreateTestSimpleResponseCreated(body *CreateTestResponseBody) *codelab.SimpleResponse {
+	v := &codelab.SimpleResponse{
+		Success: *body.Success,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewCreateTestSolutionFailed builds a codelab service CreateTest endpoint
+// solution_failed error.
+func NewCreateTestSolutionFailed(body *CreateTestSolutionFailedResponseBody) *codelab.SolutionFailure {
+	v := &codelab.SolutionFailure{
+		Message: *body.Message,
+	}
+	v.FailingTests = make([]*codelab.SolutionTestFailure, len(body.FailingTests))
+	for i, val := range body.FailingTests {
+		v.FailingTests[i] = unmarshalSolutionTestFailureResponseBodyToCodelabSolutionTestFailure(val)
+	}
+
+	return v
+}
+
+// NewCreateTestInvalidInput builds a codelab service CreateTest endpoint
+// invalid_input error.
+func NewCreateTestInvalidInput(body string) codelab.InvalidInput {
+	v := codelab.InvalidInput(body)
+
+	return v
+}
+
+// NewCreateTestPermissionDenied builds a codelab service CreateTest endpoint
+// permission_denied error.
+func NewCreateTestPermissionDenied(body string) codelab.PermissionDenied {
+	v := codelab.PermissionDenied(body)
+
+	return v
+}
+
+// NewCreateTestServiceUnavailable builds a codelab service CreateTest endpoint
+// service_unavailable error.
+func NewCreateTestServiceUnavailable(body string) codelab.ServiceUnavailable {
+	v := codelab.ServiceUnavailable(body)
+
+	return v
+}
+
+// NewCreateTestUnauthorized builds a codelab service CreateTest endpoint
+// unauthorized error.
+func NewCreateTestUnauthorized(body string) codelab.Unauthorized {
+	v := codelab.Unauthorized(body)
+
+	return v
+}
+
+// NewGetTestsByExerciseTestOK builds a "codelab" service "GetTestsByExercise"
+// endpoint result from a HTTP "OK" response.
+func NewGetTestsByExerciseTestOK(body []*TestResponse) []*codelab.Test {
+	v := make([]*codelab.Test, len(body))
+	for i, val := range body {
+		v[i] = unmarshalTestResponseToCodelabTest(val)
+	}
+
+	return v
+}
+
+// NewGetTestsByExerciseInvalidInput builds a codelab service
+// GetTestsByExercise endpoint invalid_input error.
+func NewGetTestsByExerciseInvalidInput(body string) codelab.InvalidInput {
+	v := codelab.InvalidInput(body)
+
+	return v
+}
+
+// NewGetTestsByExerciseNotFound builds a codelab service GetTestsByExercise
+// endpoint not_found error.
+func NewGetTestsByExerciseNotFound(body string) codelab.NotFound {
+	v := codelab.NotFound(body)
+
+	return v
+}
+
+// NewGetTestsByExercisePermissionDenied builds a codelab service
+// GetTestsByExercise endpoint permission_denied error.
+func NewGetTestsByExercisePermissionDenied(body string) codelab.PermissionDenied {
 	v := codelab.PermissionDenied(body)
 
 	return v
@@ -757,6 +2123,20 @@ func NewUpdateTestSimpleResponseOK(body *UpdateTestResponseBody) *codelab.Simple
 	return v
 }
 
+// NewUpdateTestSolutionFailed builds a codelab service UpdateTest endpoint
+// solution_failed error.
+func NewUpdateTestSolutionFailed(body *UpdateTestSolutionFailedResponseBody) *codelab.SolutionFailure {
+	v := &codelab.SolutionFailure{
+		Message: *body.Message,
+	}
+	v.FailingTests = make([]*codelab.SolutionTestFailure, len(body.FailingTests))
+	for i, val := range body.FailingTests {
+		v.FailingTests[i] = unmarshalSolutionTestFailureResponseBodyToCodelabSolutionTestFailure(val)
+	}
+
+	return v
+}
+
 // NewUpdateTestInvalidInput builds a codelab service UpdateTest endpoint
 // invalid_input error.
 func NewUpdateTestInvalidInput(body string) codelab.InvalidInput {
@@ -848,126 +2228,120 @@ func NewDeleteTestUnauthorized(body string) codelab.Unauthorized {
 	return v
 }
 
-// NewGetExerciseForStudentExerciseForStudentsOK builds a "codelab" service
-// "GetExerciseForStudent" endpoint result from a HTTP "OK" response.
-func NewGetExerciseForStudentExerciseForStudentsOK(body *GetExerciseForStudentResponseBody) *codelab.ExerciseForStudents {
-	v := &codelab.ExerciseForStudents{
-		ID:          *body.ID,
-		Title:       *body.Title,
-		Description: *body.Description,
-		InitialCode: *body.InitialCode,
-		Difficulty:  *body.Difficulty,
-		CreatedBy:   *body.CreatedBy,
-		CreatedAt:   *body.CreatedAt,
-		UpdatedAt:   *body.UpdatedAt,
-	}
-	v.Tests = make([]*codelab.Test, len(body.Tests))
-	for i, val := range body.Tests {
-		v.Tests[i] = unmarshalTestResponseBodyToCodelabTest(val)
-	}
-	v.Attempts = make([]*codelab.Attempt, len(body.Attempts))
-	for i, val := range body.Attempts {
-		v.Attempts[i] = unmarshalAttemptResponseBodyToCodelabAttempt(val)
+// NewListExerciseVersionsExerciseVersionOK builds a "codelab" service
+// "ListExerciseVersions" endpoint result from a HTTP "OK" response.
+func NewListExerciseVersionsExerciseVersionOK(body []*ExerciseVersionResponse) []*codelab.ExerciseVersion {
+	v := make([]*codelab.ExerciseVersion, len(body))
+	for i, val := range body {
+		v[i] = unmarshalExerciseVersionResponseToCodelabExerciseVersion(val)
 	}
-	v.Answer = unmarshalAnswerResponseBodyToCodelabAnswer(body.Answer)
 
 	return v
 }
 
-// NewGetExerciseForStudentInvalidInput builds a codelab service
-// GetExerciseForStudent endpoint invalid_input error.
-func NewGetExerciseForStudentInvalidInput(body string) codelab.InvalidInput {
+// NewListExerciseVersionsInvalidInput builds a codelab service
+// ListExerciseVersions endpoint invalid_input error.
+func NewListExerciseVersionsInvalidInput(body string) codelab.InvalidInput {
 	v := codelab.InvalidInput(body)
 
 	return v
 }
 
-// NewGetExerciseForStudentNotFound builds a codelab service
-// GetExerciseForStudent endpoint not_found error.
-func NewGetExerciseForStudentNotFound(body string) codelab.NotFound {
+// NewListExerciseVersionsNotFound builds a codelab service
+// ListExerciseVersions endpoint not_found error.
+func NewListExerciseVersionsNotFound(body string) codelab.NotFound {
 	v := codelab.NotFound(body)
 
 	return v
 }
 
-// NewGetExerciseForStudentPermissionDenied builds a codelab service
-// GetExerciseForStudent endpoint permission_denied error.
-func NewGetExerciseForStudentPermissionDenied(body string) codelab.PermissionDenied {
+// NewListExerciseVersionsPermissionDenied builds a codelab service
+// ListExerciseVersions endpoint permission_denied error.
+func NewListExerciseVersionsPermissionDenied(body string) codelab.PermissionDenied {
 	v := codelab.PermissionDenied(body)
 
 	return v
 }
 
-// NewGetExerciseForStudentServiceUnavailable builds a codelab service
-// GetExerciseForStudent endpoint service_unavailable error.
-func NewGetExerciseForStudentServiceUnavailable(body string) codelab.ServiceUnavailable {
+// NewListExerciseVersionsServiceUnavailable builds a codelab service
+// ListExerciseVersions endpoint service_unavailable error.
+func NewListExerciseVersionsServiceUnavailable(body string) codelab.ServiceUnavailable {
 	v := codelab.ServiceUnavailable(body)
 
 	return v
 }
 
-// NewGetExerciseForStudentUnauthorized builds a codelab service
-// GetExerciseForStudent endpoint unauthorized error.
-func NewGetExerciseForStudentUnauthorized(body string) codelab.Unauthorized {
+// NewListExerciseVersionsUnauthorized builds a codelab service
+// ListExerciseVersions endpoint unauthorized error.
+func NewListExerciseVersionsUnauthorized(body string) codelab.Unauthorized {
 	v := codelab.Unauthorized(body)
 
 	return v
 }
 
-// NewListExercisesForStudentsExerciseForStudentsListViewOK builds a "codelab"
-// service "ListExercisesForStudents" endpoint result from a HTTP "OK" response.
-func NewListExercisesForStudentsExerciseForStudentsListViewOK(body []*ExerciseForStudentsListViewResponse) []*codelab.ExerciseForStudentsListView {
-	v := make([]*codelab.ExerciseForStudentsListView, len(body))
-	for i, val := range body {
-		v[i] = unmarshalExerciseForStudentsListViewResponseToCodelabExerciseForStudentsListView(val)
+// NewDiffExerciseVersionsExerciseVersionDiffOK builds a "codelab" service
+// "DiffExerciseVersions" endpoint result from a HTTP "OK" response.
+func NewDiffExerciseVersionsExerciseVersionDiffOK(body *DiffExerciseVersionsResponseBody) *codelab.ExerciseVersionDiff {
+	v := &codelab.ExerciseVersionDiff{
+		ExerciseID:  *body.ExerciseID,
+		FromVersion: *body.FromVersion,
+		ToVersion:   *body.ToVersion,
+	}
+	v.Fields = make([]*codelab.FieldChange, len(body.Fields))
+	for i, val := range body.Fields {
+		v.Fields[i] = unmarshalFieldChangeResponseBodyToCodelabFieldChange(val)
+	}
+	v.Tests = make([]*codelab.TestChange, len(body.Tests))
+	for i, val := range body.Tests {
+		v.Tests[i] = unmarshalTestChangeResponseBodyToCodelabTestChange(val)
 	}
 
 	return v
 }
 
-// NewListExercisesForStudentsInvalidInput builds a codelab service
-// ListExercisesForStudents endpoint invalid_input error.
-func NewListExercisesForStudentsInvalidInput(body string) codelab.InvalidInput {
+// NewDiffExerciseVersionsInvalidInput builds a codelab service
+// DiffExerciseVersions endpoint invalid_input error.
+func NewDiffExerciseVersionsInvalidInput(body string) codelab.InvalidInput {
 	v := codelab.InvalidInput(body)
 
 	return v
 }
 
-// NewListExercisesForStudentsNotFound builds a codelab service
-// ListExercisesForStudents endpoint not_found error.
-func NewListExercisesForStudentsNotFound(body string) codelab.NotFound {
+// NewDiffExerciseVersionsNotFound builds a codelab service
+// DiffExerciseVersions endpoint not_found error.
+func NewDiffExerciseVersionsNotFound(body string) codelab.NotFound {
 	v := codelab.NotFound(body)
 
 	return v
 }
 
-// NewListExercisesForStudentsPermissionDenied builds a codelab service
-// ListExercisesForStudents endpoint permission_denied error.
-func NewListExercisesForStudentsPermissionDenied(body string) codelab.PermissionDenied {
+// NewDiffExerciseVersionsPermissionDenied builds a codelab service
+// DiffExerciseVersions endpoint permission_denied error.
+func NewDiffExerciseVersionsPermissionDenied(body string) codelab.PermissionDenied {
 	v := codelab.PermissionDenied(body)
 
 	return v
 }
 
-// NewListExercisesForStudentsServiceUnavailable builds a codelab service
-// ListExercisesForStudents endpoint service_unavailable error.
-func NewListExercisesForStudentsServiceUnavailable(body string) codelab.ServiceUnavailable {
+// NewDiffExerciseVersionsServiceUnavailable builds a codelab service
+// DiffExerciseVersions endpoint service_unavailable error.
+func NewDiffExerciseVersionsServiceUnavailable(body string) codelab.ServiceUnavailable {
 	v := codelab.ServiceUnavailable(body)
 
 	return v
 }
 
-// NewListExercisesForStudentsUnauthorized builds a codelab service
-// ListExercisesForStudents endpoint unauthorized error.
-func NewListExercisesForStudentsUnauthorized(body string) codelab.Unauthorized {
+// NewDiffExerciseVersionsUnauthorized builds a codelab service
+// DiffExerciseVersions endpoint unauthorized error.
+func NewDiffExerciseVersionsUnauthorized(body string) codelab.Unauthorized {
 	v := codelab.Unauthorized(body)
 
 	return v
 }
 
-// NewCreateAttemptSimpleResponseCreated builds a "codelab" service
-// "CreateAttempt" endpoint result from a HTTP "Created" response.
-func NewCreateAttemptSimpleResponseCreated(body *CreateAttemptResponseBody) *codelab.SimpleResponse {
+// NewRollbackExerciseSimpleResponseOK builds a "codelab" service
+// "RollbackExercise" endpoint result from a HTTP "OK" response.
+func NewRollbackExerciseSimpleResponseOK(body *RollbackExerciseResponseBody) *codelab.SimpleResponse {
 	v := &codelab.SimpleResponse{
 		Success: *body.Success,
 		Message: *body.Message,
@@ -976,167 +2350,2571 @@ func NewCreateAttemptSimpleResponseCreated(body *CreateAttemptResponseBody) *cod
 	return v
 }
 
-// NewCreateAttemptInvalidInput builds a codelab service CreateAttempt endpoint
-// invalid_input error.
-func NewCreateAttemptInvalidInput(body string) codelab.InvalidInput {
+// NewRollbackExerciseSolutionFailed builds a codelab service RollbackExercise
+// endpoint solution_failed error.
+func NewRollbackExerciseSolutionFailed(body *RollbackExerciseSolutionFailedResponseBody) *codelab.SolutionFailure {
+	v := &codelab.SolutionFailure{
+		Message: *body.Message,
+	}
+	v.FailingTests = make([]*codelab.SolutionTestFailure, len(body.FailingTests))
+	for i, val := range body.FailingTests {
+		v.FailingTests[i] = unmarshalSolutionTestFailureResponseBodyToCodelabSolutionTestFailure(val)
+	}
+
+	return v
+}
+
+// NewRollbackExerciseInvalidInput builds a codelab service RollbackExercise
+// endpoint invalid_input error.
+func NewRollbackExerciseInvalidInput(body string) codelab.InvalidInput {
 	v := codelab.InvalidInput(body)
 
 	return v
 }
 
-// NewCreateAttemptNotFound builds a codelab service CreateAttempt endpoint
-// not_found error.
-func NewCreateAttemptNotFound(body string) codelab.NotFound {
+// NewRollbackExerciseNotFound builds a codelab service RollbackExercise
+// endpoint not_found error.
+func NewRollbackExerciseNotFound(body string) codelab.NotFound {
 	v := codelab.NotFound(body)
 
 	return v
 }
 
-// NewCreateAttemptPermissionDenied builds a codelab service CreateAttempt
-// endpoint permission_denied error.
-func NewCreateAttemptPermissionDenied(body string) codelab.PermissionDenied {
+// NewRollbackExercisePermissionDenied builds a codelab service
+// RollbackExercise endpoint permission_denied error.
+func NewRollbackExercisePermissionDenied(body string) codelab.PermissionDenied {
 	v := codelab.PermissionDenied(body)
 
 	return v
 }
 
-// NewCreateAttemptServiceUnavailable builds a codelab service CreateAttempt
-// endpoint service_unavailable error.
-func NewCreateAttemptServiceUnavailable(body string) codelab.ServiceUnavailable {
+// NewRollbackExerciseServiceUnavailable builds a codelab service
+// RollbackExercise endpoint service_unavailable error.
+func NewRollbackExerciseServiceUnavailable(body string) codelab.ServiceUnavailable {
 	v := codelab.ServiceUnavailable(body)
 
 	return v
 }
 
-// NewCreateAttemptUnauthorized builds a codelab service CreateAttempt endpoint
-// unauthorized error.
-func NewCreateAttemptUnauthorized(body string) codelab.Unauthorized {
+// NewRollbackExerciseUnauthorized builds a codelab service RollbackExercise
+// endpoint unauthorized error.
+func NewRollbackExerciseUnauthorized(body string) codelab.Unauthorized {
 	v := codelab.Unauthorized(body)
 
 	return v
 }
 
-// NewGetAttemptsByUserAndExerciseAttemptOK builds a "codelab" service
-// "GetAttemptsByUserAndExercise" endpoint result from a HTTP "OK" response.
-func NewGetAttemptsByUserAndExerciseAttemptOK(body []*AttemptResponse) []*codelab.Attempt {
-	v := make([]*codelab.Attempt, len(body))
-	for i, val := range body {
-		v[i] = unmarshalAttemptResponseToCodelabAttempt(val)
+// NewRegradeExerciseRegradeAccepted builds a "codelab" service
+// "RegradeExercise" endpoint result from a HTTP "Accepted" response.
+func NewRegradeExerciseRegradeAccepted(body *RegradeExerciseResponseBody) *codelab.Regrade {
+	v := &codelab.Regrade{
+		ID:                  *body.ID,
+		ExerciseID:          *body.ExerciseID,
+		ExerciseVersion:     *body.ExerciseVersion,
+		Status:              *body.Status,
+		TotalAttempts:       *body.TotalAttempts,
+		RegradedAttempts:    *body.RegradedAttempts,
+		ErroredAttempts:     *body.ErroredAttempts,
+		NewlyPassedAttempts: *body.NewlyPassedAttempts,
+		NewlyFailedAttempts: *body.NewlyFailedAttempts,
+		Error:               body.Error,
+		CreatedBy:           *body.CreatedBy,
+		CreatedAt:           *body.CreatedAt,
+		FinishedAt:          body.FinishedAt,
+	}
+	v.NewlyPassed = make([]*codelab.RegradeStudentChange, len(body.NewlyPassed))
+	for i, val := range body.NewlyPassed {
+		v.NewlyPassed[i] = unmarshalRegradeStudentChangeResponseBodyToCodelabRegradeStudentChange(val)
+	}
+	v.NewlyFailed = make([]*codelab.RegradeStudentChange, len(body.NewlyFailed))
+	for i, val := range body.NewlyFailed {
+		v.NewlyFailed[i] = unmarshalRegradeStudentChangeResponseBodyToCodelabRegradeStudentChange(val)
 	}
 
 	return v
 }
 
-// NewGetAttemptsByUserAndExerciseInvalidInput builds a codelab service
-// GetAttemptsByUserAndExercise endpoint invalid_input error.
-func NewGetAttemptsByUserAndExerciseInvalidInput(body string) codelab.InvalidInput {
+// NewRegradeExerciseInvalidInput builds a codelab service RegradeExercise
+// endpoint invalid_input error.
+func NewRegradeExerciseInvalidInput(body string) codelab.InvalidInput {
 	v := codelab.InvalidInput(body)
 
 	return v
 }
 
-// NewGetAttemptsByUserAndExerciseNotFound builds a codelab service
-// GetAttemptsByUserAndExercise endpoint not_found error.
-func NewGetAttemptsByUserAndExerciseNotFound(body string) codelab.NotFound {
+// NewRegradeExerciseNotFound builds a codelab service RegradeExercise endpoint
+// not_found error.
+func NewRegradeExerciseNotFound(body string) codelab.NotFound {
 	v := codelab.NotFound(body)
 
 	return v
 }
 
-// NewGetAttemptsByUserAndExercisePermissionDenied builds a codelab service
-// GetAttemptsByUserAndExercise endpoint permission_denied error.
-func NewGetAttemptsByUserAndExercisePermissionDenied(body string) codelab.PermissionDenied {
+// NewRegradeExercisePermissionDenied builds a codelab service RegradeExercise
+// endpoint permission_denied error.
+func NewRegradeExercisePermissionDenied(body string) codelab.PermissionDenied {
 	v := codelab.PermissionDenied(body)
 
 	return v
 }
 
-// NewGetAttemptsByUserAndExerciseServiceUnavailable builds a codelab service
-// GetAttemptsByUserAndExercise endpoint service_unavailable error.
-func NewGetAttemptsByUserAndExerciseServiceUnavailable(body string) codelab.ServiceUnavailable {
+// NewRegradeExerciseServiceUnavailable builds a codelab service
+// RegradeExercise endpoint service_unavailable error.
+func NewRegradeExerciseServiceUnavailable(body string) codelab.ServiceUnavailable {
 	v := codelab.ServiceUnavailable(body)
 
 	return v
 }
 
-// NewGetAttemptsByUserAndExerciseUnauthorized builds a codelab service
-// GetAttemptsByUserAndExercise endpoint unauthorized error.
-func NewGetAttemptsByUserAndExerciseUnauthorized(body string) codelab.Unauthorized {
+// NewRegradeExerciseUnauthorized builds a codelab service RegradeExercise
+// endpoint unauthorized error.
+func NewRegradeExerciseUnauthorized(body string) codelab.Unauthorized {
 	v := codelab.Unauthorized(body)
 
 	return v
 }
 
-// NewGetAnswerByUserAndExerciseAnswerOK builds a "codelab" service
-// "GetAnswerByUserAndExercise" endpoint result from a HTTP "OK" response.
-func NewGetAnswerByUserAndExerciseAnswerOK(body *GetAnswerByUserAndExerciseResponseBody) *codelab.Answer {
-	v := &codelab.Answer{
-		ID:         *body.ID,
-		ExerciseID: *body.ExerciseID,
-		UserID:     *body.UserID,
-		Completed:  *body.Completed,
-		CreatedAt:  *body.CreatedAt,
-		UpdatedAt:  *body.UpdatedAt,
+// NewGetRegradeRegradeOK builds a "codelab" service "GetRegrade" endpoint
+// result from a HTTP "OK" response.
+func NewGetRegradeRegradeOK(body *GetRegradeResponseBody) *codelab.Regrade {
+	v := &codelab.Regrade{
+		ID:                  *body.ID,
+		ExerciseID:          *body.ExerciseID,
+		ExerciseVersion:     *body.ExerciseVersion,
+		Status:              *body.Status,
+		TotalAttempts:       *body.TotalAttempts,
+		RegradedAttempts:    *body.RegradedAttempts,
+		ErroredAttempts:     *body.ErroredAttempts,
+		NewlyPassedAttempts: *body.NewlyPassedAttempts,
+		NewlyFailedAttempts: *body.NewlyFailedAttempts,
+		Error:               body.Error,
+		CreatedBy:           *body.CreatedBy,
+		CreatedAt:           *body.CreatedAt,
+		FinishedAt:          body.FinishedAt,
+	}
+	v.NewlyPassed = make([]*codelab.RegradeStudentChange, len(body.NewlyPassed))
+	for i, val := range body.NewlyPassed {
+		v.NewlyPassed[i] = unmarshalRegradeStudentChangeResponseBodyToCodelabRegradeStudentChange(val)
+	}
+	v.NewlyFailed = make([]*codelab.RegradeStudentChange, len(body.NewlyFailed))
+	for i, val := range body.NewlyFailed {
+		v.NewlyFailed[i] = unmarshalRegradeStudentChangeResponseBodyToCodelabRegradeStudentChange(val)
 	}
 
 	return v
 }
 
-// NewGetAnswerByUserAndExerciseInvalidInput builds a codelab service
-// GetAnswerByUserAndExercise endpoint invalid_input error.
-func NewGetAnswerByUserAndExerciseInvalidInput(body string) codelab.InvalidInput {
+// NewGetRegradeInvalidInput builds a codelab service GetRegrade endpoint
+// invalid_input error.
+func NewGetRegradeInvalidInput(body string) codelab.InvalidInput {
 	v := codelab.InvalidInput(body)
 
 	return v
 }
 
-// NewGetAnswerByUserAndExerciseNotFound builds a codelab service
-// GetAnswerByUserAndExercise endpoint not_found error.
-func NewGetAnswerByUserAndExerciseNotFound(body string) codelab.NotFound {
+// NewGetRegradeNotFound builds a codelab service GetRegrade endpoint not_found
+// error.
+func NewGetRegradeNotFound(body string) codelab.NotFound {
 	v := codelab.NotFound(body)
 
 	return v
 }
 
-// NewGetAnswerByUserAndExercisePermissionDenied builds a codelab service
-// GetAnswerByUserAndExercise endpoint permission_denied error.
-func NewGetAnswerByUserAndExercisePermissionDenied(body string) codelab.PermissionDenied {
+// NewGetRegradePermissionDenied builds a codelab service GetRegrade endpoint
+// permission_denied error.
+func NewGetRegradePermissionDenied(body string) codelab.PermissionDenied {
 	v := codelab.PermissionDenied(body)
 
 	return v
 }
 
-// NewGetAnswerByUserAndExerciseServiceUnavailable builds a codelab service
-// GetAnswerByUserAndExercise endpoint service_unavailable error.
-func NewGetAnswerByUserAndExerciseServiceUnavailable(body string) codelab.ServiceUnavailable {
+// NewGetRegradeServiceUnavailable builds a codelab service GetRegrade endpoint
+// service_unavailable error.
+func NewGetRegradeServiceUnavailable(body string) codelab.ServiceUnavailable {
 	v := codelab.ServiceUnavailable(body)
 
 	return v
 }
 
-// NewGetAnswerByUserAndExerciseUnauthorized builds a codelab service
-// GetAnswerByUserAndExercise endpoint unauthorized error.
-func NewGetAnswerByUserAndExerciseUnauthorized(body string) codelab.Unauthorized {
+// NewGetRegradeUnauthorized builds a codelab service GetRegrade endpoint
+// unauthorized error.
+func NewGetRegradeUnauthorized(body string) codelab.Unauthorized {
 	v := codelab.Unauthorized(body)
 
 	return v
 }
 
-// ValidateCreateExerciseResponseBody runs the validations defined on
-// CreateExerciseResponseBody
-func ValidateCreateExerciseResponseBody(body *CreateExerciseResponseBody) (err error) {
-	if body.Success == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("success", "body"))
+// NewCreateHintSimpleResponseCreated builds a "codelab" service "CreateHint"
+// endpoint result from a HTTP "Created" response.
+func NewCreateHintSimpleResponseCreated(body *CreateHintResponseBody) *codelab.SimpleResponse {
+	v := &codelab.SimpleResponse{
+		Success: *body.Success,
+		Message: *body.Message,
 	}
-	if body.Message == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+
+	return v
+}
+
+// NewCreateHintInvalidInput builds a codelab service CreateHint endpoint
+// invalid_input error.
+func NewCreateHintInvalidInput(body string) codelab.InvalidInput {
+	v := codelab.InvalidInput(body)
+
+	return v
+}
+
+// NewCreateHintNotFound builds a codelab service CreateHint endpoint not_found
+// error.
+func NewCreateHintNotFound(body string) codelab.NotFound {
+	v := codelab.NotFound(body)
+
+	return v
+}
+
+// NewCreateHintPermissionDenied builds a codelab service CreateHint endpoint
+// permission_denied error.
+func NewCreateHintPermissionDenied(body string) codelab.PermissionDenied {
+	v := codelab.PermissionDenied(body)
+
+	return v
+}
+
+// NewCreateHintServiceUnavailable builds a codelab service CreateHint endpoint
+// service_unavailable error.
+func NewCreateHintServiceUnavailable(body string) codelab.ServiceUnavailable {
+	v := codelab.ServiceUnavailable(body)
+
+	return v
+}
+
+// NewCreateHintUnauthorized builds a codelab service CreateHint endpoint
+// unauthorized error.
+func NewCreateHintUnauthorized(body string) codelab.Unauthorized {
+	v := codelab.Unauthorized(body)
+
+	return v
+}
+
+// NewGetHintsByExerciseHintOK builds a "codelab" service "GetHintsByExercise"
+// endpoint result from a HTTP "OK" response.
+func NewGetHintsByExerciseHintOK(body []*HintResponse) []*codelab.Hint {
+	v := make([]*codelab.Hint, len(body))
+	for i, val := range body {
+		v[i] = unmarshalHintResponseToCodelabHint(val)
+	}
+
+	return v
+}
+
+// NewGetHintsByExerciseInvalidInput builds a codelab service
+// GetHintsByExercise endpoint invalid_input error.
+func NewGetHintsByExerciseInvalidInput(body string) codelab.InvalidInput {
+	v := codelab.InvalidInput(body)
+
+	return v
+}
+
+// NewGetHintsByExerciseNotFound builds a codelab service GetHintsByExercise
+// endpoint not_found error.
+func NewGetHintsByExerciseNotFound(body string) codelab.NotFound {
+	v := codelab.NotFound(body)
+
+	return v
+}
+
+// NewGetHintsByExercisePermissionDenied builds a codelab service
+// GetHintsByExercise endpoint permission_denied error.
+func NewGetHintsByExercisePermissionDenied(body string) codelab.PermissionDenied {
+	v := codelab.PermissionDenied(body)
+
+	return v
+}
+
+// NewGetHintsByExerciseServiceUnavailable builds a codelab service
+// GetHintsByExercise endpoint service_unavailable error.
+func NewGetHintsByExerciseServiceUnavailable(body string) codelab.ServiceUnavailable {
+	v := codelab.ServiceUnavailable(body)
+
+	return v
+}
+
+// NewGetHintsByExerciseUnauthorized builds a codelab service
+// GetHintsByExercise endpoint unauthorized error.
+func NewGetHintsByExerciseUnauthorized(body string) codelab.Unauthorized {
+	v := codelab.Unauthorized(body)
+
+	return v
+}
+
+// NewUpdateHintSimpleResponseOK builds a "codelab" service "UpdateHint"
+// endpoint result from a HTTP "OK" response.
+func NewUpdateHintSimpleResponseOK(body *UpdateHintResponseBody) *codelab.SimpleResponse {
+	v := &codelab.SimpleResponse{
+		Success: *body.Success,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewUpdateHintInvalidInput builds a codelab service UpdateHint endpoint
+// invalid_input error.
+func NewUpdateHintInvalidInput(body string) codelab.InvalidInput {
+	v := codelab.InvalidInput(body)
+
+	return v
+}
+
+// NewUpdateHintNotFound builds a codelab service UpdateHint endpoint not_found
+// error.
+func NewUpdateHintNotFound(body string) codelab.NotFound {
+	v := codelab.NotFound(body)
+
+	return v
+}
+
+// NewUpdateHintPermissionDenied builds a codelab service UpdateHint endpoint
+// permission_denied error.
+func NewUpdateHintPermissionDenied(body string) codelab.PermissionDenied {
+	v := codelab.PermissionDenied(body)
+
+	return v
+}
+
+// NewUpdateHintServiceUnavailable builds a codelab service UpdateHint endpoint
+// service_unavailable error.
+func NewUpdateHintServiceUnavailable(body string) codelab.ServiceUnavailable {
+	v := codelab.ServiceUnavailable(body)
+
+	return v
+}
+
+// NewUpdateHintUnauthorized builds a codelab service UpdateHint endpoint
+// unauthorized error.
+func NewUpdateHintUnauthorized(body string) codelab.Unauthorized {
+	v := codelab.Unauthorized(body)
+
+	return v
+}
+
+// NewDeleteHintSimpleResponseOK builds a "codelab" service "DeleteHint"
+// endpoint result from a HTTP "OK" response.
+func NewDeleteHintSimpleResponseOK(body *DeleteHintResponseBody) *codelab.SimpleResponse {
+	v := &codelab.SimpleResponse{
+		Success: *body.Success,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewDeleteHintInvalidInput builds a codelab service DeleteHint endpoint
+// invalid_input error.
+func NewDeleteHintInvalidInput(body string) codelab.InvalidInput {
+	v := codelab.InvalidInput(body)
+
+	return v
+}
+
+// NewDeleteHintNotFound builds a codelab service DeleteHint endpoint not_found
+// error.
+func NewDeleteHintNotFound(body string) codelab.NotFound {
+	v := codelab.NotFound(body)
+
+	return v
+}
+
+// NewDeleteHintPermissionDenied builds a codelab service DeleteHint endpoint
+// permission_denied error.
+func NewDeleteHintPermissionDenied(body string) codelab.PermissionDenied {
+	v := codelab.PermissionDenied(body)
+
+	return v
+}
+
+// NewDeleteHintServiceUnavailable builds a codelab service DeleteHint endpoint
+// service_unavailable error.
+func NewDeleteHintServiceUnavailable(body string) codelab.ServiceUnavailable {
+	v := codelab.ServiceUnavailable(body)
+
+	return v
+}
+
+// NewDeleteHintUnauthorized builds a codelab service DeleteHint endpoint
+// unauthorized error.
+func NewDeleteHintUnauthorized(body string) codelab.Unauthorized {
+	v := codelab.Unauthorized(body)
+
+	return v
+}
+
+// NewCreateAssignmentSimpleResponseCreated builds a "codelab" service
+// "CreateAssignment" endpoint result from a HTTP "Created" response.
+func NewCreateAssignmentSimpleResponseCreated(body *CreateAssignmentResponseBody) *codelab.SimpleResponse {
+	v := &codelab.SimpleResponse{
+		Success: *body.Success,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewCreateAssignmentInvalidInput builds a codelab service CreateAssignment
+// endpoint invalid_input error.
+func NewCreateAssignmentInvalidInput(body string) codelab.InvalidInput {
+	v := codelab.InvalidInput(body)
+
+	return v
+}
+
+// NewCreateAssignmentNotFound builds a codelab service CreateAssignment
+// endpoint not_found error.
+func NewCreateAssignmentNotFound(body string) codelab.NotFound {
+	v := codelab.NotFound(body)
+
+	return v
+}
+
+// NewCreateAssignmentPermissionDenied builds a codelab service
+// CreateAssignment endpoint permission_denied error.
+func NewCreateAssignmentPermissionDenied(body string) codelab.PermissionDenied {
+	v := codelab.PermissionDenied(body)
+
+	return v
+}
+
+// NewCreateAssignmentServiceUnavailable builds a codelab service
+// CreateAssignment endpoint service_unavailable error.
+func NewCreateAssignmentServiceUnavailable(body string) codelab.ServiceUnavailable {
+	v := codelab.ServiceUnavailable(body)
+
+	return v
+}
+
+// NewCreateAssignmentUnauthorized builds a codelab service CreateAssignment
+// endpoint unauthorized error.
+func NewCreateAssignmentUnauthorized(body string) codelab.Unauthorized {
+	v := codelab.Unauthorized(body)
+
+	return v
+}
+
+// NewGetAssignmentAssignmentOK builds a "codelab" service "GetAssignment"
+// endpoint result from a HTTP "OK" response.
+func NewGetAssignmentAssignmentOK(body *GetAssignmentResponseBody) *codelab.Assignment {
+	v := &codelab.Assignment{
+		ID:          *body.ID,
+		Title:       *body.Title,
+		Description: *body.Description,
+		OpensAt:     *body.OpensAt,
+		ClosesAt:    *body.ClosesAt,
+		AllowLate:   *body.AllowLate,
+		Status:      *body.Status,
+		CreatedBy:   *body.CreatedBy,
+		CreatedAt:   *body.CreatedAt,
+		UpdatedAt:   *body.UpdatedAt,
+	}
+	v.Exercises = make([]*codelab.AssignmentExercise, len(body.Exercises))
+	for i, val := range body.Exercises {
+		v.Exercises[i] = unmarshalAssignmentExerciseResponseBodyToCodelabAssignmentExercise(val)
+	}
+
+	return v
+}
+
+// NewGetAssignmentInvalidInput builds a codelab service GetAssignment endpoint
+// invalid_input error.
+func NewGetAssignmentInvalidInput(body string) codelab.InvalidInput {
+	v := codelab.InvalidInput(body)
+
+	return v
+}
+
+// NewGetAssignmentNotFound builds a codelab service GetAssignment endpoint
+// not_found error.
+func NewGetAssignmentNotFound(body string) codelab.NotFound {
+	v := codelab.NotFound(body)
+
+	return v
+}
+
+// NewGetAssignmentPermissionDenied builds a codelab service GetAssignment
+// endpoint permission_denied error.
+func NewGetAssignmentPermissionDenied(body string) codelab.PermissionDenied {
+	v := codelab.PermissionDenied(body)
+
+	return v
+}
+
+// NewGetAssignmentServiceUnavailable builds a codelab service GetAssignment
+// endpoint service_unavailable error.
+func NewGetAssignmentServiceUnavailable(body string) codelab.ServiceUnavailable {
+	v := codelab.ServiceUnavailable(body)
+
+	return v
+}
+
+// NewGetAssignmentUnauthorized builds a codelab service GetAssignment endpoint
+// unauthorized error.
+func NewGetAssignmentUnauthorized(body string) codelab.Unauthorized {
+	v := codelab.Unauthorized(body)
+
+	return v
+}
+
+// NewListAssignmentsAssignmentOK builds a "codelab" service "ListAssignments"
+// endpoint result from a HTTP "OK" response.
+func NewListAssignmentsAssignmentOK(body []*AssignmentResponse) []*codelab.Assignment {
+	v := make([]*codelab.Assignment, len(body))
+	for i, val := range body {
+		v[i] = unmarshalAssignmentResponseToCodelabAssignment(val)
+	}
+
+	return v
+}
+
+// NewListAssignmentsInvalidInput builds a codelab service ListAssignments
+// endpoint invalid_input error.
+func NewListAssignmentsInvalidInput(body string) codelab.InvalidInput {
+	v := codelab.InvalidInput(body)
+
+	return v
+}
+
+// NewListAssignmentsPermissionDenied builds a codelab service ListAssignments
+// endpoint permission_denied error.
+func NewListAssignmentsPermissionDenied(body string) codelab.PermissionDenied {
+	v := codelab.PermissionDenied(body)
+
+	return v
+}
+
+// NewListAssignmentsServiceUnavailable builds a codelab service
+// ListAssignments endpoint service_unavailable error.
+func NewListAssignmentsServiceUnavailable(body string) codelab.ServiceUnavailable {
+	v := codelab.ServiceUnavailable(body)
+
+	return v
+}
+
+// NewListAssignmentsUnauthorized builds a codelab service ListAssignments
+// endpoint unauthorized error.
+func NewListAssignmentsUnauthorized(body string) codelab.Unauthorized {
+	v := codelab.Unauthorized(body)
+
+	return v
+}
+
+// NewUpdateAssignmentSimpleResponseOK builds a "codelab" service
+// "UpdateAssignment" endpoint result from a HTTP "OK" response.
+func NewUpdateAssignmentSimpleResponseOK(body *UpdateAssignmentResponseBody) *codelab.SimpleResponse {
+	v := &codelab.SimpleResponse{
+		Success: *body.Success,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewUpdateAssignmentInvalidInput builds a codelab service UpdateAssignment
+// endpoint invalid_input error.
+func NewUpdateAssignmentInvalidInput(body string) codelab.InvalidInput {
+	v := codelab.InvalidInput(body)
+
+	return v
+}
+
+// NewUpdateAssignmentNotFound builds a codelab service UpdateAssignment
+// endpoint not_found error.
+func NewUpdateAssignmentNotFound(body string) codelab.NotFound {
+	v := codelab.NotFound(body)
+
+	return v
+}
+
+// NewUpdateAssignmentPermissionDenied builds a codelab service
+// UpdateAssignment endpoint permission_denied error.
+func NewUpdateAssignmentPermissionDenied(body string) codelab.PermissionDenied {
+	v := codelab.PermissionDenied(body)
+
+	return v
+}
+
+// NewUpdateAssignmentServiceUnavailable builds a codelab service
+// UpdateAssignment endpoint service_unavailable error.
+func NewUpdateAssignmentServiceUnavailable(body string) codelab.ServiceUnavailable {
+	v := codelab.ServiceUnavailable(body)
+
+	return v
+}
+
+// NewUpdateAssignmentUnauthorized builds a codelab service UpdateAssignment
+// endpoint unauthorized error.
+func NewUpdateAssignmentUnauthorized(body string) codelab.Unauthorized {
+	v := codelab.Unauthorized(body)
+
+	return v
+}
+
+// NewDeleteAssignmentSimpleResponseOK builds a "codelab" service
+// "DeleteAssignment" endpoint result from a HTTP "OK" response.
+func NewDeleteAssignmentSimpleResponseOK(body *DeleteAssignmentResponseBody) *codelab.SimpleResponse {
+	v := &codelab.SimpleResponse{
+		Success: *body.Success,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewDeleteAssignmentInvalidInput builds a codelab service DeleteAssignment
+// endpoint invalid_input error.
+func NewDeleteAssignmentInvalidInput(body string) codelab.InvalidInput {
+	v := codelab.InvalidInput(body)
+
+	return v
+}
+
+// NewDeleteAssignmentNotFound builds a codelab service DeleteAssignment
+// endpoint not_found error.
+func NewDeleteAssignmentNotFound(body string) codelab.NotFound {
+	v := codelab.NotFound(body)
+
+	return v
+}
+
+// NewDeleteAssignmentPermissionDenied builds a codelab service
+// DeleteAssignment endpoint permission_denied error.
+func NewDeleteAssignmentPermissionDenied(body string) codelab.PermissionDenied {
+	v := codelab.PermissionDenied(body)
+
+	return v
+}
+
+// NewDeleteAssignmentServiceUnavailable builds a codelab service
+// DeleteAssignment endpoint service_unavailable error.
+func NewDeleteAssignmentServiceUnavailable(body string) codelab.ServiceUnavailable {
+	v := codelab.ServiceUnavailable(body)
+
+	return v
+}
+
+// NewDeleteAssignmentUnauthorized builds a codelab service DeleteAssignment
+// endpoint unauthorized error.
+func NewDeleteAssignmentUnauthorized(body string) codelab.Unauthorized {
+	v := codelab.Unauthorized(body)
+
+	return v
+}
+
+// NewGetAssignmentGradebookAssignmentGradebookOK builds a "codelab" service
+// "GetAssignmentGradebook" endpoint result from a HTTP "OK" response.
+func NewGetAssignmentGradebookAssignmentGradebookOK(body *GetAssignmentGradebookResponseBody) *codelab.AssignmentGradebook {
+	v := &codelab.AssignmentGradebook{}
+	v.Assignment = unmarshalAssignmentResponseBodyToCodelabAssignment(body.Assignment)
+	v.Students = make([]*codelab.StudentAssignmentGrade, len(body.Students))
+	for i, val := range body.Students {
+		v.Students[i] = unmarshalStudentAssignmentGradeResponseBodyToCodelabStudentAssignmentGrade(val)
+	}
+
+	return v
+}
+
+// NewGetAssignmentGradebookInvalidInput builds a codelab service
+// GetAssignmentGradebook endpoint invalid_input error.
+func NewGetAssignmentGradebookInvalidInput(body string) codelab.InvalidInput {
+	v := codelab.InvalidInput(body)
+
+	return v
+}
+
+// NewGetAssignmentGradebookNotFound builds a codelab service
+// GetAssignmentGradebook endpoint not_found error.
+func NewGetAssignmentGradebookNotFound(body string) codelab.NotFound {
+	v := codelab.NotFound(body)
+
+	return v
+}
+
+// NewGetAssignmentGradebookPermissionDenied builds a codelab service
+// GetAssignmentGradebook endpoint permission_denied error.
+func NewGetAssignmentGradebookPermissionDenied(body string) codelab.PermissionDenied {
+	v := codelab.PermissionDenied(body)
+
+	return v
+}
+
+// NewGetAssignmentGradebookServiceUnavailable builds a codelab service
+// GetAssignmentGradebook endpoint service_unavailable error.
+func NewGetAssignmentGradebookServiceUnavailable(body string) codelab.ServiceUnavailable {
+	v := codelab.ServiceUnavailable(body)
+
+	return v
+}
+
+// NewGetAssignmentGradebookUnauthorized builds a codelab service
+// GetAssignmentGradebook endpoint unauthorized error.
+func NewGetAssignmentGradebookUnauthorized(body string) codelab.Unauthorized {
+	v := codelab.Unauthorized(body)
+
+	return v
+}
+
+// NewGetExerciseStatsExerciseStatsOK builds a "codelab" service
+// "GetExerciseStats" endpoint result from a HTTP "OK" response.
+func NewGetExerciseStatsExerciseStatsOK(body *GetExerciseStatsResponseBody) *codelab.ExerciseStats {
+	v := &codelab.ExerciseStats{
+		ExerciseID:               *body.ExerciseID,
+		TotalStudents:            *body.TotalStudents,
+		CompletedStudents:        *body.CompletedStudents,
+		CompletionRate:           *body.CompletionRate,
+		TotalAttempts:            *body.TotalAttempts,
+		SuccessfulAttempts:       *body.SuccessfulAttempts,
+		MedianAttemptsToSuccess:  body.MedianAttemptsToSuccess,
+		MedianTimeToCompletionMs: body.MedianTimeToCompletionMs,
+		HintsUsed:                *body.HintsUsed,
+	}
+	if body.MostFailedTest != nil {
+		v.MostFailedTest = unmarshalFailingTestResponseBodyToCodelabFailingTest(body.MostFailedTest)
+	}
+	v.Students = make([]*codelab.StudentExerciseStatus, len(body.Students))
+	for i, val := range body.Students {
+		v.Students[i] = unmarshalStudentExerciseStatusResponseBodyToCodelabStudentExerciseStatus(val)
+	}
+
+	return v
+}
+
+// NewGetExerciseStatsInvalidInput builds a codelab service GetExerciseStats
+// endpoint invalid_input error.
+func NewGetExerciseStatsInvalidInput(body string) codelab.InvalidInput {
+	v := codelab.InvalidInput(body)
+
+	return v
+}
+
+// NewGetExerciseStatsNotFound builds a codelab service GetExerciseStats
+// endpoint not_found error.
+func NewGetExerciseStatsNotFound(body string) codelab.NotFound {
+	v := codelab.NotFound(body)
+
+	return v
+}
+
+// NewGetExerciseStatsPermissionDenied builds a codelab service
+// GetExerciseStats endpoint permission_denied error.
+func NewGetExerciseStatsPermissionDenied(body string) codelab.PermissionDenied {
+	v := codelab.PermissionDenied(body)
+
+	return v
+}
+
+// NewGetExerciseStatsServiceUnavailable builds a codelab service
+// GetExerciseStats endpoint service_unavailable error.
+func NewGetExerciseStatsServiceUnavailable(body string) codelab.ServiceUnavailable {
+	v := codelab.ServiceUnavailable(body)
+
+	return v
+}
+
+// NewGetExerciseStatsUnauthorized builds a codelab service GetExerciseStats
+// endpoint unauthorized error.
+func NewGetExerciseStatsUnauthorized(body string) codelab.Unauthorized {
+	v := codelab.Unauthorized(body)
+
+	return v
+}
+
+// NewGetStudentExerciseStatsStudentExerciseStatsOK builds a "codelab" service
+// "GetStudentExerciseStats" endpoint result from a HTTP "OK" response.
+func NewGetStudentExerciseStatsStudentExerciseStatsOK(body *GetStudentExerciseStatsResponseBody) *codelab.StudentExerciseStats {
+	v := &codelab.StudentExerciseStats{
+		ExerciseID: *body.ExerciseID,
+	}
+	v.Summary = unmarshalStudentExerciseStatusResponseBodyToCodelabStudentExerciseStatus(body.Summary)
+	v.Attempts = make([]*codelab.Attempt, len(body.Attempts))
+	for i, val := range body.Attempts {
+		v.Attempts[i] = unmarshalAttemptResponseBodyToCodelabAttempt(val)
+	}
+
+	return v
+}
+
+// NewGetStudentExerciseStatsInvalidInput builds a codelab service
+// GetStudentExerciseStats endpoint invalid_input error.
+func NewGetStudentExerciseStatsInvalidInput(body string) codelab.InvalidInput {
+	v := codelab.InvalidInput(body)
+
+	return v
+}
+
+// NewGetStudentExerciseStatsNotFound builds a codelab service
+// GetStudentExerciseStats endpoint not_found error.
+func NewGetStudentExerciseStatsNotFound(body string) codelab.NotFound {
+	v := codelab.NotFound(body)
+
+	return v
+}
+
+// NewGetStudentExerciseStatsPermissionDenied builds a codelab service
+// GetStudentExerciseStats endpoint permission_denied error.
+func NewGetStudentExerciseStatsPermissionDenied(body string) codelab.PermissionDenied {
+	v := codelab.PermissionDenied(body)
+
+	return v
+}
+
+// NewGetStudentExerciseStatsServiceUnavailable builds a codelab service
+// GetStudentExerciseStats endpoint service_unavailable error.
+func NewGetStudentExerciseStatsServiceUnavailable(body string) codelab.ServiceUnavailable {
+	v := codelab.ServiceUnavailable(body)
+
+	return v
+}
+
+// NewGetStudentExerciseStatsUnauthorized builds a codelab service
+// GetStudentExerciseStats endpoint unauthorized error.
+func NewGetStudentExerciseStatsUnauthorized(body string) codelab.Unauthorized {
+	v := codelab.Unauthorized(body)
+
+	return v
+}
+
+// NewGetPlagiarismReportPlagiarismReportOK builds a "codelab" service
+// "GetPlagiarismReport" endpoint result from a HTTP "OK" response.
+func NewGetPlagiarismReportPlagiarismReportOK(body *GetPlagiarismReportResponseBody) *codelab.PlagiarismReport {
+	v := &codelab.PlagiarismReport{
+		ExerciseID: *body.ExerciseID,
+		CheckedAt:  body.CheckedAt,
+	}
+	v.Pairs = make([]*codelab.PlagiarismPair, len(body.Pairs))
+	for i, val := range body.Pairs {
+		v.Pairs[i] = unmarshalPlagiarismPairResponseBodyToCodelabPlagiarismPair(val)
+	}
+
+	return v
+}
+
+// NewGetPlagiarismReportInvalidInput builds a codelab service
+// GetPlagiarismReport endpoint invalid_input error.
+func NewGetPlagiarismReportInvalidInput(body string) codelab.InvalidInput {
+	v := codelab.InvalidInput(body)
+
+	return v
+}
+
+// NewGetPlagiarismReportNotFound builds a codelab service GetPlagiarismReport
+// endpoint not_found error.
+func NewGetPlagiarismReportNotFound(body string) codelab.NotFound {
+	v := codelab.NotFound(body)
+
+	return v
+}
+
+// NewGetPlagiarismReportPermissionDenied builds a codelab service
+// GetPlagiarismReport endpoint permission_denied error.
+func NewGetPlagiarismReportPermissionDenied(body string) codelab.PermissionDenied {
+	v := codelab.PermissionDenied(body)
+
+	return v
+}
+
+// NewGetPlagiarismReportServiceUnavailable builds a codelab service
+// GetPlagiarismReport endpoint service_unavailable error.
+func NewGetPlagiarismReportServiceUnavailable(body string) codelab.ServiceUnavailable {
+	v := codelab.ServiceUnavailable(body)
+
+	return v
+}
+
+// NewGetPlagiarismReportUnauthorized builds a codelab service
+// GetPlagiarismReport endpoint unauthorized error.
+func NewGetPlagiarismReportUnauthorized(body string) codelab.Unauthorized {
+	v := codelab.Unauthorized(body)
+
+	return v
+}
+
+// NewGetExerciseForStudentExerciseForStudentsOK builds a "codelab" service
+// "GetExerciseForStudent" endpoint result from a HTTP "OK" response.
+func NewGetExerciseForStudentExerciseForStudentsOK(body *GetExerciseForStudentResponseBody) *codelab.ExerciseForStudents {
+	v := &codelab.ExerciseForStudents{
+		ID:          *body.ID,
+		Title:       *body.Title,
+		Description: *body.Description,
+		InitialCode: *body.InitialCode,
+		Difficulty:  *body.Difficulty,
+		CreatedBy:   *body.CreatedBy,
+		CreatedAt:   *body.CreatedAt,
+		UpdatedAt:   *body.UpdatedAt,
+		Language:    *body.Language,
+		Version:     *body.Version,
+	}
+	v.Tests = make([]*codelab.Test, len(body.Tests))
+	for i, val := range body.Tests {
+		v.Tests[i] = unmarshalTestResponseBodyToCodelabTest(val)
+	}
+	v.Attempts = make([]*codelab.Attempt, len(body.Attempts))
+	for i, val := range body.Attempts {
+		v.Attempts[i] = unmarshalAttemptResponseBodyToCodelabAttempt(val)
+	}
+	v.Answer = unmarshalAnswerResponseBodyToCodelabAnswer(body.Answer)
+	v.Tags = make([]string, len(body.Tags))
+	for i, val := range body.Tags {
+		v.Tags[i] = val
+	}
+
+	return v
+}
+
+// NewGetExerciseForStudentInvalidInput builds a codelab service
+// GetExerciseForStudent endpoint invalid_input error.
+func NewGetExerciseForStudentInvalidInput(body string) codelab.InvalidInput {
+	v := codelab.InvalidInput(body)
+
+	return v
+}
+
+// NewGetExerciseForStudentNotFound builds a codelab service
+// GetExerciseForStudent endpoint not_found error.
+func NewGetExerciseForStudentNotFound(body string) codelab.NotFound {
+	v := codelab.NotFound(body)
+
+	return v
+}
+
+// NewGetExerciseForStudentPermissionDenied builds a codelab service
+// GetExerciseForStudent endpoint permission_denied error.
+func NewGetExerciseForStudentPermissionDenied(body string) codelab.PermissionDenied {
+	v := codelab.PermissionDenied(body)
+
+	return v
+}
+
+// NewGetExerciseForStudentServiceUnavailable builds a codelab service
+// GetExerciseForStudent endpoint service_unavailable error.
+func NewGetExerciseForStudentServiceUnavailable(body string) codelab.ServiceUnavailable {
+	v := codelab.ServiceUnavailable(body)
+
+	return v
+}
+
+// NewGetExerciseForStudentUnauthorized builds a codelab service
+// GetExerciseForStudent endpoint unauthorized error.
+func NewGetExerciseForStudentUnauthorized(body string) codelab.Unauthorized {
+	v := codelab.Unauthorized(body)
+
+	return v
+}
+
+// NewListExercisesForStudentsExerciseForStudentsPageOK builds a "codelab"
+// service "ListExercisesForStudents" endpoint result from a HTTP "OK" response.
+func NewListExercisesForStudentsExerciseForStudentsPageOK(body []*ExerciseForStudentsListView, nextCursor *string) *codelab.ExerciseForStudentsPage {
+	v := make([]*codelab.ExerciseForStudentsListView, len(body))
+	for i, val := range body {
+		v[i] = unmarshalExerciseForStudentsListViewToCodelabExerciseForStudentsListView(val)
+	}
+	res := &codelab.ExerciseForStudentsPage{
+		Exercises: v,
+	}
+	res.NextCursor = nextCursor
+
+	return res
+}
+
+// NewListExercisesForStudentsInvalidInput builds a codelab service
+// ListExercisesForStudents endpoint invalid_input error.
+func NewListExercisesForStudentsInvalidInput(body string) codelab.InvalidInput {
+	v := codelab.InvalidInput(body)
+
+	return v
+}
+
+// NewListExercisesForStudentsNotFound builds a codelab service
+// ListExercisesForStudents endpoint not_found error.
+func NewListExercisesForStudentsNotFound(body string) codelab.NotFound {
+	v := codelab.NotFound(body)
+
+	return v
+}
+
+// NewListExercisesForStudentsPermissionDenied builds a codelab service
+// ListExercisesForStudents endpoint permission_denied error.
+func NewListExercisesForStudentsPermissionDenied(body string) codelab.PermissionDenied {
+	v := codelab.PermissionDenied(body)
+
+	return v
+}
+
+// NewListExercisesForStudentsServiceUnavailable builds a codelab service
+// ListExercisesForStudents endpoint service_unavailable error.
+func NewListExercisesForStudentsServiceUnavailable(body string) codelab.ServiceUnavailable {
+	v := codelab.ServiceUnavailable(body)
+
+	return v
+}
+
+// NewListExercisesForStudentsUnauthorized builds a codelab service
+// ListExercisesForStudents endpoint unauthorized error.
+func NewListExercisesForStudentsUnauthorized(body string) codelab.Unauthorized {
+	v := codelab.Unauthorized(body)
+
+	return v
+}
+
+// NewCreateAttemptSimpleResponseCreated builds a "codelab" service
+// "CreateAttempt" endpoint result from a HTTP "Created" response.
+func NewCreateAttemptSimpleResponseCreated(body *CreateAttemptResponseBody) *codelab.SimpleResponse {
+	v := &codelab.SimpleResponse{
+		Success: *body.Success,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewCreateAttemptRateLimited builds a codelab service CreateAttempt endpoint
+// rate_limited error.
+func NewCreateAttemptRateLimited(body *CreateAttemptRateLimitedResponseBody, retryAfter int64) *codelab.RateLimit {
+	v := &codelab.RateLimit{
+		Message: *body.Message,
+	}
+	v.RetryAfter = retryAfter
+
+	return v
+}
+
+// NewCreateAttemptInvalidInput builds a codelab service CreateAttempt endpoint
+// invalid_input error.
+func NewCreateAttemptInvalidInput(body string) codelab.InvalidInput {
+	v := codelab.InvalidInput(body)
+
+	return v
+}
+
+// NewCreateAttemptNotFound builds a codelab service CreateAttempt endpoint
+// not_found error.
+func NewCreateAttemptNotFound(body string) codelab.NotFound {
+	v := codelab.NotFound(body)
+
+	return v
+}
+
+// NewCreateAttemptPermissionDenied builds a codelab service CreateAttempt
+// endpoint permission_denied error.
+func NewCreateAttemptPermissionDenied(body string) codelab.PermissionDenied {
+	v := codelab.PermissionDenied(body)
+
+	return v
+}
+
+// NewCreateAttemptServiceUnavailable builds a codelab service CreateAttempt
+// endpoint service_unavailable error.
+func NewCreateAttemptServiceUnavailable(body string) codelab.ServiceUnavailable {
+	v := codelab.ServiceUnavailable(body)
+
+	return v
+}
+
+// NewCreateAttemptUnauthorized builds a codelab service CreateAttempt endpoint
+// unauthorized error.
+func NewCreateAttemptUnauthorized(body string) codelab.Unauthorized {
+	v := codelab.Unauthorized(body)
+
+	return v
+}
+
+// NewSubmitAttemptAttemptSubmissionAccepted builds a "codelab" service
+// "SubmitAttempt" endpoint result from a HTTP "Accepted" response.
+func NewSubmitAttemptAttemptSubmissionAccepted(body *SubmitAttemptResponseBody) *codelab.AttemptSubmission {
+	v := &codelab.AttemptSubmission{
+		AttemptID:  *body.AttemptID,
+		TotalTests: *body.TotalTests,
+		Late:       *body.Late,
+	}
+
+	return v
+}
+
+// NewSubmitAttemptRateLimited builds a codelab service SubmitAttempt endpoint
+// rate_limited error.
+func NewSubmitAttemptRateLimited(body *SubmitAttemptRateLimitedResponseBody, retryAfter int64) *codelab.RateLimit {
+	v := &codelab.RateLimit{
+		Message: *body.Message,
+	}
+	v.RetryAfter = retryAfter
+
+	return v
+}
+
+// NewSubmitAttemptInvalidInput builds a codelab service SubmitAttempt endpoint
+// invalid_input error.
+func NewSubmitAttemptInvalidInput(body string) codelab.InvalidInput {
+	v := codelab.InvalidInput(body)
+
+	return v
+}
+
+// NewSubmitAttemptNotFound builds a codelab service SubmitAttempt endpoint
+// not_found error.
+func NewSubmitAttemptNotFound(body string) codelab.NotFound {
+	v := codelab.NotFound(body)
+
+	return v
+}
+
+// NewSubmitAttemptPermissionDenied builds a codelab service SubmitAttempt
+// endpoint permission_denied error.
+func NewSubmitAttemptPermissionDenied(body string) codelab.PermissionDenied {
+	v := codelab.PermissionDenied(body)
+
+	return v
+}
+
+// NewSubmitAttemptServiceUnavailable builds a codelab service SubmitAttempt
+// endpoint service_unavailable error.
+func NewSubmitAttemptServiceUnavailable(body string) codelab.ServiceUnavailable {
+	v := codelab.ServiceUnavailable(body)
+
+	return v
+}
+
+// NewSubmitAttemptUnauthorized builds a codelab service SubmitAttempt endpoint
+// unauthorized error.
+func NewSubmitAttemptUnauthorized(body string) codelab.Unauthorized {
+	v := codelab.Unauthorized(body)
+
+	return v
+}
+
+// NewStreamAttemptEventsAttemptEventOK builds a "codelab" service
+// "StreamAttemptEvents" endpoint result from a HTTP "OK" response.
+func NewStreamAttemptEventsAttemptEventOK(body *StreamAttemptEventsResponseBody) *codelab.AttemptEvent {
+	v := &codelab.AttemptEvent{
+		Type:       *body.Type,
+		AttemptID:  *body.AttemptID,
+		TotalTests: *body.TotalTests,
+		TestIndex:  body.TestIndex,
+		Status:     body.Status,
+		Success:    body.Success,
+		Points:     body.Points,
+		MaxPoints:  body.MaxPoints,
+		Score:      body.Score,
+		Message:    body.Message,
+	}
+	if body.TestResult != nil {
+		v.TestResult = unmarshalAttemptTestResultResponseBodyToCodelabAttemptTestResult(body.TestResult)
+	}
+
+	return v
+}
+
+// NewStreamAttemptEventsInvalidInput builds a codelab service
+// StreamAttemptEvents endpoint invalid_input error.
+func NewStreamAttemptEventsInvalidInput(body string) codelab.InvalidInput {
+	v := codelab.InvalidInput(body)
+
+	return v
+}
+
+// NewStreamAttemptEventsNotFound builds a codelab service StreamAttemptEvents
+// endpoint not_found error.
+func NewStreamAttemptEventsNotFound(body string) codelab.NotFound {
+	v := codelab.NotFound(body)
+
+	return v
+}
+
+// NewStreamAttemptEventsPermissionDenied builds a codelab service
+// StreamAttemptEvents endpoint permission_denied error.
+func NewStreamAttemptEventsPermissionDenied(body string) codelab.PermissionDenied {
+	v := codelab.PermissionDenied(body)
+
+	return v
+}
+
+// NewStreamAttemptEventsServiceUnavailable builds a codelab service
+// StreamAttemptEvents endpoint service_unavailable error.
+func NewStreamAttemptEventsServiceUnavailable(body string) codelab.ServiceUnavailable {
+	v := codelab.ServiceUnavailable(body)
+
+	return v
+}
+
+// NewStreamAttemptEventsUnauthorized builds a codelab service
+// StreamAttemptEvents endpoint unauthorized error.
+func NewStreamAttemptEventsUnauthorized(body string) codelab.Unauthorized {
+	v := codelab.Unauthorized(body)
+
+	return v
+}
+
+// NewRunCodeResultOK builds a "codelab" service "RunCode" endpoint result from
+// a HTTP "OK" response.
+func NewRunCodeResultOK(body *RunCodeResponseBody) *codelab.RunCodeResult {
+	v := &codelab.RunCodeResult{
+		Status: *body.Status,
+	}
+	v.Results = make([]*codelab.RunCaseResult, len(body.Results))
+	for i, val := range body.Results {
+		v.Results[i] = unmarshalRunCaseResultResponseBodyToCodelabRunCaseResult(val)
+	}
+
+	return v
+}
+
+// NewRunCodeRateLimited builds a codelab service RunCode endpoint rate_limited
+// error.
+func NewRunCodeRateLimited(body *RunCodeRateLimitedResponseBody, retryAfter int64) *codelab.RateLimit {
+	v := &codelab.RateLimit{
+		Message: *body.Message,
+	}
+	v.RetryAfter = retryAfter
+
+	return v
+}
+
+// NewRunCodeInvalidInput builds a codelab service RunCode endpoint
+// invalid_input error.
+func NewRunCodeInvalidInput(body string) codelab.InvalidInput {
+	v := codelab.InvalidInput(body)
+
+	return v
+}
+
+// NewRunCodeNotFound builds a codelab service RunCode endpoint not_found error.
+func NewRunCodeNotFound(body string) codelab.NotFound {
+	v := codelab.NotFound(body)
+
+	return v
+}
+
+// NewRunCodePermissionDenied builds a codelab service RunCode endpoint
+// permission_denied error.
+func NewRunCodePermissionDenied(body string) codelab.PermissionDenied {
+	v := codelab.PermissionDenied(body)
+
+	return v
+}
+
+// NewRunCodeServiceUnavailable builds a codelab service RunCode endpoint
+// service_unavailable error.
+func NewRunCodeServiceUnavailable(body string) codelab.ServiceUnavailable {
+	v := codelab.ServiceUnavailable(body)
+
+	return v
+}
+
+// NewRunCodeUnauthorized builds a codelab service RunCode endpoint
+// unauthorized error.
+func NewRunCodeUnauthorized(body string) codelab.Unauthorized {
+	v := codelab.Unauthorized(body)
+
+	return v
+}
+
+// NewGetAttemptsByUserAndExerciseAttemptOK builds a "codelab" service
+// "GetAttemptsByUserAndExercise" endpoint result from a HTTP "OK" response.
+func NewGetAttemptsByUserAndExerciseAttemptOK(body []*AttemptResponse) []*codelab.Attempt {
+	v := make([]*codelab.Attempt, len(body))
+	for i, val := range body {
+		v[i] = unmarshalAttemptResponseToCodelabAttempt(val)
+	}
+
+	return v
+}
+
+// NewGetAttemptsByUserAndExerciseInvalidInput builds a codelab service
+// GetAttemptsByUserAndExercise endpoint invalid_input error.
+func NewGetAttemptsByUserAndExerciseInvalidInput(body string) codelab.InvalidInput {
+	v := codelab.InvalidInput(body)
+
+	return v
+}
+
+// NewGetAttemptsByUserAndExerciseNotFound builds a codelab service
+// GetAttemptsByUserAndExercise endpoint not_found error.
+func NewGetAttemptsByUserAndExerciseNotFound(body string) codelab.NotFound {
+	v := codelab.NotFound(body)
+
+	return v
+}
+
+// NewGetAttemptsByUserAndExercisePermissionDenied builds a codelab service
+// GetAttemptsByUserAndExercise endpoint permission_denied error.
+func NewGetAttemptsByUserAndExercisePermissionDenied(body string) codelab.PermissionDenied {
+	v := codelab.PermissionDenied(body)
+
+	return v
+}
+
+// NewGetAttemptsByUserAndExerciseServiceUnavailable builds a codelab service
+// GetAttemptsByUserAndExercise endpoint service_unavailable error.
+func NewGetAttemptsByUserAndExerciseServiceUnavailable(body string) codelab.ServiceUnavailable {
+	v := codelab.ServiceUnavailable(body)
+
+	return v
+}
+
+// NewGetAttemptsByUserAndExerciseUnauthorized builds a codelab service
+// GetAttemptsByUserAndExercise endpoint unauthorized error.
+func NewGetAttemptsByUserAndExerciseUnauthorized(body string) codelab.Unauthorized {
+	v := codelab.Unauthorized(body)
+
+	return v
+}
+
+// NewGetHintsForStudentStudentHintOK builds a "codelab" service
+// "GetHintsForStudent" endpoint result from a HTTP "OK" response.
+func NewGetHintsForStudentStudentHintOK(body []*StudentHintResponse) []*codelab.StudentHint {
+	v := make([]*codelab.StudentHint, len(body))
+	for i, val := range body {
+		v[i] = unmarshalStudentHintResponseToCodelabStudentHint(val)
+	}
+
+	return v
+}
+
+// NewGetHintsForStudentInvalidInput builds a codelab service
+// GetHintsForStudent endpoint invalid_input error.
+func NewGetHintsForStudentInvalidInput(body string) codelab.InvalidInput {
+	v := codelab.InvalidInput(body)
+
+	return v
+}
+
+// NewGetHintsForStudentNotFound builds a codelab service GetHintsForStudent
+// endpoint not_found error.
+func NewGetHintsForStudentNotFound(body string) codelab.NotFound {
+	v := codelab.NotFound(body)
+
+	return v
+}
+
+// NewGetHintsForStudentPermissionDenied builds a codelab service
+// GetHintsForStudent endpoint permission_denied error.
+func NewGetHintsForStudentPermissionDenied(body string) codelab.PermissionDenied {
+	v := codelab.PermissionDenied(body)
+
+	return v
+}
+
+// NewGetHintsForStudentServiceUnavailable builds a codelab service
+// GetHintsForStudent endpoint service_unavailable error.
+func NewGetHintsForStudentServiceUnavailable(body string) codelab.ServiceUnavailable {
+	v := codelab.ServiceUnavailable(body)
+
+	return v
+}
+
+// NewGetHintsForStudentUnauthorized builds a codelab service
+// GetHintsForStudent endpoint unauthorized error.
+func NewGetHintsForStudentUnauthorized(body string) codelab.Unauthorized {
+	v := codelab.Unauthorized(body)
+
+	return v
+}
+
+// NewRequestHintStudentHintCreated builds a "codelab" service "RequestHint"
+// endpoint result from a HTTP "Created" response.
+func NewRequestHintStudentHintCreated(body *RequestHintResponseBody) *codelab.StudentHint {
+	v := &codelab.StudentHint{
+		ID:                *body.ID,
+		Position:          *body.Position,
+		Status:            *body.Status,
+		Content:           body.Content,
+		Penalty:           *body.Penalty,
+		AttemptsRemaining: body.AttemptsRemaining,
+		UnlocksAt:         body.UnlocksAt,
+		RevealedAt:        body.RevealedAt,
+	}
+
+	return v
+}
+
+// NewRequestHintInvalidInput builds a codelab service RequestHint endpoint
+// invalid_input error.
+func NewRequestHintInvalidInput(body string) codelab.InvalidInput {
+	v := codelab.InvalidInput(body)
+
+	return v
+}
+
+// NewRequestHintNotFound builds a codelab service RequestHint endpoint
+// not_found error.
+func NewRequestHintNotFound(body string) codelab.NotFound {
+	v := codelab.NotFound(body)
+
+	return v
+}
+
+// NewRequestHintPermissionDenied builds a codelab service RequestHint endpoint
+// permission_denied error.
+func NewRequestHintPermissionDenied(body string) codelab.PermissionDenied {
+	v := codelab.PermissionDenied(body)
+
+	return v
+}
+
+// NewRequestHintServiceUnavailable builds a codelab service RequestHint
+// endpoint service_unavailable error.
+func NewRequestHintServiceUnavailable(body string) codelab.ServiceUnavailable {
+	v := codelab.ServiceUnavailable(body)
+
+	return v
+}
+
+// NewRequestHintUnauthorized builds a codelab service RequestHint endpoint
+// unauthorized error.
+func NewRequestHintUnauthorized(body string) codelab.Unauthorized {
+	v := codelab.Unauthorized(body)
+
+	return v
+}
+
+// NewListAssignmentsForStudentsAssignmentOK builds a "codelab" service
+// "ListAssignmentsForStudents" endpoint result from a HTTP "OK" response.
+func NewListAssignmentsForStudentsAssignmentOK(body []*AssignmentResponse) []*codelab.Assignment {
+	v := make([]*codelab.Assignment, len(body))
+	for i, val := range body {
+		v[i] = unmarshalAssignmentResponseToCodelabAssignment(val)
+	}
+
+	return v
+}
+
+// NewListAssignmentsForStudentsInvalidInput builds a codelab service
+// ListAssignmentsForStudents endpoint invalid_input error.
+func NewListAssignmentsForStudentsInvalidInput(body string) codelab.InvalidInput {
+	v := codelab.InvalidInput(body)
+
+	return v
+}
+
+// NewListAssignmentsForStudentsPermissionDenied builds a codelab service
+// ListAssignmentsForStudents endpoint permission_denied error.
+func NewListAssignmentsForStudentsPermissionDenied(body string) codelab.PermissionDenied {
+	v := codelab.PermissionDenied(body)
+
+	return v
+}
+
+// NewListAssignmentsForStudentsServiceUnavailable builds a codelab service
+// ListAssignmentsForStudents endpoint service_unavailable error.
+func NewListAssignmentsForStudentsServiceUnavailable(body string) codelab.ServiceUnavailable {
+	v := codelab.ServiceUnavailable(body)
+
+	return v
+}
+
+// NewListAssignmentsForStudentsUnauthorized builds a codelab service
+// ListAssignmentsForStudents endpoint unauthorized error.
+func NewListAssignmentsForStudentsUnauthorized(body string) codelab.Unauthorized {
+	v := codelab.Unauthorized(body)
+
+	return v
+}
+
+// NewGetAssignmentProgressAssignmentProgressOK builds a "codelab" service
+// "GetAssignmentProgress" endpoint result from a HTTP "OK" response.
+func NewGetAssignmentProgressAssignmentProgressOK(body *GetAssignmentProgressResponseBody) *codelab.AssignmentProgress {
+	v := &codelab.AssignmentProgress{}
+	v.Assignment = unmarshalAssignmentResponseBodyToCodelabAssignment(body.Assignment)
+	v.Progress = unmarshalStudentAssignmentGradeResponseBodyToCodelabStudentAssignmentGrade(body.Progress)
+
+	return v
+}
+
+// NewGetAssignmentProgressInvalidInput builds a codelab service
+// GetAssignmentProgress endpoint invalid_input error.
+func NewGetAssignmentProgressInvalidInput(body string) codelab.InvalidInput {
+	v := codelab.InvalidInput(body)
+
+	return v
+}
+
+// NewGetAssignmentProgressNotFound builds a codelab service
+// GetAssignmentProgress endpoint not_found error.
+func NewGetAssignmentProgressNotFound(body string) codelab.NotFound {
+	v := codelab.NotFound(body)
+
+	return v
+}
+
+// NewGetAssignmentProgressPermissionDenied builds a codelab service
+// GetAssignmentProgress endpoint permission_denied error.
+func NewGetAssignmentProgressPermissionDenied(body string) codelab.PermissionDenied {
+	v := codelab.PermissionDenied(body)
+
+	return v
+}
+
+// NewGetAssignmentProgressServiceUnavailable builds a codelab service
+// GetAssignmentProgress endpoint service_unavailable error.
+func NewGetAssignmentProgressServiceUnavailable(body string) codelab.ServiceUnavailable {
+	v := codelab.ServiceUnavailable(body)
+
+	return v
+}
+
+// NewGetAssignmentProgressUnauthorized builds a codelab service
+// GetAssignmentProgress endpoint unauthorized error.
+func NewGetAssignmentProgressUnauthorized(body string) codelab.Unauthorized {
+	v := codelab.Unauthorized(body)
+
+	return v
+}
+
+// NewGetAnswerByUserAndExerciseAnswerOK builds a "codelab" service
+// "GetAnswerByUserAndExercise" endpoint result from a HTTP "OK" response.
+func NewGetAnswerByUserAndExerciseAnswerOK(body *GetAnswerByUserAndExerciseResponseBody) *codelab.Answer {
+	v := &codelab.Answer{
+		ID:            *body.ID,
+		ExerciseID:    *body.ExerciseID,
+		UserID:        *body.UserID,
+		Completed:     *body.Completed,
+		CreatedAt:     *body.CreatedAt,
+		UpdatedAt:     *body.UpdatedAt,
+		BestScore:     *body.BestScore,
+		BestAttemptID: body.BestAttemptID,
+		HintsUsed:     *body.HintsUsed,
+		HintPenalty:   *body.HintPenalty,
+	}
+
+	return v
+}
+
+// NewGetAnswerByUserAndExerciseInvalidInput builds a codelab service
+// GetAnswerByUserAndExercise endpoint invalid_input error.
+func NewGetAnswerByUserAndExerciseInvalidInput(body string) codelab.InvalidInput {
+	v := codelab.InvalidInput(body)
+
+	return v
+}
+
+// NewGetAnswerByUserAndExerciseNotFound builds a codelab service
+// GetAnswerByUserAndExercise endpoint not_found error.
+func NewGetAnswerByUserAndExerciseNotFound(body string) codelab.NotFound {
+	v := codelab.NotFound(body)
+
+	return v
+}
+
+// NewGetAnswerByUserAndExercisePermissionDenied builds a codelab service
+// GetAnswerByUserAndExercise endpoint permission_denied error.
+func NewGetAnswerByUserAndExercisePermissionDenied(body string) codelab.PermissionDenied {
+	v := codelab.PermissionDenied(body)
+
+	return v
+}
+
+// NewGetAnswerByUserAndExerciseServiceUnavailable builds a codelab service
+// GetAnswerByUserAndExercise endpoint service_unavailable error.
+func NewGetAnswerByUserAndExerciseServiceUnavailable(body string) codelab.ServiceUnavailable {
+	v := codelab.ServiceUnavailable(body)
+
+	return v
+}
+
+// NewGetAnswerByUserAndExerciseUnauthorized builds a codelab service
+// GetAnswerByUserAndExercise endpoint unauthorized error.
+func NewGetAnswerByUserAndExerciseUnauthorized(body string) codelab.Unauthorized {
+	v := codelab.Unauthorized(body)
+
+	return v
+}
+
+// ValidateCreateExerciseResponseBody runs the validations defined on
+// CreateExerciseResponseBody
+func ValidateCreateExerciseResponseBody(body *CreateExerciseResponseBody) (err error) {
+	if body.Success == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("success", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateGetExerciseResponseBody runs the validations defined on
+// GetExerciseResponseBody
+func ValidateGetExerciseResponseBody(body *GetExerciseResponseBody) (err error) {
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Title == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("title", "body"))
+	}
+	if body.Description == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("description", "body"))
+	}
+	if body.InitialCode == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("initial_code", "body"))
+	}
+	if body.Solution == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("solution", "body"))
+	}
+	if body.Difficulty == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("difficulty", "body"))
+	}
+	if body.Language == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("language", "body"))
+	}
+	if body.Tags == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("tags", "body"))
+	}
+	if body.Version == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("version", "body"))
+	}
+	if body.CreatedBy == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("created_by", "body"))
+	}
+	if body.CreatedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("created_at", "body"))
+	}
+	if body.UpdatedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("updated_at", "body"))
+	}
+	if body.Title != nil {
+		if utf8.RuneCountInString(*body.Title) > 200 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.title", *body.Title, utf8.RuneCountInString(*body.Title), 200, false))
+		}
+	}
+	if body.Difficulty != nil {
+		if !(*body.Difficulty == "easy" || *body.Difficulty == "medium" || *body.Difficulty == "hard") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.difficulty", *body.Difficulty, []any{"easy", "medium", "hard"}))
+		}
+	}
+	if body.Language != nil {
+		if !(*body.Language == "javascript" || *body.Language == "starlark") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.language", *body.Language, []any{"javascript", "starlark"}))
+		}
+	}
+	return
+}
+
+// ValidateUpdateExerciseResponseBody runs the validations defined on
+// UpdateExerciseResponseBody
+func ValidateUpdateExerciseResponseBody(body *UpdateExerciseResponseBody) (err error) {
+	if body.Success == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("success", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateDeleteExerciseResponseBody runs the validations defined on
+// DeleteExerciseResponseBody
+func ValidateDeleteExerciseResponseBody(body *DeleteExerciseResponseBody) (err error) {
+	if body.Success == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("success", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateExportExercisesResponseBody runs the validations defined on
+// ExportExercisesResponseBody
+func ValidateExportExercisesResponseBody(body *ExportExercisesResponseBody) (err error) {
+	if body.Filename == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("filename", "body"))
+	}
+	if body.Format == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("format", "body"))
+	}
+	if body.Content == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("content", "body"))
+	}
+	if body.Format != nil {
+		if !(*body.Format == "json" || *body.Format == "zip" || *body.Format == "tar.gz") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.format", *body.Format, []any{"json", "zip", "tar.gz"}))
+		}
+	}
+	return
+}
+
+// ValidateImportExercisesResponseBody runs the validations defined on
+// ImportExercisesResponseBody
+func ValidateImportExercisesResponseBody(body *ImportExercisesResponseBody) (err error) {
+	if body.Success == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("success", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.ExerciseIds == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("exercise_ids", "body"))
+	}
+	return
+}
+
+// ValidateCreateTestResponseBody runs the validations defined on
+// CreateTestResponseBody
+func ValidateCreateTestResponseBody(body *CreateTestResponseBody) (err error) {
+	if body.Success == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("success", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateUpdateTestResponseBody runs the validations defined on
+// UpdateTestResponseBody
+func ValidateUpdateTestResponseBody(body *UpdateTestResponseBody) (err error) {
+	if body.Success == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("success", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateDeleteTestResponseBody runs the validations defined on
+// DeleteTestResponseBody
+func ValidateDeleteTestResponseBody(body *DeleteTestResponseBody) (err error) {
+	if body.Success == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("success", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateDiffExerciseVersionsResponseBody runs the validations defined on
+// DiffExerciseVersionsResponseBody
+func ValidateDiffExerciseVersionsResponseBody(body *DiffExerciseVersionsResponseBody) (err error) {
+	if body.ExerciseID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("exercise_id", "body"))
+	}
+	if body.FromVersion == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("from_version", "body"))
+	}
+	if body.ToVersion == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("to_version", "body"))
+	}
+	if body.Fields == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fields", "body"))
+	}
+	if body.Tests == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("tests", "body"))
+	}
+	for _, e := range body.Fields {
+		if e != nil {
+			if err2 := ValidateFieldChangeResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	for _, e := range body.Tests {
+		if e != nil {
+			if err2 := ValidateTestChangeResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateRollbackExerciseResponseBody runs the validations defined on
+// RollbackExerciseResponseBody
+func ValidateRollbackExerciseResponseBody(body *RollbackExerciseResponseBody) (err error) {
+	if body.Success == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("success", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateRegradeExerciseResponseBody runs the validations defined on
+// RegradeExerciseResponseBody
+func ValidateRegradeExerciseResponseBody(body *RegradeExerciseResponseBody) (err error) {
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.ExerciseID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("exercise_id", "body"))
+	}
+	if body.ExerciseVersion == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("exercise_version", "body"))
+	}
+	if body.Status == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("status", "body"))
+	}
+	if body.TotalAttempts == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("total_attempts", "body"))
+	}
+	if body.RegradedAttempts == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("regraded_attempts", "body"))
+	}
+	if body.ErroredAttempts == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("errored_attempts", "body"))
+	}
+	if body.NewlyPassedAttempts == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("newly_passed_attempts", "body"))
+	}
+	if body.NewlyFailedAttempts == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("newly_failed_attempts", "body"))
+	}
+	if body.NewlyPassed == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("newly_passed", "body"))
+	}
+	if body.NewlyFailed == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("newly_failed", "body"))
+	}
+	if body.CreatedBy == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("created_by", "body"))
+	}
+	if body.CreatedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("created_at", "body"))
+	}
+	if body.Status != nil {
+		if !(*body.Status == "running" || *body.Status == "finished" || *body.Status == "failed") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.status", *body.Status, []any{"running", "finished", "failed"}))
+		}
+	}
+	for _, e := range body.NewlyPassed {
+		if e != nil {
+			if err2 := ValidateRegradeStudentChangeResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	for _, e := range body.NewlyFailed {
+		if e != nil {
+			if err2 := ValidateRegradeStudentChangeResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateGetRegradeResponseBody runs the validations defined on
+// GetRegradeResponseBody
+func ValidateGetRegradeResponseBody(body *GetRegradeResponseBody) (err error) {
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.ExerciseID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("exercise_id", "body"))
+	}
+	if body.ExerciseVersion == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("exercise_version", "body"))
+	}
+	if body.Status == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("status", "body"))
+	}
+	if body.TotalAttempts == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("total_attempts", "body"))
+	}
+	if body.RegradedAttempts == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("regraded_attempts", "body"))
+	}
+	if body.ErroredAttempts == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("errored_attempts", "body"))
+	}
+	if body.NewlyPassedAttempts == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("newly_passed_attempts", "body"))
+	}
+	if body.NewlyFailedAttempts == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("newly_failed_attempts", "body"))
+	}
+	if body.NewlyPassed == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("newly_passed", "body"))
+	}
+	if body.NewlyFailed == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("newly_failed", "body"))
+	}
+	if body.CreatedBy == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("created_by", "body"))
+	}
+	if body.CreatedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("created_at", "body"))
+	}
+	if body.Status != nil {
+		if !(*body.Status == "running" || *body.Status == "finished" || *body.Status == "failed") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.status", *body.Status, []any{"running", "finished", "failed"}))
+		}
+	}
+	for _, e := range body.NewlyPassed {
+		if e != nil {
+			if err2 := ValidateRegradeStudentChangeResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	for _, e := range body.NewlyFailed {
+		if e != nil {
+			if err2 := ValidateRegradeStudentChangeResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateCreateHintResponseBody runs the validations defined on
+// CreateHintResponseBody
+func ValidateCreateHintResponseBody(body *CreateHintResponseBody) (err error) {
+	if body.Success == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("success", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateUpdateHintResponseBody runs the validations defined on
+// UpdateHintResponseBody
+func ValidateUpdateHintResponseBody(body *UpdateHintResponseBody) (err error) {
+	if body.Success == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("success", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateDeleteHintResponseBody runs the validations defined on
+// DeleteHintResponseBody
+func ValidateDeleteHintResponseBody(body *DeleteHintResponseBody) (err error) {
+	if body.Success == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("success", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateCreateAssignmentResponseBody runs the validations defined on
+// CreateAssignmentResponseBody
+func ValidateCreateAssignmentResponseBody(body *CreateAssignmentResponseBody) (err error) {
+	if body.Success == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("success", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateGetAssignmentResponseBody runs the validations defined on
+// GetAssignmentResponseBody
+func ValidateGetAssignmentResponseBody(body *GetAssignmentResponseBody) (err error) {
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Title == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("title", "body"))
+	}
+	if body.Description == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("description", "body"))
+	}
+	if body.OpensAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("opens_at", "body"))
+	}
+	if body.ClosesAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("closes_at", "body"))
+	}
+	if body.AllowLate == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("allow_late", "body"))
+	}
+	if body.Status == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("status", "body"))
+	}
+	if body.Exercises == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("exercises", "body"))
+	}
+	if body.CreatedBy == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("created_by", "body"))
+	}
+	if body.CreatedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("created_at", "body"))
+	}
+	if body.UpdatedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("updated_at", "body"))
+	}
+	if body.Status != nil {
+		if !(*body.Status == "upcoming" || *body.Status == "open" || *body.Status == "closed") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.status", *body.Status, []any{"upcoming", "open", "closed"}))
+		}
+	}
+	for _, e := range body.Exercises {
+		if e != nil {
+			if err2 := ValidateAssignmentExerciseResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateUpdateAssignmentResponseBody runs the validations defined on
+// UpdateAssignmentResponseBody
+func ValidateUpdateAssignmentResponseBody(body *UpdateAssignmentResponseBody) (err error) {
+	if body.Success == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("success", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateDeleteAssignmentResponseBody runs the validations defined on
+// DeleteAssignmentResponseBody
+func ValidateDeleteAssignmentResponseBody(body *DeleteAssignmentResponseBody) (err error) {
+	if body.Success == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("success", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateGetAssignmentGradebookResponseBody runs the validations defined on
+// GetAssignmentGradebookResponseBody
+func ValidateGetAssignmentGradebookResponseBody(body *GetAssignmentGradebookResponseBody) (err error) {
+	if body.Assignment == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("assignment", "body"))
+	}
+	if body.Students == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("students", "body"))
+	}
+	if body.Assignment != nil {
+		if err2 := ValidateAssignmentResponseBody(body.Assignment); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	for _, e := range body.Students {
+		if e != nil {
+			if err2 := ValidateStudentAssignmentGradeResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateGetExerciseStatsResponseBody runs the validations defined on
+// GetExerciseStatsResponseBody
+func ValidateGetExerciseStatsResponseBody(body *GetExerciseStatsResponseBody) (err error) {
+	if body.ExerciseID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("exercise_id", "body"))
+	}
+	if body.TotalStudents == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("total_students", "body"))
+	}
+	if body.CompletedStudents == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("completed_students", "body"))
+	}
+	if body.CompletionRate == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("completion_rate", "body"))
+	}
+	if body.TotalAttempts == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("total_attempts", "body"))
+	}
+	if body.SuccessfulAttempts == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("successful_attempts", "body"))
+	}
+	if body.HintsUsed == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("hints_used", "body"))
+	}
+	if body.Students == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("students", "body"))
+	}
+	if body.MostFailedTest != nil {
+		if err2 := ValidateFailingTestResponseBody(body.MostFailedTest); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	for _, e := range body.Students {
+		if e != nil {
+			if err2 := ValidateStudentExerciseStatusResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateGetStudentExerciseStatsResponseBody runs the validations defined on
+// GetStudentExerciseStatsResponseBody
+func ValidateGetStudentExerciseStatsResponseBody(body *GetStudentExerciseStatsResponseBody) (err error) {
+	if body.ExerciseID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("exercise_id", "body"))
+	}
+	if body.Summary == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("summary", "body"))
+	}
+	if body.Attempts == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("attempts", "body"))
+	}
+	if body.Summary != nil {
+		if err2 := ValidateStudentExerciseStatusResponseBody(body.Summary); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	for _, e := range body.Attempts {
+		if e != nil {
+			if err2 := ValidateAttemptResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateGetPlagiarismReportResponseBody runs the validations defined on
+// GetPlagiarismReportResponseBody
+func ValidateGetPlagiarismReportResponseBody(body *GetPlagiarismReportResponseBody) (err error) {
+	if body.ExerciseID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("exercise_id", "body"))
+	}
+	if body.Pairs == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("pairs", "body"))
+	}
+	for _, e := range body.Pairs {
+		if e != nil {
+			if err2 := ValidatePlagiarismPairResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateGetExerciseForStudentResponseBody runs the validations defined on
+// GetExerciseForStudentResponseBody
+func ValidateGetExerciseForStudentResponseBody(body *GetExerciseForStudentResponseBody) (err error) {
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Title == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("title", "body"))
+	}
+	if body.Description == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("description", "body"))
+	}
+	if body.InitialCode == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("initial_code", "body"))
+	}
+	if body.Difficulty == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("difficulty", "body"))
+	}
+	if body.Language == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("language", "body"))
+	}
+	if body.Tags == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("tags", "body"))
+	}
+	if body.Version == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("version", "body"))
+	}
+	if body.Tests == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("tests", "body"))
+	}
+	if body.Attempts == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("attempts", "body"))
+	}
+	if body.Answer == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("answer", "body"))
+	}
+	if body.CreatedBy == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("created_by", "body"))
+	}
+	if body.CreatedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("created_at", "body"))
+	}
+	if body.UpdatedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("updated_at", "body"))
+	}
+	if body.Title != nil {
+		if utf8.RuneCountInString(*body.Title) > 200 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.title", *body.Title, utf8.RuneCountInString(*body.Title), 200, false))
+		}
+	}
+	if body.Difficulty != nil {
+		if !(*body.Difficulty == "easy" || *body.Difficulty == "medium" || *body.Difficulty == "hard") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.difficulty", *body.Difficulty, []any{"easy", "medium", "hard"}))
+		}
+	}
+	for _, e := range body.Tests {
+		if e != nil {
+			if err2 := ValidateTestResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	for _, e := range body.Attempts {
+		if e != nil {
+			if err2 := ValidateAttemptResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	if body.Answer != nil {
+		if err2 := ValidateAnswerResponseBody(body.Answer); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	if body.Language != nil {
+		if !(*body.Language == "javascript" || *body.Language == "starlark") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.language", *body.Language, []any{"javascript", "starlark"}))
+		}
+	}
+	return
+}
+
+// ValidateCreateAttemptResponseBody runs the validations defined on
+// CreateAttemptResponseBody
+func ValidateCreateAttemptResponseBody(body *CreateAttemptResponseBody) (err error) {
+	if body.Success == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("success", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateSubmitAttemptResponseBody runs the validations defined on
+// SubmitAttemptResponseBody
+func ValidateSubmitAttemptResponseBody(body *SubmitAttemptResponseBody) (err error) {
+	if body.AttemptID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("attempt_id", "body"))
+	}
+	if body.TotalTests == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("total_tests", "body"))
+	}
+	if body.Late == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("late", "body"))
+	}
+	return
+}
+
+// ValidateStreamAttemptEventsResponseBody runs the validations defined on
+// StreamAttemptEventsResponseBody
+func ValidateStreamAttemptEventsResponseBody(body *StreamAttemptEventsResponseBody) (err error) {
+	if body.Type == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("type", "body"))
+	}
+	if body.AttemptID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("attempt_id", "body"))
+	}
+	if body.TotalTests == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("total_tests", "body"))
+	}
+	if body.Type != nil {
+		if !(*body.Type == "queued" || *body.Type == "running" || *body.Type == "test" || *body.Type == "finished") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.type", *body.Type, []any{"queued", "running", "test", "finished"}))
+		}
+	}
+	if body.TestResult != nil {
+		if err2 := ValidateAttemptTestResultResponseBody(body.TestResult); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	if body.Status != nil {
+		if !(*body.Status == "passed" || *body.Status == "failed" || *body.Status == "error" || *body.Status == "timeout" || *body.Status == "resource_exceeded") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.status", *body.Status, []any{"passed", "failed", "error", "timeout", "resource_exceeded"}))
+		}
+	}
+	return
+}
+
+// ValidateRunCodeResponseBody runs the validations defined on
+// RunCodeResponseBody
+func ValidateRunCodeResponseBody(body *RunCodeResponseBody) (err error) {
+	if body.Status == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("status", "body"))
+	}
+	if body.Results == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("results", "body"))
+	}
+	if body.Status != nil {
+		if !(*body.Status == "passed" || *body.Status == "failed" || *body.Status == "completed" || *body.Status == "error" || *body.Status == "timeout" || *body.Status == "resource_exceeded") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.status", *body.Status, []any{"passed", "failed", "completed", "error", "timeout", "resource_exceeded"}))
+		}
+	}
+	for _, e := range body.Results {
+		if e != nil {
+			if err2 := ValidateRunCaseResultResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateRequestHintResponseBody runs the validations defined on
+// RequestHintResponseBody
+func ValidateRequestHintResponseBody(body *RequestHintResponseBody) (err error) {
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Position == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("position", "body"))
+	}
+	if body.Status == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("status", "body"))
+	}
+	if body.Penalty == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("penalty", "body"))
+	}
+	if body.Status != nil {
+		if !(*body.Status == "revealed" || *body.Status == "available" || *body.Status == "locked") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.status", *body.Status, []any{"revealed", "available", "locked"}))
+		}
+	}
+	return
+}
+
+// ValidateGetAssignmentProgressResponseBody runs the validations defined on
+// GetAssignmentProgressResponseBody
+func ValidateGetAssignmentProgressResponseBody(body *GetAssignmentProgressResponseBody) (err error) {
+	if body.Assignment == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("assignment", "body"))
+	}
+	if body.Progress == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("progress", "body"))
+	}
+	if body.Assignment != nil {
+		if err2 := ValidateAssignmentResponseBody(body.Assignment); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	if body.Progress != nil {
+		if err2 := ValidateStudentAssignmentGradeResponseBody(body.Progress); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	return
+}
+
+// ValidateGetAnswerByUserAndExerciseResponseBody runs the validations defined
+// on GetAnswerByUserAndExerciseResponseBody
+func ValidateGetAnswerByUserAndExerciseResponseBody(body *GetAnswerByUserAndExerciseResponseBody) (err error) {
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.ExerciseID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("exercise_id", "body"))
+	}
+	if body.UserID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("user_id", "body"))
+	}
+	if body.Completed == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("completed", "body"))
+	}
+	if body.BestScore == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("best_score", "body"))
+	}
+	if body.HintsUsed == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("hints_used", "body"))
+	}
+	if body.HintPenalty == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("hint_penalty", "body"))
+	}
+	if body.CreatedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("created_at", "body"))
+	}
+	if body.UpdatedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("updated_at", "body"))
+	}
+	if body.BestScore != nil {
+		if *body.BestScore < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.best_score", *body.BestScore, 0, true))
+		}
+	}
+	if body.BestScore != nil {
+		if *body.BestScore > 100 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.best_score", *body.BestScore, 100, false))
+		}
+	}
+	return
+}
+
+// ValidateCreateExerciseSolutionFailedResponseBody runs the validations
+// defined on CreateExercise_solution_failed_Response_Body
+func ValidateCreateExerciseSolutionFailedResponseBody(body *CreateExerciseSolutionFailedResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.FailingTests == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("failing_tests", "body"))
+	}
+	for _, e := range body.FailingTests {
+		if e != nil {
+			if err2 := ValidateSolutionTestFailureResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateUpdateExerciseSolutionFailedResponseBody runs the validations
+// defined on UpdateExercise_solution_failed_Response_Body
+func ValidateUpdateExerciseSolutionFailedResponseBody(body *UpdateExerciseSolutionFailedResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.FailingTests == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("failing_tests", "body"))
+	}
+	for _, e := range body.FailingTests {
+		if e != nil {
+			if err2 := ValidateSolutionTestFailureResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateImportExercisesSolutionFailedResponseBody runs the validations
+// defined on ImportExercises_solution_failed_Response_Body
+func ValidateImportExercisesSolutionFailedResponseBody(body *ImportExercisesSolutionFailedResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.FailingTests == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("failing_tests", "body"))
+	}
+	for _, e := range body.FailingTests {
+		if e != nil {
+			if err2 := ValidateSolutionTestFailureResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateCreateTestSolutionFailedResponseBody runs the validations defined on
+// CreateTest_solution_failed_Response_Body
+func ValidateCreateTestSolutionFailedResponseBody(body *CreateTestSolutionFailedResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.FailingTests == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("failing_tests", "body"))
+	}
+	for _, e := range body.FailingTests {
+		if e != nil {
+			if err2 := ValidateSolutionTestFailureResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateUpdateTestSolutionFailedResponseBody runs the validations defined on
+// UpdateTest_solution_failed_Response_Body
+func ValidateUpdateTestSolutionFailedResponseBody(body *UpdateTestSolutionFailedResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.FailingTests == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("failing_tests", "body"))
+	}
+	for _, e := range body.FailingTests {
+		if e != nil {
+			if err2 := ValidateSolutionTestFailureResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateRollbackExerciseSolutionFailedResponseBody runs the validations
+// defined on RollbackExercise_solution_failed_Response_Body
+func ValidateRollbackExerciseSolutionFailedResponseBody(body *RollbackExerciseSolutionFailedResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	if body.FailingTests == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("failing_tests", "body"))
+	}
+	for _, e := range body.FailingTests {
+		if e != nil {
+			if err2 := ValidateSolutionTestFailureResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateCreateAttemptRateLimitedResponseBody runs the validations defined on
+// CreateAttempt_rate_limited_Response_Body
+func ValidateCreateAttemptRateLimitedResponseBody(body *CreateAttemptRateLimitedResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateSubmitAttemptRateLimitedResponseBody runs the validations defined on
+// SubmitAttempt_rate_limited_Response_Body
+func ValidateSubmitAttemptRateLimitedResponseBody(body *SubmitAttemptRateLimitedResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
 	}
 	return
 }
 
-// ValidateGetExerciseResponseBody runs the validations defined on
-// GetExerciseResponseBody
-func ValidateGetExerciseResponseBody(body *GetExerciseResponseBody) (err error) {
+// ValidateRunCodeRateLimitedResponseBody runs the validations defined on
+// RunCode_rate_limited_Response_Body
+func ValidateRunCodeRateLimitedResponseBody(body *RunCodeRateLimitedResponseBody) (err error) {
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateSolutionTestFailureResponseBody runs the validations defined on
+// SolutionTestFailureResponseBody
+func ValidateSolutionTestFailureResponseBody(body *SolutionTestFailureResponseBody) (err error) {
+	if body.Input == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("input", "body"))
+	}
+	if body.ExpectedOutput == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("expected_output", "body"))
+	}
+	if body.ActualOutput == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("actual_output", "body"))
+	}
+	if body.Status == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("status", "body"))
+	}
+	if body.Status != nil {
+		if !(*body.Status == "failed" || *body.Status == "error" || *body.Status == "timeout" || *body.Status == "resource_exceeded") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.status", *body.Status, []any{"failed", "error", "timeout", "resource_exceeded"}))
+		}
+	}
+	return
+}
+
+// ValidateExercise runs the validations defined on Exercise
+func ValidateExercise(body *Exercise) (err error) {
 	if body.ID == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
 	}
@@ -1155,6 +4933,15 @@ func ValidateGetExerciseResponseBody(body *GetExerciseResponseBody) (err error)
 	if body.Difficulty == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("difficulty", "body"))
 	}
+	if body.Language == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("language", "body"))
+	}
+	if body.Tags == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("tags", "body"))
+	}
+	if body.Version == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("version", "body"))
+	}
 	if body.CreatedBy == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("created_by", "body"))
 	}
@@ -1174,72 +4961,380 @@ func ValidateGetExerciseResponseBody(body *GetExerciseResponseBody) (err error)
 			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.difficulty", *body.Difficulty, []any{"easy", "medium", "hard"}))
 		}
 	}
+	if body.Language != nil {
+		if !(*body.Language == "javascript" || *body.Language == "starlark") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.language", *body.Language, []any{"javascript", "starlark"}))
+		}
+	}
 	return
 }
 
-// ValidateUpdateExerciseResponseBody runs the validations defined on
-// UpdateExerciseResponseBody
-func ValidateUpdateExerciseResponseBody(body *UpdateExerciseResponseBody) (err error) {
-	if body.Success == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("success", "body"))
+// ValidateTagCountResponse runs the validations defined on TagCountResponse
+func ValidateTagCountResponse(body *TagCountResponse) (err error) {
+	if body.Tag == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("tag", "body"))
 	}
-	if body.Message == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	if body.Exercises == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("exercises", "body"))
 	}
 	return
 }
 
-// ValidateDeleteExerciseResponseBody runs the validations defined on
-// DeleteExerciseResponseBody
-func ValidateDeleteExerciseResponseBody(body *DeleteExerciseResponseBody) (err error) {
-	if body.Success == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("success", "body"))
+// ValidateUpdateExercisePayloadRequestBody runs the validations defined on
+// UpdateExercisePayloadRequestBody
+func ValidateUpdateExercisePayloadRequestBody(body *UpdateExercisePayloadRequestBody) (err error) {
+	if utf8.RuneCountInString(body.Title) > 200 {
+		err = goa.MergeErrors(err, goa.InvalidLengthError("body.title", body.Title, utf8.RuneCountInString(body.Title), 200, false))
 	}
-	if body.Message == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	if !(body.Difficulty == "easy" || body.Difficulty == "medium" || body.Difficulty == "hard") {
+		err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.difficulty", body.Difficulty, []any{"easy", "medium", "hard"}))
+	}
+	if body.Language != nil {
+		if !(*body.Language == "javascript" || *body.Language == "starlark") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.language", *body.Language, []any{"javascript", "starlark"}))
+		}
+	}
+	if len(body.Tags) > 10 {
+		err = goa.MergeErrors(err, goa.InvalidLengthError("body.tags", body.Tags, len(body.Tags), 10, false))
 	}
 	return
 }
 
-// ValidateCreateTestResponseBody runs the validations defined on
-// CreateTestResponseBody
-func ValidateCreateTestResponseBody(body *CreateTestResponseBody) (err error) {
-	if body.Success == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("success", "body"))
+// ValidateTestResponse runs the validations defined on TestResponse
+func ValidateTestResponse(body *TestResponse) (err error) {
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
 	}
-	if body.Message == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	if body.Input == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("input", "body"))
+	}
+	if body.Output == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("output", "body"))
+	}
+	if body.Public == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("public", "body"))
+	}
+	if body.InputFormat == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("input_format", "body"))
+	}
+	if body.Comparison == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("comparison", "body"))
+	}
+	if body.Tolerance == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("tolerance", "body"))
+	}
+	if body.Weight == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("weight", "body"))
+	}
+	if body.ExerciseID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("exercise_id", "body"))
+	}
+	if body.CreatedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("created_at", "body"))
+	}
+	if body.UpdatedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("updated_at", "body"))
+	}
+	if body.InputFormat != nil {
+		if !(*body.InputFormat == "text" || *body.InputFormat == "json") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.input_format", *body.InputFormat, []any{"text", "json"}))
+		}
+	}
+	if body.Comparison != nil {
+		if !(*body.Comparison == "exact" || *body.Comparison == "trimmed" || *body.Comparison == "numeric" || *body.Comparison == "json" || *body.Comparison == "unordered" || *body.Comparison == "regex") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.comparison", *body.Comparison, []any{"exact", "trimmed", "numeric", "json", "unordered", "regex"}))
+		}
+	}
+	if body.Tolerance != nil {
+		if *body.Tolerance < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.tolerance", *body.Tolerance, 0, true))
+		}
+	}
+	if body.Weight != nil {
+		if *body.Weight < 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.weight", *body.Weight, 1, true))
+		}
 	}
 	return
 }
 
-// ValidateUpdateTestResponseBody runs the validations defined on
-// UpdateTestResponseBody
-func ValidateUpdateTestResponseBody(body *UpdateTestResponseBody) (err error) {
-	if body.Success == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("success", "body"))
+// ValidateUpdateTestPayloadRequestBody runs the validations defined on
+// UpdateTestPayloadRequestBody
+func ValidateUpdateTestPayloadRequestBody(body *UpdateTestPayloadRequestBody) (err error) {
+	if !(body.InputFormat == "text" || body.InputFormat == "json") {
+		err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.input_format", body.InputFormat, []any{"text", "json"}))
 	}
-	if body.Message == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	if !(body.Comparison == "exact" || body.Comparison == "trimmed" || body.Comparison == "numeric" || body.Comparison == "json" || body.Comparison == "unordered" || body.Comparison == "regex") {
+		err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.comparison", body.Comparison, []any{"exact", "trimmed", "numeric", "json", "unordered", "regex"}))
+	}
+	if body.Tolerance < 0 {
+		err = goa.MergeErrors(err, goa.InvalidRangeError("body.tolerance", body.Tolerance, 0, true))
+	}
+	if body.Weight < 1 {
+		err = goa.MergeErrors(err, goa.InvalidRangeError("body.weight", body.Weight, 1, true))
 	}
 	return
 }
 
-// ValidateDeleteTestResponseBody runs the validations defined on
-// DeleteTestResponseBody
-func ValidateDeleteTestResponseBody(body *DeleteTestResponseBody) (err error) {
-	if body.Success == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("success", "body"))
+// ValidateExerciseVersionResponse runs the validations defined on
+// ExerciseVersionResponse
+func ValidateExerciseVersionResponse(body *ExerciseVersionResponse) (err error) {
+	if body.Version == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("version", "body"))
 	}
-	if body.Message == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	if body.CreatedBy == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("created_by", "body"))
+	}
+	if body.CreatedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("created_at", "body"))
+	}
+	if body.Attempts == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("attempts", "body"))
+	}
+	return
+}
+
+// ValidateFieldChangeResponseBody runs the validations defined on
+// FieldChangeResponseBody
+func ValidateFieldChangeResponseBody(body *FieldChangeResponseBody) (err error) {
+	if body.Field == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("field", "body"))
+	}
+	if body.From == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("from", "body"))
+	}
+	if body.To == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("to", "body"))
+	}
+	return
+}
+
+// ValidateTestChangeResponseBody runs the validations defined on
+// TestChangeResponseBody
+func ValidateTestChangeResponseBody(body *TestChangeResponseBody) (err error) {
+	if body.TestID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("test_id", "body"))
+	}
+	if body.Change == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("change", "body"))
+	}
+	if body.Fields == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("fields", "body"))
+	}
+	if body.Change != nil {
+		if !(*body.Change == "added" || *body.Change == "removed" || *body.Change == "modified") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.change", *body.Change, []any{"added", "removed", "modified"}))
+		}
+	}
+	for _, e := range body.Fields {
+		if e != nil {
+			if err2 := ValidateFieldChangeResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateRegradeStudentChangeResponseBody runs the validations defined on
+// RegradeStudentChangeResponseBody
+func ValidateRegradeStudentChangeResponseBody(body *RegradeStudentChangeResponseBody) (err error) {
+	if body.UserID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("user_id", "body"))
+	}
+	if body.PreviousScore == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("previous_score", "body"))
+	}
+	if body.Score == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("score", "body"))
+	}
+	return
+}
+
+// ValidateHintResponse runs the validations defined on HintResponse
+func ValidateHintResponse(body *HintResponse) (err error) {
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.ExerciseID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("exercise_id", "body"))
+	}
+	if body.Position == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("position", "body"))
+	}
+	if body.Content == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("content", "body"))
+	}
+	if body.Penalty == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("penalty", "body"))
+	}
+	if body.CreatedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("created_at", "body"))
+	}
+	if body.UpdatedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("updated_at", "body"))
+	}
+	return
+}
+
+// ValidateUpdateHintPayloadRequestBody runs the validations defined on
+// UpdateHintPayloadRequestBody
+func ValidateUpdateHintPayloadRequestBody(body *UpdateHintPayloadRequestBody) (err error) {
+	if utf8.RuneCountInString(body.Content) < 1 {
+		err = goa.MergeErrors(err, goa.InvalidLengthError("body.content", body.Content, utf8.RuneCountInString(body.Content), 1, true))
+	}
+	if body.UnlockAfterAttempts != nil {
+		if *body.UnlockAfterAttempts < 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.unlock_after_attempts", *body.UnlockAfterAttempts, 1, true))
+		}
+	}
+	if body.UnlockAfterSeconds != nil {
+		if *body.UnlockAfterSeconds < 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.unlock_after_seconds", *body.UnlockAfterSeconds, 1, true))
+		}
+	}
+	if body.Penalty < 0 {
+		err = goa.MergeErrors(err, goa.InvalidRangeError("body.penalty", body.Penalty, 0, true))
+	}
+	if body.Penalty > 100 {
+		err = goa.MergeErrors(err, goa.InvalidRangeError("body.penalty", body.Penalty, 100, false))
+	}
+	return
+}
+
+// ValidateAssignmentExerciseResponseBody runs the validations defined on
+// AssignmentExerciseResponseBody
+func ValidateAssignmentExerciseResponseBody(body *AssignmentExerciseResponseBody) (err error) {
+	if body.ExerciseID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("exercise_id", "body"))
+	}
+	if body.Position == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("position", "body"))
+	}
+	if body.Title == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("title", "body"))
+	}
+	if body.Difficulty == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("difficulty", "body"))
+	}
+	if body.Language == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("language", "body"))
+	}
+	if body.Difficulty != nil {
+		if !(*body.Difficulty == "easy" || *body.Difficulty == "medium" || *body.Difficulty == "hard") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.difficulty", *body.Difficulty, []any{"easy", "medium", "hard"}))
+		}
+	}
+	if body.Language != nil {
+		if !(*body.Language == "javascript" || *body.Language == "starlark") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.language", *body.Language, []any{"javascript", "starlark"}))
+		}
+	}
+	return
+}
+
+// ValidateAssignmentResponse runs the validations defined on AssignmentResponse
+func ValidateAssignmentResponse(body *AssignmentResponse) (err error) {
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Title == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("title", "body"))
+	}
+	if body.Description == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("description", "body"))
+	}
+	if body.OpensAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("opens_at", "body"))
+	}
+	if body.ClosesAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("closes_at", "body"))
+	}
+	if body.AllowLate == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("allow_late", "body"))
+	}
+	if body.Status == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("status", "body"))
+	}
+	if body.Exercises == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("exercises", "body"))
+	}
+	if body.CreatedBy == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("created_by", "body"))
+	}
+	if body.CreatedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("created_at", "body"))
+	}
+	if body.UpdatedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("updated_at", "body"))
+	}
+	if body.Status != nil {
+		if !(*body.Status == "upcoming" || *body.Status == "open" || *body.Status == "closed") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.status", *body.Status, []any{"upcoming", "open", "closed"}))
+		}
+	}
+	for _, e := range body.Exercises {
+		if e != nil {
+			if err2 := ValidateAssignmentExerciseResponse(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateAssignmentExerciseResponse runs the validations defined on
+// AssignmentExerciseResponse
+func ValidateAssignmentExerciseResponse(body *AssignmentExerciseResponse) (err error) {
+	if body.ExerciseID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("exercise_id", "body"))
+	}
+	if body.Position == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("position", "body"))
+	}
+	if body.Title == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("title", "body"))
+	}
+	if body.Difficulty == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("difficulty", "body"))
+	}
+	if body.Language == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("language", "body"))
+	}
+	if body.Difficulty != nil {
+		if !(*body.Difficulty == "easy" || *body.Difficulty == "medium" || *body.Difficulty == "hard") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.difficulty", *body.Difficulty, []any{"easy", "medium", "hard"}))
+		}
+	}
+	if body.Language != nil {
+		if !(*body.Language == "javascript" || *body.Language == "starlark") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.language", *body.Language, []any{"javascript", "starlark"}))
+		}
+	}
+	return
+}
+
+// ValidateUpdateAssignmentPayloadRequestBody runs the validations defined on
+// UpdateAssignmentPayloadRequestBody
+func ValidateUpdateAssignmentPayloadRequestBody(body *UpdateAssignmentPayloadRequestBody) (err error) {
+	if body.ExerciseIds == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("exercise_ids", "body"))
+	}
+	if utf8.RuneCountInString(body.Title) < 1 {
+		err = goa.MergeErrors(err, goa.InvalidLengthError("body.title", body.Title, utf8.RuneCountInString(body.Title), 1, true))
+	}
+	if utf8.RuneCountInString(body.Title) > 200 {
+		err = goa.MergeErrors(err, goa.InvalidLengthError("body.title", body.Title, utf8.RuneCountInString(body.Title), 200, false))
+	}
+	if len(body.ExerciseIds) < 1 {
+		err = goa.MergeErrors(err, goa.InvalidLengthError("body.exercise_ids", body.ExerciseIds, len(body.ExerciseIds), 1, true))
 	}
 	return
 }
 
-// ValidateGetExerciseForStudentResponseBody runs the validations defined on
-// GetExerciseForStudentResponseBody
-func ValidateGetExerciseForStudentResponseBody(body *GetExerciseForStudentResponseBody) (err error) {
+// ValidateAssignmentResponseBody runs the validations defined on
+// AssignmentResponseBody
+func ValidateAssignmentResponseBody(body *AssignmentResponseBody) (err error) {
 	if body.ID == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
 	}
@@ -1249,20 +5344,20 @@ func ValidateGetExerciseForStudentResponseBody(body *GetExerciseForStudentRespon
 	if body.Description == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("description", "body"))
 	}
-	if body.InitialCode == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("initial_code", "body"))
+	if body.OpensAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("opens_at", "body"))
 	}
-	if body.Difficulty == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("difficulty", "body"))
+	if body.ClosesAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("closes_at", "body"))
 	}
-	if body.Tests == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("tests", "body"))
+	if body.AllowLate == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("allow_late", "body"))
 	}
-	if body.Attempts == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("attempts", "body"))
+	if body.Status == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("status", "body"))
 	}
-	if body.Answer == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("answer", "body"))
+	if body.Exercises == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("exercises", "body"))
 	}
 	if body.CreatedBy == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("created_by", "body"))
@@ -1273,150 +5368,254 @@ func ValidateGetExerciseForStudentResponseBody(body *GetExerciseForStudentRespon
 	if body.UpdatedAt == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("updated_at", "body"))
 	}
-	if body.Title != nil {
-		if utf8.RuneCountInString(*body.Title) > 200 {
-			err = goa.MergeErrors(err, goa.InvalidLengthError("body.title", *body.Title, utf8.RuneCountInString(*body.Title), 200, false))
-		}
-	}
-	if body.Difficulty != nil {
-		if !(*body.Difficulty == "easy" || *body.Difficulty == "medium" || *body.Difficulty == "hard") {
-			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.difficulty", *body.Difficulty, []any{"easy", "medium", "hard"}))
+	if body.Status != nil {
+		if !(*body.Status == "upcoming" || *body.Status == "open" || *body.Status == "closed") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.status", *body.Status, []any{"upcoming", "open", "closed"}))
 		}
 	}
-	for _, e := range body.Tests {
+	for _, e := range body.Exercises {
 		if e != nil {
-			if err2 := ValidateTestResponseBody(e); err2 != nil {
+			if err2 := ValidateAssignmentExerciseResponseBody(e); err2 != nil {
 				err = goa.MergeErrors(err, err2)
 			}
 		}
 	}
-	for _, e := range body.Attempts {
+	return
+}
+
+// ValidateStudentAssignmentGradeResponseBody runs the validations defined on
+// StudentAssignmentGradeResponseBody
+func ValidateStudentAssignmentGradeResponseBody(body *StudentAssignmentGradeResponseBody) (err error) {
+	if body.UserID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("user_id", "body"))
+	}
+	if body.Score == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("score", "body"))
+	}
+	if body.ScoreWithLate == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("score_with_late", "body"))
+	}
+	if body.CompletedExercises == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("completed_exercises", "body"))
+	}
+	if body.TotalExercises == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("total_exercises", "body"))
+	}
+	if body.Exercises == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("exercises", "body"))
+	}
+	for _, e := range body.Exercises {
 		if e != nil {
-			if err2 := ValidateAttemptResponseBody(e); err2 != nil {
+			if err2 := ValidateExerciseGradeResponseBody(e); err2 != nil {
 				err = goa.MergeErrors(err, err2)
 			}
 		}
 	}
-	if body.Answer != nil {
-		if err2 := ValidateAnswerResponseBody(body.Answer); err2 != nil {
-			err = goa.MergeErrors(err, err2)
-		}
-	}
 	return
 }
 
-// ValidateCreateAttemptResponseBody runs the validations defined on
-// CreateAttemptResponseBody
-func ValidateCreateAttemptResponseBody(body *CreateAttemptResponseBody) (err error) {
-	if body.Success == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("success", "body"))
+// ValidateExerciseGradeResponseBody runs the validations defined on
+// ExerciseGradeResponseBody
+func ValidateExerciseGradeResponseBody(body *ExerciseGradeResponseBody) (err error) {
+	if body.ExerciseID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("exercise_id", "body"))
 	}
-	if body.Message == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	if body.Status == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("status", "body"))
+	}
+	if body.Attempts == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("attempts", "body"))
+	}
+	if body.LateAttempts == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("late_attempts", "body"))
+	}
+	if body.BestScore == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("best_score", "body"))
+	}
+	if body.BestScoreWithLate == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("best_score_with_late", "body"))
+	}
+	if body.Status != nil {
+		if !(*body.Status == "not_started" || *body.Status == "in_progress" || *body.Status == "completed" || *body.Status == "completed_late") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.status", *body.Status, []any{"not_started", "in_progress", "completed", "completed_late"}))
+		}
 	}
 	return
 }
 
-// ValidateGetAnswerByUserAndExerciseResponseBody runs the validations defined
-// on GetAnswerByUserAndExerciseResponseBody
-func ValidateGetAnswerByUserAndExerciseResponseBody(body *GetAnswerByUserAndExerciseResponseBody) (err error) {
-	if body.ID == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+// ValidateFailingTestResponseBody runs the validations defined on
+// FailingTestResponseBody
+func ValidateFailingTestResponseBody(body *FailingTestResponseBody) (err error) {
+	if body.TestID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("test_id", "body"))
 	}
-	if body.ExerciseID == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("exercise_id", "body"))
+	if body.Input == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("input", "body"))
+	}
+	if body.Public == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("public", "body"))
+	}
+	if body.Failures == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("failures", "body"))
 	}
+	return
+}
+
+// ValidateStudentExerciseStatusResponseBody runs the validations defined on
+// StudentExerciseStatusResponseBody
+func ValidateStudentExerciseStatusResponseBody(body *StudentExerciseStatusResponseBody) (err error) {
 	if body.UserID == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("user_id", "body"))
 	}
-	if body.Completed == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("completed", "body"))
+	if body.Status == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("status", "body"))
 	}
-	if body.CreatedAt == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("created_at", "body"))
+	if body.Attempts == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("attempts", "body"))
 	}
-	if body.UpdatedAt == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("updated_at", "body"))
+	if body.BestScore == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("best_score", "body"))
+	}
+	if body.HintsUsed == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("hints_used", "body"))
+	}
+	if body.Status != nil {
+		if !(*body.Status == "not_started" || *body.Status == "in_progress" || *body.Status == "completed") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.status", *body.Status, []any{"not_started", "in_progress", "completed"}))
+		}
 	}
 	return
 }
 
-// ValidateExerciseResponse runs the validations defined on ExerciseResponse
-func ValidateExerciseResponse(body *ExerciseResponse) (err error) {
+// ValidateAttemptResponseBody runs the validations defined on
+// AttemptResponseBody
+func ValidateAttemptResponseBody(body *AttemptResponseBody) (err error) {
 	if body.ID == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
 	}
-	if body.Title == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("title", "body"))
+	if body.AnswerID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("answer_id", "body"))
 	}
-	if body.Description == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("description", "body"))
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
 	}
-	if body.InitialCode == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("initial_code", "body"))
+	if body.Success == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("success", "body"))
 	}
-	if body.Solution == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("solution", "body"))
+	if body.CreatedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("created_at", "body"))
 	}
-	if body.Difficulty == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("difficulty", "body"))
+	if body.Status == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("status", "body"))
 	}
-	if body.CreatedBy == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("created_by", "body"))
+	if body.Points == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("points", "body"))
 	}
-	if body.CreatedAt == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("created_at", "body"))
+	if body.MaxPoints == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("max_points", "body"))
 	}
-	if body.UpdatedAt == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("updated_at", "body"))
+	if body.Score == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("score", "body"))
 	}
-	if body.Title != nil {
-		if utf8.RuneCountInString(*body.Title) > 200 {
-			err = goa.MergeErrors(err, goa.InvalidLengthError("body.title", *body.Title, utf8.RuneCountInString(*body.Title), 200, false))
+	if body.Late == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("late", "body"))
+	}
+	if body.ExerciseVersion == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("exercise_version", "body"))
+	}
+	if body.Status != nil {
+		if !(*body.Status == "pending" || *body.Status == "passed" || *body.Status == "failed" || *body.Status == "error" || *body.Status == "timeout" || *body.Status == "resource_exceeded") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.status", *body.Status, []any{"pending", "passed", "failed", "error", "timeout", "resource_exceeded"}))
 		}
 	}
-	if body.Difficulty != nil {
-		if !(*body.Difficulty == "easy" || *body.Difficulty == "medium" || *body.Difficulty == "hard") {
-			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.difficulty", *body.Difficulty, []any{"easy", "medium", "hard"}))
+	for _, e := range body.TestResults {
+		if e != nil {
+			if err2 := ValidateAttemptTestResultResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	if body.Score != nil {
+		if *body.Score < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.score", *body.Score, 0, true))
+		}
+	}
+	if body.Score != nil {
+		if *body.Score > 100 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.score", *body.Score, 100, false))
 		}
 	}
 	return
 }
 
-// ValidateUpdateExercisePayloadRequestBody runs the validations defined on
-// UpdateExercisePayloadRequestBody
-func ValidateUpdateExercisePayloadRequestBody(body *UpdateExercisePayloadRequestBody) (err error) {
-	if utf8.RuneCountInString(body.Title) > 200 {
-		err = goa.MergeErrors(err, goa.InvalidLengthError("body.title", body.Title, utf8.RuneCountInString(body.Title), 200, false))
+// ValidateAttemptTestResultResponseBody runs the validations defined on
+// AttemptTestResultResponseBody
+func ValidateAttemptTestResultResponseBody(body *AttemptTestResultResponseBody) (err error) {
+	if body.Status == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("status", "body"))
 	}
-	if !(body.Difficulty == "easy" || body.Difficulty == "medium" || body.Difficulty == "hard") {
-		err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.difficulty", body.Difficulty, []any{"easy", "medium", "hard"}))
+	if body.ActualOutput == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("actual_output", "body"))
+	}
+	if body.ConsoleOutput == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("console_output", "body"))
+	}
+	if body.DurationMs == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("duration_ms", "body"))
+	}
+	if body.Status != nil {
+		if !(*body.Status == "passed" || *body.Status == "failed" || *body.Status == "error" || *body.Status == "timeout" || *body.Status == "resource_exceeded") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.status", *body.Status, []any{"passed", "failed", "error", "timeout", "resource_exceeded"}))
+		}
 	}
 	return
 }
 
-// ValidateTestResponse runs the validations defined on TestResponse
-func ValidateTestResponse(body *TestResponse) (err error) {
-	if body.ID == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+// ValidatePlagiarismPairResponseBody runs the validations defined on
+// PlagiarismPairResponseBody
+func ValidatePlagiarismPairResponseBody(body *PlagiarismPairResponseBody) (err error) {
+	if body.AttemptAID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("attempt_a_id", "body"))
 	}
-	if body.Input == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("input", "body"))
+	if body.UserAID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("user_a_id", "body"))
 	}
-	if body.Output == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("output", "body"))
+	if body.AttemptBID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("attempt_b_id", "body"))
 	}
-	if body.Public == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("public", "body"))
+	if body.UserBID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("user_b_id", "body"))
 	}
-	if body.ExerciseID == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("exercise_id", "body"))
+	if body.Similarity == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("similarity", "body"))
 	}
-	if body.CreatedAt == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("created_at", "body"))
+	if body.Regions == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("regions", "body"))
 	}
-	if body.UpdatedAt == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("updated_at", "body"))
+	for _, e := range body.Regions {
+		if e != nil {
+			if err2 := ValidatePlagiarismRegionResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidatePlagiarismRegionResponseBody runs the validations defined on
+// PlagiarismRegionResponseBody
+func ValidatePlagiarismRegionResponseBody(body *PlagiarismRegionResponseBody) (err error) {
+	if body.StartLineA == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("start_line_a", "body"))
+	}
+	if body.EndLineA == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("end_line_a", "body"))
+	}
+	if body.StartLineB == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("start_line_b", "body"))
+	}
+	if body.EndLineB == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("end_line_b", "body"))
 	}
 	return
 }
@@ -1435,6 +5634,18 @@ func ValidateTestResponseBody(body *TestResponseBody) (err error) {
 	if body.Public == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("public", "body"))
 	}
+	if body.InputFormat == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("input_format", "body"))
+	}
+	if body.Comparison == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("comparison", "body"))
+	}
+	if body.Tolerance == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("tolerance", "body"))
+	}
+	if body.Weight == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("weight", "body"))
+	}
 	if body.ExerciseID == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("exercise_id", "body"))
 	}
@@ -1444,26 +5655,25 @@ func ValidateTestResponseBody(body *TestResponseBody) (err error) {
 	if body.UpdatedAt == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("updated_at", "body"))
 	}
-	return
-}
-
-// ValidateAttemptResponseBody runs the validations defined on
-// AttemptResponseBody
-func ValidateAttemptResponseBody(body *AttemptResponseBody) (err error) {
-	if body.ID == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
-	}
-	if body.AnswerID == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("answer_id", "body"))
+	if body.InputFormat != nil {
+		if !(*body.InputFormat == "text" || *body.InputFormat == "json") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.input_format", *body.InputFormat, []any{"text", "json"}))
+		}
 	}
-	if body.Code == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
+	if body.Comparison != nil {
+		if !(*body.Comparison == "exact" || *body.Comparison == "trimmed" || *body.Comparison == "numeric" || *body.Comparison == "json" || *body.Comparison == "unordered" || *body.Comparison == "regex") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.comparison", *body.Comparison, []any{"exact", "trimmed", "numeric", "json", "unordered", "regex"}))
+		}
 	}
-	if body.Success == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("success", "body"))
+	if body.Tolerance != nil {
+		if *body.Tolerance < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.tolerance", *body.Tolerance, 0, true))
+		}
 	}
-	if body.CreatedAt == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("created_at", "body"))
+	if body.Weight != nil {
+		if *body.Weight < 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.weight", *body.Weight, 1, true))
+		}
 	}
 	return
 }
@@ -1482,18 +5692,37 @@ func ValidateAnswerResponseBody(body *AnswerResponseBody) (err error) {
 	if body.Completed == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("completed", "body"))
 	}
+	if body.BestScore == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("best_score", "body"))
+	}
+	if body.HintsUsed == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("hints_used", "body"))
+	}
+	if body.HintPenalty == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("hint_penalty", "body"))
+	}
 	if body.CreatedAt == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("created_at", "body"))
 	}
 	if body.UpdatedAt == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("updated_at", "body"))
 	}
+	if body.BestScore != nil {
+		if *body.BestScore < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.best_score", *body.BestScore, 0, true))
+		}
+	}
+	if body.BestScore != nil {
+		if *body.BestScore > 100 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.best_score", *body.BestScore, 100, false))
+		}
+	}
 	return
 }
 
-// ValidateExerciseForStudentsListViewResponse runs the validations defined on
-// ExerciseForStudentsListViewResponse
-func ValidateExerciseForStudentsListViewResponse(body *ExerciseForStudentsListViewResponse) (err error) {
+// ValidateExerciseForStudentsListView runs the validations defined on
+// ExerciseForStudentsListView
+func ValidateExerciseForStudentsListView(body *ExerciseForStudentsListView) (err error) {
 	if body.ID == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
 	}
@@ -1506,6 +5735,15 @@ func ValidateExerciseForStudentsListViewResponse(body *ExerciseForStudentsListVi
 	if body.Difficulty == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("difficulty", "body"))
 	}
+	if body.Language == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("language", "body"))
+	}
+	if body.Tags == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("tags", "body"))
+	}
+	if body.Completed == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("completed", "body"))
+	}
 	if body.CreatedBy == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("created_by", "body"))
 	}
@@ -1525,6 +5763,37 @@ func ValidateExerciseForStudentsListViewResponse(body *ExerciseForStudentsListVi
 			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.difficulty", *body.Difficulty, []any{"easy", "medium", "hard"}))
 		}
 	}
+	if body.Language != nil {
+		if !(*body.Language == "javascript" || *body.Language == "starlark") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.language", *body.Language, []any{"javascript", "starlark"}))
+		}
+	}
+	return
+}
+
+// ValidateRunCaseResultResponseBody runs the validations defined on
+// RunCaseResultResponseBody
+func ValidateRunCaseResultResponseBody(body *RunCaseResultResponseBody) (err error) {
+	if body.Input == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("input", "body"))
+	}
+	if body.Status == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("status", "body"))
+	}
+	if body.Output == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("output", "body"))
+	}
+	if body.ConsoleOutput == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("console_output", "body"))
+	}
+	if body.DurationMs == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("duration_ms", "body"))
+	}
+	if body.Status != nil {
+		if !(*body.Status == "passed" || *body.Status == "failed" || *body.Status == "completed" || *body.Status == "error" || *body.Status == "timeout" || *body.Status == "resource_exceeded") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.status", *body.Status, []any{"passed", "failed", "completed", "error", "timeout", "resource_exceeded"}))
+		}
+	}
 	return
 }
 
@@ -1545,5 +5814,91 @@ func ValidateAttemptResponse(body *AttemptResponse) (err error) {
 	if body.CreatedAt == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("created_at", "body"))
 	}
+	if body.Status == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("status", "body"))
+	}
+	if body.Points == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("points", "body"))
+	}
+	if body.MaxPoints == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("max_points", "body"))
+	}
+	if body.Score == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("score", "body"))
+	}
+	if body.Late == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("late", "body"))
+	}
+	if body.ExerciseVersion == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("exercise_version", "body"))
+	}
+	if body.Status != nil {
+		if !(*body.Status == "pending" || *body.Status == "passed" || *body.Status == "failed" || *body.Status == "error" || *body.Status == "timeout" || *body.Status == "resource_exceeded") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.status", *body.Status, []any{"pending", "passed", "failed", "error", "timeout", "resource_exceeded"}))
+		}
+	}
+	for _, e := range body.TestResults {
+		if e != nil {
+			if err2 := ValidateAttemptTestResultResponse(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	if body.Score != nil {
+		if *body.Score < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.score", *body.Score, 0, true))
+		}
+	}
+	if body.Score != nil {
+		if *body.Score > 100 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.score", *body.Score, 100, false))
+		}
+	}
+	return
+}
+
+// ValidateAttemptTestResultResponse runs the validations defined on
+// AttemptTestResultResponse
+func ValidateAttemptTestResultResponse(body *AttemptTestResultResponse) (err error) {
+	if body.Status == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("status", "body"))
+	}
+	if body.ActualOutput == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("actual_output", "body"))
+	}
+	if body.ConsoleOutput == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("console_output", "body"))
+	}
+	if body.DurationMs == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("duration_ms", "body"))
+	}
+	if body.Status != nil {
+		if !(*body.Status == "passed" || *body.Status == "failed" || *body.Status == "error" || *body.Status == "timeout" || *body.Status == "resource_exceeded") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.status", *body.Status, []any{"passed", "failed", "error", "timeout", "resource_exceeded"}))
+		}
+	}
+	return
+}
+
+// ValidateStudentHintResponse runs the validations defined on
+// StudentHintResponse
+func ValidateStudentHintResponse(body *StudentHintResponse) (err error) {
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Position == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("position", "body"))
+	}
+	if body.Status == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("status", "body"))
+	}
+	if body.Penalty == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("penalty", "body"))
+	}
+	if body.Status != nil {
+		if !(*body.Status == "revealed" || *body.Status == "available" || *body.Status == "locked") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.status", *body.Status, []any{"revealed", "available", "locked"}))
+		}
+	}
 	return
 }
@@ -0,0 +1,70 @@
+// Code generated by goa v3.21.1, DO NOT EDIT.
+//
+// codelab WebSocket client streaming
+//
+// Command:
+// $ goa gen
+// github.com/ynoacamino/infra-sustainable-classrooms/services/codelab/design/api
+// -o ./services/codelab/
+
+package client
+
+import (
+	"context"
+	"io"
+
+	"github.com/gorilla/websocket"
+	codelab "github.com/ynoacamino/infra-sustainable-classrooms/services/codelab/gen/codelab"
+	goahttp "goa.design/goa/v3/http"
+)
+
+// ConnConfigurer holds the websocket connection configurer functions for the
+// streaming endpoints in "codelab" service.
+type ConnConfigurer struct {
+	StreamAttemptEventsFn goahttp.ConnConfigureFunc
+}
+
+// StreamAttemptEventsClientStream implements the
+// codelab.StreamAttemptEventsClientStream interface.
+type StreamAttemptEventsClientStream struct {
+	// conn is the underlying websocket connection.
+	conn *websocket.Conn
+}
+
+// NewConnConfigurer initializes the websocket connection configurer function
+// with fn for all the streaming endpoints in "codelab" service.
+func NewConnConfigurer(fn goahttp.ConnConfigureFunc) *ConnConfigurer {
+	return &ConnConfigurer{
+		StreamAttemptEventsFn: fn,
+	}
+}
+
+// Recv reads instances of "codelab.AttemptEvent" from the
+// "StreamAttemptEvents" endpoint websocket connection.
+func (s *StreamAttemptEventsClientStream) Recv() (*codelab.AttemptEvent, error) {
+	var (
+		rv   *codelab.AttemptEvent
+		body StreamAttemptEventsResponseBody
+		err  error
+	)
+	err = s.conn.ReadJSON(&body)
+	if websocket.IsCloseError(err, websocket.CloseNormalClosure) {
+		s.conn.Close()
+		return rv, io.EOF
+	}
+	if err != nil {
+		return rv, err
+	}
+	err = ValidateStreamAttemptEventsResponseBody(&body)
+	if err != nil {
+		return rv, err
+	}
+	res := NewStreamAttemptEventsAttemptEventOK(&body)
+	return res, nil
+}
+
+// RecvWithContext reads instances of "codelab.AttemptEvent" from the
+// "StreamAttemptEvents" endpoint websocket connection with context.
+func (s *StreamAttemptEventsClientStream) RecvWithContext(ctx context.Context) (*codelab.AttemptEvent, error) {
+	return s.Recv()
+}
@@ -15,6 +15,7 @@ import (
 	"io"
 	"net/http"
 	"strconv"
+	"unicode/utf8"
 
 	codelab "github.com/ynoacamino/infra-sustainable-classrooms/services/codelab/gen/codelab"
 	goahttp "goa.design/goa/v3/http"
@@ -86,6 +87,19 @@ func EncodeCreateExerciseError(encoder func(context.Context, http.ResponseWriter
 			return encodeError(ctx, w, v)
 		}
 		switch en.GoaErrorName() {
+		case "solution_failed":
+			var res *codelab.SolutionFailure
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewCreateExerciseSolutionFailedResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			return enc.Encode(body)
 		case "invalid_input":
 			var res codelab.InvalidInput
 			errors.As(v, &res)
@@ -215,9 +229,12 @@ func EncodeGetExerciseError(encoder func(context.Context, http.ResponseWriter) g
 // codelab ListExercises endpoint.
 func EncodeListExercisesResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
 	return func(ctx context.Context, w http.ResponseWriter, v any) error {
-		res, _ := v.([]*codelab.Exercise)
+		res, _ := v.(*codelab.ExercisePage)
 		enc := encoder(ctx, w)
 		body := NewListExercisesResponseBody(res)
+		if res.NextCursor != nil {
+			w.Header().Set("X-Next-Cursor", *res.NextCursor)
+		}
 		w.WriteHeader(http.StatusOK)
 		return enc.Encode(body)
 	}
@@ -228,10 +245,68 @@ func EncodeListExercisesResponse(encoder func(context.Context, http.ResponseWrit
 func DecodeListExercisesRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (any, error) {
 	return func(r *http.Request) (any, error) {
 		var (
+			search       *string
+			difficulty   *string
+			tags         []string
+			createdBy    *int64
+			cursor       *string
+			limit        int
 			sessionToken string
 			err          error
 			c            *http.Cookie
 		)
+		qp := r.URL.Query()
+		searchRaw := qp.Get("search")
+		if searchRaw != "" {
+			search = &searchRaw
+		}
+		if search != nil {
+			if utf8.RuneCountInString(*search) > 200 {
+				err = goa.MergeErrors(err, goa.InvalidLengthError("search", *search, utf8.RuneCountInString(*search), 200, false))
+			}
+		}
+		difficultyRaw := qp.Get("difficulty")
+		if difficultyRaw != "" {
+			difficulty = &difficultyRaw
+		}
+		if difficulty != nil {
+			if !(*difficulty == "easy" || *difficulty == "medium" || *difficulty == "hard") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("difficulty", *difficulty, []any{"easy", "medium", "hard"}))
+			}
+		}
+		tags = qp["tag"]
+		{
+			createdByRaw := qp.Get("author")
+			if createdByRaw != "" {
+				v, err2 := strconv.ParseInt(createdByRaw, 10, 64)
+				if err2 != nil {
+					err = goa.MergeErrors(err, goa.InvalidFieldTypeError("created_by", createdByRaw, "integer"))
+				}
+				createdBy = &v
+			}
+		}
+		cursorRaw := qp.Get("cursor")
+		if cursorRaw != "" {
+			cursor = &cursorRaw
+		}
+		{
+			limitRaw := qp.Get("limit")
+			if limitRaw == "" {
+				limit = 50
+			} else {
+				v, err2 := strconv.ParseInt(limitRaw, 10, strconv.IntSize)
+				if err2 != nil {
+					err = goa.MergeErrors(err, goa.InvalidFieldTypeError("limit", limitRaw, "integer"))
+				}
+				limit = int(v)
+			}
+		}
+		if limit < 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("limit", limit, 1, true))
+		}
+		if limit > 100 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("limit", limit, 100, false))
+		}
 		c, err = r.Cookie("session")
 		if err == http.ErrNoCookie {
 			err = goa.MergeErrors(err, goa.MissingFieldError("session_token", "cookie"))
@@ -241,7 +316,7 @@ func DecodeListExercisesRequest(mux goahttp.Muxer, decoder func(*http.Request) g
 		if err != nil {
 			return nil, err
 		}
-		payload := NewListExercisesPayload(sessionToken)
+		payload := NewListExercisesPayload(search, difficulty, tags, createdBy, cursor, limit, sessionToken)
 
 		return payload, nil
 	}
@@ -257,6 +332,14 @@ func EncodeListExercisesError(encoder func(context.Context, http.ResponseWriter)
 			return encodeError(ctx, w, v)
 		}
 		switch en.GoaErrorName() {
+		case "invalid_input":
+			var res codelab.InvalidInput
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
 		case "not_found":
 			var res codelab.NotFound
 			errors.As(v, &res)
@@ -295,6 +378,74 @@ func EncodeListExercisesError(encoder func(context.Context, http.ResponseWriter)
 	}
 }
 
+// EncodeListTagsResponse returns an encoder for responses returned by the
+// codelab ListTags endpoint.
+func EncodeListTagsResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.([]*codelab.TagCount)
+		enc := encoder(ctx, w)
+		body := NewListTagsResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeListTagsRequest returns a decoder for requests sent to the codelab
+// ListTags endpoint.
+func DecodeListTagsRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (any, error) {
+	return func(r *http.Request) (any, error) {
+		var (
+			sessionToken string
+			err          error
+			c            *http.Cookie
+		)
+		c, err = r.Cookie("session")
+		if err == http.ErrNoCookie {
+			err = goa.MergeErrors(err, goa.MissingFieldError("session_token", "cookie"))
+		} else {
+			sessionToken = c.Value
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewListTagsPayload(sessionToken)
+
+		return payload, nil
+	}
+}
+
+// EncodeListTagsError returns an encoder for errors returned by the ListTags
+// codelab endpoint.
+func EncodeListTagsError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "service_unavailable":
+			var res codelab.ServiceUnavailable
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return enc.Encode(body)
+		case "unauthorized":
+			var res codelab.Unauthorized
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusUnauthorized)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
 // EncodeUpdateExerciseResponse returns an encoder for responses returned by
 // the codelab UpdateExercise endpoint.
 func EncodeUpdateExerciseResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
@@ -371,6 +522,19 @@ func EncodeUpdateExerciseError(encoder func(context.Context, http.ResponseWriter
 			return encodeError(ctx, w, v)
 		}
 		switch en.GoaErrorName() {
+		case "solution_failed":
+			var res *codelab.SolutionFailure
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewUpdateExerciseSolutionFailedResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			return enc.Encode(body)
 		case "invalid_input":
 			var res codelab.InvalidInput
 			errors.As(v, &res)
@@ -520,46 +684,56 @@ func EncodeDeleteExerciseError(encoder func(context.Context, http.ResponseWriter
 	}
 }
 
-// EncodeCreateTestResponse returns an encoder for responses returned by the
-// codelab CreateTest endpoint.
-func EncodeCreateTestResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+// EncodeExportExercisesResponse returns an encoder for responses returned by
+// the codelab ExportExercises endpoint.
+func EncodeExportExercisesResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
 	return func(ctx context.Context, w http.ResponseWriter, v any) error {
-		res, _ := v.(*codelab.SimpleResponse)
+		res, _ := v.(*codelab.ExerciseBundleFile)
 		enc := encoder(ctx, w)
-		body := NewCreateTestResponseBody(res)
-		w.WriteHeader(http.StatusCreated)
+		body := NewExportExercisesResponseBody(res)
+		w.WriteHeader(http.StatusOK)
 		return enc.Encode(body)
 	}
 }
 
-// DecodeCreateTestRequest returns a decoder for requests sent to the codelab
-// CreateTest endpoint.
-func DecodeCreateTestRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (any, error) {
+// DecodeExportExercisesRequest returns a decoder for requests sent to the
+// codelab ExportExercises endpoint.
+func DecodeExportExercisesRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (any, error) {
 	return func(r *http.Request) (any, error) {
 		var (
-			body CreateTestRequestBody
-			err  error
+			exerciseIds  []int64
+			format       string
+			sessionToken string
+			err          error
+			c            *http.Cookie
 		)
-		err = decoder(r).Decode(&body)
-		if err != nil {
-			if err == io.EOF {
-				return nil, goa.MissingPayloadError()
+		qp := r.URL.Query()
+		{
+			exerciseIdsRaw := qp["exercise_ids"]
+			if exerciseIdsRaw == nil {
+				err = goa.MergeErrors(err, goa.MissingFieldError("exercise_ids", "query string"))
 			}
-			var gerr *goa.ServiceError
-			if errors.As(err, &gerr) {
-				return nil, gerr
+			exerciseIds = make([]int64, len(exerciseIdsRaw))
+			for i, rv := range exerciseIdsRaw {
+				v, err2 := strconv.ParseInt(rv, 10, 64)
+				if err2 != nil {
+					err = goa.MergeErrors(err, goa.InvalidFieldTypeError("exercise_ids", exerciseIdsRaw, "array of integers"))
+				}
+				exerciseIds[i] = v
 			}
-			return nil, goa.DecodePayloadError(err.Error())
 		}
-		err = ValidateCreateTestRequestBody(&body)
-		if err != nil {
-			return nil, err
+		if len(exerciseIds) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("exercise_ids", exerciseIds, len(exerciseIds), 1, true))
+		}
+		formatRaw := qp.Get("format")
+		if formatRaw != "" {
+			format = formatRaw
+		} else {
+			format = "json"
+		}
+		if !(format == "json" || format == "zip" || format == "tar.gz") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("format", format, []any{"json", "zip", "tar.gz"}))
 		}
-
-		var (
-			sessionToken string
-			c            *http.Cookie
-		)
 		c, err = r.Cookie("session")
 		if err == http.ErrNoCookie {
 			err = goa.MergeErrors(err, goa.MissingFieldError("session_token", "cookie"))
@@ -569,15 +743,15 @@ func DecodeCreateTestRequest(mux goahttp.Muxer, decoder func(*http.Request) goah
 		if err != nil {
 			return nil, err
 		}
-		payload := NewCreateTestPayload(&body, sessionToken)
+		payload := NewExportExercisesPayload(exerciseIds, format, sessionToken)
 
 		return payload, nil
 	}
 }
 
-// EncodeCreateTestError returns an encoder for errors returned by the
-// CreateTest codelab endpoint.
-func EncodeCreateTestError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+// EncodeExportExercisesError returns an encoder for errors returned by the
+// ExportExercises codelab endpoint.
+func EncodeExportExercisesError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
 	encodeError := goahttp.ErrorEncoder(encoder, formatter)
 	return func(ctx context.Context, w http.ResponseWriter, v error) error {
 		var en goa.GoaErrorNamer
@@ -593,6 +767,14 @@ func EncodeCreateTestError(encoder func(context.Context, http.ResponseWriter) go
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusBadRequest)
 			return enc.Encode(body)
+		case "not_found":
+			var res codelab.NotFound
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
 		case "permission_denied":
 			var res codelab.PermissionDenied
 			errors.As(v, &res)
@@ -623,38 +805,46 @@ func EncodeCreateTestError(encoder func(context.Context, http.ResponseWriter) go
 	}
 }
 
-// EncodeGetTestsByExerciseResponse returns an encoder for responses returned
-// by the codelab GetTestsByExercise endpoint.
-func EncodeGetTestsByExerciseResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+// EncodeImportExercisesResponse returns an encoder for responses returned by
+// the codelab ImportExercises endpoint.
+func EncodeImportExercisesResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
 	return func(ctx context.Context, w http.ResponseWriter, v any) error {
-		res, _ := v.([]*codelab.Test)
+		res, _ := v.(*codelab.ImportedExercises)
 		enc := encoder(ctx, w)
-		body := NewGetTestsByExerciseResponseBody(res)
-		w.WriteHeader(http.StatusOK)
+		body := NewImportExercisesResponseBody(res)
+		w.WriteHeader(http.StatusCreated)
 		return enc.Encode(body)
 	}
 }
 
-// DecodeGetTestsByExerciseRequest returns a decoder for requests sent to the
-// codelab GetTestsByExercise endpoint.
-func DecodeGetTestsByExerciseRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (any, error) {
+// DecodeImportExercisesRequest returns a decoder for requests sent to the
+// codelab ImportExercises endpoint.
+func DecodeImportExercisesRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (any, error) {
 	return func(r *http.Request) (any, error) {
 		var (
-			exerciseID   int64
-			sessionToken string
-			err          error
-			c            *http.Cookie
-
-			params = mux.Vars(r)
+			body ImportExercisesRequestBody
+			err  error
 		)
-		{
-			exerciseIDRaw := params["exercise_id"]
-			v, err2 := strconv.ParseInt(exerciseIDRaw, 10, 64)
-			if err2 != nil {
-				err = goa.MergeErrors(err, goa.InvalidFieldTypeError("exercise_id", exerciseIDRaw, "integer"))
+		err = decoder(r).Decode(&body)
+		if err != nil {
+			if err == io.EOF {
+				return nil, goa.MissingPayloadError()
 			}
-			exerciseID = v
+			var gerr *goa.ServiceError
+			if errors.As(err, &gerr) {
+				return nil, gerr
+			}
+			return nil, goa.DecodePayloadError(err.Error())
+		}
+		err = ValidateImportExercisesRequestBody(&body)
+		if err != nil {
+			return nil, err
 		}
+
+		var (
+			sessionToken string
+			c            *http.Cookie
+		)
 		c, err = r.Cookie("session")
 		if err == http.ErrNoCookie {
 			err = goa.MergeErrors(err, goa.MissingFieldError("session_token", "cookie"))
@@ -664,15 +854,15 @@ func DecodeGetTestsByExerciseRequest(mux goahttp.Muxer, decoder func(*http.Reque
 		if err != nil {
 			return nil, err
 		}
-		payload := NewGetTestsByExercisePayload(exerciseID, sessionToken)
+		payload := NewImportExercisesPayload(&body, sessionToken)
 
 		return payload, nil
 	}
 }
 
-// EncodeGetTestsByExerciseError returns an encoder for errors returned by the
-// GetTestsByExercise codelab endpoint.
-func EncodeGetTestsByExerciseError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+// EncodeImportExercisesError returns an encoder for errors returned by the
+// ImportExercises codelab endpoint.
+func EncodeImportExercisesError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
 	encodeError := goahttp.ErrorEncoder(encoder, formatter)
 	return func(ctx context.Context, w http.ResponseWriter, v error) error {
 		var en goa.GoaErrorNamer
@@ -680,21 +870,26 @@ func EncodeGetTestsByExerciseError(encoder func(context.Context, http.ResponseWr
 			return encodeError(ctx, w, v)
 		}
 		switch en.GoaErrorName() {
-		case "invalid_input":
-			var res codelab.InvalidInput
+		case "solution_failed":
+			var res *codelab.SolutionFailure
 			errors.As(v, &res)
 			enc := encoder(ctx, w)
-			body := res
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewImportExercisesSolutionFailedResponseBody(res)
+			}
 			w.Header().Set("goa-error", res.GoaErrorName())
-			w.WriteHeader(http.StatusBadRequest)
+			w.WriteHeader(http.StatusUnprocessableEntity)
 			return enc.Encode(body)
-		case "not_found":
-			var res codelab.NotFound
+		case "invalid_input":
+			var res codelab.InvalidInput
 			errors.As(v, &res)
 			enc := encoder(ctx, w)
 			body := res
 			w.Header().Set("goa-error", res.GoaErrorName())
-			w.WriteHeader(http.StatusNotFound)
+			w.WriteHeader(http.StatusBadRequest)
 			return enc.Encode(body)
 		case "permission_denied":
 			var res codelab.PermissionDenied
@@ -726,24 +921,24 @@ func EncodeGetTestsByExerciseError(encoder func(context.Context, http.ResponseWr
 	}
 }
 
-// EncodeUpdateTestResponse returns an encoder for responses returned by the
-// codelab UpdateTest endpoint.
-func EncodeUpdateTestResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+// EncodeCreateTestResponse returns an encoder for responses returned by the
+// codelab CreateTest endpoint.
+func EncodeCreateTestResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
 	return func(ctx context.Context, w http.ResponseWriter, v any) error {
 		res, _ := v.(*codelab.SimpleResponse)
 		enc := encoder(ctx, w)
-		body := NewUpdateTestResponseBody(res)
-		w.WriteHeader(http.StatusOK)
+		body := NewCreateTestResponseBody(res)
+		w.WriteHeader(http.StatusCreated)
 		return enc.Encode(body)
 	}
 }
 
-// DecodeUpdateTestRequest returns a decoder for requests sent to the codelab
-// UpdateTest endpoint.
-func DecodeUpdateTestRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (any, error) {
+// DecodeCreateTestRequest returns a decoder for requests sent to the codelab
+// CreateTest endpoint.
+func DecodeCreateTestRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (any, error) {
 	return func(r *http.Request) (any, error) {
 		var (
-			body UpdateTestRequestBody
+			body CreateTestRequestBody
 			err  error
 		)
 		err = decoder(r).Decode(&body)
@@ -757,26 +952,15 @@ func DecodeUpdateTestRequest(mux goahttp.Muxer, decoder func(*http.Request) goah
 			}
 			return nil, goa.DecodePayloadError(err.Error())
 		}
-		err = ValidateUpdateTestRequestBody(&body)
+		err = ValidateCreateTestRequestBody(&body)
 		if err != nil {
 			return nil, err
 		}
 
 		var (
-			id           int64
 			sessionToken string
 			c            *http.Cookie
-
-			params = mux.Vars(r)
 		)
-		{
-			idRaw := params["id"]
-			v, err2 := strconv.ParseInt(idRaw, 10, 64)
-			if err2 != nil {
-				err = goa.MergeErrors(err, goa.InvalidFieldTypeError("id", idRaw, "integer"))
-			}
-			id = v
-		}
 		c, err = r.Cookie("session")
 		if err == http.ErrNoCookie {
 			err = goa.MergeErrors(err, goa.MissingFieldError("session_token", "cookie"))
@@ -786,15 +970,15 @@ func DecodeUpdateTestRequest(mux goahttp.Muxer, decoder func(*http.Request) goah
 		if err != nil {
 			return nil, err
 		}
-		payload := NewUpdateTestPayload2(&body, id, sessionToken)
+		payload := NewCreateTestPayload(&body, sessionToken)
 
 		return payload, nil
 	}
 }
 
-// EncodeUpdateTestError returns an encoder for errors returned by the
-// UpdateTest codelab endpoint.
-func EncodeUpdateTestError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+// EncodeCreateTestError returns an encoder for errors returned by the
+// CreateTest codelab endpoint.
+func EncodeCreateTestError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
 	encodeError := goahttp.ErrorEncoder(encoder, formatter)
 	return func(ctx context.Context, w http.ResponseWriter, v error) error {
 		var en goa.GoaErrorNamer
@@ -802,21 +986,26 @@ func EncodeUpdateTestError(encoder func(context.Context, http.ResponseWriter) go
 			return encodeError(ctx, w, v)
 		}
 		switch en.GoaErrorName() {
-		case "invalid_input":
-			var res codelab.InvalidInput
+		case "solution_failed":
+			var res *codelab.SolutionFailure
 			errors.As(v, &res)
 			enc := encoder(ctx, w)
-			body := res
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewCreateTestSolutionFailedResponseBody(res)
+			}
 			w.Header().Set("goa-error", res.GoaErrorName())
-			w.WriteHeader(http.StatusBadRequest)
+			w.WriteHeader(http.StatusUnprocessableEntity)
 			return enc.Encode(body)
-		case "not_found":
-			var res codelab.NotFound
+		case "invalid_input":
+			var res codelab.InvalidInput
 			errors.As(v, &res)
 			enc := encoder(ctx, w)
 			body := res
 			w.Header().Set("goa-error", res.GoaErrorName())
-			w.WriteHeader(http.StatusNotFound)
+			w.WriteHeader(http.StatusBadRequest)
 			return enc.Encode(body)
 		case "permission_denied":
 			var res codelab.PermissionDenied
@@ -848,24 +1037,24 @@ func EncodeUpdateTestError(encoder func(context.Context, http.ResponseWriter) go
 	}
 }
 
-// EncodeDeleteTestResponse returns an encoder for responses returned by the
-// codelab DeleteTest endpoint.
-func EncodeDeleteTestResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+// EncodeGetTestsByExerciseResponse returns an encoder for responses returned
+// by the codelab GetTestsByExercise endpoint.
+func EncodeGetTestsByExerciseResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
 	return func(ctx context.Context, w http.ResponseWriter, v any) error {
-		res, _ := v.(*codelab.SimpleResponse)
+		res, _ := v.([]*codelab.Test)
 		enc := encoder(ctx, w)
-		body := NewDeleteTestResponseBody(res)
+		body := NewGetTestsByExerciseResponseBody(res)
 		w.WriteHeader(http.StatusOK)
 		return enc.Encode(body)
 	}
 }
 
-// DecodeDeleteTestRequest returns a decoder for requests sent to the codelab
-// DeleteTest endpoint.
-func DecodeDeleteTestRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (any, error) {
-	return func(r *http.Request) (any, error) {
+// DecodeGetTestsByExerciseRequest returns a decoder for requests sent to the
+// codelab GetTestsByExercise endpoint.
+func DecodeGetTestsByExerciseRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (any, error) {
+	return func(r *http.Request) (any, error) {
 		var (
-			id           int64
+			exerciseID   int64
 			sessionToken string
 			err          error
 			c            *http.Cookie
@@ -873,12 +1062,12 @@ func DecodeDeleteTestRequest(mux goahttp.Muxer, decoder func(*http.Request) goah
 			params = mux.Vars(r)
 		)
 		{
-			idRaw := params["id"]
-			v, err2 := strconv.ParseInt(idRaw, 10, 64)
+			exerciseIDRaw := params["exercise_id"]
+			v, err2 := strconv.ParseInt(exerciseIDRaw, 10, 64)
 			if err2 != nil {
-				err = goa.MergeErrors(err, goa.InvalidFieldTypeError("id", idRaw, "integer"))
+				err = goa.MergeErrors(err, goa.InvalidFieldTypeError("exercise_id", exerciseIDRaw, "integer"))
 			}
-			id = v
+			exerciseID = v
 		}
 		c, err = r.Cookie("session")
 		if err == http.ErrNoCookie {
@@ -889,15 +1078,15 @@ func DecodeDeleteTestRequest(mux goahttp.Muxer, decoder func(*http.Request) goah
 		if err != nil {
 			return nil, err
 		}
-		payload := NewDeleteTestPayload(id, sessionToken)
+		payload := NewGetTestsByExercisePayload(exerciseID, sessionToken)
 
 		return payload, nil
 	}
 }
 
-// EncodeDeleteTestError returns an encoder for errors returned by the
-// DeleteTest codelab endpoint.
-func EncodeDeleteTestError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+// EncodeGetTestsByExerciseError returns an encoder for errors returned by the
+// GetTestsByExercise codelab endpoint.
+func EncodeGetTestsByExerciseError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
 	encodeError := goahttp.ErrorEncoder(encoder, formatter)
 	return func(ctx context.Context, w http.ResponseWriter, v error) error {
 		var en goa.GoaErrorNamer
@@ -951,26 +1140,45 @@ func EncodeDeleteTestError(encoder func(context.Context, http.ResponseWriter) go
 	}
 }
 
-// EncodeGetExerciseForStudentResponse returns an encoder for responses
-// returned by the codelab GetExerciseForStudent endpoint.
-func EncodeGetExerciseForStudentResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+// EncodeUpdateTestResponse returns an encoder for responses returned by the
+// codelab UpdateTest endpoint.
+func EncodeUpdateTestResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
 	return func(ctx context.Context, w http.ResponseWriter, v any) error {
-		res, _ := v.(*codelab.ExerciseForStudents)
+		res, _ := v.(*codelab.SimpleResponse)
 		enc := encoder(ctx, w)
-		body := NewGetExerciseForStudentResponseBody(res)
+		body := NewUpdateTestResponseBody(res)
 		w.WriteHeader(http.StatusOK)
 		return enc.Encode(body)
 	}
 }
 
-// DecodeGetExerciseForStudentRequest returns a decoder for requests sent to
-// the codelab GetExerciseForStudent endpoint.
-func DecodeGetExerciseForStudentRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (any, error) {
+// DecodeUpdateTestRequest returns a decoder for requests sent to the codelab
+// UpdateTest endpoint.
+func DecodeUpdateTestRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (any, error) {
 	return func(r *http.Request) (any, error) {
+		var (
+			body UpdateTestRequestBody
+			err  error
+		)
+		err = decoder(r).Decode(&body)
+		if err != nil {
+			if err == io.EOF {
+				return nil, goa.MissingPayloadError()
+			}
+			var gerr *goa.ServiceError
+			if errors.As(err, &gerr) {
+				return nil, gerr
+			}
+			return nil, goa.DecodePayloadError(err.Error())
+		}
+		err = ValidateUpdateTestRequestBody(&body)
+		if err != nil {
+			return nil, err
+		}
+
 		var (
 			id           int64
 			sessionToken string
-			err          error
 			c            *http.Cookie
 
 			params = mux.Vars(r)
@@ -992,15 +1200,15 @@ func DecodeGetExerciseForStudentRequest(mux goahttp.Muxer, decoder func(*http.Re
 		if err != nil {
 			return nil, err
 		}
-		payload := NewGetExerciseForStudentPayload(id, sessionToken)
+		payload := NewUpdateTestPayload2(&body, id, sessionToken)
 
 		return payload, nil
 	}
 }
 
-// EncodeGetExerciseForStudentError returns an encoder for errors returned by
-// the GetExerciseForStudent codelab endpoint.
-func EncodeGetExerciseForStudentError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+// EncodeUpdateTestError returns an encoder for errors returned by the
+// UpdateTest codelab endpoint.
+func EncodeUpdateTestError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
 	encodeError := goahttp.ErrorEncoder(encoder, formatter)
 	return func(ctx context.Context, w http.ResponseWriter, v error) error {
 		var en goa.GoaErrorNamer
@@ -1008,6 +1216,19 @@ func EncodeGetExerciseForStudentError(encoder func(context.Context, http.Respons
 			return encodeError(ctx, w, v)
 		}
 		switch en.GoaErrorName() {
+		case "solution_failed":
+			var res *codelab.SolutionFailure
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewUpdateTestSolutionFailedResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			return enc.Encode(body)
 		case "invalid_input":
 			var res codelab.InvalidInput
 			errors.As(v, &res)
@@ -1054,27 +1275,38 @@ func EncodeGetExerciseForStudentError(encoder func(context.Context, http.Respons
 	}
 }
 
-// EncodeListExercisesForStudentsResponse returns an encoder for responses
-// returned by the codelab ListExercisesForStudents endpoint.
-func EncodeListExercisesForStudentsResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+// EncodeDeleteTestResponse returns an encoder for responses returned by the
+// codelab DeleteTest endpoint.
+func EncodeDeleteTestResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
 	return func(ctx context.Context, w http.ResponseWriter, v any) error {
-		res, _ := v.([]*codelab.ExerciseForStudentsListView)
+		res, _ := v.(*codelab.SimpleResponse)
 		enc := encoder(ctx, w)
-		body := NewListExercisesForStudentsResponseBody(res)
+		body := NewDeleteTestResponseBody(res)
 		w.WriteHeader(http.StatusOK)
 		return enc.Encode(body)
 	}
 }
 
-// DecodeListExercisesForStudentsRequest returns a decoder for requests sent to
-// the codelab ListExercisesForStudents endpoint.
-func DecodeListExercisesForStudentsRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (any, error) {
+// DecodeDeleteTestRequest returns a decoder for requests sent to the codelab
+// DeleteTest endpoint.
+func DecodeDeleteTestRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (any, error) {
 	return func(r *http.Request) (any, error) {
 		var (
+			id           int64
 			sessionToken string
 			err          error
 			c            *http.Cookie
+
+			params = mux.Vars(r)
 		)
+		{
+			idRaw := params["id"]
+			v, err2 := strconv.ParseInt(idRaw, 10, 64)
+			if err2 != nil {
+				err = goa.MergeErrors(err, goa.InvalidFieldTypeError("id", idRaw, "integer"))
+			}
+			id = v
+		}
 		c, err = r.Cookie("session")
 		if err == http.ErrNoCookie {
 			err = goa.MergeErrors(err, goa.MissingFieldError("session_token", "cookie"))
@@ -1084,15 +1316,15 @@ func DecodeListExercisesForStudentsRequest(mux goahttp.Muxer, decoder func(*http
 		if err != nil {
 			return nil, err
 		}
-		payload := NewListExercisesForStudentsPayload(sessionToken)
+		payload := NewDeleteTestPayload(id, sessionToken)
 
 		return payload, nil
 	}
 }
 
-// EncodeListExercisesForStudentsError returns an encoder for errors returned
-// by the ListExercisesForStudents codelab endpoint.
-func EncodeListExercisesForStudentsError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+// EncodeDeleteTestError returns an encoder for errors returned by the
+// DeleteTest codelab endpoint.
+func EncodeDeleteTestError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
 	encodeError := goahttp.ErrorEncoder(encoder, formatter)
 	return func(ctx context.Context, w http.ResponseWriter, v error) error {
 		var en goa.GoaErrorNamer
@@ -1146,46 +1378,38 @@ func EncodeListExercisesForStudentsError(encoder func(context.Context, http.Resp
 	}
 }
 
-// EncodeCreateAttemptResponse returns an encoder for responses returned by the
-// codelab CreateAttempt endpoint.
-func EncodeCreateAttemptResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+// EncodeListExerciseVersionsResponse returns an encoder for responses returned
+// by the codelab ListExerciseVersions endpoint.
+func EncodeListExerciseVersionsResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
 	return func(ctx context.Context, w http.ResponseWriter, v any) error {
-		res, _ := v.(*codelab.SimpleResponse)
+		res, _ := v.([]*codelab.ExerciseVersion)
 		enc := encoder(ctx, w)
-		body := NewCreateAttemptResponseBody(res)
-		w.WriteHeader(http.StatusCreated)
+		body := NewListExerciseVersionsResponseBody(res)
+		w.WriteHeader(http.StatusOK)
 		return enc.Encode(body)
 	}
 }
 
-// DecodeCreateAttemptRequest returns a decoder for requests sent to the
-// codelab CreateAttempt endpoint.
-func DecodeCreateAttemptRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (any, error) {
+// DecodeListExerciseVersionsRequest returns a decoder for requests sent to the
+// codelab ListExerciseVersions endpoint.
+func DecodeListExerciseVersionsRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (any, error) {
 	return func(r *http.Request) (any, error) {
 		var (
-			body CreateAttemptRequestBody
-			err  error
-		)
-		err = decoder(r).Decode(&body)
-		if err != nil {
-			if err == io.EOF {
-				return nil, goa.MissingPayloadError()
-			}
-			var gerr *goa.ServiceError
-			if errors.As(err, &gerr) {
-				return nil, gerr
-			}
-			return nil, goa.DecodePayloadError(err.Error())
-		}
-		err = ValidateCreateAttemptRequestBody(&body)
-		if err != nil {
-			return nil, err
-		}
-
-		var (
+			exerciseID   int64
 			sessionToken string
+			err          error
 			c            *http.Cookie
+
+			params = mux.Vars(r)
 		)
+		{
+			exerciseIDRaw := params["exercise_id"]
+			v, err2 := strconv.ParseInt(exerciseIDRaw, 10, 64)
+			if err2 != nil {
+				err = goa.MergeErrors(err, goa.InvalidFieldTypeError("exercise_id", exerciseIDRaw, "integer"))
+			}
+			exerciseID = v
+		}
 		c, err = r.Cookie("session")
 		if err == http.ErrNoCookie {
 			err = goa.MergeErrors(err, goa.MissingFieldError("session_token", "cookie"))
@@ -1195,15 +1419,15 @@ func DecodeCreateAttemptRequest(mux goahttp.Muxer, decoder func(*http.Request) g
 		if err != nil {
 			return nil, err
 		}
-		payload := NewCreateAttemptPayload(&body, sessionToken)
+		payload := NewListExerciseVersionsPayload(exerciseID, sessionToken)
 
 		return payload, nil
 	}
 }
 
-// EncodeCreateAttemptError returns an encoder for errors returned by the
-// CreateAttempt codelab endpoint.
-func EncodeCreateAttemptError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+// EncodeListExerciseVersionsError returns an encoder for errors returned by
+// the ListExerciseVersions codelab endpoint.
+func EncodeListExerciseVersionsError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
 	encodeError := goahttp.ErrorEncoder(encoder, formatter)
 	return func(ctx context.Context, w http.ResponseWriter, v error) error {
 		var en goa.GoaErrorNamer
@@ -1257,39 +1481,32 @@ func EncodeCreateAttemptError(encoder func(context.Context, http.ResponseWriter)
 	}
 }
 
-// EncodeGetAttemptsByUserAndExerciseResponse returns an encoder for responses
-// returned by the codelab GetAttemptsByUserAndExercise endpoint.
-func EncodeGetAttemptsByUserAndExerciseResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+// EncodeDiffExerciseVersionsResponse returns an encoder for responses returned
+// by the codelab DiffExerciseVersions endpoint.
+func EncodeDiffExerciseVersionsResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
 	return func(ctx context.Context, w http.ResponseWriter, v any) error {
-		res, _ := v.([]*codelab.Attempt)
+		res, _ := v.(*codelab.ExerciseVersionDiff)
 		enc := encoder(ctx, w)
-		body := NewGetAttemptsByUserAndExerciseResponseBody(res)
+		body := NewDiffExerciseVersionsResponseBody(res)
 		w.WriteHeader(http.StatusOK)
 		return enc.Encode(body)
 	}
 }
 
-// DecodeGetAttemptsByUserAndExerciseRequest returns a decoder for requests
-// sent to the codelab GetAttemptsByUserAndExercise endpoint.
-func DecodeGetAttemptsByUserAndExerciseRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (any, error) {
+// DecodeDiffExerciseVersionsRequest returns a decoder for requests sent to the
+// codelab DiffExerciseVersions endpoint.
+func DecodeDiffExerciseVersionsRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (any, error) {
 	return func(r *http.Request) (any, error) {
 		var (
-			userID       int64
 			exerciseID   int64
+			from         int32
+			to           int32
 			sessionToken string
 			err          error
 			c            *http.Cookie
 
 			params = mux.Vars(r)
 		)
-		{
-			userIDRaw := params["user_id"]
-			v, err2 := strconv.ParseInt(userIDRaw, 10, 64)
-			if err2 != nil {
-				err = goa.MergeErrors(err, goa.InvalidFieldTypeError("user_id", userIDRaw, "integer"))
-			}
-			userID = v
-		}
 		{
 			exerciseIDRaw := params["exercise_id"]
 			v, err2 := strconv.ParseInt(exerciseIDRaw, 10, 64)
@@ -1298,6 +1515,35 @@ func DecodeGetAttemptsByUserAndExerciseRequest(mux goahttp.Muxer, decoder func(*
 			}
 			exerciseID = v
 		}
+		qp := r.URL.Query()
+		{
+			fromRaw := qp.Get("from")
+			if fromRaw == "" {
+				err = goa.MergeErrors(err, goa.MissingFieldError("from", "query string"))
+			}
+			v, err2 := strconv.ParseInt(fromRaw, 10, 32)
+			if err2 != nil {
+				err = goa.MergeErrors(err, goa.InvalidFieldTypeError("from", fromRaw, "integer"))
+			}
+			from = int32(v)
+		}
+		if from < 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("from", from, 1, true))
+		}
+		{
+			toRaw := qp.Get("to")
+			if toRaw == "" {
+				err = goa.MergeErrors(err, goa.MissingFieldError("to", "query string"))
+			}
+			v, err2 := strconv.ParseInt(toRaw, 10, 32)
+			if err2 != nil {
+				err = goa.MergeErrors(err, goa.InvalidFieldTypeError("to", toRaw, "integer"))
+			}
+			to = int32(v)
+		}
+		if to < 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("to", to, 1, true))
+		}
 		c, err = r.Cookie("session")
 		if err == http.ErrNoCookie {
 			err = goa.MergeErrors(err, goa.MissingFieldError("session_token", "cookie"))
@@ -1307,15 +1553,15 @@ func DecodeGetAttemptsByUserAndExerciseRequest(mux goahttp.Muxer, decoder func(*
 		if err != nil {
 			return nil, err
 		}
-		payload := NewGetAttemptsByUserAndExercisePayload(userID, exerciseID, sessionToken)
+		payload := NewDiffExerciseVersionsPayload(exerciseID, from, to, sessionToken)
 
 		return payload, nil
 	}
 }
 
-// EncodeGetAttemptsByUserAndExerciseError returns an encoder for errors
-// returned by the GetAttemptsByUserAndExercise codelab endpoint.
-func EncodeGetAttemptsByUserAndExerciseError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+// EncodeDiffExerciseVersionsError returns an encoder for errors returned by
+// the DiffExerciseVersions codelab endpoint.
+func EncodeDiffExerciseVersionsError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
 	encodeError := goahttp.ErrorEncoder(encoder, formatter)
 	return func(ctx context.Context, w http.ResponseWriter, v error) error {
 		var en goa.GoaErrorNamer
@@ -1369,39 +1615,31 @@ func EncodeGetAttemptsByUserAndExerciseError(encoder func(context.Context, http.
 	}
 }
 
-// EncodeGetAnswerByUserAndExerciseResponse returns an encoder for responses
-// returned by the codelab GetAnswerByUserAndExercise endpoint.
-func EncodeGetAnswerByUserAndExerciseResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+// EncodeRollbackExerciseResponse returns an encoder for responses returned by
+// the codelab RollbackExercise endpoint.
+func EncodeRollbackExerciseResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
 	return func(ctx context.Context, w http.ResponseWriter, v any) error {
-		res, _ := v.(*codelab.Answer)
+		res, _ := v.(*codelab.SimpleResponse)
 		enc := encoder(ctx, w)
-		body := NewGetAnswerByUserAndExerciseResponseBody(res)
+		body := NewRollbackExerciseResponseBody(res)
 		w.WriteHeader(http.StatusOK)
 		return enc.Encode(body)
 	}
 }
 
-// DecodeGetAnswerByUserAndExerciseRequest returns a decoder for requests sent
-// to the codelab GetAnswerByUserAndExercise endpoint.
-func DecodeGetAnswerByUserAndExerciseRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (any, error) {
+// DecodeRollbackExerciseRequest returns a decoder for requests sent to the
+// codelab RollbackExercise endpoint.
+func DecodeRollbackExerciseRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (any, error) {
 	return func(r *http.Request) (any, error) {
 		var (
-			userID       int64
 			exerciseID   int64
+			version      int32
 			sessionToken string
 			err          error
 			c            *http.Cookie
 
 			params = mux.Vars(r)
 		)
-		{
-			userIDRaw := params["user_id"]
-			v, err2 := strconv.ParseInt(userIDRaw, 10, 64)
-			if err2 != nil {
-				err = goa.MergeErrors(err, goa.InvalidFieldTypeError("user_id", userIDRaw, "integer"))
-			}
-			userID = v
-		}
 		{
 			exerciseIDRaw := params["exercise_id"]
 			v, err2 := strconv.ParseInt(exerciseIDRaw, 10, 64)
@@ -1410,6 +1648,17 @@ func DecodeGetAnswerByUserAndExerciseRequest(mux goahttp.Muxer, decoder func(*ht
 			}
 			exerciseID = v
 		}
+		{
+			versionRaw := params["version"]
+			v, err2 := strconv.ParseInt(versionRaw, 10, 32)
+			if err2 != nil {
+				err = goa.MergeErrors(err, goa.InvalidFieldTypeError("version", versionRaw, "integer"))
+			}
+			version = int32(v)
+		}
+		if version < 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("version", version, 1, true))
+		}
 		c, err = r.Cookie("session")
 		if err == http.ErrNoCookie {
 			err = goa.MergeErrors(err, goa.MissingFieldError("session_token", "cookie"))
@@ -1419,15 +1668,15 @@ func DecodeGetAnswerByUserAndExerciseRequest(mux goahttp.Muxer, decoder func(*ht
 		if err != nil {
 			return nil, err
 		}
-		payload := NewGetAnswerByUserAndExercisePayload(userID, exerciseID, sessionToken)
+		payload := NewRollbackExercisePayload(exerciseID, version, sessionToken)
 
 		return payload, nil
 	}
 }
 
-// EncodeGetAnswerByUserAndExerciseError returns an encoder for errors returned
-// by the GetAnswerByUserAndExercise codelab endpoint.
-func EncodeGetAnswerByUserAndExerciseError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+// EncodeRollbackExerciseError returns an encoder for errors returned by the
+// RollbackExercise codelab endpoint.
+func EncodeRollbackExerciseError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
 	encodeError := goahttp.ErrorEncoder(encoder, formatter)
 	return func(ctx context.Context, w http.ResponseWriter, v error) error {
 		var en goa.GoaErrorNamer
@@ -1435,6 +1684,19 @@ func EncodeGetAnswerByUserAndExerciseError(encoder func(context.Context, http.Re
 			return encodeError(ctx, w, v)
 		}
 		switch en.GoaErrorName() {
+		case "solution_failed":
+			var res *codelab.SolutionFailure
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewRollbackExerciseSolutionFailedResponseBody(res)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			return enc.Encode(body)
 		case "invalid_input":
 			var res codelab.InvalidInput
 			errors.As(v, &res)
@@ -1481,51 +1743,3078 @@ func EncodeGetAnswerByUserAndExerciseError(encoder func(context.Context, http.Re
 	}
 }
 
-// marshalCodelabExerciseToExerciseResponse builds a value of type
-// *ExerciseResponse from a value of type *codelab.Exercise.
-func marshalCodelabExerciseToExerciseResponse(v *codelab.Exercise) *ExerciseResponse {
-	res := &ExerciseResponse{
-		ID:          v.ID,
-		Title:       v.Title,
-		Description: v.Description,
-		InitialCode: v.InitialCode,
-		Solution:    v.Solution,
-		Difficulty:  v.Difficulty,
-		CreatedBy:   v.CreatedBy,
-		CreatedAt:   v.CreatedAt,
-		UpdatedAt:   v.UpdatedAt,
+// EncodeRegradeExerciseResponse returns an encoder for responses returned by
+// the codelab RegradeExercise endpoint.
+func EncodeRegradeExerciseResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*codelab.Regrade)
+		enc := encoder(ctx, w)
+		body := NewRegradeExerciseResponseBody(res)
+		w.WriteHeader(http.StatusAccepted)
+		return enc.Encode(body)
 	}
-
-	return res
 }
 
-// unmarshalUpdateExercisePayloadRequestBodyToCodelabUpdateExercisePayload
-// builds a value of type *codelab.UpdateExercisePayload from a value of type
-// *UpdateExercisePayloadRequestBody.
-func unmarshalUpdateExercisePayloadRequestBodyToCodelabUpdateExercisePayload(v *UpdateExercisePayloadRequestBody) *codelab.UpdateExercisePayload {
-	res := &codelab.UpdateExercisePayload{
-		Title:       *v.Title,
-		Description: *v.Description,
-		InitialCode: *v.InitialCode,
-		Solution:    *v.Solution,
-		Difficulty:  *v.Difficulty,
-	}
-
-	return res
-}
+// DecodeRegradeExerciseRequest returns a decoder for requests sent to the
+// codelab RegradeExercise endpoint.
+func DecodeRegradeExerciseRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (any, error) {
+	return func(r *http.Request) (any, error) {
+		var (
+			exerciseID   int64
+			sessionToken string
+			err          error
+			c            *http.Cookie
 
-// marshalCodelabTestToTestResponse builds a value of type *TestResponse from a
-// value of type *codelab.Test.
-func marshalCodelabTestToTestResponse(v *codelab.Test) *TestResponse {
-	res := &TestResponse{
-		ID:         v.ID,
-		Input:      v.Input,
-		Output:     v.Output,
-		Public:     v.Public,
-		ExerciseID: v.ExerciseID,
-		CreatedAt:  v.CreatedAt,
-		UpdatedAt:  v.UpdatedAt,
-	}
+			params = mux.Vars(r)
+		)
+		{
+			exerciseIDRaw := params["exercise_id"]
+			v, err2 := strconv.ParseInt(exerciseIDRaw, 10, 64)
+			if err2 != nil {
+				err = goa.MergeErrors(err, goa.InvalidFieldTypeError("exercise_id", exerciseIDRaw, "integer"))
+			}
+			exerciseID = v
+		}
+		c, err = r.Cookie("session")
+		if err == http.ErrNoCookie {
+			err = goa.MergeErrors(err, goa.MissingFieldError("session_token", "cookie"))
+		} else {
+			sessionToken = c.Value
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewRegradeExercisePayload(exerciseID, sessionToken)
+
+		return payload, nil
+	}
+}
+
+// EncodeRegradeExerciseError returns an encoder for errors returned by the
+// RegradeExercise codelab endpoint.
+func EncodeRegradeExerciseError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "invalid_input":
+			var res codelab.InvalidInput
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		case "not_found":
+			var res codelab.NotFound
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		case "permission_denied":
+			var res codelab.PermissionDenied
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusForbidden)
+			return enc.Encode(body)
+		case "service_unavailable":
+			var res codelab.ServiceUnavailable
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return enc.Encode(body)
+		case "unauthorized":
+			var res codelab.Unauthorized
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusUnauthorized)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeGetRegradeResponse returns an encoder for responses returned by the
+// codelab GetRegrade endpoint.
+func EncodeGetRegradeResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*codelab.Regrade)
+		enc := encoder(ctx, w)
+		body := NewGetRegradeResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeGetRegradeRequest returns a decoder for requests sent to the codelab
+// GetRegrade endpoint.
+func DecodeGetRegradeRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (any, error) {
+	return func(r *http.Request) (any, error) {
+		var (
+			id           int64
+			sessionToken string
+			err          error
+			c            *http.Cookie
+
+			params = mux.Vars(r)
+		)
+		{
+			idRaw := params["id"]
+			v, err2 := strconv.ParseInt(idRaw, 10, 64)
+			if err2 != nil {
+				err = goa.MergeErrors(err, goa.InvalidFieldTypeError("id", idRaw, "integer"))
+			}
+			id = v
+		}
+		c, err = r.Cookie("session")
+		if err == http.ErrNoCookie {
+			err = goa.MergeErrors(err, goa.MissingFieldError("session_token", "cookie"))
+		} else {
+			sessionToken = c.Value
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewGetRegradePayload(id, sessionToken)
+
+		return payload, nil
+	}
+}
+
+// EncodeGetRegradeError returns an encoder for errors returned by the
+// GetRegrade codelab endpoint.
+func EncodeGetRegradeError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "invalid_input":
+			var res codelab.InvalidInput
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		case "not_found":
+			var res codelab.NotFound
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		case "permission_denied":
+			var res codelab.PermissionDenied
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusForbidden)
+			return enc.Encode(body)
+		case "service_unavailable":
+			var res codelab.ServiceUnavailable
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return enc.Encode(body)
+		case "unauthorized":
+			var res codelab.Unauthorized
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusUnauthorized)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeCreateHintResponse returns an encoder for responses returned by the
+// codelab CreateHint endpoint.
+func EncodeCreateHintResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*codelab.SimpleResponse)
+		enc := encoder(ctx, w)
+		body := NewCreateHintResponseBody(res)
+		w.WriteHeader(http.StatusCreated)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeCreateHintRequest returns a decoder for requests sent to the codelab
+// CreateHint endpoint.
+func DecodeCreateHintRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (any, error) {
+	return func(r *http.Request) (any, error) {
+		var (
+			body CreateHintRequestBody
+			err  error
+		)
+		err = decoder(r).Decode(&body)
+		if err != nil {
+			if err == io.EOF {
+				return nil, goa.MissingPayloadError()
+			}
+			var gerr *goa.ServiceError
+			if errors.As(err, &gerr) {
+				return nil, gerr
+			}
+			return nil, goa.DecodePayloadError(err.Error())
+		}
+		err = ValidateCreateHintRequestBody(&body)
+		if err != nil {
+			return nil, err
+		}
+
+		var (
+			sessionToken string
+			c            *http.Cookie
+		)
+		c, err = r.Cookie("session")
+		if err == http.ErrNoCookie {
+			err = goa.MergeErrors(err, goa.MissingFieldError("session_token", "cookie"))
+		} else {
+			sessionToken = c.Value
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewCreateHintPayload(&body, sessionToken)
+
+		return payload, nil
+	}
+}
+
+// EncodeCreateHintError returns an encoder for errors returned by the
+// CreateHint codelab endpoint.
+func EncodeCreateHintError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "invalid_input":
+			var res codelab.InvalidInput
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		case "not_found":
+			var res codelab.NotFound
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		case "permission_denied":
+			var res codelab.PermissionDenied
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusForbidden)
+			return enc.Encode(body)
+		case "service_unavailable":
+			var res codelab.ServiceUnavailable
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return enc.Encode(body)
+		case "unauthorized":
+			var res codelab.Unauthorized
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusUnauthorized)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeGetHintsByExerciseResponse returns an encoder for responses returned
+// by the codelab GetHintsByExercise endpoint.
+func EncodeGetHintsByExerciseResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.([]*codelab.Hint)
+		enc := encoder(ctx, w)
+		body := NewGetHintsByExerciseResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeGetHintsByExerciseRequest returns a decoder for requests sent to the
+// codelab GetHintsByExercise endpoint.
+func DecodeGetHintsByExerciseRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (any, error) {
+	return func(r *http.Request) (any, error) {
+		var (
+			exerciseID   int64
+			sessionToken string
+			err          error
+			c            *http.Cookie
+
+			params = mux.Vars(r)
+		)
+		{
+			exerciseIDRaw := params["exercise_id"]
+			v, err2 := strconv.ParseInt(exerciseIDRaw, 10, 64)
+			if err2 != nil {
+				err = goa.MergeErrors(err, goa.InvalidFieldTypeError("exercise_id", exerciseIDRaw, "integer"))
+			}
+			exerciseID = v
+		}
+		c, err = r.Cookie("session")
+		if err == http.ErrNoCookie {
+			err = goa.MergeErrors(err, goa.MissingFieldError("session_token", "cookie"))
+		} else {
+			sessionToken = c.Value
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewGetHintsByExercisePayload(exerciseID, sessionToken)
+
+		return payload, nil
+	}
+}
+
+// EncodeGetHintsByExerciseError returns an encoder for errors returned by the
+// GetHintsByExercise codelab endpoint.
+func EncodeGetHintsByExerciseError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "invalid_input":
+			var res codelab.InvalidInput
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		case "not_found":
+			var res codelab.NotFound
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		case "permission_denied":
+			var res codelab.PermissionDenied
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusForbidden)
+			return enc.Encode(body)
+		case "service_unavailable":
+			var res codelab.ServiceUnavailable
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return enc.Encode(body)
+		case "unauthorized":
+			var res codelab.Unauthorized
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusUnauthorized)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeUpdateHintResponse returns an encoder for responses returned by the
+// codelab UpdateHint endpoint.
+func EncodeUpdateHintResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*codelab.SimpleResponse)
+		enc := encoder(ctx, w)
+		body := NewUpdateHintResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeUpdateHintRequest returns a decoder for requests sent to the codelab
+// UpdateHint endpoint.
+func DecodeUpdateHintRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (any, error) {
+	return func(r *http.Request) (any, error) {
+		var (
+			body UpdateHintRequestBody
+			err  error
+		)
+		err = decoder(r).Decode(&body)
+		if err != nil {
+			if err == io.EOF {
+				return nil, goa.MissingPayloadError()
+			}
+			var gerr *goa.ServiceError
+			if errors.As(err, &gerr) {
+				return nil, gerr
+			}
+			return nil, goa.DecodePayloadError(err.Error())
+		}
+		err = ValidateUpdateHintRequestBody(&body)
+		if err != nil {
+			return nil, err
+		}
+
+		var (
+			id           int64
+			sessionToken string
+			c            *http.Cookie
+
+			params = mux.Vars(r)
+		)
+		{
+			idRaw := params["id"]
+			v, err2 := strconv.ParseInt(idRaw, 10, 64)
+			if err2 != nil {
+				err = goa.MergeErrors(err, goa.InvalidFieldTypeError("id", idRaw, "integer"))
+			}
+			id = v
+		}
+		c, err = r.Cookie("session")
+		if err == http.ErrNoCookie {
+			err = goa.MergeErrors(err, goa.MissingFieldError("session_token", "cookie"))
+		} else {
+			sessionToken = c.Value
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewUpdateHintPayload2(&body, id, sessionToken)
+
+		return payload, nil
+	}
+}
+
+// EncodeUpdateHintError returns an encoder for errors returned by the
+// UpdateHint codelab endpoint.
+func EncodeUpdateHintError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "invalid_input":
+			var res codelab.InvalidInput
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		case "not_found":
+			var res codelab.NotFound
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		case "permission_denied":
+			var res codelab.PermissionDenied
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusForbidden)
+			return enc.Encode(body)
+		case "service_unavailable":
+			var res codelab.ServiceUnavailable
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return enc.Encode(body)
+		case "unauthorized":
+			var res codelab.Unauthorized
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusUnauthorized)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeDeleteHintResponse returns an encoder for responses returned by the
+// codelab DeleteHint endpoint.
+func EncodeDeleteHintResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*codelab.SimpleResponse)
+		enc := encoder(ctx, w)
+		body := NewDeleteHintResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeDeleteHintRequest returns a decoder for requests sent to the codelab
+// DeleteHint endpoint.
+func DecodeDeleteHintRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (any, error) {
+	return func(r *http.Request) (any, error) {
+		var (
+			id           int64
+			sessionToken string
+			err          error
+			c            *http.Cookie
+
+			params = mux.Vars(r)
+		)
+		{
+			idRaw := params["id"]
+			v, err2 := strconv.ParseInt(idRaw, 10, 64)
+			if err2 != nil {
+				err = goa.MergeErrors(err, goa.InvalidFieldTypeError("id", idRaw, "integer"))
+			}
+			id = v
+		}
+		c, err = r.Cookie("session")
+		if err == http.ErrNoCookie {
+			err = goa.MergeErrors(err, goa.MissingFieldError("session_token", "cookie"))
+		} else {
+			sessionToken = c.Value
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewDeleteHintPayload(id, sessionToken)
+
+		return payload, nil
+	}
+}
+
+// EncodeDeleteHintError returns an encoder for errors returned by the
+// DeleteHint codelab endpoint.
+func EncodeDeleteHintError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "invalid_input":
+			var res codelab.InvalidInput
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		case "not_found":
+			var res codelab.NotFound
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		case "permission_denied":
+			var res codelab.PermissionDenied
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusForbidden)
+			return enc.Encode(body)
+		case "service_unavailable":
+			var res codelab.ServiceUnavailable
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return enc.Encode(body)
+		case "unauthorized":
+			var res codelab.Unauthorized
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusUnauthorized)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeCreateAssignmentResponse returns an encoder for responses returned by
+// the codelab CreateAssignment endpoint.
+func EncodeCreateAssignmentResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*codelab.SimpleResponse)
+		enc := encoder(ctx, w)
+		body := NewCreateAssignmentResponseBody(res)
+		w.WriteHeader(http.StatusCreated)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeCreateAssignmentRequest returns a decoder for requests sent to the
+// codelab CreateAssignment endpoint.
+func DecodeCreateAssignmentRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (any, error) {
+	return func(r *http.Request) (any, error) {
+		var (
+			body CreateAssignmentRequestBody
+			err  error
+		)
+		err = decoder(r).Decode(&body)
+		if err != nil {
+			if err == io.EOF {
+				return nil, goa.MissingPayloadError()
+			}
+			var gerr *goa.ServiceError
+			if errors.As(err, &gerr) {
+				return nil, gerr
+			}
+			return nil, goa.DecodePayloadError(err.Error())
+		}
+		err = ValidateCreateAssignmentRequestBody(&body)
+		if err != nil {
+			return nil, err
+		}
+
+		var (
+			sessionToken string
+			c            *http.Cookie
+		)
+		c, err = r.Cookie("session")
+		if err == http.ErrNoCookie {
+			err = goa.MergeErrors(err, goa.MissingFieldError("session_token", "cookie"))
+		} else {
+			sessionToken = c.Value
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewCreateAssignmentPayload(&body, sessionToken)
+
+		return payload, nil
+	}
+}
+
+// EncodeCreateAssignmentError returns an encoder for errors returned by the
+// CreateAssignment codelab endpoint.
+func EncodeCreateAssignmentError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "invalid_input":
+			var res codelab.InvalidInput
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		case "not_found":
+			var res codelab.NotFound
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		case "permission_denied":
+			var res codelab.PermissionDenied
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusForbidden)
+			return enc.Encode(body)
+		case "service_unavailable":
+			var res codelab.ServiceUnavailable
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return enc.Encode(body)
+		case "unauthorized":
+			var res codelab.Unauthorized
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusUnauthorized)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeGetAssignmentResponse returns an encoder for responses returned by the
+// codelab GetAssignment endpoint.
+func EncodeGetAssignmentResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*codelab.Assignment)
+		enc := encoder(ctx, w)
+		body := NewGetAssignmentResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeGetAssignmentRequest returns a decoder for requests sent to the
+// codelab GetAssignment endpoint.
+func DecodeGetAssignmentRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (any, error) {
+	return func(r *http.Request) (any, error) {
+		var (
+			id           int64
+			sessionToken string
+			err          error
+			c            *http.Cookie
+
+			params = mux.Vars(r)
+		)
+		{
+			idRaw := params["id"]
+			v, err2 := strconv.ParseInt(idRaw, 10, 64)
+			if err2 != nil {
+				err = goa.MergeErrors(err, goa.InvalidFieldTypeError("id", idRaw, "integer"))
+			}
+			id = v
+		}
+		c, err = r.Cookie("session")
+		if err == http.ErrNoCookie {
+			err = goa.MergeErrors(err, goa.MissingFieldError("session_token", "cookie"))
+		} else {
+			sessionToken = c.Value
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewGetAssignmentPayload(id, sessionToken)
+
+		return payload, nil
+	}
+}
+
+// EncodeGetAssignmentError returns an encoder for errors returned by the
+// GetAssignment codelab endpoint.
+func EncodeGetAssignmentError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "invalid_input":
+			var res codelab.InvalidInput
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		case "not_found":
+			var res codelab.NotFound
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		case "permission_denied":
+			var res codelab.PermissionDenied
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusForbidden)
+			return enc.Encode(body)
+		case "service_unavailable":
+			var res codelab.ServiceUnavailable
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return enc.Encode(body)
+		case "unauthorized":
+			var res codelab.Unauthorized
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusUnauthorized)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeListAssignmentsResponse returns an encoder for responses returned by
+// the codelab ListAssignments endpoint.
+func EncodeListAssignmentsResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.([]*codelab.Assignment)
+		enc := encoder(ctx, w)
+		body := NewListAssignmentsResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeListAssignmentsRequest returns a decoder for requests sent to the
+// codelab ListAssignments endpoint.
+func DecodeListAssignmentsRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (any, error) {
+	return func(r *http.Request) (any, error) {
+		var (
+			sessionToken string
+			err          error
+			c            *http.Cookie
+		)
+		c, err = r.Cookie("session")
+		if err == http.ErrNoCookie {
+			err = goa.MergeErrors(err, goa.MissingFieldError("session_token", "cookie"))
+		} else {
+			sessionToken = c.Value
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewListAssignmentsPayload(sessionToken)
+
+		return payload, nil
+	}
+}
+
+// EncodeListAssignmentsError returns an encoder for errors returned by the
+// ListAssignments codelab endpoint.
+func EncodeListAssignmentsError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "invalid_input":
+			var res codelab.InvalidInput
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		case "permission_denied":
+			var res codelab.PermissionDenied
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusForbidden)
+			return enc.Encode(body)
+		case "service_unavailable":
+			var res codelab.ServiceUnavailable
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return enc.Encode(body)
+		case "unauthorized":
+			var res codelab.Unauthorized
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusUnauthorized)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeUpdateAssignmentResponse returns an encoder for responses returned by
+// the codelab UpdateAssignment endpoint.
+func EncodeUpdateAssignmentResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*codelab.SimpleResponse)
+		enc := encoder(ctx, w)
+		body := NewUpdateAssignmentResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeUpdateAssignmentRequest returns a decoder for requests sent to the
+// codelab UpdateAssignment endpoint.
+func DecodeUpdateAssignmentRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (any, error) {
+	return func(r *http.Request) (any, error) {
+		var (
+			body UpdateAssignmentRequestBody
+			err  error
+		)
+		err = decoder(r).Decode(&body)
+		if err != nil {
+			if err == io.EOF {
+				return nil, goa.MissingPayloadError()
+			}
+			var gerr *goa.ServiceError
+			if errors.As(err, &gerr) {
+				return nil, gerr
+			}
+			return nil, goa.DecodePayloadError(err.Error())
+		}
+		err = ValidateUpdateAssignmentRequestBody(&body)
+		if err != nil {
+			return nil, err
+		}
+
+		var (
+			id           int64
+			sessionToken string
+			c            *http.Cookie
+
+			params = mux.Vars(r)
+		)
+		{
+			idRaw := params["id"]
+			v, err2 := strconv.ParseInt(idRaw, 10, 64)
+			if err2 != nil {
+				err = goa.MergeErrors(err, goa.InvalidFieldTypeError("id", idRaw, "integer"))
+			}
+			id = v
+		}
+		c, err = r.Cookie("session")
+		if err == http.ErrNoCookie {
+			err = goa.MergeErrors(err, goa.MissingFieldError("session_token", "cookie"))
+		} else {
+			sessionToken = c.Value
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewUpdateAssignmentPayload2(&body, id, sessionToken)
+
+		return payload, nil
+	}
+}
+
+// EncodeUpdateAssignmentError returns an encoder for errors returned by the
+// UpdateAssignment codelab endpoint.
+func EncodeUpdateAssignmentError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "invalid_input":
+			var res codelab.InvalidInput
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		case "not_found":
+			var res codelab.NotFound
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		case "permission_denied":
+			var res codelab.PermissionDenied
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusForbidden)
+			return enc.Encode(body)
+		case "service_unavailable":
+			var res codelab.ServiceUnavailable
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return enc.Encode(body)
+		case "unauthorized":
+			var res codelab.Unauthorized
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusUnauthorized)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeDeleteAssignmentResponse returns an encoder for responses returned by
+// the codelab DeleteAssignment endpoint.
+func EncodeDeleteAssignmentResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*codelab.SimpleResponse)
+		enc := encoder(ctx, w)
+		body := NewDeleteAssignmentResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeDeleteAssignmentRequest returns a decoder for requests sent to the
+// codelab DeleteAssignment endpoint.
+func DecodeDeleteAssignmentRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (any, error) {
+	return func(r *http.Request) (any, error) {
+		var (
+			id           int64
+			sessionToken string
+			err          error
+			c            *http.Cookie
+
+			params = mux.Vars(r)
+		)
+		{
+			idRaw := params["id"]
+			v, err2 := strconv.ParseInt(idRaw, 10, 64)
+			if err2 != nil {
+				err = goa.MergeErrors(err, goa.InvalidFieldTypeError("id", idRaw, "integer"))
+			}
+			id = v
+		}
+		c, err = r.Cookie("session")
+		if err == http.ErrNoCookie {
+			err = goa.MergeErrors(err, goa.MissingFieldError("session_token", "cookie"))
+		} else {
+			sessionToken = c.Value
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewDeleteAssignmentPayload(id, sessionToken)
+
+		return payload, nil
+	}
+}
+
+// EncodeDeleteAssignmentError returns an encoder for errors returned by the
+// DeleteAssignment codelab endpoint.
+func EncodeDeleteAssignmentError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "invalid_input":
+			var res codelab.InvalidInput
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		case "not_found":
+			var res codelab.NotFound
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		case "permission_denied":
+			var res codelab.PermissionDenied
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusForbidden)
+			return enc.Encode(body)
+		case "service_unavailable":
+			var res codelab.ServiceUnavailable
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return enc.Encode(body)
+		case "unauthorized":
+			var res codelab.Unauthorized
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusUnauthorized)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeGetAssignmentGradebookResponse returns an encoder for responses
+// returned by the codelab GetAssignmentGradebook endpoint.
+func EncodeGetAssignmentGradebookResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*codelab.AssignmentGradebook)
+		enc := encoder(ctx, w)
+		body := NewGetAssignmentGradebookResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeGetAssignmentGradebookRequest returns a decoder for requests sent to
+// the codelab GetAssignmentGradebook endpoint.
+func DecodeGetAssignmentGradebookRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (any, error) {
+	return func(r *http.Request) (any, error) {
+		var (
+			id           int64
+			sessionToken string
+			err          error
+			c            *http.Cookie
+
+			params = mux.Vars(r)
+		)
+		{
+			idRaw := params["id"]
+			v, err2 := strconv.ParseInt(idRaw, 10, 64)
+			if err2 != nil {
+				err = goa.MergeErrors(err, goa.InvalidFieldTypeError("id", idRaw, "integer"))
+			}
+			id = v
+		}
+		c, err = r.Cookie("session")
+		if err == http.ErrNoCookie {
+			err = goa.MergeErrors(err, goa.MissingFieldError("session_token", "cookie"))
+		} else {
+			sessionToken = c.Value
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewGetAssignmentGradebookPayload(id, sessionToken)
+
+		return payload, nil
+	}
+}
+
+// EncodeGetAssignmentGradebookError returns an encoder for errors returned by
+// the GetAssignmentGradebook codelab endpoint.
+func EncodeGetAssignmentGradebookError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "invalid_input":
+			var res codelab.InvalidInput
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		case "not_found":
+			var res codelab.NotFound
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		case "permission_denied":
+			var res codelab.PermissionDenied
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusForbidden)
+			return enc.Encode(body)
+		case "service_unavailable":
+			var res codelab.ServiceUnavailable
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return enc.Encode(body)
+		case "unauthorized":
+			var res codelab.Unauthorized
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusUnauthorized)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeGetExerciseStatsResponse returns an encoder for responses returned by
+// the codelab GetExerciseStats endpoint.
+func EncodeGetExerciseStatsResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*codelab.ExerciseStats)
+		enc := encoder(ctx, w)
+		body := NewGetExerciseStatsResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeGetExerciseStatsRequest returns a decoder for requests sent to the
+// codelab GetExerciseStats endpoint.
+func DecodeGetExerciseStatsRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (any, error) {
+	return func(r *http.Request) (any, error) {
+		var (
+			exerciseID   int64
+			sessionToken string
+			err          error
+			c            *http.Cookie
+
+			params = mux.Vars(r)
+		)
+		{
+			exerciseIDRaw := params["exercise_id"]
+			v, err2 := strconv.ParseInt(exerciseIDRaw, 10, 64)
+			if err2 != nil {
+				err = goa.MergeErrors(err, goa.InvalidFieldTypeError("exercise_id", exerciseIDRaw, "integer"))
+			}
+			exerciseID = v
+		}
+		c, err = r.Cookie("session")
+		if err == http.ErrNoCookie {
+			err = goa.MergeErrors(err, goa.MissingFieldError("session_token", "cookie"))
+		} else {
+			sessionToken = c.Value
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewGetExerciseStatsPayload(exerciseID, sessionToken)
+
+		return payload, nil
+	}
+}
+
+// EncodeGetExerciseStatsError returns an encoder for errors returned by the
+// GetExerciseStats codelab endpoint.
+func EncodeGetExerciseStatsError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "invalid_input":
+			var res codelab.InvalidInput
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		case "not_found":
+			var res codelab.NotFound
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		case "permission_denied":
+			var res codelab.PermissionDenied
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusForbidden)
+			return enc.Encode(body)
+		case "service_unavailable":
+			var res codelab.ServiceUnavailable
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return enc.Encode(body)
+		case "unauthorized":
+			var res codelab.Unauthorized
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusUnauthorized)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeGetStudentExerciseStatsResponse returns an encoder for responses
+// returned by the codelab GetStudentExerciseStats endpoint.
+func EncodeGetStudentExerciseStatsResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*codelab.StudentExerciseStats)
+		enc := encoder(ctx, w)
+		body := NewGetStudentExerciseStatsResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeGetStudentExerciseStatsRequest returns a decoder for requests sent to
+// the codelab GetStudentExerciseStats endpoint.
+func DecodeGetStudentExerciseStatsRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (any, error) {
+	return func(r *http.Request) (any, error) {
+		var (
+			exerciseID   int64
+			userID       int64
+			sessionToken string
+			err          error
+			c            *http.Cookie
+
+			params = mux.Vars(r)
+		)
+		{
+			exerciseIDRaw := params["exercise_id"]
+			v, err2 := strconv.ParseInt(exerciseIDRaw, 10, 64)
+			if err2 != nil {
+				err = goa.MergeErrors(err, goa.InvalidFieldTypeError("exercise_id", exerciseIDRaw, "integer"))
+			}
+			exerciseID = v
+		}
+		{
+			userIDRaw := params["user_id"]
+			v, err2 := strconv.ParseInt(userIDRaw, 10, 64)
+			if err2 != nil {
+				err = goa.MergeErrors(err, goa.InvalidFieldTypeError("user_id", userIDRaw, "integer"))
+			}
+			userID = v
+		}
+		c, err = r.Cookie("session")
+		if err == http.ErrNoCookie {
+			err = goa.MergeErrors(err, goa.MissingFieldError("session_token", "cookie"))
+		} else {
+			sessionToken = c.Value
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewGetStudentExerciseStatsPayload(exerciseID, userID, sessionToken)
+
+		return payload, nil
+	}
+}
+
+// EncodeGetStudentExerciseStatsError returns an encoder for errors returned by
+// the GetStudentExerciseStats codelab endpoint.
+func EncodeGetStudentExerciseStatsError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "invalid_input":
+			var res codelab.InvalidInput
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		case "not_found":
+			var res codelab.NotFound
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		case "permission_denied":
+			var res codelab.PermissionDenied
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusForbidden)
+			return enc.Encode(body)
+		case "service_unavailable":
+			var res codelab.ServiceUnavailable
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return enc.Encode(body)
+		case "unauthorized":
+			var res codelab.Unauthorized
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusUnauthorized)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeGetPlagiarismReportResponse returns an encoder for responses returned
+// by the codelab GetPlagiarismReport endpoint.
+func EncodeGetPlagiarismReportResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*codelab.PlagiarismReport)
+		enc := encoder(ctx, w)
+		body := NewGetPlagiarismReportResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeGetPlagiarismReportRequest returns a decoder for requests sent to the
+// codelab GetPlagiarismReport endpoint.
+func DecodeGetPlagiarismReportRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (any, error) {
+	return func(r *http.Request) (any, error) {
+		var (
+			exerciseID    int64
+			minSimilarity float64
+			sessionToken  string
+			err           error
+			c             *http.Cookie
+
+			params = mux.Vars(r)
+		)
+		{
+			exerciseIDRaw := params["exercise_id"]
+			v, err2 := strconv.ParseInt(exerciseIDRaw, 10, 64)
+			if err2 != nil {
+				err = goa.MergeErrors(err, goa.InvalidFieldTypeError("exercise_id", exerciseIDRaw, "integer"))
+			}
+			exerciseID = v
+		}
+		{
+			minSimilarityRaw := r.URL.Query().Get("min_similarity")
+			if minSimilarityRaw != "" {
+				v, err2 := strconv.ParseFloat(minSimilarityRaw, 64)
+				if err2 != nil {
+					err = goa.MergeErrors(err, goa.InvalidFieldTypeError("min_similarity", minSimilarityRaw, "float"))
+				}
+				minSimilarity = v
+			}
+		}
+		if minSimilarity < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("min_similarity", minSimilarity, 0, true))
+		}
+		if minSimilarity > 100 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("min_similarity", minSimilarity, 100, false))
+		}
+		c, err = r.Cookie("session")
+		if err == http.ErrNoCookie {
+			err = goa.MergeErrors(err, goa.MissingFieldError("session_token", "cookie"))
+		} else {
+			sessionToken = c.Value
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewGetPlagiarismReportPayload(exerciseID, minSimilarity, sessionToken)
+
+		return payload, nil
+	}
+}
+
+// EncodeGetPlagiarismReportError returns an encoder for errors returned by the
+// GetPlagiarismReport codelab endpoint.
+func EncodeGetPlagiarismReportError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "invalid_input":
+			var res codelab.InvalidInput
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		case "not_found":
+			var res codelab.NotFound
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		case "permission_denied":
+			var res codelab.PermissionDenied
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusForbidden)
+			return enc.Encode(body)
+		case "service_unavailable":
+			var res codelab.ServiceUnavailable
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return enc.Encode(body)
+		case "unauthorized":
+			var res codelab.Unauthorized
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusUnauthorized)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeGetExerciseForStudentResponse returns an encoder for responses
+// returned by the codelab GetExerciseForStudent endpoint.
+func EncodeGetExerciseForStudentResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*codelab.ExerciseForStudents)
+		enc := encoder(ctx, w)
+		body := NewGetExerciseForStudentResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeGetExerciseForStudentRequest returns a decoder for requests sent to
+// the codelab GetExerciseForStudent endpoint.
+func DecodeGetExerciseForStudentRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (any, error) {
+	return func(r *http.Request) (any, error) {
+		var (
+			id           int64
+			sessionToken string
+			err          error
+			c            *http.Cookie
+
+			params = mux.Vars(r)
+		)
+		{
+			idRaw := params["id"]
+			v, err2 := strconv.ParseInt(idRaw, 10, 64)
+			if err2 != nil {
+				err = goa.MergeErrors(err, goa.InvalidFieldTypeError("id", idRaw, "integer"))
+			}
+			id = v
+		}
+		c, err = r.Cookie("session")
+		if err == http.ErrNoCookie {
+			err = goa.MergeErrors(err, goa.MissingFieldError("session_token", "cookie"))
+		} else {
+			sessionToken = c.Value
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewGetExerciseForStudentPayload(id, sessionToken)
+
+		return payload, nil
+	}
+}
+
+// EncodeGetExerciseForStudentError returns an encoder for errors returned by
+// the GetExerciseForStudent codelab endpoint.
+func EncodeGetExerciseForStudentError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "invalid_input":
+			var res codelab.InvalidInput
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		case "not_found":
+			var res codelab.NotFound
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		case "permission_denied":
+			var res codelab.PermissionDenied
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusForbidden)
+			return enc.Encode(body)
+		case "service_unavailable":
+			var res codelab.ServiceUnavailable
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return enc.Encode(body)
+		case "unauthorized":
+			var res codelab.Unauthorized
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusUnauthorized)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeListExercisesForStudentsResponse returns an encoder for responses
+// returned by the codelab ListExercisesForStudents endpoint.
+func EncodeListExercisesForStudentsResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*codelab.ExerciseForStudentsPage)
+		enc := encoder(ctx, w)
+		body := NewListExercisesForStudentsResponseBody(res)
+		if res.NextCursor != nil {
+			w.Header().Set("X-Next-Cursor", *res.NextCursor)
+		}
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeListExercisesForStudentsRequest returns a decoder for requests sent to
+// the codelab ListExercisesForStudents endpoint.
+func DecodeListExercisesForStudentsRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (any, error) {
+	return func(r *http.Request) (any, error) {
+		var (
+			search       *string
+			difficulty   *string
+			tags         []string
+			createdBy    *int64
+			cursor       *string
+			limit        int
+			completed    *bool
+			sessionToken string
+			err          error
+			c            *http.Cookie
+		)
+		qp := r.URL.Query()
+		searchRaw := qp.Get("search")
+		if searchRaw != "" {
+			search = &searchRaw
+		}
+		if search != nil {
+			if utf8.RuneCountInString(*search) > 200 {
+				err = goa.MergeErrors(err, goa.InvalidLengthError("search", *search, utf8.RuneCountInString(*search), 200, false))
+			}
+		}
+		difficultyRaw := qp.Get("difficulty")
+		if difficultyRaw != "" {
+			difficulty = &difficultyRaw
+		}
+		if difficulty != nil {
+			if !(*difficulty == "easy" || *difficulty == "medium" || *difficulty == "hard") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("difficulty", *difficulty, []any{"easy", "medium", "hard"}))
+			}
+		}
+		tags = qp["tag"]
+		{
+			createdByRaw := qp.Get("author")
+			if createdByRaw != "" {
+				v, err2 := strconv.ParseInt(createdByRaw, 10, 64)
+				if err2 != nil {
+					err = goa.MergeErrors(err, goa.InvalidFieldTypeError("created_by", createdByRaw, "integer"))
+				}
+				createdBy = &v
+			}
+		}
+		cursorRaw := qp.Get("cursor")
+		if cursorRaw != "" {
+			cursor = &cursorRaw
+		}
+		{
+			limitRaw := qp.Get("limit")
+			if limitRaw == "" {
+				limit = 50
+			} else {
+				v, err2 := strconv.ParseInt(limitRaw, 10, strconv.IntSize)
+				if err2 != nil {
+					err = goa.MergeErrors(err, goa.InvalidFieldTypeError("limit", limitRaw, "integer"))
+				}
+				limit = int(v)
+			}
+		}
+		if limit < 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("limit", limit, 1, true))
+		}
+		if limit > 100 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("limit", limit, 100, false))
+		}
+		{
+			completedRaw := qp.Get("completed")
+			if completedRaw != "" {
+				v, err2 := strconv.ParseBool(completedRaw)
+				if err2 != nil {
+					err = goa.MergeErrors(err, goa.InvalidFieldTypeError("completed", completedRaw, "boolean"))
+				}
+				completed = &v
+			}
+		}
+		c, err = r.Cookie("session")
+		if err == http.ErrNoCookie {
+			err = goa.MergeErrors(err, goa.MissingFieldError("session_token", "cookie"))
+		} else {
+			sessionToken = c.Value
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewListExercisesForStudentsPayload(search, difficulty, tags, createdBy, cursor, limit, completed, sessionToken)
+
+		return payload, nil
+	}
+}
+
+// EncodeListExercisesForStudentsError returns an encoder for errors returned
+// by the ListExercisesForStudents codelab endpoint.
+func EncodeListExercisesForStudentsError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "invalid_input":
+			var res codelab.InvalidInput
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		case "not_found":
+			var res codelab.NotFound
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		case "permission_denied":
+			var res codelab.PermissionDenied
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusForbidden)
+			return enc.Encode(body)
+		case "service_unavailable":
+			var res codelab.ServiceUnavailable
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return enc.Encode(body)
+		case "unauthorized":
+			var res codelab.Unauthorized
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusUnauthorized)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeCreateAttemptResponse returns an encoder for responses returned by the
+// codelab CreateAttempt endpoint.
+func EncodeCreateAttemptResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*codelab.SimpleResponse)
+		enc := encoder(ctx, w)
+		body := NewCreateAttemptResponseBody(res)
+		w.WriteHeader(http.StatusCreated)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeCreateAttemptRequest returns a decoder for requests sent to the
+// codelab CreateAttempt endpoint.
+func DecodeCreateAttemptRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (any, error) {
+	return func(r *http.Request) (any, error) {
+		var (
+			body CreateAttemptRequestBody
+			err  error
+		)
+		err = decoder(r).Decode(&body)
+		if err != nil {
+			if err == io.EOF {
+				return nil, goa.MissingPayloadError()
+			}
+			var gerr *goa.ServiceError
+			if errors.As(err, &gerr) {
+				return nil, gerr
+			}
+			return nil, goa.DecodePayloadError(err.Error())
+		}
+		err = ValidateCreateAttemptRequestBody(&body)
+		if err != nil {
+			return nil, err
+		}
+
+		var (
+			sessionToken string
+			c            *http.Cookie
+		)
+		c, err = r.Cookie("session")
+		if err == http.ErrNoCookie {
+			err = goa.MergeErrors(err, goa.MissingFieldError("session_token", "cookie"))
+		} else {
+			sessionToken = c.Value
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewCreateAttemptPayload(&body, sessionToken)
+
+		return payload, nil
+	}
+}
+
+// EncodeCreateAttemptError returns an encoder for errors returned by the
+// CreateAttempt codelab endpoint.
+func EncodeCreateAttemptError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "rate_limited":
+			var res *codelab.RateLimit
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewCreateAttemptRateLimitedResponseBody(res)
+			}
+			{
+				val := res.RetryAfter
+				retryAfters := strconv.FormatInt(val, 10)
+				w.Header().Set("Retry-After", retryAfters)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusTooManyRequests)
+			return enc.Encode(body)
+		case "invalid_input":
+			var res codelab.InvalidInput
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		case "not_found":
+			var res codelab.NotFound
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		case "permission_denied":
+			var res codelab.PermissionDenied
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusForbidden)
+			return enc.Encode(body)
+		case "service_unavailable":
+			var res codelab.ServiceUnavailable
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return enc.Encode(body)
+		case "unauthorized":
+			var res codelab.Unauthorized
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusUnauthorized)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeSubmitAttemptResponse returns an encoder for responses returned by the
+// codelab SubmitAttempt endpoint.
+func EncodeSubmitAttemptResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*codelab.AttemptSubmission)
+		enc := encoder(ctx, w)
+		body := NewSubmitAttemptResponseBody(res)
+		w.WriteHeader(http.StatusAccepted)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeSubmitAttemptRequest returns a decoder for requests sent to the
+// codelab SubmitAttempt endpoint.
+func DecodeSubmitAttemptRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (any, error) {
+	return func(r *http.Request) (any, error) {
+		var (
+			body SubmitAttemptRequestBody
+			err  error
+		)
+		err = decoder(r).Decode(&body)
+		if err != nil {
+			if err == io.EOF {
+				return nil, goa.MissingPayloadError()
+			}
+			var gerr *goa.ServiceError
+			if errors.As(err, &gerr) {
+				return nil, gerr
+			}
+			return nil, goa.DecodePayloadError(err.Error())
+		}
+		err = ValidateSubmitAttemptRequestBody(&body)
+		if err != nil {
+			return nil, err
+		}
+
+		var (
+			sessionToken string
+			c            *http.Cookie
+		)
+		c, err = r.Cookie("session")
+		if err == http.ErrNoCookie {
+			err = goa.MergeErrors(err, goa.MissingFieldError("session_token", "cookie"))
+		} else {
+			sessionToken = c.Value
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewSubmitAttemptCreateAttemptPayload(&body, sessionToken)
+
+		return payload, nil
+	}
+}
+
+// EncodeSubmitAttemptError returns an encoder for errors returned by the
+// SubmitAttempt codelab endpoint.
+func EncodeSubmitAttemptError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "rate_limited":
+			var res *codelab.RateLimit
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewSubmitAttemptRateLimitedResponseBody(res)
+			}
+			{
+				val := res.RetryAfter
+				retryAfters := strconv.FormatInt(val, 10)
+				w.Header().Set("Retry-After", retryAfters)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusTooManyRequests)
+			return enc.Encode(body)
+		case "invalid_input":
+			var res codelab.InvalidInput
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		case "not_found":
+			var res codelab.NotFound
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		case "permission_denied":
+			var res codelab.PermissionDenied
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusForbidden)
+			return enc.Encode(body)
+		case "service_unavailable":
+			var res codelab.ServiceUnavailable
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return enc.Encode(body)
+		case "unauthorized":
+			var res codelab.Unauthorized
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusUnauthorized)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// DecodeStreamAttemptEventsRequest returns a decoder for requests sent to the
+// codelab StreamAttemptEvents endpoint.
+func DecodeStreamAttemptEventsRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (any, error) {
+	return func(r *http.Request) (any, error) {
+		var (
+			id           int64
+			sessionToken string
+			err          error
+			c            *http.Cookie
+
+			params = mux.Vars(r)
+		)
+		{
+			idRaw := params["id"]
+			v, err2 := strconv.ParseInt(idRaw, 10, 64)
+			if err2 != nil {
+				err = goa.MergeErrors(err, goa.InvalidFieldTypeError("id", idRaw, "integer"))
+			}
+			id = v
+		}
+		c, err = r.Cookie("session")
+		if err == http.ErrNoCookie {
+			err = goa.MergeErrors(err, goa.MissingFieldError("session_token", "cookie"))
+		} else {
+			sessionToken = c.Value
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewStreamAttemptEventsPayload(id, sessionToken)
+
+		return payload, nil
+	}
+}
+
+// EncodeStreamAttemptEventsError returns an encoder for errors returned by the
+// StreamAttemptEvents codelab endpoint.
+func EncodeStreamAttemptEventsError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "invalid_input":
+			var res codelab.InvalidInput
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		case "not_found":
+			var res codelab.NotFound
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		case "permission_denied":
+			var res codelab.PermissionDenied
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusForbidden)
+			return enc.Encode(body)
+		case "service_unavailable":
+			var res codelab.ServiceUnavailable
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return enc.Encode(body)
+		case "unauthorized":
+			var res codelab.Unauthorized
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusUnauthorized)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeRunCodeResponse returns an encoder for responses returned by the
+// codelab RunCode endpoint.
+func EncodeRunCodeResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*codelab.RunCodeResult)
+		enc := encoder(ctx, w)
+		body := NewRunCodeResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeRunCodeRequest returns a decoder for requests sent to the codelab
+// RunCode endpoint.
+func DecodeRunCodeRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (any, error) {
+	return func(r *http.Request) (any, error) {
+		var (
+			body RunCodeRequestBody
+			err  error
+		)
+		err = decoder(r).Decode(&body)
+		if err != nil {
+			if err == io.EOF {
+				return nil, goa.MissingPayloadError()
+			}
+			var gerr *goa.ServiceError
+			if errors.As(err, &gerr) {
+				return nil, gerr
+			}
+			return nil, goa.DecodePayloadError(err.Error())
+		}
+		err = ValidateRunCodeRequestBody(&body)
+		if err != nil {
+			return nil, err
+		}
+
+		var (
+			exerciseID   int64
+			sessionToken string
+			c            *http.Cookie
+
+			params = mux.Vars(r)
+		)
+		{
+			exerciseIDRaw := params["exercise_id"]
+			v, err2 := strconv.ParseInt(exerciseIDRaw, 10, 64)
+			if err2 != nil {
+				err = goa.MergeErrors(err, goa.InvalidFieldTypeError("exercise_id", exerciseIDRaw, "integer"))
+			}
+			exerciseID = v
+		}
+		c, err = r.Cookie("session")
+		if err == http.ErrNoCookie {
+			err = goa.MergeErrors(err, goa.MissingFieldError("session_token", "cookie"))
+		} else {
+			sessionToken = c.Value
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewRunCodePayload(&body, exerciseID, sessionToken)
+
+		return payload, nil
+	}
+}
+
+// EncodeRunCodeError returns an encoder for errors returned by the RunCode
+// codelab endpoint.
+func EncodeRunCodeError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "rate_limited":
+			var res *codelab.RateLimit
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			var body any
+			if formatter != nil {
+				body = formatter(ctx, res)
+			} else {
+				body = NewRunCodeRateLimitedResponseBody(res)
+			}
+			{
+				val := res.RetryAfter
+				retryAfters := strconv.FormatInt(val, 10)
+				w.Header().Set("Retry-After", retryAfters)
+			}
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusTooManyRequests)
+			return enc.Encode(body)
+		case "invalid_input":
+			var res codelab.InvalidInput
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		case "not_found":
+			var res codelab.NotFound
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		case "permission_denied":
+			var res codelab.PermissionDenied
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusForbidden)
+			return enc.Encode(body)
+		case "service_unavailable":
+			var res codelab.ServiceUnavailable
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return enc.Encode(body)
+		case "unauthorized":
+			var res codelab.Unauthorized
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusUnauthorized)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeGetAttemptsByUserAndExerciseResponse returns an encoder for responses
+// returned by the codelab GetAttemptsByUserAndExercise endpoint.
+func EncodeGetAttemptsByUserAndExerciseResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.([]*codelab.Attempt)
+		enc := encoder(ctx, w)
+		body := NewGetAttemptsByUserAndExerciseResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeGetAttemptsByUserAndExerciseRequest returns a decoder for requests
+// sent to the codelab GetAttemptsByUserAndExercise endpoint.
+func DecodeGetAttemptsByUserAndExerciseRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (any, error) {
+	return func(r *http.Request) (any, error) {
+		var (
+			userID       int64
+			exerciseID   int64
+			sessionToken string
+			err          error
+			c            *http.Cookie
+
+			params = mux.Vars(r)
+		)
+		{
+			userIDRaw := params["user_id"]
+			v, err2 := strconv.ParseInt(userIDRaw, 10, 64)
+			if err2 != nil {
+				err = goa.MergeErrors(err, goa.InvalidFieldTypeError("user_id", userIDRaw, "integer"))
+			}
+			userID = v
+		}
+		{
+			exerciseIDRaw := params["exercise_id"]
+			v, err2 := strconv.ParseInt(exerciseIDRaw, 10, 64)
+			if err2 != nil {
+				err = goa.MergeErrors(err, goa.InvalidFieldTypeError("exercise_id", exerciseIDRaw, "integer"))
+			}
+			exerciseID = v
+		}
+		c, err = r.Cookie("session")
+		if err == http.ErrNoCookie {
+			err = goa.MergeErrors(err, goa.MissingFieldError("session_token", "cookie"))
+		} else {
+			sessionToken = c.Value
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewGetAttemptsByUserAndExercisePayload(userID, exerciseID, sessionToken)
+
+		return payload, nil
+	}
+}
+
+// EncodeGetAttemptsByUserAndExerciseError returns an encoder for errors
+// returned by the GetAttemptsByUserAndExercise codelab endpoint.
+func EncodeGetAttemptsByUserAndExerciseError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "invalid_input":
+			var res codelab.InvalidInput
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		case "not_found":
+			var res codelab.NotFound
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		case "permission_denied":
+			var res codelab.PermissionDenied
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusForbidden)
+			return enc.Encode(body)
+		case "service_unavailable":
+			var res codelab.ServiceUnavailable
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return enc.Encode(body)
+		case "unauthorized":
+			var res codelab.Unauthorized
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusUnauthorized)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeGetHintsForStudentResponse returns an encoder for responses returned
+// by the codelab GetHintsForStudent endpoint.
+func EncodeGetHintsForStudentResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.([]*codelab.StudentHint)
+		enc := encoder(ctx, w)
+		body := NewGetHintsForStudentResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeGetHintsForStudentRequest returns a decoder for requests sent to the
+// codelab GetHintsForStudent endpoint.
+func DecodeGetHintsForStudentRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (any, error) {
+	return func(r *http.Request) (any, error) {
+		var (
+			exerciseID   int64
+			sessionToken string
+			err          error
+			c            *http.Cookie
+
+			params = mux.Vars(r)
+		)
+		{
+			exerciseIDRaw := params["exercise_id"]
+			v, err2 := strconv.ParseInt(exerciseIDRaw, 10, 64)
+			if err2 != nil {
+				err = goa.MergeErrors(err, goa.InvalidFieldTypeError("exercise_id", exerciseIDRaw, "integer"))
+			}
+			exerciseID = v
+		}
+		c, err = r.Cookie("session")
+		if err == http.ErrNoCookie {
+			err = goa.MergeErrors(err, goa.MissingFieldError("session_token", "cookie"))
+		} else {
+			sessionToken = c.Value
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewGetHintsForStudentPayload(exerciseID, sessionToken)
+
+		return payload, nil
+	}
+}
+
+// EncodeGetHintsForStudentError returns an encoder for errors returned by the
+// GetHintsForStudent codelab endpoint.
+func EncodeGetHintsForStudentError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "invalid_input":
+			var res codelab.InvalidInput
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		case "not_found":
+			var res codelab.NotFound
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		case "permission_denied":
+			var res codelab.PermissionDenied
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusForbidden)
+			return enc.Encode(body)
+		case "service_unavailable":
+			var res codelab.ServiceUnavailable
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return enc.Encode(body)
+		case "unauthorized":
+			var res codelab.Unauthorized
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusUnauthorized)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeRequestHintResponse returns an encoder for responses returned by the
+// codelab RequestHint endpoint.
+func EncodeRequestHintResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*codelab.StudentHint)
+		enc := encoder(ctx, w)
+		body := NewRequestHintResponseBody(res)
+		w.WriteHeader(http.StatusCreated)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeRequestHintRequest returns a decoder for requests sent to the codelab
+// RequestHint endpoint.
+func DecodeRequestHintRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (any, error) {
+	return func(r *http.Request) (any, error) {
+		var (
+			exerciseID   int64
+			sessionToken string
+			err          error
+			c            *http.Cookie
+
+			params = mux.Vars(r)
+		)
+		{
+			exerciseIDRaw := params["exercise_id"]
+			v, err2 := strconv.ParseInt(exerciseIDRaw, 10, 64)
+			if err2 != nil {
+				err = goa.MergeErrors(err, goa.InvalidFieldTypeError("exercise_id", exerciseIDRaw, "integer"))
+			}
+			exerciseID = v
+		}
+		c, err = r.Cookie("session")
+		if err == http.ErrNoCookie {
+			err = goa.MergeErrors(err, goa.MissingFieldError("session_token", "cookie"))
+		} else {
+			sessionToken = c.Value
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewRequestHintPayload(exerciseID, sessionToken)
+
+		return payload, nil
+	}
+}
+
+// EncodeRequestHintError returns an encoder for errors returned by the
+// RequestHint codelab endpoint.
+func EncodeRequestHintError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "invalid_input":
+			var res codelab.InvalidInput
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		case "not_found":
+			var res codelab.NotFound
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		case "permission_denied":
+			var res codelab.PermissionDenied
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusForbidden)
+			return enc.Encode(body)
+		case "service_unavailable":
+			var res codelab.ServiceUnavailable
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return enc.Encode(body)
+		case "unauthorized":
+			var res codelab.Unauthorized
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusUnauthorized)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeListAssignmentsForStudentsResponse returns an encoder for responses
+// returned by the codelab ListAssignmentsForStudents endpoint.
+func EncodeListAssignmentsForStudentsResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.([]*codelab.Assignment)
+		enc := encoder(ctx, w)
+		body := NewListAssignmentsForStudentsResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeListAssignmentsForStudentsRequest returns a decoder for requests sent
+// to the codelab ListAssignmentsForStudents endpoint.
+func DecodeListAssignmentsForStudentsRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (any, error) {
+	return func(r *http.Request) (any, error) {
+		var (
+			sessionToken string
+			err          error
+			c            *http.Cookie
+		)
+		c, err = r.Cookie("session")
+		if err == http.ErrNoCookie {
+			err = goa.MergeErrors(err, goa.MissingFieldError("session_token", "cookie"))
+		} else {
+			sessionToken = c.Value
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewListAssignmentsForStudentsPayload(sessionToken)
+
+		return payload, nil
+	}
+}
+
+// EncodeListAssignmentsForStudentsError returns an encoder for errors returned
+// by the ListAssignmentsForStudents codelab endpoint.
+func EncodeListAssignmentsForStudentsError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "invalid_input":
+			var res codelab.InvalidInput
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		case "permission_denied":
+			var res codelab.PermissionDenied
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusForbidden)
+			return enc.Encode(body)
+		case "service_unavailable":
+			var res codelab.ServiceUnavailable
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return enc.Encode(body)
+		case "unauthorized":
+			var res codelab.Unauthorized
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusUnauthorized)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeGetAssignmentProgressResponse returns an encoder for responses
+// returned by the codelab GetAssignmentProgress endpoint.
+func EncodeGetAssignmentProgressResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*codelab.AssignmentProgress)
+		enc := encoder(ctx, w)
+		body := NewGetAssignmentProgressResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeGetAssignmentProgressRequest returns a decoder for requests sent to
+// the codelab GetAssignmentProgress endpoint.
+func DecodeGetAssignmentProgressRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (any, error) {
+	return func(r *http.Request) (any, error) {
+		var (
+			id           int64
+			sessionToken string
+			err          error
+			c            *http.Cookie
+
+			params = mux.Vars(r)
+		)
+		{
+			idRaw := params["id"]
+			v, err2 := strconv.ParseInt(idRaw, 10, 64)
+			if err2 != nil {
+				err = goa.MergeErrors(err, goa.InvalidFieldTypeError("id", idRaw, "integer"))
+			}
+			id = v
+		}
+		c, err = r.Cookie("session")
+		if err == http.ErrNoCookie {
+			err = goa.MergeErrors(err, goa.MissingFieldError("session_token", "cookie"))
+		} else {
+			sessionToken = c.Value
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewGetAssignmentProgressPayload(id, sessionToken)
+
+		return payload, nil
+	}
+}
+
+// EncodeGetAssignmentProgressError returns an encoder for errors returned by
+// the GetAssignmentProgress codelab endpoint.
+func EncodeGetAssignmentProgressError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "invalid_input":
+			var res codelab.InvalidInput
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		case "not_found":
+			var res codelab.NotFound
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		case "permission_denied":
+			var res codelab.PermissionDenied
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusForbidden)
+			return enc.Encode(body)
+		case "service_unavailable":
+			var res codelab.ServiceUnavailable
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return enc.Encode(body)
+		case "unauthorized":
+			var res codelab.Unauthorized
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusUnauthorized)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeGetAnswerByUserAndExerciseResponse returns an encoder for responses
+// returned by the codelab GetAnswerByUserAndExercise endpoint.
+func EncodeGetAnswerByUserAndExerciseResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*codelab.Answer)
+		enc := encoder(ctx, w)
+		body := NewGetAnswerByUserAndExerciseResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeGetAnswerByUserAndExerciseRequest returns a decoder for requests sent
+// to the codelab GetAnswerByUserAndExercise endpoint.
+func DecodeGetAnswerByUserAndExerciseRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (any, error) {
+	return func(r *http.Request) (any, error) {
+		var (
+			userID       int64
+			exerciseID   int64
+			sessionToken string
+			err          error
+			c            *http.Cookie
+
+			params = mux.Vars(r)
+		)
+		{
+			userIDRaw := params["user_id"]
+			v, err2 := strconv.ParseInt(userIDRaw, 10, 64)
+			if err2 != nil {
+				err = goa.MergeErrors(err, goa.InvalidFieldTypeError("user_id", userIDRaw, "integer"))
+			}
+			userID = v
+		}
+		{
+			exerciseIDRaw := params["exercise_id"]
+			v, err2 := strconv.ParseInt(exerciseIDRaw, 10, 64)
+			if err2 != nil {
+				err = goa.MergeErrors(err, goa.InvalidFieldTypeError("exercise_id", exerciseIDRaw, "integer"))
+			}
+			exerciseID = v
+		}
+		c, err = r.Cookie("session")
+		if err == http.ErrNoCookie {
+			err = goa.MergeErrors(err, goa.MissingFieldError("session_token", "cookie"))
+		} else {
+			sessionToken = c.Value
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewGetAnswerByUserAndExercisePayload(userID, exerciseID, sessionToken)
+
+		return payload, nil
+	}
+}
+
+// EncodeGetAnswerByUserAndExerciseError returns an encoder for errors returned
+// by the GetAnswerByUserAndExercise codelab endpoint.
+func EncodeGetAnswerByUserAndExerciseError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "invalid_input":
+			var res codelab.InvalidInput
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		case "not_found":
+			var res codelab.NotFound
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		case "permission_denied":
+			var res codelab.PermissionDenied
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusForbidden)
+			return enc.Encode(body)
+		case "service_unavailable":
+			var res codelab.ServiceUnavailable
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return enc.Encode(body)
+		case "unauthorized":
+			var res codelab.Unauthorized
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusUnauthorized)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// marshalCodelabSolutionTestFailureToSolutionTestFailureResponseBody builds a
+// value of type *SolutionTestFailureResponseBody from a value of type
+// *codelab.SolutionTestFailure.
+func marshalCodelabSolutionTestFailureToSolutionTestFailureResponseBody(v *codelab.SolutionTestFailure) *SolutionTestFailureResponseBody {
+	res := &SolutionTestFailureResponseBody{
+		TestID:         v.TestID,
+		Input:          v.Input,
+		ExpectedOutput: v.ExpectedOutput,
+		ActualOutput:   v.ActualOutput,
+		Status:         v.Status,
+		ErrorMessage:   v.ErrorMessage,
+	}
+
+	return res
+}
+
+// marshalCodelabExerciseToExercise builds a value of type *Exercise from a
+// value of type *codelab.Exercise.
+func marshalCodelabExerciseToExercise(v *codelab.Exercise) *Exercise {
+	res := &Exercise{
+		ID:          v.ID,
+		Title:       v.Title,
+		Description: v.Description,
+		InitialCode: v.InitialCode,
+		Solution:    v.Solution,
+		Difficulty:  v.Difficulty,
+		CreatedBy:   v.CreatedBy,
+		CreatedAt:   v.CreatedAt,
+		UpdatedAt:   v.UpdatedAt,
+		Language:    v.Language,
+		Version:     v.Version,
+	}
+	if v.Tags != nil {
+		res.Tags = make([]string, len(v.Tags))
+		for i, val := range v.Tags {
+			res.Tags[i] = val
+		}
+	} else {
+		res.Tags = []string{}
+	}
+
+	return res
+}
+
+// marshalCodelabTagCountToTagCountResponse builds a value of type
+// *TagCountResponse from a value of type *codelab.TagCount.
+func marshalCodelabTagCountToTagCountResponse(v *codelab.TagCount) *TagCountResponse {
+	res := &TagCountResponse{
+		Tag:       v.Tag,
+		Exercises: v.Exercises,
+	}
+
+	return res
+}
+
+// unmarshalUpdateExercisePayloadRequestBodyToCodelabUpdateExercisePayload
+// builds a value of type *codelab.UpdateExercisePayload from a value of type
+// *UpdateExercisePayloadRequestBody.
+func unmarshalUpdateExercisePayloadRequestBodyToCodelabUpdateExercisePayload(v *UpdateExercisePayloadRequestBody) *codelab.UpdateExercisePayload {
+	res := &codelab.UpdateExercisePayload{
+		Title:       *v.Title,
+		Description: *v.Description,
+		InitialCode: *v.InitialCode,
+		Solution:    *v.Solution,
+		Difficulty:  *v.Difficulty,
+		Language:    v.Language,
+	}
+	if v.Tags != nil {
+		res.Tags = make([]string, len(v.Tags))
+		for i, val := range v.Tags {
+			res.Tags[i] = val
+		}
+	}
+
+	return res
+}
+
+// marshalCodelabTestToTestResponse builds a value of type *TestResponse from a
+// value of type *codelab.Test.
+func marshalCodelabTestToTestResponse(v *codelab.Test) *TestResponse {
+	res := &TestResponse{
+		ID:          v.ID,
+		Input:       v.Input,
+		Output:      v.Output,
+		Public:      v.Public,
+		ExerciseID:  v.ExerciseID,
+		CreatedAt:   v.CreatedAt,
+		UpdatedAt:   v.UpdatedAt,
+		InputFormat: v.InputFormat,
+		Comparison:  v.Comparison,
+		Tolerance:   v.Tolerance,
+		Weight:      v.Weight,
+	}
 
 	return res
 }
@@ -1539,21 +4828,310 @@ func unmarshalUpdateTestPayloadRequestBodyToCodelabUpdateTestPayload(v *UpdateTe
 		Output: *v.Output,
 		Public: *v.Public,
 	}
+	if v.InputFormat != nil {
+		res.InputFormat = *v.InputFormat
+	}
+	if v.Comparison != nil {
+		res.Comparison = *v.Comparison
+	}
+	if v.Tolerance != nil {
+		res.Tolerance = *v.Tolerance
+	}
+	if v.Weight != nil {
+		res.Weight = *v.Weight
+	}
+	if v.InputFormat == nil {
+		res.InputFormat = "text"
+	}
+	if v.Comparison == nil {
+		res.Comparison = "exact"
+	}
+	if v.Tolerance == nil {
+		res.Tolerance = 0
+	}
+	if v.Weight == nil {
+		res.Weight = 1
+	}
 
 	return res
 }
 
-// marshalCodelabTestToTestResponseBody builds a value of type
-// *TestResponseBody from a value of type *codelab.Test.
-func marshalCodelabTestToTestResponseBody(v *codelab.Test) *TestResponseBody {
-	res := &TestResponseBody{
-		ID:         v.ID,
-		Input:      v.Input,
-		Output:     v.Output,
-		Public:     v.Public,
+// marshalCodelabExerciseVersionToExerciseVersionResponse builds a value of
+// type *ExerciseVersionResponse from a value of type *codelab.ExerciseVersion.
+func marshalCodelabExerciseVersionToExerciseVersionResponse(v *codelab.ExerciseVersion) *ExerciseVersionResponse {
+	res := &ExerciseVersionResponse{
+		Version:   v.Version,
+		CreatedBy: v.CreatedBy,
+		CreatedAt: v.CreatedAt,
+		Attempts:  v.Attempts,
+	}
+
+	return res
+}
+
+// marshalCodelabFieldChangeToFieldChangeResponseBody builds a value of type
+// *FieldChangeResponseBody from a value of type *codelab.FieldChange.
+func marshalCodelabFieldChangeToFieldChangeResponseBody(v *codelab.FieldChange) *FieldChangeResponseBody {
+	res := &FieldChangeResponseBody{
+		Field: v.Field,
+		From:  v.From,
+		To:    v.To,
+	}
+
+	return res
+}
+
+// marshalCodelabTestChangeToTestChangeResponseBody builds a value of type
+// *TestChangeResponseBody from a value of type *codelab.TestChange.
+func marshalCodelabTestChangeToTestChangeResponseBody(v *codelab.TestChange) *TestChangeResponseBody {
+	res := &TestChangeResponseBody{
+		TestID: v.TestID,
+		Change: v.Change,
+	}
+	if v.Fields != nil {
+		res.Fields = make([]*FieldChangeResponseBody, len(v.Fields))
+		for i, val := range v.Fields {
+			res.Fields[i] = marshalCodelabFieldChangeToFieldChangeResponseBody(val)
+		}
+	} else {
+		res.Fields = []*FieldChangeResponseBody{}
+	}
+
+	return res
+}
+
+// marshalCodelabRegradeStudentChangeToRegradeStudentChangeResponseBody builds
+// a value of type *RegradeStudentChangeResponseBody from a value of type
+// *codelab.RegradeStudentChange.
+func marshalCodelabRegradeStudentChangeToRegradeStudentChangeResponseBody(v *codelab.RegradeStudentChange) *RegradeStudentChangeResponseBody {
+	res := &RegradeStudentChangeResponseBody{
+		UserID:        v.UserID,
+		PreviousScore: v.PreviousScore,
+		Score:         v.Score,
+	}
+
+	return res
+}
+
+// marshalCodelabHintToHintResponse builds a value of type *HintResponse from a
+// value of type *codelab.Hint.
+func marshalCodelabHintToHintResponse(v *codelab.Hint) *HintResponse {
+	res := &HintResponse{
+		ID:                  v.ID,
+		ExerciseID:          v.ExerciseID,
+		Position:            v.Position,
+		Content:             v.Content,
+		UnlockAfterAttempts: v.UnlockAfterAttempts,
+		UnlockAfterSeconds:  v.UnlockAfterSeconds,
+		Penalty:             v.Penalty,
+		CreatedAt:           v.CreatedAt,
+		UpdatedAt:           v.UpdatedAt,
+	}
+
+	return res
+}
+
+// unmarshalUpdateHintPayloadRequestBodyToCodelabUpdateHintPayload builds a
+// value of type *codelab.UpdateHintPayload from a value of type
+// *UpdateHintPayloadRequestBody.
+func unmarshalUpdateHintPayloadRequestBodyToCodelabUpdateHintPayload(v *UpdateHintPayloadRequestBody) *codelab.UpdateHintPayload {
+	res := &codelab.UpdateHintPayload{
+		Content:             *v.Content,
+		UnlockAfterAttempts: v.UnlockAfterAttempts,
+		UnlockAfterSeconds:  v.UnlockAfterSeconds,
+	}
+	if v.Penalty != nil {
+		res.Penalty = *v.Penalty
+	}
+	if v.Penalty == nil {
+		res.Penalty = 0
+	}
+
+	return res
+}
+
+// marshalCodelabAssignmentExerciseToAssignmentExerciseResponseBody builds a
+// value of type *AssignmentExerciseResponseBody from a value of type
+// *codelab.AssignmentExercise.
+func marshalCodelabAssignmentExerciseToAssignmentExerciseResponseBody(v *codelab.AssignmentExercise) *AssignmentExerciseResponseBody {
+	res := &AssignmentExerciseResponseBody{
+		ExerciseID: v.ExerciseID,
+		Position:   v.Position,
+		Title:      v.Title,
+		Difficulty: v.Difficulty,
+		Language:   v.Language,
+	}
+
+	return res
+}
+
+// marshalCodelabAssignmentToAssignmentResponse builds a value of type
+// *AssignmentResponse from a value of type *codelab.Assignment.
+func marshalCodelabAssignmentToAssignmentResponse(v *codelab.Assignment) *AssignmentResponse {
+	res := &AssignmentResponse{
+		ID:          v.ID,
+		Title:       v.Title,
+		Description: v.Description,
+		OpensAt:     v.OpensAt,
+		ClosesAt:    v.ClosesAt,
+		AllowLate:   v.AllowLate,
+		Status:      v.Status,
+		CreatedBy:   v.CreatedBy,
+		CreatedAt:   v.CreatedAt,
+		UpdatedAt:   v.UpdatedAt,
+	}
+	if v.Exercises != nil {
+		res.Exercises = make([]*AssignmentExerciseResponse, len(v.Exercises))
+		for i, val := range v.Exercises {
+			res.Exercises[i] = marshalCodelabAssignmentExerciseToAssignmentExerciseResponse(val)
+		}
+	} else {
+		res.Exercises = []*AssignmentExerciseResponse{}
+	}
+
+	return res
+}
+
+// marshalCodelabAssignmentExerciseToAssignmentExerciseResponse builds a value
+// of type *AssignmentExerciseResponse from a value of type
+// *codelab.AssignmentExercise.
+func marshalCodelabAssignmentExerciseToAssignmentExerciseResponse(v *codelab.AssignmentExercise) *AssignmentExerciseResponse {
+	res := &AssignmentExerciseResponse{
 		ExerciseID: v.ExerciseID,
-		CreatedAt:  v.CreatedAt,
-		UpdatedAt:  v.UpdatedAt,
+		Position:   v.Position,
+		Title:      v.Title,
+		Difficulty: v.Difficulty,
+		Language:   v.Language,
+	}
+
+	return res
+}
+
+// unmarshalUpdateAssignmentPayloadRequestBodyToCodelabUpdateAssignmentPayload
+// builds a value of type *codelab.UpdateAssignmentPayload from a value of type
+// *UpdateAssignmentPayloadRequestBody.
+func unmarshalUpdateAssignmentPayloadRequestBodyToCodelabUpdateAssignmentPayload(v *UpdateAssignmentPayloadRequestBody) *codelab.UpdateAssignmentPayload {
+	res := &codelab.UpdateAssignmentPayload{
+		Title:    *v.Title,
+		OpensAt:  *v.OpensAt,
+		ClosesAt: *v.ClosesAt,
+	}
+	if v.Description != nil {
+		res.Description = *v.Description
+	}
+	if v.AllowLate != nil {
+		res.AllowLate = *v.AllowLate
+	}
+	if v.Description == nil {
+		res.Description = ""
+	}
+	if v.AllowLate == nil {
+		res.AllowLate = false
+	}
+	res.ExerciseIds = make([]int64, len(v.ExerciseIds))
+	for i, val := range v.ExerciseIds {
+		res.ExerciseIds[i] = val
+	}
+
+	return res
+}
+
+// marshalCodelabAssignmentToAssignmentResponseBody builds a value of type
+// *AssignmentResponseBody from a value of type *codelab.Assignment.
+func marshalCodelabAssignmentToAssignmentResponseBody(v *codelab.Assignment) *AssignmentResponseBody {
+	res := &AssignmentResponseBody{
+		ID:          v.ID,
+		Title:       v.Title,
+		Description: v.Description,
+		OpensAt:     v.OpensAt,
+		ClosesAt:    v.ClosesAt,
+		AllowLate:   v.AllowLate,
+		Status:      v.Status,
+		CreatedBy:   v.CreatedBy,
+		CreatedAt:   v.CreatedAt,
+		UpdatedAt:   v.UpdatedAt,
+	}
+	if v.Exercises != nil {
+		res.Exercises = make([]*AssignmentExerciseResponseBody, len(v.Exercises))
+		for i, val := range v.Exercises {
+			res.Exercises[i] = marshalCodelabAssignmentExerciseToAssignmentExerciseResponseBody(val)
+		}
+	} else {
+		res.Exercises = []*AssignmentExerciseResponseBody{}
+	}
+
+	return res
+}
+
+// marshalCodelabStudentAssignmentGradeToStudentAssignmentGradeResponseBody
+// builds a value of type *StudentAssignmentGradeResponseBody from a value of
+// type *codelab.StudentAssignmentGrade.
+func marshalCodelabStudentAssignmentGradeToStudentAssignmentGradeResponseBody(v *codelab.StudentAssignmentGrade) *StudentAssignmentGradeResponseBody {
+	res := &StudentAssignmentGradeResponseBody{
+		UserID:             v.UserID,
+		Score:              v.Score,
+		ScoreWithLate:      v.ScoreWithLate,
+		CompletedExercises: v.CompletedExercises,
+		TotalExercises:     v.TotalExercises,
+	}
+	if v.Exercises != nil {
+		res.Exercises = make([]*ExerciseGradeResponseBody, len(v.Exercises))
+		for i, val := range v.Exercises {
+			res.Exercises[i] = marshalCodelabExerciseGradeToExerciseGradeResponseBody(val)
+		}
+	} else {
+		res.Exercises = []*ExerciseGradeResponseBody{}
+	}
+
+	return res
+}
+
+// marshalCodelabExerciseGradeToExerciseGradeResponseBody builds a value of
+// type *ExerciseGradeResponseBody from a value of type *codelab.ExerciseGrade.
+func marshalCodelabExerciseGradeToExerciseGradeResponseBody(v *codelab.ExerciseGrade) *ExerciseGradeResponseBody {
+	res := &ExerciseGradeResponseBody{
+		ExerciseID:        v.ExerciseID,
+		Status:            v.Status,
+		Attempts:          v.Attempts,
+		LateAttempts:      v.LateAttempts,
+		BestScore:         v.BestScore,
+		BestScoreWithLate: v.BestScoreWithLate,
+	}
+
+	return res
+}
+
+// marshalCodelabFailingTestToFailingTestResponseBody builds a value of type
+// *FailingTestResponseBody from a value of type *codelab.FailingTest.
+func marshalCodelabFailingTestToFailingTestResponseBody(v *codelab.FailingTest) *FailingTestResponseBody {
+	if v == nil {
+		return nil
+	}
+	res := &FailingTestResponseBody{
+		TestID:   v.TestID,
+		Input:    v.Input,
+		Public:   v.Public,
+		Failures: v.Failures,
+	}
+
+	return res
+}
+
+// marshalCodelabStudentExerciseStatusToStudentExerciseStatusResponseBody
+// builds a value of type *StudentExerciseStatusResponseBody from a value of
+// type *codelab.StudentExerciseStatus.
+func marshalCodelabStudentExerciseStatusToStudentExerciseStatusResponseBody(v *codelab.StudentExerciseStatus) *StudentExerciseStatusResponseBody {
+	res := &StudentExerciseStatusResponseBody{
+		UserID:             v.UserID,
+		Status:             v.Status,
+		Attempts:           v.Attempts,
+		BestScore:          v.BestScore,
+		AttemptsToSuccess:  v.AttemptsToSuccess,
+		FirstAttemptAt:     v.FirstAttemptAt,
+		LastAttemptAt:      v.LastAttemptAt,
+		CompletedAt:        v.CompletedAt,
+		TimeToCompletionMs: v.TimeToCompletionMs,
+		HintsUsed:          v.HintsUsed,
 	}
 
 	return res
@@ -1563,11 +5141,101 @@ func marshalCodelabTestToTestResponseBody(v *codelab.Test) *TestResponseBody {
 // *AttemptResponseBody from a value of type *codelab.Attempt.
 func marshalCodelabAttemptToAttemptResponseBody(v *codelab.Attempt) *AttemptResponseBody {
 	res := &AttemptResponseBody{
-		ID:        v.ID,
-		AnswerID:  v.AnswerID,
-		Code:      v.Code,
-		Success:   v.Success,
-		CreatedAt: v.CreatedAt,
+		ID:              v.ID,
+		AnswerID:        v.AnswerID,
+		Code:            v.Code,
+		Success:         v.Success,
+		CreatedAt:       v.CreatedAt,
+		Status:          v.Status,
+		Points:          v.Points,
+		MaxPoints:       v.MaxPoints,
+		Score:           v.Score,
+		Late:            v.Late,
+		ExerciseVersion: v.ExerciseVersion,
+	}
+	if v.TestResults != nil {
+		res.TestResults = make([]*AttemptTestResultResponseBody, len(v.TestResults))
+		for i, val := range v.TestResults {
+			res.TestResults[i] = marshalCodelabAttemptTestResultToAttemptTestResultResponseBody(val)
+		}
+	}
+
+	return res
+}
+
+// marshalCodelabAttemptTestResultToAttemptTestResultResponseBody builds a
+// value of type *AttemptTestResultResponseBody from a value of type
+// *codelab.AttemptTestResult.
+func marshalCodelabAttemptTestResultToAttemptTestResultResponseBody(v *codelab.AttemptTestResult) *AttemptTestResultResponseBody {
+	if v == nil {
+		return nil
+	}
+	res := &AttemptTestResultResponseBody{
+		TestID:         v.TestID,
+		Status:         v.Status,
+		ActualOutput:   v.ActualOutput,
+		ExpectedOutput: v.ExpectedOutput,
+		Error:          v.Error,
+		ConsoleOutput:  v.ConsoleOutput,
+		DurationMs:     v.DurationMs,
+		StackTrace:     v.StackTrace,
+	}
+
+	return res
+}
+
+// marshalCodelabPlagiarismPairToPlagiarismPairResponseBody builds a value of
+// type *PlagiarismPairResponseBody from a value of type
+// *codelab.PlagiarismPair.
+func marshalCodelabPlagiarismPairToPlagiarismPairResponseBody(v *codelab.PlagiarismPair) *PlagiarismPairResponseBody {
+	res := &PlagiarismPairResponseBody{
+		AttemptAID: v.AttemptAID,
+		UserAID:    v.UserAID,
+		AttemptBID: v.AttemptBID,
+		UserBID:    v.UserBID,
+		Similarity: v.Similarity,
+	}
+	if v.Regions != nil {
+		res.Regions = make([]*PlagiarismRegionResponseBody, len(v.Regions))
+		for i, val := range v.Regions {
+			res.Regions[i] = marshalCodelabPlagiarismRegionToPlagiarismRegionResponseBody(val)
+		}
+	} else {
+		res.Regions = []*PlagiarismRegionResponseBody{}
+	}
+
+	return res
+}
+
+// marshalCodelabPlagiarismRegionToPlagiarismRegionResponseBody builds a value
+// of type *PlagiarismRegionResponseBody from a value of type
+// *codelab.PlagiarismRegion.
+func marshalCodelabPlagiarismRegionToPlagiarismRegionResponseBody(v *codelab.PlagiarismRegion) *PlagiarismRegionResponseBody {
+	res := &PlagiarismRegionResponseBody{
+		StartLineA: v.StartLineA,
+		EndLineA:   v.EndLineA,
+		StartLineB: v.StartLineB,
+		EndLineB:   v.EndLineB,
+	}
+
+	return res
+}
+
+// marshalCodelabTestToTestResponseBody builds a value of type
+// *TestResponseBody from a value of type *codelab.Test.
+func marshalCodelabTestToTestResponseBody(v *codelab.Test) *TestResponseBody {
+	res := &TestResponseBody{
+		ID:          v.ID,
+		Input:       v.Input,
+		Output:      v.Output,
+		Public:      v.Public,
+		ExerciseID:  v.ExerciseID,
+		CreatedAt:   v.CreatedAt,
+		UpdatedAt:   v.UpdatedAt,
+		InputFormat: v.InputFormat,
+		Comparison:  v.Comparison,
+		Tolerance:   v.Tolerance,
+		Weight:      v.Weight,
 	}
 
 	return res
@@ -1577,22 +5245,26 @@ func marshalCodelabAttemptToAttemptResponseBody(v *codelab.Attempt) *AttemptResp
 // *AnswerResponseBody from a value of type *codelab.Answer.
 func marshalCodelabAnswerToAnswerResponseBody(v *codelab.Answer) *AnswerResponseBody {
 	res := &AnswerResponseBody{
-		ID:         v.ID,
-		ExerciseID: v.ExerciseID,
-		UserID:     v.UserID,
-		Completed:  v.Completed,
-		CreatedAt:  v.CreatedAt,
-		UpdatedAt:  v.UpdatedAt,
+		ID:            v.ID,
+		ExerciseID:    v.ExerciseID,
+		UserID:        v.UserID,
+		Completed:     v.Completed,
+		CreatedAt:     v.CreatedAt,
+		UpdatedAt:     v.UpdatedAt,
+		BestScore:     v.BestScore,
+		BestAttemptID: v.BestAttemptID,
+		HintsUsed:     v.HintsUsed,
+		HintPenalty:   v.HintPenalty,
 	}
 
 	return res
 }
 
-// marshalCodelabExerciseForStudentsListViewToExerciseForStudentsListViewResponse
-// builds a value of type *ExerciseForStudentsListViewResponse from a value of
-// type *codelab.ExerciseForStudentsListView.
-func marshalCodelabExerciseForStudentsListViewToExerciseForStudentsListViewResponse(v *codelab.ExerciseForStudentsListView) *ExerciseForStudentsListViewResponse {
-	res := &ExerciseForStudentsListViewResponse{
+// marshalCodelabExerciseForStudentsListViewToExerciseForStudentsListView
+// builds a value of type *ExerciseForStudentsListView from a value of type
+// *codelab.ExerciseForStudentsListView.
+func marshalCodelabExerciseForStudentsListViewToExerciseForStudentsListView(v *codelab.ExerciseForStudentsListView) *ExerciseForStudentsListView {
+	res := &ExerciseForStudentsListView{
 		ID:          v.ID,
 		Title:       v.Title,
 		Description: v.Description,
@@ -1601,6 +5273,33 @@ func marshalCodelabExerciseForStudentsListViewToExerciseForStudentsListViewRespo
 		CreatedBy:   v.CreatedBy,
 		CreatedAt:   v.CreatedAt,
 		UpdatedAt:   v.UpdatedAt,
+		Language:    v.Language,
+	}
+	if v.Tags != nil {
+		res.Tags = make([]string, len(v.Tags))
+		for i, val := range v.Tags {
+			res.Tags[i] = val
+		}
+	} else {
+		res.Tags = []string{}
+	}
+
+	return res
+}
+
+// marshalCodelabRunCaseResultToRunCaseResultResponseBody builds a value of
+// type *RunCaseResultResponseBody from a value of type *codelab.RunCaseResult.
+func marshalCodelabRunCaseResultToRunCaseResultResponseBody(v *codelab.RunCaseResult) *RunCaseResultResponseBody {
+	res := &RunCaseResultResponseBody{
+		TestID:         v.TestID,
+		Input:          v.Input,
+		Status:         v.Status,
+		Output:         v.Output,
+		ExpectedOutput: v.ExpectedOutput,
+		Error:          v.Error,
+		ConsoleOutput:  v.ConsoleOutput,
+		DurationMs:     v.DurationMs,
+		StackTrace:     v.StackTrace,
 	}
 
 	return res
@@ -1610,11 +5309,61 @@ func marshalCodelabExerciseForStudentsListViewToExerciseForStudentsListViewRespo
 // *AttemptResponse from a value of type *codelab.Attempt.
 func marshalCodelabAttemptToAttemptResponse(v *codelab.Attempt) *AttemptResponse {
 	res := &AttemptResponse{
-		ID:        v.ID,
-		AnswerID:  v.AnswerID,
-		Code:      v.Code,
-		Success:   v.Success,
-		CreatedAt: v.CreatedAt,
+		ID:              v.ID,
+		AnswerID:        v.AnswerID,
+		Code:            v.Code,
+		Success:         v.Success,
+		CreatedAt:       v.CreatedAt,
+		Status:          v.Status,
+		Points:          v.Points,
+		MaxPoints:       v.MaxPoints,
+		Score:           v.Score,
+		Late:            v.Late,
+		ExerciseVersion: v.ExerciseVersion,
+	}
+	if v.TestResults != nil {
+		res.TestResults = make([]*AttemptTestResultResponse, len(v.TestResults))
+		for i, val := range v.TestResults {
+			res.TestResults[i] = marshalCodelabAttemptTestResultToAttemptTestResultResponse(val)
+		}
+	}
+
+	return res
+}
+
+// marshalCodelabAttemptTestResultToAttemptTestResultResponse builds a value of
+// type *AttemptTestResultResponse from a value of type
+// *codelab.AttemptTestResult.
+func marshalCodelabAttemptTestResultToAttemptTestResultResponse(v *codelab.AttemptTestResult) *AttemptTestResultResponse {
+	if v == nil {
+		return nil
+	}
+	res := &AttemptTestResultResponse{
+		TestID:         v.TestID,
+		Status:         v.Status,
+		ActualOutput:   v.ActualOutput,
+		ExpectedOutput: v.ExpectedOutput,
+		Error:          v.Error,
+		ConsoleOutput:  v.ConsoleOutput,
+		DurationMs:     v.DurationMs,
+		StackTrace:     v.StackTrace,
+	}
+
+	return res
+}
+
+// marshalCodelabStudentHintToStudentHintResponse builds a value of type
+// *StudentHintResponse from a value of type *codelab.StudentHint.
+func marshalCodelabStudentHintToStudentHintResponse(v *codelab.StudentHint) *StudentHintResponse {
+	res := &StudentHintResponse{
+		ID:                v.ID,
+		Position:          v.Position,
+		Status:            v.Status,
+		Content:           v.Content,
+		Penalty:           v.Penalty,
+		AttemptsRemaining: v.AttemptsRemaining,
+		UnlocksAt:         v.UnlocksAt,
+		RevealedAt:        v.RevealedAt,
 	}
 
 	return res
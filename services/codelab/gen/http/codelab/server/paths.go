@@ -28,6 +28,11 @@ func ListExercisesCodelabPath() string {
 	return "/api/codelab/exercises"
 }
 
+// ListTagsCodelabPath returns the URL path to the codelab service ListTags HTTP endpoint.
+func ListTagsCodelabPath() string {
+	return "/api/codelab/tags"
+}
+
 // UpdateExerciseCodelabPath returns the URL path to the codelab service UpdateExercise HTTP endpoint.
 func UpdateExerciseCodelabPath(id int64) string {
 	return fmt.Sprintf("/api/codelab/exercises/%v", id)
@@ -38,6 +43,16 @@ func DeleteExerciseCodelabPath(id int64) string {
 	return fmt.Sprintf("/api/codelab/exercises/%v", id)
 }
 
+// ExportExercisesCodelabPath returns the URL path to the codelab service ExportExercises HTTP endpoint.
+func ExportExercisesCodelabPath() string {
+	return "/api/codelab/exercises/export"
+}
+
+// ImportExercisesCodelabPath returns the URL path to the codelab service ImportExercises HTTP endpoint.
+func ImportExercisesCodelabPath() string {
+	return "/api/codelab/exercises/import"
+}
+
 // CreateTestCodelabPath returns the URL path to the codelab service CreateTest HTTP endpoint.
 func CreateTestCodelabPath() string {
 	return "/api/codelab/tests"
@@ -58,6 +73,96 @@ func DeleteTestCodelabPath(id int64) string {
 	return fmt.Sprintf("/api/codelab/tests/%v", id)
 }
 
+// ListExerciseVersionsCodelabPath returns the URL path to the codelab service ListExerciseVersions HTTP endpoint.
+func ListExerciseVersionsCodelabPath(exerciseID int64) string {
+	return fmt.Sprintf("/api/codelab/exercises/%v/versions", exerciseID)
+}
+
+// DiffExerciseVersionsCodelabPath returns the URL path to the codelab service DiffExerciseVersions HTTP endpoint.
+func DiffExerciseVersionsCodelabPath(exerciseID int64) string {
+	return fmt.Sprintf("/api/codelab/exercises/%v/versions/diff", exerciseID)
+}
+
+// RollbackExerciseCodelabPath returns the URL path to the codelab service RollbackExercise HTTP endpoint.
+func RollbackExerciseCodelabPath(exerciseID int64, version int32) string {
+	return fmt.Sprintf("/api/codelab/exercises/%v/versions/%v/rollback", exerciseID, version)
+}
+
+// RegradeExerciseCodelabPath returns the URL path to the codelab service RegradeExercise HTTP endpoint.
+func RegradeExerciseCodelabPath(exerciseID int64) string {
+	return fmt.Sprintf("/api/codelab/exercises/%v/regrade", exerciseID)
+}
+
+// GetRegradeCodelabPath returns the URL path to the codelab service GetRegrade HTTP endpoint.
+func GetRegradeCodelabPath(id int64) string {
+	return fmt.Sprintf("/api/codelab/regrades/%v", id)
+}
+
+// CreateHintCodelabPath returns the URL path to the codelab service CreateHint HTTP endpoint.
+func CreateHintCodelabPath() string {
+	return "/api/codelab/hints"
+}
+
+// GetHintsByExerciseCodelabPath returns the URL path to the codelab service GetHintsByExercise HTTP endpoint.
+func GetHintsByExerciseCodelabPath(exerciseID int64) string {
+	return fmt.Sprintf("/api/codelab/exercises/%v/hints", exerciseID)
+}
+
+// UpdateHintCodelabPath returns the URL path to the codelab service UpdateHint HTTP endpoint.
+func UpdateHintCodelabPath(id int64) string {
+	return fmt.Sprintf("/api/codelab/hints/%v", id)
+}
+
+// DeleteHintCodelabPath returns the URL path to the codelab service DeleteHint HTTP endpoint.
+func DeleteHintCodelabPath(id int64) string {
+	return fmt.Sprintf("/api/codelab/hints/%v", id)
+}
+
+// CreateAssignmentCodelabPath returns the URL path to the codelab service CreateAssignment HTTP endpoint.
+func CreateAssignmentCodelabPath() string {
+	return "/api/codelab/assignments"
+}
+
+// GetAssignmentCodelabPath returns the URL path to the codelab service GetAssignment HTTP endpoint.
+func GetAssignmentCodelabPath(id int64) string {
+	return fmt.Sprintf("/api/codelab/assignments/%v", id)
+}
+
+// ListAssignmentsCodelabPath returns the URL path to the codelab service ListAssignments HTTP endpoint.
+func ListAssignmentsCodelabPath() string {
+	return "/api/codelab/assignments"
+}
+
+// UpdateAssignmentCodelabPath returns the URL path to the codelab service UpdateAssignment HTTP endpoint.
+func UpdateAssignmentCodelabPath(id int64) string {
+	return fmt.Sprintf("/api/codelab/assignments/%v", id)
+}
+
+// DeleteAssignmentCodelabPath returns the URL path to the codelab service DeleteAssignment HTTP endpoint.
+func DeleteAssignmentCodelabPath(id int64) string {
+	return fmt.Sprintf("/api/codelab/assignments/%v", id)
+}
+
+// GetAssignmentGradebookCodelabPath returns the URL path to the codelab service GetAssignmentGradebook HTTP endpoint.
+func GetAssignmentGradebookCodelabPath(id int64) string {
+	return fmt.Sprintf("/api/codelab/assignments/%v/gradebook", id)
+}
+
+// GetExerciseStatsCodelabPath returns the URL path to the codelab service GetExerciseStats HTTP endpoint.
+func GetExerciseStatsCodelabPath(exerciseID int64) string {
+	return fmt.Sprintf("/api/codelab/exercises/%v/stats", exerciseID)
+}
+
+// GetStudentExerciseStatsCodelabPath returns the URL path to the codelab service GetStudentExerciseStats HTTP endpoint.
+func GetStudentExerciseStatsCodelabPath(exerciseID int64, userID int64) string {
+	return fmt.Sprintf("/api/codelab/exercises/%v/stats/students/%v", exerciseID, userID)
+}
+
+// GetPlagiarismReportCodelabPath returns the URL path to the codelab service GetPlagiarismReport HTTP endpoint.
+func GetPlagiarismReportCodelabPath(exerciseID int64) string {
+	return fmt.Sprintf("/api/codelab/exercises/%v/plagiarism", exerciseID)
+}
+
 // GetExerciseForStudentCodelabPath returns the URL path to the codelab service GetExerciseForStudent HTTP endpoint.
 func GetExerciseForStudentCodelabPath(id int64) string {
 	return fmt.Sprintf("/api/codelab/student/exercises/%v", id)
@@ -73,11 +178,46 @@ func CreateAttemptCodelabPath() string {
 	return "/api/codelab/attempts"
 }
 
+// SubmitAttemptCodelabPath returns the URL path to the codelab service SubmitAttempt HTTP endpoint.
+func SubmitAttemptCodelabPath() string {
+	return "/api/codelab/submissions"
+}
+
+// StreamAttemptEventsCodelabPath returns the URL path to the codelab service StreamAttemptEvents HTTP endpoint.
+func StreamAttemptEventsCodelabPath(id int64) string {
+	return fmt.Sprintf("/api/codelab/submissions/%v/events", id)
+}
+
+// RunCodeCodelabPath returns the URL path to the codelab service RunCode HTTP endpoint.
+func RunCodeCodelabPath(exerciseID int64) string {
+	return fmt.Sprintf("/api/codelab/student/exercises/%v/run", exerciseID)
+}
+
 // GetAttemptsByUserAndExerciseCodelabPath returns the URL path to the codelab service GetAttemptsByUserAndExercise HTTP endpoint.
 func GetAttemptsByUserAndExerciseCodelabPath(userID int64, exerciseID int64) string {
 	return fmt.Sprintf("/api/codelab/student/users/%v/exercises/%v/attempts", userID, exerciseID)
 }
 
+// GetHintsForStudentCodelabPath returns the URL path to the codelab service GetHintsForStudent HTTP endpoint.
+func GetHintsForStudentCodelabPath(exerciseID int64) string {
+	return fmt.Sprintf("/api/codelab/student/exercises/%v/hints", exerciseID)
+}
+
+// RequestHintCodelabPath returns the URL path to the codelab service RequestHint HTTP endpoint.
+func RequestHintCodelabPath(exerciseID int64) string {
+	return fmt.Sprintf("/api/codelab/student/exercises/%v/hints", exerciseID)
+}
+
+// ListAssignmentsForStudentsCodelabPath returns the URL path to the codelab service ListAssignmentsForStudents HTTP endpoint.
+func ListAssignmentsForStudentsCodelabPath() string {
+	return "/api/codelab/student/assignments"
+}
+
+// GetAssignmentProgressCodelabPath returns the URL path to the codelab service GetAssignmentProgress HTTP endpoint.
+func GetAssignmentProgressCodelabPath(id int64) string {
+	return fmt.Sprintf("/api/codelab/student/assignments/%v/progress", id)
+}
+
 // GetAnswerByUserAndExerciseCodelabPath returns the URL path to the codelab service GetAnswerByUserAndExercise HTTP endpoint.
 func GetAnswerByUserAndExerciseCodelabPath(userID int64, exerciseID int64) string {
 	return fmt.Sprintf("/api/codelab/answers/user/%v/exercise/%v", userID, exerciseID)
@@ -24,16 +24,44 @@ type Server struct {
 	CreateExercise               http.Handler
 	GetExercise                  http.Handler
 	ListExercises                http.Handler
+	ListTags                     http.Handler
 	UpdateExercise               http.Handler
 	DeleteExercise               http.Handler
+	ExportExercises              http.Handler
+	ImportExercises              http.Handler
 	CreateTest                   http.Handler
 	GetTestsByExercise           http.Handler
 	UpdateTest                   http.Handler
 	DeleteTest                   http.Handler
+	ListExerciseVersions         http.Handler
+	DiffExerciseVersions         http.Handler
+	RollbackExercise             http.Handler
+	RegradeExercise              http.Handler
+	GetRegrade                   http.Handler
+	CreateHint                   http.Handler
+	GetHintsByExercise           http.Handler
+	UpdateHint                   http.Handler
+	DeleteHint                   http.Handler
+	CreateAssignment             http.Handler
+	GetAssignment                http.Handler
+	ListAssignments              http.Handler
+	UpdateAssignment             http.Handler
+	DeleteAssignment             http.Handler
+	GetAssignmentGradebook       http.Handler
+	GetExerciseStats             http.Handler
+	GetStudentExerciseStats      http.Handler
+	GetPlagiarismReport          http.Handler
 	GetExerciseForStudent        http.Handler
 	ListExercisesForStudents     http.Handler
 	CreateAttempt                http.Handler
+	SubmitAttempt                http.Handler
+	StreamAttemptEvents          http.Handler
+	RunCode                      http.Handler
 	GetAttemptsByUserAndExercise http.Handler
+	GetHintsForStudent           http.Handler
+	RequestHint                  http.Handler
+	ListAssignmentsForStudents   http.Handler
+	GetAssignmentProgress        http.Handler
 	GetAnswerByUserAndExercise   http.Handler
 }
 
@@ -61,37 +89,98 @@ func New(
 	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
 	errhandler func(context.Context, http.ResponseWriter, error),
 	formatter func(ctx context.Context, err error) goahttp.Statuser,
+	upgrader goahttp.Upgrader,
+	configurer *ConnConfigurer,
 ) *Server {
+	if configurer == nil {
+		configurer = &ConnConfigurer{}
+	}
 	return &Server{
 		Mounts: []*MountPoint{
 			{"CreateExercise", "POST", "/api/codelab/exercises"},
 			{"GetExercise", "GET", "/api/codelab/exercises/{id}"},
 			{"ListExercises", "GET", "/api/codelab/exercises"},
+			{"ListTags", "GET", "/api/codelab/tags"},
 			{"UpdateExercise", "PUT", "/api/codelab/exercises/{id}"},
 			{"DeleteExercise", "DELETE", "/api/codelab/exercises/{id}"},
+			{"ExportExercises", "GET", "/api/codelab/exercises/export"},
+			{"ImportExercises", "POST", "/api/codelab/exercises/import"},
 			{"CreateTest", "POST", "/api/codelab/tests"},
 			{"GetTestsByExercise", "GET", "/api/codelab/exercises/{exercise_id}/tests"},
 			{"UpdateTest", "PUT", "/api/codelab/tests/{id}"},
 			{"DeleteTest", "DELETE", "/api/codelab/tests/{id}"},
+			{"ListExerciseVersions", "GET", "/api/codelab/exercises/{exercise_id}/versions"},
+			{"DiffExerciseVersions", "GET", "/api/codelab/exercises/{exercise_id}/versions/diff"},
+			{"RollbackExercise", "POST", "/api/codelab/exercises/{exercise_id}/versions/{version}/rollback"},
+			{"RegradeExercise", "POST", "/api/codelab/exercises/{exercise_id}/regrade"},
+			{"GetRegrade", "GET", "/api/codelab/regrades/{id}"},
+			{"CreateHint", "POST", "/api/codelab/hints"},
+			{"GetHintsByExercise", "GET", "/api/codelab/exercises/{exercise_id}/hints"},
+			{"UpdateHint", "PUT", "/api/codelab/hints/{id}"},
+			{"DeleteHint", "DELETE", "/api/codelab/hints/{id}"},
+			{"CreateAssignment", "POST", "/api/codelab/assignments"},
+			{"GetAssignment", "GET", "/api/codelab/assignments/{id}"},
+			{"ListAssignments", "GET", "/api/codelab/assignments"},
+			{"UpdateAssignment", "PUT", "/api/codelab/assignments/{id}"},
+			{"DeleteAssignment", "DELETE", "/api/codelab/assignments/{id}"},
+			{"GetAssignmentGradebook", "GET", "/api/codelab/assignments/{id}/gradebook"},
+			{"GetExerciseStats", "GET", "/api/codelab/exercises/{exercise_id}/stats"},
+			{"GetStudentExerciseStats", "GET", "/api/codelab/exercises/{exercise_id}/stats/students/{user_id}"},
+			{"GetPlagiarismReport", "GET", "/api/codelab/exercises/{exercise_id}/plagiarism"},
 			{"GetExerciseForStudent", "GET", "/api/codelab/student/exercises/{id}"},
 			{"ListExercisesForStudents", "GET", "/api/codelab/student/exercises"},
 			{"CreateAttempt", "POST", "/api/codelab/attempts"},
+			{"SubmitAttempt", "POST", "/api/codelab/submissions"},
+			{"StreamAttemptEvents", "GET", "/api/codelab/submissions/{id}/events"},
+			{"RunCode", "POST", "/api/codelab/student/exercises/{exercise_id}/run"},
 			{"GetAttemptsByUserAndExercise", "GET", "/api/codelab/student/users/{user_id}/exercises/{exercise_id}/attempts"},
+			{"GetHintsForStudent", "GET", "/api/codelab/student/exercises/{exercise_id}/hints"},
+			{"RequestHint", "POST", "/api/codelab/student/exercises/{exercise_id}/hints"},
+			{"ListAssignmentsForStudents", "GET", "/api/codelab/student/assignments"},
+			{"GetAssignmentProgress", "GET", "/api/codelab/student/assignments/{id}/progress"},
 			{"GetAnswerByUserAndExercise", "GET", "/api/codelab/answers/user/{user_id}/exercise/{exercise_id}"},
 		},
 		CreateExercise:               NewCreateExerciseHandler(e.CreateExercise, mux, decoder, encoder, errhandler, formatter),
 		GetExercise:                  NewGetExerciseHandler(e.GetExercise, mux, decoder, encoder, errhandler, formatter),
 		ListExercises:                NewListExercisesHandler(e.ListExercises, mux, decoder, encoder, errhandler, formatter),
+		ListTags:                     NewListTagsHandler(e.ListTags, mux, decoder, encoder, errhandler, formatter),
 		UpdateExercise:               NewUpdateExerciseHandler(e.UpdateExercise, mux, decoder, encoder, errhandler, formatter),
 		DeleteExercise:               NewDeleteExerciseHandler(e.DeleteExercise, mux, decoder, encoder, errhandler, formatter),
+		ExportExercises:              NewExportExercisesHandler(e.ExportExercises, mux, decoder, encoder, errhandler, formatter),
+		ImportExercises:              NewImportExercisesHandler(e.ImportExercises, mux, decoder, encoder, errhandler, formatter),
 		CreateTest:                   NewCreateTestHandler(e.CreateTest, mux, decoder, encoder, errhandler, formatter),
 		GetTestsByExercise:           NewGetTestsByExerciseHandler(e.GetTestsByExercise, mux, decoder, encoder, errhandler, formatter),
 		UpdateTest:                   NewUpdateTestHandler(e.UpdateTest, mux, decoder, encoder, errhandler, formatter),
 		DeleteTest:                   NewDeleteTestHandler(e.DeleteTest, mux, decoder, encoder, errhandler, formatter),
+		ListExerciseVersions:         NewListExerciseVersionsHandler(e.ListExerciseVersions, mux, decoder, encoder, errhandler, formatter),
+		DiffExerciseVersions:         NewDiffExerciseVersionsHandler(e.DiffExerciseVersions, mux, decoder, encoder, errhandler, formatter),
+		RollbackExercise:             NewRollbackExerciseHandler(e.RollbackExercise, mux, decoder, encoder, errhandler, formatter),
+		RegradeExercise:              NewRegradeExerciseHandler(e.RegradeExercise, mux, decoder, encoder, errhandler, formatter),
+		GetRegrade:                   NewGetRegradeHandler(e.GetRegrade, mux, decoder, encoder, errhandler, formatter),
+		CreateHint:                   NewCreateHintHandler(e.CreateHint, mux, decoder, encoder, errhandler, formatter),
+		GetHintsByExercise:           NewGetHintsByExerciseHandler(e.GetHintsByExercise, mux, decoder, encoder, errhandler, formatter),
+		UpdateHint:                   NewUpdateHintHandler(e.UpdateHint, mux, decoder, encoder, errhandler, formatter),
+		DeleteHint:                   NewDeleteHintHandler(e.DeleteHint, mux, decoder, encoder, errhandler, formatter),
+		CreateAssignment:             NewCreateAssignmentHandler(e.CreateAssignment, mux, decoder, encoder, errhandler, formatter),
+		GetAssignment:                NewGetAssignmentHandler(e.GetAssignment, mux, decoder, encoder, errhandler, formatter),
+		ListAssignments:              NewListAssignmentsHandler(e.ListAssignments, mux, decoder, encoder, errhandler, formatter),
+		UpdateAssignment:             NewUpdateAssignmentHandler(e.UpdateAssignment, mux, decoder, encoder, errhandler, formatter),
+		DeleteAssignment:             NewDeleteAssignmentHandler(e.DeleteAssignment, mux, decoder, encoder, errhandler, formatter),
+		GetAssignmentGradebook:       NewGetAssignmentGradebookHandler(e.GetAssignmentGradebook, mux, decoder, encoder, errhandler, formatter),
+		GetExerciseStats:             NewGetExerciseStatsHandler(e.GetExerciseStats, mux, decoder, encoder, errhandler, formatter),
+		GetStudentExerciseStats:      NewGetStudentExerciseStatsHandler(e.GetStudentExerciseStats, mux, decoder, encoder, errhandler, formatter),
+		GetPlagiarismReport:          NewGetPlagiarismReportHandler(e.GetPlagiarismReport, mux, decoder, encoder, errhandler, formatter),
 		GetExerciseForStudent:        NewGetExerciseForStudentHandler(e.GetExerciseForStudent, mux, decoder, encoder, errhandler, formatter),
 		ListExercisesForStudents:     NewListExercisesForStudentsHandler(e.ListExercisesForStudents, mux, decoder, encoder, errhandler, formatter),
 		CreateAttempt:                NewCreateAttemptHandler(e.CreateAttempt, mux, decoder, encoder, errhandler, formatter),
+		SubmitAttempt:                NewSubmitAttemptHandler(e.SubmitAttempt, mux, decoder, encoder, errhandler, formatter),
+		StreamAttemptEvents:          NewStreamAttemptEventsHandler(e.StreamAttemptEvents, mux, decoder, encoder, errhandler, formatter, upgrader, configurer.StreamAttemptEventsFn),
+		RunCode:                      NewRunCodeHandler(e.RunCode, mux, decoder, encoder, errhandler, formatter),
 		GetAttemptsByUserAndExercise: NewGetAttemptsByUserAndExerciseHandler(e.GetAttemptsByUserAndExercise, mux, decoder, encoder, errhandler, formatter),
+		GetHintsForStudent:           NewGetHintsForStudentHandler(e.GetHintsForStudent, mux, decoder, encoder, errhandler, formatter),
+		RequestHint:                  NewRequestHintHandler(e.RequestHint, mux, decoder, encoder, errhandler, formatter),
+		ListAssignmentsForStudents:   NewListAssignmentsForStudentsHandler(e.ListAssignmentsForStudents, mux, decoder, encoder, errhandler, formatter),
+		GetAssignmentProgress:        NewGetAssignmentProgressHandler(e.GetAssignmentProgress, mux, decoder, encoder, errhandler, formatter),
 		GetAnswerByUserAndExercise:   NewGetAnswerByUserAndExerciseHandler(e.GetAnswerByUserAndExercise, mux, decoder, encoder, errhandler, formatter),
 	}
 }
@@ -104,16 +193,44 @@ func (s *Server) Use(m func(http.Handler) http.Handler) {
 	s.CreateExercise = m(s.CreateExercise)
 	s.GetExercise = m(s.GetExercise)
 	s.ListExercises = m(s.ListExercises)
+	s.ListTags = m(s.ListTags)
 	s.UpdateExercise = m(s.UpdateExercise)
 	s.DeleteExercise = m(s.DeleteExercise)
+	s.ExportExercises = m(s.ExportExercises)
+	s.ImportExercises = m(s.ImportExercises)
 	s.CreateTest = m(s.CreateTest)
 	s.GetTestsByExercise = m(s.GetTestsByExercise)
 	s.UpdateTest = m(s.UpdateTest)
 	s.DeleteTest = m(s.DeleteTest)
+	s.ListExerciseVersions = m(s.ListExerciseVersions)
+	s.DiffExerciseVersions = m(s.DiffExerciseVersions)
+	s.RollbackExercise = m(s.RollbackExercise)
+	s.RegradeExercise = m(s.RegradeExercise)
+	s.GetRegrade = m(s.GetRegrade)
+	s.CreateHint = m(s.CreateHint)
+	s.GetHintsByExercise = m(s.GetHintsByExercise)
+	s.UpdateHint = m(s.UpdateHint)
+	s.DeleteHint = m(s.DeleteHint)
+	s.CreateAssignment = m(s.CreateAssignment)
+	s.GetAssignment = m(s.GetAssignment)
+	s.ListAssignments = m(s.ListAssignments)
+	s.UpdateAssignment = m(s.UpdateAssignment)
+	s.DeleteAssignment = m(s.DeleteAssignment)
+	s.GetAssignmentGradebook = m(s.GetAssignmentGradebook)
+	s.GetExerciseStats = m(s.GetExerciseStats)
+	s.GetStudentExerciseStats = m(s.GetStudentExerciseStats)
+	s.GetPlagiarismReport = m(s.GetPlagiarismReport)
 	s.GetExerciseForStudent = m(s.GetExerciseForStudent)
 	s.ListExercisesForStudents = m(s.ListExercisesForStudents)
 	s.CreateAttempt = m(s.CreateAttempt)
+	s.SubmitAttempt = m(s.SubmitAttempt)
+	s.StreamAttemptEvents = m(s.StreamAttemptEvents)
+	s.RunCode = m(s.RunCode)
 	s.GetAttemptsByUserAndExercise = m(s.GetAttemptsByUserAndExercise)
+	s.GetHintsForStudent = m(s.GetHintsForStudent)
+	s.RequestHint = m(s.RequestHint)
+	s.ListAssignmentsForStudents = m(s.ListAssignmentsForStudents)
+	s.GetAssignmentProgress = m(s.GetAssignmentProgress)
 	s.GetAnswerByUserAndExercise = m(s.GetAnswerByUserAndExercise)
 }
 
@@ -125,16 +242,44 @@ func Mount(mux goahttp.Muxer, h *Server) {
 	MountCreateExerciseHandler(mux, h.CreateExercise)
 	MountGetExerciseHandler(mux, h.GetExercise)
 	MountListExercisesHandler(mux, h.ListExercises)
+	MountListTagsHandler(mux, h.ListTags)
 	MountUpdateExerciseHandler(mux, h.UpdateExercise)
 	MountDeleteExerciseHandler(mux, h.DeleteExercise)
+	MountExportExercisesHandler(mux, h.ExportExercises)
+	MountImportExercisesHandler(mux, h.ImportExercises)
 	MountCreateTestHandler(mux, h.CreateTest)
 	MountGetTestsByExerciseHandler(mux, h.GetTestsByExercise)
 	MountUpdateTestHandler(mux, h.UpdateTest)
 	MountDeleteTestHandler(mux, h.DeleteTest)
+	MountListExerciseVersionsHandler(mux, h.ListExerciseVersions)
+	MountDiffExerciseVersionsHandler(mux, h.DiffExerciseVersions)
+	MountRollbackExerciseHandler(mux, h.RollbackExercise)
+	MountRegradeExerciseHandler(mux, h.RegradeExercise)
+	MountGetRegradeHandler(mux, h.GetRegrade)
+	MountCreateHintHandler(mux, h.CreateHint)
+	MountGetHintsByExerciseHandler(mux, h.GetHintsByExercise)
+	MountUpdateHintHandler(mux, h.UpdateHint)
+	MountDeleteHintHandler(mux, h.DeleteHint)
+	MountCreateAssignmentHandler(mux, h.CreateAssignment)
+	MountGetAssignmentHandler(mux, h.GetAssignment)
+	MountListAssignmentsHandler(mux, h.ListAssignments)
+	MountUpdateAssignmentHandler(mux, h.UpdateAssignment)
+	MountDeleteAssignmentHandler(mux, h.DeleteAssignment)
+	MountGetAssignmentGradebookHandler(mux, h.GetAssignmentGradebook)
+	MountGetExerciseStatsHandler(mux, h.GetExerciseStats)
+	MountGetStudentExerciseStatsHandler(mux, h.GetStudentExerciseStats)
+	MountGetPlagiarismReportHandler(mux, h.GetPlagiarismReport)
 	MountGetExerciseForStudentHandler(mux, h.GetExerciseForStudent)
 	MountListExercisesForStudentsHandler(mux, h.ListExercisesForStudents)
 	MountCreateAttemptHandler(mux, h.CreateAttempt)
+	MountSubmitAttemptHandler(mux, h.SubmitAttempt)
+	MountStreamAttemptEventsHandler(mux, h.StreamAttemptEvents)
+	MountRunCodeHandler(mux, h.RunCode)
 	MountGetAttemptsByUserAndExerciseHandler(mux, h.GetAttemptsByUserAndExercise)
+	MountGetHintsForStudentHandler(mux, h.GetHintsForStudent)
+	MountRequestHintHandler(mux, h.RequestHint)
+	MountListAssignmentsForStudentsHandler(mux, h.ListAssignmentsForStudents)
+	MountGetAssignmentProgressHandler(mux, h.GetAssignmentProgress)
 	MountGetAnswerByUserAndExerciseHandler(mux, h.GetAnswerByUserAndExercise)
 }
 
@@ -296,6 +441,57 @@ func NewListExercisesHandler(
 	})
 }
 
+// MountListTagsHandler configures the mux to serve the "codelab" service
+// "ListTags" endpoint.
+func MountListTagsHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/api/codelab/tags", f)
+}
+
+// NewListTagsHandler creates a HTTP handler which loads the HTTP request and
+// calls the "codelab" service "ListTags" endpoint.
+func NewListTagsHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeListTagsRequest(mux, decoder)
+		encodeResponse = EncodeListTagsResponse(encoder)
+		encodeError    = EncodeListTagsError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "ListTags")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "codelab")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			errhandler(ctx, w, err)
+		}
+	})
+}
+
 // MountUpdateExerciseHandler configures the mux to serve the "codelab" service
 // "UpdateExercise" endpoint.
 func MountUpdateExerciseHandler(mux goahttp.Muxer, h http.Handler) {
@@ -398,6 +594,108 @@ func NewDeleteExerciseHandler(
 	})
 }
 
+// MountExportExercisesHandler configures the mux to serve the "codelab"
+// service "ExportExercises" endpoint.
+func MountExportExercisesHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/api/codelab/exercises/export", f)
+}
+
+// NewExportExercisesHandler creates a HTTP handler which loads the HTTP
+// request and calls the "codelab" service "ExportExercises" endpoint.
+func NewExportExercisesHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeExportExercisesRequest(mux, decoder)
+		encodeResponse = EncodeExportExercisesResponse(encoder)
+		encodeError    = EncodeExportExercisesError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "ExportExercises")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "codelab")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			errhandler(ctx, w, err)
+		}
+	})
+}
+
+// MountImportExercisesHandler configures the mux to serve the "codelab"
+// service "ImportExercises" endpoint.
+func MountImportExercisesHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("POST", "/api/codelab/exercises/import", f)
+}
+
+// NewImportExercisesHandler creates a HTTP handler which loads the HTTP
+// request and calls the "codelab" service "ImportExercises" endpoint.
+func NewImportExercisesHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeImportExercisesRequest(mux, decoder)
+		encodeResponse = EncodeImportExercisesResponse(encoder)
+		encodeError    = EncodeImportExercisesError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "ImportExercises")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "codelab")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			errhandler(ctx, w, err)
+		}
+	})
+}
+
 // MountCreateTestHandler configures the mux to serve the "codelab" service
 // "CreateTest" endpoint.
 func MountCreateTestHandler(mux goahttp.Muxer, h http.Handler) {
@@ -602,21 +900,21 @@ func NewDeleteTestHandler(
 	})
 }
 
-// MountGetExerciseForStudentHandler configures the mux to serve the "codelab"
-// service "GetExerciseForStudent" endpoint.
-func MountGetExerciseForStudentHandler(mux goahttp.Muxer, h http.Handler) {
+// MountListExerciseVersionsHandler configures the mux to serve the "codelab"
+// service "ListExerciseVersions" endpoint.
+func MountListExerciseVersionsHandler(mux goahttp.Muxer, h http.Handler) {
 	f, ok := h.(http.HandlerFunc)
 	if !ok {
 		f = func(w http.ResponseWriter, r *http.Request) {
 			h.ServeHTTP(w, r)
 		}
 	}
-	mux.Handle("GET", "/api/codelab/student/exercises/{id}", f)
+	mux.Handle("GET", "/api/codelab/exercises/{exercise_id}/versions", f)
 }
 
-// NewGetExerciseForStudentHandler creates a HTTP handler which loads the HTTP
-// request and calls the "codelab" service "GetExerciseForStudent" endpoint.
-func NewGetExerciseForStudentHandler(
+// NewListExerciseVersionsHandler creates a HTTP handler which loads the HTTP
+// request and calls the "codelab" service "ListExerciseVersions" endpoint.
+func NewListExerciseVersionsHandler(
 	endpoint goa.Endpoint,
 	mux goahttp.Muxer,
 	decoder func(*http.Request) goahttp.Decoder,
@@ -625,13 +923,13 @@ func NewGetExerciseForStudentHandler(
 	formatter func(ctx context.Context, err error) goahttp.Statuser,
 ) http.Handler {
 	var (
-		decodeRequest  = DecodeGetExerciseForStudentRequest(mux, decoder)
-		encodeResponse = EncodeGetExerciseForStudentResponse(encoder)
-		encodeError    = EncodeGetExerciseForStudentError(encoder, formatter)
+		decodeRequest  = DecodeListExerciseVersionsRequest(mux, decoder)
+		encodeResponse = EncodeListExerciseVersionsResponse(encoder)
+		encodeError    = EncodeListExerciseVersionsError(encoder, formatter)
 	)
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
-		ctx = context.WithValue(ctx, goa.MethodKey, "GetExerciseForStudent")
+		ctx = context.WithValue(ctx, goa.MethodKey, "ListExerciseVersions")
 		ctx = context.WithValue(ctx, goa.ServiceKey, "codelab")
 		payload, err := decodeRequest(r)
 		if err != nil {
@@ -653,22 +951,21 @@ func NewGetExerciseForStudentHandler(
 	})
 }
 
-// MountListExercisesForStudentsHandler configures the mux to serve the
-// "codelab" service "ListExercisesForStudents" endpoint.
-func MountListExercisesForStudentsHandler(mux goahttp.Muxer, h http.Handler) {
+// MountDiffExerciseVersionsHandler configures the mux to serve the "codelab"
+// service "DiffExerciseVersions" endpoint.
+func MountDiffExerciseVersionsHandler(mux goahttp.Muxer, h http.Handler) {
 	f, ok := h.(http.HandlerFunc)
 	if !ok {
 		f = func(w http.ResponseWriter, r *http.Request) {
 			h.ServeHTTP(w, r)
 		}
 	}
-	mux.Handle("GET", "/api/codelab/student/exercises", f)
+	mux.Handle("GET", "/api/codelab/exercises/{exercise_id}/versions/diff", f)
 }
 
-// NewListExercisesForStudentsHandler creates a HTTP handler which loads the
-// HTTP request and calls the "codelab" service "ListExercisesForStudents"
-// endpoint.
-func NewListExercisesForStudentsHandler(
+// NewDiffExerciseVersionsHandler creates a HTTP handler which loads the HTTP
+// request and calls the "codelab" service "DiffExerciseVersions" endpoint.
+func NewDiffExerciseVersionsHandler(
 	endpoint goa.Endpoint,
 	mux goahttp.Muxer,
 	decoder func(*http.Request) goahttp.Decoder,
@@ -677,13 +974,13 @@ func NewListExercisesForStudentsHandler(
 	formatter func(ctx context.Context, err error) goahttp.Statuser,
 ) http.Handler {
 	var (
-		decodeRequest  = DecodeListExercisesForStudentsRequest(mux, decoder)
-		encodeResponse = EncodeListExercisesForStudentsResponse(encoder)
-		encodeError    = EncodeListExercisesForStudentsError(encoder, formatter)
+		decodeRequest  = DecodeDiffExerciseVersionsRequest(mux, decoder)
+		encodeResponse = EncodeDiffExerciseVersionsResponse(encoder)
+		encodeError    = EncodeDiffExerciseVersionsError(encoder, formatter)
 	)
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
-		ctx = context.WithValue(ctx, goa.MethodKey, "ListExercisesForStudents")
+		ctx = context.WithValue(ctx, goa.MethodKey, "DiffExerciseVersions")
 		ctx = context.WithValue(ctx, goa.ServiceKey, "codelab")
 		payload, err := decodeRequest(r)
 		if err != nil {
@@ -705,21 +1002,21 @@ func NewListExercisesForStudentsHandler(
 	})
 }
 
-// MountCreateAttemptHandler configures the mux to serve the "codelab" service
-// "CreateAttempt" endpoint.
-func MountCreateAttemptHandler(mux goahttp.Muxer, h http.Handler) {
+// MountRollbackExerciseHandler configures the mux to serve the "codelab"
+// service "RollbackExercise" endpoint.
+func MountRollbackExerciseHandler(mux goahttp.Muxer, h http.Handler) {
 	f, ok := h.(http.HandlerFunc)
 	if !ok {
 		f = func(w http.ResponseWriter, r *http.Request) {
 			h.ServeHTTP(w, r)
 		}
 	}
-	mux.Handle("POST", "/api/codelab/attempts", f)
+	mux.Handle("POST", "/api/codelab/exercises/{exercise_id}/versions/{version}/rollback", f)
 }
 
-// NewCreateAttemptHandler creates a HTTP handler which loads the HTTP request
-// and calls the "codelab" service "CreateAttempt" endpoint.
-func NewCreateAttemptHandler(
+// NewRollbackExerciseHandler creates a HTTP handler which loads the HTTP
+// request and calls the "codelab" service "RollbackExercise" endpoint.
+func NewRollbackExerciseHandler(
 	endpoint goa.Endpoint,
 	mux goahttp.Muxer,
 	decoder func(*http.Request) goahttp.Decoder,
@@ -728,13 +1025,13 @@ func NewCreateAttemptHandler(
 	formatter func(ctx context.Context, err error) goahttp.Statuser,
 ) http.Handler {
 	var (
-		decodeRequest  = DecodeCreateAttemptRequest(mux, decoder)
-		encodeResponse = EncodeCreateAttemptResponse(encoder)
-		encodeError    = EncodeCreateAttemptError(encoder, formatter)
+		decodeRequest  = DecodeRollbackExerciseRequest(mux, decoder)
+		encodeResponse = EncodeRollbackExerciseResponse(encoder)
+		encodeError    = EncodeRollbackExerciseError(encoder, formatter)
 	)
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
-		ctx = context.WithValue(ctx, goa.MethodKey, "CreateAttempt")
+		ctx = context.WithValue(ctx, goa.MethodKey, "RollbackExercise")
 		ctx = context.WithValue(ctx, goa.ServiceKey, "codelab")
 		payload, err := decodeRequest(r)
 		if err != nil {
@@ -756,22 +1053,21 @@ func NewCreateAttemptHandler(
 	})
 }
 
-// MountGetAttemptsByUserAndExerciseHandler configures the mux to serve the
-// "codelab" service "GetAttemptsByUserAndExercise" endpoint.
-func MountGetAttemptsByUserAndExerciseHandler(mux goahttp.Muxer, h http.Handler) {
+// MountRegradeExerciseHandler configures the mux to serve the "codelab"
+// service "RegradeExercise" endpoint.
+func MountRegradeExerciseHandler(mux goahttp.Muxer, h http.Handler) {
 	f, ok := h.(http.HandlerFunc)
 	if !ok {
 		f = func(w http.ResponseWriter, r *http.Request) {
 			h.ServeHTTP(w, r)
 		}
 	}
-	mux.Handle("GET", "/api/codelab/student/users/{user_id}/exercises/{exercise_id}/attempts", f)
+	mux.Handle("POST", "/api/codelab/exercises/{exercise_id}/regrade", f)
 }
 
-// NewGetAttemptsByUserAndExerciseHandler creates a HTTP handler which loads
-// the HTTP request and calls the "codelab" service
-// "GetAttemptsByUserAndExercise" endpoint.
-func NewGetAttemptsByUserAndExerciseHandler(
+// NewRegradeExerciseHandler creates a HTTP handler which loads the HTTP
+// request and calls the "codelab" service "RegradeExercise" endpoint.
+func NewRegradeExerciseHandler(
 	endpoint goa.Endpoint,
 	mux goahttp.Muxer,
 	decoder func(*http.Request) goahttp.Decoder,
@@ -780,13 +1076,1307 @@ func NewGetAttemptsByUserAndExerciseHandler(
 	formatter func(ctx context.Context, err error) goahttp.Statuser,
 ) http.Handler {
 	var (
-		decodeRequest  = DecodeGetAttemptsByUserAndExerciseRequest(mux, decoder)
-		encodeResponse = EncodeGetAttemptsByUserAndExerciseResponse(encoder)
-		encodeError    = EncodeGetAttemptsByUserAndExerciseError(encoder, formatter)
+		decodeRequest  = DecodeRegradeExerciseRequest(mux, decoder)
+		encodeResponse = EncodeRegradeExerciseResponse(encoder)
+		encodeError    = EncodeRegradeExerciseError(encoder, formatter)
 	)
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
-		ctx = context.WithValue(ctx, goa.MethodKey, "GetAttemptsByUserAndExercise")
+		ctx = context.WithValue(ctx, goa.MethodKey, "RegradeExercise")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "codelab")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			errhandler(ctx, w, err)
+		}
+	})
+}
+
+// MountGetRegradeHandler configures the mux to serve the "codelab" service
+// "GetRegrade" endpoint.
+func MountGetRegradeHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/api/codelab/regrades/{id}", f)
+}
+
+// NewGetRegradeHandler creates a HTTP handler which loads the HTTP request and
+// calls the "codelab" service "GetRegrade" endpoint.
+func NewGetRegradeHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeGetRegradeRequest(mux, decoder)
+		encodeResponse = EncodeGetRegradeResponse(encoder)
+		encodeError    = EncodeGetRegradeError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "GetRegrade")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "codelab")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			errhandler(ctx, w, err)
+		}
+	})
+}
+
+// MountCreateHintHandler configures the mux to serve the "codelab" service
+// "CreateHint" endpoint.
+func MountCreateHintHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("POST", "/api/codelab/hints", f)
+}
+
+// NewCreateHintHandler creates a HTTP handler which loads the HTTP request and
+// calls the "codelab" service "CreateHint" endpoint.
+func NewCreateHintHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeCreateHintRequest(mux, decoder)
+		encodeResponse = EncodeCreateHintResponse(encoder)
+		encodeError    = EncodeCreateHintError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "CreateHint")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "codelab")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			errhandler(ctx, w, err)
+		}
+	})
+}
+
+// MountGetHintsByExerciseHandler configures the mux to serve the "codelab"
+// service "GetHintsByExercise" endpoint.
+func MountGetHintsByExerciseHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/api/codelab/exercises/{exercise_id}/hints", f)
+}
+
+// NewGetHintsByExerciseHandler creates a HTTP handler which loads the HTTP
+// request and calls the "codelab" service "GetHintsByExercise" endpoint.
+func NewGetHintsByExerciseHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeGetHintsByExerciseRequest(mux, decoder)
+		encodeResponse = EncodeGetHintsByExerciseResponse(encoder)
+		encodeError    = EncodeGetHintsByExerciseError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "GetHintsByExercise")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "codelab")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			errhandler(ctx, w, err)
+		}
+	})
+}
+
+// MountUpdateHintHandler configures the mux to serve the "codelab" service
+// "UpdateHint" endpoint.
+func MountUpdateHintHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("PUT", "/api/codelab/hints/{id}", f)
+}
+
+// NewUpdateHintHandler creates a HTTP handler which loads the HTTP request and
+// calls the "codelab" service "UpdateHint" endpoint.
+func NewUpdateHintHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeUpdateHintRequest(mux, decoder)
+		encodeResponse = EncodeUpdateHintResponse(encoder)
+		encodeError    = EncodeUpdateHintError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "UpdateHint")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "codelab")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			errhandler(ctx, w, err)
+		}
+	})
+}
+
+// MountDeleteHintHandler configures the mux to serve the "codelab" service
+// "DeleteHint" endpoint.
+func MountDeleteHintHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("DELETE", "/api/codelab/hints/{id}", f)
+}
+
+// NewDeleteHintHandler creates a HTTP handler which loads the HTTP request and
+// calls the "codelab" service "DeleteHint" endpoint.
+func NewDeleteHintHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeDeleteHintRequest(mux, decoder)
+		encodeResponse = EncodeDeleteHintResponse(encoder)
+		encodeError    = EncodeDeleteHintError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "DeleteHint")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "codelab")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			errhandler(ctx, w, err)
+		}
+	})
+}
+
+// MountCreateAssignmentHandler configures the mux to serve the "codelab"
+// service "CreateAssignment" endpoint.
+func MountCreateAssignmentHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("POST", "/api/codelab/assignments", f)
+}
+
+// NewCreateAssignmentHandler creates a HTTP handler which loads the HTTP
+// request and calls the "codelab" service "CreateAssignment" endpoint.
+func NewCreateAssignmentHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeCreateAssignmentRequest(mux, decoder)
+		encodeResponse = EncodeCreateAssignmentResponse(encoder)
+		encodeError    = EncodeCreateAssignmentError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "CreateAssignment")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "codelab")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			errhandler(ctx, w, err)
+		}
+	})
+}
+
+// MountGetAssignmentHandler configures the mux to serve the "codelab" service
+// "GetAssignment" endpoint.
+func MountGetAssignmentHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/api/codelab/assignments/{id}", f)
+}
+
+// NewGetAssignmentHandler creates a HTTP handler which loads the HTTP request
+// and calls the "codelab" service "GetAssignment" endpoint.
+func NewGetAssignmentHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeGetAssignmentRequest(mux, decoder)
+		encodeResponse = EncodeGetAssignmentResponse(encoder)
+		encodeError    = EncodeGetAssignmentError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "GetAssignment")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "codelab")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			errhandler(ctx, w, err)
+		}
+	})
+}
+
+// MountListAssignmentsHandler configures the mux to serve the "codelab"
+// service "ListAssignments" endpoint.
+func MountListAssignmentsHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/api/codelab/assignments", f)
+}
+
+// NewListAssignmentsHandler creates a HTTP handler which loads the HTTP
+// request and calls the "codelab" service "ListAssignments" endpoint.
+func NewListAssignmentsHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeListAssignmentsRequest(mux, decoder)
+		encodeResponse = EncodeListAssignmentsResponse(encoder)
+		encodeError    = EncodeListAssignmentsError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "ListAssignments")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "codelab")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			errhandler(ctx, w, err)
+		}
+	})
+}
+
+// MountUpdateAssignmentHandler configures the mux to serve the "codelab"
+// service "UpdateAssignment" endpoint.
+func MountUpdateAssignmentHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("PUT", "/api/codelab/assignments/{id}", f)
+}
+
+// NewUpdateAssignmentHandler creates a HTTP handler which loads the HTTP
+// request and calls the "codelab" service "UpdateAssignment" endpoint.
+func NewUpdateAssignmentHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeUpdateAssignmentRequest(mux, decoder)
+		encodeResponse = EncodeUpdateAssignmentResponse(encoder)
+		encodeError    = EncodeUpdateAssignmentError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "UpdateAssignment")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "codelab")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			errhandler(ctx, w, err)
+		}
+	})
+}
+
+// MountDeleteAssignmentHandler configures the mux to serve the "codelab"
+// service "DeleteAssignment" endpoint.
+func MountDeleteAssignmentHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("DELETE", "/api/codelab/assignments/{id}", f)
+}
+
+// NewDeleteAssignmentHandler creates a HTTP handler which loads the HTTP
+// request and calls the "codelab" service "DeleteAssignment" endpoint.
+func NewDeleteAssignmentHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeDeleteAssignmentRequest(mux, decoder)
+		encodeResponse = EncodeDeleteAssignmentResponse(encoder)
+		encodeError    = EncodeDeleteAssignmentError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "DeleteAssignment")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "codelab")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			errhandler(ctx, w, err)
+		}
+	})
+}
+
+// MountGetAssignmentGradebookHandler configures the mux to serve the "codelab"
+// service "GetAssignmentGradebook" endpoint.
+func MountGetAssignmentGradebookHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/api/codelab/assignments/{id}/gradebook", f)
+}
+
+// NewGetAssignmentGradebookHandler creates a HTTP handler which loads the HTTP
+// request and calls the "codelab" service "GetAssignmentGradebook" endpoint.
+func NewGetAssignmentGradebookHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeGetAssignmentGradebookRequest(mux, decoder)
+		encodeResponse = EncodeGetAssignmentGradebookResponse(encoder)
+		encodeError    = EncodeGetAssignmentGradebookError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "GetAssignmentGradebook")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "codelab")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			errhandler(ctx, w, err)
+		}
+	})
+}
+
+// MountGetExerciseStatsHandler configures the mux to serve the "codelab"
+// service "GetExerciseStats" endpoint.
+func MountGetExerciseStatsHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/api/codelab/exercises/{exercise_id}/stats", f)
+}
+
+// NewGetExerciseStatsHandler creates a HTTP handler which loads the HTTP
+// request and calls the "codelab" service "GetExerciseStats" endpoint.
+func NewGetExerciseStatsHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeGetExerciseStatsRequest(mux, decoder)
+		encodeResponse = EncodeGetExerciseStatsResponse(encoder)
+		encodeError    = EncodeGetExerciseStatsError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "GetExerciseStats")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "codelab")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			errhandler(ctx, w, err)
+		}
+	})
+}
+
+// MountGetStudentExerciseStatsHandler configures the mux to serve the
+// "codelab" service "GetStudentExerciseStats" endpoint.
+func MountGetStudentExerciseStatsHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/api/codelab/exercises/{exercise_id}/stats/students/{user_id}", f)
+}
+
+// NewGetStudentExerciseStatsHandler creates a HTTP handler which loads the
+// HTTP request and calls the "codelab" service "GetStudentExerciseStats"
+// endpoint.
+func NewGetStudentExerciseStatsHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeGetStudentExerciseStatsRequest(mux, decoder)
+		encodeResponse = EncodeGetStudentExerciseStatsResponse(encoder)
+		encodeError    = EncodeGetStudentExerciseStatsError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "GetStudentExerciseStats")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "codelab")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			errhandler(ctx, w, err)
+		}
+	})
+}
+
+// MountGetPlagiarismReportHandler configures the mux to serve the "codelab"
+// service "GetPlagiarismReport" endpoint.
+func MountGetPlagiarismReportHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/api/codelab/exercises/{exercise_id}/plagiarism", f)
+}
+
+// NewGetPlagiarismReportHandler creates a HTTP handler which loads the HTTP
+// request and calls the "codelab" service "GetPlagiarismReport" endpoint.
+func NewGetPlagiarismReportHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeGetPlagiarismReportRequest(mux, decoder)
+		encodeResponse = EncodeGetPlagiarismReportResponse(encoder)
+		encodeError    = EncodeGetPlagiarismReportError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "GetPlagiarismReport")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "codelab")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			errhandler(ctx, w, err)
+		}
+	})
+}
+
+// MountGetExerciseForStudentHandler configures the mux to serve the "codelab"
+// service "GetExerciseForStudent" endpoint.
+func MountGetExerciseForStudentHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/api/codelab/student/exercises/{id}", f)
+}
+
+// NewGetExerciseForStudentHandler creates a HTTP handler which loads the HTTP
+// request and calls the "codelab" service "GetExerciseForStudent" endpoint.
+func NewGetExerciseForStudentHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeGetExerciseForStudentRequest(mux, decoder)
+		encodeResponse = EncodeGetExerciseForStudentResponse(encoder)
+		encodeError    = EncodeGetExerciseForStudentError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "GetExerciseForStudent")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "codelab")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			errhandler(ctx, w, err)
+		}
+	})
+}
+
+// MountListExercisesForStudentsHandler configures the mux to serve the
+// "codelab" service "ListExercisesForStudents" endpoint.
+func MountListExercisesForStudentsHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/api/codelab/student/exercises", f)
+}
+
+// NewListExercisesForStudentsHandler creates a HTTP handler which loads the
+// HTTP request and calls the "codelab" service "ListExercisesForStudents"
+// endpoint.
+func NewListExercisesForStudentsHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeListExercisesForStudentsRequest(mux, decoder)
+		encodeResponse = EncodeListExercisesForStudentsResponse(encoder)
+		encodeError    = EncodeListExercisesForStudentsError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "ListExercisesForStudents")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "codelab")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			errhandler(ctx, w, err)
+		}
+	})
+}
+
+// MountCreateAttemptHandler configures the mux to serve the "codelab" service
+// "CreateAttempt" endpoint.
+func MountCreateAttemptHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("POST", "/api/codelab/attempts", f)
+}
+
+// NewCreateAttemptHandler creates a HTTP handler which loads the HTTP request
+// and calls the "codelab" service "CreateAttempt" endpoint.
+func NewCreateAttemptHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeCreateAttemptRequest(mux, decoder)
+		encodeResponse = EncodeCreateAttemptResponse(encoder)
+		encodeError    = EncodeCreateAttemptError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "CreateAttempt")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "codelab")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			errhandler(ctx, w, err)
+		}
+	})
+}
+
+// MountSubmitAttemptHandler configures the mux to serve the "codelab" service
+// "SubmitAttempt" endpoint.
+func MountSubmitAttemptHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("POST", "/api/codelab/submissions", f)
+}
+
+// NewSubmitAttemptHandler creates a HTTP handler which loads the HTTP request
+// and calls the "codelab" service "SubmitAttempt" endpoint.
+func NewSubmitAttemptHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeSubmitAttemptRequest(mux, decoder)
+		encodeResponse = EncodeSubmitAttemptResponse(encoder)
+		encodeError    = EncodeSubmitAttemptError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "SubmitAttempt")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "codelab")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			errhandler(ctx, w, err)
+		}
+	})
+}
+
+// MountStreamAttemptEventsHandler configures the mux to serve the "codelab"
+// service "StreamAttemptEvents" endpoint.
+func MountStreamAttemptEventsHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/api/codelab/submissions/{id}/events", f)
+}
+
+// NewStreamAttemptEventsHandler creates a HTTP handler which loads the HTTP
+// request and calls the "codelab" service "StreamAttemptEvents" endpoint.
+func NewStreamAttemptEventsHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+	upgrader goahttp.Upgrader,
+	configurer goahttp.ConnConfigureFunc,
+) http.Handler {
+	var (
+		decodeRequest = DecodeStreamAttemptEventsRequest(mux, decoder)
+		encodeError   = EncodeStreamAttemptEventsError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "StreamAttemptEvents")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "codelab")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithCancel(ctx)
+		v := &codelab.StreamAttemptEventsEndpointInput{
+			Stream: &StreamAttemptEventsServerStream{
+				upgrader:   upgrader,
+				configurer: configurer,
+				cancel:     cancel,
+				w:          w,
+				r:          r,
+			},
+			Payload: payload.(*codelab.StreamAttemptEventsPayload),
+		}
+		_, err = endpoint(ctx, v)
+		if err != nil {
+			if v.Stream.(*StreamAttemptEventsServerStream).conn != nil {
+				// Response writer has been hijacked, do not encode the error
+				errhandler(ctx, w, err)
+				return
+			}
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+	})
+}
+
+// MountRunCodeHandler configures the mux to serve the "codelab" service
+// "RunCode" endpoint.
+func MountRunCodeHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("POST", "/api/codelab/student/exercises/{exercise_id}/run", f)
+}
+
+// NewRunCodeHandler creates a HTTP handler which loads the HTTP request and
+// calls the "codelab" service "RunCode" endpoint.
+func NewRunCodeHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeRunCodeRequest(mux, decoder)
+		encodeResponse = EncodeRunCodeResponse(encoder)
+		encodeError    = EncodeRunCodeError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "RunCode")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "codelab")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			errhandler(ctx, w, err)
+		}
+	})
+}
+
+// MountGetAttemptsByUserAndExerciseHandler configures the mux to serve the
+// "codelab" service "GetAttemptsByUserAndExercise" endpoint.
+func MountGetAttemptsByUserAndExerciseHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/api/codelab/student/users/{user_id}/exercises/{exercise_id}/attempts", f)
+}
+
+// NewGetAttemptsByUserAndExerciseHandler creates a HTTP handler which loads
+// the HTTP request and calls the "codelab" service
+// "GetAttemptsByUserAndExercise" endpoint.
+func NewGetAttemptsByUserAndExerciseHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeGetAttemptsByUserAndExerciseRequest(mux, decoder)
+		encodeResponse = EncodeGetAttemptsByUserAndExerciseResponse(encoder)
+		encodeError    = EncodeGetAttemptsByUserAndExerciseError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "GetAttemptsByUserAndExercise")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "codelab")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			errhandler(ctx, w, err)
+		}
+	})
+}
+
+// MountGetHintsForStudentHandler configures the mux to serve the "codelab"
+// service "GetHintsForStudent" endpoint.
+func MountGetHintsForStudentHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/api/codelab/student/exercises/{exercise_id}/hints", f)
+}
+
+// NewGetHintsForStudentHandler creates a HTTP handler which loads the HTTP
+// request and calls the "codelab" service "GetHintsForStudent" endpoint.
+func NewGetHintsForStudentHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeGetHintsForStudentRequest(mux, decoder)
+		encodeResponse = EncodeGetHintsForStudentResponse(encoder)
+		encodeError    = EncodeGetHintsForStudentError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "GetHintsForStudent")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "codelab")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			errhandler(ctx, w, err)
+		}
+	})
+}
+
+// MountRequestHintHandler configures the mux to serve the "codelab" service
+// "RequestHint" endpoint.
+func MountRequestHintHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("POST", "/api/codelab/student/exercises/{exercise_id}/hints", f)
+}
+
+// NewRequestHintHandler creates a HTTP handler which loads the HTTP request
+// and calls the "codelab" service "RequestHint" endpoint.
+func NewRequestHintHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeRequestHintRequest(mux, decoder)
+		encodeResponse = EncodeRequestHintResponse(encoder)
+		encodeError    = EncodeRequestHintError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "RequestHint")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "codelab")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			errhandler(ctx, w, err)
+		}
+	})
+}
+
+// MountListAssignmentsForStudentsHandler configures the mux to serve the
+// "codelab" service "ListAssignmentsForStudents" endpoint.
+func MountListAssignmentsForStudentsHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/api/codelab/student/assignments", f)
+}
+
+// NewListAssignmentsForStudentsHandler creates a HTTP handler which loads the
+// HTTP request and calls the "codelab" service "ListAssignmentsForStudents"
+// endpoint.
+func NewListAssignmentsForStudentsHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeListAssignmentsForStudentsRequest(mux, decoder)
+		encodeResponse = EncodeListAssignmentsForStudentsResponse(encoder)
+		encodeError    = EncodeListAssignmentsForStudentsError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "ListAssignmentsForStudents")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "codelab")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			errhandler(ctx, w, err)
+		}
+	})
+}
+
+// MountGetAssignmentProgressHandler configures the mux to serve the "codelab"
+// service "GetAssignmentProgress" endpoint.
+func MountGetAssignmentProgressHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/api/codelab/student/assignments/{id}/progress", f)
+}
+
+// NewGetAssignmentProgressHandler creates a HTTP handler which loads the HTTP
+// request and calls the "codelab" service "GetAssignmentProgress" endpoint.
+func NewGetAssignmentProgressHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeGetAssignmentProgressRequest(mux, decoder)
+		encodeResponse = EncodeGetAssignmentProgressResponse(encoder)
+		encodeError    = EncodeGetAssignmentProgressError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "GetAssignmentProgress")
 		ctx = context.WithValue(ctx, goa.ServiceKey, "codelab")
 		payload, err := decodeRequest(r)
 		if err != nil {
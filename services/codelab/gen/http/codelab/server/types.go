@@ -31,6 +31,10 @@ type CreateExerciseRequestBody struct {
 	Difficulty *string `form:"difficulty,omitempty" json:"difficulty,omitempty" xml:"difficulty,omitempty"`
 	// ID of user creating the exercise
 	CreatedBy *int64 `form:"created_by,omitempty" json:"created_by,omitempty" xml:"created_by,omitempty"`
+	// Programming language the exercise is solved in
+	Language *string `form:"language,omitempty" json:"language,omitempty" xml:"language,omitempty"`
+	// Topics the exercise covers, stored in lowercase
+	Tags []string `form:"tags,omitempty" json:"tags,omitempty" xml:"tags,omitempty"`
 }
 
 // UpdateExerciseRequestBody is the type of the "codelab" service
@@ -40,6 +44,13 @@ type UpdateExerciseRequestBody struct {
 	Exercise *UpdateExercisePayloadRequestBody `form:"exercise,omitempty" json:"exercise,omitempty" xml:"exercise,omitempty"`
 }
 
+// ImportExercisesRequestBody is the type of the "codelab" service
+// "ImportExercises" endpoint HTTP request body.
+type ImportExercisesRequestBody struct {
+	// Bundle in any of the export formats
+	Content []byte `form:"content,omitempty" json:"content,omitempty" xml:"content,omitempty"`
+}
+
 // CreateTestRequestBody is the type of the "codelab" service "CreateTest"
 // endpoint HTTP request body.
 type CreateTestRequestBody struct {
@@ -51,6 +62,15 @@ type CreateTestRequestBody struct {
 	Public *bool `form:"public,omitempty" json:"public,omitempty" xml:"public,omitempty"`
 	// Associated exercise ID
 	ExerciseID *int64 `form:"exercise_id,omitempty" json:"exercise_id,omitempty" xml:"exercise_id,omitempty"`
+	// How the input is passed to solution: a raw string or a JSON array of
+	// arguments
+	InputFormat *string `form:"input_format,omitempty" json:"input_format,omitempty" xml:"input_format,omitempty"`
+	// How the output is compared to the expected output
+	Comparison *string `form:"comparison,omitempty" json:"comparison,omitempty" xml:"comparison,omitempty"`
+	// Allowed absolute difference for numeric comparison
+	Tolerance *float64 `form:"tolerance,omitempty" json:"tolerance,omitempty" xml:"tolerance,omitempty"`
+	// Points awarded for passing the test
+	Weight *int32 `form:"weight,omitempty" json:"weight,omitempty" xml:"weight,omitempty"`
 }
 
 // UpdateTestRequestBody is the type of the "codelab" service "UpdateTest"
@@ -60,6 +80,52 @@ type UpdateTestRequestBody struct {
 	Test *UpdateTestPayloadRequestBody `form:"test,omitempty" json:"test,omitempty" xml:"test,omitempty"`
 }
 
+// CreateHintRequestBody is the type of the "codelab" service "CreateHint"
+// endpoint HTTP request body.
+type CreateHintRequestBody struct {
+	// Associated exercise ID
+	ExerciseID *int64 `form:"exercise_id,omitempty" json:"exercise_id,omitempty" xml:"exercise_id,omitempty"`
+	// Hint text
+	Content *string `form:"content,omitempty" json:"content,omitempty" xml:"content,omitempty"`
+	// Failed attempts after which the hint unlocks
+	UnlockAfterAttempts *int32 `form:"unlock_after_attempts,omitempty" json:"unlock_after_attempts,omitempty" xml:"unlock_after_attempts,omitempty"`
+	// Seconds since the student started the exercise after which the hint unlocks
+	UnlockAfterSeconds *int32 `form:"unlock_after_seconds,omitempty" json:"unlock_after_seconds,omitempty" xml:"unlock_after_seconds,omitempty"`
+	// Points taken off the score of the attempts made once the hint is revealed
+	Penalty *float64 `form:"penalty,omitempty" json:"penalty,omitempty" xml:"penalty,omitempty"`
+}
+
+// UpdateHintRequestBody is the type of the "codelab" service "UpdateHint"
+// endpoint HTTP request body.
+type UpdateHintRequestBody struct {
+	// Hint data to update
+	Hint *UpdateHintPayloadRequestBody `form:"hint,omitempty" json:"hint,omitempty" xml:"hint,omitempty"`
+}
+
+// CreateAssignmentRequestBody is the type of the "codelab" service
+// "CreateAssignment" endpoint HTTP request body.
+type CreateAssignmentRequestBody struct {
+	// Assignment title
+	Title *string `form:"title,omitempty" json:"title,omitempty" xml:"title,omitempty"`
+	// Assignment description
+	Description *string `form:"description,omitempty" json:"description,omitempty" xml:"description,omitempty"`
+	// Timestamp from which attempts are accepted
+	OpensAt *int64 `form:"opens_at,omitempty" json:"opens_at,omitempty" xml:"opens_at,omitempty"`
+	// Timestamp after which attempts are rejected or flagged as late
+	ClosesAt *int64 `form:"closes_at,omitempty" json:"closes_at,omitempty" xml:"closes_at,omitempty"`
+	// Whether attempts made after closes_at are accepted as late
+	AllowLate *bool `form:"allow_late,omitempty" json:"allow_late,omitempty" xml:"allow_late,omitempty"`
+	// Exercises of the assignment in order
+	ExerciseIds []int64 `form:"exercise_ids,omitempty" json:"exercise_ids,omitempty" xml:"exercise_ids,omitempty"`
+}
+
+// UpdateAssignmentRequestBody is the type of the "codelab" service
+// "UpdateAssignment" endpoint HTTP request body.
+type UpdateAssignmentRequestBody struct {
+	// Assignment data to update
+	Assignment *UpdateAssignmentPayloadRequestBody `form:"assignment,omitempty" json:"assignment,omitempty" xml:"assignment,omitempty"`
+}
+
 // CreateAttemptRequestBody is the type of the "codelab" service
 // "CreateAttempt" endpoint HTTP request body.
 type CreateAttemptRequestBody struct {
@@ -71,6 +137,29 @@ type CreateAttemptRequestBody struct {
 	Success *bool `form:"success,omitempty" json:"success,omitempty" xml:"success,omitempty"`
 }
 
+// SubmitAttemptRequestBody is the type of the "codelab" service
+// "SubmitAttempt" endpoint HTTP request body.
+type SubmitAttemptRequestBody struct {
+	// Associated exercise ID
+	ExerciseID *int64 `form:"exercise_id,omitempty" json:"exercise_id,omitempty" xml:"exercise_id,omitempty"`
+	// Submitted code
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Whether the attempt was successful
+	Success *bool `form:"success,omitempty" json:"success,omitempty" xml:"success,omitempty"`
+}
+
+// RunCodeRequestBody is the type of the "codelab" service "RunCode" endpoint
+// HTTP request body.
+type RunCodeRequestBody struct {
+	// Code to run
+	Code *string `form:"code,omitempty" json:"code,omitempty" xml:"code,omitempty"`
+	// Custom inputs to run instead of the public tests
+	Inputs []string `form:"inputs,omitempty" json:"inputs,omitempty" xml:"inputs,omitempty"`
+	// How custom inputs are passed to solution: a raw string or a JSON array of
+	// arguments
+	InputFormat *string `form:"input_format,omitempty" json:"input_format,omitempty" xml:"input_format,omitempty"`
+}
+
 // CreateExerciseResponseBody is the type of the "codelab" service
 // "CreateExercise" endpoint HTTP response body.
 type CreateExerciseResponseBody struct {
@@ -101,11 +190,21 @@ type GetExerciseResponseBody struct {
 	CreatedAt int64 `form:"created_at" json:"created_at" xml:"created_at"`
 	// Last update timestamp
 	UpdatedAt int64 `form:"updated_at" json:"updated_at" xml:"updated_at"`
+	// Programming language the exercise is solved in
+	Language string `form:"language" json:"language" xml:"language"`
+	// Topics the exercise covers
+	Tags []string `form:"tags" json:"tags" xml:"tags"`
+	// Latest version, bumped by every change to the exercise or its tests
+	Version int32 `form:"version" json:"version" xml:"version"`
 }
 
 // ListExercisesResponseBody is the type of the "codelab" service
 // "ListExercises" endpoint HTTP response body.
-type ListExercisesResponseBody []*ExerciseResponse
+type ListExercisesResponseBody []*Exercise
+
+// ListTagsResponseBody is the type of the "codelab" service "ListTags"
+// endpoint HTTP response body.
+type ListTagsResponseBody []*TagCountResponse
 
 // UpdateExerciseResponseBody is the type of the "codelab" service
 // "UpdateExercise" endpoint HTTP response body.
@@ -125,6 +224,28 @@ type DeleteExerciseResponseBody struct {
 	Message string `form:"message" json:"message" xml:"message"`
 }
 
+// ExportExercisesResponseBody is the type of the "codelab" service
+// "ExportExercises" endpoint HTTP response body.
+type ExportExercisesResponseBody struct {
+	// Suggested file name
+	Filename string `form:"filename" json:"filename" xml:"filename"`
+	// Bundle format
+	Format string `form:"format" json:"format" xml:"format"`
+	// Bundle content
+	Content []byte `form:"content" json:"content" xml:"content"`
+}
+
+// ImportExercisesResponseBody is the type of the "codelab" service
+// "ImportExercises" endpoint HTTP response body.
+type ImportExercisesResponseBody struct {
+	// Operation success status
+	Success bool `form:"success" json:"success" xml:"success"`
+	// Response message
+	Message string `form:"message" json:"message" xml:"message"`
+	// IDs of the created exercises, in bundle order
+	ExerciseIds []int64 `form:"exercise_ids" json:"exercise_ids" xml:"exercise_ids"`
+}
+
 // CreateTestResponseBody is the type of the "codelab" service "CreateTest"
 // endpoint HTTP response body.
 type CreateTestResponseBody struct {
@@ -156,6 +277,252 @@ type DeleteTestResponseBody struct {
 	Message string `form:"message" json:"message" xml:"message"`
 }
 
+// ListExerciseVersionsResponseBody is the type of the "codelab" service
+// "ListExerciseVersions" endpoint HTTP response body.
+type ListExerciseVersionsResponseBody []*ExerciseVersionResponse
+
+// DiffExerciseVersionsResponseBody is the type of the "codelab" service
+// "DiffExerciseVersions" endpoint HTTP response body.
+type DiffExerciseVersionsResponseBody struct {
+	// Exercise ID
+	ExerciseID int64 `form:"exercise_id" json:"exercise_id" xml:"exercise_id"`
+	// Older version
+	FromVersion int32 `form:"from_version" json:"from_version" xml:"from_version"`
+	// Newer version
+	ToVersion int32 `form:"to_version" json:"to_version" xml:"to_version"`
+	// Fields of the exercise that changed
+	Fields []*FieldChangeResponseBody `form:"fields" json:"fields" xml:"fields"`
+	// Tests that changed
+	Tests []*TestChangeResponseBody `form:"tests" json:"tests" xml:"tests"`
+}
+
+// RollbackExerciseResponseBody is the type of the "codelab" service
+// "RollbackExercise" endpoint HTTP response body.
+type RollbackExerciseResponseBody struct {
+	// Operation success status
+	Success bool `form:"success" json:"success" xml:"success"`
+	// Response message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// RegradeExerciseResponseBody is the type of the "codelab" service
+// "RegradeExercise" endpoint HTTP response body.
+type RegradeExerciseResponseBody struct {
+	// Regrade ID
+	ID int64 `form:"id" json:"id" xml:"id"`
+	// Exercise ID
+	ExerciseID int64 `form:"exercise_id" json:"exercise_id" xml:"exercise_id"`
+	// Version of the exercise the attempts are regraded against
+	ExerciseVersion int32 `form:"exercise_version" json:"exercise_version" xml:"exercise_version"`
+	// Whether the regrade is still running
+	Status string `form:"status" json:"status" xml:"status"`
+	// Number of attempts to regrade
+	TotalAttempts int32 `form:"total_attempts" json:"total_attempts" xml:"total_attempts"`
+	// Number of attempts processed so far
+	RegradedAttempts int32 `form:"regraded_attempts" json:"regraded_attempts" xml:"regraded_attempts"`
+	// Number of attempts that could not be run and kept their previous outcome
+	ErroredAttempts int32 `form:"errored_attempts" json:"errored_attempts" xml:"errored_attempts"`
+	// Number of attempts that failed before and pass now
+	NewlyPassedAttempts int32 `form:"newly_passed_attempts" json:"newly_passed_attempts" xml:"newly_passed_attempts"`
+	// Number of attempts that passed before and fail now
+	NewlyFailedAttempts int32 `form:"newly_failed_attempts" json:"newly_failed_attempts" xml:"newly_failed_attempts"`
+	// Students that did not pass the exercise before and pass it now, once finished
+	NewlyPassed []*RegradeStudentChangeResponseBody `form:"newly_passed" json:"newly_passed" xml:"newly_passed"`
+	// Students that passed the exercise before and no longer pass it, once finished
+	NewlyFailed []*RegradeStudentChangeResponseBody `form:"newly_failed" json:"newly_failed" xml:"newly_failed"`
+	// Why the regrade failed
+	Error *string `form:"error,omitempty" json:"error,omitempty" xml:"error,omitempty"`
+	// ID of user who started the regrade
+	CreatedBy int64 `form:"created_by" json:"created_by" xml:"created_by"`
+	// Start timestamp
+	CreatedAt int64 `form:"created_at" json:"created_at" xml:"created_at"`
+	// End timestamp, once no longer running
+	FinishedAt *int64 `form:"finished_at,omitempty" json:"finished_at,omitempty" xml:"finished_at,omitempty"`
+}
+
+// GetRegradeResponseBody is the type of the "codelab" service "GetRegrade"
+// endpoint HTTP response body.
+type GetRegradeResponseBody struct {
+	// Regrade ID
+	ID int64 `form:"id" json:"id" xml:"id"`
+	// Exercise ID
+	ExerciseID int64 `form:"exercise_id" json:"exercise_id" xml:"exercise_id"`
+	// Version of the exercise the attempts are regraded against
+	ExerciseVersion int32 `form:"exercise_version" json:"exercise_version" xml:"exercise_version"`
+	// Whether the regrade is still running
+	Status string `form:"status" json:"status" xml:"status"`
+	// Number of attempts to regrade
+	TotalAttempts int32 `form:"total_attempts" json:"total_attempts" xml:"total_attempts"`
+	// Number of attempts processed so far
+	RegradedAttempts int32 `form:"regraded_attempts" json:"regraded_attempts" xml:"regraded_attempts"`
+	// Number of attempts that could not be run and kept their previous outcome
+	ErroredAttempts int32 `form:"errored_attempts" json:"errored_attempts" xml:"errored_attempts"`
+	// Number of attempts that failed before and pass now
+	NewlyPassedAttempts int32 `form:"newly_passed_attempts" json:"newly_passed_attempts" xml:"newly_passed_attempts"`
+	// Number of attempts that passed before and fail now
+	NewlyFailedAttempts int32 `form:"newly_failed_attempts" json:"newly_failed_attempts" xml:"newly_failed_attempts"`
+	// Students that did not pass the exercise before and pass it now, once finished
+	NewlyPassed []*RegradeStudentChangeResponseBody `form:"newly_passed" json:"newly_passed" xml:"newly_passed"`
+	// Students that passed the exercise before and no longer pass it, once finished
+	NewlyFailed []*RegradeStudentChangeResponseBody `form:"newly_failed" json:"newly_failed" xml:"newly_failed"`
+	// Why the regrade failed
+	Error *string `form:"error,omitempty" json:"error,omitempty" xml:"error,omitempty"`
+	// ID of user who started the regrade
+	CreatedBy int64 `form:"created_by" json:"created_by" xml:"created_by"`
+	// Start timestamp
+	CreatedAt int64 `form:"created_at" json:"created_at" xml:"created_at"`
+	// End timestamp, once no longer running
+	FinishedAt *int64 `form:"finished_at,omitempty" json:"finished_at,omitempty" xml:"finished_at,omitempty"`
+}
+
+// CreateHintResponseBody is the type of the "codelab" service "CreateHint"
+// endpoint HTTP response body.
+type CreateHintResponseBody struct {
+	// Operation success status
+	Success bool `form:"success" json:"success" xml:"success"`
+	// Response message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// GetHintsByExerciseResponseBody is the type of the "codelab" service
+// "GetHintsByExercise" endpoint HTTP response body.
+type GetHintsByExerciseResponseBody []*HintResponse
+
+// UpdateHintResponseBody is the type of the "codelab" service "UpdateHint"
+// endpoint HTTP response body.
+type UpdateHintResponseBody struct {
+	// Operation success status
+	Success bool `form:"success" json:"success" xml:"success"`
+	// Response message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// DeleteHintResponseBody is the type of the "codelab" service "DeleteHint"
+// endpoint HTTP response body.
+type DeleteHintResponseBody struct {
+	// Operation success status
+	Success bool `form:"success" json:"success" xml:"success"`
+	// Response message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// CreateAssignmentResponseBody is the type of the "codelab" service
+// "CreateAssignment" endpoint HTTP response body.
+type CreateAssignmentResponseBody struct {
+	// Operation success status
+	Success bool `form:"success" json:"success" xml:"success"`
+	// Response message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// GetAssignmentResponseBody is the type of the "codelab" service
+// "GetAssignment" endpoint HTTP response body.
+type GetAssignmentResponseBody struct {
+	// Assignment ID
+	ID int64 `form:"id" json:"id" xml:"id"`
+	// Assignment title
+	Title string `form:"title" json:"title" xml:"title"`
+	// Assignment description
+	Description string `form:"description" json:"description" xml:"description"`
+	// Timestamp from which attempts are accepted
+	OpensAt int64 `form:"opens_at" json:"opens_at" xml:"opens_at"`
+	// Timestamp after which attempts are rejected or flagged as late
+	ClosesAt int64 `form:"closes_at" json:"closes_at" xml:"closes_at"`
+	// Whether attempts made after closes_at are accepted as late
+	AllowLate bool `form:"allow_late" json:"allow_late" xml:"allow_late"`
+	// Whether the assignment has not opened yet, is open or has closed
+	Status string `form:"status" json:"status" xml:"status"`
+	// Exercises of the assignment in order
+	Exercises []*AssignmentExerciseResponseBody `form:"exercises" json:"exercises" xml:"exercises"`
+	// Creator user ID
+	CreatedBy int64 `form:"created_by" json:"created_by" xml:"created_by"`
+	// Creation timestamp
+	CreatedAt int64 `form:"created_at" json:"created_at" xml:"created_at"`
+	// Last update timestamp
+	UpdatedAt int64 `form:"updated_at" json:"updated_at" xml:"updated_at"`
+}
+
+// ListAssignmentsResponseBody is the type of the "codelab" service
+// "ListAssignments" endpoint HTTP response body.
+type ListAssignmentsResponseBody []*AssignmentResponse
+
+// UpdateAssignmentResponseBody is the type of the "codelab" service
+// "UpdateAssignment" endpoint HTTP response body.
+type UpdateAssignmentResponseBody struct {
+	// Operation success status
+	Success bool `form:"success" json:"success" xml:"success"`
+	// Response message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// DeleteAssignmentResponseBody is the type of the "codelab" service
+// "DeleteAssignment" endpoint HTTP response body.
+type DeleteAssignmentResponseBody struct {
+	// Operation success status
+	Success bool `form:"success" json:"success" xml:"success"`
+	// Response message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// GetAssignmentGradebookResponseBody is the type of the "codelab" service
+// "GetAssignmentGradebook" endpoint HTTP response body.
+type GetAssignmentGradebookResponseBody struct {
+	// The assignment
+	Assignment *AssignmentResponseBody `form:"assignment" json:"assignment" xml:"assignment"`
+	// Grades of every student, by user ID
+	Students []*StudentAssignmentGradeResponseBody `form:"students" json:"students" xml:"students"`
+}
+
+// GetExerciseStatsResponseBody is the type of the "codelab" service
+// "GetExerciseStats" endpoint HTTP response body.
+type GetExerciseStatsResponseBody struct {
+	// Exercise ID
+	ExerciseID int64 `form:"exercise_id" json:"exercise_id" xml:"exercise_id"`
+	// Students that started the exercise
+	TotalStudents int64 `form:"total_students" json:"total_students" xml:"total_students"`
+	// Students that completed the exercise
+	CompletedStudents int64 `form:"completed_students" json:"completed_students" xml:"completed_students"`
+	// Percentage of the students that completed the exercise
+	CompletionRate float64 `form:"completion_rate" json:"completion_rate" xml:"completion_rate"`
+	// Attempts made by every student
+	TotalAttempts int64 `form:"total_attempts" json:"total_attempts" xml:"total_attempts"`
+	// Attempts that passed every test
+	SuccessfulAttempts int64 `form:"successful_attempts" json:"successful_attempts" xml:"successful_attempts"`
+	// Median attempts students needed to pass, missing until a student passes
+	MedianAttemptsToSuccess *float64 `form:"median_attempts_to_success,omitempty" json:"median_attempts_to_success,omitempty" xml:"median_attempts_to_success,omitempty"`
+	// Median time from the first attempt to the first successful one in
+	// milliseconds
+	MedianTimeToCompletionMs *int64 `form:"median_time_to_completion_ms,omitempty" json:"median_time_to_completion_ms,omitempty" xml:"median_time_to_completion_ms,omitempty"`
+	// Test failed by the most attempts, missing if no test was failed
+	MostFailedTest *FailingTestResponseBody `form:"most_failed_test,omitempty" json:"most_failed_test,omitempty" xml:"most_failed_test,omitempty"`
+	// Progress of every student that started the exercise
+	Students []*StudentExerciseStatusResponseBody `form:"students" json:"students" xml:"students"`
+	// Hints revealed to every student
+	HintsUsed int64 `form:"hints_used" json:"hints_used" xml:"hints_used"`
+}
+
+// GetStudentExerciseStatsResponseBody is the type of the "codelab" service
+// "GetStudentExerciseStats" endpoint HTTP response body.
+type GetStudentExerciseStatsResponseBody struct {
+	// Exercise ID
+	ExerciseID int64 `form:"exercise_id" json:"exercise_id" xml:"exercise_id"`
+	// Progress of the student
+	Summary *StudentExerciseStatusResponseBody `form:"summary" json:"summary" xml:"summary"`
+	// Attempts of the student with their test results, oldest first
+	Attempts []*AttemptResponseBody `form:"attempts" json:"attempts" xml:"attempts"`
+}
+
+// GetPlagiarismReportResponseBody is the type of the "codelab" service
+// "GetPlagiarismReport" endpoint HTTP response body.
+type GetPlagiarismReportResponseBody struct {
+	// Exercise ID
+	ExerciseID int64 `form:"exercise_id" json:"exercise_id" xml:"exercise_id"`
+	// Timestamp of the last check, missing if the exercise was not checked yet
+	CheckedAt *int64 `form:"checked_at,omitempty" json:"checked_at,omitempty" xml:"checked_at,omitempty"`
+	// Suspicious pairs, most similar first
+	Pairs []*PlagiarismPairResponseBody `form:"pairs" json:"pairs" xml:"pairs"`
+}
+
 // GetExerciseForStudentResponseBody is the type of the "codelab" service
 // "GetExerciseForStudent" endpoint HTTP response body.
 type GetExerciseForStudentResponseBody struct {
@@ -181,11 +548,17 @@ type GetExerciseForStudentResponseBody struct {
 	CreatedAt int64 `form:"created_at" json:"created_at" xml:"created_at"`
 	// Last update timestamp
 	UpdatedAt int64 `form:"updated_at" json:"updated_at" xml:"updated_at"`
+	// Programming language the exercise is solved in
+	Language string `form:"language" json:"language" xml:"language"`
+	// Topics the exercise covers
+	Tags []string `form:"tags" json:"tags" xml:"tags"`
+	// Version new attempts are graded against
+	Version int32 `form:"version" json:"version" xml:"version"`
 }
 
 // ListExercisesForStudentsResponseBody is the type of the "codelab" service
 // "ListExercisesForStudents" endpoint HTTP response body.
-type ListExercisesForStudentsResponseBody []*ExerciseForStudentsListViewResponse
+type ListExercisesForStudentsResponseBody []*ExerciseForStudentsListView
 
 // CreateAttemptResponseBody is the type of the "codelab" service
 // "CreateAttempt" endpoint HTTP response body.
@@ -196,10 +569,95 @@ type CreateAttemptResponseBody struct {
 	Message string `form:"message" json:"message" xml:"message"`
 }
 
+// SubmitAttemptResponseBody is the type of the "codelab" service
+// "SubmitAttempt" endpoint HTTP response body.
+type SubmitAttemptResponseBody struct {
+	// ID of the attempt, used to follow its grading
+	AttemptID int64 `form:"attempt_id" json:"attempt_id" xml:"attempt_id"`
+	// Number of tests the attempt runs against
+	TotalTests int64 `form:"total_tests" json:"total_tests" xml:"total_tests"`
+	// Whether the attempt was made after the assignments of the exercise closed
+	Late bool `form:"late" json:"late" xml:"late"`
+}
+
+// StreamAttemptEventsResponseBody is the type of the "codelab" service
+// "StreamAttemptEvents" endpoint HTTP response body.
+type StreamAttemptEventsResponseBody struct {
+	// What happened: queued, started running, finished a test or finished grading
+	Type string `form:"type" json:"type" xml:"type"`
+	// Attempt ID
+	AttemptID int64 `form:"attempt_id" json:"attempt_id" xml:"attempt_id"`
+	// Number of tests the attempt runs against
+	TotalTests int64 `form:"total_tests" json:"total_tests" xml:"total_tests"`
+	// Position of the finished test, starting at 0, for test events
+	TestIndex *int64 `form:"test_index,omitempty" json:"test_index,omitempty" xml:"test_index,omitempty"`
+	// Outcome of the finished test, for test events
+	TestResult *AttemptTestResultResponseBody `form:"test_result,omitempty" json:"test_result,omitempty" xml:"test_result,omitempty"`
+	// Execution outcome of the attempt, for finished events
+	Status *string `form:"status,omitempty" json:"status,omitempty" xml:"status,omitempty"`
+	// Whether the attempt passed every test, for finished events
+	Success *bool `form:"success,omitempty" json:"success,omitempty" xml:"success,omitempty"`
+	// Weight of the tests the attempt passed, for finished events
+	Points *int32 `form:"points,omitempty" json:"points,omitempty" xml:"points,omitempty"`
+	// Weight of all the tests the attempt ran against, for finished events
+	MaxPoints *int32 `form:"max_points,omitempty" json:"max_points,omitempty" xml:"max_points,omitempty"`
+	// Percentage of max_points earned, for finished events
+	Score *float64 `form:"score,omitempty" json:"score,omitempty" xml:"score,omitempty"`
+	// Summary of the outcome, for finished events
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// RunCodeResponseBody is the type of the "codelab" service "RunCode" endpoint
+// HTTP response body.
+type RunCodeResponseBody struct {
+	// Overall outcome of the run
+	Status string `form:"status" json:"status" xml:"status"`
+	// Outcome for each input
+	Results []*RunCaseResultResponseBody `form:"results" json:"results" xml:"results"`
+}
+
 // GetAttemptsByUserAndExerciseResponseBody is the type of the "codelab"
 // service "GetAttemptsByUserAndExercise" endpoint HTTP response body.
 type GetAttemptsByUserAndExerciseResponseBody []*AttemptResponse
 
+// GetHintsForStudentResponseBody is the type of the "codelab" service
+// "GetHintsForStudent" endpoint HTTP response body.
+type GetHintsForStudentResponseBody []*StudentHintResponse
+
+// RequestHintResponseBody is the type of the "codelab" service "RequestHint"
+// endpoint HTTP response body.
+type RequestHintResponseBody struct {
+	// Hint ID
+	ID int64 `form:"id" json:"id" xml:"id"`
+	// Order in which the hint is revealed, starting at 1
+	Position int32 `form:"position" json:"position" xml:"position"`
+	// Whether the hint was revealed, can be requested next or is still locked
+	Status string `form:"status" json:"status" xml:"status"`
+	// Hint text, only sent once revealed
+	Content *string `form:"content,omitempty" json:"content,omitempty" xml:"content,omitempty"`
+	// Points taken off the score of the attempts made once the hint is revealed
+	Penalty float64 `form:"penalty" json:"penalty" xml:"penalty"`
+	// Failed attempts still needed to unlock the hint
+	AttemptsRemaining *int32 `form:"attempts_remaining,omitempty" json:"attempts_remaining,omitempty" xml:"attempts_remaining,omitempty"`
+	// Timestamp at which the hint unlocks by time
+	UnlocksAt *int64 `form:"unlocks_at,omitempty" json:"unlocks_at,omitempty" xml:"unlocks_at,omitempty"`
+	// Timestamp at which the hint was revealed
+	RevealedAt *int64 `form:"revealed_at,omitempty" json:"revealed_at,omitempty" xml:"revealed_at,omitempty"`
+}
+
+// ListAssignmentsForStudentsResponseBody is the type of the "codelab" service
+// "ListAssignmentsForStudents" endpoint HTTP response body.
+type ListAssignmentsForStudentsResponseBody []*AssignmentResponse
+
+// GetAssignmentProgressResponseBody is the type of the "codelab" service
+// "GetAssignmentProgress" endpoint HTTP response body.
+type GetAssignmentProgressResponseBody struct {
+	// The assignment
+	Assignment *AssignmentResponseBody `form:"assignment" json:"assignment" xml:"assignment"`
+	// Progress of the student
+	Progress *StudentAssignmentGradeResponseBody `form:"progress" json:"progress" xml:"progress"`
+}
+
 // GetAnswerByUserAndExerciseResponseBody is the type of the "codelab" service
 // "GetAnswerByUserAndExercise" endpoint HTTP response body.
 type GetAnswerByUserAndExerciseResponseBody struct {
@@ -215,10 +673,114 @@ type GetAnswerByUserAndExerciseResponseBody struct {
 	CreatedAt int64 `form:"created_at" json:"created_at" xml:"created_at"`
 	// Last update timestamp
 	UpdatedAt int64 `form:"updated_at" json:"updated_at" xml:"updated_at"`
+	// Best score reached by an attempt, as a percentage of the test weight
+	BestScore float64 `form:"best_score" json:"best_score" xml:"best_score"`
+	// Attempt that reached the best score, missing until an attempt is made
+	BestAttemptID *int64 `form:"best_attempt_id,omitempty" json:"best_attempt_id,omitempty" xml:"best_attempt_id,omitempty"`
+	// Hints revealed to the student
+	HintsUsed int32 `form:"hints_used" json:"hints_used" xml:"hints_used"`
+	// Points taken off the score of every attempt for the hints revealed
+	HintPenalty float64 `form:"hint_penalty" json:"hint_penalty" xml:"hint_penalty"`
+}
+
+// CreateExerciseSolutionFailedResponseBody is the type of the "codelab"
+// service "CreateExercise" endpoint HTTP response body for the
+// "solution_failed" error.
+type CreateExerciseSolutionFailedResponseBody struct {
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+	// Tests the solution does not pass
+	FailingTests []*SolutionTestFailureResponseBody `form:"failing_tests" json:"failing_tests" xml:"failing_tests"`
+}
+
+// UpdateExerciseSolutionFailedResponseBody is the type of the "codelab"
+// service "UpdateExercise" endpoint HTTP response body for the
+// "solution_failed" error.
+type UpdateExerciseSolutionFailedResponseBody struct {
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+	// Tests the solution does not pass
+	FailingTests []*SolutionTestFailureResponseBody `form:"failing_tests" json:"failing_tests" xml:"failing_tests"`
+}
+
+// ImportExercisesSolutionFailedResponseBody is the type of the "codelab"
+// service "ImportExercises" endpoint HTTP response body for the
+// "solution_failed" error.
+type ImportExercisesSolutionFailedResponseBody struct {
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+	// Tests the solution does not pass
+	FailingTests []*SolutionTestFailureResponseBody `form:"failing_tests" json:"failing_tests" xml:"failing_tests"`
+}
+
+// CreateTestSolutionFailedResponseBody is the type of the "codelab" service
+// "CreateTest" endpoint HTTP response body for the "solution_failed" error.
+type CreateTestSolutionFailedResponseBody struct {
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+	// Tests the solution does not pass
+	FailingTests []*SolutionTestFailureResponseBody `form:"failing_tests" json:"failing_tests" xml:"failing_tests"`
+}
+
+// UpdateTestSolutionFailedResponseBody is the type of the "codelab" service
+// "UpdateTest" endpoint HTTP response body for the "solution_failed" error.
+type UpdateTestSolutionFailedResponseBody struct {
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+	// Tests the solution does not pass
+	FailingTests []*SolutionTestFailureResponseBody `form:"failing_tests" json:"failing_tests" xml:"failing_tests"`
+}
+
+// RollbackExerciseSolutionFailedResponseBody is the type of the "codelab"
+// service "RollbackExercise" endpoint HTTP response body for the
+// "solution_failed" error.
+type RollbackExerciseSolutionFailedResponseBody struct {
+	// Error message
+	Message string `form:"message" json:"message" xml:"message"`
+	// Tests the solution does not pass
+	FailingTests []*SolutionTestFailureResponseBody `form:"failing_tests" json:"failing_tests" xml:"failing_tests"`
+}
+
+// CreateAttemptRateLimitedResponseBody is the type of the "codelab" service
+// "CreateAttempt" endpoint HTTP response body for the "rate_limited" error.
+type CreateAttemptRateLimitedResponseBody struct {
+	// Why the request was limited
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// SubmitAttemptRateLimitedResponseBody is the type of the "codelab" service
+// "SubmitAttempt" endpoint HTTP response body for the "rate_limited" error.
+type SubmitAttemptRateLimitedResponseBody struct {
+	// Why the request was limited
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// RunCodeRateLimitedResponseBody is the type of the "codelab" service
+// "RunCode" endpoint HTTP response body for the "rate_limited" error.
+type RunCodeRateLimitedResponseBody struct {
+	// Why the request was limited
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// SolutionTestFailureResponseBody is used to define fields on response body
+// types.
+type SolutionTestFailureResponseBody struct {
+	// Test ID, missing for a test that is not saved yet
+	TestID *int64 `form:"test_id,omitempty" json:"test_id,omitempty" xml:"test_id,omitempty"`
+	// Test input
+	Input string `form:"input" json:"input" xml:"input"`
+	// Expected output
+	ExpectedOutput string `form:"expected_output" json:"expected_output" xml:"expected_output"`
+	// Output of the solution
+	ActualOutput string `form:"actual_output" json:"actual_output" xml:"actual_output"`
+	// How the test finished
+	Status string `form:"status" json:"status" xml:"status"`
+	// Error raised by the solution
+	ErrorMessage *string `form:"error_message,omitempty" json:"error_message,omitempty" xml:"error_message,omitempty"`
 }
 
-// ExerciseResponse is used to define fields on response body types.
-type ExerciseResponse struct {
+// Exercise is used to define fields on response body types.
+type Exercise struct {
 	// Exercise ID
 	ID int64 `form:"id" json:"id" xml:"id"`
 	// Exercise title
@@ -237,6 +799,20 @@ type ExerciseResponse struct {
 	CreatedAt int64 `form:"created_at" json:"created_at" xml:"created_at"`
 	// Last update timestamp
 	UpdatedAt int64 `form:"updated_at" json:"updated_at" xml:"updated_at"`
+	// Programming language the exercise is solved in
+	Language string `form:"language" json:"language" xml:"language"`
+	// Topics the exercise covers
+	Tags []string `form:"tags" json:"tags" xml:"tags"`
+	// Latest version, bumped by every change to the exercise or its tests
+	Version int32 `form:"version" json:"version" xml:"version"`
+}
+
+// TagCountResponse is used to define fields on response body types.
+type TagCountResponse struct {
+	// Topic name
+	Tag string `form:"tag" json:"tag" xml:"tag"`
+	// Number of exercises tagged with the topic
+	Exercises int64 `form:"exercises" json:"exercises" xml:"exercises"`
 }
 
 // TestResponse is used to define fields on response body types.
@@ -255,70 +831,116 @@ type TestResponse struct {
 	CreatedAt int64 `form:"created_at" json:"created_at" xml:"created_at"`
 	// Last update timestamp
 	UpdatedAt int64 `form:"updated_at" json:"updated_at" xml:"updated_at"`
+	// How the input is passed to solution: a raw string or a JSON array of
+	// arguments
+	InputFormat string `form:"input_format" json:"input_format" xml:"input_format"`
+	// How the output is compared to the expected output
+	Comparison string `form:"comparison" json:"comparison" xml:"comparison"`
+	// Allowed absolute difference for numeric comparison
+	Tolerance float64 `form:"tolerance" json:"tolerance" xml:"tolerance"`
+	// Points awarded for passing the test
+	Weight int32 `form:"weight" json:"weight" xml:"weight"`
 }
 
-// TestResponseBody is used to define fields on response body types.
-type TestResponseBody struct {
-	// Test ID
-	ID int64 `form:"id" json:"id" xml:"id"`
-	// Test input
-	Input string `form:"input" json:"input" xml:"input"`
-	// Expected output
-	Output string `form:"output" json:"output" xml:"output"`
-	// Whether test is visible to students
-	Public bool `form:"public" json:"public" xml:"public"`
-	// Associated exercise ID
-	ExerciseID int64 `form:"exercise_id" json:"exercise_id" xml:"exercise_id"`
+// ExerciseVersionResponse is used to define fields on response body types.
+type ExerciseVersionResponse struct {
+	// Version number
+	Version int32 `form:"version" json:"version" xml:"version"`
+	// ID of user whose change created the version
+	CreatedBy int64 `form:"created_by" json:"created_by" xml:"created_by"`
 	// Creation timestamp
 	CreatedAt int64 `form:"created_at" json:"created_at" xml:"created_at"`
-	// Last update timestamp
-	UpdatedAt int64 `form:"updated_at" json:"updated_at" xml:"updated_at"`
+	// Number of attempts graded against the version
+	Attempts int64 `form:"attempts" json:"attempts" xml:"attempts"`
 }
 
-// AttemptResponseBody is used to define fields on response body types.
-type AttemptResponseBody struct {
-	// Attempt ID
-	ID int64 `form:"id" json:"id" xml:"id"`
-	// Associated answer ID
-	AnswerID int64 `form:"answer_id" json:"answer_id" xml:"answer_id"`
-	// Submitted code
-	Code string `form:"code" json:"code" xml:"code"`
-	// Whether the attempt was successful
-	Success bool `form:"success" json:"success" xml:"success"`
-	// Creation timestamp
-	CreatedAt int64 `form:"created_at" json:"created_at" xml:"created_at"`
+// FieldChangeResponseBody is used to define fields on response body types.
+type FieldChangeResponseBody struct {
+	// Field name
+	Field string `form:"field" json:"field" xml:"field"`
+	// Value in the older version, empty for added tests
+	From string `form:"from" json:"from" xml:"from"`
+	// Value in the newer version, empty for removed tests
+	To string `form:"to" json:"to" xml:"to"`
 }
 
-// AnswerResponseBody is used to define fields on response body types.
-type AnswerResponseBody struct {
-	// Answer ID
+// TestChangeResponseBody is used to define fields on response body types.
+type TestChangeResponseBody struct {
+	// Test ID
+	TestID int64 `form:"test_id" json:"test_id" xml:"test_id"`
+	// How the test changed
+	Change string `form:"change" json:"change" xml:"change"`
+	// Fields that changed, every field for added and removed tests
+	Fields []*FieldChangeResponseBody `form:"fields" json:"fields" xml:"fields"`
+}
+
+// RegradeStudentChangeResponseBody is used to define fields on response body
+// types.
+type RegradeStudentChangeResponseBody struct {
+	// Student user ID
+	UserID int64 `form:"user_id" json:"user_id" xml:"user_id"`
+	// Best score before the regrade
+	PreviousScore float64 `form:"previous_score" json:"previous_score" xml:"previous_score"`
+	// Best score after the regrade
+	Score float64 `form:"score" json:"score" xml:"score"`
+}
+
+// HintResponse is used to define fields on response body types.
+type HintResponse struct {
+	// Hint ID
 	ID int64 `form:"id" json:"id" xml:"id"`
 	// Associated exercise ID
 	ExerciseID int64 `form:"exercise_id" json:"exercise_id" xml:"exercise_id"`
-	// Student user ID
-	UserID int64 `form:"user_id" json:"user_id" xml:"user_id"`
-	// Whether the exercise is completed
-	Completed bool `form:"completed" json:"completed" xml:"completed"`
+	// Order in which the hint is revealed, starting at 1
+	Position int32 `form:"position" json:"position" xml:"position"`
+	// Hint text
+	Content string `form:"content" json:"content" xml:"content"`
+	// Failed attempts after which the hint unlocks
+	UnlockAfterAttempts *int32 `form:"unlock_after_attempts,omitempty" json:"unlock_after_attempts,omitempty" xml:"unlock_after_attempts,omitempty"`
+	// Seconds since the student started the exercise after which the hint unlocks
+	UnlockAfterSeconds *int32 `form:"unlock_after_seconds,omitempty" json:"unlock_after_seconds,omitempty" xml:"unlock_after_seconds,omitempty"`
+	// Points taken off the score of the attempts made once the hint is revealed
+	Penalty float64 `form:"penalty" json:"penalty" xml:"penalty"`
 	// Creation timestamp
 	CreatedAt int64 `form:"created_at" json:"created_at" xml:"created_at"`
 	// Last update timestamp
 	UpdatedAt int64 `form:"updated_at" json:"updated_at" xml:"updated_at"`
 }
 
-// ExerciseForStudentsListViewResponse is used to define fields on response
-// body types.
-type ExerciseForStudentsListViewResponse struct {
+// AssignmentExerciseResponseBody is used to define fields on response body
+// types.
+type AssignmentExerciseResponseBody struct {
 	// Exercise ID
-	ID int64 `form:"id" json:"id" xml:"id"`
+	ExerciseID int64 `form:"exercise_id" json:"exercise_id" xml:"exercise_id"`
+	// Order of the exercise in the assignment, starting at 1
+	Position int32 `form:"position" json:"position" xml:"position"`
 	// Exercise title
 	Title string `form:"title" json:"title" xml:"title"`
-	// Exercise description
-	Description string `form:"description" json:"description" xml:"description"`
-	// Exercise difficulty level
+	// Exercise difficulty
 	Difficulty string `form:"difficulty" json:"difficulty" xml:"difficulty"`
-	// Whether the exercise is completed by the student
-	Completed *bool `form:"completed,omitempty" json:"completed,omitempty" xml:"completed,omitempty"`
-	// ID of user who created the exercise
+	// Language the exercise is solved in
+	Language string `form:"language" json:"language" xml:"language"`
+}
+
+// AssignmentResponse is used to define fields on response body types.
+type AssignmentResponse struct {
+	// Assignment ID
+	ID int64 `form:"id" json:"id" xml:"id"`
+	// Assignment title
+	Title string `form:"title" json:"title" xml:"title"`
+	// Assignment description
+	Description string `form:"description" json:"description" xml:"description"`
+	// Timestamp from which attempts are accepted
+	OpensAt int64 `form:"opens_at" json:"opens_at" xml:"opens_at"`
+	// Timestamp after which attempts are rejected or flagged as late
+	ClosesAt int64 `form:"closes_at" json:"closes_at" xml:"closes_at"`
+	// Whether attempts made after closes_at are accepted as late
+	AllowLate bool `form:"allow_late" json:"allow_late" xml:"allow_late"`
+	// Whether the assignment has not opened yet, is open or has closed
+	Status string `form:"status" json:"status" xml:"status"`
+	// Exercises of the assignment in order
+	Exercises []*AssignmentExerciseResponse `form:"exercises" json:"exercises" xml:"exercises"`
+	// Creator user ID
 	CreatedBy int64 `form:"created_by" json:"created_by" xml:"created_by"`
 	// Creation timestamp
 	CreatedAt int64 `form:"created_at" json:"created_at" xml:"created_at"`
@@ -326,21 +948,368 @@ type ExerciseForStudentsListViewResponse struct {
 	UpdatedAt int64 `form:"updated_at" json:"updated_at" xml:"updated_at"`
 }
 
-// AttemptResponse is used to define fields on response body types.
-type AttemptResponse struct {
-	// Attempt ID
+// AssignmentExerciseResponse is used to define fields on response body types.
+type AssignmentExerciseResponse struct {
+	// Exercise ID
+	ExerciseID int64 `form:"exercise_id" json:"exercise_id" xml:"exercise_id"`
+	// Order of the exercise in the assignment, starting at 1
+	Position int32 `form:"position" json:"position" xml:"position"`
+	// Exercise title
+	Title string `form:"title" json:"title" xml:"title"`
+	// Exercise difficulty
+	Difficulty string `form:"difficulty" json:"difficulty" xml:"difficulty"`
+	// Language the exercise is solved in
+	Language string `form:"language" json:"language" xml:"language"`
+}
+
+// AssignmentResponseBody is used to define fields on response body types.
+type AssignmentResponseBody struct {
+	// Assignment ID
 	ID int64 `form:"id" json:"id" xml:"id"`
-	// Associated answer ID
-	AnswerID int64 `form:"answer_id" json:"answer_id" xml:"answer_id"`
-	// Submitted code
-	Code string `form:"code" json:"code" xml:"code"`
-	// Whether the attempt was successful
-	Success bool `form:"success" json:"success" xml:"success"`
+	// Assignment title
+	Title string `form:"title" json:"title" xml:"title"`
+	// Assignment description
+	Description string `form:"description" json:"description" xml:"description"`
+	// Timestamp from which attempts are accepted
+	OpensAt int64 `form:"opens_at" json:"opens_at" xml:"opens_at"`
+	// Timestamp after which attempts are rejected or flagged as late
+	ClosesAt int64 `form:"closes_at" json:"closes_at" xml:"closes_at"`
+	// Whether attempts made after closes_at are accepted as late
+	AllowLate bool `form:"allow_late" json:"allow_late" xml:"allow_late"`
+	// Whether the assignment has not opened yet, is open or has closed
+	Status string `form:"status" json:"status" xml:"status"`
+	// Exercises of the assignment in order
+	Exercises []*AssignmentExerciseResponseBody `form:"exercises" json:"exercises" xml:"exercises"`
+	// Creator user ID
+	CreatedBy int64 `form:"created_by" json:"created_by" xml:"created_by"`
 	// Creation timestamp
 	CreatedAt int64 `form:"created_at" json:"created_at" xml:"created_at"`
+	// Last update timestamp
+	UpdatedAt int64 `form:"updated_at" json:"updated_at" xml:"updated_at"`
 }
 
-// UpdateExercisePayloadRequestBody is used to define fields on request body
+// StudentAssignmentGradeResponseBody is used to define fields on response body
+// types.
+type StudentAssignmentGradeResponseBody struct {
+	// Student user ID
+	UserID int64 `form:"user_id" json:"user_id" xml:"user_id"`
+	// Average best score over every exercise before the assignment closed
+	Score float64 `form:"score" json:"score" xml:"score"`
+	// Average best score over every exercise counting late attempts
+	ScoreWithLate float64 `form:"score_with_late" json:"score_with_late" xml:"score_with_late"`
+	// Exercises completed before the assignment closed
+	CompletedExercises int64 `form:"completed_exercises" json:"completed_exercises" xml:"completed_exercises"`
+	// Exercises of the assignment
+	TotalExercises int64 `form:"total_exercises" json:"total_exercises" xml:"total_exercises"`
+	// Progress on every exercise in assignment order
+	Exercises []*ExerciseGradeResponseBody `form:"exercises" json:"exercises" xml:"exercises"`
+}
+
+// ExerciseGradeResponseBody is used to define fields on response body types.
+type ExerciseGradeResponseBody struct {
+	// Exercise ID
+	ExerciseID int64 `form:"exercise_id" json:"exercise_id" xml:"exercise_id"`
+	// Whether the student has not attempted, is working on, completed the exercise
+	// before the deadline or only after it
+	Status string `form:"status" json:"status" xml:"status"`
+	// Number of attempts made
+	Attempts int64 `form:"attempts" json:"attempts" xml:"attempts"`
+	// Attempts made after the assignment closed
+	LateAttempts int64 `form:"late_attempts" json:"late_attempts" xml:"late_attempts"`
+	// Best score reached before the assignment closed
+	BestScore float64 `form:"best_score" json:"best_score" xml:"best_score"`
+	// Best score reached counting late attempts
+	BestScoreWithLate float64 `form:"best_score_with_late" json:"best_score_with_late" xml:"best_score_with_late"`
+}
+
+// FailingTestResponseBody is used to define fields on response body types.
+type FailingTestResponseBody struct {
+	// Test ID
+	TestID int64 `form:"test_id" json:"test_id" xml:"test_id"`
+	// Test input
+	Input string `form:"input" json:"input" xml:"input"`
+	// Whether test is visible to students
+	Public bool `form:"public" json:"public" xml:"public"`
+	// Number of attempts that did not pass the test
+	Failures int64 `form:"failures" json:"failures" xml:"failures"`
+}
+
+// StudentExerciseStatusResponseBody is used to define fields on response body
+// types.
+type StudentExerciseStatusResponseBody struct {
+	// Student user ID
+	UserID int64 `form:"user_id" json:"user_id" xml:"user_id"`
+	// Whether the student has not attempted, is working on or has completed the
+	// exercise
+	Status string `form:"status" json:"status" xml:"status"`
+	// Number of attempts made
+	Attempts int64 `form:"attempts" json:"attempts" xml:"attempts"`
+	// Best score reached by an attempt
+	BestScore float64 `form:"best_score" json:"best_score" xml:"best_score"`
+	// Attempts made up to and including the first successful one
+	AttemptsToSuccess *int64 `form:"attempts_to_success,omitempty" json:"attempts_to_success,omitempty" xml:"attempts_to_success,omitempty"`
+	// Timestamp of the first attempt
+	FirstAttemptAt *int64 `form:"first_attempt_at,omitempty" json:"first_attempt_at,omitempty" xml:"first_attempt_at,omitempty"`
+	// Timestamp of the latest attempt
+	LastAttemptAt *int64 `form:"last_attempt_at,omitempty" json:"last_attempt_at,omitempty" xml:"last_attempt_at,omitempty"`
+	// Timestamp of the first successful attempt
+	CompletedAt *int64 `form:"completed_at,omitempty" json:"completed_at,omitempty" xml:"completed_at,omitempty"`
+	// Time from the first attempt to the first successful one in milliseconds
+	TimeToCompletionMs *int64 `form:"time_to_completion_ms,omitempty" json:"time_to_completion_ms,omitempty" xml:"time_to_completion_ms,omitempty"`
+	// Hints revealed to the student
+	HintsUsed int32 `form:"hints_used" json:"hints_used" xml:"hints_used"`
+}
+
+// AttemptResponseBody is used to define fields on response body types.
+type AttemptResponseBody struct {
+	// Attempt ID
+	ID int64 `form:"id" json:"id" xml:"id"`
+	// Associated answer ID
+	AnswerID int64 `form:"answer_id" json:"answer_id" xml:"answer_id"`
+	// Submitted code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Whether the attempt was successful
+	Success bool `form:"success" json:"success" xml:"success"`
+	// Creation timestamp
+	CreatedAt int64 `form:"created_at" json:"created_at" xml:"created_at"`
+	// Execution outcome of the attempt, pending while it is graded in the
+	// background
+	Status string `form:"status" json:"status" xml:"status"`
+	// Result of running the attempt against each test of the exercise
+	TestResults []*AttemptTestResultResponseBody `form:"test_results,omitempty" json:"test_results,omitempty" xml:"test_results,omitempty"`
+	// Weight of the tests the attempt passed
+	Points int32 `form:"points" json:"points" xml:"points"`
+	// Weight of all the tests the attempt ran against
+	MaxPoints int32 `form:"max_points" json:"max_points" xml:"max_points"`
+	// Percentage of max_points earned
+	Score float64 `form:"score" json:"score" xml:"score"`
+	// Whether the attempt was made after the assignments of the exercise closed
+	Late bool `form:"late" json:"late" xml:"late"`
+	// Version of the exercise the attempt was graded against
+	ExerciseVersion int32 `form:"exercise_version" json:"exercise_version" xml:"exercise_version"`
+}
+
+// AttemptTestResultResponseBody is used to define fields on response body
+// types.
+type AttemptTestResultResponseBody struct {
+	// Test ID, missing if the test was deleted
+	TestID *int64 `form:"test_id,omitempty" json:"test_id,omitempty" xml:"test_id,omitempty"`
+	// Outcome of the test
+	Status string `form:"status" json:"status" xml:"status"`
+	// Output produced by the submitted code
+	ActualOutput string `form:"actual_output" json:"actual_output" xml:"actual_output"`
+	// Expected output, only shown for public tests
+	ExpectedOutput *string `form:"expected_output,omitempty" json:"expected_output,omitempty" xml:"expected_output,omitempty"`
+	// Error raised while running the test
+	Error *string `form:"error,omitempty" json:"error,omitempty" xml:"error,omitempty"`
+	// Output written to the console while running the test, with warnings and
+	// errors prefixed by their level
+	ConsoleOutput string `form:"console_output" json:"console_output" xml:"console_output"`
+	// Time spent running the test in milliseconds
+	DurationMs int64 `form:"duration_ms" json:"duration_ms" xml:"duration_ms"`
+	// Stack trace through the submitted code when it threw, with positions in the
+	// submitted code
+	StackTrace *string `form:"stack_trace,omitempty" json:"stack_trace,omitempty" xml:"stack_trace,omitempty"`
+}
+
+// PlagiarismPairResponseBody is used to define fields on response body types.
+type PlagiarismPairResponseBody struct {
+	// Attempt of the first student
+	AttemptAID int64 `form:"attempt_a_id" json:"attempt_a_id" xml:"attempt_a_id"`
+	// First student user ID
+	UserAID int64 `form:"user_a_id" json:"user_a_id" xml:"user_a_id"`
+	// Attempt of the second student
+	AttemptBID int64 `form:"attempt_b_id" json:"attempt_b_id" xml:"attempt_b_id"`
+	// Second student user ID
+	UserBID int64 `form:"user_b_id" json:"user_b_id" xml:"user_b_id"`
+	// Percentage of the code fingerprints both attempts share
+	Similarity float64 `form:"similarity" json:"similarity" xml:"similarity"`
+	// Matching regions of both attempts
+	Regions []*PlagiarismRegionResponseBody `form:"regions" json:"regions" xml:"regions"`
+}
+
+// PlagiarismRegionResponseBody is used to define fields on response body types.
+type PlagiarismRegionResponseBody struct {
+	// First line in the first attempt
+	StartLineA int `form:"start_line_a" json:"start_line_a" xml:"start_line_a"`
+	// Last line in the first attempt
+	EndLineA int `form:"end_line_a" json:"end_line_a" xml:"end_line_a"`
+	// First line in the second attempt
+	StartLineB int `form:"start_line_b" json:"start_line_b" xml:"start_line_b"`
+	// Last line in the second attempt
+	EndLineB int `form:"end_line_b" json:"end_line_b" xml:"end_line_b"`
+}
+
+// TestResponseBody is used to define fields on response body types.
+type TestResponseBody struct {
+	// Test ID
+	ID int64 `form:"id" json:"id" xml:"id"`
+	// Test input
+	Input string `form:"input" json:"input" xml:"input"`
+	// Expected output
+	Output string `form:"output" json:"output" xml:"output"`
+	// Whether test is visible to students
+	Public bool `form:"public" json:"public" xml:"public"`
+	// Associated exercise ID
+	ExerciseID int64 `form:"exercise_id" json:"exercise_id" xml:"exercise_id"`
+	// Creation timestamp
+	CreatedAt int64 `form:"created_at" json:"created_at" xml:"created_at"`
+	// Last update timestamp
+	UpdatedAt int64 `form:"updated_at" json:"updated_at" xml:"updated_at"`
+	// How the input is passed to solution: a raw string or a JSON array of
+	// arguments
+	InputFormat string `form:"input_format" json:"input_format" xml:"input_format"`
+	// How the output is compared to the expected output
+	Comparison string `form:"comparison" json:"comparison" xml:"comparison"`
+	// Allowed absolute difference for numeric comparison
+	Tolerance float64 `form:"tolerance" json:"tolerance" xml:"tolerance"`
+	// Points awarded for passing the test
+	Weight int32 `form:"weight" json:"weight" xml:"weight"`
+}
+
+// AnswerResponseBody is used to define fields on response body types.
+type AnswerResponseBody struct {
+	// Answer ID
+	ID int64 `form:"id" json:"id" xml:"id"`
+	// Associated exercise ID
+	ExerciseID int64 `form:"exercise_id" json:"exercise_id" xml:"exercise_id"`
+	// Student user ID
+	UserID int64 `form:"user_id" json:"user_id" xml:"user_id"`
+	// Whether the exercise is completed
+	Completed bool `form:"completed" json:"completed" xml:"completed"`
+	// Creation timestamp
+	CreatedAt int64 `form:"created_at" json:"created_at" xml:"created_at"`
+	// Last update timestamp
+	UpdatedAt int64 `form:"updated_at" json:"updated_at" xml:"updated_at"`
+	// Best score reached by an attempt, as a percentage of the test weight
+	BestScore float64 `form:"best_score" json:"best_score" xml:"best_score"`
+	// Attempt that reached the best score, missing until an attempt is made
+	BestAttemptID *int64 `form:"best_attempt_id,omitempty" json:"best_attempt_id,omitempty" xml:"best_attempt_id,omitempty"`
+	// Hints revealed to the student
+	HintsUsed int32 `form:"hints_used" json:"hints_used" xml:"hints_used"`
+	// Points taken off the score of every attempt for the hints revealed
+	HintPenalty float64 `form:"hint_penalty" json:"hint_penalty" xml:"hint_penalty"`
+}
+
+// ExerciseForStudentsListView is used to define fields on response body types.
+type ExerciseForStudentsListView struct {
+	// Exercise ID
+	ID int64 `form:"id" json:"id" xml:"id"`
+	// Exercise title
+	Title string `form:"title" json:"title" xml:"title"`
+	// Exercise description
+	Description string `form:"description" json:"description" xml:"description"`
+	// Exercise difficulty level
+	Difficulty string `form:"difficulty" json:"difficulty" xml:"difficulty"`
+	// Whether the exercise is completed by the student
+	Completed bool `form:"completed" json:"completed" xml:"completed"`
+	// ID of user who created the exercise
+	CreatedBy int64 `form:"created_by" json:"created_by" xml:"created_by"`
+	// Creation timestamp
+	CreatedAt int64 `form:"created_at" json:"created_at" xml:"created_at"`
+	// Last update timestamp
+	UpdatedAt int64 `form:"updated_at" json:"updated_at" xml:"updated_at"`
+	// Programming language the exercise is solved in
+	Language string `form:"language" json:"language" xml:"language"`
+	// Topics the exercise covers
+	Tags []string `form:"tags" json:"tags" xml:"tags"`
+}
+
+// RunCaseResultResponseBody is used to define fields on response body types.
+type RunCaseResultResponseBody struct {
+	// Public test ID, missing for custom inputs
+	TestID *int64 `form:"test_id,omitempty" json:"test_id,omitempty" xml:"test_id,omitempty"`
+	// Input given to the code
+	Input string `form:"input" json:"input" xml:"input"`
+	// Outcome of the run, completed for custom inputs that ran without errors
+	Status string `form:"status" json:"status" xml:"status"`
+	// Output produced by the code
+	Output string `form:"output" json:"output" xml:"output"`
+	// Expected output, missing for custom inputs
+	ExpectedOutput *string `form:"expected_output,omitempty" json:"expected_output,omitempty" xml:"expected_output,omitempty"`
+	// Error raised while running the code
+	Error *string `form:"error,omitempty" json:"error,omitempty" xml:"error,omitempty"`
+	// Output written to the console while running the code, with warnings and
+	// errors prefixed by their level
+	ConsoleOutput string `form:"console_output" json:"console_output" xml:"console_output"`
+	// Time spent running the code in milliseconds
+	DurationMs int64 `form:"duration_ms" json:"duration_ms" xml:"duration_ms"`
+	// Stack trace through the code when it threw, with positions in the code
+	StackTrace *string `form:"stack_trace,omitempty" json:"stack_trace,omitempty" xml:"stack_trace,omitempty"`
+}
+
+// AttemptResponse is used to define fields on response body types.
+type AttemptResponse struct {
+	// Attempt ID
+	ID int64 `form:"id" json:"id" xml:"id"`
+	// Associated answer ID
+	AnswerID int64 `form:"answer_id" json:"answer_id" xml:"answer_id"`
+	// Submitted code
+	Code string `form:"code" json:"code" xml:"code"`
+	// Whether the attempt was successful
+	Success bool `form:"success" json:"success" xml:"success"`
+	// Creation timestamp
+	CreatedAt int64 `form:"created_at" json:"created_at" xml:"created_at"`
+	// Execution outcome of the attempt, pending while it is graded in the
+	// background
+	Status string `form:"status" json:"status" xml:"status"`
+	// Result of running the attempt against each test of the exercise
+	TestResults []*AttemptTestResultResponse `form:"test_results,omitempty" json:"test_results,omitempty" xml:"test_results,omitempty"`
+	// Weight of the tests the attempt passed
+	Points int32 `form:"points" json:"points" xml:"points"`
+	// Weight of all the tests the attempt ran against
+	MaxPoints int32 `form:"max_points" json:"max_points" xml:"max_points"`
+	// Percentage of max_points earned
+	Score float64 `form:"score" json:"score" xml:"score"`
+	// Whether the attempt was made after the assignments of the exercise closed
+	Late bool `form:"late" json:"late" xml:"late"`
+	// Version of the exercise the attempt was graded against
+	ExerciseVersion int32 `form:"exercise_version" json:"exercise_version" xml:"exercise_version"`
+}
+
+// AttemptTestResultResponse is used to define fields on response body types.
+type AttemptTestResultResponse struct {
+	// Test ID, missing if the test was deleted
+	TestID *int64 `form:"test_id,omitempty" json:"test_id,omitempty" xml:"test_id,omitempty"`
+	// Outcome of the test
+	Status string `form:"status" json:"status" xml:"status"`
+	// Output produced by the submitted code
+	ActualOutput string `form:"actual_output" json:"actual_output" xml:"actual_output"`
+	// Expected output, only shown for public tests
+	ExpectedOutput *string `form:"expected_output,omitempty" json:"expected_output,omitempty" xml:"expected_output,omitempty"`
+	// Error raised while running the test
+	Error *string `form:"error,omitempty" json:"error,omitempty" xml:"error,omitempty"`
+	// Output written to the console while running the test, with warnings and
+	// errors prefixed by their level
+	ConsoleOutput string `form:"console_output" json:"console_output" xml:"console_output"`
+	// Time spent running the test in milliseconds
+	DurationMs int64 `form:"duration_ms" json:"duration_ms" xml:"duration_ms"`
+	// Stack trace through the submitted code when it threw, with positions in the
+	// submitted code
+	StackTrace *string `form:"stack_trace,omitempty" json:"stack_trace,omitempty" xml:"stack_trace,omitempty"`
+}
+
+// StudentHintResponse is used to define fields on response body types.
+type StudentHintResponse struct {
+	// Hint ID
+	ID int64 `form:"id" json:"id" xml:"id"`
+	// Order in which the hint is revealed, starting at 1
+	Position int32 `form:"position" json:"position" xml:"position"`
+	// Whether the hint was revealed, can be requested next or is still locked
+	Status string `form:"status" json:"status" xml:"status"`
+	// Hint text, only sent once revealed
+	Content *string `form:"content,omitempty" json:"content,omitempty" xml:"content,omitempty"`
+	// Points taken off the score of the attempts made once the hint is revealed
+	Penalty float64 `form:"penalty" json:"penalty" xml:"penalty"`
+	// Failed attempts still needed to unlock the hint
+	AttemptsRemaining *int32 `form:"attempts_remaining,omitempty" json:"attempts_remaining,omitempty" xml:"attempts_remaining,omitempty"`
+	// Timestamp at which the hint unlocks by time
+	UnlocksAt *int64 `form:"unlocks_at,omitempty" json:"unlocks_at,omitempty" xml:"unlocks_at,omitempty"`
+	// Timestamp at which the hint was revealed
+	RevealedAt *int64 `form:"revealed_at,omitempty" json:"revealed_at,omitempty" xml:"revealed_at,omitempty"`
+}
+
+// UpdateExercisePayloadRequestBody is used to define fields on request body
 // types.
 type UpdateExercisePayloadRequestBody struct {
 	// Exercise title
@@ -353,6 +1322,10 @@ type UpdateExercisePayloadRequestBody struct {
 	Solution *string `form:"solution,omitempty" json:"solution,omitempty" xml:"solution,omitempty"`
 	// Exercise difficulty level
 	Difficulty *string `form:"difficulty,omitempty" json:"difficulty,omitempty" xml:"difficulty,omitempty"`
+	// Programming language the exercise is solved in, unchanged if omitted
+	Language *string `form:"language,omitempty" json:"language,omitempty" xml:"language,omitempty"`
+	// Topics the exercise covers, unchanged if omitted
+	Tags []string `form:"tags,omitempty" json:"tags,omitempty" xml:"tags,omitempty"`
 }
 
 // UpdateTestPayloadRequestBody is used to define fields on request body types.
@@ -363,6 +1336,44 @@ type UpdateTestPayloadRequestBody struct {
 	Output *string `form:"output,omitempty" json:"output,omitempty" xml:"output,omitempty"`
 	// Whether test is visible to students
 	Public *bool `form:"public,omitempty" json:"public,omitempty" xml:"public,omitempty"`
+	// How the input is passed to solution: a raw string or a JSON array of
+	// arguments
+	InputFormat *string `form:"input_format,omitempty" json:"input_format,omitempty" xml:"input_format,omitempty"`
+	// How the output is compared to the expected output
+	Comparison *string `form:"comparison,omitempty" json:"comparison,omitempty" xml:"comparison,omitempty"`
+	// Allowed absolute difference for numeric comparison
+	Tolerance *float64 `form:"tolerance,omitempty" json:"tolerance,omitempty" xml:"tolerance,omitempty"`
+	// Points awarded for passing the test
+	Weight *int32 `form:"weight,omitempty" json:"weight,omitempty" xml:"weight,omitempty"`
+}
+
+// UpdateHintPayloadRequestBody is used to define fields on request body types.
+type UpdateHintPayloadRequestBody struct {
+	// Hint text
+	Content *string `form:"content,omitempty" json:"content,omitempty" xml:"content,omitempty"`
+	// Failed attempts after which the hint unlocks
+	UnlockAfterAttempts *int32 `form:"unlock_after_attempts,omitempty" json:"unlock_after_attempts,omitempty" xml:"unlock_after_attempts,omitempty"`
+	// Seconds since the student started the exercise after which the hint unlocks
+	UnlockAfterSeconds *int32 `form:"unlock_after_seconds,omitempty" json:"unlock_after_seconds,omitempty" xml:"unlock_after_seconds,omitempty"`
+	// Points taken off the score of the attempts made once the hint is revealed
+	Penalty *float64 `form:"penalty,omitempty" json:"penalty,omitempty" xml:"penalty,omitempty"`
+}
+
+// UpdateAssignmentPayloadRequestBody is used to define fields on request body
+// types.
+type UpdateAssignmentPayloadRequestBody struct {
+	// Assignment title
+	Title *string `form:"title,omitempty" json:"title,omitempty" xml:"title,omitempty"`
+	// Assignment description
+	Description *string `form:"description,omitempty" json:"description,omitempty" xml:"description,omitempty"`
+	// Timestamp from which attempts are accepted
+	OpensAt *int64 `form:"opens_at,omitempty" json:"opens_at,omitempty" xml:"opens_at,omitempty"`
+	// Timestamp after which attempts are rejected or flagged as late
+	ClosesAt *int64 `form:"closes_at,omitempty" json:"closes_at,omitempty" xml:"closes_at,omitempty"`
+	// Whether attempts made after closes_at are accepted as late
+	AllowLate *bool `form:"allow_late,omitempty" json:"allow_late,omitempty" xml:"allow_late,omitempty"`
+	// Exercises of the assignment in order
+	ExerciseIds []int64 `form:"exercise_ids,omitempty" json:"exercise_ids,omitempty" xml:"exercise_ids,omitempty"`
 }
 
 // NewCreateExerciseResponseBody builds the HTTP response body from the result
@@ -388,16 +1399,36 @@ func NewGetExerciseResponseBody(res *codelab.Exercise) *GetExerciseResponseBody
 		CreatedBy:   res.CreatedBy,
 		CreatedAt:   res.CreatedAt,
 		UpdatedAt:   res.UpdatedAt,
+		Language:    res.Language,
+		Version:     res.Version,
+	}
+	if res.Tags != nil {
+		body.Tags = make([]string, len(res.Tags))
+		for i, val := range res.Tags {
+			body.Tags[i] = val
+		}
+	} else {
+		body.Tags = []string{}
 	}
 	return body
 }
 
 // NewListExercisesResponseBody builds the HTTP response body from the result
 // of the "ListExercises" endpoint of the "codelab" service.
-func NewListExercisesResponseBody(res []*codelab.Exercise) ListExercisesResponseBody {
-	body := make([]*ExerciseResponse, len(res))
+func NewListExercisesResponseBody(res *codelab.ExercisePage) ListExercisesResponseBody {
+	body := make([]*Exercise, len(res.Exercises))
+	for i, val := range res.Exercises {
+		body[i] = marshalCodelabExerciseToExercise(val)
+	}
+	return body
+}
+
+// NewListTagsResponseBody builds the HTTP response body from the result of the
+// "ListTags" endpoint of the "codelab" service.
+func NewListTagsResponseBody(res []*codelab.TagCount) ListTagsResponseBody {
+	body := make([]*TagCountResponse, len(res))
 	for i, val := range res {
-		body[i] = marshalCodelabExerciseToExerciseResponse(val)
+		body[i] = marshalCodelabTagCountToTagCountResponse(val)
 	}
 	return body
 }
@@ -422,6 +1453,35 @@ func NewDeleteExerciseResponseBody(res *codelab.SimpleResponse) *DeleteExerciseR
 	return body
 }
 
+// NewExportExercisesResponseBody builds the HTTP response body from the result
+// of the "ExportExercises" endpoint of the "codelab" service.
+func NewExportExercisesResponseBody(res *codelab.ExerciseBundleFile) *ExportExercisesResponseBody {
+	body := &ExportExercisesResponseBody{
+		Filename: res.Filename,
+		Format:   res.Format,
+		Content:  res.Content,
+	}
+	return body
+}
+
+// NewImportExercisesResponseBody builds the HTTP response body from the result
+// of the "ImportExercises" endpoint of the "codelab" service.
+func NewImportExercisesResponseBody(res *codelab.ImportedExercises) *ImportExercisesResponseBody {
+	body := &ImportExercisesResponseBody{
+		Success: res.Success,
+		Message: res.Message,
+	}
+	if res.ExerciseIds != nil {
+		body.ExerciseIds = make([]int64, len(res.ExerciseIds))
+		for i, val := range res.ExerciseIds {
+			body.ExerciseIds[i] = val
+		}
+	} else {
+		body.ExerciseIds = []int64{}
+	}
+	return body
+}
+
 // NewCreateTestResponseBody builds the HTTP response body from the result of
 // the "CreateTest" endpoint of the "codelab" service.
 func NewCreateTestResponseBody(res *codelab.SimpleResponse) *CreateTestResponseBody {
@@ -462,180 +1522,1017 @@ func NewDeleteTestResponseBody(res *codelab.SimpleResponse) *DeleteTestResponseB
 	return body
 }
 
-// NewGetExerciseForStudentResponseBody builds the HTTP response body from the
-// result of the "GetExerciseForStudent" endpoint of the "codelab" service.
-func NewGetExerciseForStudentResponseBody(res *codelab.ExerciseForStudents) *GetExerciseForStudentResponseBody {
-	body := &GetExerciseForStudentResponseBody{
-		ID:          res.ID,
-		Title:       res.Title,
-		Description: res.Description,
-		InitialCode: res.InitialCode,
-		Difficulty:  res.Difficulty,
-		CreatedBy:   res.CreatedBy,
-		CreatedAt:   res.CreatedAt,
-		UpdatedAt:   res.UpdatedAt,
+// NewListExerciseVersionsResponseBody builds the HTTP response body from the
+// result of the "ListExerciseVersions" endpoint of the "codelab" service.
+func NewListExerciseVersionsResponseBody(res []*codelab.ExerciseVersion) ListExerciseVersionsResponseBody {
+	body := make([]*ExerciseVersionResponse, len(res))
+	for i, val := range res {
+		body[i] = marshalCodelabExerciseVersionToExerciseVersionResponse(val)
+	}
+	return body
+}
+
+// NewDiffExerciseVersionsResponseBody builds the HTTP response body from the
+// result of the "DiffExerciseVersions" endpoint of the "codelab" service.
+func NewDiffExerciseVersionsResponseBody(res *codelab.ExerciseVersionDiff) *DiffExerciseVersionsResponseBody {
+	body := &DiffExerciseVersionsResponseBody{
+		ExerciseID:  res.ExerciseID,
+		FromVersion: res.FromVersion,
+		ToVersion:   res.ToVersion,
+	}
+	if res.Fields != nil {
+		body.Fields = make([]*FieldChangeResponseBody, len(res.Fields))
+		for i, val := range res.Fields {
+			body.Fields[i] = marshalCodelabFieldChangeToFieldChangeResponseBody(val)
+		}
+	} else {
+		body.Fields = []*FieldChangeResponseBody{}
 	}
 	if res.Tests != nil {
-		body.Tests = make([]*TestResponseBody, len(res.Tests))
+		body.Tests = make([]*TestChangeResponseBody, len(res.Tests))
 		for i, val := range res.Tests {
-			body.Tests[i] = marshalCodelabTestToTestResponseBody(val)
+			body.Tests[i] = marshalCodelabTestChangeToTestChangeResponseBody(val)
 		}
 	} else {
-		body.Tests = []*TestResponseBody{}
+		body.Tests = []*TestChangeResponseBody{}
 	}
-	if res.Attempts != nil {
-		body.Attempts = make([]*AttemptResponseBody, len(res.Attempts))
-		for i, val := range res.Attempts {
-			body.Attempts[i] = marshalCodelabAttemptToAttemptResponseBody(val)
+	return body
+}
+
+// NewRollbackExerciseResponseBody builds the HTTP response body from the
+// result of the "RollbackExercise" endpoint of the "codelab" service.
+func NewRollbackExerciseResponseBody(res *codelab.SimpleResponse) *RollbackExerciseResponseBody {
+	body := &RollbackExerciseResponseBody{
+		Success: res.Success,
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewRegradeExerciseResponseBody builds the HTTP response body from the result
+// of the "RegradeExercise" endpoint of the "codelab" service.
+func NewRegradeExerciseResponseBody(res *codelab.Regrade) *RegradeExerciseResponseBody {
+	body := &RegradeExerciseResponseBody{
+		ID:                  res.ID,
+		ExerciseID:          res.ExerciseID,
+		ExerciseVersion:     res.ExerciseVersion,
+		Status:              res.Status,
+		TotalAttempts:       res.TotalAttempts,
+		RegradedAttempts:    res.RegradedAttempts,
+		ErroredAttempts:     res.ErroredAttempts,
+		NewlyPassedAttempts: res.NewlyPassedAttempts,
+		NewlyFailedAttempts: res.NewlyFailedAttempts,
+		Error:               res.Error,
+		CreatedBy:           res.CreatedBy,
+		CreatedAt:           res.CreatedAt,
+		FinishedAt:          res.FinishedAt,
+	}
+	if res.NewlyPassed != nil {
+		body.NewlyPassed = make([]*RegradeStudentChangeResponseBody, len(res.NewlyPassed))
+		for i, val := range res.NewlyPassed {
+			body.NewlyPassed[i] = marshalCodelabRegradeStudentChangeToRegradeStudentChangeResponseBody(val)
 		}
 	} else {
-		body.Attempts = []*AttemptResponseBody{}
+		body.NewlyPassed = []*RegradeStudentChangeResponseBody{}
 	}
-	if res.Answer != nil {
-		body.Answer = marshalCodelabAnswerToAnswerResponseBody(res.Answer)
+	if res.NewlyFailed != nil {
+		body.NewlyFailed = make([]*RegradeStudentChangeResponseBody, len(res.NewlyFailed))
+		for i, val := range res.NewlyFailed {
+			body.NewlyFailed[i] = marshalCodelabRegradeStudentChangeToRegradeStudentChangeResponseBody(val)
+		}
+	} else {
+		body.NewlyFailed = []*RegradeStudentChangeResponseBody{}
 	}
 	return body
 }
 
-// NewListExercisesForStudentsResponseBody builds the HTTP response body from
-// the result of the "ListExercisesForStudents" endpoint of the "codelab"
-// service.
-func NewListExercisesForStudentsResponseBody(res []*codelab.ExerciseForStudentsListView) ListExercisesForStudentsResponseBody {
-	body := make([]*ExerciseForStudentsListViewResponse, len(res))
+// NewGetRegradeResponseBody builds the HTTP response body from the result of
+// the "GetRegrade" endpoint of the "codelab" service.
+func NewGetRegradeResponseBody(res *codelab.Regrade) *GetRegradeResponseBody {
+	body := &GetRegradeResponseBody{
+		ID:                  res.ID,
+		ExerciseID:          res.ExerciseID,
+		ExerciseVersion:     res.ExerciseVersion,
+		Status:              res.Status,
+		TotalAttempts:       res.TotalAttempts,
+		RegradedAttempts:    res.RegradedAttempts,
+		ErroredAttempts:     res.ErroredAttempts,
+		NewlyPassedAttempts: res.NewlyPassedAttempts,
+		NewlyFailedAttempts: res.NewlyFailedAttempts,
+		Error:               res.Error,
+		CreatedBy:           res.CreatedBy,
+		CreatedAt:           res.CreatedAt,
+		FinishedAt:          res.FinishedAt,
+	}
+	if res.NewlyPassed != nil {
+		body.NewlyPassed = make([]*RegradeStudentChangeResponseBody, len(res.NewlyPassed))
+		for i, val := range res.NewlyPassed {
+			body.NewlyPassed[i] = marshalCodelabRegradeStudentChangeToRegradeStudentChangeResponseBody(val)
+		}
+	} else {
+		body.NewlyPassed = []*RegradeStudentChangeResponseBody{}
+	}
+	if res.NewlyFailed != nil {
+		body.NewlyFailed = make([]*RegradeStudentChangeResponseBody, len(res.NewlyFailed))
+		for i, val := range res.NewlyFailed {
+			body.NewlyFailed[i] = marshalCodelabRegradeStudentChangeToRegradeStudentChangeResponseBody(val)
+		}
+	} else {
+		body.NewlyFailed = []*RegradeStudentChangeResponseBody{}
+	}
+	return body
+}
+
+// NewCreateHintResponseBody builds the HTTP response body from the result of
+// the "CreateHint" endpoint of the "codelab" service.
+func NewCreateHintResponseBody(res *codelab.SimpleResponse) *CreateHintResponseBody {
+	body := &CreateHintResponseBody{
+		Success: res.Success,
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewGetHintsByExerciseResponseBody builds the HTTP response body from the
+// result of the "GetHintsByExercise" endpoint of the "codelab" service.
+func NewGetHintsByExerciseResponseBody(res []*codelab.Hint) GetHintsByExerciseResponseBody {
+	body := make([]*HintResponse, len(res))
 	for i, val := range res {
-		body[i] = marshalCodelabExerciseForStudentsListViewToExerciseForStudentsListViewResponse(val)
+		body[i] = marshalCodelabHintToHintResponse(val)
 	}
 	return body
 }
 
-// NewCreateAttemptResponseBody builds the HTTP response body from the result
-// of the "CreateAttempt" endpoint of the "codelab" service.
-func NewCreateAttemptResponseBody(res *codelab.SimpleResponse) *CreateAttemptResponseBody {
-	body := &CreateAttemptResponseBody{
+// NewUpdateHintResponseBody builds the HTTP response body from the result of
+// the "UpdateHint" endpoint of the "codelab" service.
+func NewUpdateHintResponseBody(res *codelab.SimpleResponse) *UpdateHintResponseBody {
+	body := &UpdateHintResponseBody{
 		Success: res.Success,
 		Message: res.Message,
 	}
 	return body
 }
 
-// NewGetAttemptsByUserAndExerciseResponseBody builds the HTTP response body
-// from the result of the "GetAttemptsByUserAndExercise" endpoint of the
-// "codelab" service.
-func NewGetAttemptsByUserAndExerciseResponseBody(res []*codelab.Attempt) GetAttemptsByUserAndExerciseResponseBody {
-	body := make([]*AttemptResponse, len(res))
+// NewDeleteHintResponseBody builds the HTTP response body from the result of
+// the "DeleteHint" endpoint of the "codelab" service.
+func NewDeleteHintResponseBody(res *codelab.SimpleResponse) *DeleteHintResponseBody {
+	body := &DeleteHintResponseBody{
+		Success: res.Success,
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewCreateAssignmentResponseBody builds the HTTP response body from the
+// result of the "CreateAssignment" endpoint of the "codelab" service.
+func NewCreateAssignmentResponseBody(res *codelab.SimpleResponse) *CreateAssignmentResponseBody {
+	body := &CreateAssignmentResponseBody{
+		Success: res.Success,
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewGetAssignmentResponseBody builds the HTTP response body from the result
+// of the "GetAssignment" endpoint of the "codelab" service.
+func NewGetAssignmentResponseBody(res *codelab.Assignment) *GetAssignmentResponseBody {
+	body := &GetAssignmentResponseBody{
+		ID:          res.ID,
+		Title:       res.Title,
+		Description: res.Description,
+		OpensAt:     res.OpensAt,
+		ClosesAt:    res.ClosesAt,
+		AllowLate:   res.AllowLate,
+		Status:      res.Status,
+		CreatedBy:   res.CreatedBy,
+		CreatedAt:   res.CreatedAt,
+		UpdatedAt:   res.UpdatedAt,
+	}
+	if res.Exercises != nil {
+		body.Exercises = make([]*AssignmentExerciseResponseBody, len(res.Exercises))
+		for i, val := range res.Exercises {
+			body.Exercises[i] = marshalCodelabAssignmentExerciseToAssignmentExerciseResponseBody(val)
+		}
+	} else {
+		body.Exercises = []*AssignmentExerciseResponseBody{}
+	}
+	return body
+}
+
+// NewListAssignmentsResponseBody builds the HTTP response body from the result
+// of the "ListAssignments" endpoint of the "codelab" service.
+func NewListAssignmentsResponseBody(res []*codelab.Assignment) ListAssignmentsResponseBody {
+	body := make([]*AssignmentResponse, len(res))
 	for i, val := range res {
-		body[i] = marshalCodelabAttemptToAttemptResponse(val)
+		body[i] = marshalCodelabAssignmentToAssignmentResponse(val)
+	}
+	return body
+}
+
+// NewUpdateAssignmentResponseBody builds the HTTP response body from the
+// result of the "UpdateAssignment" endpoint of the "codelab" service.
+func NewUpdateAssignmentResponseBody(res *codelab.SimpleResponse) *UpdateAssignmentResponseBody {
+	body := &UpdateAssignmentResponseBody{
+		Success: res.Success,
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewDeleteAssignmentResponseBody builds the HTTP response body from the
+// result of the "DeleteAssignment" endpoint of the "codelab" service.
+func NewDeleteAssignmentResponseBody(res *codelab.SimpleResponse) *DeleteAssignmentResponseBody {
+	body := &DeleteAssignmentResponseBody{
+		Success: res.Success,
+		Message: res.Message,
 	}
 	return body
 }
 
-// NewGetAnswerByUserAndExerciseResponseBody builds the HTTP response body from
-// the result of the "GetAnswerByUserAndExercise" endpoint of the "codelab"
-// service.
-func NewGetAnswerByUserAndExerciseResponseBody(res *codelab.Answer) *GetAnswerByUserAndExerciseResponseBody {
-	body := &GetAnswerByUserAndExerciseResponseBody{
-		ID:         res.ID,
-		ExerciseID: res.ExerciseID,
-		UserID:     res.UserID,
-		Completed:  res.Completed,
-		CreatedAt:  res.CreatedAt,
-		UpdatedAt:  res.UpdatedAt,
-	}
-	return body
+// NewGetAssignmentGradebookResponseBody builds the HTTP response body from the
+// result of the "GetAssignmentGradebook" endpoint of the "codelab" service.
+func NewGetAssignmentGradebookResponseBody(res *codelab.AssignmentGradebook) *GetAssignmentGradebookResponseBody {
+	body := &GetAssignmentGradebookResponseBody{}
+	if res.Assignment != nil {
+		body.Assignment = marshalCodelabAssignmentToAssignmentResponseBody(res.Assignment)
+	}
+	if res.Students != nil {
+		body.Students = make([]*StudentAssignmentGradeResponseBody, len(res.Students))
+		for i, val := range res.Students {
+			body.Students[i] = marshalCodelabStudentAssignmentGradeToStudentAssignmentGradeResponseBody(val)
+		}
+	} else {
+		body.Students = []*StudentAssignmentGradeResponseBody{}
+	}
+	return body
+}
+
+// NewGetExerciseStatsResponseBody builds the HTTP response body from the
+// result of the "GetExerciseStats" endpoint of the "codelab" service.
+func NewGetExerciseStatsResponseBody(res *codelab.ExerciseStats) *GetExerciseStatsResponseBody {
+	body := &GetExerciseStatsResponseBody{
+		ExerciseID:               res.ExerciseID,
+		TotalStudents:            res.TotalStudents,
+		CompletedStudents:        res.CompletedStudents,
+		CompletionRate:           res.CompletionRate,
+		TotalAttempts:            res.TotalAttempts,
+		SuccessfulAttempts:       res.SuccessfulAttempts,
+		MedianAttemptsToSuccess:  res.MedianAttemptsToSuccess,
+		MedianTimeToCompletionMs: res.MedianTimeToCompletionMs,
+		HintsUsed:                res.HintsUsed,
+	}
+	if res.MostFailedTest != nil {
+		body.MostFailedTest = marshalCodelabFailingTestToFailingTestResponseBody(res.MostFailedTest)
+	}
+	if res.Students != nil {
+		body.Students = make([]*StudentExerciseStatusResponseBody, len(res.Students))
+		for i, val := range res.Students {
+			body.Students[i] = marshalCodelabStudentExerciseStatusToStudentExerciseStatusResponseBody(val)
+		}
+	} else {
+		body.Students = []*StudentExerciseStatusResponseBody{}
+	}
+	return body
+}
+
+// NewGetStudentExerciseStatsResponseBody builds the HTTP response body from
+// the result of the "GetStudentExerciseStats" endpoint of the "codelab"
+// service.
+func NewGetStudentExerciseStatsResponseBody(res *codelab.StudentExerciseStats) *GetStudentExerciseStatsResponseBody {
+	body := &GetStudentExerciseStatsResponseBody{
+		ExerciseID: res.ExerciseID,
+	}
+	if res.Summary != nil {
+		body.Summary = marshalCodelabStudentExerciseStatusToStudentExerciseStatusResponseBody(res.Summary)
+	}
+	if res.Attempts != nil {
+		body.Attempts = make([]*AttemptResponseBody, len(res.Attempts))
+		for i, val := range res.Attempts {
+			body.Attempts[i] = marshalCodelabAttemptToAttemptResponseBody(val)
+		}
+	} else {
+		body.Attempts = []*AttemptResponseBody{}
+	}
+	return body
+}
+
+// NewGetPlagiarismReportResponseBody builds the HTTP response body from the
+// result of the "GetPlagiarismReport" endpoint of the "codelab" service.
+func NewGetPlagiarismReportResponseBody(res *codelab.PlagiarismReport) *GetPlagiarismReportResponseBody {
+	body := &GetPlagiarismReportResponseBody{
+		ExerciseID: res.ExerciseID,
+		CheckedAt:  res.CheckedAt,
+	}
+	if res.Pairs != nil {
+		body.Pairs = make([]*PlagiarismPairResponseBody, len(res.Pairs))
+		for i, val := range res.Pairs {
+			body.Pairs[i] = marshalCodelabPlagiarismPairToPlagiarismPairResponseBody(val)
+		}
+	} else {
+		body.Pairs = []*PlagiarismPairResponseBody{}
+	}
+	return body
+}
+
+// NewGetExerciseForStudentResponseBody builds the HTTP response body from the
+// result of the "GetExerciseForStudent" endpoint of the "codelab" service.
+func NewGetExerciseForStudentResponseBody(res *codelab.ExerciseForStudents) *GetExerciseForStudentResponseBody {
+	body := &GetExerciseForStudentResponseBody{
+		ID:          res.ID,
+		Title:       res.Title,
+		Description: res.Description,
+		InitialCode: res.InitialCode,
+		Difficulty:  res.Difficulty,
+		CreatedBy:   res.CreatedBy,
+		CreatedAt:   res.CreatedAt,
+		UpdatedAt:   res.UpdatedAt,
+		Language:    res.Language,
+		Version:     res.Version,
+	}
+	if res.Tests != nil {
+		body.Tests = make([]*TestResponseBody, len(res.Tests))
+		for i, val := range res.Tests {
+			body.Tests[i] = marshalCodelabTestToTestResponseBody(val)
+		}
+	} else {
+		body.Tests = []*TestResponseBody{}
+	}
+	if res.Attempts != nil {
+		body.Attempts = make([]*AttemptResponseBody, len(res.Attempts))
+		for i, val := range res.Attempts {
+			body.Attempts[i] = marshalCodelabAttemptToAttemptResponseBody(val)
+		}
+	} else {
+		body.Attempts = []*AttemptResponseBody{}
+	}
+	if res.Answer != nil {
+		body.Answer = marshalCodelabAnswerToAnswerResponseBody(res.Answer)
+	}
+	if res.Tags != nil {
+		body.Tags = make([]string, len(res.Tags))
+		for i, val := range res.Tags {
+			body.Tags[i] = val
+		}
+	} else {
+		body.Tags = []string{}
+	}
+	return body
+}
+
+// NewListExercisesForStudentsResponseBody builds the HTTP response body from
+// the result of the "ListExercisesForStudents" endpoint of the "codelab"
+// service.
+func NewListExercisesForStudentsResponseBody(res *codelab.ExerciseForStudentsPage) ListExercisesForStudentsResponseBody {
+	body := make([]*ExerciseForStudentsListView, len(res.Exercises))
+	for i, val := range res.Exercises {
+		body[i] = marshalCodelabExerciseForStudentsListViewToExerciseForStudentsListView(val)
+	}
+	return body
+}
+
+// NewCreateAttemptResponseBody builds the HTTP response body from the result
+// of the "CreateAttempt" endpoint of the "codelab" service.
+func NewCreateAttemptResponseBody(res *codelab.SimpleResponse) *CreateAttemptResponseBody {
+	body := &CreateAttemptResponseBody{
+		Success: res.Success,
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewSubmitAttemptResponseBody builds the HTTP response body from the result
+// of the "SubmitAttempt" endpoint of the "codelab" service.
+func NewSubmitAttemptResponseBody(res *codelab.AttemptSubmission) *SubmitAttemptResponseBody {
+	body := &SubmitAttemptResponseBody{
+		AttemptID:  res.AttemptID,
+		TotalTests: res.TotalTests,
+		Late:       res.Late,
+	}
+	return body
+}
+
+// NewStreamAttemptEventsResponseBody builds the HTTP response body from the
+// result of the "StreamAttemptEvents" endpoint of the "codelab" service.
+func NewStreamAttemptEventsResponseBody(res *codelab.AttemptEvent) *StreamAttemptEventsResponseBody {
+	body := &StreamAttemptEventsResponseBody{
+		Type:       res.Type,
+		AttemptID:  res.AttemptID,
+		TotalTests: res.TotalTests,
+		TestIndex:  res.TestIndex,
+		Status:     res.Status,
+		Success:    res.Success,
+		Points:     res.Points,
+		MaxPoints:  res.MaxPoints,
+		Score:      res.Score,
+		Message:    res.Message,
+	}
+	if res.TestResult != nil {
+		body.TestResult = marshalCodelabAttemptTestResultToAttemptTestResultResponseBody(res.TestResult)
+	}
+	return body
+}
+
+// NewRunCodeResponseBody builds the HTTP response body from the result of the
+// "RunCode" endpoint of the "codelab" service.
+func NewRunCodeResponseBody(res *codelab.RunCodeResult) *RunCodeResponseBody {
+	body := &RunCodeResponseBody{
+		Status: res.Status,
+	}
+	if res.Results != nil {
+		body.Results = make([]*RunCaseResultResponseBody, len(res.Results))
+		for i, val := range res.Results {
+			body.Results[i] = marshalCodelabRunCaseResultToRunCaseResultResponseBody(val)
+		}
+	} else {
+		body.Results = []*RunCaseResultResponseBody{}
+	}
+	return body
+}
+
+// NewGetAttemptsByUserAndExerciseResponseBody builds the HTTP response body
+// from the result of the "GetAttemptsByUserAndExercise" endpoint of the
+// "codelab" service.
+func NewGetAttemptsByUserAndExerciseResponseBody(res []*codelab.Attempt) GetAttemptsByUserAndExerciseResponseBody {
+	body := make([]*AttemptResponse, len(res))
+	for i, val := range res {
+		body[i] = marshalCodelabAttemptToAttemptResponse(val)
+	}
+	return body
+}
+
+// NewGetHintsForStudentResponseBody builds the HTTP response body from the
+// result of the "GetHintsForStudent" endpoint of the "codelab" service.
+func NewGetHintsForStudentResponseBody(res []*codelab.StudentHint) GetHintsForStudentResponseBody {
+	body := make([]*StudentHintResponse, len(res))
+	for i, val := range res {
+		body[i] = marshalCodelabStudentHintToStudentHintResponse(val)
+	}
+	return body
+}
+
+// NewRequestHintResponseBody builds the HTTP response body from the result of
+// the "RequestHint" endpoint of the "codelab" service.
+func NewRequestHintResponseBody(res *codelab.StudentHint) *RequestHintResponseBody {
+	body := &RequestHintResponseBody{
+		ID:                res.ID,
+		Position:          res.Position,
+		Status:            res.Status,
+		Content:           res.Content,
+		Penalty:           res.Penalty,
+		AttemptsRemaining: res.AttemptsRemaining,
+		UnlocksAt:         res.UnlocksAt,
+		RevealedAt:        res.RevealedAt,
+	}
+	return body
+}
+
+// NewListAssignmentsForStudentsResponseBody builds the HTTP response body from
+// the result of the "ListAssignmentsForStudents" endpoint of the "codelab"
+// service.
+func NewListAssignmentsForStudentsResponseBody(res []*codelab.Assignment) ListAssignmentsForStudentsResponseBody {
+	body := make([]*AssignmentResponse, len(res))
+	for i, val := range res {
+		body[i] = marshalCodelabAssignmentToAssignmentResponse(val)
+	}
+	return body
+}
+
+// NewGetAssignmentProgressResponseBody builds the HTTP response body from the
+// result of the "GetAssignmentProgress" endpoint of the "codelab" service.
+func NewGetAssignmentProgressResponseBody(res *codelab.AssignmentProgress) *GetAssignmentProgressResponseBody {
+	body := &GetAssignmentProgressResponseBody{}
+	if res.Assignment != nil {
+		body.Assignment = marshalCodelabAssignmentToAssignmentResponseBody(res.Assignment)
+	}
+	if res.Progress != nil {
+		body.Progress = marshalCodelabStudentAssignmentGradeToStudentAssignmentGradeResponseBody(res.Progress)
+	}
+	return body
+}
+
+// NewGetAnswerByUserAndExerciseResponseBody builds the HTTP response body from
+// the result of the "GetAnswerByUserAndExercise" endpoint of the "codelab"
+// service.
+func NewGetAnswerByUserAndExerciseResponseBody(res *codelab.Answer) *GetAnswerByUserAndExerciseResponseBody {
+	body := &GetAnswerByUserAndExerciseResponseBody{
+		ID:            res.ID,
+		ExerciseID:    res.ExerciseID,
+		UserID:        res.UserID,
+		Completed:     res.Completed,
+		CreatedAt:     res.CreatedAt,
+		UpdatedAt:     res.UpdatedAt,
+		BestScore:     res.BestScore,
+		BestAttemptID: res.BestAttemptID,
+		HintsUsed:     res.HintsUsed,
+		HintPenalty:   res.HintPenalty,
+	}
+	return body
+}
+
+// NewCreateExerciseSolutionFailedResponseBody builds the HTTP response body
+// from the result of the "CreateExercise" endpoint of the "codelab" service.
+func NewCreateExerciseSolutionFailedResponseBody(res *codelab.SolutionFailure) *CreateExerciseSolutionFailedResponseBody {
+	body := &CreateExerciseSolutionFailedResponseBody{
+		Message: res.Message,
+	}
+	if res.FailingTests != nil {
+		body.FailingTests = make([]*SolutionTestFailureResponseBody, len(res.FailingTests))
+		for i, val := range res.FailingTests {
+			body.FailingTests[i] = marshalCodelabSolutionTestFailureToSolutionTestFailureResponseBody(val)
+		}
+	} else {
+		body.FailingTests = []*SolutionTestFailureResponseBody{}
+	}
+	return body
+}
+
+// NewUpdateExerciseSolutionFailedResponseBody builds the HTTP response body
+// from the result of the "UpdateExercise" endpoint of the "codelab" service.
+func NewUpdateExerciseSolutionFailedResponseBody(res *codelab.SolutionFailure) *UpdateExerciseSolutionFailedResponseBody {
+	body := &UpdateExerciseSolutionFailedResponseBody{
+		Message: res.Message,
+	}
+	if res.FailingTests != nil {
+		body.FailingTests = make([]*SolutionTestFailureResponseBody, len(res.FailingTests))
+		for i, val := range res.FailingTests {
+			body.FailingTests[i] = marshalCodelabSolutionTestFailureToSolutionTestFailureResponseBody(val)
+		}
+	} else {
+		body.FailingTests = []*SolutionTestFailureResponseBody{}
+	}
+	return body
+}
+
+// NewImportExercisesSolutionFailedResponseBody builds the HTTP response body
+// from the result of the "ImportExercises" endpoint of the "codelab" service.
+func NewImportExercisesSolutionFailedResponseBody(res *codelab.SolutionFailure) *ImportExercisesSolutionFailedResponseBody {
+	body := &ImportExercisesSolutionFailedResponseBody{
+		Message: res.Message,
+	}
+	if res.FailingTests != nil {
+		body.FailingTests = make([]*SolutionTestFailureResponseBody, len(res.FailingTests))
+		for i, val := range res.FailingTests {
+			body.FailingTests[i] = marshalCodelabSolutionTestFailureToSolutionTestFailureResponseBody(val)
+		}
+	} else {
+		body.FailingTests = []*SolutionTestFailureResponseBody{}
+	}
+	return body
+}
+
+// NewCreateTestSolutionFailedResponseBody builds the HTTP response body from
+// the result of the "CreateTest" endpoint of the "codelab" service.
+func NewCreateTestSolutionFailedResponseBody(res *codelab.SolutionFailure) *CreateTestSolutionFailedResponseBody {
+	body := &CreateTestSolutionFailedResponseBody{
+		Message: res.Message,
+	}
+	if res.FailingTests != nil {
+		body.FailingTests = make([]*SolutionTestFailureResponseBody, len(res.FailingTests))
+		for i, val := range res.FailingTests {
+			body.FailingTests[i] = marshalCodelabSolutionTestFailureToSolutionTestFailureResponseBody(val)
+		}
+	} else {
+		body.FailingTests = []*SolutionTestFailureResponseBody{}
+	}
+	return body
+}
+
+// NewUpdateTestSolutionFailedResponseBody builds the HTTP response body from
+// the result of the "UpdateTest" endpoint of the "codelab" service.
+func NewUpdateTestSolutionFailedResponseBody(res *codelab.SolutionFailure) *UpdateTestSolutionFailedResponseBody {
+	body := &UpdateTestSolutionFailedResponseBody{
+		Message: res.Message,
+	}
+	if res.FailingTests != nil {
+		body.FailingTests = make([]*SolutionTestFailureResponseBody, len(res.FailingTests))
+		for i, val := range res.FailingTests {
+			body.FailingTests[i] = marshalCodelabSolutionTestFailureToSolutionTestFailureResponseBody(val)
+		}
+	} else {
+		body.FailingTests = []*SolutionTestFailureResponseBody{}
+	}
+	return body
+}
+
+// NewRollbackExerciseSolutionFailedResponseBody builds the HTTP response body
+// from the result of the "RollbackExercise" endpoint of the "codelab" service.
+func NewRollbackExerciseSolutionFailedResponseBody(res *codelab.SolutionFailure) *RollbackExerciseSolutionFailedResponseBody {
+	body := &RollbackExerciseSolutionFailedResponseBody{
+		Message: res.Message,
+	}
+	if res.FailingTests != nil {
+		body.FailingTests = make([]*SolutionTestFailureResponseBody, len(res.FailingTests))
+		for i, val := range res.FailingTests {
+			body.FailingTests[i] = marshalCodelabSolutionTestFailureToSolutionTestFailureResponseBody(val)
+		}
+	} else {
+		body.FailingTests = []*SolutionTestFailureResponseBody{}
+	}
+	return body
+}
+
+// NewCreateAttemptRateLimitedResponseBody builds the HTTP response body from
+// the result of the "CreateAttempt" endpoint of the "codelab" service.
+func NewCreateAttemptRateLimitedResponseBody(res *codelab.RateLimit) *CreateAttemptRateLimitedResponseBody {
+	body := &CreateAttemptRateLimitedResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewSubmitAttemptRateLimitedResponseBody builds the HTTP response body from
+// the result of the "SubmitAttempt" endpoint of the "codelab" service.
+func NewSubmitAttemptRateLimitedResponseBody(res *codelab.RateLimit) *SubmitAttemptRateLimitedResponseBody {
+	body := &SubmitAttemptRateLimitedResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewRunCodeRateLimitedResponseBody builds the HTTP response body from the
+// result of the "RunCode" endpoint of the "codelab" service.
+func NewRunCodeRateLimitedResponseBody(res *codelab.RateLimit) *RunCodeRateLimitedResponseBody {
+	body := &RunCodeRateLimitedResponseBody{
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewCreateExercisePayload builds a codelab service CreateExercise endpoint
+// payload.
+func NewCreateExercisePayload(body *CreateExerciseRequestBody, sessionToken string) *codelab.CreateExercisePayload {
+	v := &codelab.CreateExercisePayload{
+		Title:       *body.Title,
+		Description: *body.Description,
+		InitialCode: *body.InitialCode,
+		Solution:    *body.Solution,
+		Difficulty:  *body.Difficulty,
+		CreatedBy:   *body.CreatedBy,
+	}
+	if body.Language != nil {
+		v.Language = *body.Language
+	}
+	if body.Language == nil {
+		v.Language = "javascript"
+	}
+	if body.Tags != nil {
+		v.Tags = make([]string, len(body.Tags))
+		for i, val := range body.Tags {
+			v.Tags[i] = val
+		}
+	}
+	v.SessionToken = sessionToken
+
+	return v
+}
+
+// NewGetExercisePayload builds a codelab service GetExercise endpoint payload.
+func NewGetExercisePayload(id int64, sessionToken string) *codelab.GetExercisePayload {
+	v := &codelab.GetExercisePayload{}
+	v.ID = id
+	v.SessionToken = sessionToken
+
+	return v
+}
+
+// NewListExercisesPayload builds a codelab service ListExercises endpoint
+// payload.
+func NewListExercisesPayload(search *string, difficulty *string, tags []string, createdBy *int64, cursor *string, limit int, sessionToken string) *codelab.ListExercisesPayload {
+	v := &codelab.ListExercisesPayload{}
+	v.Search = search
+	v.Difficulty = difficulty
+	v.Tags = tags
+	v.CreatedBy = createdBy
+	v.Cursor = cursor
+	v.Limit = limit
+	v.SessionToken = sessionToken
+
+	return v
+}
+
+// NewListTagsPayload builds a codelab service ListTags endpoint payload.
+func NewListTagsPayload(sessionToken string) *codelab.ListTagsPayload {
+	v := &codelab.ListTagsPayload{}
+	v.SessionToken = sessionToken
+
+	return v
+}
+
+// NewUpdateExercisePayload2 builds a codelab service UpdateExercise endpoint
+// payload.
+func NewUpdateExercisePayload2(body *UpdateExerciseRequestBody, id int64, sessionToken string) *codelab.UpdateExercisePayload2 {
+	v := &codelab.UpdateExercisePayload2{}
+	v.Exercise = unmarshalUpdateExercisePayloadRequestBodyToCodelabUpdateExercisePayload(body.Exercise)
+	v.ID = id
+	v.SessionToken = sessionToken
+
+	return v
+}
+
+// NewDeleteExercisePayload builds a codelab service DeleteExercise endpoint
+// payload.
+func NewDeleteExercisePayload(id int64, sessionToken string) *codelab.DeleteExercisePayload {
+	v := &codelab.DeleteExercisePayload{}
+	v.ID = id
+	v.SessionToken = sessionToken
+
+	return v
+}
+
+// NewExportExercisesPayload builds a codelab service ExportExercises endpoint
+// payload.
+func NewExportExercisesPayload(exerciseIds []int64, format string, sessionToken string) *codelab.ExportExercisesPayload {
+	v := &codelab.ExportExercisesPayload{}
+	v.ExerciseIds = exerciseIds
+	v.Format = format
+	v.SessionToken = sessionToken
+
+	return v
+}
+
+// NewImportExercisesPayload builds a codelab service ImportExercises endpoint
+// payload.
+func NewImportExercisesPayload(body *ImportExercisesRequestBody, sessionToken string) *codelab.ImportExercisesPayload {
+	v := &codelab.ImportExercisesPayload{
+		Content: body.Content,
+	}
+	v.SessionToken = sessionToken
+
+	return v
+}
+
+// NewCreateTestPayload builds a codelab service CreateTest endpoint payload.
+func NewCreateTestPayload(body *CreateTestRequestBody, sessionToken string) *codelab.CreateTestPayload {
+	v := &codelab.CreateTestPayload{
+		Input:      *body.Input,
+		Output:     *body.Output,
+		Public:     *body.Public,
+		ExerciseID: *body.ExerciseID,
+	}
+	if body.InputFormat != nil {
+		v.InputFormat = *body.InputFormat
+	}
+	if body.Comparison != nil {
+		v.Comparison = *body.Comparison
+	}
+	if body.Tolerance != nil {
+		v.Tolerance = *body.Tolerance
+	}
+	if body.Weight != nil {
+		v.Weight = *body.Weight
+	}
+	if body.InputFormat == nil {
+		v.InputFormat = "text"
+	}
+	if body.Comparison == nil {
+		v.Comparison = "exact"
+	}
+	if body.Tolerance == nil {
+		v.Tolerance = 0
+	}
+	if body.Weight == nil {
+		v.Weight = 1
+	}
+	v.SessionToken = sessionToken
+
+	return v
+}
+
+// NewGetTestsByExercisePayload builds a codelab service GetTestsByExercise
+// endpoint payload.
+func NewGetTestsByExercisePayload(exerciseID int64, sessionToken string) *codelab.GetTestsByExercisePayload {
+	v := &codelab.GetTestsByExercisePayload{}
+	v.ExerciseID = exerciseID
+	v.SessionToken = sessionToken
+
+	return v
+}
+
+// NewUpdateTestPayload2 builds a codelab service UpdateTest endpoint payload.
+func NewUpdateTestPayload2(body *UpdateTestRequestBody, id int64, sessionToken string) *codelab.UpdateTestPayload2 {
+	v := &codelab.UpdateTestPayload2{}
+	v.Test = unmarshalUpdateTestPayloadRequestBodyToCodelabUpdateTestPayload(body.Test)
+	v.ID = id
+	v.SessionToken = sessionToken
+
+	return v
+}
+
+// NewDeleteTestPayload builds a codelab service DeleteTest endpoint payload.
+func NewDeleteTestPayload(id int64, sessionToken string) *codelab.DeleteTestPayload {
+	v := &codelab.DeleteTestPayload{}
+	v.ID = id
+	v.SessionToken = sessionToken
+
+	return v
+}
+
+// NewListExerciseVersionsPayload builds a codelab service ListExerciseVersions
+// endpoint payload.
+func NewListExerciseVersionsPayload(exerciseID int64, sessionToken string) *codelab.ListExerciseVersionsPayload {
+	v := &codelab.ListExerciseVersionsPayload{}
+	v.ExerciseID = exerciseID
+	v.SessionToken = sessionToken
+
+	return v
+}
+
+// NewDiffExerciseVersionsPayload builds a codelab service DiffExerciseVersions
+// endpoint payload.
+func NewDiffExerciseVersionsPayload(exerciseID int64, from int32, to int32, sessionToken string) *codelab.DiffExerciseVersionsPayload {
+	v := &codelab.DiffExerciseVersionsPayload{}
+	v.ExerciseID = exerciseID
+	v.From = from
+	v.To = to
+	v.SessionToken = sessionToken
+
+	return v
+}
+
+// NewRollbackExercisePayload builds a codelab service RollbackExercise
+// endpoint payload.
+func NewRollbackExercisePayload(exerciseID int64, version int32, sessionToken string) *codelab.RollbackExercisePayload {
+	v := &codelab.RollbackExercisePayload{}
+	v.ExerciseID = exerciseID
+	v.Version = version
+	v.SessionToken = sessionToken
+
+	return v
+}
+
+// NewRegradeExercisePayload builds a codelab service RegradeExercise endpoint
+// payload.
+func NewRegradeExercisePayload(exerciseID int64, sessionToken string) *codelab.RegradeExercisePayload {
+	v := &codelab.RegradeExercisePayload{}
+	v.ExerciseID = exerciseID
+	v.SessionToken = sessionToken
+
+	return v
+}
+
+// NewGetRegradePayload builds a codelab service GetRegrade endpoint payload.
+func NewGetRegradePayload(id int64, sessionToken string) *codelab.GetRegradePayload {
+	v := &codelab.GetRegradePayload{}
+	v.ID = id
+	v.SessionToken = sessionToken
+
+	return v
+}
+
+// NewCreateHintPayload builds a codelab service CreateHint endpoint payload.
+func NewCreateHintPayload(body *CreateHintRequestBody, sessionToken string) *codelab.CreateHintPayload {
+	v := &codelab.CreateHintPayload{
+		ExerciseID:          *body.ExerciseID,
+		Content:             *body.Content,
+		UnlockAfterAttempts: body.UnlockAfterAttempts,
+		UnlockAfterSeconds:  body.UnlockAfterSeconds,
+	}
+	if body.Penalty != nil {
+		v.Penalty = *body.Penalty
+	}
+	if body.Penalty == nil {
+		v.Penalty = 0
+	}
+	v.SessionToken = sessionToken
+
+	return v
+}
+
+// NewGetHintsByExercisePayload builds a codelab service GetHintsByExercise
+// endpoint payload.
+func NewGetHintsByExercisePayload(exerciseID int64, sessionToken string) *codelab.GetHintsByExercisePayload {
+	v := &codelab.GetHintsByExercisePayload{}
+	v.ExerciseID = exerciseID
+	v.SessionToken = sessionToken
+
+	return v
+}
+
+// NewUpdateHintPayload2 builds a codelab service UpdateHint endpoint payload.
+func NewUpdateHintPayload2(body *UpdateHintRequestBody, id int64, sessionToken string) *codelab.UpdateHintPayload2 {
+	v := &codelab.UpdateHintPayload2{}
+	v.Hint = unmarshalUpdateHintPayloadRequestBodyToCodelabUpdateHintPayload(body.Hint)
+	v.ID = id
+	v.SessionToken = sessionToken
+
+	return v
+}
+
+// NewDeleteHintPayload builds a codelab service DeleteHint endpoint payload.
+func NewDeleteHintPayload(id int64, sessionToken string) *codelab.DeleteHintPayload {
+	v := &codelab.DeleteHintPayload{}
+	v.ID = id
+	v.SessionToken = sessionToken
+
+	return v
 }
 
-// NewCreateExercisePayload builds a codelab service CreateExercise endpoint
-// payload.
-func NewCreateExercisePayload(body *CreateExerciseRequestBody, sessionToken string) *codelab.CreateExercisePayload {
-	v := &codelab.CreateExercisePayload{
-		Title:       *body.Title,
-		Description: *body.Description,
-		InitialCode: *body.InitialCode,
-		Solution:    *body.Solution,
-		Difficulty:  *body.Difficulty,
-		CreatedBy:   *body.CreatedBy,
+// NewCreateAssignmentPayload builds a codelab service CreateAssignment
+// endpoint payload.
+func NewCreateAssignmentPayload(body *CreateAssignmentRequestBody, sessionToken string) *codelab.CreateAssignmentPayload {
+	v := &codelab.CreateAssignmentPayload{
+		Title:    *body.Title,
+		OpensAt:  *body.OpensAt,
+		ClosesAt: *body.ClosesAt,
+	}
+	if body.Description != nil {
+		v.Description = *body.Description
+	}
+	if body.AllowLate != nil {
+		v.AllowLate = *body.AllowLate
+	}
+	if body.Description == nil {
+		v.Description = ""
+	}
+	if body.AllowLate == nil {
+		v.AllowLate = false
+	}
+	v.ExerciseIds = make([]int64, len(body.ExerciseIds))
+	for i, val := range body.ExerciseIds {
+		v.ExerciseIds[i] = val
 	}
 	v.SessionToken = sessionToken
 
 	return v
 }
 
-// NewGetExercisePayload builds a codelab service GetExercise endpoint payload.
-func NewGetExercisePayload(id int64, sessionToken string) *codelab.GetExercisePayload {
-	v := &codelab.GetExercisePayload{}
+// NewGetAssignmentPayload builds a codelab service GetAssignment endpoint
+// payload.
+func NewGetAssignmentPayload(id int64, sessionToken string) *codelab.GetAssignmentPayload {
+	v := &codelab.GetAssignmentPayload{}
 	v.ID = id
 	v.SessionToken = sessionToken
 
 	return v
 }
 
-// NewListExercisesPayload builds a codelab service ListExercises endpoint
+// NewListAssignmentsPayload builds a codelab service ListAssignments endpoint
 // payload.
-func NewListExercisesPayload(sessionToken string) *codelab.ListExercisesPayload {
-	v := &codelab.ListExercisesPayload{}
+func NewListAssignmentsPayload(sessionToken string) *codelab.ListAssignmentsPayload {
+	v := &codelab.ListAssignmentsPayload{}
 	v.SessionToken = sessionToken
 
 	return v
 }
 
-// NewUpdateExercisePayload2 builds a codelab service UpdateExercise endpoint
-// payload.
-func NewUpdateExercisePayload2(body *UpdateExerciseRequestBody, id int64, sessionToken string) *codelab.UpdateExercisePayload2 {
-	v := &codelab.UpdateExercisePayload2{}
-	v.Exercise = unmarshalUpdateExercisePayloadRequestBodyToCodelabUpdateExercisePayload(body.Exercise)
+// NewUpdateAssignmentPayload2 builds a codelab service UpdateAssignment
+// endpoint payload.
+func NewUpdateAssignmentPayload2(body *UpdateAssignmentRequestBody, id int64, sessionToken string) *codelab.UpdateAssignmentPayload2 {
+	v := &codelab.UpdateAssignmentPayload2{}
+	v.Assignment = unmarshalUpdateAssignmentPayloadRequestBodyToCodelabUpdateAssignmentPayload(body.Assignment)
 	v.ID = id
 	v.SessionToken = sessionToken
 
 	return v
 }
 
-// NewDeleteExercisePayload builds a codelab service DeleteExercise endpoint
-// payload.
-func NewDeleteExercisePayload(id int64, sessionToken string) *codelab.DeleteExercisePayload {
-	v := &codelab.DeleteExercisePayload{}
+// NewDeleteAssignmentPayload builds a codelab service DeleteAssignment
+// endpoint payload.
+func NewDeleteAssignmentPayload(id int64, sessionToken string) *codelab.DeleteAssignmentPayload {
+	v := &codelab.DeleteAssignmentPayload{}
 	v.ID = id
 	v.SessionToken = sessionToken
 
 	return v
 }
 
-// NewCreateTestPayload builds a codelab service CreateTest endpoint payload.
-func NewCreateTestPayload(body *CreateTestRequestBody, sessionToken string) *codelab.CreateTestPayload {
-	v := &codelab.CreateTestPayload{
-		Input:      *body.Input,
-		Output:     *body.Output,
-		Public:     *body.Public,
-		ExerciseID: *body.ExerciseID,
-	}
+// NewGetAssignmentGradebookPayload builds a codelab service
+// GetAssignmentGradebook endpoint payload.
+func NewGetAssignmentGradebookPayload(id int64, sessionToken string) *codelab.GetAssignmentGradebookPayload {
+	v := &codelab.GetAssignmentGradebookPayload{}
+	v.ID = id
 	v.SessionToken = sessionToken
 
 	return v
 }
 
-// NewGetTestsByExercisePayload builds a codelab service GetTestsByExercise
+// NewGetExerciseStatsPayload builds a codelab service GetExerciseStats
 // endpoint payload.
-func NewGetTestsByExercisePayload(exerciseID int64, sessionToken string) *codelab.GetTestsByExercisePayload {
-	v := &codelab.GetTestsByExercisePayload{}
+func NewGetExerciseStatsPayload(exerciseID int64, sessionToken string) *codelab.GetExerciseStatsPayload {
+	v := &codelab.GetExerciseStatsPayload{}
 	v.ExerciseID = exerciseID
 	v.SessionToken = sessionToken
 
 	return v
 }
 
-// NewUpdateTestPayload2 builds a codelab service UpdateTest endpoint payload.
-func NewUpdateTestPayload2(body *UpdateTestRequestBody, id int64, sessionToken string) *codelab.UpdateTestPayload2 {
-	v := &codelab.UpdateTestPayload2{}
-	v.Test = unmarshalUpdateTestPayloadRequestBodyToCodelabUpdateTestPayload(body.Test)
-	v.ID = id
+// NewGetStudentExerciseStatsPayload builds a codelab service
+// GetStudentExerciseStats endpoint payload.
+func NewGetStudentExerciseStatsPayload(exerciseID int64, userID int64, sessionToken string) *codelab.GetStudentExerciseStatsPayload {
+	v := &codelab.GetStudentExerciseStatsPayload{}
+	v.ExerciseID = exerciseID
+	v.UserID = userID
 	v.SessionToken = sessionToken
 
 	return v
 }
 
-// NewDeleteTestPayload builds a codelab service DeleteTest endpoint payload.
-func NewDeleteTestPayload(id int64, sessionToken string) *codelab.DeleteTestPayload {
-	v := &codelab.DeleteTestPayload{}
-	v.ID = id
+// NewGetPlagiarismReportPayload builds a codelab service GetPlagiarismReport
+// endpoint payload.
+func NewGetPlagiarismReportPayload(exerciseID int64, minSimilarity float64, sessionToken string) *codelab.GetPlagiarismReportPayload {
+	v := &codelab.GetPlagiarismReportPayload{}
+	v.ExerciseID = exerciseID
+	v.MinSimilarity = minSimilarity
 	v.SessionToken = sessionToken
 
 	return v
@@ -653,8 +2550,15 @@ func NewGetExerciseForStudentPayload(id int64, sessionToken string) *codelab.Get
 
 // NewListExercisesForStudentsPayload builds a codelab service
 // ListExercisesForStudents endpoint payload.
-func NewListExercisesForStudentsPayload(sessionToken string) *codelab.ListExercisesForStudentsPayload {
+func NewListExercisesForStudentsPayload(search *string, difficulty *string, tags []string, createdBy *int64, cursor *string, limit int, completed *bool, sessionToken string) *codelab.ListExercisesForStudentsPayload {
 	v := &codelab.ListExercisesForStudentsPayload{}
+	v.Search = search
+	v.Difficulty = difficulty
+	v.Tags = tags
+	v.CreatedBy = createdBy
+	v.Cursor = cursor
+	v.Limit = limit
+	v.Completed = completed
 	v.SessionToken = sessionToken
 
 	return v
@@ -673,6 +2577,52 @@ func NewCreateAttemptPayload(body *CreateAttemptRequestBody, sessionToken string
 	return v
 }
 
+// NewSubmitAttemptCreateAttemptPayload builds a codelab service SubmitAttempt
+// endpoint payload.
+func NewSubmitAttemptCreateAttemptPayload(body *SubmitAttemptRequestBody, sessionToken string) *codelab.CreateAttemptPayload {
+	v := &codelab.CreateAttemptPayload{
+		ExerciseID: *body.ExerciseID,
+		Code:       *body.Code,
+		Success:    *body.Success,
+	}
+	v.SessionToken = sessionToken
+
+	return v
+}
+
+// NewStreamAttemptEventsPayload builds a codelab service StreamAttemptEvents
+// endpoint payload.
+func NewStreamAttemptEventsPayload(id int64, sessionToken string) *codelab.StreamAttemptEventsPayload {
+	v := &codelab.StreamAttemptEventsPayload{}
+	v.ID = id
+	v.SessionToken = sessionToken
+
+	return v
+}
+
+// NewRunCodePayload builds a codelab service RunCode endpoint payload.
+func NewRunCodePayload(body *RunCodeRequestBody, exerciseID int64, sessionToken string) *codelab.RunCodePayload {
+	v := &codelab.RunCodePayload{
+		Code: *body.Code,
+	}
+	if body.InputFormat != nil {
+		v.InputFormat = *body.InputFormat
+	}
+	if body.Inputs != nil {
+		v.Inputs = make([]string, len(body.Inputs))
+		for i, val := range body.Inputs {
+			v.Inputs[i] = val
+		}
+	}
+	if body.InputFormat == nil {
+		v.InputFormat = "text"
+	}
+	v.ExerciseID = exerciseID
+	v.SessionToken = sessionToken
+
+	return v
+}
+
 // NewGetAttemptsByUserAndExercisePayload builds a codelab service
 // GetAttemptsByUserAndExercise endpoint payload.
 func NewGetAttemptsByUserAndExercisePayload(userID int64, exerciseID int64, sessionToken string) *codelab.GetAttemptsByUserAndExercisePayload {
@@ -684,6 +2634,44 @@ func NewGetAttemptsByUserAndExercisePayload(userID int64, exerciseID int64, sess
 	return v
 }
 
+// NewGetHintsForStudentPayload builds a codelab service GetHintsForStudent
+// endpoint payload.
+func NewGetHintsForStudentPayload(exerciseID int64, sessionToken string) *codelab.GetHintsForStudentPayload {
+	v := &codelab.GetHintsForStudentPayload{}
+	v.ExerciseID = exerciseID
+	v.SessionToken = sessionToken
+
+	return v
+}
+
+// NewRequestHintPayload builds a codelab service RequestHint endpoint payload.
+func NewRequestHintPayload(exerciseID int64, sessionToken string) *codelab.RequestHintPayload {
+	v := &codelab.RequestHintPayload{}
+	v.ExerciseID = exerciseID
+	v.SessionToken = sessionToken
+
+	return v
+}
+
+// NewListAssignmentsForStudentsPayload builds a codelab service
+// ListAssignmentsForStudents endpoint payload.
+func NewListAssignmentsForStudentsPayload(sessionToken string) *codelab.ListAssignmentsForStudentsPayload {
+	v := &codelab.ListAssignmentsForStudentsPayload{}
+	v.SessionToken = sessionToken
+
+	return v
+}
+
+// NewGetAssignmentProgressPayload builds a codelab service
+// GetAssignmentProgress endpoint payload.
+func NewGetAssignmentProgressPayload(id int64, sessionToken string) *codelab.GetAssignmentProgressPayload {
+	v := &codelab.GetAssignmentProgressPayload{}
+	v.ID = id
+	v.SessionToken = sessionToken
+
+	return v
+}
+
 // NewGetAnswerByUserAndExercisePayload builds a codelab service
 // GetAnswerByUserAndExercise endpoint payload.
 func NewGetAnswerByUserAndExercisePayload(userID int64, exerciseID int64, sessionToken string) *codelab.GetAnswerByUserAndExercisePayload {
@@ -726,6 +2714,14 @@ func ValidateCreateExerciseRequestBody(body *CreateExerciseRequestBody) (err err
 			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.difficulty", *body.Difficulty, []any{"easy", "medium", "hard"}))
 		}
 	}
+	if body.Language != nil {
+		if !(*body.Language == "javascript" || *body.Language == "starlark") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.language", *body.Language, []any{"javascript", "starlark"}))
+		}
+	}
+	if len(body.Tags) > 10 {
+		err = goa.MergeErrors(err, goa.InvalidLengthError("body.tags", body.Tags, len(body.Tags), 10, false))
+	}
 	return
 }
 
@@ -743,6 +2739,15 @@ func ValidateUpdateExerciseRequestBody(body *UpdateExerciseRequestBody) (err err
 	return
 }
 
+// ValidateImportExercisesRequestBody runs the validations defined on
+// ImportExercisesRequestBody
+func ValidateImportExercisesRequestBody(body *ImportExercisesRequestBody) (err error) {
+	if body.Content == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("content", "body"))
+	}
+	return
+}
+
 // ValidateCreateTestRequestBody runs the validations defined on
 // CreateTestRequestBody
 func ValidateCreateTestRequestBody(body *CreateTestRequestBody) (err error) {
@@ -758,6 +2763,26 @@ func ValidateCreateTestRequestBody(body *CreateTestRequestBody) (err error) {
 	if body.ExerciseID == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("exercise_id", "body"))
 	}
+	if body.InputFormat != nil {
+		if !(*body.InputFormat == "text" || *body.InputFormat == "json") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.input_format", *body.InputFormat, []any{"text", "json"}))
+		}
+	}
+	if body.Comparison != nil {
+		if !(*body.Comparison == "exact" || *body.Comparison == "trimmed" || *body.Comparison == "numeric" || *body.Comparison == "json" || *body.Comparison == "unordered" || *body.Comparison == "regex") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.comparison", *body.Comparison, []any{"exact", "trimmed", "numeric", "json", "unordered", "regex"}))
+		}
+	}
+	if body.Tolerance != nil {
+		if *body.Tolerance < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.tolerance", *body.Tolerance, 0, true))
+		}
+	}
+	if body.Weight != nil {
+		if *body.Weight < 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.weight", *body.Weight, 1, true))
+		}
+	}
 	return
 }
 
@@ -775,6 +2800,102 @@ func ValidateUpdateTestRequestBody(body *UpdateTestRequestBody) (err error) {
 	return
 }
 
+// ValidateCreateHintRequestBody runs the validations defined on
+// CreateHintRequestBody
+func ValidateCreateHintRequestBody(body *CreateHintRequestBody) (err error) {
+	if body.ExerciseID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("exercise_id", "body"))
+	}
+	if body.Content == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("content", "body"))
+	}
+	if body.Content != nil {
+		if utf8.RuneCountInString(*body.Content) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.content", *body.Content, utf8.RuneCountInString(*body.Content), 1, true))
+		}
+	}
+	if body.UnlockAfterAttempts != nil {
+		if *body.UnlockAfterAttempts < 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.unlock_after_attempts", *body.UnlockAfterAttempts, 1, true))
+		}
+	}
+	if body.UnlockAfterSeconds != nil {
+		if *body.UnlockAfterSeconds < 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.unlock_after_seconds", *body.UnlockAfterSeconds, 1, true))
+		}
+	}
+	if body.Penalty != nil {
+		if *body.Penalty < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.penalty", *body.Penalty, 0, true))
+		}
+	}
+	if body.Penalty != nil {
+		if *body.Penalty > 100 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.penalty", *body.Penalty, 100, false))
+		}
+	}
+	return
+}
+
+// ValidateUpdateHintRequestBody runs the validations defined on
+// UpdateHintRequestBody
+func ValidateUpdateHintRequestBody(body *UpdateHintRequestBody) (err error) {
+	if body.Hint == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("hint", "body"))
+	}
+	if body.Hint != nil {
+		if err2 := ValidateUpdateHintPayloadRequestBody(body.Hint); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	return
+}
+
+// ValidateCreateAssignmentRequestBody runs the validations defined on
+// CreateAssignmentRequestBody
+func ValidateCreateAssignmentRequestBody(body *CreateAssignmentRequestBody) (err error) {
+	if body.Title == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("title", "body"))
+	}
+	if body.OpensAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("opens_at", "body"))
+	}
+	if body.ClosesAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("closes_at", "body"))
+	}
+	if body.ExerciseIds == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("exercise_ids", "body"))
+	}
+	if body.Title != nil {
+		if utf8.RuneCountInString(*body.Title) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.title", *body.Title, utf8.RuneCountInString(*body.Title), 1, true))
+		}
+	}
+	if body.Title != nil {
+		if utf8.RuneCountInString(*body.Title) > 200 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.title", *body.Title, utf8.RuneCountInString(*body.Title), 200, false))
+		}
+	}
+	if len(body.ExerciseIds) < 1 {
+		err = goa.MergeErrors(err, goa.InvalidLengthError("body.exercise_ids", body.ExerciseIds, len(body.ExerciseIds), 1, true))
+	}
+	return
+}
+
+// ValidateUpdateAssignmentRequestBody runs the validations defined on
+// UpdateAssignmentRequestBody
+func ValidateUpdateAssignmentRequestBody(body *UpdateAssignmentRequestBody) (err error) {
+	if body.Assignment == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("assignment", "body"))
+	}
+	if body.Assignment != nil {
+		if err2 := ValidateUpdateAssignmentPayloadRequestBody(body.Assignment); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	return
+}
+
 // ValidateCreateAttemptRequestBody runs the validations defined on
 // CreateAttemptRequestBody
 func ValidateCreateAttemptRequestBody(body *CreateAttemptRequestBody) (err error) {
@@ -790,6 +2911,37 @@ func ValidateCreateAttemptRequestBody(body *CreateAttemptRequestBody) (err error
 	return
 }
 
+// ValidateSubmitAttemptRequestBody runs the validations defined on
+// SubmitAttemptRequestBody
+func ValidateSubmitAttemptRequestBody(body *SubmitAttemptRequestBody) (err error) {
+	if body.ExerciseID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("exercise_id", "body"))
+	}
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
+	}
+	if body.Success == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("success", "body"))
+	}
+	return
+}
+
+// ValidateRunCodeRequestBody runs the validations defined on RunCodeRequestBody
+func ValidateRunCodeRequestBody(body *RunCodeRequestBody) (err error) {
+	if body.Code == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("code", "body"))
+	}
+	if len(body.Inputs) > 20 {
+		err = goa.MergeErrors(err, goa.InvalidLengthError("body.inputs", body.Inputs, len(body.Inputs), 20, false))
+	}
+	if body.InputFormat != nil {
+		if !(*body.InputFormat == "text" || *body.InputFormat == "json") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.input_format", *body.InputFormat, []any{"text", "json"}))
+		}
+	}
+	return
+}
+
 // ValidateUpdateExercisePayloadRequestBody runs the validations defined on
 // UpdateExercisePayloadRequestBody
 func ValidateUpdateExercisePayloadRequestBody(body *UpdateExercisePayloadRequestBody) (err error) {
@@ -818,6 +2970,14 @@ func ValidateUpdateExercisePayloadRequestBody(body *UpdateExercisePayloadRequest
 			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.difficulty", *body.Difficulty, []any{"easy", "medium", "hard"}))
 		}
 	}
+	if body.Language != nil {
+		if !(*body.Language == "javascript" || *body.Language == "starlark") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.language", *body.Language, []any{"javascript", "starlark"}))
+		}
+	}
+	if len(body.Tags) > 10 {
+		err = goa.MergeErrors(err, goa.InvalidLengthError("body.tags", body.Tags, len(body.Tags), 10, false))
+	}
 	return
 }
 
@@ -833,5 +2993,90 @@ func ValidateUpdateTestPayloadRequestBody(body *UpdateTestPayloadRequestBody) (e
 	if body.Public == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("public", "body"))
 	}
+	if body.InputFormat != nil {
+		if !(*body.InputFormat == "text" || *body.InputFormat == "json") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.input_format", *body.InputFormat, []any{"text", "json"}))
+		}
+	}
+	if body.Comparison != nil {
+		if !(*body.Comparison == "exact" || *body.Comparison == "trimmed" || *body.Comparison == "numeric" || *body.Comparison == "json" || *body.Comparison == "unordered" || *body.Comparison == "regex") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.comparison", *body.Comparison, []any{"exact", "trimmed", "numeric", "json", "unordered", "regex"}))
+		}
+	}
+	if body.Tolerance != nil {
+		if *body.Tolerance < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.tolerance", *body.Tolerance, 0, true))
+		}
+	}
+	if body.Weight != nil {
+		if *body.Weight < 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.weight", *body.Weight, 1, true))
+		}
+	}
+	return
+}
+
+// ValidateUpdateHintPayloadRequestBody runs the validations defined on
+// UpdateHintPayloadRequestBody
+func ValidateUpdateHintPayloadRequestBody(body *UpdateHintPayloadRequestBody) (err error) {
+	if body.Content == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("content", "body"))
+	}
+	if body.Content != nil {
+		if utf8.RuneCountInString(*body.Content) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.content", *body.Content, utf8.RuneCountInString(*body.Content), 1, true))
+		}
+	}
+	if body.UnlockAfterAttempts != nil {
+		if *body.UnlockAfterAttempts < 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.unlock_after_attempts", *body.UnlockAfterAttempts, 1, true))
+		}
+	}
+	if body.UnlockAfterSeconds != nil {
+		if *body.UnlockAfterSeconds < 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.unlock_after_seconds", *body.UnlockAfterSeconds, 1, true))
+		}
+	}
+	if body.Penalty != nil {
+		if *body.Penalty < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.penalty", *body.Penalty, 0, true))
+		}
+	}
+	if body.Penalty != nil {
+		if *body.Penalty > 100 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.penalty", *body.Penalty, 100, false))
+		}
+	}
+	return
+}
+
+// ValidateUpdateAssignmentPayloadRequestBody runs the validations defined on
+// UpdateAssignmentPayloadRequestBody
+func ValidateUpdateAssignmentPayloadRequestBody(body *UpdateAssignmentPayloadRequestBody) (err error) {
+	if body.Title == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("title", "body"))
+	}
+	if body.OpensAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("opens_at", "body"))
+	}
+	if body.ClosesAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("closes_at", "body"))
+	}
+	if body.ExerciseIds == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("exercise_ids", "body"))
+	}
+	if body.Title != nil {
+		if utf8.RuneCountInString(*body.Title) < 1 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.title", *body.Title, utf8.RuneCountInString(*body.Title), 1, true))
+		}
+	}
+	if body.Title != nil {
+		if utf8.RuneCountInString(*body.Title) > 200 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.title", *body.Title, utf8.RuneCountInString(*body.Title), 200, false))
+		}
+	}
+	if len(body.ExerciseIds) < 1 {
+		err = goa.MergeErrors(err, goa.InvalidLengthError("body.exercise_ids", body.ExerciseIds, len(body.ExerciseIds), 1, true))
+	}
 	return
 }
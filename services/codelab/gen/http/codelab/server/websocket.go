@@ -0,0 +1,102 @@
+// Code generated by goa v3.21.1, DO NOT EDIT.
+//
+// codelab WebSocket server streaming
+//
+// Command:
+// $ goa gen
+// github.com/ynoacamino/infra-sustainable-classrooms/services/codelab/design/api
+// -o ./services/codelab/
+
+package server
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+	codelab "github.com/ynoacamino/infra-sustainable-classrooms/services/codelab/gen/codelab"
+	goahttp "goa.design/goa/v3/http"
+)
+
+// ConnConfigurer holds the websocket connection configurer functions for the
+// streaming endpoints in "codelab" service.
+type ConnConfigurer struct {
+	StreamAttemptEventsFn goahttp.ConnConfigureFunc
+}
+
+// StreamAttemptEventsServerStream implements the
+// codelab.StreamAttemptEventsServerStream interface.
+type StreamAttemptEventsServerStream struct {
+	once sync.Once
+	// upgrader is the websocket connection upgrader.
+	upgrader goahttp.Upgrader
+	// configurer is the websocket connection configurer.
+	configurer goahttp.ConnConfigureFunc
+	// cancel is the context cancellation function which cancels the request
+	// context when invoked.
+	cancel context.CancelFunc
+	// w is the HTTP response writer used in upgrading the connection.
+	w http.ResponseWriter
+	// r is the HTTP request.
+	r *http.Request
+	// conn is the underlying websocket connection.
+	conn *websocket.Conn
+}
+
+// NewConnConfigurer initializes the websocket connection configurer function
+// with fn for all the streaming endpoints in "codelab" service.
+func NewConnConfigurer(fn goahttp.ConnConfigureFunc) *ConnConfigurer {
+	return &ConnConfigurer{
+		StreamAttemptEventsFn: fn,
+	}
+}
+
+// Send streams instances of "codelab.AttemptEvent" to the
+// "StreamAttemptEvents" endpoint websocket connection.
+func (s *StreamAttemptEventsServerStream) Send(v *codelab.AttemptEvent) error {
+	var err error
+	// Upgrade the HTTP connection to a websocket connection only once. Connection
+	// upgrade is done here so that authorization logic in the endpoint is executed
+	// before calling the actual service method which may call Send().
+	s.once.Do(func() {
+		var conn *websocket.Conn
+		conn, err = s.upgrader.Upgrade(s.w, s.r, nil)
+		if err != nil {
+			return
+		}
+		if s.configurer != nil {
+			conn = s.configurer(conn, s.cancel)
+		}
+		s.conn = conn
+	})
+	if err != nil {
+		return err
+	}
+	res := v
+	body := NewStreamAttemptEventsResponseBody(res)
+	return s.conn.WriteJSON(body)
+}
+
+// SendWithContext streams instances of "codelab.AttemptEvent" to the
+// "StreamAttemptEvents" endpoint websocket connection with context.
+func (s *StreamAttemptEventsServerStream) SendWithContext(ctx context.Context, v *codelab.AttemptEvent) error {
+	return s.Send(v)
+}
+
+// Close closes the "StreamAttemptEvents" endpoint websocket connection.
+func (s *StreamAttemptEventsServerStream) Close() error {
+	var err error
+	if s.conn == nil {
+		return nil
+	}
+	if err = s.conn.WriteControl(
+		websocket.CloseMessage,
+		websocket.FormatCloseMessage(websocket.CloseNormalClosure, "server closing connection"),
+		time.Now().Add(time.Second),
+	); err != nil {
+		return err
+	}
+	return s.conn.Close()
+}
@@ -0,0 +1,280 @@
+package bundle
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"path"
+	"slices"
+	"time"
+)
+
+// ManifestFile is the name of the manifest inside an archive
+const ManifestFile = "bundle.json"
+
+// maxFiles bounds the number of files read from an archive
+const maxFiles = 1000
+
+// Encode serializes exercises into a bundle of the given format
+func Encode(exercises []Exercise, exportedAt time.Time, format Format) ([]byte, error) {
+	b := Bundle{
+		Version:    Version,
+		ExportedAt: exportedAt.UTC(),
+		Exercises:  exercises,
+	}
+
+	switch format {
+	case FormatJSON:
+		return json.MarshalIndent(b, "", "  ")
+	case FormatZip, FormatTarGz:
+		files, err := b.files()
+		if err != nil {
+			return nil, err
+		}
+		if format == FormatZip {
+			return writeZip(files, exportedAt)
+		}
+		return writeTarGz(files, exportedAt)
+	default:
+		return nil, fmt.Errorf("%w %q", ErrUnsupportedFormat, format)
+	}
+}
+
+// Decode reads a bundle in any format, telling them apart by their content,
+// and checks it with Validate
+func Decode(data []byte) (*Bundle, error) {
+	if len(data) > MaxSize {
+		return nil, ErrTooLarge
+	}
+
+	var (
+		b   *Bundle
+		err error
+	)
+	switch {
+	case bytes.HasPrefix(data, []byte("PK\x03\x04")):
+		b, err = readArchive(readZip(data))
+	case bytes.HasPrefix(data, []byte{0x1f, 0x8b}):
+		b, err = readArchive(readTarGz(data))
+	default:
+		b, err = parseManifest(data)
+		if err == nil && hasFiles(b) {
+			err = errors.New("code files can only be referenced from archives")
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	b.setDefaults()
+	if err := b.Validate(); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+// files lays out the manifest and the code of every exercise as archive files
+func (b Bundle) files() (map[string][]byte, error) {
+	files := make(map[string][]byte)
+	exercises := make([]Exercise, len(b.Exercises))
+	for i, exercise := range b.Exercises {
+		dir := fmt.Sprintf("exercises/%03d", i+1)
+		ext := extension(exercise.Language)
+
+		exercise.InitialCodeFile = path.Join(dir, "initial_code"+ext)
+		exercise.SolutionFile = path.Join(dir, "solution"+ext)
+		files[exercise.InitialCodeFile] = []byte(exercise.InitialCode)
+		files[exercise.SolutionFile] = []byte(exercise.Solution)
+		exercise.InitialCode = ""
+		exercise.Solution = ""
+		exercises[i] = exercise
+	}
+	b.Exercises = exercises
+
+	manifest, err := json.MarshalIndent(b, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	files[ManifestFile] = manifest
+	return files, nil
+}
+
+// readArchive builds a bundle from the files of an archive, loading the code
+// its manifest references
+func readArchive(files map[string][]byte, err error) (*Bundle, error) {
+	if err != nil {
+		return nil, err
+	}
+
+	manifest, ok := files[ManifestFile]
+	if !ok {
+		return nil, fmt.Errorf("archive has no %s", ManifestFile)
+	}
+	b, err := parseManifest(manifest)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range b.Exercises {
+		exercise := &b.Exercises[i]
+		if exercise.InitialCodeFile != "" {
+			code, ok := files[path.Clean(exercise.InitialCodeFile)]
+			if !ok {
+				return nil, fmt.Errorf("exercise %d: missing file %s", i+1, exercise.InitialCodeFile)
+			}
+			exercise.InitialCode = string(code)
+		}
+		if exercise.SolutionFile != "" {
+			code, ok := files[path.Clean(exercise.SolutionFile)]
+			if !ok {
+				return nil, fmt.Errorf("exercise %d: missing file %s", i+1, exercise.SolutionFile)
+			}
+			exercise.Solution = string(code)
+		}
+	}
+	return b, nil
+}
+
+func parseManifest(data []byte) (*Bundle, error) {
+	var b Bundle
+	if err := json.Unmarshal(data, &b); err != nil {
+		return nil, fmt.Errorf("invalid bundle manifest: %w", err)
+	}
+	return &b, nil
+}
+
+func hasFiles(b *Bundle) bool {
+	for _, exercise := range b.Exercises {
+		if exercise.InitialCodeFile != "" || exercise.SolutionFile != "" {
+			return true
+		}
+	}
+	return false
+}
+
+func writeZip(files map[string][]byte, modified time.Time) ([]byte, error) {
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	for _, name := range slices.Sorted(maps.Keys(files)) {
+		w, err := zw.CreateHeader(&zip.FileHeader{Name: name, Method: zip.Deflate, Modified: modified})
+		if err != nil {
+			return nil, err
+		}
+		if _, err := w.Write(files[name]); err != nil {
+			return nil, err
+		}
+	}
+	if err := zw.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func writeTarGz(files map[string][]byte, modified time.Time) ([]byte, error) {
+	var buf bytes.Buffer
+	gw := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(gw)
+	for _, name := range slices.Sorted(maps.Keys(files)) {
+		err := tw.WriteHeader(&tar.Header{
+			Name:    name,
+			Mode:    0o644,
+			Size:    int64(len(files[name])),
+			ModTime: modified,
+		})
+		if err != nil {
+			return nil, err
+		}
+		if _, err := tw.Write(files[name]); err != nil {
+			return nil, err
+		}
+	}
+	if err := tw.Close(); err != nil {
+		return nil, err
+	}
+	if err := gw.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func readZip(data []byte) (map[string][]byte, error) {
+	zr, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return nil, fmt.Errorf("invalid zip archive: %w", err)
+	}
+	if len(zr.File) > maxFiles {
+		return nil, fmt.Errorf("archive has more than %d files", maxFiles)
+	}
+
+	files := make(map[string][]byte)
+	var total int64
+	for _, f := range zr.File {
+		if f.FileInfo().IsDir() {
+			continue
+		}
+		rc, err := f.Open()
+		if err != nil {
+			return nil, fmt.Errorf("invalid zip archive: %w", err)
+		}
+		content, err := readLimited(rc, &total)
+		rc.Close()
+		if err != nil {
+			return nil, err
+		}
+		files[path.Clean(f.Name)] = content
+	}
+	return files, nil
+}
+
+func readTarGz(data []byte) (map[string][]byte, error) {
+	gr, err := gzip.NewReader(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("invalid tar.gz archive: %w", err)
+	}
+	defer gr.Close()
+
+	files := make(map[string][]byte)
+	var total int64
+	tr := tar.NewReader(gr)
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("invalid tar.gz archive: %w", err)
+		}
+		if header.Typeflag != tar.TypeReg {
+			continue
+		}
+		if len(files) == maxFiles {
+			return nil, fmt.Errorf("archive has more than %d files", maxFiles)
+		}
+		content, err := readLimited(tr, &total)
+		if err != nil {
+			return nil, err
+		}
+		files[path.Clean(header.Name)] = content
+	}
+	return files, nil
+}
+
+// readLimited reads a file of an archive, failing once the files read so
+// far, counted in total, exceed MaxSize
+func readLimited(r io.Reader, total *int64) ([]byte, error) {
+	content, err := io.ReadAll(io.LimitReader(r, MaxSize-*total+1))
+	if err != nil {
+		return nil, fmt.Errorf("invalid archive: %w", err)
+	}
+	*total += int64(len(content))
+	if *total > MaxSize {
+		return nil, ErrTooLarge
+	}
+	return content, nil
+}
@@ -0,0 +1,148 @@
+// Package bundle reads and writes exercise bundles, the versioned format used
+// to move exercises with their tests and solutions between codelab instances.
+// A bundle is either a single JSON document or a zip or gzipped tar archive
+// holding a bundle.json manifest and the code of every exercise as files.
+package bundle
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+)
+
+// Version is the bundle format version written by Encode. Decode rejects
+// bundles written with a newer version.
+const Version = 1
+
+// MaxSize bounds the size of a bundle, and of the files it holds once
+// decompressed
+const MaxSize = 10 << 20
+
+// Format is how a bundle is serialized
+type Format string
+
+const (
+	FormatJSON  Format = "json"
+	FormatZip   Format = "zip"
+	FormatTarGz Format = "tar.gz"
+)
+
+var (
+	// ErrUnsupportedFormat is returned when encoding to an unknown format
+	ErrUnsupportedFormat = errors.New("unsupported bundle format")
+	// ErrTooLarge is returned when a bundle exceeds MaxSize
+	ErrTooLarge = fmt.Errorf("bundle is larger than %d bytes", MaxSize)
+)
+
+// Bundle is a set of exercises with their tests
+type Bundle struct {
+	Version    int        `json:"version"`
+	ExportedAt time.Time  `json:"exported_at"`
+	Exercises  []Exercise `json:"exercises"`
+}
+
+// Exercise is an exercise and its tests. In archives the code is stored in
+// the files named by InitialCodeFile and SolutionFile instead.
+type Exercise struct {
+	Title           string   `json:"title"`
+	Description     string   `json:"description"`
+	Difficulty      string   `json:"difficulty"`
+	Language        string   `json:"language"`
+	Tags            []string `json:"tags,omitempty"`
+	InitialCode     string   `json:"initial_code,omitempty"`
+	InitialCodeFile string   `json:"initial_code_file,omitempty"`
+	Solution        string   `json:"solution,omitempty"`
+	SolutionFile    string   `json:"solution_file,omitempty"`
+	Tests           []Test   `json:"tests"`
+}
+
+// Test is a test of an exercise
+type Test struct {
+	Input       string  `json:"input"`
+	Output      string  `json:"output"`
+	Public      bool    `json:"public"`
+	Weight      int32   `json:"weight"`
+	InputFormat string  `json:"input_format"`
+	Comparison  string  `json:"comparison"`
+	Tolerance   float64 `json:"tolerance"`
+}
+
+var (
+	difficulties = []string{"easy", "medium", "hard"}
+	languages    = []string{"javascript", "starlark"}
+)
+
+// Validate checks the fields every exercise needs. Whether the tests are
+// well formed and the solutions pass them is left to the caller.
+func (b *Bundle) Validate() error {
+	if b.Version < 1 || b.Version > Version {
+		return fmt.Errorf("unsupported bundle version %d, expected 1 to %d", b.Version, Version)
+	}
+	if len(b.Exercises) == 0 {
+		return errors.New("bundle has no exercises")
+	}
+
+	for i, exercise := range b.Exercises {
+		if err := exercise.validate(); err != nil {
+			return fmt.Errorf("exercise %d: %w", i+1, err)
+		}
+	}
+	return nil
+}
+
+func (e *Exercise) validate() error {
+	switch {
+	case e.Title == "":
+		return errors.New("title is required")
+	case len(e.Title) > 200:
+		return errors.New("title must be at most 200 characters")
+	case e.Description == "":
+		return errors.New("description is required")
+	case e.Solution == "":
+		return errors.New("solution is required")
+	case !slices.Contains(difficulties, e.Difficulty):
+		return fmt.Errorf("unknown difficulty %q", e.Difficulty)
+	case !slices.Contains(languages, e.Language):
+		return fmt.Errorf("unknown language %q", e.Language)
+	}
+
+	for i, test := range e.Tests {
+		if test.Weight < 1 {
+			return fmt.Errorf("test %d: weight must be at least 1", i+1)
+		}
+	}
+	return nil
+}
+
+// setDefaults fills the optional fields left out of a bundle
+func (b *Bundle) setDefaults() {
+	for i := range b.Exercises {
+		exercise := &b.Exercises[i]
+		if exercise.Language == "" {
+			exercise.Language = "javascript"
+		}
+		for j := range exercise.Tests {
+			test := &exercise.Tests[j]
+			if test.Weight == 0 {
+				test.Weight = 1
+			}
+			if test.InputFormat == "" {
+				test.InputFormat = "text"
+			}
+			if test.Comparison == "" {
+				test.Comparison = "exact"
+			}
+		}
+	}
+}
+
+// extension returns the file extension of code written in language
+func extension(language string) string {
+	switch language {
+	case "starlark":
+		return ".star"
+	default:
+		return ".js"
+	}
+}
@@ -0,0 +1,191 @@
+package bundle
+
+import (
+	"archive/zip"
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func testExercises() []Exercise {
+	return []Exercise{
+		{
+			Title:       "Sum Two Numbers",
+			Description: "Return the sum of two numbers",
+			Difficulty:  "easy",
+			Language:    "javascript",
+			Tags:        []string{"arithmetic"},
+			InitialCode: "function solution(input) {\n  // write your code here\n}",
+			Solution:    "function solution(input) {\n  const [a, b] = input.split(',').map(Number);\n  return a + b;\n}",
+			Tests: []Test{
+				{Input: "5,3", Output: "8", Public: true, Weight: 1, InputFormat: "text", Comparison: "exact"},
+				{Input: "[1, 2]", Output: "3", Weight: 2, InputFormat: "json", Comparison: "numeric", Tolerance: 0.1},
+			},
+		},
+		{
+			Title:       "Double",
+			Description: "Double a number",
+			Difficulty:  "medium",
+			Language:    "starlark",
+			InitialCode: "def solution(n):\n    pass",
+			Solution:    "def solution(n):\n    return int(n) * 2",
+			Tests:       []Test{},
+		},
+	}
+}
+
+func TestEncodeDecode(t *testing.T) {
+	exportedAt := time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC)
+
+	for _, format := range []Format{FormatJSON, FormatZip, FormatTarGz} {
+		t.Run(string(format), func(t *testing.T) {
+			data, err := Encode(testExercises(), exportedAt, format)
+			if !assert.NoError(t, err) {
+				return
+			}
+
+			b, err := Decode(data)
+			if !assert.NoError(t, err) {
+				return
+			}
+			assert.Equal(t, Version, b.Version)
+			assert.True(t, exportedAt.Equal(b.ExportedAt))
+			if assert.Len(t, b.Exercises, 2) {
+				for i, expected := range testExercises() {
+					actual := b.Exercises[i]
+					assert.Equal(t, expected.Title, actual.Title)
+					assert.Equal(t, expected.Tags, actual.Tags)
+					assert.Equal(t, expected.InitialCode, actual.InitialCode)
+					assert.Equal(t, expected.Solution, actual.Solution)
+					assert.Equal(t, expected.Tests, actual.Tests)
+				}
+			}
+		})
+	}
+}
+
+func TestEncode_ArchiveLayout(t *testing.T) {
+	data, err := Encode(testExercises(), time.Now(), FormatZip)
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	zr, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if !assert.NoError(t, err) {
+		return
+	}
+	var names []string
+	for _, f := range zr.File {
+		names = append(names, f.Name)
+	}
+	assert.Equal(t, []string{
+		"bundle.json",
+		"exercises/001/initial_code.js",
+		"exercises/001/solution.js",
+		"exercises/002/initial_code.star",
+		"exercises/002/solution.star",
+	}, names)
+}
+
+func TestDecode_Defaults(t *testing.T) {
+	data := []byte(`{
+		"version": 1,
+		"exercises": [{
+			"title": "Echo",
+			"description": "Return the input",
+			"difficulty": "easy",
+			"solution": "function solution(input) { return input; }",
+			"tests": [{"input": "a", "output": "a"}]
+		}]
+	}`)
+
+	b, err := Decode(data)
+
+	if assert.NoError(t, err) {
+		exercise := b.Exercises[0]
+		assert.Equal(t, "javascript", exercise.Language)
+		assert.Equal(t, Test{Input: "a", Output: "a", Weight: 1, InputFormat: "text", Comparison: "exact"}, exercise.Tests[0])
+	}
+}
+
+func TestDecode_Invalid(t *testing.T) {
+	tests := []struct {
+		name          string
+		data          []byte
+		expectedError string
+	}{
+		{
+			name:          "not json",
+			data:          []byte("exercises"),
+			expectedError: "invalid bundle manifest",
+		},
+		{
+			name:          "newer version",
+			data:          []byte(`{"version": 2, "exercises": []}`),
+			expectedError: "unsupported bundle version 2",
+		},
+		{
+			name:          "missing version",
+			data:          []byte(`{"exercises": []}`),
+			expectedError: "unsupported bundle version 0",
+		},
+		{
+			name:          "no exercises",
+			data:          []byte(`{"version": 1, "exercises": []}`),
+			expectedError: "bundle has no exercises",
+		},
+		{
+			name:          "missing solution",
+			data:          []byte(`{"version": 1, "exercises": [{"title": "A", "description": "B", "difficulty": "easy"}]}`),
+			expectedError: "exercise 1: solution is required",
+		},
+		{
+			name:          "unknown difficulty",
+			data:          []byte(`{"version": 1, "exercises": [{"title": "A", "description": "B", "difficulty": "trivial", "solution": "x"}]}`),
+			expectedError: `exercise 1: unknown difficulty "trivial"`,
+		},
+		{
+			name:          "negative weight",
+			data:          []byte(`{"version": 1, "exercises": [{"title": "A", "description": "B", "difficulty": "easy", "solution": "x", "tests": [{"weight": -1}]}]}`),
+			expectedError: "exercise 1: test 1: weight must be at least 1",
+		},
+		{
+			name:          "file reference outside an archive",
+			data:          []byte(`{"version": 1, "exercises": [{"title": "A", "description": "B", "difficulty": "easy", "solution_file": "solution.js"}]}`),
+			expectedError: "code files can only be referenced from archives",
+		},
+		{
+			name:          "too large",
+			data:          make([]byte, MaxSize+1),
+			expectedError: "bundle is larger than",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b, err := Decode(tt.data)
+
+			assert.Nil(t, b)
+			if assert.Error(t, err) {
+				assert.Contains(t, err.Error(), tt.expectedError)
+			}
+		})
+	}
+}
+
+func TestDecode_ArchiveMissingFile(t *testing.T) {
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	w, _ := zw.Create(ManifestFile)
+	w.Write([]byte(`{"version": 1, "exercises": [{"title": "A", "description": "B", "difficulty": "easy", "solution_file": "exercises/001/solution.js"}]}`))
+	zw.Close()
+
+	b, err := Decode(buf.Bytes())
+
+	assert.Nil(t, b)
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "exercise 1: missing file exercises/001/solution.js")
+	}
+}
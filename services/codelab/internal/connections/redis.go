@@ -0,0 +1,31 @@
+package connections
+
+import (
+	"github.com/redis/go-redis/v9"
+	"github.com/ynoacamino/infra-sustainable-classrooms/services/codelab/config"
+)
+
+type ConnectRedisConfig interface {
+	GetRedisConfig() *config.RedisConfig
+}
+
+// ConnectRedis creates a client and checks that Redis answers. The client is
+// returned along with the error when it does not, as it keeps reconnecting on
+// its own.
+func ConnectRedis(cfg ConnectRedisConfig) (*redis.Client, error) {
+	redisConfig := cfg.GetRedisConfig()
+
+	// TODO should we add security to this?
+	client := redis.NewClient(&redis.Options{
+		Addr:     redisConfig.Endpoint,
+		Password: "",
+		DB:       0,
+	})
+
+	_, err := client.Ping(redisConfig.Ctx).Result()
+	if err != nil {
+		return client, err
+	}
+
+	return client, nil
+}
@@ -2,12 +2,21 @@ package controllers
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
 
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/ynoacamino/infra-sustainable-classrooms/services/codelab/gen/codelab"
 	codelabdb "github.com/ynoacamino/infra-sustainable-classrooms/services/codelab/gen/database"
+	"github.com/ynoacamino/infra-sustainable-classrooms/services/codelab/internal/bundle"
+	"github.com/ynoacamino/infra-sustainable-classrooms/services/codelab/internal/grading"
+	"github.com/ynoacamino/infra-sustainable-classrooms/services/codelab/internal/ratelimit"
+	"github.com/ynoacamino/infra-sustainable-classrooms/services/codelab/internal/sandbox"
+	"github.com/ynoacamino/infra-sustainable-classrooms/services/codelab/internal/versioning"
 	"github.com/ynoacamino/infra-sustainable-classrooms/services/profiles/gen/profiles"
-
-	"github.com/dop251/goja"
 )
 
 func (s *codelabsvrc) CreateExercise(ctx context.Context, payload *codelab.CreateExercisePayload) (res *codelab.SimpleResponse, err error) {
@@ -22,13 +31,38 @@ func (s *codelabsvrc) CreateExercise(ctx context.Context, payload *codelab.Creat
 		return nil, codelab.PermissionDenied("Only teachers can create exercises")
 	}
 
-	err = s.exercisesRepo.CreateExercise(ctx, codelabdb.CreateExerciseParams{
-		Title:       payload.Title,
-		Description: payload.Description,
-		InitialCode: payload.InitialCode,
-		Solution:    payload.Solution,
-		Difficulty:  payload.Difficulty,
-		CreatedBy:   profile.UserID,
+	tags, err := normalizeTags(payload.Tags)
+	if err != nil {
+		return nil, codelab.InvalidInput("Invalid tags: " + err.Error())
+	}
+
+	// A new exercise has no tests yet, but its solution must still load
+	if err := s.validateSolution(ctx, "Solution", payload.Language, payload.Solution, nil); err != nil {
+		return nil, err
+	}
+
+	// The exercise starts at version 1, with no tests yet
+	err = s.txManager.WithTransaction(ctx, func(tx pgx.Tx) error {
+		txRepos := s.withTransaction(tx)
+		id, err := txRepos.ExercisesRepo.CreateExercise(ctx, codelabdb.CreateExerciseParams{
+			Title:       payload.Title,
+			Description: payload.Description,
+			InitialCode: payload.InitialCode,
+			Solution:    payload.Solution,
+			Difficulty:  payload.Difficulty,
+			Language:    payload.Language,
+			Tags:        tags,
+			CreatedBy:   profile.UserID,
+		})
+		if err != nil {
+			return err
+		}
+
+		exercise, err := txRepos.ExercisesRepo.GetExerciseById(ctx, id)
+		if err != nil {
+			return err
+		}
+		return saveExerciseVersion(ctx, txRepos, exercise, profile.UserID)
 	})
 	if err != nil {
 		return nil, codelab.InternalError("Failed to create exercise: " + err.Error())
@@ -64,13 +98,16 @@ func (s *codelabsvrc) GetExercise(ctx context.Context, payload *codelab.GetExerc
 		InitialCode: exercise.InitialCode,
 		Solution:    exercise.Solution,
 		Difficulty:  exercise.Difficulty,
+		Language:    exercise.Language,
+		Tags:        exerciseTags(exercise.Tags),
+		Version:     exercise.Version,
 		CreatedBy:   exercise.CreatedBy,
 		CreatedAt:   exercise.CreatedAt.Time.UnixMilli(),
 		UpdatedAt:   exercise.UpdatedAt.Time.UnixMilli(),
 	}, nil
 }
 
-func (s *codelabsvrc) ListExercises(ctx context.Context, payload *codelab.ListExercisesPayload) (res []*codelab.Exercise, err error) {
+func (s *codelabsvrc) ListExercises(ctx context.Context, payload *codelab.ListExercisesPayload) (res *codelab.ExercisePage, err error) {
 	profile, err := s.profilesServiceRepo.GetCompleteProfile(context.Background(), &profiles.GetCompleteProfilePayload{
 		SessionToken: payload.SessionToken,
 	})
@@ -82,26 +119,73 @@ func (s *codelabsvrc) ListExercises(ctx context.Context, payload *codelab.ListEx
 		return nil, codelab.PermissionDenied("Only teachers can view exercises with solutions")
 	}
 
-	exercises, err := s.exercisesRepo.ListExercises(ctx)
+	filters, err := newExerciseFilters(payload.Search, payload.Difficulty, payload.Tags, payload.CreatedBy, payload.Cursor, payload.Limit)
+	if err != nil {
+		return nil, err
+	}
+
+	exercises, err := s.exercisesRepo.ListExercises(ctx, codelabdb.ListExercisesParams{
+		Search:         filters.search,
+		Difficulty:     filters.difficulty,
+		Tags:           filters.tags,
+		CreatedBy:      filters.createdBy,
+		AfterCreatedAt: filters.afterCreatedAt,
+		AfterID:        filters.afterID,
+		RowLimit:       filters.rowLimit,
+	})
 	if err != nil {
 		return nil, codelab.InternalError("Failed to list exercises: " + err.Error())
 	}
 
-	result := make([]*codelab.Exercise, len(exercises))
+	page := &codelab.ExercisePage{}
+	if len(exercises) > payload.Limit {
+		exercises = exercises[:payload.Limit]
+		last := exercises[len(exercises)-1]
+		page.NextCursor = ptr(encodeCursor(last.CreatedAt.Time, last.ID))
+	}
+
+	page.Exercises = make([]*codelab.Exercise, len(exercises))
 	for i, exercise := range exercises {
-		result[i] = &codelab.Exercise{
+		page.Exercises[i] = &codelab.Exercise{
 			ID:          exercise.ID,
 			Title:       exercise.Title,
 			Description: exercise.Description,
 			InitialCode: exercise.InitialCode,
 			Solution:    exercise.Solution,
 			Difficulty:  exercise.Difficulty,
+			Language:    exercise.Language,
+			Tags:        exerciseTags(exercise.Tags),
+			Version:     exercise.Version,
 			CreatedBy:   exercise.CreatedBy,
 			CreatedAt:   exercise.CreatedAt.Time.UnixMilli(),
 			UpdatedAt:   exercise.UpdatedAt.Time.UnixMilli(),
 		}
 	}
 
+	return page, nil
+}
+
+func (s *codelabsvrc) ListTags(ctx context.Context, payload *codelab.ListTagsPayload) (res []*codelab.TagCount, err error) {
+	profile, err := s.profilesServiceRepo.GetCompleteProfile(context.Background(), &profiles.GetCompleteProfilePayload{
+		SessionToken: payload.SessionToken,
+	})
+	if err != nil || profile == nil {
+		return nil, codelab.Unauthorized("Unauthorized access")
+	}
+
+	tags, err := s.exercisesRepo.ListTags(ctx)
+	if err != nil {
+		return nil, codelab.InternalError("Failed to list tags: " + err.Error())
+	}
+
+	result := make([]*codelab.TagCount, len(tags))
+	for i, tag := range tags {
+		result[i] = &codelab.TagCount{
+			Tag:       tag.Tag,
+			Exercises: tag.Exercises,
+		}
+	}
+
 	return result, nil
 }
 
@@ -118,18 +202,49 @@ func (s *codelabsvrc) UpdateExercise(ctx context.Context, payload *codelab.Updat
 	}
 
 	// Verify the exercise exists
-	_, err = s.exercisesRepo.GetExerciseById(ctx, payload.ID)
+	exercise, err := s.exercisesRepo.GetExerciseById(ctx, payload.ID)
 	if err != nil {
 		return nil, codelab.NotFound("Exercise not found")
 	}
 
-	err = s.exercisesRepo.UpdateExercise(ctx, codelabdb.UpdateExerciseParams{
-		ID:          payload.ID,
-		Title:       payload.Exercise.Title,
-		Description: payload.Exercise.Description,
-		InitialCode: payload.Exercise.InitialCode,
-		Solution:    payload.Exercise.Solution,
-		Difficulty:  payload.Exercise.Difficulty,
+	language := exercise.Language
+	if payload.Exercise.Language != nil {
+		language = *payload.Exercise.Language
+	}
+
+	tags := exercise.Tags
+	if payload.Exercise.Tags != nil {
+		if tags, err = normalizeTags(payload.Exercise.Tags); err != nil {
+			return nil, codelab.InvalidInput("Invalid tags: " + err.Error())
+		}
+	}
+
+	tests, err := s.testsRepo.GetTestsByExercise(ctx, payload.ID)
+	if err != nil {
+		return nil, codelab.InternalError("Failed to get tests: " + err.Error())
+	}
+	if err := s.validateSolution(ctx, "Solution", language, payload.Exercise.Solution, testCases(tests)); err != nil {
+		return nil, err
+	}
+
+	// Answers keep their status, attempts stay pinned to the version they ran
+	// against until the exercise is regraded
+	err = s.txManager.WithTransaction(ctx, func(tx pgx.Tx) error {
+		txRepos := s.withTransaction(tx)
+		err := txRepos.ExercisesRepo.UpdateExercise(ctx, codelabdb.UpdateExerciseParams{
+			ID:          payload.ID,
+			Title:       payload.Exercise.Title,
+			Description: payload.Exercise.Description,
+			InitialCode: payload.Exercise.InitialCode,
+			Solution:    payload.Exercise.Solution,
+			Difficulty:  payload.Exercise.Difficulty,
+			Language:    language,
+			Tags:        exerciseTags(tags),
+		})
+		if err != nil {
+			return err
+		}
+		return newExerciseVersion(ctx, txRepos, payload.ID, profile.UserID)
 	})
 	if err != nil {
 		return nil, codelab.InternalError("Failed to update exercise: " + err.Error())
@@ -170,6 +285,147 @@ func (s *codelabsvrc) DeleteExercise(ctx context.Context, payload *codelab.Delet
 	}, nil
 }
 
+func (s *codelabsvrc) ExportExercises(ctx context.Context, payload *codelab.ExportExercisesPayload) (res *codelab.ExerciseBundleFile, err error) {
+	profile, err := s.profilesServiceRepo.GetCompleteProfile(context.Background(), &profiles.GetCompleteProfilePayload{
+		SessionToken: payload.SessionToken,
+	})
+	if err != nil || profile == nil {
+		return nil, codelab.Unauthorized("Unauthorized access")
+	}
+
+	if profile.Role != "teacher" {
+		return nil, codelab.PermissionDenied("Only teachers can export exercises")
+	}
+
+	exercises := make([]bundle.Exercise, 0, len(payload.ExerciseIds))
+	exported := make(map[int64]bool, len(payload.ExerciseIds))
+	for _, id := range payload.ExerciseIds {
+		if exported[id] {
+			continue
+		}
+		exported[id] = true
+
+		exercise, err := s.exercisesRepo.GetExerciseById(ctx, id)
+		if err != nil {
+			return nil, codelab.NotFound(fmt.Sprintf("Exercise %d not found", id))
+		}
+
+		tests, err := s.testsRepo.GetTestsByExercise(ctx, id)
+		if err != nil {
+			return nil, codelab.InternalError("Failed to get tests: " + err.Error())
+		}
+
+		exercises = append(exercises, bundleExercise(exercise, tests))
+	}
+
+	now := time.Now()
+	content, err := bundle.Encode(exercises, now, bundle.Format(payload.Format))
+	if err != nil {
+		return nil, codelab.InternalError("Failed to encode bundle: " + err.Error())
+	}
+
+	return &codelab.ExerciseBundleFile{
+		Filename: "exercises-" + now.Format("20060102") + "." + payload.Format,
+		Format:   payload.Format,
+		Content:  content,
+	}, nil
+}
+
+func (s *codelabsvrc) ImportExercises(ctx context.Context, payload *codelab.ImportExercisesPayload) (res *codelab.ImportedExercises, err error) {
+	profile, err := s.profilesServiceRepo.GetCompleteProfile(context.Background(), &profiles.GetCompleteProfilePayload{
+		SessionToken: payload.SessionToken,
+	})
+	if err != nil || profile == nil {
+		return nil, codelab.Unauthorized("Unauthorized access")
+	}
+
+	if profile.Role != "teacher" {
+		return nil, codelab.PermissionDenied("Only teachers can import exercises")
+	}
+
+	b, err := bundle.Decode(payload.Content)
+	if err != nil {
+		return nil, codelab.InvalidInput("Invalid bundle: " + err.Error())
+	}
+
+	// Every solution must pass its tests before anything is written
+	tags := make([][]string, len(b.Exercises))
+	for i, exercise := range b.Exercises {
+		name := fmt.Sprintf("Exercise %d (%s)", i+1, exercise.Title)
+
+		if tags[i], err = normalizeTags(exercise.Tags); err != nil {
+			return nil, codelab.InvalidInput(fmt.Sprintf("%s: invalid tags: %s", name, err.Error()))
+		}
+
+		cases := make([]sandbox.TestCase, len(exercise.Tests))
+		for j, test := range exercise.Tests {
+			cases[j] = bundleTestCase(test)
+			if err := cases[j].Validate(); err != nil {
+				return nil, codelab.InvalidInput(fmt.Sprintf("%s: invalid test %d: %s", name, j+1, err.Error()))
+			}
+		}
+
+		if err := s.validateSolution(ctx, name+": solution", exercise.Language, exercise.Solution, cases); err != nil {
+			return nil, err
+		}
+	}
+
+	ids := make([]int64, len(b.Exercises))
+	err = s.txManager.WithTransaction(ctx, func(tx pgx.Tx) error {
+		txRepos := s.withTransaction(tx)
+		for i, exercise := range b.Exercises {
+			id, err := txRepos.ExercisesRepo.CreateExercise(ctx, codelabdb.CreateExerciseParams{
+				Title:       exercise.Title,
+				Description: exercise.Description,
+				InitialCode: exercise.InitialCode,
+				Solution:    exercise.Solution,
+				Difficulty:  exercise.Difficulty,
+				Language:    exercise.Language,
+				Tags:        tags[i],
+				CreatedBy:   profile.UserID,
+			})
+			if err != nil {
+				return fmt.Errorf("failed to create exercise %d: %w", i+1, err)
+			}
+			ids[i] = id
+
+			for j, test := range exercise.Tests {
+				err := txRepos.TestsRepo.CreateTest(ctx, codelabdb.CreateTestParams{
+					Input:       test.Input,
+					Output:      test.Output,
+					Public:      test.Public,
+					Weight:      test.Weight,
+					InputFormat: test.InputFormat,
+					Comparison:  test.Comparison,
+					Tolerance:   test.Tolerance,
+					ExerciseID:  id,
+				})
+				if err != nil {
+					return fmt.Errorf("failed to create test %d of exercise %d: %w", j+1, i+1, err)
+				}
+			}
+
+			created, err := txRepos.ExercisesRepo.GetExerciseById(ctx, id)
+			if err != nil {
+				return fmt.Errorf("failed to get exercise %d: %w", i+1, err)
+			}
+			if err := saveExerciseVersion(ctx, txRepos, created, profile.UserID); err != nil {
+				return fmt.Errorf("exercise %d: %w", i+1, err)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, codelab.InternalError("Failed to import exercises: " + err.Error())
+	}
+
+	return &codelab.ImportedExercises{
+		Success:     true,
+		Message:     fmt.Sprintf("%d exercises imported successfully", len(ids)),
+		ExerciseIds: ids,
+	}, nil
+}
+
 func (s *codelabsvrc) CreateTest(ctx context.Context, payload *codelab.CreateTestPayload) (res *codelab.SimpleResponse, err error) {
 	profile, err := s.profilesServiceRepo.GetCompleteProfile(context.Background(), &profiles.GetCompleteProfilePayload{
 		SessionToken: payload.SessionToken,
@@ -183,16 +439,47 @@ func (s *codelabsvrc) CreateTest(ctx context.Context, payload *codelab.CreateTes
 	}
 
 	// Verify the exercise exists
-	_, err = s.exercisesRepo.GetExerciseById(ctx, payload.ExerciseID)
+	exercise, err := s.exercisesRepo.GetExerciseById(ctx, payload.ExerciseID)
 	if err != nil {
 		return nil, codelab.NotFound("Exercise not found")
 	}
 
-	err = s.testsRepo.CreateTest(ctx, codelabdb.CreateTestParams{
-		Input:      payload.Input,
-		Output:     payload.Output,
-		Public:     payload.Public,
-		ExerciseID: payload.ExerciseID,
+	params := codelabdb.CreateTestParams{
+		Input:       payload.Input,
+		Output:      payload.Output,
+		Public:      payload.Public,
+		Weight:      payload.Weight,
+		InputFormat: payload.InputFormat,
+		Comparison:  payload.Comparison,
+		Tolerance:   payload.Tolerance,
+		ExerciseID:  payload.ExerciseID,
+	}
+	spec := sandbox.TestCase{
+		Input:       params.Input,
+		Expected:    params.Output,
+		InputFormat: sandbox.InputFormat(params.InputFormat),
+		Compare:     sandbox.CompareMode(params.Comparison),
+		Tolerance:   params.Tolerance,
+	}
+	if err := spec.Validate(); err != nil {
+		return nil, codelab.InvalidInput("Invalid test: " + err.Error())
+	}
+
+	tests, err := s.testsRepo.GetTestsByExercise(ctx, payload.ExerciseID)
+	if err != nil {
+		return nil, codelab.InternalError("Failed to get tests: " + err.Error())
+	}
+	cases := append(testCases(tests), spec)
+	if err := s.validateSolution(ctx, "Solution", exercise.Language, exercise.Solution, cases); err != nil {
+		return nil, err
+	}
+
+	err = s.txManager.WithTransaction(ctx, func(tx pgx.Tx) error {
+		txRepos := s.withTransaction(tx)
+		if err := txRepos.TestsRepo.CreateTest(ctx, params); err != nil {
+			return err
+		}
+		return newExerciseVersion(ctx, txRepos, exercise.ID, profile.UserID)
 	})
 	if err != nil {
 		return nil, codelab.InternalError("Failed to create test: " + err.Error())
@@ -230,13 +517,17 @@ func (s *codelabsvrc) GetTestsByExercise(ctx context.Context, payload *codelab.G
 	result := make([]*codelab.Test, len(tests))
 	for i, test := range tests {
 		result[i] = &codelab.Test{
-			ID:         test.ID,
-			Input:      test.Input,
-			Output:     test.Output,
-			Public:     test.Public,
-			ExerciseID: test.ExerciseID,
-			CreatedAt:  test.CreatedAt.Time.UnixMilli(),
-			UpdatedAt:  test.UpdatedAt.Time.UnixMilli(),
+			ID:          test.ID,
+			Input:       test.Input,
+			Output:      test.Output,
+			Public:      test.Public,
+			InputFormat: test.InputFormat,
+			Comparison:  test.Comparison,
+			Tolerance:   test.Tolerance,
+			Weight:      test.Weight,
+			ExerciseID:  test.ExerciseID,
+			CreatedAt:   test.CreatedAt.Time.UnixMilli(),
+			UpdatedAt:   test.UpdatedAt.Time.UnixMilli(),
 		}
 	}
 
@@ -255,11 +546,58 @@ func (s *codelabsvrc) UpdateTest(ctx context.Context, payload *codelab.UpdateTes
 		return nil, codelab.PermissionDenied("Only teachers can update tests")
 	}
 
-	err = s.testsRepo.UpdateTest(ctx, codelabdb.UpdateTestParams{
-		ID:     payload.ID,
-		Input:  payload.Test.Input,
-		Output: payload.Test.Output,
-		Public: payload.Test.Public,
+	test, err := s.testsRepo.GetTestById(ctx, payload.ID)
+	if err != nil {
+		return nil, codelab.NotFound("Test not found")
+	}
+
+	exercise, err := s.exercisesRepo.GetExerciseById(ctx, test.ExerciseID)
+	if err != nil {
+		return nil, codelab.NotFound("Exercise not found")
+	}
+
+	params := codelabdb.UpdateTestParams{
+		ID:          payload.ID,
+		Input:       payload.Test.Input,
+		Output:      payload.Test.Output,
+		Public:      payload.Test.Public,
+		Weight:      payload.Test.Weight,
+		InputFormat: payload.Test.InputFormat,
+		Comparison:  payload.Test.Comparison,
+		Tolerance:   payload.Test.Tolerance,
+	}
+	spec := sandbox.TestCase{
+		ID:          params.ID,
+		Input:       params.Input,
+		Expected:    params.Output,
+		InputFormat: sandbox.InputFormat(params.InputFormat),
+		Compare:     sandbox.CompareMode(params.Comparison),
+		Tolerance:   params.Tolerance,
+	}
+	if err := spec.Validate(); err != nil {
+		return nil, codelab.InvalidInput("Invalid test: " + err.Error())
+	}
+
+	tests, err := s.testsRepo.GetTestsByExercise(ctx, exercise.ID)
+	if err != nil {
+		return nil, codelab.InternalError("Failed to get tests: " + err.Error())
+	}
+	cases := testCases(tests)
+	for i := range cases {
+		if cases[i].ID == params.ID {
+			cases[i] = spec
+		}
+	}
+	if err := s.validateSolution(ctx, "Solution", exercise.Language, exercise.Solution, cases); err != nil {
+		return nil, err
+	}
+
+	err = s.txManager.WithTransaction(ctx, func(tx pgx.Tx) error {
+		txRepos := s.withTransaction(tx)
+		if err := txRepos.TestsRepo.UpdateTest(ctx, params); err != nil {
+			return err
+		}
+		return newExerciseVersion(ctx, txRepos, exercise.ID, profile.UserID)
 	})
 	if err != nil {
 		return nil, codelab.InternalError("Failed to update test: " + err.Error())
@@ -283,7 +621,18 @@ func (s *codelabsvrc) DeleteTest(ctx context.Context, payload *codelab.DeleteTes
 		return nil, codelab.PermissionDenied("Only teachers can delete tests")
 	}
 
-	err = s.testsRepo.DeleteTest(ctx, payload.ID)
+	test, err := s.testsRepo.GetTestById(ctx, payload.ID)
+	if err != nil {
+		return nil, codelab.NotFound("Test not found")
+	}
+
+	err = s.txManager.WithTransaction(ctx, func(tx pgx.Tx) error {
+		txRepos := s.withTransaction(tx)
+		if err := txRepos.TestsRepo.DeleteTest(ctx, test.ID); err != nil {
+			return err
+		}
+		return newExerciseVersion(ctx, txRepos, test.ExerciseID, profile.UserID)
+	})
 	if err != nil {
 		return nil, codelab.InternalError("Failed to delete test: " + err.Error())
 	}
@@ -294,7 +643,7 @@ func (s *codelabsvrc) DeleteTest(ctx context.Context, payload *codelab.DeleteTes
 	}, nil
 }
 
-func (s *codelabsvrc) GetExerciseForStudent(ctx context.Context, payload *codelab.GetExerciseForStudentPayload) (res *codelab.ExerciseForStudents, err error) {
+func (s *codelabsvrc) ListExerciseVersions(ctx context.Context, payload *codelab.ListExerciseVersionsPayload) (res []*codelab.ExerciseVersion, err error) {
 	profile, err := s.profilesServiceRepo.GetCompleteProfile(context.Background(), &profiles.GetCompleteProfilePayload{
 		SessionToken: payload.SessionToken,
 	})
@@ -302,63 +651,35 @@ func (s *codelabsvrc) GetExerciseForStudent(ctx context.Context, payload *codela
 		return nil, codelab.Unauthorized("Unauthorized access")
 	}
 
-	exercise, err := s.exercisesRepo.GetExerciseToResolveById(ctx, payload.ID)
-	if err != nil {
-		return nil, codelab.NotFound("Exercise not found")
+	if profile.Role != "teacher" {
+		return nil, codelab.PermissionDenied("Only teachers can view exercise versions")
 	}
 
-	publicTests, err := s.testsRepo.GetPublicTestsByExercise(ctx, exercise.ID)
+	// Verify the exercise exists
+	_, err = s.exercisesRepo.GetExerciseById(ctx, payload.ExerciseID)
 	if err != nil {
-		return nil, codelab.InternalError("Failed to get public tests: " + err.Error())
-	}
-
-	publicTestsToAPI := make([]*codelab.Test, len(publicTests))
-	for i, test := range publicTests {
-		publicTestsToAPI[i] = &codelab.Test{
-			ID:         test.ID,
-			Input:      test.Input,
-			Output:     test.Output,
-			Public:     test.Public,
-			ExerciseID: test.ExerciseID,
-			CreatedAt:  test.CreatedAt.Time.UnixMilli(),
-			UpdatedAt:  test.UpdatedAt.Time.UnixMilli(),
-		}
+		return nil, codelab.NotFound("Exercise not found")
 	}
 
-	attempts, err := s.attemptsRepo.GetAttemptsByUserAndExercise(ctx, codelabdb.GetAttemptsByUserAndExerciseParams{
-		ExerciseID: exercise.ID,
-		UserID:     profile.UserID,
-	})
+	versions, err := s.exercisesRepo.ListExerciseVersions(ctx, payload.ExerciseID)
 	if err != nil {
-		return nil, codelab.InternalError("Failed to get attempts: " + err.Error())
+		return nil, codelab.InternalError("Failed to list versions: " + err.Error())
 	}
 
-	attemptsToAPI := make([]*codelab.Attempt, len(attempts))
-	for i, attempt := range attempts {
-		attemptsToAPI[i] = &codelab.Attempt{
-			ID:        attempt.ID,
-			AnswerID:  attempt.AnswerID,
-			Code:      attempt.Code,
-			Success:   attempt.Success,
-			CreatedAt: attempt.CreatedAt.Time.UnixMilli(),
+	result := make([]*codelab.ExerciseVersion, len(versions))
+	for i, version := range versions {
+		result[i] = &codelab.ExerciseVersion{
+			Version:   version.Version,
+			CreatedBy: version.CreatedBy,
+			CreatedAt: version.CreatedAt.Time.UnixMilli(),
+			Attempts:  version.Attempts,
 		}
 	}
 
-	return &codelab.ExerciseForStudents{
-		ID:          exercise.ID,
-		Title:       exercise.Title,
-		Description: exercise.Description,
-		InitialCode: exercise.InitialCode,
-		Difficulty:  exercise.Difficulty,
-		CreatedBy:   exercise.CreatedBy,
-		Tests:       publicTestsToAPI,
-		Attempts:    attemptsToAPI,
-		CreatedAt:   exercise.CreatedAt.Time.UnixMilli(),
-		UpdatedAt:   exercise.UpdatedAt.Time.UnixMilli(),
-	}, nil
+	return result, nil
 }
 
-func (s *codelabsvrc) ListExercisesForStudents(ctx context.Context, payload *codelab.ListExercisesForStudentsPayload) (res []*codelab.ExerciseForStudentsListView, err error) {
+func (s *codelabsvrc) DiffExerciseVersions(ctx context.Context, payload *codelab.DiffExerciseVersionsPayload) (res *codelab.ExerciseVersionDiff, err error) {
 	profile, err := s.profilesServiceRepo.GetCompleteProfile(context.Background(), &profiles.GetCompleteProfilePayload{
 		SessionToken: payload.SessionToken,
 	})
@@ -366,28 +687,23 @@ func (s *codelabsvrc) ListExercisesForStudents(ctx context.Context, payload *cod
 		return nil, codelab.Unauthorized("Unauthorized access")
 	}
 
-	exercises, err := s.exercisesRepo.ListExercisesToResolve(ctx)
-	if err != nil {
-		return nil, codelab.InternalError("Failed to list exercises: " + err.Error())
+	if profile.Role != "teacher" {
+		return nil, codelab.PermissionDenied("Only teachers can compare exercise versions")
 	}
 
-	result := make([]*codelab.ExerciseForStudentsListView, len(exercises))
-	for i, exercise := range exercises {
-		result[i] = &codelab.ExerciseForStudentsListView{
-			ID:          exercise.ID,
-			Title:       exercise.Title,
-			Description: exercise.Description,
-			Difficulty:  exercise.Difficulty,
-			CreatedBy:   exercise.CreatedBy,
-			CreatedAt:   exercise.CreatedAt.Time.UnixMilli(),
-			UpdatedAt:   exercise.UpdatedAt.Time.UnixMilli(),
-		}
+	from, err := s.loadExerciseVersion(ctx, payload.ExerciseID, payload.From)
+	if err != nil {
+		return nil, err
+	}
+	to, err := s.loadExerciseVersion(ctx, payload.ExerciseID, payload.To)
+	if err != nil {
+		return nil, err
 	}
 
-	return result, nil
+	return versionDiffToAPI(payload.ExerciseID, payload.From, payload.To, versioning.Compare(from, to)), nil
 }
 
-func (s *codelabsvrc) CreateAttempt(ctx context.Context, payload *codelab.CreateAttemptPayload) (res *codelab.SimpleResponse, err error) {
+func (s *codelabsvrc) RollbackExercise(ctx context.Context, payload *codelab.RollbackExercisePayload) (res *codelab.SimpleResponse, err error) {
 	profile, err := s.profilesServiceRepo.GetCompleteProfile(context.Background(), &profiles.GetCompleteProfilePayload{
 		SessionToken: payload.SessionToken,
 	})
@@ -395,123 +711,48 @@ func (s *codelabsvrc) CreateAttempt(ctx context.Context, payload *codelab.Create
 		return nil, codelab.Unauthorized("Unauthorized access")
 	}
 
-	if profile.Role != "student" {
-		return nil, codelab.PermissionDenied("Only students can create attempts")
+	if profile.Role != "teacher" {
+		return nil, codelab.PermissionDenied("Only teachers can roll back exercises")
 	}
 
-	exercise, err := s.exercisesRepo.GetExerciseToResolveById(ctx, payload.ExerciseID)
+	exercise, err := s.exercisesRepo.GetExerciseById(ctx, payload.ExerciseID)
 	if err != nil {
 		return nil, codelab.NotFound("Exercise not found")
 	}
-
-	// Ensure answer exists for this user/exercise combination
-	_, err = s.answersRepo.CheckIfAnswerExists(ctx, codelabdb.CheckIfAnswerExistsParams{
-		ExerciseID: exercise.ID,
-		UserID:     profile.UserID,
-	})
-	if err != nil {
-		// Create answer if it doesn't exist
-		err = s.answersRepo.CreateAnswer(ctx, codelabdb.CreateAnswerParams{
-			ExerciseID: exercise.ID,
-			UserID:     profile.UserID,
-			Completed:  false,
-		})
-		if err != nil {
-			return nil, codelab.InternalError("Failed to create answer: " + err.Error())
-		}
-	}
-
-	// Get the answer
-	answer, err := s.answersRepo.GetAnswerByUserAndExercise(ctx, codelabdb.GetAnswerByUserAndExerciseParams{
-		ExerciseID: exercise.ID,
-		UserID:     profile.UserID,
-	})
-	if err != nil {
-		return nil, codelab.InternalError("Failed to get answer: " + err.Error())
-	}
-
-	// Get hidden tests for validation
-	privateTests, err := s.testsRepo.GetHiddenTestsByExercise(ctx, exercise.ID)
-	if err != nil {
-		return nil, codelab.InternalError("Failed to get private tests: " + err.Error())
+	if payload.Version == exercise.Version {
+		return nil, codelab.InvalidInput(fmt.Sprintf("Exercise is already at version %d", payload.Version))
 	}
 
-	// Execute and validate code
-	vm := goja.New()
-	_, err = vm.RunString(payload.Code)
+	snapshot, err := s.loadExerciseVersion(ctx, exercise.ID, payload.Version)
 	if err != nil {
-		return nil, codelab.InvalidInput("Code execution failed: " + err.Error())
+		return nil, err
 	}
 
-	solution, ok := goja.AssertFunction(vm.Get("solution"))
-	if !ok {
-		return nil, codelab.InvalidInput("Code must define a 'solution' function")
+	// The sandbox may have changed since, so the old solution is checked again
+	subject := fmt.Sprintf("Solution of version %d", payload.Version)
+	if err := s.validateSolution(ctx, subject, snapshot.Language, snapshot.Solution, snapshotTestCases(snapshot.Tests)); err != nil {
+		return nil, err
 	}
 
-	// Test the code against all hidden tests
-	for _, test := range privateTests {
-		result, err := solution(goja.Undefined(), vm.ToValue(test.Input))
-		if err != nil {
-			errorLog := err.Error()
-
-			err = s.attemptsRepo.CreateAttempt(ctx, codelabdb.CreateAttemptParams{
-				AnswerID: answer.ID,
-				Code:     payload.Code,
-				Success:  false,
-			})
-			if err != nil {
-				return nil, codelab.InternalError("Failed to create attempt: " + err.Error())
-			}
-
-			return &codelab.SimpleResponse{
-				Message: "Test failed: " + errorLog,
-				Success: false,
-			}, nil
-		}
-
-		success := result.Equals(vm.ToValue(test.Output))
-		if !success {
-			err = s.attemptsRepo.CreateAttempt(ctx, codelabdb.CreateAttemptParams{
-				AnswerID: answer.ID,
-				Code:     payload.Code,
-				Success:  false,
-			})
-			if err != nil {
-				return nil, codelab.InternalError("Failed to create attempt: " + err.Error())
-			}
-
-			return &codelab.SimpleResponse{
-				Message: "Test failed: expected " + test.Output + ", got " + result.String(),
-				Success: false,
-			}, nil
+	// Rolling back is a change like any other, so it is saved as a new version
+	err = s.txManager.WithTransaction(ctx, func(tx pgx.Tx) error {
+		txRepos := s.withTransaction(tx)
+		if err := restoreExerciseVersion(ctx, txRepos, exercise.ID, snapshot); err != nil {
+			return err
 		}
-	}
-
-	err = s.attemptsRepo.CreateAttempt(ctx, codelabdb.CreateAttemptParams{
-		AnswerID: answer.ID,
-		Code:     payload.Code,
-		Success:  true,
-	})
-	if err != nil {
-		return nil, codelab.InternalError("Failed to create attempt: " + err.Error())
-	}
-
-	err = s.answersRepo.UpdateAnswerCompleted(ctx, codelabdb.UpdateAnswerCompletedParams{
-		ExerciseID: answer.ExerciseID,
-		UserID:     profile.UserID,
-		Completed:  true,
+		return newExerciseVersion(ctx, txRepos, exercise.ID, profile.UserID)
 	})
 	if err != nil {
-		return nil, codelab.InternalError("Failed to update answer completion: " + err.Error())
+		return nil, codelab.InternalError("Failed to roll back exercise: " + err.Error())
 	}
 
 	return &codelab.SimpleResponse{
-		Message: "All tests passed successfully",
+		Message: fmt.Sprintf("Exercise rolled back to version %d", payload.Version),
 		Success: true,
 	}, nil
 }
 
-func (s *codelabsvrc) GetAttemptsByUserAndExercise(ctx context.Context, payload *codelab.GetAttemptsByUserAndExercisePayload) (res []*codelab.Attempt, err error) {
+func (s *codelabsvrc) RegradeExercise(ctx context.Context, payload *codelab.RegradeExercisePayload) (res *codelab.Regrade, err error) {
 	profile, err := s.profilesServiceRepo.GetCompleteProfile(context.Background(), &profiles.GetCompleteProfilePayload{
 		SessionToken: payload.SessionToken,
 	})
@@ -519,13 +760,977 @@ func (s *codelabsvrc) GetAttemptsByUserAndExercise(ctx context.Context, payload
 		return nil, codelab.Unauthorized("Unauthorized access")
 	}
 
-	if profile.Role != "student" {
-		return nil, codelab.PermissionDenied("Only students can access this endpoint")
+	if profile.Role != "teacher" {
+		return nil, codelab.PermissionDenied("Only teachers can regrade exercises")
 	}
 
-	// Students can only see their own attempts
-	if profile.UserID != payload.UserID {
-		return nil, codelab.PermissionDenied("Students can only view their own attempts")
+	exercise, err := s.exercisesRepo.GetExerciseToResolveById(ctx, payload.ExerciseID)
+	if err != nil {
+		return nil, codelab.NotFound("Exercise not found")
+	}
+
+	// Interrupted regrades would otherwise keep the exercise from being regraded
+	err = s.regradesRepo.FailInterruptedRegrades(ctx, codelabdb.FailInterruptedRegradesParams{
+		ExerciseID:   exercise.ID,
+		ErrorMessage: pgtype.Text{String: regradeInterruptedMessage, Valid: true},
+	})
+	if err != nil {
+		return nil, codelab.InternalError("Failed to close interrupted regrades: " + err.Error())
+	}
+
+	_, err = s.regradesRepo.GetRunningRegradeByExercise(ctx, exercise.ID)
+	switch {
+	case err == nil:
+		return nil, codelab.InvalidInput("A regrade of this exercise is already running")
+	case !errors.Is(err, pgx.ErrNoRows):
+		return nil, codelab.InternalError("Failed to check running regrades: " + err.Error())
+	}
+
+	r, err := s.prepareRegrade(ctx, exercise)
+	if err != nil {
+		return nil, err
+	}
+
+	regrade, err := s.regradesRepo.CreateRegrade(ctx, codelabdb.CreateRegradeParams{
+		ExerciseID:      exercise.ID,
+		ExerciseVersion: exercise.Version,
+		TotalAttempts:   int32(len(r.attempts)),
+		CreatedBy:       profile.UserID,
+	})
+	if isUniqueViolation(err) {
+		// Another regrade of the exercise started since the check above
+		return nil, codelab.InvalidInput("A regrade of this exercise is already running")
+	}
+	if err != nil {
+		return nil, codelab.InternalError("Failed to create regrade: " + err.Error())
+	}
+	r.id = regrade.ID
+
+	err = s.regradeQueue.Submit(func(ctx context.Context) {
+		s.runRegrade(ctx, r)
+	})
+	if err != nil {
+		// Nothing will run the regrade, so it is closed as failed
+		s.failRegrade(ctx, regrade.ID, "Regrading is busy, try again later")
+		return nil, codelab.ServiceUnavailable("Regrading is busy, try again later")
+	}
+
+	return regradeToAPI(regrade, nil), nil
+}
+
+func (s *codelabsvrc) GetRegrade(ctx context.Context, payload *codelab.GetRegradePayload) (res *codelab.Regrade, err error) {
+	profile, err := s.profilesServiceRepo.GetCompleteProfile(context.Background(), &profiles.GetCompleteProfilePayload{
+		SessionToken: payload.SessionToken,
+	})
+	if err != nil || profile == nil {
+		return nil, codelab.Unauthorized("Unauthorized access")
+	}
+
+	if profile.Role != "teacher" {
+		return nil, codelab.PermissionDenied("Only teachers can view regrades")
+	}
+
+	regrade, err := s.regradesRepo.GetRegradeById(ctx, payload.ID)
+	if err != nil {
+		return nil, codelab.NotFound("Regrade not found")
+	}
+
+	changes, err := s.regradesRepo.ListRegradeChanges(ctx, regrade.ID)
+	if err != nil {
+		return nil, codelab.InternalError("Failed to get regrade changes: " + err.Error())
+	}
+
+	return regradeToAPI(regrade, changes), nil
+}
+
+func (s *codelabsvrc) CreateHint(ctx context.Context, payload *codelab.CreateHintPayload) (res *codelab.SimpleResponse, err error) {
+	profile, err := s.profilesServiceRepo.GetCompleteProfile(context.Background(), &profiles.GetCompleteProfilePayload{
+		SessionToken: payload.SessionToken,
+	})
+	if err != nil || profile == nil {
+		return nil, codelab.Unauthorized("Unauthorized access")
+	}
+
+	if profile.Role != "teacher" {
+		return nil, codelab.PermissionDenied("Only teachers can create hints")
+	}
+
+	// Verify the exercise exists
+	_, err = s.exercisesRepo.GetExerciseById(ctx, payload.ExerciseID)
+	if err != nil {
+		return nil, codelab.NotFound("Exercise not found")
+	}
+
+	// New hints are revealed after the existing ones
+	err = s.hintsRepo.CreateHint(ctx, codelabdb.CreateHintParams{
+		ExerciseID:          payload.ExerciseID,
+		Content:             payload.Content,
+		UnlockAfterAttempts: optionalInt4(payload.UnlockAfterAttempts),
+		UnlockAfterSeconds:  optionalInt4(payload.UnlockAfterSeconds),
+		Penalty:             payload.Penalty,
+	})
+	if err != nil {
+		return nil, codelab.InternalError("Failed to create hint: " + err.Error())
+	}
+
+	return &codelab.SimpleResponse{
+		Message: "Hint created successfully",
+		Success: true,
+	}, nil
+}
+
+func (s *codelabsvrc) GetHintsByExercise(ctx context.Context, payload *codelab.GetHintsByExercisePayload) (res []*codelab.Hint, err error) {
+	profile, err := s.profilesServiceRepo.GetCompleteProfile(context.Background(), &profiles.GetCompleteProfilePayload{
+		SessionToken: payload.SessionToken,
+	})
+	if err != nil || profile == nil {
+		return nil, codelab.Unauthorized("Unauthorized access")
+	}
+
+	if profile.Role != "teacher" {
+		return nil, codelab.PermissionDenied("Only teachers can view all hints")
+	}
+
+	// Verify the exercise exists
+	_, err = s.exercisesRepo.GetExerciseById(ctx, payload.ExerciseID)
+	if err != nil {
+		return nil, codelab.NotFound("Exercise not found")
+	}
+
+	hints, err := s.hintsRepo.GetHintsByExercise(ctx, payload.ExerciseID)
+	if err != nil {
+		return nil, codelab.InternalError("Failed to get hints: " + err.Error())
+	}
+
+	result := make([]*codelab.Hint, len(hints))
+	for i, hint := range hints {
+		result[i] = hintToAPI(hint)
+	}
+
+	return result, nil
+}
+
+func (s *codelabsvrc) UpdateHint(ctx context.Context, payload *codelab.UpdateHintPayload2) (res *codelab.SimpleResponse, err error) {
+	profile, err := s.profilesServiceRepo.GetCompleteProfile(context.Background(), &profiles.GetCompleteProfilePayload{
+		SessionToken: payload.SessionToken,
+	})
+	if err != nil || profile == nil {
+		return nil, codelab.Unauthorized("Unauthorized access")
+	}
+
+	if profile.Role != "teacher" {
+		return nil, codelab.PermissionDenied("Only teachers can update hints")
+	}
+
+	_, err = s.hintsRepo.GetHintById(ctx, payload.ID)
+	if err != nil {
+		return nil, codelab.NotFound("Hint not found")
+	}
+
+	err = s.hintsRepo.UpdateHint(ctx, codelabdb.UpdateHintParams{
+		ID:                  payload.ID,
+		Content:             payload.Hint.Content,
+		UnlockAfterAttempts: optionalInt4(payload.Hint.UnlockAfterAttempts),
+		UnlockAfterSeconds:  optionalInt4(payload.Hint.UnlockAfterSeconds),
+		Penalty:             payload.Hint.Penalty,
+	})
+	if err != nil {
+		return nil, codelab.InternalError("Failed to update hint: " + err.Error())
+	}
+
+	return &codelab.SimpleResponse{
+		Message: "Hint updated successfully",
+		Success: true,
+	}, nil
+}
+
+func (s *codelabsvrc) DeleteHint(ctx context.Context, payload *codelab.DeleteHintPayload) (res *codelab.SimpleResponse, err error) {
+	profile, err := s.profilesServiceRepo.GetCompleteProfile(context.Background(), &profiles.GetCompleteProfilePayload{
+		SessionToken: payload.SessionToken,
+	})
+	if err != nil || profile == nil {
+		return nil, codelab.Unauthorized("Unauthorized access")
+	}
+
+	if profile.Role != "teacher" {
+		return nil, codelab.PermissionDenied("Only teachers can delete hints")
+	}
+
+	_, err = s.hintsRepo.GetHintById(ctx, payload.ID)
+	if err != nil {
+		return nil, codelab.NotFound("Hint not found")
+	}
+
+	err = s.hintsRepo.DeleteHint(ctx, payload.ID)
+	if err != nil {
+		return nil, codelab.InternalError("Failed to delete hint: " + err.Error())
+	}
+
+	return &codelab.SimpleResponse{
+		Message: "Hint deleted successfully",
+		Success: true,
+	}, nil
+}
+
+func (s *codelabsvrc) CreateAssignment(ctx context.Context, payload *codelab.CreateAssignmentPayload) (res *codelab.SimpleResponse, err error) {
+	profile, err := s.profilesServiceRepo.GetCompleteProfile(context.Background(), &profiles.GetCompleteProfilePayload{
+		SessionToken: payload.SessionToken,
+	})
+	if err != nil || profile == nil {
+		return nil, codelab.Unauthorized("Unauthorized access")
+	}
+
+	if profile.Role != "teacher" {
+		return nil, codelab.PermissionDenied("Only teachers can create assignments")
+	}
+
+	if err := s.validateAssignment(ctx, payload.OpensAt, payload.ClosesAt, payload.ExerciseIds); err != nil {
+		return nil, err
+	}
+
+	err = s.txManager.WithTransaction(ctx, func(tx pgx.Tx) error {
+		txRepos := s.withTransaction(tx)
+		id, err := txRepos.AssignmentsRepo.CreateAssignment(ctx, codelabdb.CreateAssignmentParams{
+			Title:       payload.Title,
+			Description: payload.Description,
+			OpensAt:     timestamptz(payload.OpensAt),
+			ClosesAt:    timestamptz(payload.ClosesAt),
+			AllowLate:   payload.AllowLate,
+			CreatedBy:   profile.UserID,
+		})
+		if err != nil {
+			return err
+		}
+		return addAssignmentExercises(ctx, txRepos.AssignmentsRepo, id, payload.ExerciseIds)
+	})
+	if err != nil {
+		return nil, codelab.InternalError("Failed to create assignment: " + err.Error())
+	}
+
+	return &codelab.SimpleResponse{
+		Message: "Assignment created successfully",
+		Success: true,
+	}, nil
+}
+
+func (s *codelabsvrc) GetAssignment(ctx context.Context, payload *codelab.GetAssignmentPayload) (res *codelab.Assignment, err error) {
+	profile, err := s.profilesServiceRepo.GetCompleteProfile(context.Background(), &profiles.GetCompleteProfilePayload{
+		SessionToken: payload.SessionToken,
+	})
+	if err != nil || profile == nil {
+		return nil, codelab.Unauthorized("Unauthorized access")
+	}
+
+	if profile.Role != "teacher" {
+		return nil, codelab.PermissionDenied("Only teachers can view assignments")
+	}
+
+	return s.loadAssignment(ctx, payload.ID)
+}
+
+func (s *codelabsvrc) ListAssignments(ctx context.Context, payload *codelab.ListAssignmentsPayload) (res []*codelab.Assignment, err error) {
+	profile, err := s.profilesServiceRepo.GetCompleteProfile(context.Background(), &profiles.GetCompleteProfilePayload{
+		SessionToken: payload.SessionToken,
+	})
+	if err != nil || profile == nil {
+		return nil, codelab.Unauthorized("Unauthorized access")
+	}
+
+	if profile.Role != "teacher" {
+		return nil, codelab.PermissionDenied("Only teachers can list assignments")
+	}
+
+	return s.listAssignments(ctx)
+}
+
+func (s *codelabsvrc) UpdateAssignment(ctx context.Context, payload *codelab.UpdateAssignmentPayload2) (res *codelab.SimpleResponse, err error) {
+	profile, err := s.profilesServiceRepo.GetCompleteProfile(context.Background(), &profiles.GetCompleteProfilePayload{
+		SessionToken: payload.SessionToken,
+	})
+	if err != nil || profile == nil {
+		return nil, codelab.Unauthorized("Unauthorized access")
+	}
+
+	if profile.Role != "teacher" {
+		return nil, codelab.PermissionDenied("Only teachers can update assignments")
+	}
+
+	_, err = s.assignmentsRepo.GetAssignmentById(ctx, payload.ID)
+	if err != nil {
+		return nil, codelab.NotFound("Assignment not found")
+	}
+
+	assignment := payload.Assignment
+	if err := s.validateAssignment(ctx, assignment.OpensAt, assignment.ClosesAt, assignment.ExerciseIds); err != nil {
+		return nil, err
+	}
+
+	// The exercises are replaced as a whole so their positions stay contiguous
+	err = s.txManager.WithTransaction(ctx, func(tx pgx.Tx) error {
+		txRepos := s.withTransaction(tx)
+		err := txRepos.AssignmentsRepo.UpdateAssignment(ctx, codelabdb.UpdateAssignmentParams{
+			ID:          payload.ID,
+			Title:       assignment.Title,
+			Description: assignment.Description,
+			OpensAt:     timestamptz(assignment.OpensAt),
+			ClosesAt:    timestamptz(assignment.ClosesAt),
+			AllowLate:   assignment.AllowLate,
+		})
+		if err != nil {
+			return err
+		}
+		if err := txRepos.AssignmentsRepo.DeleteAssignmentExercises(ctx, payload.ID); err != nil {
+			return err
+		}
+		return addAssignmentExercises(ctx, txRepos.AssignmentsRepo, payload.ID, assignment.ExerciseIds)
+	})
+	if err != nil {
+		return nil, codelab.InternalError("Failed to update assignment: " + err.Error())
+	}
+
+	return &codelab.SimpleResponse{
+		Message: "Assignment updated successfully",
+		Success: true,
+	}, nil
+}
+
+func (s *codelabsvrc) DeleteAssignment(ctx context.Context, payload *codelab.DeleteAssignmentPayload) (res *codelab.SimpleResponse, err error) {
+	profile, err := s.profilesServiceRepo.GetCompleteProfile(context.Background(), &profiles.GetCompleteProfilePayload{
+		SessionToken: payload.SessionToken,
+	})
+	if err != nil || profile == nil {
+		return nil, codelab.Unauthorized("Unauthorized access")
+	}
+
+	if profile.Role != "teacher" {
+		return nil, codelab.PermissionDenied("Only teachers can delete assignments")
+	}
+
+	_, err = s.assignmentsRepo.GetAssignmentById(ctx, payload.ID)
+	if err != nil {
+		return nil, codelab.NotFound("Assignment not found")
+	}
+
+	err = s.assignmentsRepo.DeleteAssignment(ctx, payload.ID)
+	if err != nil {
+		return nil, codelab.InternalError("Failed to delete assignment: " + err.Error())
+	}
+
+	return &codelab.SimpleResponse{
+		Message: "Assignment deleted successfully",
+		Success: true,
+	}, nil
+}
+
+func (s *codelabsvrc) GetAssignmentGradebook(ctx context.Context, payload *codelab.GetAssignmentGradebookPayload) (res *codelab.AssignmentGradebook, err error) {
+	profile, err := s.profilesServiceRepo.GetCompleteProfile(context.Background(), &profiles.GetCompleteProfilePayload{
+		SessionToken: payload.SessionToken,
+	})
+	if err != nil || profile == nil {
+		return nil, codelab.Unauthorized("Unauthorized access")
+	}
+
+	if profile.Role != "teacher" {
+		return nil, codelab.PermissionDenied("Only teachers can view the gradebook")
+	}
+
+	assignment, err := s.loadAssignment(ctx, payload.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := s.assignmentsRepo.GetAssignmentGrades(ctx, codelabdb.GetAssignmentGradesParams{
+		AssignmentID: payload.ID,
+	})
+	if err != nil {
+		return nil, codelab.InternalError("Failed to get assignment grades: " + err.Error())
+	}
+
+	// Rows are sorted by user, so the students keep that order
+	students := []*codelab.StudentAssignmentGrade{}
+	for start := 0; start < len(rows); {
+		end := start + 1
+		for end < len(rows) && rows[end].UserID == rows[start].UserID {
+			end++
+		}
+		students = append(students, assignmentGrade(rows[start].UserID, assignment.Exercises, rows[start:end]))
+		start = end
+	}
+
+	return &codelab.AssignmentGradebook{
+		Assignment: assignment,
+		Students:   students,
+	}, nil
+}
+
+func (s *codelabsvrc) GetExerciseStats(ctx context.Context, payload *codelab.GetExerciseStatsPayload) (res *codelab.ExerciseStats, err error) {
+	profile, err := s.profilesServiceRepo.GetCompleteProfile(context.Background(), &profiles.GetCompleteProfilePayload{
+		SessionToken: payload.SessionToken,
+	})
+	if err != nil || profile == nil {
+		return nil, codelab.Unauthorized("Unauthorized access")
+	}
+
+	if profile.Role != "teacher" {
+		return nil, codelab.PermissionDenied("Only teachers can view exercise statistics")
+	}
+
+	// Verify the exercise exists
+	_, err = s.exercisesRepo.GetExerciseById(ctx, payload.ExerciseID)
+	if err != nil {
+		return nil, codelab.NotFound("Exercise not found")
+	}
+
+	totalStudents, err := s.answersRepo.CountTotalAnswersByExercise(ctx, payload.ExerciseID)
+	if err != nil {
+		return nil, codelab.InternalError("Failed to count answers: " + err.Error())
+	}
+
+	completedStudents, err := s.answersRepo.CountCompletedAnswersByExercise(ctx, payload.ExerciseID)
+	if err != nil {
+		return nil, codelab.InternalError("Failed to count completed answers: " + err.Error())
+	}
+
+	totalAttempts, err := s.attemptsRepo.CountTotalAttemptsByExercise(ctx, payload.ExerciseID)
+	if err != nil {
+		return nil, codelab.InternalError("Failed to count attempts: " + err.Error())
+	}
+
+	successfulAttempts, err := s.attemptsRepo.CountSuccessfulAttemptsByExercise(ctx, payload.ExerciseID)
+	if err != nil {
+		return nil, codelab.InternalError("Failed to count successful attempts: " + err.Error())
+	}
+
+	answers, err := s.answersRepo.ListAnswersByExercise(ctx, payload.ExerciseID)
+	if err != nil {
+		return nil, codelab.InternalError("Failed to list answers: " + err.Error())
+	}
+
+	attempts, err := s.attemptsRepo.GetAttemptsWithAnswerInfo(ctx, payload.ExerciseID)
+	if err != nil {
+		return nil, codelab.InternalError("Failed to get attempts: " + err.Error())
+	}
+
+	failures, err := s.attemptsRepo.GetTestFailureCountsByExercise(ctx, payload.ExerciseID)
+	if err != nil {
+		return nil, codelab.InternalError("Failed to count test failures: " + err.Error())
+	}
+
+	attemptsByUser := groupAttemptsByUser(attempts)
+	students := make([]*codelab.StudentExerciseStatus, len(answers))
+	var hintsUsed int64
+	for i, answer := range answers {
+		students[i] = studentStatus(answer, attemptsByUser[answer.UserID])
+		hintsUsed += int64(answer.HintsUsed)
+	}
+
+	result := &codelab.ExerciseStats{
+		ExerciseID:         payload.ExerciseID,
+		TotalStudents:      totalStudents,
+		CompletedStudents:  completedStudents,
+		CompletionRate:     percentage(completedStudents, totalStudents),
+		TotalAttempts:      totalAttempts,
+		SuccessfulAttempts: successfulAttempts,
+		HintsUsed:          hintsUsed,
+		Students:           students,
+	}
+	result.MedianAttemptsToSuccess, result.MedianTimeToCompletionMs = completionMedians(students)
+
+	// Failure counts are sorted with the most failed test first
+	if len(failures) > 0 {
+		result.MostFailedTest = &codelab.FailingTest{
+			TestID:   failures[0].ID,
+			Input:    failures[0].Input,
+			Public:   failures[0].Public,
+			Failures: failures[0].Failures,
+		}
+	}
+
+	return result, nil
+}
+
+func (s *codelabsvrc) GetStudentExerciseStats(ctx context.Context, payload *codelab.GetStudentExerciseStatsPayload) (res *codelab.StudentExerciseStats, err error) {
+	profile, err := s.profilesServiceRepo.GetCompleteProfile(context.Background(), &profiles.GetCompleteProfilePayload{
+		SessionToken: payload.SessionToken,
+	})
+	if err != nil || profile == nil {
+		return nil, codelab.Unauthorized("Unauthorized access")
+	}
+
+	if profile.Role != "teacher" {
+		return nil, codelab.PermissionDenied("Only teachers can view exercise statistics")
+	}
+
+	// Verify the exercise exists
+	_, err = s.exercisesRepo.GetExerciseById(ctx, payload.ExerciseID)
+	if err != nil {
+		return nil, codelab.NotFound("Exercise not found")
+	}
+
+	answer, err := s.answersRepo.GetAnswerByUserAndExercise(ctx, codelabdb.GetAnswerByUserAndExerciseParams{
+		ExerciseID: payload.ExerciseID,
+		UserID:     payload.UserID,
+	})
+	if err != nil {
+		return nil, codelab.NotFound("Student has not started this exercise")
+	}
+
+	attempts, err := s.attemptsRepo.GetAttemptsByUserAndExercise(ctx, codelabdb.GetAttemptsByUserAndExerciseParams{
+		UserID:     payload.UserID,
+		ExerciseID: payload.ExerciseID,
+	})
+	if err != nil {
+		return nil, codelab.InternalError("Failed to get attempts: " + err.Error())
+	}
+	// Attempts are listed newest first
+	slices.Reverse(attempts)
+
+	testResults, err := s.attemptsRepo.GetTestResultsByUserAndExercise(ctx, codelabdb.GetTestResultsByUserAndExerciseParams{
+		UserID:     payload.UserID,
+		ExerciseID: payload.ExerciseID,
+	})
+	if err != nil {
+		return nil, codelab.InternalError("Failed to get test results: " + err.Error())
+	}
+	resultsByAttempt := groupTestResults(testResults)
+
+	attemptsToAPI := make([]*codelab.Attempt, len(attempts))
+	for i, attempt := range attempts {
+		attemptsToAPI[i] = &codelab.Attempt{
+			ID:              attempt.ID,
+			AnswerID:        attempt.AnswerID,
+			Code:            attempt.Code,
+			Success:         attempt.Success,
+			Status:          attempt.Status,
+			Points:          attempt.Points,
+			MaxPoints:       attempt.MaxPoints,
+			Score:           attempt.Score,
+			Late:            attempt.Late,
+			ExerciseVersion: attempt.ExerciseVersion,
+			TestResults:     resultsByAttempt[attempt.ID],
+			CreatedAt:       attempt.CreatedAt.Time.UnixMilli(),
+		}
+	}
+
+	return &codelab.StudentExerciseStats{
+		ExerciseID: payload.ExerciseID,
+		Summary:    studentStatus(answer, attempts),
+		Attempts:   attemptsToAPI,
+	}, nil
+}
+
+func (s *codelabsvrc) GetPlagiarismReport(ctx context.Context, payload *codelab.GetPlagiarismReportPayload) (res *codelab.PlagiarismReport, err error) {
+	profile, err := s.profilesServiceRepo.GetCompleteProfile(context.Background(), &profiles.GetCompleteProfilePayload{
+		SessionToken: payload.SessionToken,
+	})
+	if err != nil || profile == nil {
+		return nil, codelab.Unauthorized("Unauthorized access")
+	}
+
+	if profile.Role != "teacher" {
+		return nil, codelab.PermissionDenied("Only teachers can view plagiarism reports")
+	}
+
+	// Verify the exercise exists
+	_, err = s.exercisesRepo.GetExerciseById(ctx, payload.ExerciseID)
+	if err != nil {
+		return nil, codelab.NotFound("Exercise not found")
+	}
+
+	report := &codelab.PlagiarismReport{
+		ExerciseID: payload.ExerciseID,
+		Pairs:      []*codelab.PlagiarismPair{},
+	}
+
+	// Exercises are checked in the background, so a new exercise may not have
+	// been checked yet
+	check, err := s.plagiarismRepo.GetPlagiarismCheck(ctx, payload.ExerciseID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return report, nil
+	}
+	if err != nil {
+		return nil, codelab.InternalError("Failed to get plagiarism check: " + err.Error())
+	}
+	report.CheckedAt = ptr(check.CheckedAt.Time.UnixMilli())
+
+	matches, err := s.plagiarismRepo.ListPlagiarismMatchesByExercise(ctx, codelabdb.ListPlagiarismMatchesByExerciseParams{
+		ExerciseID: payload.ExerciseID,
+		Similarity: payload.MinSimilarity,
+	})
+	if err != nil {
+		return nil, codelab.InternalError("Failed to get plagiarism matches: " + err.Error())
+	}
+
+	for _, match := range matches {
+		pair, err := plagiarismPairToAPI(match)
+		if err != nil {
+			return nil, codelab.InternalError("Failed to decode plagiarism match: " + err.Error())
+		}
+		report.Pairs = append(report.Pairs, pair)
+	}
+
+	return report, nil
+}
+
+func (s *codelabsvrc) GetExerciseForStudent(ctx context.Context, payload *codelab.GetExerciseForStudentPayload) (res *codelab.ExerciseForStudents, err error) {
+	profile, err := s.profilesServiceRepo.GetCompleteProfile(context.Background(), &profiles.GetCompleteProfilePayload{
+		SessionToken: payload.SessionToken,
+	})
+	if err != nil || profile == nil {
+		return nil, codelab.Unauthorized("Unauthorized access")
+	}
+
+	exercise, err := s.exercisesRepo.GetExerciseToResolveById(ctx, payload.ID)
+	if err != nil {
+		return nil, codelab.NotFound("Exercise not found")
+	}
+
+	publicTests, err := s.testsRepo.GetPublicTestsByExercise(ctx, exercise.ID)
+	if err != nil {
+		return nil, codelab.InternalError("Failed to get public tests: " + err.Error())
+	}
+
+	publicTestsToAPI := make([]*codelab.Test, len(publicTests))
+	for i, test := range publicTests {
+		publicTestsToAPI[i] = &codelab.Test{
+			ID:          test.ID,
+			Input:       test.Input,
+			Output:      test.Output,
+			Public:      test.Public,
+			InputFormat: test.InputFormat,
+			Comparison:  test.Comparison,
+			Tolerance:   test.Tolerance,
+			Weight:      test.Weight,
+			ExerciseID:  test.ExerciseID,
+			CreatedAt:   test.CreatedAt.Time.UnixMilli(),
+			UpdatedAt:   test.UpdatedAt.Time.UnixMilli(),
+		}
+	}
+
+	attempts, err := s.attemptsRepo.GetAttemptsByUserAndExercise(ctx, codelabdb.GetAttemptsByUserAndExerciseParams{
+		ExerciseID: exercise.ID,
+		UserID:     profile.UserID,
+	})
+	if err != nil {
+		return nil, codelab.InternalError("Failed to get attempts: " + err.Error())
+	}
+
+	attemptsToAPI := make([]*codelab.Attempt, len(attempts))
+	for i, attempt := range attempts {
+		attemptsToAPI[i] = &codelab.Attempt{
+			ID:              attempt.ID,
+			AnswerID:        attempt.AnswerID,
+			Code:            attempt.Code,
+			Success:         attempt.Success,
+			Status:          attempt.Status,
+			Points:          attempt.Points,
+			MaxPoints:       attempt.MaxPoints,
+			Score:           attempt.Score,
+			Late:            attempt.Late,
+			ExerciseVersion: attempt.ExerciseVersion,
+			CreatedAt:       attempt.CreatedAt.Time.UnixMilli(),
+		}
+	}
+
+	return &codelab.ExerciseForStudents{
+		ID:          exercise.ID,
+		Title:       exercise.Title,
+		Description: exercise.Description,
+		InitialCode: exercise.InitialCode,
+		Difficulty:  exercise.Difficulty,
+		Language:    exercise.Language,
+		Tags:        exerciseTags(exercise.Tags),
+		Version:     exercise.Version,
+		CreatedBy:   exercise.CreatedBy,
+		Tests:       publicTestsToAPI,
+		Attempts:    attemptsToAPI,
+		CreatedAt:   exercise.CreatedAt.Time.UnixMilli(),
+		UpdatedAt:   exercise.UpdatedAt.Time.UnixMilli(),
+	}, nil
+}
+
+func (s *codelabsvrc) ListExercisesForStudents(ctx context.Context, payload *codelab.ListExercisesForStudentsPayload) (res *codelab.ExerciseForStudentsPage, err error) {
+	profile, err := s.profilesServiceRepo.GetCompleteProfile(context.Background(), &profiles.GetCompleteProfilePayload{
+		SessionToken: payload.SessionToken,
+	})
+	if err != nil || profile == nil {
+		return nil, codelab.Unauthorized("Unauthorized access")
+	}
+
+	filters, err := newExerciseFilters(payload.Search, payload.Difficulty, payload.Tags, payload.CreatedBy, payload.Cursor, payload.Limit)
+	if err != nil {
+		return nil, err
+	}
+	completed := pgtype.Bool{}
+	if payload.Completed != nil {
+		completed = pgtype.Bool{Bool: *payload.Completed, Valid: true}
+	}
+
+	exercises, err := s.exercisesRepo.ListExercisesToResolve(ctx, codelabdb.ListExercisesToResolveParams{
+		UserID:         profile.UserID,
+		Search:         filters.search,
+		Difficulty:     filters.difficulty,
+		Tags:           filters.tags,
+		CreatedBy:      filters.createdBy,
+		Completed:      completed,
+		AfterCreatedAt: filters.afterCreatedAt,
+		AfterID:        filters.afterID,
+		RowLimit:       filters.rowLimit,
+	})
+	if err != nil {
+		return nil, codelab.InternalError("Failed to list exercises: " + err.Error())
+	}
+
+	page := &codelab.ExerciseForStudentsPage{}
+	if len(exercises) > payload.Limit {
+		exercises = exercises[:payload.Limit]
+		last := exercises[len(exercises)-1]
+		page.NextCursor = ptr(encodeCursor(last.CreatedAt.Time, last.ID))
+	}
+
+	page.Exercises = make([]*codelab.ExerciseForStudentsListView, len(exercises))
+	for i, exercise := range exercises {
+		page.Exercises[i] = &codelab.ExerciseForStudentsListView{
+			ID:          exercise.ID,
+			Title:       exercise.Title,
+			Description: exercise.Description,
+			Difficulty:  exercise.Difficulty,
+			Language:    exercise.Language,
+			Tags:        exerciseTags(exercise.Tags),
+			Completed:   exercise.Completed,
+			CreatedBy:   exercise.CreatedBy,
+			CreatedAt:   exercise.CreatedAt.Time.UnixMilli(),
+			UpdatedAt:   exercise.UpdatedAt.Time.UnixMilli(),
+		}
+	}
+
+	return page, nil
+}
+
+func (s *codelabsvrc) CreateAttempt(ctx context.Context, payload *codelab.CreateAttemptPayload) (res *codelab.SimpleResponse, err error) {
+	profile, err := s.profilesServiceRepo.GetCompleteProfile(context.Background(), &profiles.GetCompleteProfilePayload{
+		SessionToken: payload.SessionToken,
+	})
+	if err != nil || profile == nil {
+		return nil, codelab.Unauthorized("Unauthorized access")
+	}
+
+	if profile.Role != "student" {
+		return nil, codelab.PermissionDenied("Only students can create attempts")
+	}
+
+	g, err := s.prepareAttempt(ctx, payload.ExerciseID, profile.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	release, err := s.acquireExecution(ctx, ratelimit.ActionAttempt, profile.UserID)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
+	// Execute and validate code against all tests in the sandbox
+	result, err := s.executor.Run(ctx, sandbox.Language(g.language), payload.Code, g.cases)
+	if err != nil {
+		return nil, executionError(err)
+	}
+
+	points, maxPoints, score := g.score(result)
+	attempt, err := s.attemptsRepo.CreateAttempt(ctx, codelabdb.CreateAttemptParams{
+		AnswerID:        g.answer.ID,
+		Code:            payload.Code,
+		Success:         result.Status == sandbox.StatusPassed,
+		Status:          string(result.Status),
+		Points:          points,
+		MaxPoints:       maxPoints,
+		Score:           score,
+		Late:            g.late,
+		ExerciseVersion: g.version,
+		Message:         g.message(result),
+	})
+	if err != nil {
+		return nil, codelab.InternalError("Failed to create attempt: " + err.Error())
+	}
+
+	if err := s.saveAttemptResults(ctx, g, attempt.ID, result, score); err != nil {
+		return nil, err
+	}
+
+	return &codelab.SimpleResponse{
+		Message: g.message(result),
+		Success: result.Status == sandbox.StatusPassed,
+	}, nil
+}
+
+func (s *codelabsvrc) SubmitAttempt(ctx context.Context, payload *codelab.CreateAttemptPayload) (res *codelab.AttemptSubmission, err error) {
+	profile, err := s.profilesServiceRepo.GetCompleteProfile(context.Background(), &profiles.GetCompleteProfilePayload{
+		SessionToken: payload.SessionToken,
+	})
+	if err != nil || profile == nil {
+		return nil, codelab.Unauthorized("Unauthorized access")
+	}
+
+	if profile.Role != "student" {
+		return nil, codelab.PermissionDenied("Only students can submit attempts")
+	}
+
+	g, err := s.prepareAttempt(ctx, payload.ExerciseID, profile.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	// The execution slot is held until the attempt is graded
+	release, err := s.acquireExecution(ctx, ratelimit.ActionAttempt, profile.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	// The attempt is stored right away so it can be followed, and its outcome
+	// is filled in once graded
+	attempt, err := s.attemptsRepo.CreateAttempt(ctx, codelabdb.CreateAttemptParams{
+		AnswerID:        g.answer.ID,
+		Code:            payload.Code,
+		Status:          attemptPending,
+		Late:            g.late,
+		ExerciseVersion: g.version,
+	})
+	if err != nil {
+		release()
+		return nil, codelab.InternalError("Failed to create attempt: " + err.Error())
+	}
+
+	s.broker.Open(attempt.ID)
+	s.broker.Publish(attempt.ID, grading.Event{Type: grading.EventQueued, Total: len(g.cases)})
+	err = s.queue.Submit(func(ctx context.Context) {
+		defer release()
+		s.gradeAttempt(ctx, g, attempt.ID, payload.Code)
+	})
+	if err != nil {
+		release()
+		// Nothing will grade the attempt, so it is dropped rather than
+		// counted as a failed attempt
+		s.dropAttempt(ctx, attempt.ID, len(g.cases), "Grading is busy, try again later")
+		return nil, codelab.ServiceUnavailable("Grading is busy, try again later")
+	}
+
+	return &codelab.AttemptSubmission{
+		AttemptID:  attempt.ID,
+		TotalTests: int64(len(g.cases)),
+		Late:       g.late,
+	}, nil
+}
+
+func (s *codelabsvrc) StreamAttemptEvents(ctx context.Context, payload *codelab.StreamAttemptEventsPayload, stream codelab.StreamAttemptEventsServerStream) (err error) {
+	profile, err := s.profilesServiceRepo.GetCompleteProfile(context.Background(), &profiles.GetCompleteProfilePayload{
+		SessionToken: payload.SessionToken,
+	})
+	if err != nil || profile == nil {
+		return codelab.Unauthorized("Unauthorized access")
+	}
+
+	if profile.Role != "student" {
+		return codelab.PermissionDenied("Only students can follow their attempts")
+	}
+
+	attempt, err := s.attemptsRepo.GetAttemptByUser(ctx, codelabdb.GetAttemptByUserParams{
+		ID:     payload.ID,
+		UserID: profile.UserID,
+	})
+	if err != nil {
+		return codelab.NotFound("Attempt not found")
+	}
+
+	err = s.broker.Watch(ctx, attempt.ID, func(event grading.Event) error {
+		return stream.SendWithContext(ctx, attemptEventToAPI(attempt.ID, event))
+	})
+	if errors.Is(err, grading.ErrUnknownAttempt) {
+		// Graded a while ago, so its stored outcome is sent instead
+		err = s.replayAttempt(ctx, attempt, stream)
+	}
+	if err != nil {
+		return err
+	}
+
+	return stream.Close()
+}
+
+func (s *codelabsvrc) RunCode(ctx context.Context, payload *codelab.RunCodePayload) (res *codelab.RunCodeResult, err error) {
+	profile, err := s.profilesServiceRepo.GetCompleteProfile(context.Background(), &profiles.GetCompleteProfilePayload{
+		SessionToken: payload.SessionToken,
+	})
+	if err != nil || profile == nil {
+		return nil, codelab.Unauthorized("Unauthorized access")
+	}
+
+	if profile.Role != "student" {
+		return nil, codelab.PermissionDenied("Only students can run code")
+	}
+
+	exercise, err := s.exercisesRepo.GetExerciseToResolveById(ctx, payload.ExerciseID)
+	if err != nil {
+		return nil, codelab.NotFound("Exercise not found")
+	}
+
+	// Custom inputs have no expected output, so they are only run
+	var cases []sandbox.TestCase
+	if len(payload.Inputs) > 0 {
+		cases = make([]sandbox.TestCase, len(payload.Inputs))
+		for i, input := range payload.Inputs {
+			cases[i] = sandbox.TestCase{
+				Input:       input,
+				InputFormat: sandbox.InputFormat(payload.InputFormat),
+				Unchecked:   true,
+			}
+			if err := cases[i].Validate(); err != nil {
+				return nil, codelab.InvalidInput("Invalid input: " + err.Error())
+			}
+		}
+	} else {
+		publicTests, err := s.testsRepo.GetPublicTestsByExercise(ctx, exercise.ID)
+		if err != nil {
+			return nil, codelab.InternalError("Failed to get public tests: " + err.Error())
+		}
+
+		cases = make([]sandbox.TestCase, len(publicTests))
+		for i, test := range publicTests {
+			cases[i] = testCase(test)
+		}
+	}
+
+	release, err := s.acquireExecution(ctx, ratelimit.ActionRun, profile.UserID)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
+	// Dry runs never create answers or attempts
+	result, err := s.executor.Run(ctx, sandbox.Language(exercise.Language), payload.Code, cases)
+	if err != nil {
+		return nil, executionError(err)
+	}
+
+	return &codelab.RunCodeResult{
+		Status:  string(result.Status),
+		Results: runCaseResults(result, cases),
+	}, nil
+}
+
+func (s *codelabsvrc) GetAttemptsByUserAndExercise(ctx context.Context, payload *codelab.GetAttemptsByUserAndExercisePayload) (res []*codelab.Attempt, err error) {
+	profile, err := s.profilesServiceRepo.GetCompleteProfile(context.Background(), &profiles.GetCompleteProfilePayload{
+		SessionToken: payload.SessionToken,
+	})
+	if err != nil || profile == nil {
+		return nil, codelab.Unauthorized("Unauthorized access")
+	}
+
+	if profile.Role != "student" {
+		return nil, codelab.PermissionDenied("Only students can access this endpoint")
+	}
+
+	// Students can only see their own attempts
+	if profile.UserID != payload.UserID {
+		return nil, codelab.PermissionDenied("Students can only view their own attempts")
 	}
 
 	attempts, err := s.attemptsRepo.GetAttemptsByUserAndExercise(ctx, codelabdb.GetAttemptsByUserAndExerciseParams{
@@ -536,20 +1741,195 @@ func (s *codelabsvrc) GetAttemptsByUserAndExercise(ctx context.Context, payload
 		return nil, codelab.InternalError("Failed to get attempts: " + err.Error())
 	}
 
+	testResults, err := s.attemptsRepo.GetTestResultsByUserAndExercise(ctx, codelabdb.GetTestResultsByUserAndExerciseParams{
+		UserID:     payload.UserID,
+		ExerciseID: payload.ExerciseID,
+	})
+	if err != nil {
+		return nil, codelab.InternalError("Failed to get test results: " + err.Error())
+	}
+	resultsByAttempt := groupTestResults(testResults)
+
 	result := make([]*codelab.Attempt, len(attempts))
 	for i, attempt := range attempts {
 		result[i] = &codelab.Attempt{
-			ID:        attempt.ID,
-			AnswerID:  attempt.AnswerID,
-			Code:      attempt.Code,
-			Success:   attempt.Success,
-			CreatedAt: attempt.CreatedAt.Time.UnixMilli(),
+			ID:              attempt.ID,
+			AnswerID:        attempt.AnswerID,
+			Code:            attempt.Code,
+			Success:         attempt.Success,
+			Status:          attempt.Status,
+			Points:          attempt.Points,
+			MaxPoints:       attempt.MaxPoints,
+			Score:           attempt.Score,
+			Late:            attempt.Late,
+			ExerciseVersion: attempt.ExerciseVersion,
+			TestResults:     resultsByAttempt[attempt.ID],
+			CreatedAt:       attempt.CreatedAt.Time.UnixMilli(),
 		}
 	}
 
 	return result, nil
 }
 
+func (s *codelabsvrc) GetHintsForStudent(ctx context.Context, payload *codelab.GetHintsForStudentPayload) (res []*codelab.StudentHint, err error) {
+	profile, err := s.profilesServiceRepo.GetCompleteProfile(context.Background(), &profiles.GetCompleteProfilePayload{
+		SessionToken: payload.SessionToken,
+	})
+	if err != nil || profile == nil {
+		return nil, codelab.Unauthorized("Unauthorized access")
+	}
+
+	if profile.Role != "student" {
+		return nil, codelab.PermissionDenied("Only students can view their hints")
+	}
+
+	exercise, err := s.exercisesRepo.GetExerciseToResolveById(ctx, payload.ExerciseID)
+	if err != nil {
+		return nil, codelab.NotFound("Exercise not found")
+	}
+
+	hints, err := s.hintsRepo.GetHintsByExercise(ctx, exercise.ID)
+	if err != nil {
+		return nil, codelab.InternalError("Failed to get hints: " + err.Error())
+	}
+
+	// Students without an answer have not started the exercise yet
+	var progress hintProgress
+	answer, err := s.answersRepo.GetAnswerByUserAndExercise(ctx, codelabdb.GetAnswerByUserAndExerciseParams{
+		ExerciseID: exercise.ID,
+		UserID:     profile.UserID,
+	})
+	switch {
+	case errors.Is(err, pgx.ErrNoRows):
+	case err != nil:
+		return nil, codelab.InternalError("Failed to get answer: " + err.Error())
+	default:
+		progress, err = s.loadHintProgress(ctx, answer)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return studentHints(hints, progress, time.Now()), nil
+}
+
+func (s *codelabsvrc) RequestHint(ctx context.Context, payload *codelab.RequestHintPayload) (res *codelab.StudentHint, err error) {
+	profile, err := s.profilesServiceRepo.GetCompleteProfile(context.Background(), &profiles.GetCompleteProfilePayload{
+		SessionToken: payload.SessionToken,
+	})
+	if err != nil || profile == nil {
+		return nil, codelab.Unauthorized("Unauthorized access")
+	}
+
+	if profile.Role != "student" {
+		return nil, codelab.PermissionDenied("Only students can request hints")
+	}
+
+	exercise, err := s.exercisesRepo.GetExerciseToResolveById(ctx, payload.ExerciseID)
+	if err != nil {
+		return nil, codelab.NotFound("Exercise not found")
+	}
+
+	hints, err := s.hintsRepo.GetHintsByExercise(ctx, exercise.ID)
+	if err != nil {
+		return nil, codelab.InternalError("Failed to get hints: " + err.Error())
+	}
+
+	// Requesting a hint starts the exercise, like a first attempt
+	answer, err := s.ensureAnswer(ctx, exercise.ID, profile.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	progress, err := s.loadHintProgress(ctx, answer)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	views := studentHints(hints, progress, now)
+	next := slices.IndexFunc(views, func(hint *codelab.StudentHint) bool {
+		return hint.Status != "revealed"
+	})
+	if next == -1 {
+		return nil, codelab.NotFound("No hints left to reveal")
+	}
+	if views[next].Status != "available" {
+		return nil, codelab.InvalidInput(lockedHintMessage(views[next]))
+	}
+
+	err = s.txManager.WithTransaction(ctx, func(tx pgx.Tx) error {
+		txRepos := s.withTransaction(tx)
+		created, err := txRepos.HintsRepo.CreateHintUsage(ctx, codelabdb.CreateHintUsageParams{
+			AnswerID: answer.ID,
+			HintID:   hints[next].ID,
+		})
+		if err != nil {
+			return err
+		}
+		// A concurrent request already revealed the hint and counted it
+		if created == 0 {
+			return nil
+		}
+		return txRepos.AnswersRepo.RecordAnswerHintUsage(ctx, codelabdb.RecordAnswerHintUsageParams{
+			ID:          answer.ID,
+			HintPenalty: hints[next].Penalty,
+		})
+	})
+	if err != nil {
+		return nil, codelab.InternalError("Failed to reveal hint: " + err.Error())
+	}
+
+	progress.revealed[hints[next].ID] = now
+	return studentHint(hints[next], progress, now, true), nil
+}
+
+func (s *codelabsvrc) ListAssignmentsForStudents(ctx context.Context, payload *codelab.ListAssignmentsForStudentsPayload) (res []*codelab.Assignment, err error) {
+	profile, err := s.profilesServiceRepo.GetCompleteProfile(context.Background(), &profiles.GetCompleteProfilePayload{
+		SessionToken: payload.SessionToken,
+	})
+	if err != nil || profile == nil {
+		return nil, codelab.Unauthorized("Unauthorized access")
+	}
+
+	if profile.Role != "student" {
+		return nil, codelab.PermissionDenied("Only students can access this endpoint")
+	}
+
+	return s.listAssignments(ctx)
+}
+
+func (s *codelabsvrc) GetAssignmentProgress(ctx context.Context, payload *codelab.GetAssignmentProgressPayload) (res *codelab.AssignmentProgress, err error) {
+	profile, err := s.profilesServiceRepo.GetCompleteProfile(context.Background(), &profiles.GetCompleteProfilePayload{
+		SessionToken: payload.SessionToken,
+	})
+	if err != nil || profile == nil {
+		return nil, codelab.Unauthorized("Unauthorized access")
+	}
+
+	if profile.Role != "student" {
+		return nil, codelab.PermissionDenied("Only students can view their assignment progress")
+	}
+
+	assignment, err := s.loadAssignment(ctx, payload.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := s.assignmentsRepo.GetAssignmentGrades(ctx, codelabdb.GetAssignmentGradesParams{
+		AssignmentID: payload.ID,
+		UserID:       pgtype.Int8{Int64: profile.UserID, Valid: true},
+	})
+	if err != nil {
+		return nil, codelab.InternalError("Failed to get assignment grades: " + err.Error())
+	}
+
+	return &codelab.AssignmentProgress{
+		Assignment: assignment,
+		Progress:   assignmentGrade(profile.UserID, assignment.Exercises, rows),
+	}, nil
+}
+
 func (s *codelabsvrc) GetAnswerByUserAndExercise(ctx context.Context, payload *codelab.GetAnswerByUserAndExercisePayload) (res *codelab.Answer, err error) {
 	profile, err := s.profilesServiceRepo.GetCompleteProfile(context.Background(), &profiles.GetCompleteProfilePayload{
 		SessionToken: payload.SessionToken,
@@ -575,12 +1955,20 @@ func (s *codelabsvrc) GetAnswerByUserAndExercise(ctx context.Context, payload *c
 		return nil, codelab.NotFound("Answer not found")
 	}
 
-	return &codelab.Answer{
-		ID:         answer.ID,
-		ExerciseID: answer.ExerciseID,
-		UserID:     answer.UserID,
-		Completed:  answer.Completed,
-		CreatedAt:  answer.CreatedAt.Time.UnixMilli(),
-		UpdatedAt:  answer.UpdatedAt.Time.UnixMilli(),
-	}, nil
+	result := &codelab.Answer{
+		ID:          answer.ID,
+		ExerciseID:  answer.ExerciseID,
+		UserID:      answer.UserID,
+		Completed:   answer.Completed,
+		BestScore:   answer.BestScore,
+		HintsUsed:   answer.HintsUsed,
+		HintPenalty: answer.HintPenalty,
+		CreatedAt:   answer.CreatedAt.Time.UnixMilli(),
+		UpdatedAt:   answer.UpdatedAt.Time.UnixMilli(),
+	}
+	if answer.BestAttemptID.Valid {
+		result.BestAttemptID = &answer.BestAttemptID.Int64
+	}
+
+	return result, nil
 }
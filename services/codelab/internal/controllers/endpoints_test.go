@@ -3,15 +3,26 @@ package controllers
 import (
 	"context"
 	"errors"
+	"reflect"
+	"strconv"
+	"strings"
 	"testing"
 	"time"
 
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/ynoacamino/infra-sustainable-classrooms/services/codelab/gen/codelab"
 	codelabdb "github.com/ynoacamino/infra-sustainable-classrooms/services/codelab/gen/database"
+	"github.com/ynoacamino/infra-sustainable-classrooms/services/codelab/internal/bundle"
+	"github.com/ynoacamino/infra-sustainable-classrooms/services/codelab/internal/grading"
+	"github.com/ynoacamino/infra-sustainable-classrooms/services/codelab/internal/ratelimit"
+	"github.com/ynoacamino/infra-sustainable-classrooms/services/codelab/internal/repositories"
 	"github.com/ynoacamino/infra-sustainable-classrooms/services/codelab/internal/repositories/mocks"
+	"github.com/ynoacamino/infra-sustainable-classrooms/services/codelab/internal/sandbox"
+	"github.com/ynoacamino/infra-sustainable-classrooms/services/codelab/internal/versioning"
 	"github.com/ynoacamino/infra-sustainable-classrooms/services/profiles/gen/profiles"
 )
 
@@ -40,12 +51,40 @@ func setupTestService(
 		attemptsRepo = &mocks.MockAttemptsRepository{}
 	}
 
+	// Exercises belong to no assignment unless a test says otherwise
+	assignmentsRepo := &mocks.MockAssignmentsRepository{}
+	assignmentsRepo.On("GetAssignmentsByExercise", mock.Anything, mock.Anything).Return([]codelabdb.Assignment{}, nil).Maybe()
+
+	// Transactions run against the same mocks unless a test says otherwise
+	txManager := &mocks.MockTransactionManager{}
+	txManager.On("WithTransaction", mock.Anything).Return(nil).Maybe()
+	txRepos := &repositories.TransactionalRepositories{
+		ExercisesRepo:   exercisesRepo,
+		TestsRepo:       testsRepo,
+		AnswersRepo:     answersRepo,
+		AttemptsRepo:    attemptsRepo,
+		AssignmentsRepo: assignmentsRepo,
+	}
+
+	// Executions are never rate limited unless a test says otherwise
+	limiter := &mocks.MockRateLimiter{}
+	limiter.On("Acquire", mock.Anything, mock.Anything, mock.Anything).Return(func() {}, nil).Maybe()
+
 	return &codelabsvrc{
 		profilesServiceRepo: profilesServiceRepo,
 		exercisesRepo:       exercisesRepo,
 		testsRepo:           testsRepo,
 		answersRepo:         answersRepo,
 		attemptsRepo:        attemptsRepo,
+		assignmentsRepo:     assignmentsRepo,
+		txManager:           txManager,
+		withTransaction:     func(pgx.Tx) *repositories.TransactionalRepositories { return txRepos },
+		queue:               grading.NewQueue(1, 10),
+		broker:              grading.NewBroker(time.Minute),
+		regradeQueue:        grading.NewQueue(1, 10),
+		regradePool:         grading.NewPool(2),
+		executor:            sandbox.NewExecutor(sandbox.DefaultOptions()),
+		limiter:             limiter,
 	}
 }
 
@@ -96,6 +135,8 @@ func createTestExercise() codelabdb.Exercise {
 		InitialCode: "function solution(input) {\n  // Your code here\n}",
 		Solution:    "function solution(input) {\n  return input * 2;\n}",
 		Difficulty:  "easy",
+		Language:    "javascript",
+		Version:     1,
 		CreatedBy:   1,
 		CreatedAt:   pgtype.Timestamptz{Time: now, Valid: true},
 		UpdatedAt:   pgtype.Timestamptz{Time: now, Valid: true},
@@ -111,6 +152,8 @@ func createTestExerciseToResolve() codelabdb.GetExerciseToResolveByIdRow {
 		Description: "This is a test exercise",
 		InitialCode: "function solution(input) {\n  // Your code here\n}",
 		Difficulty:  "easy",
+		Language:    "javascript",
+		Version:     1,
 		CreatedBy:   1,
 		CreatedAt:   pgtype.Timestamptz{Time: now, Valid: true},
 		UpdatedAt:   pgtype.Timestamptz{Time: now, Valid: true},
@@ -152,6 +195,32 @@ func timePtr(t int64) *int64 {
 	return &t
 }
 
+// errorMessage returns the message carried by a service error. Goa string
+// errors report their design description from Error(), so the underlying
+// value is read directly.
+func errorMessage(err error) string {
+	if v := reflect.ValueOf(err); v.Kind() == reflect.String {
+		return v.String()
+	}
+	return err.Error()
+}
+
+// expectExerciseVersion sets up saving version of the test exercise with its
+// tests. Versions after the first one are made by bumping the version.
+func expectExerciseVersion(exercisesRepo *mocks.MockExercisesRepository, testsRepo *mocks.MockTestsRepository, version int32) {
+	exercise := createTestExercise()
+	exercise.Version = version
+	if version == 1 {
+		exercisesRepo.On("GetExerciseById", mock.Anything, exercise.ID).Return(exercise, nil)
+	} else {
+		exercisesRepo.On("BumpExerciseVersion", mock.Anything, exercise.ID).Return(exercise, nil)
+	}
+	testsRepo.On("GetTestsByExercise", mock.Anything, exercise.ID).Return([]codelabdb.Test{}, nil).Maybe()
+	exercisesRepo.On("CreateExerciseVersion", mock.Anything, mock.MatchedBy(func(arg codelabdb.CreateExerciseVersionParams) bool {
+		return arg.ExerciseID == exercise.ID && arg.Version == version
+	})).Return(nil)
+}
+
 // ========================================
 // EXERCISE TESTS
 // ========================================
@@ -160,12 +229,14 @@ func TestCreateExercise_Success(t *testing.T) {
 	// Arrange
 	mockProfilesServiceRepo := &mocks.MockProfilesServiceRepository{}
 	mockExercisesRepo := &mocks.MockExercisesRepository{}
-	service := setupTestService(mockProfilesServiceRepo, mockExercisesRepo, nil, nil, nil)
+	mockTestsRepo := &mocks.MockTestsRepository{}
+	service := setupTestService(mockProfilesServiceRepo, mockExercisesRepo, mockTestsRepo, nil, nil)
 
 	expectedProfile := createTestTeacherProfile()
 
 	mockProfilesServiceRepo.On("GetCompleteProfile", mock.Anything, mock.AnythingOfType("*profiles.GetCompleteProfilePayload")).Return(expectedProfile, nil)
-	mockExercisesRepo.On("CreateExercise", mock.Anything, mock.AnythingOfType("codelabdb.CreateExerciseParams")).Return(nil)
+	mockExercisesRepo.On("CreateExercise", mock.Anything, mock.AnythingOfType("codelabdb.CreateExerciseParams")).Return(int64(1), nil)
+	expectExerciseVersion(mockExercisesRepo, mockTestsRepo, 1)
 
 	// Act
 	result, err := service.CreateExercise(context.Background(), &codelab.CreateExercisePayload{
@@ -175,6 +246,7 @@ func TestCreateExercise_Success(t *testing.T) {
 		InitialCode:  "function solution(input) {\n  // Your code here\n}",
 		Solution:     "function solution(input) {\n  return input * 2;\n}",
 		Difficulty:   "easy",
+		Language:     "javascript",
 	})
 
 	// Assert
@@ -186,6 +258,44 @@ func TestCreateExercise_Success(t *testing.T) {
 	mockExercisesRepo.AssertExpectations(t)
 }
 
+func TestUpdateExercise_KeepsLanguageWhenOmitted(t *testing.T) {
+	// Arrange
+	mockProfilesServiceRepo := &mocks.MockProfilesServiceRepository{}
+	mockExercisesRepo := &mocks.MockExercisesRepository{}
+	mockTestsRepo := &mocks.MockTestsRepository{}
+	service := setupTestService(mockProfilesServiceRepo, mockExercisesRepo, mockTestsRepo, nil, nil)
+
+	exercise := createTestExercise()
+	exercise.Language = "starlark"
+
+	mockProfilesServiceRepo.On("GetCompleteProfile", mock.Anything, mock.AnythingOfType("*profiles.GetCompleteProfilePayload")).Return(createTestTeacherProfile(), nil)
+	mockExercisesRepo.On("GetExerciseById", mock.Anything, int64(1)).Return(exercise, nil)
+	mockTestsRepo.On("GetTestsByExercise", mock.Anything, int64(1)).Return([]codelabdb.Test{}, nil)
+	mockExercisesRepo.On("UpdateExercise", mock.Anything, mock.MatchedBy(func(arg codelabdb.UpdateExerciseParams) bool {
+		return arg.Language == "starlark"
+	})).Return(nil)
+	expectExerciseVersion(mockExercisesRepo, mockTestsRepo, 2)
+
+	// Act
+	result, err := service.UpdateExercise(context.Background(), &codelab.UpdateExercisePayload2{
+		SessionToken: "valid-session-token",
+		ID:           1,
+		Exercise: &codelab.UpdateExercisePayload{
+			Title:       "Updated Exercise",
+			Description: "This is an updated exercise",
+			InitialCode: "def solution(input):\n    pass\n",
+			Solution:    "def solution(input):\n    return input\n",
+			Difficulty:  "medium",
+		},
+	})
+
+	// Assert
+	assert.NoError(t, err)
+	assert.NotNil(t, result)
+	assert.True(t, result.Success)
+	mockExercisesRepo.AssertExpectations(t)
+}
+
 func TestCreateExercise_Unauthorized(t *testing.T) {
 	// Arrange
 	mockProfilesServiceRepo := &mocks.MockProfilesServiceRepository{}
@@ -206,7 +316,7 @@ func TestCreateExercise_Unauthorized(t *testing.T) {
 	// Assert
 	assert.Error(t, err)
 	assert.Nil(t, result)
-	assert.Contains(t, err.Error(), "Unauthorized access")
+	assert.Contains(t, errorMessage(err), "Unauthorized access")
 	mockProfilesServiceRepo.AssertExpectations(t)
 }
 
@@ -232,7 +342,7 @@ func TestCreateExercise_PermissionDenied_Student(t *testing.T) {
 	// Assert
 	assert.Error(t, err)
 	assert.Nil(t, result)
-	assert.Contains(t, err.Error(), "Only teachers can create exercises")
+	assert.Contains(t, errorMessage(err), "Only teachers can create exercises")
 	mockProfilesServiceRepo.AssertExpectations(t)
 }
 
@@ -245,7 +355,7 @@ func TestCreateExercise_DatabaseError(t *testing.T) {
 	expectedProfile := createTestTeacherProfile()
 
 	mockProfilesServiceRepo.On("GetCompleteProfile", mock.Anything, mock.AnythingOfType("*profiles.GetCompleteProfilePayload")).Return(expectedProfile, nil)
-	mockExercisesRepo.On("CreateExercise", mock.Anything, mock.AnythingOfType("codelabdb.CreateExerciseParams")).Return(errors.New("database error"))
+	mockExercisesRepo.On("CreateExercise", mock.Anything, mock.AnythingOfType("codelabdb.CreateExerciseParams")).Return(int64(0), errors.New("database error"))
 
 	// Act
 	result, err := service.CreateExercise(context.Background(), &codelab.CreateExercisePayload{
@@ -255,12 +365,13 @@ func TestCreateExercise_DatabaseError(t *testing.T) {
 		InitialCode:  "function solution(input) {\n  // Your code here\n}",
 		Solution:     "function solution(input) {\n  return input * 2;\n}",
 		Difficulty:   "easy",
+		Language:     "javascript",
 	})
 
 	// Assert
 	assert.Error(t, err)
 	assert.Nil(t, result)
-	assert.Contains(t, err.Error(), "Failed to create exercise")
+	assert.Contains(t, errorMessage(err), "Failed to create exercise")
 	mockProfilesServiceRepo.AssertExpectations(t)
 	mockExercisesRepo.AssertExpectations(t)
 }
@@ -315,7 +426,7 @@ func TestGetExercise_PermissionDenied_Student(t *testing.T) {
 	// Assert
 	assert.Error(t, err)
 	assert.Nil(t, result)
-	assert.Contains(t, err.Error(), "Only teachers can view exercise with solution")
+	assert.Contains(t, errorMessage(err), "Only teachers can view exercise with solution")
 	mockProfilesServiceRepo.AssertExpectations(t)
 }
 
@@ -339,11 +450,272 @@ func TestGetExercise_NotFound(t *testing.T) {
 	// Assert
 	assert.Error(t, err)
 	assert.Nil(t, result)
-	assert.Contains(t, err.Error(), "Exercise not found")
+	assert.Contains(t, errorMessage(err), "Exercise not found")
 	mockProfilesServiceRepo.AssertExpectations(t)
 	mockExercisesRepo.AssertExpectations(t)
 }
 
+// ========================================
+// CATALOG SEARCH TESTS
+// ========================================
+
+func TestCreateExercise_NormalizesTags(t *testing.T) {
+	// Arrange
+	mockProfilesServiceRepo := &mocks.MockProfilesServiceRepository{}
+	mockExercisesRepo := &mocks.MockExercisesRepository{}
+	mockTestsRepo := &mocks.MockTestsRepository{}
+	service := setupTestService(mockProfilesServiceRepo, mockExercisesRepo, mockTestsRepo, nil, nil)
+
+	mockProfilesServiceRepo.On("GetCompleteProfile", mock.Anything, mock.AnythingOfType("*profiles.GetCompleteProfilePayload")).Return(createTestTeacherProfile(), nil)
+	mockExercisesRepo.On("CreateExercise", mock.Anything, mock.MatchedBy(func(arg codelabdb.CreateExerciseParams) bool {
+		return assert.ObjectsAreEqual([]string{"dynamic-programming", "recursion"}, arg.Tags)
+	})).Return(int64(1), nil)
+	expectExerciseVersion(mockExercisesRepo, mockTestsRepo, 1)
+
+	// Act
+	result, err := service.CreateExercise(context.Background(), &codelab.CreateExercisePayload{
+		SessionToken: "valid-session-token",
+		Title:        "Fibonacci",
+		Description:  "Return the nth Fibonacci number",
+		InitialCode:  "function solution(input) {\n}",
+		Solution:     "function solution(input) {\n  return input;\n}",
+		Difficulty:   "easy",
+		Language:     "javascript",
+		Tags:         []string{" Recursion ", "Dynamic  Programming", "recursion"},
+	})
+
+	// Assert
+	assert.NoError(t, err)
+	assert.True(t, result.Success)
+	mockExercisesRepo.AssertExpectations(t)
+}
+
+func TestCreateExercise_InvalidTags(t *testing.T) {
+	tests := []struct {
+		name          string
+		tags          []string
+		expectedError string
+	}{
+		{
+			name:          "empty tag",
+			tags:          []string{"  "},
+			expectedError: "Invalid tags: tags cannot be empty",
+		},
+		{
+			name:          "forbidden characters",
+			tags:          []string{"a/b"},
+			expectedError: `Invalid tags: tag "a/b" can only contain letters, digits and -+#.`,
+		},
+		{
+			name:          "too many tags",
+			tags:          []string{"a", "b", "c", "d", "e", "f", "g", "h", "i", "j", "k"},
+			expectedError: "Invalid tags: an exercise can have at most 10 tags",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			mockProfilesServiceRepo := &mocks.MockProfilesServiceRepository{}
+			mockExercisesRepo := &mocks.MockExercisesRepository{}
+			service := setupTestService(mockProfilesServiceRepo, mockExercisesRepo, nil, nil, nil)
+
+			mockProfilesServiceRepo.On("GetCompleteProfile", mock.Anything, mock.AnythingOfType("*profiles.GetCompleteProfilePayload")).Return(createTestTeacherProfile(), nil)
+
+			// Act
+			result, err := service.CreateExercise(context.Background(), &codelab.CreateExercisePayload{
+				SessionToken: "valid-session-token",
+				Title:        "Fibonacci",
+				Description:  "Return the nth Fibonacci number",
+				InitialCode:  "function solution(input) {\n}",
+				Solution:     "function solution(input) {\n  return input;\n}",
+				Difficulty:   "easy",
+				Language:     "javascript",
+				Tags:         tt.tags,
+			})
+
+			// Assert
+			assert.Nil(t, result)
+			assert.IsType(t, codelab.InvalidInput(""), err)
+			assert.Equal(t, tt.expectedError, errorMessage(err))
+			mockExercisesRepo.AssertNotCalled(t, "CreateExercise", mock.Anything, mock.Anything)
+		})
+	}
+}
+
+func TestListExercises_FiltersAndPages(t *testing.T) {
+	// Arrange
+	mockProfilesServiceRepo := &mocks.MockProfilesServiceRepository{}
+	mockExercisesRepo := &mocks.MockExercisesRepository{}
+	service := setupTestService(mockProfilesServiceRepo, mockExercisesRepo, nil, nil, nil)
+
+	newest := createTestExercise()
+	newest.ID = 3
+	newest.Tags = []string{"recursion"}
+	middle := createTestExercise()
+	middle.ID = 2
+	middle.CreatedAt.Time = newest.CreatedAt.Time.Add(-time.Minute)
+	oldest := createTestExercise()
+	oldest.CreatedAt.Time = newest.CreatedAt.Time.Add(-time.Hour)
+
+	mockProfilesServiceRepo.On("GetCompleteProfile", mock.Anything, mock.AnythingOfType("*profiles.GetCompleteProfilePayload")).Return(createTestTeacherProfile(), nil)
+	mockExercisesRepo.On("ListExercises", mock.Anything, mock.MatchedBy(func(arg codelabdb.ListExercisesParams) bool {
+		return arg.Search == pgtype.Text{String: "fibonacci", Valid: true} &&
+			arg.Difficulty == pgtype.Text{String: "easy", Valid: true} &&
+			assert.ObjectsAreEqual([]string{"recursion"}, arg.Tags) &&
+			arg.CreatedBy == pgtype.Int8{Int64: 1, Valid: true} &&
+			!arg.AfterCreatedAt.Valid &&
+			arg.RowLimit == 3
+	})).Return([]codelabdb.Exercise{newest, middle, oldest}, nil).Once()
+	mockExercisesRepo.On("ListExercises", mock.Anything, mock.MatchedBy(func(arg codelabdb.ListExercisesParams) bool {
+		return arg.AfterCreatedAt.Time.Equal(middle.CreatedAt.Time.Truncate(time.Microsecond)) &&
+			arg.AfterID == pgtype.Int8{Int64: 2, Valid: true}
+	})).Return([]codelabdb.Exercise{oldest}, nil).Once()
+
+	// Act
+	first, err := service.ListExercises(context.Background(), &codelab.ListExercisesPayload{
+		SessionToken: "valid-session-token",
+		Search:       stringPtr("fibonacci"),
+		Difficulty:   stringPtr("easy"),
+		Tags:         []string{"Recursion"},
+		CreatedBy:    ptr(int64(1)),
+		Limit:        2,
+	})
+	assert.NoError(t, err)
+	second, err := service.ListExercises(context.Background(), &codelab.ListExercisesPayload{
+		SessionToken: "valid-session-token",
+		Cursor:       first.NextCursor,
+		Limit:        2,
+	})
+
+	// Assert
+	assert.NoError(t, err)
+	if assert.Len(t, first.Exercises, 2) {
+		assert.Equal(t, int64(3), first.Exercises[0].ID)
+		assert.Equal(t, []string{"recursion"}, first.Exercises[0].Tags)
+		assert.Equal(t, []string{}, first.Exercises[1].Tags)
+	}
+	assert.NotNil(t, first.NextCursor)
+	assert.Len(t, second.Exercises, 1)
+	assert.Nil(t, second.NextCursor)
+	mockExercisesRepo.AssertExpectations(t)
+}
+
+func TestListExercises_PermissionDenied_Student(t *testing.T) {
+	// Arrange
+	mockProfilesServiceRepo := &mocks.MockProfilesServiceRepository{}
+	mockExercisesRepo := &mocks.MockExercisesRepository{}
+	service := setupTestService(mockProfilesServiceRepo, mockExercisesRepo, nil, nil, nil)
+
+	mockProfilesServiceRepo.On("GetCompleteProfile", mock.Anything, mock.AnythingOfType("*profiles.GetCompleteProfilePayload")).Return(createTestStudentProfile(), nil)
+
+	// Act
+	result, err := service.ListExercises(context.Background(), &codelab.ListExercisesPayload{
+		SessionToken: "valid-session-token",
+		Limit:        50,
+	})
+
+	// Assert
+	assert.Nil(t, result)
+	assert.IsType(t, codelab.PermissionDenied(""), err)
+	mockExercisesRepo.AssertNotCalled(t, "ListExercises", mock.Anything, mock.Anything)
+}
+
+func TestListExercisesForStudents_NotCompleted(t *testing.T) {
+	// Arrange
+	mockProfilesServiceRepo := &mocks.MockProfilesServiceRepository{}
+	mockExercisesRepo := &mocks.MockExercisesRepository{}
+	service := setupTestService(mockProfilesServiceRepo, mockExercisesRepo, nil, nil, nil)
+
+	exercise := createTestExerciseToResolve()
+	mockProfilesServiceRepo.On("GetCompleteProfile", mock.Anything, mock.AnythingOfType("*profiles.GetCompleteProfilePayload")).Return(createTestStudentProfile(), nil)
+	mockExercisesRepo.On("ListExercisesToResolve", mock.Anything, mock.MatchedBy(func(arg codelabdb.ListExercisesToResolveParams) bool {
+		return arg.UserID == 1 &&
+			arg.Completed == pgtype.Bool{Bool: false, Valid: true} &&
+			assert.ObjectsAreEqual([]string{"recursion"}, arg.Tags) &&
+			arg.Difficulty == pgtype.Text{String: "easy", Valid: true} &&
+			!arg.Search.Valid &&
+			arg.RowLimit == 51
+	})).Return([]codelabdb.ListExercisesToResolveRow{{
+		ID:          exercise.ID,
+		Title:       exercise.Title,
+		Description: exercise.Description,
+		Difficulty:  exercise.Difficulty,
+		Language:    exercise.Language,
+		Tags:        []string{"recursion"},
+		CreatedBy:   exercise.CreatedBy,
+		CreatedAt:   exercise.CreatedAt,
+		UpdatedAt:   exercise.UpdatedAt,
+	}}, nil)
+
+	// Act
+	result, err := service.ListExercisesForStudents(context.Background(), &codelab.ListExercisesForStudentsPayload{
+		SessionToken: "valid-session-token",
+		Search:       stringPtr("  "),
+		Difficulty:   stringPtr("easy"),
+		Tags:         []string{"recursion"},
+		Completed:    ptr(false),
+		Limit:        50,
+	})
+
+	// Assert
+	assert.NoError(t, err)
+	if assert.Len(t, result.Exercises, 1) {
+		assert.Equal(t, int64(1), result.Exercises[0].ID)
+		assert.False(t, result.Exercises[0].Completed)
+		assert.Equal(t, []string{"recursion"}, result.Exercises[0].Tags)
+	}
+	assert.Nil(t, result.NextCursor)
+	mockExercisesRepo.AssertExpectations(t)
+}
+
+func TestListExercisesForStudents_InvalidCursor(t *testing.T) {
+	// Arrange
+	mockProfilesServiceRepo := &mocks.MockProfilesServiceRepository{}
+	mockExercisesRepo := &mocks.MockExercisesRepository{}
+	service := setupTestService(mockProfilesServiceRepo, mockExercisesRepo, nil, nil, nil)
+
+	mockProfilesServiceRepo.On("GetCompleteProfile", mock.Anything, mock.AnythingOfType("*profiles.GetCompleteProfilePayload")).Return(createTestStudentProfile(), nil)
+
+	// Act
+	result, err := service.ListExercisesForStudents(context.Background(), &codelab.ListExercisesForStudentsPayload{
+		SessionToken: "valid-session-token",
+		Cursor:       stringPtr("not a cursor"),
+		Limit:        50,
+	})
+
+	// Assert
+	assert.Nil(t, result)
+	assert.IsType(t, codelab.InvalidInput(""), err)
+	assert.Equal(t, "Invalid cursor", errorMessage(err))
+	mockExercisesRepo.AssertNotCalled(t, "ListExercisesToResolve", mock.Anything, mock.Anything)
+}
+
+func TestListTags_Success(t *testing.T) {
+	// Arrange
+	mockProfilesServiceRepo := &mocks.MockProfilesServiceRepository{}
+	mockExercisesRepo := &mocks.MockExercisesRepository{}
+	service := setupTestService(mockProfilesServiceRepo, mockExercisesRepo, nil, nil, nil)
+
+	mockProfilesServiceRepo.On("GetCompleteProfile", mock.Anything, mock.AnythingOfType("*profiles.GetCompleteProfilePayload")).Return(createTestStudentProfile(), nil)
+	mockExercisesRepo.On("ListTags", mock.Anything).Return([]codelabdb.ListTagsRow{
+		{Tag: "recursion", Exercises: 4},
+		{Tag: "strings", Exercises: 2},
+	}, nil)
+
+	// Act
+	result, err := service.ListTags(context.Background(), &codelab.ListTagsPayload{
+		SessionToken: "valid-session-token",
+	})
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, []*codelab.TagCount{
+		{Tag: "recursion", Exercises: 4},
+		{Tag: "strings", Exercises: 2},
+	}, result)
+}
+
 // ========================================
 // CREATE ATTEMPT TESTS (MOST CRITICAL)
 // ========================================
@@ -381,8 +753,10 @@ func TestCreateAttempt_Success_AllTestsPass(t *testing.T) {
 	mockExercisesRepo.On("GetExerciseToResolveById", mock.Anything, int64(1)).Return(expectedExercise, nil)
 	mockAnswersRepo.On("CheckIfAnswerExists", mock.Anything, mock.AnythingOfType("codelabdb.CheckIfAnswerExistsParams")).Return(int32(1), nil)
 	mockAnswersRepo.On("GetAnswerByUserAndExercise", mock.Anything, mock.AnythingOfType("codelabdb.GetAnswerByUserAndExerciseParams")).Return(expectedAnswer, nil)
-	mockTestsRepo.On("GetHiddenTestsByExercise", mock.Anything, int64(1)).Return(expectedHiddenTests, nil)
-	mockAttemptsRepo.On("CreateAttempt", mock.Anything, mock.AnythingOfType("codelabdb.CreateAttemptParams")).Return(nil)
+	mockTestsRepo.On("GetTestsByExercise", mock.Anything, int64(1)).Return(expectedHiddenTests, nil)
+	mockAttemptsRepo.On("CreateAttempt", mock.Anything, mock.AnythingOfType("codelabdb.CreateAttemptParams")).Return(createTestAttempt(false), nil)
+	mockAttemptsRepo.On("CreateAttemptTestResult", mock.Anything, mock.AnythingOfType("codelabdb.CreateAttemptTestResultParams")).Return(nil)
+	mockAnswersRepo.On("UpdateAnswerBestScore", mock.Anything, mock.AnythingOfType("codelabdb.UpdateAnswerBestScoreParams")).Return(nil)
 	mockAnswersRepo.On("UpdateAnswerCompleted", mock.Anything, mock.AnythingOfType("codelabdb.UpdateAnswerCompletedParams")).Return(nil)
 
 	// Valid JavaScript code that should pass the tests
@@ -424,7 +798,7 @@ func TestCreateAttempt_CodeExecutionError(t *testing.T) {
 	mockExercisesRepo.On("GetExerciseToResolveById", mock.Anything, int64(1)).Return(expectedExercise, nil)
 	mockAnswersRepo.On("CheckIfAnswerExists", mock.Anything, mock.AnythingOfType("codelabdb.CheckIfAnswerExistsParams")).Return(int32(1), nil)
 	mockAnswersRepo.On("GetAnswerByUserAndExercise", mock.Anything, mock.AnythingOfType("codelabdb.GetAnswerByUserAndExerciseParams")).Return(expectedAnswer, nil)
-	mockTestsRepo.On("GetHiddenTestsByExercise", mock.Anything, int64(1)).Return(expectedHiddenTests, nil)
+	mockTestsRepo.On("GetTestsByExercise", mock.Anything, int64(1)).Return(expectedHiddenTests, nil)
 
 	// Invalid JavaScript code
 	invalidCode := `function solution(input) { return input * 2; // missing closing brace`
@@ -439,7 +813,7 @@ func TestCreateAttempt_CodeExecutionError(t *testing.T) {
 	// Assert
 	assert.Error(t, err)
 	assert.Nil(t, result)
-	assert.Contains(t, err.Error(), "Code execution failed")
+	assert.Contains(t, errorMessage(err), "Code execution failed")
 	mockProfilesServiceRepo.AssertExpectations(t)
 	mockExercisesRepo.AssertExpectations(t)
 	mockAnswersRepo.AssertExpectations(t)
@@ -463,7 +837,7 @@ func TestCreateAttempt_MissingFunction(t *testing.T) {
 	mockExercisesRepo.On("GetExerciseToResolveById", mock.Anything, int64(1)).Return(expectedExercise, nil)
 	mockAnswersRepo.On("CheckIfAnswerExists", mock.Anything, mock.AnythingOfType("codelabdb.CheckIfAnswerExistsParams")).Return(int32(1), nil)
 	mockAnswersRepo.On("GetAnswerByUserAndExercise", mock.Anything, mock.AnythingOfType("codelabdb.GetAnswerByUserAndExerciseParams")).Return(expectedAnswer, nil)
-	mockTestsRepo.On("GetHiddenTestsByExercise", mock.Anything, int64(1)).Return(expectedHiddenTests, nil)
+	mockTestsRepo.On("GetTestsByExercise", mock.Anything, int64(1)).Return(expectedHiddenTests, nil)
 
 	// Code without required 'solution' function
 	codeWithoutFunction := `var x = 42;`
@@ -478,7 +852,7 @@ func TestCreateAttempt_MissingFunction(t *testing.T) {
 	// Assert
 	assert.Error(t, err)
 	assert.Nil(t, result)
-	assert.Contains(t, err.Error(), "Code must define a 'solution' function")
+	assert.Contains(t, errorMessage(err), "Code must define a 'solution' function")
 	mockProfilesServiceRepo.AssertExpectations(t)
 	mockExercisesRepo.AssertExpectations(t)
 	mockAnswersRepo.AssertExpectations(t)
@@ -511,8 +885,10 @@ func TestCreateAttempt_TestFailure_RuntimeError(t *testing.T) {
 	mockExercisesRepo.On("GetExerciseToResolveById", mock.Anything, int64(1)).Return(expectedExercise, nil)
 	mockAnswersRepo.On("CheckIfAnswerExists", mock.Anything, mock.AnythingOfType("codelabdb.CheckIfAnswerExistsParams")).Return(int32(1), nil)
 	mockAnswersRepo.On("GetAnswerByUserAndExercise", mock.Anything, mock.AnythingOfType("codelabdb.GetAnswerByUserAndExerciseParams")).Return(expectedAnswer, nil)
-	mockTestsRepo.On("GetHiddenTestsByExercise", mock.Anything, int64(1)).Return(expectedHiddenTests, nil)
-	mockAttemptsRepo.On("CreateAttempt", mock.Anything, mock.AnythingOfType("codelabdb.CreateAttemptParams")).Return(nil)
+	mockTestsRepo.On("GetTestsByExercise", mock.Anything, int64(1)).Return(expectedHiddenTests, nil)
+	mockAttemptsRepo.On("CreateAttempt", mock.Anything, mock.AnythingOfType("codelabdb.CreateAttemptParams")).Return(createTestAttempt(false), nil)
+	mockAttemptsRepo.On("CreateAttemptTestResult", mock.Anything, mock.AnythingOfType("codelabdb.CreateAttemptTestResultParams")).Return(nil)
+	mockAnswersRepo.On("UpdateAnswerBestScore", mock.Anything, mock.AnythingOfType("codelabdb.UpdateAnswerBestScoreParams")).Return(nil)
 
 	// Code that causes runtime error
 	errorCode := `function solution(input) { throw new Error("Runtime error"); }`
@@ -562,8 +938,10 @@ func TestCreateAttempt_TestFailure_WrongOutput(t *testing.T) {
 	mockExercisesRepo.On("GetExerciseToResolveById", mock.Anything, int64(1)).Return(expectedExercise, nil)
 	mockAnswersRepo.On("CheckIfAnswerExists", mock.Anything, mock.AnythingOfType("codelabdb.CheckIfAnswerExistsParams")).Return(int32(1), nil)
 	mockAnswersRepo.On("GetAnswerByUserAndExercise", mock.Anything, mock.AnythingOfType("codelabdb.GetAnswerByUserAndExerciseParams")).Return(expectedAnswer, nil)
-	mockTestsRepo.On("GetHiddenTestsByExercise", mock.Anything, int64(1)).Return(expectedHiddenTests, nil)
-	mockAttemptsRepo.On("CreateAttempt", mock.Anything, mock.AnythingOfType("codelabdb.CreateAttemptParams")).Return(nil)
+	mockTestsRepo.On("GetTestsByExercise", mock.Anything, int64(1)).Return(expectedHiddenTests, nil)
+	mockAttemptsRepo.On("CreateAttempt", mock.Anything, mock.AnythingOfType("codelabdb.CreateAttemptParams")).Return(createTestAttempt(false), nil)
+	mockAttemptsRepo.On("CreateAttemptTestResult", mock.Anything, mock.AnythingOfType("codelabdb.CreateAttemptTestResultParams")).Return(nil)
+	mockAnswersRepo.On("UpdateAnswerBestScore", mock.Anything, mock.AnythingOfType("codelabdb.UpdateAnswerBestScoreParams")).Return(nil)
 
 	// Code that returns wrong output
 	wrongCode := `function solution(input) { return parseInt(input) * 3; }`
@@ -606,7 +984,7 @@ func TestCreateAttempt_PermissionDenied_Teacher(t *testing.T) {
 	// Assert
 	assert.Error(t, err)
 	assert.Nil(t, result)
-	assert.Contains(t, err.Error(), "Only students can create attempts")
+	assert.Contains(t, errorMessage(err), "Only students can create attempts")
 	mockProfilesServiceRepo.AssertExpectations(t)
 }
 
@@ -631,11 +1009,52 @@ func TestCreateAttempt_ExerciseNotFound(t *testing.T) {
 	// Assert
 	assert.Error(t, err)
 	assert.Nil(t, result)
-	assert.Contains(t, err.Error(), "Exercise not found")
+	assert.Contains(t, errorMessage(err), "Exercise not found")
 	mockProfilesServiceRepo.AssertExpectations(t)
 	mockExercisesRepo.AssertExpectations(t)
 }
 
+func TestCreateAttempt_RateLimited(t *testing.T) {
+	// Arrange
+	mockProfilesServiceRepo := &mocks.MockProfilesServiceRepository{}
+	mockExercisesRepo := &mocks.MockExercisesRepository{}
+	mockTestsRepo := &mocks.MockTestsRepository{}
+	mockAnswersRepo := &mocks.MockAnswersRepository{}
+	mockAttemptsRepo := &mocks.MockAttemptsRepository{}
+	mockLimiter := &mocks.MockRateLimiter{}
+	service := setupTestService(mockProfilesServiceRepo, mockExercisesRepo, mockTestsRepo, mockAnswersRepo, mockAttemptsRepo)
+	service.limiter = mockLimiter
+
+	mockProfilesServiceRepo.On("GetCompleteProfile", mock.Anything, mock.AnythingOfType("*profiles.GetCompleteProfilePayload")).Return(createTestStudentProfile(), nil)
+	mockExercisesRepo.On("GetExerciseToResolveById", mock.Anything, int64(1)).Return(createTestExerciseToResolve(), nil)
+	mockTestsRepo.On("GetTestsByExercise", mock.Anything, int64(1)).Return([]codelabdb.Test{
+		{ID: 1, Input: "5", Output: "10", ExerciseID: 1},
+	}, nil)
+	mockAnswersRepo.On("CheckIfAnswerExists", mock.Anything, mock.AnythingOfType("codelabdb.CheckIfAnswerExistsParams")).Return(int32(1), nil)
+	mockAnswersRepo.On("GetAnswerByUserAndExercise", mock.Anything, mock.AnythingOfType("codelabdb.GetAnswerByUserAndExerciseParams")).Return(createTestAnswer(), nil)
+	mockLimiter.On("Acquire", mock.Anything, ratelimit.ActionAttempt, int64(1)).Return(nil, &ratelimit.LimitedError{
+		Reason:     ratelimit.ReasonCooldown,
+		RetryAfter: 3200 * time.Millisecond,
+	})
+
+	// Act
+	result, err := service.CreateAttempt(context.Background(), &codelab.CreateAttemptPayload{
+		SessionToken: "valid-session-token",
+		ExerciseID:   1,
+		Code:         "function solution(input) { return input * 2; }",
+	})
+
+	// Assert
+	assert.Nil(t, result)
+	var limited *codelab.RateLimit
+	if assert.ErrorAs(t, err, &limited) {
+		assert.Equal(t, int64(4), limited.RetryAfter)
+		assert.Equal(t, "Wait a few seconds before submitting another attempt", limited.Message)
+	}
+	mockLimiter.AssertExpectations(t)
+	mockAttemptsRepo.AssertNotCalled(t, "CreateAttempt", mock.Anything, mock.Anything)
+}
+
 func TestCreateAttempt_CreateAnswerWhenNotExists(t *testing.T) {
 	// Arrange
 	mockProfilesServiceRepo := &mocks.MockProfilesServiceRepository{}
@@ -663,8 +1082,10 @@ func TestCreateAttempt_CreateAnswerWhenNotExists(t *testing.T) {
 	mockAnswersRepo.On("CheckIfAnswerExists", mock.Anything, mock.AnythingOfType("codelabdb.CheckIfAnswerExistsParams")).Return(int32(0), errors.New("not found"))
 	mockAnswersRepo.On("CreateAnswer", mock.Anything, mock.AnythingOfType("codelabdb.CreateAnswerParams")).Return(nil)
 	mockAnswersRepo.On("GetAnswerByUserAndExercise", mock.Anything, mock.AnythingOfType("codelabdb.GetAnswerByUserAndExerciseParams")).Return(expectedAnswer, nil)
-	mockTestsRepo.On("GetHiddenTestsByExercise", mock.Anything, int64(1)).Return(expectedHiddenTests, nil)
-	mockAttemptsRepo.On("CreateAttempt", mock.Anything, mock.AnythingOfType("codelabdb.CreateAttemptParams")).Return(nil)
+	mockTestsRepo.On("GetTestsByExercise", mock.Anything, int64(1)).Return(expectedHiddenTests, nil)
+	mockAttemptsRepo.On("CreateAttempt", mock.Anything, mock.AnythingOfType("codelabdb.CreateAttemptParams")).Return(createTestAttempt(false), nil)
+	mockAttemptsRepo.On("CreateAttemptTestResult", mock.Anything, mock.AnythingOfType("codelabdb.CreateAttemptTestResultParams")).Return(nil)
+	mockAnswersRepo.On("UpdateAnswerBestScore", mock.Anything, mock.AnythingOfType("codelabdb.UpdateAnswerBestScoreParams")).Return(nil)
 	mockAnswersRepo.On("UpdateAnswerCompleted", mock.Anything, mock.AnythingOfType("codelabdb.UpdateAnswerCompletedParams")).Return(nil)
 
 	// Valid JavaScript code that should pass the tests
@@ -689,94 +1110,693 @@ func TestCreateAttempt_CreateAnswerWhenNotExists(t *testing.T) {
 	mockAttemptsRepo.AssertExpectations(t)
 }
 
-// ========================================
-// TEST TESTS
-// ========================================
-
-func TestCreateTest_Success(t *testing.T) {
+func TestCreateAttempt_RecordsEveryTestResult(t *testing.T) {
 	// Arrange
 	mockProfilesServiceRepo := &mocks.MockProfilesServiceRepository{}
 	mockExercisesRepo := &mocks.MockExercisesRepository{}
+	mockAnswersRepo := &mocks.MockAnswersRepository{}
 	mockTestsRepo := &mocks.MockTestsRepository{}
-	service := setupTestService(mockProfilesServiceRepo, mockExercisesRepo, mockTestsRepo, nil, nil)
+	mockAttemptsRepo := &mocks.MockAttemptsRepository{}
+	service := setupTestService(mockProfilesServiceRepo, mockExercisesRepo, mockTestsRepo, mockAnswersRepo, mockAttemptsRepo)
 
-	expectedProfile := createTestTeacherProfile()
-	expectedExercise := createTestExercise()
+	expectedTests := []codelabdb.Test{
+		{ID: 1, Input: "5", Output: "10", Public: true, ExerciseID: 1},
+		{ID: 2, Input: "3", Output: "6", Public: false, ExerciseID: 1},
+		{ID: 3, Input: "4", Output: "8", Public: false, ExerciseID: 1},
+	}
 
-	mockProfilesServiceRepo.On("GetCompleteProfile", mock.Anything, mock.AnythingOfType("*profiles.GetCompleteProfilePayload")).Return(expectedProfile, nil)
-	mockExercisesRepo.On("GetExerciseById", mock.Anything, int64(1)).Return(expectedExercise, nil)
-	mockTestsRepo.On("CreateTest", mock.Anything, mock.AnythingOfType("codelabdb.CreateTestParams")).Return(nil)
+	mockProfilesServiceRepo.On("GetCompleteProfile", mock.Anything, mock.AnythingOfType("*profiles.GetCompleteProfilePayload")).Return(createTestStudentProfile(), nil)
+	mockExercisesRepo.On("GetExerciseToResolveById", mock.Anything, int64(1)).Return(createTestExerciseToResolve(), nil)
+	mockAnswersRepo.On("CheckIfAnswerExists", mock.Anything, mock.AnythingOfType("codelabdb.CheckIfAnswerExistsParams")).Return(int32(1), nil)
+	mockAnswersRepo.On("GetAnswerByUserAndExercise", mock.Anything, mock.AnythingOfType("codelabdb.GetAnswerByUserAndExerciseParams")).Return(createTestAnswer(), nil)
+	mockTestsRepo.On("GetTestsByExercise", mock.Anything, int64(1)).Return(expectedTests, nil)
+	mockAttemptsRepo.On("CreateAttempt", mock.Anything, mock.MatchedBy(func(arg codelabdb.CreateAttemptParams) bool {
+		return !arg.Success && arg.Status == "failed"
+	})).Return(codelabdb.Attempt{ID: 7}, nil)
+
+	var recorded []codelabdb.CreateAttemptTestResultParams
+	mockAttemptsRepo.On("CreateAttemptTestResult", mock.Anything, mock.AnythingOfType("codelabdb.CreateAttemptTestResultParams")).Run(func(args mock.Arguments) {
+		recorded = append(recorded, args.Get(1).(codelabdb.CreateAttemptTestResultParams))
+	}).Return(nil)
+	mockAnswersRepo.On("UpdateAnswerBestScore", mock.Anything, mock.AnythingOfType("codelabdb.UpdateAnswerBestScoreParams")).Return(nil)
+
+	// Fails the first test, throws on the second and passes the last one
+	code := `function solution(input) {
+		console.log("input", input);
+		if (input === "3") { throw new Error("boom"); }
+		return input === "5" ? 11 : parseInt(input) * 2;
+	}`
 
 	// Act
-	result, err := service.CreateTest(context.Background(), &codelab.CreateTestPayload{
+	result, err := service.CreateAttempt(context.Background(), &codelab.CreateAttemptPayload{
 		SessionToken: "valid-session-token",
-		Input:        "5",
-		Output:       "10",
-		Public:       true,
 		ExerciseID:   1,
+		Code:         code,
 	})
 
 	// Assert
 	assert.NoError(t, err)
 	assert.NotNil(t, result)
-	assert.Equal(t, "Test created successfully", result.Message)
-	assert.True(t, result.Success)
-	mockProfilesServiceRepo.AssertExpectations(t)
-	mockExercisesRepo.AssertExpectations(t)
-	mockTestsRepo.AssertExpectations(t)
+	assert.False(t, result.Success)
+	assert.Contains(t, result.Message, "Test failed: expected 10, got 11")
+	assert.Contains(t, result.Message, "1 of 3 tests passed")
+
+	if assert.Len(t, recorded, 3) {
+		assert.Equal(t, int64(7), recorded[0].AttemptID)
+		assert.Equal(t, int64(1), recorded[0].TestID.Int64)
+		assert.Equal(t, "failed", recorded[0].Status)
+		assert.Equal(t, "11", recorded[0].ActualOutput)
+		assert.Equal(t, pgtype.Text{String: "10", Valid: true}, recorded[0].ExpectedOutput)
+		assert.Equal(t, "input 5\n", recorded[0].ConsoleOutput)
+
+		assert.Equal(t, "error", recorded[1].Status)
+		assert.False(t, recorded[1].ExpectedOutput.Valid)
+		assert.True(t, recorded[1].ErrorMessage.Valid)
+		assert.Contains(t, recorded[1].ErrorMessage.String, "boom")
+
+		assert.Equal(t, "passed", recorded[2].Status)
+		assert.Equal(t, "8", recorded[2].ActualOutput)
+		assert.False(t, recorded[2].ExpectedOutput.Valid)
+	}
+	mockAttemptsRepo.AssertExpectations(t)
 }
 
-func TestCreateTest_PermissionDenied_Student(t *testing.T) {
+func TestCreateAttempt_WeightedScore(t *testing.T) {
 	// Arrange
 	mockProfilesServiceRepo := &mocks.MockProfilesServiceRepository{}
-	service := setupTestService(mockProfilesServiceRepo, nil, nil, nil, nil)
+	mockExercisesRepo := &mocks.MockExercisesRepository{}
+	mockAnswersRepo := &mocks.MockAnswersRepository{}
+	mockTestsRepo := &mocks.MockTestsRepository{}
+	mockAttemptsRepo := &mocks.MockAttemptsRepository{}
+	service := setupTestService(mockProfilesServiceRepo, mockExercisesRepo, mockTestsRepo, mockAnswersRepo, mockAttemptsRepo)
 
-	expectedProfile := createTestStudentProfile()
+	expectedTests := []codelabdb.Test{
+		{ID: 1, Input: "5", Output: "10", Weight: 1, ExerciseID: 1},
+		{ID: 2, Input: "3", Output: "6", Weight: 2, ExerciseID: 1},
+		{ID: 3, Input: "4", Output: "8", Weight: 3, ExerciseID: 1},
+	}
 
-	mockProfilesServiceRepo.On("GetCompleteProfile", mock.Anything, mock.AnythingOfType("*profiles.GetCompleteProfilePayload")).Return(expectedProfile, nil)
+	mockProfilesServiceRepo.On("GetCompleteProfile", mock.Anything, mock.AnythingOfType("*profiles.GetCompleteProfilePayload")).Return(createTestStudentProfile(), nil)
+	mockExercisesRepo.On("GetExerciseToResolveById", mock.Anything, int64(1)).Return(createTestExerciseToResolve(), nil)
+	mockAnswersRepo.On("CheckIfAnswerExists", mock.Anything, mock.AnythingOfType("codelabdb.CheckIfAnswerExistsParams")).Return(int32(1), nil)
+	mockAnswersRepo.On("GetAnswerByUserAndExercise", mock.Anything, mock.AnythingOfType("codelabdb.GetAnswerByUserAndExerciseParams")).Return(createTestAnswer(), nil)
+	mockTestsRepo.On("GetTestsByExercise", mock.Anything, int64(1)).Return(expectedTests, nil)
+	mockAttemptsRepo.On("CreateAttempt", mock.Anything, codelabdb.CreateAttemptParams{
+		AnswerID:        1,
+		Code:            "function solution(input) { return input === \"3\" ? 0 : input * 2; }",
+		Success:         false,
+		Status:          "failed",
+		Points:          4,
+		MaxPoints:       6,
+		Score:           66.67,
+		ExerciseVersion: 1,
+		Message:         "Test failed: expected 6, got 0 (2 of 3 tests passed)",
+	}).Return(codelabdb.Attempt{ID: 9, ExerciseVersion: 1}, nil)
+	mockAttemptsRepo.On("CreateAttemptTestResult", mock.Anything, mock.AnythingOfType("codelabdb.CreateAttemptTestResultParams")).Return(nil)
+	mockAnswersRepo.On("UpdateAnswerBestScore", mock.Anything, codelabdb.UpdateAnswerBestScoreParams{
+		ID:            1,
+		BestScore:     66.67,
+		BestAttemptID: pgtype.Int8{Int64: 9, Valid: true},
+	}).Return(nil)
 
 	// Act
-	result, err := service.CreateTest(context.Background(), &codelab.CreateTestPayload{
+	result, err := service.CreateAttempt(context.Background(), &codelab.CreateAttemptPayload{
 		SessionToken: "valid-session-token",
-		Input:        "5",
-		Output:       "10",
-		Public:       true,
 		ExerciseID:   1,
+		Code:         "function solution(input) { return input === \"3\" ? 0 : input * 2; }",
 	})
 
 	// Assert
-	assert.Error(t, err)
-	assert.Nil(t, result)
-	assert.Contains(t, err.Error(), "Only teachers can create tests")
-	mockProfilesServiceRepo.AssertExpectations(t)
+	assert.NoError(t, err)
+	assert.NotNil(t, result)
+	assert.False(t, result.Success)
+	mockAttemptsRepo.AssertExpectations(t)
+	mockAnswersRepo.AssertExpectations(t)
+	mockAnswersRepo.AssertNotCalled(t, "UpdateAnswerCompleted", mock.Anything, mock.Anything)
 }
 
-func TestCreateTest_ExerciseNotFound(t *testing.T) {
-	// Arrange
-	mockProfilesServiceRepo := &mocks.MockProfilesServiceRepository{}
-	mockExercisesRepo := &mocks.MockExercisesRepository{}
-	service := setupTestService(mockProfilesServiceRepo, mockExercisesRepo, nil, nil, nil)
+// setupSandboxAttempt prepares the mocks shared by the sandbox limit tests
+// and returns the attempts mock so the recorded status can be asserted
+func setupSandboxAttempt(service *codelabsvrc, mockProfilesServiceRepo *mocks.MockProfilesServiceRepository, mockExercisesRepo *mocks.MockExercisesRepository, mockAnswersRepo *mocks.MockAnswersRepository, mockTestsRepo *mocks.MockTestsRepository, limits sandbox.Limits) {
+	service.executor = sandbox.NewExecutor(sandbox.Options{
+		Workers:      1,
+		QueueTimeout: time.Second,
+		Limits:       limits,
+	})
 
-	expectedProfile := createTestTeacherProfile()
+	mockProfilesServiceRepo.On("GetCompleteProfile", mock.Anything, mock.AnythingOfType("*profiles.GetCompleteProfilePayload")).Return(createTestStudentProfile(), nil)
+	mockExercisesRepo.On("GetExerciseToResolveById", mock.Anything, int64(1)).Return(createTestExerciseToResolve(), nil)
+	mockAnswersRepo.On("CheckIfAnswerExists", mock.Anything, mock.AnythingOfType("codelabdb.CheckIfAnswerExistsParams")).Return(int32(1), nil)
+	mockAnswersRepo.On("GetAnswerByUserAndExercise", mock.Anything, mock.AnythingOfType("codelabdb.GetAnswerByUserAndExerciseParams")).Return(createTestAnswer(), nil)
+	mockTestsRepo.On("GetTestsByExercise", mock.Anything, int64(1)).Return([]codelabdb.Test{
+		{ID: 1, Input: "5", Output: "10", ExerciseID: 1},
+		{ID: 2, Input: "3", Output: "6", ExerciseID: 1},
+	}, nil)
+}
 
-	mockProfilesServiceRepo.On("GetCompleteProfile", mock.Anything, mock.AnythingOfType("*profiles.GetCompleteProfilePayload")).Return(expectedProfile, nil)
-	mockExercisesRepo.On("GetExerciseById", mock.Anything, int64(999)).Return(codelabdb.Exercise{}, errors.New("not found"))
+func TestCreateAttempt_SandboxLimits(t *testing.T) {
+	limits := sandbox.Limits{
+		TestTimeout:      100 * time.Millisecond,
+		TotalTimeout:     time.Second,
+		MaxCallStackSize: 256,
+		MaxMemoryBytes:   32 << 20,
+	}
 
-	// Act
-	result, err := service.CreateTest(context.Background(), &codelab.CreateTestPayload{
-		SessionToken: "valid-session-token",
-		Input:        "5",
-		Output:       "10",
-		Public:       true,
-		ExerciseID:   999,
-	})
+	tests := []struct {
+		name           string
+		code           string
+		limits         sandbox.Limits
+		expectedStatus string
+		expectedError  string
+	}{
+		{
+			name:           "infinite loop in solution",
+			code:           `function solution(input) { while (true) {} }`,
+			limits:         limits,
+			expectedStatus: "timeout",
+			expectedError:  "time limit exceeded",
+		},
+		{
+			name:           "infinite loop at top level",
+			code:           `while (true) {} function solution(input) { return input; }`,
+			limits:         limits,
+			expectedStatus: "timeout",
+			expectedError:  "time limit exceeded",
+		},
+		{
+			name: "attempt budget exhausted",
+			code: `function solution(input) { var end = Date.now() + 80; while (Date.now() < end) {} return String(input * 2); }`,
+			limits: sandbox.Limits{
+				TestTimeout:      100 * time.Millisecond,
+				TotalTimeout:     120 * time.Millisecond,
+				MaxCallStackSize: 256,
+				MaxMemoryBytes:   32 << 20,
+			},
+			expectedStatus: "timeout",
+			expectedError:  "attempt time budget exhausted",
+		},
+		{
+			name:           "unbounded recursion",
+			code:           `function solution(input) { return solution(input); }`,
+			limits:         limits,
+			expectedStatus: "resource_exceeded",
+			expectedError:  "maximum call stack size exceeded",
+		},
+		{
+			name: "unbounded allocation",
+			code: `function solution(input) { var chunks = []; while (true) { chunks.push(new Array(100000).fill(input)); } }`,
+			limits: sandbox.Limits{
+				TestTimeout:      10 * time.Second,
+				TotalTimeout:     10 * time.Second,
+				MaxCallStackSize: 256,
+				MaxMemoryBytes:   32 << 20,
+			},
+			expectedStatus: "resource_exceeded",
+			expectedError:  "memory limit exceeded",
+		},
+	}
 
-	// Assert
-	assert.Error(t, err)
-	assert.Nil(t, result)
-	assert.Contains(t, err.Error(), "Exercise not found")
-	mockProfilesServiceRepo.AssertExpectations(t)
-	mockExercisesRepo.AssertExpectations(t)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			mockProfilesServiceRepo := &mocks.MockProfilesServiceRepository{}
+			mockExercisesRepo := &mocks.MockExercisesRepository{}
+			mockAnswersRepo := &mocks.MockAnswersRepository{}
+			mockTestsRepo := &mocks.MockTestsRepository{}
+			mockAttemptsRepo := &mocks.MockAttemptsRepository{}
+			service := setupTestService(mockProfilesServiceRepo, mockExercisesRepo, mockTestsRepo, mockAnswersRepo, mockAttemptsRepo)
+			setupSandboxAttempt(service, mockProfilesServiceRepo, mockExercisesRepo, mockAnswersRepo, mockTestsRepo, tt.limits)
+
+			mockAttemptsRepo.On("CreateAttempt", mock.Anything, mock.MatchedBy(func(arg codelabdb.CreateAttemptParams) bool {
+				return !arg.Success && arg.Status == tt.expectedStatus
+			})).Return(createTestAttempt(false), nil)
+			// Code that never finishes loading has no per test results
+			mockAttemptsRepo.On("CreateAttemptTestResult", mock.Anything, mock.AnythingOfType("codelabdb.CreateAttemptTestResultParams")).Return(nil).Maybe()
+			mockAnswersRepo.On("UpdateAnswerBestScore", mock.Anything, mock.AnythingOfType("codelabdb.UpdateAnswerBestScoreParams")).Return(nil).Maybe()
+
+			// Act
+			result, err := service.CreateAttempt(context.Background(), &codelab.CreateAttemptPayload{
+				SessionToken: "valid-session-token",
+				ExerciseID:   1,
+				Code:         tt.code,
+			})
+
+			// Assert
+			assert.NoError(t, err)
+			assert.NotNil(t, result)
+			assert.False(t, result.Success)
+			assert.Contains(t, result.Message, tt.expectedError)
+			mockAttemptsRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestCreateAttempt_Starlark(t *testing.T) {
+	tests := []struct {
+		name            string
+		code            string
+		expectedStatus  string
+		expectedSuccess bool
+		expectedMessage string
+	}{
+		{
+			name:            "passing solution",
+			code:            "def solution(input):\n    print('got', input)\n    return int(input) * 2\n",
+			expectedStatus:  "passed",
+			expectedSuccess: true,
+			expectedMessage: "All tests passed successfully",
+		},
+		{
+			name:            "wrong output",
+			code:            "def solution(input):\n    return int(input) * 3\n",
+			expectedStatus:  "failed",
+			expectedMessage: "Test failed: expected 10, got 15",
+		},
+		{
+			name:            "infinite loop",
+			code:            "def solution(input):\n    while True:\n        pass\n",
+			expectedStatus:  "timeout",
+			expectedMessage: "time limit exceeded",
+		},
+		{
+			name:            "recursion is rejected",
+			code:            "def solution(input):\n    return solution(input)\n",
+			expectedStatus:  "error",
+			expectedMessage: "called recursively",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			mockProfilesServiceRepo := &mocks.MockProfilesServiceRepository{}
+			mockExercisesRepo := &mocks.MockExercisesRepository{}
+			mockAnswersRepo := &mocks.MockAnswersRepository{}
+			mockTestsRepo := &mocks.MockTestsRepository{}
+			mockAttemptsRepo := &mocks.MockAttemptsRepository{}
+			service := setupTestService(mockProfilesServiceRepo, mockExercisesRepo, mockTestsRepo, mockAnswersRepo, mockAttemptsRepo)
+			service.executor = sandbox.NewExecutor(sandbox.Options{
+				Workers: 1,
+				Limits: sandbox.Limits{
+					TestTimeout:  100 * time.Millisecond,
+					TotalTimeout: time.Second,
+				},
+			})
+
+			exercise := createTestExerciseToResolve()
+			exercise.Language = "starlark"
+
+			mockProfilesServiceRepo.On("GetCompleteProfile", mock.Anything, mock.AnythingOfType("*profiles.GetCompleteProfilePayload")).Return(createTestStudentProfile(), nil)
+			mockExercisesRepo.On("GetExerciseToResolveById", mock.Anything, int64(1)).Return(exercise, nil)
+			mockAnswersRepo.On("CheckIfAnswerExists", mock.Anything, mock.AnythingOfType("codelabdb.CheckIfAnswerExistsParams")).Return(int32(1), nil)
+			mockAnswersRepo.On("GetAnswerByUserAndExercise", mock.Anything, mock.AnythingOfType("codelabdb.GetAnswerByUserAndExerciseParams")).Return(createTestAnswer(), nil)
+			mockAnswersRepo.On("UpdateAnswerCompleted", mock.Anything, mock.AnythingOfType("codelabdb.UpdateAnswerCompletedParams")).Return(nil).Maybe()
+			mockTestsRepo.On("GetTestsByExercise", mock.Anything, int64(1)).Return([]codelabdb.Test{
+				{ID: 1, Input: "5", Output: "10", ExerciseID: 1},
+			}, nil)
+			mockAttemptsRepo.On("CreateAttempt", mock.Anything, mock.MatchedBy(func(arg codelabdb.CreateAttemptParams) bool {
+				return arg.Success == tt.expectedSuccess && arg.Status == tt.expectedStatus
+			})).Return(createTestAttempt(tt.expectedSuccess), nil)
+			mockAttemptsRepo.On("CreateAttemptTestResult", mock.Anything, mock.AnythingOfType("codelabdb.CreateAttemptTestResultParams")).Return(nil)
+			mockAnswersRepo.On("UpdateAnswerBestScore", mock.Anything, mock.AnythingOfType("codelabdb.UpdateAnswerBestScoreParams")).Return(nil)
+
+			// Act
+			result, err := service.CreateAttempt(context.Background(), &codelab.CreateAttemptPayload{
+				SessionToken: "valid-session-token",
+				ExerciseID:   1,
+				Code:         tt.code,
+			})
+
+			// Assert
+			assert.NoError(t, err)
+			assert.NotNil(t, result)
+			assert.Equal(t, tt.expectedSuccess, result.Success)
+			assert.Contains(t, result.Message, tt.expectedMessage)
+			mockAttemptsRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestCreateAttempt_StructuredTests(t *testing.T) {
+	tests := []struct {
+		name     string
+		language string
+		code     string
+		test     codelabdb.Test
+	}{
+		{
+			name:     "javascript json arguments with json comparison",
+			language: "javascript",
+			code:     `function solution(scores, bonus) { return { total: scores.reduce(function (a, b) { return a + b; }, 0) + bonus, count: scores.length }; }`,
+			test:     codelabdb.Test{ID: 1, Input: `[[1, 2, 3], 4]`, Output: `{"count": 3, "total": 10}`, InputFormat: "json", Comparison: "json"},
+		},
+		{
+			name:     "javascript unordered array",
+			language: "javascript",
+			code:     `function solution(items) { return Object.keys(items); }`,
+			test:     codelabdb.Test{ID: 1, Input: `[{"b": 1, "a": 2}]`, Output: `["a", "b"]`, InputFormat: "json", Comparison: "unordered"},
+		},
+		{
+			name:     "javascript numeric tolerance",
+			language: "javascript",
+			code:     `function solution(input) { return Math.sqrt(parseFloat(input)); }`,
+			test:     codelabdb.Test{ID: 1, Input: "2", Output: "1.4142", InputFormat: "text", Comparison: "numeric", Tolerance: 0.0001},
+		},
+		{
+			name:     "starlark json arguments with json comparison",
+			language: "starlark",
+			code:     "def solution(scores, bonus):\n    return {'total': sum(scores) + bonus, 'count': len(scores)}\n\ndef sum(values):\n    total = 0\n    for v in values:\n        total += v\n    return total\n",
+			test:     codelabdb.Test{ID: 1, Input: `[[1, 2, 3], 4]`, Output: `{"count": 3, "total": 10}`, InputFormat: "json", Comparison: "json"},
+		},
+		{
+			name:     "starlark trimmed output",
+			language: "starlark",
+			code:     "def solution(input):\n    return '  ' + input + '  \\n'\n",
+			test:     codelabdb.Test{ID: 1, Input: "hello", Output: "hello", InputFormat: "text", Comparison: "trimmed"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			mockProfilesServiceRepo := &mocks.MockProfilesServiceRepository{}
+			mockExercisesRepo := &mocks.MockExercisesRepository{}
+			mockAnswersRepo := &mocks.MockAnswersRepository{}
+			mockTestsRepo := &mocks.MockTestsRepository{}
+			mockAttemptsRepo := &mocks.MockAttemptsRepository{}
+			service := setupTestService(mockProfilesServiceRepo, mockExercisesRepo, mockTestsRepo, mockAnswersRepo, mockAttemptsRepo)
+
+			exercise := createTestExerciseToResolve()
+			exercise.Language = tt.language
+
+			mockProfilesServiceRepo.On("GetCompleteProfile", mock.Anything, mock.AnythingOfType("*profiles.GetCompleteProfilePayload")).Return(createTestStudentProfile(), nil)
+			mockExercisesRepo.On("GetExerciseToResolveById", mock.Anything, int64(1)).Return(exercise, nil)
+			mockAnswersRepo.On("CheckIfAnswerExists", mock.Anything, mock.AnythingOfType("codelabdb.CheckIfAnswerExistsParams")).Return(int32(1), nil)
+			mockAnswersRepo.On("GetAnswerByUserAndExercise", mock.Anything, mock.AnythingOfType("codelabdb.GetAnswerByUserAndExerciseParams")).Return(createTestAnswer(), nil)
+			mockAnswersRepo.On("UpdateAnswerCompleted", mock.Anything, mock.AnythingOfType("codelabdb.UpdateAnswerCompletedParams")).Return(nil)
+			mockTestsRepo.On("GetTestsByExercise", mock.Anything, int64(1)).Return([]codelabdb.Test{tt.test}, nil)
+			mockAttemptsRepo.On("CreateAttempt", mock.Anything, mock.AnythingOfType("codelabdb.CreateAttemptParams")).Return(createTestAttempt(true), nil)
+			mockAttemptsRepo.On("CreateAttemptTestResult", mock.Anything, mock.AnythingOfType("codelabdb.CreateAttemptTestResultParams")).Return(nil)
+			mockAnswersRepo.On("UpdateAnswerBestScore", mock.Anything, mock.AnythingOfType("codelabdb.UpdateAnswerBestScoreParams")).Return(nil)
+
+			// Act
+			result, err := service.CreateAttempt(context.Background(), &codelab.CreateAttemptPayload{
+				SessionToken: "valid-session-token",
+				ExerciseID:   1,
+				Code:         tt.code,
+			})
+
+			// Assert
+			assert.NoError(t, err)
+			assert.NotNil(t, result)
+			assert.True(t, result.Success, result.Message)
+			mockAnswersRepo.AssertExpectations(t)
+		})
+	}
+}
+
+// ========================================
+// RUN CODE TESTS
+// ========================================
+
+func TestRunCode_PublicTests(t *testing.T) {
+	// Arrange
+	mockProfilesServiceRepo := &mocks.MockProfilesServiceRepository{}
+	mockExercisesRepo := &mocks.MockExercisesRepository{}
+	mockAnswersRepo := &mocks.MockAnswersRepository{}
+	mockTestsRepo := &mocks.MockTestsRepository{}
+	mockAttemptsRepo := &mocks.MockAttemptsRepository{}
+	service := setupTestService(mockProfilesServiceRepo, mockExercisesRepo, mockTestsRepo, mockAnswersRepo, mockAttemptsRepo)
+
+	mockProfilesServiceRepo.On("GetCompleteProfile", mock.Anything, mock.AnythingOfType("*profiles.GetCompleteProfilePayload")).Return(createTestStudentProfile(), nil)
+	mockExercisesRepo.On("GetExerciseToResolveById", mock.Anything, int64(1)).Return(createTestExerciseToResolve(), nil)
+	mockTestsRepo.On("GetPublicTestsByExercise", mock.Anything, int64(1)).Return([]codelabdb.Test{
+		{ID: 1, Input: "5", Output: "10", Public: true, ExerciseID: 1},
+		{ID: 2, Input: "3", Output: "7", Public: true, ExerciseID: 1},
+	}, nil)
+
+	// Act
+	result, err := service.RunCode(context.Background(), &codelab.RunCodePayload{
+		SessionToken: "valid-session-token",
+		ExerciseID:   1,
+		Code:         `function solution(input) { return parseInt(input) * 2; }`,
+	})
+
+	// Assert
+	assert.NoError(t, err)
+	assert.NotNil(t, result)
+	assert.Equal(t, "failed", result.Status)
+	if assert.Len(t, result.Results, 2) {
+		assert.Equal(t, "passed", result.Results[0].Status)
+		assert.Equal(t, int64(1), *result.Results[0].TestID)
+		assert.Equal(t, "10", result.Results[0].Output)
+		assert.Equal(t, "failed", result.Results[1].Status)
+		assert.Equal(t, "6", result.Results[1].Output)
+		assert.Equal(t, "7", *result.Results[1].ExpectedOutput)
+	}
+	mockTestsRepo.AssertExpectations(t)
+	mockAnswersRepo.AssertNotCalled(t, "CreateAnswer", mock.Anything, mock.Anything)
+	mockAttemptsRepo.AssertNotCalled(t, "CreateAttempt", mock.Anything, mock.Anything)
+}
+
+func TestRunCode_CustomInputs(t *testing.T) {
+	// Arrange
+	mockProfilesServiceRepo := &mocks.MockProfilesServiceRepository{}
+	mockExercisesRepo := &mocks.MockExercisesRepository{}
+	mockTestsRepo := &mocks.MockTestsRepository{}
+	mockAttemptsRepo := &mocks.MockAttemptsRepository{}
+	service := setupTestService(mockProfilesServiceRepo, mockExercisesRepo, mockTestsRepo, nil, mockAttemptsRepo)
+
+	mockProfilesServiceRepo.On("GetCompleteProfile", mock.Anything, mock.AnythingOfType("*profiles.GetCompleteProfilePayload")).Return(createTestStudentProfile(), nil)
+	mockExercisesRepo.On("GetExerciseToResolveById", mock.Anything, int64(1)).Return(createTestExerciseToResolve(), nil)
+
+	// Act
+	result, err := service.RunCode(context.Background(), &codelab.RunCodePayload{
+		SessionToken: "valid-session-token",
+		ExerciseID:   1,
+		Code:         "function solution(input) {\n  console.warn(input);\n  if (input === \"x\") { throw new Error(\"bad input\"); }\n  return input + \"!\";\n}",
+		Inputs:       []string{"hi", "x"},
+	})
+
+	// Assert
+	assert.NoError(t, err)
+	assert.NotNil(t, result)
+	assert.Equal(t, "error", result.Status)
+	if assert.Len(t, result.Results, 2) {
+		assert.Equal(t, "completed", result.Results[0].Status)
+		assert.Equal(t, "hi!", result.Results[0].Output)
+		assert.Nil(t, result.Results[0].TestID)
+		assert.Nil(t, result.Results[0].ExpectedOutput)
+		assert.Equal(t, "[warn] hi\n", result.Results[0].ConsoleOutput)
+		assert.Nil(t, result.Results[0].StackTrace)
+		assert.Equal(t, "error", result.Results[1].Status)
+		assert.Equal(t, "Error: bad input", *result.Results[1].Error)
+		assert.Equal(t, "Error: bad input\n    at solution (solution.js:3:30)", *result.Results[1].StackTrace)
+	}
+	mockTestsRepo.AssertNotCalled(t, "GetPublicTestsByExercise", mock.Anything, mock.Anything)
+	mockAttemptsRepo.AssertNotCalled(t, "CreateAttempt", mock.Anything, mock.Anything)
+}
+
+func TestRunCode_RateLimited(t *testing.T) {
+	// Arrange
+	mockProfilesServiceRepo := &mocks.MockProfilesServiceRepository{}
+	mockExercisesRepo := &mocks.MockExercisesRepository{}
+	mockLimiter := &mocks.MockRateLimiter{}
+	service := setupTestService(mockProfilesServiceRepo, mockExercisesRepo, nil, nil, nil)
+	service.limiter = mockLimiter
+
+	mockProfilesServiceRepo.On("GetCompleteProfile", mock.Anything, mock.AnythingOfType("*profiles.GetCompleteProfilePayload")).Return(createTestStudentProfile(), nil)
+	mockExercisesRepo.On("GetExerciseToResolveById", mock.Anything, int64(1)).Return(createTestExerciseToResolve(), nil)
+	mockLimiter.On("Acquire", mock.Anything, ratelimit.ActionRun, int64(1)).Return(nil, &ratelimit.LimitedError{
+		Reason:     ratelimit.ReasonBusy,
+		RetryAfter: 2 * time.Second,
+	})
+
+	// Act
+	result, err := service.RunCode(context.Background(), &codelab.RunCodePayload{
+		SessionToken: "valid-session-token",
+		ExerciseID:   1,
+		Code:         "function solution(input) { return input; }",
+		Inputs:       []string{"hi"},
+	})
+
+	// Assert
+	assert.Nil(t, result)
+	var limited *codelab.RateLimit
+	if assert.ErrorAs(t, err, &limited) {
+		assert.Equal(t, int64(2), limited.RetryAfter)
+		assert.Equal(t, "Too many executions are running, try again later", limited.Message)
+	}
+	mockLimiter.AssertExpectations(t)
+}
+
+func TestRunCode_LimiterUnavailable(t *testing.T) {
+	// Arrange
+	mockProfilesServiceRepo := &mocks.MockProfilesServiceRepository{}
+	mockExercisesRepo := &mocks.MockExercisesRepository{}
+	mockLimiter := &mocks.MockRateLimiter{}
+	service := setupTestService(mockProfilesServiceRepo, mockExercisesRepo, nil, nil, nil)
+	service.limiter = mockLimiter
+
+	mockProfilesServiceRepo.On("GetCompleteProfile", mock.Anything, mock.AnythingOfType("*profiles.GetCompleteProfilePayload")).Return(createTestStudentProfile(), nil)
+	mockExercisesRepo.On("GetExerciseToResolveById", mock.Anything, int64(1)).Return(createTestExerciseToResolve(), nil)
+	mockLimiter.On("Acquire", mock.Anything, ratelimit.ActionRun, int64(1)).Return(nil, errors.New("connection refused"))
+
+	// Act
+	result, err := service.RunCode(context.Background(), &codelab.RunCodePayload{
+		SessionToken: "valid-session-token",
+		ExerciseID:   1,
+		Code:         "function solution(input) { return input; }",
+		Inputs:       []string{"hi"},
+	})
+
+	// Assert: code still runs while the limiter is down
+	assert.NoError(t, err)
+	if assert.NotNil(t, result) {
+		assert.Equal(t, "completed", result.Status)
+	}
+	mockLimiter.AssertExpectations(t)
+}
+
+func TestRunCode_PermissionDenied_Teacher(t *testing.T) {
+	// Arrange
+	mockProfilesServiceRepo := &mocks.MockProfilesServiceRepository{}
+	service := setupTestService(mockProfilesServiceRepo, nil, nil, nil, nil)
+
+	mockProfilesServiceRepo.On("GetCompleteProfile", mock.Anything, mock.AnythingOfType("*profiles.GetCompleteProfilePayload")).Return(createTestTeacherProfile(), nil)
+
+	// Act
+	result, err := service.RunCode(context.Background(), &codelab.RunCodePayload{
+		SessionToken: "valid-session-token",
+		ExerciseID:   1,
+		Code:         "function solution(input) { return input; }",
+	})
+
+	// Assert
+	assert.Error(t, err)
+	assert.Nil(t, result)
+	assert.Contains(t, errorMessage(err), "Only students can run code")
+	mockProfilesServiceRepo.AssertExpectations(t)
+}
+
+// ========================================
+// TEST TESTS
+// ========================================
+
+func TestCreateTest_Success(t *testing.T) {
+	// Arrange
+	mockProfilesServiceRepo := &mocks.MockProfilesServiceRepository{}
+	mockExercisesRepo := &mocks.MockExercisesRepository{}
+	mockTestsRepo := &mocks.MockTestsRepository{}
+	service := setupTestService(mockProfilesServiceRepo, mockExercisesRepo, mockTestsRepo, nil, nil)
+
+	expectedProfile := createTestTeacherProfile()
+	expectedExercise := createTestExercise()
+
+	mockProfilesServiceRepo.On("GetCompleteProfile", mock.Anything, mock.AnythingOfType("*profiles.GetCompleteProfilePayload")).Return(expectedProfile, nil)
+	mockExercisesRepo.On("GetExerciseById", mock.Anything, int64(1)).Return(expectedExercise, nil)
+	mockTestsRepo.On("GetTestsByExercise", mock.Anything, int64(1)).Return([]codelabdb.Test{}, nil)
+	mockTestsRepo.On("CreateTest", mock.Anything, mock.AnythingOfType("codelabdb.CreateTestParams")).Return(nil)
+	expectExerciseVersion(mockExercisesRepo, mockTestsRepo, 2)
+
+	// Act
+	result, err := service.CreateTest(context.Background(), &codelab.CreateTestPayload{
+		SessionToken: "valid-session-token",
+		Input:        "5",
+		Output:       "10",
+		Public:       true,
+		ExerciseID:   1,
+	})
+
+	// Assert
+	assert.NoError(t, err)
+	assert.NotNil(t, result)
+	assert.Equal(t, "Test created successfully", result.Message)
+	assert.True(t, result.Success)
+	mockProfilesServiceRepo.AssertExpectations(t)
+	mockExercisesRepo.AssertExpectations(t)
+	mockTestsRepo.AssertExpectations(t)
+}
+
+func TestCreateTest_InvalidComparison(t *testing.T) {
+	// Arrange
+	mockProfilesServiceRepo := &mocks.MockProfilesServiceRepository{}
+	mockExercisesRepo := &mocks.MockExercisesRepository{}
+	mockTestsRepo := &mocks.MockTestsRepository{}
+	service := setupTestService(mockProfilesServiceRepo, mockExercisesRepo, mockTestsRepo, nil, nil)
+
+	mockProfilesServiceRepo.On("GetCompleteProfile", mock.Anything, mock.AnythingOfType("*profiles.GetCompleteProfilePayload")).Return(createTestTeacherProfile(), nil)
+	mockExercisesRepo.On("GetExerciseById", mock.Anything, int64(1)).Return(createTestExercise(), nil)
+
+	// Act
+	result, err := service.CreateTest(context.Background(), &codelab.CreateTestPayload{
+		SessionToken: "valid-session-token",
+		Input:        "5",
+		Output:       "[0-9",
+		Public:       true,
+		ExerciseID:   1,
+		InputFormat:  "text",
+		Comparison:   "regex",
+	})
+
+	// Assert
+	assert.Error(t, err)
+	assert.Nil(t, result)
+	assert.Contains(t, errorMessage(err), "Invalid test: expected output must be a valid regular expression")
+	mockTestsRepo.AssertNotCalled(t, "CreateTest", mock.Anything, mock.Anything)
+}
+
+func TestCreateTest_PermissionDenied_Student(t *testing.T) {
+	// Arrange
+	mockProfilesServiceRepo := &mocks.MockProfilesServiceRepository{}
+	service := setupTestService(mockProfilesServiceRepo, nil, nil, nil, nil)
+
+	expectedProfile := createTestStudentProfile()
+
+	mockProfilesServiceRepo.On("GetCompleteProfile", mock.Anything, mock.AnythingOfType("*profiles.GetCompleteProfilePayload")).Return(expectedProfile, nil)
+
+	// Act
+	result, err := service.CreateTest(context.Background(), &codelab.CreateTestPayload{
+		SessionToken: "valid-session-token",
+		Input:        "5",
+		Output:       "10",
+		Public:       true,
+		ExerciseID:   1,
+	})
+
+	// Assert
+	assert.Error(t, err)
+	assert.Nil(t, result)
+	assert.Contains(t, errorMessage(err), "Only teachers can create tests")
+	mockProfilesServiceRepo.AssertExpectations(t)
+}
+
+func TestCreateTest_ExerciseNotFound(t *testing.T) {
+	// Arrange
+	mockProfilesServiceRepo := &mocks.MockProfilesServiceRepository{}
+	mockExercisesRepo := &mocks.MockExercisesRepository{}
+	service := setupTestService(mockProfilesServiceRepo, mockExercisesRepo, nil, nil, nil)
+
+	expectedProfile := createTestTeacherProfile()
+
+	mockProfilesServiceRepo.On("GetCompleteProfile", mock.Anything, mock.AnythingOfType("*profiles.GetCompleteProfilePayload")).Return(expectedProfile, nil)
+	mockExercisesRepo.On("GetExerciseById", mock.Anything, int64(999)).Return(codelabdb.Exercise{}, errors.New("not found"))
+
+	// Act
+	result, err := service.CreateTest(context.Background(), &codelab.CreateTestPayload{
+		SessionToken: "valid-session-token",
+		Input:        "5",
+		Output:       "10",
+		Public:       true,
+		ExerciseID:   999,
+	})
+
+	// Assert
+	assert.Error(t, err)
+	assert.Nil(t, result)
+	assert.Contains(t, errorMessage(err), "Exercise not found")
+	mockProfilesServiceRepo.AssertExpectations(t)
+	mockExercisesRepo.AssertExpectations(t)
 }
 
 // ========================================
@@ -787,13 +1807,17 @@ func TestGetExerciseForStudent_Success(t *testing.T) {
 	// Arrange
 	mockProfilesServiceRepo := &mocks.MockProfilesServiceRepository{}
 	mockExercisesRepo := &mocks.MockExercisesRepository{}
-	service := setupTestService(mockProfilesServiceRepo, mockExercisesRepo, nil, nil, nil)
+	mockTestsRepo := &mocks.MockTestsRepository{}
+	mockAttemptsRepo := &mocks.MockAttemptsRepository{}
+	service := setupTestService(mockProfilesServiceRepo, mockExercisesRepo, mockTestsRepo, nil, mockAttemptsRepo)
 
 	expectedProfile := createTestStudentProfile()
 	expectedExercise := createTestExerciseToResolve()
 
 	mockProfilesServiceRepo.On("GetCompleteProfile", mock.Anything, mock.AnythingOfType("*profiles.GetCompleteProfilePayload")).Return(expectedProfile, nil)
 	mockExercisesRepo.On("GetExerciseToResolveById", mock.Anything, int64(1)).Return(expectedExercise, nil)
+	mockTestsRepo.On("GetPublicTestsByExercise", mock.Anything, int64(1)).Return([]codelabdb.Test{}, nil)
+	mockAttemptsRepo.On("GetAttemptsByUserAndExercise", mock.Anything, mock.AnythingOfType("codelabdb.GetAttemptsByUserAndExerciseParams")).Return([]codelabdb.Attempt{}, nil)
 
 	// Act
 	result, err := service.GetExerciseForStudent(context.Background(), &codelab.GetExerciseForStudentPayload{
@@ -813,6 +1837,8 @@ func TestGetExerciseForStudent_Success(t *testing.T) {
 	// Note: No solution field in student response
 	mockProfilesServiceRepo.AssertExpectations(t)
 	mockExercisesRepo.AssertExpectations(t)
+	mockTestsRepo.AssertExpectations(t)
+	mockAttemptsRepo.AssertExpectations(t)
 }
 
 func TestGetAttemptsByUserAndExercise_Success(t *testing.T) {
@@ -826,9 +1852,29 @@ func TestGetAttemptsByUserAndExercise_Success(t *testing.T) {
 		createTestAttempt(false),
 		createTestAttempt(true),
 	}
+	expectedAttempts[1].ID = 2
 
 	mockProfilesServiceRepo.On("GetCompleteProfile", mock.Anything, mock.AnythingOfType("*profiles.GetCompleteProfilePayload")).Return(expectedProfile, nil)
 	mockAttemptsRepo.On("GetAttemptsByUserAndExercise", mock.Anything, mock.AnythingOfType("codelabdb.GetAttemptsByUserAndExerciseParams")).Return(expectedAttempts, nil)
+	mockAttemptsRepo.On("GetTestResultsByUserAndExercise", mock.Anything, mock.AnythingOfType("codelabdb.GetTestResultsByUserAndExerciseParams")).Return([]codelabdb.AttemptTestResult{
+		{
+			ID:             1,
+			AttemptID:      1,
+			TestID:         pgtype.Int8{Int64: 1, Valid: true},
+			Status:         "failed",
+			ActualOutput:   "15",
+			ExpectedOutput: pgtype.Text{String: "10", Valid: true},
+			ConsoleOutput:  "debug 5\n",
+			DurationMs:     2,
+		},
+		{
+			ID:           2,
+			AttemptID:    1,
+			TestID:       pgtype.Int8{Int64: 2, Valid: true},
+			Status:       "error",
+			ErrorMessage: pgtype.Text{String: "Error: boom", Valid: true},
+		},
+	}, nil)
 
 	// Act
 	result, err := service.GetAttemptsByUserAndExercise(context.Background(), &codelab.GetAttemptsByUserAndExercisePayload{
@@ -846,6 +1892,14 @@ func TestGetAttemptsByUserAndExercise_Success(t *testing.T) {
 	assert.Equal(t, "function solution(input) { return input * 2; }", result[0].Code)
 	assert.False(t, result[0].Success)
 	assert.True(t, result[1].Success)
+	assert.Len(t, result[0].TestResults, 2)
+	assert.Equal(t, "failed", result[0].TestResults[0].Status)
+	assert.Equal(t, "15", result[0].TestResults[0].ActualOutput)
+	assert.Equal(t, "10", *result[0].TestResults[0].ExpectedOutput)
+	assert.Equal(t, "debug 5\n", result[0].TestResults[0].ConsoleOutput)
+	assert.Nil(t, result[0].TestResults[1].ExpectedOutput)
+	assert.Equal(t, "Error: boom", *result[0].TestResults[1].Error)
+	assert.Empty(t, result[1].TestResults)
 	mockProfilesServiceRepo.AssertExpectations(t)
 	mockAttemptsRepo.AssertExpectations(t)
 }
@@ -869,7 +1923,7 @@ func TestGetAttemptsByUserAndExercise_PermissionDenied_DifferentUser(t *testing.
 	// Assert
 	assert.Error(t, err)
 	assert.Nil(t, result)
-	assert.Contains(t, err.Error(), "Students can only view their own attempts")
+	assert.Contains(t, errorMessage(err), "Students can only view their own attempts")
 	mockProfilesServiceRepo.AssertExpectations(t)
 }
 
@@ -892,6 +1946,2458 @@ func TestGetAttemptsByUserAndExercise_PermissionDenied_Teacher(t *testing.T) {
 	// Assert
 	assert.Error(t, err)
 	assert.Nil(t, result)
-	assert.Contains(t, err.Error(), "Only students can access this endpoint")
+	assert.Contains(t, errorMessage(err), "Only students can access this endpoint")
 	mockProfilesServiceRepo.AssertExpectations(t)
 }
+
+// ========================================
+// STATS TESTS
+// ========================================
+
+func TestGetExerciseStats_Success(t *testing.T) {
+	// Arrange
+	mockProfilesServiceRepo := &mocks.MockProfilesServiceRepository{}
+	mockExercisesRepo := &mocks.MockExercisesRepository{}
+	mockAnswersRepo := &mocks.MockAnswersRepository{}
+	mockAttemptsRepo := &mocks.MockAttemptsRepository{}
+	service := setupTestService(mockProfilesServiceRepo, mockExercisesRepo, nil, mockAnswersRepo, mockAttemptsRepo)
+
+	start := time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC)
+	at := func(offset time.Duration) pgtype.Timestamptz {
+		return pgtype.Timestamptz{Time: start.Add(offset), Valid: true}
+	}
+
+	answers := []codelabdb.Answer{
+		{ID: 1, ExerciseID: 1, UserID: 10, Completed: true, BestScore: 100},
+		{ID: 2, ExerciseID: 1, UserID: 11, Completed: true, BestScore: 100, HintsUsed: 1},
+		{ID: 3, ExerciseID: 1, UserID: 12, BestScore: 50, HintsUsed: 2},
+		{ID: 4, ExerciseID: 1, UserID: 13},
+	}
+	// Attempts are listed newest first
+	attempts := []codelabdb.GetAttemptsWithAnswerInfoRow{
+		{ID: 5, AnswerID: 1, UserID: 10, Success: true, CreatedAt: at(3 * time.Minute)},
+		{ID: 4, AnswerID: 3, UserID: 12, CreatedAt: at(2 * time.Minute)},
+		{ID: 3, AnswerID: 1, UserID: 10, CreatedAt: at(time.Minute)},
+		{ID: 2, AnswerID: 2, UserID: 11, Success: true, CreatedAt: at(0)},
+		{ID: 1, AnswerID: 1, UserID: 10, CreatedAt: at(0)},
+	}
+
+	mockProfilesServiceRepo.On("GetCompleteProfile", mock.Anything, mock.AnythingOfType("*profiles.GetCompleteProfilePayload")).Return(createTestTeacherProfile(), nil)
+	mockExercisesRepo.On("GetExerciseById", mock.Anything, int64(1)).Return(createTestExercise(), nil)
+	mockAnswersRepo.On("CountTotalAnswersByExercise", mock.Anything, int64(1)).Return(int64(4), nil)
+	mockAnswersRepo.On("CountCompletedAnswersByExercise", mock.Anything, int64(1)).Return(int64(2), nil)
+	mockAnswersRepo.On("ListAnswersByExercise", mock.Anything, int64(1)).Return(answers, nil)
+	mockAttemptsRepo.On("CountTotalAttemptsByExercise", mock.Anything, int64(1)).Return(int64(5), nil)
+	mockAttemptsRepo.On("CountSuccessfulAttemptsByExercise", mock.Anything, int64(1)).Return(int64(2), nil)
+	mockAttemptsRepo.On("GetAttemptsWithAnswerInfo", mock.Anything, int64(1)).Return(attempts, nil)
+	mockAttemptsRepo.On("GetTestFailureCountsByExercise", mock.Anything, int64(1)).Return([]codelabdb.GetTestFailureCountsByExerciseRow{
+		{ID: 3, Input: "-5,5", Public: false, Failures: 3},
+		{ID: 1, Input: "2,3", Public: true, Failures: 1},
+	}, nil)
+
+	// Act
+	result, err := service.GetExerciseStats(context.Background(), &codelab.GetExerciseStatsPayload{
+		SessionToken: "valid-session-token",
+		ExerciseID:   1,
+	})
+
+	// Assert
+	assert.NoError(t, err)
+	if assert.NotNil(t, result) {
+		assert.Equal(t, int64(4), result.TotalStudents)
+		assert.Equal(t, int64(2), result.CompletedStudents)
+		assert.Equal(t, 50.0, result.CompletionRate)
+		assert.Equal(t, int64(5), result.TotalAttempts)
+		assert.Equal(t, int64(2), result.SuccessfulAttempts)
+		assert.Equal(t, 2.0, *result.MedianAttemptsToSuccess)
+		assert.Equal(t, int64(90000), *result.MedianTimeToCompletionMs)
+		assert.Equal(t, &codelab.FailingTest{TestID: 3, Input: "-5,5", Public: false, Failures: 3}, result.MostFailedTest)
+		assert.Equal(t, int64(3), result.HintsUsed)
+
+		if assert.Len(t, result.Students, 4) {
+			assert.Equal(t, "completed", result.Students[0].Status)
+			assert.Equal(t, int64(3), result.Students[0].Attempts)
+			assert.Equal(t, int64(3), *result.Students[0].AttemptsToSuccess)
+			assert.Equal(t, int64(180000), *result.Students[0].TimeToCompletionMs)
+			assert.Equal(t, start.UnixMilli(), *result.Students[0].FirstAttemptAt)
+
+			assert.Equal(t, int64(1), *result.Students[1].AttemptsToSuccess)
+			assert.Equal(t, int64(0), *result.Students[1].TimeToCompletionMs)
+
+			assert.Equal(t, "in_progress", result.Students[2].Status)
+			assert.Equal(t, 50.0, result.Students[2].BestScore)
+			assert.Equal(t, int32(2), result.Students[2].HintsUsed)
+			assert.Nil(t, result.Students[2].CompletedAt)
+
+			assert.Equal(t, "not_started", result.Students[3].Status)
+			assert.Equal(t, int64(0), result.Students[3].Attempts)
+			assert.Nil(t, result.Students[3].FirstAttemptAt)
+		}
+	}
+	mockAnswersRepo.AssertExpectations(t)
+	mockAttemptsRepo.AssertExpectations(t)
+}
+
+func TestGetExerciseStats_NoAttempts(t *testing.T) {
+	// Arrange
+	mockProfilesServiceRepo := &mocks.MockProfilesServiceRepository{}
+	mockExercisesRepo := &mocks.MockExercisesRepository{}
+	mockAnswersRepo := &mocks.MockAnswersRepository{}
+	mockAttemptsRepo := &mocks.MockAttemptsRepository{}
+	service := setupTestService(mockProfilesServiceRepo, mockExercisesRepo, nil, mockAnswersRepo, mockAttemptsRepo)
+
+	mockProfilesServiceRepo.On("GetCompleteProfile", mock.Anything, mock.AnythingOfType("*profiles.GetCompleteProfilePayload")).Return(createTestTeacherProfile(), nil)
+	mockExercisesRepo.On("GetExerciseById", mock.Anything, int64(1)).Return(createTestExercise(), nil)
+	mockAnswersRepo.On("CountTotalAnswersByExercise", mock.Anything, int64(1)).Return(int64(0), nil)
+	mockAnswersRepo.On("CountCompletedAnswersByExercise", mock.Anything, int64(1)).Return(int64(0), nil)
+	mockAnswersRepo.On("ListAnswersByExercise", mock.Anything, int64(1)).Return([]codelabdb.Answer{}, nil)
+	mockAttemptsRepo.On("CountTotalAttemptsByExercise", mock.Anything, int64(1)).Return(int64(0), nil)
+	mockAttemptsRepo.On("CountSuccessfulAttemptsByExercise", mock.Anything, int64(1)).Return(int64(0), nil)
+	mockAttemptsRepo.On("GetAttemptsWithAnswerInfo", mock.Anything, int64(1)).Return([]codelabdb.GetAttemptsWithAnswerInfoRow{}, nil)
+	mockAttemptsRepo.On("GetTestFailureCountsByExercise", mock.Anything, int64(1)).Return([]codelabdb.GetTestFailureCountsByExerciseRow{}, nil)
+
+	// Act
+	result, err := service.GetExerciseStats(context.Background(), &codelab.GetExerciseStatsPayload{
+		SessionToken: "valid-session-token",
+		ExerciseID:   1,
+	})
+
+	// Assert
+	assert.NoError(t, err)
+	if assert.NotNil(t, result) {
+		assert.Equal(t, 0.0, result.CompletionRate)
+		assert.Nil(t, result.MedianAttemptsToSuccess)
+		assert.Nil(t, result.MedianTimeToCompletionMs)
+		assert.Nil(t, result.MostFailedTest)
+		assert.Empty(t, result.Students)
+	}
+}
+
+func TestGetExerciseStats_PermissionDenied_Student(t *testing.T) {
+	// Arrange
+	mockProfilesServiceRepo := &mocks.MockProfilesServiceRepository{}
+	service := setupTestService(mockProfilesServiceRepo, nil, nil, nil, nil)
+
+	mockProfilesServiceRepo.On("GetCompleteProfile", mock.Anything, mock.AnythingOfType("*profiles.GetCompleteProfilePayload")).Return(createTestStudentProfile(), nil)
+
+	// Act
+	result, err := service.GetExerciseStats(context.Background(), &codelab.GetExerciseStatsPayload{
+		SessionToken: "valid-session-token",
+		ExerciseID:   1,
+	})
+
+	// Assert
+	assert.Error(t, err)
+	assert.Nil(t, result)
+	assert.Contains(t, errorMessage(err), "Only teachers can view exercise statistics")
+	mockProfilesServiceRepo.AssertExpectations(t)
+}
+
+func TestGetStudentExerciseStats_Success(t *testing.T) {
+	// Arrange
+	mockProfilesServiceRepo := &mocks.MockProfilesServiceRepository{}
+	mockExercisesRepo := &mocks.MockExercisesRepository{}
+	mockAnswersRepo := &mocks.MockAnswersRepository{}
+	mockAttemptsRepo := &mocks.MockAttemptsRepository{}
+	service := setupTestService(mockProfilesServiceRepo, mockExercisesRepo, nil, mockAnswersRepo, mockAttemptsRepo)
+
+	start := time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC)
+	answer := codelabdb.Answer{ID: 1, ExerciseID: 1, UserID: 10, Completed: true, BestScore: 100}
+
+	mockProfilesServiceRepo.On("GetCompleteProfile", mock.Anything, mock.AnythingOfType("*profiles.GetCompleteProfilePayload")).Return(createTestTeacherProfile(), nil)
+	mockExercisesRepo.On("GetExerciseById", mock.Anything, int64(1)).Return(createTestExercise(), nil)
+	mockAnswersRepo.On("GetAnswerByUserAndExercise", mock.Anything, codelabdb.GetAnswerByUserAndExerciseParams{ExerciseID: 1, UserID: 10}).Return(answer, nil)
+	mockAttemptsRepo.On("GetAttemptsByUserAndExercise", mock.Anything, codelabdb.GetAttemptsByUserAndExerciseParams{UserID: 10, ExerciseID: 1}).Return([]codelabdb.Attempt{
+		{ID: 2, AnswerID: 1, Success: true, Status: "passed", Score: 100, CreatedAt: pgtype.Timestamptz{Time: start.Add(time.Minute), Valid: true}},
+		{ID: 1, AnswerID: 1, Status: "failed", Score: 50, CreatedAt: pgtype.Timestamptz{Time: start, Valid: true}},
+	}, nil)
+	mockAttemptsRepo.On("GetTestResultsByUserAndExercise", mock.Anything, codelabdb.GetTestResultsByUserAndExerciseParams{UserID: 10, ExerciseID: 1}).Return([]codelabdb.AttemptTestResult{
+		{ID: 1, AttemptID: 1, TestID: pgtype.Int8{Int64: 1, Valid: true}, Status: "failed"},
+	}, nil)
+
+	// Act
+	result, err := service.GetStudentExerciseStats(context.Background(), &codelab.GetStudentExerciseStatsPayload{
+		SessionToken: "valid-session-token",
+		ExerciseID:   1,
+		UserID:       10,
+	})
+
+	// Assert
+	assert.NoError(t, err)
+	if assert.NotNil(t, result) {
+		assert.Equal(t, "completed", result.Summary.Status)
+		assert.Equal(t, int64(2), *result.Summary.AttemptsToSuccess)
+		assert.Equal(t, int64(60000), *result.Summary.TimeToCompletionMs)
+		if assert.Len(t, result.Attempts, 2) {
+			assert.Equal(t, int64(1), result.Attempts[0].ID)
+			assert.Equal(t, 50.0, result.Attempts[0].Score)
+			assert.Len(t, result.Attempts[0].TestResults, 1)
+			assert.Equal(t, int64(2), result.Attempts[1].ID)
+			assert.Empty(t, result.Attempts[1].TestResults)
+		}
+	}
+	mockAttemptsRepo.AssertExpectations(t)
+}
+
+func TestGetStudentExerciseStats_NotStarted(t *testing.T) {
+	// Arrange
+	mockProfilesServiceRepo := &mocks.MockProfilesServiceRepository{}
+	mockExercisesRepo := &mocks.MockExercisesRepository{}
+	mockAnswersRepo := &mocks.MockAnswersRepository{}
+	service := setupTestService(mockProfilesServiceRepo, mockExercisesRepo, nil, mockAnswersRepo, nil)
+
+	mockProfilesServiceRepo.On("GetCompleteProfile", mock.Anything, mock.AnythingOfType("*profiles.GetCompleteProfilePayload")).Return(createTestTeacherProfile(), nil)
+	mockExercisesRepo.On("GetExerciseById", mock.Anything, int64(1)).Return(createTestExercise(), nil)
+	mockAnswersRepo.On("GetAnswerByUserAndExercise", mock.Anything, mock.AnythingOfType("codelabdb.GetAnswerByUserAndExerciseParams")).Return(codelabdb.Answer{}, errors.New("no rows in result set"))
+
+	// Act
+	result, err := service.GetStudentExerciseStats(context.Background(), &codelab.GetStudentExerciseStatsPayload{
+		SessionToken: "valid-session-token",
+		ExerciseID:   1,
+		UserID:       10,
+	})
+
+	// Assert
+	assert.Error(t, err)
+	assert.Nil(t, result)
+	assert.Contains(t, errorMessage(err), "Student has not started this exercise")
+}
+
+// ========================================
+// PLAGIARISM TESTS
+// ========================================
+
+func TestGetPlagiarismReport_Success(t *testing.T) {
+	// Arrange
+	mockProfilesServiceRepo := &mocks.MockProfilesServiceRepository{}
+	mockExercisesRepo := &mocks.MockExercisesRepository{}
+	mockPlagiarismRepo := &mocks.MockPlagiarismRepository{}
+	service := setupTestService(mockProfilesServiceRepo, mockExercisesRepo, nil, nil, nil)
+	service.plagiarismRepo = mockPlagiarismRepo
+
+	checkedAt := time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC)
+
+	mockProfilesServiceRepo.On("GetCompleteProfile", mock.Anything, mock.AnythingOfType("*profiles.GetCompleteProfilePayload")).Return(createTestTeacherProfile(), nil)
+	mockExercisesRepo.On("GetExerciseById", mock.Anything, int64(1)).Return(createTestExercise(), nil)
+	mockPlagiarismRepo.On("GetPlagiarismCheck", mock.Anything, int64(1)).Return(codelabdb.PlagiarismCheck{
+		ExerciseID: 1,
+		CheckedAt:  pgtype.Timestamptz{Time: checkedAt, Valid: true},
+	}, nil)
+	mockPlagiarismRepo.On("ListPlagiarismMatchesByExercise", mock.Anything, codelabdb.ListPlagiarismMatchesByExerciseParams{ExerciseID: 1, Similarity: 80}).Return([]codelabdb.PlagiarismMatch{
+		{
+			ID:         1,
+			ExerciseID: 1,
+			AttemptAID: 3,
+			UserAID:    10,
+			AttemptBID: 7,
+			UserBID:    11,
+			Similarity: 92.5,
+			Regions:    []byte(`[{"start_line_a":2,"end_line_a":6,"start_line_b":3,"end_line_b":7}]`),
+		},
+	}, nil)
+
+	// Act
+	result, err := service.GetPlagiarismReport(context.Background(), &codelab.GetPlagiarismReportPayload{
+		SessionToken:  "valid-session-token",
+		ExerciseID:    1,
+		MinSimilarity: 80,
+	})
+
+	// Assert
+	assert.NoError(t, err)
+	if assert.NotNil(t, result) {
+		assert.Equal(t, checkedAt.UnixMilli(), *result.CheckedAt)
+		if assert.Len(t, result.Pairs, 1) {
+			pair := result.Pairs[0]
+			assert.Equal(t, int64(10), pair.UserAID)
+			assert.Equal(t, int64(11), pair.UserBID)
+			assert.Equal(t, 92.5, pair.Similarity)
+			assert.Equal(t, []*codelab.PlagiarismRegion{{StartLineA: 2, EndLineA: 6, StartLineB: 3, EndLineB: 7}}, pair.Regions)
+		}
+	}
+	mockPlagiarismRepo.AssertExpectations(t)
+}
+
+func TestGetPlagiarismReport_NotCheckedYet(t *testing.T) {
+	// Arrange
+	mockProfilesServiceRepo := &mocks.MockProfilesServiceRepository{}
+	mockExercisesRepo := &mocks.MockExercisesRepository{}
+	mockPlagiarismRepo := &mocks.MockPlagiarismRepository{}
+	service := setupTestService(mockProfilesServiceRepo, mockExercisesRepo, nil, nil, nil)
+	service.plagiarismRepo = mockPlagiarismRepo
+
+	mockProfilesServiceRepo.On("GetCompleteProfile", mock.Anything, mock.AnythingOfType("*profiles.GetCompleteProfilePayload")).Return(createTestTeacherProfile(), nil)
+	mockExercisesRepo.On("GetExerciseById", mock.Anything, int64(1)).Return(createTestExercise(), nil)
+	mockPlagiarismRepo.On("GetPlagiarismCheck", mock.Anything, int64(1)).Return(codelabdb.PlagiarismCheck{}, pgx.ErrNoRows)
+
+	// Act
+	result, err := service.GetPlagiarismReport(context.Background(), &codelab.GetPlagiarismReportPayload{
+		SessionToken: "valid-session-token",
+		ExerciseID:   1,
+	})
+
+	// Assert
+	assert.NoError(t, err)
+	if assert.NotNil(t, result) {
+		assert.Nil(t, result.CheckedAt)
+		assert.Empty(t, result.Pairs)
+	}
+	mockPlagiarismRepo.AssertNotCalled(t, "ListPlagiarismMatchesByExercise", mock.Anything, mock.Anything)
+}
+
+func TestGetPlagiarismReport_StudentDenied(t *testing.T) {
+	// Arrange
+	mockProfilesServiceRepo := &mocks.MockProfilesServiceRepository{}
+	service := setupTestService(mockProfilesServiceRepo, nil, nil, nil, nil)
+
+	mockProfilesServiceRepo.On("GetCompleteProfile", mock.Anything, mock.AnythingOfType("*profiles.GetCompleteProfilePayload")).Return(createTestStudentProfile(), nil)
+
+	// Act
+	result, err := service.GetPlagiarismReport(context.Background(), &codelab.GetPlagiarismReportPayload{
+		SessionToken: "valid-session-token",
+		ExerciseID:   1,
+	})
+
+	// Assert
+	assert.Error(t, err)
+	assert.Nil(t, result)
+	assert.Contains(t, errorMessage(err), "Only teachers can view plagiarism reports")
+}
+
+// ========================================
+// IMPORT / EXPORT TESTS
+// ========================================
+
+// testBundle builds a JSON bundle with a single exercise solved by solution
+func testBundle(solution string) []byte {
+	return []byte(`{
+		"version": 1,
+		"exercises": [{
+			"title": "Double",
+			"description": "Double a number",
+			"difficulty": "easy",
+			"tags": ["Arithmetic"],
+			"initial_code": "function solution(input) {}",
+			"solution": ` + strconv.Quote(solution) + `,
+			"tests": [
+				{"input": "2", "output": "4", "public": true},
+				{"input": "[5]", "output": "10", "input_format": "json", "weight": 3}
+			]
+		}]
+	}`)
+}
+
+func TestExportExercises_Success(t *testing.T) {
+	// Arrange
+	mockProfilesServiceRepo := &mocks.MockProfilesServiceRepository{}
+	mockExercisesRepo := &mocks.MockExercisesRepository{}
+	mockTestsRepo := &mocks.MockTestsRepository{}
+	service := setupTestService(mockProfilesServiceRepo, mockExercisesRepo, mockTestsRepo, nil, nil)
+
+	mockProfilesServiceRepo.On("GetCompleteProfile", mock.Anything, mock.AnythingOfType("*profiles.GetCompleteProfilePayload")).Return(createTestTeacherProfile(), nil)
+	mockExercisesRepo.On("GetExerciseById", mock.Anything, int64(1)).Return(createTestExercise(), nil).Once()
+	mockTestsRepo.On("GetTestsByExercise", mock.Anything, int64(1)).Return([]codelabdb.Test{
+		{ID: 1, Input: "5", Output: "10", Public: true, Weight: 2, InputFormat: "text", Comparison: "exact", ExerciseID: 1},
+	}, nil).Once()
+
+	// Act
+	result, err := service.ExportExercises(context.Background(), &codelab.ExportExercisesPayload{
+		SessionToken: "valid-session-token",
+		ExerciseIds:  []int64{1, 1},
+		Format:       "zip",
+	})
+
+	// Assert
+	assert.NoError(t, err)
+	if assert.NotNil(t, result) {
+		assert.Equal(t, "zip", result.Format)
+		assert.Regexp(t, `^exercises-\d{8}\.zip$`, result.Filename)
+
+		b, err := bundle.Decode(result.Content)
+		if assert.NoError(t, err) && assert.Len(t, b.Exercises, 1) {
+			exercise := b.Exercises[0]
+			assert.Equal(t, "Test Exercise", exercise.Title)
+			assert.Equal(t, createTestExercise().Solution, exercise.Solution)
+			assert.Equal(t, []bundle.Test{{Input: "5", Output: "10", Public: true, Weight: 2, InputFormat: "text", Comparison: "exact"}}, exercise.Tests)
+		}
+	}
+	mockExercisesRepo.AssertExpectations(t)
+	mockTestsRepo.AssertExpectations(t)
+}
+
+func TestExportExercises_NotFound(t *testing.T) {
+	// Arrange
+	mockProfilesServiceRepo := &mocks.MockProfilesServiceRepository{}
+	mockExercisesRepo := &mocks.MockExercisesRepository{}
+	service := setupTestService(mockProfilesServiceRepo, mockExercisesRepo, nil, nil, nil)
+
+	mockProfilesServiceRepo.On("GetCompleteProfile", mock.Anything, mock.AnythingOfType("*profiles.GetCompleteProfilePayload")).Return(createTestTeacherProfile(), nil)
+	mockExercisesRepo.On("GetExerciseById", mock.Anything, int64(99)).Return(codelabdb.Exercise{}, errors.New("no rows in result set"))
+
+	// Act
+	result, err := service.ExportExercises(context.Background(), &codelab.ExportExercisesPayload{
+		SessionToken: "valid-session-token",
+		ExerciseIds:  []int64{99},
+		Format:       "json",
+	})
+
+	// Assert
+	assert.Error(t, err)
+	assert.Nil(t, result)
+	assert.Contains(t, errorMessage(err), "Exercise 99 not found")
+}
+
+func TestImportExercises_Success(t *testing.T) {
+	// Arrange
+	mockProfilesServiceRepo := &mocks.MockProfilesServiceRepository{}
+	mockExercisesRepo := &mocks.MockExercisesRepository{}
+	mockTestsRepo := &mocks.MockTestsRepository{}
+	service := setupTestService(mockProfilesServiceRepo, nil, nil, nil, nil)
+	mockTxManager := useTransaction(service, &repositories.TransactionalRepositories{ExercisesRepo: mockExercisesRepo, TestsRepo: mockTestsRepo})
+
+	mockProfilesServiceRepo.On("GetCompleteProfile", mock.Anything, mock.AnythingOfType("*profiles.GetCompleteProfilePayload")).Return(createTestTeacherProfile(), nil)
+	mockTxManager.On("WithTransaction", mock.Anything).Return(nil)
+	mockExercisesRepo.On("CreateExercise", mock.Anything, codelabdb.CreateExerciseParams{
+		Title:       "Double",
+		Description: "Double a number",
+		InitialCode: "function solution(input) {}",
+		Solution:    "function solution(n) { return Number(n) * 2; }",
+		Difficulty:  "easy",
+		Language:    "javascript",
+		Tags:        []string{"arithmetic"},
+		CreatedBy:   1,
+	}).Return(int64(7), nil)
+	mockTestsRepo.On("CreateTest", mock.Anything, codelabdb.CreateTestParams{
+		Input: "2", Output: "4", Public: true, Weight: 1, InputFormat: "text", Comparison: "exact", ExerciseID: 7,
+	}).Return(nil)
+	mockTestsRepo.On("CreateTest", mock.Anything, codelabdb.CreateTestParams{
+		Input: "[5]", Output: "10", Weight: 3, InputFormat: "json", Comparison: "exact", ExerciseID: 7,
+	}).Return(nil)
+	mockExercisesRepo.On("GetExerciseById", mock.Anything, int64(7)).Return(codelabdb.Exercise{ID: 7, Title: "Double", Version: 1}, nil)
+	mockTestsRepo.On("GetTestsByExercise", mock.Anything, int64(7)).Return([]codelabdb.Test{
+		{ID: 20, Input: "2", Output: "4", Public: true, Weight: 1, InputFormat: "text", Comparison: "exact", ExerciseID: 7},
+		{ID: 21, Input: "[5]", Output: "10", Weight: 3, InputFormat: "json", Comparison: "exact", ExerciseID: 7},
+	}, nil)
+	mockExercisesRepo.On("CreateExerciseVersion", mock.Anything, mock.MatchedBy(func(arg codelabdb.CreateExerciseVersionParams) bool {
+		snapshot, err := versioning.Decode(arg.Snapshot)
+		return err == nil && arg.ExerciseID == 7 && arg.Version == 1 && arg.CreatedBy == 1 && len(snapshot.Tests) == 2
+	})).Return(nil)
+
+	// Act
+	result, err := service.ImportExercises(context.Background(), &codelab.ImportExercisesPayload{
+		SessionToken: "valid-session-token",
+		Content:      testBundle("function solution(n) { return Number(n) * 2; }"),
+	})
+
+	// Assert
+	assert.NoError(t, err)
+	if assert.NotNil(t, result) {
+		assert.True(t, result.Success)
+		assert.Equal(t, []int64{7}, result.ExerciseIds)
+	}
+	mockExercisesRepo.AssertExpectations(t)
+	mockTestsRepo.AssertExpectations(t)
+}
+
+func TestImportExercises_FailingSolution(t *testing.T) {
+	// Arrange
+	mockProfilesServiceRepo := &mocks.MockProfilesServiceRepository{}
+	service := setupTestService(mockProfilesServiceRepo, nil, nil, nil, nil)
+	mockTxManager := useTransaction(service, &repositories.TransactionalRepositories{})
+
+	mockProfilesServiceRepo.On("GetCompleteProfile", mock.Anything, mock.AnythingOfType("*profiles.GetCompleteProfilePayload")).Return(createTestTeacherProfile(), nil)
+
+	// Act
+	result, err := service.ImportExercises(context.Background(), &codelab.ImportExercisesPayload{
+		SessionToken: "valid-session-token",
+		Content:      testBundle("function solution(n) { return Number(n) + 2; }"),
+	})
+
+	// Assert
+	assert.Error(t, err)
+	assert.Nil(t, result)
+	var failure *codelab.SolutionFailure
+	if assert.ErrorAs(t, err, &failure) {
+		assert.Contains(t, failure.Message, "Exercise 1 (Double): solution does not pass its tests: Test failed: expected 10, got 7")
+		assert.Len(t, failure.FailingTests, 1)
+	}
+	mockTxManager.AssertNotCalled(t, "WithTransaction", mock.Anything)
+}
+
+func TestImportExercises_InvalidBundle(t *testing.T) {
+	// Arrange
+	mockProfilesServiceRepo := &mocks.MockProfilesServiceRepository{}
+	service := setupTestService(mockProfilesServiceRepo, nil, nil, nil, nil)
+
+	mockProfilesServiceRepo.On("GetCompleteProfile", mock.Anything, mock.AnythingOfType("*profiles.GetCompleteProfilePayload")).Return(createTestTeacherProfile(), nil)
+
+	// Act
+	result, err := service.ImportExercises(context.Background(), &codelab.ImportExercisesPayload{
+		SessionToken: "valid-session-token",
+		Content:      []byte(`{"version": 2, "exercises": []}`),
+	})
+
+	// Assert
+	assert.Error(t, err)
+	assert.Nil(t, result)
+	assert.Contains(t, errorMessage(err), "Invalid bundle: unsupported bundle version 2")
+}
+
+func TestImportExercises_RollsBackOnError(t *testing.T) {
+	// Arrange
+	mockProfilesServiceRepo := &mocks.MockProfilesServiceRepository{}
+	mockExercisesRepo := &mocks.MockExercisesRepository{}
+	mockTestsRepo := &mocks.MockTestsRepository{}
+	service := setupTestService(mockProfilesServiceRepo, nil, nil, nil, nil)
+	mockTxManager := useTransaction(service, &repositories.TransactionalRepositories{ExercisesRepo: mockExercisesRepo, TestsRepo: mockTestsRepo})
+
+	mockProfilesServiceRepo.On("GetCompleteProfile", mock.Anything, mock.AnythingOfType("*profiles.GetCompleteProfilePayload")).Return(createTestTeacherProfile(), nil)
+	mockTxManager.On("WithTransaction", mock.Anything).Return(nil)
+	mockExercisesRepo.On("CreateExercise", mock.Anything, mock.AnythingOfType("codelabdb.CreateExerciseParams")).Return(int64(7), nil)
+	mockTestsRepo.On("CreateTest", mock.Anything, mock.AnythingOfType("codelabdb.CreateTestParams")).Return(errors.New("database error")).Once()
+
+	// Act
+	result, err := service.ImportExercises(context.Background(), &codelab.ImportExercisesPayload{
+		SessionToken: "valid-session-token",
+		Content:      testBundle("function solution(n) { return Number(n) * 2; }"),
+	})
+
+	// Assert
+	assert.Error(t, err)
+	assert.Nil(t, result)
+	assert.Contains(t, errorMessage(err), "Failed to import exercises: failed to create test 1 of exercise 1")
+	mockTestsRepo.AssertNumberOfCalls(t, "CreateTest", 1)
+}
+
+// ========================================
+// SOLUTION VALIDATION TESTS
+// ========================================
+
+func TestCreateExercise_SolutionFailsToLoad(t *testing.T) {
+	// Arrange
+	mockProfilesServiceRepo := &mocks.MockProfilesServiceRepository{}
+	mockExercisesRepo := &mocks.MockExercisesRepository{}
+	service := setupTestService(mockProfilesServiceRepo, mockExercisesRepo, nil, nil, nil)
+
+	mockProfilesServiceRepo.On("GetCompleteProfile", mock.Anything, mock.AnythingOfType("*profiles.GetCompleteProfilePayload")).Return(createTestTeacherProfile(), nil)
+
+	// Act
+	result, err := service.CreateExercise(context.Background(), &codelab.CreateExercisePayload{
+		SessionToken: "valid-session-token",
+		Title:        "Test Exercise",
+		Description:  "This is a test exercise",
+		InitialCode:  "function solution(input) {\n  // Your code here\n}",
+		Solution:     "function solution(input) {\n  return input *;\n}",
+		Difficulty:   "easy",
+		Language:     "javascript",
+	})
+
+	// Assert
+	assert.Error(t, err)
+	assert.Nil(t, result)
+	assert.Contains(t, errorMessage(err), "Solution failed to run")
+	mockExercisesRepo.AssertNotCalled(t, "CreateExercise", mock.Anything, mock.Anything)
+}
+
+func TestUpdateExercise_SolutionFailsTests(t *testing.T) {
+	// Arrange
+	mockProfilesServiceRepo := &mocks.MockProfilesServiceRepository{}
+	mockExercisesRepo := &mocks.MockExercisesRepository{}
+	mockTestsRepo := &mocks.MockTestsRepository{}
+	service := setupTestService(mockProfilesServiceRepo, mockExercisesRepo, mockTestsRepo, nil, nil)
+
+	mockProfilesServiceRepo.On("GetCompleteProfile", mock.Anything, mock.AnythingOfType("*profiles.GetCompleteProfilePayload")).Return(createTestTeacherProfile(), nil)
+	mockExercisesRepo.On("GetExerciseById", mock.Anything, int64(1)).Return(createTestExercise(), nil)
+	mockTestsRepo.On("GetTestsByExercise", mock.Anything, int64(1)).Return([]codelabdb.Test{
+		{ID: 1, Input: "5", Output: "10", Public: true, Weight: 1, InputFormat: "text", Comparison: "exact", ExerciseID: 1},
+		{ID: 2, Input: "7", Output: "14", Weight: 1, InputFormat: "text", Comparison: "exact", ExerciseID: 1},
+	}, nil)
+
+	// Act
+	result, err := service.UpdateExercise(context.Background(), &codelab.UpdateExercisePayload2{
+		SessionToken: "valid-session-token",
+		ID:           1,
+		Exercise: &codelab.UpdateExercisePayload{
+			Title:       "Updated Exercise",
+			Description: "This is an updated exercise",
+			InitialCode: "function solution(input) {}",
+			Solution:    "function solution(input) { return input === '5' ? 10 : 0; }",
+			Difficulty:  "easy",
+		},
+	})
+
+	// Assert
+	assert.Nil(t, result)
+	var failure *codelab.SolutionFailure
+	if assert.ErrorAs(t, err, &failure) {
+		assert.Contains(t, failure.Message, "Solution does not pass its tests: Test failed: expected 14, got 0 (1 of 2 tests passed)")
+		if assert.Len(t, failure.FailingTests, 1) {
+			assert.Equal(t, &codelab.SolutionTestFailure{
+				TestID:         ptr(int64(2)),
+				Input:          "7",
+				ExpectedOutput: "14",
+				ActualOutput:   "0",
+				Status:         "failed",
+			}, failure.FailingTests[0])
+		}
+	}
+	mockExercisesRepo.AssertNotCalled(t, "UpdateExercise", mock.Anything, mock.Anything)
+}
+
+func TestCreateTest_SolutionFailsNewTest(t *testing.T) {
+	// Arrange
+	mockProfilesServiceRepo := &mocks.MockProfilesServiceRepository{}
+	mockExercisesRepo := &mocks.MockExercisesRepository{}
+	mockTestsRepo := &mocks.MockTestsRepository{}
+	service := setupTestService(mockProfilesServiceRepo, mockExercisesRepo, mockTestsRepo, nil, nil)
+
+	mockProfilesServiceRepo.On("GetCompleteProfile", mock.Anything, mock.AnythingOfType("*profiles.GetCompleteProfilePayload")).Return(createTestTeacherProfile(), nil)
+	mockExercisesRepo.On("GetExerciseById", mock.Anything, int64(1)).Return(createTestExercise(), nil)
+	mockTestsRepo.On("GetTestsByExercise", mock.Anything, int64(1)).Return([]codelabdb.Test{
+		{ID: 1, Input: "5", Output: "10", Public: true, Weight: 1, InputFormat: "text", Comparison: "exact", ExerciseID: 1},
+	}, nil)
+
+	// Act
+	result, err := service.CreateTest(context.Background(), &codelab.CreateTestPayload{
+		SessionToken: "valid-session-token",
+		Input:        "3",
+		Output:       "9",
+		Public:       false,
+		ExerciseID:   1,
+		InputFormat:  "text",
+		Comparison:   "exact",
+		Weight:       1,
+	})
+
+	// Assert
+	assert.Nil(t, result)
+	var failure *codelab.SolutionFailure
+	if assert.ErrorAs(t, err, &failure) && assert.Len(t, failure.FailingTests, 1) {
+		assert.Nil(t, failure.FailingTests[0].TestID)
+		assert.Equal(t, "9", failure.FailingTests[0].ExpectedOutput)
+		assert.Equal(t, "6", failure.FailingTests[0].ActualOutput)
+	}
+	mockTestsRepo.AssertNotCalled(t, "CreateTest", mock.Anything, mock.Anything)
+}
+
+func TestUpdateTest_Success(t *testing.T) {
+	// Arrange
+	mockProfilesServiceRepo := &mocks.MockProfilesServiceRepository{}
+	mockExercisesRepo := &mocks.MockExercisesRepository{}
+	mockTestsRepo := &mocks.MockTestsRepository{}
+	service := setupTestService(mockProfilesServiceRepo, mockExercisesRepo, mockTestsRepo, nil, nil)
+
+	test := codelabdb.Test{ID: 1, Input: "5", Output: "10", Public: true, Weight: 1, InputFormat: "text", Comparison: "exact", ExerciseID: 1}
+
+	mockProfilesServiceRepo.On("GetCompleteProfile", mock.Anything, mock.AnythingOfType("*profiles.GetCompleteProfilePayload")).Return(createTestTeacherProfile(), nil)
+	mockTestsRepo.On("GetTestById", mock.Anything, int64(1)).Return(test, nil)
+	mockExercisesRepo.On("GetExerciseById", mock.Anything, int64(1)).Return(createTestExercise(), nil)
+	mockTestsRepo.On("GetTestsByExercise", mock.Anything, int64(1)).Return([]codelabdb.Test{test}, nil)
+	mockTestsRepo.On("UpdateTest", mock.Anything, mock.MatchedBy(func(arg codelabdb.UpdateTestParams) bool {
+		return arg.ID == 1 && arg.Input == "6" && arg.Output == "12"
+	})).Return(nil)
+	expectExerciseVersion(mockExercisesRepo, mockTestsRepo, 2)
+
+	// Act
+	result, err := service.UpdateTest(context.Background(), &codelab.UpdateTestPayload2{
+		SessionToken: "valid-session-token",
+		ID:           1,
+		Test: &codelab.UpdateTestPayload{
+			Input:       "6",
+			Output:      "12",
+			Public:      true,
+			Weight:      1,
+			InputFormat: "text",
+			Comparison:  "exact",
+		},
+	})
+
+	// Assert
+	assert.NoError(t, err)
+	if assert.NotNil(t, result) {
+		assert.True(t, result.Success)
+	}
+	mockTestsRepo.AssertExpectations(t)
+}
+
+func TestUpdateTest_SolutionFails(t *testing.T) {
+	// Arrange
+	mockProfilesServiceRepo := &mocks.MockProfilesServiceRepository{}
+	mockExercisesRepo := &mocks.MockExercisesRepository{}
+	mockTestsRepo := &mocks.MockTestsRepository{}
+	service := setupTestService(mockProfilesServiceRepo, mockExercisesRepo, mockTestsRepo, nil, nil)
+
+	test := codelabdb.Test{ID: 1, Input: "5", Output: "10", Public: true, Weight: 1, InputFormat: "text", Comparison: "exact", ExerciseID: 1}
+
+	mockProfilesServiceRepo.On("GetCompleteProfile", mock.Anything, mock.AnythingOfType("*profiles.GetCompleteProfilePayload")).Return(createTestTeacherProfile(), nil)
+	mockTestsRepo.On("GetTestById", mock.Anything, int64(1)).Return(test, nil)
+	mockExercisesRepo.On("GetExerciseById", mock.Anything, int64(1)).Return(createTestExercise(), nil)
+	mockTestsRepo.On("GetTestsByExercise", mock.Anything, int64(1)).Return([]codelabdb.Test{test}, nil)
+
+	// Act
+	result, err := service.UpdateTest(context.Background(), &codelab.UpdateTestPayload2{
+		SessionToken: "valid-session-token",
+		ID:           1,
+		Test: &codelab.UpdateTestPayload{
+			Input:       "5",
+			Output:      "11",
+			Public:      true,
+			Weight:      1,
+			InputFormat: "text",
+			Comparison:  "exact",
+		},
+	})
+
+	// Assert
+	assert.Nil(t, result)
+	var failure *codelab.SolutionFailure
+	if assert.ErrorAs(t, err, &failure) && assert.Len(t, failure.FailingTests, 1) {
+		assert.Equal(t, ptr(int64(1)), failure.FailingTests[0].TestID)
+		assert.Equal(t, "11", failure.FailingTests[0].ExpectedOutput)
+	}
+	mockTestsRepo.AssertNotCalled(t, "UpdateTest", mock.Anything, mock.Anything)
+}
+
+func TestUpdateTest_NotFound(t *testing.T) {
+	// Arrange
+	mockProfilesServiceRepo := &mocks.MockProfilesServiceRepository{}
+	mockTestsRepo := &mocks.MockTestsRepository{}
+	service := setupTestService(mockProfilesServiceRepo, nil, mockTestsRepo, nil, nil)
+
+	mockProfilesServiceRepo.On("GetCompleteProfile", mock.Anything, mock.AnythingOfType("*profiles.GetCompleteProfilePayload")).Return(createTestTeacherProfile(), nil)
+	mockTestsRepo.On("GetTestById", mock.Anything, int64(99)).Return(codelabdb.Test{}, errors.New("no rows in result set"))
+
+	// Act
+	result, err := service.UpdateTest(context.Background(), &codelab.UpdateTestPayload2{
+		SessionToken: "valid-session-token",
+		ID:           99,
+		Test: &codelab.UpdateTestPayload{
+			Input:       "5",
+			Output:      "10",
+			Public:      true,
+			Weight:      1,
+			InputFormat: "text",
+			Comparison:  "exact",
+		},
+	})
+
+	// Assert
+	assert.Error(t, err)
+	assert.Nil(t, result)
+	assert.Contains(t, errorMessage(err), "Test not found")
+}
+
+// ========================================
+// VERSION TESTS
+// ========================================
+
+// encodeSnapshot encodes a version of the test exercise with the given tests
+func encodeSnapshot(t *testing.T, solution string, tests ...versioning.Test) []byte {
+	exercise := createTestExercise()
+	snapshot, err := versioning.Encode(versioning.Snapshot{
+		Title:       exercise.Title,
+		Description: exercise.Description,
+		InitialCode: exercise.InitialCode,
+		Solution:    solution,
+		Difficulty:  exercise.Difficulty,
+		Language:    exercise.Language,
+		Tests:       tests,
+	})
+	assert.NoError(t, err)
+	return snapshot
+}
+
+func TestDeleteTest_SavesNewVersion(t *testing.T) {
+	// Arrange
+	mockProfilesServiceRepo := &mocks.MockProfilesServiceRepository{}
+	mockExercisesRepo := &mocks.MockExercisesRepository{}
+	mockTestsRepo := &mocks.MockTestsRepository{}
+	service := setupTestService(mockProfilesServiceRepo, mockExercisesRepo, mockTestsRepo, nil, nil)
+
+	mockProfilesServiceRepo.On("GetCompleteProfile", mock.Anything, mock.AnythingOfType("*profiles.GetCompleteProfilePayload")).Return(createTestTeacherProfile(), nil)
+	mockTestsRepo.On("GetTestById", mock.Anything, int64(5)).Return(codelabdb.Test{ID: 5, ExerciseID: 1}, nil)
+	mockTestsRepo.On("DeleteTest", mock.Anything, int64(5)).Return(nil)
+	expectExerciseVersion(mockExercisesRepo, mockTestsRepo, 2)
+
+	// Act
+	result, err := service.DeleteTest(context.Background(), &codelab.DeleteTestPayload{
+		SessionToken: "valid-session-token",
+		ID:           5,
+	})
+
+	// Assert
+	assert.NoError(t, err)
+	if assert.NotNil(t, result) {
+		assert.True(t, result.Success)
+	}
+	mockTestsRepo.AssertExpectations(t)
+	mockExercisesRepo.AssertExpectations(t)
+}
+
+func TestDeleteTest_NotFound(t *testing.T) {
+	// Arrange
+	mockProfilesServiceRepo := &mocks.MockProfilesServiceRepository{}
+	mockTestsRepo := &mocks.MockTestsRepository{}
+	service := setupTestService(mockProfilesServiceRepo, nil, mockTestsRepo, nil, nil)
+
+	mockProfilesServiceRepo.On("GetCompleteProfile", mock.Anything, mock.AnythingOfType("*profiles.GetCompleteProfilePayload")).Return(createTestTeacherProfile(), nil)
+	mockTestsRepo.On("GetTestById", mock.Anything, int64(99)).Return(codelabdb.Test{}, pgx.ErrNoRows)
+
+	// Act
+	result, err := service.DeleteTest(context.Background(), &codelab.DeleteTestPayload{
+		SessionToken: "valid-session-token",
+		ID:           99,
+	})
+
+	// Assert
+	assert.Error(t, err)
+	assert.Nil(t, result)
+	assert.Contains(t, errorMessage(err), "Test not found")
+	mockTestsRepo.AssertNotCalled(t, "DeleteTest", mock.Anything, mock.Anything)
+}
+
+func TestListExerciseVersions_Success(t *testing.T) {
+	// Arrange
+	mockProfilesServiceRepo := &mocks.MockProfilesServiceRepository{}
+	mockExercisesRepo := &mocks.MockExercisesRepository{}
+	service := setupTestService(mockProfilesServiceRepo, mockExercisesRepo, nil, nil, nil)
+
+	createdAt := time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC)
+	mockProfilesServiceRepo.On("GetCompleteProfile", mock.Anything, mock.AnythingOfType("*profiles.GetCompleteProfilePayload")).Return(createTestTeacherProfile(), nil)
+	mockExercisesRepo.On("GetExerciseById", mock.Anything, int64(1)).Return(createTestExercise(), nil)
+	mockExercisesRepo.On("ListExerciseVersions", mock.Anything, int64(1)).Return([]codelabdb.ListExerciseVersionsRow{
+		{Version: 2, CreatedBy: 1, CreatedAt: pgtype.Timestamptz{Time: createdAt.Add(time.Hour), Valid: true}, Attempts: 0},
+		{Version: 1, CreatedBy: 1, CreatedAt: pgtype.Timestamptz{Time: createdAt, Valid: true}, Attempts: 4},
+	}, nil)
+
+	// Act
+	result, err := service.ListExerciseVersions(context.Background(), &codelab.ListExerciseVersionsPayload{
+		SessionToken: "valid-session-token",
+		ExerciseID:   1,
+	})
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, []*codelab.ExerciseVersion{
+		{Version: 2, CreatedBy: 1, CreatedAt: createdAt.Add(time.Hour).UnixMilli(), Attempts: 0},
+		{Version: 1, CreatedBy: 1, CreatedAt: createdAt.UnixMilli(), Attempts: 4},
+	}, result)
+}
+
+func TestListExerciseVersions_PermissionDenied_Student(t *testing.T) {
+	// Arrange
+	mockProfilesServiceRepo := &mocks.MockProfilesServiceRepository{}
+	mockExercisesRepo := &mocks.MockExercisesRepository{}
+	service := setupTestService(mockProfilesServiceRepo, mockExercisesRepo, nil, nil, nil)
+
+	mockProfilesServiceRepo.On("GetCompleteProfile", mock.Anything, mock.AnythingOfType("*profiles.GetCompleteProfilePayload")).Return(createTestStudentProfile(), nil)
+
+	// Act
+	result, err := service.ListExerciseVersions(context.Background(), &codelab.ListExerciseVersionsPayload{
+		SessionToken: "valid-session-token",
+		ExerciseID:   1,
+	})
+
+	// Assert
+	assert.Error(t, err)
+	assert.Nil(t, result)
+	assert.Contains(t, errorMessage(err), "Only teachers can view exercise versions")
+	mockExercisesRepo.AssertNotCalled(t, "ListExerciseVersions", mock.Anything, mock.Anything)
+}
+
+func TestDiffExerciseVersions_Success(t *testing.T) {
+	// Arrange
+	mockProfilesServiceRepo := &mocks.MockProfilesServiceRepository{}
+	mockExercisesRepo := &mocks.MockExercisesRepository{}
+	service := setupTestService(mockProfilesServiceRepo, mockExercisesRepo, nil, nil, nil)
+
+	solution := createTestExercise().Solution
+	mockProfilesServiceRepo.On("GetCompleteProfile", mock.Anything, mock.AnythingOfType("*profiles.GetCompleteProfilePayload")).Return(createTestTeacherProfile(), nil)
+	mockExercisesRepo.On("GetExerciseVersion", mock.Anything, codelabdb.GetExerciseVersionParams{ExerciseID: 1, Version: 1}).Return(codelabdb.ExerciseVersion{
+		ExerciseID: 1,
+		Version:    1,
+		Snapshot:   encodeSnapshot(t, solution, versioning.Test{ID: 1, Input: "5", Output: "11", Public: true, Weight: 1, InputFormat: "text", Comparison: "exact"}),
+	}, nil)
+	mockExercisesRepo.On("GetExerciseVersion", mock.Anything, codelabdb.GetExerciseVersionParams{ExerciseID: 1, Version: 2}).Return(codelabdb.ExerciseVersion{
+		ExerciseID: 1,
+		Version:    2,
+		Snapshot: encodeSnapshot(t, "function solution(input) {\n  return input * 2;\n}\n",
+			versioning.Test{ID: 1, Input: "5", Output: "10", Public: true, Weight: 1, InputFormat: "text", Comparison: "exact"},
+			versioning.Test{ID: 2, Input: "7", Output: "14", Weight: 2, InputFormat: "text", Comparison: "exact"},
+		),
+	}, nil)
+
+	// Act
+	result, err := service.DiffExerciseVersions(context.Background(), &codelab.DiffExerciseVersionsPayload{
+		SessionToken: "valid-session-token",
+		ExerciseID:   1,
+		From:         1,
+		To:           2,
+	})
+
+	// Assert
+	assert.NoError(t, err)
+	if assert.NotNil(t, result) {
+		assert.Equal(t, []*codelab.FieldChange{
+			{Field: "solution", From: solution, To: solution + "\n"},
+		}, result.Fields)
+		if assert.Len(t, result.Tests, 2) {
+			assert.Equal(t, &codelab.TestChange{TestID: 1, Change: "modified", Fields: []*codelab.FieldChange{
+				{Field: "output", From: "11", To: "10"},
+			}}, result.Tests[0])
+			assert.Equal(t, int64(2), result.Tests[1].TestID)
+			assert.Equal(t, "added", result.Tests[1].Change)
+		}
+	}
+}
+
+func TestDiffExerciseVersions_VersionNotFound(t *testing.T) {
+	// Arrange
+	mockProfilesServiceRepo := &mocks.MockProfilesServiceRepository{}
+	mockExercisesRepo := &mocks.MockExercisesRepository{}
+	service := setupTestService(mockProfilesServiceRepo, mockExercisesRepo, nil, nil, nil)
+
+	mockProfilesServiceRepo.On("GetCompleteProfile", mock.Anything, mock.AnythingOfType("*profiles.GetCompleteProfilePayload")).Return(createTestTeacherProfile(), nil)
+	mockExercisesRepo.On("GetExerciseVersion", mock.Anything, codelabdb.GetExerciseVersionParams{ExerciseID: 1, Version: 9}).Return(codelabdb.ExerciseVersion{}, pgx.ErrNoRows)
+
+	// Act
+	result, err := service.DiffExerciseVersions(context.Background(), &codelab.DiffExerciseVersionsPayload{
+		SessionToken: "valid-session-token",
+		ExerciseID:   1,
+		From:         9,
+		To:           2,
+	})
+
+	// Assert
+	assert.Error(t, err)
+	assert.Nil(t, result)
+	assert.Contains(t, errorMessage(err), "Version 9 not found")
+}
+
+func TestRollbackExercise_Success(t *testing.T) {
+	// Arrange
+	mockProfilesServiceRepo := &mocks.MockProfilesServiceRepository{}
+	mockExercisesRepo := &mocks.MockExercisesRepository{}
+	mockTestsRepo := &mocks.MockTestsRepository{}
+	mockAnswersRepo := &mocks.MockAnswersRepository{}
+	service := setupTestService(mockProfilesServiceRepo, mockExercisesRepo, mockTestsRepo, mockAnswersRepo, nil)
+
+	exercise := createTestExercise()
+	exercise.Version = 3
+	exercise.Title = "Renamed"
+	// Version 1 had tests 1 and 2, test 2 was deleted and test 3 added since
+	mockProfilesServiceRepo.On("GetCompleteProfile", mock.Anything, mock.AnythingOfType("*profiles.GetCompleteProfilePayload")).Return(createTestTeacherProfile(), nil)
+	mockExercisesRepo.On("GetExerciseById", mock.Anything, int64(1)).Return(exercise, nil)
+	mockExercisesRepo.On("GetExerciseVersion", mock.Anything, codelabdb.GetExerciseVersionParams{ExerciseID: 1, Version: 1}).Return(codelabdb.ExerciseVersion{
+		ExerciseID: 1,
+		Version:    1,
+		Snapshot: encodeSnapshot(t, exercise.Solution,
+			versioning.Test{ID: 1, Input: "5", Output: "10", Public: true, Weight: 1, InputFormat: "text", Comparison: "exact"},
+			versioning.Test{ID: 2, Input: "7", Output: "14", Weight: 2, InputFormat: "text", Comparison: "exact"},
+		),
+	}, nil)
+	mockExercisesRepo.On("UpdateExercise", mock.Anything, mock.MatchedBy(func(arg codelabdb.UpdateExerciseParams) bool {
+		return arg.ID == 1 && arg.Title == "Test Exercise"
+	})).Return(nil)
+	mockTestsRepo.On("GetTestsByExercise", mock.Anything, int64(1)).Return([]codelabdb.Test{
+		{ID: 1, Input: "5", Output: "11", Public: true, Weight: 1, InputFormat: "text", Comparison: "exact", ExerciseID: 1},
+		{ID: 3, Input: "1", Output: "2", Weight: 1, InputFormat: "text", Comparison: "exact", ExerciseID: 1},
+	}, nil)
+	mockTestsRepo.On("DeleteTest", mock.Anything, int64(3)).Return(nil)
+	mockTestsRepo.On("UpdateTest", mock.Anything, mock.MatchedBy(func(arg codelabdb.UpdateTestParams) bool {
+		return arg.ID == 1 && arg.Output == "10"
+	})).Return(nil)
+	mockTestsRepo.On("CreateTest", mock.Anything, codelabdb.CreateTestParams{
+		Input: "7", Output: "14", Weight: 2, InputFormat: "text", Comparison: "exact", ExerciseID: 1,
+	}).Return(nil)
+	expectExerciseVersion(mockExercisesRepo, mockTestsRepo, 4)
+
+	// Act
+	result, err := service.RollbackExercise(context.Background(), &codelab.RollbackExercisePayload{
+		SessionToken: "valid-session-token",
+		ExerciseID:   1,
+		Version:      1,
+	})
+
+	// Assert
+	assert.NoError(t, err)
+	if assert.NotNil(t, result) {
+		assert.True(t, result.Success)
+		assert.Equal(t, "Exercise rolled back to version 1", result.Message)
+	}
+	mockExercisesRepo.AssertExpectations(t)
+	mockTestsRepo.AssertExpectations(t)
+	// Answers keep their status until the exercise is regraded
+	mockAnswersRepo.AssertNotCalled(t, "UpdateAnswerCompleted", mock.Anything, mock.Anything)
+}
+
+func TestRollbackExercise_AlreadyAtVersion(t *testing.T) {
+	// Arrange
+	mockProfilesServiceRepo := &mocks.MockProfilesServiceRepository{}
+	mockExercisesRepo := &mocks.MockExercisesRepository{}
+	service := setupTestService(mockProfilesServiceRepo, mockExercisesRepo, nil, nil, nil)
+
+	mockProfilesServiceRepo.On("GetCompleteProfile", mock.Anything, mock.AnythingOfType("*profiles.GetCompleteProfilePayload")).Return(createTestTeacherProfile(), nil)
+	mockExercisesRepo.On("GetExerciseById", mock.Anything, int64(1)).Return(createTestExercise(), nil)
+
+	// Act
+	result, err := service.RollbackExercise(context.Background(), &codelab.RollbackExercisePayload{
+		SessionToken: "valid-session-token",
+		ExerciseID:   1,
+		Version:      1,
+	})
+
+	// Assert
+	assert.Error(t, err)
+	assert.Nil(t, result)
+	assert.Contains(t, errorMessage(err), "Exercise is already at version 1")
+	mockExercisesRepo.AssertNotCalled(t, "GetExerciseVersion", mock.Anything, mock.Anything)
+}
+
+func TestRollbackExercise_SolutionFails(t *testing.T) {
+	// Arrange
+	mockProfilesServiceRepo := &mocks.MockProfilesServiceRepository{}
+	mockExercisesRepo := &mocks.MockExercisesRepository{}
+	service := setupTestService(mockProfilesServiceRepo, mockExercisesRepo, nil, nil, nil)
+
+	exercise := createTestExercise()
+	exercise.Version = 2
+	mockProfilesServiceRepo.On("GetCompleteProfile", mock.Anything, mock.AnythingOfType("*profiles.GetCompleteProfilePayload")).Return(createTestTeacherProfile(), nil)
+	mockExercisesRepo.On("GetExerciseById", mock.Anything, int64(1)).Return(exercise, nil)
+	mockExercisesRepo.On("GetExerciseVersion", mock.Anything, codelabdb.GetExerciseVersionParams{ExerciseID: 1, Version: 1}).Return(codelabdb.ExerciseVersion{
+		ExerciseID: 1,
+		Version:    1,
+		Snapshot:   encodeSnapshot(t, "function solution(input) {", versioning.Test{ID: 1, Input: "5", Output: "10", Weight: 1, InputFormat: "text", Comparison: "exact"}),
+	}, nil)
+
+	// Act
+	result, err := service.RollbackExercise(context.Background(), &codelab.RollbackExercisePayload{
+		SessionToken: "valid-session-token",
+		ExerciseID:   1,
+		Version:      1,
+	})
+
+	// Assert
+	assert.Error(t, err)
+	assert.Nil(t, result)
+	assert.Contains(t, errorMessage(err), "Solution of version 1")
+	mockExercisesRepo.AssertNotCalled(t, "UpdateExercise", mock.Anything, mock.Anything)
+}
+
+// ========================================
+// REGRADE TESTS
+// ========================================
+
+// useTransaction makes the transactions of a service run against repos
+func useTransaction(service *codelabsvrc, repos *repositories.TransactionalRepositories) *mocks.MockTransactionManager {
+	txManager := &mocks.MockTransactionManager{}
+	service.txManager = txManager
+	service.withTransaction = func(pgx.Tx) *repositories.TransactionalRepositories { return repos }
+	return txManager
+}
+
+// setupRegradeMocks makes a service and its transactions use the given
+// regrade, hint and plagiarism mocks
+func setupRegradeMocks(service *codelabsvrc, regradesRepo *mocks.MockRegradesRepository, hintsRepo *mocks.MockHintsRepository, plagiarismRepo *mocks.MockPlagiarismRepository) {
+	service.regradesRepo = regradesRepo
+	service.hintsRepo = hintsRepo
+	txRepos := service.withTransaction(nil)
+	txRepos.RegradesRepo = regradesRepo
+	txRepos.HintsRepo = hintsRepo
+	txRepos.PlagiarismRepo = plagiarismRepo
+}
+
+func TestRegradeExercise_FlipsOutcomes(t *testing.T) {
+	// Arrange
+	mockProfilesServiceRepo := &mocks.MockProfilesServiceRepository{}
+	mockExercisesRepo := &mocks.MockExercisesRepository{}
+	mockTestsRepo := &mocks.MockTestsRepository{}
+	mockAnswersRepo := &mocks.MockAnswersRepository{}
+	mockAttemptsRepo := &mocks.MockAttemptsRepository{}
+	mockRegradesRepo := &mocks.MockRegradesRepository{}
+	mockHintsRepo := &mocks.MockHintsRepository{}
+	mockPlagiarismRepo := &mocks.MockPlagiarismRepository{}
+	service := setupTestService(mockProfilesServiceRepo, mockExercisesRepo, mockTestsRepo, mockAnswersRepo, mockAttemptsRepo)
+	setupRegradeMocks(service, mockRegradesRepo, mockHintsRepo, mockPlagiarismRepo)
+	service.regradeQueue.Start(context.Background())
+
+	exercise := createTestExerciseToResolve()
+	exercise.Version = 2
+	now := time.Now()
+	at := func(ago time.Duration) pgtype.Timestamptz {
+		return pgtype.Timestamptz{Time: now.Add(-ago), Valid: true}
+	}
+
+	mockProfilesServiceRepo.On("GetCompleteProfile", mock.Anything, mock.AnythingOfType("*profiles.GetCompleteProfilePayload")).Return(createTestTeacherProfile(), nil)
+	mockExercisesRepo.On("GetExerciseToResolveById", mock.Anything, int64(1)).Return(exercise, nil)
+	mockRegradesRepo.On("FailInterruptedRegrades", mock.Anything, codelabdb.FailInterruptedRegradesParams{
+		ExerciseID:   1,
+		ErrorMessage: pgtype.Text{String: "Regrade was interrupted", Valid: true},
+	}).Return(nil)
+	mockRegradesRepo.On("GetRunningRegradeByExercise", mock.Anything, int64(1)).Return(codelabdb.Regrade{}, pgx.ErrNoRows)
+	// The expected output was fixed, so doubling passes now and adding fails
+	mockTestsRepo.On("GetTestsByExercise", mock.Anything, int64(1)).Return([]codelabdb.Test{
+		{ID: 1, Input: "4", Output: "8", Weight: 1, ExerciseID: 1},
+	}, nil)
+	mockAttemptsRepo.On("GetAttemptsWithAnswerInfo", mock.Anything, int64(1)).Return([]codelabdb.GetAttemptsWithAnswerInfoRow{
+		{ID: 3, AnswerID: 1, UserID: 1, Status: "pending", Code: `function solution(input) { return input; }`, CreatedAt: at(time.Second)},
+		{ID: 2, AnswerID: 2, UserID: 2, Status: "passed", Success: true, Score: 100, Code: `function solution(input) { return String(Number(input) + 3); }`, CreatedAt: at(time.Minute)},
+		{ID: 1, AnswerID: 1, UserID: 1, Status: "failed", Code: `function solution(input) { return String(Number(input) * 2); }`, CreatedAt: at(time.Minute)},
+	}, nil)
+	mockHintsRepo.On("GetHintsByExercise", mock.Anything, int64(1)).Return([]codelabdb.Hint{{ID: 1, ExerciseID: 1, Penalty: 20}}, nil)
+	mockHintsRepo.On("GetHintUsagesByAnswer", mock.Anything, int64(1)).Return([]codelabdb.HintUsage{{AnswerID: 1, HintID: 1, RevealedAt: at(time.Hour)}}, nil)
+	mockHintsRepo.On("GetHintUsagesByAnswer", mock.Anything, int64(2)).Return([]codelabdb.HintUsage{}, nil)
+	mockRegradesRepo.On("CreateRegrade", mock.Anything, codelabdb.CreateRegradeParams{
+		ExerciseID:      1,
+		ExerciseVersion: 2,
+		TotalAttempts:   2,
+		CreatedBy:       1,
+	}).Return(codelabdb.Regrade{ID: 5, ExerciseID: 1, ExerciseVersion: 2, Status: "running", TotalAttempts: 2, CreatedBy: 1}, nil)
+
+	mockAttemptsRepo.On("DeleteTestResultsByAttempt", mock.Anything, mock.AnythingOfType("int64")).Return(nil)
+	mockAttemptsRepo.On("CreateAttemptTestResult", mock.Anything, mock.AnythingOfType("codelabdb.CreateAttemptTestResultParams")).Return(nil)
+	// The hint revealed before the attempt still takes its points off
+	mockAttemptsRepo.On("RegradeAttempt", mock.Anything, codelabdb.RegradeAttemptParams{
+		ID: 1, Success: true, Status: "passed", Points: 1, MaxPoints: 1, Score: 80, ExerciseVersion: 2,
+		Message: "All tests passed successfully",
+	}).Return(nil)
+	mockAttemptsRepo.On("RegradeAttempt", mock.Anything, codelabdb.RegradeAttemptParams{
+		ID: 2, Status: "failed", MaxPoints: 1, ExerciseVersion: 2,
+		Message: "Test failed: expected 8, got 7 (0 of 1 tests passed)",
+	}).Return(nil)
+	mockRegradesRepo.On("AddRegradeProgress", mock.Anything, codelabdb.AddRegradeProgressParams{ID: 5, NewlyPassed: 1}).Return(nil)
+	mockRegradesRepo.On("AddRegradeProgress", mock.Anything, codelabdb.AddRegradeProgressParams{ID: 5, NewlyFailed: 1}).Return(nil)
+
+	mockAnswersRepo.On("ListAnswersByExercise", mock.Anything, int64(1)).Return([]codelabdb.Answer{
+		{ID: 1, ExerciseID: 1, UserID: 1},
+		{ID: 2, ExerciseID: 1, UserID: 2, Completed: true, BestScore: 100},
+	}, nil)
+	mockAnswersRepo.On("RecalculateAnswerScore", mock.Anything, int64(1)).Return(codelabdb.Answer{ID: 1, UserID: 1, Completed: true, BestScore: 80}, nil)
+	mockAnswersRepo.On("RecalculateAnswerScore", mock.Anything, int64(2)).Return(codelabdb.Answer{ID: 2, UserID: 2}, nil)
+	mockRegradesRepo.On("CreateRegradeChange", mock.Anything, codelabdb.CreateRegradeChangeParams{
+		RegradeID: 5, UserID: 1, Completed: true, Score: 80,
+	}).Return(nil)
+	mockRegradesRepo.On("CreateRegradeChange", mock.Anything, codelabdb.CreateRegradeChangeParams{
+		RegradeID: 5, UserID: 2, PreviousScore: 100,
+	}).Return(nil)
+	mockPlagiarismRepo.On("DeletePlagiarismCheck", mock.Anything, int64(1)).Return(nil)
+	mockRegradesRepo.On("FinishRegrade", mock.Anything, codelabdb.FinishRegradeParams{ID: 5, Status: "finished"}).Return(nil)
+
+	// Act
+	result, err := service.RegradeExercise(context.Background(), &codelab.RegradeExercisePayload{
+		SessionToken: "valid-session-token",
+		ExerciseID:   1,
+	})
+	service.regradeQueue.Stop()
+
+	// Assert
+	assert.NoError(t, err)
+	if assert.NotNil(t, result) {
+		assert.Equal(t, int64(5), result.ID)
+		assert.Equal(t, "running", result.Status)
+		assert.Equal(t, int32(2), result.TotalAttempts)
+	}
+	mockAttemptsRepo.AssertExpectations(t)
+	mockAnswersRepo.AssertExpectations(t)
+	mockRegradesRepo.AssertExpectations(t)
+	mockPlagiarismRepo.AssertExpectations(t)
+}
+
+func TestRegradeExercise_AlreadyRunning(t *testing.T) {
+	// Arrange
+	mockProfilesServiceRepo := &mocks.MockProfilesServiceRepository{}
+	mockExercisesRepo := &mocks.MockExercisesRepository{}
+	mockRegradesRepo := &mocks.MockRegradesRepository{}
+	service := setupTestService(mockProfilesServiceRepo, mockExercisesRepo, nil, nil, nil)
+	setupRegradeMocks(service, mockRegradesRepo, &mocks.MockHintsRepository{}, &mocks.MockPlagiarismRepository{})
+
+	mockProfilesServiceRepo.On("GetCompleteProfile", mock.Anything, mock.AnythingOfType("*profiles.GetCompleteProfilePayload")).Return(createTestTeacherProfile(), nil)
+	mockExercisesRepo.On("GetExerciseToResolveById", mock.Anything, int64(1)).Return(createTestExerciseToResolve(), nil)
+	mockRegradesRepo.On("FailInterruptedRegrades", mock.Anything, codelabdb.FailInterruptedRegradesParams{
+		ExerciseID:   1,
+		ErrorMessage: pgtype.Text{String: "Regrade was interrupted", Valid: true},
+	}).Return(nil)
+	mockRegradesRepo.On("GetRunningRegradeByExercise", mock.Anything, int64(1)).Return(codelabdb.Regrade{ID: 4, Status: "running"}, nil)
+
+	// Act
+	result, err := service.RegradeExercise(context.Background(), &codelab.RegradeExercisePayload{
+		SessionToken: "valid-session-token",
+		ExerciseID:   1,
+	})
+
+	// Assert
+	assert.Nil(t, result)
+	assert.Contains(t, errorMessage(err), "already running")
+	mockRegradesRepo.AssertNotCalled(t, "CreateRegrade", mock.Anything, mock.Anything)
+}
+
+func TestRegradeExercise_StartedConcurrently(t *testing.T) {
+	// Arrange
+	mockProfilesServiceRepo := &mocks.MockProfilesServiceRepository{}
+	mockExercisesRepo := &mocks.MockExercisesRepository{}
+	mockTestsRepo := &mocks.MockTestsRepository{}
+	mockAttemptsRepo := &mocks.MockAttemptsRepository{}
+	mockRegradesRepo := &mocks.MockRegradesRepository{}
+	mockHintsRepo := &mocks.MockHintsRepository{}
+	service := setupTestService(mockProfilesServiceRepo, mockExercisesRepo, mockTestsRepo, nil, mockAttemptsRepo)
+	setupRegradeMocks(service, mockRegradesRepo, mockHintsRepo, &mocks.MockPlagiarismRepository{})
+
+	mockProfilesServiceRepo.On("GetCompleteProfile", mock.Anything, mock.AnythingOfType("*profiles.GetCompleteProfilePayload")).Return(createTestTeacherProfile(), nil)
+	mockExercisesRepo.On("GetExerciseToResolveById", mock.Anything, int64(1)).Return(createTestExerciseToResolve(), nil)
+	mockRegradesRepo.On("FailInterruptedRegrades", mock.Anything, mock.AnythingOfType("codelabdb.FailInterruptedRegradesParams")).Return(nil)
+	mockRegradesRepo.On("GetRunningRegradeByExercise", mock.Anything, int64(1)).Return(codelabdb.Regrade{}, pgx.ErrNoRows)
+	mockTestsRepo.On("GetTestsByExercise", mock.Anything, int64(1)).Return([]codelabdb.Test{
+		{ID: 1, Input: "4", Output: "8", Weight: 1, ExerciseID: 1},
+	}, nil)
+	mockAttemptsRepo.On("GetAttemptsWithAnswerInfo", mock.Anything, int64(1)).Return([]codelabdb.GetAttemptsWithAnswerInfoRow{}, nil)
+	mockHintsRepo.On("GetHintsByExercise", mock.Anything, int64(1)).Return([]codelabdb.Hint{}, nil).Maybe()
+	// The running regrade index rejects the second regrade of the exercise
+	mockRegradesRepo.On("CreateRegrade", mock.Anything, mock.AnythingOfType("codelabdb.CreateRegradeParams")).Return(codelabdb.Regrade{}, &pgconn.PgError{Code: "23505"})
+
+	// Act
+	result, err := service.RegradeExercise(context.Background(), &codelab.RegradeExercisePayload{
+		SessionToken: "valid-session-token",
+		ExerciseID:   1,
+	})
+
+	// Assert
+	assert.Nil(t, result)
+	assert.Contains(t, errorMessage(err), "already running")
+	mockRegradesRepo.AssertNotCalled(t, "FinishRegrade", mock.Anything, mock.Anything)
+}
+
+func TestRunRegrade_InterruptedStillClosed(t *testing.T) {
+	// Arrange
+	mockRegradesRepo := &mocks.MockRegradesRepository{}
+	service := setupTestService(nil, nil, nil, nil, nil)
+	setupRegradeMocks(service, mockRegradesRepo, &mocks.MockHintsRepository{}, &mocks.MockPlagiarismRepository{})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	mockRegradesRepo.On("FinishRegrade", mock.MatchedBy(func(ctx context.Context) bool {
+		return ctx.Err() == nil
+	}), codelabdb.FinishRegradeParams{
+		ID:           5,
+		Status:       "failed",
+		ErrorMessage: pgtype.Text{String: "Regrade was interrupted", Valid: true},
+	}).Return(nil)
+
+	// Act
+	service.runRegrade(ctx, &exerciseRegrade{id: 5, exerciseID: 1, attempts: []codelabdb.GetAttemptsWithAnswerInfoRow{{ID: 1}}})
+
+	// Assert
+	mockRegradesRepo.AssertExpectations(t)
+}
+
+func TestRegradeAttempt_TimeoutKeepsPassingOutcome(t *testing.T) {
+	// Arrange
+	mockAttemptsRepo := &mocks.MockAttemptsRepository{}
+	mockRegradesRepo := &mocks.MockRegradesRepository{}
+	service := setupTestService(nil, nil, nil, nil, mockAttemptsRepo)
+	setupRegradeMocks(service, mockRegradesRepo, &mocks.MockHintsRepository{}, &mocks.MockPlagiarismRepository{})
+	opts := sandbox.DefaultOptions()
+	opts.Limits.TestTimeout = 50 * time.Millisecond
+	service.executor = sandbox.NewExecutor(opts)
+
+	r := &exerciseRegrade{
+		id:       5,
+		language: "javascript",
+		tests:    []codelabdb.Test{{ID: 1, Input: "4", Output: "8", Weight: 1}},
+		cases:    []sandbox.TestCase{{ID: 1, Input: "4", Expected: "8"}},
+	}
+	mockRegradesRepo.On("AddRegradeProgress", mock.Anything, codelabdb.AddRegradeProgressParams{ID: 5, Errored: 1}).Return(nil)
+
+	// Act
+	service.regradeAttempt(context.Background(), r, codelabdb.GetAttemptsWithAnswerInfoRow{
+		ID: 1, AnswerID: 1, Status: "passed", Success: true, Score: 100,
+		Code: `function solution(input) { while (true) {} }`,
+	})
+
+	// Assert
+	mockRegradesRepo.AssertExpectations(t)
+	mockAttemptsRepo.AssertNotCalled(t, "RegradeAttempt", mock.Anything, mock.Anything)
+}
+
+func TestRegradeExercise_PermissionDenied_Student(t *testing.T) {
+	// Arrange
+	mockProfilesServiceRepo := &mocks.MockProfilesServiceRepository{}
+	service := setupTestService(mockProfilesServiceRepo, nil, nil, nil, nil)
+
+	mockProfilesServiceRepo.On("GetCompleteProfile", mock.Anything, mock.AnythingOfType("*profiles.GetCompleteProfilePayload")).Return(createTestStudentProfile(), nil)
+
+	// Act
+	result, err := service.RegradeExercise(context.Background(), &codelab.RegradeExercisePayload{
+		SessionToken: "valid-session-token",
+		ExerciseID:   1,
+	})
+
+	// Assert
+	assert.Nil(t, result)
+	assert.Contains(t, errorMessage(err), "Only teachers can regrade exercises")
+}
+
+func TestGetRegrade_Success(t *testing.T) {
+	// Arrange
+	mockProfilesServiceRepo := &mocks.MockProfilesServiceRepository{}
+	mockRegradesRepo := &mocks.MockRegradesRepository{}
+	service := setupTestService(mockProfilesServiceRepo, nil, nil, nil, nil)
+	setupRegradeMocks(service, mockRegradesRepo, &mocks.MockHintsRepository{}, &mocks.MockPlagiarismRepository{})
+
+	now := time.Now()
+	mockProfilesServiceRepo.On("GetCompleteProfile", mock.Anything, mock.AnythingOfType("*profiles.GetCompleteProfilePayload")).Return(createTestTeacherProfile(), nil)
+	mockRegradesRepo.On("GetRegradeById", mock.Anything, int64(5)).Return(codelabdb.Regrade{
+		ID:                  5,
+		ExerciseID:          1,
+		ExerciseVersion:     2,
+		Status:              "finished",
+		TotalAttempts:       3,
+		RegradedAttempts:    3,
+		NewlyPassedAttempts: 2,
+		NewlyFailedAttempts: 1,
+		CreatedBy:           1,
+		CreatedAt:           pgtype.Timestamptz{Time: now.Add(-time.Minute), Valid: true},
+		FinishedAt:          pgtype.Timestamptz{Time: now, Valid: true},
+	}, nil)
+	mockRegradesRepo.On("ListRegradeChanges", mock.Anything, int64(5)).Return([]codelabdb.RegradeChange{
+		{RegradeID: 5, UserID: 2, Completed: true, PreviousScore: 50, Score: 100},
+		{RegradeID: 5, UserID: 3, PreviousScore: 100, Score: 0},
+		{RegradeID: 5, UserID: 4, Completed: true, Score: 100},
+	}, nil)
+
+	// Act
+	result, err := service.GetRegrade(context.Background(), &codelab.GetRegradePayload{
+		SessionToken: "valid-session-token",
+		ID:           5,
+	})
+
+	// Assert
+	assert.NoError(t, err)
+	if assert.NotNil(t, result) {
+		assert.Equal(t, "finished", result.Status)
+		assert.Equal(t, int32(3), result.RegradedAttempts)
+		assert.Equal(t, now.UnixMilli(), *result.FinishedAt)
+		assert.Nil(t, result.Error)
+		if assert.Len(t, result.NewlyPassed, 2) {
+			assert.Equal(t, int64(2), result.NewlyPassed[0].UserID)
+			assert.Equal(t, 50.0, result.NewlyPassed[0].PreviousScore)
+		}
+		if assert.Len(t, result.NewlyFailed, 1) {
+			assert.Equal(t, int64(3), result.NewlyFailed[0].UserID)
+		}
+	}
+}
+
+func TestGetRegrade_NotFound(t *testing.T) {
+	// Arrange
+	mockProfilesServiceRepo := &mocks.MockProfilesServiceRepository{}
+	mockRegradesRepo := &mocks.MockRegradesRepository{}
+	service := setupTestService(mockProfilesServiceRepo, nil, nil, nil, nil)
+	setupRegradeMocks(service, mockRegradesRepo, &mocks.MockHintsRepository{}, &mocks.MockPlagiarismRepository{})
+
+	mockProfilesServiceRepo.On("GetCompleteProfile", mock.Anything, mock.AnythingOfType("*profiles.GetCompleteProfilePayload")).Return(createTestTeacherProfile(), nil)
+	mockRegradesRepo.On("GetRegradeById", mock.Anything, int64(99)).Return(codelabdb.Regrade{}, pgx.ErrNoRows)
+
+	// Act
+	result, err := service.GetRegrade(context.Background(), &codelab.GetRegradePayload{
+		SessionToken: "valid-session-token",
+		ID:           99,
+	})
+
+	// Assert
+	assert.Nil(t, result)
+	assert.Contains(t, errorMessage(err), "Regrade not found")
+}
+
+// ========================================
+// HINT TESTS
+// ========================================
+
+// createTestHints creates the hints of the test exercise: one shown from the
+// start, one unlocked by failed attempts or time and one unlocked by time
+func createTestHints() []codelabdb.Hint {
+	return []codelabdb.Hint{
+		{ID: 1, ExerciseID: 1, Position: 1, Content: "Read the input as a number"},
+		{
+			ID:                  2,
+			ExerciseID:          1,
+			Position:            2,
+			Content:             "Multiply it by two",
+			UnlockAfterAttempts: pgtype.Int4{Int32: 2, Valid: true},
+			UnlockAfterSeconds:  pgtype.Int4{Int32: 600, Valid: true},
+			Penalty:             10,
+		},
+		{
+			ID:                 3,
+			ExerciseID:         1,
+			Position:           3,
+			Content:            "return parseInt(input) * 2",
+			UnlockAfterSeconds: pgtype.Int4{Int32: 3600, Valid: true},
+			Penalty:            50,
+		},
+	}
+}
+
+func TestCreateHint_Success(t *testing.T) {
+	// Arrange
+	mockProfilesServiceRepo := &mocks.MockProfilesServiceRepository{}
+	mockExercisesRepo := &mocks.MockExercisesRepository{}
+	mockHintsRepo := &mocks.MockHintsRepository{}
+	service := setupTestService(mockProfilesServiceRepo, mockExercisesRepo, nil, nil, nil)
+	service.hintsRepo = mockHintsRepo
+
+	mockProfilesServiceRepo.On("GetCompleteProfile", mock.Anything, mock.AnythingOfType("*profiles.GetCompleteProfilePayload")).Return(createTestTeacherProfile(), nil)
+	mockExercisesRepo.On("GetExerciseById", mock.Anything, int64(1)).Return(createTestExercise(), nil)
+	mockHintsRepo.On("CreateHint", mock.Anything, codelabdb.CreateHintParams{
+		ExerciseID:          1,
+		Content:             "Multiply it by two",
+		UnlockAfterAttempts: pgtype.Int4{Int32: 2, Valid: true},
+		Penalty:             10,
+	}).Return(nil)
+
+	// Act
+	result, err := service.CreateHint(context.Background(), &codelab.CreateHintPayload{
+		SessionToken:        "valid-session-token",
+		ExerciseID:          1,
+		Content:             "Multiply it by two",
+		UnlockAfterAttempts: ptr(int32(2)),
+		Penalty:             10,
+	})
+
+	// Assert
+	assert.NoError(t, err)
+	if assert.NotNil(t, result) {
+		assert.True(t, result.Success)
+	}
+	mockHintsRepo.AssertExpectations(t)
+}
+
+func TestCreateHint_StudentForbidden(t *testing.T) {
+	// Arrange
+	mockProfilesServiceRepo := &mocks.MockProfilesServiceRepository{}
+	mockHintsRepo := &mocks.MockHintsRepository{}
+	service := setupTestService(mockProfilesServiceRepo, nil, nil, nil, nil)
+	service.hintsRepo = mockHintsRepo
+
+	mockProfilesServiceRepo.On("GetCompleteProfile", mock.Anything, mock.AnythingOfType("*profiles.GetCompleteProfilePayload")).Return(createTestStudentProfile(), nil)
+
+	// Act
+	result, err := service.CreateHint(context.Background(), &codelab.CreateHintPayload{
+		SessionToken: "valid-session-token",
+		ExerciseID:   1,
+		Content:      "Multiply it by two",
+	})
+
+	// Assert
+	assert.Error(t, err)
+	assert.Nil(t, result)
+	assert.Contains(t, errorMessage(err), "Only teachers can create hints")
+	mockHintsRepo.AssertNotCalled(t, "CreateHint", mock.Anything, mock.Anything)
+}
+
+func TestUpdateHint_NotFound(t *testing.T) {
+	// Arrange
+	mockProfilesServiceRepo := &mocks.MockProfilesServiceRepository{}
+	mockHintsRepo := &mocks.MockHintsRepository{}
+	service := setupTestService(mockProfilesServiceRepo, nil, nil, nil, nil)
+	service.hintsRepo = mockHintsRepo
+
+	mockProfilesServiceRepo.On("GetCompleteProfile", mock.Anything, mock.AnythingOfType("*profiles.GetCompleteProfilePayload")).Return(createTestTeacherProfile(), nil)
+	mockHintsRepo.On("GetHintById", mock.Anything, int64(99)).Return(codelabdb.Hint{}, pgx.ErrNoRows)
+
+	// Act
+	result, err := service.UpdateHint(context.Background(), &codelab.UpdateHintPayload2{
+		SessionToken: "valid-session-token",
+		ID:           99,
+		Hint:         &codelab.UpdateHintPayload{Content: "Multiply it by two"},
+	})
+
+	// Assert
+	assert.Error(t, err)
+	assert.Nil(t, result)
+	assert.Contains(t, errorMessage(err), "Hint not found")
+	mockHintsRepo.AssertNotCalled(t, "UpdateHint", mock.Anything, mock.Anything)
+}
+
+func TestGetHintsForStudent_ProgressiveUnlocks(t *testing.T) {
+	// Arrange
+	mockProfilesServiceRepo := &mocks.MockProfilesServiceRepository{}
+	mockExercisesRepo := &mocks.MockExercisesRepository{}
+	mockAnswersRepo := &mocks.MockAnswersRepository{}
+	mockAttemptsRepo := &mocks.MockAttemptsRepository{}
+	mockHintsRepo := &mocks.MockHintsRepository{}
+	service := setupTestService(mockProfilesServiceRepo, mockExercisesRepo, nil, mockAnswersRepo, mockAttemptsRepo)
+	service.hintsRepo = mockHintsRepo
+
+	startedAt := time.Now().Add(-time.Minute)
+	revealedAt := startedAt.Add(10 * time.Second)
+	answer := createTestAnswer()
+	answer.CreatedAt = pgtype.Timestamptz{Time: startedAt, Valid: true}
+
+	mockProfilesServiceRepo.On("GetCompleteProfile", mock.Anything, mock.AnythingOfType("*profiles.GetCompleteProfilePayload")).Return(createTestStudentProfile(), nil)
+	mockExercisesRepo.On("GetExerciseToResolveById", mock.Anything, int64(1)).Return(createTestExerciseToResolve(), nil)
+	mockHintsRepo.On("GetHintsByExercise", mock.Anything, int64(1)).Return(createTestHints(), nil)
+	mockAnswersRepo.On("GetAnswerByUserAndExercise", mock.Anything, mock.AnythingOfType("codelabdb.GetAnswerByUserAndExerciseParams")).Return(answer, nil)
+	mockHintsRepo.On("GetHintUsagesByAnswer", mock.Anything, int64(1)).Return([]codelabdb.HintUsage{
+		{AnswerID: 1, HintID: 1, RevealedAt: pgtype.Timestamptz{Time: revealedAt, Valid: true}},
+	}, nil)
+	// Successful attempts do not count towards unlocking hints
+	mockAttemptsRepo.On("GetAttemptsByAnswer", mock.Anything, int64(1)).Return([]codelabdb.Attempt{
+		createTestAttempt(false), createTestAttempt(true), createTestAttempt(false),
+	}, nil)
+
+	// Act
+	result, err := service.GetHintsForStudent(context.Background(), &codelab.GetHintsForStudentPayload{
+		SessionToken: "valid-session-token",
+		ExerciseID:   1,
+	})
+
+	// Assert
+	assert.NoError(t, err)
+	if assert.Len(t, result, 3) {
+		assert.Equal(t, "revealed", result[0].Status)
+		assert.Equal(t, "Read the input as a number", *result[0].Content)
+		assert.Equal(t, revealedAt.UnixMilli(), *result[0].RevealedAt)
+
+		assert.Equal(t, "available", result[1].Status)
+		assert.Nil(t, result[1].Content)
+		assert.Equal(t, int32(0), *result[1].AttemptsRemaining)
+		assert.Equal(t, startedAt.Add(10*time.Minute).UnixMilli(), *result[1].UnlocksAt)
+
+		// Only the next hint can be available
+		assert.Equal(t, "locked", result[2].Status)
+		assert.Nil(t, result[2].Content)
+		assert.Nil(t, result[2].AttemptsRemaining)
+	}
+}
+
+func TestGetHintsForStudent_NotStarted(t *testing.T) {
+	// Arrange
+	mockProfilesServiceRepo := &mocks.MockProfilesServiceRepository{}
+	mockExercisesRepo := &mocks.MockExercisesRepository{}
+	mockAnswersRepo := &mocks.MockAnswersRepository{}
+	mockHintsRepo := &mocks.MockHintsRepository{}
+	service := setupTestService(mockProfilesServiceRepo, mockExercisesRepo, nil, mockAnswersRepo, nil)
+	service.hintsRepo = mockHintsRepo
+
+	mockProfilesServiceRepo.On("GetCompleteProfile", mock.Anything, mock.AnythingOfType("*profiles.GetCompleteProfilePayload")).Return(createTestStudentProfile(), nil)
+	mockExercisesRepo.On("GetExerciseToResolveById", mock.Anything, int64(1)).Return(createTestExerciseToResolve(), nil)
+	mockHintsRepo.On("GetHintsByExercise", mock.Anything, int64(1)).Return(createTestHints(), nil)
+	mockAnswersRepo.On("GetAnswerByUserAndExercise", mock.Anything, mock.AnythingOfType("codelabdb.GetAnswerByUserAndExerciseParams")).Return(codelabdb.Answer{}, pgx.ErrNoRows)
+
+	// Act
+	result, err := service.GetHintsForStudent(context.Background(), &codelab.GetHintsForStudentPayload{
+		SessionToken: "valid-session-token",
+		ExerciseID:   1,
+	})
+
+	// Assert
+	assert.NoError(t, err)
+	if assert.Len(t, result, 3) {
+		assert.Equal(t, "available", result[0].Status)
+		assert.Equal(t, "locked", result[1].Status)
+		assert.Equal(t, int32(2), *result[1].AttemptsRemaining)
+		// The delay only runs once the exercise is started
+		assert.Nil(t, result[1].UnlocksAt)
+	}
+	mockHintsRepo.AssertNotCalled(t, "GetHintUsagesByAnswer", mock.Anything, mock.Anything)
+}
+
+func TestRequestHint_RevealsNextHint(t *testing.T) {
+	// Arrange
+	mockProfilesServiceRepo := &mocks.MockProfilesServiceRepository{}
+	mockExercisesRepo := &mocks.MockExercisesRepository{}
+	mockAnswersRepo := &mocks.MockAnswersRepository{}
+	mockAttemptsRepo := &mocks.MockAttemptsRepository{}
+	mockHintsRepo := &mocks.MockHintsRepository{}
+	service := setupTestService(mockProfilesServiceRepo, mockExercisesRepo, nil, mockAnswersRepo, mockAttemptsRepo)
+	service.hintsRepo = mockHintsRepo
+	mockTxManager := useTransaction(service, &repositories.TransactionalRepositories{AnswersRepo: mockAnswersRepo, HintsRepo: mockHintsRepo})
+
+	mockProfilesServiceRepo.On("GetCompleteProfile", mock.Anything, mock.AnythingOfType("*profiles.GetCompleteProfilePayload")).Return(createTestStudentProfile(), nil)
+	mockExercisesRepo.On("GetExerciseToResolveById", mock.Anything, int64(1)).Return(createTestExerciseToResolve(), nil)
+	mockHintsRepo.On("GetHintsByExercise", mock.Anything, int64(1)).Return(createTestHints(), nil)
+	mockAnswersRepo.On("CheckIfAnswerExists", mock.Anything, mock.AnythingOfType("codelabdb.CheckIfAnswerExistsParams")).Return(int32(1), nil)
+	mockAnswersRepo.On("GetAnswerByUserAndExercise", mock.Anything, mock.AnythingOfType("codelabdb.GetAnswerByUserAndExerciseParams")).Return(createTestAnswer(), nil)
+	mockHintsRepo.On("GetHintUsagesByAnswer", mock.Anything, int64(1)).Return([]codelabdb.HintUsage{{AnswerID: 1, HintID: 1}}, nil)
+	mockAttemptsRepo.On("GetAttemptsByAnswer", mock.Anything, int64(1)).Return([]codelabdb.Attempt{createTestAttempt(false), createTestAttempt(false)}, nil)
+	mockTxManager.On("WithTransaction", mock.Anything).Return(nil)
+	mockHintsRepo.On("CreateHintUsage", mock.Anything, codelabdb.CreateHintUsageParams{AnswerID: 1, HintID: 2}).Return(int64(1), nil)
+	mockAnswersRepo.On("RecordAnswerHintUsage", mock.Anything, codelabdb.RecordAnswerHintUsageParams{ID: 1, HintPenalty: 10}).Return(nil)
+
+	// Act
+	result, err := service.RequestHint(context.Background(), &codelab.RequestHintPayload{
+		SessionToken: "valid-session-token",
+		ExerciseID:   1,
+	})
+
+	// Assert
+	assert.NoError(t, err)
+	if assert.NotNil(t, result) {
+		assert.Equal(t, int64(2), result.ID)
+		assert.Equal(t, "revealed", result.Status)
+		assert.Equal(t, "Multiply it by two", *result.Content)
+		assert.NotNil(t, result.RevealedAt)
+	}
+	mockHintsRepo.AssertExpectations(t)
+	mockAnswersRepo.AssertExpectations(t)
+}
+
+func TestRequestHint_AlreadyRevealedConcurrently(t *testing.T) {
+	// Arrange
+	mockProfilesServiceRepo := &mocks.MockProfilesServiceRepository{}
+	mockExercisesRepo := &mocks.MockExercisesRepository{}
+	mockAnswersRepo := &mocks.MockAnswersRepository{}
+	mockAttemptsRepo := &mocks.MockAttemptsRepository{}
+	mockHintsRepo := &mocks.MockHintsRepository{}
+	service := setupTestService(mockProfilesServiceRepo, mockExercisesRepo, nil, mockAnswersRepo, mockAttemptsRepo)
+	service.hintsRepo = mockHintsRepo
+	mockTxManager := useTransaction(service, &repositories.TransactionalRepositories{AnswersRepo: mockAnswersRepo, HintsRepo: mockHintsRepo})
+
+	mockProfilesServiceRepo.On("GetCompleteProfile", mock.Anything, mock.AnythingOfType("*profiles.GetCompleteProfilePayload")).Return(createTestStudentProfile(), nil)
+	mockExercisesRepo.On("GetExerciseToResolveById", mock.Anything, int64(1)).Return(createTestExerciseToResolve(), nil)
+	mockHintsRepo.On("GetHintsByExercise", mock.Anything, int64(1)).Return(createTestHints(), nil)
+	mockAnswersRepo.On("CheckIfAnswerExists", mock.Anything, mock.AnythingOfType("codelabdb.CheckIfAnswerExistsParams")).Return(int32(1), nil)
+	mockAnswersRepo.On("GetAnswerByUserAndExercise", mock.Anything, mock.AnythingOfType("codelabdb.GetAnswerByUserAndExerciseParams")).Return(createTestAnswer(), nil)
+	mockHintsRepo.On("GetHintUsagesByAnswer", mock.Anything, int64(1)).Return([]codelabdb.HintUsage{}, nil)
+	mockAttemptsRepo.On("GetAttemptsByAnswer", mock.Anything, int64(1)).Return([]codelabdb.Attempt{}, nil)
+	mockTxManager.On("WithTransaction", mock.Anything).Return(nil)
+	mockHintsRepo.On("CreateHintUsage", mock.Anything, codelabdb.CreateHintUsageParams{AnswerID: 1, HintID: 1}).Return(int64(0), nil)
+
+	// Act
+	result, err := service.RequestHint(context.Background(), &codelab.RequestHintPayload{
+		SessionToken: "valid-session-token",
+		ExerciseID:   1,
+	})
+
+	// Assert
+	assert.NoError(t, err)
+	if assert.NotNil(t, result) {
+		assert.Equal(t, int64(1), result.ID)
+	}
+	mockAnswersRepo.AssertNotCalled(t, "RecordAnswerHintUsage", mock.Anything, mock.Anything)
+}
+
+func TestRequestHint_Locked(t *testing.T) {
+	// Arrange
+	mockProfilesServiceRepo := &mocks.MockProfilesServiceRepository{}
+	mockExercisesRepo := &mocks.MockExercisesRepository{}
+	mockAnswersRepo := &mocks.MockAnswersRepository{}
+	mockAttemptsRepo := &mocks.MockAttemptsRepository{}
+	mockHintsRepo := &mocks.MockHintsRepository{}
+	service := setupTestService(mockProfilesServiceRepo, mockExercisesRepo, nil, mockAnswersRepo, mockAttemptsRepo)
+	service.hintsRepo = mockHintsRepo
+	mockTxManager := useTransaction(service, &repositories.TransactionalRepositories{})
+
+	startedAt := time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC)
+	answer := createTestAnswer()
+	answer.CreatedAt = pgtype.Timestamptz{Time: time.Now(), Valid: true}
+
+	mockProfilesServiceRepo.On("GetCompleteProfile", mock.Anything, mock.AnythingOfType("*profiles.GetCompleteProfilePayload")).Return(createTestStudentProfile(), nil)
+	mockExercisesRepo.On("GetExerciseToResolveById", mock.Anything, int64(1)).Return(createTestExerciseToResolve(), nil)
+	mockHintsRepo.On("GetHintsByExercise", mock.Anything, int64(1)).Return(createTestHints(), nil)
+	mockAnswersRepo.On("CheckIfAnswerExists", mock.Anything, mock.AnythingOfType("codelabdb.CheckIfAnswerExistsParams")).Return(int32(1), nil)
+	mockAnswersRepo.On("GetAnswerByUserAndExercise", mock.Anything, mock.AnythingOfType("codelabdb.GetAnswerByUserAndExerciseParams")).Return(answer, nil)
+	mockHintsRepo.On("GetHintUsagesByAnswer", mock.Anything, int64(1)).Return([]codelabdb.HintUsage{
+		{AnswerID: 1, HintID: 1, RevealedAt: pgtype.Timestamptz{Time: startedAt, Valid: true}},
+	}, nil)
+	mockAttemptsRepo.On("GetAttemptsByAnswer", mock.Anything, int64(1)).Return([]codelabdb.Attempt{createTestAttempt(false)}, nil)
+
+	// Act
+	result, err := service.RequestHint(context.Background(), &codelab.RequestHintPayload{
+		SessionToken: "valid-session-token",
+		ExerciseID:   1,
+	})
+
+	// Assert
+	assert.Error(t, err)
+	assert.Nil(t, result)
+	assert.Contains(t, errorMessage(err), "Next hint unlocks after 1 more failed attempts or at ")
+	mockTxManager.AssertNotCalled(t, "WithTransaction", mock.Anything)
+}
+
+func TestRequestHint_NoHintsLeft(t *testing.T) {
+	// Arrange
+	mockProfilesServiceRepo := &mocks.MockProfilesServiceRepository{}
+	mockExercisesRepo := &mocks.MockExercisesRepository{}
+	mockAnswersRepo := &mocks.MockAnswersRepository{}
+	mockAttemptsRepo := &mocks.MockAttemptsRepository{}
+	mockHintsRepo := &mocks.MockHintsRepository{}
+	service := setupTestService(mockProfilesServiceRepo, mockExercisesRepo, nil, mockAnswersRepo, mockAttemptsRepo)
+	service.hintsRepo = mockHintsRepo
+
+	mockProfilesServiceRepo.On("GetCompleteProfile", mock.Anything, mock.AnythingOfType("*profiles.GetCompleteProfilePayload")).Return(createTestStudentProfile(), nil)
+	mockExercisesRepo.On("GetExerciseToResolveById", mock.Anything, int64(1)).Return(createTestExerciseToResolve(), nil)
+	mockHintsRepo.On("GetHintsByExercise", mock.Anything, int64(1)).Return(createTestHints()[:1], nil)
+	mockAnswersRepo.On("CheckIfAnswerExists", mock.Anything, mock.AnythingOfType("codelabdb.CheckIfAnswerExistsParams")).Return(int32(1), nil)
+	mockAnswersRepo.On("GetAnswerByUserAndExercise", mock.Anything, mock.AnythingOfType("codelabdb.GetAnswerByUserAndExerciseParams")).Return(createTestAnswer(), nil)
+	mockHintsRepo.On("GetHintUsagesByAnswer", mock.Anything, int64(1)).Return([]codelabdb.HintUsage{{AnswerID: 1, HintID: 1}}, nil)
+	mockAttemptsRepo.On("GetAttemptsByAnswer", mock.Anything, int64(1)).Return([]codelabdb.Attempt{}, nil)
+
+	// Act
+	result, err := service.RequestHint(context.Background(), &codelab.RequestHintPayload{
+		SessionToken: "valid-session-token",
+		ExerciseID:   1,
+	})
+
+	// Assert
+	assert.Error(t, err)
+	assert.Nil(t, result)
+	assert.Contains(t, errorMessage(err), "No hints left to reveal")
+}
+
+func TestCreateAttempt_AppliesHintPenalty(t *testing.T) {
+	// Arrange
+	mockProfilesServiceRepo := &mocks.MockProfilesServiceRepository{}
+	mockExercisesRepo := &mocks.MockExercisesRepository{}
+	mockAnswersRepo := &mocks.MockAnswersRepository{}
+	mockTestsRepo := &mocks.MockTestsRepository{}
+	mockAttemptsRepo := &mocks.MockAttemptsRepository{}
+	service := setupTestService(mockProfilesServiceRepo, mockExercisesRepo, mockTestsRepo, mockAnswersRepo, mockAttemptsRepo)
+
+	answer := createTestAnswer()
+	answer.HintsUsed = 2
+	answer.HintPenalty = 30
+
+	mockProfilesServiceRepo.On("GetCompleteProfile", mock.Anything, mock.AnythingOfType("*profiles.GetCompleteProfilePayload")).Return(createTestStudentProfile(), nil)
+	mockExercisesRepo.On("GetExerciseToResolveById", mock.Anything, int64(1)).Return(createTestExerciseToResolve(), nil)
+	mockAnswersRepo.On("CheckIfAnswerExists", mock.Anything, mock.AnythingOfType("codelabdb.CheckIfAnswerExistsParams")).Return(int32(1), nil)
+	mockAnswersRepo.On("GetAnswerByUserAndExercise", mock.Anything, mock.AnythingOfType("codelabdb.GetAnswerByUserAndExerciseParams")).Return(answer, nil)
+	mockTestsRepo.On("GetTestsByExercise", mock.Anything, int64(1)).Return([]codelabdb.Test{
+		{ID: 1, Input: "5", Output: "10", Weight: 1, ExerciseID: 1},
+	}, nil)
+	mockAttemptsRepo.On("CreateAttempt", mock.Anything, mock.MatchedBy(func(arg codelabdb.CreateAttemptParams) bool {
+		return arg.Success && arg.Points == 1 && arg.Score == 70
+	})).Return(createTestAttempt(true), nil)
+	mockAttemptsRepo.On("CreateAttemptTestResult", mock.Anything, mock.AnythingOfType("codelabdb.CreateAttemptTestResultParams")).Return(nil)
+	mockAnswersRepo.On("UpdateAnswerBestScore", mock.Anything, mock.MatchedBy(func(arg codelabdb.UpdateAnswerBestScoreParams) bool {
+		return arg.BestScore == 70
+	})).Return(nil)
+	mockAnswersRepo.On("UpdateAnswerCompleted", mock.Anything, mock.AnythingOfType("codelabdb.UpdateAnswerCompletedParams")).Return(nil)
+
+	// Act
+	result, err := service.CreateAttempt(context.Background(), &codelab.CreateAttemptPayload{
+		SessionToken: "valid-session-token",
+		ExerciseID:   1,
+		Code:         `function solution(input) { return parseInt(input) * 2; }`,
+	})
+
+	// Assert
+	assert.NoError(t, err)
+	if assert.NotNil(t, result) {
+		assert.True(t, result.Success)
+	}
+	mockAttemptsRepo.AssertExpectations(t)
+	mockAnswersRepo.AssertExpectations(t)
+}
+
+// ========================================
+// ASSIGNMENT TESTS
+// ========================================
+
+// createTestAssignment creates an assignment that opened an hour ago and
+// closes in an hour
+func createTestAssignment() codelabdb.Assignment {
+	now := time.Now()
+	return codelabdb.Assignment{
+		ID:        1,
+		Title:     "Arithmetic",
+		OpensAt:   pgtype.Timestamptz{Time: now.Add(-time.Hour), Valid: true},
+		ClosesAt:  pgtype.Timestamptz{Time: now.Add(time.Hour), Valid: true},
+		CreatedBy: 1,
+		CreatedAt: pgtype.Timestamptz{Time: now.Add(-2 * time.Hour), Valid: true},
+		UpdatedAt: pgtype.Timestamptz{Time: now.Add(-2 * time.Hour), Valid: true},
+	}
+}
+
+// createTestAssignmentExercises creates the exercises of the test assignment
+func createTestAssignmentExercises() []codelabdb.GetAssignmentExercisesRow {
+	return []codelabdb.GetAssignmentExercisesRow{
+		{Position: 1, ID: 1, Title: "Double", Difficulty: "easy", Language: "javascript"},
+		{Position: 2, ID: 2, Title: "Sum", Difficulty: "medium", Language: "javascript"},
+	}
+}
+
+func TestCreateAssignment_Success(t *testing.T) {
+	// Arrange
+	mockProfilesServiceRepo := &mocks.MockProfilesServiceRepository{}
+	mockExercisesRepo := &mocks.MockExercisesRepository{}
+	mockAssignmentsRepo := &mocks.MockAssignmentsRepository{}
+	service := setupTestService(mockProfilesServiceRepo, mockExercisesRepo, nil, nil, nil)
+	mockTxManager := useTransaction(service, &repositories.TransactionalRepositories{AssignmentsRepo: mockAssignmentsRepo})
+
+	mockProfilesServiceRepo.On("GetCompleteProfile", mock.Anything, mock.AnythingOfType("*profiles.GetCompleteProfilePayload")).Return(createTestTeacherProfile(), nil)
+	mockExercisesRepo.On("GetExerciseById", mock.Anything, mock.AnythingOfType("int64")).Return(createTestExercise(), nil)
+	mockTxManager.On("WithTransaction", mock.Anything).Return(nil)
+	mockAssignmentsRepo.On("CreateAssignment", mock.Anything, mock.MatchedBy(func(arg codelabdb.CreateAssignmentParams) bool {
+		return arg.Title == "Arithmetic" && arg.ClosesAt.Time.UnixMilli() == 1673136000000 && arg.AllowLate && arg.CreatedBy == 1
+	})).Return(int64(7), nil)
+	mockAssignmentsRepo.On("AddAssignmentExercise", mock.Anything, codelabdb.AddAssignmentExerciseParams{AssignmentID: 7, ExerciseID: 2, Position: 1}).Return(nil)
+	mockAssignmentsRepo.On("AddAssignmentExercise", mock.Anything, codelabdb.AddAssignmentExerciseParams{AssignmentID: 7, ExerciseID: 1, Position: 2}).Return(nil)
+
+	// Act
+	result, err := service.CreateAssignment(context.Background(), &codelab.CreateAssignmentPayload{
+		SessionToken: "valid-session-token",
+		Title:        "Arithmetic",
+		OpensAt:      1672531200000,
+		ClosesAt:     1673136000000,
+		AllowLate:    true,
+		ExerciseIds:  []int64{2, 1},
+	})
+
+	// Assert
+	assert.NoError(t, err)
+	if assert.NotNil(t, result) {
+		assert.True(t, result.Success)
+	}
+	mockAssignmentsRepo.AssertExpectations(t)
+}
+
+func TestCreateAssignment_InvalidInput(t *testing.T) {
+	tests := []struct {
+		name          string
+		payload       *codelab.CreateAssignmentPayload
+		expectedError string
+	}{
+		{
+			name: "closes before opening",
+			payload: &codelab.CreateAssignmentPayload{
+				SessionToken: "valid-session-token",
+				Title:        "Arithmetic",
+				OpensAt:      1673136000000,
+				ClosesAt:     1672531200000,
+				ExerciseIds:  []int64{1},
+			},
+			expectedError: "Assignment must close after it opens",
+		},
+		{
+			name: "duplicate exercise",
+			payload: &codelab.CreateAssignmentPayload{
+				SessionToken: "valid-session-token",
+				Title:        "Arithmetic",
+				OpensAt:      1672531200000,
+				ClosesAt:     1673136000000,
+				ExerciseIds:  []int64{1, 1},
+			},
+			expectedError: "Exercise 1 is listed more than once",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange
+			mockProfilesServiceRepo := &mocks.MockProfilesServiceRepository{}
+			mockExercisesRepo := &mocks.MockExercisesRepository{}
+			service := setupTestService(mockProfilesServiceRepo, mockExercisesRepo, nil, nil, nil)
+
+			mockProfilesServiceRepo.On("GetCompleteProfile", mock.Anything, mock.AnythingOfType("*profiles.GetCompleteProfilePayload")).Return(createTestTeacherProfile(), nil)
+			mockExercisesRepo.On("GetExerciseById", mock.Anything, int64(1)).Return(createTestExercise(), nil)
+
+			// Act
+			result, err := service.CreateAssignment(context.Background(), tt.payload)
+
+			// Assert
+			assert.Nil(t, result)
+			assert.Contains(t, errorMessage(err), tt.expectedError)
+		})
+	}
+}
+
+func TestUpdateAssignment_ReplacesExercises(t *testing.T) {
+	// Arrange
+	mockProfilesServiceRepo := &mocks.MockProfilesServiceRepository{}
+	mockExercisesRepo := &mocks.MockExercisesRepository{}
+	mockAssignmentsRepo := &mocks.MockAssignmentsRepository{}
+	service := setupTestService(mockProfilesServiceRepo, mockExercisesRepo, nil, nil, nil)
+	service.assignmentsRepo = mockAssignmentsRepo
+	mockTxManager := useTransaction(service, &repositories.TransactionalRepositories{AssignmentsRepo: mockAssignmentsRepo})
+
+	mockProfilesServiceRepo.On("GetCompleteProfile", mock.Anything, mock.AnythingOfType("*profiles.GetCompleteProfilePayload")).Return(createTestTeacherProfile(), nil)
+	mockExercisesRepo.On("GetExerciseById", mock.Anything, int64(3)).Return(createTestExercise(), nil)
+	mockTxManager.On("WithTransaction", mock.Anything).Return(nil)
+	mockAssignmentsRepo.On("GetAssignmentById", mock.Anything, int64(1)).Return(createTestAssignment(), nil)
+	mockAssignmentsRepo.On("UpdateAssignment", mock.Anything, mock.MatchedBy(func(arg codelabdb.UpdateAssignmentParams) bool {
+		return arg.ID == 1 && arg.Title == "Arithmetic II"
+	})).Return(nil)
+	mockAssignmentsRepo.On("DeleteAssignmentExercises", mock.Anything, int64(1)).Return(nil)
+	mockAssignmentsRepo.On("AddAssignmentExercise", mock.Anything, codelabdb.AddAssignmentExerciseParams{AssignmentID: 1, ExerciseID: 3, Position: 1}).Return(nil)
+
+	// Act
+	result, err := service.UpdateAssignment(context.Background(), &codelab.UpdateAssignmentPayload2{
+		SessionToken: "valid-session-token",
+		ID:           1,
+		Assignment: &codelab.UpdateAssignmentPayload{
+			Title:       "Arithmetic II",
+			OpensAt:     1672531200000,
+			ClosesAt:    1673136000000,
+			ExerciseIds: []int64{3},
+		},
+	})
+
+	// Assert
+	assert.NoError(t, err)
+	if assert.NotNil(t, result) {
+		assert.True(t, result.Success)
+	}
+	mockAssignmentsRepo.AssertExpectations(t)
+}
+
+func TestAttemptDeadline(t *testing.T) {
+	now := time.Now()
+	assignment := func(title string, opens, closes time.Duration, allowLate bool) codelabdb.Assignment {
+		return codelabdb.Assignment{
+			Title:     title,
+			OpensAt:   pgtype.Timestamptz{Time: now.Add(opens), Valid: true},
+			ClosesAt:  pgtype.Timestamptz{Time: now.Add(closes), Valid: true},
+			AllowLate: allowLate,
+		}
+	}
+
+	tests := []struct {
+		name          string
+		assignments   []codelabdb.Assignment
+		expectedLate  bool
+		expectedError string
+	}{
+		{
+			name: "no assignments",
+		},
+		{
+			name:        "open",
+			assignments: []codelabdb.Assignment{assignment("Closed", -2*time.Hour, -time.Hour, false), assignment("Open", -time.Hour, time.Hour, false)},
+		},
+		{
+			name:         "closed accepting late attempts",
+			assignments:  []codelabdb.Assignment{assignment("Closed", -2*time.Hour, -time.Hour, true)},
+			expectedLate: true,
+		},
+		{
+			name:          "closed",
+			assignments:   []codelabdb.Assignment{assignment("Closed", -2*time.Hour, -time.Hour, false)},
+			expectedError: `Assignment "Closed" closed at`,
+		},
+		{
+			name:          "upcoming",
+			assignments:   []codelabdb.Assignment{assignment("Closed", -2*time.Hour, -time.Hour, false), assignment("Upcoming", time.Hour, 2*time.Hour, false)},
+			expectedError: `Assignment "Upcoming" opens at`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			late, err := attemptDeadline(tt.assignments, now)
+
+			assert.Equal(t, tt.expectedLate, late)
+			if tt.expectedError == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.Contains(t, errorMessage(err), tt.expectedError)
+			}
+		})
+	}
+}
+
+func TestCreateAttempt_AssignmentClosed(t *testing.T) {
+	// Arrange
+	mockProfilesServiceRepo := &mocks.MockProfilesServiceRepository{}
+	mockExercisesRepo := &mocks.MockExercisesRepository{}
+	mockAssignmentsRepo := &mocks.MockAssignmentsRepository{}
+	mockAttemptsRepo := &mocks.MockAttemptsRepository{}
+	service := setupTestService(mockProfilesServiceRepo, mockExercisesRepo, nil, nil, mockAttemptsRepo)
+	service.assignmentsRepo = mockAssignmentsRepo
+
+	assignment := createTestAssignment()
+	assignment.ClosesAt = pgtype.Timestamptz{Time: time.Now().Add(-time.Minute), Valid: true}
+
+	mockProfilesServiceRepo.On("GetCompleteProfile", mock.Anything, mock.AnythingOfType("*profiles.GetCompleteProfilePayload")).Return(createTestStudentProfile(), nil)
+	mockExercisesRepo.On("GetExerciseToResolveById", mock.Anything, int64(1)).Return(createTestExerciseToResolve(), nil)
+	mockAssignmentsRepo.On("GetAssignmentsByExercise", mock.Anything, int64(1)).Return([]codelabdb.Assignment{assignment}, nil)
+
+	// Act
+	result, err := service.CreateAttempt(context.Background(), &codelab.CreateAttemptPayload{
+		SessionToken: "valid-session-token",
+		ExerciseID:   1,
+		Code:         `function solution(input) { return parseInt(input) * 2; }`,
+	})
+
+	// Assert
+	assert.Nil(t, result)
+	assert.Contains(t, errorMessage(err), "late attempts are not accepted")
+	mockAttemptsRepo.AssertNotCalled(t, "CreateAttempt", mock.Anything, mock.Anything)
+}
+
+func TestCreateAttempt_FlagsLateAttempt(t *testing.T) {
+	// Arrange
+	mockProfilesServiceRepo := &mocks.MockProfilesServiceRepository{}
+	mockExercisesRepo := &mocks.MockExercisesRepository{}
+	mockAnswersRepo := &mocks.MockAnswersRepository{}
+	mockTestsRepo := &mocks.MockTestsRepository{}
+	mockAttemptsRepo := &mocks.MockAttemptsRepository{}
+	mockAssignmentsRepo := &mocks.MockAssignmentsRepository{}
+	service := setupTestService(mockProfilesServiceRepo, mockExercisesRepo, mockTestsRepo, mockAnswersRepo, mockAttemptsRepo)
+	service.assignmentsRepo = mockAssignmentsRepo
+
+	assignment := createTestAssignment()
+	assignment.ClosesAt = pgtype.Timestamptz{Time: time.Now().Add(-time.Minute), Valid: true}
+	assignment.AllowLate = true
+
+	mockProfilesServiceRepo.On("GetCompleteProfile", mock.Anything, mock.AnythingOfType("*profiles.GetCompleteProfilePayload")).Return(createTestStudentProfile(), nil)
+	mockExercisesRepo.On("GetExerciseToResolveById", mock.Anything, int64(1)).Return(createTestExerciseToResolve(), nil)
+	mockAssignmentsRepo.On("GetAssignmentsByExercise", mock.Anything, int64(1)).Return([]codelabdb.Assignment{assignment}, nil)
+	mockAnswersRepo.On("CheckIfAnswerExists", mock.Anything, mock.AnythingOfType("codelabdb.CheckIfAnswerExistsParams")).Return(int32(1), nil)
+	mockAnswersRepo.On("GetAnswerByUserAndExercise", mock.Anything, mock.AnythingOfType("codelabdb.GetAnswerByUserAndExerciseParams")).Return(createTestAnswer(), nil)
+	mockTestsRepo.On("GetTestsByExercise", mock.Anything, int64(1)).Return([]codelabdb.Test{
+		{ID: 1, Input: "5", Output: "10", Weight: 1, ExerciseID: 1},
+	}, nil)
+	mockAttemptsRepo.On("CreateAttempt", mock.Anything, mock.MatchedBy(func(arg codelabdb.CreateAttemptParams) bool {
+		return arg.Success && arg.Late
+	})).Return(createTestAttempt(true), nil)
+	mockAttemptsRepo.On("CreateAttemptTestResult", mock.Anything, mock.AnythingOfType("codelabdb.CreateAttemptTestResultParams")).Return(nil)
+	mockAnswersRepo.On("UpdateAnswerBestScore", mock.Anything, mock.AnythingOfType("codelabdb.UpdateAnswerBestScoreParams")).Return(nil)
+	mockAnswersRepo.On("UpdateAnswerCompleted", mock.Anything, mock.AnythingOfType("codelabdb.UpdateAnswerCompletedParams")).Return(nil)
+
+	// Act
+	result, err := service.CreateAttempt(context.Background(), &codelab.CreateAttemptPayload{
+		SessionToken: "valid-session-token",
+		ExerciseID:   1,
+		Code:         `function solution(input) { return parseInt(input) * 2; }`,
+	})
+
+	// Assert
+	assert.NoError(t, err)
+	if assert.NotNil(t, result) {
+		assert.True(t, result.Success)
+		assert.Contains(t, result.Message, "submitted after the deadline")
+	}
+	mockAttemptsRepo.AssertExpectations(t)
+}
+
+func TestGetAssignmentGradebook_Success(t *testing.T) {
+	// Arrange
+	mockProfilesServiceRepo := &mocks.MockProfilesServiceRepository{}
+	mockAssignmentsRepo := &mocks.MockAssignmentsRepository{}
+	service := setupTestService(mockProfilesServiceRepo, nil, nil, nil, nil)
+	service.assignmentsRepo = mockAssignmentsRepo
+
+	mockProfilesServiceRepo.On("GetCompleteProfile", mock.Anything, mock.AnythingOfType("*profiles.GetCompleteProfilePayload")).Return(createTestTeacherProfile(), nil)
+	mockAssignmentsRepo.On("GetAssignmentById", mock.Anything, int64(1)).Return(createTestAssignment(), nil)
+	mockAssignmentsRepo.On("GetAssignmentExercises", mock.Anything, int64(1)).Return(createTestAssignmentExercises(), nil)
+	mockAssignmentsRepo.On("GetAssignmentGrades", mock.Anything, codelabdb.GetAssignmentGradesParams{AssignmentID: 1}).Return([]codelabdb.GetAssignmentGradesRow{
+		{UserID: 2, ExerciseID: 1, Attempts: 2, BestScore: 100, BestScoreWithLate: 100, Completed: true, CompletedWithLate: true},
+		{UserID: 2, ExerciseID: 2, Attempts: 3, LateAttempts: 1, BestScore: 50, BestScoreWithLate: 100, CompletedWithLate: true},
+		{UserID: 3, ExerciseID: 2, Attempts: 1, BestScore: 25, BestScoreWithLate: 25},
+	}, nil)
+
+	// Act
+	result, err := service.GetAssignmentGradebook(context.Background(), &codelab.GetAssignmentGradebookPayload{
+		SessionToken: "valid-session-token",
+		ID:           1,
+	})
+
+	// Assert
+	assert.NoError(t, err)
+	if assert.NotNil(t, result) && assert.Len(t, result.Students, 2) {
+		assert.Equal(t, "open", result.Assignment.Status)
+		assert.Len(t, result.Assignment.Exercises, 2)
+
+		first := result.Students[0]
+		assert.Equal(t, int64(2), first.UserID)
+		assert.Equal(t, 75.0, first.Score)
+		assert.Equal(t, 100.0, first.ScoreWithLate)
+		assert.Equal(t, int64(1), first.CompletedExercises)
+		assert.Equal(t, "completed", first.Exercises[0].Status)
+		assert.Equal(t, "completed_late", first.Exercises[1].Status)
+
+		second := result.Students[1]
+		assert.Equal(t, int64(3), second.UserID)
+		assert.Equal(t, 12.5, second.Score)
+		assert.Equal(t, "not_started", second.Exercises[0].Status)
+		assert.Equal(t, "in_progress", second.Exercises[1].Status)
+	}
+}
+
+func TestGetAssignmentGradebook_StudentForbidden(t *testing.T) {
+	// Arrange
+	mockProfilesServiceRepo := &mocks.MockProfilesServiceRepository{}
+	service := setupTestService(mockProfilesServiceRepo, nil, nil, nil, nil)
+
+	mockProfilesServiceRepo.On("GetCompleteProfile", mock.Anything, mock.AnythingOfType("*profiles.GetCompleteProfilePayload")).Return(createTestStudentProfile(), nil)
+
+	// Act
+	result, err := service.GetAssignmentGradebook(context.Background(), &codelab.GetAssignmentGradebookPayload{
+		SessionToken: "valid-session-token",
+		ID:           1,
+	})
+
+	// Assert
+	assert.Nil(t, result)
+	assert.Contains(t, errorMessage(err), "Only teachers can view the gradebook")
+}
+
+func TestListAssignments_GroupsExercises(t *testing.T) {
+	// Arrange
+	mockProfilesServiceRepo := &mocks.MockProfilesServiceRepository{}
+	mockAssignmentsRepo := &mocks.MockAssignmentsRepository{}
+	service := setupTestService(mockProfilesServiceRepo, nil, nil, nil, nil)
+	service.assignmentsRepo = mockAssignmentsRepo
+
+	second := createTestAssignment()
+	second.ID = 2
+	second.Title = "Strings"
+	mockProfilesServiceRepo.On("GetCompleteProfile", mock.Anything, mock.AnythingOfType("*profiles.GetCompleteProfilePayload")).Return(createTestTeacherProfile(), nil)
+	mockAssignmentsRepo.On("ListAssignments", mock.Anything).Return([]codelabdb.Assignment{createTestAssignment(), second}, nil)
+	mockAssignmentsRepo.On("ListAssignmentExercises", mock.Anything).Return([]codelabdb.ListAssignmentExercisesRow{
+		{AssignmentID: 1, Position: 1, ID: 1, Title: "Double", Difficulty: "easy", Language: "javascript"},
+		{AssignmentID: 1, Position: 2, ID: 2, Title: "Sum", Difficulty: "medium", Language: "javascript"},
+		{AssignmentID: 2, Position: 1, ID: 3, Title: "Reverse", Difficulty: "easy", Language: "javascript"},
+	}, nil).Once()
+
+	// Act
+	result, err := service.ListAssignments(context.Background(), &codelab.ListAssignmentsPayload{
+		SessionToken: "valid-session-token",
+	})
+
+	// Assert
+	assert.NoError(t, err)
+	if assert.Len(t, result, 2) {
+		assert.Len(t, result[0].Exercises, 2)
+		if assert.Len(t, result[1].Exercises, 1) {
+			assert.Equal(t, int64(3), result[1].Exercises[0].ExerciseID)
+		}
+	}
+	mockAssignmentsRepo.AssertExpectations(t)
+	mockAssignmentsRepo.AssertNotCalled(t, "GetAssignmentExercises", mock.Anything, mock.Anything)
+}
+
+func TestGetAssignmentProgress_Success(t *testing.T) {
+	// Arrange
+	mockProfilesServiceRepo := &mocks.MockProfilesServiceRepository{}
+	mockAssignmentsRepo := &mocks.MockAssignmentsRepository{}
+	service := setupTestService(mockProfilesServiceRepo, nil, nil, nil, nil)
+	service.assignmentsRepo = mockAssignmentsRepo
+
+	student := createTestStudentProfile()
+	mockProfilesServiceRepo.On("GetCompleteProfile", mock.Anything, mock.AnythingOfType("*profiles.GetCompleteProfilePayload")).Return(student, nil)
+	mockAssignmentsRepo.On("GetAssignmentById", mock.Anything, int64(1)).Return(createTestAssignment(), nil)
+	mockAssignmentsRepo.On("GetAssignmentExercises", mock.Anything, int64(1)).Return(createTestAssignmentExercises(), nil)
+	mockAssignmentsRepo.On("GetAssignmentGrades", mock.Anything, codelabdb.GetAssignmentGradesParams{
+		AssignmentID: 1,
+		UserID:       pgtype.Int8{Int64: student.UserID, Valid: true},
+	}).Return([]codelabdb.GetAssignmentGradesRow{
+		{UserID: student.UserID, ExerciseID: 1, Attempts: 1, BestScore: 100, BestScoreWithLate: 100, Completed: true, CompletedWithLate: true},
+	}, nil)
+
+	// Act
+	result, err := service.GetAssignmentProgress(context.Background(), &codelab.GetAssignmentProgressPayload{
+		SessionToken: "valid-session-token",
+		ID:           1,
+	})
+
+	// Assert
+	assert.NoError(t, err)
+	if assert.NotNil(t, result) {
+		assert.Equal(t, student.UserID, result.Progress.UserID)
+		assert.Equal(t, int64(2), result.Progress.TotalExercises)
+		assert.Equal(t, int64(1), result.Progress.CompletedExercises)
+		assert.Equal(t, 50.0, result.Progress.Score)
+		assert.Equal(t, "not_started", result.Progress.Exercises[1].Status)
+	}
+}
+
+func TestGetAssignmentProgress_NotFound(t *testing.T) {
+	// Arrange
+	mockProfilesServiceRepo := &mocks.MockProfilesServiceRepository{}
+	mockAssignmentsRepo := &mocks.MockAssignmentsRepository{}
+	service := setupTestService(mockProfilesServiceRepo, nil, nil, nil, nil)
+	service.assignmentsRepo = mockAssignmentsRepo
+
+	mockProfilesServiceRepo.On("GetCompleteProfile", mock.Anything, mock.AnythingOfType("*profiles.GetCompleteProfilePayload")).Return(createTestStudentProfile(), nil)
+	mockAssignmentsRepo.On("GetAssignmentById", mock.Anything, int64(99)).Return(codelabdb.Assignment{}, pgx.ErrNoRows)
+
+	// Act
+	result, err := service.GetAssignmentProgress(context.Background(), &codelab.GetAssignmentProgressPayload{
+		SessionToken: "valid-session-token",
+		ID:           99,
+	})
+
+	// Assert
+	assert.Nil(t, result)
+	assert.Contains(t, errorMessage(err), "Assignment not found")
+}
+
+// ========================================
+// SUBMISSION TESTS
+// ========================================
+
+// recordingStream collects the events sent to a client following an attempt
+type recordingStream struct {
+	events []*codelab.AttemptEvent
+	closed bool
+}
+
+func (r *recordingStream) Send(event *codelab.AttemptEvent) error {
+	r.events = append(r.events, event)
+	return nil
+}
+
+func (r *recordingStream) SendWithContext(ctx context.Context, event *codelab.AttemptEvent) error {
+	return r.Send(event)
+}
+
+func (r *recordingStream) Close() error {
+	r.closed = true
+	return nil
+}
+
+func TestSubmitAttempt_StreamsTestResults(t *testing.T) {
+	// Arrange
+	mockProfilesServiceRepo := &mocks.MockProfilesServiceRepository{}
+	mockExercisesRepo := &mocks.MockExercisesRepository{}
+	mockAnswersRepo := &mocks.MockAnswersRepository{}
+	mockTestsRepo := &mocks.MockTestsRepository{}
+	mockAttemptsRepo := &mocks.MockAttemptsRepository{}
+	service := setupTestService(mockProfilesServiceRepo, mockExercisesRepo, mockTestsRepo, mockAnswersRepo, mockAttemptsRepo)
+	service.queue.Start(context.Background())
+
+	pending := createTestAttempt(false)
+	pending.Status = "pending"
+
+	mockProfilesServiceRepo.On("GetCompleteProfile", mock.Anything, mock.AnythingOfType("*profiles.GetCompleteProfilePayload")).Return(createTestStudentProfile(), nil)
+	mockExercisesRepo.On("GetExerciseToResolveById", mock.Anything, int64(1)).Return(createTestExerciseToResolve(), nil)
+	mockAnswersRepo.On("CheckIfAnswerExists", mock.Anything, mock.AnythingOfType("codelabdb.CheckIfAnswerExistsParams")).Return(int32(1), nil)
+	mockAnswersRepo.On("GetAnswerByUserAndExercise", mock.Anything, mock.AnythingOfType("codelabdb.GetAnswerByUserAndExerciseParams")).Return(createTestAnswer(), nil)
+	mockTestsRepo.On("GetTestsByExercise", mock.Anything, int64(1)).Return([]codelabdb.Test{
+		{ID: 1, Input: "5", Output: "10", Public: true, Weight: 1, ExerciseID: 1},
+		{ID: 2, Input: "7", Output: "14", Weight: 3, ExerciseID: 1},
+	}, nil)
+	mockAttemptsRepo.On("CreateAttempt", mock.Anything, mock.MatchedBy(func(arg codelabdb.CreateAttemptParams) bool {
+		return arg.Status == "pending" && !arg.Success
+	})).Return(pending, nil)
+	mockAttemptsRepo.On("UpdateAttemptResult", mock.Anything, codelabdb.UpdateAttemptResultParams{
+		ID:        1,
+		Success:   true,
+		Status:    "passed",
+		Points:    4,
+		MaxPoints: 4,
+		Score:     100,
+		Message:   "All tests passed successfully",
+	}).Return(nil)
+	mockAttemptsRepo.On("CreateAttemptTestResult", mock.Anything, mock.AnythingOfType("codelabdb.CreateAttemptTestResultParams")).Return(nil)
+	mockAttemptsRepo.On("GetAttemptByUser", mock.Anything, codelabdb.GetAttemptByUserParams{ID: 1, UserID: 1}).Return(pending, nil)
+	mockAnswersRepo.On("UpdateAnswerBestScore", mock.Anything, mock.AnythingOfType("codelabdb.UpdateAnswerBestScoreParams")).Return(nil)
+	mockAnswersRepo.On("UpdateAnswerCompleted", mock.Anything, mock.AnythingOfType("codelabdb.UpdateAnswerCompletedParams")).Return(nil)
+
+	// Act
+	submission, err := service.SubmitAttempt(context.Background(), &codelab.CreateAttemptPayload{
+		SessionToken: "valid-session-token",
+		ExerciseID:   1,
+		Code:         `function solution(input) { return parseInt(input) * 2; }`,
+	})
+	stream := &recordingStream{}
+	streamErr := service.StreamAttemptEvents(context.Background(), &codelab.StreamAttemptEventsPayload{
+		SessionToken: "valid-session-token",
+		ID:           1,
+	}, stream)
+	service.queue.Stop()
+
+	// Assert
+	assert.NoError(t, err)
+	if assert.NotNil(t, submission) {
+		assert.Equal(t, int64(1), submission.AttemptID)
+		assert.Equal(t, int64(2), submission.TotalTests)
+	}
+	assert.NoError(t, streamErr)
+	assert.True(t, stream.closed)
+	if assert.Len(t, stream.events, 5) {
+		types := make([]string, len(stream.events))
+		for i, event := range stream.events {
+			types[i] = event.Type
+		}
+		assert.Equal(t, []string{"queued", "running", "test", "test", "finished"}, types)
+
+		public, hidden := stream.events[2].TestResult, stream.events[3].TestResult
+		assert.Equal(t, "passed", public.Status)
+		assert.Equal(t, "10", *public.ExpectedOutput)
+		assert.Nil(t, hidden.ExpectedOutput, "hidden tests keep their expected output hidden")
+		assert.Equal(t, int64(1), *stream.events[3].TestIndex)
+
+		finished := stream.events[4]
+		assert.True(t, *finished.Success)
+		assert.Equal(t, 100.0, *finished.Score)
+		assert.Equal(t, "All tests passed successfully", *finished.Message)
+	}
+	mockAttemptsRepo.AssertExpectations(t)
+	mockAnswersRepo.AssertExpectations(t)
+}
+
+func TestSubmitAttempt_CodeError(t *testing.T) {
+	// Arrange
+	mockProfilesServiceRepo := &mocks.MockProfilesServiceRepository{}
+	mockExercisesRepo := &mocks.MockExercisesRepository{}
+	mockAnswersRepo := &mocks.MockAnswersRepository{}
+	mockTestsRepo := &mocks.MockTestsRepository{}
+	mockAttemptsRepo := &mocks.MockAttemptsRepository{}
+	service := setupTestService(mockProfilesServiceRepo, mockExercisesRepo, mockTestsRepo, mockAnswersRepo, mockAttemptsRepo)
+	service.queue.Start(context.Background())
+
+	pending := createTestAttempt(false)
+	pending.Status = "pending"
+
+	mockProfilesServiceRepo.On("GetCompleteProfile", mock.Anything, mock.AnythingOfType("*profiles.GetCompleteProfilePayload")).Return(createTestStudentProfile(), nil)
+	mockExercisesRepo.On("GetExerciseToResolveById", mock.Anything, int64(1)).Return(createTestExerciseToResolve(), nil)
+	mockAnswersRepo.On("CheckIfAnswerExists", mock.Anything, mock.AnythingOfType("codelabdb.CheckIfAnswerExistsParams")).Return(int32(1), nil)
+	mockAnswersRepo.On("GetAnswerByUserAndExercise", mock.Anything, mock.AnythingOfType("codelabdb.GetAnswerByUserAndExerciseParams")).Return(createTestAnswer(), nil)
+	mockTestsRepo.On("GetTestsByExercise", mock.Anything, int64(1)).Return([]codelabdb.Test{
+		{ID: 1, Input: "5", Output: "10", Weight: 1, ExerciseID: 1},
+	}, nil)
+	mockAttemptsRepo.On("CreateAttempt", mock.Anything, mock.AnythingOfType("codelabdb.CreateAttemptParams")).Return(pending, nil)
+	mockAttemptsRepo.On("UpdateAttemptResult", mock.Anything, mock.MatchedBy(func(arg codelabdb.UpdateAttemptResultParams) bool {
+		return arg.ID == 1 && arg.Status == "error" && strings.HasPrefix(arg.Message, "Code execution failed")
+	})).Return(nil)
+	mockAttemptsRepo.On("GetAttemptByUser", mock.Anything, mock.AnythingOfType("codelabdb.GetAttemptByUserParams")).Return(pending, nil)
+
+	// Act
+	_, err := service.SubmitAttempt(context.Background(), &codelab.CreateAttemptPayload{
+		SessionToken: "valid-session-token",
+		ExerciseID:   1,
+		Code:         `function solution(input) {`,
+	})
+	stream := &recordingStream{}
+	streamErr := service.StreamAttemptEvents(context.Background(), &codelab.StreamAttemptEventsPayload{
+		SessionToken: "valid-session-token",
+		ID:           1,
+	}, stream)
+	service.queue.Stop()
+
+	// Assert
+	assert.NoError(t, err)
+	assert.NoError(t, streamErr)
+	if assert.NotEmpty(t, stream.events) {
+		finished := stream.events[len(stream.events)-1]
+		assert.Equal(t, "finished", finished.Type)
+		assert.Equal(t, "error", *finished.Status)
+		assert.Contains(t, *finished.Message, "Code execution failed")
+	}
+	mockAttemptsRepo.AssertExpectations(t)
+}
+
+func TestSubmitAttempt_QueueFull(t *testing.T) {
+	// Arrange
+	mockProfilesServiceRepo := &mocks.MockProfilesServiceRepository{}
+	mockExercisesRepo := &mocks.MockExercisesRepository{}
+	mockAnswersRepo := &mocks.MockAnswersRepository{}
+	mockTestsRepo := &mocks.MockTestsRepository{}
+	mockAttemptsRepo := &mocks.MockAttemptsRepository{}
+	service := setupTestService(mockProfilesServiceRepo, mockExercisesRepo, mockTestsRepo, mockAnswersRepo, mockAttemptsRepo)
+	// Without workers the only slot of the queue stays taken
+	service.queue = grading.NewQueue(1, 1)
+	service.queue.Submit(func(ctx context.Context) {})
+
+	mockProfilesServiceRepo.On("GetCompleteProfile", mock.Anything, mock.AnythingOfType("*profiles.GetCompleteProfilePayload")).Return(createTestStudentProfile(), nil)
+	mockExercisesRepo.On("GetExerciseToResolveById", mock.Anything, int64(1)).Return(createTestExerciseToResolve(), nil)
+	mockAnswersRepo.On("CheckIfAnswerExists", mock.Anything, mock.AnythingOfType("codelabdb.CheckIfAnswerExistsParams")).Return(int32(1), nil)
+	mockAnswersRepo.On("GetAnswerByUserAndExercise", mock.Anything, mock.AnythingOfType("codelabdb.GetAnswerByUserAndExerciseParams")).Return(createTestAnswer(), nil)
+	mockTestsRepo.On("GetTestsByExercise", mock.Anything, int64(1)).Return([]codelabdb.Test{}, nil)
+	mockAttemptsRepo.On("CreateAttempt", mock.Anything, mock.AnythingOfType("codelabdb.CreateAttemptParams")).Return(createTestAttempt(false), nil)
+	// The attempt was never graded, so it does not count as a failed one
+	mockAttemptsRepo.On("DeleteAttempt", mock.Anything, int64(1)).Return(nil)
+
+	// Act
+	result, err := service.SubmitAttempt(context.Background(), &codelab.CreateAttemptPayload{
+		SessionToken: "valid-session-token",
+		ExerciseID:   1,
+		Code:         `function solution(input) { return input; }`,
+	})
+
+	// Assert
+	assert.Nil(t, result)
+	assert.Contains(t, errorMessage(err), "Grading is busy")
+	mockAttemptsRepo.AssertExpectations(t)
+}
+
+func TestGradeAttempt_InterruptedStillStored(t *testing.T) {
+	// Arrange
+	mockAttemptsRepo := &mocks.MockAttemptsRepository{}
+	service := setupTestService(nil, nil, nil, nil, mockAttemptsRepo)
+	g := &attemptGrading{
+		language: "javascript",
+		tests:    []codelabdb.Test{{ID: 1, Input: "5", Output: "10", Weight: 1}},
+		cases:    []sandbox.TestCase{{ID: 1, Input: "5", Expected: "10"}},
+	}
+	// The queue stopped before the attempt ran
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	mockAttemptsRepo.On("UpdateAttemptResult", mock.MatchedBy(func(ctx context.Context) bool {
+		return ctx.Err() == nil
+	}), codelabdb.UpdateAttemptResultParams{
+		ID:      1,
+		Status:  "error",
+		Message: attemptInterruptedMessage,
+	}).Return(nil)
+
+	// Act
+	service.broker.Open(1)
+	service.gradeAttempt(ctx, g, 1, `function solution(input) { return input * 2; }`)
+
+	// Assert
+	mockAttemptsRepo.AssertExpectations(t)
+}
+
+func TestStreamAttemptEvents_ReplaysStoredAttempt(t *testing.T) {
+	// Arrange
+	mockProfilesServiceRepo := &mocks.MockProfilesServiceRepository{}
+	mockAttemptsRepo := &mocks.MockAttemptsRepository{}
+	service := setupTestService(mockProfilesServiceRepo, nil, nil, nil, mockAttemptsRepo)
+
+	attempt := createTestAttempt(false)
+	attempt.Status = "failed"
+	attempt.Points = 1
+	attempt.MaxPoints = 2
+	attempt.Score = 50
+	attempt.Message = "Test failed: expected 14, got 13 (1 of 2 tests passed)"
+
+	mockProfilesServiceRepo.On("GetCompleteProfile", mock.Anything, mock.AnythingOfType("*profiles.GetCompleteProfilePayload")).Return(createTestStudentProfile(), nil)
+	mockAttemptsRepo.On("GetAttemptByUser", mock.Anything, mock.AnythingOfType("codelabdb.GetAttemptByUserParams")).Return(attempt, nil)
+	mockAttemptsRepo.On("GetTestResultsByAttempt", mock.Anything, int64(1)).Return([]codelabdb.AttemptTestResult{
+		{AttemptID: 1, TestID: pgtype.Int8{Int64: 1, Valid: true}, Status: "passed", ActualOutput: "10"},
+		{AttemptID: 1, TestID: pgtype.Int8{Int64: 2, Valid: true}, Status: "failed", ActualOutput: "13"},
+	}, nil)
+
+	// Act
+	stream := &recordingStream{}
+	err := service.StreamAttemptEvents(context.Background(), &codelab.StreamAttemptEventsPayload{
+		SessionToken: "valid-session-token",
+		ID:           1,
+	}, stream)
+
+	// Assert
+	assert.NoError(t, err)
+	if assert.Len(t, stream.events, 3) {
+		assert.Equal(t, "test", stream.events[0].Type)
+		assert.Equal(t, "13", stream.events[1].TestResult.ActualOutput)
+		assert.Equal(t, "finished", stream.events[2].Type)
+		assert.Equal(t, 50.0, *stream.events[2].Score)
+		assert.Equal(t, attempt.Message, *stream.events[2].Message)
+	}
+}
+
+func TestStreamAttemptEvents_NotFound(t *testing.T) {
+	// Arrange
+	mockProfilesServiceRepo := &mocks.MockProfilesServiceRepository{}
+	mockAttemptsRepo := &mocks.MockAttemptsRepository{}
+	service := setupTestService(mockProfilesServiceRepo, nil, nil, nil, mockAttemptsRepo)
+
+	mockProfilesServiceRepo.On("GetCompleteProfile", mock.Anything, mock.AnythingOfType("*profiles.GetCompleteProfilePayload")).Return(createTestStudentProfile(), nil)
+	mockAttemptsRepo.On("GetAttemptByUser", mock.Anything, mock.AnythingOfType("codelabdb.GetAttemptByUserParams")).Return(codelabdb.Attempt{}, pgx.ErrNoRows)
+
+	// Act
+	stream := &recordingStream{}
+	err := service.StreamAttemptEvents(context.Background(), &codelab.StreamAttemptEventsPayload{
+		SessionToken: "valid-session-token",
+		ID:           99,
+	}, stream)
+
+	// Assert
+	assert.Contains(t, errorMessage(err), "Attempt not found")
+	assert.Empty(t, stream.events)
+}
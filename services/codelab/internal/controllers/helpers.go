@@ -0,0 +1,1747 @@
+package controllers
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/ynoacamino/infra-sustainable-classrooms/services/codelab/gen/codelab"
+	codelabdb "github.com/ynoacamino/infra-sustainable-classrooms/services/codelab/gen/database"
+	"github.com/ynoacamino/infra-sustainable-classrooms/services/codelab/internal/bundle"
+	"github.com/ynoacamino/infra-sustainable-classrooms/services/codelab/internal/grading"
+	"github.com/ynoacamino/infra-sustainable-classrooms/services/codelab/internal/plagiarism"
+	"github.com/ynoacamino/infra-sustainable-classrooms/services/codelab/internal/ports"
+	"github.com/ynoacamino/infra-sustainable-classrooms/services/codelab/internal/ratelimit"
+	"github.com/ynoacamino/infra-sustainable-classrooms/services/codelab/internal/repositories"
+	"github.com/ynoacamino/infra-sustainable-classrooms/services/codelab/internal/sandbox"
+	"github.com/ynoacamino/infra-sustainable-classrooms/services/codelab/internal/versioning"
+	"goa.design/clue/log"
+)
+
+// executionError maps an error returned by the sandbox to a service error
+func executionError(err error) error {
+	var codeErr *sandbox.CodeError
+	switch {
+	case errors.Is(err, sandbox.ErrMissingEntryPoint):
+		return codelab.InvalidInput("Code must define a 'solution' function")
+	case errors.Is(err, sandbox.ErrUnsupportedLanguage):
+		return codelab.InvalidInput("Exercise language is not supported")
+	case errors.As(err, &codeErr):
+		if codeErr.Stack != "" {
+			return codelab.InvalidInput("Code execution failed: " + codeErr.Stack)
+		}
+		return codelab.InvalidInput("Code execution failed: " + codeErr.Message)
+	case errors.Is(err, sandbox.ErrUnavailable):
+		return codelab.ServiceUnavailable("Code execution is busy, try again later")
+	default:
+		return codelab.InternalError("Code execution failed: " + err.Error())
+	}
+}
+
+// acquireExecution checks the rate limits of the user before running code.
+// When the limiter itself fails the execution is allowed, so that an outage of
+// Redis does not stop students from working.
+func (s *codelabsvrc) acquireExecution(ctx context.Context, action ratelimit.Action, userID int64) (func(), error) {
+	release, err := s.limiter.Acquire(ctx, action, userID)
+	var limited *ratelimit.LimitedError
+	switch {
+	case err == nil:
+		return release, nil
+	case errors.As(err, &limited):
+		return nil, &codelab.RateLimit{
+			Message:    rateLimitMessage(action, limited.Reason),
+			RetryAfter: max(int64(math.Ceil(limited.RetryAfter.Seconds())), 1),
+		}
+	default:
+		log.Errorf(ctx, err, "rate limiter unavailable, allowing %s of user %d", action, userID)
+		return func() {}, nil
+	}
+}
+
+func rateLimitMessage(action ratelimit.Action, reason ratelimit.Reason) string {
+	switch {
+	case reason == ratelimit.ReasonBusy:
+		return "Too many executions are running, try again later"
+	case reason == ratelimit.ReasonCooldown && action == ratelimit.ActionAttempt:
+		return "Wait a few seconds before submitting another attempt"
+	case reason == ratelimit.ReasonCooldown:
+		return "Wait a moment before running code again"
+	case action == ratelimit.ActionAttempt:
+		return "Too many attempts, try again later"
+	default:
+		return "Too many runs, try again later"
+	}
+}
+
+// serviceErrorMessage returns the message a service error was created with,
+// as Error only describes its kind
+func serviceErrorMessage(err error) string {
+	switch e := err.(type) {
+	case codelab.InvalidInput:
+		return string(e)
+	case codelab.ServiceUnavailable:
+		return string(e)
+	case codelab.InternalError:
+		return string(e)
+	default:
+		return err.Error()
+	}
+}
+
+// testCase builds the sandbox test case for a stored test
+func testCase(test codelabdb.Test) sandbox.TestCase {
+	return sandbox.TestCase{
+		ID:          test.ID,
+		Input:       test.Input,
+		Expected:    test.Output,
+		InputFormat: sandbox.InputFormat(test.InputFormat),
+		Compare:     sandbox.CompareMode(test.Comparison),
+		Tolerance:   test.Tolerance,
+	}
+}
+
+// validateSolution runs the reference solution of an exercise against its
+// tests, so teachers cannot leave an exercise no student is able to pass.
+// subject names the solution in error messages.
+func (s *codelabsvrc) validateSolution(ctx context.Context, subject, language, solution string, cases []sandbox.TestCase) error {
+	result, err := s.executor.Run(ctx, sandbox.Language(language), solution, cases)
+	var codeErr *sandbox.CodeError
+	switch {
+	case errors.As(err, &codeErr):
+		return codelab.InvalidInput(subject + " failed to run: " + codeErr.Message)
+	case err != nil:
+		return executionError(err)
+	case result.Status == sandbox.StatusPassed:
+		return nil
+	}
+
+	return &codelab.SolutionFailure{
+		Message:      subject + " does not pass its tests: " + attemptFailureMessage(result, cases),
+		FailingTests: solutionTestFailures(result, cases),
+	}
+}
+
+// solutionTestFailures describes every test a solution did not pass. Tests
+// left out of a run that was cut short share the status of the run.
+func solutionTestFailures(result *sandbox.Result, cases []sandbox.TestCase) []*codelab.SolutionTestFailure {
+	failures := []*codelab.SolutionTestFailure{}
+	for i, tc := range cases {
+		status, output, message := result.Status, "", result.Error
+		if i < len(result.Cases) {
+			status, output, message = result.Cases[i].Status, result.Cases[i].Output, result.Cases[i].Error
+		}
+		if status == sandbox.StatusPassed {
+			continue
+		}
+
+		failure := &codelab.SolutionTestFailure{
+			Input:          tc.Input,
+			ExpectedOutput: tc.Expected,
+			ActualOutput:   output,
+			Status:         string(status),
+		}
+		if tc.ID != 0 {
+			failure.TestID = ptr(tc.ID)
+		}
+		if message != "" {
+			failure.ErrorMessage = ptr(message)
+		}
+		failures = append(failures, failure)
+	}
+	return failures
+}
+
+// testCases builds the sandbox test cases for stored tests
+func testCases(tests []codelabdb.Test) []sandbox.TestCase {
+	cases := make([]sandbox.TestCase, len(tests))
+	for i, test := range tests {
+		cases[i] = testCase(test)
+	}
+	return cases
+}
+
+// bundleExercise converts a stored exercise and its tests to their bundle form
+func bundleExercise(exercise codelabdb.Exercise, tests []codelabdb.Test) bundle.Exercise {
+	bundleTests := make([]bundle.Test, len(tests))
+	for i, test := range tests {
+		bundleTests[i] = bundle.Test{
+			Input:       test.Input,
+			Output:      test.Output,
+			Public:      test.Public,
+			Weight:      test.Weight,
+			InputFormat: test.InputFormat,
+			Comparison:  test.Comparison,
+			Tolerance:   test.Tolerance,
+		}
+	}
+
+	return bundle.Exercise{
+		Title:       exercise.Title,
+		Description: exercise.Description,
+		Difficulty:  exercise.Difficulty,
+		Language:    exercise.Language,
+		Tags:        exercise.Tags,
+		InitialCode: exercise.InitialCode,
+		Solution:    exercise.Solution,
+		Tests:       bundleTests,
+	}
+}
+
+// bundleTestCase builds the sandbox test case for a test of a bundle
+func bundleTestCase(test bundle.Test) sandbox.TestCase {
+	return sandbox.TestCase{
+		Input:       test.Input,
+		Expected:    test.Output,
+		InputFormat: sandbox.InputFormat(test.InputFormat),
+		Compare:     sandbox.CompareMode(test.Comparison),
+		Tolerance:   test.Tolerance,
+	}
+}
+
+// Limits on the tags of an exercise
+const (
+	maxTags      = 10
+	maxTagLength = 30
+)
+
+// normalizeTags lowercases tags, joins their words with dashes and drops
+// duplicates, so every topic is spelled the same way across exercises. Tags
+// are returned sorted.
+func normalizeTags(tags []string) ([]string, error) {
+	normalized := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		tag = strings.Join(strings.Fields(strings.ToLower(tag)), "-")
+		if tag == "" {
+			return nil, errors.New("tags cannot be empty")
+		}
+		if utf8.RuneCountInString(tag) > maxTagLength {
+			return nil, fmt.Errorf("tag %q is longer than %d characters", tag, maxTagLength)
+		}
+		for _, r := range tag {
+			if !unicode.IsLetter(r) && !unicode.IsDigit(r) && !strings.ContainsRune("-+#.", r) {
+				return nil, fmt.Errorf("tag %q can only contain letters, digits and -+#.", tag)
+			}
+		}
+		if !slices.Contains(normalized, tag) {
+			normalized = append(normalized, tag)
+		}
+	}
+	if len(normalized) > maxTags {
+		return nil, fmt.Errorf("an exercise can have at most %d tags", maxTags)
+	}
+	slices.Sort(normalized)
+	return normalized, nil
+}
+
+// exerciseTags returns the tags of a stored exercise, never nil so they are
+// encoded as an empty list
+func exerciseTags(tags []string) []string {
+	if tags == nil {
+		return []string{}
+	}
+	return tags
+}
+
+// encodeCursor builds the opaque cursor of the page that follows the
+// exercise created at createdAt with the given ID
+func encodeCursor(createdAt time.Time, id int64) string {
+	return base64.RawURLEncoding.EncodeToString(fmt.Appendf(nil, "%d:%d", createdAt.UnixMicro(), id))
+}
+
+// decodeCursor returns the position encoded by encodeCursor, as the columns
+// of the last exercise of the previous page. A missing cursor starts from the
+// newest exercise.
+func decodeCursor(cursor *string) (pgtype.Timestamptz, pgtype.Int8, error) {
+	if cursor == nil || *cursor == "" {
+		return pgtype.Timestamptz{}, pgtype.Int8{}, nil
+	}
+
+	data, err := base64.RawURLEncoding.DecodeString(*cursor)
+	if err != nil {
+		return pgtype.Timestamptz{}, pgtype.Int8{}, codelab.InvalidInput("Invalid cursor")
+	}
+	var micros, id int64
+	if _, err := fmt.Sscanf(string(data), "%d:%d", &micros, &id); err != nil {
+		return pgtype.Timestamptz{}, pgtype.Int8{}, codelab.InvalidInput("Invalid cursor")
+	}
+	return pgtype.Timestamptz{Time: time.UnixMicro(micros), Valid: true}, pgtype.Int8{Int64: id, Valid: true}, nil
+}
+
+// exerciseFilters are the filters shared by the exercise listings, converted
+// to query parameters
+type exerciseFilters struct {
+	search         pgtype.Text
+	difficulty     pgtype.Text
+	tags           []string
+	createdBy      pgtype.Int8
+	afterCreatedAt pgtype.Timestamptz
+	afterID        pgtype.Int8
+	// One more row than the page holds, to tell whether another page follows
+	rowLimit int32
+}
+
+// newExerciseFilters validates the filters of an exercise listing
+func newExerciseFilters(search, difficulty *string, tags []string, createdBy *int64, cursor *string, limit int) (exerciseFilters, error) {
+	var filters exerciseFilters
+	if search != nil && strings.TrimSpace(*search) != "" {
+		filters.search = pgtype.Text{String: *search, Valid: true}
+	}
+	if difficulty != nil {
+		filters.difficulty = pgtype.Text{String: *difficulty, Valid: true}
+	}
+	if createdBy != nil {
+		filters.createdBy = pgtype.Int8{Int64: *createdBy, Valid: true}
+	}
+
+	var err error
+	if filters.tags, err = normalizeTags(tags); err != nil {
+		return exerciseFilters{}, codelab.InvalidInput("Invalid tags: " + err.Error())
+	}
+	if filters.afterCreatedAt, filters.afterID, err = decodeCursor(cursor); err != nil {
+		return exerciseFilters{}, err
+	}
+	filters.rowLimit = int32(limit) + 1
+	return filters, nil
+}
+
+// exerciseSnapshot converts a stored exercise and its tests to the snapshot
+// kept for one of its versions
+func exerciseSnapshot(exercise codelabdb.Exercise, tests []codelabdb.Test) versioning.Snapshot {
+	snapshotTests := make([]versioning.Test, len(tests))
+	for i, test := range tests {
+		snapshotTests[i] = versioning.Test{
+			ID:          test.ID,
+			Input:       test.Input,
+			Output:      test.Output,
+			Public:      test.Public,
+			Weight:      test.Weight,
+			InputFormat: test.InputFormat,
+			Comparison:  test.Comparison,
+			Tolerance:   test.Tolerance,
+		}
+	}
+
+	return versioning.Snapshot{
+		Title:       exercise.Title,
+		Description: exercise.Description,
+		InitialCode: exercise.InitialCode,
+		Solution:    exercise.Solution,
+		Difficulty:  exercise.Difficulty,
+		Language:    exercise.Language,
+		Tags:        exercise.Tags,
+		Tests:       snapshotTests,
+	}
+}
+
+// snapshotTestCases builds the sandbox test cases for the tests of a version
+func snapshotTestCases(tests []versioning.Test) []sandbox.TestCase {
+	cases := make([]sandbox.TestCase, len(tests))
+	for i, test := range tests {
+		cases[i] = sandbox.TestCase{
+			Input:       test.Input,
+			Expected:    test.Output,
+			InputFormat: sandbox.InputFormat(test.InputFormat),
+			Compare:     sandbox.CompareMode(test.Comparison),
+			Tolerance:   test.Tolerance,
+		}
+	}
+	return cases
+}
+
+// saveExerciseVersion stores an exercise and its tests, as seen by the
+// transaction of txRepos, as the snapshot of the version the exercise is at
+func saveExerciseVersion(ctx context.Context, txRepos *repositories.TransactionalRepositories, exercise codelabdb.Exercise, userID int64) error {
+	tests, err := txRepos.TestsRepo.GetTestsByExercise(ctx, exercise.ID)
+	if err != nil {
+		return fmt.Errorf("failed to get tests: %w", err)
+	}
+
+	snapshot, err := versioning.Encode(exerciseSnapshot(exercise, tests))
+	if err != nil {
+		return fmt.Errorf("failed to encode version: %w", err)
+	}
+
+	err = txRepos.ExercisesRepo.CreateExerciseVersion(ctx, codelabdb.CreateExerciseVersionParams{
+		ExerciseID: exercise.ID,
+		Version:    exercise.Version,
+		Snapshot:   snapshot,
+		CreatedBy:  userID,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to save version %d: %w", exercise.Version, err)
+	}
+	return nil
+}
+
+// newExerciseVersion saves the changes made to an exercise or its tests
+// within the transaction of txRepos as a new version of the exercise
+func newExerciseVersion(ctx context.Context, txRepos *repositories.TransactionalRepositories, exerciseID, userID int64) error {
+	exercise, err := txRepos.ExercisesRepo.BumpExerciseVersion(ctx, exerciseID)
+	if err != nil {
+		return fmt.Errorf("failed to bump exercise version: %w", err)
+	}
+	return saveExerciseVersion(ctx, txRepos, exercise, userID)
+}
+
+// loadExerciseVersion returns the snapshot of a version of an exercise
+func (s *codelabsvrc) loadExerciseVersion(ctx context.Context, exerciseID int64, version int32) (versioning.Snapshot, error) {
+	row, err := s.exercisesRepo.GetExerciseVersion(ctx, codelabdb.GetExerciseVersionParams{
+		ExerciseID: exerciseID,
+		Version:    version,
+	})
+	if err != nil {
+		return versioning.Snapshot{}, codelab.NotFound(fmt.Sprintf("Version %d not found", version))
+	}
+
+	snapshot, err := versioning.Decode(row.Snapshot)
+	if err != nil {
+		return versioning.Snapshot{}, codelab.InternalError(fmt.Sprintf("Failed to read version %d: %s", version, err.Error()))
+	}
+	return snapshot, nil
+}
+
+// restoreExerciseVersion makes an exercise and its tests match a snapshot
+// within the transaction of txRepos. Tests that still exist are updated in
+// place, so the results of past attempts stay linked to them, and the rest
+// are recreated.
+func restoreExerciseVersion(ctx context.Context, txRepos *repositories.TransactionalRepositories, exerciseID int64, snapshot versioning.Snapshot) error {
+	err := txRepos.ExercisesRepo.UpdateExercise(ctx, codelabdb.UpdateExerciseParams{
+		ID:          exerciseID,
+		Title:       snapshot.Title,
+		Description: snapshot.Description,
+		InitialCode: snapshot.InitialCode,
+		Solution:    snapshot.Solution,
+		Difficulty:  snapshot.Difficulty,
+		Language:    snapshot.Language,
+		Tags:        exerciseTags(snapshot.Tags),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to restore exercise: %w", err)
+	}
+
+	tests, err := txRepos.TestsRepo.GetTestsByExercise(ctx, exerciseID)
+	if err != nil {
+		return fmt.Errorf("failed to get tests: %w", err)
+	}
+	restored := make(map[int64]bool, len(snapshot.Tests))
+	for _, test := range snapshot.Tests {
+		restored[test.ID] = true
+	}
+	existing := make(map[int64]bool, len(tests))
+	for _, test := range tests {
+		existing[test.ID] = true
+		if restored[test.ID] {
+			continue
+		}
+		if err := txRepos.TestsRepo.DeleteTest(ctx, test.ID); err != nil {
+			return fmt.Errorf("failed to delete test %d: %w", test.ID, err)
+		}
+	}
+
+	for _, test := range snapshot.Tests {
+		if existing[test.ID] {
+			err = txRepos.TestsRepo.UpdateTest(ctx, codelabdb.UpdateTestParams{
+				ID:          test.ID,
+				Input:       test.Input,
+				Output:      test.Output,
+				Public:      test.Public,
+				Weight:      test.Weight,
+				InputFormat: test.InputFormat,
+				Comparison:  test.Comparison,
+				Tolerance:   test.Tolerance,
+			})
+		} else {
+			err = txRepos.TestsRepo.CreateTest(ctx, codelabdb.CreateTestParams{
+				Input:       test.Input,
+				Output:      test.Output,
+				Public:      test.Public,
+				Weight:      test.Weight,
+				InputFormat: test.InputFormat,
+				Comparison:  test.Comparison,
+				Tolerance:   test.Tolerance,
+				ExerciseID:  exerciseID,
+			})
+		}
+		if err != nil {
+			return fmt.Errorf("failed to restore test %d: %w", test.ID, err)
+		}
+	}
+	return nil
+}
+
+// fieldChangesToAPI converts the fields changed between two versions to
+// their API form
+func fieldChangesToAPI(changes []versioning.Change) []*codelab.FieldChange {
+	result := make([]*codelab.FieldChange, len(changes))
+	for i, change := range changes {
+		result[i] = &codelab.FieldChange{
+			Field: change.Field,
+			From:  change.From,
+			To:    change.To,
+		}
+	}
+	return result
+}
+
+// versionDiffToAPI converts what changed between two versions to its API
+// form
+func versionDiffToAPI(exerciseID int64, from, to int32, diff versioning.Diff) *codelab.ExerciseVersionDiff {
+	tests := make([]*codelab.TestChange, len(diff.Tests))
+	for i, test := range diff.Tests {
+		tests[i] = &codelab.TestChange{
+			TestID: test.TestID,
+			Change: string(test.Kind),
+			Fields: fieldChangesToAPI(test.Changes),
+		}
+	}
+
+	return &codelab.ExerciseVersionDiff{
+		ExerciseID:  exerciseID,
+		FromVersion: from,
+		ToVersion:   to,
+		Fields:      fieldChangesToAPI(diff.Changes),
+		Tests:       tests,
+	}
+}
+
+// attemptScore adds up the weight of the tests an attempt passed. The score is
+// the percentage of the total weight earned, rounded to two decimals. Without
+// tests the score only depends on whether the attempt passed.
+func attemptScore(tests []codelabdb.Test, result *sandbox.Result) (points, maxPoints int32, score float64) {
+	for i, test := range tests {
+		maxPoints += test.Weight
+		// Cases are missing when the whole run was cut short
+		if i < len(result.Cases) && result.Cases[i].Status == sandbox.StatusPassed {
+			points += test.Weight
+		}
+	}
+
+	switch {
+	case maxPoints > 0:
+		score = percentage(int64(points), int64(maxPoints))
+	case result.Status == sandbox.StatusPassed:
+		score = 100
+	}
+	return points, maxPoints, score
+}
+
+// percentage returns part as a percentage of whole rounded to two decimals,
+// or zero when whole is zero
+func percentage(part, whole int64) float64 {
+	if whole == 0 {
+		return 0
+	}
+	return math.Round(float64(part)*10000/float64(whole)) / 100
+}
+
+// groupAttemptsByUser maps the attempts of an exercise to the user that made
+// them, oldest first. Rows are listed newest first.
+func groupAttemptsByUser(rows []codelabdb.GetAttemptsWithAnswerInfoRow) map[int64][]codelabdb.Attempt {
+	grouped := make(map[int64][]codelabdb.Attempt)
+	for i := len(rows) - 1; i >= 0; i-- {
+		row := rows[i]
+		grouped[row.UserID] = append(grouped[row.UserID], codelabdb.Attempt{
+			ID:        row.ID,
+			AnswerID:  row.AnswerID,
+			Code:      row.Code,
+			Success:   row.Success,
+			Status:    row.Status,
+			Points:    row.Points,
+			MaxPoints: row.MaxPoints,
+			Score:     row.Score,
+			Late:      row.Late,
+			CreatedAt: row.CreatedAt,
+		})
+	}
+	return grouped
+}
+
+// studentStatus summarizes the progress of a student from their answer and
+// their attempts, oldest first
+func studentStatus(answer codelabdb.Answer, attempts []codelabdb.Attempt) *codelab.StudentExerciseStatus {
+	status := &codelab.StudentExerciseStatus{
+		UserID:    answer.UserID,
+		Status:    "in_progress",
+		Attempts:  int64(len(attempts)),
+		BestScore: answer.BestScore,
+		HintsUsed: answer.HintsUsed,
+	}
+	switch {
+	case answer.Completed:
+		status.Status = "completed"
+	case len(attempts) == 0:
+		status.Status = "not_started"
+	}
+	if len(attempts) == 0 {
+		return status
+	}
+
+	first := attempts[0].CreatedAt.Time
+	last := attempts[len(attempts)-1].CreatedAt.Time
+	status.FirstAttemptAt = ptr(first.UnixMilli())
+	status.LastAttemptAt = ptr(last.UnixMilli())
+
+	for i, attempt := range attempts {
+		if !attempt.Success {
+			continue
+		}
+		completed := attempt.CreatedAt.Time
+		status.AttemptsToSuccess = ptr(int64(i + 1))
+		status.CompletedAt = ptr(completed.UnixMilli())
+		status.TimeToCompletionMs = ptr(completed.Sub(first).Milliseconds())
+		break
+	}
+	return status
+}
+
+// completionMedians returns the median attempts and time students needed to
+// pass, both missing until a student passes
+func completionMedians(students []*codelab.StudentExerciseStatus) (attempts *float64, timeMs *int64) {
+	var attemptCounts, durations []float64
+	for _, student := range students {
+		if student.AttemptsToSuccess == nil {
+			continue
+		}
+		attemptCounts = append(attemptCounts, float64(*student.AttemptsToSuccess))
+		durations = append(durations, float64(*student.TimeToCompletionMs))
+	}
+	if len(attemptCounts) == 0 {
+		return nil, nil
+	}
+	return ptr(median(attemptCounts)), ptr(int64(median(durations)))
+}
+
+// median returns the median of a non empty list of values
+func median(values []float64) float64 {
+	sorted := slices.Clone(values)
+	slices.Sort(sorted)
+	middle := len(sorted) / 2
+	if len(sorted)%2 == 1 {
+		return sorted[middle]
+	}
+	return (sorted[middle-1] + sorted[middle]) / 2
+}
+
+// ptr returns a pointer to a copy of value
+func ptr[T any](value T) *T {
+	return &value
+}
+
+// attemptFailureMessage describes why an attempt did not pass its tests
+func attemptFailureMessage(result *sandbox.Result, cases []sandbox.TestCase) string {
+	summary := fmt.Sprintf(" (%d of %d tests passed)", result.Passed(), len(cases))
+	for i, c := range result.Cases {
+		switch c.Status {
+		case sandbox.StatusPassed:
+			continue
+		case sandbox.StatusFailed:
+			return "Test failed: expected " + cases[i].Expected + ", got " + c.Output + summary
+		default:
+			return "Test failed: " + c.Error + summary
+		}
+	}
+	return "Test failed: " + result.Error + summary
+}
+
+// testResultParams builds the row stored for the outcome of a test. The
+// expected output is only kept for public tests so hidden ones stay hidden.
+func testResultParams(attemptID int64, test codelabdb.Test, result sandbox.CaseResult) codelabdb.CreateAttemptTestResultParams {
+	return codelabdb.CreateAttemptTestResultParams{
+		AttemptID:      attemptID,
+		TestID:         pgtype.Int8{Int64: test.ID, Valid: true},
+		Status:         string(result.Status),
+		ActualOutput:   result.Output,
+		ExpectedOutput: pgtype.Text{String: test.Output, Valid: test.Public},
+		ErrorMessage:   pgtype.Text{String: result.Error, Valid: result.Error != ""},
+		ConsoleOutput:  result.Console,
+		StackTrace:     pgtype.Text{String: result.Stack, Valid: result.Stack != ""},
+		DurationMs:     int32(result.Duration.Milliseconds()),
+	}
+}
+
+// groupTestResults maps stored test results to their attempt ID
+func groupTestResults(rows []codelabdb.AttemptTestResult) map[int64][]*codelab.AttemptTestResult {
+	grouped := make(map[int64][]*codelab.AttemptTestResult)
+	for _, row := range rows {
+		result := &codelab.AttemptTestResult{
+			Status:        row.Status,
+			ActualOutput:  row.ActualOutput,
+			ConsoleOutput: row.ConsoleOutput,
+			DurationMs:    int64(row.DurationMs),
+		}
+		if row.TestID.Valid {
+			result.TestID = &row.TestID.Int64
+		}
+		if row.ExpectedOutput.Valid {
+			result.ExpectedOutput = &row.ExpectedOutput.String
+		}
+		if row.ErrorMessage.Valid {
+			result.Error = &row.ErrorMessage.String
+		}
+		if row.StackTrace.Valid {
+			result.StackTrace = &row.StackTrace.String
+		}
+		grouped[row.AttemptID] = append(grouped[row.AttemptID], result)
+	}
+	return grouped
+}
+
+// runCaseResults maps the outcome of a dry run to its response
+func runCaseResults(result *sandbox.Result, cases []sandbox.TestCase) []*codelab.RunCaseResult {
+	results := make([]*codelab.RunCaseResult, len(result.Cases))
+	for i, c := range result.Cases {
+		results[i] = &codelab.RunCaseResult{
+			Input:         cases[i].Input,
+			Status:        string(c.Status),
+			Output:        c.Output,
+			ConsoleOutput: c.Console,
+			DurationMs:    c.Duration.Milliseconds(),
+		}
+		if !cases[i].Unchecked {
+			results[i].TestID = &cases[i].ID
+			results[i].ExpectedOutput = &cases[i].Expected
+		}
+		if c.Error != "" {
+			results[i].Error = &c.Error
+		}
+		if c.Stack != "" {
+			results[i].StackTrace = &c.Stack
+		}
+	}
+	return results
+}
+
+// plagiarismPairToAPI converts a stored plagiarism match to its API form
+func plagiarismPairToAPI(match codelabdb.PlagiarismMatch) (*codelab.PlagiarismPair, error) {
+	var regions []plagiarism.Region
+	if err := json.Unmarshal(match.Regions, &regions); err != nil {
+		return nil, err
+	}
+
+	regionsToAPI := make([]*codelab.PlagiarismRegion, len(regions))
+	for i, region := range regions {
+		regionsToAPI[i] = &codelab.PlagiarismRegion{
+			StartLineA: region.StartA,
+			EndLineA:   region.EndA,
+			StartLineB: region.StartB,
+			EndLineB:   region.EndB,
+		}
+	}
+
+	return &codelab.PlagiarismPair{
+		AttemptAID: match.AttemptAID,
+		UserAID:    match.UserAID,
+		AttemptBID: match.AttemptBID,
+		UserBID:    match.UserBID,
+		Similarity: match.Similarity,
+		Regions:    regionsToAPI,
+	}, nil
+}
+
+// ensureAnswer returns the answer of a student to an exercise, creating it
+// when the student starts the exercise
+func (s *codelabsvrc) ensureAnswer(ctx context.Context, exerciseID, userID int64) (codelabdb.Answer, error) {
+	_, err := s.answersRepo.CheckIfAnswerExists(ctx, codelabdb.CheckIfAnswerExistsParams{
+		ExerciseID: exerciseID,
+		UserID:     userID,
+	})
+	if err != nil {
+		err = s.answersRepo.CreateAnswer(ctx, codelabdb.CreateAnswerParams{
+			ExerciseID: exerciseID,
+			UserID:     userID,
+			Completed:  false,
+		})
+		if err != nil {
+			return codelabdb.Answer{}, codelab.InternalError("Failed to create answer: " + err.Error())
+		}
+	}
+
+	answer, err := s.answersRepo.GetAnswerByUserAndExercise(ctx, codelabdb.GetAnswerByUserAndExerciseParams{
+		ExerciseID: exerciseID,
+		UserID:     userID,
+	})
+	if err != nil {
+		return codelabdb.Answer{}, codelab.InternalError("Failed to get answer: " + err.Error())
+	}
+	return answer, nil
+}
+
+// optionalInt4 converts an optional payload field to a nullable column
+func optionalInt4(value *int32) pgtype.Int4 {
+	if value == nil {
+		return pgtype.Int4{}
+	}
+	return pgtype.Int4{Int32: *value, Valid: true}
+}
+
+// hintToAPI converts a stored hint to its API form
+func hintToAPI(hint codelabdb.Hint) *codelab.Hint {
+	result := &codelab.Hint{
+		ID:         hint.ID,
+		ExerciseID: hint.ExerciseID,
+		Position:   hint.Position,
+		Content:    hint.Content,
+		Penalty:    hint.Penalty,
+		CreatedAt:  hint.CreatedAt.Time.UnixMilli(),
+		UpdatedAt:  hint.UpdatedAt.Time.UnixMilli(),
+	}
+	if hint.UnlockAfterAttempts.Valid {
+		result.UnlockAfterAttempts = &hint.UnlockAfterAttempts.Int32
+	}
+	if hint.UnlockAfterSeconds.Valid {
+		result.UnlockAfterSeconds = &hint.UnlockAfterSeconds.Int32
+	}
+	return result
+}
+
+// hintProgress is what unlocks the hints of a student: their failed attempts,
+// when they started the exercise and the hints they already revealed. It is
+// the zero value for students that have not started the exercise.
+type hintProgress struct {
+	failedAttempts int32
+	startedAt      time.Time
+	revealed       map[int64]time.Time
+}
+
+// loadHintProgress loads the progress of the student owning answer
+func (s *codelabsvrc) loadHintProgress(ctx context.Context, answer codelabdb.Answer) (hintProgress, error) {
+	usages, err := s.hintsRepo.GetHintUsagesByAnswer(ctx, answer.ID)
+	if err != nil {
+		return hintProgress{}, codelab.InternalError("Failed to get hint usages: " + err.Error())
+	}
+
+	attempts, err := s.attemptsRepo.GetAttemptsByAnswer(ctx, answer.ID)
+	if err != nil {
+		return hintProgress{}, codelab.InternalError("Failed to get attempts: " + err.Error())
+	}
+
+	progress := hintProgress{
+		startedAt: answer.CreatedAt.Time,
+		revealed:  make(map[int64]time.Time, len(usages)),
+	}
+	for _, usage := range usages {
+		progress.revealed[usage.HintID] = usage.RevealedAt.Time
+	}
+	for _, attempt := range attempts {
+		if !attempt.Success && attempt.Status != attemptPending {
+			progress.failedAttempts++
+		}
+	}
+	return progress, nil
+}
+
+// studentHints lists the hints of an exercise as seen by a student. Hints are
+// revealed in order, so only the first hint not revealed yet can be available.
+func studentHints(hints []codelabdb.Hint, progress hintProgress, now time.Time) []*codelab.StudentHint {
+	result := make([]*codelab.StudentHint, len(hints))
+	next := true
+	for i, hint := range hints {
+		result[i] = studentHint(hint, progress, now, next)
+		if result[i].Status != "revealed" {
+			next = false
+		}
+	}
+	return result
+}
+
+// studentHint describes a hint to a student. A hint without unlock rules is
+// unlocked from the start, otherwise it unlocks once any of its rules is met.
+func studentHint(hint codelabdb.Hint, progress hintProgress, now time.Time, next bool) *codelab.StudentHint {
+	result := &codelab.StudentHint{
+		ID:       hint.ID,
+		Position: hint.Position,
+		Status:   "locked",
+		Penalty:  hint.Penalty,
+	}
+	if revealedAt, ok := progress.revealed[hint.ID]; ok {
+		result.Status = "revealed"
+		result.Content = &hint.Content
+		result.RevealedAt = ptr(revealedAt.UnixMilli())
+		return result
+	}
+
+	unlocked := !hint.UnlockAfterAttempts.Valid && !hint.UnlockAfterSeconds.Valid
+	if hint.UnlockAfterAttempts.Valid {
+		remaining := max(hint.UnlockAfterAttempts.Int32-progress.failedAttempts, 0)
+		result.AttemptsRemaining = ptr(remaining)
+		unlocked = unlocked || remaining == 0
+	}
+	// The delay only runs once the student starts the exercise
+	if hint.UnlockAfterSeconds.Valid && !progress.startedAt.IsZero() {
+		unlocksAt := progress.startedAt.Add(time.Duration(hint.UnlockAfterSeconds.Int32) * time.Second)
+		result.UnlocksAt = ptr(unlocksAt.UnixMilli())
+		unlocked = unlocked || !now.Before(unlocksAt)
+	}
+
+	if next && unlocked {
+		result.Status = "available"
+	}
+	return result
+}
+
+// lockedHintMessage explains when the next hint of a student unlocks
+func lockedHintMessage(hint *codelab.StudentHint) string {
+	var rules []string
+	if hint.AttemptsRemaining != nil {
+		rules = append(rules, fmt.Sprintf("after %d more failed attempts", *hint.AttemptsRemaining))
+	}
+	if hint.UnlocksAt != nil {
+		rules = append(rules, "at "+time.UnixMilli(*hint.UnlocksAt).UTC().Format(time.RFC3339))
+	}
+	if len(rules) == 0 {
+		return "Next hint is locked"
+	}
+	return "Next hint unlocks " + strings.Join(rules, " or ")
+}
+
+// timestamptz converts a millisecond timestamp of a payload to a column
+func timestamptz(ms int64) pgtype.Timestamptz {
+	return pgtype.Timestamptz{Time: time.UnixMilli(ms), Valid: true}
+}
+
+// assignmentStatus tells whether an assignment has not opened yet, is open or
+// has closed at now
+func assignmentStatus(assignment codelabdb.Assignment, now time.Time) string {
+	switch {
+	case now.Before(assignment.OpensAt.Time):
+		return "upcoming"
+	case now.Before(assignment.ClosesAt.Time):
+		return "open"
+	default:
+		return "closed"
+	}
+}
+
+// attemptDeadline checks whether a student can attempt an exercise at now
+// given the assignments it belongs to, and whether the attempt is late. An
+// exercise outside of every assignment can always be attempted, otherwise it
+// is enough for one of its assignments to be open or to accept late attempts.
+func attemptDeadline(assignments []codelabdb.Assignment, now time.Time) (late bool, err error) {
+	if len(assignments) == 0 {
+		return false, nil
+	}
+
+	var upcoming, closed *codelabdb.Assignment
+	for i, assignment := range assignments {
+		switch assignmentStatus(assignment, now) {
+		case "open":
+			return false, nil
+		case "upcoming":
+			upcoming = &assignments[i]
+		default:
+			if assignment.AllowLate {
+				late = true
+			}
+			closed = &assignments[i]
+		}
+	}
+
+	switch {
+	case late:
+		return true, nil
+	case upcoming != nil:
+		return false, codelab.PermissionDenied(fmt.Sprintf("Assignment %q opens at %s",
+			upcoming.Title, upcoming.OpensAt.Time.UTC().Format(time.RFC3339)))
+	default:
+		return false, codelab.PermissionDenied(fmt.Sprintf("Assignment %q closed at %s, late attempts are not accepted",
+			closed.Title, closed.ClosesAt.Time.UTC().Format(time.RFC3339)))
+	}
+}
+
+// validateAssignment checks the schedule and the exercises of an assignment
+func (s *codelabsvrc) validateAssignment(ctx context.Context, opensAt, closesAt int64, exerciseIDs []int64) error {
+	if closesAt <= opensAt {
+		return codelab.InvalidInput("Assignment must close after it opens")
+	}
+
+	seen := make(map[int64]bool, len(exerciseIDs))
+	for _, exerciseID := range exerciseIDs {
+		if seen[exerciseID] {
+			return codelab.InvalidInput(fmt.Sprintf("Exercise %d is listed more than once", exerciseID))
+		}
+		seen[exerciseID] = true
+
+		_, err := s.exercisesRepo.GetExerciseById(ctx, exerciseID)
+		if err != nil {
+			return codelab.NotFound(fmt.Sprintf("Exercise %d not found", exerciseID))
+		}
+	}
+	return nil
+}
+
+// addAssignmentExercises adds exercises to an assignment in the given order
+func addAssignmentExercises(ctx context.Context, repo ports.AssignmentsRepository, assignmentID int64, exerciseIDs []int64) error {
+	for i, exerciseID := range exerciseIDs {
+		err := repo.AddAssignmentExercise(ctx, codelabdb.AddAssignmentExerciseParams{
+			AssignmentID: assignmentID,
+			ExerciseID:   exerciseID,
+			Position:     int32(i + 1),
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// assignmentToAPI converts a stored assignment and its exercises to their API
+// form
+func assignmentToAPI(assignment codelabdb.Assignment, exercises []codelabdb.GetAssignmentExercisesRow, now time.Time) *codelab.Assignment {
+	exercisesToAPI := make([]*codelab.AssignmentExercise, len(exercises))
+	for i, exercise := range exercises {
+		exercisesToAPI[i] = &codelab.AssignmentExercise{
+			ExerciseID: exercise.ID,
+			Position:   exercise.Position,
+			Title:      exercise.Title,
+			Difficulty: exercise.Difficulty,
+			Language:   exercise.Language,
+		}
+	}
+
+	return &codelab.Assignment{
+		ID:          assignment.ID,
+		Title:       assignment.Title,
+		Description: assignment.Description,
+		OpensAt:     assignment.OpensAt.Time.UnixMilli(),
+		ClosesAt:    assignment.ClosesAt.Time.UnixMilli(),
+		AllowLate:   assignment.AllowLate,
+		Status:      assignmentStatus(assignment, now),
+		Exercises:   exercisesToAPI,
+		CreatedBy:   assignment.CreatedBy,
+		CreatedAt:   assignment.CreatedAt.Time.UnixMilli(),
+		UpdatedAt:   assignment.UpdatedAt.Time.UnixMilli(),
+	}
+}
+
+// loadAssignment loads an assignment with its exercises in their API form
+func (s *codelabsvrc) loadAssignment(ctx context.Context, id int64) (*codelab.Assignment, error) {
+	assignment, err := s.assignmentsRepo.GetAssignmentById(ctx, id)
+	if err != nil {
+		return nil, codelab.NotFound("Assignment not found")
+	}
+
+	exercises, err := s.assignmentsRepo.GetAssignmentExercises(ctx, id)
+	if err != nil {
+		return nil, codelab.InternalError("Failed to get assignment exercises: " + err.Error())
+	}
+
+	return assignmentToAPI(assignment, exercises, time.Now()), nil
+}
+
+// listAssignments lists every assignment with its exercises in their API
+// form, loading the exercises of all of them at once
+func (s *codelabsvrc) listAssignments(ctx context.Context) ([]*codelab.Assignment, error) {
+	assignments, err := s.assignmentsRepo.ListAssignments(ctx)
+	if err != nil {
+		return nil, codelab.InternalError("Failed to list assignments: " + err.Error())
+	}
+
+	rows, err := s.assignmentsRepo.ListAssignmentExercises(ctx)
+	if err != nil {
+		return nil, codelab.InternalError("Failed to get assignment exercises: " + err.Error())
+	}
+	// Rows come ordered by position within each assignment
+	exercises := make(map[int64][]codelabdb.GetAssignmentExercisesRow, len(assignments))
+	for _, row := range rows {
+		exercises[row.AssignmentID] = append(exercises[row.AssignmentID], codelabdb.GetAssignmentExercisesRow{
+			Position:   row.Position,
+			ID:         row.ID,
+			Title:      row.Title,
+			Difficulty: row.Difficulty,
+			Language:   row.Language,
+		})
+	}
+
+	now := time.Now()
+	result := make([]*codelab.Assignment, len(assignments))
+	for i, assignment := range assignments {
+		result[i] = assignmentToAPI(assignment, exercises[assignment.ID], now)
+	}
+	return result, nil
+}
+
+// assignmentGrade summarizes how a student did on every exercise of an
+// assignment from their grade rows. Exercises the student never started count
+// as a zero in the averages.
+func assignmentGrade(userID int64, exercises []*codelab.AssignmentExercise, rows []codelabdb.GetAssignmentGradesRow) *codelab.StudentAssignmentGrade {
+	byExercise := make(map[int64]codelabdb.GetAssignmentGradesRow, len(rows))
+	for _, row := range rows {
+		byExercise[row.ExerciseID] = row
+	}
+
+	grade := &codelab.StudentAssignmentGrade{
+		UserID:         userID,
+		TotalExercises: int64(len(exercises)),
+		Exercises:      make([]*codelab.ExerciseGrade, len(exercises)),
+	}
+	var total, totalWithLate float64
+	for i, exercise := range exercises {
+		row := byExercise[exercise.ExerciseID]
+		exerciseGrade := &codelab.ExerciseGrade{
+			ExerciseID:        exercise.ExerciseID,
+			Status:            "not_started",
+			Attempts:          row.Attempts,
+			LateAttempts:      row.LateAttempts,
+			BestScore:         row.BestScore,
+			BestScoreWithLate: row.BestScoreWithLate,
+		}
+		switch {
+		case row.Completed:
+			exerciseGrade.Status = "completed"
+			grade.CompletedExercises++
+		case row.CompletedWithLate:
+			exerciseGrade.Status = "completed_late"
+		case row.Attempts > 0:
+			exerciseGrade.Status = "in_progress"
+		}
+		total += row.BestScore
+		totalWithLate += row.BestScoreWithLate
+		grade.Exercises[i] = exerciseGrade
+	}
+
+	if len(exercises) > 0 {
+		grade.Score = math.Round(total*100/float64(len(exercises))) / 100
+		grade.ScoreWithLate = math.Round(totalWithLate*100/float64(len(exercises))) / 100
+	}
+	return grade
+}
+
+// attemptPending is the status of an attempt waiting to be graded in the
+// background
+const attemptPending = "pending"
+
+// attemptGrading is what grading an attempt of a student needs
+type attemptGrading struct {
+	userID   int64
+	answer   codelabdb.Answer
+	language string
+	version  int32
+	tests    []codelabdb.Test
+	cases    []sandbox.TestCase
+	late     bool
+}
+
+// prepareAttempt checks a student can attempt an exercise now and loads what
+// grading the attempt needs, starting the exercise for the student if needed
+func (s *codelabsvrc) prepareAttempt(ctx context.Context, exerciseID, userID int64) (*attemptGrading, error) {
+	exercise, err := s.exercisesRepo.GetExerciseToResolveById(ctx, exerciseID)
+	if err != nil {
+		return nil, codelab.NotFound("Exercise not found")
+	}
+
+	assignments, err := s.assignmentsRepo.GetAssignmentsByExercise(ctx, exercise.ID)
+	if err != nil {
+		return nil, codelab.InternalError("Failed to get assignments: " + err.Error())
+	}
+	late, err := attemptDeadline(assignments, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	answer, err := s.ensureAnswer(ctx, exercise.ID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Get every test of the exercise for validation
+	tests, err := s.testsRepo.GetTestsByExercise(ctx, exercise.ID)
+	if err != nil {
+		return nil, codelab.InternalError("Failed to get tests: " + err.Error())
+	}
+
+	return &attemptGrading{
+		userID:   userID,
+		answer:   answer,
+		language: exercise.Language,
+		version:  exercise.Version,
+		tests:    tests,
+		cases:    testCases(tests),
+		late:     late,
+	}, nil
+}
+
+// score grades the result of an attempt. Revealed hints take points off the
+// score, never below zero.
+func (g *attemptGrading) score(result *sandbox.Result) (points, maxPoints int32, score float64) {
+	points, maxPoints, score = attemptScore(g.tests, result)
+	return points, maxPoints, max(score-g.answer.HintPenalty, 0)
+}
+
+// message summarizes the result of an attempt for the student
+func (g *attemptGrading) message(result *sandbox.Result) string {
+	return attemptMessage(result, g.cases, g.late)
+}
+
+// attemptMessage summarizes the result of an attempt for the student. Late
+// attempts are graded like any other, the gradebook tells them apart.
+func attemptMessage(result *sandbox.Result, cases []sandbox.TestCase, late bool) string {
+	message := "All tests passed successfully"
+	if result.Status != sandbox.StatusPassed {
+		message = attemptFailureMessage(result, cases)
+	}
+	if late {
+		message += " (submitted after the deadline)"
+	}
+	return message
+}
+
+// saveAttemptResults stores the outcome of every test of a graded attempt and
+// updates the answer of the student with it
+func (s *codelabsvrc) saveAttemptResults(ctx context.Context, g *attemptGrading, attemptID int64, result *sandbox.Result, score float64) error {
+	// Cases are reported in the same order as the tests they ran
+	for i, caseResult := range result.Cases {
+		err := s.attemptsRepo.CreateAttemptTestResult(ctx, testResultParams(attemptID, g.tests[i], caseResult))
+		if err != nil {
+			return codelab.InternalError("Failed to save test result: " + err.Error())
+		}
+	}
+
+	// Only kept when the attempt beats the best score of the answer
+	err := s.answersRepo.UpdateAnswerBestScore(ctx, codelabdb.UpdateAnswerBestScoreParams{
+		ID:            g.answer.ID,
+		BestScore:     score,
+		BestAttemptID: pgtype.Int8{Int64: attemptID, Valid: true},
+	})
+	if err != nil {
+		return codelab.InternalError("Failed to update answer score: " + err.Error())
+	}
+
+	if result.Status != sandbox.StatusPassed {
+		return nil
+	}
+
+	err = s.answersRepo.UpdateAnswerCompleted(ctx, codelabdb.UpdateAnswerCompletedParams{
+		ExerciseID: g.answer.ExerciseID,
+		UserID:     g.userID,
+		Completed:  true,
+	})
+	if err != nil {
+		return codelab.InternalError("Failed to update answer completion: " + err.Error())
+	}
+	return nil
+}
+
+// gradeAttempt grades a submitted attempt in the background, publishing the
+// outcome of every test as soon as it is known
+func (s *codelabsvrc) gradeAttempt(ctx context.Context, g *attemptGrading, attemptID int64, code string) {
+	total := len(g.cases)
+	s.broker.Publish(attemptID, grading.Event{Type: grading.EventRunning, Total: total})
+
+	result, err := s.executor.RunWithProgress(ctx, sandbox.Language(g.language), code, g.cases, func(i int, result sandbox.CaseResult) {
+		event := grading.Event{Type: grading.EventTest, Total: total, Index: i, Result: result}
+		// Hidden tests keep their expected output hidden
+		if g.tests[i].Public {
+			event.Expected = &g.tests[i].Output
+		}
+		s.broker.Publish(attemptID, event)
+	})
+	// A run cut short by the queue stopping is not graded, but it is still
+	// closed so the attempt does not stay pending
+	interrupted := ctx.Err() != nil
+	ctx = context.WithoutCancel(ctx)
+	if interrupted {
+		s.failAttempt(ctx, attemptID, total, attemptInterruptedMessage)
+		return
+	}
+	if err != nil {
+		s.failAttempt(ctx, attemptID, total, serviceErrorMessage(executionError(err)))
+		return
+	}
+
+	points, maxPoints, score := g.score(result)
+	success := result.Status == sandbox.StatusPassed
+	message := g.message(result)
+	err = s.attemptsRepo.UpdateAttemptResult(ctx, codelabdb.UpdateAttemptResultParams{
+		ID:        attemptID,
+		Success:   success,
+		Status:    string(result.Status),
+		Points:    points,
+		MaxPoints: maxPoints,
+		Score:     score,
+		Message:   message,
+	})
+	if err != nil {
+		s.failAttempt(ctx, attemptID, total, "Failed to save attempt: "+err.Error())
+		return
+	}
+	if err := s.saveAttemptResults(ctx, g, attemptID, result, score); err != nil {
+		s.failAttempt(ctx, attemptID, total, serviceErrorMessage(err))
+		return
+	}
+
+	s.broker.Publish(attemptID, grading.Event{
+		Type:      grading.EventFinished,
+		Total:     total,
+		Status:    string(result.Status),
+		Success:   success,
+		Points:    points,
+		MaxPoints: maxPoints,
+		Score:     score,
+		Message:   message,
+	})
+}
+
+// attemptInterruptedMessage tells the student an attempt was not graded
+// because the service stopped
+const attemptInterruptedMessage = "Grading was interrupted, submit the attempt again"
+
+// failAttempt closes a submitted attempt that could not be graded
+func (s *codelabsvrc) failAttempt(ctx context.Context, attemptID int64, total int, message string) {
+	err := s.attemptsRepo.UpdateAttemptResult(ctx, codelabdb.UpdateAttemptResultParams{
+		ID:      attemptID,
+		Status:  string(sandbox.StatusError),
+		Message: message,
+	})
+	if err != nil {
+		message += " (failed to save attempt: " + err.Error() + ")"
+	}
+
+	s.broker.Publish(attemptID, grading.Event{
+		Type:    grading.EventFinished,
+		Total:   total,
+		Status:  string(sandbox.StatusError),
+		Message: message,
+	})
+}
+
+// dropAttempt removes a submitted attempt that was never graded, so it does
+// not count as a failed attempt, and tells the clients following it why
+func (s *codelabsvrc) dropAttempt(ctx context.Context, attemptID int64, total int, message string) {
+	if err := s.attemptsRepo.DeleteAttempt(ctx, attemptID); err != nil {
+		// Left behind, it is closed as failed like any attempt never graded
+		s.failAttempt(ctx, attemptID, total, message)
+		return
+	}
+
+	s.broker.Publish(attemptID, grading.Event{
+		Type:    grading.EventFinished,
+		Total:   total,
+		Status:  string(sandbox.StatusError),
+		Message: message,
+	})
+}
+
+// FailStalePendingAttempts closes the attempts left pending by a replica that
+// stopped while grading them, which nothing would grade otherwise. It returns
+// how many attempts were closed.
+func FailStalePendingAttempts(ctx context.Context, attemptsRepo ports.AttemptsRepository) (int64, error) {
+	return attemptsRepo.FailStalePendingAttempts(ctx, attemptInterruptedMessage)
+}
+
+// replayAttempt sends the stored outcome of an attempt that is no longer
+// being graded as the events it went through
+func (s *codelabsvrc) replayAttempt(ctx context.Context, attempt codelabdb.Attempt, stream codelab.StreamAttemptEventsServerStream) error {
+	// Pending attempts unknown to the broker are being graded by another
+	// instance, or were lost when it stopped
+	if attempt.Status == attemptPending {
+		return codelab.ServiceUnavailable("Attempt progress is not available, check its result later")
+	}
+
+	rows, err := s.attemptsRepo.GetTestResultsByAttempt(ctx, attempt.ID)
+	if err != nil {
+		return codelab.InternalError("Failed to get test results: " + err.Error())
+	}
+
+	results := groupTestResults(rows)[attempt.ID]
+	total := int64(len(results))
+	for i, result := range results {
+		err := stream.SendWithContext(ctx, &codelab.AttemptEvent{
+			Type:       string(grading.EventTest),
+			AttemptID:  attempt.ID,
+			TotalTests: total,
+			TestIndex:  ptr(int64(i)),
+			TestResult: result,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return stream.SendWithContext(ctx, &codelab.AttemptEvent{
+		Type:       string(grading.EventFinished),
+		AttemptID:  attempt.ID,
+		TotalTests: total,
+		Status:     &attempt.Status,
+		Success:    &attempt.Success,
+		Points:     &attempt.Points,
+		MaxPoints:  &attempt.MaxPoints,
+		Score:      &attempt.Score,
+		Message:    &attempt.Message,
+	})
+}
+
+// attemptEventToAPI converts a grading event to its API form
+func attemptEventToAPI(attemptID int64, event grading.Event) *codelab.AttemptEvent {
+	result := &codelab.AttemptEvent{
+		Type:       string(event.Type),
+		AttemptID:  attemptID,
+		TotalTests: int64(event.Total),
+	}
+
+	switch event.Type {
+	case grading.EventTest:
+		testResult := &codelab.AttemptTestResult{
+			Status:         string(event.Result.Status),
+			ActualOutput:   event.Result.Output,
+			ExpectedOutput: event.Expected,
+			ConsoleOutput:  event.Result.Console,
+			DurationMs:     event.Result.Duration.Milliseconds(),
+		}
+		if event.Result.TestID != 0 {
+			testResult.TestID = ptr(event.Result.TestID)
+		}
+		if event.Result.Error != "" {
+			testResult.Error = ptr(event.Result.Error)
+		}
+		if event.Result.Stack != "" {
+			testResult.StackTrace = ptr(event.Result.Stack)
+		}
+		result.TestIndex = ptr(int64(event.Index))
+		result.TestResult = testResult
+	case grading.EventFinished:
+		result.Status = ptr(event.Status)
+		result.Success = ptr(event.Success)
+		result.Points = ptr(event.Points)
+		result.MaxPoints = ptr(event.MaxPoints)
+		result.Score = ptr(event.Score)
+		result.Message = ptr(event.Message)
+	}
+	return result
+}
+
+// Statuses a regrade ends with, as it is created running
+const (
+	regradeFinished = "finished"
+	regradeFailed   = "failed"
+)
+
+// regradeInterruptedMessage is the error of a regrade that stopped before
+// finishing, as the service shut down
+const regradeInterruptedMessage = "Regrade was interrupted"
+
+// regradeRetries is how many more times a regrade runs an attempt when no
+// execution worker is free, as students keep running code meanwhile.
+// regradeRetryDelay is the wait before the first retry, which grows with
+// every try.
+const (
+	regradeRetries    = 3
+	regradeRetryDelay = 500 * time.Millisecond
+)
+
+// exerciseRegrade is what regrading the attempts of an exercise needs
+type exerciseRegrade struct {
+	id         int64
+	exerciseID int64
+	version    int32
+	language   string
+	tests      []codelabdb.Test
+	cases      []sandbox.TestCase
+	attempts   []codelabdb.GetAttemptsWithAnswerInfoRow
+	// Points taken off every attempt by the hints revealed before it was
+	// made, by attempt ID
+	penalties map[int64]float64
+}
+
+// prepareRegrade loads what regrading the attempts of an exercise against its
+// current tests needs. Attempts still pending are left out, as they are
+// graded against the current tests already.
+func (s *codelabsvrc) prepareRegrade(ctx context.Context, exercise codelabdb.GetExerciseToResolveByIdRow) (*exerciseRegrade, error) {
+	tests, err := s.testsRepo.GetTestsByExercise(ctx, exercise.ID)
+	if err != nil {
+		return nil, codelab.InternalError("Failed to get tests: " + err.Error())
+	}
+
+	rows, err := s.attemptsRepo.GetAttemptsWithAnswerInfo(ctx, exercise.ID)
+	if err != nil {
+		return nil, codelab.InternalError("Failed to get attempts: " + err.Error())
+	}
+	attempts := slices.DeleteFunc(rows, func(attempt codelabdb.GetAttemptsWithAnswerInfoRow) bool {
+		return attempt.Status == attemptPending
+	})
+
+	penalties, err := s.attemptHintPenalties(ctx, exercise.ID, attempts)
+	if err != nil {
+		return nil, err
+	}
+
+	return &exerciseRegrade{
+		exerciseID: exercise.ID,
+		version:    exercise.Version,
+		language:   exercise.Language,
+		tests:      tests,
+		cases:      testCases(tests),
+		attempts:   attempts,
+		penalties:  penalties,
+	}, nil
+}
+
+// attemptHintPenalties returns the points taken off every attempt by the
+// hints its student revealed before making it, added up like
+// RecordAnswerHintUsage does
+func (s *codelabsvrc) attemptHintPenalties(ctx context.Context, exerciseID int64, attempts []codelabdb.GetAttemptsWithAnswerInfoRow) (map[int64]float64, error) {
+	hints, err := s.hintsRepo.GetHintsByExercise(ctx, exerciseID)
+	if err != nil {
+		return nil, codelab.InternalError("Failed to get hints: " + err.Error())
+	}
+	penalties := make(map[int64]float64, len(attempts))
+	if len(hints) == 0 {
+		return penalties, nil
+	}
+
+	hintPenalties := make(map[int64]float64, len(hints))
+	for _, hint := range hints {
+		hintPenalties[hint.ID] = hint.Penalty
+	}
+
+	usages := make(map[int64][]codelabdb.HintUsage)
+	for _, attempt := range attempts {
+		answerUsages, ok := usages[attempt.AnswerID]
+		if !ok {
+			answerUsages, err = s.hintsRepo.GetHintUsagesByAnswer(ctx, attempt.AnswerID)
+			if err != nil {
+				return nil, codelab.InternalError("Failed to get hint usages: " + err.Error())
+			}
+			usages[attempt.AnswerID] = answerUsages
+		}
+
+		var penalty float64
+		for _, usage := range answerUsages {
+			if usage.RevealedAt.Time.Before(attempt.CreatedAt.Time) {
+				penalty += hintPenalties[usage.HintID]
+			}
+		}
+		penalties[attempt.ID] = min(penalty, 100)
+	}
+	return penalties, nil
+}
+
+// runRegrade regrades the attempts of an exercise in the background, spread
+// over the regrade pool, and then updates the answers of their students
+func (s *codelabsvrc) runRegrade(ctx context.Context, r *exerciseRegrade) {
+	err := s.regradePool.Run(ctx, len(r.attempts), func(ctx context.Context, i int) {
+		s.regradeAttempt(ctx, r, r.attempts[i])
+	})
+	if err != nil {
+		s.failRegrade(ctx, r.id, regradeInterruptedMessage)
+		return
+	}
+
+	if err := s.finishRegrade(ctx, r); err != nil {
+		s.failRegrade(ctx, r.id, "Failed to update answers: "+err.Error())
+	}
+}
+
+// regradeAttempt runs an attempt again against the current tests and replaces
+// its outcome. Attempts that cannot be run keep their previous outcome.
+func (s *codelabsvrc) regradeAttempt(ctx context.Context, r *exerciseRegrade, attempt codelabdb.GetAttemptsWithAnswerInfoRow) {
+	progress := codelabdb.AddRegradeProgressParams{ID: r.id}
+	defer func() {
+		// Progress is only informative, the outcome is stored either way
+		_ = s.regradesRepo.AddRegradeProgress(ctx, progress)
+	}()
+
+	result, err := s.runRegradeAttempt(ctx, r, attempt.Code)
+	var codeErr *sandbox.CodeError
+	switch {
+	case err == nil:
+	case errors.Is(err, sandbox.ErrMissingEntryPoint), errors.As(err, &codeErr):
+		// Code that cannot run fails without test results, as when submitted
+		result = nil
+	default:
+		progress.Errored = 1
+		return
+	}
+	// A passing attempt that times out may have been slowed down by other
+	// executions, so it keeps the outcome it had rather than failing
+	if result != nil && result.Status == sandbox.StatusTimeout && attempt.Success {
+		progress.Errored = 1
+		return
+	}
+
+	params := codelabdb.RegradeAttemptParams{
+		ID:              attempt.ID,
+		Status:          string(sandbox.StatusError),
+		ExerciseVersion: r.version,
+	}
+	if err != nil {
+		params.Message = serviceErrorMessage(executionError(err))
+	}
+	if result != nil {
+		points, maxPoints, score := attemptScore(r.tests, result)
+		params.Success = result.Status == sandbox.StatusPassed
+		params.Status = string(result.Status)
+		params.Points = points
+		params.MaxPoints = maxPoints
+		params.Score = max(score-r.penalties[attempt.ID], 0)
+		params.Message = attemptMessage(result, r.cases, attempt.Late)
+	}
+
+	err = s.txManager.WithTransaction(ctx, func(tx pgx.Tx) error {
+		txRepos := s.withTransaction(tx)
+		if err := txRepos.AttemptsRepo.DeleteTestResultsByAttempt(ctx, attempt.ID); err != nil {
+			return err
+		}
+		if result != nil {
+			// Cases are reported in the same order as the tests they ran
+			for i, caseResult := range result.Cases {
+				if err := txRepos.AttemptsRepo.CreateAttemptTestResult(ctx, testResultParams(attempt.ID, r.tests[i], caseResult)); err != nil {
+					return err
+				}
+			}
+		}
+		return txRepos.AttemptsRepo.RegradeAttempt(ctx, params)
+	})
+	switch {
+	case err != nil:
+		progress.Errored = 1
+	case params.Success && !attempt.Success:
+		progress.NewlyPassed = 1
+	case !params.Success && attempt.Success:
+		progress.NewlyFailed = 1
+	}
+}
+
+// runRegradeAttempt runs the code of an attempt against the current tests,
+// trying again a few times, each after a longer wait, while no execution
+// worker is free
+func (s *codelabsvrc) runRegradeAttempt(ctx context.Context, r *exerciseRegrade, code string) (*sandbox.Result, error) {
+	for try := 0; ; try++ {
+		result, err := s.executor.Run(ctx, sandbox.Language(r.language), code, r.cases)
+		if !errors.Is(err, sandbox.ErrUnavailable) || try == regradeRetries {
+			return result, err
+		}
+
+		delay := time.NewTimer(regradeRetryDelay * time.Duration(try+1))
+		select {
+		case <-delay.C:
+		case <-ctx.Done():
+			delay.Stop()
+			return nil, ctx.Err()
+		}
+	}
+}
+
+// finishRegrade recalculates the answers of the regraded attempts from them,
+// records the students whose outcome flipped and closes the regrade
+func (s *codelabsvrc) finishRegrade(ctx context.Context, r *exerciseRegrade) error {
+	regraded := make(map[int64]bool)
+	for _, attempt := range r.attempts {
+		regraded[attempt.AnswerID] = true
+	}
+
+	return s.txManager.WithTransaction(ctx, func(tx pgx.Tx) error {
+		txRepos := s.withTransaction(tx)
+		// Read now, so attempts made during the regrade count as before it
+		answers, err := txRepos.AnswersRepo.ListAnswersByExercise(ctx, r.exerciseID)
+		if err != nil {
+			return err
+		}
+
+		newlyPassed := false
+		for _, answer := range answers {
+			if !regraded[answer.ID] {
+				continue
+			}
+			updated, err := txRepos.AnswersRepo.RecalculateAnswerScore(ctx, answer.ID)
+			if err != nil {
+				return err
+			}
+			if updated.Completed == answer.Completed {
+				continue
+			}
+
+			err = txRepos.RegradesRepo.CreateRegradeChange(ctx, codelabdb.CreateRegradeChangeParams{
+				RegradeID:     r.id,
+				UserID:        answer.UserID,
+				Completed:     updated.Completed,
+				PreviousScore: answer.BestScore,
+				Score:         updated.BestScore,
+			})
+			if err != nil {
+				return err
+			}
+			newlyPassed = newlyPassed || updated.Completed
+		}
+
+		// Attempts that pass now were never checked for plagiarism
+		if newlyPassed {
+			if err := txRepos.PlagiarismRepo.DeletePlagiarismCheck(ctx, r.exerciseID); err != nil {
+				return err
+			}
+		}
+
+		return txRepos.RegradesRepo.FinishRegrade(ctx, codelabdb.FinishRegradeParams{
+			ID:     r.id,
+			Status: regradeFinished,
+		})
+	})
+}
+
+// failRegrade closes a regrade that could not finish, even when it failed
+// because ctx is done
+func (s *codelabsvrc) failRegrade(ctx context.Context, regradeID int64, message string) {
+	// Nothing is left to report the error to
+	_ = s.regradesRepo.FinishRegrade(context.WithoutCancel(ctx), codelabdb.FinishRegradeParams{
+		ID:           regradeID,
+		Status:       regradeFailed,
+		ErrorMessage: pgtype.Text{String: message, Valid: true},
+	})
+}
+
+// isUniqueViolation reports whether a write failed because it would duplicate
+// a row that must be unique
+func isUniqueViolation(err error) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.Code == "23505"
+}
+
+// regradeToAPI converts a stored regrade and the students whose outcome it
+// flipped to its API form
+func regradeToAPI(regrade codelabdb.Regrade, changes []codelabdb.RegradeChange) *codelab.Regrade {
+	result := &codelab.Regrade{
+		ID:                  regrade.ID,
+		ExerciseID:          regrade.ExerciseID,
+		ExerciseVersion:     regrade.ExerciseVersion,
+		Status:              regrade.Status,
+		TotalAttempts:       regrade.TotalAttempts,
+		RegradedAttempts:    regrade.RegradedAttempts,
+		ErroredAttempts:     regrade.ErroredAttempts,
+		NewlyPassedAttempts: regrade.NewlyPassedAttempts,
+		NewlyFailedAttempts: regrade.NewlyFailedAttempts,
+		NewlyPassed:         []*codelab.RegradeStudentChange{},
+		NewlyFailed:         []*codelab.RegradeStudentChange{},
+		CreatedBy:           regrade.CreatedBy,
+		CreatedAt:           regrade.CreatedAt.Time.UnixMilli(),
+	}
+	if regrade.ErrorMessage.Valid {
+		result.Error = &regrade.ErrorMessage.String
+	}
+	if regrade.FinishedAt.Valid {
+		result.FinishedAt = ptr(regrade.FinishedAt.Time.UnixMilli())
+	}
+
+	for _, change := range changes {
+		student := &codelab.RegradeStudentChange{
+			UserID:        change.UserID,
+			PreviousScore: change.PreviousScore,
+			Score:         change.Score,
+		}
+		if change.Completed {
+			result.NewlyPassed = append(result.NewlyPassed, student)
+		} else {
+			result.NewlyFailed = append(result.NewlyFailed, student)
+		}
+	}
+	return result
+}
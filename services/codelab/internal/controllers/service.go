@@ -1,9 +1,14 @@
 package controllers
 
 import (
+	"time"
+
+	"github.com/jackc/pgx/v5"
 	"github.com/ynoacamino/infra-sustainable-classrooms/services/codelab/gen/codelab"
+	"github.com/ynoacamino/infra-sustainable-classrooms/services/codelab/internal/grading"
 	"github.com/ynoacamino/infra-sustainable-classrooms/services/codelab/internal/ports"
 	"github.com/ynoacamino/infra-sustainable-classrooms/services/codelab/internal/repositories"
+	"github.com/ynoacamino/infra-sustainable-classrooms/services/codelab/internal/sandbox"
 )
 
 type codelabsvrc struct {
@@ -12,14 +17,42 @@ type codelabsvrc struct {
 	exercisesRepo       ports.ExercisesRepository
 	testsRepo           ports.TestsRepository
 	attemptsRepo        ports.AttemptsRepository
+	plagiarismRepo      ports.PlagiarismRepository
+	hintsRepo           ports.HintsRepository
+	assignmentsRepo     ports.AssignmentsRepository
+	regradesRepo        ports.RegradesRepository
+	txManager           ports.TransactionManager
+	withTransaction     func(tx pgx.Tx) *repositories.TransactionalRepositories
+	executor            *sandbox.Executor
+	queue               *grading.Queue
+	broker              *grading.Broker
+	regradeQueue        *grading.Queue
+	regradePool         *grading.Pool
+	limiter             ports.RateLimiter
 }
 
-func NewCodelab(repoManager *repositories.RepositoryManager) codelab.Service {
+// submissionRetention is how long the events of a graded submission are kept
+// for clients that start following it late
+const submissionRetention = time.Minute
+
+func NewCodelab(repoManager *repositories.RepositoryManager, executor *sandbox.Executor, queue, regradeQueue *grading.Queue, regradePool *grading.Pool, limiter ports.RateLimiter) codelab.Service {
 	return &codelabsvrc{
 		profilesServiceRepo: repoManager.ProfilesServiceRepo,
 		answersRepo:         repoManager.AnswersRepo,
 		exercisesRepo:       repoManager.ExercisesRepo,
 		testsRepo:           repoManager.TestsRepo,
 		attemptsRepo:        repoManager.AttemptsRepo,
+		plagiarismRepo:      repoManager.PlagiarismRepo,
+		hintsRepo:           repoManager.HintsRepo,
+		assignmentsRepo:     repoManager.AssignmentsRepo,
+		regradesRepo:        repoManager.RegradesRepo,
+		txManager:           &repoManager.TxManager,
+		withTransaction:     repoManager.WithTransaction,
+		executor:            executor,
+		queue:               queue,
+		broker:              grading.NewBroker(submissionRetention),
+		regradeQueue:        regradeQueue,
+		regradePool:         regradePool,
+		limiter:             limiter,
 	}
 }
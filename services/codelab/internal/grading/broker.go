@@ -0,0 +1,132 @@
+package grading
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/ynoacamino/infra-sustainable-classrooms/services/codelab/internal/sandbox"
+)
+
+// ErrUnknownAttempt is returned when watching an attempt the broker is not
+// tracking, either because it was graded a while ago or by another instance
+var ErrUnknownAttempt = errors.New("attempt is not being graded")
+
+// EventType tells what happened to an attempt
+type EventType string
+
+const (
+	EventQueued   EventType = "queued"   // Waiting for a worker
+	EventRunning  EventType = "running"  // Running against its tests
+	EventTest     EventType = "test"     // One of its tests finished
+	EventFinished EventType = "finished" // Graded and stored
+)
+
+// Event is a step in the grading of an attempt
+type Event struct {
+	Type  EventType
+	Total int // Number of tests the attempt runs against
+
+	// Set on test events
+	Index    int
+	Result   sandbox.CaseResult
+	Expected *string // Expected output, only for public tests
+
+	// Set on finished events
+	Status    string
+	Success   bool
+	Points    int32
+	MaxPoints int32
+	Score     float64
+	Message   string
+}
+
+// stream holds the events of an attempt published so far. changed is closed
+// and replaced on every new event to wake up the watchers.
+type stream struct {
+	events  []Event
+	changed chan struct{}
+	done    bool
+}
+
+// Broker keeps the events of the attempts being graded so clients can follow
+// them from the start, however late they connect
+type Broker struct {
+	retention time.Duration
+
+	mu      sync.Mutex
+	streams map[int64]*stream
+}
+
+// NewBroker creates a broker that forgets finished attempts after retention
+func NewBroker(retention time.Duration) *Broker {
+	return &Broker{
+		retention: retention,
+		streams:   make(map[int64]*stream),
+	}
+}
+
+// Open starts tracking an attempt
+func (b *Broker) Open(attemptID int64) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.streams[attemptID] = &stream{changed: make(chan struct{})}
+}
+
+// Publish adds an event to an attempt. Finished events end its stream.
+func (b *Broker) Publish(attemptID int64, event Event) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	s, ok := b.streams[attemptID]
+	if !ok || s.done {
+		return
+	}
+	s.events = append(s.events, event)
+	close(s.changed)
+	s.changed = make(chan struct{})
+
+	if event.Type == EventFinished {
+		s.done = true
+		time.AfterFunc(b.retention, func() {
+			b.mu.Lock()
+			defer b.mu.Unlock()
+			delete(b.streams, attemptID)
+		})
+	}
+}
+
+// Watch calls fn with every event of an attempt, starting from the first
+// one, until the attempt finishes, fn fails or ctx is done
+func (b *Broker) Watch(ctx context.Context, attemptID int64, fn func(Event) error) error {
+	b.mu.Lock()
+	s, ok := b.streams[attemptID]
+	b.mu.Unlock()
+	if !ok {
+		return ErrUnknownAttempt
+	}
+
+	for next := 0; ; {
+		b.mu.Lock()
+		events, changed, done := s.events[next:], s.changed, s.done
+		b.mu.Unlock()
+
+		for _, event := range events {
+			if err := fn(event); err != nil {
+				return err
+			}
+		}
+		next += len(events)
+		if done {
+			return nil
+		}
+
+		select {
+		case <-changed:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
@@ -0,0 +1,138 @@
+package grading
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/ynoacamino/infra-sustainable-classrooms/services/codelab/internal/sandbox"
+)
+
+func TestQueue_RunsJobs(t *testing.T) {
+	queue := NewQueue(2, 10)
+	queue.Start(context.Background())
+
+	var mu sync.Mutex
+	ran := 0
+	for range 5 {
+		err := queue.Submit(func(ctx context.Context) {
+			mu.Lock()
+			defer mu.Unlock()
+			ran++
+		})
+		assert.NoError(t, err)
+	}
+	queue.Stop()
+
+	// Stop waits for the jobs already submitted
+	assert.Equal(t, 5, ran)
+	assert.ErrorIs(t, queue.Submit(func(ctx context.Context) {}), ErrQueueStopped)
+}
+
+func TestQueue_Full(t *testing.T) {
+	// Without workers started nothing takes jobs out of the queue
+	queue := NewQueue(1, 1)
+
+	assert.NoError(t, queue.Submit(func(ctx context.Context) {}))
+	assert.ErrorIs(t, queue.Submit(func(ctx context.Context) {}), ErrQueueFull)
+}
+
+func TestBroker_ReplaysAndFollows(t *testing.T) {
+	broker := NewBroker(time.Minute)
+	broker.Open(1)
+	broker.Publish(1, Event{Type: EventQueued, Total: 2})
+	broker.Publish(1, Event{Type: EventRunning, Total: 2})
+
+	var events []Event
+	done := make(chan error)
+	go func() {
+		done <- broker.Watch(context.Background(), 1, func(event Event) error {
+			events = append(events, event)
+			return nil
+		})
+	}()
+
+	broker.Publish(1, Event{Type: EventTest, Total: 2, Index: 0, Result: sandbox.CaseResult{Status: sandbox.StatusPassed}})
+	broker.Publish(1, Event{Type: EventTest, Total: 2, Index: 1, Result: sandbox.CaseResult{Status: sandbox.StatusFailed}})
+	broker.Publish(1, Event{Type: EventFinished, Total: 2, Status: "failed", Points: 1, MaxPoints: 2, Score: 50})
+	// Ignored once the attempt finished
+	broker.Publish(1, Event{Type: EventRunning})
+
+	assert.NoError(t, <-done)
+	types := make([]EventType, len(events))
+	for i, event := range events {
+		types[i] = event.Type
+	}
+	assert.Equal(t, []EventType{EventQueued, EventRunning, EventTest, EventTest, EventFinished}, types)
+	assert.Equal(t, 50.0, events[4].Score)
+}
+
+func TestBroker_UnknownAttempt(t *testing.T) {
+	broker := NewBroker(time.Minute)
+
+	err := broker.Watch(context.Background(), 1, func(event Event) error { return nil })
+
+	assert.ErrorIs(t, err, ErrUnknownAttempt)
+}
+
+func TestBroker_ForgetsFinishedAttempts(t *testing.T) {
+	broker := NewBroker(10 * time.Millisecond)
+	broker.Open(1)
+	broker.Publish(1, Event{Type: EventFinished})
+
+	assert.Eventually(t, func() bool {
+		return broker.Watch(context.Background(), 1, func(event Event) error { return nil }) == ErrUnknownAttempt
+	}, time.Second, 5*time.Millisecond)
+}
+
+func TestBroker_WatchStopsWithContext(t *testing.T) {
+	broker := NewBroker(time.Minute)
+	broker.Open(1)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	err := broker.Watch(ctx, 1, func(event Event) error { return nil })
+
+	assert.ErrorIs(t, err, context.Canceled)
+}
+
+func TestPool_RunsEveryTask(t *testing.T) {
+	pool := NewPool(3)
+
+	var mu sync.Mutex
+	running, busiest := 0, 0
+	ran := make([]bool, 10)
+	err := pool.Run(context.Background(), len(ran), func(ctx context.Context, i int) {
+		mu.Lock()
+		running++
+		busiest = max(busiest, running)
+		mu.Unlock()
+
+		time.Sleep(time.Millisecond)
+
+		mu.Lock()
+		defer mu.Unlock()
+		running--
+		ran[i] = true
+	})
+
+	assert.NoError(t, err)
+	assert.NotContains(t, ran, false)
+	assert.LessOrEqual(t, busiest, 3)
+}
+
+func TestPool_StopsWithContext(t *testing.T) {
+	pool := NewPool(1)
+	ctx, cancel := context.WithCancel(context.Background())
+
+	ran := 0
+	err := pool.Run(ctx, 5, func(ctx context.Context, i int) {
+		ran++
+		cancel()
+	})
+
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Less(t, ran, 5)
+}
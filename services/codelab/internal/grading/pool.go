@@ -0,0 +1,51 @@
+package grading
+
+import (
+	"context"
+	"sync"
+)
+
+// Pool runs the tasks of a batch, such as the attempts of a regrade, on a
+// fixed number of workers
+type Pool struct {
+	workers int
+}
+
+// NewPool creates a pool running up to workers tasks at once
+func NewPool(workers int) *Pool {
+	return &Pool{workers: max(workers, 1)}
+}
+
+// Run calls task with every index below n and waits for the tasks started.
+// Once ctx is done no more tasks are started and its error is returned.
+func (p *Pool) Run(ctx context.Context, n int, task func(ctx context.Context, i int)) error {
+	indexes := make(chan int)
+	var wg sync.WaitGroup
+	for range min(p.workers, n) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indexes {
+				task(ctx, i)
+			}
+		}()
+	}
+
+	var err error
+feed:
+	for i := range n {
+		// Checked first as select picks at random when a worker is free too
+		if err = ctx.Err(); err != nil {
+			break
+		}
+		select {
+		case indexes <- i:
+		case <-ctx.Done():
+			err = ctx.Err()
+			break feed
+		}
+	}
+	close(indexes)
+	wg.Wait()
+	return err
+}
@@ -0,0 +1,83 @@
+// Package grading grades attempts in the background. A Queue runs grading
+// jobs on a fixed number of workers, a Pool spreads the attempts of a batch
+// over its workers and a Broker relays the progress of every attempt to the
+// clients following it.
+package grading
+
+import (
+	"context"
+	"errors"
+	"sync"
+)
+
+var (
+	// ErrQueueFull is returned when no more jobs fit in the queue
+	ErrQueueFull = errors.New("grading queue is full")
+	// ErrQueueStopped is returned when submitting to a stopped queue
+	ErrQueueStopped = errors.New("grading queue is stopped")
+)
+
+// Job grades a single attempt. ctx is done once the queue stops.
+type Job func(ctx context.Context)
+
+// Queue runs jobs in the order they were submitted on a fixed number of
+// workers
+type Queue struct {
+	jobs    chan Job
+	workers int
+
+	mu      sync.Mutex
+	stopped bool
+	wg      sync.WaitGroup
+}
+
+// NewQueue creates a queue running jobs on workers goroutines and holding up
+// to size jobs waiting for a worker
+func NewQueue(workers, size int) *Queue {
+	return &Queue{
+		jobs:    make(chan Job, size),
+		workers: max(workers, 1),
+	}
+}
+
+// Start launches the workers, which run jobs with ctx
+func (q *Queue) Start(ctx context.Context) {
+	for range q.workers {
+		q.wg.Add(1)
+		go func() {
+			defer q.wg.Done()
+			for job := range q.jobs {
+				job(ctx)
+			}
+		}()
+	}
+}
+
+// Stop stops accepting jobs and waits for the workers to finish the jobs
+// already submitted
+func (q *Queue) Stop() {
+	q.mu.Lock()
+	if !q.stopped {
+		q.stopped = true
+		close(q.jobs)
+	}
+	q.mu.Unlock()
+
+	q.wg.Wait()
+}
+
+// Submit adds a job to the queue without waiting for room in it
+func (q *Queue) Submit(job Job) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if q.stopped {
+		return ErrQueueStopped
+	}
+	select {
+	case q.jobs <- job:
+		return nil
+	default:
+		return ErrQueueFull
+	}
+}
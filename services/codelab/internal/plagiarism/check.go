@@ -0,0 +1,56 @@
+package plagiarism
+
+// Submission is code submitted by a student
+type Submission struct {
+	ID     int64
+	UserID int64
+	Code   string
+}
+
+// Pair is two submissions from different students that are suspiciously
+// similar
+type Pair struct {
+	A, B Submission
+	Comparison
+}
+
+// Check compares every submission with the submissions of the other students
+// and returns, for each pair of students, their most similar submissions when
+// the similarity reaches threshold. Code shared with base is ignored.
+func Check(submissions []Submission, base string, threshold float64, opts Options) []Pair {
+	baseDoc := NewDocument(base, opts)
+	docs := make([]*Document, len(submissions))
+	for i, submission := range submissions {
+		docs[i] = NewDocument(submission.Code, opts)
+		docs[i].Exclude(baseDoc)
+	}
+
+	type students struct{ a, b int64 }
+	best := make(map[students]int)
+	var pairs []Pair
+	for i := range submissions {
+		for j := i + 1; j < len(submissions); j++ {
+			a, b := submissions[i], submissions[j]
+			if a.UserID == b.UserID {
+				continue
+			}
+
+			comparison := Compare(docs[i], docs[j])
+			if comparison.Similarity < threshold {
+				continue
+			}
+
+			key := students{min(a.UserID, b.UserID), max(a.UserID, b.UserID)}
+			if k, ok := best[key]; ok {
+				if comparison.Similarity > pairs[k].Similarity {
+					pairs[k] = Pair{A: a, B: b, Comparison: comparison}
+				}
+				continue
+			}
+			best[key] = len(pairs)
+			pairs = append(pairs, Pair{A: a, B: b, Comparison: comparison})
+		}
+	}
+
+	return pairs
+}
@@ -0,0 +1,184 @@
+package plagiarism
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func tokenTexts(tokens []Token) []string {
+	texts := make([]string, len(tokens))
+	for i, token := range tokens {
+		texts[i] = token.Text
+	}
+	return texts
+}
+
+func TestTokenize(t *testing.T) {
+	code := `// adds the numbers
+function solution(input) {
+	/* split
+	   the input */
+	const [a, b] = input.split(",").map(Number);
+	return a + b >= 10 ? 'big' : a+b;
+}`
+
+	tokens := Tokenize(code)
+
+	assert.Equal(t, []string{
+		"function", "ID", "(", "ID", ")", "{",
+		"const", "[", "ID", ",", "ID", "]", "=", "ID", ".", "split", "(", `","`, ")", ".", "map", "(", "ID", ")", ";",
+		"return", "ID", "+", "ID", ">=", "10", "?", "'big'", ":", "ID", "+", "ID", ";",
+		"}",
+	}, tokenTexts(tokens))
+	assert.Equal(t, 2, tokens[0].Line)
+	assert.Equal(t, 5, tokens[6].Line)
+	assert.Equal(t, 7, tokens[len(tokens)-1].Line)
+}
+
+func TestTokenize_IgnoresFormattingAndNames(t *testing.T) {
+	original := `function solution(input) { let total = 0; for (const n of input.split(",")) { total += Number(n); } return String(total); }`
+	disguised := `
+// my own solution
+function solution(text) {
+    let sum = 0;    // running sum
+    for (const value of text.split(",")) {
+        sum += Number(value);
+    }
+    return String(sum);
+}
+`
+
+	assert.Equal(t, tokenTexts(Tokenize(original)), tokenTexts(Tokenize(disguised)))
+}
+
+func TestCompare(t *testing.T) {
+	original := `function solution(input) {
+	let total = 0;
+	for (const n of input.split(",")) {
+		total += Number(n);
+	}
+	return String(total);
+}`
+	renamed := `function solution(text) {
+	let sum = 0;
+	for (const value of text.split(",")) {
+		sum += Number(value);
+	}
+	return String(sum);
+}`
+	different := `function solution(input) {
+	const [a, b] = input.split(",").map(Number);
+	return String(Math.pow(a, b));
+}`
+
+	opts := DefaultOptions()
+	copied := Compare(NewDocument(original, opts), NewDocument(renamed, opts))
+	assert.Equal(t, 100.0, copied.Similarity)
+	// Winnowing samples the hashes, so a region may stop short of the last lines
+	if assert.Len(t, copied.Regions, 1) {
+		assert.Equal(t, 1, copied.Regions[0].StartA)
+		assert.GreaterOrEqual(t, copied.Regions[0].EndA, 6)
+		assert.Equal(t, copied.Regions[0].StartA, copied.Regions[0].StartB)
+		assert.Equal(t, copied.Regions[0].EndA, copied.Regions[0].EndB)
+	}
+
+	unrelated := Compare(NewDocument(original, opts), NewDocument(different, opts))
+	assert.Less(t, unrelated.Similarity, 30.0)
+
+	empty := Compare(NewDocument("", opts), NewDocument(original, opts))
+	assert.Equal(t, 0.0, empty.Similarity)
+	assert.Empty(t, empty.Regions)
+}
+
+func TestCompare_LocatesSharedCode(t *testing.T) {
+	a := `function helper(x) {
+	return x * 2;
+}
+
+function solution(input) {
+	const parts = input.split(",").map(Number).filter((n) => n > 0);
+	return String(parts.reduce((acc, n) => acc + helper(n), 0));
+}`
+	b := `function solution(input) {
+	const parts = input.split(",").map(Number).filter((n) => n > 0);
+	return String(parts.reduce((acc, n) => acc + n * 2, 0));
+}`
+
+	opts := DefaultOptions()
+	comparison := Compare(NewDocument(a, opts), NewDocument(b, opts))
+
+	if assert.NotEmpty(t, comparison.Regions) {
+		region := comparison.Regions[0]
+		assert.Equal(t, 5, region.StartA)
+		assert.Equal(t, 1, region.StartB)
+	}
+	for _, region := range comparison.Regions {
+		assert.GreaterOrEqual(t, region.StartA, 4, "the helper is not in b")
+	}
+}
+
+func TestCheck(t *testing.T) {
+	starter := "function solution(input) {\n  // write your code here\n}"
+	solution := `function solution(input) {
+	let total = 0;
+	for (const n of input.split(",")) {
+		total += Number(n);
+	}
+	return String(total);
+}`
+	copied := `function solution(s) {
+	let acc = 0;
+	for (const x of s.split(",")) {
+		acc += Number(x);
+	}
+	return String(acc);
+}`
+	own := `function solution(input) {
+	const [a, b] = input.split(",").map(Number);
+	return String(a + b);
+}`
+
+	submissions := []Submission{
+		{ID: 1, UserID: 10, Code: solution},
+		{ID: 2, UserID: 10, Code: copied},
+		{ID: 3, UserID: 11, Code: copied},
+		{ID: 4, UserID: 12, Code: own},
+	}
+
+	pairs := Check(submissions, starter, 70, DefaultOptions())
+
+	// Submissions of the same student are never compared, and only the most
+	// similar pair of submissions is kept for each pair of students
+	if assert.Len(t, pairs, 1) {
+		assert.Equal(t, int64(10), pairs[0].A.UserID)
+		assert.Equal(t, int64(11), pairs[0].B.UserID)
+		assert.Equal(t, 100.0, pairs[0].Similarity)
+	}
+}
+
+func TestCheck_IgnoresStarterCode(t *testing.T) {
+	starter := `function solution(input) {
+	const [a, b] = input.split(",").map(Number);
+	// write your code here
+}`
+	first := `function solution(input) {
+	const [a, b] = input.split(",").map(Number);
+	if (a > b) { return String(a - b); }
+	return String(b - a);
+}`
+	second := `function solution(input) {
+	const [a, b] = input.split(",").map(Number);
+	let result = 1;
+	for (let i = 0; i < b; i++) { result *= a; }
+	return String(result);
+}`
+
+	opts := DefaultOptions()
+	withStarter := Check([]Submission{{ID: 1, UserID: 1, Code: first}, {ID: 2, UserID: 2, Code: second}}, starter, 0, opts)
+	withoutStarter := Check([]Submission{{ID: 1, UserID: 1, Code: first}, {ID: 2, UserID: 2, Code: second}}, "", 0, opts)
+
+	if assert.Len(t, withStarter, 1) && assert.Len(t, withoutStarter, 1) {
+		assert.Less(t, withStarter[0].Similarity, withoutStarter[0].Similarity)
+	}
+}
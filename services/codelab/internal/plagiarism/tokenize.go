@@ -0,0 +1,121 @@
+package plagiarism
+
+import (
+	"strings"
+	"unicode"
+)
+
+// identifierToken replaces every renamable identifier, so renaming variables
+// does not change the normalized code
+const identifierToken = "ID"
+
+// Token is a normalized token of JavaScript code and the line it starts on
+type Token struct {
+	Text string
+	Line int
+}
+
+// jsKeywords are kept as they are since they carry the structure of the code
+var jsKeywords = map[string]bool{
+	"async": true, "await": true, "break": true, "case": true, "catch": true,
+	"class": true, "const": true, "continue": true, "debugger": true, "default": true,
+	"delete": true, "do": true, "else": true, "export": true, "extends": true,
+	"false": true, "finally": true, "for": true, "function": true, "if": true,
+	"import": true, "in": true, "instanceof": true, "let": true, "new": true,
+	"null": true, "of": true, "return": true, "super": true, "switch": true,
+	"this": true, "throw": true, "true": true, "try": true, "typeof": true,
+	"undefined": true, "var": true, "void": true, "while": true, "yield": true,
+}
+
+// jsOperators are the punctuators made of more than one character, longest first
+var jsOperators = []string{
+	">>>=",
+	"...", "===", "!==", "**=", "<<=", ">>=", ">>>", "&&=", "||=", "??=",
+	"=>", "==", "!=", "<=", ">=", "&&", "||", "??", "?.", "++", "--",
+	"+=", "-=", "*=", "/=", "%=", "&=", "|=", "^=", "**", "<<", ">>",
+}
+
+// Tokenize normalizes JavaScript code into tokens. Comments and whitespace
+// are dropped and identifiers are renamed, except keywords and the property
+// names that follow a dot, which usually name library methods.
+func Tokenize(code string) []Token {
+	var tokens []Token
+	line := 1
+	src := []rune(code)
+
+	for i := 0; i < len(src); {
+		c := src[i]
+		switch {
+		case c == '\n':
+			line++
+			i++
+		case unicode.IsSpace(c):
+			i++
+		case c == '/' && i+1 < len(src) && src[i+1] == '/':
+			for i < len(src) && src[i] != '\n' {
+				i++
+			}
+		case c == '/' && i+1 < len(src) && src[i+1] == '*':
+			i += 2
+			for i < len(src) && !(src[i] == '*' && i+1 < len(src) && src[i+1] == '/') {
+				if src[i] == '\n' {
+					line++
+				}
+				i++
+			}
+			i += 2
+		case c == '"' || c == '\'' || c == '`':
+			start, startLine := i, line
+			i++
+			for i < len(src) && src[i] != c {
+				if src[i] == '\\' {
+					i++
+				}
+				if i < len(src) && src[i] == '\n' {
+					line++
+				}
+				i++
+			}
+			i++
+			tokens = append(tokens, Token{Text: string(src[start:min(i, len(src))]), Line: startLine})
+		case unicode.IsDigit(c) || (c == '.' && i+1 < len(src) && unicode.IsDigit(src[i+1])):
+			start := i
+			for i < len(src) && (isIdentifierPart(src[i]) || src[i] == '.') {
+				i++
+			}
+			tokens = append(tokens, Token{Text: string(src[start:i]), Line: line})
+		case isIdentifierStart(c):
+			start := i
+			for i < len(src) && isIdentifierPart(src[i]) {
+				i++
+			}
+			text := string(src[start:i])
+			afterDot := len(tokens) > 0 && (tokens[len(tokens)-1].Text == "." || tokens[len(tokens)-1].Text == "?.")
+			if !jsKeywords[text] && !afterDot {
+				text = identifierToken
+			}
+			tokens = append(tokens, Token{Text: text, Line: line})
+		default:
+			text := string(c)
+			rest := string(src[i:min(i+4, len(src))])
+			for _, op := range jsOperators {
+				if strings.HasPrefix(rest, op) {
+					text = op
+					break
+				}
+			}
+			tokens = append(tokens, Token{Text: text, Line: line})
+			i += len([]rune(text))
+		}
+	}
+
+	return tokens
+}
+
+func isIdentifierStart(c rune) bool {
+	return c == '_' || c == '$' || unicode.IsLetter(c)
+}
+
+func isIdentifierPart(c rune) bool {
+	return isIdentifierStart(c) || unicode.IsDigit(c)
+}
@@ -0,0 +1,173 @@
+package plagiarism
+
+import (
+	"hash/fnv"
+	"math"
+	"sort"
+)
+
+// Options tune how fingerprints are selected
+type Options struct {
+	// K is the number of tokens hashed together. Matches shorter than K tokens
+	// are never reported.
+	K int
+	// Window is the number of consecutive hashes a fingerprint is picked
+	// from. Matches of at least K+Window-1 tokens are always reported.
+	Window int
+}
+
+// DefaultOptions suit the short solutions written for exercises
+func DefaultOptions() Options {
+	return Options{K: 6, Window: 4}
+}
+
+// fingerprint is the hash of the tokens [start, end] of a document
+type fingerprint struct {
+	hash       uint64
+	start, end int
+}
+
+// Document is the normalized form of some code and its fingerprints
+type Document struct {
+	tokens []Token
+	prints []fingerprint
+}
+
+// NewDocument tokenizes JavaScript code and selects its fingerprints by
+// winnowing the hashes of every K tokens
+func NewDocument(code string, opts Options) *Document {
+	doc := &Document{tokens: Tokenize(code)}
+	if len(doc.tokens) == 0 {
+		return doc
+	}
+
+	// Code shorter than K tokens is hashed as a whole
+	k := min(opts.K, len(doc.tokens))
+	hashes := make([]uint64, len(doc.tokens)-k+1)
+	for i := range hashes {
+		h := fnv.New64a()
+		for _, token := range doc.tokens[i : i+k] {
+			h.Write([]byte(token.Text))
+			h.Write([]byte{0})
+		}
+		hashes[i] = h.Sum64()
+	}
+
+	// Pick the rightmost minimal hash of every window, once per position
+	window := min(opts.Window, len(hashes))
+	selected := -1
+	for start := 0; start+window <= len(hashes); start++ {
+		best := start
+		for i := start; i < start+window; i++ {
+			if hashes[i] <= hashes[best] {
+				best = i
+			}
+		}
+		if best != selected {
+			selected = best
+			doc.prints = append(doc.prints, fingerprint{hash: hashes[best], start: best, end: best + k - 1})
+		}
+	}
+
+	return doc
+}
+
+// Exclude drops the fingerprints shared with base, such as the starter code
+// every student receives, so they do not count as copied
+func (d *Document) Exclude(base *Document) {
+	shared := make(map[uint64]bool, len(base.prints))
+	for _, fp := range base.prints {
+		shared[fp.hash] = true
+	}
+
+	kept := d.prints[:0]
+	for _, fp := range d.prints {
+		if !shared[fp.hash] {
+			kept = append(kept, fp)
+		}
+	}
+	d.prints = kept
+}
+
+// Region is a pair of line ranges, one in each document, that hold the same
+// normalized code. Lines start at 1 and ranges include both ends.
+type Region struct {
+	StartA int `json:"start_line_a"`
+	EndA   int `json:"end_line_a"`
+	StartB int `json:"start_line_b"`
+	EndB   int `json:"end_line_b"`
+}
+
+// Comparison is how similar two documents are
+type Comparison struct {
+	// Similarity is the percentage of the distinct fingerprints of both
+	// documents that they share
+	Similarity float64
+	Regions    []Region
+}
+
+// Compare measures the similarity of two documents and locates the code
+// they share
+func Compare(a, b *Document) Comparison {
+	inB := make(map[uint64]fingerprint, len(b.prints))
+	for _, fp := range b.prints {
+		if _, ok := inB[fp.hash]; !ok {
+			inB[fp.hash] = fp
+		}
+	}
+
+	union := make(map[uint64]bool, len(a.prints)+len(b.prints))
+	for hash := range inB {
+		union[hash] = true
+	}
+
+	var regions []Region
+	shared := make(map[uint64]bool)
+	for _, fp := range a.prints {
+		union[fp.hash] = true
+		match, ok := inB[fp.hash]
+		if !ok {
+			continue
+		}
+		shared[fp.hash] = true
+		regions = append(regions, Region{
+			StartA: a.tokens[fp.start].Line,
+			EndA:   a.tokens[fp.end].Line,
+			StartB: b.tokens[match.start].Line,
+			EndB:   b.tokens[match.end].Line,
+		})
+	}
+
+	if len(union) == 0 {
+		return Comparison{}
+	}
+	return Comparison{
+		Similarity: math.Round(float64(len(shared))*10000/float64(len(union))) / 100,
+		Regions:    mergeRegions(regions),
+	}
+}
+
+// mergeRegions joins regions that overlap or touch in both documents
+func mergeRegions(regions []Region) []Region {
+	sort.SliceStable(regions, func(i, j int) bool {
+		return regions[i].StartA < regions[j].StartA
+	})
+
+	var merged []Region
+	for _, r := range regions {
+		if n := len(merged); n > 0 && touches(merged[n-1].StartA, merged[n-1].EndA, r.StartA, r.EndA) && touches(merged[n-1].StartB, merged[n-1].EndB, r.StartB, r.EndB) {
+			last := &merged[n-1]
+			last.EndA = max(last.EndA, r.EndA)
+			last.StartB = min(last.StartB, r.StartB)
+			last.EndB = max(last.EndB, r.EndB)
+			continue
+		}
+		merged = append(merged, r)
+	}
+	return merged
+}
+
+// touches reports whether two line ranges overlap or are next to each other
+func touches(start1, end1, start2, end2 int) bool {
+	return start2 <= end1+1 && start1 <= end2+1
+}
@@ -13,6 +13,9 @@ type AnswersRepository interface {
 	ListAnswersByExercise(ctx context.Context, exerciseID int64) ([]codelabdb.Answer, error)
 	ListAnswersByUser(ctx context.Context, userID int64) ([]codelabdb.Answer, error)
 	UpdateAnswerCompleted(ctx context.Context, arg codelabdb.UpdateAnswerCompletedParams) error
+	UpdateAnswerBestScore(ctx context.Context, arg codelabdb.UpdateAnswerBestScoreParams) error
+	RecalculateAnswerScore(ctx context.Context, id int64) (codelabdb.Answer, error)
+	RecordAnswerHintUsage(ctx context.Context, arg codelabdb.RecordAnswerHintUsageParams) error
 	CountCompletedAnswersByExercise(ctx context.Context, exerciseID int64) (int64, error)
 	CountTotalAnswersByExercise(ctx context.Context, exerciseID int64) (int64, error)
 }
@@ -0,0 +1,21 @@
+package ports
+
+import (
+	"context"
+
+	codelabdb "github.com/ynoacamino/infra-sustainable-classrooms/services/codelab/gen/database"
+)
+
+type AssignmentsRepository interface {
+	CreateAssignment(ctx context.Context, arg codelabdb.CreateAssignmentParams) (int64, error)
+	GetAssignmentById(ctx context.Context, id int64) (codelabdb.Assignment, error)
+	ListAssignments(ctx context.Context) ([]codelabdb.Assignment, error)
+	UpdateAssignment(ctx context.Context, arg codelabdb.UpdateAssignmentParams) error
+	DeleteAssignment(ctx context.Context, id int64) error
+	AddAssignmentExercise(ctx context.Context, arg codelabdb.AddAssignmentExerciseParams) error
+	DeleteAssignmentExercises(ctx context.Context, assignmentID int64) error
+	GetAssignmentExercises(ctx context.Context, assignmentID int64) ([]codelabdb.GetAssignmentExercisesRow, error)
+	ListAssignmentExercises(ctx context.Context) ([]codelabdb.ListAssignmentExercisesRow, error)
+	GetAssignmentsByExercise(ctx context.Context, exerciseID int64) ([]codelabdb.Assignment, error)
+	GetAssignmentGrades(ctx context.Context, arg codelabdb.GetAssignmentGradesParams) ([]codelabdb.GetAssignmentGradesRow, error)
+}
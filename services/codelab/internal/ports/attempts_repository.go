@@ -7,8 +7,13 @@ import (
 )
 
 type AttemptsRepository interface {
-	CreateAttempt(ctx context.Context, arg codelabdb.CreateAttemptParams) error
+	CreateAttempt(ctx context.Context, arg codelabdb.CreateAttemptParams) (codelabdb.Attempt, error)
 	GetAttempt(ctx context.Context, id int64) (codelabdb.Attempt, error)
+	GetAttemptByUser(ctx context.Context, arg codelabdb.GetAttemptByUserParams) (codelabdb.Attempt, error)
+	UpdateAttemptResult(ctx context.Context, arg codelabdb.UpdateAttemptResultParams) error
+	RegradeAttempt(ctx context.Context, arg codelabdb.RegradeAttemptParams) error
+	DeleteAttempt(ctx context.Context, id int64) error
+	FailStalePendingAttempts(ctx context.Context, message string) (int64, error)
 	GetAttemptsByAnswer(ctx context.Context, answerID int64) ([]codelabdb.Attempt, error)
 	GetAttemptsByUserAndExercise(ctx context.Context, arg codelabdb.GetAttemptsByUserAndExerciseParams) ([]codelabdb.Attempt, error)
 	GetLatestAttemptByAnswer(ctx context.Context, answerID int64) (codelabdb.Attempt, error)
@@ -18,4 +23,9 @@ type AttemptsRepository interface {
 	CountSuccessfulAttemptsByExercise(ctx context.Context, exerciseID int64) (int64, error)
 	GetAttemptsWithAnswerInfo(ctx context.Context, exerciseID int64) ([]codelabdb.GetAttemptsWithAnswerInfoRow, error)
 	GetUserAttemptsForExercise(ctx context.Context, arg codelabdb.GetUserAttemptsForExerciseParams) ([]codelabdb.Attempt, error)
+	CreateAttemptTestResult(ctx context.Context, arg codelabdb.CreateAttemptTestResultParams) error
+	DeleteTestResultsByAttempt(ctx context.Context, attemptID int64) error
+	GetTestResultsByAttempt(ctx context.Context, attemptID int64) ([]codelabdb.AttemptTestResult, error)
+	GetTestResultsByUserAndExercise(ctx context.Context, arg codelabdb.GetTestResultsByUserAndExerciseParams) ([]codelabdb.AttemptTestResult, error)
+	GetTestFailureCountsByExercise(ctx context.Context, exerciseID int64) ([]codelabdb.GetTestFailureCountsByExerciseRow, error)
 }
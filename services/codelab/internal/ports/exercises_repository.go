@@ -7,11 +7,16 @@ import (
 )
 
 type ExercisesRepository interface {
-	CreateExercise(ctx context.Context, arg codelabdb.CreateExerciseParams) error
+	CreateExercise(ctx context.Context, arg codelabdb.CreateExerciseParams) (int64, error)
 	GetExerciseById(ctx context.Context, id int64) (codelabdb.Exercise, error)
 	GetExerciseToResolveById(ctx context.Context, id int64) (codelabdb.GetExerciseToResolveByIdRow, error)
-	ListExercises(ctx context.Context) ([]codelabdb.Exercise, error)
-	ListExercisesToResolve(ctx context.Context) ([]codelabdb.ListExercisesToResolveRow, error)
+	ListExercises(ctx context.Context, arg codelabdb.ListExercisesParams) ([]codelabdb.Exercise, error)
+	ListExercisesToResolve(ctx context.Context, arg codelabdb.ListExercisesToResolveParams) ([]codelabdb.ListExercisesToResolveRow, error)
+	ListTags(ctx context.Context) ([]codelabdb.ListTagsRow, error)
 	UpdateExercise(ctx context.Context, arg codelabdb.UpdateExerciseParams) error
 	DeleteExercise(ctx context.Context, id int64) error
+	BumpExerciseVersion(ctx context.Context, id int64) (codelabdb.Exercise, error)
+	CreateExerciseVersion(ctx context.Context, arg codelabdb.CreateExerciseVersionParams) error
+	GetExerciseVersion(ctx context.Context, arg codelabdb.GetExerciseVersionParams) (codelabdb.ExerciseVersion, error)
+	ListExerciseVersions(ctx context.Context, exerciseID int64) ([]codelabdb.ListExerciseVersionsRow, error)
 }
@@ -0,0 +1,17 @@
+package ports
+
+import (
+	"context"
+
+	codelabdb "github.com/ynoacamino/infra-sustainable-classrooms/services/codelab/gen/database"
+)
+
+type HintsRepository interface {
+	CreateHint(ctx context.Context, arg codelabdb.CreateHintParams) error
+	GetHintById(ctx context.Context, id int64) (codelabdb.Hint, error)
+	GetHintsByExercise(ctx context.Context, exerciseID int64) ([]codelabdb.Hint, error)
+	UpdateHint(ctx context.Context, arg codelabdb.UpdateHintParams) error
+	DeleteHint(ctx context.Context, id int64) error
+	CreateHintUsage(ctx context.Context, arg codelabdb.CreateHintUsageParams) (int64, error)
+	GetHintUsagesByAnswer(ctx context.Context, answerID int64) ([]codelabdb.HintUsage, error)
+}
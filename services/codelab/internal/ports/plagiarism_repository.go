@@ -0,0 +1,18 @@
+package ports
+
+import (
+	"context"
+
+	codelabdb "github.com/ynoacamino/infra-sustainable-classrooms/services/codelab/gen/database"
+)
+
+type PlagiarismRepository interface {
+	ListExercisesPendingPlagiarismCheck(ctx context.Context) ([]codelabdb.ListExercisesPendingPlagiarismCheckRow, error)
+	GetSuccessfulAttemptsByExercise(ctx context.Context, exerciseID int64) ([]codelabdb.GetSuccessfulAttemptsByExerciseRow, error)
+	DeletePlagiarismMatchesByExercise(ctx context.Context, exerciseID int64) error
+	CreatePlagiarismMatch(ctx context.Context, arg codelabdb.CreatePlagiarismMatchParams) error
+	ListPlagiarismMatchesByExercise(ctx context.Context, arg codelabdb.ListPlagiarismMatchesByExerciseParams) ([]codelabdb.PlagiarismMatch, error)
+	UpsertPlagiarismCheck(ctx context.Context, arg codelabdb.UpsertPlagiarismCheckParams) error
+	GetPlagiarismCheck(ctx context.Context, exerciseID int64) (codelabdb.PlagiarismCheck, error)
+	DeletePlagiarismCheck(ctx context.Context, exerciseID int64) error
+}
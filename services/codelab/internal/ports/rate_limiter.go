@@ -0,0 +1,14 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/ynoacamino/infra-sustainable-classrooms/services/codelab/internal/ratelimit"
+)
+
+// RateLimiter limits how often users run code. Acquire returns a
+// *ratelimit.LimitedError when the execution is not allowed yet; otherwise
+// release must be called once the execution finishes.
+type RateLimiter interface {
+	Acquire(ctx context.Context, action ratelimit.Action, userID int64) (release func(), err error)
+}
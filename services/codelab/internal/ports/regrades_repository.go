@@ -0,0 +1,18 @@
+package ports
+
+import (
+	"context"
+
+	codelabdb "github.com/ynoacamino/infra-sustainable-classrooms/services/codelab/gen/database"
+)
+
+type RegradesRepository interface {
+	CreateRegrade(ctx context.Context, arg codelabdb.CreateRegradeParams) (codelabdb.Regrade, error)
+	GetRegradeById(ctx context.Context, id int64) (codelabdb.Regrade, error)
+	GetRunningRegradeByExercise(ctx context.Context, exerciseID int64) (codelabdb.Regrade, error)
+	FailInterruptedRegrades(ctx context.Context, arg codelabdb.FailInterruptedRegradesParams) error
+	AddRegradeProgress(ctx context.Context, arg codelabdb.AddRegradeProgressParams) error
+	FinishRegrade(ctx context.Context, arg codelabdb.FinishRegradeParams) error
+	CreateRegradeChange(ctx context.Context, arg codelabdb.CreateRegradeChangeParams) error
+	ListRegradeChanges(ctx context.Context, regradeID int64) ([]codelabdb.RegradeChange, error)
+}
@@ -12,6 +12,10 @@ type CodeLabRepository interface {
 	TestsRepository
 	AnswersRepository
 	AttemptsRepository
+	PlagiarismRepository
+	HintsRepository
+	AssignmentsRepository
+	RegradesRepository
 }
 
 // Repositories struct holds all repository implementations
@@ -39,10 +43,14 @@ var _ ExercisesRepository = (*DatabaseRepository)(nil)
 var _ TestsRepository = (*DatabaseRepository)(nil)
 var _ AnswersRepository = (*DatabaseRepository)(nil)
 var _ AttemptsRepository = (*DatabaseRepository)(nil)
+var _ PlagiarismRepository = (*DatabaseRepository)(nil)
+var _ HintsRepository = (*DatabaseRepository)(nil)
+var _ AssignmentsRepository = (*DatabaseRepository)(nil)
+var _ RegradesRepository = (*DatabaseRepository)(nil)
 var _ CodeLabRepository = (*DatabaseRepository)(nil)
 
 // Exercises Repository methods
-func (r *DatabaseRepository) CreateExercise(ctx context.Context, arg codelabdb.CreateExerciseParams) error {
+func (r *DatabaseRepository) CreateExercise(ctx context.Context, arg codelabdb.CreateExerciseParams) (int64, error) {
 	return r.Queries.CreateExercise(ctx, arg)
 }
 
@@ -54,12 +62,16 @@ func (r *DatabaseRepository) GetExerciseToResolveById(ctx context.Context, id in
 	return r.Queries.GetExerciseToResolveById(ctx, id)
 }
 
-func (r *DatabaseRepository) ListExercises(ctx context.Context) ([]codelabdb.Exercise, error) {
-	return r.Queries.ListExercises(ctx)
+func (r *DatabaseRepository) ListExercises(ctx context.Context, arg codelabdb.ListExercisesParams) ([]codelabdb.Exercise, error) {
+	return r.Queries.ListExercises(ctx, arg)
 }
 
-func (r *DatabaseRepository) ListExercisesToResolve(ctx context.Context) ([]codelabdb.ListExercisesToResolveRow, error) {
-	return r.Queries.ListExercisesToResolve(ctx)
+func (r *DatabaseRepository) ListExercisesToResolve(ctx context.Context, arg codelabdb.ListExercisesToResolveParams) ([]codelabdb.ListExercisesToResolveRow, error) {
+	return r.Queries.ListExercisesToResolve(ctx, arg)
+}
+
+func (r *DatabaseRepository) ListTags(ctx context.Context) ([]codelabdb.ListTagsRow, error) {
+	return r.Queries.ListTags(ctx)
 }
 
 func (r *DatabaseRepository) UpdateExercise(ctx context.Context, arg codelabdb.UpdateExerciseParams) error {
@@ -70,11 +82,31 @@ func (r *DatabaseRepository) DeleteExercise(ctx context.Context, id int64) error
 	return r.Queries.DeleteExercise(ctx, id)
 }
 
+func (r *DatabaseRepository) BumpExerciseVersion(ctx context.Context, id int64) (codelabdb.Exercise, error) {
+	return r.Queries.BumpExerciseVersion(ctx, id)
+}
+
+func (r *DatabaseRepository) CreateExerciseVersion(ctx context.Context, arg codelabdb.CreateExerciseVersionParams) error {
+	return r.Queries.CreateExerciseVersion(ctx, arg)
+}
+
+func (r *DatabaseRepository) GetExerciseVersion(ctx context.Context, arg codelabdb.GetExerciseVersionParams) (codelabdb.ExerciseVersion, error) {
+	return r.Queries.GetExerciseVersion(ctx, arg)
+}
+
+func (r *DatabaseRepository) ListExerciseVersions(ctx context.Context, exerciseID int64) ([]codelabdb.ListExerciseVersionsRow, error) {
+	return r.Queries.ListExerciseVersions(ctx, exerciseID)
+}
+
 // Tests Repository methods
 func (r *DatabaseRepository) CreateTest(ctx context.Context, arg codelabdb.CreateTestParams) error {
 	return r.Queries.CreateTest(ctx, arg)
 }
 
+func (r *DatabaseRepository) GetTestById(ctx context.Context, id int64) (codelabdb.Test, error) {
+	return r.Queries.GetTestById(ctx, id)
+}
+
 func (r *DatabaseRepository) GetTestsByExercise(ctx context.Context, exerciseID int64) ([]codelabdb.Test, error) {
 	return r.Queries.GetTestsByExercise(ctx, exerciseID)
 }
@@ -124,6 +156,18 @@ func (r *DatabaseRepository) UpdateAnswerCompleted(ctx context.Context, arg code
 	return r.Queries.UpdateAnswerCompleted(ctx, arg)
 }
 
+func (r *DatabaseRepository) UpdateAnswerBestScore(ctx context.Context, arg codelabdb.UpdateAnswerBestScoreParams) error {
+	return r.Queries.UpdateAnswerBestScore(ctx, arg)
+}
+
+func (r *DatabaseRepository) RecalculateAnswerScore(ctx context.Context, id int64) (codelabdb.Answer, error) {
+	return r.Queries.RecalculateAnswerScore(ctx, id)
+}
+
+func (r *DatabaseRepository) RecordAnswerHintUsage(ctx context.Context, arg codelabdb.RecordAnswerHintUsageParams) error {
+	return r.Queries.RecordAnswerHintUsage(ctx, arg)
+}
+
 func (r *DatabaseRepository) CountCompletedAnswersByExercise(ctx context.Context, exerciseID int64) (int64, error) {
 	return r.Queries.CountCompletedAnswersByExercise(ctx, exerciseID)
 }
@@ -133,7 +177,7 @@ func (r *DatabaseRepository) CountTotalAnswersByExercise(ctx context.Context, ex
 }
 
 // Attempts Repository methods
-func (r *DatabaseRepository) CreateAttempt(ctx context.Context, arg codelabdb.CreateAttemptParams) error {
+func (r *DatabaseRepository) CreateAttempt(ctx context.Context, arg codelabdb.CreateAttemptParams) (codelabdb.Attempt, error) {
 	return r.Queries.CreateAttempt(ctx, arg)
 }
 
@@ -141,6 +185,26 @@ func (r *DatabaseRepository) GetAttempt(ctx context.Context, id int64) (codelabd
 	return r.Queries.GetAttempt(ctx, id)
 }
 
+func (r *DatabaseRepository) GetAttemptByUser(ctx context.Context, arg codelabdb.GetAttemptByUserParams) (codelabdb.Attempt, error) {
+	return r.Queries.GetAttemptByUser(ctx, arg)
+}
+
+func (r *DatabaseRepository) UpdateAttemptResult(ctx context.Context, arg codelabdb.UpdateAttemptResultParams) error {
+	return r.Queries.UpdateAttemptResult(ctx, arg)
+}
+
+func (r *DatabaseRepository) RegradeAttempt(ctx context.Context, arg codelabdb.RegradeAttemptParams) error {
+	return r.Queries.RegradeAttempt(ctx, arg)
+}
+
+func (r *DatabaseRepository) DeleteAttempt(ctx context.Context, id int64) error {
+	return r.Queries.DeleteAttempt(ctx, id)
+}
+
+func (r *DatabaseRepository) FailStalePendingAttempts(ctx context.Context, message string) (int64, error) {
+	return r.Queries.FailStalePendingAttempts(ctx, message)
+}
+
 func (r *DatabaseRepository) GetAttemptsByAnswer(ctx context.Context, answerID int64) ([]codelabdb.Attempt, error) {
 	return r.Queries.GetAttemptsByAnswer(ctx, answerID)
 }
@@ -176,3 +240,163 @@ func (r *DatabaseRepository) GetAttemptsWithAnswerInfo(ctx context.Context, exer
 func (r *DatabaseRepository) GetUserAttemptsForExercise(ctx context.Context, arg codelabdb.GetUserAttemptsForExerciseParams) ([]codelabdb.Attempt, error) {
 	return r.Queries.GetUserAttemptsForExercise(ctx, arg)
 }
+
+func (r *DatabaseRepository) CreateAttemptTestResult(ctx context.Context, arg codelabdb.CreateAttemptTestResultParams) error {
+	return r.Queries.CreateAttemptTestResult(ctx, arg)
+}
+
+func (r *DatabaseRepository) DeleteTestResultsByAttempt(ctx context.Context, attemptID int64) error {
+	return r.Queries.DeleteTestResultsByAttempt(ctx, attemptID)
+}
+
+func (r *DatabaseRepository) GetTestResultsByAttempt(ctx context.Context, attemptID int64) ([]codelabdb.AttemptTestResult, error) {
+	return r.Queries.GetTestResultsByAttempt(ctx, attemptID)
+}
+
+func (r *DatabaseRepository) GetTestResultsByUserAndExercise(ctx context.Context, arg codelabdb.GetTestResultsByUserAndExerciseParams) ([]codelabdb.AttemptTestResult, error) {
+	return r.Queries.GetTestResultsByUserAndExercise(ctx, arg)
+}
+
+func (r *DatabaseRepository) GetTestFailureCountsByExercise(ctx context.Context, exerciseID int64) ([]codelabdb.GetTestFailureCountsByExerciseRow, error) {
+	return r.Queries.GetTestFailureCountsByExercise(ctx, exerciseID)
+}
+
+// Plagiarism Repository methods
+func (r *DatabaseRepository) ListExercisesPendingPlagiarismCheck(ctx context.Context) ([]codelabdb.ListExercisesPendingPlagiarismCheckRow, error) {
+	return r.Queries.ListExercisesPendingPlagiarismCheck(ctx)
+}
+
+func (r *DatabaseRepository) GetSuccessfulAttemptsByExercise(ctx context.Context, exerciseID int64) ([]codelabdb.GetSuccessfulAttemptsByExerciseRow, error) {
+	return r.Queries.GetSuccessfulAttemptsByExercise(ctx, exerciseID)
+}
+
+func (r *DatabaseRepository) DeletePlagiarismMatchesByExercise(ctx context.Context, exerciseID int64) error {
+	return r.Queries.DeletePlagiarismMatchesByExercise(ctx, exerciseID)
+}
+
+func (r *DatabaseRepository) CreatePlagiarismMatch(ctx context.Context, arg codelabdb.CreatePlagiarismMatchParams) error {
+	return r.Queries.CreatePlagiarismMatch(ctx, arg)
+}
+
+func (r *DatabaseRepository) ListPlagiarismMatchesByExercise(ctx context.Context, arg codelabdb.ListPlagiarismMatchesByExerciseParams) ([]codelabdb.PlagiarismMatch, error) {
+	return r.Queries.ListPlagiarismMatchesByExercise(ctx, arg)
+}
+
+func (r *DatabaseRepository) UpsertPlagiarismCheck(ctx context.Context, arg codelabdb.UpsertPlagiarismCheckParams) error {
+	return r.Queries.UpsertPlagiarismCheck(ctx, arg)
+}
+
+func (r *DatabaseRepository) GetPlagiarismCheck(ctx context.Context, exerciseID int64) (codelabdb.PlagiarismCheck, error) {
+	return r.Queries.GetPlagiarismCheck(ctx, exerciseID)
+}
+
+func (r *DatabaseRepository) DeletePlagiarismCheck(ctx context.Context, exerciseID int64) error {
+	return r.Queries.DeletePlagiarismCheck(ctx, exerciseID)
+}
+
+// Hints Repository methods
+func (r *DatabaseRepository) CreateHint(ctx context.Context, arg codelabdb.CreateHintParams) error {
+	return r.Queries.CreateHint(ctx, arg)
+}
+
+func (r *DatabaseRepository) GetHintById(ctx context.Context, id int64) (codelabdb.Hint, error) {
+	return r.Queries.GetHintById(ctx, id)
+}
+
+func (r *DatabaseRepository) GetHintsByExercise(ctx context.Context, exerciseID int64) ([]codelabdb.Hint, error) {
+	return r.Queries.GetHintsByExercise(ctx, exerciseID)
+}
+
+func (r *DatabaseRepository) UpdateHint(ctx context.Context, arg codelabdb.UpdateHintParams) error {
+	return r.Queries.UpdateHint(ctx, arg)
+}
+
+func (r *DatabaseRepository) DeleteHint(ctx context.Context, id int64) error {
+	return r.Queries.DeleteHint(ctx, id)
+}
+
+func (r *DatabaseRepository) CreateHintUsage(ctx context.Context, arg codelabdb.CreateHintUsageParams) (int64, error) {
+	return r.Queries.CreateHintUsage(ctx, arg)
+}
+
+func (r *DatabaseRepository) GetHintUsagesByAnswer(ctx context.Context, answerID int64) ([]codelabdb.HintUsage, error) {
+	return r.Queries.GetHintUsagesByAnswer(ctx, answerID)
+}
+
+// Assignments Repository methods
+func (r *DatabaseRepository) CreateAssignment(ctx context.Context, arg codelabdb.CreateAssignmentParams) (int64, error) {
+	return r.Queries.CreateAssignment(ctx, arg)
+}
+
+func (r *DatabaseRepository) GetAssignmentById(ctx context.Context, id int64) (codelabdb.Assignment, error) {
+	return r.Queries.GetAssignmentById(ctx, id)
+}
+
+func (r *DatabaseRepository) ListAssignments(ctx context.Context) ([]codelabdb.Assignment, error) {
+	return r.Queries.ListAssignments(ctx)
+}
+
+func (r *DatabaseRepository) UpdateAssignment(ctx context.Context, arg codelabdb.UpdateAssignmentParams) error {
+	return r.Queries.UpdateAssignment(ctx, arg)
+}
+
+func (r *DatabaseRepository) DeleteAssignment(ctx context.Context, id int64) error {
+	return r.Queries.DeleteAssignment(ctx, id)
+}
+
+func (r *DatabaseRepository) AddAssignmentExercise(ctx context.Context, arg codelabdb.AddAssignmentExerciseParams) error {
+	return r.Queries.AddAssignmentExercise(ctx, arg)
+}
+
+func (r *DatabaseRepository) DeleteAssignmentExercises(ctx context.Context, assignmentID int64) error {
+	return r.Queries.DeleteAssignmentExercises(ctx, assignmentID)
+}
+
+func (r *DatabaseRepository) GetAssignmentExercises(ctx context.Context, assignmentID int64) ([]codelabdb.GetAssignmentExercisesRow, error) {
+	return r.Queries.GetAssignmentExercises(ctx, assignmentID)
+}
+
+func (r *DatabaseRepository) ListAssignmentExercises(ctx context.Context) ([]codelabdb.ListAssignmentExercisesRow, error) {
+	return r.Queries.ListAssignmentExercises(ctx)
+}
+
+func (r *DatabaseRepository) GetAssignmentsByExercise(ctx context.Context, exerciseID int64) ([]codelabdb.Assignment, error) {
+	return r.Queries.GetAssignmentsByExercise(ctx, exerciseID)
+}
+
+func (r *DatabaseRepository) GetAssignmentGrades(ctx context.Context, arg codelabdb.GetAssignmentGradesParams) ([]codelabdb.GetAssignmentGradesRow, error) {
+	return r.Queries.GetAssignmentGrades(ctx, arg)
+}
+
+// Regrades Repository methods
+func (r *DatabaseRepository) CreateRegrade(ctx context.Context, arg codelabdb.CreateRegradeParams) (codelabdb.Regrade, error) {
+	return r.Queries.CreateRegrade(ctx, arg)
+}
+
+func (r *DatabaseRepository) GetRegradeById(ctx context.Context, id int64) (codelabdb.Regrade, error) {
+	return r.Queries.GetRegradeById(ctx, id)
+}
+
+func (r *DatabaseRepository) GetRunningRegradeByExercise(ctx context.Context, exerciseID int64) (codelabdb.Regrade, error) {
+	return r.Queries.GetRunningRegradeByExercise(ctx, exerciseID)
+}
+
+func (r *DatabaseRepository) FailInterruptedRegrades(ctx context.Context, arg codelabdb.FailInterruptedRegradesParams) error {
+	return r.Queries.FailInterruptedRegrades(ctx, arg)
+}
+
+func (r *DatabaseRepository) AddRegradeProgress(ctx context.Context, arg codelabdb.AddRegradeProgressParams) error {
+	return r.Queries.AddRegradeProgress(ctx, arg)
+}
+
+func (r *DatabaseRepository) FinishRegrade(ctx context.Context, arg codelabdb.FinishRegradeParams) error {
+	return r.Queries.FinishRegrade(ctx, arg)
+}
+
+func (r *DatabaseRepository) CreateRegradeChange(ctx context.Context, arg codelabdb.CreateRegradeChangeParams) error {
+	return r.Queries.CreateRegradeChange(ctx, arg)
+}
+
+func (r *DatabaseRepository) ListRegradeChanges(ctx context.Context, regradeID int64) ([]codelabdb.RegradeChange, error) {
+	return r.Queries.ListRegradeChanges(ctx, regradeID)
+}
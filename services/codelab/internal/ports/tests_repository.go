@@ -8,6 +8,7 @@ import (
 
 type TestsRepository interface {
 	CreateTest(ctx context.Context, arg codelabdb.CreateTestParams) error
+	GetTestById(ctx context.Context, id int64) (codelabdb.Test, error)
 	GetTestsByExercise(ctx context.Context, exerciseID int64) ([]codelabdb.Test, error)
 	GetPublicTestsByExercise(ctx context.Context, exerciseID int64) ([]codelabdb.Test, error)
 	GetHiddenTestsByExercise(ctx context.Context, exerciseID int64) ([]codelabdb.Test, error)
@@ -0,0 +1,14 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// TransactionManager runs functions inside database transactions
+type TransactionManager interface {
+	// WithTransaction commits the transaction if fn succeeds and rolls it
+	// back otherwise
+	WithTransaction(ctx context.Context, fn func(tx pgx.Tx) error) error
+}
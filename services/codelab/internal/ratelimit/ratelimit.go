@@ -0,0 +1,206 @@
+// Package ratelimit limits how often each user runs code and how many
+// executions run at once. State is kept in Redis so that every replica of the
+// service shares the same limits.
+package ratelimit
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// Action is a kind of execution limited separately
+type Action string
+
+const (
+	// ActionAttempt is a graded attempt at an exercise
+	ActionAttempt Action = "attempt"
+	// ActionRun is a run of code against custom inputs
+	ActionRun Action = "run"
+)
+
+// Reason is why an execution was limited
+type Reason string
+
+const (
+	// ReasonCooldown means the user ran the action too recently
+	ReasonCooldown Reason = "cooldown"
+	// ReasonRate means the user ran the action too many times in the window
+	ReasonRate Reason = "rate"
+	// ReasonBusy means too many executions are already running
+	ReasonBusy Reason = "busy"
+)
+
+const (
+	// DefaultSlotTTL is how long an execution slot is held when it is not
+	// released, as happens when a replica stops while running code
+	DefaultSlotTTL = 5 * time.Minute
+	// busyRetryAfter is how long to wait when every execution slot is taken,
+	// which depends on executions finishing rather than on a known time
+	busyRetryAfter = 2 * time.Second
+)
+
+// Rule limits an action to Limit executions per Window, at least Cooldown
+// apart
+type Rule struct {
+	Limit    int
+	Window   time.Duration
+	Cooldown time.Duration
+}
+
+// Config is the rule of each action and the executions allowed at once
+type Config struct {
+	Rules         map[Action]Rule
+	MaxConcurrent int
+	// SlotTTL defaults to DefaultSlotTTL
+	SlotTTL time.Duration
+}
+
+// LimitedError is returned when an execution is not allowed yet
+type LimitedError struct {
+	Reason     Reason
+	RetryAfter time.Duration
+}
+
+func (e *LimitedError) Error() string {
+	return fmt.Sprintf("rate limited (%s), retry after %v", e.Reason, e.RetryAfter)
+}
+
+// Limiter allows executions within the configured limits
+type Limiter struct {
+	client redis.Cmdable
+	config Config
+}
+
+// NewLimiter creates a limiter keeping its state in client
+func NewLimiter(client redis.Cmdable, config Config) (*Limiter, error) {
+	if config.MaxConcurrent <= 0 {
+		return nil, fmt.Errorf("max concurrent executions (%d) must be greater than zero", config.MaxConcurrent)
+	}
+	for action, rule := range config.Rules {
+		if rule.Limit <= 0 || rule.Window <= 0 {
+			return nil, fmt.Errorf("rule for %s must allow at least one execution per window", action)
+		}
+		if rule.Cooldown < 0 || rule.Cooldown > rule.Window {
+			return nil, fmt.Errorf("cooldown for %s (%v) must be between zero and the window (%v)", action, rule.Cooldown, rule.Window)
+		}
+	}
+	if config.SlotTTL <= 0 {
+		config.SlotTTL = DefaultSlotTTL
+	}
+	return &Limiter{client: client, config: config}, nil
+}
+
+// acquireScript checks the cooldown and window of the user, then takes an
+// execution slot. Time is read from Redis so that replicas with skewed clocks
+// agree. It returns the reason and how many milliseconds to wait, or "ok".
+var acquireScript = redis.NewScript(`
+local time = redis.call('TIME')
+local now = tonumber(time[1]) * 1000 + math.floor(tonumber(time[2]) / 1000)
+local window = tonumber(ARGV[1])
+local limit = tonumber(ARGV[2])
+local cooldown = tonumber(ARGV[3])
+local maxConcurrent = tonumber(ARGV[4])
+local slotTTL = tonumber(ARGV[5])
+local token = ARGV[6]
+
+redis.call('ZREMRANGEBYSCORE', KEYS[1], '-inf', now - window)
+local last = redis.call('ZRANGE', KEYS[1], -1, -1, 'WITHSCORES')
+if #last > 0 and tonumber(last[2]) + cooldown > now then
+	return {'cooldown', tonumber(last[2]) + cooldown - now}
+end
+if redis.call('ZCARD', KEYS[1]) >= limit then
+	local first = redis.call('ZRANGE', KEYS[1], 0, 0, 'WITHSCORES')
+	return {'rate', tonumber(first[2]) + window - now}
+end
+
+redis.call('ZREMRANGEBYSCORE', KEYS[2], '-inf', now)
+if redis.call('ZCARD', KEYS[2]) >= maxConcurrent then
+	return {'busy', 0}
+end
+
+redis.call('ZADD', KEYS[1], now, token)
+redis.call('PEXPIRE', KEYS[1], window)
+redis.call('ZADD', KEYS[2], now + slotTTL, token)
+return {'ok', 0}
+`)
+
+// executionsKey holds the execution slots taken, scored by when they expire
+const executionsKey = "codelab:executions"
+
+func userKey(action Action, userID int64) string {
+	return fmt.Sprintf("codelab:ratelimit:%s:%d", action, userID)
+}
+
+// Acquire records an execution of action by the user and takes an execution
+// slot, or returns a *LimitedError when it is not allowed yet. release frees
+// the slot once the execution finishes and is safe to call more than once.
+func (l *Limiter) Acquire(ctx context.Context, action Action, userID int64) (func(), error) {
+	rule, ok := l.config.Rules[action]
+	if !ok {
+		return nil, fmt.Errorf("no rate limit configured for %s", action)
+	}
+
+	token, err := newToken()
+	if err != nil {
+		return nil, err
+	}
+
+	reply, err := acquireScript.Run(ctx, l.client,
+		[]string{userKey(action, userID), executionsKey},
+		rule.Window.Milliseconds(),
+		rule.Limit,
+		rule.Cooldown.Milliseconds(),
+		l.config.MaxConcurrent,
+		l.config.SlotTTL.Milliseconds(),
+		token,
+	).Slice()
+	if err != nil {
+		return nil, fmt.Errorf("failed to check rate limit: %w", err)
+	}
+	if err := parseReply(reply); err != nil {
+		return nil, err
+	}
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			// The slot is freed even when the request that took it was canceled
+			l.client.ZRem(context.WithoutCancel(ctx), executionsKey, token)
+		})
+	}, nil
+}
+
+// parseReply turns the reply of acquireScript into a *LimitedError, or nil
+// when the execution was allowed
+func parseReply(reply []any) error {
+	if len(reply) != 2 {
+		return fmt.Errorf("unexpected rate limit reply: %v", reply)
+	}
+	reason, _ := reply[0].(string)
+	wait, _ := reply[1].(int64)
+
+	switch Reason(reason) {
+	case "ok":
+		return nil
+	case ReasonCooldown, ReasonRate:
+		return &LimitedError{Reason: Reason(reason), RetryAfter: time.Duration(wait) * time.Millisecond}
+	case ReasonBusy:
+		return &LimitedError{Reason: ReasonBusy, RetryAfter: busyRetryAfter}
+	default:
+		return fmt.Errorf("unexpected rate limit reply: %v", reply)
+	}
+}
+
+func newToken() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("failed to generate execution token: %w", err)
+	}
+	return hex.EncodeToString(b), nil
+}
@@ -0,0 +1,150 @@
+package ratelimit
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseReply(t *testing.T) {
+	assert.NoError(t, parseReply([]any{"ok", int64(0)}))
+
+	err := parseReply([]any{"cooldown", int64(1500)})
+	assert.Equal(t, &LimitedError{Reason: ReasonCooldown, RetryAfter: 1500 * time.Millisecond}, err)
+
+	err = parseReply([]any{"rate", int64(42000)})
+	assert.Equal(t, &LimitedError{Reason: ReasonRate, RetryAfter: 42 * time.Second}, err)
+
+	err = parseReply([]any{"busy", int64(0)})
+	assert.Equal(t, &LimitedError{Reason: ReasonBusy, RetryAfter: busyRetryAfter}, err)
+
+	err = parseReply([]any{"unknown"})
+	assert.Error(t, err)
+	_, limited := err.(*LimitedError)
+	assert.False(t, limited)
+}
+
+func TestNewLimiter_Validation(t *testing.T) {
+	client := redis.NewClient(&redis.Options{})
+	defer client.Close()
+
+	rules := map[Action]Rule{ActionAttempt: {Limit: 10, Window: time.Minute, Cooldown: 5 * time.Second}}
+	limiter, err := NewLimiter(client, Config{Rules: rules, MaxConcurrent: 4})
+	assert.NoError(t, err)
+	assert.Equal(t, DefaultSlotTTL, limiter.config.SlotTTL)
+
+	_, err = NewLimiter(client, Config{Rules: rules})
+	assert.Error(t, err)
+
+	_, err = NewLimiter(client, Config{Rules: map[Action]Rule{ActionRun: {Limit: 0, Window: time.Minute}}, MaxConcurrent: 4})
+	assert.Error(t, err)
+
+	_, err = NewLimiter(client, Config{Rules: map[Action]Rule{ActionRun: {Limit: 1, Window: time.Second, Cooldown: time.Minute}}, MaxConcurrent: 4})
+	assert.Error(t, err)
+}
+
+// newTestLimiter creates a limiter backed by an in memory Redis whose clock
+// starts at now
+func newTestLimiter(t *testing.T, now time.Time, config Config) (*Limiter, *miniredis.Miniredis) {
+	server, err := miniredis.Run()
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(server.Close)
+	server.SetTime(now)
+
+	client := redis.NewClient(&redis.Options{Addr: server.Addr(), Protocol: 2})
+	t.Cleanup(func() { client.Close() })
+
+	limiter, err := NewLimiter(client, config)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return limiter, server
+}
+
+func TestAcquire_SlidingWindow(t *testing.T) {
+	start := time.UnixMilli(1700000000000)
+	limiter, server := newTestLimiter(t, start, Config{
+		Rules:         map[Action]Rule{ActionAttempt: {Limit: 2, Window: time.Minute}},
+		MaxConcurrent: 10,
+	})
+	ctx := context.Background()
+
+	_, err := limiter.Acquire(ctx, ActionAttempt, 1)
+	assert.NoError(t, err)
+	server.SetTime(start.Add(time.Second))
+	_, err = limiter.Acquire(ctx, ActionAttempt, 1)
+	assert.NoError(t, err)
+
+	server.SetTime(start.Add(2 * time.Second))
+	_, err = limiter.Acquire(ctx, ActionAttempt, 1)
+	assert.Equal(t, &LimitedError{Reason: ReasonRate, RetryAfter: 58 * time.Second}, err)
+
+	// Other users and actions are counted apart
+	_, err = limiter.Acquire(ctx, ActionAttempt, 2)
+	assert.NoError(t, err)
+
+	// Only the first execution left the window
+	server.SetTime(start.Add(time.Minute + 500*time.Millisecond))
+	_, err = limiter.Acquire(ctx, ActionAttempt, 1)
+	assert.NoError(t, err)
+	server.SetTime(start.Add(time.Minute + 600*time.Millisecond))
+	_, err = limiter.Acquire(ctx, ActionAttempt, 1)
+	assert.Equal(t, &LimitedError{Reason: ReasonRate, RetryAfter: 400 * time.Millisecond}, err)
+}
+
+func TestAcquire_Cooldown(t *testing.T) {
+	start := time.UnixMilli(1700000000000)
+	limiter, server := newTestLimiter(t, start, Config{
+		Rules:         map[Action]Rule{ActionRun: {Limit: 10, Window: time.Minute, Cooldown: 5 * time.Second}},
+		MaxConcurrent: 10,
+	})
+	ctx := context.Background()
+
+	release, err := limiter.Acquire(ctx, ActionRun, 1)
+	if assert.NoError(t, err) {
+		release()
+	}
+
+	server.SetTime(start.Add(2 * time.Second))
+	_, err = limiter.Acquire(ctx, ActionRun, 1)
+	assert.Equal(t, &LimitedError{Reason: ReasonCooldown, RetryAfter: 3 * time.Second}, err)
+
+	server.SetTime(start.Add(5 * time.Second))
+	_, err = limiter.Acquire(ctx, ActionRun, 1)
+	assert.NoError(t, err)
+}
+
+func TestAcquire_ExecutionSlots(t *testing.T) {
+	start := time.UnixMilli(1700000000000)
+	limiter, server := newTestLimiter(t, start, Config{
+		Rules:         map[Action]Rule{ActionRun: {Limit: 10, Window: time.Minute}},
+		MaxConcurrent: 1,
+		SlotTTL:       time.Minute,
+	})
+	ctx := context.Background()
+
+	release, err := limiter.Acquire(ctx, ActionRun, 1)
+	assert.NoError(t, err)
+
+	_, err = limiter.Acquire(ctx, ActionRun, 2)
+	assert.Equal(t, &LimitedError{Reason: ReasonBusy, RetryAfter: busyRetryAfter}, err)
+
+	release()
+	release()
+	held, err := limiter.Acquire(ctx, ActionRun, 2)
+	assert.NoError(t, err)
+
+	// A slot that is never released is freed once its time to live passes
+	_, err = limiter.Acquire(ctx, ActionRun, 3)
+	assert.Equal(t, &LimitedError{Reason: ReasonBusy, RetryAfter: busyRetryAfter}, err)
+	server.SetTime(start.Add(time.Minute))
+	_, err = limiter.Acquire(ctx, ActionRun, 3)
+	assert.NoError(t, err)
+	held()
+}
@@ -0,0 +1,13 @@
+package repositories
+
+import codelabdb "github.com/ynoacamino/infra-sustainable-classrooms/services/codelab/gen/database"
+
+type AssignmentsRepository struct {
+	*codelabdb.Queries
+}
+
+func NewAssignmentsRepository(db codelabdb.DBTX) *AssignmentsRepository {
+	return &AssignmentsRepository{
+		Queries: codelabdb.New(db),
+	}
+}
@@ -0,0 +1,13 @@
+package repositories
+
+import codelabdb "github.com/ynoacamino/infra-sustainable-classrooms/services/codelab/gen/database"
+
+type HintsRepository struct {
+	*codelabdb.Queries
+}
+
+func NewHintsRepository(db codelabdb.DBTX) *HintsRepository {
+	return &HintsRepository{
+		Queries: codelabdb.New(db),
+	}
+}
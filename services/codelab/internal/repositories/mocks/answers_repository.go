@@ -42,6 +42,21 @@ func (m *MockAnswersRepository) UpdateAnswerCompleted(ctx context.Context, arg c
 	return args.Error(0)
 }
 
+func (m *MockAnswersRepository) UpdateAnswerBestScore(ctx context.Context, arg codelabdb.UpdateAnswerBestScoreParams) error {
+	args := m.Called(ctx, arg)
+	return args.Error(0)
+}
+
+func (m *MockAnswersRepository) RecalculateAnswerScore(ctx context.Context, id int64) (codelabdb.Answer, error) {
+	args := m.Called(ctx, id)
+	return args.Get(0).(codelabdb.Answer), args.Error(1)
+}
+
+func (m *MockAnswersRepository) RecordAnswerHintUsage(ctx context.Context, arg codelabdb.RecordAnswerHintUsageParams) error {
+	args := m.Called(ctx, arg)
+	return args.Error(0)
+}
+
 func (m *MockAnswersRepository) CountCompletedAnswersByExercise(ctx context.Context, exerciseID int64) (int64, error) {
 	args := m.Called(ctx, exerciseID)
 	return args.Get(0).(int64), args.Error(1)
@@ -0,0 +1,68 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/stretchr/testify/mock"
+	codelabdb "github.com/ynoacamino/infra-sustainable-classrooms/services/codelab/gen/database"
+)
+
+// MockAssignmentsRepository is a mock implementation of AssignmentsRepository
+type MockAssignmentsRepository struct {
+	mock.Mock
+}
+
+func (m *MockAssignmentsRepository) CreateAssignment(ctx context.Context, arg codelabdb.CreateAssignmentParams) (int64, error) {
+	args := m.Called(ctx, arg)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockAssignmentsRepository) GetAssignmentById(ctx context.Context, id int64) (codelabdb.Assignment, error) {
+	args := m.Called(ctx, id)
+	return args.Get(0).(codelabdb.Assignment), args.Error(1)
+}
+
+func (m *MockAssignmentsRepository) ListAssignments(ctx context.Context) ([]codelabdb.Assignment, error) {
+	args := m.Called(ctx)
+	return args.Get(0).([]codelabdb.Assignment), args.Error(1)
+}
+
+func (m *MockAssignmentsRepository) UpdateAssignment(ctx context.Context, arg codelabdb.UpdateAssignmentParams) error {
+	args := m.Called(ctx, arg)
+	return args.Error(0)
+}
+
+func (m *MockAssignmentsRepository) DeleteAssignment(ctx context.Context, id int64) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+func (m *MockAssignmentsRepository) AddAssignmentExercise(ctx context.Context, arg codelabdb.AddAssignmentExerciseParams) error {
+	args := m.Called(ctx, arg)
+	return args.Error(0)
+}
+
+func (m *MockAssignmentsRepository) DeleteAssignmentExercises(ctx context.Context, assignmentID int64) error {
+	args := m.Called(ctx, assignmentID)
+	return args.Error(0)
+}
+
+func (m *MockAssignmentsRepository) GetAssignmentExercises(ctx context.Context, assignmentID int64) ([]codelabdb.GetAssignmentExercisesRow, error) {
+	args := m.Called(ctx, assignmentID)
+	return args.Get(0).([]codelabdb.GetAssignmentExercisesRow), args.Error(1)
+}
+
+func (m *MockAssignmentsRepository) ListAssignmentExercises(ctx context.Context) ([]codelabdb.ListAssignmentExercisesRow, error) {
+	args := m.Called(ctx)
+	return args.Get(0).([]codelabdb.ListAssignmentExercisesRow), args.Error(1)
+}
+
+func (m *MockAssignmentsRepository) GetAssignmentsByExercise(ctx context.Context, exerciseID int64) ([]codelabdb.Assignment, error) {
+	args := m.Called(ctx, exerciseID)
+	return args.Get(0).([]codelabdb.Assignment), args.Error(1)
+}
+
+func (m *MockAssignmentsRepository) GetAssignmentGrades(ctx context.Context, arg codelabdb.GetAssignmentGradesParams) ([]codelabdb.GetAssignmentGradesRow, error) {
+	args := m.Called(ctx, arg)
+	return args.Get(0).([]codelabdb.GetAssignmentGradesRow), args.Error(1)
+}
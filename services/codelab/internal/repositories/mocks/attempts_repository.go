@@ -12,9 +12,9 @@ type MockAttemptsRepository struct {
 	mock.Mock
 }
 
-func (m *MockAttemptsRepository) CreateAttempt(ctx context.Context, arg codelabdb.CreateAttemptParams) error {
+func (m *MockAttemptsRepository) CreateAttempt(ctx context.Context, arg codelabdb.CreateAttemptParams) (codelabdb.Attempt, error) {
 	args := m.Called(ctx, arg)
-	return args.Error(0)
+	return args.Get(0).(codelabdb.Attempt), args.Error(1)
 }
 
 func (m *MockAttemptsRepository) GetAttempt(ctx context.Context, id int64) (codelabdb.Attempt, error) {
@@ -22,6 +22,31 @@ func (m *MockAttemptsRepository) GetAttempt(ctx context.Context, id int64) (code
 	return args.Get(0).(codelabdb.Attempt), args.Error(1)
 }
 
+func (m *MockAttemptsRepository) GetAttemptByUser(ctx context.Context, arg codelabdb.GetAttemptByUserParams) (codelabdb.Attempt, error) {
+	args := m.Called(ctx, arg)
+	return args.Get(0).(codelabdb.Attempt), args.Error(1)
+}
+
+func (m *MockAttemptsRepository) UpdateAttemptResult(ctx context.Context, arg codelabdb.UpdateAttemptResultParams) error {
+	args := m.Called(ctx, arg)
+	return args.Error(0)
+}
+
+func (m *MockAttemptsRepository) RegradeAttempt(ctx context.Context, arg codelabdb.RegradeAttemptParams) error {
+	args := m.Called(ctx, arg)
+	return args.Error(0)
+}
+
+func (m *MockAttemptsRepository) DeleteAttempt(ctx context.Context, id int64) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+func (m *MockAttemptsRepository) FailStalePendingAttempts(ctx context.Context, message string) (int64, error) {
+	args := m.Called(ctx, message)
+	return args.Get(0).(int64), args.Error(1)
+}
+
 func (m *MockAttemptsRepository) GetAttemptsByAnswer(ctx context.Context, answerID int64) ([]codelabdb.Attempt, error) {
 	args := m.Called(ctx, answerID)
 	return args.Get(0).([]codelabdb.Attempt), args.Error(1)
@@ -66,3 +91,28 @@ func (m *MockAttemptsRepository) GetUserAttemptsForExercise(ctx context.Context,
 	args := m.Called(ctx, arg)
 	return args.Get(0).([]codelabdb.Attempt), args.Error(1)
 }
+
+func (m *MockAttemptsRepository) CreateAttemptTestResult(ctx context.Context, arg codelabdb.CreateAttemptTestResultParams) error {
+	args := m.Called(ctx, arg)
+	return args.Error(0)
+}
+
+func (m *MockAttemptsRepository) DeleteTestResultsByAttempt(ctx context.Context, attemptID int64) error {
+	args := m.Called(ctx, attemptID)
+	return args.Error(0)
+}
+
+func (m *MockAttemptsRepository) GetTestResultsByAttempt(ctx context.Context, attemptID int64) ([]codelabdb.AttemptTestResult, error) {
+	args := m.Called(ctx, attemptID)
+	return args.Get(0).([]codelabdb.AttemptTestResult), args.Error(1)
+}
+
+func (m *MockAttemptsRepository) GetTestResultsByUserAndExercise(ctx context.Context, arg codelabdb.GetTestResultsByUserAndExerciseParams) ([]codelabdb.AttemptTestResult, error) {
+	args := m.Called(ctx, arg)
+	return args.Get(0).([]codelabdb.AttemptTestResult), args.Error(1)
+}
+
+func (m *MockAttemptsRepository) GetTestFailureCountsByExercise(ctx context.Context, exerciseID int64) ([]codelabdb.GetTestFailureCountsByExerciseRow, error) {
+	args := m.Called(ctx, exerciseID)
+	return args.Get(0).([]codelabdb.GetTestFailureCountsByExerciseRow), args.Error(1)
+}
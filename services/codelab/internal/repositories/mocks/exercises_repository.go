@@ -12,9 +12,9 @@ type MockExercisesRepository struct {
 	mock.Mock
 }
 
-func (m *MockExercisesRepository) CreateExercise(ctx context.Context, arg codelabdb.CreateExerciseParams) error {
+func (m *MockExercisesRepository) CreateExercise(ctx context.Context, arg codelabdb.CreateExerciseParams) (int64, error) {
 	args := m.Called(ctx, arg)
-	return args.Error(0)
+	return args.Get(0).(int64), args.Error(1)
 }
 
 func (m *MockExercisesRepository) GetExerciseById(ctx context.Context, id int64) (codelabdb.Exercise, error) {
@@ -27,16 +27,21 @@ func (m *MockExercisesRepository) GetExerciseToResolveById(ctx context.Context,
 	return args.Get(0).(codelabdb.GetExerciseToResolveByIdRow), args.Error(1)
 }
 
-func (m *MockExercisesRepository) ListExercises(ctx context.Context) ([]codelabdb.Exercise, error) {
-	args := m.Called(ctx)
+func (m *MockExercisesRepository) ListExercises(ctx context.Context, arg codelabdb.ListExercisesParams) ([]codelabdb.Exercise, error) {
+	args := m.Called(ctx, arg)
 	return args.Get(0).([]codelabdb.Exercise), args.Error(1)
 }
 
-func (m *MockExercisesRepository) ListExercisesToResolve(ctx context.Context) ([]codelabdb.ListExercisesToResolveRow, error) {
-	args := m.Called(ctx)
+func (m *MockExercisesRepository) ListExercisesToResolve(ctx context.Context, arg codelabdb.ListExercisesToResolveParams) ([]codelabdb.ListExercisesToResolveRow, error) {
+	args := m.Called(ctx, arg)
 	return args.Get(0).([]codelabdb.ListExercisesToResolveRow), args.Error(1)
 }
 
+func (m *MockExercisesRepository) ListTags(ctx context.Context) ([]codelabdb.ListTagsRow, error) {
+	args := m.Called(ctx)
+	return args.Get(0).([]codelabdb.ListTagsRow), args.Error(1)
+}
+
 func (m *MockExercisesRepository) UpdateExercise(ctx context.Context, arg codelabdb.UpdateExerciseParams) error {
 	args := m.Called(ctx, arg)
 	return args.Error(0)
@@ -46,3 +51,23 @@ func (m *MockExercisesRepository) DeleteExercise(ctx context.Context, id int64)
 	args := m.Called(ctx, id)
 	return args.Error(0)
 }
+
+func (m *MockExercisesRepository) BumpExerciseVersion(ctx context.Context, id int64) (codelabdb.Exercise, error) {
+	args := m.Called(ctx, id)
+	return args.Get(0).(codelabdb.Exercise), args.Error(1)
+}
+
+func (m *MockExercisesRepository) CreateExerciseVersion(ctx context.Context, arg codelabdb.CreateExerciseVersionParams) error {
+	args := m.Called(ctx, arg)
+	return args.Error(0)
+}
+
+func (m *MockExercisesRepository) GetExerciseVersion(ctx context.Context, arg codelabdb.GetExerciseVersionParams) (codelabdb.ExerciseVersion, error) {
+	args := m.Called(ctx, arg)
+	return args.Get(0).(codelabdb.ExerciseVersion), args.Error(1)
+}
+
+func (m *MockExercisesRepository) ListExerciseVersions(ctx context.Context, exerciseID int64) ([]codelabdb.ListExerciseVersionsRow, error) {
+	args := m.Called(ctx, exerciseID)
+	return args.Get(0).([]codelabdb.ListExerciseVersionsRow), args.Error(1)
+}
@@ -0,0 +1,48 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/stretchr/testify/mock"
+	codelabdb "github.com/ynoacamino/infra-sustainable-classrooms/services/codelab/gen/database"
+)
+
+// MockHintsRepository is a mock implementation of HintsRepository
+type MockHintsRepository struct {
+	mock.Mock
+}
+
+func (m *MockHintsRepository) CreateHint(ctx context.Context, arg codelabdb.CreateHintParams) error {
+	args := m.Called(ctx, arg)
+	return args.Error(0)
+}
+
+func (m *MockHintsRepository) GetHintById(ctx context.Context, id int64) (codelabdb.Hint, error) {
+	args := m.Called(ctx, id)
+	return args.Get(0).(codelabdb.Hint), args.Error(1)
+}
+
+func (m *MockHintsRepository) GetHintsByExercise(ctx context.Context, exerciseID int64) ([]codelabdb.Hint, error) {
+	args := m.Called(ctx, exerciseID)
+	return args.Get(0).([]codelabdb.Hint), args.Error(1)
+}
+
+func (m *MockHintsRepository) UpdateHint(ctx context.Context, arg codelabdb.UpdateHintParams) error {
+	args := m.Called(ctx, arg)
+	return args.Error(0)
+}
+
+func (m *MockHintsRepository) DeleteHint(ctx context.Context, id int64) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+func (m *MockHintsRepository) CreateHintUsage(ctx context.Context, arg codelabdb.CreateHintUsageParams) (int64, error) {
+	args := m.Called(ctx, arg)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockHintsRepository) GetHintUsagesByAnswer(ctx context.Context, answerID int64) ([]codelabdb.HintUsage, error) {
+	args := m.Called(ctx, answerID)
+	return args.Get(0).([]codelabdb.HintUsage), args.Error(1)
+}
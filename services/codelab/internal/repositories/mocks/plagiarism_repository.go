@@ -0,0 +1,53 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/stretchr/testify/mock"
+	codelabdb "github.com/ynoacamino/infra-sustainable-classrooms/services/codelab/gen/database"
+)
+
+// MockPlagiarismRepository is a mock implementation of PlagiarismRepository
+type MockPlagiarismRepository struct {
+	mock.Mock
+}
+
+func (m *MockPlagiarismRepository) ListExercisesPendingPlagiarismCheck(ctx context.Context) ([]codelabdb.ListExercisesPendingPlagiarismCheckRow, error) {
+	args := m.Called(ctx)
+	return args.Get(0).([]codelabdb.ListExercisesPendingPlagiarismCheckRow), args.Error(1)
+}
+
+func (m *MockPlagiarismRepository) GetSuccessfulAttemptsByExercise(ctx context.Context, exerciseID int64) ([]codelabdb.GetSuccessfulAttemptsByExerciseRow, error) {
+	args := m.Called(ctx, exerciseID)
+	return args.Get(0).([]codelabdb.GetSuccessfulAttemptsByExerciseRow), args.Error(1)
+}
+
+func (m *MockPlagiarismRepository) DeletePlagiarismMatchesByExercise(ctx context.Context, exerciseID int64) error {
+	args := m.Called(ctx, exerciseID)
+	return args.Error(0)
+}
+
+func (m *MockPlagiarismRepository) CreatePlagiarismMatch(ctx context.Context, arg codelabdb.CreatePlagiarismMatchParams) error {
+	args := m.Called(ctx, arg)
+	return args.Error(0)
+}
+
+func (m *MockPlagiarismRepository) ListPlagiarismMatchesByExercise(ctx context.Context, arg codelabdb.ListPlagiarismMatchesByExerciseParams) ([]codelabdb.PlagiarismMatch, error) {
+	args := m.Called(ctx, arg)
+	return args.Get(0).([]codelabdb.PlagiarismMatch), args.Error(1)
+}
+
+func (m *MockPlagiarismRepository) UpsertPlagiarismCheck(ctx context.Context, arg codelabdb.UpsertPlagiarismCheckParams) error {
+	args := m.Called(ctx, arg)
+	return args.Error(0)
+}
+
+func (m *MockPlagiarismRepository) GetPlagiarismCheck(ctx context.Context, exerciseID int64) (codelabdb.PlagiarismCheck, error) {
+	args := m.Called(ctx, exerciseID)
+	return args.Get(0).(codelabdb.PlagiarismCheck), args.Error(1)
+}
+
+func (m *MockPlagiarismRepository) DeletePlagiarismCheck(ctx context.Context, exerciseID int64) error {
+	args := m.Called(ctx, exerciseID)
+	return args.Error(0)
+}
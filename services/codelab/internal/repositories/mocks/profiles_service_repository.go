@@ -14,6 +14,9 @@ type MockProfilesServiceRepository struct {
 
 func (m *MockProfilesServiceRepository) GetCompleteProfile(ctx context.Context, in *profiles.GetCompleteProfilePayload) (*profiles.CompleteProfileResponse, error) {
 	args := m.Called(ctx, in)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
 	return args.Get(0).(*profiles.CompleteProfileResponse), args.Error(1)
 }
 
@@ -0,0 +1,21 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/ynoacamino/infra-sustainable-classrooms/services/codelab/internal/ratelimit"
+)
+
+// MockRateLimiter is a mock implementation of RateLimiter
+type MockRateLimiter struct {
+	mock.Mock
+}
+
+func (m *MockRateLimiter) Acquire(ctx context.Context, action ratelimit.Action, userID int64) (func(), error) {
+	args := m.Called(ctx, action, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(func()), args.Error(1)
+}
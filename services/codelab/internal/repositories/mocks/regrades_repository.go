@@ -0,0 +1,53 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/stretchr/testify/mock"
+	codelabdb "github.com/ynoacamino/infra-sustainable-classrooms/services/codelab/gen/database"
+)
+
+// MockRegradesRepository is a mock implementation of RegradesRepository
+type MockRegradesRepository struct {
+	mock.Mock
+}
+
+func (m *MockRegradesRepository) CreateRegrade(ctx context.Context, arg codelabdb.CreateRegradeParams) (codelabdb.Regrade, error) {
+	args := m.Called(ctx, arg)
+	return args.Get(0).(codelabdb.Regrade), args.Error(1)
+}
+
+func (m *MockRegradesRepository) GetRegradeById(ctx context.Context, id int64) (codelabdb.Regrade, error) {
+	args := m.Called(ctx, id)
+	return args.Get(0).(codelabdb.Regrade), args.Error(1)
+}
+
+func (m *MockRegradesRepository) GetRunningRegradeByExercise(ctx context.Context, exerciseID int64) (codelabdb.Regrade, error) {
+	args := m.Called(ctx, exerciseID)
+	return args.Get(0).(codelabdb.Regrade), args.Error(1)
+}
+
+func (m *MockRegradesRepository) FailInterruptedRegrades(ctx context.Context, arg codelabdb.FailInterruptedRegradesParams) error {
+	args := m.Called(ctx, arg)
+	return args.Error(0)
+}
+
+func (m *MockRegradesRepository) AddRegradeProgress(ctx context.Context, arg codelabdb.AddRegradeProgressParams) error {
+	args := m.Called(ctx, arg)
+	return args.Error(0)
+}
+
+func (m *MockRegradesRepository) FinishRegrade(ctx context.Context, arg codelabdb.FinishRegradeParams) error {
+	args := m.Called(ctx, arg)
+	return args.Error(0)
+}
+
+func (m *MockRegradesRepository) CreateRegradeChange(ctx context.Context, arg codelabdb.CreateRegradeChangeParams) error {
+	args := m.Called(ctx, arg)
+	return args.Error(0)
+}
+
+func (m *MockRegradesRepository) ListRegradeChanges(ctx context.Context, regradeID int64) ([]codelabdb.RegradeChange, error) {
+	args := m.Called(ctx, regradeID)
+	return args.Get(0).([]codelabdb.RegradeChange), args.Error(1)
+}
@@ -17,6 +17,11 @@ func (m *MockTestsRepository) CreateTest(ctx context.Context, arg codelabdb.Crea
 	return args.Error(0)
 }
 
+func (m *MockTestsRepository) GetTestById(ctx context.Context, id int64) (codelabdb.Test, error) {
+	args := m.Called(ctx, id)
+	return args.Get(0).(codelabdb.Test), args.Error(1)
+}
+
 func (m *MockTestsRepository) GetTestsByExercise(ctx context.Context, exerciseID int64) ([]codelabdb.Test, error) {
 	args := m.Called(ctx, exerciseID)
 	return args.Get(0).([]codelabdb.Test), args.Error(1)
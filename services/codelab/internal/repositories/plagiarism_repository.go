@@ -0,0 +1,13 @@
+package repositories
+
+import codelabdb "github.com/ynoacamino/infra-sustainable-classrooms/services/codelab/gen/database"
+
+type PlagiarismRepository struct {
+	*codelabdb.Queries
+}
+
+func NewPlagiarismRepository(db codelabdb.DBTX) *PlagiarismRepository {
+	return &PlagiarismRepository{
+		Queries: codelabdb.New(db),
+	}
+}
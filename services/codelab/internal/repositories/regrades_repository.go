@@ -0,0 +1,13 @@
+package repositories
+
+import codelabdb "github.com/ynoacamino/infra-sustainable-classrooms/services/codelab/gen/database"
+
+type RegradesRepository struct {
+	*codelabdb.Queries
+}
+
+func NewRegradesRepository(db codelabdb.DBTX) *RegradesRepository {
+	return &RegradesRepository{
+		Queries: codelabdb.New(db),
+	}
+}
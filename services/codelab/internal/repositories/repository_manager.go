@@ -1,6 +1,7 @@
 package repositories
 
 import (
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/ynoacamino/infra-sustainable-classrooms/services/codelab/internal/ports"
 	"google.golang.org/grpc"
@@ -12,6 +13,11 @@ type RepositoryManager struct {
 	ExercisesRepo       ports.ExercisesRepository
 	TestsRepo           ports.TestsRepository
 	AttemptsRepo        ports.AttemptsRepository
+	PlagiarismRepo      ports.PlagiarismRepository
+	HintsRepo           ports.HintsRepository
+	AssignmentsRepo     ports.AssignmentsRepository
+	RegradesRepo        ports.RegradesRepository
+	TxManager           TransactionManager
 	pool                *pgxpool.Pool
 }
 
@@ -24,6 +30,11 @@ func NewRepositoryManager(
 		ExercisesRepo:       NewExercisesRepository(pool),
 		TestsRepo:           NewTestsRepository(pool),
 		AttemptsRepo:        NewAttemptsRepository(pool),
+		PlagiarismRepo:      NewPlagiarismRepository(pool),
+		HintsRepo:           NewHintsRepository(pool),
+		AssignmentsRepo:     NewAssignmentsRepository(pool),
+		RegradesRepo:        NewRegradesRepository(pool),
+		TxManager:           NewTransactionManager(pool),
 		pool:                pool,
 	}
 }
@@ -35,3 +46,31 @@ func (rm *RepositoryManager) Close() {
 func (rm *RepositoryManager) GetPool() *pgxpool.Pool {
 	return rm.pool
 }
+
+var _ ports.TransactionManager = (*TransactionManager)(nil)
+
+// WithTransaction returns repositories that run their queries inside tx
+func (rm *RepositoryManager) WithTransaction(tx pgx.Tx) *TransactionalRepositories {
+	return &TransactionalRepositories{
+		AnswersRepo:     NewAnswersRepository(tx),
+		ExercisesRepo:   NewExercisesRepository(tx),
+		TestsRepo:       NewTestsRepository(tx),
+		AttemptsRepo:    NewAttemptsRepository(tx),
+		PlagiarismRepo:  NewPlagiarismRepository(tx),
+		HintsRepo:       NewHintsRepository(tx),
+		AssignmentsRepo: NewAssignmentsRepository(tx),
+		RegradesRepo:    NewRegradesRepository(tx),
+	}
+}
+
+// TransactionalRepositories groups the repositories bound to a transaction
+type TransactionalRepositories struct {
+	AnswersRepo     ports.AnswersRepository
+	ExercisesRepo   ports.ExercisesRepository
+	TestsRepo       ports.TestsRepository
+	AttemptsRepo    ports.AttemptsRepository
+	PlagiarismRepo  ports.PlagiarismRepository
+	HintsRepo       ports.HintsRepository
+	AssignmentsRepo ports.AssignmentsRepository
+	RegradesRepo    ports.RegradesRepository
+}
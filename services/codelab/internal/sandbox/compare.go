@@ -0,0 +1,156 @@
+package sandbox
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// Validate checks that the input and the expected output of the case can be
+// used with its input format and comparison mode
+func (tc TestCase) Validate() error {
+	switch tc.InputFormat {
+	case "", InputText:
+	case InputJSON:
+		var args []json.RawMessage
+		if err := json.Unmarshal([]byte(tc.Input), &args); err != nil || args == nil {
+			return errors.New("input must be a JSON array of arguments")
+		}
+	default:
+		return fmt.Errorf("unknown input format %q", tc.InputFormat)
+	}
+
+	if tc.Unchecked {
+		return nil
+	}
+
+	switch tc.Compare {
+	case "", CompareExact, CompareTrimmed:
+	case CompareNumeric:
+		if _, err := parseNumber(tc.Expected); err != nil {
+			return errors.New("expected output must be a number")
+		}
+		if tc.Tolerance < 0 || math.IsNaN(tc.Tolerance) {
+			return errors.New("tolerance must not be negative")
+		}
+	case CompareJSON:
+		if !json.Valid([]byte(tc.Expected)) {
+			return errors.New("expected output must be valid JSON")
+		}
+	case CompareUnordered:
+		var elements []json.RawMessage
+		if err := json.Unmarshal([]byte(tc.Expected), &elements); err != nil || elements == nil {
+			return errors.New("expected output must be a JSON array")
+		}
+	case CompareRegex:
+		if _, err := regexp.Compile(tc.Expected); err != nil {
+			return errors.New("expected output must be a valid regular expression: " + err.Error())
+		}
+	default:
+		return fmt.Errorf("unknown comparison %q", tc.Compare)
+	}
+	return nil
+}
+
+// jsonInput reports whether the input holds a JSON array of arguments
+func (tc TestCase) jsonInput() bool {
+	return tc.InputFormat == InputJSON
+}
+
+// matches reports whether an output satisfies the case. text is the string
+// form of the output and encoded its JSON encoding, empty if it has none.
+func (tc TestCase) matches(text, encoded string) bool {
+	switch tc.Compare {
+	case "", CompareExact:
+		return text == tc.Expected
+	case CompareTrimmed:
+		return trimLines(text) == trimLines(tc.Expected)
+	case CompareNumeric:
+		actual, err := parseNumber(text)
+		if err != nil {
+			return false
+		}
+		expected, err := parseNumber(tc.Expected)
+		if err != nil {
+			return false
+		}
+		return math.Abs(actual-expected) <= tc.Tolerance
+	case CompareJSON:
+		var actual, expected any
+		if json.Unmarshal([]byte(encoded), &actual) != nil || json.Unmarshal([]byte(tc.Expected), &expected) != nil {
+			return false
+		}
+		return reflect.DeepEqual(actual, expected)
+	case CompareUnordered:
+		actual, err := canonicalElements(encoded)
+		if err != nil {
+			return false
+		}
+		expected, err := canonicalElements(tc.Expected)
+		if err != nil {
+			return false
+		}
+		return slices.Equal(actual, expected)
+	case CompareRegex:
+		// The whole output must match, not just a part of it
+		pattern, err := regexp.Compile(`^(?:` + tc.Expected + `)$`)
+		if err != nil {
+			return false
+		}
+		return pattern.MatchString(text)
+	default:
+		return false
+	}
+}
+
+// trimLines drops trailing whitespace from every line and surrounding
+// whitespace from the whole text
+func trimLines(text string) string {
+	lines := strings.Split(strings.ReplaceAll(text, "\r\n", "\n"), "\n")
+	for i, line := range lines {
+		lines[i] = strings.TrimRight(line, " \t\r")
+	}
+	return strings.TrimSpace(strings.Join(lines, "\n"))
+}
+
+// parseNumber parses a finite number surrounded by optional whitespace
+func parseNumber(text string) (float64, error) {
+	value, err := strconv.ParseFloat(strings.TrimSpace(text), 64)
+	if err != nil {
+		return 0, err
+	}
+	if math.IsNaN(value) || math.IsInf(value, 0) {
+		return 0, errors.New("number must be finite")
+	}
+	return value, nil
+}
+
+// canonicalElements decodes a JSON array and returns the canonical encoding
+// of its elements in sorted order, so arrays can be compared as multisets
+func canonicalElements(encoded string) ([]string, error) {
+	var elements []any
+	if err := json.Unmarshal([]byte(encoded), &elements); err != nil {
+		return nil, err
+	}
+	if elements == nil {
+		return nil, errors.New("value is not an array")
+	}
+
+	canonical := make([]string, len(elements))
+	for i, element := range elements {
+		// Map keys are sorted when encoding, which makes the encoding canonical
+		data, err := json.Marshal(element)
+		if err != nil {
+			return nil, err
+		}
+		canonical[i] = string(data)
+	}
+	slices.Sort(canonical)
+	return canonical, nil
+}
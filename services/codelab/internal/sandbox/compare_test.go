@@ -0,0 +1,212 @@
+package sandbox
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTestCaseMatches(t *testing.T) {
+	tests := []struct {
+		name     string
+		tc       TestCase
+		text     string
+		encoded  string
+		expected bool
+	}{
+		{
+			name:     "exact match",
+			tc:       TestCase{Expected: "10"},
+			text:     "10",
+			expected: true,
+		},
+		{
+			name:     "exact rejects surrounding whitespace",
+			tc:       TestCase{Compare: CompareExact, Expected: "10"},
+			text:     "10 ",
+			expected: false,
+		},
+		{
+			name:     "trimmed keeps indentation inside the text",
+			tc:       TestCase{Compare: CompareTrimmed, Expected: "a\nb"},
+			text:     "a\n  b",
+			expected: false,
+		},
+		{
+			name:     "trimmed ignores surrounding and trailing whitespace",
+			tc:       TestCase{Compare: CompareTrimmed, Expected: "a\nb"},
+			text:     "\na  \r\nb \n",
+			expected: true,
+		},
+		{
+			name:     "numeric with different formatting",
+			tc:       TestCase{Compare: CompareNumeric, Expected: "5"},
+			text:     "5.0",
+			expected: true,
+		},
+		{
+			name:     "numeric within tolerance",
+			tc:       TestCase{Compare: CompareNumeric, Expected: "3.14159", Tolerance: 0.001},
+			text:     "3.1411",
+			expected: true,
+		},
+		{
+			name:     "numeric outside tolerance",
+			tc:       TestCase{Compare: CompareNumeric, Expected: "3.14159", Tolerance: 0.0001},
+			text:     "3.14",
+			expected: false,
+		},
+		{
+			name:     "numeric rejects non numbers",
+			tc:       TestCase{Compare: CompareNumeric, Expected: "1"},
+			text:     "NaN",
+			expected: false,
+		},
+		{
+			name:     "json deep equal ignores key order and spacing",
+			tc:       TestCase{Compare: CompareJSON, Expected: `{"a": [1, 2], "b": {"c": true}}`},
+			encoded:  `{"b":{"c":true},"a":[1,2]}`,
+			expected: true,
+		},
+		{
+			name:     "json detects different values",
+			tc:       TestCase{Compare: CompareJSON, Expected: `{"a": [1, 2]}`},
+			encoded:  `{"a":[2,1]}`,
+			expected: false,
+		},
+		{
+			name:     "json needs an encodable output",
+			tc:       TestCase{Compare: CompareJSON, Expected: `null`},
+			encoded:  "",
+			expected: false,
+		},
+		{
+			name:     "unordered array with duplicates",
+			tc:       TestCase{Compare: CompareUnordered, Expected: `[1, {"a": 1, "b": 2}, 1, "x"]`},
+			encoded:  `["x",1,{"b":2,"a":1},1]`,
+			expected: true,
+		},
+		{
+			name:     "unordered array with different counts",
+			tc:       TestCase{Compare: CompareUnordered, Expected: `[1, 1, 2]`},
+			encoded:  `[1,2,2]`,
+			expected: false,
+		},
+		{
+			name:     "unordered rejects non arrays",
+			tc:       TestCase{Compare: CompareUnordered, Expected: `[]`},
+			encoded:  `null`,
+			expected: false,
+		},
+		{
+			name:     "regex match",
+			tc:       TestCase{Compare: CompareRegex, Expected: `^id-\d{3}$`},
+			text:     "id-042",
+			expected: true,
+		},
+		{
+			name:     "regex mismatch",
+			tc:       TestCase{Compare: CompareRegex, Expected: `^id-\d{3}$`},
+			text:     "id-42",
+			expected: false,
+		},
+		{
+			name:     "regex matches the whole output",
+			tc:       TestCase{Compare: CompareRegex, Expected: `\d+`},
+			text:     "123",
+			expected: true,
+		},
+		{
+			name:     "regex rejects a partial match",
+			tc:       TestCase{Compare: CompareRegex, Expected: `\d+`},
+			text:     "abc 1 xyz",
+			expected: false,
+		},
+		{
+			name:     "regex anchors every alternative",
+			tc:       TestCase{Compare: CompareRegex, Expected: `yes|no`},
+			text:     "yesno",
+			expected: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, tt.tc.matches(tt.text, tt.encoded))
+		})
+	}
+}
+
+func TestTestCaseValidate(t *testing.T) {
+	tests := []struct {
+		name          string
+		tc            TestCase
+		expectedError string
+	}{
+		{
+			name: "text input with exact comparison",
+			tc:   TestCase{Input: "5", Expected: "10"},
+		},
+		{
+			name: "json arguments",
+			tc:   TestCase{Input: `[1, "two", {"three": 3}]`, InputFormat: InputJSON, Expected: "x"},
+		},
+		{
+			name:          "json input that is not an array",
+			tc:            TestCase{Input: `{"a": 1}`, InputFormat: InputJSON},
+			expectedError: "input must be a JSON array of arguments",
+		},
+		{
+			name:          "json input that is null",
+			tc:            TestCase{Input: `null`, InputFormat: InputJSON},
+			expectedError: "input must be a JSON array of arguments",
+		},
+		{
+			name:          "numeric expected output that is not a number",
+			tc:            TestCase{Compare: CompareNumeric, Expected: "five"},
+			expectedError: "expected output must be a number",
+		},
+		{
+			name:          "negative tolerance",
+			tc:            TestCase{Compare: CompareNumeric, Expected: "5", Tolerance: -1},
+			expectedError: "tolerance must not be negative",
+		},
+		{
+			name:          "invalid json expected output",
+			tc:            TestCase{Compare: CompareJSON, Expected: "{"},
+			expectedError: "expected output must be valid JSON",
+		},
+		{
+			name:          "unordered expected output that is not an array",
+			tc:            TestCase{Compare: CompareUnordered, Expected: `{"a": 1}`},
+			expectedError: "expected output must be a JSON array",
+		},
+		{
+			name:          "invalid regular expression",
+			tc:            TestCase{Compare: CompareRegex, Expected: "(unclosed"},
+			expectedError: "expected output must be a valid regular expression",
+		},
+		{
+			name:          "unknown comparison",
+			tc:            TestCase{Compare: "fuzzy"},
+			expectedError: `unknown comparison "fuzzy"`,
+		},
+		{
+			name: "unchecked cases skip the comparison",
+			tc:   TestCase{Compare: CompareRegex, Expected: "(unclosed", Unchecked: true},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.tc.Validate()
+			if tt.expectedError == "" {
+				assert.NoError(t, err)
+				return
+			}
+			if assert.Error(t, err) {
+				assert.Contains(t, err.Error(), tt.expectedError)
+			}
+		})
+	}
+}
@@ -0,0 +1,65 @@
+package sandbox
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+// ConsoleLevel is the severity a line was written to the console with
+type ConsoleLevel string
+
+const (
+	ConsoleLog   ConsoleLevel = "log"
+	ConsoleWarn  ConsoleLevel = "warn"
+	ConsoleError ConsoleLevel = "error"
+)
+
+// consoleTruncated ends the console output of a case that wrote past the limit
+const consoleTruncated = "... console output truncated\n"
+
+// console collects the lines written by the code while a case runs, up to
+// limit bytes. Warnings and errors are prefixed with their level so they
+// stand out from regular output.
+type console struct {
+	limit     int
+	buf       strings.Builder
+	truncated bool
+}
+
+// write adds a line, truncating it once the limit is reached
+func (c *console) write(level ConsoleLevel, text string) {
+	if c.truncated {
+		return
+	}
+
+	line := text + "\n"
+	if level != ConsoleLog {
+		line = "[" + string(level) + "] " + line
+	}
+	if c.limit > 0 && c.buf.Len()+len(line) > c.limit {
+		line = line[:c.limit-c.buf.Len()]
+		// Do not leave half of a multibyte character behind
+		for i := 0; i < utf8.UTFMax-1 && len(line) > 0; i++ {
+			if r, size := utf8.DecodeLastRuneInString(line); r != utf8.RuneError || size > 1 {
+				break
+			}
+			line = line[:len(line)-1]
+		}
+		c.truncated = true
+	}
+	c.buf.WriteString(line)
+}
+
+// String returns the output collected since the last reset
+func (c *console) String() string {
+	if c.truncated {
+		return c.buf.String() + consoleTruncated
+	}
+	return c.buf.String()
+}
+
+// reset discards the output collected so far
+func (c *console) reset() {
+	c.buf.Reset()
+	c.truncated = false
+}
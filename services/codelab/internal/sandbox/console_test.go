@@ -0,0 +1,132 @@
+package sandbox
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConsole_Truncates(t *testing.T) {
+	c := &console{limit: 10}
+
+	c.write(ConsoleLog, "12345")
+	c.write(ConsoleWarn, "abc")
+	c.write(ConsoleLog, "ignored")
+
+	assert.Equal(t, "12345\n[war"+consoleTruncated, c.String())
+
+	c.reset()
+	c.write(ConsoleLog, "ok")
+	assert.Equal(t, "ok\n", c.String())
+}
+
+func TestConsole_KeepsWholeCharacters(t *testing.T) {
+	c := &console{limit: 3}
+
+	c.write(ConsoleLog, "aaé")
+	c.write(ConsoleLog, "b")
+
+	// é takes two bytes and would be cut in half
+	assert.Equal(t, "aa"+consoleTruncated, c.String())
+}
+
+func TestJavaScriptRunner_Console(t *testing.T) {
+	code := `function solution(input) {
+	console.log("input", input, {a: [1, 2]});
+	console.warn("careful");
+	console.error(new TypeError("bad"));
+	return input;
+}`
+	executor := NewExecutor(DefaultOptions())
+
+	result, err := executor.Run(context.Background(), LanguageJavaScript, code, []TestCase{{Input: "x", Expected: "x"}})
+
+	if assert.NoError(t, err) && assert.Len(t, result.Cases, 1) {
+		assert.Equal(t, StatusPassed, result.Cases[0].Status)
+		assert.Equal(t, "input x {\"a\":[1,2]}\n[warn] careful\n[error] TypeError: bad\n", result.Cases[0].Console)
+	}
+}
+
+func TestJavaScriptRunner_ConsoleLimit(t *testing.T) {
+	code := `function solution(input) {
+	for (var i = 0; i < 100000; i++) {
+		console.log("line " + i);
+	}
+	return input;
+}`
+	opts := DefaultOptions()
+	opts.Limits.MaxConsoleBytes = 100
+	executor := NewExecutor(opts)
+
+	result, err := executor.Run(context.Background(), LanguageJavaScript, code, []TestCase{{Input: "x", Expected: "x"}})
+
+	if assert.NoError(t, err) && assert.Len(t, result.Cases, 1) {
+		console := result.Cases[0].Console
+		assert.Equal(t, 100+len(consoleTruncated), len(console))
+		assert.True(t, strings.HasSuffix(console, consoleTruncated))
+	}
+}
+
+func TestJavaScriptRunner_StackTrace(t *testing.T) {
+	code := `function check(n) {
+  if (n < 0) {
+    throw new RangeError("negative: " + n);
+  }
+  return n;
+}
+
+function solution(input) {
+  return check(Number(input));
+}`
+	executor := NewExecutor(DefaultOptions())
+
+	result, err := executor.Run(context.Background(), LanguageJavaScript, code, []TestCase{{Input: "-1", Expected: "1"}})
+
+	if assert.NoError(t, err) && assert.Len(t, result.Cases, 1) {
+		c := result.Cases[0]
+		assert.Equal(t, StatusError, c.Status)
+		assert.Equal(t, "RangeError: negative: -1", c.Error)
+		assert.Equal(t, "RangeError: negative: -1\n"+
+			"    at check (solution.js:3:11)\n"+
+			"    at solution (solution.js:9:15)", c.Stack)
+	}
+}
+
+func TestJavaScriptRunner_TopLevelException(t *testing.T) {
+	code := "var x = null;\nx.f();\nfunction solution(input) { return input; }"
+	executor := NewExecutor(DefaultOptions())
+
+	_, err := executor.Run(context.Background(), LanguageJavaScript, code, []TestCase{{Input: "x", Expected: "x"}})
+
+	var codeErr *CodeError
+	if assert.ErrorAs(t, err, &codeErr) {
+		assert.Equal(t, "TypeError: Cannot read property 'f' of undefined or null", codeErr.Message)
+		assert.Contains(t, codeErr.Stack, "at <anonymous> (solution.js:2:")
+	}
+}
+
+func TestStarlarkRunner_StackTrace(t *testing.T) {
+	code := `def check(n):
+    if n < 0:
+        fail("negative: %d" % n)
+    return n
+
+def solution(input):
+    print("checking", input)
+    return check(int(input))`
+	executor := NewExecutor(DefaultOptions())
+
+	result, err := executor.Run(context.Background(), LanguageStarlark, code, []TestCase{{Input: "-1", Expected: "1"}})
+
+	if assert.NoError(t, err) && assert.Len(t, result.Cases, 1) {
+		c := result.Cases[0]
+		assert.Equal(t, StatusError, c.Status)
+		assert.Equal(t, "fail: negative: -1", c.Error)
+		assert.Equal(t, "checking -1\n", c.Console)
+		assert.Equal(t, "fail: negative: -1\n"+
+			"    at check (solution.star:3:13)\n"+
+			"    at solution (solution.star:8:17)", c.Stack)
+	}
+}
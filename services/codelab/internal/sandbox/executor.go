@@ -0,0 +1,146 @@
+package sandbox
+
+import (
+	"context"
+	"time"
+)
+
+// Options configures an Executor
+type Options struct {
+	Workers      int           // Maximum number of executions admitted at the same time, which still run one at a time
+	QueueTimeout time.Duration // How long an execution may wait for a free worker
+	Limits       Limits
+}
+
+// DefaultOptions returns the options used when no configuration is given
+func DefaultOptions() Options {
+	return Options{
+		Workers:      4,
+		QueueTimeout: 5 * time.Second,
+		Limits: Limits{
+			TestTimeout:      2 * time.Second,
+			TotalTimeout:     10 * time.Second,
+			MaxCallStackSize: 1024,
+			MaxMemoryBytes:   64 << 20,
+			MaxConsoleBytes:  16 << 10,
+		},
+	}
+}
+
+// Executor runs student code in a bounded pool of workers
+type Executor struct {
+	slots        chan struct{}
+	queueTimeout time.Duration
+	limits       Limits
+	runners      map[Language]Runner
+}
+
+// NewExecutor creates an executor with the given options
+func NewExecutor(opts Options) *Executor {
+	defaults := DefaultOptions()
+	if opts.Workers <= 0 {
+		opts.Workers = defaults.Workers
+	}
+	if opts.QueueTimeout <= 0 {
+		opts.QueueTimeout = defaults.QueueTimeout
+	}
+	if opts.Limits.TestTimeout <= 0 {
+		opts.Limits.TestTimeout = defaults.Limits.TestTimeout
+	}
+	if opts.Limits.TotalTimeout <= 0 {
+		opts.Limits.TotalTimeout = defaults.Limits.TotalTimeout
+	}
+	if opts.Limits.MaxCallStackSize <= 0 {
+		opts.Limits.MaxCallStackSize = defaults.Limits.MaxCallStackSize
+	}
+	if opts.Limits.MaxMemoryBytes == 0 {
+		opts.Limits.MaxMemoryBytes = defaults.Limits.MaxMemoryBytes
+	}
+	if opts.Limits.MaxConsoleBytes <= 0 {
+		opts.Limits.MaxConsoleBytes = defaults.Limits.MaxConsoleBytes
+	}
+
+	return &Executor{
+		slots:        make(chan struct{}, opts.Workers),
+		queueTimeout: opts.QueueTimeout,
+		limits:       opts.Limits,
+		runners: map[Language]Runner{
+			LanguageJavaScript: javaScriptRunner{},
+			LanguageStarlark:   starlarkRunner{},
+		},
+	}
+}
+
+// Limits returns the limits applied to every execution
+func (e *Executor) Limits() Limits {
+	return e.limits
+}
+
+// Supports reports whether the executor has a runner for the language
+func (e *Executor) Supports(language Language) bool {
+	_, ok := e.runners[language]
+	return ok
+}
+
+// Run executes the code against the given test cases once a worker is free.
+// Load failures are reported as errors, while per test outcomes (including
+// timeouts and resource violations) are reported in the Result.
+func (e *Executor) Run(ctx context.Context, language Language, code string, cases []TestCase) (*Result, error) {
+	return e.RunWithProgress(ctx, language, code, cases, nil)
+}
+
+// RunWithProgress is like Run, and also reports the outcome of every test
+// case to progress as soon as it is known
+func (e *Executor) RunWithProgress(ctx context.Context, language Language, code string, cases []TestCase, progress Progress) (*Result, error) {
+	runner, ok := e.runners[language]
+	if !ok {
+		return nil, ErrUnsupportedLanguage
+	}
+
+	if err := e.acquire(ctx); err != nil {
+		return nil, err
+	}
+	defer e.release()
+
+	// The budget starts once the execution runs, not while it waits its turn
+	ctx, cancel := context.WithTimeout(ctx, e.limits.TotalTimeout)
+	defer cancel()
+
+	return runner.Run(ctx, code, cases, e.limits, progress)
+}
+
+// running lets a single execution run at a time in the process, so the heap
+// growth seen by the watchdog is not inflated by other executions
+var running = make(chan struct{}, 1)
+
+// acquire blocks until a worker is free and no other execution is running, the
+// queue timeout expires or ctx is done
+func (e *Executor) acquire(ctx context.Context) error {
+	timer := time.NewTimer(e.queueTimeout)
+	defer timer.Stop()
+
+	select {
+	case e.slots <- struct{}{}:
+	case <-timer.C:
+		return ErrUnavailable
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+
+	select {
+	case running <- struct{}{}:
+		return nil
+	case <-timer.C:
+		<-e.slots
+		return ErrUnavailable
+	case <-ctx.Done():
+		<-e.slots
+		return ctx.Err()
+	}
+}
+
+// release frees the worker taken by acquire and lets the next execution run
+func (e *Executor) release() {
+	<-running
+	<-e.slots
+}
@@ -0,0 +1,59 @@
+package sandbox
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// busyCode keeps the CPU busy for about 50ms before answering
+const busyCode = `function solution(input) {
+	var end = Date.now() + 50;
+	while (Date.now() < end) {}
+	return input;
+}`
+
+func TestExecutor_ConcurrentSubmissionsDoNotTimeOut(t *testing.T) {
+	// Eight executions take longer together than the budget of any of them
+	opts := DefaultOptions()
+	opts.Limits.TestTimeout = 200 * time.Millisecond
+	opts.Limits.TotalTimeout = 300 * time.Millisecond
+	executor := NewExecutor(opts)
+
+	const submissions = 8
+	results := make([]*Result, submissions)
+	errs := make([]error, submissions)
+	var wg sync.WaitGroup
+	for i := range submissions {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			results[i], errs[i] = executor.Run(context.Background(), LanguageJavaScript, busyCode, []TestCase{{Input: "x", Expected: "x"}})
+		}()
+	}
+	wg.Wait()
+
+	for i := range submissions {
+		if assert.NoError(t, errs[i]) {
+			assert.Equal(t, StatusPassed, results[i].Status, "submission %d", i)
+		}
+	}
+}
+
+func TestExecutor_QueueTimeoutWhileAnotherRuns(t *testing.T) {
+	opts := DefaultOptions()
+	opts.QueueTimeout = 20 * time.Millisecond
+	executor := NewExecutor(opts)
+
+	running <- struct{}{}
+	defer func() { <-running }()
+
+	_, err := executor.Run(context.Background(), LanguageJavaScript, busyCode, []TestCase{{Input: "x", Expected: "x"}})
+
+	assert.ErrorIs(t, err, ErrUnavailable)
+	// The worker is given back for the next execution
+	assert.Len(t, executor.slots, 0)
+}
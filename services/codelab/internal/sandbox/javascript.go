@@ -0,0 +1,233 @@
+package sandbox
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/dop251/goja"
+)
+
+// harnessSource calls the entry point and encodes its result inside the VM,
+// so user defined toString/toJSON run under the same limits as the code.
+// The builtins are captured before the student code runs so they cannot be
+// replaced. JSON inputs hold the list of arguments.
+const harnessSource = `(function (toString, stringify, parse, apply, isArray) {
+	return function (solution, input, jsonInput) {
+		var args = [input];
+		if (jsonInput) {
+			args = parse(input);
+			if (!isArray(args)) {
+				args = [args];
+			}
+		}
+		var output = apply(solution, undefined, args);
+		var encoded;
+		try {
+			encoded = stringify(output);
+		} catch (e) {}
+		return [toString(output), encoded === undefined ? "" : encoded];
+	};
+})(String, JSON.stringify, JSON.parse, Reflect.apply, Array.isArray)`
+
+// consoleSource builds the console object from a function writing a line at
+// a level. Plain objects and arrays are printed as JSON and everything else as
+// with String, using builtins captured before the student code runs.
+const consoleSource = `(function (toString, stringify, Error) {
+	function format(args) {
+		var text = "";
+		for (var i = 0; i < args.length; i++) {
+			var arg = args[i];
+			var formatted;
+			if (typeof arg === "object" && arg !== null && !(arg instanceof Error)) {
+				try {
+					formatted = stringify(arg);
+				} catch (e) {}
+			}
+			text += (i > 0 ? " " : "") + (formatted === undefined ? toString(arg) : formatted);
+		}
+		return text;
+	}
+	return function (write) {
+		function method(level) {
+			return function () {
+				write(level, format(arguments));
+			};
+		}
+		return {
+			log: method("log"),
+			info: method("log"),
+			debug: method("log"),
+			warn: method("warn"),
+			error: method("error")
+		};
+	};
+})(String, JSON.stringify, Error)`
+
+// jsRuntime is a goja runtime bound to the limits of a single execution
+type jsRuntime struct {
+	vm       *goja.Runtime
+	limits   Limits
+	console  *console
+	toString goja.Callable
+}
+
+// installConsole exposes a console object whose output is captured
+func (r *jsRuntime) installConsole() error {
+	factoryValue, err := r.vm.RunString(consoleSource)
+	if err != nil {
+		return err
+	}
+	factory, _ := goja.AssertFunction(factoryValue)
+
+	write := func(level string, text string) {
+		r.console.write(ConsoleLevel(level), text)
+	}
+	console, err := factory(goja.Undefined(), r.vm.ToValue(write))
+	if err != nil {
+		return err
+	}
+	return r.vm.Set("console", console)
+}
+
+// thrown describes an exception thrown by the code. It runs under the
+// execution limits, as converting the exception to a string may call a
+// toString defined by the student.
+func (r *jsRuntime) thrown(err error) error {
+	var exception *goja.Exception
+	if !errors.As(err, &exception) || exception.Value() == nil {
+		return err
+	}
+
+	message := "Uncaught exception"
+	if value, err := r.toString(goja.Undefined(), exception.Value()); err == nil {
+		message = value.String()
+	}
+
+	var frames []stackFrame
+	for _, frame := range exception.Stack() {
+		if frame.SrcName() != javaScriptFile {
+			continue
+		}
+		position := frame.Position()
+		frames = append(frames, stackFrame{
+			function: frame.FuncName(),
+			line:     position.Line,
+			column:   position.Column,
+		})
+	}
+	return &thrownError{message: message, stack: formatStack(message, javaScriptFile, frames)}
+}
+
+// call runs fn under the execution limits and classifies how it finished
+func (r *jsRuntime) call(ctx context.Context, fn func() error) (Status, error) {
+	if ctx.Err() != nil {
+		return StatusTimeout, errBudgetExhausted
+	}
+
+	var err error
+	reason := watch(ctx, r.limits.TestTimeout, r.limits.MaxMemoryBytes, func(reason error) {
+		r.vm.Interrupt(reason)
+	}, func() {
+		err = r.thrown(fn())
+	})
+	r.vm.ClearInterrupt()
+
+	if reason != nil {
+		return statusFor(reason), reason
+	}
+	if err == nil {
+		return StatusPassed, nil
+	}
+
+	var stackOverflow *goja.StackOverflowError
+	if errors.As(err, &stackOverflow) {
+		return StatusResourceExceeded, errStackOverflow
+	}
+
+	return StatusError, err
+}
+
+// javaScriptRunner runs JavaScript code with goja
+type javaScriptRunner struct{}
+
+// Run loads the code in a fresh goja runtime and runs its entry point against
+// every test case. Cases left once the attempt budget is spent are reported
+// as timed out.
+func (javaScriptRunner) Run(ctx context.Context, code string, cases []TestCase, limits Limits, progress Progress) (*Result, error) {
+	vm := goja.New()
+	vm.SetMaxCallStackSize(limits.MaxCallStackSize)
+	toString, _ := goja.AssertFunction(vm.Get("String"))
+	rt := &jsRuntime{
+		vm:       vm,
+		limits:   limits,
+		console:  &console{limit: limits.MaxConsoleBytes},
+		toString: toString,
+	}
+	if err := rt.installConsole(); err != nil {
+		return nil, err
+	}
+
+	harnessValue, err := vm.RunString(harnessSource)
+	if err != nil {
+		return nil, err
+	}
+	harness, _ := goja.AssertFunction(harnessValue)
+
+	status, err := rt.call(ctx, func() error {
+		_, err := vm.RunScript(javaScriptFile, code)
+		return err
+	})
+	switch status {
+	case StatusPassed:
+	case StatusError:
+		return nil, &CodeError{Message: err.Error(), Stack: stackOf(err)}
+	default:
+		return &Result{Status: status, Error: err.Error()}, nil
+	}
+
+	solution := vm.Get(EntryPoint)
+	if _, ok := goja.AssertFunction(solution); !ok {
+		return nil, ErrMissingEntryPoint
+	}
+
+	results := make([]CaseResult, 0, len(cases))
+	for i, tc := range cases {
+		var value goja.Value
+		rt.console.reset()
+		start := time.Now()
+		status, err := rt.call(ctx, func() error {
+			var err error
+			value, err = harness(goja.Undefined(), solution, vm.ToValue(tc.Input), vm.ToValue(tc.jsonInput()))
+			return err
+		})
+
+		result := CaseResult{
+			TestID:   tc.ID,
+			Status:   status,
+			Console:  rt.console.String(),
+			Duration: time.Since(start),
+		}
+		if err != nil {
+			result.Error = err.Error()
+			result.Stack = stackOf(err)
+		} else {
+			var encoded string
+			if outcome, _ := value.Export().([]interface{}); len(outcome) == 2 {
+				result.Output, _ = outcome[0].(string)
+				encoded, _ = outcome[1].(string)
+			}
+			switch {
+			case tc.Unchecked:
+				result.Status = StatusCompleted
+			case !tc.matches(result.Output, encoded):
+				result.Status = StatusFailed
+			}
+		}
+
+		results = append(results, result)
+		progress.report(i, result)
+	}
+
+	return newResult(results), nil
+}
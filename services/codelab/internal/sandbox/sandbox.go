@@ -0,0 +1,163 @@
+// Package sandbox executes untrusted student code under wall-clock, stack
+// depth and memory limits, with a bounded number of concurrent executions.
+// Each supported language is run in process by its own Runner.
+//
+// The memory limit is enforced on the heap of the whole process, which the
+// runtimes do not split per execution. Executions therefore run one at a time,
+// and the time spent waiting for the previous one never counts against the
+// limits of the next.
+package sandbox
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// Language identifies the programming language of an exercise
+type Language string
+
+const (
+	LanguageJavaScript Language = "javascript"
+	LanguageStarlark   Language = "starlark"
+)
+
+// Status describes how the execution of student code finished
+type Status string
+
+const (
+	StatusPassed           Status = "passed"
+	StatusFailed           Status = "failed"
+	StatusCompleted        Status = "completed" // Ran without errors, with no expected output to check
+	StatusError            Status = "error"
+	StatusTimeout          Status = "timeout"
+	StatusResourceExceeded Status = "resource_exceeded"
+)
+
+// EntryPoint is the function every submission must define
+const EntryPoint = "solution"
+
+var (
+	// ErrUnavailable is returned when no execution slot frees up in time
+	ErrUnavailable = errors.New("no execution worker available")
+	// ErrMissingEntryPoint is returned when the code does not define the entry point
+	ErrMissingEntryPoint = errors.New("code must define a '" + EntryPoint + "' function")
+	// ErrUnsupportedLanguage is returned when no runner handles the language
+	ErrUnsupportedLanguage = errors.New("unsupported language")
+)
+
+// Runner executes code written in a single language
+type Runner interface {
+	// Run loads the code and calls its entry point with the input of every
+	// test case, reporting each outcome to progress as soon as it is known.
+	// ctx is done once the attempt budget is spent.
+	Run(ctx context.Context, code string, cases []TestCase, limits Limits, progress Progress) (*Result, error)
+}
+
+// Progress receives the outcome of every test case while the code runs,
+// along with the index of the case. A nil Progress ignores them.
+type Progress func(index int, result CaseResult)
+
+// report calls p when it is set
+func (p Progress) report(index int, result CaseResult) {
+	if p != nil {
+		p(index, result)
+	}
+}
+
+// CodeError reports student code that could not be loaded, such as syntax
+// errors or exceptions thrown at the top level
+type CodeError struct {
+	Message string
+	Stack   string // Stack trace through the code, for exceptions
+}
+
+func (e *CodeError) Error() string {
+	return e.Message
+}
+
+// Limits bounds the resources a single execution may use
+type Limits struct {
+	TestTimeout      time.Duration // Wall-clock limit for loading the code and for each test case
+	TotalTimeout     time.Duration // Wall-clock budget for the whole attempt
+	MaxCallStackSize int           // Maximum function call depth
+	MaxMemoryBytes   uint64        // Maximum growth of the process heap while the code runs
+	MaxConsoleBytes  int           // Maximum console output kept for each test case
+}
+
+// InputFormat tells how the input of a test case is passed to the entry point
+type InputFormat string
+
+const (
+	InputText InputFormat = "text" // The raw input is the only argument
+	InputJSON InputFormat = "json" // The input is a JSON array with the arguments
+)
+
+// CompareMode selects how the output of a test case is checked
+type CompareMode string
+
+const (
+	CompareExact     CompareMode = "exact"     // Same string form
+	CompareTrimmed   CompareMode = "trimmed"   // Same string form ignoring surrounding and trailing whitespace
+	CompareNumeric   CompareMode = "numeric"   // Numbers within Tolerance of each other
+	CompareJSON      CompareMode = "json"      // Deep equal JSON values
+	CompareUnordered CompareMode = "unordered" // JSON arrays with the same elements in any order
+	CompareRegex     CompareMode = "regex"     // Whole string form matched by the expected regular expression
+)
+
+// TestCase is a single input the code is evaluated against. Empty formats
+// and modes default to text input and exact comparison.
+type TestCase struct {
+	ID          int64
+	Input       string
+	Expected    string
+	InputFormat InputFormat
+	Compare     CompareMode
+	Tolerance   float64 // Allowed absolute difference for numeric comparison
+	Unchecked   bool    // Run the input without comparing the output to Expected
+}
+
+// CaseResult is the outcome of running the code against one test case
+type CaseResult struct {
+	TestID   int64
+	Status   Status
+	Output   string
+	Error    string
+	Console  string // Output written to the console while the case ran
+	Stack    string // Stack trace through the code when it threw
+	Duration time.Duration
+}
+
+// Result is the outcome of running the code against a set of test cases
+type Result struct {
+	Status Status
+	Error  string
+	Cases  []CaseResult
+}
+
+// newResult builds a Result whose status is the first non passing case, if
+// any. Runs made only of unchecked cases are reported as completed.
+func newResult(cases []CaseResult) *Result {
+	status := StatusPassed
+	for _, c := range cases {
+		switch c.Status {
+		case StatusPassed:
+		case StatusCompleted:
+			status = StatusCompleted
+		default:
+			return &Result{Status: c.Status, Error: c.Error, Cases: cases}
+		}
+	}
+	return &Result{Status: status, Cases: cases}
+}
+
+// Passed returns how many test cases passed
+func (r *Result) Passed() int {
+	passed := 0
+	for _, c := range r.Cases {
+		if c.Status == StatusPassed {
+			passed++
+		}
+	}
+	return passed
+}
@@ -0,0 +1,68 @@
+package sandbox
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// Names the student code is loaded under, so its frames can be told apart
+// from the ones of the harness
+const (
+	javaScriptFile = "solution.js"
+	starlarkFile   = "solution.star"
+)
+
+// maxStackFrames bounds the frames kept in a stack trace
+const maxStackFrames = 20
+
+// stackFrame is a call in the student code, with 1-based line and column
+type stackFrame struct {
+	function string
+	line     int
+	column   int
+}
+
+// thrownError is an error raised by the student code, described by its
+// message alone, along with the stack trace through the student code
+type thrownError struct {
+	message string
+	stack   string
+}
+
+func (e *thrownError) Error() string {
+	return e.message
+}
+
+// stackOf returns the stack trace of an error raised by the student code, if
+// it has one
+func stackOf(err error) string {
+	var thrown *thrownError
+	if errors.As(err, &thrown) {
+		return thrown.stack
+	}
+	return ""
+}
+
+// formatStack renders the message of an error followed by the frames of the
+// student code it went through, innermost first
+func formatStack(message, file string, frames []stackFrame) string {
+	if len(frames) == 0 {
+		return ""
+	}
+
+	var b strings.Builder
+	b.WriteString(message)
+	for i, frame := range frames {
+		if i == maxStackFrames {
+			fmt.Fprintf(&b, "\n    ... %d more", len(frames)-i)
+			break
+		}
+		function := frame.function
+		if function == "" {
+			function = "<anonymous>"
+		}
+		fmt.Fprintf(&b, "\n    at %s (%s:%d:%d)", function, file, frame.line, frame.column)
+	}
+	return b.String()
+}
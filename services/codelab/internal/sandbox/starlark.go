@@ -0,0 +1,190 @@
+package sandbox
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	starlarkjson "go.starlark.net/lib/json"
+	"go.starlark.net/starlark"
+	"go.starlark.net/syntax"
+)
+
+// starlarkFileOptions enables the Python features students expect. Recursion
+// stays disabled since the depth of a Starlark call stack cannot be bounded.
+var starlarkFileOptions = &syntax.FileOptions{
+	Set:             true,
+	While:           true,
+	TopLevelControl: true,
+	GlobalReassign:  true,
+}
+
+// starlarkPredeclared are the modules available to student code
+var starlarkPredeclared = starlark.StringDict{
+	"json": starlarkjson.Module,
+}
+
+// starlarkRunner runs Starlark, a Python dialect, with go.starlark.net
+type starlarkRunner struct{}
+
+// starlarkRuntime holds the state shared by the calls of a single execution
+type starlarkRuntime struct {
+	limits  Limits
+	console *console
+}
+
+// call runs fn on a fresh thread under the execution limits and classifies how
+// it finished. A cancelled thread stays cancelled, so threads are not reused.
+func (r *starlarkRuntime) call(ctx context.Context, fn func(thread *starlark.Thread) error) (Status, error) {
+	if ctx.Err() != nil {
+		return StatusTimeout, errBudgetExhausted
+	}
+
+	thread := &starlark.Thread{
+		Name: EntryPoint,
+		Print: func(_ *starlark.Thread, msg string) {
+			r.console.write(ConsoleLog, msg)
+		},
+	}
+
+	var err error
+	reason := watch(ctx, r.limits.TestTimeout, r.limits.MaxMemoryBytes, func(reason error) {
+		thread.Cancel(reason.Error())
+	}, func() {
+		err = fn(thread)
+	})
+
+	if reason != nil {
+		return statusFor(reason), reason
+	}
+	if err != nil {
+		return StatusError, starlarkThrown(err)
+	}
+	return StatusPassed, nil
+}
+
+// starlarkThrown adds the stack trace through the student code to an error
+// raised while evaluating it
+func starlarkThrown(err error) error {
+	var evalErr *starlark.EvalError
+	if !errors.As(err, &evalErr) {
+		return err
+	}
+
+	var frames []stackFrame
+	for i := range evalErr.CallStack {
+		frame := evalErr.CallStack.At(i)
+		if frame.Pos.Filename() != starlarkFile {
+			continue
+		}
+		frames = append(frames, stackFrame{
+			function: frame.Name,
+			line:     int(frame.Pos.Line),
+			column:   int(frame.Pos.Col),
+		})
+	}
+	return &thrownError{message: evalErr.Msg, stack: formatStack(evalErr.Msg, starlarkFile, frames)}
+}
+
+// Run loads the code and runs its entry point against every test case. The
+// string form of the output has no quotes for strings, like str() in Python.
+func (starlarkRunner) Run(ctx context.Context, code string, cases []TestCase, limits Limits, progress Progress) (*Result, error) {
+	rt := &starlarkRuntime{limits: limits, console: &console{limit: limits.MaxConsoleBytes}}
+
+	var globals starlark.StringDict
+	status, err := rt.call(ctx, func(thread *starlark.Thread) error {
+		var err error
+		globals, err = starlark.ExecFileOptions(starlarkFileOptions, thread, starlarkFile, code, starlarkPredeclared)
+		return err
+	})
+	switch status {
+	case StatusPassed:
+	case StatusError:
+		return nil, &CodeError{Message: err.Error(), Stack: stackOf(err)}
+	default:
+		return &Result{Status: status, Error: err.Error()}, nil
+	}
+
+	solution, ok := globals[EntryPoint].(starlark.Callable)
+	if !ok {
+		return nil, ErrMissingEntryPoint
+	}
+
+	results := make([]CaseResult, 0, len(cases))
+	for i, tc := range cases {
+		var value starlark.Value
+		var encoded string
+		rt.console.reset()
+		start := time.Now()
+		status, err := rt.call(ctx, func(thread *starlark.Thread) error {
+			args, err := starlarkArgs(thread, tc)
+			if err != nil {
+				return err
+			}
+			value, err = starlark.Call(thread, solution, args, nil)
+			if err != nil {
+				return err
+			}
+			// Values without a JSON form only fail the JSON based comparisons
+			if data, err := starlark.Call(thread, starlarkjson.Module.Members["encode"], starlark.Tuple{value}, nil); err == nil {
+				encoded, _ = starlark.AsString(data)
+			}
+			return nil
+		})
+
+		result := CaseResult{
+			TestID:   tc.ID,
+			Status:   status,
+			Console:  rt.console.String(),
+			Duration: time.Since(start),
+		}
+		if err != nil {
+			result.Error = err.Error()
+			result.Stack = stackOf(err)
+		} else {
+			result.Output = starlarkString(value)
+			switch {
+			case tc.Unchecked:
+				result.Status = StatusCompleted
+			case !tc.matches(result.Output, encoded):
+				result.Status = StatusFailed
+			}
+		}
+
+		results = append(results, result)
+		progress.report(i, result)
+	}
+
+	return newResult(results), nil
+}
+
+// starlarkArgs returns the arguments the entry point is called with. JSON
+// inputs are decoded by the json module so they become Starlark values.
+func starlarkArgs(thread *starlark.Thread, tc TestCase) (starlark.Tuple, error) {
+	if !tc.jsonInput() {
+		return starlark.Tuple{starlark.String(tc.Input)}, nil
+	}
+
+	decoded, err := starlark.Call(thread, starlarkjson.Module.Members["decode"], starlark.Tuple{starlark.String(tc.Input)}, nil)
+	if err != nil {
+		return nil, err
+	}
+	list, ok := decoded.(*starlark.List)
+	if !ok {
+		return starlark.Tuple{decoded}, nil
+	}
+
+	args := make(starlark.Tuple, list.Len())
+	for i := range args {
+		args[i] = list.Index(i)
+	}
+	return args, nil
+}
+
+// starlarkString returns the string form of a value, without quotes for strings
+func starlarkString(value starlark.Value) string {
+	if s, ok := starlark.AsString(value); ok {
+		return s
+	}
+	return value.String()
+}
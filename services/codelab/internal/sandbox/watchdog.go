@@ -0,0 +1,102 @@
+package sandbox
+
+import (
+	"context"
+	"errors"
+	"runtime/metrics"
+	"sync"
+	"time"
+)
+
+// memoryCheckInterval is how often the heap is sampled while code runs
+const memoryCheckInterval = 10 * time.Millisecond
+
+// heapObjectsMetric tracks the bytes held by heap objects. Neither goja nor
+// starlark account for the memory of a single runtime, so the limit applies to
+// the growth of the heap of the whole process while the code runs.
+const heapObjectsMetric = "/memory/classes/heap/objects:bytes"
+
+var (
+	errTimeLimit       = errors.New("time limit exceeded")
+	errBudgetExhausted = errors.New("attempt time budget exhausted")
+	errMemoryLimit     = errors.New("memory limit exceeded")
+	errStackOverflow   = errors.New("maximum call stack size exceeded")
+)
+
+// statusFor maps a limit violation to the status recorded for it
+func statusFor(reason error) Status {
+	switch reason {
+	case errTimeLimit, errBudgetExhausted:
+		return StatusTimeout
+	case errMemoryLimit, errStackOverflow:
+		return StatusResourceExceeded
+	default:
+		return StatusError
+	}
+}
+
+// watch runs fn and calls interrupt when fn outlives timeout, when ctx is done
+// or when the heap grows past maxMemory. It returns the violated limit, if any.
+// interrupt is never called once fn has returned.
+func watch(ctx context.Context, timeout time.Duration, maxMemory uint64, interrupt func(reason error), fn func()) error {
+	var (
+		mu       sync.Mutex
+		finished bool
+		reason   error
+	)
+	stop := func(r error) {
+		mu.Lock()
+		defer mu.Unlock()
+		if finished {
+			return
+		}
+		reason = r
+		interrupt(r)
+	}
+
+	done := make(chan struct{})
+	go func() {
+		timer := time.NewTimer(timeout)
+		defer timer.Stop()
+		ticker := time.NewTicker(memoryCheckInterval)
+		defer ticker.Stop()
+
+		baseline := heapObjectsBytes()
+		for {
+			select {
+			case <-done:
+				return
+			case <-timer.C:
+				stop(errTimeLimit)
+				return
+			case <-ctx.Done():
+				stop(errBudgetExhausted)
+				return
+			case <-ticker.C:
+				if current := heapObjectsBytes(); current > baseline && current-baseline > maxMemory {
+					stop(errMemoryLimit)
+					return
+				}
+			}
+		}
+	}()
+
+	fn()
+
+	mu.Lock()
+	finished = true
+	mu.Unlock()
+	close(done)
+
+	return reason
+}
+
+// heapObjectsBytes returns the bytes currently held by heap objects
+func heapObjectsBytes() uint64 {
+	sample := []metrics.Sample{{Name: heapObjectsMetric}}
+	metrics.Read(sample)
+	if sample[0].Value.Kind() != metrics.KindUint64 {
+		return 0
+	}
+	return sample[0].Value.Uint64()
+}
@@ -0,0 +1,159 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	codelabdb "github.com/ynoacamino/infra-sustainable-classrooms/services/codelab/gen/database"
+	"github.com/ynoacamino/infra-sustainable-classrooms/services/codelab/internal/plagiarism"
+	"github.com/ynoacamino/infra-sustainable-classrooms/services/codelab/internal/repositories"
+	goaLog "goa.design/clue/log"
+)
+
+// PlagiarismService periodically compares the successful attempts of every
+// exercise and stores the suspiciously similar pairs
+type PlagiarismService struct {
+	repoManager *repositories.RepositoryManager
+	ctx         context.Context
+	ticker      *time.Ticker
+	stopChan    chan bool
+
+	// Configuration
+	threshold float64
+	options   plagiarism.Options
+}
+
+// PlagiarismServiceConfig holds configuration for the plagiarism service
+type PlagiarismServiceConfig struct {
+	Threshold float64            // Minimum similarity (0-100) of a stored pair
+	Options   plagiarism.Options // Fingerprinting options
+}
+
+// NewPlagiarismService creates a new plagiarism service with default configuration
+func NewPlagiarismService(ctx context.Context, repoManager *repositories.RepositoryManager) *PlagiarismService {
+	return NewPlagiarismServiceWithConfig(ctx, repoManager, PlagiarismServiceConfig{
+		Threshold: 70,
+		Options:   plagiarism.DefaultOptions(),
+	})
+}
+
+// NewPlagiarismServiceWithConfig creates a new plagiarism service with custom configuration
+func NewPlagiarismServiceWithConfig(ctx context.Context, repoManager *repositories.RepositoryManager, config PlagiarismServiceConfig) *PlagiarismService {
+	return &PlagiarismService{
+		repoManager: repoManager,
+		ctx:         ctx,
+		stopChan:    make(chan bool),
+		threshold:   config.Threshold,
+		options:     config.Options,
+	}
+}
+
+// Start begins the periodic plagiarism checks
+func (ps *PlagiarismService) Start(interval time.Duration) {
+	ps.ticker = time.NewTicker(interval)
+
+	goaLog.Printf(ps.ctx, "Starting plagiarism service with interval: %v", interval)
+
+	go func() {
+		defer ps.ticker.Stop()
+
+		for {
+			select {
+			case <-ps.ticker.C:
+				if err := ps.checkPending(); err != nil {
+					goaLog.Printf(ps.ctx, "Error during plagiarism check: %v", err)
+				}
+			case <-ps.stopChan:
+				goaLog.Printf(ps.ctx, "Stopping plagiarism service")
+				return
+			}
+		}
+	}()
+}
+
+// Stop stops the plagiarism service
+func (ps *PlagiarismService) Stop() {
+	if ps.ticker != nil {
+		ps.stopChan <- true
+	}
+}
+
+// checkPending checks again every exercise with successful attempts newer
+// than its last check
+func (ps *PlagiarismService) checkPending() error {
+	exercises, err := ps.repoManager.PlagiarismRepo.ListExercisesPendingPlagiarismCheck(ps.ctx)
+	if err != nil {
+		return fmt.Errorf("failed to list exercises pending a plagiarism check: %w", err)
+	}
+
+	if len(exercises) == 0 {
+		return nil
+	}
+
+	checked := 0
+	for _, exercise := range exercises {
+		if err := ps.checkExercise(exercise.ID, exercise.InitialCode); err != nil {
+			goaLog.Printf(ps.ctx, "Failed to check exercise %d for plagiarism: %v", exercise.ID, err)
+			continue
+		}
+		checked++
+	}
+
+	goaLog.Printf(ps.ctx, "Checked %d exercises for plagiarism", checked)
+	return nil
+}
+
+// checkExercise replaces the stored matches of an exercise with the pairs of
+// its successful attempts that reach the threshold
+func (ps *PlagiarismService) checkExercise(exerciseID int64, initialCode string) error {
+	// Attempts created while the check runs are picked up by the next one
+	checkedAt := time.Now()
+
+	attempts, err := ps.repoManager.PlagiarismRepo.GetSuccessfulAttemptsByExercise(ps.ctx, exerciseID)
+	if err != nil {
+		return fmt.Errorf("failed to get successful attempts: %w", err)
+	}
+
+	submissions := make([]plagiarism.Submission, len(attempts))
+	for i, attempt := range attempts {
+		submissions[i] = plagiarism.Submission{ID: attempt.ID, UserID: attempt.UserID, Code: attempt.Code}
+	}
+	pairs := plagiarism.Check(submissions, initialCode, ps.threshold, ps.options)
+
+	return ps.repoManager.TxManager.WithTransaction(ps.ctx, func(tx pgx.Tx) error {
+		txRepos := ps.repoManager.WithTransaction(tx)
+
+		if err := txRepos.PlagiarismRepo.DeletePlagiarismMatchesByExercise(ps.ctx, exerciseID); err != nil {
+			return fmt.Errorf("failed to delete previous matches: %w", err)
+		}
+
+		for _, pair := range pairs {
+			regions, err := json.Marshal(pair.Regions)
+			if err != nil {
+				return fmt.Errorf("failed to encode matched regions: %w", err)
+			}
+
+			err = txRepos.PlagiarismRepo.CreatePlagiarismMatch(ps.ctx, codelabdb.CreatePlagiarismMatchParams{
+				ExerciseID: exerciseID,
+				AttemptAID: pair.A.ID,
+				UserAID:    pair.A.UserID,
+				AttemptBID: pair.B.ID,
+				UserBID:    pair.B.UserID,
+				Similarity: pair.Similarity,
+				Regions:    regions,
+			})
+			if err != nil {
+				return fmt.Errorf("failed to create match: %w", err)
+			}
+		}
+
+		return txRepos.PlagiarismRepo.UpsertPlagiarismCheck(ps.ctx, codelabdb.UpsertPlagiarismCheckParams{
+			ExerciseID: exerciseID,
+			CheckedAt:  pgtype.Timestamptz{Time: checkedAt, Valid: true},
+		})
+	})
+}
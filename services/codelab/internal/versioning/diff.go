@@ -0,0 +1,106 @@
+package versioning
+
+import "slices"
+
+// TestChangeKind is how a test changed between two versions
+type TestChangeKind string
+
+const (
+	TestAdded    TestChangeKind = "added"
+	TestRemoved  TestChangeKind = "removed"
+	TestModified TestChangeKind = "modified"
+)
+
+// Change is a field with a different value in two versions
+type Change struct {
+	Field string
+	From  string
+	To    string
+}
+
+// TestChange is a test added, removed or modified between two versions
+type TestChange struct {
+	TestID int64
+	Kind   TestChangeKind
+	// Changes holds the fields that changed, which are all of them for added
+	// and removed tests
+	Changes []Change
+}
+
+// Diff is what changed between two versions of an exercise
+type Diff struct {
+	Changes []Change
+	Tests   []TestChange
+}
+
+// Empty reports whether both versions are the same
+func (d Diff) Empty() bool {
+	return len(d.Changes) == 0 && len(d.Tests) == 0
+}
+
+// Compare returns what changed from one version to another. Tests are matched
+// by ID, except that a test removed and another added with the same content
+// are taken as the same test, as happens when a rollback recreates it.
+func Compare(from, to Snapshot) Diff {
+	diff := Diff{Changes: compareFields(from.fields(), to.fields())}
+
+	toByID := make(map[int64]Test, len(to.Tests))
+	for _, test := range to.Tests {
+		toByID[test.ID] = test
+	}
+	fromIDs := make(map[int64]bool, len(from.Tests))
+	var removed []Test
+	for _, test := range from.Tests {
+		fromIDs[test.ID] = true
+		other, ok := toByID[test.ID]
+		if !ok {
+			removed = append(removed, test)
+			continue
+		}
+		if changes := compareFields(test.fields(), other.fields()); len(changes) > 0 {
+			diff.Tests = append(diff.Tests, TestChange{TestID: test.ID, Kind: TestModified, Changes: changes})
+		}
+	}
+
+	var added []Test
+	for _, test := range to.Tests {
+		if fromIDs[test.ID] {
+			continue
+		}
+		i := slices.IndexFunc(removed, func(old Test) bool {
+			return len(compareFields(old.fields(), test.fields())) == 0
+		})
+		if i >= 0 {
+			removed = slices.Delete(removed, i, i+1)
+			continue
+		}
+		added = append(added, test)
+	}
+
+	for _, test := range removed {
+		diff.Tests = append(diff.Tests, TestChange{TestID: test.ID, Kind: TestRemoved, Changes: compareFields(test.fields(), nil)})
+	}
+	for _, test := range added {
+		diff.Tests = append(diff.Tests, TestChange{TestID: test.ID, Kind: TestAdded, Changes: compareFields(nil, test.fields())})
+	}
+	return diff
+}
+
+// compareFields returns the fields whose value differs, in the same order.
+// Either side may be nil, when the fields were added or removed.
+func compareFields(from, to []field) []Change {
+	var changes []Change
+	for i := range max(len(from), len(to)) {
+		var change Change
+		if from != nil {
+			change.Field, change.From = from[i].name, from[i].value
+		}
+		if to != nil {
+			change.Field, change.To = to[i].name, to[i].value
+		}
+		if from == nil || to == nil || change.From != change.To {
+			changes = append(changes, change)
+		}
+	}
+	return changes
+}
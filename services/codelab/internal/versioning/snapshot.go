@@ -0,0 +1,84 @@
+// Package versioning keeps every change to an exercise as an immutable
+// version. A version is a Snapshot of the exercise and its tests, which can
+// be compared with another version or restored.
+package versioning
+
+import (
+	"encoding/json"
+	"strconv"
+	"strings"
+)
+
+// Snapshot is an exercise and its tests as of a version
+type Snapshot struct {
+	Title       string   `json:"title"`
+	Description string   `json:"description"`
+	InitialCode string   `json:"initial_code"`
+	Solution    string   `json:"solution"`
+	Difficulty  string   `json:"difficulty"`
+	Language    string   `json:"language"`
+	Tags        []string `json:"tags"`
+	Tests       []Test   `json:"tests"`
+}
+
+// Test is a test of an exercise as of a version. ID is the test the version
+// was taken from, which may have been deleted since.
+type Test struct {
+	ID          int64   `json:"id"`
+	Input       string  `json:"input"`
+	Output      string  `json:"output"`
+	Public      bool    `json:"public"`
+	Weight      int32   `json:"weight"`
+	InputFormat string  `json:"input_format"`
+	Comparison  string  `json:"comparison"`
+	Tolerance   float64 `json:"tolerance"`
+}
+
+// field is the name and value of a field, formatted to be compared and shown
+type field struct {
+	name  string
+	value string
+}
+
+func (s Snapshot) fields() []field {
+	return []field{
+		{"title", s.Title},
+		{"description", s.Description},
+		{"initial_code", s.InitialCode},
+		{"solution", s.Solution},
+		{"difficulty", s.Difficulty},
+		{"language", s.Language},
+		{"tags", strings.Join(s.Tags, ", ")},
+	}
+}
+
+func (t Test) fields() []field {
+	return []field{
+		{"input", t.Input},
+		{"output", t.Output},
+		{"public", strconv.FormatBool(t.Public)},
+		{"weight", strconv.FormatInt(int64(t.Weight), 10)},
+		{"input_format", t.InputFormat},
+		{"comparison", t.Comparison},
+		{"tolerance", strconv.FormatFloat(t.Tolerance, 'g', -1, 64)},
+	}
+}
+
+// Encode serializes a snapshot to be stored
+func Encode(s Snapshot) ([]byte, error) {
+	// Empty lists are stored as such rather than as null
+	if s.Tags == nil {
+		s.Tags = []string{}
+	}
+	if s.Tests == nil {
+		s.Tests = []Test{}
+	}
+	return json.Marshal(s)
+}
+
+// Decode reads a snapshot written by Encode
+func Decode(data []byte) (Snapshot, error) {
+	var s Snapshot
+	err := json.Unmarshal(data, &s)
+	return s, err
+}
@@ -0,0 +1,95 @@
+package versioning
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func testSnapshot() Snapshot {
+	return Snapshot{
+		Title:       "Double",
+		Description: "Double a number",
+		InitialCode: "function solution(input) {}",
+		Solution:    "function solution(n) { return Number(n) * 2; }",
+		Difficulty:  "easy",
+		Language:    "javascript",
+		Tags:        []string{"arithmetic"},
+		Tests: []Test{
+			{ID: 1, Input: "2", Output: "4", Public: true, Weight: 1, InputFormat: "text", Comparison: "exact"},
+			{ID: 2, Input: "5", Output: "10", Weight: 2, InputFormat: "text", Comparison: "exact"},
+		},
+	}
+}
+
+func TestEncode_RoundTrip(t *testing.T) {
+	snapshot := testSnapshot()
+
+	data, err := Encode(snapshot)
+	assert.NoError(t, err)
+	decoded, err := Decode(data)
+	assert.NoError(t, err)
+	assert.Equal(t, snapshot, decoded)
+
+	// Empty lists are not stored as null
+	data, err = Encode(Snapshot{Title: "Empty"})
+	assert.NoError(t, err)
+	assert.Contains(t, string(data), `"tags":[]`)
+	assert.Contains(t, string(data), `"tests":[]`)
+}
+
+func TestCompare_SameVersion(t *testing.T) {
+	diff := Compare(testSnapshot(), testSnapshot())
+
+	assert.True(t, diff.Empty())
+}
+
+func TestCompare_Fields(t *testing.T) {
+	from := testSnapshot()
+	to := testSnapshot()
+	to.Title = "Double it"
+	to.Tags = []string{"arithmetic", "numbers"}
+
+	diff := Compare(from, to)
+
+	assert.Equal(t, []Change{
+		{Field: "title", From: "Double", To: "Double it"},
+		{Field: "tags", From: "arithmetic", To: "arithmetic, numbers"},
+	}, diff.Changes)
+	assert.Empty(t, diff.Tests)
+}
+
+func TestCompare_Tests(t *testing.T) {
+	from := testSnapshot()
+	to := testSnapshot()
+	to.Tests[0].Output = "4.0"
+	to.Tests[0].Comparison = "numeric"
+	to.Tests = append(to.Tests[:1], Test{ID: 3, Input: "-1", Output: "-2", Weight: 1, InputFormat: "text", Comparison: "exact"})
+
+	diff := Compare(from, to)
+
+	assert.Empty(t, diff.Changes)
+	if assert.Len(t, diff.Tests, 3) {
+		assert.Equal(t, TestChange{TestID: 1, Kind: TestModified, Changes: []Change{
+			{Field: "output", From: "4", To: "4.0"},
+			{Field: "comparison", From: "exact", To: "numeric"},
+		}}, diff.Tests[0])
+		assert.Equal(t, int64(2), diff.Tests[1].TestID)
+		assert.Equal(t, TestRemoved, diff.Tests[1].Kind)
+		assert.Contains(t, diff.Tests[1].Changes, Change{Field: "output", From: "10"})
+		assert.Equal(t, int64(3), diff.Tests[2].TestID)
+		assert.Equal(t, TestAdded, diff.Tests[2].Kind)
+		assert.Contains(t, diff.Tests[2].Changes, Change{Field: "input", To: "-1"})
+	}
+}
+
+func TestCompare_RecreatedTest(t *testing.T) {
+	from := testSnapshot()
+	to := testSnapshot()
+	// A rollback recreates deleted tests with a new ID
+	to.Tests[1].ID = 7
+
+	diff := Compare(from, to)
+
+	assert.True(t, diff.Empty())
+}
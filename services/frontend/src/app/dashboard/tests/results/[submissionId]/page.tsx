@@ -3,6 +3,7 @@ import Link from 'next/link';
 import { Button } from '@/ui/button';
 import { ArrowLeft, CheckCircle, XCircle, Clock } from 'lucide-react';
 import { notFound } from 'next/navigation';
+import { QuestionResultReview } from '@/components/knowledge/question-result-review';
 
 interface SubmissionResultPageProps {
   params: Promise<{ submissionId: string }>;
@@ -36,7 +37,11 @@ export default async function SubmissionResultPage({
     );
   }
 
-  const { submission, questions } = submissionResult.data;
+  const { submission, attempts, counted_score, answers_revealed } =
+    submissionResult.data;
+  const questions = [...submissionResult.data.questions].sort(
+    (a, b) => a.position - b.position,
+  );
 
   return (
     <div className="container mx-auto px-4 py-8">
@@ -104,6 +109,17 @@ export default async function SubmissionResultPage({
             Submitted on:{' '}
             {new Date(submission.submitted_at * 1000).toLocaleString()}
           </p>
+          {attempts.length > 1 && (
+            <p className="text-sm text-gray-600 mt-1">
+              Attempt {submission.attempt_number} of {attempts.length}. Score
+              counted for the test: {counted_score}%
+            </p>
+          )}
+          {!answers_revealed && (
+            <p className="text-sm text-gray-600 mt-1">
+              The correct answers of this test are not shown.
+            </p>
+          )}
         </div>
       </div>
 
@@ -120,7 +136,11 @@ export default async function SubmissionResultPage({
               <h3 className="text-lg font-semibold">Question {index + 1}</h3>
               <div
                 className={`flex items-center ${
-                  questionResult.is_correct ? 'text-green-600' : 'text-red-600'
+                  questionResult.is_correct
+                    ? 'text-green-600'
+                    : questionResult.credit > 0
+                      ? 'text-amber-600'
+                      : 'text-red-600'
                 }`}
               >
                 {questionResult.is_correct ? (
@@ -129,7 +149,11 @@ export default async function SubmissionResultPage({
                   <XCircle className="h-5 w-5 mr-1" />
                 )}
                 <span className="font-medium">
-                  {questionResult.is_correct ? 'Correct' : 'Incorrect'}
+                  {questionResult.is_correct
+                    ? 'Correct'
+                    : questionResult.credit > 0
+                      ? `Partially correct (${Math.round(questionResult.credit * 100)}%)`
+                      : 'Incorrect'}
                 </span>
               </div>
             </div>
@@ -138,73 +162,10 @@ export default async function SubmissionResultPage({
               {questionResult.question.question_text}
             </p>
 
-            <div className="grid grid-cols-1 sm:grid-cols-2 gap-3">
-              {[
-                {
-                  index: 0,
-                  label: 'A',
-                  text: questionResult.question.option_a,
-                },
-                {
-                  index: 1,
-                  label: 'B',
-                  text: questionResult.question.option_b,
-                },
-                {
-                  index: 2,
-                  label: 'C',
-                  text: questionResult.question.option_c,
-                },
-                {
-                  index: 3,
-                  label: 'D',
-                  text: questionResult.question.option_d,
-                },
-              ].map((option) => {
-                const isSelected =
-                  questionResult.selected_answer === option.index;
-                const isCorrect =
-                  questionResult.question.correct_answer === option.index;
-
-                return (
-                  <div
-                    key={option.index}
-                    className={`p-3 border rounded-lg ${
-                      isCorrect && isSelected
-                        ? 'border-green-500 bg-green-50'
-                        : isCorrect
-                          ? 'border-green-500 bg-green-50'
-                          : isSelected && !isCorrect
-                            ? 'border-red-500 bg-red-50'
-                            : 'border-gray-200'
-                    }`}
-                  >
-                    <div className="flex items-center">
-                      <span className="font-medium mr-2">{option.label}.</span>
-                      <span className="flex-1">{option.text}</span>
-                      {isCorrect && (
-                        <CheckCircle className="h-4 w-4 text-green-600 ml-2" />
-                      )}
-                      {isSelected && !isCorrect && (
-                        <XCircle className="h-4 w-4 text-red-600 ml-2" />
-                      )}
-                    </div>
-
-                    {isSelected && (
-                      <div className="mt-1 text-xs text-gray-600">
-                        Your answer
-                      </div>
-                    )}
-
-                    {isCorrect && !isSelected && (
-                      <div className="mt-1 text-xs text-green-600">
-                        Correct answer
-                      </div>
-                    )}
-                  </div>
-                );
-              })}
-            </div>
+            <QuestionResultReview
+              result={questionResult}
+              answersRevealed={answers_revealed}
+            />
           </div>
         ))}
       </div>
@@ -6,6 +6,10 @@ import Link from 'next/link';
 import { Button } from '@/ui/button';
 import { ArrowLeft, Edit, Plus, FileText, Users } from 'lucide-react';
 import { notFound } from 'next/navigation';
+import {
+  describeCorrectAnswer,
+  optionLabel,
+} from '@/lib/knowledge/questions';
 
 interface TestPageProps {
   params: Promise<{ testId: string }>;
@@ -151,13 +155,14 @@ export default async function TestPage({ params }: TestPageProps) {
                       Question {index + 1}: {question.question_text}
                     </h4>
                     <div className="grid grid-cols-2 gap-2 text-sm text-gray-600">
-                      <p>A) {question.option_a}</p>
-                      <p>B) {question.option_b}</p>
-                      <p>C) {question.option_c}</p>
-                      <p>D) {question.option_d}</p>
+                      {question.options.map((option, position) => (
+                        <p key={position}>
+                          {optionLabel(position)}) {option}
+                        </p>
+                      ))}
                     </div>
                     <p className="text-sm text-green-600 mt-2">
-                      Correct: {['A', 'B', 'C', 'D'][question.correct_answer]}
+                      Correct: {describeCorrectAnswer(question)}
                     </p>
                   </div>
                 ))}
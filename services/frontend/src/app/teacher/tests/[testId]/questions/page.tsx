@@ -7,6 +7,10 @@ import { Button } from '@/ui/button';
 import { ArrowLeft, Plus, Edit, FileText } from 'lucide-react';
 import { notFound } from 'next/navigation';
 import { DeleteQuestionButton } from '@/components/knowledge/delete-question-button';
+import {
+  describeCorrectAnswer,
+  optionLabel,
+} from '@/lib/knowledge/questions';
 
 interface QuestionsPageProps {
   params: Promise<{ testId: string }>;
@@ -149,103 +153,47 @@ export default async function QuestionsPage({ params }: QuestionsPageProps) {
                 </div>
 
                 <div className="grid grid-cols-1 md:grid-cols-2 gap-3 mb-4">
-                  <div
-                    className={`p-3 rounded-lg border ${
-                      question.correct_answer === 0
-                        ? 'bg-green-50 border-green-200'
-                        : 'bg-gray-50 border-gray-200'
-                    }`}
-                  >
-                    <span className="font-medium">A) </span>
-                    <span
-                      className={
-                        question.correct_answer === 0
-                          ? 'text-green-800'
-                          : 'text-gray-700'
-                      }
-                    >
-                      {question.option_a}
-                    </span>
-                    {question.correct_answer === 0 && (
-                      <span className="ml-2 text-green-600 text-sm font-medium">
-                        ✓ Correct
-                      </span>
-                    )}
-                  </div>
-
-                  <div
-                    className={`p-3 rounded-lg border ${
-                      question.correct_answer === 1
-                        ? 'bg-green-50 border-green-200'
-                        : 'bg-gray-50 border-gray-200'
-                    }`}
-                  >
-                    <span className="font-medium">B) </span>
-                    <span
-                      className={
-                        question.correct_answer === 1
-                          ? 'text-green-800'
-                          : 'text-gray-700'
-                      }
-                    >
-                      {question.option_b}
-                    </span>
-                    {question.correct_answer === 1 && (
-                      <span className="ml-2 text-green-600 text-sm font-medium">
-                        ✓ Correct
-                      </span>
-                    )}
-                  </div>
-
-                  <div
-                    className={`p-3 rounded-lg border ${
-                      question.correct_answer === 2
-                        ? 'bg-green-50 border-green-200'
-                        : 'bg-gray-50 border-gray-200'
-                    }`}
-                  >
-                    <span className="font-medium">C) </span>
-                    <span
-                      className={
-                        question.correct_answer === 2
-                          ? 'text-green-800'
-                          : 'text-gray-700'
-                      }
-                    >
-                      {question.option_c}
-                    </span>
-                    {question.correct_answer === 2 && (
-                      <span className="ml-2 text-green-600 text-sm font-medium">
-                        ✓ Correct
-                      </span>
-                    )}
-                  </div>
-
-                  <div
-                    className={`p-3 rounded-lg border ${
-                      question.correct_answer === 3
-                        ? 'bg-green-50 border-green-200'
-                        : 'bg-gray-50 border-gray-200'
-                    }`}
-                  >
-                    <span className="font-medium">D) </span>
-                    <span
-                      className={
-                        question.correct_answer === 3
-                          ? 'text-green-800'
-                          : 'text-gray-700'
-                      }
-                    >
-                      {question.option_d}
-                    </span>
-                    {question.correct_answer === 3 && (
-                      <span className="ml-2 text-green-600 text-sm font-medium">
-                        ✓ Correct
-                      </span>
-                    )}
-                  </div>
+                  {question.options.map((option, position) => {
+                    const correctIndex =
+                      question.correct_options.indexOf(position);
+                    const isCorrect = correctIndex !== -1;
+                    return (
+                      <div
+                        key={position}
+                        className={`p-3 rounded-lg border ${
+                          isCorrect
+                            ? 'bg-green-50 border-green-200'
+                            : 'bg-gray-50 border-gray-200'
+                        }`}
+                      >
+                        <span className="font-medium">
+                          {optionLabel(position)}){' '}
+                        </span>
+                        <span
+                          className={
+                            isCorrect ? 'text-green-800' : 'text-gray-700'
+                          }
+                        >
+                          {option}
+                        </span>
+                        {isCorrect && (
+                          <span className="ml-2 text-green-600 text-sm font-medium">
+                            {question.question_type === 'ordering'
+                              ? `✓ Position ${correctIndex + 1}`
+                              : '✓ Correct'}
+                          </span>
+                        )}
+                      </div>
+                    );
+                  })}
                 </div>
 
+                {question.options.length === 0 && (
+                  <p className="p-3 mb-4 rounded-lg border bg-green-50 border-green-200 text-green-800">
+                    Correct: {describeCorrectAnswer(question)}
+                  </p>
+                )}
+
                 <div className="text-sm text-gray-500">
                   Order: {question.question_order}
                 </div>
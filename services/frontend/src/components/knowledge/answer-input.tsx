@@ -0,0 +1,151 @@
+'use client';
+
+import { Button } from '@/ui/button';
+import { Input } from '@/ui/input';
+import { ArrowDown, ArrowUp } from 'lucide-react';
+import type { QuestionForm } from '@/types/knowledge/models';
+import { optionLabel } from '@/lib/knowledge/questions';
+
+interface AnswerInputProps {
+  question: QuestionForm;
+  value: string;
+  onChange: (value: string) => void;
+}
+
+const toPositions = (value: string) =>
+  value === ''
+    ? []
+    : value.split(',').map((position) => parseInt(position, 10));
+
+/**
+ * Renders the input for the answer to a question, chosen by its type. The
+ * answer is kept as text: the chosen position, the chosen or ordered positions
+ * separated by commas, or the number or text typed.
+ */
+export function AnswerInput({ question, value, onChange }: AnswerInputProps) {
+  if (question.question_type === 'numeric') {
+    return (
+      <Input
+        type="number"
+        step="any"
+        placeholder="Enter a number"
+        value={value}
+        onChange={(e) => onChange(e.target.value)}
+      />
+    );
+  }
+
+  if (question.question_type === 'short_text') {
+    return (
+      <Input
+        type="text"
+        placeholder="Enter your answer"
+        value={value}
+        onChange={(e) => onChange(e.target.value)}
+      />
+    );
+  }
+
+  if (question.question_type === 'ordering') {
+    const order = toPositions(value);
+    const move = (from: number, to: number) => {
+      const next = [...order];
+      [next[from], next[to]] = [next[to], next[from]];
+      onChange(next.join(','));
+    };
+
+    return (
+      <div className="space-y-3">
+        {order.map((position, index) => (
+          <div
+            key={position}
+            className="flex items-center justify-between p-3 border border-gray-200 rounded-lg"
+          >
+            <span>
+              <span className="font-medium mr-2">{index + 1}.</span>
+              {question.options[position]}
+            </span>
+            <span className="flex gap-1">
+              <Button
+                type="button"
+                variant="outline"
+                size="icon"
+                onClick={() => move(index, index - 1)}
+                disabled={index === 0}
+              >
+                <ArrowUp className="h-4 w-4" />
+              </Button>
+              <Button
+                type="button"
+                variant="outline"
+                size="icon"
+                onClick={() => move(index, index + 1)}
+                disabled={index === order.length - 1}
+              >
+                <ArrowDown className="h-4 w-4" />
+              </Button>
+            </span>
+          </div>
+        ))}
+      </div>
+    );
+  }
+
+  const multiple = question.question_type === 'multiple_select';
+  const selected = toPositions(value);
+  const toggle = (position: number) => {
+    if (!multiple) {
+      onChange(position.toString());
+      return;
+    }
+    const next = selected.includes(position)
+      ? selected.filter((p) => p !== position)
+      : [...selected, position].sort((a, b) => a - b);
+    onChange(next.join(','));
+  };
+
+  return (
+    <div className="space-y-3">
+      {question.options.map((option, position) => {
+        const isSelected = selected.includes(position);
+        return (
+          <label
+            key={position}
+            className={`flex items-center p-3 border rounded-lg cursor-pointer transition-colors ${
+              isSelected
+                ? 'border-blue-500 bg-blue-50'
+                : 'border-gray-200 hover:border-gray-300'
+            }`}
+          >
+            <input
+              type={multiple ? 'checkbox' : 'radio'}
+              value={position}
+              checked={isSelected}
+              onChange={() => toggle(position)}
+              className="sr-only"
+            />
+            <span className="flex items-center">
+              <span
+                className={`w-6 h-6 border-2 border-gray-300 mr-3 flex items-center justify-center ${
+                  multiple ? 'rounded' : 'rounded-full'
+                }`}
+              >
+                {isSelected && (
+                  <span
+                    className={`w-3 h-3 bg-blue-500 ${
+                      multiple ? 'rounded-sm' : 'rounded-full'
+                    }`}
+                  />
+                )}
+              </span>
+              <span className="font-medium mr-2">
+                {optionLabel(position)}.
+              </span>
+              <span>{option}</span>
+            </span>
+          </label>
+        );
+      })}
+    </div>
+  );
+}
@@ -10,7 +10,9 @@ import { toast } from 'sonner';
 import {
   addQuestionFormFields,
   addQuestionFormSchema,
+  toAddQuestionPayload,
 } from '@/lib/knowledge/forms/add-question-form';
+import { emptyQuestionValues } from '@/lib/knowledge/forms/question-form-values';
 import { addQuestionAction } from '@/actions/knowledge/actions';
 import { redirect } from 'next/navigation';
 
@@ -23,17 +25,12 @@ function AddQuestionForm({ testId }: AddQuestionFormProps) {
     resolver: zodResolver(addQuestionFormSchema),
     defaultValues: {
       test_id: testId,
-      question_text: '',
-      option_a: '',
-      option_b: '',
-      option_c: '',
-      option_d: '',
-      correct_answer: 0,
+      ...emptyQuestionValues(),
     },
   });
 
   const onSubmit = async (values: z.infer<typeof addQuestionFormSchema>) => {
-    const res = await addQuestionAction(values);
+    const res = await addQuestionAction(toAddQuestionPayload(values));
     if (!res.success) {
       form.setError('root', { message: res.error.message });
       console.error(res.error);
@@ -42,12 +39,7 @@ function AddQuestionForm({ testId }: AddQuestionFormProps) {
     }
     form.reset({
       test_id: testId,
-      question_text: '',
-      option_a: '',
-      option_b: '',
-      option_c: '',
-      option_d: '',
-      correct_answer: 0,
+      ...emptyQuestionValues(),
     });
     toast.success('Question added successfully');
     redirect(`/teacher/tests/${testId}/questions`);
@@ -65,15 +57,7 @@ function AddQuestionForm({ testId }: AddQuestionFormProps) {
             control={form.control}
             name={field.name}
             render={({ field: formField }) => (
-              <InferItem
-                {...field}
-                {...formField}
-                onChange={
-                  field.name === 'correct_answer'
-                    ? (value: string) => formField.onChange(parseInt(value, 10))
-                    : formField.onChange
-                }
-              />
+              <InferItem {...field} {...formField} />
             )}
           />
         ))}
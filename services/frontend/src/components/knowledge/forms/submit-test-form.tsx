@@ -19,7 +19,12 @@ import {
   DialogTrigger,
 } from '@/ui/dialog';
 import { AlertTriangle } from 'lucide-react';
-import { createSubmitTestFormSchema } from '@/lib/knowledge/forms/submit-test-form';
+import {
+  createSubmitTestFormSchema,
+  defaultAnswerValue,
+  toAnswer,
+} from '@/lib/knowledge/forms/submit-test-form';
+import { AnswerInput } from '@/components/knowledge/answer-input';
 import type z from 'zod';
 
 interface SubmitTestFormProps {
@@ -35,13 +40,15 @@ function SubmitTestForm({ test, questions }: SubmitTestFormProps) {
   const submitTestFormSchema = createSubmitTestFormSchema(questions);
 
   // Create dynamic default values
-  const defaultValues = questions.reduce(
-    (acc, question) => ({
-      ...acc,
-      [`question_${question.id}`]: '',
-    }),
-    { id: test.id },
-  );
+  const defaultValues = {
+    id: test.id,
+    answers: Object.fromEntries(
+      questions.map((question) => [
+        `question_${question.id}`,
+        defaultAnswerValue(question),
+      ]),
+    ),
+  };
 
   const form = useForm<z.infer<typeof submitTestFormSchema>>({
     resolver: zodResolver(submitTestFormSchema),
@@ -75,13 +82,9 @@ function SubmitTestForm({ test, questions }: SubmitTestFormProps) {
       const values = form.getValues();
 
       // Transform form values to the expected format
-      const answers = questions.map((question) => ({
-        question_id: question.id,
-        selected_answer: parseInt(
-          values.answers[`question_${question.id}`],
-          10,
-        ),
-      }));
+      const answers = questions.map((question) =>
+        toAnswer(question, values.answers[`question_${question.id}`]),
+      );
 
       const payload = {
         id: test.id,
@@ -166,7 +169,7 @@ function SubmitTestForm({ test, questions }: SubmitTestFormProps) {
         if (Date.now() - timestamp < 24 * 60 * 60 * 1000) {
           // Restore form values
           form.setValue('id', values.id);
-          Object.entries(values).forEach(([key, value]) => {
+          Object.entries(values.answers ?? {}).forEach(([key, value]) => {
             if (key.startsWith('question_')) {
               form.setValue(`answers.${key}`, value as string);
             }
@@ -230,40 +233,11 @@ function SubmitTestForm({ test, questions }: SubmitTestFormProps) {
             control={form.control}
             name={`answers.question_${currentQuestion.id}`}
             render={({ field }) => (
-              <div className="space-y-3">
-                {[
-                  { value: '0', label: 'A', text: currentQuestion.option_a },
-                  { value: '1', label: 'B', text: currentQuestion.option_b },
-                  { value: '2', label: 'C', text: currentQuestion.option_c },
-                  { value: '3', label: 'D', text: currentQuestion.option_d },
-                ].map((option) => (
-                  <label
-                    key={option.value}
-                    className={`flex items-center p-3 border rounded-lg cursor-pointer transition-colors ${
-                      field.value === option.value
-                        ? 'border-blue-500 bg-blue-50'
-                        : 'border-gray-200 hover:border-gray-300'
-                    }`}
-                  >
-                    <input
-                      type="radio"
-                      value={option.value}
-                      checked={field.value === option.value}
-                      onChange={field.onChange}
-                      className="sr-only"
-                    />
-                    <span className="flex items-center">
-                      <span className="w-6 h-6 border-2 border-gray-300 rounded-full mr-3 flex items-center justify-center">
-                        {field.value === option.value && (
-                          <span className="w-3 h-3 bg-blue-500 rounded-full" />
-                        )}
-                      </span>
-                      <span className="font-medium mr-2">{option.label}.</span>
-                      <span>{option.text}</span>
-                    </span>
-                  </label>
-                ))}
-              </div>
+              <AnswerInput
+                question={currentQuestion}
+                value={field.value}
+                onChange={field.onChange}
+              />
             )}
           />
         </div>
@@ -8,9 +8,11 @@ import { InferItem } from '@/ui/infer-field';
 import { Button } from '@/ui/button';
 import { toast } from 'sonner';
 import {
+  toUpdateQuestionPayload,
   updateQuestionFormFields,
   updateQuestionFormSchema,
 } from '@/lib/knowledge/forms/update-question-form';
+import { toQuestionValues } from '@/lib/knowledge/forms/question-form-values';
 import type { Question } from '@/types/knowledge/models';
 import { updateQuestionAction } from '@/actions/knowledge/actions';
 import { redirect } from 'next/navigation';
@@ -25,17 +27,12 @@ function UpdateQuestionForm({ question }: UpdateQuestionFormProps) {
     defaultValues: {
       test_id: question.test_id,
       id: question.id,
-      question_text: question.question_text,
-      option_a: question.option_a,
-      option_b: question.option_b,
-      option_c: question.option_c,
-      option_d: question.option_d,
-      correct_answer: question.correct_answer,
+      ...toQuestionValues(question),
     },
   });
 
   const onSubmit = async (values: z.infer<typeof updateQuestionFormSchema>) => {
-    const res = await updateQuestionAction(values);
+    const res = await updateQuestionAction(toUpdateQuestionPayload(values));
     if (!res.success) {
       form.setError('root', { message: res.error.message });
       console.error(res.error);
@@ -58,15 +55,7 @@ function UpdateQuestionForm({ question }: UpdateQuestionFormProps) {
             control={form.control}
             name={field.name}
             render={({ field: formField }) => (
-              <InferItem
-                {...field}
-                {...formField}
-                onChange={
-                  field.name === 'correct_answer'
-                    ? (value: string) => formField.onChange(parseInt(value, 10))
-                    : formField.onChange
-                }
-              />
+              <InferItem {...field} {...formField} />
             )}
           />
         ))}
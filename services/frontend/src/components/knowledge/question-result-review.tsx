@@ -0,0 +1,138 @@
+import { CheckCircle, XCircle } from 'lucide-react';
+import {
+  describeCorrectAnswer,
+  optionLabel,
+} from '@/lib/knowledge/questions';
+import type { QuestionResult } from '@/types/knowledge/responses';
+
+interface QuestionResultReviewProps {
+  result: QuestionResult;
+  answersRevealed: boolean;
+}
+
+/**
+ * Shows the answer given to a question of a finished attempt, with the
+ * options in the order they were shown. Selected options are indexes into
+ * the question's options, and the option order maps each shown position to
+ * one of them. The correct answer is only marked when the test reveals it.
+ */
+export function QuestionResultReview({
+  result,
+  answersRevealed,
+}: QuestionResultReviewProps) {
+  const { question } = result;
+
+  if (question.question_type === 'numeric') {
+    return (
+      <TypedAnswerReview
+        answer={result.numeric_answer?.toString()}
+        correct={answersRevealed ? describeCorrectAnswer(question) : undefined}
+      />
+    );
+  }
+
+  if (question.question_type === 'short_text') {
+    return (
+      <TypedAnswerReview
+        answer={result.text_answer}
+        correct={answersRevealed ? describeCorrectAnswer(question) : undefined}
+      />
+    );
+  }
+
+  const order =
+    result.option_order.length === question.options.length
+      ? result.option_order
+      : question.options.map((_, index) => index);
+  const shownLabel = (index: number) => optionLabel(order.indexOf(index));
+
+  if (question.question_type === 'ordering') {
+    return (
+      <div className="space-y-2 text-sm">
+        <p>
+          <span className="font-medium">Your order: </span>
+          {result.selected_options.length > 0
+            ? result.selected_options
+                .map((index) => question.options[index])
+                .join(' → ')
+            : 'No answer'}
+        </p>
+        {answersRevealed && (
+          <p className="text-green-700">
+            <span className="font-medium">Correct order: </span>
+            {question.correct_options
+              .map((index) => question.options[index])
+              .join(' → ')}
+          </p>
+        )}
+      </div>
+    );
+  }
+
+  return (
+    <div className="grid grid-cols-1 sm:grid-cols-2 gap-3">
+      {order.map((index) => {
+        const isSelected = result.selected_options.includes(index);
+        const isCorrect =
+          answersRevealed && question.correct_options.includes(index);
+
+        return (
+          <div
+            key={index}
+            className={`p-3 border rounded-lg ${
+              isCorrect
+                ? 'border-green-500 bg-green-50'
+                : isSelected && answersRevealed
+                  ? 'border-red-500 bg-red-50'
+                  : isSelected
+                    ? 'border-blue-500 bg-blue-50'
+                    : 'border-gray-200'
+            }`}
+          >
+            <div className="flex items-center">
+              <span className="font-medium mr-2">{shownLabel(index)}.</span>
+              <span className="flex-1">{question.options[index]}</span>
+              {isCorrect && (
+                <CheckCircle className="h-4 w-4 text-green-600 ml-2" />
+              )}
+              {isSelected && answersRevealed && !isCorrect && (
+                <XCircle className="h-4 w-4 text-red-600 ml-2" />
+              )}
+            </div>
+
+            {isSelected && (
+              <div className="mt-1 text-xs text-gray-600">Your answer</div>
+            )}
+
+            {isCorrect && !isSelected && (
+              <div className="mt-1 text-xs text-green-600">Correct answer</div>
+            )}
+          </div>
+        );
+      })}
+    </div>
+  );
+}
+
+function TypedAnswerReview({
+  answer,
+  correct,
+}: {
+  answer?: string;
+  correct?: string;
+}) {
+  return (
+    <div className="space-y-2 text-sm">
+      <p>
+        <span className="font-medium">Your answer: </span>
+        {answer ?? 'No answer'}
+      </p>
+      {correct !== undefined && (
+        <p className="text-green-700">
+          <span className="font-medium">Correct answer: </span>
+          {correct}
+        </p>
+      )}
+    </div>
+  );
+}
@@ -6,12 +6,24 @@ export { updateTestFormSchema, updateTestFormFields } from './update-test-form';
 export {
   addQuestionFormSchema,
   addQuestionFormFields,
+  toAddQuestionPayload,
 } from './add-question-form';
 
 export {
   updateQuestionFormSchema,
   updateQuestionFormFields,
+  toUpdateQuestionPayload,
 } from './update-question-form';
 
+export {
+  emptyQuestionValues,
+  toQuestionValues,
+} from './question-form-values';
+
 // Student form configurations
-export { createSubmitTestFormFields } from './submit-test-form';
+export {
+  createSubmitTestFormFields,
+  createSubmitTestFormSchema,
+  defaultAnswerValue,
+  toAnswer,
+} from './submit-test-form';
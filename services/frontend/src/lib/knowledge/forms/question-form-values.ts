@@ -0,0 +1,159 @@
+import type { Question, QuestionType } from '@/types/knowledge/models';
+import { QuestionSchema } from '@/types/knowledge/schemas/models';
+import type { Field } from '@/types/shared/field';
+import type { SelectOption } from '@/types/shared/select-option';
+import z from 'zod';
+
+// Question forms edit option lists as text, one entry per line, and the
+// correct options as their numbers separated by commas. These helpers convert
+// between those text values and the question fields the API expects.
+
+export const questionTypeOptions: SelectOption[] = [
+  {
+    key: 'multiple-choice',
+    value: 'multiple_choice',
+    textValue: 'Multiple choice',
+  },
+  {
+    key: 'multiple-select',
+    value: 'multiple_select',
+    textValue: 'Multiple select',
+  },
+  { key: 'true-false', value: 'true_false', textValue: 'True / False' },
+  { key: 'numeric', value: 'numeric', textValue: 'Numeric' },
+  { key: 'short-text', value: 'short_text', textValue: 'Short text' },
+  { key: 'ordering', value: 'ordering', textValue: 'Ordering' },
+];
+
+export const questionValuesSchema = z.object({
+  question_text: QuestionSchema.shape.question_text,
+  question_type: QuestionSchema.shape.question_type,
+  options: z.string().describe('Options, one per line'),
+  correct_options: z
+    .string()
+    .regex(/^\s*(\d+\s*(,\s*\d+\s*)*)?$/, {
+      message: 'Enter option numbers separated by commas, e.g. 1, 3',
+    })
+    .describe('Numbers of the correct options, separated by commas'),
+  numeric_answer: z
+    .string()
+    .refine((value) => value.trim() === '' || !isNaN(Number(value)), {
+      message: 'Numeric answer must be a number',
+    })
+    .describe('Correct answer of a numeric question'),
+  tolerance: QuestionSchema.shape.tolerance,
+  accepted_answers: z.string().describe('Accepted answers, one per line'),
+});
+
+export type QuestionValues = z.infer<typeof questionValuesSchema>;
+
+const splitLines = (value: string) =>
+  value
+    .split('\n')
+    .map((line) => line.trim())
+    .filter((line) => line !== '');
+
+/**
+ * Converts the text values of a question form to the question fields of the
+ * add and update question payloads. Option numbers are shown from 1 and sent
+ * as indexes from 0.
+ */
+export const toQuestionPayload = (values: QuestionValues) => ({
+  question_text: values.question_text,
+  question_type: values.question_type,
+  options: splitLines(values.options),
+  correct_options: values.correct_options
+    .split(',')
+    .map((number) => number.trim())
+    .filter((number) => number !== '')
+    .map((number) => parseInt(number, 10) - 1),
+  numeric_answer:
+    values.numeric_answer.trim() === ''
+      ? undefined
+      : Number(values.numeric_answer),
+  tolerance: values.tolerance,
+  accepted_answers: splitLines(values.accepted_answers),
+});
+
+/** Converts a question to the text values of a question form. */
+export const toQuestionValues = (question: Question): QuestionValues => ({
+  question_text: question.question_text,
+  question_type: question.question_type,
+  options: question.options.join('\n'),
+  correct_options: question.correct_options
+    .map((index) => index + 1)
+    .join(', '),
+  numeric_answer: question.numeric_answer?.toString() ?? '',
+  tolerance: question.tolerance,
+  accepted_answers: question.accepted_answers.join('\n'),
+});
+
+export const emptyQuestionValues = (
+  questionType: QuestionType = 'multiple_choice',
+): QuestionValues => ({
+  question_text: '',
+  question_type: questionType,
+  options: '',
+  correct_options: '',
+  numeric_answer: '',
+  tolerance: 0,
+  accepted_answers: '',
+});
+
+export const questionValuesFields: Field<keyof QuestionValues>[] = [
+  {
+    name: 'question_text',
+    label: 'Question Text',
+    type: 'textarea',
+    placeholder:
+      'e.g. What is the correct syntax for creating a variable in JavaScript?',
+    description: 'Enter the question text (5-500 characters).',
+  },
+  {
+    name: 'question_type',
+    label: 'Question Type',
+    type: 'select',
+    placeholder: 'Select the question type',
+    description: 'Select how the question is answered.',
+    options: questionTypeOptions,
+  },
+  {
+    name: 'options',
+    label: 'Options',
+    type: 'textarea',
+    placeholder: 'var x = 5;\nlet x = 5;\nconst x = 5;\nAll of the above',
+    description:
+      'Enter one option per line for choice and ordering questions. Leave empty for True / False, numeric and short text questions.',
+  },
+  {
+    name: 'correct_options',
+    label: 'Correct Options',
+    type: 'text',
+    placeholder: 'e.g. 1, 3',
+    description:
+      'Enter the numbers of the correct options separated by commas, in the correct order for ordering questions. For True / False, 1 is True and 2 is False.',
+  },
+  {
+    name: 'numeric_answer',
+    label: 'Numeric Answer',
+    type: 'text',
+    placeholder: 'e.g. 3.14',
+    description: 'Enter the correct answer of a numeric question.',
+  },
+  {
+    name: 'tolerance',
+    label: 'Tolerance',
+    type: 'number',
+    placeholder: 'e.g. 0.01',
+    description:
+      'Enter how far a numeric answer may be from the correct one and still count.',
+  },
+  {
+    name: 'accepted_answers',
+    label: 'Accepted Answers',
+    type: 'textarea',
+    placeholder: 'e.g. Paris\nparis*',
+    description:
+      'Enter one accepted answer per line for short text questions. * matches any text.',
+  },
+];
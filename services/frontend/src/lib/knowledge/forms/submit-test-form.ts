@@ -1,11 +1,14 @@
-import type { QuestionForm } from '@/types/knowledge/models';
+import type { Answer, QuestionForm } from '@/types/knowledge/models';
 import { QuestionFormSchema } from '@/types/knowledge/schemas/models';
 import type { Field } from '@/types/shared/field';
+import { optionLabel } from '@/lib/knowledge/questions';
 import z from 'zod';
 
 // Note: Este formulario es especial porque las preguntas son dinámicas
 // Los campos se generarán dinámicamente basándose en las preguntas del test
-// Cada pregunta tendrá un campo de radio button group con las opciones A, B, C, D
+// Cada respuesta se guarda como texto y se convierte según el tipo de pregunta:
+// la posición elegida, las posiciones elegidas u ordenadas separadas por comas,
+// el número o el texto escrito
 
 export const createSubmitTestFormSchema = (questions: QuestionForm[]) =>
   z.object({
@@ -15,43 +18,77 @@ export const createSubmitTestFormSchema = (questions: QuestionForm[]) =>
         questions.map((question) => [
           `question_${question.id}`,
           z.string().min(1, {
-            message: `Please select an answer for question ${question.id}`,
+            message: `Please answer question ${question.question_order}`,
           }),
         ]),
       ),
     ),
   });
 
+/**
+ * Returns the starting value of the answer to a question. Ordering questions
+ * start in the order their options are shown, which is already an answer.
+ */
+export const defaultAnswerValue = (question: QuestionForm) =>
+  question.question_type === 'ordering'
+    ? question.options.map((_, position) => position).join(',')
+    : '';
+
+const toPositions = (value: string) =>
+  value
+    .split(',')
+    .filter((position) => position !== '')
+    .map((position) => parseInt(position, 10));
+
+/** Converts the text value of an answer to the answer the API expects. */
+export const toAnswer = (question: QuestionForm, value: string): Answer => {
+  switch (question.question_type) {
+    case 'numeric':
+      return { question_id: question.id, numeric_answer: Number(value) };
+    case 'short_text':
+      return { question_id: question.id, text_answer: value };
+    default:
+      return {
+        question_id: question.id,
+        selected_options: toPositions(value),
+      };
+  }
+};
+
 export const createSubmitTestFormFields = (
   questions: QuestionForm[],
 ): Field<string>[] => {
-  return questions.map((question, index) => ({
-    name: `question_${question.id}`,
-    label: `Question ${index + 1}`,
-    type: 'select' as const,
-    placeholder: 'Select your answer',
-    description: question.question_text,
-    options: [
-      {
-        key: `q${question.id}-a`,
-        value: '0',
-        textValue: `A) ${question.option_a}`,
-      },
-      {
-        key: `q${question.id}-b`,
-        value: '1',
-        textValue: `B) ${question.option_b}`,
-      },
-      {
-        key: `q${question.id}-c`,
-        value: '2',
-        textValue: `C) ${question.option_c}`,
-      },
-      {
-        key: `q${question.id}-d`,
-        value: '3',
-        textValue: `D) ${question.option_d}`,
-      },
-    ],
-  }));
+  return questions.map((question, index) => {
+    const base = {
+      name: `question_${question.id}`,
+      label: `Question ${index + 1}`,
+      description: question.question_text,
+    };
+    switch (question.question_type) {
+      case 'multiple_choice':
+      case 'true_false':
+        return {
+          ...base,
+          type: 'select' as const,
+          placeholder: 'Select your answer',
+          options: question.options.map((option, position) => ({
+            key: `q${question.id}-${position}`,
+            value: position.toString(),
+            textValue: `${optionLabel(position)}) ${option}`,
+          })),
+        };
+      case 'numeric':
+        return {
+          ...base,
+          type: 'text' as const,
+          placeholder: 'Enter a number',
+        };
+      default:
+        return {
+          ...base,
+          type: 'text' as const,
+          placeholder: 'Enter your answer',
+        };
+    }
+  });
 };
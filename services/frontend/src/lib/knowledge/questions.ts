@@ -0,0 +1,26 @@
+import type { Question } from '@/types/knowledge/models';
+
+/** Returns the letter an option is shown with, A for the first one. */
+export function optionLabel(position: number) {
+  return String.fromCharCode(65 + position);
+}
+
+/**
+ * Describes the correct answer of a question for teachers and revealed
+ * results: the letters of the correct options, in order for ordering
+ * questions, the numeric answer with its tolerance, or the accepted answers.
+ */
+export function describeCorrectAnswer(question: Question) {
+  switch (question.question_type) {
+    case 'numeric':
+      return question.tolerance > 0
+        ? `${question.numeric_answer} ± ${question.tolerance}`
+        : `${question.numeric_answer}`;
+    case 'short_text':
+      return question.accepted_answers.join(' / ');
+    case 'ordering':
+      return question.correct_options.map(optionLabel).join(' → ');
+    default:
+      return question.correct_options.map(optionLabel).join(', ');
+  }
+}
@@ -4,11 +4,14 @@ import type {
   QuestionFormSchema,
   AnswerSchema,
   SubmissionSchema,
+  QuestionTypeSchema,
 } from '@/types/knowledge/schemas/models';
 import type z from 'zod';
 
 export type Test = z.infer<typeof TestSchema>;
 
+export type QuestionType = z.infer<typeof QuestionTypeSchema>;
+
 export type Question = z.infer<typeof QuestionSchema>;
 
 export type QuestionForm = z.infer<typeof QuestionFormSchema>;
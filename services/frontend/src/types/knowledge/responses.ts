@@ -40,11 +40,21 @@ export type SubmitTestResponse = SimpleResponse & {
   score: number;
 };
 
+export type QuestionResult = {
+  question: Question;
+  selected_options: number[];
+  numeric_answer?: number;
+  text_answer?: string;
+  credit: number;
+  is_correct: boolean;
+  position: number;
+  option_order: number[];
+};
+
 export type GetSubmissionResultResponse = {
   submission: Submission;
-  questions: {
-    question: Question;
-    selected_answer: number;
-    is_correct: boolean;
-  }[];
+  questions: QuestionResult[];
+  attempts: Submission[];
+  counted_score: number;
+  answers_revealed: boolean;
 };
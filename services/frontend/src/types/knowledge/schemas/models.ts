@@ -1,5 +1,14 @@
 import z from 'zod';
 
+export const QuestionTypeSchema = z.enum([
+  'multiple_choice',
+  'multiple_select',
+  'true_false',
+  'numeric',
+  'short_text',
+  'ordering',
+]);
+
 export const TestSchema = z.object({
   id: z
     .number()
@@ -35,28 +44,28 @@ export const QuestionSchema = z.object({
     .string()
     .min(1, 'Question text is required')
     .describe('Text of the question'),
-  option_a: z
-    .string()
-    .min(1, 'Option A is required')
-    .describe('Answer option A'),
-  option_b: z
-    .string()
-    .min(1, 'Option B is required')
-    .describe('Answer option B'),
-  option_c: z
-    .string()
-    .min(1, 'Option C is required')
-    .describe('Answer option C'),
-  option_d: z
-    .string()
-    .min(1, 'Option D is required')
-    .describe('Answer option D'),
-  correct_answer: z
-    .number()
-    .int('Correct answer must be an integer')
-    .min(0, 'Correct answer must be between 0-3')
-    .max(3, 'Correct answer must be between 0-3')
-    .describe('Correct answer index (0=A, 1=B, 2=C, 3=D)'),
+  question_type: QuestionTypeSchema.describe('Type of the question'),
+  options: z
+    .array(z.string().min(1, 'Options cannot be empty'))
+    .describe(
+      'Options to choose from or to order, empty for numeric and short text questions',
+    ),
+  correct_options: z
+    .array(z.number().int('Correct options must be integers').min(0))
+    .describe(
+      'Indexes of the correct options, in the correct order for ordering questions',
+    ),
+  numeric_answer: z
+    .number()
+    .optional()
+    .describe('Correct answer of a numeric question'),
+  tolerance: z
+    .number()
+    .min(0, 'Tolerance cannot be negative')
+    .describe('Accepted distance from the numeric answer'),
+  accepted_answers: z
+    .array(z.string().min(1, 'Accepted answers cannot be empty'))
+    .describe('Accepted answers of a short text question'),
   question_order: z
     .number()
     .int('Question order must be an integer')
@@ -73,22 +82,12 @@ export const QuestionFormSchema = z.object({
     .string()
     .min(1, 'Question text is required')
     .describe('Text of the question'),
-  option_a: z
-    .string()
-    .min(1, 'Option A is required')
-    .describe('Answer option A'),
-  option_b: z
-    .string()
-    .min(1, 'Option B is required')
-    .describe('Answer option B'),
-  option_c: z
-    .string()
-    .min(1, 'Option C is required')
-    .describe('Answer option C'),
-  option_d: z
-    .string()
-    .min(1, 'Option D is required')
-    .describe('Answer option D'),
+  question_type: QuestionTypeSchema.describe('Type of the question'),
+  options: z
+    .array(z.string())
+    .describe(
+      'Options to choose from or to order, empty for numeric and short text questions',
+    ),
   question_order: z
     .number()
     .int('Question order must be an integer')
@@ -101,12 +100,20 @@ export const AnswerSchema = z.object({
     .number()
     .int('Question ID must be an integer')
     .describe('ID of the question being answered'),
-  selected_answer: z
+  selected_options: z
+    .array(z.number().int('Selected options must be integers').min(0))
+    .optional()
+    .describe(
+      'Positions of the selected options as shown, in the chosen order for ordering questions',
+    ),
+  numeric_answer: z
     .number()
-    .int('Selected answer must be an integer')
-    .min(0, 'Selected answer must be between 0-3')
-    .max(3, 'Selected answer must be between 0-3')
-    .describe('Selected answer index (0=A, 1=B, 2=C, 3=D)'),
+    .optional()
+    .describe('Answer to a numeric question'),
+  text_answer: z
+    .string()
+    .optional()
+    .describe('Answer to a short text question'),
 });
 
 export const SubmissionSchema = z.object({
@@ -133,4 +140,16 @@ export const SubmissionSchema = z.object({
     .describe(
       'Timestamp when the test was submitted (milliseconds since epoch)',
     ),
+  status: z
+    .enum(['in_progress', 'submitted', 'expired'])
+    .describe('Whether the test was submitted or expired'),
+  started_at: z
+    .number()
+    .int('Start timestamp must be an integer')
+    .describe('Timestamp when the attempt started (milliseconds since epoch)'),
+  attempt_number: z
+    .number()
+    .int('Attempt number must be an integer')
+    .min(1, 'Attempt number must be at least 1')
+    .describe('Number of the attempt at the test, from 1'),
 });
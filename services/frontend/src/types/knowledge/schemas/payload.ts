@@ -39,11 +39,12 @@ export const GetTestQuestionsPayloadSchema = TestSchema.pick({
 export const AddQuestionPayloadSchema = QuestionSchema.pick({
   test_id: true,
   question_text: true,
-  option_a: true,
-  option_b: true,
-  option_c: true,
-  option_d: true,
-  correct_answer: true,
+  question_type: true,
+  options: true,
+  correct_options: true,
+  numeric_answer: true,
+  tolerance: true,
+  accepted_answers: true,
 });
 
 export const GetQuestionPayloadSchema = QuestionSchema.pick({
@@ -54,19 +55,19 @@ export const GetQuestionPayloadSchema = QuestionSchema.pick({
 export const UpdateQuestionPayloadSchema = QuestionSchema.pick({
   id: true,
   test_id: true,
-  option_a: true,
-  option_b: true,
-  option_c: true,
-  option_d: true,
   question_text: true,
-  correct_answer: true,
+  question_type: true,
+  options: true,
+  correct_options: true,
+  numeric_answer: true,
+  tolerance: true,
+  accepted_answers: true,
 }).partial({
-  option_a: true,
-  option_b: true,
-  option_c: true,
-  option_d: true,
-  question_text: true,
-  correct_answer: true,
+  question_type: true,
+  options: true,
+  correct_options: true,
+  tolerance: true,
+  accepted_answers: true,
 });
 
 export const DeleteQuestionPayloadSchema = QuestionSchema.pick({
@@ -49,7 +49,7 @@ func main() {
 	reposManager := repositories.NewRepositoryManager(pool, grpccoon)
 	defer reposManager.Close()
 
-	var knowledgeSvc knowledge.Service = knowledgeapi.NewKnowledge(reposManager)
+	var knowledgeSvc knowledge.Service = knowledgeapi.NewKnowledge(reposManager, cfg.SubmissionGracePeriod)
 
 	var knowledgeEndpoints *knowledge.Endpoints
 	knowledgeEndpoints = knowledge.NewEndpoints(knowledgeSvc)
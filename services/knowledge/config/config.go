@@ -21,6 +21,8 @@ const (
 	APP_ENV   = "APP_ENV"
 
 	PROFILES_GRPC_ADDRESS = "PROFILES_GRPC_ADDRESS"
+
+	SUBMISSION_GRACE_SECONDS = "SUBMISSION_GRACE_SECONDS"
 )
 
 type DBConfig struct {
@@ -49,6 +51,9 @@ type Config struct {
 	// gRPC configuration
 	ProfilesGRPCAddress string
 
+	// Timed tests
+	SubmissionGracePeriod time.Duration
+
 	TOTPIssuer      string
 	SessionDuration time.Duration
 
@@ -82,6 +87,11 @@ func NewConfig() (*Config, error) {
 	// Parse boolean and numeric values
 	debug := parseBoolOrDefault(DBG, false)
 
+	graceSeconds := parseIntOrDefault(SUBMISSION_GRACE_SECONDS, 30)
+	if graceSeconds < 0 {
+		return nil, fmt.Errorf("submission grace period (%d) cannot be negative", graceSeconds)
+	}
+
 	format := goaLog.FormatTerminal
 
 	ctx := goaLog.Context(context.Background(), goaLog.WithFormat(format))
@@ -106,6 +116,8 @@ func NewConfig() (*Config, error) {
 		MaxConns:            max_conns,
 		MinConns:            min_conns,
 		ProfilesGRPCAddress: profilesGRPCAddress,
+
+		SubmissionGracePeriod: time.Duration(graceSeconds) * time.Second,
 	}, nil
 }
 
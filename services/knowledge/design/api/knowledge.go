@@ -19,6 +19,8 @@ var _ = Service("knowledge", func() {
 	Error("submission_not_found", String, "Submission not found")
 	Error("test_already_submitted", String, "Test already submitted by user")
 	Error("invalid_input", String, "Invalid input")
+	Error("time_expired", String, "Time limit of the test exceeded")
+	Error("internal_error", String, "Internal server error")
 
 	// === TEACHER METHODS ===
 	// DONE in frontend
@@ -28,6 +30,29 @@ var _ = Service("knowledge", func() {
 		Payload(func() {
 			Field(1, "session_token", String, "Session token")
 			Field(2, "title", String, "Test title")
+			Field(3, "duration_minutes", Int, "Time limit in minutes, untimed when not set", func() {
+				Minimum(1)
+			})
+			Field(4, "max_attempts", Int, "Attempts allowed per student, 0 for unlimited", func() {
+				Default(1)
+				Minimum(0)
+			})
+			Field(5, "cooldown_minutes", Int, "Minutes a student waits between attempts", func() {
+				Default(0)
+				Minimum(0)
+			})
+			Field(6, "score_policy", ScorePolicy, "Which score counts when the test is taken more than once", func() {
+				Default("best")
+			})
+			Field(7, "reveal_answers", Boolean, "Whether correct answers are shown after each attempt", func() {
+				Default(true)
+			})
+			Field(8, "shuffle_questions", Boolean, "Whether each attempt shows the questions in a different order", func() {
+				Default(false)
+			})
+			Field(9, "shuffle_options", Boolean, "Whether each attempt shows the options in a different order", func() {
+				Default(false)
+			})
 			Required("session_token", "title")
 		})
 		Result(SimpleResponse)
@@ -79,11 +104,34 @@ var _ = Service("knowledge", func() {
 	// DONE in frontend
 	// NOT TESTED
 	Method("UpdateTest", func() {
-		Description("Update test title")
+		Description("Update test title, time limit, attempt policy and shuffling")
 		Payload(func() {
 			Field(1, "session_token", String, "Session token")
 			Field(2, "test_id", Int64, "Test ID")
 			Field(3, "title", String, "New title")
+			Field(4, "duration_minutes", Int, "Time limit in minutes, untimed when not set", func() {
+				Minimum(1)
+			})
+			Field(5, "max_attempts", Int, "Attempts allowed per student, 0 for unlimited", func() {
+				Default(1)
+				Minimum(0)
+			})
+			Field(6, "cooldown_minutes", Int, "Minutes a student waits between attempts", func() {
+				Default(0)
+				Minimum(0)
+			})
+			Field(7, "score_policy", ScorePolicy, "Which score counts when the test is taken more than once", func() {
+				Default("best")
+			})
+			Field(8, "reveal_answers", Boolean, "Whether correct answers are shown after each attempt", func() {
+				Default(true)
+			})
+			Field(9, "shuffle_questions", Boolean, "Whether each attempt shows the questions in a different order", func() {
+				Default(false)
+			})
+			Field(10, "shuffle_options", Boolean, "Whether each attempt shows the options in a different order", func() {
+				Default(false)
+			})
 			Required("session_token", "test_id", "title")
 		})
 		Result(SimpleResponse)
@@ -142,12 +190,18 @@ var _ = Service("knowledge", func() {
 			Field(1, "session_token", String, "Session token")
 			Field(2, "test_id", Int64, "Test ID")
 			Field(3, "question_text", String, "Question text")
-			Field(4, "option_a", String, "Option A")
-			Field(5, "option_b", String, "Option B")
-			Field(6, "option_c", String, "Option C")
-			Field(7, "option_d", String, "Option D")
-			Field(8, "correct_answer", Int, "Correct answer (0=A, 1=B, 2=C, 3=D)")
-			Required("session_token", "test_id", "question_text", "option_a", "option_b", "option_c", "option_d", "correct_answer")
+			Field(4, "question_type", QuestionType, "Question type", func() {
+				Default("multiple_choice")
+			})
+			Field(5, "options", ArrayOf(String), "Options of choice and ordering questions, True and False by default for true/false questions")
+			Field(6, "correct_options", ArrayOf(Int), "Indexes of the correct options, in the correct order for ordering questions")
+			Field(7, "numeric_answer", Float64, "Correct answer of numeric questions")
+			Field(8, "tolerance", Float64, "Allowed difference from the numeric answer", func() {
+				Default(0)
+				Minimum(0)
+			})
+			Field(9, "accepted_answers", ArrayOf(String), "Accepted answers of short text questions, where * matches any text")
+			Required("session_token", "test_id", "question_text")
 		})
 		Result(SimpleResponse)
 		HTTP(func() {
@@ -190,12 +244,18 @@ var _ = Service("knowledge", func() {
 			Field(2, "test_id", Int64, "Test ID")
 			Field(3, "question_id", Int64, "Question ID")
 			Field(4, "question_text", String, "Question text")
-			Field(5, "option_a", String, "Option A")
-			Field(6, "option_b", String, "Option B")
-			Field(7, "option_c", String, "Option C")
-			Field(8, "option_d", String, "Option D")
-			Field(9, "correct_answer", Int, "Correct answer (0=A, 1=B, 2=C, 3=D)")
-			Required("session_token", "test_id", "question_id", "question_text", "option_a", "option_b", "option_c", "option_d", "correct_answer")
+			Field(5, "question_type", QuestionType, "Question type", func() {
+				Default("multiple_choice")
+			})
+			Field(6, "options", ArrayOf(String), "Options of choice and ordering questions, True and False by default for true/false questions")
+			Field(7, "correct_options", ArrayOf(Int), "Indexes of the correct options, in the correct order for ordering questions")
+			Field(8, "numeric_answer", Float64, "Correct answer of numeric questions")
+			Field(9, "tolerance", Float64, "Allowed difference from the numeric answer", func() {
+				Default(0)
+				Minimum(0)
+			})
+			Field(10, "accepted_answers", ArrayOf(String), "Accepted answers of short text questions, where * matches any text")
+			Required("session_token", "test_id", "question_id", "question_text")
 		})
 		Result(SimpleResponse)
 		HTTP(func() {
@@ -205,6 +265,7 @@ var _ = Service("knowledge", func() {
 			Response("unauthorized", StatusUnauthorized)
 			Response("test_not_found", StatusNotFound)
 			Response("question_not_found", StatusNotFound)
+			Response("invalid_input", StatusBadRequest)
 		})
 	})
 
@@ -229,6 +290,297 @@ var _ = Service("knowledge", func() {
 		})
 	})
 
+	// === QUESTION BANK METHODS ===
+	Method("CreateQuestionBank", func() {
+		Description("Create a question bank")
+		Payload(func() {
+			Field(1, "session_token", String, "Session token")
+			Field(2, "title", String, "Question bank title")
+			Required("session_token", "title")
+		})
+		Result(SimpleResponse)
+		HTTP(func() {
+			POST("/banks")
+			Cookie("session_token:session")
+			Response(StatusCreated)
+			Response("unauthorized", StatusUnauthorized)
+			Response("invalid_input", StatusBadRequest)
+		})
+	})
+
+	Method("GetMyQuestionBanks", func() {
+		Description("Get my question banks")
+		Payload(func() {
+			Field(1, "session_token", String, "Session token")
+			Required("session_token")
+		})
+		Result(QuestionBanksResponse)
+		HTTP(func() {
+			GET("/banks/my")
+			Cookie("session_token:session")
+			Response(StatusOK)
+			Response("unauthorized", StatusUnauthorized)
+		})
+	})
+
+	Method("DeleteQuestionBank", func() {
+		Description("Delete a question bank with its questions and the draw rules using it")
+		Payload(func() {
+			Field(1, "session_token", String, "Session token")
+			Field(2, "bank_id", Int64, "Question bank ID")
+			Required("session_token", "bank_id")
+		})
+		Result(SimpleResponse)
+		HTTP(func() {
+			DELETE("/banks/{bank_id}")
+			Cookie("session_token:session")
+			Response(StatusOK)
+			Response("unauthorized", StatusUnauthorized)
+			Response("invalid_input", StatusBadRequest)
+		})
+	})
+
+	Method("GetBankQuestions", func() {
+		Description("Get the questions of a question bank")
+		Payload(func() {
+			Field(1, "session_token", String, "Session token")
+			Field(2, "bank_id", Int64, "Question bank ID")
+			Required("session_token", "bank_id")
+		})
+		Result(BankQuestionsResponse)
+		HTTP(func() {
+			GET("/banks/{bank_id}/questions")
+			Cookie("session_token:session")
+			Response(StatusOK)
+			Response("unauthorized", StatusUnauthorized)
+			Response("invalid_input", StatusBadRequest)
+		})
+	})
+
+	Method("AddBankQuestion", func() {
+		Description("Add a question to a question bank")
+		Payload(func() {
+			Field(1, "session_token", String, "Session token")
+			Field(2, "bank_id", Int64, "Question bank ID")
+			Field(3, "question_text", String, "Question text")
+			Field(4, "question_type", QuestionType, "Question type", func() {
+				Default("multiple_choice")
+			})
+			Field(5, "options", ArrayOf(String), "Options of choice and ordering questions, True and False by default for true/false questions")
+			Field(6, "correct_options", ArrayOf(Int), "Indexes of the correct options, in the correct order for ordering questions")
+			Field(7, "numeric_answer", Float64, "Correct answer of numeric questions")
+			Field(8, "tolerance", Float64, "Allowed difference from the numeric answer", func() {
+				Default(0)
+				Minimum(0)
+			})
+			Field(9, "accepted_answers", ArrayOf(String), "Accepted answers of short text questions, where * matches any text")
+			Field(10, "topic", String, "Topic of the question", func() {
+				MaxLength(100)
+			})
+			Field(11, "difficulty", Difficulty, "Question difficulty", func() {
+				Default("medium")
+			})
+			Required("session_token", "bank_id", "question_text")
+		})
+		Result(SimpleResponse)
+		HTTP(func() {
+			POST("/banks/{bank_id}/questions")
+			Cookie("session_token:session")
+			Response(StatusCreated)
+			Response("unauthorized", StatusUnauthorized)
+			Response("invalid_input", StatusBadRequest)
+		})
+	})
+
+	Method("UpdateBankQuestion", func() {
+		Description("Update a question of a question bank")
+		Payload(func() {
+			Field(1, "session_token", String, "Session token")
+			Field(2, "bank_id", Int64, "Question bank ID")
+			Field(3, "question_id", Int64, "Question ID")
+			Field(4, "question_text", String, "Question text")
+			Field(5, "question_type", QuestionType, "Question type", func() {
+				Default("multiple_choice")
+			})
+			Field(6, "options", ArrayOf(String), "Options of choice and ordering questions, True and False by default for true/false questions")
+			Field(7, "correct_options", ArrayOf(Int), "Indexes of the correct options, in the correct order for ordering questions")
+			Field(8, "numeric_answer", Float64, "Correct answer of numeric questions")
+			Field(9, "tolerance", Float64, "Allowed difference from the numeric answer", func() {
+				Default(0)
+				Minimum(0)
+			})
+			Field(10, "accepted_answers", ArrayOf(String), "Accepted answers of short text questions, where * matches any text")
+			Field(11, "topic", String, "Topic of the question", func() {
+				MaxLength(100)
+			})
+			Field(12, "difficulty", Difficulty, "Question difficulty", func() {
+				Default("medium")
+			})
+			Required("session_token", "bank_id", "question_id", "question_text")
+		})
+		Result(SimpleResponse)
+		HTTP(func() {
+			PUT("/banks/{bank_id}/questions/{question_id}")
+			Cookie("session_token:session")
+			Response(StatusOK)
+			Response("unauthorized", StatusUnauthorized)
+			Response("question_not_found", StatusNotFound)
+			Response("invalid_input", StatusBadRequest)
+		})
+	})
+
+	Method("DeleteBankQuestion", func() {
+		Description("Delete a question of a question bank")
+		Payload(func() {
+			Field(1, "session_token", String, "Session token")
+			Field(2, "bank_id", Int64, "Question bank ID")
+			Field(3, "question_id", Int64, "Question ID")
+			Required("session_token", "bank_id", "question_id")
+		})
+		Result(SimpleResponse)
+		HTTP(func() {
+			DELETE("/banks/{bank_id}/questions/{question_id}")
+			Cookie("session_token:session")
+			Response(StatusOK)
+			Response("unauthorized", StatusUnauthorized)
+			Response("question_not_found", StatusNotFound)
+			Response("invalid_input", StatusBadRequest)
+		})
+	})
+
+	Method("AddDrawRule", func() {
+		Description("Make every attempt at a test draw questions at random from a question bank")
+		Payload(func() {
+			Field(1, "session_token", String, "Session token")
+			Field(2, "test_id", Int64, "Test ID")
+			Field(3, "bank_id", Int64, "Question bank ID")
+			Field(4, "topic", String, "Topic of the questions drawn, any when not set", func() {
+				MaxLength(100)
+			})
+			Field(5, "difficulty", Difficulty, "Difficulty of the questions drawn, any when not set")
+			Field(6, "question_count", Int, "Number of questions drawn", func() {
+				Minimum(1)
+			})
+			Required("session_token", "test_id", "bank_id", "question_count")
+		})
+		Result(SimpleResponse)
+		HTTP(func() {
+			POST("/tests/{test_id}/draw-rules")
+			Cookie("session_token:session")
+			Response(StatusCreated)
+			Response("unauthorized", StatusUnauthorized)
+			Response("test_not_found", StatusNotFound)
+			Response("invalid_input", StatusBadRequest)
+		})
+	})
+
+	Method("GetDrawRules", func() {
+		Description("Get the draw rules of a test")
+		Payload(func() {
+			Field(1, "session_token", String, "Session token")
+			Field(2, "test_id", Int64, "Test ID")
+			Required("session_token", "test_id")
+		})
+		Result(DrawRulesResponse)
+		HTTP(func() {
+			GET("/tests/{test_id}/draw-rules")
+			Cookie("session_token:session")
+			Response(StatusOK)
+			Response("unauthorized", StatusUnauthorized)
+			Response("test_not_found", StatusNotFound)
+		})
+	})
+
+	Method("DeleteDrawRule", func() {
+		Description("Delete a draw rule of a test")
+		Payload(func() {
+			Field(1, "session_token", String, "Session token")
+			Field(2, "test_id", Int64, "Test ID")
+			Field(3, "rule_id", Int64, "Draw rule ID")
+			Required("session_token", "test_id", "rule_id")
+		})
+		Result(SimpleResponse)
+		HTTP(func() {
+			DELETE("/tests/{test_id}/draw-rules/{rule_id}")
+			Cookie("session_token:session")
+			Response(StatusOK)
+			Response("unauthorized", StatusUnauthorized)
+			Response("test_not_found", StatusNotFound)
+			Response("invalid_input", StatusBadRequest)
+		})
+	})
+
+	// === ANALYTICS METHODS ===
+	Method("GetTestAnalytics", func() {
+		Description("Get the score distribution and item analysis of a test")
+		Payload(func() {
+			Field(1, "session_token", String, "Session token")
+			Field(2, "test_id", Int64, "Test ID")
+			Required("session_token", "test_id")
+		})
+		Result(TestAnalytics)
+		HTTP(func() {
+			GET("/tests/{test_id}/analytics")
+			Cookie("session_token:session")
+			Response(StatusOK)
+			Response("unauthorized", StatusUnauthorized)
+			Response("test_not_found", StatusNotFound)
+			Response("invalid_input", StatusBadRequest)
+			Response("internal_error", StatusInternalServerError)
+		})
+	})
+
+	// === GRADEBOOK METHODS ===
+	Method("GetTestGradebook", func() {
+		Description("Get the submissions of every student at a test")
+		Payload(func() {
+			Field(1, "session_token", String, "Session token")
+			Field(2, "test_id", Int64, "Test ID")
+			Field(3, "sort_by", String, "Field to sort submissions by", func() {
+				Enum("submitted_at", "score", "student_name")
+				Default("submitted_at")
+			})
+			Field(4, "sort_order", String, "Sort direction", func() {
+				Enum("asc", "desc")
+				Default("desc")
+			})
+			Field(5, "status", AttemptStatus, "Only submissions with this status")
+			Field(6, "student", String, "Only submissions of students whose name contains this text")
+			Required("session_token", "test_id")
+		})
+		Result(TestGradebook)
+		HTTP(func() {
+			GET("/tests/{test_id}/gradebook")
+			Cookie("session_token:session")
+			Param("sort_by")
+			Param("sort_order")
+			Param("status")
+			Param("student")
+			Response(StatusOK)
+			Response("unauthorized", StatusUnauthorized)
+			Response("test_not_found", StatusNotFound)
+			Response("invalid_input", StatusBadRequest)
+		})
+	})
+
+	Method("GetStudentHistory", func() {
+		Description("Get the submissions of a student at every test of the teacher")
+		Payload(func() {
+			Field(1, "session_token", String, "Session token")
+			Field(2, "user_id", Int64, "Student user ID")
+			Required("session_token", "user_id")
+		})
+		Result(StudentHistory)
+		HTTP(func() {
+			GET("/students/{user_id}/submissions")
+			Cookie("session_token:session")
+			Response(StatusOK)
+			Response("unauthorized", StatusUnauthorized)
+			Response("submission_not_found", StatusNotFound)
+			Response("invalid_input", StatusBadRequest)
+		})
+	})
+
 	// === STUDENT METHODS ===
 	// DONE in frontend
 	// NOT TESTED
@@ -247,6 +599,27 @@ var _ = Service("knowledge", func() {
 		})
 	})
 
+	Method("StartTest", func() {
+		Description("Start an attempt at a test, or resume the one in progress")
+		Payload(func() {
+			Field(1, "session_token", String, "Session token")
+			Field(2, "test_id", Int64, "Test ID")
+			Required("session_token", "test_id")
+		})
+		Result(TestAttempt)
+		HTTP(func() {
+			POST("/tests/{test_id}/start")
+			Cookie("session_token:session")
+			Response(StatusOK)
+			Response("unauthorized", StatusUnauthorized)
+			Response("test_not_found", StatusNotFound)
+			Response("test_already_submitted", StatusConflict)
+			Response("time_expired", StatusConflict)
+			Response("invalid_input", StatusBadRequest)
+			Response("internal_error", StatusInternalServerError)
+		})
+	})
+
 	// DONE in frontend
 	// NOT TESTED
 	Method("GetTestForm", func() {
@@ -264,6 +637,9 @@ var _ = Service("knowledge", func() {
 			Response("unauthorized", StatusUnauthorized)
 			Response("test_not_found", StatusNotFound)
 			Response("test_already_submitted", StatusConflict)
+			Response("time_expired", StatusConflict)
+			Response("invalid_input", StatusBadRequest)
+			Response("internal_error", StatusInternalServerError)
 		})
 	})
 
@@ -285,14 +661,16 @@ var _ = Service("knowledge", func() {
 			Response("unauthorized", StatusUnauthorized)
 			Response("test_not_found", StatusNotFound)
 			Response("test_already_submitted", StatusConflict)
+			Response("time_expired", StatusConflict)
 			Response("invalid_input", StatusBadRequest)
+			Response("internal_error", StatusInternalServerError)
 		})
 	})
 
 	// DONE in frontend
 	// NOT TESTED
 	Method("GetMySubmissions", func() {
-		Description("Get my test submissions")
+		Description("Get every attempt of mine at every test, with the score counted for each test")
 		Payload(func() {
 			Field(1, "session_token", String, "Session token")
 			Required("session_token")
@@ -326,7 +704,7 @@ var _ = Service("knowledge", func() {
 	})
 
 	Method("GetSubmissionResult", func() {
-		Description("Get detailed submission result")
+		Description("Get detailed submission result, with every attempt at the same test")
 		Payload(func() {
 			Field(1, "session_token", String, "Session token")
 			Field(2, "submission_id", Int64, "Submission ID")
@@ -339,6 +717,7 @@ var _ = Service("knowledge", func() {
 			Response(StatusOK)
 			Response("unauthorized", StatusUnauthorized)
 			Response("submission_not_found", StatusNotFound)
+			Response("invalid_input", StatusBadRequest)
 		})
 	})
 })
@@ -20,7 +20,44 @@ var Test = Type("Test", func() {
 	Field(3, "created_by", Int64, "Creator user ID")
 	Field(4, "created_at", Int64, "Creation timestamp")
 	Field(5, "question_count", Int, "Number of questions")
-	Required("id", "title", "created_by", "created_at")
+	Field(6, "duration_minutes", Int, "Time limit in minutes, untimed when not set")
+	Field(7, "max_attempts", Int, "Attempts allowed per student, 0 for unlimited")
+	Field(8, "cooldown_minutes", Int, "Minutes a student waits between attempts")
+	Field(9, "score_policy", ScorePolicy, "Which score counts when the test is taken more than once")
+	Field(10, "reveal_answers", Boolean, "Whether correct answers are shown after each attempt")
+	Field(11, "shuffle_questions", Boolean, "Whether each attempt shows the questions in a different order")
+	Field(12, "shuffle_options", Boolean, "Whether each attempt shows the options in a different order")
+	Required("id", "title", "created_by", "created_at", "max_attempts", "cooldown_minutes", "score_policy", "reveal_answers",
+		"shuffle_questions", "shuffle_options")
+})
+
+// ScorePolicy decides which score counts for a test taken more than once
+var ScorePolicy = Type("ScorePolicy", String, func() {
+	Description("Score policy")
+	Enum("best", "latest", "average")
+})
+
+// AttemptStatus is the state of an attempt at a test
+var AttemptStatus = Type("AttemptStatus", String, func() {
+	Description("Attempt status")
+	Enum("in_progress", "submitted", "expired")
+})
+
+var TestAttempt = Type("TestAttempt", func() {
+	Description("Attempt at a test, timed from when it was started")
+	Field(1, "submission_id", Int64, "Submission ID")
+	Field(2, "test_id", Int64, "Test ID")
+	Field(3, "status", AttemptStatus, "Attempt status")
+	Field(4, "started_at", Int64, "Start timestamp")
+	Field(5, "deadline", Int64, "Timestamp by which answers must be submitted, not set for untimed tests")
+	Field(6, "duration_minutes", Int, "Time limit in minutes")
+	Required("submission_id", "test_id", "status", "started_at")
+})
+
+// QuestionType is the kind of question, which decides how it is answered
+var QuestionType = Type("QuestionType", String, func() {
+	Description("Question type")
+	Enum("multiple_choice", "multiple_select", "true_false", "numeric", "short_text", "ordering")
 })
 
 var Question = Type("Question", func() {
@@ -28,32 +65,33 @@ var Question = Type("Question", func() {
 	Field(1, "id", Int64, "Question ID")
 	Field(2, "test_id", Int64, "Test ID")
 	Field(3, "question_text", String, "Question text")
-	Field(4, "option_a", String, "Option A")
-	Field(5, "option_b", String, "Option B")
-	Field(6, "option_c", String, "Option C")
-	Field(7, "option_d", String, "Option D")
-	Field(8, "correct_answer", Int, "Correct answer (0=A, 1=B, 2=C, 3=D)")
-	Field(9, "question_order", Int, "Question order")
-	Required("id", "test_id", "question_text", "option_a", "option_b", "option_c", "option_d", "correct_answer", "question_order")
+	Field(4, "question_type", QuestionType, "Question type")
+	Field(5, "options", ArrayOf(String), "Options of choice and ordering questions")
+	Field(6, "correct_options", ArrayOf(Int), "Indexes of the correct options, in the correct order for ordering questions")
+	Field(7, "numeric_answer", Float64, "Correct answer of numeric questions")
+	Field(8, "tolerance", Float64, "Allowed difference from the numeric answer")
+	Field(9, "accepted_answers", ArrayOf(String), "Accepted answers of short text questions, where * matches any text")
+	Field(10, "question_order", Int, "Question order")
+	Required("id", "test_id", "question_text", "question_type", "options", "correct_options", "tolerance", "accepted_answers", "question_order")
 })
 
 var QuestionForm = Type("QuestionForm", func() {
 	Description("Question for form taking (without correct answer)")
 	Field(1, "id", Int64, "Question ID")
 	Field(2, "question_text", String, "Question text")
-	Field(3, "option_a", String, "Option A")
-	Field(4, "option_b", String, "Option B")
-	Field(5, "option_c", String, "Option C")
-	Field(6, "option_d", String, "Option D")
-	Field(7, "question_order", Int, "Question order")
-	Required("id", "question_text", "option_a", "option_b", "option_c", "option_d", "question_order")
+	Field(3, "question_type", QuestionType, "Question type")
+	Field(4, "options", ArrayOf(String), "Options to choose from or to order, empty for numeric and short text questions")
+	Field(5, "question_order", Int, "Position of the question in the attempt")
+	Required("id", "question_text", "question_type", "options", "question_order")
 })
 
 var Answer = Type("Answer", func() {
 	Description("Answer submission")
 	Field(1, "question_id", Int64, "Question ID")
-	Field(2, "selected_answer", Int, "Selected answer (0=A, 1=B, 2=C, 3=D)")
-	Required("question_id", "selected_answer")
+	Field(2, "selected_options", ArrayOf(Int), "Positions of the selected options as shown in the form, in the chosen order for ordering questions")
+	Field(3, "numeric_answer", Float64, "Answer to a numeric question")
+	Field(4, "text_answer", String, "Answer to a short text question")
+	Required("question_id")
 })
 
 var Submission = Type("Submission", func() {
@@ -63,22 +101,151 @@ var Submission = Type("Submission", func() {
 	Field(3, "test_title", String, "Test title")
 	Field(4, "score", Float64, "Score percentage")
 	Field(5, "submitted_at", Int64, "Submission timestamp")
-	Required("id", "test_id", "test_title", "score", "submitted_at")
+	Field(6, "status", AttemptStatus, "Whether the test was submitted or expired")
+	Field(7, "started_at", Int64, "Start timestamp")
+	Field(8, "attempt_number", Int, "Number of the attempt at the test, from 1")
+	Required("id", "test_id", "test_title", "score", "submitted_at", "status", "started_at", "attempt_number")
 })
 
 var SubmissionResult = Type("SubmissionResult", func() {
 	Description("Detailed submission result")
 	Field(1, "submission", Submission, "Submission info")
 	Field(2, "questions", ArrayOf(QuestionResult), "Question results")
-	Required("submission", "questions")
+	Field(3, "attempts", ArrayOf(Submission), "Every attempt at the test, oldest first")
+	Field(4, "counted_score", Float64, "Score percentage counted for the test over every attempt")
+	Field(5, "answers_revealed", Boolean, "Whether the questions include their correct answers")
+	Required("submission", "questions", "attempts", "counted_score", "answers_revealed")
 })
 
 var QuestionResult = Type("QuestionResult", func() {
 	Description("Question result with user answer")
 	Field(1, "question", Question, "Question info")
-	Field(2, "selected_answer", Int, "User selected answer")
-	Field(3, "is_correct", Boolean, "Whether answer was correct")
-	Required("question", "selected_answer", "is_correct")
+	Field(2, "selected_options", ArrayOf(Int), "User selected options")
+	Field(3, "numeric_answer", Float64, "User numeric answer")
+	Field(4, "text_answer", String, "User text answer")
+	Field(5, "credit", Float64, "Fraction of the question earned, from 0 to 1")
+	Field(6, "is_correct", Boolean, "Whether answer was correct")
+	Field(7, "position", Int, "Position of the question as shown to the user")
+	Field(8, "option_order", ArrayOf(Int), "Index of each option of the question in the order shown to the user, empty when shown in order")
+	Required("question", "selected_options", "credit", "is_correct", "position", "option_order")
+})
+
+// === QUESTION BANK RELATED TYPES ===
+// Difficulty is how hard a bank question is, to draw equivalent questions
+var Difficulty = Type("Difficulty", String, func() {
+	Description("Question difficulty")
+	Enum("easy", "medium", "hard")
+})
+
+var QuestionBank = Type("QuestionBank", func() {
+	Description("Reusable set of questions tests are drawn from")
+	Field(1, "id", Int64, "Question bank ID")
+	Field(2, "title", String, "Question bank title")
+	Field(3, "created_by", Int64, "Creator user ID")
+	Field(4, "created_at", Int64, "Creation timestamp")
+	Field(5, "question_count", Int, "Number of questions")
+	Required("id", "title", "created_by", "created_at")
+})
+
+var BankQuestion = Type("BankQuestion", func() {
+	Description("Question of a question bank, tagged by topic and difficulty")
+	Field(1, "id", Int64, "Question ID")
+	Field(2, "bank_id", Int64, "Question bank ID")
+	Field(3, "question_text", String, "Question text")
+	Field(4, "question_type", QuestionType, "Question type")
+	Field(5, "options", ArrayOf(String), "Options of choice and ordering questions")
+	Field(6, "correct_options", ArrayOf(Int), "Indexes of the correct options, in the correct order for ordering questions")
+	Field(7, "numeric_answer", Float64, "Correct answer of numeric questions")
+	Field(8, "tolerance", Float64, "Allowed difference from the numeric answer")
+	Field(9, "accepted_answers", ArrayOf(String), "Accepted answers of short text questions, where * matches any text")
+	Field(10, "question_order", Int, "Question order in the bank")
+	Field(11, "topic", String, "Topic of the question, empty when untagged")
+	Field(12, "difficulty", Difficulty, "Question difficulty")
+	Required("id", "bank_id", "question_text", "question_type", "options", "correct_options", "tolerance", "accepted_answers", "question_order",
+		"topic", "difficulty")
+})
+
+var DrawRule = Type("DrawRule", func() {
+	Description("Questions every attempt at a test draws at random from a question bank")
+	Field(1, "id", Int64, "Draw rule ID")
+	Field(2, "test_id", Int64, "Test ID")
+	Field(3, "bank_id", Int64, "Question bank ID")
+	Field(4, "topic", String, "Topic of the questions drawn, any when not set")
+	Field(5, "difficulty", Difficulty, "Difficulty of the questions drawn, any when not set")
+	Field(6, "question_count", Int, "Number of questions drawn")
+	Required("id", "test_id", "bank_id", "question_count")
+})
+
+// === ANALYTICS RELATED TYPES ===
+var ScoreBin = Type("ScoreBin", func() {
+	Description("Range of scores of a histogram, including from and excluding to except for the last one")
+	Field(1, "from", Float64, "Lowest score percentage of the range")
+	Field(2, "to", Float64, "Highest score percentage of the range")
+	Field(3, "count", Int, "Number of attempts with a score in the range")
+	Required("from", "to", "count")
+})
+
+var OptionSelection = Type("OptionSelection", func() {
+	Description("How often an option of a question was selected")
+	Field(1, "index", Int, "Option index")
+	Field(2, "option", String, "Option text")
+	Field(3, "count", Int, "Number of attempts selecting the option")
+	Field(4, "is_correct", Boolean, "Whether the option is correct")
+	Required("index", "option", "count", "is_correct")
+})
+
+var QuestionAnalytics = Type("QuestionAnalytics", func() {
+	Description("Item analysis of a question")
+	Field(1, "question_id", Int64, "Question ID")
+	Field(2, "question_text", String, "Question text")
+	Field(3, "question_type", QuestionType, "Question type")
+	Field(4, "attempts", Int, "Number of attempts the question was given in")
+	Field(5, "difficulty_index", Float64, "Mean credit those attempts earned on it, which is the share answering it correctly for questions without partial credit, from 0 to 1")
+	Field(6, "discrimination_index", Float64, "Mean credit in the top 27% of attempts by score minus that in the bottom 27%, from -1 to 1, not set with too few attempts")
+	Field(7, "option_selections", ArrayOf(OptionSelection), "How often each option was selected, empty for questions without options")
+	Field(8, "unanswered", Int, "Number of attempts leaving the question unanswered")
+	Required("question_id", "question_text", "question_type", "attempts", "difficulty_index", "option_selections", "unanswered")
+})
+
+var TestAnalytics = Type("TestAnalytics", func() {
+	Description("Analysis of the first submitted attempt of every student at a test")
+	Field(1, "test_id", Int64, "Test ID")
+	Field(2, "attempts", Int, "Number of attempts analyzed")
+	Field(3, "mean", Float64, "Mean score percentage")
+	Field(4, "median", Float64, "Median score percentage")
+	Field(5, "std_dev", Float64, "Standard deviation of the score percentages")
+	Field(6, "score_histogram", ArrayOf(ScoreBin), "Number of attempts by score range")
+	Field(7, "questions", ArrayOf(QuestionAnalytics), "Item analysis of every question given")
+	Field(8, "reliability", Float64, "Reliability coefficient over the questions given in every attempt, not set when it cannot be computed")
+	Field(9, "reliability_method", String, "Reliability coefficient used, kr20 when those questions were all scored right or wrong and Cronbach's alpha when any earned partial credit, not set without a reliability", func() {
+		Enum("kr20", "alpha")
+	})
+	Required("test_id", "attempts", "mean", "median", "std_dev", "score_histogram", "questions")
+})
+
+// === GRADEBOOK RELATED TYPES ===
+var StudentSubmission = Type("StudentSubmission", func() {
+	Description("Submission of a student, as seen by the teacher of the test")
+	Field(1, "user_id", Int64, "Student user ID")
+	Field(2, "student_name", String, "Student full name")
+	Field(3, "submission", Submission, "Submission info")
+	Required("user_id", "student_name", "submission")
+})
+
+var TestGradebook = Type("TestGradebook", func() {
+	Description("Finished attempts of every student at a test")
+	Field(1, "test", Test, "Test info")
+	Field(2, "submissions", ArrayOf(StudentSubmission), "Submissions, sorted and filtered as requested")
+	Required("test", "submissions")
+})
+
+var StudentHistory = Type("StudentHistory", func() {
+	Description("Finished attempts of a student at the tests of a teacher")
+	Field(1, "user_id", Int64, "Student user ID")
+	Field(2, "student_name", String, "Student full name")
+	Field(3, "submissions", ArrayOf(Submission), "Submissions, newest first")
+	Field(4, "test_scores", ArrayOf(TestScore), "Score counted for each test taken")
+	Required("user_id", "student_name", "submissions", "test_scores")
 })
 
 // === RESPONSE TYPES ===
@@ -106,6 +273,24 @@ var QuestionsResponse = Type("QuestionsResponse", func() {
 	Required("questions")
 })
 
+var QuestionBanksResponse = Type("QuestionBanksResponse", func() {
+	Description("List of question banks")
+	Field(1, "banks", ArrayOf(QuestionBank), "Question banks")
+	Required("banks")
+})
+
+var BankQuestionsResponse = Type("BankQuestionsResponse", func() {
+	Description("List of questions of a question bank")
+	Field(1, "questions", ArrayOf(BankQuestion), "Questions")
+	Required("questions")
+})
+
+var DrawRulesResponse = Type("DrawRulesResponse", func() {
+	Description("List of draw rules of a test")
+	Field(1, "rules", ArrayOf(DrawRule), "Draw rules")
+	Required("rules")
+})
+
 var FormResponse = Type("FormResponse", func() {
 	Description("Form for taking test")
 	Field(1, "test", Test, "Test info")
@@ -122,7 +307,18 @@ var SubmissionResponse = Type("SubmissionResponse", func() {
 var SubmissionsResponse = Type("SubmissionsResponse", func() {
 	Description("List of submissions")
 	Field(1, "submissions", ArrayOf(Submission), "Submissions")
-	Required("submissions")
+	Field(2, "test_scores", ArrayOf(TestScore), "Score counted for each test taken")
+	Required("submissions", "test_scores")
+})
+
+var TestScore = Type("TestScore", func() {
+	Description("Score counted for a test over every attempt")
+	Field(1, "test_id", Int64, "Test ID")
+	Field(2, "test_title", String, "Test title")
+	Field(3, "score_policy", ScorePolicy, "Which score counts")
+	Field(4, "attempts", Int, "Number of attempts")
+	Field(5, "score", Float64, "Score percentage counted")
+	Required("test_id", "test_title", "score_policy", "attempts", "score")
 })
 
 var SubmitResponse = Type("SubmitResponse", func() {
@@ -131,7 +327,8 @@ var SubmitResponse = Type("SubmitResponse", func() {
 	Field(2, "message", String, "Response message")
 	Field(3, "submission_id", Int64, "Submission ID")
 	Field(4, "score", Float64, "Score percentage")
-	Required("success", "message", "submission_id", "score")
+	Field(5, "attempt_number", Int, "Number of the attempt at the test, from 1")
+	Required("success", "message", "submission_id", "score", "attempt_number")
 })
 
 // === INTER-SERVICE COMMUNICATION TYPES ===
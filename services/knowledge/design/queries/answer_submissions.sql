@@ -4,20 +4,33 @@
 INSERT INTO answer_submissions (
     submission_id,
     question_id,
-    selected_answer,
-    is_correct
-) VALUES ($1, $2, $3, $4);
+    selected_options,
+    numeric_answer,
+    text_answer,
+    credit,
+    is_correct,
+    position,
+    option_order
+) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9);
 
 -- name: GetAnswersBySubmission :many
 SELECT 
     a.*,
     q.question_text,
-    q.option_a,
-    q.option_b,
-    q.option_c,
-    q.option_d,
-    q.correct_answer
+    q.question_type,
+    q.options,
+    q.correct_options,
+    q.numeric_answer AS correct_numeric_answer,
+    q.tolerance,
+    q.accepted_answers,
+    q.question_order
 FROM answer_submissions a
 JOIN questions q ON a.question_id = q.id
 WHERE a.submission_id = $1
-ORDER BY q.question_order ASC;
+ORDER BY a.position ASC;
+
+-- name: GetAnswersByTest :many
+-- Answers of every submitted attempt at a test
+SELECT a.* FROM answer_submissions a
+JOIN test_submissions s ON a.submission_id = s.id
+WHERE s.test_id = $1 AND s.status = 'submitted';
@@ -0,0 +1,14 @@
+-- Draw rules queries
+
+-- name: CreateDrawRule :exec
+INSERT INTO draw_rules (test_id, bank_id, topic, difficulty, question_count)
+VALUES ($1, $2, $3, $4, $5);
+
+-- name: GetDrawRuleById :one
+SELECT * FROM draw_rules WHERE id = $1;
+
+-- name: GetDrawRulesByTestId :many
+SELECT * FROM draw_rules WHERE test_id = $1 ORDER BY id;
+
+-- name: DeleteDrawRule :exec
+DELETE FROM draw_rules WHERE id = $1;
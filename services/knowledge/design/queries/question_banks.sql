@@ -0,0 +1,17 @@
+-- Question banks queries
+
+-- name: CreateQuestionBank :exec
+INSERT INTO question_banks (title, created_by) VALUES ($1, $2);
+
+-- name: GetQuestionBankById :one
+SELECT * FROM question_banks WHERE id = $1;
+
+-- name: GetMyQuestionBanks :many
+SELECT b.*,
+       (SELECT COUNT(*) FROM questions WHERE bank_id = b.id) as question_count
+FROM question_banks b
+WHERE b.created_by = $1
+ORDER BY b.created_at DESC;
+
+-- name: DeleteQuestionBank :exec
+DELETE FROM question_banks WHERE id = $1;
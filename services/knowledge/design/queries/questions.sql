@@ -4,31 +4,90 @@
 INSERT INTO questions (
     test_id,
     question_text,
-    option_a,
-    option_b,
-    option_c,
-    option_d,
-    correct_answer,
+    question_type,
+    options,
+    correct_options,
+    numeric_answer,
+    tolerance,
+    accepted_answers,
     question_order
-) VALUES ($1, $2, $3, $4, $5, $6, $7, $8);
+) VALUES (@test_id::BIGINT, @question_text, @question_type, @options, @correct_options, @numeric_answer, @tolerance, @accepted_answers, @question_order);
+
+-- name: CreateBankQuestion :exec
+INSERT INTO questions (
+    bank_id,
+    question_text,
+    question_type,
+    options,
+    correct_options,
+    numeric_answer,
+    tolerance,
+    accepted_answers,
+    question_order,
+    topic,
+    difficulty
+) VALUES (@bank_id::BIGINT, @question_text, @question_type, @options, @correct_options, @numeric_answer, @tolerance, @accepted_answers, @question_order, @topic, @difficulty);
 
 -- name: GetQuestionsByTestId :many
 SELECT * FROM questions
-WHERE test_id = $1
+WHERE test_id = @test_id::BIGINT
+ORDER BY question_order ASC;
+
+-- name: GetQuestionsByBankId :many
+SELECT * FROM questions
+WHERE bank_id = @bank_id::BIGINT
 ORDER BY question_order ASC;
 
+-- name: GetQuestionsByIds :many
+SELECT * FROM questions
+WHERE id = ANY(@ids::BIGINT[])
+ORDER BY id;
+
 -- name: GetQuestionById :one
 SELECT * FROM questions WHERE id = $1;
 
+-- name: CountBankQuestions :one
+-- Counts the questions of a bank a draw rule can draw, where a NULL topic or
+-- difficulty matches any
+SELECT COUNT(*) FROM questions
+WHERE bank_id = @bank_id::BIGINT
+  AND (sqlc.narg(topic)::text IS NULL OR topic = sqlc.narg(topic))
+  AND (sqlc.narg(difficulty)::text IS NULL OR difficulty = sqlc.narg(difficulty));
+
+-- name: DrawQuestions :many
+-- Draws questions of a bank at random, leaving out those already drawn
+SELECT * FROM questions
+WHERE bank_id = @bank_id::BIGINT
+  AND (sqlc.narg(topic)::text IS NULL OR topic = sqlc.narg(topic))
+  AND (sqlc.narg(difficulty)::text IS NULL OR difficulty = sqlc.narg(difficulty))
+  AND NOT (id = ANY(@excluded::BIGINT[]))
+ORDER BY random()
+LIMIT @question_count;
+
 -- name: UpdateQuestion :exec
 UPDATE questions
 SET
     question_text = $2,
-    option_a = $3,
-    option_b = $4,
-    option_c = $5,
-    option_d = $6,
-    correct_answer = $7
+    question_type = $3,
+    options = $4,
+    correct_options = $5,
+    numeric_answer = $6,
+    tolerance = $7,
+    accepted_answers = $8
+WHERE id = $1;
+
+-- name: UpdateBankQuestion :exec
+UPDATE questions
+SET
+    question_text = $2,
+    question_type = $3,
+    options = $4,
+    correct_options = $5,
+    numeric_answer = $6,
+    tolerance = $7,
+    accepted_answers = $8,
+    topic = $9,
+    difficulty = $10
 WHERE id = $1;
 
 -- name: DeleteQuestion :exec
@@ -1,8 +1,10 @@
 -- Submissions queries - simplified
 
 -- name: CreateSubmission :one
-INSERT INTO test_submissions (test_id, user_id, score)
-VALUES ($1, $2, $3)
+INSERT INTO test_submissions (test_id, user_id, attempt_number, score)
+VALUES ($1, $2, (
+    SELECT COALESCE(MAX(attempt_number), 0) + 1 FROM test_submissions WHERE test_id = $1 AND user_id = $2
+), $3)
 RETURNING *;
 
 -- name: GetSubmissionById :one
@@ -11,14 +13,90 @@ SELECT * FROM test_submissions WHERE id = $1;
 -- name: GetUserSubmissions :many
 SELECT 
     ts.*,
-    t.title as test_title
+    t.title as test_title,
+    t.score_policy
 FROM test_submissions ts
 JOIN tests t ON ts.test_id = t.id
-WHERE ts.user_id = $1
-ORDER BY ts.submitted_at DESC;
-
--- name: CheckUserCompletedTest :one
-SELECT EXISTS(
-    SELECT 1 FROM test_submissions 
-    WHERE user_id = $1 AND test_id = $2
-);
+WHERE ts.user_id = $1 AND ts.status <> 'in_progress'
+ORDER BY ts.submitted_at DESC, ts.attempt_number DESC;
+
+-- name: GetUserTestSubmissions :many
+SELECT * FROM test_submissions
+WHERE user_id = $1 AND test_id = $2 AND status <> 'in_progress'
+ORDER BY attempt_number;
+
+-- name: GetAttemptSummary :one
+-- Counts the finished attempts of a user at a test
+SELECT COUNT(*) AS attempts, MAX(submitted_at)::timestamptz AS last_submitted_at
+FROM test_submissions
+WHERE user_id = $1 AND test_id = $2 AND status <> 'in_progress';
+
+-- name: StartSubmission :one
+-- Starts an attempt together with its questions in the order they are shown,
+-- in a single statement so an attempt is never left without them. Option
+-- orders are comma separated canonical indexes, empty when not shuffled. The
+-- deadline is NULL when the test is untimed.
+WITH started AS (
+    INSERT INTO test_submissions (
+        test_id, user_id, attempt_number, status, submitted_at, deadline,
+        shuffle_questions, shuffle_options, shuffle_seed
+    ) VALUES (@test_id, @user_id, (
+        SELECT COALESCE(MAX(attempt_number), 0) + 1 FROM test_submissions WHERE test_id = @test_id AND user_id = @user_id
+    ), 'in_progress', NULL, NOW() + make_interval(mins => sqlc.narg(duration_minutes)::int), @shuffle_questions, @shuffle_options, @shuffle_seed)
+    RETURNING *
+), shown AS (
+    INSERT INTO attempt_questions (submission_id, question_id, position, option_order)
+    SELECT started.id, q.id, q.position, string_to_array(q.option_order, ',')::INTEGER[]
+    FROM started, unnest(@question_ids::BIGINT[], @option_orders::TEXT[]) WITH ORDINALITY AS q(id, option_order, position)
+)
+SELECT * FROM started;
+
+-- name: GetInProgressSubmission :one
+SELECT * FROM test_submissions
+WHERE user_id = $1 AND test_id = $2 AND status = 'in_progress';
+
+-- name: FinishSubmission :one
+UPDATE test_submissions
+SET status = 'submitted', score = $2, submitted_at = NOW()
+WHERE id = $1 AND status = 'in_progress'
+RETURNING *;
+
+-- name: ExpireSubmission :exec
+UPDATE test_submissions
+SET status = 'expired', score = 0, submitted_at = NOW()
+WHERE id = $1 AND status = 'in_progress';
+
+-- name: GetAttemptQuestions :many
+-- The questions of an attempt in the order they are shown
+SELECT sqlc.embed(q), a.position, a.option_order FROM attempt_questions a
+JOIN questions q ON a.question_id = q.id
+WHERE a.submission_id = $1
+ORDER BY a.position;
+
+-- name: GetFirstSubmissions :many
+-- The first submitted attempt of every student at a test
+SELECT DISTINCT ON (user_id) * FROM test_submissions
+WHERE test_id = $1 AND status = 'submitted'
+ORDER BY user_id, attempt_number;
+
+-- name: GetAttemptQuestionsByTest :many
+SELECT a.* FROM attempt_questions a
+JOIN test_submissions s ON a.submission_id = s.id
+WHERE s.test_id = $1 AND s.status = 'submitted';
+
+-- name: GetTestSubmissions :many
+-- Finished attempts of every student at a test, for its teacher
+SELECT * FROM test_submissions
+WHERE test_id = $1 AND status <> 'in_progress'
+ORDER BY submitted_at DESC, attempt_number DESC;
+
+-- name: GetStudentSubmissionsByTeacher :many
+-- Finished attempts of a student at the tests of a teacher
+SELECT 
+    ts.*,
+    t.title as test_title,
+    t.score_policy
+FROM test_submissions ts
+JOIN tests t ON ts.test_id = t.id
+WHERE ts.user_id = $1 AND t.created_by = $2 AND ts.status <> 'in_progress'
+ORDER BY ts.submitted_at DESC, ts.attempt_number DESC;
@@ -1,7 +1,17 @@
 -- Tests queries - simplified
 
 -- name: CreateTest :exec
-INSERT INTO tests (title, created_by) VALUES ($1, $2);
+INSERT INTO tests (
+    title,
+    created_by,
+    duration_minutes,
+    max_attempts,
+    cooldown_minutes,
+    score_policy,
+    reveal_answers,
+    shuffle_questions,
+    shuffle_options
+) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9);
 
 -- name: GetTestById :one
 SELECT * FROM tests WHERE id = $1;
@@ -11,14 +21,20 @@ SELECT * FROM tests WHERE created_by = $1 ORDER BY created_at DESC;
 
 -- name: GetAvailableTests :many
 SELECT t.*, 
-       (SELECT COUNT(*) FROM questions WHERE test_id = t.id) as question_count
+       ((SELECT COUNT(*) FROM questions WHERE test_id = t.id)
+        + (SELECT COALESCE(SUM(question_count), 0) FROM draw_rules WHERE test_id = t.id))::BIGINT as question_count
 FROM tests t 
 WHERE t.created_by != $1 
-  AND NOT EXISTS (SELECT 1 FROM test_submissions WHERE test_id = t.id AND user_id = $1)
+  AND (t.max_attempts = 0 OR t.max_attempts > (
+      SELECT COUNT(*) FROM test_submissions WHERE test_id = t.id AND user_id = $1 AND status <> 'in_progress'
+  ))
 ORDER BY t.created_at DESC;
 
 -- name: UpdateTest :exec
-UPDATE tests SET title = $2 WHERE id = $1;
+UPDATE tests
+SET title = $2, duration_minutes = $3, max_attempts = $4, cooldown_minutes = $5, score_policy = $6, reveal_answers = $7,
+    shuffle_questions = $8, shuffle_options = $9
+WHERE id = $1;
 
 -- name: DeleteTest :exec
 DELETE FROM tests WHERE id = $1;
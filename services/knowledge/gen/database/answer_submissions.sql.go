@@ -7,6 +7,8 @@ package knowledgedb
 
 import (
 	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
 )
 
 const createAnswerSubmission = `-- name: CreateAnswerSubmission :exec
@@ -14,16 +16,26 @@ const createAnswerSubmission = `-- name: CreateAnswerSubmission :exec
 INSERT INTO answer_submissions (
     submission_id,
     question_id,
-    selected_answer,
-    is_correct
-) VALUES ($1, $2, $3, $4)
+    selected_options,
+    numeric_answer,
+    text_answer,
+    credit,
+    is_correct,
+    position,
+    option_order
+) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
 `
 
 type CreateAnswerSubmissionParams struct {
-	SubmissionID   int64
-	QuestionID     int64
-	SelectedAnswer int32
-	IsCorrect      bool
+	SubmissionID    int64
+	QuestionID      int64
+	SelectedOptions []int32
+	NumericAnswer   pgtype.Float8
+	TextAnswer      pgtype.Text
+	Credit          float64
+	IsCorrect       bool
+	Position        int32
+	OptionOrder     []int32
 }
 
 // Answer submissions queries - simplified
@@ -31,39 +43,91 @@ func (q *Queries) CreateAnswerSubmission(ctx context.Context, arg CreateAnswerSu
 	_, err := q.db.Exec(ctx, createAnswerSubmission,
 		arg.SubmissionID,
 		arg.QuestionID,
-		arg.SelectedAnswer,
+		arg.SelectedOptions,
+		arg.NumericAnswer,
+		arg.TextAnswer,
+		arg.Credit,
 		arg.IsCorrect,
+		arg.Position,
+		arg.OptionOrder,
 	)
 	return err
 }
 
+const getAnswersByTest = `-- name: GetAnswersByTest :many
+SELECT a.id, a.submission_id, a.question_id, a.selected_options, a.numeric_answer, a.text_answer, a.credit, a.is_correct, a.position, a.option_order FROM answer_submissions a
+JOIN test_submissions s ON a.submission_id = s.id
+WHERE s.test_id = $1 AND s.status = 'submitted'
+`
+
+// Answers of every submitted attempt at a test
+func (q *Queries) GetAnswersByTest(ctx context.Context, testID int64) ([]AnswerSubmission, error) {
+	rows, err := q.db.Query(ctx, getAnswersByTest, testID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []AnswerSubmission
+	for rows.Next() {
+		var i AnswerSubmission
+		if err := rows.Scan(
+			&i.ID,
+			&i.SubmissionID,
+			&i.QuestionID,
+			&i.SelectedOptions,
+			&i.NumericAnswer,
+			&i.TextAnswer,
+			&i.Credit,
+			&i.IsCorrect,
+			&i.Position,
+			&i.OptionOrder,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getAnswersBySubmission = `-- name: GetAnswersBySubmission :many
 SELECT 
-    a.id, a.submission_id, a.question_id, a.selected_answer, a.is_correct,
+    a.id, a.submission_id, a.question_id, a.selected_options, a.numeric_answer, a.text_answer, a.credit, a.is_correct, a.position, a.option_order,
     q.question_text,
-    q.option_a,
-    q.option_b,
-    q.option_c,
-    q.option_d,
-    q.correct_answer
+    q.question_type,
+    q.options,
+    q.correct_options,
+    q.numeric_answer AS correct_numeric_answer,
+    q.tolerance,
+    q.accepted_answers,
+    q.question_order
 FROM answer_submissions a
 JOIN questions q ON a.question_id = q.id
 WHERE a.submission_id = $1
-ORDER BY q.question_order ASC
+ORDER BY a.position ASC
 `
 
 type GetAnswersBySubmissionRow struct {
-	ID             int64
-	SubmissionID   int64
-	QuestionID     int64
-	SelectedAnswer int32
-	IsCorrect      bool
-	QuestionText   string
-	OptionA        string
-	OptionB        string
-	OptionC        string
-	OptionD        string
-	CorrectAnswer  int32
+	ID                   int64
+	SubmissionID         int64
+	QuestionID           int64
+	SelectedOptions      []int32
+	NumericAnswer        pgtype.Float8
+	TextAnswer           pgtype.Text
+	Credit               float64
+	IsCorrect            bool
+	Position             int32
+	OptionOrder          []int32
+	QuestionText         string
+	QuestionType         string
+	Options              []string
+	CorrectOptions       []int32
+	CorrectNumericAnswer pgtype.Float8
+	Tolerance            float64
+	AcceptedAnswers      []string
+	QuestionOrder        int32
 }
 
 func (q *Queries) GetAnswersBySubmission(ctx context.Context, submissionID int64) ([]GetAnswersBySubmissionRow, error) {
@@ -79,14 +143,21 @@ func (q *Queries) GetAnswersBySubmission(ctx context.Context, submissionID int64
 			&i.ID,
 			&i.SubmissionID,
 			&i.QuestionID,
-			&i.SelectedAnswer,
+			&i.SelectedOptions,
+			&i.NumericAnswer,
+			&i.TextAnswer,
+			&i.Credit,
 			&i.IsCorrect,
+			&i.Position,
+			&i.OptionOrder,
 			&i.QuestionText,
-			&i.OptionA,
-			&i.OptionB,
-			&i.OptionC,
-			&i.OptionD,
-			&i.CorrectAnswer,
+			&i.QuestionType,
+			&i.Options,
+			&i.CorrectOptions,
+			&i.CorrectNumericAnswer,
+			&i.Tolerance,
+			&i.AcceptedAnswers,
+			&i.QuestionOrder,
 		); err != nil {
 			return nil, err
 		}
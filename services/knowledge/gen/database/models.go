@@ -9,36 +9,82 @@ import (
 )
 
 type AnswerSubmission struct {
-	ID             int64
-	SubmissionID   int64
-	QuestionID     int64
-	SelectedAnswer int32
-	IsCorrect      bool
+	ID              int64
+	SubmissionID    int64
+	QuestionID      int64
+	SelectedOptions []int32
+	NumericAnswer   pgtype.Float8
+	TextAnswer      pgtype.Text
+	Credit          float64
+	IsCorrect       bool
+	Position        int32
+	OptionOrder     []int32
 }
 
-type Question struct {
+type AttemptQuestion struct {
+	SubmissionID int64
+	QuestionID   int64
+	Position     int32
+	OptionOrder  []int32
+}
+
+type DrawRule struct {
 	ID            int64
 	TestID        int64
-	QuestionText  string
-	OptionA       string
-	OptionB       string
-	OptionC       string
-	OptionD       string
-	CorrectAnswer int32
-	QuestionOrder int32
+	BankID        int64
+	Topic         pgtype.Text
+	Difficulty    pgtype.Text
+	QuestionCount int32
 }
 
-type Test struct {
+type Question struct {
+	ID              int64
+	TestID          pgtype.Int8
+	BankID          pgtype.Int8
+	QuestionText    string
+	QuestionType    string
+	Options         []string
+	CorrectOptions  []int32
+	NumericAnswer   pgtype.Float8
+	Tolerance       float64
+	AcceptedAnswers []string
+	QuestionOrder   int32
+	Topic           string
+	Difficulty      string
+}
+
+type QuestionBank struct {
 	ID        int64
 	Title     string
 	CreatedBy int64
 	CreatedAt pgtype.Timestamptz
 }
 
+type Test struct {
+	ID               int64
+	Title            string
+	CreatedBy        int64
+	DurationMinutes  pgtype.Int4
+	MaxAttempts      int32
+	CooldownMinutes  int32
+	ScorePolicy      string
+	RevealAnswers    bool
+	ShuffleQuestions bool
+	ShuffleOptions   bool
+	CreatedAt        pgtype.Timestamptz
+}
+
 type TestSubmission struct {
-	ID          int64
-	TestID      int64
-	UserID      int64
-	Score       pgtype.Numeric
-	SubmittedAt pgtype.Timestamptz
+	ID               int64
+	TestID           int64
+	UserID           int64
+	AttemptNumber    int32
+	Score            pgtype.Numeric
+	SubmittedAt      pgtype.Timestamptz
+	Status           string
+	StartedAt        pgtype.Timestamptz
+	Deadline         pgtype.Timestamptz
+	ShuffleQuestions bool
+	ShuffleOptions   bool
+	ShuffleSeed      int64
 }
@@ -7,31 +7,104 @@ package knowledgedb
 
 import (
 	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
 )
 
+const countBankQuestions = `-- name: CountBankQuestions :one
+SELECT COUNT(*) FROM questions
+WHERE bank_id = $1::BIGINT
+  AND ($2::text IS NULL OR topic = $2)
+  AND ($3::text IS NULL OR difficulty = $3)
+`
+
+type CountBankQuestionsParams struct {
+	BankID     int64
+	Topic      pgtype.Text
+	Difficulty pgtype.Text
+}
+
+// Counts the questions of a bank a draw rule can draw, where a NULL topic or
+// difficulty matches any
+func (q *Queries) CountBankQuestions(ctx context.Context, arg CountBankQuestionsParams) (int64, error) {
+	row := q.db.QueryRow(ctx, countBankQuestions, arg.BankID, arg.Topic, arg.Difficulty)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const createBankQuestion = `-- name: CreateBankQuestion :exec
+INSERT INTO questions (
+    bank_id,
+    question_text,
+    question_type,
+    options,
+    correct_options,
+    numeric_answer,
+    tolerance,
+    accepted_answers,
+    question_order,
+    topic,
+    difficulty
+) VALUES ($1::BIGINT, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+`
+
+type CreateBankQuestionParams struct {
+	BankID          int64
+	QuestionText    string
+	QuestionType    string
+	Options         []string
+	CorrectOptions  []int32
+	NumericAnswer   pgtype.Float8
+	Tolerance       float64
+	AcceptedAnswers []string
+	QuestionOrder   int32
+	Topic           string
+	Difficulty      string
+}
+
+func (q *Queries) CreateBankQuestion(ctx context.Context, arg CreateBankQuestionParams) error {
+	_, err := q.db.Exec(ctx, createBankQuestion,
+		arg.BankID,
+		arg.QuestionText,
+		arg.QuestionType,
+		arg.Options,
+		arg.CorrectOptions,
+		arg.NumericAnswer,
+		arg.Tolerance,
+		arg.AcceptedAnswers,
+		arg.QuestionOrder,
+		arg.Topic,
+		arg.Difficulty,
+	)
+	return err
+}
+
 const createQuestion = `-- name: CreateQuestion :exec
 
 INSERT INTO questions (
     test_id,
     question_text,
-    option_a,
-    option_b,
-    option_c,
-    option_d,
-    correct_answer,
+    question_type,
+    options,
+    correct_options,
+    numeric_answer,
+    tolerance,
+    accepted_answers,
     question_order
-) VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+) VALUES ($1::BIGINT, $2, $3, $4, $5, $6, $7, $8, $9)
 `
 
 type CreateQuestionParams struct {
-	TestID        int64
-	QuestionText  string
-	OptionA       string
-	OptionB       string
-	OptionC       string
-	OptionD       string
-	CorrectAnswer int32
-	QuestionOrder int32
+	TestID          int64
+	QuestionText    string
+	QuestionType    string
+	Options         []string
+	CorrectOptions  []int32
+	NumericAnswer   pgtype.Float8
+	Tolerance       float64
+	AcceptedAnswers []string
+	QuestionOrder   int32
 }
 
 // Questions queries - simplified
@@ -39,11 +112,12 @@ func (q *Queries) CreateQuestion(ctx context.Context, arg CreateQuestionParams)
 	_, err := q.db.Exec(ctx, createQuestion,
 		arg.TestID,
 		arg.QuestionText,
-		arg.OptionA,
-		arg.OptionB,
-		arg.OptionC,
-		arg.OptionD,
-		arg.CorrectAnswer,
+		arg.QuestionType,
+		arg.Options,
+		arg.CorrectOptions,
+		arg.NumericAnswer,
+		arg.Tolerance,
+		arg.AcceptedAnswers,
 		arg.QuestionOrder,
 	)
 	return err
@@ -58,8 +132,67 @@ func (q *Queries) DeleteQuestion(ctx context.Context, id int64) error {
 	return err
 }
 
+const drawQuestions = `-- name: DrawQuestions :many
+SELECT id, test_id, bank_id, question_text, question_type, options, correct_options, numeric_answer, tolerance, accepted_answers, question_order, topic, difficulty FROM questions
+WHERE bank_id = $1::BIGINT
+  AND ($2::text IS NULL OR topic = $2)
+  AND ($3::text IS NULL OR difficulty = $3)
+  AND NOT (id = ANY($4::BIGINT[]))
+ORDER BY random()
+LIMIT $5
+`
+
+type DrawQuestionsParams struct {
+	BankID        int64
+	Topic         pgtype.Text
+	Difficulty    pgtype.Text
+	Excluded      []int64
+	QuestionCount int32
+}
+
+// Draws questions of a bank at random, leaving out those already drawn
+func (q *Queries) DrawQuestions(ctx context.Context, arg DrawQuestionsParams) ([]Question, error) {
+	rows, err := q.db.Query(ctx, drawQuestions,
+		arg.BankID,
+		arg.Topic,
+		arg.Difficulty,
+		arg.Excluded,
+		arg.QuestionCount,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Question
+	for rows.Next() {
+		var i Question
+		if err := rows.Scan(
+			&i.ID,
+			&i.TestID,
+			&i.BankID,
+			&i.QuestionText,
+			&i.QuestionType,
+			&i.Options,
+			&i.CorrectOptions,
+			&i.NumericAnswer,
+			&i.Tolerance,
+			&i.AcceptedAnswers,
+			&i.QuestionOrder,
+			&i.Topic,
+			&i.Difficulty,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getQuestionById = `-- name: GetQuestionById :one
-SELECT id, test_id, question_text, option_a, option_b, option_c, option_d, correct_answer, question_order FROM questions WHERE id = $1
+SELECT id, test_id, bank_id, question_text, question_type, options, correct_options, numeric_answer, tolerance, accepted_answers, question_order, topic, difficulty FROM questions WHERE id = $1
 `
 
 func (q *Queries) GetQuestionById(ctx context.Context, id int64) (Question, error) {
@@ -68,20 +201,104 @@ func (q *Queries) GetQuestionById(ctx context.Context, id int64) (Question, erro
 	err := row.Scan(
 		&i.ID,
 		&i.TestID,
+		&i.BankID,
 		&i.QuestionText,
-		&i.OptionA,
-		&i.OptionB,
-		&i.OptionC,
-		&i.OptionD,
-		&i.CorrectAnswer,
+		&i.QuestionType,
+		&i.Options,
+		&i.CorrectOptions,
+		&i.NumericAnswer,
+		&i.Tolerance,
+		&i.AcceptedAnswers,
 		&i.QuestionOrder,
+		&i.Topic,
+		&i.Difficulty,
 	)
 	return i, err
 }
 
+const getQuestionsByIds = `-- name: GetQuestionsByIds :many
+SELECT id, test_id, bank_id, question_text, question_type, options, correct_options, numeric_answer, tolerance, accepted_answers, question_order, topic, difficulty FROM questions
+WHERE id = ANY($1::BIGINT[])
+ORDER BY id
+`
+
+func (q *Queries) GetQuestionsByIds(ctx context.Context, ids []int64) ([]Question, error) {
+	rows, err := q.db.Query(ctx, getQuestionsByIds, ids)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Question
+	for rows.Next() {
+		var i Question
+		if err := rows.Scan(
+			&i.ID,
+			&i.TestID,
+			&i.BankID,
+			&i.QuestionText,
+			&i.QuestionType,
+			&i.Options,
+			&i.CorrectOptions,
+			&i.NumericAnswer,
+			&i.Tolerance,
+			&i.AcceptedAnswers,
+			&i.QuestionOrder,
+			&i.Topic,
+			&i.Difficulty,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getQuestionsByBankId = `-- name: GetQuestionsByBankId :many
+SELECT id, test_id, bank_id, question_text, question_type, options, correct_options, numeric_answer, tolerance, accepted_answers, question_order, topic, difficulty FROM questions
+WHERE bank_id = $1::BIGINT
+ORDER BY question_order ASC
+`
+
+func (q *Queries) GetQuestionsByBankId(ctx context.Context, bankID int64) ([]Question, error) {
+	rows, err := q.db.Query(ctx, getQuestionsByBankId, bankID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Question
+	for rows.Next() {
+		var i Question
+		if err := rows.Scan(
+			&i.ID,
+			&i.TestID,
+			&i.BankID,
+			&i.QuestionText,
+			&i.QuestionType,
+			&i.Options,
+			&i.CorrectOptions,
+			&i.NumericAnswer,
+			&i.Tolerance,
+			&i.AcceptedAnswers,
+			&i.QuestionOrder,
+			&i.Topic,
+			&i.Difficulty,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getQuestionsByTestId = `-- name: GetQuestionsByTestId :many
-SELECT id, test_id, question_text, option_a, option_b, option_c, option_d, correct_answer, question_order FROM questions
-WHERE test_id = $1
+SELECT id, test_id, bank_id, question_text, question_type, options, correct_options, numeric_answer, tolerance, accepted_answers, question_order, topic, difficulty FROM questions
+WHERE test_id = $1::BIGINT
 ORDER BY question_order ASC
 `
 
@@ -97,13 +314,17 @@ func (q *Queries) GetQuestionsByTestId(ctx context.Context, testID int64) ([]Que
 		if err := rows.Scan(
 			&i.ID,
 			&i.TestID,
+			&i.BankID,
 			&i.QuestionText,
-			&i.OptionA,
-			&i.OptionB,
-			&i.OptionC,
-			&i.OptionD,
-			&i.CorrectAnswer,
+			&i.QuestionType,
+			&i.Options,
+			&i.CorrectOptions,
+			&i.NumericAnswer,
+			&i.Tolerance,
+			&i.AcceptedAnswers,
 			&i.QuestionOrder,
+			&i.Topic,
+			&i.Difficulty,
 		); err != nil {
 			return nil, err
 		}
@@ -115,37 +336,84 @@ func (q *Queries) GetQuestionsByTestId(ctx context.Context, testID int64) ([]Que
 	return items, nil
 }
 
+const updateBankQuestion = `-- name: UpdateBankQuestion :exec
+UPDATE questions
+SET
+    question_text = $2,
+    question_type = $3,
+    options = $4,
+    correct_options = $5,
+    numeric_answer = $6,
+    tolerance = $7,
+    accepted_answers = $8,
+    topic = $9,
+    difficulty = $10
+WHERE id = $1
+`
+
+type UpdateBankQuestionParams struct {
+	ID              int64
+	QuestionText    string
+	QuestionType    string
+	Options         []string
+	CorrectOptions  []int32
+	NumericAnswer   pgtype.Float8
+	Tolerance       float64
+	AcceptedAnswers []string
+	Topic           string
+	Difficulty      string
+}
+
+func (q *Queries) UpdateBankQuestion(ctx context.Context, arg UpdateBankQuestionParams) error {
+	_, err := q.db.Exec(ctx, updateBankQuestion,
+		arg.ID,
+		arg.QuestionText,
+		arg.QuestionType,
+		arg.Options,
+		arg.CorrectOptions,
+		arg.NumericAnswer,
+		arg.Tolerance,
+		arg.AcceptedAnswers,
+		arg.Topic,
+		arg.Difficulty,
+	)
+	return err
+}
+
 const updateQuestion = `-- name: UpdateQuestion :exec
 UPDATE questions
 SET
     question_text = $2,
-    option_a = $3,
-    option_b = $4,
-    option_c = $5,
-    option_d = $6,
-    correct_answer = $7
+    question_type = $3,
+    options = $4,
+    correct_options = $5,
+    numeric_answer = $6,
+    tolerance = $7,
+    accepted_answers = $8
 WHERE id = $1
 `
 
 type UpdateQuestionParams struct {
-	ID            int64
-	QuestionText  string
-	OptionA       string
-	OptionB       string
-	OptionC       string
-	OptionD       string
-	CorrectAnswer int32
+	ID              int64
+	QuestionText    string
+	QuestionType    string
+	Options         []string
+	CorrectOptions  []int32
+	NumericAnswer   pgtype.Float8
+	Tolerance       float64
+	AcceptedAnswers []string
 }
 
 func (q *Queries) UpdateQuestion(ctx context.Context, arg UpdateQuestionParams) error {
 	_, err := q.db.Exec(ctx, updateQuestion,
 		arg.ID,
 		arg.QuestionText,
-		arg.OptionA,
-		arg.OptionB,
-		arg.OptionC,
-		arg.OptionD,
-		arg.CorrectAnswer,
+		arg.QuestionType,
+		arg.Options,
+		arg.CorrectOptions,
+		arg.NumericAnswer,
+		arg.Tolerance,
+		arg.AcceptedAnswers,
 	)
 	return err
 }
@@ -11,54 +11,331 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
-const checkUserCompletedTest = `-- name: CheckUserCompletedTest :one
-SELECT EXISTS(
-    SELECT 1 FROM test_submissions 
-    WHERE user_id = $1 AND test_id = $2
-)
+const createSubmission = `-- name: CreateSubmission :one
+
+INSERT INTO test_submissions (test_id, user_id, attempt_number, score)
+VALUES ($1, $2, (
+    SELECT COALESCE(MAX(attempt_number), 0) + 1 FROM test_submissions WHERE test_id = $1 AND user_id = $2
+), $3)
+RETURNING id, test_id, user_id, attempt_number, score, submitted_at, status, started_at, deadline, shuffle_questions, shuffle_options, shuffle_seed
 `
 
-type CheckUserCompletedTestParams struct {
-	UserID int64
+type CreateSubmissionParams struct {
 	TestID int64
+	UserID int64
+	Score  pgtype.Numeric
 }
 
-func (q *Queries) CheckUserCompletedTest(ctx context.Context, arg CheckUserCompletedTestParams) (bool, error) {
-	row := q.db.QueryRow(ctx, checkUserCompletedTest, arg.UserID, arg.TestID)
-	var exists bool
-	err := row.Scan(&exists)
-	return exists, err
+// Submissions queries - simplified
+func (q *Queries) CreateSubmission(ctx context.Context, arg CreateSubmissionParams) (TestSubmission, error) {
+	row := q.db.QueryRow(ctx, createSubmission, arg.TestID, arg.UserID, arg.Score)
+	var i TestSubmission
+	err := row.Scan(
+		&i.ID,
+		&i.TestID,
+		&i.UserID,
+		&i.AttemptNumber,
+		&i.Score,
+		&i.SubmittedAt,
+		&i.Status,
+		&i.StartedAt,
+		&i.Deadline,
+		&i.ShuffleQuestions,
+		&i.ShuffleOptions,
+		&i.ShuffleSeed,
+	)
+	return i, err
 }
 
-const createSubmission = `-- name: CreateSubmission :one
+const expireSubmission = `-- name: ExpireSubmission :exec
+UPDATE test_submissions
+SET status = 'expired', score = 0, submitted_at = NOW()
+WHERE id = $1 AND status = 'in_progress'
+`
 
-INSERT INTO test_submissions (test_id, user_id, score)
-VALUES ($1, $2, $3)
-RETURNING id, test_id, user_id, score, submitted_at
+func (q *Queries) ExpireSubmission(ctx context.Context, id int64) error {
+	_, err := q.db.Exec(ctx, expireSubmission, id)
+	return err
+}
+
+const finishSubmission = `-- name: FinishSubmission :one
+UPDATE test_submissions
+SET status = 'submitted', score = $2, submitted_at = NOW()
+WHERE id = $1 AND status = 'in_progress'
+RETURNING id, test_id, user_id, attempt_number, score, submitted_at, status, started_at, deadline, shuffle_questions, shuffle_options, shuffle_seed
 `
 
-type CreateSubmissionParams struct {
+type FinishSubmissionParams struct {
+	ID    int64
+	Score pgtype.Numeric
+}
+
+func (q *Queries) FinishSubmission(ctx context.Context, arg FinishSubmissionParams) (TestSubmission, error) {
+	row := q.db.QueryRow(ctx, finishSubmission, arg.ID, arg.Score)
+	var i TestSubmission
+	err := row.Scan(
+		&i.ID,
+		&i.TestID,
+		&i.UserID,
+		&i.AttemptNumber,
+		&i.Score,
+		&i.SubmittedAt,
+		&i.Status,
+		&i.StartedAt,
+		&i.Deadline,
+		&i.ShuffleQuestions,
+		&i.ShuffleOptions,
+		&i.ShuffleSeed,
+	)
+	return i, err
+}
+
+const getAttemptQuestions = `-- name: GetAttemptQuestions :many
+SELECT q.id, q.test_id, q.bank_id, q.question_text, q.question_type, q.options, q.correct_options, q.numeric_answer, q.tolerance, q.accepted_answers, q.question_order, q.topic, q.difficulty, a.position, a.option_order FROM attempt_questions a
+JOIN questions q ON a.question_id = q.id
+WHERE a.submission_id = $1
+ORDER BY a.position
+`
+
+type GetAttemptQuestionsRow struct {
+	Question    Question
+	Position    int32
+	OptionOrder []int32
+}
+
+// The questions of an attempt in the order they are shown
+func (q *Queries) GetAttemptQuestions(ctx context.Context, submissionID int64) ([]GetAttemptQuestionsRow, error) {
+	rows, err := q.db.Query(ctx, getAttemptQuestions, submissionID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetAttemptQuestionsRow
+	for rows.Next() {
+		var i GetAttemptQuestionsRow
+		if err := rows.Scan(
+			&i.Question.ID,
+			&i.Question.TestID,
+			&i.Question.BankID,
+			&i.Question.QuestionText,
+			&i.Question.QuestionType,
+			&i.Question.Options,
+			&i.Question.CorrectOptions,
+			&i.Question.NumericAnswer,
+			&i.Question.Tolerance,
+			&i.Question.AcceptedAnswers,
+			&i.Question.QuestionOrder,
+			&i.Question.Topic,
+			&i.Question.Difficulty,
+			&i.Position,
+			&i.OptionOrder,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getAttemptQuestionsByTest = `-- name: GetAttemptQuestionsByTest :many
+SELECT a.submission_id, a.question_id, a.position, a.option_order FROM attempt_questions a
+JOIN test_submissions s ON a.submission_id = s.id
+WHERE s.test_id = $1 AND s.status = 'submitted'
+`
+
+func (q *Queries) GetAttemptQuestionsByTest(ctx context.Context, testID int64) ([]AttemptQuestion, error) {
+	rows, err := q.db.Query(ctx, getAttemptQuestionsByTest, testID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []AttemptQuestion
+	for rows.Next() {
+		var i AttemptQuestion
+		if err := rows.Scan(
+			&i.SubmissionID,
+			&i.QuestionID,
+			&i.Position,
+			&i.OptionOrder,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getAttemptSummary = `-- name: GetAttemptSummary :one
+SELECT COUNT(*) AS attempts, MAX(submitted_at)::timestamptz AS last_submitted_at
+FROM test_submissions
+WHERE user_id = $1 AND test_id = $2 AND status <> 'in_progress'
+`
+
+type GetAttemptSummaryParams struct {
+	UserID int64
 	TestID int64
+}
+
+type GetAttemptSummaryRow struct {
+	Attempts        int64
+	LastSubmittedAt pgtype.Timestamptz
+}
+
+// Counts the finished attempts of a user at a test
+func (q *Queries) GetAttemptSummary(ctx context.Context, arg GetAttemptSummaryParams) (GetAttemptSummaryRow, error) {
+	row := q.db.QueryRow(ctx, getAttemptSummary, arg.UserID, arg.TestID)
+	var i GetAttemptSummaryRow
+	err := row.Scan(&i.Attempts, &i.LastSubmittedAt)
+	return i, err
+}
+
+const getFirstSubmissions = `-- name: GetFirstSubmissions :many
+SELECT DISTINCT ON (user_id) id, test_id, user_id, attempt_number, score, submitted_at, status, started_at, deadline, shuffle_questions, shuffle_options, shuffle_seed FROM test_submissions
+WHERE test_id = $1 AND status = 'submitted'
+ORDER BY user_id, attempt_number
+`
+
+// The first submitted attempt of every student at a test
+func (q *Queries) GetFirstSubmissions(ctx context.Context, testID int64) ([]TestSubmission, error) {
+	rows, err := q.db.Query(ctx, getFirstSubmissions, testID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []TestSubmission
+	for rows.Next() {
+		var i TestSubmission
+		if err := rows.Scan(
+			&i.ID,
+			&i.TestID,
+			&i.UserID,
+			&i.AttemptNumber,
+			&i.Score,
+			&i.SubmittedAt,
+			&i.Status,
+			&i.StartedAt,
+			&i.Deadline,
+			&i.ShuffleQuestions,
+			&i.ShuffleOptions,
+			&i.ShuffleSeed,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getInProgressSubmission = `-- name: GetInProgressSubmission :one
+SELECT id, test_id, user_id, attempt_number, score, submitted_at, status, started_at, deadline, shuffle_questions, shuffle_options, shuffle_seed FROM test_submissions
+WHERE user_id = $1 AND test_id = $2 AND status = 'in_progress'
+`
+
+type GetInProgressSubmissionParams struct {
 	UserID int64
-	Score  pgtype.Numeric
+	TestID int64
 }
 
-// Submissions queries - simplified
-func (q *Queries) CreateSubmission(ctx context.Context, arg CreateSubmissionParams) (TestSubmission, error) {
-	row := q.db.QueryRow(ctx, createSubmission, arg.TestID, arg.UserID, arg.Score)
+func (q *Queries) GetInProgressSubmission(ctx context.Context, arg GetInProgressSubmissionParams) (TestSubmission, error) {
+	row := q.db.QueryRow(ctx, getInProgressSubmission, arg.UserID, arg.TestID)
 	var i TestSubmission
 	err := row.Scan(
 		&i.ID,
 		&i.TestID,
 		&i.UserID,
+		&i.AttemptNumber,
 		&i.Score,
 		&i.SubmittedAt,
+		&i.Status,
+		&i.StartedAt,
+		&i.Deadline,
+		&i.ShuffleQuestions,
+		&i.ShuffleOptions,
+		&i.ShuffleSeed,
 	)
 	return i, err
 }
 
+const getStudentSubmissionsByTeacher = `-- name: GetStudentSubmissionsByTeacher :many
+SELECT 
+    ts.id, ts.test_id, ts.user_id, ts.attempt_number, ts.score, ts.submitted_at, ts.status, ts.started_at, ts.deadline, ts.shuffle_questions, ts.shuffle_options, ts.shuffle_seed,
+    t.title as test_title,
+    t.score_policy
+FROM test_submissions ts
+JOIN tests t ON ts.test_id = t.id
+WHERE ts.user_id = $1 AND t.created_by = $2 AND ts.status <> 'in_progress'
+ORDER BY ts.submitted_at DESC, ts.attempt_number DESC
+`
+
+type GetStudentSubmissionsByTeacherParams struct {
+	UserID    int64
+	CreatedBy int64
+}
+
+type GetStudentSubmissionsByTeacherRow struct {
+	ID               int64
+	TestID           int64
+	UserID           int64
+	AttemptNumber    int32
+	Score            pgtype.Numeric
+	SubmittedAt      pgtype.Timestamptz
+	Status           string
+	StartedAt        pgtype.Timestamptz
+	Deadline         pgtype.Timestamptz
+	ShuffleQuestions bool
+	ShuffleOptions   bool
+	ShuffleSeed      int64
+	TestTitle        string
+	ScorePolicy      string
+}
+
+// Finished attempts of a student at the tests of a teacher
+func (q *Queries) GetStudentSubmissionsByTeacher(ctx context.Context, arg GetStudentSubmissionsByTeacherParams) ([]GetStudentSubmissionsByTeacherRow, error) {
+	rows, err := q.db.Query(ctx, getStudentSubmissionsByTeacher, arg.UserID, arg.CreatedBy)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetStudentSubmissionsByTeacherRow
+	for rows.Next() {
+		var i GetStudentSubmissionsByTeacherRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.TestID,
+			&i.UserID,
+			&i.AttemptNumber,
+			&i.Score,
+			&i.SubmittedAt,
+			&i.Status,
+			&i.StartedAt,
+			&i.Deadline,
+			&i.ShuffleQuestions,
+			&i.ShuffleOptions,
+			&i.ShuffleSeed,
+			&i.TestTitle,
+			&i.ScorePolicy,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getSubmissionById = `-- name: GetSubmissionById :one
-SELECT id, test_id, user_id, score, submitted_at FROM test_submissions WHERE id = $1
+SELECT id, test_id, user_id, attempt_number, score, submitted_at, status, started_at, deadline, shuffle_questions, shuffle_options, shuffle_seed FROM test_submissions WHERE id = $1
 `
 
 func (q *Queries) GetSubmissionById(ctx context.Context, id int64) (TestSubmission, error) {
@@ -68,29 +345,85 @@ func (q *Queries) GetSubmissionById(ctx context.Context, id int64) (TestSubmissi
 		&i.ID,
 		&i.TestID,
 		&i.UserID,
+		&i.AttemptNumber,
 		&i.Score,
 		&i.SubmittedAt,
+		&i.Status,
+		&i.StartedAt,
+		&i.Deadline,
+		&i.ShuffleQuestions,
+		&i.ShuffleOptions,
+		&i.ShuffleSeed,
 	)
 	return i, err
 }
 
+const getTestSubmissions = `-- name: GetTestSubmissions :many
+SELECT id, test_id, user_id, attempt_number, score, submitted_at, status, started_at, deadline, shuffle_questions, shuffle_options, shuffle_seed FROM test_submissions
+WHERE test_id = $1 AND status <> 'in_progress'
+ORDER BY submitted_at DESC, attempt_number DESC
+`
+
+// Finished attempts of every student at a test, for its teacher
+func (q *Queries) GetTestSubmissions(ctx context.Context, testID int64) ([]TestSubmission, error) {
+	rows, err := q.db.Query(ctx, getTestSubmissions, testID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []TestSubmission
+	for rows.Next() {
+		var i TestSubmission
+		if err := rows.Scan(
+			&i.ID,
+			&i.TestID,
+			&i.UserID,
+			&i.AttemptNumber,
+			&i.Score,
+			&i.SubmittedAt,
+			&i.Status,
+			&i.StartedAt,
+			&i.Deadline,
+			&i.ShuffleQuestions,
+			&i.ShuffleOptions,
+			&i.ShuffleSeed,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getUserSubmissions = `-- name: GetUserSubmissions :many
 SELECT 
-    ts.id, ts.test_id, ts.user_id, ts.score, ts.submitted_at,
-    t.title as test_title
+    ts.id, ts.test_id, ts.user_id, ts.attempt_number, ts.score, ts.submitted_at, ts.status, ts.started_at, ts.deadline, ts.shuffle_questions, ts.shuffle_options, ts.shuffle_seed,
+    t.title as test_title,
+    t.score_policy
 FROM test_submissions ts
 JOIN tests t ON ts.test_id = t.id
-WHERE ts.user_id = $1
-ORDER BY ts.submitted_at DESC
+WHERE ts.user_id = $1 AND ts.status <> 'in_progress'
+ORDER BY ts.submitted_at DESC, ts.attempt_number DESC
 `
 
 type GetUserSubmissionsRow struct {
-	ID          int64
-	TestID      int64
-	UserID      int64
-	Score       pgtype.Numeric
-	SubmittedAt pgtype.Timestamptz
-	TestTitle   string
+	ID               int64
+	TestID           int64
+	UserID           int64
+	AttemptNumber    int32
+	Score            pgtype.Numeric
+	SubmittedAt      pgtype.Timestamptz
+	Status           string
+	StartedAt        pgtype.Timestamptz
+	Deadline         pgtype.Timestamptz
+	ShuffleQuestions bool
+	ShuffleOptions   bool
+	ShuffleSeed      int64
+	TestTitle        string
+	ScorePolicy      string
 }
 
 func (q *Queries) GetUserSubmissions(ctx context.Context, userID int64) ([]GetUserSubmissionsRow, error) {
@@ -106,9 +439,17 @@ func (q *Queries) GetUserSubmissions(ctx context.Context, userID int64) ([]GetUs
 			&i.ID,
 			&i.TestID,
 			&i.UserID,
+			&i.AttemptNumber,
 			&i.Score,
 			&i.SubmittedAt,
+			&i.Status,
+			&i.StartedAt,
+			&i.Deadline,
+			&i.ShuffleQuestions,
+			&i.ShuffleOptions,
+			&i.ShuffleSeed,
 			&i.TestTitle,
+			&i.ScorePolicy,
 		); err != nil {
 			return nil, err
 		}
@@ -119,3 +460,123 @@ func (q *Queries) GetUserSubmissions(ctx context.Context, userID int64) ([]GetUs
 	}
 	return items, nil
 }
+
+const getUserTestSubmissions = `-- name: GetUserTestSubmissions :many
+SELECT id, test_id, user_id, attempt_number, score, submitted_at, status, started_at, deadline, shuffle_questions, shuffle_options, shuffle_seed FROM test_submissions
+WHERE user_id = $1 AND test_id = $2 AND status <> 'in_progress'
+ORDER BY attempt_number
+`
+
+type GetUserTestSubmissionsParams struct {
+	UserID int64
+	TestID int64
+}
+
+func (q *Queries) GetUserTestSubmissions(ctx context.Context, arg GetUserTestSubmissionsParams) ([]TestSubmission, error) {
+	rows, err := q.db.Query(ctx, getUserTestSubmissions, arg.UserID, arg.TestID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []TestSubmission
+	for rows.Next() {
+		var i TestSubmission
+		if err := rows.Scan(
+			&i.ID,
+			&i.TestID,
+			&i.UserID,
+			&i.AttemptNumber,
+			&i.Score,
+			&i.SubmittedAt,
+			&i.Status,
+			&i.StartedAt,
+			&i.Deadline,
+			&i.ShuffleQuestions,
+			&i.ShuffleOptions,
+			&i.ShuffleSeed,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const startSubmission = `-- name: StartSubmission :one
+WITH started AS (
+    INSERT INTO test_submissions (
+        test_id, user_id, attempt_number, status, submitted_at, deadline,
+        shuffle_questions, shuffle_options, shuffle_seed
+    ) VALUES ($1, $2, (
+        SELECT COALESCE(MAX(attempt_number), 0) + 1 FROM test_submissions WHERE test_id = $1 AND user_id = $2
+    ), 'in_progress', NULL, NOW() + make_interval(mins => $3::int), $4, $5, $6)
+    RETURNING id, test_id, user_id, attempt_number, score, submitted_at, status, started_at, deadline, shuffle_questions, shuffle_options, shuffle_seed
+), shown AS (
+    INSERT INTO attempt_questions (submission_id, question_id, position, option_order)
+    SELECT started.id, q.id, q.position, string_to_array(q.option_order, ',')::INTEGER[]
+    FROM started, unnest($7::BIGINT[], $8::TEXT[]) WITH ORDINALITY AS q(id, option_order, position)
+)
+SELECT id, test_id, user_id, attempt_number, score, submitted_at, status, started_at, deadline, shuffle_questions, shuffle_options, shuffle_seed FROM started
+`
+
+type StartSubmissionParams struct {
+	TestID           int64
+	UserID           int64
+	DurationMinutes  pgtype.Int4
+	ShuffleQuestions bool
+	ShuffleOptions   bool
+	ShuffleSeed      int64
+	QuestionIds      []int64
+	OptionOrders     []string
+}
+
+type StartSubmissionRow struct {
+	ID               int64
+	TestID           int64
+	UserID           int64
+	AttemptNumber    int32
+	Score            pgtype.Numeric
+	SubmittedAt      pgtype.Timestamptz
+	Status           string
+	StartedAt        pgtype.Timestamptz
+	Deadline         pgtype.Timestamptz
+	ShuffleQuestions bool
+	ShuffleOptions   bool
+	ShuffleSeed      int64
+}
+
+// Starts an attempt together with its questions in the order they are shown,
+// in a single statement so an attempt is never left without them. Option
+// orders are comma separated canonical indexes, empty when not shuffled. The
+// deadline is NULL when the test is untimed.
+func (q *Queries) StartSubmission(ctx context.Context, arg StartSubmissionParams) (StartSubmissionRow, error) {
+	row := q.db.QueryRow(ctx, startSubmission,
+		arg.TestID,
+		arg.UserID,
+		arg.DurationMinutes,
+		arg.ShuffleQuestions,
+		arg.ShuffleOptions,
+		arg.ShuffleSeed,
+		arg.QuestionIds,
+		arg.OptionOrders,
+	)
+	var i StartSubmissionRow
+	err := row.Scan(
+		&i.ID,
+		&i.TestID,
+		&i.UserID,
+		&i.AttemptNumber,
+		&i.Score,
+		&i.SubmittedAt,
+		&i.Status,
+		&i.StartedAt,
+		&i.Deadline,
+		&i.ShuffleQuestions,
+		&i.ShuffleOptions,
+		&i.ShuffleSeed,
+	)
+	return i, err
+}
@@ -13,17 +13,44 @@ import (
 
 const createTest = `-- name: CreateTest :exec
 
-INSERT INTO tests (title, created_by) VALUES ($1, $2)
+INSERT INTO tests (
+    title,
+    created_by,
+    duration_minutes,
+    max_attempts,
+    cooldown_minutes,
+    score_policy,
+    reveal_answers,
+    shuffle_questions,
+    shuffle_options
+) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
 `
 
 type CreateTestParams struct {
-	Title     string
-	CreatedBy int64
+	Title            string
+	CreatedBy        int64
+	DurationMinutes  pgtype.Int4
+	MaxAttempts      int32
+	CooldownMinutes  int32
+	ScorePolicy      string
+	RevealAnswers    bool
+	ShuffleQuestions bool
+	ShuffleOptions   bool
 }
 
 // Tests queries - simplified
 func (q *Queries) CreateTest(ctx context.Context, arg CreateTestParams) error {
-	_, err := q.db.Exec(ctx, createTest, arg.Title, arg.CreatedBy)
+	_, err := q.db.Exec(ctx, createTest,
+		arg.Title,
+		arg.CreatedBy,
+		arg.DurationMinutes,
+		arg.MaxAttempts,
+		arg.CooldownMinutes,
+		arg.ScorePolicy,
+		arg.RevealAnswers,
+		arg.ShuffleQuestions,
+		arg.ShuffleOptions,
+	)
 	return err
 }
 
@@ -37,20 +64,30 @@ func (q *Queries) DeleteTest(ctx context.Context, id int64) error {
 }
 
 const getAvailableTests = `-- name: GetAvailableTests :many
-SELECT t.id, t.title, t.created_by, t.created_at, 
-       (SELECT COUNT(*) FROM questions WHERE test_id = t.id) as question_count
+SELECT t.id, t.title, t.created_by, t.duration_minutes, t.max_attempts, t.cooldown_minutes, t.score_policy, t.reveal_answers, t.shuffle_questions, t.shuffle_options, t.created_at, 
+       ((SELECT COUNT(*) FROM questions WHERE test_id = t.id)
+        + (SELECT COALESCE(SUM(question_count), 0) FROM draw_rules WHERE test_id = t.id))::BIGINT as question_count
 FROM tests t 
 WHERE t.created_by != $1 
-  AND NOT EXISTS (SELECT 1 FROM test_submissions WHERE test_id = t.id AND user_id = $1)
+  AND (t.max_attempts = 0 OR t.max_attempts > (
+      SELECT COUNT(*) FROM test_submissions WHERE test_id = t.id AND user_id = $1 AND status <> 'in_progress'
+  ))
 ORDER BY t.created_at DESC
 `
 
 type GetAvailableTestsRow struct {
-	ID            int64
-	Title         string
-	CreatedBy     int64
-	CreatedAt     pgtype.Timestamptz
-	QuestionCount int64
+	ID               int64
+	Title            string
+	CreatedBy        int64
+	DurationMinutes  pgtype.Int4
+	MaxAttempts      int32
+	CooldownMinutes  int32
+	ScorePolicy      string
+	RevealAnswers    bool
+	ShuffleQuestions bool
+	ShuffleOptions   bool
+	CreatedAt        pgtype.Timestamptz
+	QuestionCount    int64
 }
 
 func (q *Queries) GetAvailableTests(ctx context.Context, createdBy int64) ([]GetAvailableTestsRow, error) {
@@ -66,6 +103,13 @@ func (q *Queries) GetAvailableTests(ctx context.Context, createdBy int64) ([]Get
 			&i.ID,
 			&i.Title,
 			&i.CreatedBy,
+			&i.DurationMinutes,
+			&i.MaxAttempts,
+			&i.CooldownMinutes,
+			&i.ScorePolicy,
+			&i.RevealAnswers,
+			&i.ShuffleQuestions,
+			&i.ShuffleOptions,
 			&i.CreatedAt,
 			&i.QuestionCount,
 		); err != nil {
@@ -80,7 +124,7 @@ func (q *Queries) GetAvailableTests(ctx context.Context, createdBy int64) ([]Get
 }
 
 const getMyTests = `-- name: GetMyTests :many
-SELECT id, title, created_by, created_at FROM tests WHERE created_by = $1 ORDER BY created_at DESC
+SELECT id, title, created_by, duration_minutes, max_attempts, cooldown_minutes, score_policy, reveal_answers, shuffle_questions, shuffle_options, created_at FROM tests WHERE created_by = $1 ORDER BY created_at DESC
 `
 
 func (q *Queries) GetMyTests(ctx context.Context, createdBy int64) ([]Test, error) {
@@ -96,6 +140,13 @@ func (q *Queries) GetMyTests(ctx context.Context, createdBy int64) ([]Test, erro
 			&i.ID,
 			&i.Title,
 			&i.CreatedBy,
+			&i.DurationMinutes,
+			&i.MaxAttempts,
+			&i.CooldownMinutes,
+			&i.ScorePolicy,
+			&i.RevealAnswers,
+			&i.ShuffleQuestions,
+			&i.ShuffleOptions,
 			&i.CreatedAt,
 		); err != nil {
 			return nil, err
@@ -109,7 +160,7 @@ func (q *Queries) GetMyTests(ctx context.Context, createdBy int64) ([]Test, erro
 }
 
 const getTestById = `-- name: GetTestById :one
-SELECT id, title, created_by, created_at FROM tests WHERE id = $1
+SELECT id, title, created_by, duration_minutes, max_attempts, cooldown_minutes, score_policy, reveal_answers, shuffle_questions, shuffle_options, created_at FROM tests WHERE id = $1
 `
 
 func (q *Queries) GetTestById(ctx context.Context, id int64) (Test, error) {
@@ -119,21 +170,48 @@ func (q *Queries) GetTestById(ctx context.Context, id int64) (Test, error) {
 		&i.ID,
 		&i.Title,
 		&i.CreatedBy,
+		&i.DurationMinutes,
+		&i.MaxAttempts,
+		&i.CooldownMinutes,
+		&i.ScorePolicy,
+		&i.RevealAnswers,
+		&i.ShuffleQuestions,
+		&i.ShuffleOptions,
 		&i.CreatedAt,
 	)
 	return i, err
 }
 
 const updateTest = `-- name: UpdateTest :exec
-UPDATE tests SET title = $2 WHERE id = $1
+UPDATE tests
+SET title = $2, duration_minutes = $3, max_attempts = $4, cooldown_minutes = $5, score_policy = $6, reveal_answers = $7,
+    shuffle_questions = $8, shuffle_options = $9
+WHERE id = $1
 `
 
 type UpdateTestParams struct {
-	ID    int64
-	Title string
+	ID               int64
+	Title            string
+	DurationMinutes  pgtype.Int4
+	MaxAttempts      int32
+	CooldownMinutes  int32
+	ScorePolicy      string
+	RevealAnswers    bool
+	ShuffleQuestions bool
+	ShuffleOptions   bool
 }
 
 func (q *Queries) UpdateTest(ctx context.Context, arg UpdateTestParams) error {
-	_, err := q.db.Exec(ctx, updateTest, arg.ID, arg.Title)
+	_, err := q.db.Exec(ctx, updateTest,
+		arg.ID,
+		arg.Title,
+		arg.DurationMinutes,
+		arg.MaxAttempts,
+		arg.CooldownMinutes,
+		arg.ScorePolicy,
+		arg.RevealAnswers,
+		arg.ShuffleQuestions,
+		arg.ShuffleOptions,
+	)
 	return err
 }
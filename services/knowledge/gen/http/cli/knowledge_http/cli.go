@@ -24,15 +24,22 @@ import (
 //
 //	command (subcommand1|subcommand2|...)
 func UsageCommands() string {
-	return `knowledge (create-test|get-my-tests|get-test-by-id|update-test|delete-test|get-test-questions|add-question|get-question-by-id|update-question|delete-question|get-available-tests|get-test-form|submit-test|get-my-submissions|get-submission-by-id|get-submission-result)
+	return `knowledge (create-test|get-my-tests|get-test-by-id|update-test|delete-test|get-test-questions|add-question|get-question-by-id|update-question|delete-question|create-question-bank|get-my-question-banks|delete-question-bank|get-bank-questions|add-bank-question|update-bank-question|delete-bank-question|add-draw-rule|get-draw-rules|delete-draw-rule|get-test-analytics|get-test-gradebook|get-student-history|get-available-tests|start-test|get-test-form|submit-test|get-my-submissions|get-submission-by-id|get-submission-result)
 `
 }
 
 // UsageExamples produces an example of a valid invocation of the CLI tool.
 func UsageExamples() string {
 	return os.Args[0] + ` knowledge create-test --body '{
-      "title": "Quia eaque qui officia."
-   }' --session-token "Corporis inventore perferendis."` + "\n" +
+      "cooldown_minutes": 6012866890008007084,
+      "duration_minutes": 932660918325851464,
+      "max_attempts": 3223473584357138593,
+      "reveal_answers": false,
+      "score_policy": "best",
+      "shuffle_options": false,
+      "shuffle_questions": true,
+      "title": "Error voluptatibus."
+   }' --session-token "Necessitatibus est aut cumque voluptas voluptatibus molestiae."` + "\n" +
 		""
 }
 
@@ -93,9 +100,74 @@ func ParseEndpoint(
 		knowledgeDeleteQuestionQuestionIDFlag   = knowledgeDeleteQuestionFlags.String("question-id", "REQUIRED", "Question ID")
 		knowledgeDeleteQuestionSessionTokenFlag = knowledgeDeleteQuestionFlags.String("session-token", "REQUIRED", "")
 
+		knowledgeCreateQuestionBankFlags            = flag.NewFlagSet("create-question-bank", flag.ExitOnError)
+		knowledgeCreateQuestionBankBodyFlag         = knowledgeCreateQuestionBankFlags.String("body", "REQUIRED", "")
+		knowledgeCreateQuestionBankSessionTokenFlag = knowledgeCreateQuestionBankFlags.String("session-token", "REQUIRED", "")
+
+		knowledgeGetMyQuestionBanksFlags            = flag.NewFlagSet("get-my-question-banks", flag.ExitOnError)
+		knowledgeGetMyQuestionBanksSessionTokenFlag = knowledgeGetMyQuestionBanksFlags.String("session-token", "REQUIRED", "")
+
+		knowledgeDeleteQuestionBankFlags            = flag.NewFlagSet("delete-question-bank", flag.ExitOnError)
+		knowledgeDeleteQuestionBankBankIDFlag       = knowledgeDeleteQuestionBankFlags.String("bank-id", "REQUIRED", "Question bank ID")
+		knowledgeDeleteQuestionBankSessionTokenFlag = knowledgeDeleteQuestionBankFlags.String("session-token", "REQUIRED", "")
+
+		knowledgeGetBankQuestionsFlags            = flag.NewFlagSet("get-bank-questions", flag.ExitOnError)
+		knowledgeGetBankQuestionsBankIDFlag       = knowledgeGetBankQuestionsFlags.String("bank-id", "REQUIRED", "Question bank ID")
+		knowledgeGetBankQuestionsSessionTokenFlag = knowledgeGetBankQuestionsFlags.String("session-token", "REQUIRED", "")
+
+		knowledgeAddBankQuestionFlags            = flag.NewFlagSet("add-bank-question", flag.ExitOnError)
+		knowledgeAddBankQuestionBodyFlag         = knowledgeAddBankQuestionFlags.String("body", "REQUIRED", "")
+		knowledgeAddBankQuestionBankIDFlag       = knowledgeAddBankQuestionFlags.String("bank-id", "REQUIRED", "Question bank ID")
+		knowledgeAddBankQuestionSessionTokenFlag = knowledgeAddBankQuestionFlags.String("session-token", "REQUIRED", "")
+
+		knowledgeUpdateBankQuestionFlags            = flag.NewFlagSet("update-bank-question", flag.ExitOnError)
+		knowledgeUpdateBankQuestionBodyFlag         = knowledgeUpdateBankQuestionFlags.String("body", "REQUIRED", "")
+		knowledgeUpdateBankQuestionBankIDFlag       = knowledgeUpdateBankQuestionFlags.String("bank-id", "REQUIRED", "Question bank ID")
+		knowledgeUpdateBankQuestionQuestionIDFlag   = knowledgeUpdateBankQuestionFlags.String("question-id", "REQUIRED", "Question ID")
+		knowledgeUpdateBankQuestionSessionTokenFlag = knowledgeUpdateBankQuestionFlags.String("session-token", "REQUIRED", "")
+
+		knowledgeDeleteBankQuestionFlags            = flag.NewFlagSet("delete-bank-question", flag.ExitOnError)
+		knowledgeDeleteBankQuestionBankIDFlag       = knowledgeDeleteBankQuestionFlags.String("bank-id", "REQUIRED", "Question bank ID")
+		knowledgeDeleteBankQuestionQuestionIDFlag   = knowledgeDeleteBankQuestionFlags.String("question-id", "REQUIRED", "Question ID")
+		knowledgeDeleteBankQuestionSessionTokenFlag = knowledgeDeleteBankQuestionFlags.String("session-token", "REQUIRED", "")
+
+		knowledgeAddDrawRuleFlags            = flag.NewFlagSet("add-draw-rule", flag.ExitOnError)
+		knowledgeAddDrawRuleBodyFlag         = knowledgeAddDrawRuleFlags.String("body", "REQUIRED", "")
+		knowledgeAddDrawRuleTestIDFlag       = knowledgeAddDrawRuleFlags.String("test-id", "REQUIRED", "Test ID")
+		knowledgeAddDrawRuleSessionTokenFlag = knowledgeAddDrawRuleFlags.String("session-token", "REQUIRED", "")
+
+		knowledgeGetDrawRulesFlags            = flag.NewFlagSet("get-draw-rules", flag.ExitOnError)
+		knowledgeGetDrawRulesTestIDFlag       = knowledgeGetDrawRulesFlags.String("test-id", "REQUIRED", "Test ID")
+		knowledgeGetDrawRulesSessionTokenFlag = knowledgeGetDrawRulesFlags.String("session-token", "REQUIRED", "")
+
+		knowledgeDeleteDrawRuleFlags            = flag.NewFlagSet("delete-draw-rule", flag.ExitOnError)
+		knowledgeDeleteDrawRuleTestIDFlag       = knowledgeDeleteDrawRuleFlags.String("test-id", "REQUIRED", "Test ID")
+		knowledgeDeleteDrawRuleRuleIDFlag       = knowledgeDeleteDrawRuleFlags.String("rule-id", "REQUIRED", "Draw rule ID")
+		knowledgeDeleteDrawRuleSessionTokenFlag = knowledgeDeleteDrawRuleFlags.String("session-token", "REQUIRED", "")
+
+		knowledgeGetTestAnalyticsFlags            = flag.NewFlagSet("get-test-analytics", flag.ExitOnError)
+		knowledgeGetTestAnalyticsTestIDFlag       = knowledgeGetTestAnalyticsFlags.String("test-id", "REQUIRED", "Test ID")
+		knowledgeGetTestAnalyticsSessionTokenFlag = knowledgeGetTestAnalyticsFlags.String("session-token", "REQUIRED", "")
+
+		knowledgeGetTestGradebookFlags            = flag.NewFlagSet("get-test-gradebook", flag.ExitOnError)
+		knowledgeGetTestGradebookTestIDFlag       = knowledgeGetTestGradebookFlags.String("test-id", "REQUIRED", "Test ID")
+		knowledgeGetTestGradebookSortByFlag       = knowledgeGetTestGradebookFlags.String("sort-by", "submitted_at", "")
+		knowledgeGetTestGradebookSortOrderFlag    = knowledgeGetTestGradebookFlags.String("sort-order", "desc", "")
+		knowledgeGetTestGradebookStatusFlag       = knowledgeGetTestGradebookFlags.String("status", "", "")
+		knowledgeGetTestGradebookStudentFlag      = knowledgeGetTestGradebookFlags.String("student", "", "")
+		knowledgeGetTestGradebookSessionTokenFlag = knowledgeGetTestGradebookFlags.String("session-token", "REQUIRED", "")
+
+		knowledgeGetStudentHistoryFlags            = flag.NewFlagSet("get-student-history", flag.ExitOnError)
+		knowledgeGetStudentHistoryUserIDFlag       = knowledgeGetStudentHistoryFlags.String("user-id", "REQUIRED", "Student user ID")
+		knowledgeGetStudentHistorySessionTokenFlag = knowledgeGetStudentHistoryFlags.String("session-token", "REQUIRED", "")
+
 		knowledgeGetAvailableTestsFlags            = flag.NewFlagSet("get-available-tests", flag.ExitOnError)
 		knowledgeGetAvailableTestsSessionTokenFlag = knowledgeGetAvailableTestsFlags.String("session-token", "REQUIRED", "")
 
+		knowledgeStartTestFlags            = flag.NewFlagSet("start-test", flag.ExitOnError)
+		knowledgeStartTestTestIDFlag       = knowledgeStartTestFlags.String("test-id", "REQUIRED", "Test ID")
+		knowledgeStartTestSessionTokenFlag = knowledgeStartTestFlags.String("session-token", "REQUIRED", "")
+
 		knowledgeGetTestFormFlags            = flag.NewFlagSet("get-test-form", flag.ExitOnError)
 		knowledgeGetTestFormTestIDFlag       = knowledgeGetTestFormFlags.String("test-id", "REQUIRED", "Test ID")
 		knowledgeGetTestFormSessionTokenFlag = knowledgeGetTestFormFlags.String("session-token", "REQUIRED", "")
@@ -127,7 +199,21 @@ func ParseEndpoint(
 	knowledgeGetQuestionByIDFlags.Usage = knowledgeGetQuestionByIDUsage
 	knowledgeUpdateQuestionFlags.Usage = knowledgeUpdateQuestionUsage
 	knowledgeDeleteQuestionFlags.Usage = knowledgeDeleteQuestionUsage
+	knowledgeCreateQuestionBankFlags.Usage = knowledgeCreateQuestionBankUsage
+	knowledgeGetMyQuestionBanksFlags.Usage = knowledgeGetMyQuestionBanksUsage
+	knowledgeDeleteQuestionBankFlags.Usage = knowledgeDeleteQuestionBankUsage
+	knowledgeGetBankQuestionsFlags.Usage = knowledgeGetBankQuestionsUsage
+	knowledgeAddBankQuestionFlags.Usage = knowledgeAddBankQuestionUsage
+	knowledgeUpdateBankQuestionFlags.Usage = knowledgeUpdateBankQuestionUsage
+	knowledgeDeleteBankQuestionFlags.Usage = knowledgeDeleteBankQuestionUsage
+	knowledgeAddDrawRuleFlags.Usage = knowledgeAddDrawRuleUsage
+	knowledgeGetDrawRulesFlags.Usage = knowledgeGetDrawRulesUsage
+	knowledgeDeleteDrawRuleFlags.Usage = knowledgeDeleteDrawRuleUsage
+	knowledgeGetTestAnalyticsFlags.Usage = knowledgeGetTestAnalyticsUsage
+	knowledgeGetTestGradebookFlags.Usage = knowledgeGetTestGradebookUsage
+	knowledgeGetStudentHistoryFlags.Usage = knowledgeGetStudentHistoryUsage
 	knowledgeGetAvailableTestsFlags.Usage = knowledgeGetAvailableTestsUsage
+	knowledgeStartTestFlags.Usage = knowledgeStartTestUsage
 	knowledgeGetTestFormFlags.Usage = knowledgeGetTestFormUsage
 	knowledgeSubmitTestFlags.Usage = knowledgeSubmitTestUsage
 	knowledgeGetMySubmissionsFlags.Usage = knowledgeGetMySubmissionsUsage
@@ -198,9 +284,51 @@ func ParseEndpoint(
 			case "delete-question":
 				epf = knowledgeDeleteQuestionFlags
 
+			case "create-question-bank":
+				epf = knowledgeCreateQuestionBankFlags
+
+			case "get-my-question-banks":
+				epf = knowledgeGetMyQuestionBanksFlags
+
+			case "delete-question-bank":
+				epf = knowledgeDeleteQuestionBankFlags
+
+			case "get-bank-questions":
+				epf = knowledgeGetBankQuestionsFlags
+
+			case "add-bank-question":
+				epf = knowledgeAddBankQuestionFlags
+
+			case "update-bank-question":
+				epf = knowledgeUpdateBankQuestionFlags
+
+			case "delete-bank-question":
+				epf = knowledgeDeleteBankQuestionFlags
+
+			case "add-draw-rule":
+				epf = knowledgeAddDrawRuleFlags
+
+			case "get-draw-rules":
+				epf = knowledgeGetDrawRulesFlags
+
+			case "delete-draw-rule":
+				epf = knowledgeDeleteDrawRuleFlags
+
+			case "get-test-analytics":
+				epf = knowledgeGetTestAnalyticsFlags
+
+			case "get-test-gradebook":
+				epf = knowledgeGetTestGradebookFlags
+
+			case "get-student-history":
+				epf = knowledgeGetStudentHistoryFlags
+
 			case "get-available-tests":
 				epf = knowledgeGetAvailableTestsFlags
 
+			case "start-test":
+				epf = knowledgeStartTestFlags
+
 			case "get-test-form":
 				epf = knowledgeGetTestFormFlags
 
@@ -271,9 +399,51 @@ func ParseEndpoint(
 			case "delete-question":
 				endpoint = c.DeleteQuestion()
 				data, err = knowledgec.BuildDeleteQuestionPayload(*knowledgeDeleteQuestionTestIDFlag, *knowledgeDeleteQuestionQuestionIDFlag, *knowledgeDeleteQuestionSessionTokenFlag)
+			case "create-question-bank":
+				endpoint = c.CreateQuestionBank()
+				data, err = knowledgec.BuildCreateQuestionBankPayload(*knowledgeCreateQuestionBankBodyFlag, *knowledgeCreateQuestionBankSessionTokenFlag)
+			case "get-my-question-banks":
+				endpoint = c.GetMyQuestionBanks()
+				data, err = knowledgec.BuildGetMyQuestionBanksPayload(*knowledgeGetMyQuestionBanksSessionTokenFlag)
+			case "delete-question-bank":
+				endpoint = c.DeleteQuestionBank()
+				data, err = knowledgec.BuildDeleteQuestionBankPayload(*knowledgeDeleteQuestionBankBankIDFlag, *knowledgeDeleteQuestionBankSessionTokenFlag)
+			case "get-bank-questions":
+				endpoint = c.GetBankQuestions()
+				data, err = knowledgec.BuildGetBankQuestionsPayload(*knowledgeGetBankQuestionsBankIDFlag, *knowledgeGetBankQuestionsSessionTokenFlag)
+			case "add-bank-question":
+				endpoint = c.AddBankQuestion()
+				data, err = knowledgec.BuildAddBankQuestionPayload(*knowledgeAddBankQuestionBodyFlag, *knowledgeAddBankQuestionBankIDFlag, *knowledgeAddBankQuestionSessionTokenFlag)
+			case "update-bank-question":
+				endpoint = c.UpdateBankQuestion()
+				data, err = knowledgec.BuildUpdateBankQuestionPayload(*knowledgeUpdateBankQuestionBodyFlag, *knowledgeUpdateBankQuestionBankIDFlag, *knowledgeUpdateBankQuestionQuestionIDFlag, *knowledgeUpdateBankQuestionSessionTokenFlag)
+			case "delete-bank-question":
+				endpoint = c.DeleteBankQuestion()
+				data, err = knowledgec.BuildDeleteBankQuestionPayload(*knowledgeDeleteBankQuestionBankIDFlag, *knowledgeDeleteBankQuestionQuestionIDFlag, *knowledgeDeleteBankQuestionSessionTokenFlag)
+			case "add-draw-rule":
+				endpoint = c.AddDrawRule()
+				data, err = knowledgec.BuildAddDrawRulePayload(*knowledgeAddDrawRuleBodyFlag, *knowledgeAddDrawRuleTestIDFlag, *knowledgeAddDrawRuleSessionTokenFlag)
+			case "get-draw-rules":
+				endpoint = c.GetDrawRules()
+				data, err = knowledgec.BuildGetDrawRulesPayload(*knowledgeGetDrawRulesTestIDFlag, *knowledgeGetDrawRulesSessionTokenFlag)
+			case "delete-draw-rule":
+				endpoint = c.DeleteDrawRule()
+				data, err = knowledgec.BuildDeleteDrawRulePayload(*knowledgeDeleteDrawRuleTestIDFlag, *knowledgeDeleteDrawRuleRuleIDFlag, *knowledgeDeleteDrawRuleSessionTokenFlag)
+			case "get-test-analytics":
+				endpoint = c.GetTestAnalytics()
+				data, err = knowledgec.BuildGetTestAnalyticsPayload(*knowledgeGetTestAnalyticsTestIDFlag, *knowledgeGetTestAnalyticsSessionTokenFlag)
+			case "get-test-gradebook":
+				endpoint = c.GetTestGradebook()
+				data, err = knowledgec.BuildGetTestGradebookPayload(*knowledgeGetTestGradebookTestIDFlag, *knowledgeGetTestGradebookSortByFlag, *knowledgeGetTestGradebookSortOrderFlag, *knowledgeGetTestGradebookStatusFlag, *knowledgeGetTestGradebookStudentFlag, *knowledgeGetTestGradebookSessionTokenFlag)
+			case "get-student-history":
+				endpoint = c.GetStudentHistory()
+				data, err = knowledgec.BuildGetStudentHistoryPayload(*knowledgeGetStudentHistoryUserIDFlag, *knowledgeGetStudentHistorySessionTokenFlag)
 			case "get-available-tests":
 				endpoint = c.GetAvailableTests()
 				data, err = knowledgec.BuildGetAvailableTestsPayload(*knowledgeGetAvailableTestsSessionTokenFlag)
+			case "start-test":
+				endpoint = c.StartTest()
+				data, err = knowledgec.BuildStartTestPayload(*knowledgeStartTestTestIDFlag, *knowledgeStartTestSessionTokenFlag)
 			case "get-test-form":
 				endpoint = c.GetTestForm()
 				data, err = knowledgec.BuildGetTestFormPayload(*knowledgeGetTestFormTestIDFlag, *knowledgeGetTestFormSessionTokenFlag)
@@ -310,19 +480,33 @@ COMMAND:
     create-test: Create a new test form
     get-my-tests: Get my created tests
     get-test-by-id: Get a test by ID
-    update-test: Update test title
+    update-test: Update test title, time limit, attempt policy and shuffling
     delete-test: Delete a test
     get-test-questions: Get questions for a test
     add-question: Add a question to a test
     get-question-by-id: Get a question by ID
     update-question: Update a question
     delete-question: Delete a question
+    create-question-bank: Create a question bank
+    get-my-question-banks: Get my question banks
+    delete-question-bank: Delete a question bank with its questions and the draw rules using it
+    get-bank-questions: Get the questions of a question bank
+    add-bank-question: Add a question to a question bank
+    update-bank-question: Update a question of a question bank
+    delete-bank-question: Delete a question of a question bank
+    add-draw-rule: Make every attempt at a test draw questions at random from a question bank
+    get-draw-rules: Get the draw rules of a test
+    delete-draw-rule: Delete a draw rule of a test
+    get-test-analytics: Get the score distribution and item analysis of a test
+    get-test-gradebook: Get the submissions of every student at a test
+    get-student-history: Get the submissions of a student at every test of the teacher
     get-available-tests: Get available tests for students
+    start-test: Start an attempt at a test, or resume the one in progress
     get-test-form: Get test form for taking
     submit-test: Submit test answers
-    get-my-submissions: Get my test submissions
+    get-my-submissions: Get every attempt of mine at every test, with the score counted for each test
     get-submission-by-id: Get a submission by ID
-    get-submission-result: Get detailed submission result
+    get-submission-result: Get detailed submission result, with every attempt at the same test
 
 Additional help:
     %[1]s knowledge COMMAND --help
@@ -337,8 +521,15 @@ Create a new test form
 
 Example:
     %[1]s knowledge create-test --body '{
-      "title": "Quia eaque qui officia."
-   }' --session-token "Corporis inventore perferendis."
+      "cooldown_minutes": 6012866890008007084,
+      "duration_minutes": 932660918325851464,
+      "max_attempts": 3223473584357138593,
+      "reveal_answers": false,
+      "score_policy": "best",
+      "shuffle_options": false,
+      "shuffle_questions": true,
+      "title": "Error voluptatibus."
+   }' --session-token "Necessitatibus est aut cumque voluptas voluptatibus molestiae."
 `, os.Args[0])
 }
 
@@ -349,7 +540,7 @@ Get my created tests
     -session-token STRING: 
 
 Example:
-    %[1]s knowledge get-my-tests --session-token "Nihil molestias et."
+    %[1]s knowledge get-my-tests --session-token "Impedit pariatur."
 `, os.Args[0])
 }
 
@@ -361,22 +552,29 @@ Get a test by ID
     -session-token STRING: 
 
 Example:
-    %[1]s knowledge get-test-by-id --test-id 4436154052070521967 --session-token "Facilis eum incidunt ad et."
+    %[1]s knowledge get-test-by-id --test-id 2856589210464147975 --session-token "Facilis consequatur laboriosam et."
 `, os.Args[0])
 }
 
 func knowledgeUpdateTestUsage() {
 	fmt.Fprintf(os.Stderr, `%[1]s [flags] knowledge update-test -body JSON -test-id INT64 -session-token STRING
 
-Update test title
+Update test title, time limit, attempt policy and shuffling
     -body JSON: 
     -test-id INT64: Test ID
     -session-token STRING: 
 
 Example:
     %[1]s knowledge update-test --body '{
-      "title": "Tenetur id maiores debitis at aperiam reiciendis."
-   }' --test-id 5573552492505036084 --session-token "Vero porro quaerat doloribus eius rerum est."
+      "cooldown_minutes": 3959701362646123257,
+      "duration_minutes": 7439970023521147051,
+      "max_attempts": 4637466240073273332,
+      "reveal_answers": true,
+      "score_policy": "best",
+      "shuffle_options": true,
+      "shuffle_questions": true,
+      "title": "Dolores eaque omnis quisquam sunt saepe."
+   }' --test-id 6509679316475416537 --session-token "Excepturi corporis nihil provident rerum."
 `, os.Args[0])
 }
 
@@ -388,7 +586,7 @@ Delete a test
     -session-token STRING: 
 
 Example:
-    %[1]s knowledge delete-test --test-id 950464656488258168 --session-token "Quia repellat esse voluptatem necessitatibus."
+    %[1]s knowledge delete-test --test-id 7172750306380702579 --session-token "Numquam possimus minima doloribus nostrum."
 `, os.Args[0])
 }
 
@@ -400,7 +598,7 @@ Get questions for a test
     -session-token STRING: 
 
 Example:
-    %[1]s knowledge get-test-questions --test-id 4823614619145999819 --session-token "Sunt cum laudantium sit."
+    %[1]s knowledge get-test-questions --test-id 6926393799364355458 --session-token "Vel quae maxime rem accusamus quidem."
 `, os.Args[0])
 }
 
@@ -414,13 +612,28 @@ Add a question to a test
 
 Example:
     %[1]s knowledge add-question --body '{
-      "correct_answer": 5237011504810502332,
-      "option_a": "Dolores iusto sit fuga.",
-      "option_b": "Aliquam et totam aliquid aut optio.",
-      "option_c": "Libero quis sint.",
-      "option_d": "Magni nihil.",
-      "question_text": "Ducimus quia fugiat fuga molestiae accusantium amet."
-   }' --test-id 1014521382646075800 --session-token "Vitae odio quis vel."
+      "accepted_answers": [
+         "Impedit quidem veniam.",
+         "Nulla velit.",
+         "Eveniet minus assumenda.",
+         "Nihil deserunt necessitatibus tenetur."
+      ],
+      "correct_options": [
+         1723356791580318836,
+         3075198830580654417,
+         8630635807734739627
+      ],
+      "numeric_answer": 0.03314191545206406,
+      "options": [
+         "Praesentium quae cumque perspiciatis quasi eaque consequatur.",
+         "Rerum est dolore incidunt nihil.",
+         "Est dolore.",
+         "Possimus enim."
+      ],
+      "question_text": "Consequatur laudantium vel quas.",
+      "question_type": "numeric",
+      "tolerance": 0.07066022101955631
+   }' --test-id 1904539557754275739 --session-token "Cum omnis exercitationem id quia saepe."
 `, os.Args[0])
 }
 
@@ -433,7 +646,7 @@ Get a question by ID
     -session-token STRING: 
 
 Example:
-    %[1]s knowledge get-question-by-id --test-id 4777821089179222314 --question-id 573487563850651286 --session-token "Rerum et ipsa qui dolorem et."
+    %[1]s knowledge get-question-by-id --test-id 2676959448756873004 --question-id 3312487450357920451 --session-token "Occaecati aliquid facilis placeat excepturi."
 `, os.Args[0])
 }
 
@@ -448,13 +661,26 @@ Update a question
 
 Example:
     %[1]s knowledge update-question --body '{
-      "correct_answer": 5497856277076629409,
-      "option_a": "Sunt quidem vel enim.",
-      "option_b": "Ducimus deserunt fuga.",
-      "option_c": "Adipisci occaecati vel dolorum aut dolore.",
-      "option_d": "Fuga alias veniam.",
-      "question_text": "Et quis neque omnis enim et."
-   }' --test-id 2107802249804250201 --question-id 62667417625230853 --session-token "Eos delectus voluptatum dignissimos."
+      "accepted_answers": [
+         "Saepe illo error dolores itaque.",
+         "Nesciunt hic sapiente dolor.",
+         "Ipsam possimus repellendus minima voluptas autem nam.",
+         "Ipsam et aperiam numquam."
+      ],
+      "correct_options": [
+         69560815059749354,
+         3518138538915657330
+      ],
+      "numeric_answer": 0.5844119610416902,
+      "options": [
+         "Distinctio aut totam ut.",
+         "Recusandae est ad laboriosam et.",
+         "Eaque quasi et sit."
+      ],
+      "question_text": "Mollitia et harum ratione pariatur repellendus consequatur.",
+      "question_type": "short_text",
+      "tolerance": 0.6161980463297633
+   }' --test-id 6949920127716026328 --question-id 8327873056088305187 --session-token "Commodi cupiditate eos totam."
 `, os.Args[0])
 }
 
@@ -467,7 +693,222 @@ Delete a question
     -session-token STRING: 
 
 Example:
-    %[1]s knowledge delete-question --test-id 2402815448165623977 --question-id 841615088860982762 --session-token "Qui quas est."
+    %[1]s knowledge delete-question --test-id 5240850485737529189 --question-id 6547996976309654210 --session-token "Non quia ratione saepe a et."
+`, os.Args[0])
+}
+
+func knowledgeCreateQuestionBankUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] knowledge create-question-bank -body JSON -session-token STRING
+
+Create a question bank
+    -body JSON: 
+    -session-token STRING: 
+
+Example:
+    %[1]s knowledge create-question-bank --body '{
+      "title": "Culpa commodi."
+   }' --session-token "Necessitatibus quas alias maxime porro."
+`, os.Args[0])
+}
+
+func knowledgeGetMyQuestionBanksUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] knowledge get-my-question-banks -session-token STRING
+
+Get my question banks
+    -session-token STRING: 
+
+Example:
+    %[1]s knowledge get-my-question-banks --session-token "Velit nihil est."
+`, os.Args[0])
+}
+
+func knowledgeDeleteQuestionBankUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] knowledge delete-question-bank -bank-id INT64 -session-token STRING
+
+Delete a question bank with its questions and the draw rules using it
+    -bank-id INT64: Question bank ID
+    -session-token STRING: 
+
+Example:
+    %[1]s knowledge delete-question-bank --bank-id 6284453415845313773 --session-token "Molestias est ut modi."
+`, os.Args[0])
+}
+
+func knowledgeGetBankQuestionsUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] knowledge get-bank-questions -bank-id INT64 -session-token STRING
+
+Get the questions of a question bank
+    -bank-id INT64: Question bank ID
+    -session-token STRING: 
+
+Example:
+    %[1]s knowledge get-bank-questions --bank-id 1212430164404538963 --session-token "Autem aut rem magnam ut veniam."
+`, os.Args[0])
+}
+
+func knowledgeAddBankQuestionUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] knowledge add-bank-question -body JSON -bank-id INT64 -session-token STRING
+
+Add a question to a question bank
+    -body JSON: 
+    -bank-id INT64: Question bank ID
+    -session-token STRING: 
+
+Example:
+    %[1]s knowledge add-bank-question --body '{
+      "accepted_answers": [
+         "Quis consequatur et.",
+         "Dolorem est.",
+         "Qui nostrum similique."
+      ],
+      "correct_options": [
+         4461791798213975481,
+         7530868657508262204,
+         4233659037043231070
+      ],
+      "difficulty": "easy",
+      "numeric_answer": 0.7334811058096822,
+      "options": [
+         "Accusamus cum inventore odio odit.",
+         "Autem eveniet ut.",
+         "Ratione quia placeat."
+      ],
+      "question_text": "Enim non asperiores quod ut.",
+      "question_type": "multiple_select",
+      "tolerance": 0.12850477244527186,
+      "topic": "igk"
+   }' --bank-id 1762719796511850270 --session-token "Aperiam aut ea."
+`, os.Args[0])
+}
+
+func knowledgeUpdateBankQuestionUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] knowledge update-bank-question -body JSON -bank-id INT64 -question-id INT64 -session-token STRING
+
+Update a question of a question bank
+    -body JSON: 
+    -bank-id INT64: Question bank ID
+    -question-id INT64: Question ID
+    -session-token STRING: 
+
+Example:
+    %[1]s knowledge update-bank-question --body '{
+      "accepted_answers": [
+         "Quidem fuga nulla dolore quisquam et.",
+         "Maxime dolor aliquid cupiditate."
+      ],
+      "correct_options": [
+         2516181537301509506,
+         3140617901535048514
+      ],
+      "difficulty": "medium",
+      "numeric_answer": 0.2597708022936953,
+      "options": [
+         "Fuga placeat ipsa repellat pariatur corrupti enim.",
+         "Quis dolores dicta autem et facere.",
+         "Ea molestiae neque ipsum deserunt omnis quae.",
+         "Cumque blanditiis id totam rerum."
+      ],
+      "question_text": "Fugiat dignissimos molestiae sit optio.",
+      "question_type": "multiple_choice",
+      "tolerance": 0.04018062873893399,
+      "topic": "eew"
+   }' --bank-id 525881345493068815 --question-id 9100408360877598951 --session-token "Culpa id molestiae."
+`, os.Args[0])
+}
+
+func knowledgeDeleteBankQuestionUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] knowledge delete-bank-question -bank-id INT64 -question-id INT64 -session-token STRING
+
+Delete a question of a question bank
+    -bank-id INT64: Question bank ID
+    -question-id INT64: Question ID
+    -session-token STRING: 
+
+Example:
+    %[1]s knowledge delete-bank-question --bank-id 8030198055757702948 --question-id 3582599614468267248 --session-token "Officiis illo minima ullam."
+`, os.Args[0])
+}
+
+func knowledgeAddDrawRuleUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] knowledge add-draw-rule -body JSON -test-id INT64 -session-token STRING
+
+Make every attempt at a test draw questions at random from a question bank
+    -body JSON: 
+    -test-id INT64: Test ID
+    -session-token STRING: 
+
+Example:
+    %[1]s knowledge add-draw-rule --body '{
+      "bank_id": 818443890589725575,
+      "difficulty": "medium",
+      "question_count": 1651193286025122916,
+      "topic": "ab3"
+   }' --test-id 7488328132189622300 --session-token "Ut deleniti minus ut."
+`, os.Args[0])
+}
+
+func knowledgeGetDrawRulesUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] knowledge get-draw-rules -test-id INT64 -session-token STRING
+
+Get the draw rules of a test
+    -test-id INT64: Test ID
+    -session-token STRING: 
+
+Example:
+    %[1]s knowledge get-draw-rules --test-id 7315196822985371312 --session-token "Voluptates doloribus veniam tempore."
+`, os.Args[0])
+}
+
+func knowledgeDeleteDrawRuleUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] knowledge delete-draw-rule -test-id INT64 -rule-id INT64 -session-token STRING
+
+Delete a draw rule of a test
+    -test-id INT64: Test ID
+    -rule-id INT64: Draw rule ID
+    -session-token STRING: 
+
+Example:
+    %[1]s knowledge delete-draw-rule --test-id 7357066515840584049 --rule-id 5349394434011079560 --session-token "Est maiores et natus sequi."
+`, os.Args[0])
+}
+
+func knowledgeGetTestAnalyticsUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] knowledge get-test-analytics -test-id INT64 -session-token STRING
+
+Get the score distribution and item analysis of a test
+    -test-id INT64: Test ID
+    -session-token STRING: 
+
+Example:
+    %[1]s knowledge get-test-analytics --test-id 1229903216430042658 --session-token "Dolores sed tenetur tenetur."
+`, os.Args[0])
+}
+
+func knowledgeGetTestGradebookUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] knowledge get-test-gradebook -test-id INT64 -sort-by STRING -sort-order STRING -status STRING -student STRING -session-token STRING
+
+Get the submissions of every student at a test
+    -test-id INT64: Test ID
+    -sort-by STRING: 
+    -sort-order STRING: 
+    -status STRING: 
+    -student STRING: 
+    -session-token STRING: 
+
+Example:
+    %[1]s knowledge get-test-gradebook --test-id 5396991070581004549 --sort-by "submitted_at" --sort-order "asc" --status "submitted" --student "Eum est ab voluptatem odio." --session-token "Autem eum quo veniam."
+`, os.Args[0])
+}
+
+func knowledgeGetStudentHistoryUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] knowledge get-student-history -user-id INT64 -session-token STRING
+
+Get the submissions of a student at every test of the teacher
+    -user-id INT64: Student user ID
+    -session-token STRING: 
+
+Example:
+    %[1]s knowledge get-student-history --user-id 4520864296600991180 --session-token "Dolores eius qui quis."
 `, os.Args[0])
 }
 
@@ -478,7 +919,19 @@ Get available tests for students
     -session-token STRING: 
 
 Example:
-    %[1]s knowledge get-available-tests --session-token "Modi qui."
+    %[1]s knowledge get-available-tests --session-token "Qui consectetur."
+`, os.Args[0])
+}
+
+func knowledgeStartTestUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] knowledge start-test -test-id INT64 -session-token STRING
+
+Start an attempt at a test, or resume the one in progress
+    -test-id INT64: Test ID
+    -session-token STRING: 
+
+Example:
+    %[1]s knowledge start-test --test-id 1570465972387445159 --session-token "Ut aut deserunt vel."
 `, os.Args[0])
 }
 
@@ -490,7 +943,7 @@ Get test form for taking
     -session-token STRING: 
 
 Example:
-    %[1]s knowledge get-test-form --test-id 139387877286796641 --session-token "Dolor ut consequatur sint."
+    %[1]s knowledge get-test-form --test-id 6481246217468104729 --session-token "Tempora et."
 `, os.Args[0])
 }
 
@@ -506,34 +959,36 @@ Example:
     %[1]s knowledge submit-test --body '{
       "answers": [
          {
-            "question_id": 4096248363570844082,
-            "selected_answer": 3700718689874649655
-         },
-         {
-            "question_id": 4096248363570844082,
-            "selected_answer": 3700718689874649655
-         },
-         {
-            "question_id": 4096248363570844082,
-            "selected_answer": 3700718689874649655
+            "numeric_answer": 0.08877186459628728,
+            "question_id": 2798399229346647812,
+            "selected_options": [
+               1740833882688747858,
+               3480373929497777977
+            ],
+            "text_answer": "Ea mollitia numquam."
          },
          {
-            "question_id": 4096248363570844082,
-            "selected_answer": 3700718689874649655
+            "numeric_answer": 0.08877186459628728,
+            "question_id": 2798399229346647812,
+            "selected_options": [
+               1740833882688747858,
+               3480373929497777977
+            ],
+            "text_answer": "Ea mollitia numquam."
          }
       ]
-   }' --test-id 5179380141281466925 --session-token "Deleniti quia quo et dolorem nostrum."
+   }' --test-id 8245115612762481310 --session-token "Harum et nisi velit aperiam ab illum."
 `, os.Args[0])
 }
 
 func knowledgeGetMySubmissionsUsage() {
 	fmt.Fprintf(os.Stderr, `%[1]s [flags] knowledge get-my-submissions -session-token STRING
 
-Get my test submissions
+Get every attempt of mine at every test, with the score counted for each test
     -session-token STRING: 
 
 Example:
-    %[1]s knowledge get-my-submissions --session-token "Voluptatem dolor quasi eum eveniet."
+    %[1]s knowledge get-my-submissions --session-token "Ab iste hic facilis minus aut."
 `, os.Args[0])
 }
 
@@ -545,18 +1000,18 @@ Get a submission by ID
     -session-token STRING: 
 
 Example:
-    %[1]s knowledge get-submission-by-id --submission-id 7915203422341276603 --session-token "Veritatis et iure culpa et."
+    %[1]s knowledge get-submission-by-id --submission-id 188817560453134969 --session-token "Tempore ipsum neque iusto ab numquam."
 `, os.Args[0])
 }
 
 func knowledgeGetSubmissionResultUsage() {
 	fmt.Fprintf(os.Stderr, `%[1]s [flags] knowledge get-submission-result -submission-id INT64 -session-token STRING
 
-Get detailed submission result
+Get detailed submission result, with every attempt at the same test
     -submission-id INT64: Submission ID
     -session-token STRING: 
 
 Example:
-    %[1]s knowledge get-submission-result --submission-id 52667249506286983 --session-token "Rerum consequatur qui delectus et nostrum."
+    %[1]s knowledge get-submission-result --submission-id 1627695891375518208 --session-token "Culpa excepturi nobis distinctio nobis et."
 `, os.Args[0])
 }
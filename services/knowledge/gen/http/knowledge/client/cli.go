@@ -13,6 +13,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"strconv"
+	"unicode/utf8"
 
 	knowledge "github.com/ynoacamino/infra-sustainable-classrooms/services/knowledge/gen/knowledge"
 	goa "goa.design/goa/v3/pkg"
@@ -26,7 +27,26 @@ func BuildCreateTestPayload(knowledgeCreateTestBody string, knowledgeCreateTestS
 	{
 		err = json.Unmarshal([]byte(knowledgeCreateTestBody), &body)
 		if err != nil {
-			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"title\": \"Quia eaque qui officia.\"\n   }'")
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"cooldown_minutes\": 6012866890008007084,\n      \"duration_minutes\": 932660918325851464,\n      \"max_attempts\": 3223473584357138593,\n      \"reveal_answers\": false,\n      \"score_policy\": \"best\",\n      \"shuffle_options\": false,\n      \"shuffle_questions\": true,\n      \"title\": \"Error voluptatibus.\"\n   }'")
+		}
+		if body.DurationMinutes != nil {
+			if *body.DurationMinutes < 1 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("body.duration_minutes", *body.DurationMinutes, 1, true))
+			}
+		}
+		if body.MaxAttempts < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.max_attempts", body.MaxAttempts, 0, true))
+		}
+		if body.CooldownMinutes < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.cooldown_minutes", body.CooldownMinutes, 0, true))
+		}
+		if body.ScorePolicy != nil {
+			if !(*body.ScorePolicy == "best" || *body.ScorePolicy == "latest" || *body.ScorePolicy == "average") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.score_policy", *body.ScorePolicy, []any{"best", "latest", "average"}))
+			}
+		}
+		if err != nil {
+			return nil, err
 		}
 	}
 	var sessionToken string
@@ -34,7 +54,49 @@ func BuildCreateTestPayload(knowledgeCreateTestBody string, knowledgeCreateTestS
 		sessionToken = knowledgeCreateTestSessionToken
 	}
 	v := &knowledge.CreateTestPayload{
-		Title: body.Title,
+		Title:            body.Title,
+		DurationMinutes:  body.DurationMinutes,
+		MaxAttempts:      body.MaxAttempts,
+		CooldownMinutes:  body.CooldownMinutes,
+		RevealAnswers:    body.RevealAnswers,
+		ShuffleQuestions: body.ShuffleQuestions,
+		ShuffleOptions:   body.ShuffleOptions,
+	}
+	if body.ScorePolicy != nil {
+		v.ScorePolicy = knowledge.ScorePolicy(*body.ScorePolicy)
+	}
+	{
+		var zero int
+		if v.MaxAttempts == zero {
+			v.MaxAttempts = 1
+		}
+	}
+	{
+		var zero int
+		if v.CooldownMinutes == zero {
+			v.CooldownMinutes = 0
+		}
+	}
+	if body.ScorePolicy == nil {
+		v.ScorePolicy = "best"
+	}
+	{
+		var zero bool
+		if v.RevealAnswers == zero {
+			v.RevealAnswers = true
+		}
+	}
+	{
+		var zero bool
+		if v.ShuffleQuestions == zero {
+			v.ShuffleQuestions = false
+		}
+	}
+	{
+		var zero bool
+		if v.ShuffleOptions == zero {
+			v.ShuffleOptions = false
+		}
 	}
 	v.SessionToken = sessionToken
 
@@ -84,7 +146,26 @@ func BuildUpdateTestPayload(knowledgeUpdateTestBody string, knowledgeUpdateTestT
 	{
 		err = json.Unmarshal([]byte(knowledgeUpdateTestBody), &body)
 		if err != nil {
-			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"title\": \"Tenetur id maiores debitis at aperiam reiciendis.\"\n   }'")
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"cooldown_minutes\": 3959701362646123257,\n      \"duration_minutes\": 7439970023521147051,\n      \"max_attempts\": 4637466240073273332,\n      \"reveal_answers\": true,\n      \"score_policy\": \"best\",\n      \"shuffle_options\": true,\n      \"shuffle_questions\": true,\n      \"title\": \"Dolores eaque omnis quisquam sunt saepe.\"\n   }'")
+		}
+		if body.DurationMinutes != nil {
+			if *body.DurationMinutes < 1 {
+				err = goa.MergeErrors(err, goa.InvalidRangeError("body.duration_minutes", *body.DurationMinutes, 1, true))
+			}
+		}
+		if body.MaxAttempts < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.max_attempts", body.MaxAttempts, 0, true))
+		}
+		if body.CooldownMinutes < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.cooldown_minutes", body.CooldownMinutes, 0, true))
+		}
+		if body.ScorePolicy != nil {
+			if !(*body.ScorePolicy == "best" || *body.ScorePolicy == "latest" || *body.ScorePolicy == "average") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.score_policy", *body.ScorePolicy, []any{"best", "latest", "average"}))
+			}
+		}
+		if err != nil {
+			return nil, err
 		}
 	}
 	var testID int64
@@ -99,7 +180,49 @@ func BuildUpdateTestPayload(knowledgeUpdateTestBody string, knowledgeUpdateTestT
 		sessionToken = knowledgeUpdateTestSessionToken
 	}
 	v := &knowledge.UpdateTestPayload{
-		Title: body.Title,
+		Title:            body.Title,
+		DurationMinutes:  body.DurationMinutes,
+		MaxAttempts:      body.MaxAttempts,
+		CooldownMinutes:  body.CooldownMinutes,
+		RevealAnswers:    body.RevealAnswers,
+		ShuffleQuestions: body.ShuffleQuestions,
+		ShuffleOptions:   body.ShuffleOptions,
+	}
+	if body.ScorePolicy != nil {
+		v.ScorePolicy = knowledge.ScorePolicy(*body.ScorePolicy)
+	}
+	{
+		var zero int
+		if v.MaxAttempts == zero {
+			v.MaxAttempts = 1
+		}
+	}
+	{
+		var zero int
+		if v.CooldownMinutes == zero {
+			v.CooldownMinutes = 0
+		}
+	}
+	if body.ScorePolicy == nil {
+		v.ScorePolicy = "best"
+	}
+	{
+		var zero bool
+		if v.RevealAnswers == zero {
+			v.RevealAnswers = true
+		}
+	}
+	{
+		var zero bool
+		if v.ShuffleQuestions == zero {
+			v.ShuffleQuestions = false
+		}
+	}
+	{
+		var zero bool
+		if v.ShuffleOptions == zero {
+			v.ShuffleOptions = false
+		}
 	}
 	v.TestID = testID
 	v.SessionToken = sessionToken
@@ -159,7 +282,18 @@ func BuildAddQuestionPayload(knowledgeAddQuestionBody string, knowledgeAddQuesti
 	{
 		err = json.Unmarshal([]byte(knowledgeAddQuestionBody), &body)
 		if err != nil {
-			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"correct_answer\": 5237011504810502332,\n      \"option_a\": \"Dolores iusto sit fuga.\",\n      \"option_b\": \"Aliquam et totam aliquid aut optio.\",\n      \"option_c\": \"Libero quis sint.\",\n      \"option_d\": \"Magni nihil.\",\n      \"question_text\": \"Ducimus quia fugiat fuga molestiae accusantium amet.\"\n   }'")
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"accepted_answers\": [\n         \"Impedit quidem veniam.\",\n         \"Nulla velit.\",\n         \"Eveniet minus assumenda.\",\n         \"Nihil deserunt necessitatibus tenetur.\"\n      ],\n      \"correct_options\": [\n         1723356791580318836,\n         3075198830580654417,\n         8630635807734739627\n      ],\n      \"numeric_answer\": 0.03314191545206406,\n      \"options\": [\n         \"Praesentium quae cumque perspiciatis quasi eaque consequatur.\",\n         \"Rerum est dolore incidunt nihil.\",\n         \"Est dolore.\",\n         \"Possimus enim.\"\n      ],\n      \"question_text\": \"Consequatur laudantium vel quas.\",\n      \"question_type\": \"numeric\",\n      \"tolerance\": 0.07066022101955631\n   }'")
+		}
+		if body.QuestionType != nil {
+			if !(*body.QuestionType == "multiple_choice" || *body.QuestionType == "multiple_select" || *body.QuestionType == "true_false" || *body.QuestionType == "numeric" || *body.QuestionType == "short_text" || *body.QuestionType == "ordering") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.question_type", *body.QuestionType, []any{"multiple_choice", "multiple_select", "true_false", "numeric", "short_text", "ordering"}))
+			}
+		}
+		if body.Tolerance < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.tolerance", body.Tolerance, 0, true))
+		}
+		if err != nil {
+			return nil, err
 		}
 	}
 	var testID int64
@@ -175,11 +309,38 @@ func BuildAddQuestionPayload(knowledgeAddQuestionBody string, knowledgeAddQuesti
 	}
 	v := &knowledge.AddQuestionPayload{
 		QuestionText:  body.QuestionText,
-		OptionA:       body.OptionA,
-		OptionB:       body.OptionB,
-		OptionC:       body.OptionC,
-		OptionD:       body.OptionD,
-		CorrectAnswer: body.CorrectAnswer,
+		NumericAnswer: body.NumericAnswer,
+		Tolerance:     body.Tolerance,
+	}
+	if body.QuestionType != nil {
+		v.QuestionType = knowledge.QuestionType(*body.QuestionType)
+	}
+	if body.QuestionType == nil {
+		v.QuestionType = "multiple_choice"
+	}
+	if body.Options != nil {
+		v.Options = make([]string, len(body.Options))
+		for i, val := range body.Options {
+			v.Options[i] = val
+		}
+	}
+	if body.CorrectOptions != nil {
+		v.CorrectOptions = make([]int, len(body.CorrectOptions))
+		for i, val := range body.CorrectOptions {
+			v.CorrectOptions[i] = val
+		}
+	}
+	{
+		var zero float64
+		if v.Tolerance == zero {
+			v.Tolerance = 0
+		}
+	}
+	if body.AcceptedAnswers != nil {
+		v.AcceptedAnswers = make([]string, len(body.AcceptedAnswers))
+		for i, val := range body.AcceptedAnswers {
+			v.AcceptedAnswers[i] = val
+		}
 	}
 	v.TestID = testID
 	v.SessionToken = sessionToken
@@ -225,7 +386,18 @@ func BuildUpdateQuestionPayload(knowledgeUpdateQuestionBody string, knowledgeUpd
 	{
 		err = json.Unmarshal([]byte(knowledgeUpdateQuestionBody), &body)
 		if err != nil {
-			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"correct_answer\": 5497856277076629409,\n      \"option_a\": \"Sunt quidem vel enim.\",\n      \"option_b\": \"Ducimus deserunt fuga.\",\n      \"option_c\": \"Adipisci occaecati vel dolorum aut dolore.\",\n      \"option_d\": \"Fuga alias veniam.\",\n      \"question_text\": \"Et quis neque omnis enim et.\"\n   }'")
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"accepted_answers\": [\n         \"Saepe illo error dolores itaque.\",\n         \"Nesciunt hic sapiente dolor.\",\n         \"Ipsam possimus repellendus minima voluptas autem nam.\",\n         \"Ipsam et aperiam numquam.\"\n      ],\n      \"correct_options\": [\n         69560815059749354,\n         3518138538915657330\n      ],\n      \"numeric_answer\": 0.5844119610416902,\n      \"options\": [\n         \"Distinctio aut totam ut.\",\n         \"Recusandae est ad laboriosam et.\",\n         \"Eaque quasi et sit.\"\n      ],\n      \"question_text\": \"Mollitia et harum ratione pariatur repellendus consequatur.\",\n      \"question_type\": \"short_text\",\n      \"tolerance\": 0.6161980463297633\n   }'")
+		}
+		if body.QuestionType != nil {
+			if !(*body.QuestionType == "multiple_choice" || *body.QuestionType == "multiple_select" || *body.QuestionType == "true_false" || *body.QuestionType == "numeric" || *body.QuestionType == "short_text" || *body.QuestionType == "ordering") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.question_type", *body.QuestionType, []any{"multiple_choice", "multiple_select", "true_false", "numeric", "short_text", "ordering"}))
+			}
+		}
+		if body.Tolerance < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.tolerance", body.Tolerance, 0, true))
+		}
+		if err != nil {
+			return nil, err
 		}
 	}
 	var testID int64
@@ -248,11 +420,38 @@ func BuildUpdateQuestionPayload(knowledgeUpdateQuestionBody string, knowledgeUpd
 	}
 	v := &knowledge.UpdateQuestionPayload{
 		QuestionText:  body.QuestionText,
-		OptionA:       body.OptionA,
-		OptionB:       body.OptionB,
-		OptionC:       body.OptionC,
-		OptionD:       body.OptionD,
-		CorrectAnswer: body.CorrectAnswer,
+		NumericAnswer: body.NumericAnswer,
+		Tolerance:     body.Tolerance,
+	}
+	if body.QuestionType != nil {
+		v.QuestionType = knowledge.QuestionType(*body.QuestionType)
+	}
+	if body.QuestionType == nil {
+		v.QuestionType = "multiple_choice"
+	}
+	if body.Options != nil {
+		v.Options = make([]string, len(body.Options))
+		for i, val := range body.Options {
+			v.Options[i] = val
+		}
+	}
+	if body.CorrectOptions != nil {
+		v.CorrectOptions = make([]int, len(body.CorrectOptions))
+		for i, val := range body.CorrectOptions {
+			v.CorrectOptions[i] = val
+		}
+	}
+	{
+		var zero float64
+		if v.Tolerance == zero {
+			v.Tolerance = 0
+		}
+	}
+	if body.AcceptedAnswers != nil {
+		v.AcceptedAnswers = make([]string, len(body.AcceptedAnswers))
+		for i, val := range body.AcceptedAnswers {
+			v.AcceptedAnswers[i] = val
+		}
 	}
 	v.TestID = testID
 	v.QuestionID = questionID
@@ -291,6 +490,526 @@ func BuildDeleteQuestionPayload(knowledgeDeleteQuestionTestID string, knowledgeD
 	return v, nil
 }
 
+// BuildCreateQuestionBankPayload builds the payload for the knowledge
+// CreateQuestionBank endpoint from CLI flags.
+func BuildCreateQuestionBankPayload(knowledgeCreateQuestionBankBody string, knowledgeCreateQuestionBankSessionToken string) (*knowledge.CreateQuestionBankPayload, error) {
+	var err error
+	var body CreateQuestionBankRequestBody
+	{
+		err = json.Unmarshal([]byte(knowledgeCreateQuestionBankBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"title\": \"Culpa commodi.\"\n   }'")
+		}
+	}
+	var sessionToken string
+	{
+		sessionToken = knowledgeCreateQuestionBankSessionToken
+	}
+	v := &knowledge.CreateQuestionBankPayload{
+		Title: body.Title,
+	}
+	v.SessionToken = sessionToken
+
+	return v, nil
+}
+
+// BuildGetMyQuestionBanksPayload builds the payload for the knowledge
+// GetMyQuestionBanks endpoint from CLI flags.
+func BuildGetMyQuestionBanksPayload(knowledgeGetMyQuestionBanksSessionToken string) (*knowledge.GetMyQuestionBanksPayload, error) {
+	var sessionToken string
+	{
+		sessionToken = knowledgeGetMyQuestionBanksSessionToken
+	}
+	v := &knowledge.GetMyQuestionBanksPayload{}
+	v.SessionToken = sessionToken
+
+	return v, nil
+}
+
+// BuildDeleteQuestionBankPayload builds the payload for the knowledge
+// DeleteQuestionBank endpoint from CLI flags.
+func BuildDeleteQuestionBankPayload(knowledgeDeleteQuestionBankBankID string, knowledgeDeleteQuestionBankSessionToken string) (*knowledge.DeleteQuestionBankPayload, error) {
+	var err error
+	var bankID int64
+	{
+		bankID, err = strconv.ParseInt(knowledgeDeleteQuestionBankBankID, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value for bankID, must be INT64")
+		}
+	}
+	var sessionToken string
+	{
+		sessionToken = knowledgeDeleteQuestionBankSessionToken
+	}
+	v := &knowledge.DeleteQuestionBankPayload{}
+	v.BankID = bankID
+	v.SessionToken = sessionToken
+
+	return v, nil
+}
+
+// BuildGetBankQuestionsPayload builds the payload for the knowledge
+// GetBankQuestions endpoint from CLI flags.
+func BuildGetBankQuestionsPayload(knowledgeGetBankQuestionsBankID string, knowledgeGetBankQuestionsSessionToken string) (*knowledge.GetBankQuestionsPayload, error) {
+	var err error
+	var bankID int64
+	{
+		bankID, err = strconv.ParseInt(knowledgeGetBankQuestionsBankID, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value for bankID, must be INT64")
+		}
+	}
+	var sessionToken string
+	{
+		sessionToken = knowledgeGetBankQuestionsSessionToken
+	}
+	v := &knowledge.GetBankQuestionsPayload{}
+	v.BankID = bankID
+	v.SessionToken = sessionToken
+
+	return v, nil
+}
+
+// BuildAddBankQuestionPayload builds the payload for the knowledge
+// AddBankQuestion endpoint from CLI flags.
+func BuildAddBankQuestionPayload(knowledgeAddBankQuestionBody string, knowledgeAddBankQuestionBankID string, knowledgeAddBankQuestionSessionToken string) (*knowledge.AddBankQuestionPayload, error) {
+	var err error
+	var body AddBankQuestionRequestBody
+	{
+		err = json.Unmarshal([]byte(knowledgeAddBankQuestionBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"accepted_answers\": [\n         \"Quis consequatur et.\",\n         \"Dolorem est.\",\n         \"Qui nostrum similique.\"\n      ],\n      \"correct_options\": [\n         4461791798213975481,\n         7530868657508262204,\n         4233659037043231070\n      ],\n      \"difficulty\": \"easy\",\n      \"numeric_answer\": 0.7334811058096822,\n      \"options\": [\n         \"Accusamus cum inventore odio odit.\",\n         \"Autem eveniet ut.\",\n         \"Ratione quia placeat.\"\n      ],\n      \"question_text\": \"Enim non asperiores quod ut.\",\n      \"question_type\": \"multiple_select\",\n      \"tolerance\": 0.12850477244527186,\n      \"topic\": \"igk\"\n   }'")
+		}
+		if body.QuestionType != nil {
+			if !(*body.QuestionType == "multiple_choice" || *body.QuestionType == "multiple_select" || *body.QuestionType == "true_false" || *body.QuestionType == "numeric" || *body.QuestionType == "short_text" || *body.QuestionType == "ordering") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.question_type", *body.QuestionType, []any{"multiple_choice", "multiple_select", "true_false", "numeric", "short_text", "ordering"}))
+			}
+		}
+		if body.Tolerance < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.tolerance", body.Tolerance, 0, true))
+		}
+		if body.Topic != nil {
+			if utf8.RuneCountInString(*body.Topic) > 100 {
+				err = goa.MergeErrors(err, goa.InvalidLengthError("body.topic", *body.Topic, utf8.RuneCountInString(*body.Topic), 100, false))
+			}
+		}
+		if body.Difficulty != nil {
+			if !(*body.Difficulty == "easy" || *body.Difficulty == "medium" || *body.Difficulty == "hard") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.difficulty", *body.Difficulty, []any{"easy", "medium", "hard"}))
+			}
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	var bankID int64
+	{
+		bankID, err = strconv.ParseInt(knowledgeAddBankQuestionBankID, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value for bankID, must be INT64")
+		}
+	}
+	var sessionToken string
+	{
+		sessionToken = knowledgeAddBankQuestionSessionToken
+	}
+	v := &knowledge.AddBankQuestionPayload{
+		QuestionText:  body.QuestionText,
+		NumericAnswer: body.NumericAnswer,
+		Tolerance:     body.Tolerance,
+		Topic:         body.Topic,
+	}
+	if body.QuestionType != nil {
+		v.QuestionType = knowledge.QuestionType(*body.QuestionType)
+	}
+	if body.Difficulty != nil {
+		v.Difficulty = knowledge.Difficulty(*body.Difficulty)
+	}
+	if body.QuestionType == nil {
+		v.QuestionType = "multiple_choice"
+	}
+	if body.Options != nil {
+		v.Options = make([]string, len(body.Options))
+		for i, val := range body.Options {
+			v.Options[i] = val
+		}
+	}
+	if body.CorrectOptions != nil {
+		v.CorrectOptions = make([]int, len(body.CorrectOptions))
+		for i, val := range body.CorrectOptions {
+			v.CorrectOptions[i] = val
+		}
+	}
+	{
+		var zero float64
+		if v.Tolerance == zero {
+			v.Tolerance = 0
+		}
+	}
+	if body.AcceptedAnswers != nil {
+		v.AcceptedAnswers = make([]string, len(body.AcceptedAnswers))
+		for i, val := range body.AcceptedAnswers {
+			v.AcceptedAnswers[i] = val
+		}
+	}
+	if body.Difficulty == nil {
+		v.Difficulty = "medium"
+	}
+	v.BankID = bankID
+	v.SessionToken = sessionToken
+
+	return v, nil
+}
+
+// BuildUpdateBankQuestionPayload builds the payload for the knowledge
+// UpdateBankQuestion endpoint from CLI flags.
+func BuildUpdateBankQuestionPayload(knowledgeUpdateBankQuestionBody string, knowledgeUpdateBankQuestionBankID string, knowledgeUpdateBankQuestionQuestionID string, knowledgeUpdateBankQuestionSessionToken string) (*knowledge.UpdateBankQuestionPayload, error) {
+	var err error
+	var body UpdateBankQuestionRequestBody
+	{
+		err = json.Unmarshal([]byte(knowledgeUpdateBankQuestionBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"accepted_answers\": [\n         \"Quidem fuga nulla dolore quisquam et.\",\n         \"Maxime dolor aliquid cupiditate.\"\n      ],\n      \"correct_options\": [\n         2516181537301509506,\n         3140617901535048514\n      ],\n      \"difficulty\": \"medium\",\n      \"numeric_answer\": 0.2597708022936953,\n      \"options\": [\n         \"Fuga placeat ipsa repellat pariatur corrupti enim.\",\n         \"Quis dolores dicta autem et facere.\",\n         \"Ea molestiae neque ipsum deserunt omnis quae.\",\n         \"Cumque blanditiis id totam rerum.\"\n      ],\n      \"question_text\": \"Fugiat dignissimos molestiae sit optio.\",\n      \"question_type\": \"multiple_choice\",\n      \"tolerance\": 0.04018062873893399,\n      \"topic\": \"eew\"\n   }'")
+		}
+		if body.QuestionType != nil {
+			if !(*body.QuestionType == "multiple_choice" || *body.QuestionType == "multiple_select" || *body.QuestionType == "true_false" || *body.QuestionType == "numeric" || *body.QuestionType == "short_text" || *body.QuestionType == "ordering") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.question_type", *body.QuestionType, []any{"multiple_choice", "multiple_select", "true_false", "numeric", "short_text", "ordering"}))
+			}
+		}
+		if body.Tolerance < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.tolerance", body.Tolerance, 0, true))
+		}
+		if body.Topic != nil {
+			if utf8.RuneCountInString(*body.Topic) > 100 {
+				err = goa.MergeErrors(err, goa.InvalidLengthError("body.topic", *body.Topic, utf8.RuneCountInString(*body.Topic), 100, false))
+			}
+		}
+		if body.Difficulty != nil {
+			if !(*body.Difficulty == "easy" || *body.Difficulty == "medium" || *body.Difficulty == "hard") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.difficulty", *body.Difficulty, []any{"easy", "medium", "hard"}))
+			}
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	var bankID int64
+	{
+		bankID, err = strconv.ParseInt(knowledgeUpdateBankQuestionBankID, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value for bankID, must be INT64")
+		}
+	}
+	var questionID int64
+	{
+		questionID, err = strconv.ParseInt(knowledgeUpdateBankQuestionQuestionID, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value for questionID, must be INT64")
+		}
+	}
+	var sessionToken string
+	{
+		sessionToken = knowledgeUpdateBankQuestionSessionToken
+	}
+	v := &knowledge.UpdateBankQuestionPayload{
+		QuestionText:  body.QuestionText,
+		NumericAnswer: body.NumericAnswer,
+		Tolerance:     body.Tolerance,
+		Topic:         body.Topic,
+	}
+	if body.QuestionType != nil {
+		v.QuestionType = knowledge.QuestionType(*body.QuestionType)
+	}
+	if body.Difficulty != nil {
+		v.Difficulty = knowledge.Difficulty(*body.Difficulty)
+	}
+	if body.QuestionType == nil {
+		v.QuestionType = "multiple_choice"
+	}
+	if body.Options != nil {
+		v.Options = make([]string, len(body.Options))
+		for i, val := range body.Options {
+			v.Options[i] = val
+		}
+	}
+	if body.CorrectOptions != nil {
+		v.CorrectOptions = make([]int, len(body.CorrectOptions))
+		for i, val := range body.CorrectOptions {
+			v.CorrectOptions[i] = val
+		}
+	}
+	{
+		var zero float64
+		if v.Tolerance == zero {
+			v.Tolerance = 0
+		}
+	}
+	if body.AcceptedAnswers != nil {
+		v.AcceptedAnswers = make([]string, len(body.AcceptedAnswers))
+		for i, val := range body.AcceptedAnswers {
+			v.AcceptedAnswers[i] = val
+		}
+	}
+	if body.Difficulty == nil {
+		v.Difficulty = "medium"
+	}
+	v.BankID = bankID
+	v.QuestionID = questionID
+	v.SessionToken = sessionToken
+
+	return v, nil
+}
+
+// BuildDeleteBankQuestionPayload builds the payload for the knowledge
+// DeleteBankQuestion endpoint from CLI flags.
+func BuildDeleteBankQuestionPayload(knowledgeDeleteBankQuestionBankID string, knowledgeDeleteBankQuestionQuestionID string, knowledgeDeleteBankQuestionSessionToken string) (*knowledge.DeleteBankQuestionPayload, error) {
+	var err error
+	var bankID int64
+	{
+		bankID, err = strconv.ParseInt(knowledgeDeleteBankQuestionBankID, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value for bankID, must be INT64")
+		}
+	}
+	var questionID int64
+	{
+		questionID, err = strconv.ParseInt(knowledgeDeleteBankQuestionQuestionID, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value for questionID, must be INT64")
+		}
+	}
+	var sessionToken string
+	{
+		sessionToken = knowledgeDeleteBankQuestionSessionToken
+	}
+	v := &knowledge.DeleteBankQuestionPayload{}
+	v.BankID = bankID
+	v.QuestionID = questionID
+	v.SessionToken = sessionToken
+
+	return v, nil
+}
+
+// BuildAddDrawRulePayload builds the payload for the knowledge AddDrawRule
+// endpoint from CLI flags.
+func BuildAddDrawRulePayload(knowledgeAddDrawRuleBody string, knowledgeAddDrawRuleTestID string, knowledgeAddDrawRuleSessionToken string) (*knowledge.AddDrawRulePayload, error) {
+	var err error
+	var body AddDrawRuleRequestBody
+	{
+		err = json.Unmarshal([]byte(knowledgeAddDrawRuleBody), &body)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"bank_id\": 818443890589725575,\n      \"difficulty\": \"medium\",\n      \"question_count\": 1651193286025122916,\n      \"topic\": \"ab3\"\n   }'")
+		}
+		if body.Topic != nil {
+			if utf8.RuneCountInString(*body.Topic) > 100 {
+				err = goa.MergeErrors(err, goa.InvalidLengthError("body.topic", *body.Topic, utf8.RuneCountInString(*body.Topic), 100, false))
+			}
+		}
+		if body.Difficulty != nil {
+			if !(*body.Difficulty == "easy" || *body.Difficulty == "medium" || *body.Difficulty == "hard") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.difficulty", *body.Difficulty, []any{"easy", "medium", "hard"}))
+			}
+		}
+		if body.QuestionCount < 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.question_count", body.QuestionCount, 1, true))
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	var testID int64
+	{
+		testID, err = strconv.ParseInt(knowledgeAddDrawRuleTestID, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value for testID, must be INT64")
+		}
+	}
+	var sessionToken string
+	{
+		sessionToken = knowledgeAddDrawRuleSessionToken
+	}
+	v := &knowledge.AddDrawRulePayload{
+		BankID:        body.BankID,
+		Topic:         body.Topic,
+		QuestionCount: body.QuestionCount,
+	}
+	if body.Difficulty != nil {
+		difficulty := knowledge.Difficulty(*body.Difficulty)
+		v.Difficulty = &difficulty
+	}
+	v.TestID = testID
+	v.SessionToken = sessionToken
+
+	return v, nil
+}
+
+// BuildGetDrawRulesPayload builds the payload for the knowledge GetDrawRules
+// endpoint from CLI flags.
+func BuildGetDrawRulesPayload(knowledgeGetDrawRulesTestID string, knowledgeGetDrawRulesSessionToken string) (*knowledge.GetDrawRulesPayload, error) {
+	var err error
+	var testID int64
+	{
+		testID, err = strconv.ParseInt(knowledgeGetDrawRulesTestID, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value for testID, must be INT64")
+		}
+	}
+	var sessionToken string
+	{
+		sessionToken = knowledgeGetDrawRulesSessionToken
+	}
+	v := &knowledge.GetDrawRulesPayload{}
+	v.TestID = testID
+	v.SessionToken = sessionToken
+
+	return v, nil
+}
+
+// BuildDeleteDrawRulePayload builds the payload for the knowledge
+// DeleteDrawRule endpoint from CLI flags.
+func BuildDeleteDrawRulePayload(knowledgeDeleteDrawRuleTestID string, knowledgeDeleteDrawRuleRuleID string, knowledgeDeleteDrawRuleSessionToken string) (*knowledge.DeleteDrawRulePayload, error) {
+	var err error
+	var testID int64
+	{
+		testID, err = strconv.ParseInt(knowledgeDeleteDrawRuleTestID, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value for testID, must be INT64")
+		}
+	}
+	var ruleID int64
+	{
+		ruleID, err = strconv.ParseInt(knowledgeDeleteDrawRuleRuleID, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value for ruleID, must be INT64")
+		}
+	}
+	var sessionToken string
+	{
+		sessionToken = knowledgeDeleteDrawRuleSessionToken
+	}
+	v := &knowledge.DeleteDrawRulePayload{}
+	v.TestID = testID
+	v.RuleID = ruleID
+	v.SessionToken = sessionToken
+
+	return v, nil
+}
+
+// BuildGetTestAnalyticsPayload builds the payload for the knowledge
+// GetTestAnalytics endpoint from CLI flags.
+func BuildGetTestAnalyticsPayload(knowledgeGetTestAnalyticsTestID string, knowledgeGetTestAnalyticsSessionToken string) (*knowledge.GetTestAnalyticsPayload, error) {
+	var err error
+	var testID int64
+	{
+		testID, err = strconv.ParseInt(knowledgeGetTestAnalyticsTestID, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value for testID, must be INT64")
+		}
+	}
+	var sessionToken string
+	{
+		sessionToken = knowledgeGetTestAnalyticsSessionToken
+	}
+	v := &knowledge.GetTestAnalyticsPayload{}
+	v.TestID = testID
+	v.SessionToken = sessionToken
+
+	return v, nil
+}
+
+// BuildGetTestGradebookPayload builds the payload for the knowledge
+// GetTestGradebook endpoint from CLI flags.
+func BuildGetTestGradebookPayload(knowledgeGetTestGradebookTestID string, knowledgeGetTestGradebookSortBy string, knowledgeGetTestGradebookSortOrder string, knowledgeGetTestGradebookStatus string, knowledgeGetTestGradebookStudent string, knowledgeGetTestGradebookSessionToken string) (*knowledge.GetTestGradebookPayload, error) {
+	var err error
+	var testID int64
+	{
+		testID, err = strconv.ParseInt(knowledgeGetTestGradebookTestID, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value for testID, must be INT64")
+		}
+	}
+	var sortBy string
+	{
+		if knowledgeGetTestGradebookSortBy != "" {
+			sortBy = knowledgeGetTestGradebookSortBy
+			if !(sortBy == "submitted_at" || sortBy == "score" || sortBy == "student_name") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("sort_by", sortBy, []any{"submitted_at", "score", "student_name"}))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var sortOrder string
+	{
+		if knowledgeGetTestGradebookSortOrder != "" {
+			sortOrder = knowledgeGetTestGradebookSortOrder
+			if !(sortOrder == "asc" || sortOrder == "desc") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("sort_order", sortOrder, []any{"asc", "desc"}))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var status *string
+	{
+		if knowledgeGetTestGradebookStatus != "" {
+			status = &knowledgeGetTestGradebookStatus
+			if !(*status == "in_progress" || *status == "submitted" || *status == "expired") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("status", *status, []any{"in_progress", "submitted", "expired"}))
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	var student *string
+	{
+		if knowledgeGetTestGradebookStudent != "" {
+			student = &knowledgeGetTestGradebookStudent
+		}
+	}
+	var sessionToken string
+	{
+		sessionToken = knowledgeGetTestGradebookSessionToken
+	}
+	v := &knowledge.GetTestGradebookPayload{}
+	v.TestID = testID
+	v.SortBy = sortBy
+	v.SortOrder = sortOrder
+	if status != nil {
+		tmpstatus := knowledge.AttemptStatus(*status)
+		v.Status = &tmpstatus
+	}
+	v.Student = student
+	v.SessionToken = sessionToken
+
+	return v, nil
+}
+
+// BuildGetStudentHistoryPayload builds the payload for the knowledge
+// GetStudentHistory endpoint from CLI flags.
+func BuildGetStudentHistoryPayload(knowledgeGetStudentHistoryUserID string, knowledgeGetStudentHistorySessionToken string) (*knowledge.GetStudentHistoryPayload, error) {
+	var err error
+	var userID int64
+	{
+		userID, err = strconv.ParseInt(knowledgeGetStudentHistoryUserID, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value for userID, must be INT64")
+		}
+	}
+	var sessionToken string
+	{
+		sessionToken = knowledgeGetStudentHistorySessionToken
+	}
+	v := &knowledge.GetStudentHistoryPayload{}
+	v.UserID = userID
+	v.SessionToken = sessionToken
+
+	return v, nil
+}
+
 // BuildGetAvailableTestsPayload builds the payload for the knowledge
 // GetAvailableTests endpoint from CLI flags.
 func BuildGetAvailableTestsPayload(knowledgeGetAvailableTestsSessionToken string) (*knowledge.GetAvailableTestsPayload, error) {
@@ -304,6 +1023,28 @@ func BuildGetAvailableTestsPayload(knowledgeGetAvailableTestsSessionToken string
 	return v, nil
 }
 
+// BuildStartTestPayload builds the payload for the knowledge StartTest
+// endpoint from CLI flags.
+func BuildStartTestPayload(knowledgeStartTestTestID string, knowledgeStartTestSessionToken string) (*knowledge.StartTestPayload, error) {
+	var err error
+	var testID int64
+	{
+		testID, err = strconv.ParseInt(knowledgeStartTestTestID, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value for testID, must be INT64")
+		}
+	}
+	var sessionToken string
+	{
+		sessionToken = knowledgeStartTestSessionToken
+	}
+	v := &knowledge.StartTestPayload{}
+	v.TestID = testID
+	v.SessionToken = sessionToken
+
+	return v, nil
+}
+
 // BuildGetTestFormPayload builds the payload for the knowledge GetTestForm
 // endpoint from CLI flags.
 func BuildGetTestFormPayload(knowledgeGetTestFormTestID string, knowledgeGetTestFormSessionToken string) (*knowledge.GetTestFormPayload, error) {
@@ -334,7 +1075,7 @@ func BuildSubmitTestPayload(knowledgeSubmitTestBody string, knowledgeSubmitTestT
 	{
 		err = json.Unmarshal([]byte(knowledgeSubmitTestBody), &body)
 		if err != nil {
-			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"answers\": [\n         {\n            \"question_id\": 4096248363570844082,\n            \"selected_answer\": 3700718689874649655\n         },\n         {\n            \"question_id\": 4096248363570844082,\n            \"selected_answer\": 3700718689874649655\n         },\n         {\n            \"question_id\": 4096248363570844082,\n            \"selected_answer\": 3700718689874649655\n         },\n         {\n            \"question_id\": 4096248363570844082,\n            \"selected_answer\": 3700718689874649655\n         }\n      ]\n   }'")
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"answers\": [\n         {\n            \"numeric_answer\": 0.08877186459628728,\n            \"question_id\": 2798399229346647812,\n            \"selected_options\": [\n               1740833882688747858,\n               3480373929497777977\n            ],\n            \"text_answer\": \"Ea mollitia numquam.\"\n         },\n         {\n            \"numeric_answer\": 0.08877186459628728,\n            \"question_id\": 2798399229346647812,\n            \"selected_options\": [\n               1740833882688747858,\n               3480373929497777977\n            ],\n            \"text_answer\": \"Ea mollitia numquam.\"\n         }\n      ]\n   }'")
 		}
 		if body.Answers == nil {
 			err = goa.MergeErrors(err, goa.MissingFieldError("answers", "body"))
@@ -59,10 +59,66 @@ type Client struct {
 	// DeleteQuestion endpoint.
 	DeleteQuestionDoer goahttp.Doer
 
+	// CreateQuestionBank Doer is the HTTP client used to make requests to the
+	// CreateQuestionBank endpoint.
+	CreateQuestionBankDoer goahttp.Doer
+
+	// GetMyQuestionBanks Doer is the HTTP client used to make requests to the
+	// GetMyQuestionBanks endpoint.
+	GetMyQuestionBanksDoer goahttp.Doer
+
+	// DeleteQuestionBank Doer is the HTTP client used to make requests to the
+	// DeleteQuestionBank endpoint.
+	DeleteQuestionBankDoer goahttp.Doer
+
+	// GetBankQuestions Doer is the HTTP client used to make requests to the
+	// GetBankQuestions endpoint.
+	GetBankQuestionsDoer goahttp.Doer
+
+	// AddBankQuestion Doer is the HTTP client used to make requests to the
+	// AddBankQuestion endpoint.
+	AddBankQuestionDoer goahttp.Doer
+
+	// UpdateBankQuestion Doer is the HTTP client used to make requests to the
+	// UpdateBankQuestion endpoint.
+	UpdateBankQuestionDoer goahttp.Doer
+
+	// DeleteBankQuestion Doer is the HTTP client used to make requests to the
+	// DeleteBankQuestion endpoint.
+	DeleteBankQuestionDoer goahttp.Doer
+
+	// AddDrawRule Doer is the HTTP client used to make requests to the AddDrawRule
+	// endpoint.
+	AddDrawRuleDoer goahttp.Doer
+
+	// GetDrawRules Doer is the HTTP client used to make requests to the
+	// GetDrawRules endpoint.
+	GetDrawRulesDoer goahttp.Doer
+
+	// DeleteDrawRule Doer is the HTTP client used to make requests to the
+	// DeleteDrawRule endpoint.
+	DeleteDrawRuleDoer goahttp.Doer
+
+	// GetTestAnalytics Doer is the HTTP client used to make requests to the
+	// GetTestAnalytics endpoint.
+	GetTestAnalyticsDoer goahttp.Doer
+
+	// GetTestGradebook Doer is the HTTP client used to make requests to the
+	// GetTestGradebook endpoint.
+	GetTestGradebookDoer goahttp.Doer
+
+	// GetStudentHistory Doer is the HTTP client used to make requests to the
+	// GetStudentHistory endpoint.
+	GetStudentHistoryDoer goahttp.Doer
+
 	// GetAvailableTests Doer is the HTTP client used to make requests to the
 	// GetAvailableTests endpoint.
 	GetAvailableTestsDoer goahttp.Doer
 
+	// StartTest Doer is the HTTP client used to make requests to the StartTest
+	// endpoint.
+	StartTestDoer goahttp.Doer
+
 	// GetTestForm Doer is the HTTP client used to make requests to the GetTestForm
 	// endpoint.
 	GetTestFormDoer goahttp.Doer
@@ -113,7 +169,21 @@ func NewClient(
 		GetQuestionByIDDoer:     doer,
 		UpdateQuestionDoer:      doer,
 		DeleteQuestionDoer:      doer,
+		CreateQuestionBankDoer:  doer,
+		GetMyQuestionBanksDoer:  doer,
+		DeleteQuestionBankDoer:  doer,
+		GetBankQuestionsDoer:    doer,
+		AddBankQuestionDoer:     doer,
+		UpdateBankQuestionDoer:  doer,
+		DeleteBankQuestionDoer:  doer,
+		AddDrawRuleDoer:         doer,
+		GetDrawRulesDoer:        doer,
+		DeleteDrawRuleDoer:      doer,
+		GetTestAnalyticsDoer:    doer,
+		GetTestGradebookDoer:    doer,
+		GetStudentHistoryDoer:   doer,
 		GetAvailableTestsDoer:   doer,
+		StartTestDoer:           doer,
 		GetTestFormDoer:         doer,
 		SubmitTestDoer:          doer,
 		GetMySubmissionsDoer:    doer,
@@ -367,6 +437,318 @@ func (c *Client) DeleteQuestion() goa.Endpoint {
 	}
 }
 
+// CreateQuestionBank returns an endpoint that makes HTTP requests to the
+// knowledge service CreateQuestionBank server.
+func (c *Client) CreateQuestionBank() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeCreateQuestionBankRequest(c.encoder)
+		decodeResponse = DecodeCreateQuestionBankResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildCreateQuestionBankRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.CreateQuestionBankDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("knowledge", "CreateQuestionBank", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// GetMyQuestionBanks returns an endpoint that makes HTTP requests to the
+// knowledge service GetMyQuestionBanks server.
+func (c *Client) GetMyQuestionBanks() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeGetMyQuestionBanksRequest(c.encoder)
+		decodeResponse = DecodeGetMyQuestionBanksResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildGetMyQuestionBanksRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.GetMyQuestionBanksDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("knowledge", "GetMyQuestionBanks", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// DeleteQuestionBank returns an endpoint that makes HTTP requests to the
+// knowledge service DeleteQuestionBank server.
+func (c *Client) DeleteQuestionBank() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeDeleteQuestionBankRequest(c.encoder)
+		decodeResponse = DecodeDeleteQuestionBankResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildDeleteQuestionBankRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.DeleteQuestionBankDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("knowledge", "DeleteQuestionBank", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// GetBankQuestions returns an endpoint that makes HTTP requests to the
+// knowledge service GetBankQuestions server.
+func (c *Client) GetBankQuestions() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeGetBankQuestionsRequest(c.encoder)
+		decodeResponse = DecodeGetBankQuestionsResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildGetBankQuestionsRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.GetBankQuestionsDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("knowledge", "GetBankQuestions", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// AddBankQuestion returns an endpoint that makes HTTP requests to the
+// knowledge service AddBankQuestion server.
+func (c *Client) AddBankQuestion() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeAddBankQuestionRequest(c.encoder)
+		decodeResponse = DecodeAddBankQuestionResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildAddBankQuestionRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.AddBankQuestionDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("knowledge", "AddBankQuestion", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// UpdateBankQuestion returns an endpoint that makes HTTP requests to the
+// knowledge service UpdateBankQuestion server.
+func (c *Client) UpdateBankQuestion() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeUpdateBankQuestionRequest(c.encoder)
+		decodeResponse = DecodeUpdateBankQuestionResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildUpdateBankQuestionRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.UpdateBankQuestionDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("knowledge", "UpdateBankQuestion", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// DeleteBankQuestion returns an endpoint that makes HTTP requests to the
+// knowledge service DeleteBankQuestion server.
+func (c *Client) DeleteBankQuestion() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeDeleteBankQuestionRequest(c.encoder)
+		decodeResponse = DecodeDeleteBankQuestionResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildDeleteBankQuestionRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.DeleteBankQuestionDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("knowledge", "DeleteBankQuestion", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// AddDrawRule returns an endpoint that makes HTTP requests to the knowledge
+// service AddDrawRule server.
+func (c *Client) AddDrawRule() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeAddDrawRuleRequest(c.encoder)
+		decodeResponse = DecodeAddDrawRuleResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildAddDrawRuleRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.AddDrawRuleDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("knowledge", "AddDrawRule", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// GetDrawRules returns an endpoint that makes HTTP requests to the knowledge
+// service GetDrawRules server.
+func (c *Client) GetDrawRules() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeGetDrawRulesRequest(c.encoder)
+		decodeResponse = DecodeGetDrawRulesResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildGetDrawRulesRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.GetDrawRulesDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("knowledge", "GetDrawRules", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// DeleteDrawRule returns an endpoint that makes HTTP requests to the knowledge
+// service DeleteDrawRule server.
+func (c *Client) DeleteDrawRule() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeDeleteDrawRuleRequest(c.encoder)
+		decodeResponse = DecodeDeleteDrawRuleResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildDeleteDrawRuleRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.DeleteDrawRuleDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("knowledge", "DeleteDrawRule", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// GetTestAnalytics returns an endpoint that makes HTTP requests to the
+// knowledge service GetTestAnalytics server.
+func (c *Client) GetTestAnalytics() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeGetTestAnalyticsRequest(c.encoder)
+		decodeResponse = DecodeGetTestAnalyticsResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildGetTestAnalyticsRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.GetTestAnalyticsDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("knowledge", "GetTestAnalytics", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// GetTestGradebook returns an endpoint that makes HTTP requests to the
+// knowledge service GetTestGradebook server.
+func (c *Client) GetTestGradebook() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeGetTestGradebookRequest(c.encoder)
+		decodeResponse = DecodeGetTestGradebookResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildGetTestGradebookRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.GetTestGradebookDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("knowledge", "GetTestGradebook", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
+// GetStudentHistory returns an endpoint that makes HTTP requests to the
+// knowledge service GetStudentHistory server.
+func (c *Client) GetStudentHistory() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeGetStudentHistoryRequest(c.encoder)
+		decodeResponse = DecodeGetStudentHistoryResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildGetStudentHistoryRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.GetStudentHistoryDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("knowledge", "GetStudentHistory", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
 // GetAvailableTests returns an endpoint that makes HTTP requests to the
 // knowledge service GetAvailableTests server.
 func (c *Client) GetAvailableTests() goa.Endpoint {
@@ -391,6 +773,30 @@ func (c *Client) GetAvailableTests() goa.Endpoint {
 	}
 }
 
+// StartTest returns an endpoint that makes HTTP requests to the knowledge
+// service StartTest server.
+func (c *Client) StartTest() goa.Endpoint {
+	var (
+		encodeRequest  = EncodeStartTestRequest(c.encoder)
+		decodeResponse = DecodeStartTestResponse(c.decoder, c.RestoreResponseBody)
+	)
+	return func(ctx context.Context, v any) (any, error) {
+		req, err := c.BuildStartTestRequest(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		err = encodeRequest(req, v)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := c.StartTestDoer.Do(req)
+		if err != nil {
+			return nil, goahttp.ErrRequestError("knowledge", "StartTest", err)
+		}
+		return decodeResponse(resp)
+	}
+}
+
 // GetTestForm returns an endpoint that makes HTTP requests to the knowledge
 // service GetTestForm server.
 func (c *Client) GetTestForm() goa.Endpoint {
@@ -950,6 +950,7 @@ func EncodeUpdateQuestionRequest(encoder func(*http.Request) goahttp.Encoder) fu
 // knowledge UpdateQuestion endpoint. restoreBody controls whether the response
 // body should be restored after having been read.
 // DecodeUpdateQuestionResponse may return the following errors:
+//   - "invalid_input" (type knowledge.InvalidInput): http.StatusBadRequest
 //   - "question_not_found" (type knowledge.QuestionNotFound): http.StatusNotFound
 //   - "test_not_found" (type knowledge.TestNotFound): http.StatusNotFound
 //   - "unauthorized" (type knowledge.Unauthorized): http.StatusUnauthorized
@@ -984,6 +985,16 @@ func DecodeUpdateQuestionResponse(decoder func(*http.Response) goahttp.Decoder,
 			}
 			res := NewUpdateQuestionSimpleResponseOK(&body)
 			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("knowledge", "UpdateQuestion", err)
+			}
+			return nil, NewUpdateQuestionInvalidInput(body)
 		case http.StatusNotFound:
 			en := resp.Header.Get("goa-error")
 			switch en {
@@ -1146,23 +1157,1618 @@ func DecodeDeleteQuestionResponse(decoder func(*http.Response) goahttp.Decoder,
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("knowledge", "DeleteQuestion", err)
+				return nil, goahttp.ErrDecodingError("knowledge", "DeleteQuestion", err)
+			}
+			return nil, NewDeleteQuestionUnauthorized(body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("knowledge", "DeleteQuestion", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildCreateQuestionBankRequest instantiates a HTTP request object with
+// method and path set to call the "knowledge" service "CreateQuestionBank"
+// endpoint
+func (c *Client) BuildCreateQuestionBankRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: CreateQuestionBankKnowledgePath()}
+	req, err := http.NewRequest("POST", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("knowledge", "CreateQuestionBank", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeCreateQuestionBankRequest returns an encoder for requests sent to the
+// knowledge CreateQuestionBank server.
+func EncodeCreateQuestionBankRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*knowledge.CreateQuestionBankPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("knowledge", "CreateQuestionBank", "*knowledge.CreateQuestionBankPayload", v)
+		}
+		{
+			v := p.SessionToken
+			req.AddCookie(&http.Cookie{
+				Name:  "session",
+				Value: v,
+			})
+		}
+		body := NewCreateQuestionBankRequestBody(p)
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("knowledge", "CreateQuestionBank", err)
+		}
+		return nil
+	}
+}
+
+// DecodeCreateQuestionBankResponse returns a decoder for responses returned by
+// the knowledge CreateQuestionBank endpoint. restoreBody controls whether the
+// response body should be restored after having been read.
+// DecodeCreateQuestionBankResponse may return the following errors:
+//   - "invalid_input" (type knowledge.InvalidInput): http.StatusBadRequest
+//   - "unauthorized" (type knowledge.Unauthorized): http.StatusUnauthorized
+//   - error: internal error
+func DecodeCreateQuestionBankResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusCreated:
+			var (
+				body CreateQuestionBankResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("knowledge", "CreateQuestionBank", err)
+			}
+			err = ValidateCreateQuestionBankResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("knowledge", "CreateQuestionBank", err)
+			}
+			res := NewCreateQuestionBankSimpleResponseCreated(&body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("knowledge", "CreateQuestionBank", err)
+			}
+			return nil, NewCreateQuestionBankInvalidInput(body)
+		case http.StatusUnauthorized:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("knowledge", "CreateQuestionBank", err)
+			}
+			return nil, NewCreateQuestionBankUnauthorized(body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("knowledge", "CreateQuestionBank", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildGetMyQuestionBanksRequest instantiates a HTTP request object with
+// method and path set to call the "knowledge" service "GetMyQuestionBanks"
+// endpoint
+func (c *Client) BuildGetMyQuestionBanksRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: GetMyQuestionBanksKnowledgePath()}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("knowledge", "GetMyQuestionBanks", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeGetMyQuestionBanksRequest returns an encoder for requests sent to the
+// knowledge GetMyQuestionBanks server.
+func EncodeGetMyQuestionBanksRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*knowledge.GetMyQuestionBanksPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("knowledge", "GetMyQuestionBanks", "*knowledge.GetMyQuestionBanksPayload", v)
+		}
+		{
+			v := p.SessionToken
+			req.AddCookie(&http.Cookie{
+				Name:  "session",
+				Value: v,
+			})
+		}
+		return nil
+	}
+}
+
+// DecodeGetMyQuestionBanksResponse returns a decoder for responses returned by
+// the knowledge GetMyQuestionBanks endpoint. restoreBody controls whether the
+// response body should be restored after having been read.
+// DecodeGetMyQuestionBanksResponse may return the following errors:
+//   - "unauthorized" (type knowledge.Unauthorized): http.StatusUnauthorized
+//   - error: internal error
+func DecodeGetMyQuestionBanksResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body GetMyQuestionBanksResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("knowledge", "GetMyQuestionBanks", err)
+			}
+			err = ValidateGetMyQuestionBanksResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("knowledge", "GetMyQuestionBanks", err)
+			}
+			res := NewGetMyQuestionBanksQuestionBanksResponseOK(&body)
+			return res, nil
+		case http.StatusUnauthorized:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("knowledge", "GetMyQuestionBanks", err)
+			}
+			return nil, NewGetMyQuestionBanksUnauthorized(body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("knowledge", "GetMyQuestionBanks", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildDeleteQuestionBankRequest instantiates a HTTP request object with
+// method and path set to call the "knowledge" service "DeleteQuestionBank"
+// endpoint
+func (c *Client) BuildDeleteQuestionBankRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		bankID int64
+	)
+	{
+		p, ok := v.(*knowledge.DeleteQuestionBankPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("knowledge", "DeleteQuestionBank", "*knowledge.DeleteQuestionBankPayload", v)
+		}
+		bankID = p.BankID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: DeleteQuestionBankKnowledgePath(bankID)}
+	req, err := http.NewRequest("DELETE", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("knowledge", "DeleteQuestionBank", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeDeleteQuestionBankRequest returns an encoder for requests sent to the
+// knowledge DeleteQuestionBank server.
+func EncodeDeleteQuestionBankRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*knowledge.DeleteQuestionBankPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("knowledge", "DeleteQuestionBank", "*knowledge.DeleteQuestionBankPayload", v)
+		}
+		{
+			v := p.SessionToken
+			req.AddCookie(&http.Cookie{
+				Name:  "session",
+				Value: v,
+			})
+		}
+		return nil
+	}
+}
+
+// DecodeDeleteQuestionBankResponse returns a decoder for responses returned by
+// the knowledge DeleteQuestionBank endpoint. restoreBody controls whether the
+// response body should be restored after having been read.
+// DecodeDeleteQuestionBankResponse may return the following errors:
+//   - "invalid_input" (type knowledge.InvalidInput): http.StatusBadRequest
+//   - "unauthorized" (type knowledge.Unauthorized): http.StatusUnauthorized
+//   - error: internal error
+func DecodeDeleteQuestionBankResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body DeleteQuestionBankResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("knowledge", "DeleteQuestionBank", err)
+			}
+			err = ValidateDeleteQuestionBankResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("knowledge", "DeleteQuestionBank", err)
+			}
+			res := NewDeleteQuestionBankSimpleResponseOK(&body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("knowledge", "DeleteQuestionBank", err)
+			}
+			return nil, NewDeleteQuestionBankInvalidInput(body)
+		case http.StatusUnauthorized:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("knowledge", "DeleteQuestionBank", err)
+			}
+			return nil, NewDeleteQuestionBankUnauthorized(body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("knowledge", "DeleteQuestionBank", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildGetBankQuestionsRequest instantiates a HTTP request object with method
+// and path set to call the "knowledge" service "GetBankQuestions" endpoint
+func (c *Client) BuildGetBankQuestionsRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		bankID int64
+	)
+	{
+		p, ok := v.(*knowledge.GetBankQuestionsPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("knowledge", "GetBankQuestions", "*knowledge.GetBankQuestionsPayload", v)
+		}
+		bankID = p.BankID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: GetBankQuestionsKnowledgePath(bankID)}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("knowledge", "GetBankQuestions", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeGetBankQuestionsRequest returns an encoder for requests sent to the
+// knowledge GetBankQuestions server.
+func EncodeGetBankQuestionsRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*knowledge.GetBankQuestionsPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("knowledge", "GetBankQuestions", "*knowledge.GetBankQuestionsPayload", v)
+		}
+		{
+			v := p.SessionToken
+			req.AddCookie(&http.Cookie{
+				Name:  "session",
+				Value: v,
+			})
+		}
+		return nil
+	}
+}
+
+// DecodeGetBankQuestionsResponse returns a decoder for responses returned by
+// the knowledge GetBankQuestions endpoint. restoreBody controls whether the
+// response body should be restored after having been read.
+// DecodeGetBankQuestionsResponse may return the following errors:
+//   - "invalid_input" (type knowledge.InvalidInput): http.StatusBadRequest
+//   - "unauthorized" (type knowledge.Unauthorized): http.StatusUnauthorized
+//   - error: internal error
+func DecodeGetBankQuestionsResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body GetBankQuestionsResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("knowledge", "GetBankQuestions", err)
+			}
+			err = ValidateGetBankQuestionsResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("knowledge", "GetBankQuestions", err)
+			}
+			res := NewGetBankQuestionsBankQuestionsResponseOK(&body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("knowledge", "GetBankQuestions", err)
+			}
+			return nil, NewGetBankQuestionsInvalidInput(body)
+		case http.StatusUnauthorized:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("knowledge", "GetBankQuestions", err)
+			}
+			return nil, NewGetBankQuestionsUnauthorized(body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("knowledge", "GetBankQuestions", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildAddBankQuestionRequest instantiates a HTTP request object with method
+// and path set to call the "knowledge" service "AddBankQuestion" endpoint
+func (c *Client) BuildAddBankQuestionRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		bankID int64
+	)
+	{
+		p, ok := v.(*knowledge.AddBankQuestionPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("knowledge", "AddBankQuestion", "*knowledge.AddBankQuestionPayload", v)
+		}
+		bankID = p.BankID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: AddBankQuestionKnowledgePath(bankID)}
+	req, err := http.NewRequest("POST", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("knowledge", "AddBankQuestion", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeAddBankQuestionRequest returns an encoder for requests sent to the
+// knowledge AddBankQuestion server.
+func EncodeAddBankQuestionRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*knowledge.AddBankQuestionPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("knowledge", "AddBankQuestion", "*knowledge.AddBankQuestionPayload", v)
+		}
+		{
+			v := p.SessionToken
+			req.AddCookie(&http.Cookie{
+				Name:  "session",
+				Value: v,
+			})
+		}
+		body := NewAddBankQuestionRequestBody(p)
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("knowledge", "AddBankQuestion", err)
+		}
+		return nil
+	}
+}
+
+// DecodeAddBankQuestionResponse returns a decoder for responses returned by
+// the knowledge AddBankQuestion endpoint. restoreBody controls whether the
+// response body should be restored after having been read.
+// DecodeAddBankQuestionResponse may return the following errors:
+//   - "invalid_input" (type knowledge.InvalidInput): http.StatusBadRequest
+//   - "unauthorized" (type knowledge.Unauthorized): http.StatusUnauthorized
+//   - error: internal error
+func DecodeAddBankQuestionResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusCreated:
+			var (
+				body AddBankQuestionResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("knowledge", "AddBankQuestion", err)
+			}
+			err = ValidateAddBankQuestionResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("knowledge", "AddBankQuestion", err)
+			}
+			res := NewAddBankQuestionSimpleResponseCreated(&body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("knowledge", "AddBankQuestion", err)
+			}
+			return nil, NewAddBankQuestionInvalidInput(body)
+		case http.StatusUnauthorized:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("knowledge", "AddBankQuestion", err)
+			}
+			return nil, NewAddBankQuestionUnauthorized(body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("knowledge", "AddBankQuestion", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildUpdateBankQuestionRequest instantiates a HTTP request object with
+// method and path set to call the "knowledge" service "UpdateBankQuestion"
+// endpoint
+func (c *Client) BuildUpdateBankQuestionRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		bankID     int64
+		questionID int64
+	)
+	{
+		p, ok := v.(*knowledge.UpdateBankQuestionPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("knowledge", "UpdateBankQuestion", "*knowledge.UpdateBankQuestionPayload", v)
+		}
+		bankID = p.BankID
+		questionID = p.QuestionID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: UpdateBankQuestionKnowledgePath(bankID, questionID)}
+	req, err := http.NewRequest("PUT", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("knowledge", "UpdateBankQuestion", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeUpdateBankQuestionRequest returns an encoder for requests sent to the
+// knowledge UpdateBankQuestion server.
+func EncodeUpdateBankQuestionRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*knowledge.UpdateBankQuestionPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("knowledge", "UpdateBankQuestion", "*knowledge.UpdateBankQuestionPayload", v)
+		}
+		{
+			v := p.SessionToken
+			req.AddCookie(&http.Cookie{
+				Name:  "session",
+				Value: v,
+			})
+		}
+		body := NewUpdateBankQuestionRequestBody(p)
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("knowledge", "UpdateBankQuestion", err)
+		}
+		return nil
+	}
+}
+
+// DecodeUpdateBankQuestionResponse returns a decoder for responses returned by
+// the knowledge UpdateBankQuestion endpoint. restoreBody controls whether the
+// response body should be restored after having been read.
+// DecodeUpdateBankQuestionResponse may return the following errors:
+//   - "invalid_input" (type knowledge.InvalidInput): http.StatusBadRequest
+//   - "question_not_found" (type knowledge.QuestionNotFound): http.StatusNotFound
+//   - "unauthorized" (type knowledge.Unauthorized): http.StatusUnauthorized
+//   - error: internal error
+func DecodeUpdateBankQuestionResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body UpdateBankQuestionResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("knowledge", "UpdateBankQuestion", err)
+			}
+			err = ValidateUpdateBankQuestionResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("knowledge", "UpdateBankQuestion", err)
+			}
+			res := NewUpdateBankQuestionSimpleResponseOK(&body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("knowledge", "UpdateBankQuestion", err)
+			}
+			return nil, NewUpdateBankQuestionInvalidInput(body)
+		case http.StatusNotFound:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("knowledge", "UpdateBankQuestion", err)
+			}
+			return nil, NewUpdateBankQuestionQuestionNotFound(body)
+		case http.StatusUnauthorized:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("knowledge", "UpdateBankQuestion", err)
+			}
+			return nil, NewUpdateBankQuestionUnauthorized(body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("knowledge", "UpdateBankQuestion", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildDeleteBankQuestionRequest instantiates a HTTP request object with
+// method and path set to call the "knowledge" service "DeleteBankQuestion"
+// endpoint
+func (c *Client) BuildDeleteBankQuestionRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		bankID     int64
+		questionID int64
+	)
+	{
+		p, ok := v.(*knowledge.DeleteBankQuestionPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("knowledge", "DeleteBankQuestion", "*knowledge.DeleteBankQuestionPayload", v)
+		}
+		bankID = p.BankID
+		questionID = p.QuestionID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: DeleteBankQuestionKnowledgePath(bankID, questionID)}
+	req, err := http.NewRequest("DELETE", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("knowledge", "DeleteBankQuestion", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeDeleteBankQuestionRequest returns an encoder for requests sent to the
+// knowledge DeleteBankQuestion server.
+func EncodeDeleteBankQuestionRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*knowledge.DeleteBankQuestionPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("knowledge", "DeleteBankQuestion", "*knowledge.DeleteBankQuestionPayload", v)
+		}
+		{
+			v := p.SessionToken
+			req.AddCookie(&http.Cookie{
+				Name:  "session",
+				Value: v,
+			})
+		}
+		return nil
+	}
+}
+
+// DecodeDeleteBankQuestionResponse returns a decoder for responses returned by
+// the knowledge DeleteBankQuestion endpoint. restoreBody controls whether the
+// response body should be restored after having been read.
+// DecodeDeleteBankQuestionResponse may return the following errors:
+//   - "invalid_input" (type knowledge.InvalidInput): http.StatusBadRequest
+//   - "question_not_found" (type knowledge.QuestionNotFound): http.StatusNotFound
+//   - "unauthorized" (type knowledge.Unauthorized): http.StatusUnauthorized
+//   - error: internal error
+func DecodeDeleteBankQuestionResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body DeleteBankQuestionResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("knowledge", "DeleteBankQuestion", err)
+			}
+			err = ValidateDeleteBankQuestionResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("knowledge", "DeleteBankQuestion", err)
+			}
+			res := NewDeleteBankQuestionSimpleResponseOK(&body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("knowledge", "DeleteBankQuestion", err)
+			}
+			return nil, NewDeleteBankQuestionInvalidInput(body)
+		case http.StatusNotFound:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("knowledge", "DeleteBankQuestion", err)
+			}
+			return nil, NewDeleteBankQuestionQuestionNotFound(body)
+		case http.StatusUnauthorized:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("knowledge", "DeleteBankQuestion", err)
+			}
+			return nil, NewDeleteBankQuestionUnauthorized(body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("knowledge", "DeleteBankQuestion", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildAddDrawRuleRequest instantiates a HTTP request object with method and
+// path set to call the "knowledge" service "AddDrawRule" endpoint
+func (c *Client) BuildAddDrawRuleRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		testID int64
+	)
+	{
+		p, ok := v.(*knowledge.AddDrawRulePayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("knowledge", "AddDrawRule", "*knowledge.AddDrawRulePayload", v)
+		}
+		testID = p.TestID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: AddDrawRuleKnowledgePath(testID)}
+	req, err := http.NewRequest("POST", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("knowledge", "AddDrawRule", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeAddDrawRuleRequest returns an encoder for requests sent to the
+// knowledge AddDrawRule server.
+func EncodeAddDrawRuleRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*knowledge.AddDrawRulePayload)
+		if !ok {
+			return goahttp.ErrInvalidType("knowledge", "AddDrawRule", "*knowledge.AddDrawRulePayload", v)
+		}
+		{
+			v := p.SessionToken
+			req.AddCookie(&http.Cookie{
+				Name:  "session",
+				Value: v,
+			})
+		}
+		body := NewAddDrawRuleRequestBody(p)
+		if err := encoder(req).Encode(&body); err != nil {
+			return goahttp.ErrEncodingError("knowledge", "AddDrawRule", err)
+		}
+		return nil
+	}
+}
+
+// DecodeAddDrawRuleResponse returns a decoder for responses returned by the
+// knowledge AddDrawRule endpoint. restoreBody controls whether the response
+// body should be restored after having been read.
+// DecodeAddDrawRuleResponse may return the following errors:
+//   - "invalid_input" (type knowledge.InvalidInput): http.StatusBadRequest
+//   - "test_not_found" (type knowledge.TestNotFound): http.StatusNotFound
+//   - "unauthorized" (type knowledge.Unauthorized): http.StatusUnauthorized
+//   - error: internal error
+func DecodeAddDrawRuleResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusCreated:
+			var (
+				body AddDrawRuleResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("knowledge", "AddDrawRule", err)
+			}
+			err = ValidateAddDrawRuleResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("knowledge", "AddDrawRule", err)
+			}
+			res := NewAddDrawRuleSimpleResponseCreated(&body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("knowledge", "AddDrawRule", err)
+			}
+			return nil, NewAddDrawRuleInvalidInput(body)
+		case http.StatusNotFound:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("knowledge", "AddDrawRule", err)
+			}
+			return nil, NewAddDrawRuleTestNotFound(body)
+		case http.StatusUnauthorized:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("knowledge", "AddDrawRule", err)
+			}
+			return nil, NewAddDrawRuleUnauthorized(body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("knowledge", "AddDrawRule", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildGetDrawRulesRequest instantiates a HTTP request object with method and
+// path set to call the "knowledge" service "GetDrawRules" endpoint
+func (c *Client) BuildGetDrawRulesRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		testID int64
+	)
+	{
+		p, ok := v.(*knowledge.GetDrawRulesPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("knowledge", "GetDrawRules", "*knowledge.GetDrawRulesPayload", v)
+		}
+		testID = p.TestID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: GetDrawRulesKnowledgePath(testID)}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("knowledge", "GetDrawRules", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeGetDrawRulesRequest returns an encoder for requests sent to the
+// knowledge GetDrawRules server.
+func EncodeGetDrawRulesRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*knowledge.GetDrawRulesPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("knowledge", "GetDrawRules", "*knowledge.GetDrawRulesPayload", v)
+		}
+		{
+			v := p.SessionToken
+			req.AddCookie(&http.Cookie{
+				Name:  "session",
+				Value: v,
+			})
+		}
+		return nil
+	}
+}
+
+// DecodeGetDrawRulesResponse returns a decoder for responses returned by the
+// knowledge GetDrawRules endpoint. restoreBody controls whether the response
+// body should be restored after having been read.
+// DecodeGetDrawRulesResponse may return the following errors:
+//   - "test_not_found" (type knowledge.TestNotFound): http.StatusNotFound
+//   - "unauthorized" (type knowledge.Unauthorized): http.StatusUnauthorized
+//   - error: internal error
+func DecodeGetDrawRulesResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body GetDrawRulesResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("knowledge", "GetDrawRules", err)
+			}
+			err = ValidateGetDrawRulesResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("knowledge", "GetDrawRules", err)
+			}
+			res := NewGetDrawRulesDrawRulesResponseOK(&body)
+			return res, nil
+		case http.StatusNotFound:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("knowledge", "GetDrawRules", err)
+			}
+			return nil, NewGetDrawRulesTestNotFound(body)
+		case http.StatusUnauthorized:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("knowledge", "GetDrawRules", err)
+			}
+			return nil, NewGetDrawRulesUnauthorized(body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("knowledge", "GetDrawRules", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildDeleteDrawRuleRequest instantiates a HTTP request object with method
+// and path set to call the "knowledge" service "DeleteDrawRule" endpoint
+func (c *Client) BuildDeleteDrawRuleRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		testID int64
+		ruleID int64
+	)
+	{
+		p, ok := v.(*knowledge.DeleteDrawRulePayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("knowledge", "DeleteDrawRule", "*knowledge.DeleteDrawRulePayload", v)
+		}
+		testID = p.TestID
+		ruleID = p.RuleID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: DeleteDrawRuleKnowledgePath(testID, ruleID)}
+	req, err := http.NewRequest("DELETE", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("knowledge", "DeleteDrawRule", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeDeleteDrawRuleRequest returns an encoder for requests sent to the
+// knowledge DeleteDrawRule server.
+func EncodeDeleteDrawRuleRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*knowledge.DeleteDrawRulePayload)
+		if !ok {
+			return goahttp.ErrInvalidType("knowledge", "DeleteDrawRule", "*knowledge.DeleteDrawRulePayload", v)
+		}
+		{
+			v := p.SessionToken
+			req.AddCookie(&http.Cookie{
+				Name:  "session",
+				Value: v,
+			})
+		}
+		return nil
+	}
+}
+
+// DecodeDeleteDrawRuleResponse returns a decoder for responses returned by the
+// knowledge DeleteDrawRule endpoint. restoreBody controls whether the response
+// body should be restored after having been read.
+// DecodeDeleteDrawRuleResponse may return the following errors:
+//   - "invalid_input" (type knowledge.InvalidInput): http.StatusBadRequest
+//   - "test_not_found" (type knowledge.TestNotFound): http.StatusNotFound
+//   - "unauthorized" (type knowledge.Unauthorized): http.StatusUnauthorized
+//   - error: internal error
+func DecodeDeleteDrawRuleResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body DeleteDrawRuleResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("knowledge", "DeleteDrawRule", err)
+			}
+			err = ValidateDeleteDrawRuleResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("knowledge", "DeleteDrawRule", err)
+			}
+			res := NewDeleteDrawRuleSimpleResponseOK(&body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("knowledge", "DeleteDrawRule", err)
+			}
+			return nil, NewDeleteDrawRuleInvalidInput(body)
+		case http.StatusNotFound:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("knowledge", "DeleteDrawRule", err)
+			}
+			return nil, NewDeleteDrawRuleTestNotFound(body)
+		case http.StatusUnauthorized:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("knowledge", "DeleteDrawRule", err)
+			}
+			return nil, NewDeleteDrawRuleUnauthorized(body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("knowledge", "DeleteDrawRule", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildGetTestAnalyticsRequest instantiates a HTTP request object with method
+// and path set to call the "knowledge" service "GetTestAnalytics" endpoint
+func (c *Client) BuildGetTestAnalyticsRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		testID int64
+	)
+	{
+		p, ok := v.(*knowledge.GetTestAnalyticsPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("knowledge", "GetTestAnalytics", "*knowledge.GetTestAnalyticsPayload", v)
+		}
+		testID = p.TestID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: GetTestAnalyticsKnowledgePath(testID)}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("knowledge", "GetTestAnalytics", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeGetTestAnalyticsRequest returns an encoder for requests sent to the
+// knowledge GetTestAnalytics server.
+func EncodeGetTestAnalyticsRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*knowledge.GetTestAnalyticsPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("knowledge", "GetTestAnalytics", "*knowledge.GetTestAnalyticsPayload", v)
+		}
+		{
+			v := p.SessionToken
+			req.AddCookie(&http.Cookie{
+				Name:  "session",
+				Value: v,
+			})
+		}
+		return nil
+	}
+}
+
+// DecodeGetTestAnalyticsResponse returns a decoder for responses returned by
+// the knowledge GetTestAnalytics endpoint. restoreBody controls whether the
+// response body should be restored after having been read.
+// DecodeGetTestAnalyticsResponse may return the following errors:
+//   - "internal_error" (type knowledge.InternalError): http.StatusInternalServerError
+//   - "invalid_input" (type knowledge.InvalidInput): http.StatusBadRequest
+//   - "test_not_found" (type knowledge.TestNotFound): http.StatusNotFound
+//   - "unauthorized" (type knowledge.Unauthorized): http.StatusUnauthorized
+//   - error: internal error
+func DecodeGetTestAnalyticsResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body GetTestAnalyticsResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("knowledge", "GetTestAnalytics", err)
+			}
+			err = ValidateGetTestAnalyticsResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("knowledge", "GetTestAnalytics", err)
+			}
+			res := NewGetTestAnalyticsTestAnalyticsOK(&body)
+			return res, nil
+		case http.StatusInternalServerError:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("knowledge", "GetTestAnalytics", err)
+			}
+			return nil, NewGetTestAnalyticsInternalError(body)
+		case http.StatusBadRequest:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("knowledge", "GetTestAnalytics", err)
+			}
+			return nil, NewGetTestAnalyticsInvalidInput(body)
+		case http.StatusNotFound:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("knowledge", "GetTestAnalytics", err)
+			}
+			return nil, NewGetTestAnalyticsTestNotFound(body)
+		case http.StatusUnauthorized:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("knowledge", "GetTestAnalytics", err)
+			}
+			return nil, NewGetTestAnalyticsUnauthorized(body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("knowledge", "GetTestAnalytics", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildGetTestGradebookRequest instantiates a HTTP request object with method
+// and path set to call the "knowledge" service "GetTestGradebook" endpoint
+func (c *Client) BuildGetTestGradebookRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		testID int64
+	)
+	{
+		p, ok := v.(*knowledge.GetTestGradebookPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("knowledge", "GetTestGradebook", "*knowledge.GetTestGradebookPayload", v)
+		}
+		testID = p.TestID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: GetTestGradebookKnowledgePath(testID)}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("knowledge", "GetTestGradebook", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeGetTestGradebookRequest returns an encoder for requests sent to the
+// knowledge GetTestGradebook server.
+func EncodeGetTestGradebookRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*knowledge.GetTestGradebookPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("knowledge", "GetTestGradebook", "*knowledge.GetTestGradebookPayload", v)
+		}
+		{
+			v := p.SessionToken
+			req.AddCookie(&http.Cookie{
+				Name:  "session",
+				Value: v,
+			})
+		}
+		values := req.URL.Query()
+		values.Add("sort_by", p.SortBy)
+		values.Add("sort_order", p.SortOrder)
+		if p.Status != nil {
+			values.Add("status", string(*p.Status))
+		}
+		if p.Student != nil {
+			values.Add("student", *p.Student)
+		}
+		req.URL.RawQuery = values.Encode()
+		return nil
+	}
+}
+
+// DecodeGetTestGradebookResponse returns a decoder for responses returned by
+// the knowledge GetTestGradebook endpoint. restoreBody controls whether the
+// response body should be restored after having been read.
+// DecodeGetTestGradebookResponse may return the following errors:
+//   - "invalid_input" (type knowledge.InvalidInput): http.StatusBadRequest
+//   - "test_not_found" (type knowledge.TestNotFound): http.StatusNotFound
+//   - "unauthorized" (type knowledge.Unauthorized): http.StatusUnauthorized
+//   - error: internal error
+func DecodeGetTestGradebookResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body GetTestGradebookResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("knowledge", "GetTestGradebook", err)
+			}
+			err = ValidateGetTestGradebookResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("knowledge", "GetTestGradebook", err)
+			}
+			res := NewGetTestGradebookTestGradebookOK(&body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("knowledge", "GetTestGradebook", err)
+			}
+			return nil, NewGetTestGradebookInvalidInput(body)
+		case http.StatusNotFound:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("knowledge", "GetTestGradebook", err)
+			}
+			return nil, NewGetTestGradebookTestNotFound(body)
+		case http.StatusUnauthorized:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("knowledge", "GetTestGradebook", err)
+			}
+			return nil, NewGetTestGradebookUnauthorized(body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("knowledge", "GetTestGradebook", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildGetStudentHistoryRequest instantiates a HTTP request object with method
+// and path set to call the "knowledge" service "GetStudentHistory" endpoint
+func (c *Client) BuildGetStudentHistoryRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		userID int64
+	)
+	{
+		p, ok := v.(*knowledge.GetStudentHistoryPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("knowledge", "GetStudentHistory", "*knowledge.GetStudentHistoryPayload", v)
+		}
+		userID = p.UserID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: GetStudentHistoryKnowledgePath(userID)}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("knowledge", "GetStudentHistory", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeGetStudentHistoryRequest returns an encoder for requests sent to the
+// knowledge GetStudentHistory server.
+func EncodeGetStudentHistoryRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*knowledge.GetStudentHistoryPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("knowledge", "GetStudentHistory", "*knowledge.GetStudentHistoryPayload", v)
+		}
+		{
+			v := p.SessionToken
+			req.AddCookie(&http.Cookie{
+				Name:  "session",
+				Value: v,
+			})
+		}
+		return nil
+	}
+}
+
+// DecodeGetStudentHistoryResponse returns a decoder for responses returned by
+// the knowledge GetStudentHistory endpoint. restoreBody controls whether the
+// response body should be restored after having been read.
+// DecodeGetStudentHistoryResponse may return the following errors:
+//   - "invalid_input" (type knowledge.InvalidInput): http.StatusBadRequest
+//   - "submission_not_found" (type knowledge.SubmissionNotFound): http.StatusNotFound
+//   - "unauthorized" (type knowledge.Unauthorized): http.StatusUnauthorized
+//   - error: internal error
+func DecodeGetStudentHistoryResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body GetStudentHistoryResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("knowledge", "GetStudentHistory", err)
+			}
+			err = ValidateGetStudentHistoryResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("knowledge", "GetStudentHistory", err)
+			}
+			res := NewGetStudentHistoryStudentHistoryOK(&body)
+			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("knowledge", "GetStudentHistory", err)
+			}
+			return nil, NewGetStudentHistoryInvalidInput(body)
+		case http.StatusNotFound:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("knowledge", "GetStudentHistory", err)
+			}
+			return nil, NewGetStudentHistorySubmissionNotFound(body)
+		case http.StatusUnauthorized:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("knowledge", "GetStudentHistory", err)
+			}
+			return nil, NewGetStudentHistoryUnauthorized(body)
+		default:
+			body, _ := io.ReadAll(resp.Body)
+			return nil, goahttp.ErrInvalidResponse("knowledge", "GetStudentHistory", resp.StatusCode, string(body))
+		}
+	}
+}
+
+// BuildGetAvailableTestsRequest instantiates a HTTP request object with method
+// and path set to call the "knowledge" service "GetAvailableTests" endpoint
+func (c *Client) BuildGetAvailableTestsRequest(ctx context.Context, v any) (*http.Request, error) {
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: GetAvailableTestsKnowledgePath()}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, goahttp.ErrInvalidURL("knowledge", "GetAvailableTests", u.String(), err)
+	}
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	return req, nil
+}
+
+// EncodeGetAvailableTestsRequest returns an encoder for requests sent to the
+// knowledge GetAvailableTests server.
+func EncodeGetAvailableTestsRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+	return func(req *http.Request, v any) error {
+		p, ok := v.(*knowledge.GetAvailableTestsPayload)
+		if !ok {
+			return goahttp.ErrInvalidType("knowledge", "GetAvailableTests", "*knowledge.GetAvailableTestsPayload", v)
+		}
+		{
+			v := p.SessionToken
+			req.AddCookie(&http.Cookie{
+				Name:  "session",
+				Value: v,
+			})
+		}
+		return nil
+	}
+}
+
+// DecodeGetAvailableTestsResponse returns a decoder for responses returned by
+// the knowledge GetAvailableTests endpoint. restoreBody controls whether the
+// response body should be restored after having been read.
+// DecodeGetAvailableTestsResponse may return the following errors:
+//   - "unauthorized" (type knowledge.Unauthorized): http.StatusUnauthorized
+//   - error: internal error
+func DecodeGetAvailableTestsResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+	return func(resp *http.Response) (any, error) {
+		if restoreBody {
+			b, err := io.ReadAll(resp.Body)
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			defer func() {
+				resp.Body = io.NopCloser(bytes.NewBuffer(b))
+			}()
+		} else {
+			defer resp.Body.Close()
+		}
+		switch resp.StatusCode {
+		case http.StatusOK:
+			var (
+				body GetAvailableTestsResponseBody
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("knowledge", "GetAvailableTests", err)
+			}
+			err = ValidateGetAvailableTestsResponseBody(&body)
+			if err != nil {
+				return nil, goahttp.ErrValidationError("knowledge", "GetAvailableTests", err)
+			}
+			res := NewGetAvailableTestsTestsResponseOK(&body)
+			return res, nil
+		case http.StatusUnauthorized:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("knowledge", "GetAvailableTests", err)
 			}
-			return nil, NewDeleteQuestionUnauthorized(body)
+			return nil, NewGetAvailableTestsUnauthorized(body)
 		default:
 			body, _ := io.ReadAll(resp.Body)
-			return nil, goahttp.ErrInvalidResponse("knowledge", "DeleteQuestion", resp.StatusCode, string(body))
+			return nil, goahttp.ErrInvalidResponse("knowledge", "GetAvailableTests", resp.StatusCode, string(body))
 		}
 	}
 }
 
-// BuildGetAvailableTestsRequest instantiates a HTTP request object with method
-// and path set to call the "knowledge" service "GetAvailableTests" endpoint
-func (c *Client) BuildGetAvailableTestsRequest(ctx context.Context, v any) (*http.Request, error) {
-	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: GetAvailableTestsKnowledgePath()}
-	req, err := http.NewRequest("GET", u.String(), nil)
+// BuildStartTestRequest instantiates a HTTP request object with method and
+// path set to call the "knowledge" service "StartTest" endpoint
+func (c *Client) BuildStartTestRequest(ctx context.Context, v any) (*http.Request, error) {
+	var (
+		testID int64
+	)
+	{
+		p, ok := v.(*knowledge.StartTestPayload)
+		if !ok {
+			return nil, goahttp.ErrInvalidType("knowledge", "StartTest", "*knowledge.StartTestPayload", v)
+		}
+		testID = p.TestID
+	}
+	u := &url.URL{Scheme: c.scheme, Host: c.host, Path: StartTestKnowledgePath(testID)}
+	req, err := http.NewRequest("POST", u.String(), nil)
 	if err != nil {
-		return nil, goahttp.ErrInvalidURL("knowledge", "GetAvailableTests", u.String(), err)
+		return nil, goahttp.ErrInvalidURL("knowledge", "StartTest", u.String(), err)
 	}
 	if ctx != nil {
 		req = req.WithContext(ctx)
@@ -1171,13 +2777,13 @@ func (c *Client) BuildGetAvailableTestsRequest(ctx context.Context, v any) (*htt
 	return req, nil
 }
 
-// EncodeGetAvailableTestsRequest returns an encoder for requests sent to the
-// knowledge GetAvailableTests server.
-func EncodeGetAvailableTestsRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
+// EncodeStartTestRequest returns an encoder for requests sent to the knowledge
+// StartTest server.
+func EncodeStartTestRequest(encoder func(*http.Request) goahttp.Encoder) func(*http.Request, any) error {
 	return func(req *http.Request, v any) error {
-		p, ok := v.(*knowledge.GetAvailableTestsPayload)
+		p, ok := v.(*knowledge.StartTestPayload)
 		if !ok {
-			return goahttp.ErrInvalidType("knowledge", "GetAvailableTests", "*knowledge.GetAvailableTestsPayload", v)
+			return goahttp.ErrInvalidType("knowledge", "StartTest", "*knowledge.StartTestPayload", v)
 		}
 		{
 			v := p.SessionToken
@@ -1190,13 +2796,18 @@ func EncodeGetAvailableTestsRequest(encoder func(*http.Request) goahttp.Encoder)
 	}
 }
 
-// DecodeGetAvailableTestsResponse returns a decoder for responses returned by
-// the knowledge GetAvailableTests endpoint. restoreBody controls whether the
-// response body should be restored after having been read.
-// DecodeGetAvailableTestsResponse may return the following errors:
+// DecodeStartTestResponse returns a decoder for responses returned by the
+// knowledge StartTest endpoint. restoreBody controls whether the response body
+// should be restored after having been read.
+// DecodeStartTestResponse may return the following errors:
+//   - "internal_error" (type knowledge.InternalError): http.StatusInternalServerError
+//   - "invalid_input" (type knowledge.InvalidInput): http.StatusBadRequest
+//   - "test_already_submitted" (type knowledge.TestAlreadySubmitted): http.StatusConflict
+//   - "time_expired" (type knowledge.TimeExpired): http.StatusConflict
+//   - "test_not_found" (type knowledge.TestNotFound): http.StatusNotFound
 //   - "unauthorized" (type knowledge.Unauthorized): http.StatusUnauthorized
 //   - error: internal error
-func DecodeGetAvailableTestsResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
+func DecodeStartTestResponse(decoder func(*http.Response) goahttp.Decoder, restoreBody bool) func(*http.Response) (any, error) {
 	return func(resp *http.Response) (any, error) {
 		if restoreBody {
 			b, err := io.ReadAll(resp.Body)
@@ -1213,19 +2824,76 @@ func DecodeGetAvailableTestsResponse(decoder func(*http.Response) goahttp.Decode
 		switch resp.StatusCode {
 		case http.StatusOK:
 			var (
-				body GetAvailableTestsResponseBody
+				body StartTestResponseBody
 				err  error
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("knowledge", "GetAvailableTests", err)
+				return nil, goahttp.ErrDecodingError("knowledge", "StartTest", err)
 			}
-			err = ValidateGetAvailableTestsResponseBody(&body)
+			err = ValidateStartTestResponseBody(&body)
 			if err != nil {
-				return nil, goahttp.ErrValidationError("knowledge", "GetAvailableTests", err)
+				return nil, goahttp.ErrValidationError("knowledge", "StartTest", err)
 			}
-			res := NewGetAvailableTestsTestsResponseOK(&body)
+			res := NewStartTestTestAttemptOK(&body)
 			return res, nil
+		case http.StatusInternalServerError:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("knowledge", "StartTest", err)
+			}
+			return nil, NewStartTestInternalError(body)
+		case http.StatusBadRequest:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("knowledge", "StartTest", err)
+			}
+			return nil, NewStartTestInvalidInput(body)
+		case http.StatusConflict:
+			en := resp.Header.Get("goa-error")
+			switch en {
+			case "test_already_submitted":
+				var (
+					body string
+					err  error
+				)
+				err = decoder(resp).Decode(&body)
+				if err != nil {
+					return nil, goahttp.ErrDecodingError("knowledge", "StartTest", err)
+				}
+				return nil, NewStartTestTestAlreadySubmitted(body)
+			case "time_expired":
+				var (
+					body string
+					err  error
+				)
+				err = decoder(resp).Decode(&body)
+				if err != nil {
+					return nil, goahttp.ErrDecodingError("knowledge", "StartTest", err)
+				}
+				return nil, NewStartTestTimeExpired(body)
+			default:
+				body, _ := io.ReadAll(resp.Body)
+				return nil, goahttp.ErrInvalidResponse("knowledge", "StartTest", resp.StatusCode, string(body))
+			}
+		case http.StatusNotFound:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("knowledge", "StartTest", err)
+			}
+			return nil, NewStartTestTestNotFound(body)
 		case http.StatusUnauthorized:
 			var (
 				body string
@@ -1233,12 +2901,12 @@ func DecodeGetAvailableTestsResponse(decoder func(*http.Response) goahttp.Decode
 			)
 			err = decoder(resp).Decode(&body)
 			if err != nil {
-				return nil, goahttp.ErrDecodingError("knowledge", "GetAvailableTests", err)
+				return nil, goahttp.ErrDecodingError("knowledge", "StartTest", err)
 			}
-			return nil, NewGetAvailableTestsUnauthorized(body)
+			return nil, NewStartTestUnauthorized(body)
 		default:
 			body, _ := io.ReadAll(resp.Body)
-			return nil, goahttp.ErrInvalidResponse("knowledge", "GetAvailableTests", resp.StatusCode, string(body))
+			return nil, goahttp.ErrInvalidResponse("knowledge", "StartTest", resp.StatusCode, string(body))
 		}
 	}
 }
@@ -1291,7 +2959,10 @@ func EncodeGetTestFormRequest(encoder func(*http.Request) goahttp.Encoder) func(
 // knowledge GetTestForm endpoint. restoreBody controls whether the response
 // body should be restored after having been read.
 // DecodeGetTestFormResponse may return the following errors:
+//   - "internal_error" (type knowledge.InternalError): http.StatusInternalServerError
+//   - "invalid_input" (type knowledge.InvalidInput): http.StatusBadRequest
 //   - "test_already_submitted" (type knowledge.TestAlreadySubmitted): http.StatusConflict
+//   - "time_expired" (type knowledge.TimeExpired): http.StatusConflict
 //   - "test_not_found" (type knowledge.TestNotFound): http.StatusNotFound
 //   - "unauthorized" (type knowledge.Unauthorized): http.StatusUnauthorized
 //   - error: internal error
@@ -1325,7 +2996,17 @@ func DecodeGetTestFormResponse(decoder func(*http.Response) goahttp.Decoder, res
 			}
 			res := NewGetTestFormFormResponseOK(&body)
 			return res, nil
-		case http.StatusConflict:
+		case http.StatusInternalServerError:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("knowledge", "GetTestForm", err)
+			}
+			return nil, NewGetTestFormInternalError(body)
+		case http.StatusBadRequest:
 			var (
 				body string
 				err  error
@@ -1334,7 +3015,34 @@ func DecodeGetTestFormResponse(decoder func(*http.Response) goahttp.Decoder, res
 			if err != nil {
 				return nil, goahttp.ErrDecodingError("knowledge", "GetTestForm", err)
 			}
-			return nil, NewGetTestFormTestAlreadySubmitted(body)
+			return nil, NewGetTestFormInvalidInput(body)
+		case http.StatusConflict:
+			en := resp.Header.Get("goa-error")
+			switch en {
+			case "test_already_submitted":
+				var (
+					body string
+					err  error
+				)
+				err = decoder(resp).Decode(&body)
+				if err != nil {
+					return nil, goahttp.ErrDecodingError("knowledge", "GetTestForm", err)
+				}
+				return nil, NewGetTestFormTestAlreadySubmitted(body)
+			case "time_expired":
+				var (
+					body string
+					err  error
+				)
+				err = decoder(resp).Decode(&body)
+				if err != nil {
+					return nil, goahttp.ErrDecodingError("knowledge", "GetTestForm", err)
+				}
+				return nil, NewGetTestFormTimeExpired(body)
+			default:
+				body, _ := io.ReadAll(resp.Body)
+				return nil, goahttp.ErrInvalidResponse("knowledge", "GetTestForm", resp.StatusCode, string(body))
+			}
 		case http.StatusNotFound:
 			var (
 				body string
@@ -1414,8 +3122,10 @@ func EncodeSubmitTestRequest(encoder func(*http.Request) goahttp.Encoder) func(*
 // knowledge SubmitTest endpoint. restoreBody controls whether the response
 // body should be restored after having been read.
 // DecodeSubmitTestResponse may return the following errors:
+//   - "internal_error" (type knowledge.InternalError): http.StatusInternalServerError
 //   - "invalid_input" (type knowledge.InvalidInput): http.StatusBadRequest
 //   - "test_already_submitted" (type knowledge.TestAlreadySubmitted): http.StatusConflict
+//   - "time_expired" (type knowledge.TimeExpired): http.StatusConflict
 //   - "test_not_found" (type knowledge.TestNotFound): http.StatusNotFound
 //   - "unauthorized" (type knowledge.Unauthorized): http.StatusUnauthorized
 //   - error: internal error
@@ -1449,7 +3159,7 @@ func DecodeSubmitTestResponse(decoder func(*http.Response) goahttp.Decoder, rest
 			}
 			res := NewSubmitTestSubmitResponseOK(&body)
 			return res, nil
-		case http.StatusBadRequest:
+		case http.StatusInternalServerError:
 			var (
 				body string
 				err  error
@@ -1458,8 +3168,8 @@ func DecodeSubmitTestResponse(decoder func(*http.Response) goahttp.Decoder, rest
 			if err != nil {
 				return nil, goahttp.ErrDecodingError("knowledge", "SubmitTest", err)
 			}
-			return nil, NewSubmitTestInvalidInput(body)
-		case http.StatusConflict:
+			return nil, NewSubmitTestInternalError(body)
+		case http.StatusBadRequest:
 			var (
 				body string
 				err  error
@@ -1468,7 +3178,34 @@ func DecodeSubmitTestResponse(decoder func(*http.Response) goahttp.Decoder, rest
 			if err != nil {
 				return nil, goahttp.ErrDecodingError("knowledge", "SubmitTest", err)
 			}
-			return nil, NewSubmitTestTestAlreadySubmitted(body)
+			return nil, NewSubmitTestInvalidInput(body)
+		case http.StatusConflict:
+			en := resp.Header.Get("goa-error")
+			switch en {
+			case "test_already_submitted":
+				var (
+					body string
+					err  error
+				)
+				err = decoder(resp).Decode(&body)
+				if err != nil {
+					return nil, goahttp.ErrDecodingError("knowledge", "SubmitTest", err)
+				}
+				return nil, NewSubmitTestTestAlreadySubmitted(body)
+			case "time_expired":
+				var (
+					body string
+					err  error
+				)
+				err = decoder(resp).Decode(&body)
+				if err != nil {
+					return nil, goahttp.ErrDecodingError("knowledge", "SubmitTest", err)
+				}
+				return nil, NewSubmitTestTimeExpired(body)
+			default:
+				body, _ := io.ReadAll(resp.Body)
+				return nil, goahttp.ErrInvalidResponse("knowledge", "SubmitTest", resp.StatusCode, string(body))
+			}
 		case http.StatusNotFound:
 			var (
 				body string
@@ -1740,6 +3477,7 @@ func EncodeGetSubmissionResultRequest(encoder func(*http.Request) goahttp.Encode
 // by the knowledge GetSubmissionResult endpoint. restoreBody controls whether
 // the response body should be restored after having been read.
 // DecodeGetSubmissionResultResponse may return the following errors:
+//   - "invalid_input" (type knowledge.InvalidInput): http.StatusBadRequest
 //   - "submission_not_found" (type knowledge.SubmissionNotFound): http.StatusNotFound
 //   - "unauthorized" (type knowledge.Unauthorized): http.StatusUnauthorized
 //   - error: internal error
@@ -1773,6 +3511,16 @@ func DecodeGetSubmissionResultResponse(decoder func(*http.Response) goahttp.Deco
 			}
 			res := NewGetSubmissionResultSubmissionResultOK(&body)
 			return res, nil
+		case http.StatusBadRequest:
+			var (
+				body string
+				err  error
+			)
+			err = decoder(resp).Decode(&body)
+			if err != nil {
+				return nil, goahttp.ErrDecodingError("knowledge", "GetSubmissionResult", err)
+			}
+			return nil, NewGetSubmissionResultInvalidInput(body)
 		case http.StatusNotFound:
 			var (
 				body string
@@ -1804,11 +3552,18 @@ func DecodeGetSubmissionResultResponse(decoder func(*http.Response) goahttp.Deco
 // *knowledge.Test from a value of type *TestResponseBody.
 func unmarshalTestResponseBodyToKnowledgeTest(v *TestResponseBody) *knowledge.Test {
 	res := &knowledge.Test{
-		ID:            *v.ID,
-		Title:         *v.Title,
-		CreatedBy:     *v.CreatedBy,
-		CreatedAt:     *v.CreatedAt,
-		QuestionCount: v.QuestionCount,
+		ID:               *v.ID,
+		Title:            *v.Title,
+		CreatedBy:        *v.CreatedBy,
+		CreatedAt:        *v.CreatedAt,
+		QuestionCount:    v.QuestionCount,
+		DurationMinutes:  v.DurationMinutes,
+		MaxAttempts:      *v.MaxAttempts,
+		CooldownMinutes:  *v.CooldownMinutes,
+		ScorePolicy:      knowledge.ScorePolicy(*v.ScorePolicy),
+		RevealAnswers:    *v.RevealAnswers,
+		ShuffleQuestions: *v.ShuffleQuestions,
+		ShuffleOptions:   *v.ShuffleOptions,
 	}
 
 	return res
@@ -1821,12 +3576,175 @@ func unmarshalQuestionResponseBodyToKnowledgeQuestion(v *QuestionResponseBody) *
 		ID:            *v.ID,
 		TestID:        *v.TestID,
 		QuestionText:  *v.QuestionText,
-		OptionA:       *v.OptionA,
-		OptionB:       *v.OptionB,
-		OptionC:       *v.OptionC,
-		OptionD:       *v.OptionD,
-		CorrectAnswer: *v.CorrectAnswer,
+		QuestionType:  knowledge.QuestionType(*v.QuestionType),
+		NumericAnswer: v.NumericAnswer,
+		Tolerance:     *v.Tolerance,
+		QuestionOrder: *v.QuestionOrder,
+	}
+	res.Options = make([]string, len(v.Options))
+	for i, val := range v.Options {
+		res.Options[i] = val
+	}
+	res.CorrectOptions = make([]int, len(v.CorrectOptions))
+	for i, val := range v.CorrectOptions {
+		res.CorrectOptions[i] = val
+	}
+	res.AcceptedAnswers = make([]string, len(v.AcceptedAnswers))
+	for i, val := range v.AcceptedAnswers {
+		res.AcceptedAnswers[i] = val
+	}
+
+	return res
+}
+
+// unmarshalQuestionBankResponseBodyToKnowledgeQuestionBank builds a value of
+// type *knowledge.QuestionBank from a value of type *QuestionBankResponseBody.
+func unmarshalQuestionBankResponseBodyToKnowledgeQuestionBank(v *QuestionBankResponseBody) *knowledge.QuestionBank {
+	res := &knowledge.QuestionBank{
+		ID:            *v.ID,
+		Title:         *v.Title,
+		CreatedBy:     *v.CreatedBy,
+		CreatedAt:     *v.CreatedAt,
+		QuestionCount: v.QuestionCount,
+	}
+
+	return res
+}
+
+// unmarshalBankQuestionResponseBodyToKnowledgeBankQuestion builds a value of
+// type *knowledge.BankQuestion from a value of type *BankQuestionResponseBody.
+func unmarshalBankQuestionResponseBodyToKnowledgeBankQuestion(v *BankQuestionResponseBody) *knowledge.BankQuestion {
+	res := &knowledge.BankQuestion{
+		ID:            *v.ID,
+		BankID:        *v.BankID,
+		QuestionText:  *v.QuestionText,
+		QuestionType:  knowledge.QuestionType(*v.QuestionType),
+		NumericAnswer: v.NumericAnswer,
+		Tolerance:     *v.Tolerance,
 		QuestionOrder: *v.QuestionOrder,
+		Topic:         *v.Topic,
+		Difficulty:    knowledge.Difficulty(*v.Difficulty),
+	}
+	res.Options = make([]string, len(v.Options))
+	for i, val := range v.Options {
+		res.Options[i] = val
+	}
+	res.CorrectOptions = make([]int, len(v.CorrectOptions))
+	for i, val := range v.CorrectOptions {
+		res.CorrectOptions[i] = val
+	}
+	res.AcceptedAnswers = make([]string, len(v.AcceptedAnswers))
+	for i, val := range v.AcceptedAnswers {
+		res.AcceptedAnswers[i] = val
+	}
+
+	return res
+}
+
+// unmarshalDrawRuleResponseBodyToKnowledgeDrawRule builds a value of type
+// *knowledge.DrawRule from a value of type *DrawRuleResponseBody.
+func unmarshalDrawRuleResponseBodyToKnowledgeDrawRule(v *DrawRuleResponseBody) *knowledge.DrawRule {
+	res := &knowledge.DrawRule{
+		ID:            *v.ID,
+		TestID:        *v.TestID,
+		BankID:        *v.BankID,
+		Topic:         v.Topic,
+		QuestionCount: *v.QuestionCount,
+	}
+	if v.Difficulty != nil {
+		difficulty := knowledge.Difficulty(*v.Difficulty)
+		res.Difficulty = &difficulty
+	}
+
+	return res
+}
+
+// unmarshalScoreBinResponseBodyToKnowledgeScoreBin builds a value of type
+// *knowledge.ScoreBin from a value of type *ScoreBinResponseBody.
+func unmarshalScoreBinResponseBodyToKnowledgeScoreBin(v *ScoreBinResponseBody) *knowledge.ScoreBin {
+	res := &knowledge.ScoreBin{
+		From:  *v.From,
+		To:    *v.To,
+		Count: *v.Count,
+	}
+
+	return res
+}
+
+// unmarshalQuestionAnalyticsResponseBodyToKnowledgeQuestionAnalytics builds a
+// value of type *knowledge.QuestionAnalytics from a value of type
+// *QuestionAnalyticsResponseBody.
+func unmarshalQuestionAnalyticsResponseBodyToKnowledgeQuestionAnalytics(v *QuestionAnalyticsResponseBody) *knowledge.QuestionAnalytics {
+	res := &knowledge.QuestionAnalytics{
+		QuestionID:          *v.QuestionID,
+		QuestionText:        *v.QuestionText,
+		QuestionType:        knowledge.QuestionType(*v.QuestionType),
+		Attempts:            *v.Attempts,
+		DifficultyIndex:     *v.DifficultyIndex,
+		DiscriminationIndex: v.DiscriminationIndex,
+		Unanswered:          *v.Unanswered,
+	}
+	res.OptionSelections = make([]*knowledge.OptionSelection, len(v.OptionSelections))
+	for i, val := range v.OptionSelections {
+		res.OptionSelections[i] = unmarshalOptionSelectionResponseBodyToKnowledgeOptionSelection(val)
+	}
+
+	return res
+}
+
+// unmarshalOptionSelectionResponseBodyToKnowledgeOptionSelection builds a
+// value of type *knowledge.OptionSelection from a value of type
+// *OptionSelectionResponseBody.
+func unmarshalOptionSelectionResponseBodyToKnowledgeOptionSelection(v *OptionSelectionResponseBody) *knowledge.OptionSelection {
+	res := &knowledge.OptionSelection{
+		Index:     *v.Index,
+		Option:    *v.Option,
+		Count:     *v.Count,
+		IsCorrect: *v.IsCorrect,
+	}
+
+	return res
+}
+
+// unmarshalStudentSubmissionResponseBodyToKnowledgeStudentSubmission builds a
+// value of type *knowledge.StudentSubmission from a value of type
+// *StudentSubmissionResponseBody.
+func unmarshalStudentSubmissionResponseBodyToKnowledgeStudentSubmission(v *StudentSubmissionResponseBody) *knowledge.StudentSubmission {
+	res := &knowledge.StudentSubmission{
+		UserID:      *v.UserID,
+		StudentName: *v.StudentName,
+	}
+	res.Submission = unmarshalSubmissionResponseBodyToKnowledgeSubmission(v.Submission)
+
+	return res
+}
+
+// unmarshalSubmissionResponseBodyToKnowledgeSubmission builds a value of type
+// *knowledge.Submission from a value of type *SubmissionResponseBody.
+func unmarshalSubmissionResponseBodyToKnowledgeSubmission(v *SubmissionResponseBody) *knowledge.Submission {
+	res := &knowledge.Submission{
+		ID:            *v.ID,
+		TestID:        *v.TestID,
+		TestTitle:     *v.TestTitle,
+		Score:         *v.Score,
+		SubmittedAt:   *v.SubmittedAt,
+		Status:        knowledge.AttemptStatus(*v.Status),
+		StartedAt:     *v.StartedAt,
+		AttemptNumber: *v.AttemptNumber,
+	}
+
+	return res
+}
+
+// unmarshalTestScoreResponseBodyToKnowledgeTestScore builds a value of type
+// *knowledge.TestScore from a value of type *TestScoreResponseBody.
+func unmarshalTestScoreResponseBodyToKnowledgeTestScore(v *TestScoreResponseBody) *knowledge.TestScore {
+	res := &knowledge.TestScore{
+		TestID:      *v.TestID,
+		TestTitle:   *v.TestTitle,
+		ScorePolicy: knowledge.ScorePolicy(*v.ScorePolicy),
+		Attempts:    *v.Attempts,
+		Score:       *v.Score,
 	}
 
 	return res
@@ -1838,12 +3756,13 @@ func unmarshalQuestionFormResponseBodyToKnowledgeQuestionForm(v *QuestionFormRes
 	res := &knowledge.QuestionForm{
 		ID:            *v.ID,
 		QuestionText:  *v.QuestionText,
-		OptionA:       *v.OptionA,
-		OptionB:       *v.OptionB,
-		OptionC:       *v.OptionC,
-		OptionD:       *v.OptionD,
+		QuestionType:  knowledge.QuestionType(*v.QuestionType),
 		QuestionOrder: *v.QuestionOrder,
 	}
+	res.Options = make([]string, len(v.Options))
+	for i, val := range v.Options {
+		res.Options[i] = val
+	}
 
 	return res
 }
@@ -1852,8 +3771,15 @@ func unmarshalQuestionFormResponseBodyToKnowledgeQuestionForm(v *QuestionFormRes
 // *AnswerRequestBody from a value of type *knowledge.Answer.
 func marshalKnowledgeAnswerToAnswerRequestBody(v *knowledge.Answer) *AnswerRequestBody {
 	res := &AnswerRequestBody{
-		QuestionID:     v.QuestionID,
-		SelectedAnswer: v.SelectedAnswer,
+		QuestionID:    v.QuestionID,
+		NumericAnswer: v.NumericAnswer,
+		TextAnswer:    v.TextAnswer,
+	}
+	if v.SelectedOptions != nil {
+		res.SelectedOptions = make([]int, len(v.SelectedOptions))
+		for i, val := range v.SelectedOptions {
+			res.SelectedOptions[i] = val
+		}
 	}
 
 	return res
@@ -1863,22 +3789,15 @@ func marshalKnowledgeAnswerToAnswerRequestBody(v *knowledge.Answer) *AnswerReque
 // *knowledge.Answer from a value of type *AnswerRequestBody.
 func marshalAnswerRequestBodyToKnowledgeAnswer(v *AnswerRequestBody) *knowledge.Answer {
 	res := &knowledge.Answer{
-		QuestionID:     v.QuestionID,
-		SelectedAnswer: v.SelectedAnswer,
+		QuestionID:    v.QuestionID,
+		NumericAnswer: v.NumericAnswer,
+		TextAnswer:    v.TextAnswer,
 	}
-
-	return res
-}
-
-// unmarshalSubmissionResponseBodyToKnowledgeSubmission builds a value of type
-// *knowledge.Submission from a value of type *SubmissionResponseBody.
-func unmarshalSubmissionResponseBodyToKnowledgeSubmission(v *SubmissionResponseBody) *knowledge.Submission {
-	res := &knowledge.Submission{
-		ID:          *v.ID,
-		TestID:      *v.TestID,
-		TestTitle:   *v.TestTitle,
-		Score:       *v.Score,
-		SubmittedAt: *v.SubmittedAt,
+	if v.SelectedOptions != nil {
+		res.SelectedOptions = make([]int, len(v.SelectedOptions))
+		for i, val := range v.SelectedOptions {
+			res.SelectedOptions[i] = val
+		}
 	}
 
 	return res
@@ -1889,10 +3808,21 @@ func unmarshalSubmissionResponseBodyToKnowledgeSubmission(v *SubmissionResponseB
 // *QuestionResultResponseBody.
 func unmarshalQuestionResultResponseBodyToKnowledgeQuestionResult(v *QuestionResultResponseBody) *knowledge.QuestionResult {
 	res := &knowledge.QuestionResult{
-		SelectedAnswer: *v.SelectedAnswer,
-		IsCorrect:      *v.IsCorrect,
+		NumericAnswer: v.NumericAnswer,
+		TextAnswer:    v.TextAnswer,
+		Credit:        *v.Credit,
+		IsCorrect:     *v.IsCorrect,
+		Position:      *v.Position,
 	}
 	res.Question = unmarshalQuestionResponseBodyToKnowledgeQuestion(v.Question)
+	res.SelectedOptions = make([]int, len(v.SelectedOptions))
+	for i, val := range v.SelectedOptions {
+		res.SelectedOptions[i] = val
+	}
+	res.OptionOrder = make([]int, len(v.OptionOrder))
+	for i, val := range v.OptionOrder {
+		res.OptionOrder[i] = val
+	}
 
 	return res
 }
@@ -19,6 +19,20 @@ import (
 type CreateTestRequestBody struct {
 	// Test title
 	Title string `form:"title" json:"title" xml:"title"`
+	// Time limit in minutes, untimed when not set
+	DurationMinutes *int `form:"duration_minutes,omitempty" json:"duration_minutes,omitempty" xml:"duration_minutes,omitempty"`
+	// Attempts allowed per student, 0 for unlimited
+	MaxAttempts int `form:"max_attempts" json:"max_attempts" xml:"max_attempts"`
+	// Minutes a student waits between attempts
+	CooldownMinutes int `form:"cooldown_minutes" json:"cooldown_minutes" xml:"cooldown_minutes"`
+	// Which score counts when the test is taken more than once
+	ScorePolicy *string `form:"score_policy,omitempty" json:"score_policy,omitempty" xml:"score_policy,omitempty"`
+	// Whether correct answers are shown after each attempt
+	RevealAnswers bool `form:"reveal_answers" json:"reveal_answers" xml:"reveal_answers"`
+	// Whether each attempt shows the questions in a different order
+	ShuffleQuestions bool `form:"shuffle_questions" json:"shuffle_questions" xml:"shuffle_questions"`
+	// Whether each attempt shows the options in a different order
+	ShuffleOptions bool `form:"shuffle_options" json:"shuffle_options" xml:"shuffle_options"`
 }
 
 // UpdateTestRequestBody is the type of the "knowledge" service "UpdateTest"
@@ -26,6 +40,20 @@ type CreateTestRequestBody struct {
 type UpdateTestRequestBody struct {
 	// New title
 	Title string `form:"title" json:"title" xml:"title"`
+	// Time limit in minutes, untimed when not set
+	DurationMinutes *int `form:"duration_minutes,omitempty" json:"duration_minutes,omitempty" xml:"duration_minutes,omitempty"`
+	// Attempts allowed per student, 0 for unlimited
+	MaxAttempts int `form:"max_attempts" json:"max_attempts" xml:"max_attempts"`
+	// Minutes a student waits between attempts
+	CooldownMinutes int `form:"cooldown_minutes" json:"cooldown_minutes" xml:"cooldown_minutes"`
+	// Which score counts when the test is taken more than once
+	ScorePolicy *string `form:"score_policy,omitempty" json:"score_policy,omitempty" xml:"score_policy,omitempty"`
+	// Whether correct answers are shown after each attempt
+	RevealAnswers bool `form:"reveal_answers" json:"reveal_answers" xml:"reveal_answers"`
+	// Whether each attempt shows the questions in a different order
+	ShuffleQuestions bool `form:"shuffle_questions" json:"shuffle_questions" xml:"shuffle_questions"`
+	// Whether each attempt shows the options in a different order
+	ShuffleOptions bool `form:"shuffle_options" json:"shuffle_options" xml:"shuffle_options"`
 }
 
 // AddQuestionRequestBody is the type of the "knowledge" service "AddQuestion"
@@ -33,16 +61,19 @@ type UpdateTestRequestBody struct {
 type AddQuestionRequestBody struct {
 	// Question text
 	QuestionText string `form:"question_text" json:"question_text" xml:"question_text"`
-	// Option A
-	OptionA string `form:"option_a" json:"option_a" xml:"option_a"`
-	// Option B
-	OptionB string `form:"option_b" json:"option_b" xml:"option_b"`
-	// Option C
-	OptionC string `form:"option_c" json:"option_c" xml:"option_c"`
-	// Option D
-	OptionD string `form:"option_d" json:"option_d" xml:"option_d"`
-	// Correct answer (0=A, 1=B, 2=C, 3=D)
-	CorrectAnswer int `form:"correct_answer" json:"correct_answer" xml:"correct_answer"`
+	// Question type
+	QuestionType *string `form:"question_type,omitempty" json:"question_type,omitempty" xml:"question_type,omitempty"`
+	// Options of choice and ordering questions, True and False by default for
+	// true/false questions
+	Options []string `form:"options,omitempty" json:"options,omitempty" xml:"options,omitempty"`
+	// Indexes of the correct options, in the correct order for ordering questions
+	CorrectOptions []int `form:"correct_options,omitempty" json:"correct_options,omitempty" xml:"correct_options,omitempty"`
+	// Correct answer of numeric questions
+	NumericAnswer *float64 `form:"numeric_answer,omitempty" json:"numeric_answer,omitempty" xml:"numeric_answer,omitempty"`
+	// Allowed difference from the numeric answer
+	Tolerance float64 `form:"tolerance" json:"tolerance" xml:"tolerance"`
+	// Accepted answers of short text questions, where * matches any text
+	AcceptedAnswers []string `form:"accepted_answers,omitempty" json:"accepted_answers,omitempty" xml:"accepted_answers,omitempty"`
 }
 
 // UpdateQuestionRequestBody is the type of the "knowledge" service
@@ -50,16 +81,87 @@ type AddQuestionRequestBody struct {
 type UpdateQuestionRequestBody struct {
 	// Question text
 	QuestionText string `form:"question_text" json:"question_text" xml:"question_text"`
-	// Option A
-	OptionA string `form:"option_a" json:"option_a" xml:"option_a"`
-	// Option B
-	OptionB string `form:"option_b" json:"option_b" xml:"option_b"`
-	// Option C
-	OptionC string `form:"option_c" json:"option_c" xml:"option_c"`
-	// Option D
-	OptionD string `form:"option_d" json:"option_d" xml:"option_d"`
-	// Correct answer (0=A, 1=B, 2=C, 3=D)
-	CorrectAnswer int `form:"correct_answer" json:"correct_answer" xml:"correct_answer"`
+	// Question type
+	QuestionType *string `form:"question_type,omitempty" json:"question_type,omitempty" xml:"question_type,omitempty"`
+	// Options of choice and ordering questions, True and False by default for
+	// true/false questions
+	Options []string `form:"options,omitempty" json:"options,omitempty" xml:"options,omitempty"`
+	// Indexes of the correct options, in the correct order for ordering questions
+	CorrectOptions []int `form:"correct_options,omitempty" json:"correct_options,omitempty" xml:"correct_options,omitempty"`
+	// Correct answer of numeric questions
+	NumericAnswer *float64 `form:"numeric_answer,omitempty" json:"numeric_answer,omitempty" xml:"numeric_answer,omitempty"`
+	// Allowed difference from the numeric answer
+	Tolerance float64 `form:"tolerance" json:"tolerance" xml:"tolerance"`
+	// Accepted answers of short text questions, where * matches any text
+	AcceptedAnswers []string `form:"accepted_answers,omitempty" json:"accepted_answers,omitempty" xml:"accepted_answers,omitempty"`
+}
+
+// CreateQuestionBankRequestBody is the type of the "knowledge" service
+// "CreateQuestionBank" endpoint HTTP request body.
+type CreateQuestionBankRequestBody struct {
+	// Question bank title
+	Title string `form:"title" json:"title" xml:"title"`
+}
+
+// AddBankQuestionRequestBody is the type of the "knowledge" service
+// "AddBankQuestion" endpoint HTTP request body.
+type AddBankQuestionRequestBody struct {
+	// Question text
+	QuestionText string `form:"question_text" json:"question_text" xml:"question_text"`
+	// Question type
+	QuestionType *string `form:"question_type,omitempty" json:"question_type,omitempty" xml:"question_type,omitempty"`
+	// Options of choice and ordering questions, True and False by default for
+	// true/false questions
+	Options []string `form:"options,omitempty" json:"options,omitempty" xml:"options,omitempty"`
+	// Indexes of the correct options, in the correct order for ordering questions
+	CorrectOptions []int `form:"correct_options,omitempty" json:"correct_options,omitempty" xml:"correct_options,omitempty"`
+	// Correct answer of numeric questions
+	NumericAnswer *float64 `form:"numeric_answer,omitempty" json:"numeric_answer,omitempty" xml:"numeric_answer,omitempty"`
+	// Allowed difference from the numeric answer
+	Tolerance float64 `form:"tolerance" json:"tolerance" xml:"tolerance"`
+	// Accepted answers of short text questions, where * matches any text
+	AcceptedAnswers []string `form:"accepted_answers,omitempty" json:"accepted_answers,omitempty" xml:"accepted_answers,omitempty"`
+	// Topic of the question
+	Topic *string `form:"topic,omitempty" json:"topic,omitempty" xml:"topic,omitempty"`
+	// Question difficulty
+	Difficulty *string `form:"difficulty,omitempty" json:"difficulty,omitempty" xml:"difficulty,omitempty"`
+}
+
+// UpdateBankQuestionRequestBody is the type of the "knowledge" service
+// "UpdateBankQuestion" endpoint HTTP request body.
+type UpdateBankQuestionRequestBody struct {
+	// Question text
+	QuestionText string `form:"question_text" json:"question_text" xml:"question_text"`
+	// Question type
+	QuestionType *string `form:"question_type,omitempty" json:"question_type,omitempty" xml:"question_type,omitempty"`
+	// Options of choice and ordering questions, True and False by default for
+	// true/false questions
+	Options []string `form:"options,omitempty" json:"options,omitempty" xml:"options,omitempty"`
+	// Indexes of the correct options, in the correct order for ordering questions
+	CorrectOptions []int `form:"correct_options,omitempty" json:"correct_options,omitempty" xml:"correct_options,omitempty"`
+	// Correct answer of numeric questions
+	NumericAnswer *float64 `form:"numeric_answer,omitempty" json:"numeric_answer,omitempty" xml:"numeric_answer,omitempty"`
+	// Allowed difference from the numeric answer
+	Tolerance float64 `form:"tolerance" json:"tolerance" xml:"tolerance"`
+	// Accepted answers of short text questions, where * matches any text
+	AcceptedAnswers []string `form:"accepted_answers,omitempty" json:"accepted_answers,omitempty" xml:"accepted_answers,omitempty"`
+	// Topic of the question
+	Topic *string `form:"topic,omitempty" json:"topic,omitempty" xml:"topic,omitempty"`
+	// Question difficulty
+	Difficulty *string `form:"difficulty,omitempty" json:"difficulty,omitempty" xml:"difficulty,omitempty"`
+}
+
+// AddDrawRuleRequestBody is the type of the "knowledge" service "AddDrawRule"
+// endpoint HTTP request body.
+type AddDrawRuleRequestBody struct {
+	// Question bank ID
+	BankID int64 `form:"bank_id" json:"bank_id" xml:"bank_id"`
+	// Topic of the questions drawn, any when not set
+	Topic *string `form:"topic,omitempty" json:"topic,omitempty" xml:"topic,omitempty"`
+	// Difficulty of the questions drawn, any when not set
+	Difficulty *string `form:"difficulty,omitempty" json:"difficulty,omitempty" xml:"difficulty,omitempty"`
+	// Number of questions drawn
+	QuestionCount int `form:"question_count" json:"question_count" xml:"question_count"`
 }
 
 // SubmitTestRequestBody is the type of the "knowledge" service "SubmitTest"
@@ -151,6 +253,138 @@ type DeleteQuestionResponseBody struct {
 	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
 }
 
+// CreateQuestionBankResponseBody is the type of the "knowledge" service
+// "CreateQuestionBank" endpoint HTTP response body.
+type CreateQuestionBankResponseBody struct {
+	// Operation success status
+	Success *bool `form:"success,omitempty" json:"success,omitempty" xml:"success,omitempty"`
+	// Response message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// GetMyQuestionBanksResponseBody is the type of the "knowledge" service
+// "GetMyQuestionBanks" endpoint HTTP response body.
+type GetMyQuestionBanksResponseBody struct {
+	// Question banks
+	Banks []*QuestionBankResponseBody `form:"banks,omitempty" json:"banks,omitempty" xml:"banks,omitempty"`
+}
+
+// DeleteQuestionBankResponseBody is the type of the "knowledge" service
+// "DeleteQuestionBank" endpoint HTTP response body.
+type DeleteQuestionBankResponseBody struct {
+	// Operation success status
+	Success *bool `form:"success,omitempty" json:"success,omitempty" xml:"success,omitempty"`
+	// Response message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// GetBankQuestionsResponseBody is the type of the "knowledge" service
+// "GetBankQuestions" endpoint HTTP response body.
+type GetBankQuestionsResponseBody struct {
+	// Questions
+	Questions []*BankQuestionResponseBody `form:"questions,omitempty" json:"questions,omitempty" xml:"questions,omitempty"`
+}
+
+// AddBankQuestionResponseBody is the type of the "knowledge" service
+// "AddBankQuestion" endpoint HTTP response body.
+type AddBankQuestionResponseBody struct {
+	// Operation success status
+	Success *bool `form:"success,omitempty" json:"success,omitempty" xml:"success,omitempty"`
+	// Response message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// UpdateBankQuestionResponseBody is the type of the "knowledge" service
+// "UpdateBankQuestion" endpoint HTTP response body.
+type UpdateBankQuestionResponseBody struct {
+	// Operation success status
+	Success *bool `form:"success,omitempty" json:"success,omitempty" xml:"success,omitempty"`
+	// Response message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// DeleteBankQuestionResponseBody is the type of the "knowledge" service
+// "DeleteBankQuestion" endpoint HTTP response body.
+type DeleteBankQuestionResponseBody struct {
+	// Operation success status
+	Success *bool `form:"success,omitempty" json:"success,omitempty" xml:"success,omitempty"`
+	// Response message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// AddDrawRuleResponseBody is the type of the "knowledge" service "AddDrawRule"
+// endpoint HTTP response body.
+type AddDrawRuleResponseBody struct {
+	// Operation success status
+	Success *bool `form:"success,omitempty" json:"success,omitempty" xml:"success,omitempty"`
+	// Response message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// GetDrawRulesResponseBody is the type of the "knowledge" service
+// "GetDrawRules" endpoint HTTP response body.
+type GetDrawRulesResponseBody struct {
+	// Draw rules
+	Rules []*DrawRuleResponseBody `form:"rules,omitempty" json:"rules,omitempty" xml:"rules,omitempty"`
+}
+
+// DeleteDrawRuleResponseBody is the type of the "knowledge" service
+// "DeleteDrawRule" endpoint HTTP response body.
+type DeleteDrawRuleResponseBody struct {
+	// Operation success status
+	Success *bool `form:"success,omitempty" json:"success,omitempty" xml:"success,omitempty"`
+	// Response message
+	Message *string `form:"message,omitempty" json:"message,omitempty" xml:"message,omitempty"`
+}
+
+// GetTestAnalyticsResponseBody is the type of the "knowledge" service
+// "GetTestAnalytics" endpoint HTTP response body.
+type GetTestAnalyticsResponseBody struct {
+	// Test ID
+	TestID *int64 `form:"test_id,omitempty" json:"test_id,omitempty" xml:"test_id,omitempty"`
+	// Number of attempts analyzed
+	Attempts *int `form:"attempts,omitempty" json:"attempts,omitempty" xml:"attempts,omitempty"`
+	// Mean score percentage
+	Mean *float64 `form:"mean,omitempty" json:"mean,omitempty" xml:"mean,omitempty"`
+	// Median score percentage
+	Median *float64 `form:"median,omitempty" json:"median,omitempty" xml:"median,omitempty"`
+	// Standard deviation of the score percentages
+	StdDev *float64 `form:"std_dev,omitempty" json:"std_dev,omitempty" xml:"std_dev,omitempty"`
+	// Number of attempts by score range
+	ScoreHistogram []*ScoreBinResponseBody `form:"score_histogram,omitempty" json:"score_histogram,omitempty" xml:"score_histogram,omitempty"`
+	// Item analysis of every question given
+	Questions []*QuestionAnalyticsResponseBody `form:"questions,omitempty" json:"questions,omitempty" xml:"questions,omitempty"`
+	// Reliability coefficient over the questions given in every attempt, not set
+	// when it cannot be computed
+	Reliability *float64 `form:"reliability,omitempty" json:"reliability,omitempty" xml:"reliability,omitempty"`
+	// Reliability coefficient used, kr20 when those questions were all scored
+	// right or wrong and Cronbach's alpha when any earned partial credit, not set
+	// without a reliability
+	ReliabilityMethod *string `form:"reliability_method,omitempty" json:"reliability_method,omitempty" xml:"reliability_method,omitempty"`
+}
+
+// GetTestGradebookResponseBody is the type of the "knowledge" service
+// "GetTestGradebook" endpoint HTTP response body.
+type GetTestGradebookResponseBody struct {
+	// Test info
+	Test *TestResponseBody `form:"test,omitempty" json:"test,omitempty" xml:"test,omitempty"`
+	// Submissions, sorted and filtered as requested
+	Submissions []*StudentSubmissionResponseBody `form:"submissions,omitempty" json:"submissions,omitempty" xml:"submissions,omitempty"`
+}
+
+// GetStudentHistoryResponseBody is the type of the "knowledge" service
+// "GetStudentHistory" endpoint HTTP response body.
+type GetStudentHistoryResponseBody struct {
+	// Student user ID
+	UserID *int64 `form:"user_id,omitempty" json:"user_id,omitempty" xml:"user_id,omitempty"`
+	// Student full name
+	StudentName *string `form:"student_name,omitempty" json:"student_name,omitempty" xml:"student_name,omitempty"`
+	// Submissions, newest first
+	Submissions []*SubmissionResponseBody `form:"submissions,omitempty" json:"submissions,omitempty" xml:"submissions,omitempty"`
+	// Score counted for each test taken
+	TestScores []*TestScoreResponseBody `form:"test_scores,omitempty" json:"test_scores,omitempty" xml:"test_scores,omitempty"`
+}
+
 // GetAvailableTestsResponseBody is the type of the "knowledge" service
 // "GetAvailableTests" endpoint HTTP response body.
 type GetAvailableTestsResponseBody struct {
@@ -158,6 +392,23 @@ type GetAvailableTestsResponseBody struct {
 	Tests []*TestResponseBody `form:"tests,omitempty" json:"tests,omitempty" xml:"tests,omitempty"`
 }
 
+// StartTestResponseBody is the type of the "knowledge" service "StartTest"
+// endpoint HTTP response body.
+type StartTestResponseBody struct {
+	// Submission ID
+	SubmissionID *int64 `form:"submission_id,omitempty" json:"submission_id,omitempty" xml:"submission_id,omitempty"`
+	// Test ID
+	TestID *int64 `form:"test_id,omitempty" json:"test_id,omitempty" xml:"test_id,omitempty"`
+	// Attempt status
+	Status *string `form:"status,omitempty" json:"status,omitempty" xml:"status,omitempty"`
+	// Start timestamp
+	StartedAt *int64 `form:"started_at,omitempty" json:"started_at,omitempty" xml:"started_at,omitempty"`
+	// Timestamp by which answers must be submitted, not set for untimed tests
+	Deadline *int64 `form:"deadline,omitempty" json:"deadline,omitempty" xml:"deadline,omitempty"`
+	// Time limit in minutes
+	DurationMinutes *int `form:"duration_minutes,omitempty" json:"duration_minutes,omitempty" xml:"duration_minutes,omitempty"`
+}
+
 // GetTestFormResponseBody is the type of the "knowledge" service "GetTestForm"
 // endpoint HTTP response body.
 type GetTestFormResponseBody struct {
@@ -178,6 +429,8 @@ type SubmitTestResponseBody struct {
 	SubmissionID *int64 `form:"submission_id,omitempty" json:"submission_id,omitempty" xml:"submission_id,omitempty"`
 	// Score percentage
 	Score *float64 `form:"score,omitempty" json:"score,omitempty" xml:"score,omitempty"`
+	// Number of the attempt at the test, from 1
+	AttemptNumber *int `form:"attempt_number,omitempty" json:"attempt_number,omitempty" xml:"attempt_number,omitempty"`
 }
 
 // GetMySubmissionsResponseBody is the type of the "knowledge" service
@@ -185,6 +438,8 @@ type SubmitTestResponseBody struct {
 type GetMySubmissionsResponseBody struct {
 	// Submissions
 	Submissions []*SubmissionResponseBody `form:"submissions,omitempty" json:"submissions,omitempty" xml:"submissions,omitempty"`
+	// Score counted for each test taken
+	TestScores []*TestScoreResponseBody `form:"test_scores,omitempty" json:"test_scores,omitempty" xml:"test_scores,omitempty"`
 }
 
 // GetSubmissionByIDResponseBody is the type of the "knowledge" service
@@ -201,6 +456,12 @@ type GetSubmissionResultResponseBody struct {
 	Submission *SubmissionResponseBody `form:"submission,omitempty" json:"submission,omitempty" xml:"submission,omitempty"`
 	// Question results
 	Questions []*QuestionResultResponseBody `form:"questions,omitempty" json:"questions,omitempty" xml:"questions,omitempty"`
+	// Every attempt at the test, oldest first
+	Attempts []*SubmissionResponseBody `form:"attempts,omitempty" json:"attempts,omitempty" xml:"attempts,omitempty"`
+	// Score percentage counted for the test over every attempt
+	CountedScore *float64 `form:"counted_score,omitempty" json:"counted_score,omitempty" xml:"counted_score,omitempty"`
+	// Whether the questions include their correct answers
+	AnswersRevealed *bool `form:"answers_revealed,omitempty" json:"answers_revealed,omitempty" xml:"answers_revealed,omitempty"`
 }
 
 // TestResponseBody is used to define fields on response body types.
@@ -215,6 +476,20 @@ type TestResponseBody struct {
 	CreatedAt *int64 `form:"created_at,omitempty" json:"created_at,omitempty" xml:"created_at,omitempty"`
 	// Number of questions
 	QuestionCount *int `form:"question_count,omitempty" json:"question_count,omitempty" xml:"question_count,omitempty"`
+	// Time limit in minutes, untimed when not set
+	DurationMinutes *int `form:"duration_minutes,omitempty" json:"duration_minutes,omitempty" xml:"duration_minutes,omitempty"`
+	// Attempts allowed per student, 0 for unlimited
+	MaxAttempts *int `form:"max_attempts,omitempty" json:"max_attempts,omitempty" xml:"max_attempts,omitempty"`
+	// Minutes a student waits between attempts
+	CooldownMinutes *int `form:"cooldown_minutes,omitempty" json:"cooldown_minutes,omitempty" xml:"cooldown_minutes,omitempty"`
+	// Which score counts when the test is taken more than once
+	ScorePolicy *string `form:"score_policy,omitempty" json:"score_policy,omitempty" xml:"score_policy,omitempty"`
+	// Whether correct answers are shown after each attempt
+	RevealAnswers *bool `form:"reveal_answers,omitempty" json:"reveal_answers,omitempty" xml:"reveal_answers,omitempty"`
+	// Whether each attempt shows the questions in a different order
+	ShuffleQuestions *bool `form:"shuffle_questions,omitempty" json:"shuffle_questions,omitempty" xml:"shuffle_questions,omitempty"`
+	// Whether each attempt shows the options in a different order
+	ShuffleOptions *bool `form:"shuffle_options,omitempty" json:"shuffle_options,omitempty" xml:"shuffle_options,omitempty"`
 }
 
 // QuestionResponseBody is used to define fields on response body types.
@@ -225,44 +500,134 @@ type QuestionResponseBody struct {
 	TestID *int64 `form:"test_id,omitempty" json:"test_id,omitempty" xml:"test_id,omitempty"`
 	// Question text
 	QuestionText *string `form:"question_text,omitempty" json:"question_text,omitempty" xml:"question_text,omitempty"`
-	// Option A
-	OptionA *string `form:"option_a,omitempty" json:"option_a,omitempty" xml:"option_a,omitempty"`
-	// Option B
-	OptionB *string `form:"option_b,omitempty" json:"option_b,omitempty" xml:"option_b,omitempty"`
-	// Option C
-	OptionC *string `form:"option_c,omitempty" json:"option_c,omitempty" xml:"option_c,omitempty"`
-	// Option D
-	OptionD *string `form:"option_d,omitempty" json:"option_d,omitempty" xml:"option_d,omitempty"`
-	// Correct answer (0=A, 1=B, 2=C, 3=D)
-	CorrectAnswer *int `form:"correct_answer,omitempty" json:"correct_answer,omitempty" xml:"correct_answer,omitempty"`
+	// Question type
+	QuestionType *string `form:"question_type,omitempty" json:"question_type,omitempty" xml:"question_type,omitempty"`
+	// Options of choice and ordering questions
+	Options []string `form:"options,omitempty" json:"options,omitempty" xml:"options,omitempty"`
+	// Indexes of the correct options, in the correct order for ordering questions
+	CorrectOptions []int `form:"correct_options,omitempty" json:"correct_options,omitempty" xml:"correct_options,omitempty"`
+	// Correct answer of numeric questions
+	NumericAnswer *float64 `form:"numeric_answer,omitempty" json:"numeric_answer,omitempty" xml:"numeric_answer,omitempty"`
+	// Allowed difference from the numeric answer
+	Tolerance *float64 `form:"tolerance,omitempty" json:"tolerance,omitempty" xml:"tolerance,omitempty"`
+	// Accepted answers of short text questions, where * matches any text
+	AcceptedAnswers []string `form:"accepted_answers,omitempty" json:"accepted_answers,omitempty" xml:"accepted_answers,omitempty"`
 	// Question order
 	QuestionOrder *int `form:"question_order,omitempty" json:"question_order,omitempty" xml:"question_order,omitempty"`
 }
 
-// QuestionFormResponseBody is used to define fields on response body types.
-type QuestionFormResponseBody struct {
+// QuestionBankResponseBody is used to define fields on response body types.
+type QuestionBankResponseBody struct {
+	// Question bank ID
+	ID *int64 `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Question bank title
+	Title *string `form:"title,omitempty" json:"title,omitempty" xml:"title,omitempty"`
+	// Creator user ID
+	CreatedBy *int64 `form:"created_by,omitempty" json:"created_by,omitempty" xml:"created_by,omitempty"`
+	// Creation timestamp
+	CreatedAt *int64 `form:"created_at,omitempty" json:"created_at,omitempty" xml:"created_at,omitempty"`
+	// Number of questions
+	QuestionCount *int `form:"question_count,omitempty" json:"question_count,omitempty" xml:"question_count,omitempty"`
+}
+
+// BankQuestionResponseBody is used to define fields on response body types.
+type BankQuestionResponseBody struct {
 	// Question ID
 	ID *int64 `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Question bank ID
+	BankID *int64 `form:"bank_id,omitempty" json:"bank_id,omitempty" xml:"bank_id,omitempty"`
 	// Question text
 	QuestionText *string `form:"question_text,omitempty" json:"question_text,omitempty" xml:"question_text,omitempty"`
-	// Option A
-	OptionA *string `form:"option_a,omitempty" json:"option_a,omitempty" xml:"option_a,omitempty"`
-	// Option B
-	OptionB *string `form:"option_b,omitempty" json:"option_b,omitempty" xml:"option_b,omitempty"`
-	// Option C
-	OptionC *string `form:"option_c,omitempty" json:"option_c,omitempty" xml:"option_c,omitempty"`
-	// Option D
-	OptionD *string `form:"option_d,omitempty" json:"option_d,omitempty" xml:"option_d,omitempty"`
-	// Question order
+	// Question type
+	QuestionType *string `form:"question_type,omitempty" json:"question_type,omitempty" xml:"question_type,omitempty"`
+	// Options of choice and ordering questions
+	Options []string `form:"options,omitempty" json:"options,omitempty" xml:"options,omitempty"`
+	// Indexes of the correct options, in the correct order for ordering questions
+	CorrectOptions []int `form:"correct_options,omitempty" json:"correct_options,omitempty" xml:"correct_options,omitempty"`
+	// Correct answer of numeric questions
+	NumericAnswer *float64 `form:"numeric_answer,omitempty" json:"numeric_answer,omitempty" xml:"numeric_answer,omitempty"`
+	// Allowed difference from the numeric answer
+	Tolerance *float64 `form:"tolerance,omitempty" json:"tolerance,omitempty" xml:"tolerance,omitempty"`
+	// Accepted answers of short text questions, where * matches any text
+	AcceptedAnswers []string `form:"accepted_answers,omitempty" json:"accepted_answers,omitempty" xml:"accepted_answers,omitempty"`
+	// Question order in the bank
 	QuestionOrder *int `form:"question_order,omitempty" json:"question_order,omitempty" xml:"question_order,omitempty"`
+	// Topic of the question, empty when untagged
+	Topic *string `form:"topic,omitempty" json:"topic,omitempty" xml:"topic,omitempty"`
+	// Question difficulty
+	Difficulty *string `form:"difficulty,omitempty" json:"difficulty,omitempty" xml:"difficulty,omitempty"`
 }
 
-// AnswerRequestBody is used to define fields on request body types.
-type AnswerRequestBody struct {
+// DrawRuleResponseBody is used to define fields on response body types.
+type DrawRuleResponseBody struct {
+	// Draw rule ID
+	ID *int64 `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Test ID
+	TestID *int64 `form:"test_id,omitempty" json:"test_id,omitempty" xml:"test_id,omitempty"`
+	// Question bank ID
+	BankID *int64 `form:"bank_id,omitempty" json:"bank_id,omitempty" xml:"bank_id,omitempty"`
+	// Topic of the questions drawn, any when not set
+	Topic *string `form:"topic,omitempty" json:"topic,omitempty" xml:"topic,omitempty"`
+	// Difficulty of the questions drawn, any when not set
+	Difficulty *string `form:"difficulty,omitempty" json:"difficulty,omitempty" xml:"difficulty,omitempty"`
+	// Number of questions drawn
+	QuestionCount *int `form:"question_count,omitempty" json:"question_count,omitempty" xml:"question_count,omitempty"`
+}
+
+// ScoreBinResponseBody is used to define fields on response body types.
+type ScoreBinResponseBody struct {
+	// Lowest score percentage of the range
+	From *float64 `form:"from,omitempty" json:"from,omitempty" xml:"from,omitempty"`
+	// Highest score percentage of the range
+	To *float64 `form:"to,omitempty" json:"to,omitempty" xml:"to,omitempty"`
+	// Number of attempts with a score in the range
+	Count *int `form:"count,omitempty" json:"count,omitempty" xml:"count,omitempty"`
+}
+
+// QuestionAnalyticsResponseBody is used to define fields on response body
+// types.
+type QuestionAnalyticsResponseBody struct {
 	// Question ID
-	QuestionID int64 `form:"question_id" json:"question_id" xml:"question_id"`
-	// Selected answer (0=A, 1=B, 2=C, 3=D)
-	SelectedAnswer int `form:"selected_answer" json:"selected_answer" xml:"selected_answer"`
+	QuestionID *int64 `form:"question_id,omitempty" json:"question_id,omitempty" xml:"question_id,omitempty"`
+	// Question text
+	QuestionText *string `form:"question_text,omitempty" json:"question_text,omitempty" xml:"question_text,omitempty"`
+	// Question type
+	QuestionType *string `form:"question_type,omitempty" json:"question_type,omitempty" xml:"question_type,omitempty"`
+	// Number of attempts the question was given in
+	Attempts *int `form:"attempts,omitempty" json:"attempts,omitempty" xml:"attempts,omitempty"`
+	// Mean credit those attempts earned on it, which is the share answering it
+	// correctly for questions without partial credit, from 0 to 1
+	DifficultyIndex *float64 `form:"difficulty_index,omitempty" json:"difficulty_index,omitempty" xml:"difficulty_index,omitempty"`
+	// Mean credit in the top 27% of attempts by score minus that in the bottom
+	// 27%, from -1 to 1, not set with too few attempts
+	DiscriminationIndex *float64 `form:"discrimination_index,omitempty" json:"discrimination_index,omitempty" xml:"discrimination_index,omitempty"`
+	// How often each option was selected, empty for questions without options
+	OptionSelections []*OptionSelectionResponseBody `form:"option_selections,omitempty" json:"option_selections,omitempty" xml:"option_selections,omitempty"`
+	// Number of attempts leaving the question unanswered
+	Unanswered *int `form:"unanswered,omitempty" json:"unanswered,omitempty" xml:"unanswered,omitempty"`
+}
+
+// OptionSelectionResponseBody is used to define fields on response body types.
+type OptionSelectionResponseBody struct {
+	// Option index
+	Index *int `form:"index,omitempty" json:"index,omitempty" xml:"index,omitempty"`
+	// Option text
+	Option *string `form:"option,omitempty" json:"option,omitempty" xml:"option,omitempty"`
+	// Number of attempts selecting the option
+	Count *int `form:"count,omitempty" json:"count,omitempty" xml:"count,omitempty"`
+	// Whether the option is correct
+	IsCorrect *bool `form:"is_correct,omitempty" json:"is_correct,omitempty" xml:"is_correct,omitempty"`
+}
+
+// StudentSubmissionResponseBody is used to define fields on response body
+// types.
+type StudentSubmissionResponseBody struct {
+	// Student user ID
+	UserID *int64 `form:"user_id,omitempty" json:"user_id,omitempty" xml:"user_id,omitempty"`
+	// Student full name
+	StudentName *string `form:"student_name,omitempty" json:"student_name,omitempty" xml:"student_name,omitempty"`
+	// Submission info
+	Submission *SubmissionResponseBody `form:"submission,omitempty" json:"submission,omitempty" xml:"submission,omitempty"`
 }
 
 // SubmissionResponseBody is used to define fields on response body types.
@@ -277,23 +642,120 @@ type SubmissionResponseBody struct {
 	Score *float64 `form:"score,omitempty" json:"score,omitempty" xml:"score,omitempty"`
 	// Submission timestamp
 	SubmittedAt *int64 `form:"submitted_at,omitempty" json:"submitted_at,omitempty" xml:"submitted_at,omitempty"`
+	// Whether the test was submitted or expired
+	Status *string `form:"status,omitempty" json:"status,omitempty" xml:"status,omitempty"`
+	// Start timestamp
+	StartedAt *int64 `form:"started_at,omitempty" json:"started_at,omitempty" xml:"started_at,omitempty"`
+	// Number of the attempt at the test, from 1
+	AttemptNumber *int `form:"attempt_number,omitempty" json:"attempt_number,omitempty" xml:"attempt_number,omitempty"`
+}
+
+// TestScoreResponseBody is used to define fields on response body types.
+type TestScoreResponseBody struct {
+	// Test ID
+	TestID *int64 `form:"test_id,omitempty" json:"test_id,omitempty" xml:"test_id,omitempty"`
+	// Test title
+	TestTitle *string `form:"test_title,omitempty" json:"test_title,omitempty" xml:"test_title,omitempty"`
+	// Which score counts
+	ScorePolicy *string `form:"score_policy,omitempty" json:"score_policy,omitempty" xml:"score_policy,omitempty"`
+	// Number of attempts
+	Attempts *int `form:"attempts,omitempty" json:"attempts,omitempty" xml:"attempts,omitempty"`
+	// Score percentage counted
+	Score *float64 `form:"score,omitempty" json:"score,omitempty" xml:"score,omitempty"`
+}
+
+// QuestionFormResponseBody is used to define fields on response body types.
+type QuestionFormResponseBody struct {
+	// Question ID
+	ID *int64 `form:"id,omitempty" json:"id,omitempty" xml:"id,omitempty"`
+	// Question text
+	QuestionText *string `form:"question_text,omitempty" json:"question_text,omitempty" xml:"question_text,omitempty"`
+	// Question type
+	QuestionType *string `form:"question_type,omitempty" json:"question_type,omitempty" xml:"question_type,omitempty"`
+	// Options to choose from or to order, empty for numeric and short text
+	// questions
+	Options []string `form:"options,omitempty" json:"options,omitempty" xml:"options,omitempty"`
+	// Position of the question in the attempt
+	QuestionOrder *int `form:"question_order,omitempty" json:"question_order,omitempty" xml:"question_order,omitempty"`
+}
+
+// AnswerRequestBody is used to define fields on request body types.
+type AnswerRequestBody struct {
+	// Question ID
+	QuestionID int64 `form:"question_id" json:"question_id" xml:"question_id"`
+	// Positions of the selected options as shown in the form, in the chosen order
+	// for ordering questions
+	SelectedOptions []int `form:"selected_options,omitempty" json:"selected_options,omitempty" xml:"selected_options,omitempty"`
+	// Answer to a numeric question
+	NumericAnswer *float64 `form:"numeric_answer,omitempty" json:"numeric_answer,omitempty" xml:"numeric_answer,omitempty"`
+	// Answer to a short text question
+	TextAnswer *string `form:"text_answer,omitempty" json:"text_answer,omitempty" xml:"text_answer,omitempty"`
 }
 
 // QuestionResultResponseBody is used to define fields on response body types.
 type QuestionResultResponseBody struct {
 	// Question info
 	Question *QuestionResponseBody `form:"question,omitempty" json:"question,omitempty" xml:"question,omitempty"`
-	// User selected answer
-	SelectedAnswer *int `form:"selected_answer,omitempty" json:"selected_answer,omitempty" xml:"selected_answer,omitempty"`
+	// User selected options
+	SelectedOptions []int `form:"selected_options,omitempty" json:"selected_options,omitempty" xml:"selected_options,omitempty"`
+	// User numeric answer
+	NumericAnswer *float64 `form:"numeric_answer,omitempty" json:"numeric_answer,omitempty" xml:"numeric_answer,omitempty"`
+	// User text answer
+	TextAnswer *string `form:"text_answer,omitempty" json:"text_answer,omitempty" xml:"text_answer,omitempty"`
+	// Fraction of the question earned, from 0 to 1
+	Credit *float64 `form:"credit,omitempty" json:"credit,omitempty" xml:"credit,omitempty"`
 	// Whether answer was correct
 	IsCorrect *bool `form:"is_correct,omitempty" json:"is_correct,omitempty" xml:"is_correct,omitempty"`
+	// Position of the question as shown to the user
+	Position *int `form:"position,omitempty" json:"position,omitempty" xml:"position,omitempty"`
+	// Index of each option of the question in the order shown to the user, empty
+	// when shown in order
+	OptionOrder []int `form:"option_order,omitempty" json:"option_order,omitempty" xml:"option_order,omitempty"`
 }
 
 // NewCreateTestRequestBody builds the HTTP request body from the payload of
 // the "CreateTest" endpoint of the "knowledge" service.
 func NewCreateTestRequestBody(p *knowledge.CreateTestPayload) *CreateTestRequestBody {
 	body := &CreateTestRequestBody{
-		Title: p.Title,
+		Title:            p.Title,
+		DurationMinutes:  p.DurationMinutes,
+		MaxAttempts:      p.MaxAttempts,
+		CooldownMinutes:  p.CooldownMinutes,
+		RevealAnswers:    p.RevealAnswers,
+		ShuffleQuestions: p.ShuffleQuestions,
+		ShuffleOptions:   p.ShuffleOptions,
+	}
+	scorePolicy := string(p.ScorePolicy)
+	body.ScorePolicy = &scorePolicy
+	{
+		var zero int
+		if body.MaxAttempts == zero {
+			body.MaxAttempts = 1
+		}
+	}
+	{
+		var zero int
+		if body.CooldownMinutes == zero {
+			body.CooldownMinutes = 0
+		}
+	}
+	{
+		var zero bool
+		if body.RevealAnswers == zero {
+			body.RevealAnswers = true
+		}
+	}
+	{
+		var zero bool
+		if body.ShuffleQuestions == zero {
+			body.ShuffleQuestions = false
+		}
+	}
+	{
+		var zero bool
+		if body.ShuffleOptions == zero {
+			body.ShuffleOptions = false
+		}
 	}
 	return body
 }
@@ -302,7 +764,45 @@ func NewCreateTestRequestBody(p *knowledge.CreateTestPayload) *CreateTestRequest
 // the "UpdateTest" endpoint of the "knowledge" service.
 func NewUpdateTestRequestBody(p *knowledge.UpdateTestPayload) *UpdateTestRequestBody {
 	body := &UpdateTestRequestBody{
-		Title: p.Title,
+		Title:            p.Title,
+		DurationMinutes:  p.DurationMinutes,
+		MaxAttempts:      p.MaxAttempts,
+		CooldownMinutes:  p.CooldownMinutes,
+		RevealAnswers:    p.RevealAnswers,
+		ShuffleQuestions: p.ShuffleQuestions,
+		ShuffleOptions:   p.ShuffleOptions,
+	}
+	scorePolicy := string(p.ScorePolicy)
+	body.ScorePolicy = &scorePolicy
+	{
+		var zero int
+		if body.MaxAttempts == zero {
+			body.MaxAttempts = 1
+		}
+	}
+	{
+		var zero int
+		if body.CooldownMinutes == zero {
+			body.CooldownMinutes = 0
+		}
+	}
+	{
+		var zero bool
+		if body.RevealAnswers == zero {
+			body.RevealAnswers = true
+		}
+	}
+	{
+		var zero bool
+		if body.ShuffleQuestions == zero {
+			body.ShuffleQuestions = false
+		}
+	}
+	{
+		var zero bool
+		if body.ShuffleOptions == zero {
+			body.ShuffleOptions = false
+		}
 	}
 	return body
 }
@@ -312,11 +812,34 @@ func NewUpdateTestRequestBody(p *knowledge.UpdateTestPayload) *UpdateTestRequest
 func NewAddQuestionRequestBody(p *knowledge.AddQuestionPayload) *AddQuestionRequestBody {
 	body := &AddQuestionRequestBody{
 		QuestionText:  p.QuestionText,
-		OptionA:       p.OptionA,
-		OptionB:       p.OptionB,
-		OptionC:       p.OptionC,
-		OptionD:       p.OptionD,
-		CorrectAnswer: p.CorrectAnswer,
+		NumericAnswer: p.NumericAnswer,
+		Tolerance:     p.Tolerance,
+	}
+	questionType := string(p.QuestionType)
+	body.QuestionType = &questionType
+	if p.Options != nil {
+		body.Options = make([]string, len(p.Options))
+		for i, val := range p.Options {
+			body.Options[i] = val
+		}
+	}
+	if p.CorrectOptions != nil {
+		body.CorrectOptions = make([]int, len(p.CorrectOptions))
+		for i, val := range p.CorrectOptions {
+			body.CorrectOptions[i] = val
+		}
+	}
+	{
+		var zero float64
+		if body.Tolerance == zero {
+			body.Tolerance = 0
+		}
+	}
+	if p.AcceptedAnswers != nil {
+		body.AcceptedAnswers = make([]string, len(p.AcceptedAnswers))
+		for i, val := range p.AcceptedAnswers {
+			body.AcceptedAnswers[i] = val
+		}
 	}
 	return body
 }
@@ -326,11 +849,138 @@ func NewAddQuestionRequestBody(p *knowledge.AddQuestionPayload) *AddQuestionRequ
 func NewUpdateQuestionRequestBody(p *knowledge.UpdateQuestionPayload) *UpdateQuestionRequestBody {
 	body := &UpdateQuestionRequestBody{
 		QuestionText:  p.QuestionText,
-		OptionA:       p.OptionA,
-		OptionB:       p.OptionB,
-		OptionC:       p.OptionC,
-		OptionD:       p.OptionD,
-		CorrectAnswer: p.CorrectAnswer,
+		NumericAnswer: p.NumericAnswer,
+		Tolerance:     p.Tolerance,
+	}
+	questionType := string(p.QuestionType)
+	body.QuestionType = &questionType
+	if p.Options != nil {
+		body.Options = make([]string, len(p.Options))
+		for i, val := range p.Options {
+			body.Options[i] = val
+		}
+	}
+	if p.CorrectOptions != nil {
+		body.CorrectOptions = make([]int, len(p.CorrectOptions))
+		for i, val := range p.CorrectOptions {
+			body.CorrectOptions[i] = val
+		}
+	}
+	{
+		var zero float64
+		if body.Tolerance == zero {
+			body.Tolerance = 0
+		}
+	}
+	if p.AcceptedAnswers != nil {
+		body.AcceptedAnswers = make([]string, len(p.AcceptedAnswers))
+		for i, val := range p.AcceptedAnswers {
+			body.AcceptedAnswers[i] = val
+		}
+	}
+	return body
+}
+
+// NewCreateQuestionBankRequestBody builds the HTTP request body from the
+// payload of the "CreateQuestionBank" endpoint of the "knowledge" service.
+func NewCreateQuestionBankRequestBody(p *knowledge.CreateQuestionBankPayload) *CreateQuestionBankRequestBody {
+	body := &CreateQuestionBankRequestBody{
+		Title: p.Title,
+	}
+	return body
+}
+
+// NewAddBankQuestionRequestBody builds the HTTP request body from the payload
+// of the "AddBankQuestion" endpoint of the "knowledge" service.
+func NewAddBankQuestionRequestBody(p *knowledge.AddBankQuestionPayload) *AddBankQuestionRequestBody {
+	body := &AddBankQuestionRequestBody{
+		QuestionText:  p.QuestionText,
+		NumericAnswer: p.NumericAnswer,
+		Tolerance:     p.Tolerance,
+		Topic:         p.Topic,
+	}
+	questionType := string(p.QuestionType)
+	body.QuestionType = &questionType
+	difficulty := string(p.Difficulty)
+	body.Difficulty = &difficulty
+	if p.Options != nil {
+		body.Options = make([]string, len(p.Options))
+		for i, val := range p.Options {
+			body.Options[i] = val
+		}
+	}
+	if p.CorrectOptions != nil {
+		body.CorrectOptions = make([]int, len(p.CorrectOptions))
+		for i, val := range p.CorrectOptions {
+			body.CorrectOptions[i] = val
+		}
+	}
+	{
+		var zero float64
+		if body.Tolerance == zero {
+			body.Tolerance = 0
+		}
+	}
+	if p.AcceptedAnswers != nil {
+		body.AcceptedAnswers = make([]string, len(p.AcceptedAnswers))
+		for i, val := range p.AcceptedAnswers {
+			body.AcceptedAnswers[i] = val
+		}
+	}
+	return body
+}
+
+// NewUpdateBankQuestionRequestBody builds the HTTP request body from the
+// payload of the "UpdateBankQuestion" endpoint of the "knowledge" service.
+func NewUpdateBankQuestionRequestBody(p *knowledge.UpdateBankQuestionPayload) *UpdateBankQuestionRequestBody {
+	body := &UpdateBankQuestionRequestBody{
+		QuestionText:  p.QuestionText,
+		NumericAnswer: p.NumericAnswer,
+		Tolerance:     p.Tolerance,
+		Topic:         p.Topic,
+	}
+	questionType := string(p.QuestionType)
+	body.QuestionType = &questionType
+	difficulty := string(p.Difficulty)
+	body.Difficulty = &difficulty
+	if p.Options != nil {
+		body.Options = make([]string, len(p.Options))
+		for i, val := range p.Options {
+			body.Options[i] = val
+		}
+	}
+	if p.CorrectOptions != nil {
+		body.CorrectOptions = make([]int, len(p.CorrectOptions))
+		for i, val := range p.CorrectOptions {
+			body.CorrectOptions[i] = val
+		}
+	}
+	{
+		var zero float64
+		if body.Tolerance == zero {
+			body.Tolerance = 0
+		}
+	}
+	if p.AcceptedAnswers != nil {
+		body.AcceptedAnswers = make([]string, len(p.AcceptedAnswers))
+		for i, val := range p.AcceptedAnswers {
+			body.AcceptedAnswers[i] = val
+		}
+	}
+	return body
+}
+
+// NewAddDrawRuleRequestBody builds the HTTP request body from the payload of
+// the "AddDrawRule" endpoint of the "knowledge" service.
+func NewAddDrawRuleRequestBody(p *knowledge.AddDrawRulePayload) *AddDrawRuleRequestBody {
+	body := &AddDrawRuleRequestBody{
+		BankID:        p.BankID,
+		Topic:         p.Topic,
+		QuestionCount: p.QuestionCount,
+	}
+	if p.Difficulty != nil {
+		difficulty := string(*p.Difficulty)
+		body.Difficulty = &difficulty
 	}
 	return body
 }
@@ -583,6 +1233,14 @@ func NewUpdateQuestionSimpleResponseOK(body *UpdateQuestionResponseBody) *knowle
 	return v
 }
 
+// NewUpdateQuestionInvalidInput builds a knowledge service UpdateQuestion
+// endpoint invalid_input error.
+func NewUpdateQuestionInvalidInput(body string) knowledge.InvalidInput {
+	v := knowledge.InvalidInput(body)
+
+	return v
+}
+
 // NewUpdateQuestionQuestionNotFound builds a knowledge service UpdateQuestion
 // endpoint question_not_found error.
 func NewUpdateQuestionQuestionNotFound(body string) knowledge.QuestionNotFound {
@@ -642,92 +1300,629 @@ func NewDeleteQuestionUnauthorized(body string) knowledge.Unauthorized {
 	return v
 }
 
-// NewGetAvailableTestsTestsResponseOK builds a "knowledge" service
-// "GetAvailableTests" endpoint result from a HTTP "OK" response.
-func NewGetAvailableTestsTestsResponseOK(body *GetAvailableTestsResponseBody) *knowledge.TestsResponse {
-	v := &knowledge.TestsResponse{}
-	v.Tests = make([]*knowledge.Test, len(body.Tests))
-	for i, val := range body.Tests {
-		v.Tests[i] = unmarshalTestResponseBodyToKnowledgeTest(val)
+// NewCreateQuestionBankSimpleResponseCreated builds a "knowledge" service
+// "CreateQuestionBank" endpoint result from a HTTP "Created" response.
+func NewCreateQuestionBankSimpleResponseCreated(body *CreateQuestionBankResponseBody) *knowledge.SimpleResponse {
+	v := &knowledge.SimpleResponse{
+		Success: *body.Success,
+		Message: *body.Message,
 	}
 
 	return v
 }
 
-// NewGetAvailableTestsUnauthorized builds a knowledge service
-// GetAvailableTests endpoint unauthorized error.
-func NewGetAvailableTestsUnauthorized(body string) knowledge.Unauthorized {
+// NewCreateQuestionBankInvalidInput builds a knowledge service
+// CreateQuestionBank endpoint invalid_input error.
+func NewCreateQuestionBankInvalidInput(body string) knowledge.InvalidInput {
+	v := knowledge.InvalidInput(body)
+
+	return v
+}
+
+// NewCreateQuestionBankUnauthorized builds a knowledge service
+// CreateQuestionBank endpoint unauthorized error.
+func NewCreateQuestionBankUnauthorized(body string) knowledge.Unauthorized {
 	v := knowledge.Unauthorized(body)
 
 	return v
 }
 
-// NewGetTestFormFormResponseOK builds a "knowledge" service "GetTestForm"
-// endpoint result from a HTTP "OK" response.
-func NewGetTestFormFormResponseOK(body *GetTestFormResponseBody) *knowledge.FormResponse {
-	v := &knowledge.FormResponse{}
-	v.Test = unmarshalTestResponseBodyToKnowledgeTest(body.Test)
-	v.Questions = make([]*knowledge.QuestionForm, len(body.Questions))
-	for i, val := range body.Questions {
-		v.Questions[i] = unmarshalQuestionFormResponseBodyToKnowledgeQuestionForm(val)
+// NewGetMyQuestionBanksQuestionBanksResponseOK builds a "knowledge" service
+// "GetMyQuestionBanks" endpoint result from a HTTP "OK" response.
+func NewGetMyQuestionBanksQuestionBanksResponseOK(body *GetMyQuestionBanksResponseBody) *knowledge.QuestionBanksResponse {
+	v := &knowledge.QuestionBanksResponse{}
+	v.Banks = make([]*knowledge.QuestionBank, len(body.Banks))
+	for i, val := range body.Banks {
+		v.Banks[i] = unmarshalQuestionBankResponseBodyToKnowledgeQuestionBank(val)
 	}
 
 	return v
 }
 
-// NewGetTestFormTestAlreadySubmitted builds a knowledge service GetTestForm
-// endpoint test_already_submitted error.
-func NewGetTestFormTestAlreadySubmitted(body string) knowledge.TestAlreadySubmitted {
-	v := knowledge.TestAlreadySubmitted(body)
+// NewGetMyQuestionBanksUnauthorized builds a knowledge service
+// GetMyQuestionBanks endpoint unauthorized error.
+func NewGetMyQuestionBanksUnauthorized(body string) knowledge.Unauthorized {
+	v := knowledge.Unauthorized(body)
 
 	return v
 }
 
-// NewGetTestFormTestNotFound builds a knowledge service GetTestForm endpoint
-// test_not_found error.
-func NewGetTestFormTestNotFound(body string) knowledge.TestNotFound {
-	v := knowledge.TestNotFound(body)
+// NewDeleteQuestionBankSimpleResponseOK builds a "knowledge" service
+// "DeleteQuestionBank" endpoint result from a HTTP "OK" response.
+func NewDeleteQuestionBankSimpleResponseOK(body *DeleteQuestionBankResponseBody) *knowledge.SimpleResponse {
+	v := &knowledge.SimpleResponse{
+		Success: *body.Success,
+		Message: *body.Message,
+	}
 
 	return v
 }
 
-// NewGetTestFormUnauthorized builds a knowledge service GetTestForm endpoint
-// unauthorized error.
-func NewGetTestFormUnauthorized(body string) knowledge.Unauthorized {
+// NewDeleteQuestionBankInvalidInput builds a knowledge service
+// DeleteQuestionBank endpoint invalid_input error.
+func NewDeleteQuestionBankInvalidInput(body string) knowledge.InvalidInput {
+	v := knowledge.InvalidInput(body)
+
+	return v
+}
+
+// NewDeleteQuestionBankUnauthorized builds a knowledge service
+// DeleteQuestionBank endpoint unauthorized error.
+func NewDeleteQuestionBankUnauthorized(body string) knowledge.Unauthorized {
 	v := knowledge.Unauthorized(body)
 
 	return v
 }
 
-// NewSubmitTestSubmitResponseOK builds a "knowledge" service "SubmitTest"
-// endpoint result from a HTTP "OK" response.
-func NewSubmitTestSubmitResponseOK(body *SubmitTestResponseBody) *knowledge.SubmitResponse {
-	v := &knowledge.SubmitResponse{
-		Success:      *body.Success,
-		Message:      *body.Message,
-		SubmissionID: *body.SubmissionID,
-		Score:        *body.Score,
+// NewGetBankQuestionsBankQuestionsResponseOK builds a "knowledge" service
+// "GetBankQuestions" endpoint result from a HTTP "OK" response.
+func NewGetBankQuestionsBankQuestionsResponseOK(body *GetBankQuestionsResponseBody) *knowledge.BankQuestionsResponse {
+	v := &knowledge.BankQuestionsResponse{}
+	v.Questions = make([]*knowledge.BankQuestion, len(body.Questions))
+	for i, val := range body.Questions {
+		v.Questions[i] = unmarshalBankQuestionResponseBodyToKnowledgeBankQuestion(val)
 	}
 
 	return v
 }
 
-// NewSubmitTestInvalidInput builds a knowledge service SubmitTest endpoint
-// invalid_input error.
-func NewSubmitTestInvalidInput(body string) knowledge.InvalidInput {
+// NewGetBankQuestionsInvalidInput builds a knowledge service GetBankQuestions
+// endpoint invalid_input error.
+func NewGetBankQuestionsInvalidInput(body string) knowledge.InvalidInput {
 	v := knowledge.InvalidInput(body)
 
 	return v
 }
 
-// NewSubmitTestTestAlreadySubmitted builds a knowledge service SubmitTest
-// endpoint test_already_submitted error.
-func NewSubmitTestTestAlreadySubmitted(body string) knowledge.TestAlreadySubmitted {
+// NewGetBankQuestionsUnauthorized builds a knowledge service GetBankQuestions
+// endpoint unauthorized error.
+func NewGetBankQuestionsUnauthorized(body string) knowledge.Unauthorized {
+	v := knowledge.Unauthorized(body)
+
+	return v
+}
+
+// NewAddBankQuestionSimpleResponseCreated builds a "knowledge" service
+// "AddBankQuestion" endpoint result from a HTTP "Created" response.
+func NewAddBankQuestionSimpleResponseCreated(body *AddBankQuestionResponseBody) *knowledge.SimpleResponse {
+	v := &knowledge.SimpleResponse{
+		Success: *body.Success,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewAddBankQuestionInvalidInput builds a knowledge service AddBankQuestion
+// endpoint invalid_input error.
+func NewAddBankQuestionInvalidInput(body string) knowledge.InvalidInput {
+	v := knowledge.InvalidInput(body)
+
+	return v
+}
+
+// NewAddBankQuestionUnauthorized builds a knowledge service AddBankQuestion
+// endpoint unauthorized error.
+func NewAddBankQuestionUnauthorized(body string) knowledge.Unauthorized {
+	v := knowledge.Unauthorized(body)
+
+	return v
+}
+
+// NewUpdateBankQuestionSimpleResponseOK builds a "knowledge" service
+// "UpdateBankQuestion" endpoint result from a HTTP "OK" response.
+func NewUpdateBankQuestionSimpleResponseOK(body *UpdateBankQuestionResponseBody) *knowledge.SimpleResponse {
+	v := &knowledge.SimpleResponse{
+		Success: *body.Success,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewUpdateBankQuestionInvalidInput builds a knowledge service
+// UpdateBankQuestion endpoint invalid_input error.
+func NewUpdateBankQuestionInvalidInput(body string) knowledge.InvalidInput {
+	v := knowledge.InvalidInput(body)
+
+	return v
+}
+
+// NewUpdateBankQuestionQuestionNotFound builds a knowledge service
+// UpdateBankQuestion endpoint question_not_found error.
+func NewUpdateBankQuestionQuestionNotFound(body string) knowledge.QuestionNotFound {
+	v := knowledge.QuestionNotFound(body)
+
+	return v
+}
+
+// NewUpdateBankQuestionUnauthorized builds a knowledge service
+// UpdateBankQuestion endpoint unauthorized error.
+func NewUpdateBankQuestionUnauthorized(body string) knowledge.Unauthorized {
+	v := knowledge.Unauthorized(body)
+
+	return v
+}
+
+// NewDeleteBankQuestionSimpleResponseOK builds a "knowledge" service
+// "DeleteBankQuestion" endpoint result from a HTTP "OK" response.
+func NewDeleteBankQuestionSimpleResponseOK(body *DeleteBankQuestionResponseBody) *knowledge.SimpleResponse {
+	v := &knowledge.SimpleResponse{
+		Success: *body.Success,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewDeleteBankQuestionInvalidInput builds a knowledge service
+// DeleteBankQuestion endpoint invalid_input error.
+func NewDeleteBankQuestionInvalidInput(body string) knowledge.InvalidInput {
+	v := knowledge.InvalidInput(body)
+
+	return v
+}
+
+// NewDeleteBankQuestionQuestionNotFound builds a knowledge service
+// DeleteBankQuestion endpoint question_not_found error.
+func NewDeleteBankQuestionQuestionNotFound(body string) knowledge.QuestionNotFound {
+	v := knowledge.QuestionNotFound(body)
+
+	return v
+}
+
+// NewDeleteBankQuestionUnauthorized builds a knowledge service
+// DeleteBankQuestion endpoint unauthorized error.
+func NewDeleteBankQuestionUnauthorized(body string) knowledge.Unauthorized {
+	v := knowledge.Unauthorized(body)
+
+	return v
+}
+
+// NewAddDrawRuleSimpleResponseCreated builds a "knowledge" service
+// "AddDrawRule" endpoint result from a HTTP "Created" response.
+func NewAddDrawRuleSimpleResponseCreated(body *AddDrawRuleResponseBody) *knowledge.SimpleResponse {
+	v := &knowledge.SimpleResponse{
+		Success: *body.Success,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewAddDrawRuleInvalidInput builds a knowledge service AddDrawRule endpoint
+// invalid_input error.
+func NewAddDrawRuleInvalidInput(body string) knowledge.InvalidInput {
+	v := knowledge.InvalidInput(body)
+
+	return v
+}
+
+// NewAddDrawRuleTestNotFound builds a knowledge service AddDrawRule endpoint
+// test_not_found error.
+func NewAddDrawRuleTestNotFound(body string) knowledge.TestNotFound {
+	v := knowledge.TestNotFound(body)
+
+	return v
+}
+
+// NewAddDrawRuleUnauthorized builds a knowledge service AddDrawRule endpoint
+// unauthorized error.
+func NewAddDrawRuleUnauthorized(body string) knowledge.Unauthorized {
+	v := knowledge.Unauthorized(body)
+
+	return v
+}
+
+// NewGetDrawRulesDrawRulesResponseOK builds a "knowledge" service
+// "GetDrawRules" endpoint result from a HTTP "OK" response.
+func NewGetDrawRulesDrawRulesResponseOK(body *GetDrawRulesResponseBody) *knowledge.DrawRulesResponse {
+	v := &knowledge.DrawRulesResponse{}
+	v.Rules = make([]*knowledge.DrawRule, len(body.Rules))
+	for i, val := range body.Rules {
+		v.Rules[i] = unmarshalDrawRuleResponseBodyToKnowledgeDrawRule(val)
+	}
+
+	return v
+}
+
+// NewGetDrawRulesTestNotFound builds a knowledge service GetDrawRules endpoint
+// test_not_found error.
+func NewGetDrawRulesTestNotFound(body string) knowledge.TestNotFound {
+	v := knowledge.TestNotFound(body)
+
+	return v
+}
+
+// NewGetDrawRulesUnauthorized builds a knowledge service GetDrawRules endpoint
+// unauthorized error.
+func NewGetDrawRulesUnauthorized(body string) knowledge.Unauthorized {
+	v := knowledge.Unauthorized(body)
+
+	return v
+}
+
+// NewDeleteDrawRuleSimpleResponseOK builds a "knowledge" service
+// "DeleteDrawRule" endpoint result from a HTTP "OK" response.
+func NewDeleteDrawRuleSimpleResponseOK(body *DeleteDrawRuleResponseBody) *knowledge.SimpleResponse {
+	v := &knowledge.SimpleResponse{
+		Success: *body.Success,
+		Message: *body.Message,
+	}
+
+	return v
+}
+
+// NewDeleteDrawRuleInvalidInput builds a knowledge service DeleteDrawRule
+// endpoint invalid_input error.
+func NewDeleteDrawRuleInvalidInput(body string) knowledge.InvalidInput {
+	v := knowledge.InvalidInput(body)
+
+	return v
+}
+
+// NewDeleteDrawRuleTestNotFound builds a knowledge service DeleteDrawRule
+// endpoint test_not_found error.
+func NewDeleteDrawRuleTestNotFound(body string) knowledge.TestNotFound {
+	v := knowledge.TestNotFound(body)
+
+	return v
+}
+
+// NewDeleteDrawRuleUnauthorized builds a knowledge service DeleteDrawRule
+// endpoint unauthorized error.
+func NewDeleteDrawRuleUnauthorized(body string) knowledge.Unauthorized {
+	v := knowledge.Unauthorized(body)
+
+	return v
+}
+
+// NewGetTestAnalyticsTestAnalyticsOK builds a "knowledge" service
+// "GetTestAnalytics" endpoint result from a HTTP "OK" response.
+func NewGetTestAnalyticsTestAnalyticsOK(body *GetTestAnalyticsResponseBody) *knowledge.TestAnalytics {
+	v := &knowledge.TestAnalytics{
+		TestID:            *body.TestID,
+		Attempts:          *body.Attempts,
+		Mean:              *body.Mean,
+		Median:            *body.Median,
+		StdDev:            *body.StdDev,
+		Reliability:       body.Reliability,
+		ReliabilityMethod: body.ReliabilityMethod,
+	}
+	v.ScoreHistogram = make([]*knowledge.ScoreBin, len(body.ScoreHistogram))
+	for i, val := range body.ScoreHistogram {
+		v.ScoreHistogram[i] = unmarshalScoreBinResponseBodyToKnowledgeScoreBin(val)
+	}
+	v.Questions = make([]*knowledge.QuestionAnalytics, len(body.Questions))
+	for i, val := range body.Questions {
+		v.Questions[i] = unmarshalQuestionAnalyticsResponseBodyToKnowledgeQuestionAnalytics(val)
+	}
+
+	return v
+}
+
+// NewGetTestAnalyticsInternalError builds a knowledge service GetTestAnalytics
+// endpoint internal_error error.
+func NewGetTestAnalyticsInternalError(body string) knowledge.InternalError {
+	v := knowledge.InternalError(body)
+
+	return v
+}
+
+// NewGetTestAnalyticsInvalidInput builds a knowledge service GetTestAnalytics
+// endpoint invalid_input error.
+func NewGetTestAnalyticsInvalidInput(body string) knowledge.InvalidInput {
+	v := knowledge.InvalidInput(body)
+
+	return v
+}
+
+// NewGetTestAnalyticsTestNotFound builds a knowledge service GetTestAnalytics
+// endpoint test_not_found error.
+func NewGetTestAnalyticsTestNotFound(body string) knowledge.TestNotFound {
+	v := knowledge.TestNotFound(body)
+
+	return v
+}
+
+// NewGetTestAnalyticsUnauthorized builds a knowledge service GetTestAnalytics
+// endpoint unauthorized error.
+func NewGetTestAnalyticsUnauthorized(body string) knowledge.Unauthorized {
+	v := knowledge.Unauthorized(body)
+
+	return v
+}
+
+// NewGetTestGradebookTestGradebookOK builds a "knowledge" service
+// "GetTestGradebook" endpoint result from a HTTP "OK" response.
+func NewGetTestGradebookTestGradebookOK(body *GetTestGradebookResponseBody) *knowledge.TestGradebook {
+	v := &knowledge.TestGradebook{}
+	v.Test = unmarshalTestResponseBodyToKnowledgeTest(body.Test)
+	v.Submissions = make([]*knowledge.StudentSubmission, len(body.Submissions))
+	for i, val := range body.Submissions {
+		v.Submissions[i] = unmarshalStudentSubmissionResponseBodyToKnowledgeStudentSubmission(val)
+	}
+
+	return v
+}
+
+// NewGetTestGradebookInvalidInput builds a knowledge service GetTestGradebook
+// endpoint invalid_input error.
+func NewGetTestGradebookInvalidInput(body string) knowledge.InvalidInput {
+	v := knowledge.InvalidInput(body)
+
+	return v
+}
+
+// NewGetTestGradebookTestNotFound builds a knowledge service GetTestGradebook
+// endpoint test_not_found error.
+func NewGetTestGradebookTestNotFound(body string) knowledge.TestNotFound {
+	v := knowledge.TestNotFound(body)
+
+	return v
+}
+
+// NewGetTestGradebookUnauthorized builds a knowledge service GetTestGradebook
+// endpoint unauthorized error.
+func NewGetTestGradebookUnauthorized(body string) knowledge.Unauthorized {
+	v := knowledge.Unauthorized(body)
+
+	return v
+}
+
+// NewGetStudentHistoryStudentHistoryOK builds a "knowledge" service
+// "GetStudentHistory" endpoint result from a HTTP "OK" response.
+func NewGetStudentHistoryStudentHistoryOK(body *GetStudentHistoryResponseBody) *knowledge.StudentHistory {
+	v := &knowledge.StudentHistory{
+		UserID:      *body.UserID,
+		StudentName: *body.StudentName,
+	}
+	v.Submissions = make([]*knowledge.Submission, len(body.Submissions))
+	for i, val := range body.Submissions {
+		v.Submissions[i] = unmarshalSubmissionResponseBodyToKnowledgeSubmission(val)
+	}
+	v.TestScores = make([]*knowledge.TestScore, len(body.TestScores))
+	for i, val := range body.TestScores {
+		v.TestScores[i] = unmarshalTestScoreResponseBodyToKnowledgeTestScore(val)
+	}
+
+	return v
+}
+
+// NewGetStudentHistoryInvalidInput builds a knowledge service
+// GetStudentHistory endpoint invalid_input error.
+func NewGetStudentHistoryInvalidInput(body string) knowledge.InvalidInput {
+	v := knowledge.InvalidInput(body)
+
+	return v
+}
+
+// NewGetStudentHistorySubmissionNotFound builds a knowledge service
+// GetStudentHistory endpoint submission_not_found error.
+func NewGetStudentHistorySubmissionNotFound(body string) knowledge.SubmissionNotFound {
+	v := knowledge.SubmissionNotFound(body)
+
+	return v
+}
+
+// NewGetStudentHistoryUnauthorized builds a knowledge service
+// GetStudentHistory endpoint unauthorized error.
+func NewGetStudentHistoryUnauthorized(body string) knowledge.Unauthorized {
+	v := knowledge.Unauthorized(body)
+
+	return v
+}
+
+// NewGetAvailableTestsTestsResponseOK builds a "knowledge" service
+// "GetAvailableTests" endpoint result from a HTTP "OK" response.
+func NewGetAvailableTestsTestsResponseOK(body *GetAvailableTestsResponseBody) *knowledge.TestsResponse {
+	v := &knowledge.TestsResponse{}
+	v.Tests = make([]*knowledge.Test, len(body.Tests))
+	for i, val := range body.Tests {
+		v.Tests[i] = unmarshalTestResponseBodyToKnowledgeTest(val)
+	}
+
+	return v
+}
+
+// NewGetAvailableTestsUnauthorized builds a knowledge service
+// GetAvailableTests endpoint unauthorized error.
+func NewGetAvailableTestsUnauthorized(body string) knowledge.Unauthorized {
+	v := knowledge.Unauthorized(body)
+
+	return v
+}
+
+// NewStartTestTestAttemptOK builds a "knowledge" service "StartTest" endpoint
+// result from a HTTP "OK" response.
+func NewStartTestTestAttemptOK(body *StartTestResponseBody) *knowledge.TestAttempt {
+	v := &knowledge.TestAttempt{
+		SubmissionID:    *body.SubmissionID,
+		TestID:          *body.TestID,
+		Status:          knowledge.AttemptStatus(*body.Status),
+		StartedAt:       *body.StartedAt,
+		Deadline:        body.Deadline,
+		DurationMinutes: body.DurationMinutes,
+	}
+
+	return v
+}
+
+// NewStartTestInternalError builds a knowledge service StartTest endpoint
+// internal_error error.
+func NewStartTestInternalError(body string) knowledge.InternalError {
+	v := knowledge.InternalError(body)
+
+	return v
+}
+
+// NewStartTestInvalidInput builds a knowledge service StartTest endpoint
+// invalid_input error.
+func NewStartTestInvalidInput(body string) knowledge.InvalidInput {
+	v := knowledge.InvalidInput(body)
+
+	return v
+}
+
+// NewStartTestTestAlreadySubmitted builds a knowledge service StartTest
+// endpoint test_already_submitted error.
+func NewStartTestTestAlreadySubmitted(body string) knowledge.TestAlreadySubmitted {
+	v := knowledge.TestAlreadySubmitted(body)
+
+	return v
+}
+
+// NewStartTestTimeExpired builds a knowledge service StartTest endpoint
+// time_expired error.
+func NewStartTestTimeExpired(body string) knowledge.TimeExpired {
+	v := knowledge.TimeExpired(body)
+
+	return v
+}
+
+// NewStartTestTestNotFound builds a knowledge service StartTest endpoint
+// test_not_found error.
+func NewStartTestTestNotFound(body string) knowledge.TestNotFound {
+	v := knowledge.TestNotFound(body)
+
+	return v
+}
+
+// NewStartTestUnauthorized builds a knowledge service StartTest endpoint
+// unauthorized error.
+func NewStartTestUnauthorized(body string) knowledge.Unauthorized {
+	v := knowledge.Unauthorized(body)
+
+	return v
+}
+
+// NewGetTestFormFormResponseOK builds a "knowledge" service "GetTestForm"
+// endpoint result from a HTTP "OK" response.
+func NewGetTestFormFormResponseOK(body *GetTestFormResponseBody) *knowledge.FormResponse {
+	v := &knowledge.FormResponse{}
+	v.Test = unmarshalTestResponseBodyToKnowledgeTest(body.Test)
+	v.Questions = make([]*knowledge.QuestionForm, len(body.Questions))
+	for i, val := range body.Questions {
+		v.Questions[i] = unmarshalQuestionFormResponseBodyToKnowledgeQuestionForm(val)
+	}
+
+	return v
+}
+
+// NewGetTestFormInternalError builds a knowledge service GetTestForm endpoint
+// internal_error error.
+func NewGetTestFormInternalError(body string) knowledge.InternalError {
+	v := knowledge.InternalError(body)
+
+	return v
+}
+
+// NewGetTestFormInvalidInput builds a knowledge service GetTestForm endpoint
+// invalid_input error.
+func NewGetTestFormInvalidInput(body string) knowledge.InvalidInput {
+	v := knowledge.InvalidInput(body)
+
+	return v
+}
+
+// NewGetTestFormTestAlreadySubmitted builds a knowledge service GetTestForm
+// endpoint test_already_submitted error.
+func NewGetTestFormTestAlreadySubmitted(body string) knowledge.TestAlreadySubmitted {
+	v := knowledge.TestAlreadySubmitted(body)
+
+	return v
+}
+
+// NewGetTestFormTimeExpired builds a knowledge service GetTestForm endpoint
+// time_expired error.
+func NewGetTestFormTimeExpired(body string) knowledge.TimeExpired {
+	v := knowledge.TimeExpired(body)
+
+	return v
+}
+
+// NewGetTestFormTestNotFound builds a knowledge service GetTestForm endpoint
+// test_not_found error.
+func NewGetTestFormTestNotFound(body string) knowledge.TestNotFound {
+	v := knowledge.TestNotFound(body)
+
+	return v
+}
+
+// NewGetTestFormUnauthorized builds a knowledge service GetTestForm endpoint
+// unauthorized error.
+func NewGetTestFormUnauthorized(body string) knowledge.Unauthorized {
+	v := knowledge.Unauthorized(body)
+
+	return v
+}
+
+// NewSubmitTestSubmitResponseOK builds a "knowledge" service "SubmitTest"
+// endpoint result from a HTTP "OK" response.
+func NewSubmitTestSubmitResponseOK(body *SubmitTestResponseBody) *knowledge.SubmitResponse {
+	v := &knowledge.SubmitResponse{
+		Success:       *body.Success,
+		Message:       *body.Message,
+		SubmissionID:  *body.SubmissionID,
+		Score:         *body.Score,
+		AttemptNumber: *body.AttemptNumber,
+	}
+
+	return v
+}
+
+// NewSubmitTestInternalError builds a knowledge service SubmitTest endpoint
+// internal_error error.
+func NewSubmitTestInternalError(body string) knowledge.InternalError {
+	v := knowledge.InternalError(body)
+
+	return v
+}
+
+// NewSubmitTestInvalidInput builds a knowledge service SubmitTest endpoint
+// invalid_input error.
+func NewSubmitTestInvalidInput(body string) knowledge.InvalidInput {
+	v := knowledge.InvalidInput(body)
+
+	return v
+}
+
+// NewSubmitTestTestAlreadySubmitted builds a knowledge service SubmitTest
+// endpoint test_already_submitted error.
+func NewSubmitTestTestAlreadySubmitted(body string) knowledge.TestAlreadySubmitted {
 	v := knowledge.TestAlreadySubmitted(body)
 
 	return v
 }
 
+// NewSubmitTestTimeExpired builds a knowledge service SubmitTest endpoint
+// time_expired error.
+func NewSubmitTestTimeExpired(body string) knowledge.TimeExpired {
+	v := knowledge.TimeExpired(body)
+
+	return v
+}
+
 // NewSubmitTestTestNotFound builds a knowledge service SubmitTest endpoint
 // test_not_found error.
 func NewSubmitTestTestNotFound(body string) knowledge.TestNotFound {
@@ -752,6 +1947,10 @@ func NewGetMySubmissionsSubmissionsResponseOK(body *GetMySubmissionsResponseBody
 	for i, val := range body.Submissions {
 		v.Submissions[i] = unmarshalSubmissionResponseBodyToKnowledgeSubmission(val)
 	}
+	v.TestScores = make([]*knowledge.TestScore, len(body.TestScores))
+	for i, val := range body.TestScores {
+		v.TestScores[i] = unmarshalTestScoreResponseBodyToKnowledgeTestScore(val)
+	}
 
 	return v
 }
@@ -792,12 +1991,27 @@ func NewGetSubmissionByIDUnauthorized(body string) knowledge.Unauthorized {
 // NewGetSubmissionResultSubmissionResultOK builds a "knowledge" service
 // "GetSubmissionResult" endpoint result from a HTTP "OK" response.
 func NewGetSubmissionResultSubmissionResultOK(body *GetSubmissionResultResponseBody) *knowledge.SubmissionResult {
-	v := &knowledge.SubmissionResult{}
+	v := &knowledge.SubmissionResult{
+		CountedScore:    *body.CountedScore,
+		AnswersRevealed: *body.AnswersRevealed,
+	}
 	v.Submission = unmarshalSubmissionResponseBodyToKnowledgeSubmission(body.Submission)
 	v.Questions = make([]*knowledge.QuestionResult, len(body.Questions))
 	for i, val := range body.Questions {
 		v.Questions[i] = unmarshalQuestionResultResponseBodyToKnowledgeQuestionResult(val)
 	}
+	v.Attempts = make([]*knowledge.Submission, len(body.Attempts))
+	for i, val := range body.Attempts {
+		v.Attempts[i] = unmarshalSubmissionResponseBodyToKnowledgeSubmission(val)
+	}
+
+	return v
+}
+
+// NewGetSubmissionResultInvalidInput builds a knowledge service
+// GetSubmissionResult endpoint invalid_input error.
+func NewGetSubmissionResultInvalidInput(body string) knowledge.InvalidInput {
+	v := knowledge.InvalidInput(body)
 
 	return v
 }
@@ -872,9 +2086,179 @@ func ValidateUpdateTestResponseBody(body *UpdateTestResponseBody) (err error) {
 	return
 }
 
-// ValidateDeleteTestResponseBody runs the validations defined on
-// DeleteTestResponseBody
-func ValidateDeleteTestResponseBody(body *DeleteTestResponseBody) (err error) {
+// ValidateDeleteTestResponseBody runs the validations defined on
+// DeleteTestResponseBody
+func ValidateDeleteTestResponseBody(body *DeleteTestResponseBody) (err error) {
+	if body.Success == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("success", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateGetTestQuestionsResponseBody runs the validations defined on
+// GetTestQuestionsResponseBody
+func ValidateGetTestQuestionsResponseBody(body *GetTestQuestionsResponseBody) (err error) {
+	if body.Questions == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("questions", "body"))
+	}
+	for _, e := range body.Questions {
+		if e != nil {
+			if err2 := ValidateQuestionResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateAddQuestionResponseBody runs the validations defined on
+// AddQuestionResponseBody
+func ValidateAddQuestionResponseBody(body *AddQuestionResponseBody) (err error) {
+	if body.Success == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("success", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateGetQuestionByIDResponseBody runs the validations defined on
+// GetQuestionByIdResponseBody
+func ValidateGetQuestionByIDResponseBody(body *GetQuestionByIDResponseBody) (err error) {
+	if body.Question == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("question", "body"))
+	}
+	if body.Question != nil {
+		if err2 := ValidateQuestionResponseBody(body.Question); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	return
+}
+
+// ValidateUpdateQuestionResponseBody runs the validations defined on
+// UpdateQuestionResponseBody
+func ValidateUpdateQuestionResponseBody(body *UpdateQuestionResponseBody) (err error) {
+	if body.Success == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("success", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateDeleteQuestionResponseBody runs the validations defined on
+// DeleteQuestionResponseBody
+func ValidateDeleteQuestionResponseBody(body *DeleteQuestionResponseBody) (err error) {
+	if body.Success == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("success", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateCreateQuestionBankResponseBody runs the validations defined on
+// CreateQuestionBankResponseBody
+func ValidateCreateQuestionBankResponseBody(body *CreateQuestionBankResponseBody) (err error) {
+	if body.Success == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("success", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateGetMyQuestionBanksResponseBody runs the validations defined on
+// GetMyQuestionBanksResponseBody
+func ValidateGetMyQuestionBanksResponseBody(body *GetMyQuestionBanksResponseBody) (err error) {
+	if body.Banks == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("banks", "body"))
+	}
+	for _, e := range body.Banks {
+		if e != nil {
+			if err2 := ValidateQuestionBankResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateDeleteQuestionBankResponseBody runs the validations defined on
+// DeleteQuestionBankResponseBody
+func ValidateDeleteQuestionBankResponseBody(body *DeleteQuestionBankResponseBody) (err error) {
+	if body.Success == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("success", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateGetBankQuestionsResponseBody runs the validations defined on
+// GetBankQuestionsResponseBody
+func ValidateGetBankQuestionsResponseBody(body *GetBankQuestionsResponseBody) (err error) {
+	if body.Questions == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("questions", "body"))
+	}
+	for _, e := range body.Questions {
+		if e != nil {
+			if err2 := ValidateBankQuestionResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateAddBankQuestionResponseBody runs the validations defined on
+// AddBankQuestionResponseBody
+func ValidateAddBankQuestionResponseBody(body *AddBankQuestionResponseBody) (err error) {
+	if body.Success == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("success", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateUpdateBankQuestionResponseBody runs the validations defined on
+// UpdateBankQuestionResponseBody
+func ValidateUpdateBankQuestionResponseBody(body *UpdateBankQuestionResponseBody) (err error) {
+	if body.Success == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("success", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateDeleteBankQuestionResponseBody runs the validations defined on
+// DeleteBankQuestionResponseBody
+func ValidateDeleteBankQuestionResponseBody(body *DeleteBankQuestionResponseBody) (err error) {
+	if body.Success == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("success", "body"))
+	}
+	if body.Message == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	}
+	return
+}
+
+// ValidateAddDrawRuleResponseBody runs the validations defined on
+// AddDrawRuleResponseBody
+func ValidateAddDrawRuleResponseBody(body *AddDrawRuleResponseBody) (err error) {
 	if body.Success == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("success", "body"))
 	}
@@ -884,15 +2268,15 @@ func ValidateDeleteTestResponseBody(body *DeleteTestResponseBody) (err error) {
 	return
 }
 
-// ValidateGetTestQuestionsResponseBody runs the validations defined on
-// GetTestQuestionsResponseBody
-func ValidateGetTestQuestionsResponseBody(body *GetTestQuestionsResponseBody) (err error) {
-	if body.Questions == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("questions", "body"))
+// ValidateGetDrawRulesResponseBody runs the validations defined on
+// GetDrawRulesResponseBody
+func ValidateGetDrawRulesResponseBody(body *GetDrawRulesResponseBody) (err error) {
+	if body.Rules == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("rules", "body"))
 	}
-	for _, e := range body.Questions {
+	for _, e := range body.Rules {
 		if e != nil {
-			if err2 := ValidateQuestionResponseBody(e); err2 != nil {
+			if err2 := ValidateDrawRuleResponseBody(e); err2 != nil {
 				err = goa.MergeErrors(err, err2)
 			}
 		}
@@ -900,9 +2284,9 @@ func ValidateGetTestQuestionsResponseBody(body *GetTestQuestionsResponseBody) (e
 	return
 }
 
-// ValidateAddQuestionResponseBody runs the validations defined on
-// AddQuestionResponseBody
-func ValidateAddQuestionResponseBody(body *AddQuestionResponseBody) (err error) {
+// ValidateDeleteDrawRuleResponseBody runs the validations defined on
+// DeleteDrawRuleResponseBody
+func ValidateDeleteDrawRuleResponseBody(body *DeleteDrawRuleResponseBody) (err error) {
 	if body.Success == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("success", "body"))
 	}
@@ -912,40 +2296,104 @@ func ValidateAddQuestionResponseBody(body *AddQuestionResponseBody) (err error)
 	return
 }
 
-// ValidateGetQuestionByIDResponseBody runs the validations defined on
-// GetQuestionByIdResponseBody
-func ValidateGetQuestionByIDResponseBody(body *GetQuestionByIDResponseBody) (err error) {
-	if body.Question == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("question", "body"))
+// ValidateGetTestAnalyticsResponseBody runs the validations defined on
+// GetTestAnalyticsResponseBody
+func ValidateGetTestAnalyticsResponseBody(body *GetTestAnalyticsResponseBody) (err error) {
+	if body.TestID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("test_id", "body"))
 	}
-	if body.Question != nil {
-		if err2 := ValidateQuestionResponseBody(body.Question); err2 != nil {
-			err = goa.MergeErrors(err, err2)
+	if body.Attempts == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("attempts", "body"))
+	}
+	if body.Mean == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("mean", "body"))
+	}
+	if body.Median == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("median", "body"))
+	}
+	if body.StdDev == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("std_dev", "body"))
+	}
+	if body.ScoreHistogram == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("score_histogram", "body"))
+	}
+	if body.Questions == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("questions", "body"))
+	}
+	for _, e := range body.ScoreHistogram {
+		if e != nil {
+			if err2 := ValidateScoreBinResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	for _, e := range body.Questions {
+		if e != nil {
+			if err2 := ValidateQuestionAnalyticsResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	if body.ReliabilityMethod != nil {
+		if !(*body.ReliabilityMethod == "kr20" || *body.ReliabilityMethod == "alpha") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.reliability_method", *body.ReliabilityMethod, []any{"kr20", "alpha"}))
 		}
 	}
 	return
 }
 
-// ValidateUpdateQuestionResponseBody runs the validations defined on
-// UpdateQuestionResponseBody
-func ValidateUpdateQuestionResponseBody(body *UpdateQuestionResponseBody) (err error) {
-	if body.Success == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("success", "body"))
+// ValidateGetTestGradebookResponseBody runs the validations defined on
+// GetTestGradebookResponseBody
+func ValidateGetTestGradebookResponseBody(body *GetTestGradebookResponseBody) (err error) {
+	if body.Test == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("test", "body"))
 	}
-	if body.Message == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	if body.Submissions == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("submissions", "body"))
+	}
+	if body.Test != nil {
+		if err2 := ValidateTestResponseBody(body.Test); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
+	for _, e := range body.Submissions {
+		if e != nil {
+			if err2 := ValidateStudentSubmissionResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
 	}
 	return
 }
 
-// ValidateDeleteQuestionResponseBody runs the validations defined on
-// DeleteQuestionResponseBody
-func ValidateDeleteQuestionResponseBody(body *DeleteQuestionResponseBody) (err error) {
-	if body.Success == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("success", "body"))
+// ValidateGetStudentHistoryResponseBody runs the validations defined on
+// GetStudentHistoryResponseBody
+func ValidateGetStudentHistoryResponseBody(body *GetStudentHistoryResponseBody) (err error) {
+	if body.UserID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("user_id", "body"))
 	}
-	if body.Message == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("message", "body"))
+	if body.StudentName == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("student_name", "body"))
+	}
+	if body.Submissions == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("submissions", "body"))
+	}
+	if body.TestScores == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("test_scores", "body"))
+	}
+	for _, e := range body.Submissions {
+		if e != nil {
+			if err2 := ValidateSubmissionResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	for _, e := range body.TestScores {
+		if e != nil {
+			if err2 := ValidateTestScoreResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
 	}
 	return
 }
@@ -966,6 +2414,29 @@ func ValidateGetAvailableTestsResponseBody(body *GetAvailableTestsResponseBody)
 	return
 }
 
+// ValidateStartTestResponseBody runs the validations defined on
+// StartTestResponseBody
+func ValidateStartTestResponseBody(body *StartTestResponseBody) (err error) {
+	if body.SubmissionID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("submission_id", "body"))
+	}
+	if body.TestID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("test_id", "body"))
+	}
+	if body.Status == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("status", "body"))
+	}
+	if body.StartedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("started_at", "body"))
+	}
+	if body.Status != nil {
+		if !(*body.Status == "in_progress" || *body.Status == "submitted" || *body.Status == "expired") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.status", *body.Status, []any{"in_progress", "submitted", "expired"}))
+		}
+	}
+	return
+}
+
 // ValidateGetTestFormResponseBody runs the validations defined on
 // GetTestFormResponseBody
 func ValidateGetTestFormResponseBody(body *GetTestFormResponseBody) (err error) {
@@ -1005,6 +2476,9 @@ func ValidateSubmitTestResponseBody(body *SubmitTestResponseBody) (err error) {
 	if body.Score == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("score", "body"))
 	}
+	if body.AttemptNumber == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("attempt_number", "body"))
+	}
 	return
 }
 
@@ -1014,6 +2488,9 @@ func ValidateGetMySubmissionsResponseBody(body *GetMySubmissionsResponseBody) (e
 	if body.Submissions == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("submissions", "body"))
 	}
+	if body.TestScores == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("test_scores", "body"))
+	}
 	for _, e := range body.Submissions {
 		if e != nil {
 			if err2 := ValidateSubmissionResponseBody(e); err2 != nil {
@@ -1021,6 +2498,13 @@ func ValidateGetMySubmissionsResponseBody(body *GetMySubmissionsResponseBody) (e
 			}
 		}
 	}
+	for _, e := range body.TestScores {
+		if e != nil {
+			if err2 := ValidateTestScoreResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
 	return
 }
 
@@ -1047,6 +2531,15 @@ func ValidateGetSubmissionResultResponseBody(body *GetSubmissionResultResponseBo
 	if body.Questions == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("questions", "body"))
 	}
+	if body.Attempts == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("attempts", "body"))
+	}
+	if body.CountedScore == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("counted_score", "body"))
+	}
+	if body.AnswersRevealed == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("answers_revealed", "body"))
+	}
 	if body.Submission != nil {
 		if err2 := ValidateSubmissionResponseBody(body.Submission); err2 != nil {
 			err = goa.MergeErrors(err, err2)
@@ -1059,6 +2552,13 @@ func ValidateGetSubmissionResultResponseBody(body *GetSubmissionResultResponseBo
 			}
 		}
 	}
+	for _, e := range body.Attempts {
+		if e != nil {
+			if err2 := ValidateSubmissionResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
 	return
 }
 
@@ -1076,6 +2576,29 @@ func ValidateTestResponseBody(body *TestResponseBody) (err error) {
 	if body.CreatedAt == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("created_at", "body"))
 	}
+	if body.MaxAttempts == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("max_attempts", "body"))
+	}
+	if body.CooldownMinutes == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("cooldown_minutes", "body"))
+	}
+	if body.ScorePolicy == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("score_policy", "body"))
+	}
+	if body.RevealAnswers == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("reveal_answers", "body"))
+	}
+	if body.ShuffleQuestions == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("shuffle_questions", "body"))
+	}
+	if body.ShuffleOptions == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("shuffle_options", "body"))
+	}
+	if body.ScorePolicy != nil {
+		if !(*body.ScorePolicy == "best" || *body.ScorePolicy == "latest" || *body.ScorePolicy == "average") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.score_policy", *body.ScorePolicy, []any{"best", "latest", "average"}))
+		}
+	}
 	return
 }
 
@@ -1091,51 +2614,211 @@ func ValidateQuestionResponseBody(body *QuestionResponseBody) (err error) {
 	if body.QuestionText == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("question_text", "body"))
 	}
-	if body.OptionA == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("option_a", "body"))
+	if body.QuestionType == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("question_type", "body"))
 	}
-	if body.OptionB == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("option_b", "body"))
+	if body.Options == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("options", "body"))
 	}
-	if body.OptionC == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("option_c", "body"))
+	if body.CorrectOptions == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("correct_options", "body"))
 	}
-	if body.OptionD == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("option_d", "body"))
+	if body.Tolerance == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("tolerance", "body"))
 	}
-	if body.CorrectAnswer == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("correct_answer", "body"))
+	if body.AcceptedAnswers == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("accepted_answers", "body"))
 	}
 	if body.QuestionOrder == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("question_order", "body"))
 	}
+	if body.QuestionType != nil {
+		if !(*body.QuestionType == "multiple_choice" || *body.QuestionType == "multiple_select" || *body.QuestionType == "true_false" || *body.QuestionType == "numeric" || *body.QuestionType == "short_text" || *body.QuestionType == "ordering") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.question_type", *body.QuestionType, []any{"multiple_choice", "multiple_select", "true_false", "numeric", "short_text", "ordering"}))
+		}
+	}
 	return
 }
 
-// ValidateQuestionFormResponseBody runs the validations defined on
-// QuestionFormResponseBody
-func ValidateQuestionFormResponseBody(body *QuestionFormResponseBody) (err error) {
+// ValidateQuestionBankResponseBody runs the validations defined on
+// QuestionBankResponseBody
+func ValidateQuestionBankResponseBody(body *QuestionBankResponseBody) (err error) {
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.Title == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("title", "body"))
+	}
+	if body.CreatedBy == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("created_by", "body"))
+	}
+	if body.CreatedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("created_at", "body"))
+	}
+	return
+}
+
+// ValidateBankQuestionResponseBody runs the validations defined on
+// BankQuestionResponseBody
+func ValidateBankQuestionResponseBody(body *BankQuestionResponseBody) (err error) {
 	if body.ID == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
 	}
+	if body.BankID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("bank_id", "body"))
+	}
 	if body.QuestionText == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("question_text", "body"))
 	}
-	if body.OptionA == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("option_a", "body"))
+	if body.QuestionType == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("question_type", "body"))
 	}
-	if body.OptionB == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("option_b", "body"))
+	if body.Options == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("options", "body"))
 	}
-	if body.OptionC == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("option_c", "body"))
+	if body.CorrectOptions == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("correct_options", "body"))
 	}
-	if body.OptionD == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("option_d", "body"))
+	if body.Tolerance == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("tolerance", "body"))
+	}
+	if body.AcceptedAnswers == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("accepted_answers", "body"))
 	}
 	if body.QuestionOrder == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("question_order", "body"))
 	}
+	if body.Topic == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("topic", "body"))
+	}
+	if body.Difficulty == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("difficulty", "body"))
+	}
+	if body.QuestionType != nil {
+		if !(*body.QuestionType == "multiple_choice" || *body.QuestionType == "multiple_select" || *body.QuestionType == "true_false" || *body.QuestionType == "numeric" || *body.QuestionType == "short_text" || *body.QuestionType == "ordering") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.question_type", *body.QuestionType, []any{"multiple_choice", "multiple_select", "true_false", "numeric", "short_text", "ordering"}))
+		}
+	}
+	if body.Difficulty != nil {
+		if !(*body.Difficulty == "easy" || *body.Difficulty == "medium" || *body.Difficulty == "hard") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.difficulty", *body.Difficulty, []any{"easy", "medium", "hard"}))
+		}
+	}
+	return
+}
+
+// ValidateDrawRuleResponseBody runs the validations defined on
+// DrawRuleResponseBody
+func ValidateDrawRuleResponseBody(body *DrawRuleResponseBody) (err error) {
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.TestID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("test_id", "body"))
+	}
+	if body.BankID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("bank_id", "body"))
+	}
+	if body.QuestionCount == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("question_count", "body"))
+	}
+	if body.Difficulty != nil {
+		if !(*body.Difficulty == "easy" || *body.Difficulty == "medium" || *body.Difficulty == "hard") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.difficulty", *body.Difficulty, []any{"easy", "medium", "hard"}))
+		}
+	}
+	return
+}
+
+// ValidateScoreBinResponseBody runs the validations defined on
+// ScoreBinResponseBody
+func ValidateScoreBinResponseBody(body *ScoreBinResponseBody) (err error) {
+	if body.From == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("from", "body"))
+	}
+	if body.To == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("to", "body"))
+	}
+	if body.Count == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("count", "body"))
+	}
+	return
+}
+
+// ValidateQuestionAnalyticsResponseBody runs the validations defined on
+// QuestionAnalyticsResponseBody
+func ValidateQuestionAnalyticsResponseBody(body *QuestionAnalyticsResponseBody) (err error) {
+	if body.QuestionID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("question_id", "body"))
+	}
+	if body.QuestionText == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("question_text", "body"))
+	}
+	if body.QuestionType == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("question_type", "body"))
+	}
+	if body.Attempts == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("attempts", "body"))
+	}
+	if body.DifficultyIndex == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("difficulty_index", "body"))
+	}
+	if body.OptionSelections == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("option_selections", "body"))
+	}
+	if body.Unanswered == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("unanswered", "body"))
+	}
+	if body.QuestionType != nil {
+		if !(*body.QuestionType == "multiple_choice" || *body.QuestionType == "multiple_select" || *body.QuestionType == "true_false" || *body.QuestionType == "numeric" || *body.QuestionType == "short_text" || *body.QuestionType == "ordering") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.question_type", *body.QuestionType, []any{"multiple_choice", "multiple_select", "true_false", "numeric", "short_text", "ordering"}))
+		}
+	}
+	for _, e := range body.OptionSelections {
+		if e != nil {
+			if err2 := ValidateOptionSelectionResponseBody(e); err2 != nil {
+				err = goa.MergeErrors(err, err2)
+			}
+		}
+	}
+	return
+}
+
+// ValidateOptionSelectionResponseBody runs the validations defined on
+// OptionSelectionResponseBody
+func ValidateOptionSelectionResponseBody(body *OptionSelectionResponseBody) (err error) {
+	if body.Index == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("index", "body"))
+	}
+	if body.Option == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("option", "body"))
+	}
+	if body.Count == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("count", "body"))
+	}
+	if body.IsCorrect == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("is_correct", "body"))
+	}
+	return
+}
+
+// ValidateStudentSubmissionResponseBody runs the validations defined on
+// StudentSubmissionResponseBody
+func ValidateStudentSubmissionResponseBody(body *StudentSubmissionResponseBody) (err error) {
+	if body.UserID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("user_id", "body"))
+	}
+	if body.StudentName == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("student_name", "body"))
+	}
+	if body.Submission == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("submission", "body"))
+	}
+	if body.Submission != nil {
+		if err2 := ValidateSubmissionResponseBody(body.Submission); err2 != nil {
+			err = goa.MergeErrors(err, err2)
+		}
+	}
 	return
 }
 
@@ -1157,6 +2840,72 @@ func ValidateSubmissionResponseBody(body *SubmissionResponseBody) (err error) {
 	if body.SubmittedAt == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("submitted_at", "body"))
 	}
+	if body.Status == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("status", "body"))
+	}
+	if body.StartedAt == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("started_at", "body"))
+	}
+	if body.AttemptNumber == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("attempt_number", "body"))
+	}
+	if body.Status != nil {
+		if !(*body.Status == "in_progress" || *body.Status == "submitted" || *body.Status == "expired") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.status", *body.Status, []any{"in_progress", "submitted", "expired"}))
+		}
+	}
+	return
+}
+
+// ValidateTestScoreResponseBody runs the validations defined on
+// TestScoreResponseBody
+func ValidateTestScoreResponseBody(body *TestScoreResponseBody) (err error) {
+	if body.TestID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("test_id", "body"))
+	}
+	if body.TestTitle == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("test_title", "body"))
+	}
+	if body.ScorePolicy == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("score_policy", "body"))
+	}
+	if body.Attempts == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("attempts", "body"))
+	}
+	if body.Score == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("score", "body"))
+	}
+	if body.ScorePolicy != nil {
+		if !(*body.ScorePolicy == "best" || *body.ScorePolicy == "latest" || *body.ScorePolicy == "average") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.score_policy", *body.ScorePolicy, []any{"best", "latest", "average"}))
+		}
+	}
+	return
+}
+
+// ValidateQuestionFormResponseBody runs the validations defined on
+// QuestionFormResponseBody
+func ValidateQuestionFormResponseBody(body *QuestionFormResponseBody) (err error) {
+	if body.ID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("id", "body"))
+	}
+	if body.QuestionText == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("question_text", "body"))
+	}
+	if body.QuestionType == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("question_type", "body"))
+	}
+	if body.Options == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("options", "body"))
+	}
+	if body.QuestionOrder == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("question_order", "body"))
+	}
+	if body.QuestionType != nil {
+		if !(*body.QuestionType == "multiple_choice" || *body.QuestionType == "multiple_select" || *body.QuestionType == "true_false" || *body.QuestionType == "numeric" || *body.QuestionType == "short_text" || *body.QuestionType == "ordering") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.question_type", *body.QuestionType, []any{"multiple_choice", "multiple_select", "true_false", "numeric", "short_text", "ordering"}))
+		}
+	}
 	return
 }
 
@@ -1166,12 +2915,21 @@ func ValidateQuestionResultResponseBody(body *QuestionResultResponseBody) (err e
 	if body.Question == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("question", "body"))
 	}
-	if body.SelectedAnswer == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("selected_answer", "body"))
+	if body.SelectedOptions == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("selected_options", "body"))
+	}
+	if body.Credit == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("credit", "body"))
 	}
 	if body.IsCorrect == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("is_correct", "body"))
 	}
+	if body.Position == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("position", "body"))
+	}
+	if body.OptionOrder == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("option_order", "body"))
+	}
 	if body.Question != nil {
 		if err2 := ValidateQuestionResponseBody(body.Question); err2 != nil {
 			err = goa.MergeErrors(err, err2)
@@ -790,6 +790,14 @@ func EncodeUpdateQuestionError(encoder func(context.Context, http.ResponseWriter
 			return encodeError(ctx, w, v)
 		}
 		switch en.GoaErrorName() {
+		case "invalid_input":
+			var res knowledge.InvalidInput
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
 		case "question_not_found":
 			var res knowledge.QuestionNotFound
 			errors.As(v, &res)
@@ -846,20 +854,1220 @@ func DecodeDeleteQuestionRequest(mux goahttp.Muxer, decoder func(*http.Request)
 			params = mux.Vars(r)
 		)
 		{
-			testIDRaw := params["test_id"]
-			v, err2 := strconv.ParseInt(testIDRaw, 10, 64)
-			if err2 != nil {
-				err = goa.MergeErrors(err, goa.InvalidFieldTypeError("test_id", testIDRaw, "integer"))
-			}
-			testID = v
-		}
-		{
-			questionIDRaw := params["question_id"]
-			v, err2 := strconv.ParseInt(questionIDRaw, 10, 64)
+			testIDRaw := params["test_id"]
+			v, err2 := strconv.ParseInt(testIDRaw, 10, 64)
+			if err2 != nil {
+				err = goa.MergeErrors(err, goa.InvalidFieldTypeError("test_id", testIDRaw, "integer"))
+			}
+			testID = v
+		}
+		{
+			questionIDRaw := params["question_id"]
+			v, err2 := strconv.ParseInt(questionIDRaw, 10, 64)
+			if err2 != nil {
+				err = goa.MergeErrors(err, goa.InvalidFieldTypeError("question_id", questionIDRaw, "integer"))
+			}
+			questionID = v
+		}
+		c, err = r.Cookie("session")
+		if err == http.ErrNoCookie {
+			err = goa.MergeErrors(err, goa.MissingFieldError("session_token", "cookie"))
+		} else {
+			sessionToken = c.Value
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewDeleteQuestionPayload(testID, questionID, sessionToken)
+
+		return payload, nil
+	}
+}
+
+// EncodeDeleteQuestionError returns an encoder for errors returned by the
+// DeleteQuestion knowledge endpoint.
+func EncodeDeleteQuestionError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "question_not_found":
+			var res knowledge.QuestionNotFound
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		case "test_not_found":
+			var res knowledge.TestNotFound
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		case "unauthorized":
+			var res knowledge.Unauthorized
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusUnauthorized)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeCreateQuestionBankResponse returns an encoder for responses returned
+// by the knowledge CreateQuestionBank endpoint.
+func EncodeCreateQuestionBankResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*knowledge.SimpleResponse)
+		enc := encoder(ctx, w)
+		body := NewCreateQuestionBankResponseBody(res)
+		w.WriteHeader(http.StatusCreated)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeCreateQuestionBankRequest returns a decoder for requests sent to the
+// knowledge CreateQuestionBank endpoint.
+func DecodeCreateQuestionBankRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (any, error) {
+	return func(r *http.Request) (any, error) {
+		var (
+			body CreateQuestionBankRequestBody
+			err  error
+		)
+		err = decoder(r).Decode(&body)
+		if err != nil {
+			if err == io.EOF {
+				return nil, goa.MissingPayloadError()
+			}
+			var gerr *goa.ServiceError
+			if errors.As(err, &gerr) {
+				return nil, gerr
+			}
+			return nil, goa.DecodePayloadError(err.Error())
+		}
+		err = ValidateCreateQuestionBankRequestBody(&body)
+		if err != nil {
+			return nil, err
+		}
+
+		var (
+			sessionToken string
+			c            *http.Cookie
+		)
+		c, err = r.Cookie("session")
+		if err == http.ErrNoCookie {
+			err = goa.MergeErrors(err, goa.MissingFieldError("session_token", "cookie"))
+		} else {
+			sessionToken = c.Value
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewCreateQuestionBankPayload(&body, sessionToken)
+
+		return payload, nil
+	}
+}
+
+// EncodeCreateQuestionBankError returns an encoder for errors returned by the
+// CreateQuestionBank knowledge endpoint.
+func EncodeCreateQuestionBankError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "invalid_input":
+			var res knowledge.InvalidInput
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		case "unauthorized":
+			var res knowledge.Unauthorized
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusUnauthorized)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeGetMyQuestionBanksResponse returns an encoder for responses returned
+// by the knowledge GetMyQuestionBanks endpoint.
+func EncodeGetMyQuestionBanksResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*knowledge.QuestionBanksResponse)
+		enc := encoder(ctx, w)
+		body := NewGetMyQuestionBanksResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeGetMyQuestionBanksRequest returns a decoder for requests sent to the
+// knowledge GetMyQuestionBanks endpoint.
+func DecodeGetMyQuestionBanksRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (any, error) {
+	return func(r *http.Request) (any, error) {
+		var (
+			sessionToken string
+			err          error
+			c            *http.Cookie
+		)
+		c, err = r.Cookie("session")
+		if err == http.ErrNoCookie {
+			err = goa.MergeErrors(err, goa.MissingFieldError("session_token", "cookie"))
+		} else {
+			sessionToken = c.Value
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewGetMyQuestionBanksPayload(sessionToken)
+
+		return payload, nil
+	}
+}
+
+// EncodeGetMyQuestionBanksError returns an encoder for errors returned by the
+// GetMyQuestionBanks knowledge endpoint.
+func EncodeGetMyQuestionBanksError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "unauthorized":
+			var res knowledge.Unauthorized
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusUnauthorized)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeDeleteQuestionBankResponse returns an encoder for responses returned
+// by the knowledge DeleteQuestionBank endpoint.
+func EncodeDeleteQuestionBankResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*knowledge.SimpleResponse)
+		enc := encoder(ctx, w)
+		body := NewDeleteQuestionBankResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeDeleteQuestionBankRequest returns a decoder for requests sent to the
+// knowledge DeleteQuestionBank endpoint.
+func DecodeDeleteQuestionBankRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (any, error) {
+	return func(r *http.Request) (any, error) {
+		var (
+			bankID       int64
+			sessionToken string
+			err          error
+			c            *http.Cookie
+
+			params = mux.Vars(r)
+		)
+		{
+			bankIDRaw := params["bank_id"]
+			v, err2 := strconv.ParseInt(bankIDRaw, 10, 64)
+			if err2 != nil {
+				err = goa.MergeErrors(err, goa.InvalidFieldTypeError("bank_id", bankIDRaw, "integer"))
+			}
+			bankID = v
+		}
+		c, err = r.Cookie("session")
+		if err == http.ErrNoCookie {
+			err = goa.MergeErrors(err, goa.MissingFieldError("session_token", "cookie"))
+		} else {
+			sessionToken = c.Value
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewDeleteQuestionBankPayload(bankID, sessionToken)
+
+		return payload, nil
+	}
+}
+
+// EncodeDeleteQuestionBankError returns an encoder for errors returned by the
+// DeleteQuestionBank knowledge endpoint.
+func EncodeDeleteQuestionBankError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "invalid_input":
+			var res knowledge.InvalidInput
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		case "unauthorized":
+			var res knowledge.Unauthorized
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusUnauthorized)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeGetBankQuestionsResponse returns an encoder for responses returned by
+// the knowledge GetBankQuestions endpoint.
+func EncodeGetBankQuestionsResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*knowledge.BankQuestionsResponse)
+		enc := encoder(ctx, w)
+		body := NewGetBankQuestionsResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeGetBankQuestionsRequest returns a decoder for requests sent to the
+// knowledge GetBankQuestions endpoint.
+func DecodeGetBankQuestionsRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (any, error) {
+	return func(r *http.Request) (any, error) {
+		var (
+			bankID       int64
+			sessionToken string
+			err          error
+			c            *http.Cookie
+
+			params = mux.Vars(r)
+		)
+		{
+			bankIDRaw := params["bank_id"]
+			v, err2 := strconv.ParseInt(bankIDRaw, 10, 64)
+			if err2 != nil {
+				err = goa.MergeErrors(err, goa.InvalidFieldTypeError("bank_id", bankIDRaw, "integer"))
+			}
+			bankID = v
+		}
+		c, err = r.Cookie("session")
+		if err == http.ErrNoCookie {
+			err = goa.MergeErrors(err, goa.MissingFieldError("session_token", "cookie"))
+		} else {
+			sessionToken = c.Value
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewGetBankQuestionsPayload(bankID, sessionToken)
+
+		return payload, nil
+	}
+}
+
+// EncodeGetBankQuestionsError returns an encoder for errors returned by the
+// GetBankQuestions knowledge endpoint.
+func EncodeGetBankQuestionsError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "invalid_input":
+			var res knowledge.InvalidInput
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		case "unauthorized":
+			var res knowledge.Unauthorized
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusUnauthorized)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeAddBankQuestionResponse returns an encoder for responses returned by
+// the knowledge AddBankQuestion endpoint.
+func EncodeAddBankQuestionResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*knowledge.SimpleResponse)
+		enc := encoder(ctx, w)
+		body := NewAddBankQuestionResponseBody(res)
+		w.WriteHeader(http.StatusCreated)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeAddBankQuestionRequest returns a decoder for requests sent to the
+// knowledge AddBankQuestion endpoint.
+func DecodeAddBankQuestionRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (any, error) {
+	return func(r *http.Request) (any, error) {
+		var (
+			body AddBankQuestionRequestBody
+			err  error
+		)
+		err = decoder(r).Decode(&body)
+		if err != nil {
+			if err == io.EOF {
+				return nil, goa.MissingPayloadError()
+			}
+			var gerr *goa.ServiceError
+			if errors.As(err, &gerr) {
+				return nil, gerr
+			}
+			return nil, goa.DecodePayloadError(err.Error())
+		}
+		err = ValidateAddBankQuestionRequestBody(&body)
+		if err != nil {
+			return nil, err
+		}
+
+		var (
+			bankID       int64
+			sessionToken string
+			c            *http.Cookie
+
+			params = mux.Vars(r)
+		)
+		{
+			bankIDRaw := params["bank_id"]
+			v, err2 := strconv.ParseInt(bankIDRaw, 10, 64)
+			if err2 != nil {
+				err = goa.MergeErrors(err, goa.InvalidFieldTypeError("bank_id", bankIDRaw, "integer"))
+			}
+			bankID = v
+		}
+		c, err = r.Cookie("session")
+		if err == http.ErrNoCookie {
+			err = goa.MergeErrors(err, goa.MissingFieldError("session_token", "cookie"))
+		} else {
+			sessionToken = c.Value
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewAddBankQuestionPayload(&body, bankID, sessionToken)
+
+		return payload, nil
+	}
+}
+
+// EncodeAddBankQuestionError returns an encoder for errors returned by the
+// AddBankQuestion knowledge endpoint.
+func EncodeAddBankQuestionError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "invalid_input":
+			var res knowledge.InvalidInput
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		case "unauthorized":
+			var res knowledge.Unauthorized
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusUnauthorized)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeUpdateBankQuestionResponse returns an encoder for responses returned
+// by the knowledge UpdateBankQuestion endpoint.
+func EncodeUpdateBankQuestionResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*knowledge.SimpleResponse)
+		enc := encoder(ctx, w)
+		body := NewUpdateBankQuestionResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeUpdateBankQuestionRequest returns a decoder for requests sent to the
+// knowledge UpdateBankQuestion endpoint.
+func DecodeUpdateBankQuestionRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (any, error) {
+	return func(r *http.Request) (any, error) {
+		var (
+			body UpdateBankQuestionRequestBody
+			err  error
+		)
+		err = decoder(r).Decode(&body)
+		if err != nil {
+			if err == io.EOF {
+				return nil, goa.MissingPayloadError()
+			}
+			var gerr *goa.ServiceError
+			if errors.As(err, &gerr) {
+				return nil, gerr
+			}
+			return nil, goa.DecodePayloadError(err.Error())
+		}
+		err = ValidateUpdateBankQuestionRequestBody(&body)
+		if err != nil {
+			return nil, err
+		}
+
+		var (
+			bankID       int64
+			questionID   int64
+			sessionToken string
+			c            *http.Cookie
+
+			params = mux.Vars(r)
+		)
+		{
+			bankIDRaw := params["bank_id"]
+			v, err2 := strconv.ParseInt(bankIDRaw, 10, 64)
+			if err2 != nil {
+				err = goa.MergeErrors(err, goa.InvalidFieldTypeError("bank_id", bankIDRaw, "integer"))
+			}
+			bankID = v
+		}
+		{
+			questionIDRaw := params["question_id"]
+			v, err2 := strconv.ParseInt(questionIDRaw, 10, 64)
+			if err2 != nil {
+				err = goa.MergeErrors(err, goa.InvalidFieldTypeError("question_id", questionIDRaw, "integer"))
+			}
+			questionID = v
+		}
+		c, err = r.Cookie("session")
+		if err == http.ErrNoCookie {
+			err = goa.MergeErrors(err, goa.MissingFieldError("session_token", "cookie"))
+		} else {
+			sessionToken = c.Value
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewUpdateBankQuestionPayload(&body, bankID, questionID, sessionToken)
+
+		return payload, nil
+	}
+}
+
+// EncodeUpdateBankQuestionError returns an encoder for errors returned by the
+// UpdateBankQuestion knowledge endpoint.
+func EncodeUpdateBankQuestionError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "invalid_input":
+			var res knowledge.InvalidInput
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		case "question_not_found":
+			var res knowledge.QuestionNotFound
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		case "unauthorized":
+			var res knowledge.Unauthorized
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusUnauthorized)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeDeleteBankQuestionResponse returns an encoder for responses returned
+// by the knowledge DeleteBankQuestion endpoint.
+func EncodeDeleteBankQuestionResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*knowledge.SimpleResponse)
+		enc := encoder(ctx, w)
+		body := NewDeleteBankQuestionResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeDeleteBankQuestionRequest returns a decoder for requests sent to the
+// knowledge DeleteBankQuestion endpoint.
+func DecodeDeleteBankQuestionRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (any, error) {
+	return func(r *http.Request) (any, error) {
+		var (
+			bankID       int64
+			questionID   int64
+			sessionToken string
+			err          error
+			c            *http.Cookie
+
+			params = mux.Vars(r)
+		)
+		{
+			bankIDRaw := params["bank_id"]
+			v, err2 := strconv.ParseInt(bankIDRaw, 10, 64)
+			if err2 != nil {
+				err = goa.MergeErrors(err, goa.InvalidFieldTypeError("bank_id", bankIDRaw, "integer"))
+			}
+			bankID = v
+		}
+		{
+			questionIDRaw := params["question_id"]
+			v, err2 := strconv.ParseInt(questionIDRaw, 10, 64)
+			if err2 != nil {
+				err = goa.MergeErrors(err, goa.InvalidFieldTypeError("question_id", questionIDRaw, "integer"))
+			}
+			questionID = v
+		}
+		c, err = r.Cookie("session")
+		if err == http.ErrNoCookie {
+			err = goa.MergeErrors(err, goa.MissingFieldError("session_token", "cookie"))
+		} else {
+			sessionToken = c.Value
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewDeleteBankQuestionPayload(bankID, questionID, sessionToken)
+
+		return payload, nil
+	}
+}
+
+// EncodeDeleteBankQuestionError returns an encoder for errors returned by the
+// DeleteBankQuestion knowledge endpoint.
+func EncodeDeleteBankQuestionError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "invalid_input":
+			var res knowledge.InvalidInput
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		case "question_not_found":
+			var res knowledge.QuestionNotFound
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		case "unauthorized":
+			var res knowledge.Unauthorized
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusUnauthorized)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeAddDrawRuleResponse returns an encoder for responses returned by the
+// knowledge AddDrawRule endpoint.
+func EncodeAddDrawRuleResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*knowledge.SimpleResponse)
+		enc := encoder(ctx, w)
+		body := NewAddDrawRuleResponseBody(res)
+		w.WriteHeader(http.StatusCreated)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeAddDrawRuleRequest returns a decoder for requests sent to the
+// knowledge AddDrawRule endpoint.
+func DecodeAddDrawRuleRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (any, error) {
+	return func(r *http.Request) (any, error) {
+		var (
+			body AddDrawRuleRequestBody
+			err  error
+		)
+		err = decoder(r).Decode(&body)
+		if err != nil {
+			if err == io.EOF {
+				return nil, goa.MissingPayloadError()
+			}
+			var gerr *goa.ServiceError
+			if errors.As(err, &gerr) {
+				return nil, gerr
+			}
+			return nil, goa.DecodePayloadError(err.Error())
+		}
+		err = ValidateAddDrawRuleRequestBody(&body)
+		if err != nil {
+			return nil, err
+		}
+
+		var (
+			testID       int64
+			sessionToken string
+			c            *http.Cookie
+
+			params = mux.Vars(r)
+		)
+		{
+			testIDRaw := params["test_id"]
+			v, err2 := strconv.ParseInt(testIDRaw, 10, 64)
+			if err2 != nil {
+				err = goa.MergeErrors(err, goa.InvalidFieldTypeError("test_id", testIDRaw, "integer"))
+			}
+			testID = v
+		}
+		c, err = r.Cookie("session")
+		if err == http.ErrNoCookie {
+			err = goa.MergeErrors(err, goa.MissingFieldError("session_token", "cookie"))
+		} else {
+			sessionToken = c.Value
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewAddDrawRulePayload(&body, testID, sessionToken)
+
+		return payload, nil
+	}
+}
+
+// EncodeAddDrawRuleError returns an encoder for errors returned by the
+// AddDrawRule knowledge endpoint.
+func EncodeAddDrawRuleError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "invalid_input":
+			var res knowledge.InvalidInput
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		case "test_not_found":
+			var res knowledge.TestNotFound
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		case "unauthorized":
+			var res knowledge.Unauthorized
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusUnauthorized)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeGetDrawRulesResponse returns an encoder for responses returned by the
+// knowledge GetDrawRules endpoint.
+func EncodeGetDrawRulesResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*knowledge.DrawRulesResponse)
+		enc := encoder(ctx, w)
+		body := NewGetDrawRulesResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeGetDrawRulesRequest returns a decoder for requests sent to the
+// knowledge GetDrawRules endpoint.
+func DecodeGetDrawRulesRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (any, error) {
+	return func(r *http.Request) (any, error) {
+		var (
+			testID       int64
+			sessionToken string
+			err          error
+			c            *http.Cookie
+
+			params = mux.Vars(r)
+		)
+		{
+			testIDRaw := params["test_id"]
+			v, err2 := strconv.ParseInt(testIDRaw, 10, 64)
+			if err2 != nil {
+				err = goa.MergeErrors(err, goa.InvalidFieldTypeError("test_id", testIDRaw, "integer"))
+			}
+			testID = v
+		}
+		c, err = r.Cookie("session")
+		if err == http.ErrNoCookie {
+			err = goa.MergeErrors(err, goa.MissingFieldError("session_token", "cookie"))
+		} else {
+			sessionToken = c.Value
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewGetDrawRulesPayload(testID, sessionToken)
+
+		return payload, nil
+	}
+}
+
+// EncodeGetDrawRulesError returns an encoder for errors returned by the
+// GetDrawRules knowledge endpoint.
+func EncodeGetDrawRulesError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "test_not_found":
+			var res knowledge.TestNotFound
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		case "unauthorized":
+			var res knowledge.Unauthorized
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusUnauthorized)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeDeleteDrawRuleResponse returns an encoder for responses returned by
+// the knowledge DeleteDrawRule endpoint.
+func EncodeDeleteDrawRuleResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*knowledge.SimpleResponse)
+		enc := encoder(ctx, w)
+		body := NewDeleteDrawRuleResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeDeleteDrawRuleRequest returns a decoder for requests sent to the
+// knowledge DeleteDrawRule endpoint.
+func DecodeDeleteDrawRuleRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (any, error) {
+	return func(r *http.Request) (any, error) {
+		var (
+			testID       int64
+			ruleID       int64
+			sessionToken string
+			err          error
+			c            *http.Cookie
+
+			params = mux.Vars(r)
+		)
+		{
+			testIDRaw := params["test_id"]
+			v, err2 := strconv.ParseInt(testIDRaw, 10, 64)
+			if err2 != nil {
+				err = goa.MergeErrors(err, goa.InvalidFieldTypeError("test_id", testIDRaw, "integer"))
+			}
+			testID = v
+		}
+		{
+			ruleIDRaw := params["rule_id"]
+			v, err2 := strconv.ParseInt(ruleIDRaw, 10, 64)
+			if err2 != nil {
+				err = goa.MergeErrors(err, goa.InvalidFieldTypeError("rule_id", ruleIDRaw, "integer"))
+			}
+			ruleID = v
+		}
+		c, err = r.Cookie("session")
+		if err == http.ErrNoCookie {
+			err = goa.MergeErrors(err, goa.MissingFieldError("session_token", "cookie"))
+		} else {
+			sessionToken = c.Value
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewDeleteDrawRulePayload(testID, ruleID, sessionToken)
+
+		return payload, nil
+	}
+}
+
+// EncodeDeleteDrawRuleError returns an encoder for errors returned by the
+// DeleteDrawRule knowledge endpoint.
+func EncodeDeleteDrawRuleError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "invalid_input":
+			var res knowledge.InvalidInput
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		case "test_not_found":
+			var res knowledge.TestNotFound
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		case "unauthorized":
+			var res knowledge.Unauthorized
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusUnauthorized)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeGetTestAnalyticsResponse returns an encoder for responses returned by
+// the knowledge GetTestAnalytics endpoint.
+func EncodeGetTestAnalyticsResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*knowledge.TestAnalytics)
+		enc := encoder(ctx, w)
+		body := NewGetTestAnalyticsResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeGetTestAnalyticsRequest returns a decoder for requests sent to the
+// knowledge GetTestAnalytics endpoint.
+func DecodeGetTestAnalyticsRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (any, error) {
+	return func(r *http.Request) (any, error) {
+		var (
+			testID       int64
+			sessionToken string
+			err          error
+			c            *http.Cookie
+
+			params = mux.Vars(r)
+		)
+		{
+			testIDRaw := params["test_id"]
+			v, err2 := strconv.ParseInt(testIDRaw, 10, 64)
+			if err2 != nil {
+				err = goa.MergeErrors(err, goa.InvalidFieldTypeError("test_id", testIDRaw, "integer"))
+			}
+			testID = v
+		}
+		c, err = r.Cookie("session")
+		if err == http.ErrNoCookie {
+			err = goa.MergeErrors(err, goa.MissingFieldError("session_token", "cookie"))
+		} else {
+			sessionToken = c.Value
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewGetTestAnalyticsPayload(testID, sessionToken)
+
+		return payload, nil
+	}
+}
+
+// EncodeGetTestAnalyticsError returns an encoder for errors returned by the
+// GetTestAnalytics knowledge endpoint.
+func EncodeGetTestAnalyticsError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "internal_error":
+			var res knowledge.InternalError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusInternalServerError)
+			return enc.Encode(body)
+		case "invalid_input":
+			var res knowledge.InvalidInput
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		case "test_not_found":
+			var res knowledge.TestNotFound
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		case "unauthorized":
+			var res knowledge.Unauthorized
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusUnauthorized)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeGetTestGradebookResponse returns an encoder for responses returned by
+// the knowledge GetTestGradebook endpoint.
+func EncodeGetTestGradebookResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*knowledge.TestGradebook)
+		enc := encoder(ctx, w)
+		body := NewGetTestGradebookResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeGetTestGradebookRequest returns a decoder for requests sent to the
+// knowledge GetTestGradebook endpoint.
+func DecodeGetTestGradebookRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (any, error) {
+	return func(r *http.Request) (any, error) {
+		var (
+			testID       int64
+			sortBy       string
+			sortOrder    string
+			status       *string
+			student      *string
+			sessionToken string
+			err          error
+			c            *http.Cookie
+
+			params = mux.Vars(r)
+		)
+		{
+			testIDRaw := params["test_id"]
+			v, err2 := strconv.ParseInt(testIDRaw, 10, 64)
+			if err2 != nil {
+				err = goa.MergeErrors(err, goa.InvalidFieldTypeError("test_id", testIDRaw, "integer"))
+			}
+			testID = v
+		}
+		qp := r.URL.Query()
+		sortByRaw := qp.Get("sort_by")
+		if sortByRaw != "" {
+			sortBy = sortByRaw
+		} else {
+			sortBy = "submitted_at"
+		}
+		if !(sortBy == "submitted_at" || sortBy == "score" || sortBy == "student_name") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("sort_by", sortBy, []any{"submitted_at", "score", "student_name"}))
+		}
+		sortOrderRaw := qp.Get("sort_order")
+		if sortOrderRaw != "" {
+			sortOrder = sortOrderRaw
+		} else {
+			sortOrder = "desc"
+		}
+		if !(sortOrder == "asc" || sortOrder == "desc") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("sort_order", sortOrder, []any{"asc", "desc"}))
+		}
+		statusRaw := qp.Get("status")
+		if statusRaw != "" {
+			status = &statusRaw
+		}
+		if status != nil {
+			if !(*status == "in_progress" || *status == "submitted" || *status == "expired") {
+				err = goa.MergeErrors(err, goa.InvalidEnumValueError("status", *status, []any{"in_progress", "submitted", "expired"}))
+			}
+		}
+		studentRaw := qp.Get("student")
+		if studentRaw != "" {
+			student = &studentRaw
+		}
+		c, err = r.Cookie("session")
+		if err == http.ErrNoCookie {
+			err = goa.MergeErrors(err, goa.MissingFieldError("session_token", "cookie"))
+		} else {
+			sessionToken = c.Value
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewGetTestGradebookPayload(testID, sortBy, sortOrder, status, student, sessionToken)
+
+		return payload, nil
+	}
+}
+
+// EncodeGetTestGradebookError returns an encoder for errors returned by the
+// GetTestGradebook knowledge endpoint.
+func EncodeGetTestGradebookError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "invalid_input":
+			var res knowledge.InvalidInput
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		case "test_not_found":
+			var res knowledge.TestNotFound
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		case "unauthorized":
+			var res knowledge.Unauthorized
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusUnauthorized)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
+// EncodeGetStudentHistoryResponse returns an encoder for responses returned by
+// the knowledge GetStudentHistory endpoint.
+func EncodeGetStudentHistoryResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*knowledge.StudentHistory)
+		enc := encoder(ctx, w)
+		body := NewGetStudentHistoryResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeGetStudentHistoryRequest returns a decoder for requests sent to the
+// knowledge GetStudentHistory endpoint.
+func DecodeGetStudentHistoryRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (any, error) {
+	return func(r *http.Request) (any, error) {
+		var (
+			userID       int64
+			sessionToken string
+			err          error
+			c            *http.Cookie
+
+			params = mux.Vars(r)
+		)
+		{
+			userIDRaw := params["user_id"]
+			v, err2 := strconv.ParseInt(userIDRaw, 10, 64)
 			if err2 != nil {
-				err = goa.MergeErrors(err, goa.InvalidFieldTypeError("question_id", questionIDRaw, "integer"))
+				err = goa.MergeErrors(err, goa.InvalidFieldTypeError("user_id", userIDRaw, "integer"))
 			}
-			questionID = v
+			userID = v
 		}
 		c, err = r.Cookie("session")
 		if err == http.ErrNoCookie {
@@ -870,15 +2078,15 @@ func DecodeDeleteQuestionRequest(mux goahttp.Muxer, decoder func(*http.Request)
 		if err != nil {
 			return nil, err
 		}
-		payload := NewDeleteQuestionPayload(testID, questionID, sessionToken)
+		payload := NewGetStudentHistoryPayload(userID, sessionToken)
 
 		return payload, nil
 	}
 }
 
-// EncodeDeleteQuestionError returns an encoder for errors returned by the
-// DeleteQuestion knowledge endpoint.
-func EncodeDeleteQuestionError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+// EncodeGetStudentHistoryError returns an encoder for errors returned by the
+// GetStudentHistory knowledge endpoint.
+func EncodeGetStudentHistoryError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
 	encodeError := goahttp.ErrorEncoder(encoder, formatter)
 	return func(ctx context.Context, w http.ResponseWriter, v error) error {
 		var en goa.GoaErrorNamer
@@ -886,16 +2094,16 @@ func EncodeDeleteQuestionError(encoder func(context.Context, http.ResponseWriter
 			return encodeError(ctx, w, v)
 		}
 		switch en.GoaErrorName() {
-		case "question_not_found":
-			var res knowledge.QuestionNotFound
+		case "invalid_input":
+			var res knowledge.InvalidInput
 			errors.As(v, &res)
 			enc := encoder(ctx, w)
 			body := res
 			w.Header().Set("goa-error", res.GoaErrorName())
-			w.WriteHeader(http.StatusNotFound)
+			w.WriteHeader(http.StatusBadRequest)
 			return enc.Encode(body)
-		case "test_not_found":
-			var res knowledge.TestNotFound
+		case "submission_not_found":
+			var res knowledge.SubmissionNotFound
 			errors.As(v, &res)
 			enc := encoder(ctx, w)
 			body := res
@@ -976,6 +2184,117 @@ func EncodeGetAvailableTestsError(encoder func(context.Context, http.ResponseWri
 	}
 }
 
+// EncodeStartTestResponse returns an encoder for responses returned by the
+// knowledge StartTest endpoint.
+func EncodeStartTestResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
+	return func(ctx context.Context, w http.ResponseWriter, v any) error {
+		res, _ := v.(*knowledge.TestAttempt)
+		enc := encoder(ctx, w)
+		body := NewStartTestResponseBody(res)
+		w.WriteHeader(http.StatusOK)
+		return enc.Encode(body)
+	}
+}
+
+// DecodeStartTestRequest returns a decoder for requests sent to the knowledge
+// StartTest endpoint.
+func DecodeStartTestRequest(mux goahttp.Muxer, decoder func(*http.Request) goahttp.Decoder) func(*http.Request) (any, error) {
+	return func(r *http.Request) (any, error) {
+		var (
+			testID       int64
+			sessionToken string
+			err          error
+			c            *http.Cookie
+
+			params = mux.Vars(r)
+		)
+		{
+			testIDRaw := params["test_id"]
+			v, err2 := strconv.ParseInt(testIDRaw, 10, 64)
+			if err2 != nil {
+				err = goa.MergeErrors(err, goa.InvalidFieldTypeError("test_id", testIDRaw, "integer"))
+			}
+			testID = v
+		}
+		c, err = r.Cookie("session")
+		if err == http.ErrNoCookie {
+			err = goa.MergeErrors(err, goa.MissingFieldError("session_token", "cookie"))
+		} else {
+			sessionToken = c.Value
+		}
+		if err != nil {
+			return nil, err
+		}
+		payload := NewStartTestPayload(testID, sessionToken)
+
+		return payload, nil
+	}
+}
+
+// EncodeStartTestError returns an encoder for errors returned by the StartTest
+// knowledge endpoint.
+func EncodeStartTestError(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder, formatter func(ctx context.Context, err error) goahttp.Statuser) func(context.Context, http.ResponseWriter, error) error {
+	encodeError := goahttp.ErrorEncoder(encoder, formatter)
+	return func(ctx context.Context, w http.ResponseWriter, v error) error {
+		var en goa.GoaErrorNamer
+		if !errors.As(v, &en) {
+			return encodeError(ctx, w, v)
+		}
+		switch en.GoaErrorName() {
+		case "internal_error":
+			var res knowledge.InternalError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusInternalServerError)
+			return enc.Encode(body)
+		case "invalid_input":
+			var res knowledge.InvalidInput
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
+		case "test_already_submitted":
+			var res knowledge.TestAlreadySubmitted
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusConflict)
+			return enc.Encode(body)
+		case "time_expired":
+			var res knowledge.TimeExpired
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusConflict)
+			return enc.Encode(body)
+		case "test_not_found":
+			var res knowledge.TestNotFound
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusNotFound)
+			return enc.Encode(body)
+		case "unauthorized":
+			var res knowledge.Unauthorized
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusUnauthorized)
+			return enc.Encode(body)
+		default:
+			return encodeError(ctx, w, v)
+		}
+	}
+}
+
 // EncodeGetTestFormResponse returns an encoder for responses returned by the
 // knowledge GetTestForm endpoint.
 func EncodeGetTestFormResponse(encoder func(context.Context, http.ResponseWriter) goahttp.Encoder) func(context.Context, http.ResponseWriter, any) error {
@@ -1033,6 +2352,22 @@ func EncodeGetTestFormError(encoder func(context.Context, http.ResponseWriter) g
 			return encodeError(ctx, w, v)
 		}
 		switch en.GoaErrorName() {
+		case "internal_error":
+			var res knowledge.InternalError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusInternalServerError)
+			return enc.Encode(body)
+		case "invalid_input":
+			var res knowledge.InvalidInput
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
 		case "test_already_submitted":
 			var res knowledge.TestAlreadySubmitted
 			errors.As(v, &res)
@@ -1041,6 +2376,14 @@ func EncodeGetTestFormError(encoder func(context.Context, http.ResponseWriter) g
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusConflict)
 			return enc.Encode(body)
+		case "time_expired":
+			var res knowledge.TimeExpired
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusConflict)
+			return enc.Encode(body)
 		case "test_not_found":
 			var res knowledge.TestNotFound
 			errors.As(v, &res)
@@ -1139,6 +2482,14 @@ func EncodeSubmitTestError(encoder func(context.Context, http.ResponseWriter) go
 			return encodeError(ctx, w, v)
 		}
 		switch en.GoaErrorName() {
+		case "internal_error":
+			var res knowledge.InternalError
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusInternalServerError)
+			return enc.Encode(body)
 		case "invalid_input":
 			var res knowledge.InvalidInput
 			errors.As(v, &res)
@@ -1155,6 +2506,14 @@ func EncodeSubmitTestError(encoder func(context.Context, http.ResponseWriter) go
 			w.Header().Set("goa-error", res.GoaErrorName())
 			w.WriteHeader(http.StatusConflict)
 			return enc.Encode(body)
+		case "time_expired":
+			var res knowledge.TimeExpired
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusConflict)
+			return enc.Encode(body)
 		case "test_not_found":
 			var res knowledge.TestNotFound
 			errors.As(v, &res)
@@ -1373,6 +2732,14 @@ func EncodeGetSubmissionResultError(encoder func(context.Context, http.ResponseW
 			return encodeError(ctx, w, v)
 		}
 		switch en.GoaErrorName() {
+		case "invalid_input":
+			var res knowledge.InvalidInput
+			errors.As(v, &res)
+			enc := encoder(ctx, w)
+			body := res
+			w.Header().Set("goa-error", res.GoaErrorName())
+			w.WriteHeader(http.StatusBadRequest)
+			return enc.Encode(body)
 		case "submission_not_found":
 			var res knowledge.SubmissionNotFound
 			errors.As(v, &res)
@@ -1399,11 +2766,18 @@ func EncodeGetSubmissionResultError(encoder func(context.Context, http.ResponseW
 // *TestResponseBody from a value of type *knowledge.Test.
 func marshalKnowledgeTestToTestResponseBody(v *knowledge.Test) *TestResponseBody {
 	res := &TestResponseBody{
-		ID:            v.ID,
-		Title:         v.Title,
-		CreatedBy:     v.CreatedBy,
-		CreatedAt:     v.CreatedAt,
-		QuestionCount: v.QuestionCount,
+		ID:               v.ID,
+		Title:            v.Title,
+		CreatedBy:        v.CreatedBy,
+		CreatedAt:        v.CreatedAt,
+		QuestionCount:    v.QuestionCount,
+		DurationMinutes:  v.DurationMinutes,
+		MaxAttempts:      v.MaxAttempts,
+		CooldownMinutes:  v.CooldownMinutes,
+		ScorePolicy:      string(v.ScorePolicy),
+		RevealAnswers:    v.RevealAnswers,
+		ShuffleQuestions: v.ShuffleQuestions,
+		ShuffleOptions:   v.ShuffleOptions,
 	}
 
 	return res
@@ -1416,39 +2790,174 @@ func marshalKnowledgeQuestionToQuestionResponseBody(v *knowledge.Question) *Ques
 		ID:            v.ID,
 		TestID:        v.TestID,
 		QuestionText:  v.QuestionText,
-		OptionA:       v.OptionA,
-		OptionB:       v.OptionB,
-		OptionC:       v.OptionC,
-		OptionD:       v.OptionD,
-		CorrectAnswer: v.CorrectAnswer,
+		QuestionType:  string(v.QuestionType),
+		NumericAnswer: v.NumericAnswer,
+		Tolerance:     v.Tolerance,
 		QuestionOrder: v.QuestionOrder,
 	}
+	if v.Options != nil {
+		res.Options = make([]string, len(v.Options))
+		for i, val := range v.Options {
+			res.Options[i] = val
+		}
+	} else {
+		res.Options = []string{}
+	}
+	if v.CorrectOptions != nil {
+		res.CorrectOptions = make([]int, len(v.CorrectOptions))
+		for i, val := range v.CorrectOptions {
+			res.CorrectOptions[i] = val
+		}
+	} else {
+		res.CorrectOptions = []int{}
+	}
+	if v.AcceptedAnswers != nil {
+		res.AcceptedAnswers = make([]string, len(v.AcceptedAnswers))
+		for i, val := range v.AcceptedAnswers {
+			res.AcceptedAnswers[i] = val
+		}
+	} else {
+		res.AcceptedAnswers = []string{}
+	}
 
 	return res
 }
 
-// marshalKnowledgeQuestionFormToQuestionFormResponseBody builds a value of
-// type *QuestionFormResponseBody from a value of type *knowledge.QuestionForm.
-func marshalKnowledgeQuestionFormToQuestionFormResponseBody(v *knowledge.QuestionForm) *QuestionFormResponseBody {
-	res := &QuestionFormResponseBody{
+// marshalKnowledgeQuestionBankToQuestionBankResponseBody builds a value of
+// type *QuestionBankResponseBody from a value of type *knowledge.QuestionBank.
+func marshalKnowledgeQuestionBankToQuestionBankResponseBody(v *knowledge.QuestionBank) *QuestionBankResponseBody {
+	res := &QuestionBankResponseBody{
+		ID:            v.ID,
+		Title:         v.Title,
+		CreatedBy:     v.CreatedBy,
+		CreatedAt:     v.CreatedAt,
+		QuestionCount: v.QuestionCount,
+	}
+
+	return res
+}
+
+// marshalKnowledgeBankQuestionToBankQuestionResponseBody builds a value of
+// type *BankQuestionResponseBody from a value of type *knowledge.BankQuestion.
+func marshalKnowledgeBankQuestionToBankQuestionResponseBody(v *knowledge.BankQuestion) *BankQuestionResponseBody {
+	res := &BankQuestionResponseBody{
 		ID:            v.ID,
+		BankID:        v.BankID,
 		QuestionText:  v.QuestionText,
-		OptionA:       v.OptionA,
-		OptionB:       v.OptionB,
-		OptionC:       v.OptionC,
-		OptionD:       v.OptionD,
+		QuestionType:  string(v.QuestionType),
+		NumericAnswer: v.NumericAnswer,
+		Tolerance:     v.Tolerance,
 		QuestionOrder: v.QuestionOrder,
+		Topic:         v.Topic,
+		Difficulty:    string(v.Difficulty),
+	}
+	if v.Options != nil {
+		res.Options = make([]string, len(v.Options))
+		for i, val := range v.Options {
+			res.Options[i] = val
+		}
+	} else {
+		res.Options = []string{}
+	}
+	if v.CorrectOptions != nil {
+		res.CorrectOptions = make([]int, len(v.CorrectOptions))
+		for i, val := range v.CorrectOptions {
+			res.CorrectOptions[i] = val
+		}
+	} else {
+		res.CorrectOptions = []int{}
+	}
+	if v.AcceptedAnswers != nil {
+		res.AcceptedAnswers = make([]string, len(v.AcceptedAnswers))
+		for i, val := range v.AcceptedAnswers {
+			res.AcceptedAnswers[i] = val
+		}
+	} else {
+		res.AcceptedAnswers = []string{}
 	}
 
 	return res
 }
 
-// unmarshalAnswerRequestBodyToKnowledgeAnswer builds a value of type
-// *knowledge.Answer from a value of type *AnswerRequestBody.
-func unmarshalAnswerRequestBodyToKnowledgeAnswer(v *AnswerRequestBody) *knowledge.Answer {
-	res := &knowledge.Answer{
-		QuestionID:     *v.QuestionID,
-		SelectedAnswer: *v.SelectedAnswer,
+// marshalKnowledgeDrawRuleToDrawRuleResponseBody builds a value of type
+// *DrawRuleResponseBody from a value of type *knowledge.DrawRule.
+func marshalKnowledgeDrawRuleToDrawRuleResponseBody(v *knowledge.DrawRule) *DrawRuleResponseBody {
+	res := &DrawRuleResponseBody{
+		ID:            v.ID,
+		TestID:        v.TestID,
+		BankID:        v.BankID,
+		Topic:         v.Topic,
+		QuestionCount: v.QuestionCount,
+	}
+	if v.Difficulty != nil {
+		difficulty := string(*v.Difficulty)
+		res.Difficulty = &difficulty
+	}
+
+	return res
+}
+
+// marshalKnowledgeScoreBinToScoreBinResponseBody builds a value of type
+// *ScoreBinResponseBody from a value of type *knowledge.ScoreBin.
+func marshalKnowledgeScoreBinToScoreBinResponseBody(v *knowledge.ScoreBin) *ScoreBinResponseBody {
+	res := &ScoreBinResponseBody{
+		From:  v.From,
+		To:    v.To,
+		Count: v.Count,
+	}
+
+	return res
+}
+
+// marshalKnowledgeQuestionAnalyticsToQuestionAnalyticsResponseBody builds a
+// value of type *QuestionAnalyticsResponseBody from a value of type
+// *knowledge.QuestionAnalytics.
+func marshalKnowledgeQuestionAnalyticsToQuestionAnalyticsResponseBody(v *knowledge.QuestionAnalytics) *QuestionAnalyticsResponseBody {
+	res := &QuestionAnalyticsResponseBody{
+		QuestionID:          v.QuestionID,
+		QuestionText:        v.QuestionText,
+		QuestionType:        string(v.QuestionType),
+		Attempts:            v.Attempts,
+		DifficultyIndex:     v.DifficultyIndex,
+		DiscriminationIndex: v.DiscriminationIndex,
+		Unanswered:          v.Unanswered,
+	}
+	if v.OptionSelections != nil {
+		res.OptionSelections = make([]*OptionSelectionResponseBody, len(v.OptionSelections))
+		for i, val := range v.OptionSelections {
+			res.OptionSelections[i] = marshalKnowledgeOptionSelectionToOptionSelectionResponseBody(val)
+		}
+	} else {
+		res.OptionSelections = []*OptionSelectionResponseBody{}
+	}
+
+	return res
+}
+
+// marshalKnowledgeOptionSelectionToOptionSelectionResponseBody builds a value
+// of type *OptionSelectionResponseBody from a value of type
+// *knowledge.OptionSelection.
+func marshalKnowledgeOptionSelectionToOptionSelectionResponseBody(v *knowledge.OptionSelection) *OptionSelectionResponseBody {
+	res := &OptionSelectionResponseBody{
+		Index:     v.Index,
+		Option:    v.Option,
+		Count:     v.Count,
+		IsCorrect: v.IsCorrect,
+	}
+
+	return res
+}
+
+// marshalKnowledgeStudentSubmissionToStudentSubmissionResponseBody builds a
+// value of type *StudentSubmissionResponseBody from a value of type
+// *knowledge.StudentSubmission.
+func marshalKnowledgeStudentSubmissionToStudentSubmissionResponseBody(v *knowledge.StudentSubmission) *StudentSubmissionResponseBody {
+	res := &StudentSubmissionResponseBody{
+		UserID:      v.UserID,
+		StudentName: v.StudentName,
+	}
+	if v.Submission != nil {
+		res.Submission = marshalKnowledgeSubmissionToSubmissionResponseBody(v.Submission)
 	}
 
 	return res
@@ -1458,11 +2967,67 @@ func unmarshalAnswerRequestBodyToKnowledgeAnswer(v *AnswerRequestBody) *knowledg
 // *SubmissionResponseBody from a value of type *knowledge.Submission.
 func marshalKnowledgeSubmissionToSubmissionResponseBody(v *knowledge.Submission) *SubmissionResponseBody {
 	res := &SubmissionResponseBody{
-		ID:          v.ID,
+		ID:            v.ID,
+		TestID:        v.TestID,
+		TestTitle:     v.TestTitle,
+		Score:         v.Score,
+		SubmittedAt:   v.SubmittedAt,
+		Status:        string(v.Status),
+		StartedAt:     v.StartedAt,
+		AttemptNumber: v.AttemptNumber,
+	}
+
+	return res
+}
+
+// marshalKnowledgeTestScoreToTestScoreResponseBody builds a value of type
+// *TestScoreResponseBody from a value of type *knowledge.TestScore.
+func marshalKnowledgeTestScoreToTestScoreResponseBody(v *knowledge.TestScore) *TestScoreResponseBody {
+	res := &TestScoreResponseBody{
 		TestID:      v.TestID,
 		TestTitle:   v.TestTitle,
+		ScorePolicy: string(v.ScorePolicy),
+		Attempts:    v.Attempts,
 		Score:       v.Score,
-		SubmittedAt: v.SubmittedAt,
+	}
+
+	return res
+}
+
+// marshalKnowledgeQuestionFormToQuestionFormResponseBody builds a value of
+// type *QuestionFormResponseBody from a value of type *knowledge.QuestionForm.
+func marshalKnowledgeQuestionFormToQuestionFormResponseBody(v *knowledge.QuestionForm) *QuestionFormResponseBody {
+	res := &QuestionFormResponseBody{
+		ID:            v.ID,
+		QuestionText:  v.QuestionText,
+		QuestionType:  string(v.QuestionType),
+		QuestionOrder: v.QuestionOrder,
+	}
+	if v.Options != nil {
+		res.Options = make([]string, len(v.Options))
+		for i, val := range v.Options {
+			res.Options[i] = val
+		}
+	} else {
+		res.Options = []string{}
+	}
+
+	return res
+}
+
+// unmarshalAnswerRequestBodyToKnowledgeAnswer builds a value of type
+// *knowledge.Answer from a value of type *AnswerRequestBody.
+func unmarshalAnswerRequestBodyToKnowledgeAnswer(v *AnswerRequestBody) *knowledge.Answer {
+	res := &knowledge.Answer{
+		QuestionID:    *v.QuestionID,
+		NumericAnswer: v.NumericAnswer,
+		TextAnswer:    v.TextAnswer,
+	}
+	if v.SelectedOptions != nil {
+		res.SelectedOptions = make([]int, len(v.SelectedOptions))
+		for i, val := range v.SelectedOptions {
+			res.SelectedOptions[i] = val
+		}
 	}
 
 	return res
@@ -1473,12 +3038,31 @@ func marshalKnowledgeSubmissionToSubmissionResponseBody(v *knowledge.Submission)
 // *knowledge.QuestionResult.
 func marshalKnowledgeQuestionResultToQuestionResultResponseBody(v *knowledge.QuestionResult) *QuestionResultResponseBody {
 	res := &QuestionResultResponseBody{
-		SelectedAnswer: v.SelectedAnswer,
-		IsCorrect:      v.IsCorrect,
+		NumericAnswer: v.NumericAnswer,
+		TextAnswer:    v.TextAnswer,
+		Credit:        v.Credit,
+		IsCorrect:     v.IsCorrect,
+		Position:      v.Position,
 	}
 	if v.Question != nil {
 		res.Question = marshalKnowledgeQuestionToQuestionResponseBody(v.Question)
 	}
+	if v.SelectedOptions != nil {
+		res.SelectedOptions = make([]int, len(v.SelectedOptions))
+		for i, val := range v.SelectedOptions {
+			res.SelectedOptions[i] = val
+		}
+	} else {
+		res.SelectedOptions = []int{}
+	}
+	if v.OptionOrder != nil {
+		res.OptionOrder = make([]int, len(v.OptionOrder))
+		for i, val := range v.OptionOrder {
+			res.OptionOrder[i] = val
+		}
+	} else {
+		res.OptionOrder = []int{}
+	}
 
 	return res
 }
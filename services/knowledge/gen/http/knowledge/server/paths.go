@@ -63,11 +63,81 @@ func DeleteQuestionKnowledgePath(testID int64, questionID int64) string {
 	return fmt.Sprintf("/api/knowledge/tests/%v/questions/%v", testID, questionID)
 }
 
+// CreateQuestionBankKnowledgePath returns the URL path to the knowledge service CreateQuestionBank HTTP endpoint.
+func CreateQuestionBankKnowledgePath() string {
+	return "/api/knowledge/banks"
+}
+
+// GetMyQuestionBanksKnowledgePath returns the URL path to the knowledge service GetMyQuestionBanks HTTP endpoint.
+func GetMyQuestionBanksKnowledgePath() string {
+	return "/api/knowledge/banks/my"
+}
+
+// DeleteQuestionBankKnowledgePath returns the URL path to the knowledge service DeleteQuestionBank HTTP endpoint.
+func DeleteQuestionBankKnowledgePath(bankID int64) string {
+	return fmt.Sprintf("/api/knowledge/banks/%v", bankID)
+}
+
+// GetBankQuestionsKnowledgePath returns the URL path to the knowledge service GetBankQuestions HTTP endpoint.
+func GetBankQuestionsKnowledgePath(bankID int64) string {
+	return fmt.Sprintf("/api/knowledge/banks/%v/questions", bankID)
+}
+
+// AddBankQuestionKnowledgePath returns the URL path to the knowledge service AddBankQuestion HTTP endpoint.
+func AddBankQuestionKnowledgePath(bankID int64) string {
+	return fmt.Sprintf("/api/knowledge/banks/%v/questions", bankID)
+}
+
+// UpdateBankQuestionKnowledgePath returns the URL path to the knowledge service UpdateBankQuestion HTTP endpoint.
+func UpdateBankQuestionKnowledgePath(bankID int64, questionID int64) string {
+	return fmt.Sprintf("/api/knowledge/banks/%v/questions/%v", bankID, questionID)
+}
+
+// DeleteBankQuestionKnowledgePath returns the URL path to the knowledge service DeleteBankQuestion HTTP endpoint.
+func DeleteBankQuestionKnowledgePath(bankID int64, questionID int64) string {
+	return fmt.Sprintf("/api/knowledge/banks/%v/questions/%v", bankID, questionID)
+}
+
+// AddDrawRuleKnowledgePath returns the URL path to the knowledge service AddDrawRule HTTP endpoint.
+func AddDrawRuleKnowledgePath(testID int64) string {
+	return fmt.Sprintf("/api/knowledge/tests/%v/draw-rules", testID)
+}
+
+// GetDrawRulesKnowledgePath returns the URL path to the knowledge service GetDrawRules HTTP endpoint.
+func GetDrawRulesKnowledgePath(testID int64) string {
+	return fmt.Sprintf("/api/knowledge/tests/%v/draw-rules", testID)
+}
+
+// DeleteDrawRuleKnowledgePath returns the URL path to the knowledge service DeleteDrawRule HTTP endpoint.
+func DeleteDrawRuleKnowledgePath(testID int64, ruleID int64) string {
+	return fmt.Sprintf("/api/knowledge/tests/%v/draw-rules/%v", testID, ruleID)
+}
+
+// GetTestAnalyticsKnowledgePath returns the URL path to the knowledge service GetTestAnalytics HTTP endpoint.
+func GetTestAnalyticsKnowledgePath(testID int64) string {
+	return fmt.Sprintf("/api/knowledge/tests/%v/analytics", testID)
+}
+
+// GetTestGradebookKnowledgePath returns the URL path to the knowledge service GetTestGradebook HTTP endpoint.
+func GetTestGradebookKnowledgePath(testID int64) string {
+	return fmt.Sprintf("/api/knowledge/tests/%v/gradebook", testID)
+}
+
+// GetStudentHistoryKnowledgePath returns the URL path to the knowledge service GetStudentHistory HTTP endpoint.
+func GetStudentHistoryKnowledgePath(userID int64) string {
+	return fmt.Sprintf("/api/knowledge/students/%v/submissions", userID)
+}
+
 // GetAvailableTestsKnowledgePath returns the URL path to the knowledge service GetAvailableTests HTTP endpoint.
 func GetAvailableTestsKnowledgePath() string {
 	return "/api/knowledge/tests/available"
 }
 
+// StartTestKnowledgePath returns the URL path to the knowledge service StartTest HTTP endpoint.
+func StartTestKnowledgePath(testID int64) string {
+	return fmt.Sprintf("/api/knowledge/tests/%v/start", testID)
+}
+
 // GetTestFormKnowledgePath returns the URL path to the knowledge service GetTestForm HTTP endpoint.
 func GetTestFormKnowledgePath(testID int64) string {
 	return fmt.Sprintf("/api/knowledge/tests/%v/form", testID)
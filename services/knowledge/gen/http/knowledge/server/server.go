@@ -31,7 +31,21 @@ type Server struct {
 	GetQuestionByID     http.Handler
 	UpdateQuestion      http.Handler
 	DeleteQuestion      http.Handler
+	CreateQuestionBank  http.Handler
+	GetMyQuestionBanks  http.Handler
+	DeleteQuestionBank  http.Handler
+	GetBankQuestions    http.Handler
+	AddBankQuestion     http.Handler
+	UpdateBankQuestion  http.Handler
+	DeleteBankQuestion  http.Handler
+	AddDrawRule         http.Handler
+	GetDrawRules        http.Handler
+	DeleteDrawRule      http.Handler
+	GetTestAnalytics    http.Handler
+	GetTestGradebook    http.Handler
+	GetStudentHistory   http.Handler
 	GetAvailableTests   http.Handler
+	StartTest           http.Handler
 	GetTestForm         http.Handler
 	SubmitTest          http.Handler
 	GetMySubmissions    http.Handler
@@ -76,7 +90,21 @@ func New(
 			{"GetQuestionByID", "GET", "/api/knowledge/tests/{test_id}/questions/{question_id}"},
 			{"UpdateQuestion", "PUT", "/api/knowledge/tests/{test_id}/questions/{question_id}"},
 			{"DeleteQuestion", "DELETE", "/api/knowledge/tests/{test_id}/questions/{question_id}"},
+			{"CreateQuestionBank", "POST", "/api/knowledge/banks"},
+			{"GetMyQuestionBanks", "GET", "/api/knowledge/banks/my"},
+			{"DeleteQuestionBank", "DELETE", "/api/knowledge/banks/{bank_id}"},
+			{"GetBankQuestions", "GET", "/api/knowledge/banks/{bank_id}/questions"},
+			{"AddBankQuestion", "POST", "/api/knowledge/banks/{bank_id}/questions"},
+			{"UpdateBankQuestion", "PUT", "/api/knowledge/banks/{bank_id}/questions/{question_id}"},
+			{"DeleteBankQuestion", "DELETE", "/api/knowledge/banks/{bank_id}/questions/{question_id}"},
+			{"AddDrawRule", "POST", "/api/knowledge/tests/{test_id}/draw-rules"},
+			{"GetDrawRules", "GET", "/api/knowledge/tests/{test_id}/draw-rules"},
+			{"DeleteDrawRule", "DELETE", "/api/knowledge/tests/{test_id}/draw-rules/{rule_id}"},
+			{"GetTestAnalytics", "GET", "/api/knowledge/tests/{test_id}/analytics"},
+			{"GetTestGradebook", "GET", "/api/knowledge/tests/{test_id}/gradebook"},
+			{"GetStudentHistory", "GET", "/api/knowledge/students/{user_id}/submissions"},
 			{"GetAvailableTests", "GET", "/api/knowledge/tests/available"},
+			{"StartTest", "POST", "/api/knowledge/tests/{test_id}/start"},
 			{"GetTestForm", "GET", "/api/knowledge/tests/{test_id}/form"},
 			{"SubmitTest", "POST", "/api/knowledge/tests/{test_id}/submit"},
 			{"GetMySubmissions", "GET", "/api/knowledge/submissions/my"},
@@ -93,7 +121,21 @@ func New(
 		GetQuestionByID:     NewGetQuestionByIDHandler(e.GetQuestionByID, mux, decoder, encoder, errhandler, formatter),
 		UpdateQuestion:      NewUpdateQuestionHandler(e.UpdateQuestion, mux, decoder, encoder, errhandler, formatter),
 		DeleteQuestion:      NewDeleteQuestionHandler(e.DeleteQuestion, mux, decoder, encoder, errhandler, formatter),
+		CreateQuestionBank:  NewCreateQuestionBankHandler(e.CreateQuestionBank, mux, decoder, encoder, errhandler, formatter),
+		GetMyQuestionBanks:  NewGetMyQuestionBanksHandler(e.GetMyQuestionBanks, mux, decoder, encoder, errhandler, formatter),
+		DeleteQuestionBank:  NewDeleteQuestionBankHandler(e.DeleteQuestionBank, mux, decoder, encoder, errhandler, formatter),
+		GetBankQuestions:    NewGetBankQuestionsHandler(e.GetBankQuestions, mux, decoder, encoder, errhandler, formatter),
+		AddBankQuestion:     NewAddBankQuestionHandler(e.AddBankQuestion, mux, decoder, encoder, errhandler, formatter),
+		UpdateBankQuestion:  NewUpdateBankQuestionHandler(e.UpdateBankQuestion, mux, decoder, encoder, errhandler, formatter),
+		DeleteBankQuestion:  NewDeleteBankQuestionHandler(e.DeleteBankQuestion, mux, decoder, encoder, errhandler, formatter),
+		AddDrawRule:         NewAddDrawRuleHandler(e.AddDrawRule, mux, decoder, encoder, errhandler, formatter),
+		GetDrawRules:        NewGetDrawRulesHandler(e.GetDrawRules, mux, decoder, encoder, errhandler, formatter),
+		DeleteDrawRule:      NewDeleteDrawRuleHandler(e.DeleteDrawRule, mux, decoder, encoder, errhandler, formatter),
+		GetTestAnalytics:    NewGetTestAnalyticsHandler(e.GetTestAnalytics, mux, decoder, encoder, errhandler, formatter),
+		GetTestGradebook:    NewGetTestGradebookHandler(e.GetTestGradebook, mux, decoder, encoder, errhandler, formatter),
+		GetStudentHistory:   NewGetStudentHistoryHandler(e.GetStudentHistory, mux, decoder, encoder, errhandler, formatter),
 		GetAvailableTests:   NewGetAvailableTestsHandler(e.GetAvailableTests, mux, decoder, encoder, errhandler, formatter),
+		StartTest:           NewStartTestHandler(e.StartTest, mux, decoder, encoder, errhandler, formatter),
 		GetTestForm:         NewGetTestFormHandler(e.GetTestForm, mux, decoder, encoder, errhandler, formatter),
 		SubmitTest:          NewSubmitTestHandler(e.SubmitTest, mux, decoder, encoder, errhandler, formatter),
 		GetMySubmissions:    NewGetMySubmissionsHandler(e.GetMySubmissions, mux, decoder, encoder, errhandler, formatter),
@@ -117,7 +159,21 @@ func (s *Server) Use(m func(http.Handler) http.Handler) {
 	s.GetQuestionByID = m(s.GetQuestionByID)
 	s.UpdateQuestion = m(s.UpdateQuestion)
 	s.DeleteQuestion = m(s.DeleteQuestion)
+	s.CreateQuestionBank = m(s.CreateQuestionBank)
+	s.GetMyQuestionBanks = m(s.GetMyQuestionBanks)
+	s.DeleteQuestionBank = m(s.DeleteQuestionBank)
+	s.GetBankQuestions = m(s.GetBankQuestions)
+	s.AddBankQuestion = m(s.AddBankQuestion)
+	s.UpdateBankQuestion = m(s.UpdateBankQuestion)
+	s.DeleteBankQuestion = m(s.DeleteBankQuestion)
+	s.AddDrawRule = m(s.AddDrawRule)
+	s.GetDrawRules = m(s.GetDrawRules)
+	s.DeleteDrawRule = m(s.DeleteDrawRule)
+	s.GetTestAnalytics = m(s.GetTestAnalytics)
+	s.GetTestGradebook = m(s.GetTestGradebook)
+	s.GetStudentHistory = m(s.GetStudentHistory)
 	s.GetAvailableTests = m(s.GetAvailableTests)
+	s.StartTest = m(s.StartTest)
 	s.GetTestForm = m(s.GetTestForm)
 	s.SubmitTest = m(s.SubmitTest)
 	s.GetMySubmissions = m(s.GetMySubmissions)
@@ -140,7 +196,21 @@ func Mount(mux goahttp.Muxer, h *Server) {
 	MountGetQuestionByIDHandler(mux, h.GetQuestionByID)
 	MountUpdateQuestionHandler(mux, h.UpdateQuestion)
 	MountDeleteQuestionHandler(mux, h.DeleteQuestion)
+	MountCreateQuestionBankHandler(mux, h.CreateQuestionBank)
+	MountGetMyQuestionBanksHandler(mux, h.GetMyQuestionBanks)
+	MountDeleteQuestionBankHandler(mux, h.DeleteQuestionBank)
+	MountGetBankQuestionsHandler(mux, h.GetBankQuestions)
+	MountAddBankQuestionHandler(mux, h.AddBankQuestion)
+	MountUpdateBankQuestionHandler(mux, h.UpdateBankQuestion)
+	MountDeleteBankQuestionHandler(mux, h.DeleteBankQuestion)
+	MountAddDrawRuleHandler(mux, h.AddDrawRule)
+	MountGetDrawRulesHandler(mux, h.GetDrawRules)
+	MountDeleteDrawRuleHandler(mux, h.DeleteDrawRule)
+	MountGetTestAnalyticsHandler(mux, h.GetTestAnalytics)
+	MountGetTestGradebookHandler(mux, h.GetTestGradebook)
+	MountGetStudentHistoryHandler(mux, h.GetStudentHistory)
 	MountGetAvailableTestsHandler(mux, h.GetAvailableTests)
+	MountStartTestHandler(mux, h.StartTest)
 	MountGetTestFormHandler(mux, h.GetTestForm)
 	MountSubmitTestHandler(mux, h.SubmitTest)
 	MountGetMySubmissionsHandler(mux, h.GetMySubmissions)
@@ -663,6 +733,669 @@ func NewDeleteQuestionHandler(
 	})
 }
 
+// MountCreateQuestionBankHandler configures the mux to serve the "knowledge"
+// service "CreateQuestionBank" endpoint.
+func MountCreateQuestionBankHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("POST", "/api/knowledge/banks", f)
+}
+
+// NewCreateQuestionBankHandler creates a HTTP handler which loads the HTTP
+// request and calls the "knowledge" service "CreateQuestionBank" endpoint.
+func NewCreateQuestionBankHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeCreateQuestionBankRequest(mux, decoder)
+		encodeResponse = EncodeCreateQuestionBankResponse(encoder)
+		encodeError    = EncodeCreateQuestionBankError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "CreateQuestionBank")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "knowledge")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			errhandler(ctx, w, err)
+		}
+	})
+}
+
+// MountGetMyQuestionBanksHandler configures the mux to serve the "knowledge"
+// service "GetMyQuestionBanks" endpoint.
+func MountGetMyQuestionBanksHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/api/knowledge/banks/my", f)
+}
+
+// NewGetMyQuestionBanksHandler creates a HTTP handler which loads the HTTP
+// request and calls the "knowledge" service "GetMyQuestionBanks" endpoint.
+func NewGetMyQuestionBanksHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeGetMyQuestionBanksRequest(mux, decoder)
+		encodeResponse = EncodeGetMyQuestionBanksResponse(encoder)
+		encodeError    = EncodeGetMyQuestionBanksError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "GetMyQuestionBanks")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "knowledge")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			errhandler(ctx, w, err)
+		}
+	})
+}
+
+// MountDeleteQuestionBankHandler configures the mux to serve the "knowledge"
+// service "DeleteQuestionBank" endpoint.
+func MountDeleteQuestionBankHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("DELETE", "/api/knowledge/banks/{bank_id}", f)
+}
+
+// NewDeleteQuestionBankHandler creates a HTTP handler which loads the HTTP
+// request and calls the "knowledge" service "DeleteQuestionBank" endpoint.
+func NewDeleteQuestionBankHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeDeleteQuestionBankRequest(mux, decoder)
+		encodeResponse = EncodeDeleteQuestionBankResponse(encoder)
+		encodeError    = EncodeDeleteQuestionBankError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "DeleteQuestionBank")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "knowledge")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			errhandler(ctx, w, err)
+		}
+	})
+}
+
+// MountGetBankQuestionsHandler configures the mux to serve the "knowledge"
+// service "GetBankQuestions" endpoint.
+func MountGetBankQuestionsHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/api/knowledge/banks/{bank_id}/questions", f)
+}
+
+// NewGetBankQuestionsHandler creates a HTTP handler which loads the HTTP
+// request and calls the "knowledge" service "GetBankQuestions" endpoint.
+func NewGetBankQuestionsHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeGetBankQuestionsRequest(mux, decoder)
+		encodeResponse = EncodeGetBankQuestionsResponse(encoder)
+		encodeError    = EncodeGetBankQuestionsError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "GetBankQuestions")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "knowledge")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			errhandler(ctx, w, err)
+		}
+	})
+}
+
+// MountAddBankQuestionHandler configures the mux to serve the "knowledge"
+// service "AddBankQuestion" endpoint.
+func MountAddBankQuestionHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("POST", "/api/knowledge/banks/{bank_id}/questions", f)
+}
+
+// NewAddBankQuestionHandler creates a HTTP handler which loads the HTTP
+// request and calls the "knowledge" service "AddBankQuestion" endpoint.
+func NewAddBankQuestionHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeAddBankQuestionRequest(mux, decoder)
+		encodeResponse = EncodeAddBankQuestionResponse(encoder)
+		encodeError    = EncodeAddBankQuestionError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "AddBankQuestion")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "knowledge")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			errhandler(ctx, w, err)
+		}
+	})
+}
+
+// MountUpdateBankQuestionHandler configures the mux to serve the "knowledge"
+// service "UpdateBankQuestion" endpoint.
+func MountUpdateBankQuestionHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("PUT", "/api/knowledge/banks/{bank_id}/questions/{question_id}", f)
+}
+
+// NewUpdateBankQuestionHandler creates a HTTP handler which loads the HTTP
+// request and calls the "knowledge" service "UpdateBankQuestion" endpoint.
+func NewUpdateBankQuestionHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeUpdateBankQuestionRequest(mux, decoder)
+		encodeResponse = EncodeUpdateBankQuestionResponse(encoder)
+		encodeError    = EncodeUpdateBankQuestionError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "UpdateBankQuestion")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "knowledge")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			errhandler(ctx, w, err)
+		}
+	})
+}
+
+// MountDeleteBankQuestionHandler configures the mux to serve the "knowledge"
+// service "DeleteBankQuestion" endpoint.
+func MountDeleteBankQuestionHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("DELETE", "/api/knowledge/banks/{bank_id}/questions/{question_id}", f)
+}
+
+// NewDeleteBankQuestionHandler creates a HTTP handler which loads the HTTP
+// request and calls the "knowledge" service "DeleteBankQuestion" endpoint.
+func NewDeleteBankQuestionHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeDeleteBankQuestionRequest(mux, decoder)
+		encodeResponse = EncodeDeleteBankQuestionResponse(encoder)
+		encodeError    = EncodeDeleteBankQuestionError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "DeleteBankQuestion")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "knowledge")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			errhandler(ctx, w, err)
+		}
+	})
+}
+
+// MountAddDrawRuleHandler configures the mux to serve the "knowledge" service
+// "AddDrawRule" endpoint.
+func MountAddDrawRuleHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("POST", "/api/knowledge/tests/{test_id}/draw-rules", f)
+}
+
+// NewAddDrawRuleHandler creates a HTTP handler which loads the HTTP request
+// and calls the "knowledge" service "AddDrawRule" endpoint.
+func NewAddDrawRuleHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeAddDrawRuleRequest(mux, decoder)
+		encodeResponse = EncodeAddDrawRuleResponse(encoder)
+		encodeError    = EncodeAddDrawRuleError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "AddDrawRule")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "knowledge")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			errhandler(ctx, w, err)
+		}
+	})
+}
+
+// MountGetDrawRulesHandler configures the mux to serve the "knowledge" service
+// "GetDrawRules" endpoint.
+func MountGetDrawRulesHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/api/knowledge/tests/{test_id}/draw-rules", f)
+}
+
+// NewGetDrawRulesHandler creates a HTTP handler which loads the HTTP request
+// and calls the "knowledge" service "GetDrawRules" endpoint.
+func NewGetDrawRulesHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeGetDrawRulesRequest(mux, decoder)
+		encodeResponse = EncodeGetDrawRulesResponse(encoder)
+		encodeError    = EncodeGetDrawRulesError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "GetDrawRules")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "knowledge")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			errhandler(ctx, w, err)
+		}
+	})
+}
+
+// MountDeleteDrawRuleHandler configures the mux to serve the "knowledge"
+// service "DeleteDrawRule" endpoint.
+func MountDeleteDrawRuleHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("DELETE", "/api/knowledge/tests/{test_id}/draw-rules/{rule_id}", f)
+}
+
+// NewDeleteDrawRuleHandler creates a HTTP handler which loads the HTTP request
+// and calls the "knowledge" service "DeleteDrawRule" endpoint.
+func NewDeleteDrawRuleHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeDeleteDrawRuleRequest(mux, decoder)
+		encodeResponse = EncodeDeleteDrawRuleResponse(encoder)
+		encodeError    = EncodeDeleteDrawRuleError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "DeleteDrawRule")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "knowledge")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			errhandler(ctx, w, err)
+		}
+	})
+}
+
+// MountGetTestAnalyticsHandler configures the mux to serve the "knowledge"
+// service "GetTestAnalytics" endpoint.
+func MountGetTestAnalyticsHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/api/knowledge/tests/{test_id}/analytics", f)
+}
+
+// NewGetTestAnalyticsHandler creates a HTTP handler which loads the HTTP
+// request and calls the "knowledge" service "GetTestAnalytics" endpoint.
+func NewGetTestAnalyticsHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeGetTestAnalyticsRequest(mux, decoder)
+		encodeResponse = EncodeGetTestAnalyticsResponse(encoder)
+		encodeError    = EncodeGetTestAnalyticsError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "GetTestAnalytics")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "knowledge")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			errhandler(ctx, w, err)
+		}
+	})
+}
+
+// MountGetTestGradebookHandler configures the mux to serve the "knowledge"
+// service "GetTestGradebook" endpoint.
+func MountGetTestGradebookHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/api/knowledge/tests/{test_id}/gradebook", f)
+}
+
+// NewGetTestGradebookHandler creates a HTTP handler which loads the HTTP
+// request and calls the "knowledge" service "GetTestGradebook" endpoint.
+func NewGetTestGradebookHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeGetTestGradebookRequest(mux, decoder)
+		encodeResponse = EncodeGetTestGradebookResponse(encoder)
+		encodeError    = EncodeGetTestGradebookError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "GetTestGradebook")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "knowledge")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			errhandler(ctx, w, err)
+		}
+	})
+}
+
+// MountGetStudentHistoryHandler configures the mux to serve the "knowledge"
+// service "GetStudentHistory" endpoint.
+func MountGetStudentHistoryHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("GET", "/api/knowledge/students/{user_id}/submissions", f)
+}
+
+// NewGetStudentHistoryHandler creates a HTTP handler which loads the HTTP
+// request and calls the "knowledge" service "GetStudentHistory" endpoint.
+func NewGetStudentHistoryHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeGetStudentHistoryRequest(mux, decoder)
+		encodeResponse = EncodeGetStudentHistoryResponse(encoder)
+		encodeError    = EncodeGetStudentHistoryError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "GetStudentHistory")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "knowledge")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			errhandler(ctx, w, err)
+		}
+	})
+}
+
 // MountGetAvailableTestsHandler configures the mux to serve the "knowledge"
 // service "GetAvailableTests" endpoint.
 func MountGetAvailableTestsHandler(mux goahttp.Muxer, h http.Handler) {
@@ -714,6 +1447,57 @@ func NewGetAvailableTestsHandler(
 	})
 }
 
+// MountStartTestHandler configures the mux to serve the "knowledge" service
+// "StartTest" endpoint.
+func MountStartTestHandler(mux goahttp.Muxer, h http.Handler) {
+	f, ok := h.(http.HandlerFunc)
+	if !ok {
+		f = func(w http.ResponseWriter, r *http.Request) {
+			h.ServeHTTP(w, r)
+		}
+	}
+	mux.Handle("POST", "/api/knowledge/tests/{test_id}/start", f)
+}
+
+// NewStartTestHandler creates a HTTP handler which loads the HTTP request and
+// calls the "knowledge" service "StartTest" endpoint.
+func NewStartTestHandler(
+	endpoint goa.Endpoint,
+	mux goahttp.Muxer,
+	decoder func(*http.Request) goahttp.Decoder,
+	encoder func(context.Context, http.ResponseWriter) goahttp.Encoder,
+	errhandler func(context.Context, http.ResponseWriter, error),
+	formatter func(ctx context.Context, err error) goahttp.Statuser,
+) http.Handler {
+	var (
+		decodeRequest  = DecodeStartTestRequest(mux, decoder)
+		encodeResponse = EncodeStartTestResponse(encoder)
+		encodeError    = EncodeStartTestError(encoder, formatter)
+	)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), goahttp.AcceptTypeKey, r.Header.Get("Accept"))
+		ctx = context.WithValue(ctx, goa.MethodKey, "StartTest")
+		ctx = context.WithValue(ctx, goa.ServiceKey, "knowledge")
+		payload, err := decodeRequest(r)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		res, err := endpoint(ctx, payload)
+		if err != nil {
+			if err := encodeError(ctx, w, err); err != nil {
+				errhandler(ctx, w, err)
+			}
+			return
+		}
+		if err := encodeResponse(ctx, w, res); err != nil {
+			errhandler(ctx, w, err)
+		}
+	})
+}
+
 // MountGetTestFormHandler configures the mux to serve the "knowledge" service
 // "GetTestForm" endpoint.
 func MountGetTestFormHandler(mux goahttp.Muxer, h http.Handler) {
@@ -10,6 +10,8 @@
 package server
 
 import (
+	"unicode/utf8"
+
 	knowledge "github.com/ynoacamino/infra-sustainable-classrooms/services/knowledge/gen/knowledge"
 	goa "goa.design/goa/v3/pkg"
 )
@@ -19,6 +21,20 @@ import (
 type CreateTestRequestBody struct {
 	// Test title
 	Title *string `form:"title,omitempty" json:"title,omitempty" xml:"title,omitempty"`
+	// Time limit in minutes, untimed when not set
+	DurationMinutes *int `form:"duration_minutes,omitempty" json:"duration_minutes,omitempty" xml:"duration_minutes,omitempty"`
+	// Attempts allowed per student, 0 for unlimited
+	MaxAttempts *int `form:"max_attempts,omitempty" json:"max_attempts,omitempty" xml:"max_attempts,omitempty"`
+	// Minutes a student waits between attempts
+	CooldownMinutes *int `form:"cooldown_minutes,omitempty" json:"cooldown_minutes,omitempty" xml:"cooldown_minutes,omitempty"`
+	// Which score counts when the test is taken more than once
+	ScorePolicy *string `form:"score_policy,omitempty" json:"score_policy,omitempty" xml:"score_policy,omitempty"`
+	// Whether correct answers are shown after each attempt
+	RevealAnswers *bool `form:"reveal_answers,omitempty" json:"reveal_answers,omitempty" xml:"reveal_answers,omitempty"`
+	// Whether each attempt shows the questions in a different order
+	ShuffleQuestions *bool `form:"shuffle_questions,omitempty" json:"shuffle_questions,omitempty" xml:"shuffle_questions,omitempty"`
+	// Whether each attempt shows the options in a different order
+	ShuffleOptions *bool `form:"shuffle_options,omitempty" json:"shuffle_options,omitempty" xml:"shuffle_options,omitempty"`
 }
 
 // UpdateTestRequestBody is the type of the "knowledge" service "UpdateTest"
@@ -26,6 +42,20 @@ type CreateTestRequestBody struct {
 type UpdateTestRequestBody struct {
 	// New title
 	Title *string `form:"title,omitempty" json:"title,omitempty" xml:"title,omitempty"`
+	// Time limit in minutes, untimed when not set
+	DurationMinutes *int `form:"duration_minutes,omitempty" json:"duration_minutes,omitempty" xml:"duration_minutes,omitempty"`
+	// Attempts allowed per student, 0 for unlimited
+	MaxAttempts *int `form:"max_attempts,omitempty" json:"max_attempts,omitempty" xml:"max_attempts,omitempty"`
+	// Minutes a student waits between attempts
+	CooldownMinutes *int `form:"cooldown_minutes,omitempty" json:"cooldown_minutes,omitempty" xml:"cooldown_minutes,omitempty"`
+	// Which score counts when the test is taken more than once
+	ScorePolicy *string `form:"score_policy,omitempty" json:"score_policy,omitempty" xml:"score_policy,omitempty"`
+	// Whether correct answers are shown after each attempt
+	RevealAnswers *bool `form:"reveal_answers,omitempty" json:"reveal_answers,omitempty" xml:"reveal_answers,omitempty"`
+	// Whether each attempt shows the questions in a different order
+	ShuffleQuestions *bool `form:"shuffle_questions,omitempty" json:"shuffle_questions,omitempty" xml:"shuffle_questions,omitempty"`
+	// Whether each attempt shows the options in a different order
+	ShuffleOptions *bool `form:"shuffle_options,omitempty" json:"shuffle_options,omitempty" xml:"shuffle_options,omitempty"`
 }
 
 // AddQuestionRequestBody is the type of the "knowledge" service "AddQuestion"
@@ -33,16 +63,19 @@ type UpdateTestRequestBody struct {
 type AddQuestionRequestBody struct {
 	// Question text
 	QuestionText *string `form:"question_text,omitempty" json:"question_text,omitempty" xml:"question_text,omitempty"`
-	// Option A
-	OptionA *string `form:"option_a,omitempty" json:"option_a,omitempty" xml:"option_a,omitempty"`
-	// Option B
-	OptionB *string `form:"option_b,omitempty" json:"option_b,omitempty" xml:"option_b,omitempty"`
-	// Option C
-	OptionC *string `form:"option_c,omitempty" json:"option_c,omitempty" xml:"option_c,omitempty"`
-	// Option D
-	OptionD *string `form:"option_d,omitempty" json:"option_d,omitempty" xml:"option_d,omitempty"`
-	// Correct answer (0=A, 1=B, 2=C, 3=D)
-	CorrectAnswer *int `form:"correct_answer,omitempty" json:"correct_answer,omitempty" xml:"correct_answer,omitempty"`
+	// Question type
+	QuestionType *string `form:"question_type,omitempty" json:"question_type,omitempty" xml:"question_type,omitempty"`
+	// Options of choice and ordering questions, True and False by default for
+	// true/false questions
+	Options []string `form:"options,omitempty" json:"options,omitempty" xml:"options,omitempty"`
+	// Indexes of the correct options, in the correct order for ordering questions
+	CorrectOptions []int `form:"correct_options,omitempty" json:"correct_options,omitempty" xml:"correct_options,omitempty"`
+	// Correct answer of numeric questions
+	NumericAnswer *float64 `form:"numeric_answer,omitempty" json:"numeric_answer,omitempty" xml:"numeric_answer,omitempty"`
+	// Allowed difference from the numeric answer
+	Tolerance *float64 `form:"tolerance,omitempty" json:"tolerance,omitempty" xml:"tolerance,omitempty"`
+	// Accepted answers of short text questions, where * matches any text
+	AcceptedAnswers []string `form:"accepted_answers,omitempty" json:"accepted_answers,omitempty" xml:"accepted_answers,omitempty"`
 }
 
 // UpdateQuestionRequestBody is the type of the "knowledge" service
@@ -50,16 +83,87 @@ type AddQuestionRequestBody struct {
 type UpdateQuestionRequestBody struct {
 	// Question text
 	QuestionText *string `form:"question_text,omitempty" json:"question_text,omitempty" xml:"question_text,omitempty"`
-	// Option A
-	OptionA *string `form:"option_a,omitempty" json:"option_a,omitempty" xml:"option_a,omitempty"`
-	// Option B
-	OptionB *string `form:"option_b,omitempty" json:"option_b,omitempty" xml:"option_b,omitempty"`
-	// Option C
-	OptionC *string `form:"option_c,omitempty" json:"option_c,omitempty" xml:"option_c,omitempty"`
-	// Option D
-	OptionD *string `form:"option_d,omitempty" json:"option_d,omitempty" xml:"option_d,omitempty"`
-	// Correct answer (0=A, 1=B, 2=C, 3=D)
-	CorrectAnswer *int `form:"correct_answer,omitempty" json:"correct_answer,omitempty" xml:"correct_answer,omitempty"`
+	// Question type
+	QuestionType *string `form:"question_type,omitempty" json:"question_type,omitempty" xml:"question_type,omitempty"`
+	// Options of choice and ordering questions, True and False by default for
+	// true/false questions
+	Options []string `form:"options,omitempty" json:"options,omitempty" xml:"options,omitempty"`
+	// Indexes of the correct options, in the correct order for ordering questions
+	CorrectOptions []int `form:"correct_options,omitempty" json:"correct_options,omitempty" xml:"correct_options,omitempty"`
+	// Correct answer of numeric questions
+	NumericAnswer *float64 `form:"numeric_answer,omitempty" json:"numeric_answer,omitempty" xml:"numeric_answer,omitempty"`
+	// Allowed difference from the numeric answer
+	Tolerance *float64 `form:"tolerance,omitempty" json:"tolerance,omitempty" xml:"tolerance,omitempty"`
+	// Accepted answers of short text questions, where * matches any text
+	AcceptedAnswers []string `form:"accepted_answers,omitempty" json:"accepted_answers,omitempty" xml:"accepted_answers,omitempty"`
+}
+
+// CreateQuestionBankRequestBody is the type of the "knowledge" service
+// "CreateQuestionBank" endpoint HTTP request body.
+type CreateQuestionBankRequestBody struct {
+	// Question bank title
+	Title *string `form:"title,omitempty" json:"title,omitempty" xml:"title,omitempty"`
+}
+
+// AddBankQuestionRequestBody is the type of the "knowledge" service
+// "AddBankQuestion" endpoint HTTP request body.
+type AddBankQuestionRequestBody struct {
+	// Question text
+	QuestionText *string `form:"question_text,omitempty" json:"question_text,omitempty" xml:"question_text,omitempty"`
+	// Question type
+	QuestionType *string `form:"question_type,omitempty" json:"question_type,omitempty" xml:"question_type,omitempty"`
+	// Options of choice and ordering questions, True and False by default for
+	// true/false questions
+	Options []string `form:"options,omitempty" json:"options,omitempty" xml:"options,omitempty"`
+	// Indexes of the correct options, in the correct order for ordering questions
+	CorrectOptions []int `form:"correct_options,omitempty" json:"correct_options,omitempty" xml:"correct_options,omitempty"`
+	// Correct answer of numeric questions
+	NumericAnswer *float64 `form:"numeric_answer,omitempty" json:"numeric_answer,omitempty" xml:"numeric_answer,omitempty"`
+	// Allowed difference from the numeric answer
+	Tolerance *float64 `form:"tolerance,omitempty" json:"tolerance,omitempty" xml:"tolerance,omitempty"`
+	// Accepted answers of short text questions, where * matches any text
+	AcceptedAnswers []string `form:"accepted_answers,omitempty" json:"accepted_answers,omitempty" xml:"accepted_answers,omitempty"`
+	// Topic of the question
+	Topic *string `form:"topic,omitempty" json:"topic,omitempty" xml:"topic,omitempty"`
+	// Question difficulty
+	Difficulty *string `form:"difficulty,omitempty" json:"difficulty,omitempty" xml:"difficulty,omitempty"`
+}
+
+// UpdateBankQuestionRequestBody is the type of the "knowledge" service
+// "UpdateBankQuestion" endpoint HTTP request body.
+type UpdateBankQuestionRequestBody struct {
+	// Question text
+	QuestionText *string `form:"question_text,omitempty" json:"question_text,omitempty" xml:"question_text,omitempty"`
+	// Question type
+	QuestionType *string `form:"question_type,omitempty" json:"question_type,omitempty" xml:"question_type,omitempty"`
+	// Options of choice and ordering questions, True and False by default for
+	// true/false questions
+	Options []string `form:"options,omitempty" json:"options,omitempty" xml:"options,omitempty"`
+	// Indexes of the correct options, in the correct order for ordering questions
+	CorrectOptions []int `form:"correct_options,omitempty" json:"correct_options,omitempty" xml:"correct_options,omitempty"`
+	// Correct answer of numeric questions
+	NumericAnswer *float64 `form:"numeric_answer,omitempty" json:"numeric_answer,omitempty" xml:"numeric_answer,omitempty"`
+	// Allowed difference from the numeric answer
+	Tolerance *float64 `form:"tolerance,omitempty" json:"tolerance,omitempty" xml:"tolerance,omitempty"`
+	// Accepted answers of short text questions, where * matches any text
+	AcceptedAnswers []string `form:"accepted_answers,omitempty" json:"accepted_answers,omitempty" xml:"accepted_answers,omitempty"`
+	// Topic of the question
+	Topic *string `form:"topic,omitempty" json:"topic,omitempty" xml:"topic,omitempty"`
+	// Question difficulty
+	Difficulty *string `form:"difficulty,omitempty" json:"difficulty,omitempty" xml:"difficulty,omitempty"`
+}
+
+// AddDrawRuleRequestBody is the type of the "knowledge" service "AddDrawRule"
+// endpoint HTTP request body.
+type AddDrawRuleRequestBody struct {
+	// Question bank ID
+	BankID *int64 `form:"bank_id,omitempty" json:"bank_id,omitempty" xml:"bank_id,omitempty"`
+	// Topic of the questions drawn, any when not set
+	Topic *string `form:"topic,omitempty" json:"topic,omitempty" xml:"topic,omitempty"`
+	// Difficulty of the questions drawn, any when not set
+	Difficulty *string `form:"difficulty,omitempty" json:"difficulty,omitempty" xml:"difficulty,omitempty"`
+	// Number of questions drawn
+	QuestionCount *int `form:"question_count,omitempty" json:"question_count,omitempty" xml:"question_count,omitempty"`
 }
 
 // SubmitTestRequestBody is the type of the "knowledge" service "SubmitTest"
@@ -151,6 +255,138 @@ type DeleteQuestionResponseBody struct {
 	Message string `form:"message" json:"message" xml:"message"`
 }
 
+// CreateQuestionBankResponseBody is the type of the "knowledge" service
+// "CreateQuestionBank" endpoint HTTP response body.
+type CreateQuestionBankResponseBody struct {
+	// Operation success status
+	Success bool `form:"success" json:"success" xml:"success"`
+	// Response message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// GetMyQuestionBanksResponseBody is the type of the "knowledge" service
+// "GetMyQuestionBanks" endpoint HTTP response body.
+type GetMyQuestionBanksResponseBody struct {
+	// Question banks
+	Banks []*QuestionBankResponseBody `form:"banks" json:"banks" xml:"banks"`
+}
+
+// DeleteQuestionBankResponseBody is the type of the "knowledge" service
+// "DeleteQuestionBank" endpoint HTTP response body.
+type DeleteQuestionBankResponseBody struct {
+	// Operation success status
+	Success bool `form:"success" json:"success" xml:"success"`
+	// Response message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// GetBankQuestionsResponseBody is the type of the "knowledge" service
+// "GetBankQuestions" endpoint HTTP response body.
+type GetBankQuestionsResponseBody struct {
+	// Questions
+	Questions []*BankQuestionResponseBody `form:"questions" json:"questions" xml:"questions"`
+}
+
+// AddBankQuestionResponseBody is the type of the "knowledge" service
+// "AddBankQuestion" endpoint HTTP response body.
+type AddBankQuestionResponseBody struct {
+	// Operation success status
+	Success bool `form:"success" json:"success" xml:"success"`
+	// Response message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// UpdateBankQuestionResponseBody is the type of the "knowledge" service
+// "UpdateBankQuestion" endpoint HTTP response body.
+type UpdateBankQuestionResponseBody struct {
+	// Operation success status
+	Success bool `form:"success" json:"success" xml:"success"`
+	// Response message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// DeleteBankQuestionResponseBody is the type of the "knowledge" service
+// "DeleteBankQuestion" endpoint HTTP response body.
+type DeleteBankQuestionResponseBody struct {
+	// Operation success status
+	Success bool `form:"success" json:"success" xml:"success"`
+	// Response message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// AddDrawRuleResponseBody is the type of the "knowledge" service "AddDrawRule"
+// endpoint HTTP response body.
+type AddDrawRuleResponseBody struct {
+	// Operation success status
+	Success bool `form:"success" json:"success" xml:"success"`
+	// Response message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// GetDrawRulesResponseBody is the type of the "knowledge" service
+// "GetDrawRules" endpoint HTTP response body.
+type GetDrawRulesResponseBody struct {
+	// Draw rules
+	Rules []*DrawRuleResponseBody `form:"rules" json:"rules" xml:"rules"`
+}
+
+// DeleteDrawRuleResponseBody is the type of the "knowledge" service
+// "DeleteDrawRule" endpoint HTTP response body.
+type DeleteDrawRuleResponseBody struct {
+	// Operation success status
+	Success bool `form:"success" json:"success" xml:"success"`
+	// Response message
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
+// GetTestAnalyticsResponseBody is the type of the "knowledge" service
+// "GetTestAnalytics" endpoint HTTP response body.
+type GetTestAnalyticsResponseBody struct {
+	// Test ID
+	TestID int64 `form:"test_id" json:"test_id" xml:"test_id"`
+	// Number of attempts analyzed
+	Attempts int `form:"attempts" json:"attempts" xml:"attempts"`
+	// Mean score percentage
+	Mean float64 `form:"mean" json:"mean" xml:"mean"`
+	// Median score percentage
+	Median float64 `form:"median" json:"median" xml:"median"`
+	// Standard deviation of the score percentages
+	StdDev float64 `form:"std_dev" json:"std_dev" xml:"std_dev"`
+	// Number of attempts by score range
+	ScoreHistogram []*ScoreBinResponseBody `form:"score_histogram" json:"score_histogram" xml:"score_histogram"`
+	// Item analysis of every question given
+	Questions []*QuestionAnalyticsResponseBody `form:"questions" json:"questions" xml:"questions"`
+	// Reliability coefficient over the questions given in every attempt, not set
+	// when it cannot be computed
+	Reliability *float64 `form:"reliability,omitempty" json:"reliability,omitempty" xml:"reliability,omitempty"`
+	// Reliability coefficient used, kr20 when those questions were all scored
+	// right or wrong and Cronbach's alpha when any earned partial credit, not set
+	// without a reliability
+	ReliabilityMethod *string `form:"reliability_method,omitempty" json:"reliability_method,omitempty" xml:"reliability_method,omitempty"`
+}
+
+// GetTestGradebookResponseBody is the type of the "knowledge" service
+// "GetTestGradebook" endpoint HTTP response body.
+type GetTestGradebookResponseBody struct {
+	// Test info
+	Test *TestResponseBody `form:"test" json:"test" xml:"test"`
+	// Submissions, sorted and filtered as requested
+	Submissions []*StudentSubmissionResponseBody `form:"submissions" json:"submissions" xml:"submissions"`
+}
+
+// GetStudentHistoryResponseBody is the type of the "knowledge" service
+// "GetStudentHistory" endpoint HTTP response body.
+type GetStudentHistoryResponseBody struct {
+	// Student user ID
+	UserID int64 `form:"user_id" json:"user_id" xml:"user_id"`
+	// Student full name
+	StudentName string `form:"student_name" json:"student_name" xml:"student_name"`
+	// Submissions, newest first
+	Submissions []*SubmissionResponseBody `form:"submissions" json:"submissions" xml:"submissions"`
+	// Score counted for each test taken
+	TestScores []*TestScoreResponseBody `form:"test_scores" json:"test_scores" xml:"test_scores"`
+}
+
 // GetAvailableTestsResponseBody is the type of the "knowledge" service
 // "GetAvailableTests" endpoint HTTP response body.
 type GetAvailableTestsResponseBody struct {
@@ -158,6 +394,23 @@ type GetAvailableTestsResponseBody struct {
 	Tests []*TestResponseBody `form:"tests" json:"tests" xml:"tests"`
 }
 
+// StartTestResponseBody is the type of the "knowledge" service "StartTest"
+// endpoint HTTP response body.
+type StartTestResponseBody struct {
+	// Submission ID
+	SubmissionID int64 `form:"submission_id" json:"submission_id" xml:"submission_id"`
+	// Test ID
+	TestID int64 `form:"test_id" json:"test_id" xml:"test_id"`
+	// Attempt status
+	Status string `form:"status" json:"status" xml:"status"`
+	// Start timestamp
+	StartedAt int64 `form:"started_at" json:"started_at" xml:"started_at"`
+	// Timestamp by which answers must be submitted, not set for untimed tests
+	Deadline *int64 `form:"deadline,omitempty" json:"deadline,omitempty" xml:"deadline,omitempty"`
+	// Time limit in minutes
+	DurationMinutes *int `form:"duration_minutes,omitempty" json:"duration_minutes,omitempty" xml:"duration_minutes,omitempty"`
+}
+
 // GetTestFormResponseBody is the type of the "knowledge" service "GetTestForm"
 // endpoint HTTP response body.
 type GetTestFormResponseBody struct {
@@ -178,6 +431,8 @@ type SubmitTestResponseBody struct {
 	SubmissionID int64 `form:"submission_id" json:"submission_id" xml:"submission_id"`
 	// Score percentage
 	Score float64 `form:"score" json:"score" xml:"score"`
+	// Number of the attempt at the test, from 1
+	AttemptNumber int `form:"attempt_number" json:"attempt_number" xml:"attempt_number"`
 }
 
 // GetMySubmissionsResponseBody is the type of the "knowledge" service
@@ -185,6 +440,8 @@ type SubmitTestResponseBody struct {
 type GetMySubmissionsResponseBody struct {
 	// Submissions
 	Submissions []*SubmissionResponseBody `form:"submissions" json:"submissions" xml:"submissions"`
+	// Score counted for each test taken
+	TestScores []*TestScoreResponseBody `form:"test_scores" json:"test_scores" xml:"test_scores"`
 }
 
 // GetSubmissionByIDResponseBody is the type of the "knowledge" service
@@ -201,6 +458,12 @@ type GetSubmissionResultResponseBody struct {
 	Submission *SubmissionResponseBody `form:"submission" json:"submission" xml:"submission"`
 	// Question results
 	Questions []*QuestionResultResponseBody `form:"questions" json:"questions" xml:"questions"`
+	// Every attempt at the test, oldest first
+	Attempts []*SubmissionResponseBody `form:"attempts" json:"attempts" xml:"attempts"`
+	// Score percentage counted for the test over every attempt
+	CountedScore float64 `form:"counted_score" json:"counted_score" xml:"counted_score"`
+	// Whether the questions include their correct answers
+	AnswersRevealed bool `form:"answers_revealed" json:"answers_revealed" xml:"answers_revealed"`
 }
 
 // TestResponseBody is used to define fields on response body types.
@@ -215,6 +478,20 @@ type TestResponseBody struct {
 	CreatedAt int64 `form:"created_at" json:"created_at" xml:"created_at"`
 	// Number of questions
 	QuestionCount *int `form:"question_count,omitempty" json:"question_count,omitempty" xml:"question_count,omitempty"`
+	// Time limit in minutes, untimed when not set
+	DurationMinutes *int `form:"duration_minutes,omitempty" json:"duration_minutes,omitempty" xml:"duration_minutes,omitempty"`
+	// Attempts allowed per student, 0 for unlimited
+	MaxAttempts int `form:"max_attempts" json:"max_attempts" xml:"max_attempts"`
+	// Minutes a student waits between attempts
+	CooldownMinutes int `form:"cooldown_minutes" json:"cooldown_minutes" xml:"cooldown_minutes"`
+	// Which score counts when the test is taken more than once
+	ScorePolicy string `form:"score_policy" json:"score_policy" xml:"score_policy"`
+	// Whether correct answers are shown after each attempt
+	RevealAnswers bool `form:"reveal_answers" json:"reveal_answers" xml:"reveal_answers"`
+	// Whether each attempt shows the questions in a different order
+	ShuffleQuestions bool `form:"shuffle_questions" json:"shuffle_questions" xml:"shuffle_questions"`
+	// Whether each attempt shows the options in a different order
+	ShuffleOptions bool `form:"shuffle_options" json:"shuffle_options" xml:"shuffle_options"`
 }
 
 // QuestionResponseBody is used to define fields on response body types.
@@ -225,36 +502,134 @@ type QuestionResponseBody struct {
 	TestID int64 `form:"test_id" json:"test_id" xml:"test_id"`
 	// Question text
 	QuestionText string `form:"question_text" json:"question_text" xml:"question_text"`
-	// Option A
-	OptionA string `form:"option_a" json:"option_a" xml:"option_a"`
-	// Option B
-	OptionB string `form:"option_b" json:"option_b" xml:"option_b"`
-	// Option C
-	OptionC string `form:"option_c" json:"option_c" xml:"option_c"`
-	// Option D
-	OptionD string `form:"option_d" json:"option_d" xml:"option_d"`
-	// Correct answer (0=A, 1=B, 2=C, 3=D)
-	CorrectAnswer int `form:"correct_answer" json:"correct_answer" xml:"correct_answer"`
+	// Question type
+	QuestionType string `form:"question_type" json:"question_type" xml:"question_type"`
+	// Options of choice and ordering questions
+	Options []string `form:"options" json:"options" xml:"options"`
+	// Indexes of the correct options, in the correct order for ordering questions
+	CorrectOptions []int `form:"correct_options" json:"correct_options" xml:"correct_options"`
+	// Correct answer of numeric questions
+	NumericAnswer *float64 `form:"numeric_answer,omitempty" json:"numeric_answer,omitempty" xml:"numeric_answer,omitempty"`
+	// Allowed difference from the numeric answer
+	Tolerance float64 `form:"tolerance" json:"tolerance" xml:"tolerance"`
+	// Accepted answers of short text questions, where * matches any text
+	AcceptedAnswers []string `form:"accepted_answers" json:"accepted_answers" xml:"accepted_answers"`
 	// Question order
 	QuestionOrder int `form:"question_order" json:"question_order" xml:"question_order"`
 }
 
-// QuestionFormResponseBody is used to define fields on response body types.
-type QuestionFormResponseBody struct {
+// QuestionBankResponseBody is used to define fields on response body types.
+type QuestionBankResponseBody struct {
+	// Question bank ID
+	ID int64 `form:"id" json:"id" xml:"id"`
+	// Question bank title
+	Title string `form:"title" json:"title" xml:"title"`
+	// Creator user ID
+	CreatedBy int64 `form:"created_by" json:"created_by" xml:"created_by"`
+	// Creation timestamp
+	CreatedAt int64 `form:"created_at" json:"created_at" xml:"created_at"`
+	// Number of questions
+	QuestionCount *int `form:"question_count,omitempty" json:"question_count,omitempty" xml:"question_count,omitempty"`
+}
+
+// BankQuestionResponseBody is used to define fields on response body types.
+type BankQuestionResponseBody struct {
 	// Question ID
 	ID int64 `form:"id" json:"id" xml:"id"`
+	// Question bank ID
+	BankID int64 `form:"bank_id" json:"bank_id" xml:"bank_id"`
 	// Question text
 	QuestionText string `form:"question_text" json:"question_text" xml:"question_text"`
-	// Option A
-	OptionA string `form:"option_a" json:"option_a" xml:"option_a"`
-	// Option B
-	OptionB string `form:"option_b" json:"option_b" xml:"option_b"`
-	// Option C
-	OptionC string `form:"option_c" json:"option_c" xml:"option_c"`
-	// Option D
-	OptionD string `form:"option_d" json:"option_d" xml:"option_d"`
-	// Question order
+	// Question type
+	QuestionType string `form:"question_type" json:"question_type" xml:"question_type"`
+	// Options of choice and ordering questions
+	Options []string `form:"options" json:"options" xml:"options"`
+	// Indexes of the correct options, in the correct order for ordering questions
+	CorrectOptions []int `form:"correct_options" json:"correct_options" xml:"correct_options"`
+	// Correct answer of numeric questions
+	NumericAnswer *float64 `form:"numeric_answer,omitempty" json:"numeric_answer,omitempty" xml:"numeric_answer,omitempty"`
+	// Allowed difference from the numeric answer
+	Tolerance float64 `form:"tolerance" json:"tolerance" xml:"tolerance"`
+	// Accepted answers of short text questions, where * matches any text
+	AcceptedAnswers []string `form:"accepted_answers" json:"accepted_answers" xml:"accepted_answers"`
+	// Question order in the bank
 	QuestionOrder int `form:"question_order" json:"question_order" xml:"question_order"`
+	// Topic of the question, empty when untagged
+	Topic string `form:"topic" json:"topic" xml:"topic"`
+	// Question difficulty
+	Difficulty string `form:"difficulty" json:"difficulty" xml:"difficulty"`
+}
+
+// DrawRuleResponseBody is used to define fields on response body types.
+type DrawRuleResponseBody struct {
+	// Draw rule ID
+	ID int64 `form:"id" json:"id" xml:"id"`
+	// Test ID
+	TestID int64 `form:"test_id" json:"test_id" xml:"test_id"`
+	// Question bank ID
+	BankID int64 `form:"bank_id" json:"bank_id" xml:"bank_id"`
+	// Topic of the questions drawn, any when not set
+	Topic *string `form:"topic,omitempty" json:"topic,omitempty" xml:"topic,omitempty"`
+	// Difficulty of the questions drawn, any when not set
+	Difficulty *string `form:"difficulty,omitempty" json:"difficulty,omitempty" xml:"difficulty,omitempty"`
+	// Number of questions drawn
+	QuestionCount int `form:"question_count" json:"question_count" xml:"question_count"`
+}
+
+// ScoreBinResponseBody is used to define fields on response body types.
+type ScoreBinResponseBody struct {
+	// Lowest score percentage of the range
+	From float64 `form:"from" json:"from" xml:"from"`
+	// Highest score percentage of the range
+	To float64 `form:"to" json:"to" xml:"to"`
+	// Number of attempts with a score in the range
+	Count int `form:"count" json:"count" xml:"count"`
+}
+
+// QuestionAnalyticsResponseBody is used to define fields on response body
+// types.
+type QuestionAnalyticsResponseBody struct {
+	// Question ID
+	QuestionID int64 `form:"question_id" json:"question_id" xml:"question_id"`
+	// Question text
+	QuestionText string `form:"question_text" json:"question_text" xml:"question_text"`
+	// Question type
+	QuestionType string `form:"question_type" json:"question_type" xml:"question_type"`
+	// Number of attempts the question was given in
+	Attempts int `form:"attempts" json:"attempts" xml:"attempts"`
+	// Mean credit those attempts earned on it, which is the share answering it
+	// correctly for questions without partial credit, from 0 to 1
+	DifficultyIndex float64 `form:"difficulty_index" json:"difficulty_index" xml:"difficulty_index"`
+	// Mean credit in the top 27% of attempts by score minus that in the bottom
+	// 27%, from -1 to 1, not set with too few attempts
+	DiscriminationIndex *float64 `form:"discrimination_index,omitempty" json:"discrimination_index,omitempty" xml:"discrimination_index,omitempty"`
+	// How often each option was selected, empty for questions without options
+	OptionSelections []*OptionSelectionResponseBody `form:"option_selections" json:"option_selections" xml:"option_selections"`
+	// Number of attempts leaving the question unanswered
+	Unanswered int `form:"unanswered" json:"unanswered" xml:"unanswered"`
+}
+
+// OptionSelectionResponseBody is used to define fields on response body types.
+type OptionSelectionResponseBody struct {
+	// Option index
+	Index int `form:"index" json:"index" xml:"index"`
+	// Option text
+	Option string `form:"option" json:"option" xml:"option"`
+	// Number of attempts selecting the option
+	Count int `form:"count" json:"count" xml:"count"`
+	// Whether the option is correct
+	IsCorrect bool `form:"is_correct" json:"is_correct" xml:"is_correct"`
+}
+
+// StudentSubmissionResponseBody is used to define fields on response body
+// types.
+type StudentSubmissionResponseBody struct {
+	// Student user ID
+	UserID int64 `form:"user_id" json:"user_id" xml:"user_id"`
+	// Student full name
+	StudentName string `form:"student_name" json:"student_name" xml:"student_name"`
+	// Submission info
+	Submission *SubmissionResponseBody `form:"submission" json:"submission" xml:"submission"`
 }
 
 // SubmissionResponseBody is used to define fields on response body types.
@@ -269,24 +644,75 @@ type SubmissionResponseBody struct {
 	Score float64 `form:"score" json:"score" xml:"score"`
 	// Submission timestamp
 	SubmittedAt int64 `form:"submitted_at" json:"submitted_at" xml:"submitted_at"`
+	// Whether the test was submitted or expired
+	Status string `form:"status" json:"status" xml:"status"`
+	// Start timestamp
+	StartedAt int64 `form:"started_at" json:"started_at" xml:"started_at"`
+	// Number of the attempt at the test, from 1
+	AttemptNumber int `form:"attempt_number" json:"attempt_number" xml:"attempt_number"`
+}
+
+// TestScoreResponseBody is used to define fields on response body types.
+type TestScoreResponseBody struct {
+	// Test ID
+	TestID int64 `form:"test_id" json:"test_id" xml:"test_id"`
+	// Test title
+	TestTitle string `form:"test_title" json:"test_title" xml:"test_title"`
+	// Which score counts
+	ScorePolicy string `form:"score_policy" json:"score_policy" xml:"score_policy"`
+	// Number of attempts
+	Attempts int `form:"attempts" json:"attempts" xml:"attempts"`
+	// Score percentage counted
+	Score float64 `form:"score" json:"score" xml:"score"`
+}
+
+// QuestionFormResponseBody is used to define fields on response body types.
+type QuestionFormResponseBody struct {
+	// Question ID
+	ID int64 `form:"id" json:"id" xml:"id"`
+	// Question text
+	QuestionText string `form:"question_text" json:"question_text" xml:"question_text"`
+	// Question type
+	QuestionType string `form:"question_type" json:"question_type" xml:"question_type"`
+	// Options to choose from or to order, empty for numeric and short text
+	// questions
+	Options []string `form:"options" json:"options" xml:"options"`
+	// Position of the question in the attempt
+	QuestionOrder int `form:"question_order" json:"question_order" xml:"question_order"`
 }
 
 // QuestionResultResponseBody is used to define fields on response body types.
 type QuestionResultResponseBody struct {
 	// Question info
 	Question *QuestionResponseBody `form:"question" json:"question" xml:"question"`
-	// User selected answer
-	SelectedAnswer int `form:"selected_answer" json:"selected_answer" xml:"selected_answer"`
+	// User selected options
+	SelectedOptions []int `form:"selected_options" json:"selected_options" xml:"selected_options"`
+	// User numeric answer
+	NumericAnswer *float64 `form:"numeric_answer,omitempty" json:"numeric_answer,omitempty" xml:"numeric_answer,omitempty"`
+	// User text answer
+	TextAnswer *string `form:"text_answer,omitempty" json:"text_answer,omitempty" xml:"text_answer,omitempty"`
+	// Fraction of the question earned, from 0 to 1
+	Credit float64 `form:"credit" json:"credit" xml:"credit"`
 	// Whether answer was correct
 	IsCorrect bool `form:"is_correct" json:"is_correct" xml:"is_correct"`
+	// Position of the question as shown to the user
+	Position int `form:"position" json:"position" xml:"position"`
+	// Index of each option of the question in the order shown to the user, empty
+	// when shown in order
+	OptionOrder []int `form:"option_order" json:"option_order" xml:"option_order"`
 }
 
 // AnswerRequestBody is used to define fields on request body types.
 type AnswerRequestBody struct {
 	// Question ID
 	QuestionID *int64 `form:"question_id,omitempty" json:"question_id,omitempty" xml:"question_id,omitempty"`
-	// Selected answer (0=A, 1=B, 2=C, 3=D)
-	SelectedAnswer *int `form:"selected_answer,omitempty" json:"selected_answer,omitempty" xml:"selected_answer,omitempty"`
+	// Positions of the selected options as shown in the form, in the chosen order
+	// for ordering questions
+	SelectedOptions []int `form:"selected_options,omitempty" json:"selected_options,omitempty" xml:"selected_options,omitempty"`
+	// Answer to a numeric question
+	NumericAnswer *float64 `form:"numeric_answer,omitempty" json:"numeric_answer,omitempty" xml:"numeric_answer,omitempty"`
+	// Answer to a short text question
+	TextAnswer *string `form:"text_answer,omitempty" json:"text_answer,omitempty" xml:"text_answer,omitempty"`
 }
 
 // NewCreateTestResponseBody builds the HTTP response body from the result of
@@ -399,6 +825,196 @@ func NewDeleteQuestionResponseBody(res *knowledge.SimpleResponse) *DeleteQuestio
 	return body
 }
 
+// NewCreateQuestionBankResponseBody builds the HTTP response body from the
+// result of the "CreateQuestionBank" endpoint of the "knowledge" service.
+func NewCreateQuestionBankResponseBody(res *knowledge.SimpleResponse) *CreateQuestionBankResponseBody {
+	body := &CreateQuestionBankResponseBody{
+		Success: res.Success,
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewGetMyQuestionBanksResponseBody builds the HTTP response body from the
+// result of the "GetMyQuestionBanks" endpoint of the "knowledge" service.
+func NewGetMyQuestionBanksResponseBody(res *knowledge.QuestionBanksResponse) *GetMyQuestionBanksResponseBody {
+	body := &GetMyQuestionBanksResponseBody{}
+	if res.Banks != nil {
+		body.Banks = make([]*QuestionBankResponseBody, len(res.Banks))
+		for i, val := range res.Banks {
+			body.Banks[i] = marshalKnowledgeQuestionBankToQuestionBankResponseBody(val)
+		}
+	} else {
+		body.Banks = []*QuestionBankResponseBody{}
+	}
+	return body
+}
+
+// NewDeleteQuestionBankResponseBody builds the HTTP response body from the
+// result of the "DeleteQuestionBank" endpoint of the "knowledge" service.
+func NewDeleteQuestionBankResponseBody(res *knowledge.SimpleResponse) *DeleteQuestionBankResponseBody {
+	body := &DeleteQuestionBankResponseBody{
+		Success: res.Success,
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewGetBankQuestionsResponseBody builds the HTTP response body from the
+// result of the "GetBankQuestions" endpoint of the "knowledge" service.
+func NewGetBankQuestionsResponseBody(res *knowledge.BankQuestionsResponse) *GetBankQuestionsResponseBody {
+	body := &GetBankQuestionsResponseBody{}
+	if res.Questions != nil {
+		body.Questions = make([]*BankQuestionResponseBody, len(res.Questions))
+		for i, val := range res.Questions {
+			body.Questions[i] = marshalKnowledgeBankQuestionToBankQuestionResponseBody(val)
+		}
+	} else {
+		body.Questions = []*BankQuestionResponseBody{}
+	}
+	return body
+}
+
+// NewAddBankQuestionResponseBody builds the HTTP response body from the result
+// of the "AddBankQuestion" endpoint of the "knowledge" service.
+func NewAddBankQuestionResponseBody(res *knowledge.SimpleResponse) *AddBankQuestionResponseBody {
+	body := &AddBankQuestionResponseBody{
+		Success: res.Success,
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewUpdateBankQuestionResponseBody builds the HTTP response body from the
+// result of the "UpdateBankQuestion" endpoint of the "knowledge" service.
+func NewUpdateBankQuestionResponseBody(res *knowledge.SimpleResponse) *UpdateBankQuestionResponseBody {
+	body := &UpdateBankQuestionResponseBody{
+		Success: res.Success,
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewDeleteBankQuestionResponseBody builds the HTTP response body from the
+// result of the "DeleteBankQuestion" endpoint of the "knowledge" service.
+func NewDeleteBankQuestionResponseBody(res *knowledge.SimpleResponse) *DeleteBankQuestionResponseBody {
+	body := &DeleteBankQuestionResponseBody{
+		Success: res.Success,
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewAddDrawRuleResponseBody builds the HTTP response body from the result of
+// the "AddDrawRule" endpoint of the "knowledge" service.
+func NewAddDrawRuleResponseBody(res *knowledge.SimpleResponse) *AddDrawRuleResponseBody {
+	body := &AddDrawRuleResponseBody{
+		Success: res.Success,
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewGetDrawRulesResponseBody builds the HTTP response body from the result of
+// the "GetDrawRules" endpoint of the "knowledge" service.
+func NewGetDrawRulesResponseBody(res *knowledge.DrawRulesResponse) *GetDrawRulesResponseBody {
+	body := &GetDrawRulesResponseBody{}
+	if res.Rules != nil {
+		body.Rules = make([]*DrawRuleResponseBody, len(res.Rules))
+		for i, val := range res.Rules {
+			body.Rules[i] = marshalKnowledgeDrawRuleToDrawRuleResponseBody(val)
+		}
+	} else {
+		body.Rules = []*DrawRuleResponseBody{}
+	}
+	return body
+}
+
+// NewDeleteDrawRuleResponseBody builds the HTTP response body from the result
+// of the "DeleteDrawRule" endpoint of the "knowledge" service.
+func NewDeleteDrawRuleResponseBody(res *knowledge.SimpleResponse) *DeleteDrawRuleResponseBody {
+	body := &DeleteDrawRuleResponseBody{
+		Success: res.Success,
+		Message: res.Message,
+	}
+	return body
+}
+
+// NewGetTestAnalyticsResponseBody builds the HTTP response body from the
+// result of the "GetTestAnalytics" endpoint of the "knowledge" service.
+func NewGetTestAnalyticsResponseBody(res *knowledge.TestAnalytics) *GetTestAnalyticsResponseBody {
+	body := &GetTestAnalyticsResponseBody{
+		TestID:            res.TestID,
+		Attempts:          res.Attempts,
+		Mean:              res.Mean,
+		Median:            res.Median,
+		StdDev:            res.StdDev,
+		Reliability:       res.Reliability,
+		ReliabilityMethod: res.ReliabilityMethod,
+	}
+	if res.ScoreHistogram != nil {
+		body.ScoreHistogram = make([]*ScoreBinResponseBody, len(res.ScoreHistogram))
+		for i, val := range res.ScoreHistogram {
+			body.ScoreHistogram[i] = marshalKnowledgeScoreBinToScoreBinResponseBody(val)
+		}
+	} else {
+		body.ScoreHistogram = []*ScoreBinResponseBody{}
+	}
+	if res.Questions != nil {
+		body.Questions = make([]*QuestionAnalyticsResponseBody, len(res.Questions))
+		for i, val := range res.Questions {
+			body.Questions[i] = marshalKnowledgeQuestionAnalyticsToQuestionAnalyticsResponseBody(val)
+		}
+	} else {
+		body.Questions = []*QuestionAnalyticsResponseBody{}
+	}
+	return body
+}
+
+// NewGetTestGradebookResponseBody builds the HTTP response body from the
+// result of the "GetTestGradebook" endpoint of the "knowledge" service.
+func NewGetTestGradebookResponseBody(res *knowledge.TestGradebook) *GetTestGradebookResponseBody {
+	body := &GetTestGradebookResponseBody{}
+	if res.Test != nil {
+		body.Test = marshalKnowledgeTestToTestResponseBody(res.Test)
+	}
+	if res.Submissions != nil {
+		body.Submissions = make([]*StudentSubmissionResponseBody, len(res.Submissions))
+		for i, val := range res.Submissions {
+			body.Submissions[i] = marshalKnowledgeStudentSubmissionToStudentSubmissionResponseBody(val)
+		}
+	} else {
+		body.Submissions = []*StudentSubmissionResponseBody{}
+	}
+	return body
+}
+
+// NewGetStudentHistoryResponseBody builds the HTTP response body from the
+// result of the "GetStudentHistory" endpoint of the "knowledge" service.
+func NewGetStudentHistoryResponseBody(res *knowledge.StudentHistory) *GetStudentHistoryResponseBody {
+	body := &GetStudentHistoryResponseBody{
+		UserID:      res.UserID,
+		StudentName: res.StudentName,
+	}
+	if res.Submissions != nil {
+		body.Submissions = make([]*SubmissionResponseBody, len(res.Submissions))
+		for i, val := range res.Submissions {
+			body.Submissions[i] = marshalKnowledgeSubmissionToSubmissionResponseBody(val)
+		}
+	} else {
+		body.Submissions = []*SubmissionResponseBody{}
+	}
+	if res.TestScores != nil {
+		body.TestScores = make([]*TestScoreResponseBody, len(res.TestScores))
+		for i, val := range res.TestScores {
+			body.TestScores[i] = marshalKnowledgeTestScoreToTestScoreResponseBody(val)
+		}
+	} else {
+		body.TestScores = []*TestScoreResponseBody{}
+	}
+	return body
+}
+
 // NewGetAvailableTestsResponseBody builds the HTTP response body from the
 // result of the "GetAvailableTests" endpoint of the "knowledge" service.
 func NewGetAvailableTestsResponseBody(res *knowledge.TestsResponse) *GetAvailableTestsResponseBody {
@@ -414,6 +1030,20 @@ func NewGetAvailableTestsResponseBody(res *knowledge.TestsResponse) *GetAvailabl
 	return body
 }
 
+// NewStartTestResponseBody builds the HTTP response body from the result of
+// the "StartTest" endpoint of the "knowledge" service.
+func NewStartTestResponseBody(res *knowledge.TestAttempt) *StartTestResponseBody {
+	body := &StartTestResponseBody{
+		SubmissionID:    res.SubmissionID,
+		TestID:          res.TestID,
+		Status:          string(res.Status),
+		StartedAt:       res.StartedAt,
+		Deadline:        res.Deadline,
+		DurationMinutes: res.DurationMinutes,
+	}
+	return body
+}
+
 // NewGetTestFormResponseBody builds the HTTP response body from the result of
 // the "GetTestForm" endpoint of the "knowledge" service.
 func NewGetTestFormResponseBody(res *knowledge.FormResponse) *GetTestFormResponseBody {
@@ -436,10 +1066,11 @@ func NewGetTestFormResponseBody(res *knowledge.FormResponse) *GetTestFormRespons
 // the "SubmitTest" endpoint of the "knowledge" service.
 func NewSubmitTestResponseBody(res *knowledge.SubmitResponse) *SubmitTestResponseBody {
 	body := &SubmitTestResponseBody{
-		Success:      res.Success,
-		Message:      res.Message,
-		SubmissionID: res.SubmissionID,
-		Score:        res.Score,
+		Success:       res.Success,
+		Message:       res.Message,
+		SubmissionID:  res.SubmissionID,
+		Score:         res.Score,
+		AttemptNumber: res.AttemptNumber,
 	}
 	return body
 }
@@ -456,6 +1087,14 @@ func NewGetMySubmissionsResponseBody(res *knowledge.SubmissionsResponse) *GetMyS
 	} else {
 		body.Submissions = []*SubmissionResponseBody{}
 	}
+	if res.TestScores != nil {
+		body.TestScores = make([]*TestScoreResponseBody, len(res.TestScores))
+		for i, val := range res.TestScores {
+			body.TestScores[i] = marshalKnowledgeTestScoreToTestScoreResponseBody(val)
+		}
+	} else {
+		body.TestScores = []*TestScoreResponseBody{}
+	}
 	return body
 }
 
@@ -472,7 +1111,10 @@ func NewGetSubmissionByIDResponseBody(res *knowledge.SubmissionResponse) *GetSub
 // NewGetSubmissionResultResponseBody builds the HTTP response body from the
 // result of the "GetSubmissionResult" endpoint of the "knowledge" service.
 func NewGetSubmissionResultResponseBody(res *knowledge.SubmissionResult) *GetSubmissionResultResponseBody {
-	body := &GetSubmissionResultResponseBody{}
+	body := &GetSubmissionResultResponseBody{
+		CountedScore:    res.CountedScore,
+		AnswersRevealed: res.AnswersRevealed,
+	}
 	if res.Submission != nil {
 		body.Submission = marshalKnowledgeSubmissionToSubmissionResponseBody(res.Submission)
 	}
@@ -484,13 +1126,58 @@ func NewGetSubmissionResultResponseBody(res *knowledge.SubmissionResult) *GetSub
 	} else {
 		body.Questions = []*QuestionResultResponseBody{}
 	}
+	if res.Attempts != nil {
+		body.Attempts = make([]*SubmissionResponseBody, len(res.Attempts))
+		for i, val := range res.Attempts {
+			body.Attempts[i] = marshalKnowledgeSubmissionToSubmissionResponseBody(val)
+		}
+	} else {
+		body.Attempts = []*SubmissionResponseBody{}
+	}
 	return body
 }
 
 // NewCreateTestPayload builds a knowledge service CreateTest endpoint payload.
 func NewCreateTestPayload(body *CreateTestRequestBody, sessionToken string) *knowledge.CreateTestPayload {
 	v := &knowledge.CreateTestPayload{
-		Title: *body.Title,
+		Title:           *body.Title,
+		DurationMinutes: body.DurationMinutes,
+	}
+	if body.MaxAttempts != nil {
+		v.MaxAttempts = *body.MaxAttempts
+	}
+	if body.CooldownMinutes != nil {
+		v.CooldownMinutes = *body.CooldownMinutes
+	}
+	if body.ScorePolicy != nil {
+		v.ScorePolicy = knowledge.ScorePolicy(*body.ScorePolicy)
+	}
+	if body.RevealAnswers != nil {
+		v.RevealAnswers = *body.RevealAnswers
+	}
+	if body.ShuffleQuestions != nil {
+		v.ShuffleQuestions = *body.ShuffleQuestions
+	}
+	if body.ShuffleOptions != nil {
+		v.ShuffleOptions = *body.ShuffleOptions
+	}
+	if body.MaxAttempts == nil {
+		v.MaxAttempts = 1
+	}
+	if body.CooldownMinutes == nil {
+		v.CooldownMinutes = 0
+	}
+	if body.ScorePolicy == nil {
+		v.ScorePolicy = "best"
+	}
+	if body.RevealAnswers == nil {
+		v.RevealAnswers = true
+	}
+	if body.ShuffleQuestions == nil {
+		v.ShuffleQuestions = false
+	}
+	if body.ShuffleOptions == nil {
+		v.ShuffleOptions = false
 	}
 	v.SessionToken = sessionToken
 
@@ -518,7 +1205,44 @@ func NewGetTestByIDPayload(testID int64, sessionToken string) *knowledge.GetTest
 // NewUpdateTestPayload builds a knowledge service UpdateTest endpoint payload.
 func NewUpdateTestPayload(body *UpdateTestRequestBody, testID int64, sessionToken string) *knowledge.UpdateTestPayload {
 	v := &knowledge.UpdateTestPayload{
-		Title: *body.Title,
+		Title:           *body.Title,
+		DurationMinutes: body.DurationMinutes,
+	}
+	if body.MaxAttempts != nil {
+		v.MaxAttempts = *body.MaxAttempts
+	}
+	if body.CooldownMinutes != nil {
+		v.CooldownMinutes = *body.CooldownMinutes
+	}
+	if body.ScorePolicy != nil {
+		v.ScorePolicy = knowledge.ScorePolicy(*body.ScorePolicy)
+	}
+	if body.RevealAnswers != nil {
+		v.RevealAnswers = *body.RevealAnswers
+	}
+	if body.ShuffleQuestions != nil {
+		v.ShuffleQuestions = *body.ShuffleQuestions
+	}
+	if body.ShuffleOptions != nil {
+		v.ShuffleOptions = *body.ShuffleOptions
+	}
+	if body.MaxAttempts == nil {
+		v.MaxAttempts = 1
+	}
+	if body.CooldownMinutes == nil {
+		v.CooldownMinutes = 0
+	}
+	if body.ScorePolicy == nil {
+		v.ScorePolicy = "best"
+	}
+	if body.RevealAnswers == nil {
+		v.RevealAnswers = true
+	}
+	if body.ShuffleQuestions == nil {
+		v.ShuffleQuestions = false
+	}
+	if body.ShuffleOptions == nil {
+		v.ShuffleOptions = false
 	}
 	v.TestID = testID
 	v.SessionToken = sessionToken
@@ -550,11 +1274,37 @@ func NewGetTestQuestionsPayload(testID int64, sessionToken string) *knowledge.Ge
 func NewAddQuestionPayload(body *AddQuestionRequestBody, testID int64, sessionToken string) *knowledge.AddQuestionPayload {
 	v := &knowledge.AddQuestionPayload{
 		QuestionText:  *body.QuestionText,
-		OptionA:       *body.OptionA,
-		OptionB:       *body.OptionB,
-		OptionC:       *body.OptionC,
-		OptionD:       *body.OptionD,
-		CorrectAnswer: *body.CorrectAnswer,
+		NumericAnswer: body.NumericAnswer,
+	}
+	if body.QuestionType != nil {
+		v.QuestionType = knowledge.QuestionType(*body.QuestionType)
+	}
+	if body.Tolerance != nil {
+		v.Tolerance = *body.Tolerance
+	}
+	if body.QuestionType == nil {
+		v.QuestionType = "multiple_choice"
+	}
+	if body.Options != nil {
+		v.Options = make([]string, len(body.Options))
+		for i, val := range body.Options {
+			v.Options[i] = val
+		}
+	}
+	if body.CorrectOptions != nil {
+		v.CorrectOptions = make([]int, len(body.CorrectOptions))
+		for i, val := range body.CorrectOptions {
+			v.CorrectOptions[i] = val
+		}
+	}
+	if body.Tolerance == nil {
+		v.Tolerance = 0
+	}
+	if body.AcceptedAnswers != nil {
+		v.AcceptedAnswers = make([]string, len(body.AcceptedAnswers))
+		for i, val := range body.AcceptedAnswers {
+			v.AcceptedAnswers[i] = val
+		}
 	}
 	v.TestID = testID
 	v.SessionToken = sessionToken
@@ -578,11 +1328,37 @@ func NewGetQuestionByIDPayload(testID int64, questionID int64, sessionToken stri
 func NewUpdateQuestionPayload(body *UpdateQuestionRequestBody, testID int64, questionID int64, sessionToken string) *knowledge.UpdateQuestionPayload {
 	v := &knowledge.UpdateQuestionPayload{
 		QuestionText:  *body.QuestionText,
-		OptionA:       *body.OptionA,
-		OptionB:       *body.OptionB,
-		OptionC:       *body.OptionC,
-		OptionD:       *body.OptionD,
-		CorrectAnswer: *body.CorrectAnswer,
+		NumericAnswer: body.NumericAnswer,
+	}
+	if body.QuestionType != nil {
+		v.QuestionType = knowledge.QuestionType(*body.QuestionType)
+	}
+	if body.Tolerance != nil {
+		v.Tolerance = *body.Tolerance
+	}
+	if body.QuestionType == nil {
+		v.QuestionType = "multiple_choice"
+	}
+	if body.Options != nil {
+		v.Options = make([]string, len(body.Options))
+		for i, val := range body.Options {
+			v.Options[i] = val
+		}
+	}
+	if body.CorrectOptions != nil {
+		v.CorrectOptions = make([]int, len(body.CorrectOptions))
+		for i, val := range body.CorrectOptions {
+			v.CorrectOptions[i] = val
+		}
+	}
+	if body.Tolerance == nil {
+		v.Tolerance = 0
+	}
+	if body.AcceptedAnswers != nil {
+		v.AcceptedAnswers = make([]string, len(body.AcceptedAnswers))
+		for i, val := range body.AcceptedAnswers {
+			v.AcceptedAnswers[i] = val
+		}
 	}
 	v.TestID = testID
 	v.QuestionID = questionID
@@ -602,6 +1378,234 @@ func NewDeleteQuestionPayload(testID int64, questionID int64, sessionToken strin
 	return v
 }
 
+// NewCreateQuestionBankPayload builds a knowledge service CreateQuestionBank
+// endpoint payload.
+func NewCreateQuestionBankPayload(body *CreateQuestionBankRequestBody, sessionToken string) *knowledge.CreateQuestionBankPayload {
+	v := &knowledge.CreateQuestionBankPayload{
+		Title: *body.Title,
+	}
+	v.SessionToken = sessionToken
+
+	return v
+}
+
+// NewGetMyQuestionBanksPayload builds a knowledge service GetMyQuestionBanks
+// endpoint payload.
+func NewGetMyQuestionBanksPayload(sessionToken string) *knowledge.GetMyQuestionBanksPayload {
+	v := &knowledge.GetMyQuestionBanksPayload{}
+	v.SessionToken = sessionToken
+
+	return v
+}
+
+// NewDeleteQuestionBankPayload builds a knowledge service DeleteQuestionBank
+// endpoint payload.
+func NewDeleteQuestionBankPayload(bankID int64, sessionToken string) *knowledge.DeleteQuestionBankPayload {
+	v := &knowledge.DeleteQuestionBankPayload{}
+	v.BankID = bankID
+	v.SessionToken = sessionToken
+
+	return v
+}
+
+// NewGetBankQuestionsPayload builds a knowledge service GetBankQuestions
+// endpoint payload.
+func NewGetBankQuestionsPayload(bankID int64, sessionToken string) *knowledge.GetBankQuestionsPayload {
+	v := &knowledge.GetBankQuestionsPayload{}
+	v.BankID = bankID
+	v.SessionToken = sessionToken
+
+	return v
+}
+
+// NewAddBankQuestionPayload builds a knowledge service AddBankQuestion
+// endpoint payload.
+func NewAddBankQuestionPayload(body *AddBankQuestionRequestBody, bankID int64, sessionToken string) *knowledge.AddBankQuestionPayload {
+	v := &knowledge.AddBankQuestionPayload{
+		QuestionText:  *body.QuestionText,
+		NumericAnswer: body.NumericAnswer,
+		Topic:         body.Topic,
+	}
+	if body.QuestionType != nil {
+		v.QuestionType = knowledge.QuestionType(*body.QuestionType)
+	}
+	if body.Tolerance != nil {
+		v.Tolerance = *body.Tolerance
+	}
+	if body.Difficulty != nil {
+		v.Difficulty = knowledge.Difficulty(*body.Difficulty)
+	}
+	if body.QuestionType == nil {
+		v.QuestionType = "multiple_choice"
+	}
+	if body.Options != nil {
+		v.Options = make([]string, len(body.Options))
+		for i, val := range body.Options {
+			v.Options[i] = val
+		}
+	}
+	if body.CorrectOptions != nil {
+		v.CorrectOptions = make([]int, len(body.CorrectOptions))
+		for i, val := range body.CorrectOptions {
+			v.CorrectOptions[i] = val
+		}
+	}
+	if body.Tolerance == nil {
+		v.Tolerance = 0
+	}
+	if body.AcceptedAnswers != nil {
+		v.AcceptedAnswers = make([]string, len(body.AcceptedAnswers))
+		for i, val := range body.AcceptedAnswers {
+			v.AcceptedAnswers[i] = val
+		}
+	}
+	if body.Difficulty == nil {
+		v.Difficulty = "medium"
+	}
+	v.BankID = bankID
+	v.SessionToken = sessionToken
+
+	return v
+}
+
+// NewUpdateBankQuestionPayload builds a knowledge service UpdateBankQuestion
+// endpoint payload.
+func NewUpdateBankQuestionPayload(body *UpdateBankQuestionRequestBody, bankID int64, questionID int64, sessionToken string) *knowledge.UpdateBankQuestionPayload {
+	v := &knowledge.UpdateBankQuestionPayload{
+		QuestionText:  *body.QuestionText,
+		NumericAnswer: body.NumericAnswer,
+		Topic:         body.Topic,
+	}
+	if body.QuestionType != nil {
+		v.QuestionType = knowledge.QuestionType(*body.QuestionType)
+	}
+	if body.Tolerance != nil {
+		v.Tolerance = *body.Tolerance
+	}
+	if body.Difficulty != nil {
+		v.Difficulty = knowledge.Difficulty(*body.Difficulty)
+	}
+	if body.QuestionType == nil {
+		v.QuestionType = "multiple_choice"
+	}
+	if body.Options != nil {
+		v.Options = make([]string, len(body.Options))
+		for i, val := range body.Options {
+			v.Options[i] = val
+		}
+	}
+	if body.CorrectOptions != nil {
+		v.CorrectOptions = make([]int, len(body.CorrectOptions))
+		for i, val := range body.CorrectOptions {
+			v.CorrectOptions[i] = val
+		}
+	}
+	if body.Tolerance == nil {
+		v.Tolerance = 0
+	}
+	if body.AcceptedAnswers != nil {
+		v.AcceptedAnswers = make([]string, len(body.AcceptedAnswers))
+		for i, val := range body.AcceptedAnswers {
+			v.AcceptedAnswers[i] = val
+		}
+	}
+	if body.Difficulty == nil {
+		v.Difficulty = "medium"
+	}
+	v.BankID = bankID
+	v.QuestionID = questionID
+	v.SessionToken = sessionToken
+
+	return v
+}
+
+// NewDeleteBankQuestionPayload builds a knowledge service DeleteBankQuestion
+// endpoint payload.
+func NewDeleteBankQuestionPayload(bankID int64, questionID int64, sessionToken string) *knowledge.DeleteBankQuestionPayload {
+	v := &knowledge.DeleteBankQuestionPayload{}
+	v.BankID = bankID
+	v.QuestionID = questionID
+	v.SessionToken = sessionToken
+
+	return v
+}
+
+// NewAddDrawRulePayload builds a knowledge service AddDrawRule endpoint
+// payload.
+func NewAddDrawRulePayload(body *AddDrawRuleRequestBody, testID int64, sessionToken string) *knowledge.AddDrawRulePayload {
+	v := &knowledge.AddDrawRulePayload{
+		BankID:        *body.BankID,
+		Topic:         body.Topic,
+		QuestionCount: *body.QuestionCount,
+	}
+	if body.Difficulty != nil {
+		difficulty := knowledge.Difficulty(*body.Difficulty)
+		v.Difficulty = &difficulty
+	}
+	v.TestID = testID
+	v.SessionToken = sessionToken
+
+	return v
+}
+
+// NewGetDrawRulesPayload builds a knowledge service GetDrawRules endpoint
+// payload.
+func NewGetDrawRulesPayload(testID int64, sessionToken string) *knowledge.GetDrawRulesPayload {
+	v := &knowledge.GetDrawRulesPayload{}
+	v.TestID = testID
+	v.SessionToken = sessionToken
+
+	return v
+}
+
+// NewDeleteDrawRulePayload builds a knowledge service DeleteDrawRule endpoint
+// payload.
+func NewDeleteDrawRulePayload(testID int64, ruleID int64, sessionToken string) *knowledge.DeleteDrawRulePayload {
+	v := &knowledge.DeleteDrawRulePayload{}
+	v.TestID = testID
+	v.RuleID = ruleID
+	v.SessionToken = sessionToken
+
+	return v
+}
+
+// NewGetTestAnalyticsPayload builds a knowledge service GetTestAnalytics
+// endpoint payload.
+func NewGetTestAnalyticsPayload(testID int64, sessionToken string) *knowledge.GetTestAnalyticsPayload {
+	v := &knowledge.GetTestAnalyticsPayload{}
+	v.TestID = testID
+	v.SessionToken = sessionToken
+
+	return v
+}
+
+// NewGetTestGradebookPayload builds a knowledge service GetTestGradebook
+// endpoint payload.
+func NewGetTestGradebookPayload(testID int64, sortBy string, sortOrder string, status *string, student *string, sessionToken string) *knowledge.GetTestGradebookPayload {
+	v := &knowledge.GetTestGradebookPayload{}
+	v.TestID = testID
+	v.SortBy = sortBy
+	v.SortOrder = sortOrder
+	if status != nil {
+		tmpstatus := knowledge.AttemptStatus(*status)
+		v.Status = &tmpstatus
+	}
+	v.Student = student
+	v.SessionToken = sessionToken
+
+	return v
+}
+
+// NewGetStudentHistoryPayload builds a knowledge service GetStudentHistory
+// endpoint payload.
+func NewGetStudentHistoryPayload(userID int64, sessionToken string) *knowledge.GetStudentHistoryPayload {
+	v := &knowledge.GetStudentHistoryPayload{}
+	v.UserID = userID
+	v.SessionToken = sessionToken
+
+	return v
+}
+
 // NewGetAvailableTestsPayload builds a knowledge service GetAvailableTests
 // endpoint payload.
 func NewGetAvailableTestsPayload(sessionToken string) *knowledge.GetAvailableTestsPayload {
@@ -611,6 +1615,15 @@ func NewGetAvailableTestsPayload(sessionToken string) *knowledge.GetAvailableTes
 	return v
 }
 
+// NewStartTestPayload builds a knowledge service StartTest endpoint payload.
+func NewStartTestPayload(testID int64, sessionToken string) *knowledge.StartTestPayload {
+	v := &knowledge.StartTestPayload{}
+	v.TestID = testID
+	v.SessionToken = sessionToken
+
+	return v
+}
+
 // NewGetTestFormPayload builds a knowledge service GetTestForm endpoint
 // payload.
 func NewGetTestFormPayload(testID int64, sessionToken string) *knowledge.GetTestFormPayload {
@@ -669,6 +1682,26 @@ func ValidateCreateTestRequestBody(body *CreateTestRequestBody) (err error) {
 	if body.Title == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("title", "body"))
 	}
+	if body.DurationMinutes != nil {
+		if *body.DurationMinutes < 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.duration_minutes", *body.DurationMinutes, 1, true))
+		}
+	}
+	if body.MaxAttempts != nil {
+		if *body.MaxAttempts < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.max_attempts", *body.MaxAttempts, 0, true))
+		}
+	}
+	if body.CooldownMinutes != nil {
+		if *body.CooldownMinutes < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.cooldown_minutes", *body.CooldownMinutes, 0, true))
+		}
+	}
+	if body.ScorePolicy != nil {
+		if !(*body.ScorePolicy == "best" || *body.ScorePolicy == "latest" || *body.ScorePolicy == "average") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.score_policy", *body.ScorePolicy, []any{"best", "latest", "average"}))
+		}
+	}
 	return
 }
 
@@ -678,6 +1711,26 @@ func ValidateUpdateTestRequestBody(body *UpdateTestRequestBody) (err error) {
 	if body.Title == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("title", "body"))
 	}
+	if body.DurationMinutes != nil {
+		if *body.DurationMinutes < 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.duration_minutes", *body.DurationMinutes, 1, true))
+		}
+	}
+	if body.MaxAttempts != nil {
+		if *body.MaxAttempts < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.max_attempts", *body.MaxAttempts, 0, true))
+		}
+	}
+	if body.CooldownMinutes != nil {
+		if *body.CooldownMinutes < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.cooldown_minutes", *body.CooldownMinutes, 0, true))
+		}
+	}
+	if body.ScorePolicy != nil {
+		if !(*body.ScorePolicy == "best" || *body.ScorePolicy == "latest" || *body.ScorePolicy == "average") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.score_policy", *body.ScorePolicy, []any{"best", "latest", "average"}))
+		}
+	}
 	return
 }
 
@@ -687,20 +1740,15 @@ func ValidateAddQuestionRequestBody(body *AddQuestionRequestBody) (err error) {
 	if body.QuestionText == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("question_text", "body"))
 	}
-	if body.OptionA == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("option_a", "body"))
-	}
-	if body.OptionB == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("option_b", "body"))
-	}
-	if body.OptionC == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("option_c", "body"))
-	}
-	if body.OptionD == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("option_d", "body"))
+	if body.QuestionType != nil {
+		if !(*body.QuestionType == "multiple_choice" || *body.QuestionType == "multiple_select" || *body.QuestionType == "true_false" || *body.QuestionType == "numeric" || *body.QuestionType == "short_text" || *body.QuestionType == "ordering") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.question_type", *body.QuestionType, []any{"multiple_choice", "multiple_select", "true_false", "numeric", "short_text", "ordering"}))
+		}
 	}
-	if body.CorrectAnswer == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("correct_answer", "body"))
+	if body.Tolerance != nil {
+		if *body.Tolerance < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.tolerance", *body.Tolerance, 0, true))
+		}
 	}
 	return
 }
@@ -711,20 +1759,109 @@ func ValidateUpdateQuestionRequestBody(body *UpdateQuestionRequestBody) (err err
 	if body.QuestionText == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("question_text", "body"))
 	}
-	if body.OptionA == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("option_a", "body"))
+	if body.QuestionType != nil {
+		if !(*body.QuestionType == "multiple_choice" || *body.QuestionType == "multiple_select" || *body.QuestionType == "true_false" || *body.QuestionType == "numeric" || *body.QuestionType == "short_text" || *body.QuestionType == "ordering") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.question_type", *body.QuestionType, []any{"multiple_choice", "multiple_select", "true_false", "numeric", "short_text", "ordering"}))
+		}
 	}
-	if body.OptionB == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("option_b", "body"))
+	if body.Tolerance != nil {
+		if *body.Tolerance < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.tolerance", *body.Tolerance, 0, true))
+		}
 	}
-	if body.OptionC == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("option_c", "body"))
+	return
+}
+
+// ValidateCreateQuestionBankRequestBody runs the validations defined on
+// CreateQuestionBankRequestBody
+func ValidateCreateQuestionBankRequestBody(body *CreateQuestionBankRequestBody) (err error) {
+	if body.Title == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("title", "body"))
+	}
+	return
+}
+
+// ValidateAddBankQuestionRequestBody runs the validations defined on
+// AddBankQuestionRequestBody
+func ValidateAddBankQuestionRequestBody(body *AddBankQuestionRequestBody) (err error) {
+	if body.QuestionText == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("question_text", "body"))
 	}
-	if body.OptionD == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("option_d", "body"))
+	if body.QuestionType != nil {
+		if !(*body.QuestionType == "multiple_choice" || *body.QuestionType == "multiple_select" || *body.QuestionType == "true_false" || *body.QuestionType == "numeric" || *body.QuestionType == "short_text" || *body.QuestionType == "ordering") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.question_type", *body.QuestionType, []any{"multiple_choice", "multiple_select", "true_false", "numeric", "short_text", "ordering"}))
+		}
 	}
-	if body.CorrectAnswer == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("correct_answer", "body"))
+	if body.Tolerance != nil {
+		if *body.Tolerance < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.tolerance", *body.Tolerance, 0, true))
+		}
+	}
+	if body.Topic != nil {
+		if utf8.RuneCountInString(*body.Topic) > 100 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.topic", *body.Topic, utf8.RuneCountInString(*body.Topic), 100, false))
+		}
+	}
+	if body.Difficulty != nil {
+		if !(*body.Difficulty == "easy" || *body.Difficulty == "medium" || *body.Difficulty == "hard") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.difficulty", *body.Difficulty, []any{"easy", "medium", "hard"}))
+		}
+	}
+	return
+}
+
+// ValidateUpdateBankQuestionRequestBody runs the validations defined on
+// UpdateBankQuestionRequestBody
+func ValidateUpdateBankQuestionRequestBody(body *UpdateBankQuestionRequestBody) (err error) {
+	if body.QuestionText == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("question_text", "body"))
+	}
+	if body.QuestionType != nil {
+		if !(*body.QuestionType == "multiple_choice" || *body.QuestionType == "multiple_select" || *body.QuestionType == "true_false" || *body.QuestionType == "numeric" || *body.QuestionType == "short_text" || *body.QuestionType == "ordering") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.question_type", *body.QuestionType, []any{"multiple_choice", "multiple_select", "true_false", "numeric", "short_text", "ordering"}))
+		}
+	}
+	if body.Tolerance != nil {
+		if *body.Tolerance < 0 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.tolerance", *body.Tolerance, 0, true))
+		}
+	}
+	if body.Topic != nil {
+		if utf8.RuneCountInString(*body.Topic) > 100 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.topic", *body.Topic, utf8.RuneCountInString(*body.Topic), 100, false))
+		}
+	}
+	if body.Difficulty != nil {
+		if !(*body.Difficulty == "easy" || *body.Difficulty == "medium" || *body.Difficulty == "hard") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.difficulty", *body.Difficulty, []any{"easy", "medium", "hard"}))
+		}
+	}
+	return
+}
+
+// ValidateAddDrawRuleRequestBody runs the validations defined on
+// AddDrawRuleRequestBody
+func ValidateAddDrawRuleRequestBody(body *AddDrawRuleRequestBody) (err error) {
+	if body.BankID == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("bank_id", "body"))
+	}
+	if body.QuestionCount == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("question_count", "body"))
+	}
+	if body.Topic != nil {
+		if utf8.RuneCountInString(*body.Topic) > 100 {
+			err = goa.MergeErrors(err, goa.InvalidLengthError("body.topic", *body.Topic, utf8.RuneCountInString(*body.Topic), 100, false))
+		}
+	}
+	if body.Difficulty != nil {
+		if !(*body.Difficulty == "easy" || *body.Difficulty == "medium" || *body.Difficulty == "hard") {
+			err = goa.MergeErrors(err, goa.InvalidEnumValueError("body.difficulty", *body.Difficulty, []any{"easy", "medium", "hard"}))
+		}
+	}
+	if body.QuestionCount != nil {
+		if *body.QuestionCount < 1 {
+			err = goa.MergeErrors(err, goa.InvalidRangeError("body.question_count", *body.QuestionCount, 1, true))
+		}
 	}
 	return
 }
@@ -750,8 +1887,5 @@ func ValidateAnswerRequestBody(body *AnswerRequestBody) (err error) {
 	if body.QuestionID == nil {
 		err = goa.MergeErrors(err, goa.MissingFieldError("question_id", "body"))
 	}
-	if body.SelectedAnswer == nil {
-		err = goa.MergeErrors(err, goa.MissingFieldError("selected_answer", "body"))
-	}
 	return
 }
@@ -27,7 +27,21 @@ type Client struct {
 	GetQuestionByIDEndpoint     goa.Endpoint
 	UpdateQuestionEndpoint      goa.Endpoint
 	DeleteQuestionEndpoint      goa.Endpoint
+	CreateQuestionBankEndpoint  goa.Endpoint
+	GetMyQuestionBanksEndpoint  goa.Endpoint
+	DeleteQuestionBankEndpoint  goa.Endpoint
+	GetBankQuestionsEndpoint    goa.Endpoint
+	AddBankQuestionEndpoint     goa.Endpoint
+	UpdateBankQuestionEndpoint  goa.Endpoint
+	DeleteBankQuestionEndpoint  goa.Endpoint
+	AddDrawRuleEndpoint         goa.Endpoint
+	GetDrawRulesEndpoint        goa.Endpoint
+	DeleteDrawRuleEndpoint      goa.Endpoint
+	GetTestAnalyticsEndpoint    goa.Endpoint
+	GetTestGradebookEndpoint    goa.Endpoint
+	GetStudentHistoryEndpoint   goa.Endpoint
 	GetAvailableTestsEndpoint   goa.Endpoint
+	StartTestEndpoint           goa.Endpoint
 	GetTestFormEndpoint         goa.Endpoint
 	SubmitTestEndpoint          goa.Endpoint
 	GetMySubmissionsEndpoint    goa.Endpoint
@@ -36,7 +50,7 @@ type Client struct {
 }
 
 // NewClient initializes a "knowledge" service client given the endpoints.
-func NewClient(createTest, getMyTests, getTestByID, updateTest, deleteTest, getTestQuestions, addQuestion, getQuestionByID, updateQuestion, deleteQuestion, getAvailableTests, getTestForm, submitTest, getMySubmissions, getSubmissionByID, getSubmissionResult goa.Endpoint) *Client {
+func NewClient(createTest, getMyTests, getTestByID, updateTest, deleteTest, getTestQuestions, addQuestion, getQuestionByID, updateQuestion, deleteQuestion, createQuestionBank, getMyQuestionBanks, deleteQuestionBank, getBankQuestions, addBankQuestion, updateBankQuestion, deleteBankQuestion, addDrawRule, getDrawRules, deleteDrawRule, getTestAnalytics, getTestGradebook, getStudentHistory, getAvailableTests, startTest, getTestForm, submitTest, getMySubmissions, getSubmissionByID, getSubmissionResult goa.Endpoint) *Client {
 	return &Client{
 		CreateTestEndpoint:          createTest,
 		GetMyTestsEndpoint:          getMyTests,
@@ -48,7 +62,21 @@ func NewClient(createTest, getMyTests, getTestByID, updateTest, deleteTest, getT
 		GetQuestionByIDEndpoint:     getQuestionByID,
 		UpdateQuestionEndpoint:      updateQuestion,
 		DeleteQuestionEndpoint:      deleteQuestion,
+		CreateQuestionBankEndpoint:  createQuestionBank,
+		GetMyQuestionBanksEndpoint:  getMyQuestionBanks,
+		DeleteQuestionBankEndpoint:  deleteQuestionBank,
+		GetBankQuestionsEndpoint:    getBankQuestions,
+		AddBankQuestionEndpoint:     addBankQuestion,
+		UpdateBankQuestionEndpoint:  updateBankQuestion,
+		DeleteBankQuestionEndpoint:  deleteBankQuestion,
+		AddDrawRuleEndpoint:         addDrawRule,
+		GetDrawRulesEndpoint:        getDrawRules,
+		DeleteDrawRuleEndpoint:      deleteDrawRule,
+		GetTestAnalyticsEndpoint:    getTestAnalytics,
+		GetTestGradebookEndpoint:    getTestGradebook,
+		GetStudentHistoryEndpoint:   getStudentHistory,
 		GetAvailableTestsEndpoint:   getAvailableTests,
+		StartTestEndpoint:           startTest,
 		GetTestFormEndpoint:         getTestForm,
 		SubmitTestEndpoint:          submitTest,
 		GetMySubmissionsEndpoint:    getMySubmissions,
@@ -65,6 +93,8 @@ func NewClient(createTest, getMyTests, getTestByID, updateTest, deleteTest, getT
 //   - "submission_not_found" (type SubmissionNotFound)
 //   - "test_already_submitted" (type TestAlreadySubmitted)
 //   - "invalid_input" (type InvalidInput)
+//   - "time_expired" (type TimeExpired)
+//   - "internal_error" (type InternalError)
 //   - error: internal error
 func (c *Client) CreateTest(ctx context.Context, p *CreateTestPayload) (res *SimpleResponse, err error) {
 	var ires any
@@ -83,6 +113,8 @@ func (c *Client) CreateTest(ctx context.Context, p *CreateTestPayload) (res *Sim
 //   - "submission_not_found" (type SubmissionNotFound)
 //   - "test_already_submitted" (type TestAlreadySubmitted)
 //   - "invalid_input" (type InvalidInput)
+//   - "time_expired" (type TimeExpired)
+//   - "internal_error" (type InternalError)
 //   - error: internal error
 func (c *Client) GetMyTests(ctx context.Context, p *GetMyTestsPayload) (res *TestsResponse, err error) {
 	var ires any
@@ -101,6 +133,8 @@ func (c *Client) GetMyTests(ctx context.Context, p *GetMyTestsPayload) (res *Tes
 //   - "submission_not_found" (type SubmissionNotFound)
 //   - "test_already_submitted" (type TestAlreadySubmitted)
 //   - "invalid_input" (type InvalidInput)
+//   - "time_expired" (type TimeExpired)
+//   - "internal_error" (type InternalError)
 //   - error: internal error
 func (c *Client) GetTestByID(ctx context.Context, p *GetTestByIDPayload) (res *TestResponse, err error) {
 	var ires any
@@ -119,6 +153,8 @@ func (c *Client) GetTestByID(ctx context.Context, p *GetTestByIDPayload) (res *T
 //   - "submission_not_found" (type SubmissionNotFound)
 //   - "test_already_submitted" (type TestAlreadySubmitted)
 //   - "invalid_input" (type InvalidInput)
+//   - "time_expired" (type TimeExpired)
+//   - "internal_error" (type InternalError)
 //   - error: internal error
 func (c *Client) UpdateTest(ctx context.Context, p *UpdateTestPayload) (res *SimpleResponse, err error) {
 	var ires any
@@ -137,6 +173,8 @@ func (c *Client) UpdateTest(ctx context.Context, p *UpdateTestPayload) (res *Sim
 //   - "submission_not_found" (type SubmissionNotFound)
 //   - "test_already_submitted" (type TestAlreadySubmitted)
 //   - "invalid_input" (type InvalidInput)
+//   - "time_expired" (type TimeExpired)
+//   - "internal_error" (type InternalError)
 //   - error: internal error
 func (c *Client) DeleteTest(ctx context.Context, p *DeleteTestPayload) (res *SimpleResponse, err error) {
 	var ires any
@@ -156,6 +194,8 @@ func (c *Client) DeleteTest(ctx context.Context, p *DeleteTestPayload) (res *Sim
 //   - "submission_not_found" (type SubmissionNotFound)
 //   - "test_already_submitted" (type TestAlreadySubmitted)
 //   - "invalid_input" (type InvalidInput)
+//   - "time_expired" (type TimeExpired)
+//   - "internal_error" (type InternalError)
 //   - error: internal error
 func (c *Client) GetTestQuestions(ctx context.Context, p *GetTestQuestionsPayload) (res *QuestionsResponse, err error) {
 	var ires any
@@ -174,6 +214,8 @@ func (c *Client) GetTestQuestions(ctx context.Context, p *GetTestQuestionsPayloa
 //   - "submission_not_found" (type SubmissionNotFound)
 //   - "test_already_submitted" (type TestAlreadySubmitted)
 //   - "invalid_input" (type InvalidInput)
+//   - "time_expired" (type TimeExpired)
+//   - "internal_error" (type InternalError)
 //   - error: internal error
 func (c *Client) AddQuestion(ctx context.Context, p *AddQuestionPayload) (res *SimpleResponse, err error) {
 	var ires any
@@ -193,6 +235,8 @@ func (c *Client) AddQuestion(ctx context.Context, p *AddQuestionPayload) (res *S
 //   - "submission_not_found" (type SubmissionNotFound)
 //   - "test_already_submitted" (type TestAlreadySubmitted)
 //   - "invalid_input" (type InvalidInput)
+//   - "time_expired" (type TimeExpired)
+//   - "internal_error" (type InternalError)
 //   - error: internal error
 func (c *Client) GetQuestionByID(ctx context.Context, p *GetQuestionByIDPayload) (res *QuestionResponse, err error) {
 	var ires any
@@ -212,6 +256,8 @@ func (c *Client) GetQuestionByID(ctx context.Context, p *GetQuestionByIDPayload)
 //   - "submission_not_found" (type SubmissionNotFound)
 //   - "test_already_submitted" (type TestAlreadySubmitted)
 //   - "invalid_input" (type InvalidInput)
+//   - "time_expired" (type TimeExpired)
+//   - "internal_error" (type InternalError)
 //   - error: internal error
 func (c *Client) UpdateQuestion(ctx context.Context, p *UpdateQuestionPayload) (res *SimpleResponse, err error) {
 	var ires any
@@ -231,6 +277,8 @@ func (c *Client) UpdateQuestion(ctx context.Context, p *UpdateQuestionPayload) (
 //   - "submission_not_found" (type SubmissionNotFound)
 //   - "test_already_submitted" (type TestAlreadySubmitted)
 //   - "invalid_input" (type InvalidInput)
+//   - "time_expired" (type TimeExpired)
+//   - "internal_error" (type InternalError)
 //   - error: internal error
 func (c *Client) DeleteQuestion(ctx context.Context, p *DeleteQuestionPayload) (res *SimpleResponse, err error) {
 	var ires any
@@ -241,6 +289,277 @@ func (c *Client) DeleteQuestion(ctx context.Context, p *DeleteQuestionPayload) (
 	return ires.(*SimpleResponse), nil
 }
 
+// CreateQuestionBank calls the "CreateQuestionBank" endpoint of the
+// "knowledge" service.
+// CreateQuestionBank may return the following errors:
+//   - "unauthorized" (type Unauthorized)
+//   - "test_not_found" (type TestNotFound)
+//   - "question_not_found" (type QuestionNotFound)
+//   - "submission_not_found" (type SubmissionNotFound)
+//   - "test_already_submitted" (type TestAlreadySubmitted)
+//   - "invalid_input" (type InvalidInput)
+//   - "time_expired" (type TimeExpired)
+//   - "internal_error" (type InternalError)
+//   - error: internal error
+func (c *Client) CreateQuestionBank(ctx context.Context, p *CreateQuestionBankPayload) (res *SimpleResponse, err error) {
+	var ires any
+	ires, err = c.CreateQuestionBankEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*SimpleResponse), nil
+}
+
+// GetMyQuestionBanks calls the "GetMyQuestionBanks" endpoint of the
+// "knowledge" service.
+// GetMyQuestionBanks may return the following errors:
+//   - "unauthorized" (type Unauthorized)
+//   - "test_not_found" (type TestNotFound)
+//   - "question_not_found" (type QuestionNotFound)
+//   - "submission_not_found" (type SubmissionNotFound)
+//   - "test_already_submitted" (type TestAlreadySubmitted)
+//   - "invalid_input" (type InvalidInput)
+//   - "time_expired" (type TimeExpired)
+//   - "internal_error" (type InternalError)
+//   - error: internal error
+func (c *Client) GetMyQuestionBanks(ctx context.Context, p *GetMyQuestionBanksPayload) (res *QuestionBanksResponse, err error) {
+	var ires any
+	ires, err = c.GetMyQuestionBanksEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*QuestionBanksResponse), nil
+}
+
+// DeleteQuestionBank calls the "DeleteQuestionBank" endpoint of the
+// "knowledge" service.
+// DeleteQuestionBank may return the following errors:
+//   - "unauthorized" (type Unauthorized)
+//   - "test_not_found" (type TestNotFound)
+//   - "question_not_found" (type QuestionNotFound)
+//   - "submission_not_found" (type SubmissionNotFound)
+//   - "test_already_submitted" (type TestAlreadySubmitted)
+//   - "invalid_input" (type InvalidInput)
+//   - "time_expired" (type TimeExpired)
+//   - "internal_error" (type InternalError)
+//   - error: internal error
+func (c *Client) DeleteQuestionBank(ctx context.Context, p *DeleteQuestionBankPayload) (res *SimpleResponse, err error) {
+	var ires any
+	ires, err = c.DeleteQuestionBankEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*SimpleResponse), nil
+}
+
+// GetBankQuestions calls the "GetBankQuestions" endpoint of the "knowledge"
+// service.
+// GetBankQuestions may return the following errors:
+//   - "unauthorized" (type Unauthorized)
+//   - "test_not_found" (type TestNotFound)
+//   - "question_not_found" (type QuestionNotFound)
+//   - "submission_not_found" (type SubmissionNotFound)
+//   - "test_already_submitted" (type TestAlreadySubmitted)
+//   - "invalid_input" (type InvalidInput)
+//   - "time_expired" (type TimeExpired)
+//   - "internal_error" (type InternalError)
+//   - error: internal error
+func (c *Client) GetBankQuestions(ctx context.Context, p *GetBankQuestionsPayload) (res *BankQuestionsResponse, err error) {
+	var ires any
+	ires, err = c.GetBankQuestionsEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*BankQuestionsResponse), nil
+}
+
+// AddBankQuestion calls the "AddBankQuestion" endpoint of the "knowledge"
+// service.
+// AddBankQuestion may return the following errors:
+//   - "unauthorized" (type Unauthorized)
+//   - "test_not_found" (type TestNotFound)
+//   - "question_not_found" (type QuestionNotFound)
+//   - "submission_not_found" (type SubmissionNotFound)
+//   - "test_already_submitted" (type TestAlreadySubmitted)
+//   - "invalid_input" (type InvalidInput)
+//   - "time_expired" (type TimeExpired)
+//   - "internal_error" (type InternalError)
+//   - error: internal error
+func (c *Client) AddBankQuestion(ctx context.Context, p *AddBankQuestionPayload) (res *SimpleResponse, err error) {
+	var ires any
+	ires, err = c.AddBankQuestionEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*SimpleResponse), nil
+}
+
+// UpdateBankQuestion calls the "UpdateBankQuestion" endpoint of the
+// "knowledge" service.
+// UpdateBankQuestion may return the following errors:
+//   - "unauthorized" (type Unauthorized)
+//   - "test_not_found" (type TestNotFound)
+//   - "question_not_found" (type QuestionNotFound)
+//   - "submission_not_found" (type SubmissionNotFound)
+//   - "test_already_submitted" (type TestAlreadySubmitted)
+//   - "invalid_input" (type InvalidInput)
+//   - "time_expired" (type TimeExpired)
+//   - "internal_error" (type InternalError)
+//   - error: internal error
+func (c *Client) UpdateBankQuestion(ctx context.Context, p *UpdateBankQuestionPayload) (res *SimpleResponse, err error) {
+	var ires any
+	ires, err = c.UpdateBankQuestionEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*SimpleResponse), nil
+}
+
+// DeleteBankQuestion calls the "DeleteBankQuestion" endpoint of the
+// "knowledge" service.
+// DeleteBankQuestion may return the following errors:
+//   - "unauthorized" (type Unauthorized)
+//   - "test_not_found" (type TestNotFound)
+//   - "question_not_found" (type QuestionNotFound)
+//   - "submission_not_found" (type SubmissionNotFound)
+//   - "test_already_submitted" (type TestAlreadySubmitted)
+//   - "invalid_input" (type InvalidInput)
+//   - "time_expired" (type TimeExpired)
+//   - "internal_error" (type InternalError)
+//   - error: internal error
+func (c *Client) DeleteBankQuestion(ctx context.Context, p *DeleteBankQuestionPayload) (res *SimpleResponse, err error) {
+	var ires any
+	ires, err = c.DeleteBankQuestionEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*SimpleResponse), nil
+}
+
+// AddDrawRule calls the "AddDrawRule" endpoint of the "knowledge" service.
+// AddDrawRule may return the following errors:
+//   - "unauthorized" (type Unauthorized)
+//   - "test_not_found" (type TestNotFound)
+//   - "question_not_found" (type QuestionNotFound)
+//   - "submission_not_found" (type SubmissionNotFound)
+//   - "test_already_submitted" (type TestAlreadySubmitted)
+//   - "invalid_input" (type InvalidInput)
+//   - "time_expired" (type TimeExpired)
+//   - "internal_error" (type InternalError)
+//   - error: internal error
+func (c *Client) AddDrawRule(ctx context.Context, p *AddDrawRulePayload) (res *SimpleResponse, err error) {
+	var ires any
+	ires, err = c.AddDrawRuleEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*SimpleResponse), nil
+}
+
+// GetDrawRules calls the "GetDrawRules" endpoint of the "knowledge" service.
+// GetDrawRules may return the following errors:
+//   - "unauthorized" (type Unauthorized)
+//   - "test_not_found" (type TestNotFound)
+//   - "question_not_found" (type QuestionNotFound)
+//   - "submission_not_found" (type SubmissionNotFound)
+//   - "test_already_submitted" (type TestAlreadySubmitted)
+//   - "invalid_input" (type InvalidInput)
+//   - "time_expired" (type TimeExpired)
+//   - "internal_error" (type InternalError)
+//   - error: internal error
+func (c *Client) GetDrawRules(ctx context.Context, p *GetDrawRulesPayload) (res *DrawRulesResponse, err error) {
+	var ires any
+	ires, err = c.GetDrawRulesEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*DrawRulesResponse), nil
+}
+
+// DeleteDrawRule calls the "DeleteDrawRule" endpoint of the "knowledge"
+// service.
+// DeleteDrawRule may return the following errors:
+//   - "unauthorized" (type Unauthorized)
+//   - "test_not_found" (type TestNotFound)
+//   - "question_not_found" (type QuestionNotFound)
+//   - "submission_not_found" (type SubmissionNotFound)
+//   - "test_already_submitted" (type TestAlreadySubmitted)
+//   - "invalid_input" (type InvalidInput)
+//   - "time_expired" (type TimeExpired)
+//   - "internal_error" (type InternalError)
+//   - error: internal error
+func (c *Client) DeleteDrawRule(ctx context.Context, p *DeleteDrawRulePayload) (res *SimpleResponse, err error) {
+	var ires any
+	ires, err = c.DeleteDrawRuleEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*SimpleResponse), nil
+}
+
+// GetTestAnalytics calls the "GetTestAnalytics" endpoint of the "knowledge"
+// service.
+// GetTestAnalytics may return the following errors:
+//   - "unauthorized" (type Unauthorized)
+//   - "test_not_found" (type TestNotFound)
+//   - "question_not_found" (type QuestionNotFound)
+//   - "submission_not_found" (type SubmissionNotFound)
+//   - "test_already_submitted" (type TestAlreadySubmitted)
+//   - "invalid_input" (type InvalidInput)
+//   - "time_expired" (type TimeExpired)
+//   - "internal_error" (type InternalError)
+//   - error: internal error
+func (c *Client) GetTestAnalytics(ctx context.Context, p *GetTestAnalyticsPayload) (res *TestAnalytics, err error) {
+	var ires any
+	ires, err = c.GetTestAnalyticsEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*TestAnalytics), nil
+}
+
+// GetTestGradebook calls the "GetTestGradebook" endpoint of the "knowledge"
+// service.
+// GetTestGradebook may return the following errors:
+//   - "unauthorized" (type Unauthorized)
+//   - "test_not_found" (type TestNotFound)
+//   - "question_not_found" (type QuestionNotFound)
+//   - "submission_not_found" (type SubmissionNotFound)
+//   - "test_already_submitted" (type TestAlreadySubmitted)
+//   - "invalid_input" (type InvalidInput)
+//   - "time_expired" (type TimeExpired)
+//   - "internal_error" (type InternalError)
+//   - error: internal error
+func (c *Client) GetTestGradebook(ctx context.Context, p *GetTestGradebookPayload) (res *TestGradebook, err error) {
+	var ires any
+	ires, err = c.GetTestGradebookEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*TestGradebook), nil
+}
+
+// GetStudentHistory calls the "GetStudentHistory" endpoint of the "knowledge"
+// service.
+// GetStudentHistory may return the following errors:
+//   - "unauthorized" (type Unauthorized)
+//   - "test_not_found" (type TestNotFound)
+//   - "question_not_found" (type QuestionNotFound)
+//   - "submission_not_found" (type SubmissionNotFound)
+//   - "test_already_submitted" (type TestAlreadySubmitted)
+//   - "invalid_input" (type InvalidInput)
+//   - "time_expired" (type TimeExpired)
+//   - "internal_error" (type InternalError)
+//   - error: internal error
+func (c *Client) GetStudentHistory(ctx context.Context, p *GetStudentHistoryPayload) (res *StudentHistory, err error) {
+	var ires any
+	ires, err = c.GetStudentHistoryEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*StudentHistory), nil
+}
+
 // GetAvailableTests calls the "GetAvailableTests" endpoint of the "knowledge"
 // service.
 // GetAvailableTests may return the following errors:
@@ -250,6 +569,8 @@ func (c *Client) DeleteQuestion(ctx context.Context, p *DeleteQuestionPayload) (
 //   - "submission_not_found" (type SubmissionNotFound)
 //   - "test_already_submitted" (type TestAlreadySubmitted)
 //   - "invalid_input" (type InvalidInput)
+//   - "time_expired" (type TimeExpired)
+//   - "internal_error" (type InternalError)
 //   - error: internal error
 func (c *Client) GetAvailableTests(ctx context.Context, p *GetAvailableTestsPayload) (res *TestsResponse, err error) {
 	var ires any
@@ -260,6 +581,26 @@ func (c *Client) GetAvailableTests(ctx context.Context, p *GetAvailableTestsPayl
 	return ires.(*TestsResponse), nil
 }
 
+// StartTest calls the "StartTest" endpoint of the "knowledge" service.
+// StartTest may return the following errors:
+//   - "unauthorized" (type Unauthorized)
+//   - "test_not_found" (type TestNotFound)
+//   - "question_not_found" (type QuestionNotFound)
+//   - "submission_not_found" (type SubmissionNotFound)
+//   - "test_already_submitted" (type TestAlreadySubmitted)
+//   - "invalid_input" (type InvalidInput)
+//   - "time_expired" (type TimeExpired)
+//   - "internal_error" (type InternalError)
+//   - error: internal error
+func (c *Client) StartTest(ctx context.Context, p *StartTestPayload) (res *TestAttempt, err error) {
+	var ires any
+	ires, err = c.StartTestEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*TestAttempt), nil
+}
+
 // GetTestForm calls the "GetTestForm" endpoint of the "knowledge" service.
 // GetTestForm may return the following errors:
 //   - "unauthorized" (type Unauthorized)
@@ -268,6 +609,8 @@ func (c *Client) GetAvailableTests(ctx context.Context, p *GetAvailableTestsPayl
 //   - "submission_not_found" (type SubmissionNotFound)
 //   - "test_already_submitted" (type TestAlreadySubmitted)
 //   - "invalid_input" (type InvalidInput)
+//   - "time_expired" (type TimeExpired)
+//   - "internal_error" (type InternalError)
 //   - error: internal error
 func (c *Client) GetTestForm(ctx context.Context, p *GetTestFormPayload) (res *FormResponse, err error) {
 	var ires any
@@ -286,6 +629,8 @@ func (c *Client) GetTestForm(ctx context.Context, p *GetTestFormPayload) (res *F
 //   - "submission_not_found" (type SubmissionNotFound)
 //   - "test_already_submitted" (type TestAlreadySubmitted)
 //   - "invalid_input" (type InvalidInput)
+//   - "time_expired" (type TimeExpired)
+//   - "internal_error" (type InternalError)
 //   - error: internal error
 func (c *Client) SubmitTest(ctx context.Context, p *SubmitTestPayload) (res *SubmitResponse, err error) {
 	var ires any
@@ -305,6 +650,8 @@ func (c *Client) SubmitTest(ctx context.Context, p *SubmitTestPayload) (res *Sub
 //   - "submission_not_found" (type SubmissionNotFound)
 //   - "test_already_submitted" (type TestAlreadySubmitted)
 //   - "invalid_input" (type InvalidInput)
+//   - "time_expired" (type TimeExpired)
+//   - "internal_error" (type InternalError)
 //   - error: internal error
 func (c *Client) GetMySubmissions(ctx context.Context, p *GetMySubmissionsPayload) (res *SubmissionsResponse, err error) {
 	var ires any
@@ -324,6 +671,8 @@ func (c *Client) GetMySubmissions(ctx context.Context, p *GetMySubmissionsPayloa
 //   - "submission_not_found" (type SubmissionNotFound)
 //   - "test_already_submitted" (type TestAlreadySubmitted)
 //   - "invalid_input" (type InvalidInput)
+//   - "time_expired" (type TimeExpired)
+//   - "internal_error" (type InternalError)
 //   - error: internal error
 func (c *Client) GetSubmissionByID(ctx context.Context, p *GetSubmissionByIDPayload) (res *SubmissionResponse, err error) {
 	var ires any
@@ -343,6 +692,8 @@ func (c *Client) GetSubmissionByID(ctx context.Context, p *GetSubmissionByIDPayl
 //   - "submission_not_found" (type SubmissionNotFound)
 //   - "test_already_submitted" (type TestAlreadySubmitted)
 //   - "invalid_input" (type InvalidInput)
+//   - "time_expired" (type TimeExpired)
+//   - "internal_error" (type InternalError)
 //   - error: internal error
 func (c *Client) GetSubmissionResult(ctx context.Context, p *GetSubmissionResultPayload) (res *SubmissionResult, err error) {
 	var ires any
@@ -27,7 +27,21 @@ type Endpoints struct {
 	GetQuestionByID     goa.Endpoint
 	UpdateQuestion      goa.Endpoint
 	DeleteQuestion      goa.Endpoint
+	CreateQuestionBank  goa.Endpoint
+	GetMyQuestionBanks  goa.Endpoint
+	DeleteQuestionBank  goa.Endpoint
+	GetBankQuestions    goa.Endpoint
+	AddBankQuestion     goa.Endpoint
+	UpdateBankQuestion  goa.Endpoint
+	DeleteBankQuestion  goa.Endpoint
+	AddDrawRule         goa.Endpoint
+	GetDrawRules        goa.Endpoint
+	DeleteDrawRule      goa.Endpoint
+	GetTestAnalytics    goa.Endpoint
+	GetTestGradebook    goa.Endpoint
+	GetStudentHistory   goa.Endpoint
 	GetAvailableTests   goa.Endpoint
+	StartTest           goa.Endpoint
 	GetTestForm         goa.Endpoint
 	SubmitTest          goa.Endpoint
 	GetMySubmissions    goa.Endpoint
@@ -48,7 +62,21 @@ func NewEndpoints(s Service) *Endpoints {
 		GetQuestionByID:     NewGetQuestionByIDEndpoint(s),
 		UpdateQuestion:      NewUpdateQuestionEndpoint(s),
 		DeleteQuestion:      NewDeleteQuestionEndpoint(s),
+		CreateQuestionBank:  NewCreateQuestionBankEndpoint(s),
+		GetMyQuestionBanks:  NewGetMyQuestionBanksEndpoint(s),
+		DeleteQuestionBank:  NewDeleteQuestionBankEndpoint(s),
+		GetBankQuestions:    NewGetBankQuestionsEndpoint(s),
+		AddBankQuestion:     NewAddBankQuestionEndpoint(s),
+		UpdateBankQuestion:  NewUpdateBankQuestionEndpoint(s),
+		DeleteBankQuestion:  NewDeleteBankQuestionEndpoint(s),
+		AddDrawRule:         NewAddDrawRuleEndpoint(s),
+		GetDrawRules:        NewGetDrawRulesEndpoint(s),
+		DeleteDrawRule:      NewDeleteDrawRuleEndpoint(s),
+		GetTestAnalytics:    NewGetTestAnalyticsEndpoint(s),
+		GetTestGradebook:    NewGetTestGradebookEndpoint(s),
+		GetStudentHistory:   NewGetStudentHistoryEndpoint(s),
 		GetAvailableTests:   NewGetAvailableTestsEndpoint(s),
+		StartTest:           NewStartTestEndpoint(s),
 		GetTestForm:         NewGetTestFormEndpoint(s),
 		SubmitTest:          NewSubmitTestEndpoint(s),
 		GetMySubmissions:    NewGetMySubmissionsEndpoint(s),
@@ -69,7 +97,21 @@ func (e *Endpoints) Use(m func(goa.Endpoint) goa.Endpoint) {
 	e.GetQuestionByID = m(e.GetQuestionByID)
 	e.UpdateQuestion = m(e.UpdateQuestion)
 	e.DeleteQuestion = m(e.DeleteQuestion)
+	e.CreateQuestionBank = m(e.CreateQuestionBank)
+	e.GetMyQuestionBanks = m(e.GetMyQuestionBanks)
+	e.DeleteQuestionBank = m(e.DeleteQuestionBank)
+	e.GetBankQuestions = m(e.GetBankQuestions)
+	e.AddBankQuestion = m(e.AddBankQuestion)
+	e.UpdateBankQuestion = m(e.UpdateBankQuestion)
+	e.DeleteBankQuestion = m(e.DeleteBankQuestion)
+	e.AddDrawRule = m(e.AddDrawRule)
+	e.GetDrawRules = m(e.GetDrawRules)
+	e.DeleteDrawRule = m(e.DeleteDrawRule)
+	e.GetTestAnalytics = m(e.GetTestAnalytics)
+	e.GetTestGradebook = m(e.GetTestGradebook)
+	e.GetStudentHistory = m(e.GetStudentHistory)
 	e.GetAvailableTests = m(e.GetAvailableTests)
+	e.StartTest = m(e.StartTest)
 	e.GetTestForm = m(e.GetTestForm)
 	e.SubmitTest = m(e.SubmitTest)
 	e.GetMySubmissions = m(e.GetMySubmissions)
@@ -167,6 +209,123 @@ func NewDeleteQuestionEndpoint(s Service) goa.Endpoint {
 	}
 }
 
+// NewCreateQuestionBankEndpoint returns an endpoint function that calls the
+// method "CreateQuestionBank" of service "knowledge".
+func NewCreateQuestionBankEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*CreateQuestionBankPayload)
+		return s.CreateQuestionBank(ctx, p)
+	}
+}
+
+// NewGetMyQuestionBanksEndpoint returns an endpoint function that calls the
+// method "GetMyQuestionBanks" of service "knowledge".
+func NewGetMyQuestionBanksEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*GetMyQuestionBanksPayload)
+		return s.GetMyQuestionBanks(ctx, p)
+	}
+}
+
+// NewDeleteQuestionBankEndpoint returns an endpoint function that calls the
+// method "DeleteQuestionBank" of service "knowledge".
+func NewDeleteQuestionBankEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*DeleteQuestionBankPayload)
+		return s.DeleteQuestionBank(ctx, p)
+	}
+}
+
+// NewGetBankQuestionsEndpoint returns an endpoint function that calls the
+// method "GetBankQuestions" of service "knowledge".
+func NewGetBankQuestionsEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*GetBankQuestionsPayload)
+		return s.GetBankQuestions(ctx, p)
+	}
+}
+
+// NewAddBankQuestionEndpoint returns an endpoint function that calls the
+// method "AddBankQuestion" of service "knowledge".
+func NewAddBankQuestionEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*AddBankQuestionPayload)
+		return s.AddBankQuestion(ctx, p)
+	}
+}
+
+// NewUpdateBankQuestionEndpoint returns an endpoint function that calls the
+// method "UpdateBankQuestion" of service "knowledge".
+func NewUpdateBankQuestionEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*UpdateBankQuestionPayload)
+		return s.UpdateBankQuestion(ctx, p)
+	}
+}
+
+// NewDeleteBankQuestionEndpoint returns an endpoint function that calls the
+// method "DeleteBankQuestion" of service "knowledge".
+func NewDeleteBankQuestionEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*DeleteBankQuestionPayload)
+		return s.DeleteBankQuestion(ctx, p)
+	}
+}
+
+// NewAddDrawRuleEndpoint returns an endpoint function that calls the method
+// "AddDrawRule" of service "knowledge".
+func NewAddDrawRuleEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*AddDrawRulePayload)
+		return s.AddDrawRule(ctx, p)
+	}
+}
+
+// NewGetDrawRulesEndpoint returns an endpoint function that calls the method
+// "GetDrawRules" of service "knowledge".
+func NewGetDrawRulesEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*GetDrawRulesPayload)
+		return s.GetDrawRules(ctx, p)
+	}
+}
+
+// NewDeleteDrawRuleEndpoint returns an endpoint function that calls the method
+// "DeleteDrawRule" of service "knowledge".
+func NewDeleteDrawRuleEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*DeleteDrawRulePayload)
+		return s.DeleteDrawRule(ctx, p)
+	}
+}
+
+// NewGetTestAnalyticsEndpoint returns an endpoint function that calls the
+// method "GetTestAnalytics" of service "knowledge".
+func NewGetTestAnalyticsEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*GetTestAnalyticsPayload)
+		return s.GetTestAnalytics(ctx, p)
+	}
+}
+
+// NewGetTestGradebookEndpoint returns an endpoint function that calls the
+// method "GetTestGradebook" of service "knowledge".
+func NewGetTestGradebookEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*GetTestGradebookPayload)
+		return s.GetTestGradebook(ctx, p)
+	}
+}
+
+// NewGetStudentHistoryEndpoint returns an endpoint function that calls the
+// method "GetStudentHistory" of service "knowledge".
+func NewGetStudentHistoryEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*GetStudentHistoryPayload)
+		return s.GetStudentHistory(ctx, p)
+	}
+}
+
 // NewGetAvailableTestsEndpoint returns an endpoint function that calls the
 // method "GetAvailableTests" of service "knowledge".
 func NewGetAvailableTestsEndpoint(s Service) goa.Endpoint {
@@ -176,6 +335,15 @@ func NewGetAvailableTestsEndpoint(s Service) goa.Endpoint {
 	}
 }
 
+// NewStartTestEndpoint returns an endpoint function that calls the method
+// "StartTest" of service "knowledge".
+func NewStartTestEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*StartTestPayload)
+		return s.StartTest(ctx, p)
+	}
+}
+
 // NewGetTestFormEndpoint returns an endpoint function that calls the method
 // "GetTestForm" of service "knowledge".
 func NewGetTestFormEndpoint(s Service) goa.Endpoint {
@@ -21,7 +21,7 @@ type Service interface {
 	GetMyTests(context.Context, *GetMyTestsPayload) (res *TestsResponse, err error)
 	// Get a test by ID
 	GetTestByID(context.Context, *GetTestByIDPayload) (res *TestResponse, err error)
-	// Update test title
+	// Update test title, time limit, attempt policy and shuffling
 	UpdateTest(context.Context, *UpdateTestPayload) (res *SimpleResponse, err error)
 	// Delete a test
 	DeleteTest(context.Context, *DeleteTestPayload) (res *SimpleResponse, err error)
@@ -35,17 +35,45 @@ type Service interface {
 	UpdateQuestion(context.Context, *UpdateQuestionPayload) (res *SimpleResponse, err error)
 	// Delete a question
 	DeleteQuestion(context.Context, *DeleteQuestionPayload) (res *SimpleResponse, err error)
+	// Create a question bank
+	CreateQuestionBank(context.Context, *CreateQuestionBankPayload) (res *SimpleResponse, err error)
+	// Get my question banks
+	GetMyQuestionBanks(context.Context, *GetMyQuestionBanksPayload) (res *QuestionBanksResponse, err error)
+	// Delete a question bank with its questions and the draw rules using it
+	DeleteQuestionBank(context.Context, *DeleteQuestionBankPayload) (res *SimpleResponse, err error)
+	// Get the questions of a question bank
+	GetBankQuestions(context.Context, *GetBankQuestionsPayload) (res *BankQuestionsResponse, err error)
+	// Add a question to a question bank
+	AddBankQuestion(context.Context, *AddBankQuestionPayload) (res *SimpleResponse, err error)
+	// Update a question of a question bank
+	UpdateBankQuestion(context.Context, *UpdateBankQuestionPayload) (res *SimpleResponse, err error)
+	// Delete a question of a question bank
+	DeleteBankQuestion(context.Context, *DeleteBankQuestionPayload) (res *SimpleResponse, err error)
+	// Make every attempt at a test draw questions at random from a question bank
+	AddDrawRule(context.Context, *AddDrawRulePayload) (res *SimpleResponse, err error)
+	// Get the draw rules of a test
+	GetDrawRules(context.Context, *GetDrawRulesPayload) (res *DrawRulesResponse, err error)
+	// Delete a draw rule of a test
+	DeleteDrawRule(context.Context, *DeleteDrawRulePayload) (res *SimpleResponse, err error)
+	// Get the score distribution and item analysis of a test
+	GetTestAnalytics(context.Context, *GetTestAnalyticsPayload) (res *TestAnalytics, err error)
+	// Get the submissions of every student at a test
+	GetTestGradebook(context.Context, *GetTestGradebookPayload) (res *TestGradebook, err error)
+	// Get the submissions of a student at every test of the teacher
+	GetStudentHistory(context.Context, *GetStudentHistoryPayload) (res *StudentHistory, err error)
 	// Get available tests for students
 	GetAvailableTests(context.Context, *GetAvailableTestsPayload) (res *TestsResponse, err error)
+	// Start an attempt at a test, or resume the one in progress
+	StartTest(context.Context, *StartTestPayload) (res *TestAttempt, err error)
 	// Get test form for taking
 	GetTestForm(context.Context, *GetTestFormPayload) (res *FormResponse, err error)
 	// Submit test answers
 	SubmitTest(context.Context, *SubmitTestPayload) (res *SubmitResponse, err error)
-	// Get my test submissions
+	// Get every attempt of mine at every test, with the score counted for each test
 	GetMySubmissions(context.Context, *GetMySubmissionsPayload) (res *SubmissionsResponse, err error)
 	// Get a submission by ID
 	GetSubmissionByID(context.Context, *GetSubmissionByIDPayload) (res *SubmissionResponse, err error)
-	// Get detailed submission result
+	// Get detailed submission result, with every attempt at the same test
 	GetSubmissionResult(context.Context, *GetSubmissionResultPayload) (res *SubmissionResult, err error)
 }
 
@@ -63,7 +91,52 @@ const ServiceName = "knowledge"
 // MethodNames lists the service method names as defined in the design. These
 // are the same values that are set in the endpoint request contexts under the
 // MethodKey key.
-var MethodNames = [16]string{"CreateTest", "GetMyTests", "GetTestById", "UpdateTest", "DeleteTest", "GetTestQuestions", "AddQuestion", "GetQuestionById", "UpdateQuestion", "DeleteQuestion", "GetAvailableTests", "GetTestForm", "SubmitTest", "GetMySubmissions", "GetSubmissionById", "GetSubmissionResult"}
+var MethodNames = [30]string{"CreateTest", "GetMyTests", "GetTestById", "UpdateTest", "DeleteTest", "GetTestQuestions", "AddQuestion", "GetQuestionById", "UpdateQuestion", "DeleteQuestion", "CreateQuestionBank", "GetMyQuestionBanks", "DeleteQuestionBank", "GetBankQuestions", "AddBankQuestion", "UpdateBankQuestion", "DeleteBankQuestion", "AddDrawRule", "GetDrawRules", "DeleteDrawRule", "GetTestAnalytics", "GetTestGradebook", "GetStudentHistory", "GetAvailableTests", "StartTest", "GetTestForm", "SubmitTest", "GetMySubmissions", "GetSubmissionById", "GetSubmissionResult"}
+
+// AddBankQuestionPayload is the payload type of the knowledge service
+// AddBankQuestion method.
+type AddBankQuestionPayload struct {
+	// Session token
+	SessionToken string
+	// Question bank ID
+	BankID int64
+	// Question text
+	QuestionText string
+	// Question type
+	QuestionType QuestionType
+	// Options of choice and ordering questions, True and False by default for
+	// true/false questions
+	Options []string
+	// Indexes of the correct options, in the correct order for ordering questions
+	CorrectOptions []int
+	// Correct answer of numeric questions
+	NumericAnswer *float64
+	// Allowed difference from the numeric answer
+	Tolerance float64
+	// Accepted answers of short text questions, where * matches any text
+	AcceptedAnswers []string
+	// Topic of the question
+	Topic *string
+	// Question difficulty
+	Difficulty Difficulty
+}
+
+// AddDrawRulePayload is the payload type of the knowledge service AddDrawRule
+// method.
+type AddDrawRulePayload struct {
+	// Session token
+	SessionToken string
+	// Test ID
+	TestID int64
+	// Question bank ID
+	BankID int64
+	// Topic of the questions drawn, any when not set
+	Topic *string
+	// Difficulty of the questions drawn, any when not set
+	Difficulty *Difficulty
+	// Number of questions drawn
+	QuestionCount int
+}
 
 // AddQuestionPayload is the payload type of the knowledge service AddQuestion
 // method.
@@ -74,24 +147,79 @@ type AddQuestionPayload struct {
 	TestID int64
 	// Question text
 	QuestionText string
-	// Option A
-	OptionA string
-	// Option B
-	OptionB string
-	// Option C
-	OptionC string
-	// Option D
-	OptionD string
-	// Correct answer (0=A, 1=B, 2=C, 3=D)
-	CorrectAnswer int
+	// Question type
+	QuestionType QuestionType
+	// Options of choice and ordering questions, True and False by default for
+	// true/false questions
+	Options []string
+	// Indexes of the correct options, in the correct order for ordering questions
+	CorrectOptions []int
+	// Correct answer of numeric questions
+	NumericAnswer *float64
+	// Allowed difference from the numeric answer
+	Tolerance float64
+	// Accepted answers of short text questions, where * matches any text
+	AcceptedAnswers []string
 }
 
 // Answer submission
 type Answer struct {
 	// Question ID
 	QuestionID int64
-	// Selected answer (0=A, 1=B, 2=C, 3=D)
-	SelectedAnswer int
+	// Positions of the selected options as shown in the form, in the chosen order
+	// for ordering questions
+	SelectedOptions []int
+	// Answer to a numeric question
+	NumericAnswer *float64
+	// Answer to a short text question
+	TextAnswer *string
+}
+
+// Attempt status
+type AttemptStatus string
+
+// Question of a question bank, tagged by topic and difficulty
+type BankQuestion struct {
+	// Question ID
+	ID int64
+	// Question bank ID
+	BankID int64
+	// Question text
+	QuestionText string
+	// Question type
+	QuestionType QuestionType
+	// Options of choice and ordering questions
+	Options []string
+	// Indexes of the correct options, in the correct order for ordering questions
+	CorrectOptions []int
+	// Correct answer of numeric questions
+	NumericAnswer *float64
+	// Allowed difference from the numeric answer
+	Tolerance float64
+	// Accepted answers of short text questions, where * matches any text
+	AcceptedAnswers []string
+	// Question order in the bank
+	QuestionOrder int
+	// Topic of the question, empty when untagged
+	Topic string
+	// Question difficulty
+	Difficulty Difficulty
+}
+
+// BankQuestionsResponse is the result type of the knowledge service
+// GetBankQuestions method.
+type BankQuestionsResponse struct {
+	// Questions
+	Questions []*BankQuestion
+}
+
+// CreateQuestionBankPayload is the payload type of the knowledge service
+// CreateQuestionBank method.
+type CreateQuestionBankPayload struct {
+	// Session token
+	SessionToken string
+	// Question bank title
+	Title string
 }
 
 // CreateTestPayload is the payload type of the knowledge service CreateTest
@@ -101,6 +229,51 @@ type CreateTestPayload struct {
 	SessionToken string
 	// Test title
 	Title string
+	// Time limit in minutes, untimed when not set
+	DurationMinutes *int
+	// Attempts allowed per student, 0 for unlimited
+	MaxAttempts int
+	// Minutes a student waits between attempts
+	CooldownMinutes int
+	// Which score counts when the test is taken more than once
+	ScorePolicy ScorePolicy
+	// Whether correct answers are shown after each attempt
+	RevealAnswers bool
+	// Whether each attempt shows the questions in a different order
+	ShuffleQuestions bool
+	// Whether each attempt shows the options in a different order
+	ShuffleOptions bool
+}
+
+// DeleteBankQuestionPayload is the payload type of the knowledge service
+// DeleteBankQuestion method.
+type DeleteBankQuestionPayload struct {
+	// Session token
+	SessionToken string
+	// Question bank ID
+	BankID int64
+	// Question ID
+	QuestionID int64
+}
+
+// DeleteDrawRulePayload is the payload type of the knowledge service
+// DeleteDrawRule method.
+type DeleteDrawRulePayload struct {
+	// Session token
+	SessionToken string
+	// Test ID
+	TestID int64
+	// Draw rule ID
+	RuleID int64
+}
+
+// DeleteQuestionBankPayload is the payload type of the knowledge service
+// DeleteQuestionBank method.
+type DeleteQuestionBankPayload struct {
+	// Session token
+	SessionToken string
+	// Question bank ID
+	BankID int64
 }
 
 // DeleteQuestionPayload is the payload type of the knowledge service
@@ -123,6 +296,32 @@ type DeleteTestPayload struct {
 	TestID int64
 }
 
+// Question difficulty
+type Difficulty string
+
+// Questions every attempt at a test draws at random from a question bank
+type DrawRule struct {
+	// Draw rule ID
+	ID int64
+	// Test ID
+	TestID int64
+	// Question bank ID
+	BankID int64
+	// Topic of the questions drawn, any when not set
+	Topic *string
+	// Difficulty of the questions drawn, any when not set
+	Difficulty *Difficulty
+	// Number of questions drawn
+	QuestionCount int
+}
+
+// DrawRulesResponse is the result type of the knowledge service GetDrawRules
+// method.
+type DrawRulesResponse struct {
+	// Draw rules
+	Rules []*DrawRule
+}
+
 // FormResponse is the result type of the knowledge service GetTestForm method.
 type FormResponse struct {
 	// Test info
@@ -138,6 +337,31 @@ type GetAvailableTestsPayload struct {
 	SessionToken string
 }
 
+// GetBankQuestionsPayload is the payload type of the knowledge service
+// GetBankQuestions method.
+type GetBankQuestionsPayload struct {
+	// Session token
+	SessionToken string
+	// Question bank ID
+	BankID int64
+}
+
+// GetDrawRulesPayload is the payload type of the knowledge service
+// GetDrawRules method.
+type GetDrawRulesPayload struct {
+	// Session token
+	SessionToken string
+	// Test ID
+	TestID int64
+}
+
+// GetMyQuestionBanksPayload is the payload type of the knowledge service
+// GetMyQuestionBanks method.
+type GetMyQuestionBanksPayload struct {
+	// Session token
+	SessionToken string
+}
+
 // GetMySubmissionsPayload is the payload type of the knowledge service
 // GetMySubmissions method.
 type GetMySubmissionsPayload struct {
@@ -163,6 +387,15 @@ type GetQuestionByIDPayload struct {
 	QuestionID int64
 }
 
+// GetStudentHistoryPayload is the payload type of the knowledge service
+// GetStudentHistory method.
+type GetStudentHistoryPayload struct {
+	// Session token
+	SessionToken string
+	// Student user ID
+	UserID int64
+}
+
 // GetSubmissionByIDPayload is the payload type of the knowledge service
 // GetSubmissionById method.
 type GetSubmissionByIDPayload struct {
@@ -181,6 +414,15 @@ type GetSubmissionResultPayload struct {
 	SubmissionID int64
 }
 
+// GetTestAnalyticsPayload is the payload type of the knowledge service
+// GetTestAnalytics method.
+type GetTestAnalyticsPayload struct {
+	// Session token
+	SessionToken string
+	// Test ID
+	TestID int64
+}
+
 // GetTestByIDPayload is the payload type of the knowledge service GetTestById
 // method.
 type GetTestByIDPayload struct {
@@ -199,6 +441,23 @@ type GetTestFormPayload struct {
 	TestID int64
 }
 
+// GetTestGradebookPayload is the payload type of the knowledge service
+// GetTestGradebook method.
+type GetTestGradebookPayload struct {
+	// Session token
+	SessionToken string
+	// Test ID
+	TestID int64
+	// Field to sort submissions by
+	SortBy string
+	// Sort direction
+	SortOrder string
+	// Only submissions with this status
+	Status *AttemptStatus
+	// Only submissions of students whose name contains this text
+	Student *string
+}
+
 // GetTestQuestionsPayload is the payload type of the knowledge service
 // GetTestQuestions method.
 type GetTestQuestionsPayload struct {
@@ -208,6 +467,18 @@ type GetTestQuestionsPayload struct {
 	TestID int64
 }
 
+// How often an option of a question was selected
+type OptionSelection struct {
+	// Option index
+	Index int
+	// Option text
+	Option string
+	// Number of attempts selecting the option
+	Count int
+	// Whether the option is correct
+	IsCorrect bool
+}
+
 // Question information
 type Question struct {
 	// Question ID
@@ -216,35 +487,77 @@ type Question struct {
 	TestID int64
 	// Question text
 	QuestionText string
-	// Option A
-	OptionA string
-	// Option B
-	OptionB string
-	// Option C
-	OptionC string
-	// Option D
-	OptionD string
-	// Correct answer (0=A, 1=B, 2=C, 3=D)
-	CorrectAnswer int
+	// Question type
+	QuestionType QuestionType
+	// Options of choice and ordering questions
+	Options []string
+	// Indexes of the correct options, in the correct order for ordering questions
+	CorrectOptions []int
+	// Correct answer of numeric questions
+	NumericAnswer *float64
+	// Allowed difference from the numeric answer
+	Tolerance float64
+	// Accepted answers of short text questions, where * matches any text
+	AcceptedAnswers []string
 	// Question order
 	QuestionOrder int
 }
 
+// Item analysis of a question
+type QuestionAnalytics struct {
+	// Question ID
+	QuestionID int64
+	// Question text
+	QuestionText string
+	// Question type
+	QuestionType QuestionType
+	// Number of attempts the question was given in
+	Attempts int
+	// Mean credit those attempts earned on it, which is the share answering it
+	// correctly for questions without partial credit, from 0 to 1
+	DifficultyIndex float64
+	// Mean credit in the top 27% of attempts by score minus that in the bottom
+	// 27%, from -1 to 1, not set with too few attempts
+	DiscriminationIndex *float64
+	// How often each option was selected, empty for questions without options
+	OptionSelections []*OptionSelection
+	// Number of attempts leaving the question unanswered
+	Unanswered int
+}
+
+// Reusable set of questions tests are drawn from
+type QuestionBank struct {
+	// Question bank ID
+	ID int64
+	// Question bank title
+	Title string
+	// Creator user ID
+	CreatedBy int64
+	// Creation timestamp
+	CreatedAt int64
+	// Number of questions
+	QuestionCount *int
+}
+
+// QuestionBanksResponse is the result type of the knowledge service
+// GetMyQuestionBanks method.
+type QuestionBanksResponse struct {
+	// Question banks
+	Banks []*QuestionBank
+}
+
 // Question for form taking (without correct answer)
 type QuestionForm struct {
 	// Question ID
 	ID int64
 	// Question text
 	QuestionText string
-	// Option A
-	OptionA string
-	// Option B
-	OptionB string
-	// Option C
-	OptionC string
-	// Option D
-	OptionD string
-	// Question order
+	// Question type
+	QuestionType QuestionType
+	// Options to choose from or to order, empty for numeric and short text
+	// questions
+	Options []string
+	// Position of the question in the attempt
 	QuestionOrder int
 }
 
@@ -259,12 +572,26 @@ type QuestionResponse struct {
 type QuestionResult struct {
 	// Question info
 	Question *Question
-	// User selected answer
-	SelectedAnswer int
+	// User selected options
+	SelectedOptions []int
+	// User numeric answer
+	NumericAnswer *float64
+	// User text answer
+	TextAnswer *string
+	// Fraction of the question earned, from 0 to 1
+	Credit float64
 	// Whether answer was correct
 	IsCorrect bool
+	// Position of the question as shown to the user
+	Position int
+	// Index of each option of the question in the order shown to the user, empty
+	// when shown in order
+	OptionOrder []int
 }
 
+// Question type
+type QuestionType string
+
 // QuestionsResponse is the result type of the knowledge service
 // GetTestQuestions method.
 type QuestionsResponse struct {
@@ -272,6 +599,20 @@ type QuestionsResponse struct {
 	Questions []*Question
 }
 
+// Range of scores of a histogram, including from and excluding to except for
+// the last one
+type ScoreBin struct {
+	// Lowest score percentage of the range
+	From float64
+	// Highest score percentage of the range
+	To float64
+	// Number of attempts with a score in the range
+	Count int
+}
+
+// Score policy
+type ScorePolicy string
+
 // SimpleResponse is the result type of the knowledge service CreateTest method.
 type SimpleResponse struct {
 	// Operation success status
@@ -280,6 +621,38 @@ type SimpleResponse struct {
 	Message string
 }
 
+// StartTestPayload is the payload type of the knowledge service StartTest
+// method.
+type StartTestPayload struct {
+	// Session token
+	SessionToken string
+	// Test ID
+	TestID int64
+}
+
+// StudentHistory is the result type of the knowledge service GetStudentHistory
+// method.
+type StudentHistory struct {
+	// Student user ID
+	UserID int64
+	// Student full name
+	StudentName string
+	// Submissions, newest first
+	Submissions []*Submission
+	// Score counted for each test taken
+	TestScores []*TestScore
+}
+
+// Submission of a student, as seen by the teacher of the test
+type StudentSubmission struct {
+	// Student user ID
+	UserID int64
+	// Student full name
+	StudentName string
+	// Submission info
+	Submission *Submission
+}
+
 // Test submission
 type Submission struct {
 	// Submission ID
@@ -292,6 +665,12 @@ type Submission struct {
 	Score float64
 	// Submission timestamp
 	SubmittedAt int64
+	// Whether the test was submitted or expired
+	Status AttemptStatus
+	// Start timestamp
+	StartedAt int64
+	// Number of the attempt at the test, from 1
+	AttemptNumber int
 }
 
 // SubmissionResponse is the result type of the knowledge service
@@ -308,6 +687,12 @@ type SubmissionResult struct {
 	Submission *Submission
 	// Question results
 	Questions []*QuestionResult
+	// Every attempt at the test, oldest first
+	Attempts []*Submission
+	// Score percentage counted for the test over every attempt
+	CountedScore float64
+	// Whether the questions include their correct answers
+	AnswersRevealed bool
 }
 
 // SubmissionsResponse is the result type of the knowledge service
@@ -315,6 +700,8 @@ type SubmissionResult struct {
 type SubmissionsResponse struct {
 	// Submissions
 	Submissions []*Submission
+	// Score counted for each test taken
+	TestScores []*TestScore
 }
 
 // SubmitResponse is the result type of the knowledge service SubmitTest method.
@@ -327,6 +714,8 @@ type SubmitResponse struct {
 	SubmissionID int64
 	// Score percentage
 	Score float64
+	// Number of the attempt at the test, from 1
+	AttemptNumber int
 }
 
 // SubmitTestPayload is the payload type of the knowledge service SubmitTest
@@ -352,6 +741,71 @@ type Test struct {
 	CreatedAt int64
 	// Number of questions
 	QuestionCount *int
+	// Time limit in minutes, untimed when not set
+	DurationMinutes *int
+	// Attempts allowed per student, 0 for unlimited
+	MaxAttempts int
+	// Minutes a student waits between attempts
+	CooldownMinutes int
+	// Which score counts when the test is taken more than once
+	ScorePolicy ScorePolicy
+	// Whether correct answers are shown after each attempt
+	RevealAnswers bool
+	// Whether each attempt shows the questions in a different order
+	ShuffleQuestions bool
+	// Whether each attempt shows the options in a different order
+	ShuffleOptions bool
+}
+
+// TestAnalytics is the result type of the knowledge service GetTestAnalytics
+// method.
+type TestAnalytics struct {
+	// Test ID
+	TestID int64
+	// Number of attempts analyzed
+	Attempts int
+	// Mean score percentage
+	Mean float64
+	// Median score percentage
+	Median float64
+	// Standard deviation of the score percentages
+	StdDev float64
+	// Number of attempts by score range
+	ScoreHistogram []*ScoreBin
+	// Item analysis of every question given
+	Questions []*QuestionAnalytics
+	// Reliability coefficient over the questions given in every attempt, not set
+	// when it cannot be computed
+	Reliability *float64
+	// Reliability coefficient used, kr20 when those questions were all scored
+	// right or wrong and Cronbach's alpha when any earned partial credit, not set
+	// without a reliability
+	ReliabilityMethod *string
+}
+
+// TestAttempt is the result type of the knowledge service StartTest method.
+type TestAttempt struct {
+	// Submission ID
+	SubmissionID int64
+	// Test ID
+	TestID int64
+	// Attempt status
+	Status AttemptStatus
+	// Start timestamp
+	StartedAt int64
+	// Timestamp by which answers must be submitted, not set for untimed tests
+	Deadline *int64
+	// Time limit in minutes
+	DurationMinutes *int
+}
+
+// TestGradebook is the result type of the knowledge service GetTestGradebook
+// method.
+type TestGradebook struct {
+	// Test info
+	Test *Test
+	// Submissions, sorted and filtered as requested
+	Submissions []*StudentSubmission
 }
 
 // TestResponse is the result type of the knowledge service GetTestById method.
@@ -360,12 +814,56 @@ type TestResponse struct {
 	Test *Test
 }
 
+// Score counted for a test over every attempt
+type TestScore struct {
+	// Test ID
+	TestID int64
+	// Test title
+	TestTitle string
+	// Which score counts
+	ScorePolicy ScorePolicy
+	// Number of attempts
+	Attempts int
+	// Score percentage counted
+	Score float64
+}
+
 // TestsResponse is the result type of the knowledge service GetMyTests method.
 type TestsResponse struct {
 	// Tests
 	Tests []*Test
 }
 
+// UpdateBankQuestionPayload is the payload type of the knowledge service
+// UpdateBankQuestion method.
+type UpdateBankQuestionPayload struct {
+	// Session token
+	SessionToken string
+	// Question bank ID
+	BankID int64
+	// Question ID
+	QuestionID int64
+	// Question text
+	QuestionText string
+	// Question type
+	QuestionType QuestionType
+	// Options of choice and ordering questions, True and False by default for
+	// true/false questions
+	Options []string
+	// Indexes of the correct options, in the correct order for ordering questions
+	CorrectOptions []int
+	// Correct answer of numeric questions
+	NumericAnswer *float64
+	// Allowed difference from the numeric answer
+	Tolerance float64
+	// Accepted answers of short text questions, where * matches any text
+	AcceptedAnswers []string
+	// Topic of the question
+	Topic *string
+	// Question difficulty
+	Difficulty Difficulty
+}
+
 // UpdateQuestionPayload is the payload type of the knowledge service
 // UpdateQuestion method.
 type UpdateQuestionPayload struct {
@@ -377,16 +875,19 @@ type UpdateQuestionPayload struct {
 	QuestionID int64
 	// Question text
 	QuestionText string
-	// Option A
-	OptionA string
-	// Option B
-	OptionB string
-	// Option C
-	OptionC string
-	// Option D
-	OptionD string
-	// Correct answer (0=A, 1=B, 2=C, 3=D)
-	CorrectAnswer int
+	// Question type
+	QuestionType QuestionType
+	// Options of choice and ordering questions, True and False by default for
+	// true/false questions
+	Options []string
+	// Indexes of the correct options, in the correct order for ordering questions
+	CorrectOptions []int
+	// Correct answer of numeric questions
+	NumericAnswer *float64
+	// Allowed difference from the numeric answer
+	Tolerance float64
+	// Accepted answers of short text questions, where * matches any text
+	AcceptedAnswers []string
 }
 
 // UpdateTestPayload is the payload type of the knowledge service UpdateTest
@@ -398,8 +899,25 @@ type UpdateTestPayload struct {
 	TestID int64
 	// New title
 	Title string
+	// Time limit in minutes, untimed when not set
+	DurationMinutes *int
+	// Attempts allowed per student, 0 for unlimited
+	MaxAttempts int
+	// Minutes a student waits between attempts
+	CooldownMinutes int
+	// Which score counts when the test is taken more than once
+	ScorePolicy ScorePolicy
+	// Whether correct answers are shown after each attempt
+	RevealAnswers bool
+	// Whether each attempt shows the questions in a different order
+	ShuffleQuestions bool
+	// Whether each attempt shows the options in a different order
+	ShuffleOptions bool
 }
 
+// Internal server error
+type InternalError string
+
 // Invalid input
 type InvalidInput string
 
@@ -415,9 +933,29 @@ type TestAlreadySubmitted string
 // Test not found
 type TestNotFound string
 
+// Time limit of the test exceeded
+type TimeExpired string
+
 // Unauthorized access
 type Unauthorized string
 
+// Error returns an error description.
+func (e InternalError) Error() string {
+	return "Internal server error"
+}
+
+// ErrorName returns "internal_error".
+//
+// Deprecated: Use GoaErrorName - https://github.com/goadesign/goa/issues/3105
+func (e InternalError) ErrorName() string {
+	return e.GoaErrorName()
+}
+
+// GoaErrorName returns "internal_error".
+func (e InternalError) GoaErrorName() string {
+	return "internal_error"
+}
+
 // Error returns an error description.
 func (e InvalidInput) Error() string {
 	return "Invalid input"
@@ -503,6 +1041,23 @@ func (e TestNotFound) GoaErrorName() string {
 	return "test_not_found"
 }
 
+// Error returns an error description.
+func (e TimeExpired) Error() string {
+	return "Time limit of the test exceeded"
+}
+
+// ErrorName returns "time_expired".
+//
+// Deprecated: Use GoaErrorName - https://github.com/goadesign/goa/issues/3105
+func (e TimeExpired) ErrorName() string {
+	return e.GoaErrorName()
+}
+
+// GoaErrorName returns "time_expired".
+func (e TimeExpired) GoaErrorName() string {
+	return "time_expired"
+}
+
 // Error returns an error description.
 func (e Unauthorized) Error() string {
 	return "Unauthorized access"
@@ -0,0 +1,277 @@
+// Package analytics summarizes how students did on a test: the distribution
+// of their scores, and a classical item analysis of every question telling
+// how hard it was, how well it told strong students from weak ones and which
+// options drew them. Questions earning partial credit are weighed by the
+// credit earned rather than as right or wrong.
+package analytics
+
+import (
+	"math"
+	"slices"
+)
+
+// histogramBins is the number of equal score ranges from 0 to 100
+const histogramBins = 10
+
+// groupFraction is the share of attempts in the upper and lower groups
+// compared by the discrimination index
+const groupFraction = 0.27
+
+// Reliability coefficients
+const (
+	// KR20 is the Kuder-Richardson formula 20, for questions scored right or
+	// wrong
+	KR20 = "kr20"
+	// Alpha is Cronbach's alpha, which generalizes KR-20 to questions earning
+	// partial credit
+	Alpha = "alpha"
+)
+
+// Question is a question to analyze
+type Question struct {
+	ID int64
+	// Options is the number of options to choose from, 0 for questions whose
+	// options are not counted
+	Options int
+}
+
+// Attempt is a submitted attempt at a test
+type Attempt struct {
+	// Score is the percentage score of the attempt
+	Score float64
+	// Questions holds the IDs of the questions the attempt was given
+	Questions []int64
+	// Answers holds the answers of the attempt by question ID, without the
+	// questions left unanswered
+	Answers map[int64]Answer
+}
+
+// Answer is the answer of an attempt to a question
+type Answer struct {
+	// SelectedOptions holds the canonical indexes of the options selected
+	SelectedOptions []int
+	// Credit is the fraction of the question earned, from 0 to 1
+	Credit float64
+}
+
+// Bin is a range of scores and how many attempts fall in it. It includes From
+// and excludes To, except for the last bin which includes 100.
+type Bin struct {
+	From  float64
+	To    float64
+	Count int
+}
+
+// Scores describes the distribution of scores
+type Scores struct {
+	Mean   float64
+	Median float64
+	// StdDev is the population standard deviation
+	StdDev    float64
+	Histogram []Bin
+}
+
+// Item is the analysis of a question
+type Item struct {
+	QuestionID int64
+	// Attempts is the number of attempts the question was given in
+	Attempts int
+	// Difficulty is the mean credit those attempts earned on it, which is the
+	// share answering it correctly for questions without partial credit, so
+	// easy questions have a high difficulty index
+	Difficulty float64
+	// Discrimination is the mean credit in the upper group of attempts by
+	// score minus that in the lower group, or nil with too few attempts.
+	// Questions near or below zero do not tell strong students from weak ones.
+	Discrimination *float64
+	// OptionCounts holds how many attempts selected each option
+	OptionCounts []int
+	Unanswered   int
+}
+
+// Report is the analysis of a test
+type Report struct {
+	Attempts int
+	Scores   Scores
+	Items    []Item
+	// Reliability is the reliability coefficient over the questions every
+	// attempt was given, or nil when it cannot be computed
+	Reliability *float64
+	// ReliabilityMethod is the coefficient used, KR20 unless any of those
+	// questions earned partial credit and Alpha otherwise. It is empty
+	// without a reliability.
+	ReliabilityMethod string
+}
+
+// Analyze analyzes the attempts at a test with the given questions
+func Analyze(questions []Question, attempts []Attempt) Report {
+	report := Report{
+		Attempts: len(attempts),
+		Scores:   scoreDistribution(attempts),
+		Items:    make([]Item, 0, len(questions)),
+	}
+	for _, question := range questions {
+		report.Items = append(report.Items, analyzeItem(question, attempts))
+	}
+	report.Reliability, report.ReliabilityMethod = reliability(questions, attempts)
+	return report
+}
+
+func scoreDistribution(attempts []Attempt) Scores {
+	scores := Scores{Histogram: make([]Bin, histogramBins)}
+	width := 100.0 / histogramBins
+	for i := range scores.Histogram {
+		scores.Histogram[i].From = float64(i) * width
+		scores.Histogram[i].To = float64(i+1) * width
+	}
+	if len(attempts) == 0 {
+		return scores
+	}
+
+	values := make([]float64, len(attempts))
+	for i, attempt := range attempts {
+		values[i] = attempt.Score
+		bin := min(max(int(attempt.Score/width), 0), histogramBins-1)
+		scores.Histogram[bin].Count++
+	}
+	scores.Mean, scores.StdDev = meanStdDev(values)
+
+	slices.Sort(values)
+	middle := len(values) / 2
+	if len(values)%2 == 1 {
+		scores.Median = values[middle]
+	} else {
+		scores.Median = (values[middle-1] + values[middle]) / 2
+	}
+	return scores
+}
+
+func analyzeItem(question Question, attempts []Attempt) Item {
+	item := Item{
+		QuestionID:   question.ID,
+		OptionCounts: make([]int, question.Options),
+	}
+
+	var given []Attempt
+	for _, attempt := range attempts {
+		if !slices.Contains(attempt.Questions, question.ID) {
+			continue
+		}
+		given = append(given, attempt)
+
+		answer, answered := attempt.Answers[question.ID]
+		if !answered || (question.Options > 0 && len(answer.SelectedOptions) == 0) {
+			item.Unanswered++
+		}
+		for _, option := range answer.SelectedOptions {
+			if option >= 0 && option < question.Options {
+				item.OptionCounts[option]++
+			}
+		}
+	}
+	item.Attempts = len(given)
+	if item.Attempts == 0 {
+		return item
+	}
+	item.Difficulty = meanCredit(question.ID, given)
+
+	// Compare the attempts with the highest and lowest scores
+	group := int(math.Round(groupFraction * float64(len(given))))
+	if group > 0 {
+		slices.SortStableFunc(given, func(a, b Attempt) int {
+			return compareFloat(b.Score, a.Score)
+		})
+		discrimination := meanCredit(question.ID, given[:group]) - meanCredit(question.ID, given[len(given)-group:])
+		item.Discrimination = &discrimination
+	}
+	return item
+}
+
+// reliability is Cronbach's alpha over the questions every attempt was given,
+// scoring each by the credit earned, along with the coefficient it amounts
+// to. Without partial credit every item variance is p(1-p) and alpha is
+// exactly KR-20.
+func reliability(questions []Question, attempts []Attempt) (*float64, string) {
+	if len(attempts) < 2 {
+		return nil, ""
+	}
+	var common []int64
+	for _, question := range questions {
+		everyAttempt := true
+		for _, attempt := range attempts {
+			if !slices.Contains(attempt.Questions, question.ID) {
+				everyAttempt = false
+				break
+			}
+		}
+		if everyAttempt {
+			common = append(common, question.ID)
+		}
+	}
+	k := len(common)
+	if k < 2 {
+		return nil, ""
+	}
+
+	method := KR20
+	totals := make([]float64, len(attempts))
+	itemVariance := 0.0
+	credits := make([]float64, len(attempts))
+	for _, id := range common {
+		for i, attempt := range attempts {
+			credits[i] = attempt.Answers[id].Credit
+			totals[i] += credits[i]
+			if credits[i] != 0 && credits[i] != 1 {
+				method = Alpha
+			}
+		}
+		_, stdDev := meanStdDev(credits)
+		itemVariance += stdDev * stdDev
+	}
+	_, stdDev := meanStdDev(totals)
+	variance := stdDev * stdDev
+	if variance == 0 {
+		return nil, ""
+	}
+
+	coefficient := float64(k) / float64(k-1) * (1 - itemVariance/variance)
+	return &coefficient, method
+}
+
+// meanCredit is the mean credit the attempts earned on the question, with
+// unanswered questions earning nothing
+func meanCredit(questionID int64, attempts []Attempt) float64 {
+	if len(attempts) == 0 {
+		return 0
+	}
+	total := 0.0
+	for _, attempt := range attempts {
+		total += attempt.Answers[questionID].Credit
+	}
+	return total / float64(len(attempts))
+}
+
+// meanStdDev returns the mean and population standard deviation of values
+func meanStdDev(values []float64) (float64, float64) {
+	total := 0.0
+	for _, v := range values {
+		total += v
+	}
+	mean := total / float64(len(values))
+
+	squares := 0.0
+	for _, v := range values {
+		squares += (v - mean) * (v - mean)
+	}
+	return mean, math.Sqrt(squares / float64(len(values)))
+}
+
+func compareFloat(a, b float64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,146 @@
+package analytics
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAnalyze(t *testing.T) {
+	questions := []Question{{ID: 1, Options: 3}, {ID: 2}}
+	both := []int64{1, 2}
+	attempts := []Attempt{
+		{Score: 100, Questions: both, Answers: map[int64]Answer{
+			1: {SelectedOptions: []int{0}, Credit: 1},
+			2: {Credit: 1},
+		}},
+		{Score: 50, Questions: both, Answers: map[int64]Answer{
+			1: {SelectedOptions: []int{0}, Credit: 1},
+			2: {},
+		}},
+		{Score: 0, Questions: both, Answers: map[int64]Answer{
+			1: {SelectedOptions: []int{1}},
+			2: {},
+		}},
+		{Score: 0, Questions: both, Answers: map[int64]Answer{}},
+	}
+
+	report := Analyze(questions, attempts)
+
+	assert.Equal(t, 4, report.Attempts)
+	assert.InDelta(t, 37.5, report.Scores.Mean, 1e-9)
+	assert.InDelta(t, 25.0, report.Scores.Median, 1e-9)
+	assert.InDelta(t, 41.4578, report.Scores.StdDev, 1e-4)
+
+	require.Len(t, report.Scores.Histogram, 10)
+	assert.Equal(t, Bin{From: 0, To: 10, Count: 2}, report.Scores.Histogram[0])
+	assert.Equal(t, 1, report.Scores.Histogram[5].Count)
+	assert.Equal(t, Bin{From: 90, To: 100, Count: 1}, report.Scores.Histogram[9])
+
+	require.Len(t, report.Items, 2)
+	first := report.Items[0]
+	assert.Equal(t, 4, first.Attempts)
+	assert.InDelta(t, 0.5, first.Difficulty, 1e-9)
+	require.NotNil(t, first.Discrimination)
+	assert.InDelta(t, 1.0, *first.Discrimination, 1e-9)
+	assert.Equal(t, []int{2, 1, 0}, first.OptionCounts)
+	assert.Equal(t, 1, first.Unanswered)
+
+	second := report.Items[1]
+	assert.InDelta(t, 0.25, second.Difficulty, 1e-9)
+	assert.Empty(t, second.OptionCounts)
+	assert.Equal(t, 1, second.Unanswered)
+
+	require.NotNil(t, report.Reliability)
+	assert.InDelta(t, 0.7273, *report.Reliability, 1e-4)
+	assert.Equal(t, KR20, report.ReliabilityMethod)
+}
+
+func TestAnalyzePartialCredit(t *testing.T) {
+	questions := []Question{{ID: 1, Options: 4}, {ID: 2}}
+	both := []int64{1, 2}
+	attempts := []Attempt{
+		{Score: 100, Questions: both, Answers: map[int64]Answer{
+			1: {SelectedOptions: []int{0, 2}, Credit: 1},
+			2: {Credit: 1},
+		}},
+		{Score: 75, Questions: both, Answers: map[int64]Answer{
+			1: {SelectedOptions: []int{0}, Credit: 0.5},
+			2: {Credit: 1},
+		}},
+		{Score: 25, Questions: both, Answers: map[int64]Answer{
+			1: {SelectedOptions: []int{0, 1}, Credit: 0.5},
+			2: {},
+		}},
+		{Score: 0, Questions: both, Answers: map[int64]Answer{
+			1: {SelectedOptions: []int{1}},
+			2: {},
+		}},
+	}
+
+	report := Analyze(questions, attempts)
+
+	// Partial credit counts towards the difficulty index
+	assert.InDelta(t, 0.5, report.Items[0].Difficulty, 1e-9)
+	require.NotNil(t, report.Items[0].Discrimination)
+	assert.InDelta(t, 1.0, *report.Items[0].Discrimination, 1e-9)
+
+	// Partial credit calls for coefficient alpha
+	require.NotNil(t, report.Reliability)
+	assert.InDelta(t, 0.8, *report.Reliability, 1e-9)
+	assert.Equal(t, Alpha, report.ReliabilityMethod)
+}
+
+func TestAnalyzeDrawnQuestions(t *testing.T) {
+	questions := []Question{{ID: 1, Options: 2}, {ID: 2, Options: 2}, {ID: 3, Options: 2}}
+	attempts := []Attempt{
+		{Score: 100, Questions: []int64{1, 2}, Answers: map[int64]Answer{
+			1: {SelectedOptions: []int{0}, Credit: 1},
+			2: {SelectedOptions: []int{0}, Credit: 1},
+		}},
+		{Score: 50, Questions: []int64{1, 3}, Answers: map[int64]Answer{
+			1: {SelectedOptions: []int{1}},
+			3: {SelectedOptions: []int{0}, Credit: 1},
+		}},
+	}
+
+	report := Analyze(questions, attempts)
+
+	// Questions only count the attempts they were given in
+	assert.Equal(t, 2, report.Items[0].Attempts)
+	assert.Equal(t, 1, report.Items[1].Attempts)
+	assert.Equal(t, []int{1, 0}, report.Items[1].OptionCounts)
+	assert.InDelta(t, 1.0, report.Items[2].Difficulty, 1e-9)
+
+	// Only question 1 was given in every attempt, too few for a reliability
+	assert.Nil(t, report.Reliability)
+	assert.Empty(t, report.ReliabilityMethod)
+}
+
+func TestAnalyzeWithoutEnoughAttempts(t *testing.T) {
+	questions := []Question{{ID: 1, Options: 2}, {ID: 2, Options: 2}}
+
+	tests := []struct {
+		name     string
+		attempts []Attempt
+	}{
+		{"no attempts", nil},
+		{"single attempt", []Attempt{{Score: 50, Questions: []int64{1, 2}, Answers: map[int64]Answer{
+			1: {SelectedOptions: []int{0}, Credit: 1},
+		}}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			report := Analyze(questions, tt.attempts)
+
+			assert.Len(t, report.Scores.Histogram, 10)
+			assert.Len(t, report.Items, 2)
+			for _, item := range report.Items {
+				assert.Nil(t, item.Discrimination)
+			}
+			assert.Nil(t, report.Reliability)
+		})
+	}
+}
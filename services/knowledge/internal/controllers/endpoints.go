@@ -2,10 +2,17 @@ package controllers
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"math/rand/v2"
+	"strings"
 
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
 	knowledgedb "github.com/ynoacamino/infra-sustainable-classrooms/services/knowledge/gen/database"
 	"github.com/ynoacamino/infra-sustainable-classrooms/services/knowledge/gen/knowledge"
+	"github.com/ynoacamino/infra-sustainable-classrooms/services/knowledge/internal/analytics"
+	"github.com/ynoacamino/infra-sustainable-classrooms/services/knowledge/internal/grading"
 	"github.com/ynoacamino/infra-sustainable-classrooms/services/profiles/gen/profiles"
 )
 
@@ -24,8 +31,15 @@ func (s *knowledgesvrc) CreateTest(ctx context.Context, payload *knowledge.Creat
 	}
 
 	err = s.testRepo.CreateTest(ctx, knowledgedb.CreateTestParams{
-		Title:     payload.Title,
-		CreatedBy: profile.UserID,
+		Title:            payload.Title,
+		CreatedBy:        profile.UserID,
+		DurationMinutes:  s.IntPtrToPgInt4(payload.DurationMinutes),
+		MaxAttempts:      int32(payload.MaxAttempts),
+		CooldownMinutes:  int32(payload.CooldownMinutes),
+		ScorePolicy:      string(payload.ScorePolicy),
+		RevealAnswers:    payload.RevealAnswers,
+		ShuffleQuestions: payload.ShuffleQuestions,
+		ShuffleOptions:   payload.ShuffleOptions,
 	})
 	if err != nil {
 		return nil, knowledge.InvalidInput("Failed to create test: " + err.Error())
@@ -56,12 +70,7 @@ func (s *knowledgesvrc) GetMyTests(ctx context.Context, payload *knowledge.GetMy
 
 	var response []*knowledge.Test
 	for _, test := range tests {
-		response = append(response, &knowledge.Test{
-			ID:        test.ID,
-			Title:     test.Title,
-			CreatedBy: test.CreatedBy,
-			CreatedAt: test.CreatedAt.Time.Unix(),
-		})
+		response = append(response, s.testToAPI(test))
 	}
 
 	return &knowledge.TestsResponse{
@@ -92,8 +101,15 @@ func (s *knowledgesvrc) UpdateTest(ctx context.Context, payload *knowledge.Updat
 	}
 
 	err = s.testRepo.UpdateTest(ctx, knowledgedb.UpdateTestParams{
-		ID:    payload.TestID,
-		Title: payload.Title,
+		ID:               payload.TestID,
+		Title:            payload.Title,
+		DurationMinutes:  s.IntPtrToPgInt4(payload.DurationMinutes),
+		MaxAttempts:      int32(payload.MaxAttempts),
+		CooldownMinutes:  int32(payload.CooldownMinutes),
+		ScorePolicy:      string(payload.ScorePolicy),
+		RevealAnswers:    payload.RevealAnswers,
+		ShuffleQuestions: payload.ShuffleQuestions,
+		ShuffleOptions:   payload.ShuffleOptions,
 	})
 	if err != nil {
 		return nil, knowledge.InvalidInput("Failed to update test: " + err.Error())
@@ -169,17 +185,7 @@ func (s *knowledgesvrc) GetTestQuestions(ctx context.Context, payload *knowledge
 
 	var response []*knowledge.Question
 	for _, question := range questions {
-		response = append(response, &knowledge.Question{
-			ID:            question.ID,
-			TestID:        question.TestID,
-			QuestionText:  question.QuestionText,
-			OptionA:       question.OptionA,
-			OptionB:       question.OptionB,
-			OptionC:       question.OptionC,
-			OptionD:       question.OptionD,
-			CorrectAnswer: int(question.CorrectAnswer),
-			QuestionOrder: int(question.QuestionOrder),
-		})
+		response = append(response, s.questionToAPI(question))
 	}
 
 	return &knowledge.QuestionsResponse{
@@ -209,6 +215,19 @@ func (s *knowledgesvrc) AddQuestion(ctx context.Context, payload *knowledge.AddQ
 		return nil, knowledge.Unauthorized("You can only add questions to your own tests")
 	}
 
+	question := grading.Question{
+		Type:            grading.Type(payload.QuestionType),
+		Options:         payload.Options,
+		CorrectOptions:  payload.CorrectOptions,
+		NumericAnswer:   payload.NumericAnswer,
+		Tolerance:       payload.Tolerance,
+		AcceptedAnswers: payload.AcceptedAnswers,
+	}
+	question.Normalize()
+	if err := question.Validate(); err != nil {
+		return nil, knowledge.InvalidInput("Invalid question: " + err.Error())
+	}
+
 	// Get current questions count to set order
 	questions, err := s.questionRepo.GetQuestionsByTestId(ctx, payload.TestID)
 	if err != nil {
@@ -216,14 +235,15 @@ func (s *knowledgesvrc) AddQuestion(ctx context.Context, payload *knowledge.AddQ
 	}
 
 	err = s.questionRepo.CreateQuestion(ctx, knowledgedb.CreateQuestionParams{
-		TestID:        payload.TestID,
-		QuestionText:  payload.QuestionText,
-		OptionA:       payload.OptionA,
-		OptionB:       payload.OptionB,
-		OptionC:       payload.OptionC,
-		OptionD:       payload.OptionD,
-		CorrectAnswer: int32(payload.CorrectAnswer),
-		QuestionOrder: int32(len(questions) + 1),
+		TestID:          payload.TestID,
+		QuestionText:    payload.QuestionText,
+		QuestionType:    string(question.Type),
+		Options:         question.Options,
+		CorrectOptions:  s.IntsToInt32s(question.CorrectOptions),
+		NumericAnswer:   s.Float64PtrToPgFloat8(question.NumericAnswer),
+		Tolerance:       question.Tolerance,
+		AcceptedAnswers: question.AcceptedAnswers,
+		QuestionOrder:   int32(len(questions) + 1),
 	})
 	if err != nil {
 		return nil, knowledge.InvalidInput("Failed to create question: " + err.Error())
@@ -263,18 +283,32 @@ func (s *knowledgesvrc) UpdateQuestion(ctx context.Context, payload *knowledge.U
 		return nil, knowledge.InvalidInput("Question not found: " + err.Error())
 	}
 
-	if question.TestID != payload.TestID {
+	if !question.TestID.Valid || question.TestID.Int64 != payload.TestID {
 		return nil, knowledge.InvalidInput("Question does not belong to this test")
 	}
 
+	updated := grading.Question{
+		Type:            grading.Type(payload.QuestionType),
+		Options:         payload.Options,
+		CorrectOptions:  payload.CorrectOptions,
+		NumericAnswer:   payload.NumericAnswer,
+		Tolerance:       payload.Tolerance,
+		AcceptedAnswers: payload.AcceptedAnswers,
+	}
+	updated.Normalize()
+	if err := updated.Validate(); err != nil {
+		return nil, knowledge.InvalidInput("Invalid question: " + err.Error())
+	}
+
 	err = s.questionRepo.UpdateQuestion(ctx, knowledgedb.UpdateQuestionParams{
-		ID:            payload.QuestionID,
-		QuestionText:  payload.QuestionText,
-		OptionA:       payload.OptionA,
-		OptionB:       payload.OptionB,
-		OptionC:       payload.OptionC,
-		OptionD:       payload.OptionD,
-		CorrectAnswer: int32(payload.CorrectAnswer),
+		ID:              payload.QuestionID,
+		QuestionText:    payload.QuestionText,
+		QuestionType:    string(updated.Type),
+		Options:         updated.Options,
+		CorrectOptions:  s.IntsToInt32s(updated.CorrectOptions),
+		NumericAnswer:   s.Float64PtrToPgFloat8(updated.NumericAnswer),
+		Tolerance:       updated.Tolerance,
+		AcceptedAnswers: updated.AcceptedAnswers,
 	})
 	if err != nil {
 		return nil, knowledge.InvalidInput("Failed to update question: " + err.Error())
@@ -314,11 +348,14 @@ func (s *knowledgesvrc) DeleteQuestion(ctx context.Context, payload *knowledge.D
 		return nil, knowledge.InvalidInput("Question not found: " + err.Error())
 	}
 
-	if question.TestID != payload.TestID {
+	if !question.TestID.Valid || question.TestID.Int64 != payload.TestID {
 		return nil, knowledge.InvalidInput("Question does not belong to this test")
 	}
 
 	err = s.questionRepo.DeleteQuestion(ctx, payload.QuestionID)
+	if isStillReferenced(err) {
+		return nil, knowledge.InvalidInput("This question was given in attempts, so it cannot be deleted")
+	}
 	if err != nil {
 		return nil, knowledge.InvalidInput("Failed to delete question: " + err.Error())
 	}
@@ -329,6 +366,636 @@ func (s *knowledgesvrc) DeleteQuestion(ctx context.Context, payload *knowledge.D
 	}, nil
 }
 
+// Question bank endpoints
+
+func (s *knowledgesvrc) CreateQuestionBank(ctx context.Context, payload *knowledge.CreateQuestionBankPayload) (res *knowledge.SimpleResponse, err error) {
+	profile, err := s.profilesServiceRepo.GetCompleteProfile(ctx, &profiles.GetCompleteProfilePayload{
+		SessionToken: payload.SessionToken,
+	})
+	if err != nil {
+		return nil, knowledge.Unauthorized("Failed to retrieve user profile: " + err.Error())
+	}
+
+	if profile.Role != "teacher" {
+		return nil, knowledge.Unauthorized("Only teachers can create question banks")
+	}
+
+	err = s.bankRepo.CreateQuestionBank(ctx, knowledgedb.CreateQuestionBankParams{
+		Title:     payload.Title,
+		CreatedBy: profile.UserID,
+	})
+	if err != nil {
+		return nil, knowledge.InvalidInput("Failed to create question bank: " + err.Error())
+	}
+
+	return &knowledge.SimpleResponse{
+		Success: true,
+		Message: "Question bank created successfully",
+	}, nil
+}
+
+func (s *knowledgesvrc) GetMyQuestionBanks(ctx context.Context, payload *knowledge.GetMyQuestionBanksPayload) (res *knowledge.QuestionBanksResponse, err error) {
+	profile, err := s.profilesServiceRepo.GetCompleteProfile(ctx, &profiles.GetCompleteProfilePayload{
+		SessionToken: payload.SessionToken,
+	})
+	if err != nil {
+		return nil, knowledge.Unauthorized("Failed to retrieve user profile: " + err.Error())
+	}
+
+	if profile.Role != "teacher" {
+		return nil, knowledge.Unauthorized("Only teachers can view question banks")
+	}
+
+	banks, err := s.bankRepo.GetMyQuestionBanks(ctx, profile.UserID)
+	if err != nil {
+		return nil, knowledge.InvalidInput("Failed to get question banks: " + err.Error())
+	}
+
+	var response []*knowledge.QuestionBank
+	for _, bank := range banks {
+		questionCount := int(bank.QuestionCount)
+		response = append(response, &knowledge.QuestionBank{
+			ID:            bank.ID,
+			Title:         bank.Title,
+			CreatedBy:     bank.CreatedBy,
+			CreatedAt:     bank.CreatedAt.Time.Unix(),
+			QuestionCount: &questionCount,
+		})
+	}
+
+	return &knowledge.QuestionBanksResponse{
+		Banks: response,
+	}, nil
+}
+
+func (s *knowledgesvrc) DeleteQuestionBank(ctx context.Context, payload *knowledge.DeleteQuestionBankPayload) (res *knowledge.SimpleResponse, err error) {
+	profile, err := s.profilesServiceRepo.GetCompleteProfile(ctx, &profiles.GetCompleteProfilePayload{
+		SessionToken: payload.SessionToken,
+	})
+	if err != nil {
+		return nil, knowledge.Unauthorized("Failed to retrieve user profile: " + err.Error())
+	}
+
+	if profile.Role != "teacher" {
+		return nil, knowledge.Unauthorized("Only teachers can delete question banks")
+	}
+
+	if _, err := s.getOwnQuestionBank(ctx, payload.BankID, profile.UserID); err != nil {
+		return nil, err
+	}
+
+	err = s.bankRepo.DeleteQuestionBank(ctx, payload.BankID)
+	if isStillReferenced(err) {
+		return nil, knowledge.InvalidInput("Questions of this bank were given in attempts, so it cannot be deleted")
+	}
+	if err != nil {
+		return nil, knowledge.InvalidInput("Failed to delete question bank: " + err.Error())
+	}
+
+	return &knowledge.SimpleResponse{
+		Success: true,
+		Message: "Question bank deleted successfully",
+	}, nil
+}
+
+func (s *knowledgesvrc) GetBankQuestions(ctx context.Context, payload *knowledge.GetBankQuestionsPayload) (res *knowledge.BankQuestionsResponse, err error) {
+	profile, err := s.profilesServiceRepo.GetCompleteProfile(ctx, &profiles.GetCompleteProfilePayload{
+		SessionToken: payload.SessionToken,
+	})
+	if err != nil {
+		return nil, knowledge.Unauthorized("Failed to retrieve user profile: " + err.Error())
+	}
+
+	if profile.Role != "teacher" {
+		return nil, knowledge.Unauthorized("Only teachers can view question banks")
+	}
+
+	if _, err := s.getOwnQuestionBank(ctx, payload.BankID, profile.UserID); err != nil {
+		return nil, err
+	}
+
+	questions, err := s.questionRepo.GetQuestionsByBankId(ctx, payload.BankID)
+	if err != nil {
+		return nil, knowledge.InvalidInput("Failed to get questions: " + err.Error())
+	}
+
+	var response []*knowledge.BankQuestion
+	for _, question := range questions {
+		response = append(response, s.bankQuestionToAPI(question))
+	}
+
+	return &knowledge.BankQuestionsResponse{
+		Questions: response,
+	}, nil
+}
+
+func (s *knowledgesvrc) AddBankQuestion(ctx context.Context, payload *knowledge.AddBankQuestionPayload) (res *knowledge.SimpleResponse, err error) {
+	profile, err := s.profilesServiceRepo.GetCompleteProfile(ctx, &profiles.GetCompleteProfilePayload{
+		SessionToken: payload.SessionToken,
+	})
+	if err != nil {
+		return nil, knowledge.Unauthorized("Failed to retrieve user profile: " + err.Error())
+	}
+
+	if profile.Role != "teacher" {
+		return nil, knowledge.Unauthorized("Only teachers can add questions")
+	}
+
+	if _, err := s.getOwnQuestionBank(ctx, payload.BankID, profile.UserID); err != nil {
+		return nil, err
+	}
+
+	question := grading.Question{
+		Type:            grading.Type(payload.QuestionType),
+		Options:         payload.Options,
+		CorrectOptions:  payload.CorrectOptions,
+		NumericAnswer:   payload.NumericAnswer,
+		Tolerance:       payload.Tolerance,
+		AcceptedAnswers: payload.AcceptedAnswers,
+	}
+	question.Normalize()
+	if err := question.Validate(); err != nil {
+		return nil, knowledge.InvalidInput("Invalid question: " + err.Error())
+	}
+
+	// Get current questions count to set order
+	questions, err := s.questionRepo.GetQuestionsByBankId(ctx, payload.BankID)
+	if err != nil {
+		return nil, knowledge.InvalidInput("Failed to get questions count: " + err.Error())
+	}
+
+	err = s.questionRepo.CreateBankQuestion(ctx, knowledgedb.CreateBankQuestionParams{
+		BankID:          payload.BankID,
+		QuestionText:    payload.QuestionText,
+		QuestionType:    string(question.Type),
+		Options:         question.Options,
+		CorrectOptions:  s.IntsToInt32s(question.CorrectOptions),
+		NumericAnswer:   s.Float64PtrToPgFloat8(question.NumericAnswer),
+		Tolerance:       question.Tolerance,
+		AcceptedAnswers: question.AcceptedAnswers,
+		QuestionOrder:   int32(len(questions) + 1),
+		Topic:           topicText(payload.Topic),
+		Difficulty:      string(payload.Difficulty),
+	})
+	if err != nil {
+		return nil, knowledge.InvalidInput("Failed to create question: " + err.Error())
+	}
+
+	return &knowledge.SimpleResponse{
+		Success: true,
+		Message: "Question added successfully",
+	}, nil
+}
+
+func (s *knowledgesvrc) UpdateBankQuestion(ctx context.Context, payload *knowledge.UpdateBankQuestionPayload) (res *knowledge.SimpleResponse, err error) {
+	profile, err := s.profilesServiceRepo.GetCompleteProfile(ctx, &profiles.GetCompleteProfilePayload{
+		SessionToken: payload.SessionToken,
+	})
+	if err != nil {
+		return nil, knowledge.Unauthorized("Failed to retrieve user profile: " + err.Error())
+	}
+
+	if profile.Role != "teacher" {
+		return nil, knowledge.Unauthorized("Only teachers can update questions")
+	}
+
+	if _, err := s.getOwnQuestionBank(ctx, payload.BankID, profile.UserID); err != nil {
+		return nil, err
+	}
+
+	question, err := s.questionRepo.GetQuestionById(ctx, payload.QuestionID)
+	if err != nil || !question.BankID.Valid || question.BankID.Int64 != payload.BankID {
+		return nil, knowledge.QuestionNotFound("Question not found in the specified question bank")
+	}
+
+	updated := grading.Question{
+		Type:            grading.Type(payload.QuestionType),
+		Options:         payload.Options,
+		CorrectOptions:  payload.CorrectOptions,
+		NumericAnswer:   payload.NumericAnswer,
+		Tolerance:       payload.Tolerance,
+		AcceptedAnswers: payload.AcceptedAnswers,
+	}
+	updated.Normalize()
+	if err := updated.Validate(); err != nil {
+		return nil, knowledge.InvalidInput("Invalid question: " + err.Error())
+	}
+
+	err = s.questionRepo.UpdateBankQuestion(ctx, knowledgedb.UpdateBankQuestionParams{
+		ID:              payload.QuestionID,
+		QuestionText:    payload.QuestionText,
+		QuestionType:    string(updated.Type),
+		Options:         updated.Options,
+		CorrectOptions:  s.IntsToInt32s(updated.CorrectOptions),
+		NumericAnswer:   s.Float64PtrToPgFloat8(updated.NumericAnswer),
+		Tolerance:       updated.Tolerance,
+		AcceptedAnswers: updated.AcceptedAnswers,
+		Topic:           topicText(payload.Topic),
+		Difficulty:      string(payload.Difficulty),
+	})
+	if err != nil {
+		return nil, knowledge.InvalidInput("Failed to update question: " + err.Error())
+	}
+
+	return &knowledge.SimpleResponse{
+		Success: true,
+		Message: "Question updated successfully",
+	}, nil
+}
+
+func (s *knowledgesvrc) DeleteBankQuestion(ctx context.Context, payload *knowledge.DeleteBankQuestionPayload) (res *knowledge.SimpleResponse, err error) {
+	profile, err := s.profilesServiceRepo.GetCompleteProfile(ctx, &profiles.GetCompleteProfilePayload{
+		SessionToken: payload.SessionToken,
+	})
+	if err != nil {
+		return nil, knowledge.Unauthorized("Failed to retrieve user profile: " + err.Error())
+	}
+
+	if profile.Role != "teacher" {
+		return nil, knowledge.Unauthorized("Only teachers can delete questions")
+	}
+
+	if _, err := s.getOwnQuestionBank(ctx, payload.BankID, profile.UserID); err != nil {
+		return nil, err
+	}
+
+	question, err := s.questionRepo.GetQuestionById(ctx, payload.QuestionID)
+	if err != nil || !question.BankID.Valid || question.BankID.Int64 != payload.BankID {
+		return nil, knowledge.QuestionNotFound("Question not found in the specified question bank")
+	}
+
+	err = s.questionRepo.DeleteQuestion(ctx, payload.QuestionID)
+	if isStillReferenced(err) {
+		return nil, knowledge.InvalidInput("This question was given in attempts, so it cannot be deleted")
+	}
+	if err != nil {
+		return nil, knowledge.InvalidInput("Failed to delete question: " + err.Error())
+	}
+
+	return &knowledge.SimpleResponse{
+		Success: true,
+		Message: "Question deleted successfully",
+	}, nil
+}
+
+func (s *knowledgesvrc) AddDrawRule(ctx context.Context, payload *knowledge.AddDrawRulePayload) (res *knowledge.SimpleResponse, err error) {
+	profile, err := s.profilesServiceRepo.GetCompleteProfile(ctx, &profiles.GetCompleteProfilePayload{
+		SessionToken: payload.SessionToken,
+	})
+	if err != nil {
+		return nil, knowledge.Unauthorized("Failed to retrieve user profile: " + err.Error())
+	}
+
+	if profile.Role != "teacher" {
+		return nil, knowledge.Unauthorized("Only teachers can add draw rules")
+	}
+
+	// Check if test exists and belongs to user
+	test, err := s.testRepo.GetTestById(ctx, payload.TestID)
+	if err != nil {
+		return nil, knowledge.TestNotFound("Test not found")
+	}
+
+	if test.CreatedBy != profile.UserID {
+		return nil, knowledge.Unauthorized("You can only add draw rules to your own tests")
+	}
+
+	if _, err := s.getOwnQuestionBank(ctx, payload.BankID, profile.UserID); err != nil {
+		return nil, err
+	}
+
+	// An empty topic or no difficulty draws from any
+	var topic, difficulty pgtype.Text
+	if t := topicText(payload.Topic); t != "" {
+		topic = pgtype.Text{String: t, Valid: true}
+	}
+	if payload.Difficulty != nil {
+		difficulty = pgtype.Text{String: string(*payload.Difficulty), Valid: true}
+	}
+
+	// The bank must have enough questions for the rule, though every attempt
+	// checks again as the bank changes
+	available, err := s.questionRepo.CountBankQuestions(ctx, knowledgedb.CountBankQuestionsParams{
+		BankID:     payload.BankID,
+		Topic:      topic,
+		Difficulty: difficulty,
+	})
+	if err != nil {
+		return nil, knowledge.InvalidInput("Failed to count bank questions: " + err.Error())
+	}
+	if available < int64(payload.QuestionCount) {
+		return nil, knowledge.InvalidInput(fmt.Sprintf("The question bank only has %d matching questions", available))
+	}
+
+	err = s.testRepo.CreateDrawRule(ctx, knowledgedb.CreateDrawRuleParams{
+		TestID:        payload.TestID,
+		BankID:        payload.BankID,
+		Topic:         topic,
+		Difficulty:    difficulty,
+		QuestionCount: int32(payload.QuestionCount),
+	})
+	if err != nil {
+		return nil, knowledge.InvalidInput("Failed to create draw rule: " + err.Error())
+	}
+
+	return &knowledge.SimpleResponse{
+		Success: true,
+		Message: "Draw rule added successfully",
+	}, nil
+}
+
+func (s *knowledgesvrc) GetDrawRules(ctx context.Context, payload *knowledge.GetDrawRulesPayload) (res *knowledge.DrawRulesResponse, err error) {
+	profile, err := s.profilesServiceRepo.GetCompleteProfile(ctx, &profiles.GetCompleteProfilePayload{
+		SessionToken: payload.SessionToken,
+	})
+	if err != nil {
+		return nil, knowledge.Unauthorized("Failed to retrieve user profile: " + err.Error())
+	}
+
+	if profile.Role != "teacher" {
+		return nil, knowledge.Unauthorized("Only teachers can view draw rules")
+	}
+
+	// Check if test exists and belongs to user
+	test, err := s.testRepo.GetTestById(ctx, payload.TestID)
+	if err != nil {
+		return nil, knowledge.TestNotFound("Test not found")
+	}
+
+	if test.CreatedBy != profile.UserID {
+		return nil, knowledge.Unauthorized("You can only view draw rules of your own tests")
+	}
+
+	rules, err := s.testRepo.GetDrawRulesByTestId(ctx, payload.TestID)
+	if err != nil {
+		return nil, knowledge.InvalidInput("Failed to get draw rules: " + err.Error())
+	}
+
+	var response []*knowledge.DrawRule
+	for _, rule := range rules {
+		response = append(response, s.drawRuleToAPI(rule))
+	}
+
+	return &knowledge.DrawRulesResponse{
+		Rules: response,
+	}, nil
+}
+
+func (s *knowledgesvrc) DeleteDrawRule(ctx context.Context, payload *knowledge.DeleteDrawRulePayload) (res *knowledge.SimpleResponse, err error) {
+	profile, err := s.profilesServiceRepo.GetCompleteProfile(ctx, &profiles.GetCompleteProfilePayload{
+		SessionToken: payload.SessionToken,
+	})
+	if err != nil {
+		return nil, knowledge.Unauthorized("Failed to retrieve user profile: " + err.Error())
+	}
+
+	if profile.Role != "teacher" {
+		return nil, knowledge.Unauthorized("Only teachers can delete draw rules")
+	}
+
+	// Check if test exists and belongs to user
+	test, err := s.testRepo.GetTestById(ctx, payload.TestID)
+	if err != nil {
+		return nil, knowledge.TestNotFound("Test not found")
+	}
+
+	if test.CreatedBy != profile.UserID {
+		return nil, knowledge.Unauthorized("You can only delete draw rules of your own tests")
+	}
+
+	rule, err := s.testRepo.GetDrawRuleById(ctx, payload.RuleID)
+	if err != nil {
+		return nil, knowledge.InvalidInput("Draw rule not found: " + err.Error())
+	}
+
+	if rule.TestID != payload.TestID {
+		return nil, knowledge.InvalidInput("Draw rule does not belong to this test")
+	}
+
+	err = s.testRepo.DeleteDrawRule(ctx, payload.RuleID)
+	if err != nil {
+		return nil, knowledge.InvalidInput("Failed to delete draw rule: " + err.Error())
+	}
+
+	return &knowledge.SimpleResponse{
+		Success: true,
+		Message: "Draw rule deleted successfully",
+	}, nil
+}
+
+// Analytics endpoints
+
+func (s *knowledgesvrc) GetTestAnalytics(ctx context.Context, payload *knowledge.GetTestAnalyticsPayload) (res *knowledge.TestAnalytics, err error) {
+	profile, err := s.profilesServiceRepo.GetCompleteProfile(ctx, &profiles.GetCompleteProfilePayload{
+		SessionToken: payload.SessionToken,
+	})
+	if err != nil {
+		return nil, knowledge.Unauthorized("Failed to retrieve user profile: " + err.Error())
+	}
+
+	if profile.Role != "teacher" {
+		return nil, knowledge.Unauthorized("Only teachers can view test analytics")
+	}
+
+	// Check if test exists and belongs to user
+	test, err := s.testRepo.GetTestById(ctx, payload.TestID)
+	if err != nil {
+		return nil, knowledge.TestNotFound("Test not found")
+	}
+
+	if test.CreatedBy != profile.UserID {
+		return nil, knowledge.Unauthorized("You can only view analytics of your own tests")
+	}
+
+	// Only the first attempt of every student is analyzed, so students who
+	// retook the test are not counted twice
+	submissions, err := s.submissionRepo.GetFirstSubmissions(ctx, payload.TestID)
+	if err != nil {
+		return nil, knowledge.InternalError("Failed to get submissions")
+	}
+
+	questions, err := s.questionRepo.GetQuestionsByTestId(ctx, payload.TestID)
+	if err != nil {
+		return nil, knowledge.InternalError("Failed to get questions")
+	}
+
+	attemptQuestions, err := s.submissionRepo.GetAttemptQuestionsByTest(ctx, payload.TestID)
+	if err != nil {
+		return nil, knowledge.InternalError("Failed to get attempt questions")
+	}
+
+	answers, err := s.answerRepo.GetAnswersByTest(ctx, payload.TestID)
+	if err != nil {
+		return nil, knowledge.InternalError("Failed to get answers")
+	}
+
+	attempts := make(map[int64]*analytics.Attempt, len(submissions))
+	for _, submission := range submissions {
+		attempts[submission.ID] = &analytics.Attempt{
+			Score:   s.PgNumericToFloat64(submission.Score),
+			Answers: make(map[int64]analytics.Answer),
+		}
+	}
+
+	// Attempts drawing their questions were given those, the rest were given
+	// the questions of the test
+	known := make(map[int64]bool, len(questions))
+	for _, question := range questions {
+		known[question.ID] = true
+	}
+	var drawnIDs []int64
+	for _, given := range attemptQuestions {
+		attempt, ok := attempts[given.SubmissionID]
+		if !ok {
+			continue
+		}
+		attempt.Questions = append(attempt.Questions, given.QuestionID)
+		if !known[given.QuestionID] {
+			known[given.QuestionID] = true
+			drawnIDs = append(drawnIDs, given.QuestionID)
+		}
+	}
+	for _, attempt := range attempts {
+		if attempt.Questions == nil {
+			for _, question := range questions {
+				attempt.Questions = append(attempt.Questions, question.ID)
+			}
+		}
+	}
+
+	if len(drawnIDs) > 0 {
+		drawn, err := s.questionRepo.GetQuestionsByIds(ctx, drawnIDs)
+		if err != nil {
+			return nil, knowledge.InternalError("Failed to get questions")
+		}
+		questions = append(questions, drawn...)
+	}
+
+	for _, answer := range answers {
+		attempt, ok := attempts[answer.SubmissionID]
+		if !ok || !answered(answer) {
+			continue
+		}
+		attempt.Answers[answer.QuestionID] = analytics.Answer{
+			SelectedOptions: s.Int32sToInts(answer.SelectedOptions),
+			Credit:          answer.Credit,
+		}
+	}
+
+	analyzed := make([]analytics.Question, len(questions))
+	for i, question := range questions {
+		analyzed[i] = analyticsQuestion(question)
+	}
+	analyzedAttempts := make([]analytics.Attempt, 0, len(submissions))
+	for _, submission := range submissions {
+		analyzedAttempts = append(analyzedAttempts, *attempts[submission.ID])
+	}
+
+	report := analytics.Analyze(analyzed, analyzedAttempts)
+	return s.testAnalyticsToAPI(payload.TestID, questions, report), nil
+}
+
+// Gradebook endpoints
+
+func (s *knowledgesvrc) GetTestGradebook(ctx context.Context, payload *knowledge.GetTestGradebookPayload) (res *knowledge.TestGradebook, err error) {
+	profile, err := s.profilesServiceRepo.GetCompleteProfile(ctx, &profiles.GetCompleteProfilePayload{
+		SessionToken: payload.SessionToken,
+	})
+	if err != nil {
+		return nil, knowledge.Unauthorized("Failed to retrieve user profile: " + err.Error())
+	}
+
+	if profile.Role != "teacher" {
+		return nil, knowledge.Unauthorized("Only teachers can view the gradebook")
+	}
+
+	// Check if test exists and belongs to user
+	test, err := s.testRepo.GetTestById(ctx, payload.TestID)
+	if err != nil {
+		return nil, knowledge.TestNotFound("Test not found")
+	}
+
+	if test.CreatedBy != profile.UserID {
+		return nil, knowledge.Unauthorized("You can only view the gradebook of your own tests")
+	}
+
+	submissions, err := s.submissionRepo.GetTestSubmissions(ctx, payload.TestID)
+	if err != nil {
+		return nil, knowledge.InternalError("Failed to get submissions")
+	}
+
+	var userIDs []int64
+	for _, submission := range submissions {
+		userIDs = append(userIDs, submission.UserID)
+	}
+	names := s.studentNames(ctx, userIDs)
+
+	student := ""
+	if payload.Student != nil {
+		student = strings.ToLower(strings.TrimSpace(*payload.Student))
+	}
+
+	response := []*knowledge.StudentSubmission{}
+	for _, submission := range submissions {
+		if payload.Status != nil && submission.Status != string(*payload.Status) {
+			continue
+		}
+		name := names[submission.UserID]
+		if !strings.Contains(strings.ToLower(name), student) {
+			continue
+		}
+		response = append(response, &knowledge.StudentSubmission{
+			UserID:      submission.UserID,
+			StudentName: name,
+			Submission:  s.submissionToAPI(submission, test.Title),
+		})
+	}
+	sortStudentSubmissions(response, payload.SortBy, payload.SortOrder)
+
+	return &knowledge.TestGradebook{
+		Test:        s.testToAPI(test),
+		Submissions: response,
+	}, nil
+}
+
+func (s *knowledgesvrc) GetStudentHistory(ctx context.Context, payload *knowledge.GetStudentHistoryPayload) (res *knowledge.StudentHistory, err error) {
+	profile, err := s.profilesServiceRepo.GetCompleteProfile(ctx, &profiles.GetCompleteProfilePayload{
+		SessionToken: payload.SessionToken,
+	})
+	if err != nil {
+		return nil, knowledge.Unauthorized("Failed to retrieve user profile: " + err.Error())
+	}
+
+	if profile.Role != "teacher" {
+		return nil, knowledge.Unauthorized("Only teachers can view student history")
+	}
+
+	// Only the tests of the teacher are included
+	rows, err := s.submissionRepo.GetStudentSubmissionsByTeacher(ctx, knowledgedb.GetStudentSubmissionsByTeacherParams{
+		UserID:    payload.UserID,
+		CreatedBy: profile.UserID,
+	})
+	if err != nil {
+		return nil, knowledge.InternalError("Failed to get submissions")
+	}
+	if len(rows) == 0 {
+		return nil, knowledge.SubmissionNotFound("This student has no submissions at your tests")
+	}
+
+	submissions := make([]knowledgedb.GetUserSubmissionsRow, len(rows))
+	for i, row := range rows {
+		submissions[i] = knowledgedb.GetUserSubmissionsRow(row)
+	}
+	response, testScores := s.submissionsWithScores(submissions)
+
+	return &knowledge.StudentHistory{
+		UserID:      payload.UserID,
+		StudentName: s.studentNames(ctx, []int64{payload.UserID})[payload.UserID],
+		Submissions: response,
+		TestScores:  testScores,
+	}, nil
+}
+
 // Student endpoints
 
 func (s *knowledgesvrc) GetAvailableTests(ctx context.Context, payload *knowledge.GetAvailableTestsPayload) (res *knowledge.TestsResponse, err error) {
@@ -351,13 +1018,21 @@ func (s *knowledgesvrc) GetAvailableTests(ctx context.Context, payload *knowledg
 	var response []*knowledge.Test
 	for _, test := range tests {
 		questionCount := int(test.QuestionCount)
-		response = append(response, &knowledge.Test{
-			ID:            test.ID,
-			Title:         test.Title,
-			CreatedBy:     test.CreatedBy,
-			CreatedAt:     test.CreatedAt.Time.Unix(),
-			QuestionCount: &questionCount,
+		available := s.testToAPI(knowledgedb.Test{
+			ID:               test.ID,
+			Title:            test.Title,
+			CreatedBy:        test.CreatedBy,
+			DurationMinutes:  test.DurationMinutes,
+			MaxAttempts:      test.MaxAttempts,
+			CooldownMinutes:  test.CooldownMinutes,
+			ScorePolicy:      test.ScorePolicy,
+			RevealAnswers:    test.RevealAnswers,
+			ShuffleQuestions: test.ShuffleQuestions,
+			ShuffleOptions:   test.ShuffleOptions,
+			CreatedAt:        test.CreatedAt,
 		})
+		available.QuestionCount = &questionCount
+		response = append(response, available)
 	}
 
 	return &knowledge.TestsResponse{
@@ -365,7 +1040,7 @@ func (s *knowledgesvrc) GetAvailableTests(ctx context.Context, payload *knowledg
 	}, nil
 }
 
-func (s *knowledgesvrc) GetTestForm(ctx context.Context, payload *knowledge.GetTestFormPayload) (res *knowledge.FormResponse, err error) {
+func (s *knowledgesvrc) StartTest(ctx context.Context, payload *knowledge.StartTestPayload) (res *knowledge.TestAttempt, err error) {
 	profile, err := s.profilesServiceRepo.GetCompleteProfile(ctx, &profiles.GetCompleteProfilePayload{
 		SessionToken: payload.SessionToken,
 	})
@@ -377,17 +1052,80 @@ func (s *knowledgesvrc) GetTestForm(ctx context.Context, payload *knowledge.GetT
 		return nil, knowledge.Unauthorized("Only students can take tests")
 	}
 
-	// Check if user has already completed this test
-	completed, err := s.submissionRepo.CheckUserCompletedTest(ctx, knowledgedb.CheckUserCompletedTestParams{
-		UserID: profile.UserID,
-		TestID: payload.TestID,
+	test, err := s.testRepo.GetTestById(ctx, payload.TestID)
+	if err != nil {
+		return nil, knowledge.TestNotFound("Test not found")
+	}
+
+	// Resume the attempt in progress, keeping its original start time. One
+	// that expired is closed and a new attempt is started instead.
+	attempt, err := s.getActiveAttempt(ctx, profile.UserID, payload.TestID, false)
+	if err != nil {
+		return nil, err
+	}
+	if attempt != nil {
+		return s.attemptToAPI(*attempt, test), nil
+	}
+
+	if err := s.checkCanStartAttempt(ctx, profile.UserID, test); err != nil {
+		return nil, err
+	}
+
+	// The questions of the attempt, including those drawn from question banks,
+	// are kept for the whole attempt
+	questions, err := s.drawQuestions(ctx, test)
+	if err != nil {
+		return nil, err
+	}
+	if len(questions) == 0 {
+		return nil, knowledge.InvalidInput("Test has no questions")
+	}
+
+	// Every attempt gets its own order, chosen once and saved with it
+	var seed int64
+	if test.ShuffleQuestions || test.ShuffleOptions {
+		seed = rand.Int64()
+	}
+	shown := s.showQuestions(questions, grading.Shuffle{
+		Questions: test.ShuffleQuestions,
+		Options:   test.ShuffleOptions,
+		Seed:      seed,
+	})
+	questionIDs := make([]int64, len(shown))
+	optionOrders := make([]string, len(shown))
+	for i, question := range shown {
+		questionIDs[i] = question.question.ID
+		optionOrders[i] = question.optionOrderText()
+	}
+
+	// The attempt and its questions are saved together
+	started, err := s.submissionRepo.StartSubmission(ctx, knowledgedb.StartSubmissionParams{
+		TestID:           payload.TestID,
+		UserID:           profile.UserID,
+		DurationMinutes:  test.DurationMinutes,
+		ShuffleQuestions: test.ShuffleQuestions,
+		ShuffleOptions:   test.ShuffleOptions,
+		ShuffleSeed:      seed,
+		QuestionIds:      questionIDs,
+		OptionOrders:     optionOrders,
 	})
 	if err != nil {
-		return nil, knowledge.InvalidInput("Failed to check completion status: " + err.Error())
+		return nil, knowledge.InvalidInput("Failed to start test: " + err.Error())
 	}
 
-	if completed {
-		return nil, knowledge.InvalidInput("You have already completed this test")
+	return s.attemptToAPI(knowledgedb.TestSubmission(started), test), nil
+}
+
+func (s *knowledgesvrc) GetTestForm(ctx context.Context, payload *knowledge.GetTestFormPayload) (res *knowledge.FormResponse, err error) {
+	profile, err := s.profilesServiceRepo.GetCompleteProfile(ctx, &profiles.GetCompleteProfilePayload{
+		SessionToken: payload.SessionToken,
+	})
+	if err != nil {
+		return nil, knowledge.Unauthorized("Failed to retrieve user profile: " + err.Error())
+	}
+
+	if profile.Role != "student" {
+		return nil, knowledge.Unauthorized("Only students can take tests")
 	}
 
 	// Get test
@@ -396,32 +1134,44 @@ func (s *knowledgesvrc) GetTestForm(ctx context.Context, payload *knowledge.GetT
 		return nil, knowledge.InvalidInput("Test not found: " + err.Error())
 	}
 
+	// The questions of a timed, shuffled or drawn test are only shown in an
+	// attempt, while other tests can be opened whenever a new attempt is allowed
+	attempt, err := s.getActiveAttempt(ctx, profile.UserID, payload.TestID, true)
+	if err != nil {
+		return nil, err
+	}
+	if attempt == nil {
+		required, err := s.startRequired(ctx, test)
+		if err != nil {
+			return nil, err
+		}
+		if required {
+			return nil, knowledge.InvalidInput("Start the test before opening it")
+		}
+		if err := s.checkCanStartAttempt(ctx, profile.UserID, test); err != nil {
+			return nil, err
+		}
+	}
+
 	// Get questions (without correct answers)
-	questions, err := s.questionRepo.GetQuestionsByTestId(ctx, payload.TestID)
+	questions, err := s.attemptQuestions(ctx, test, attempt)
 	if err != nil {
-		return nil, knowledge.InvalidInput("Failed to get questions: " + err.Error())
+		return nil, err
 	}
 
 	var questionForms []*knowledge.QuestionForm
-	for _, question := range questions {
+	for _, shown := range questions {
 		questionForms = append(questionForms, &knowledge.QuestionForm{
-			ID:            question.ID,
-			QuestionText:  question.QuestionText,
-			OptionA:       question.OptionA,
-			OptionB:       question.OptionB,
-			OptionC:       question.OptionC,
-			OptionD:       question.OptionD,
-			QuestionOrder: int(question.QuestionOrder),
+			ID:            shown.question.ID,
+			QuestionText:  shown.question.QuestionText,
+			QuestionType:  knowledge.QuestionType(shown.question.QuestionType),
+			Options:       shown.options(),
+			QuestionOrder: shown.position,
 		})
 	}
 
 	return &knowledge.FormResponse{
-		Test: &knowledge.Test{
-			ID:        test.ID,
-			Title:     test.Title,
-			CreatedBy: test.CreatedBy,
-			CreatedAt: test.CreatedAt.Time.Unix(),
-		},
+		Test:      s.testToAPI(test),
 		Questions: questionForms,
 	}, nil
 }
@@ -438,68 +1188,113 @@ func (s *knowledgesvrc) SubmitTest(ctx context.Context, payload *knowledge.Submi
 		return nil, knowledge.Unauthorized("Only students can submit tests")
 	}
 
-	// Check if user has already completed this test
-	completed, err := s.submissionRepo.CheckUserCompletedTest(ctx, knowledgedb.CheckUserCompletedTestParams{
-		UserID: profile.UserID,
-		TestID: payload.TestID,
-	})
+	test, err := s.testRepo.GetTestById(ctx, payload.TestID)
 	if err != nil {
-		return nil, knowledge.InvalidInput("Failed to check completion status: " + err.Error())
+		return nil, knowledge.InvalidInput("Test not found: " + err.Error())
 	}
 
-	if completed {
-		return nil, knowledge.InvalidInput("You have already completed this test")
+	// Answers to timed tests are only accepted within the time limit of an
+	// attempt started earlier, and answers to shuffled or drawn tests only make
+	// sense for the questions of one, while other tests can be submitted
+	// directly as a new attempt
+	attempt, err := s.getActiveAttempt(ctx, profile.UserID, payload.TestID, true)
+	if err != nil {
+		return nil, err
+	}
+	if attempt == nil {
+		required, err := s.startRequired(ctx, test)
+		if err != nil {
+			return nil, err
+		}
+		if required {
+			return nil, knowledge.InvalidInput("Start the test before submitting it")
+		}
+		if err := s.checkCanStartAttempt(ctx, profile.UserID, test); err != nil {
+			return nil, err
+		}
 	}
 
-	// Get all questions for this test
-	questions, err := s.questionRepo.GetQuestionsByTestId(ctx, payload.TestID)
+	// Get all questions of this attempt
+	questions, err := s.attemptQuestions(ctx, test, attempt)
 	if err != nil {
-		return nil, knowledge.InvalidInput("Failed to get questions: " + err.Error())
+		return nil, err
 	}
 
-	// Create a map of question ID to question for easy lookup
-	questionMap := make(map[int64]knowledgedb.Question)
-	for _, question := range questions {
-		questionMap[question.ID] = question
+	if len(questions) == 0 {
+		return nil, knowledge.InvalidInput("Test has no questions")
 	}
 
-	// Calculate score
-	correctCount := 0
-	totalQuestions := len(questions)
+	// Create a map of question ID to question, as shown in the attempt, for
+	// easy lookup
+	questionMap := make(map[int64]grading.Question)
+	shownMap := make(map[int64]shownQuestion)
+	for _, shown := range questions {
+		questionMap[shown.question.ID] = s.gradingQuestion(shown.question)
+		shownMap[shown.question.ID] = shown
+	}
 
-	// Validate all answers and calculate score
-	for _, answer := range payload.Answers {
+	// Validate all answers and calculate score, where every question is worth
+	// the same and unanswered questions earn nothing. Options are selected by
+	// the position they were shown at and graded by their canonical index.
+	graded := make([]grading.Answer, len(payload.Answers))
+	credits := make([]float64, len(payload.Answers))
+	answered := make(map[int64]bool, len(payload.Answers))
+	totalCredit := 0.0
+	for i, answer := range payload.Answers {
 		question, exists := questionMap[answer.QuestionID]
 		if !exists {
 			return nil, knowledge.InvalidInput(fmt.Sprintf("Question ID %d not found in this test", answer.QuestionID))
 		}
+		if answered[answer.QuestionID] {
+			return nil, knowledge.InvalidInput(fmt.Sprintf("Question ID %d answered more than once", answer.QuestionID))
+		}
+		answered[answer.QuestionID] = true
 
-		if answer.SelectedAnswer == int(question.CorrectAnswer) {
-			correctCount++
+		graded[i] = gradingAnswer(answer)
+		graded[i].SelectedOptions = grading.ToCanonical(shownMap[answer.QuestionID].optionOrder, graded[i].SelectedOptions)
+		if err := question.ValidateAnswer(graded[i]); err != nil {
+			return nil, knowledge.InvalidInput(fmt.Sprintf("Invalid answer to question ID %d: %s", answer.QuestionID, err.Error()))
 		}
+		credits[i] = question.Grade(graded[i])
+		totalCredit += credits[i]
 	}
 
-	score := float64(correctCount) / float64(totalQuestions) * 100
+	score := totalCredit / float64(len(questions)) * 100
 
-	submission, err := s.submissionRepo.CreateSubmission(ctx, knowledgedb.CreateSubmissionParams{
-		TestID: payload.TestID,
-		UserID: profile.UserID,
-		Score:  s.Float64ToPgNumeric(score),
-	})
+	var submission knowledgedb.TestSubmission
+	if attempt != nil {
+		submission, err = s.submissionRepo.FinishSubmission(ctx, knowledgedb.FinishSubmissionParams{
+			ID:    attempt.ID,
+			Score: s.Float64ToPgNumeric(score),
+		})
+		if errors.Is(err, pgx.ErrNoRows) {
+			// Another request submitted or closed the attempt in the meantime
+			return nil, knowledge.TestAlreadySubmitted("Test already submitted")
+		}
+	} else {
+		submission, err = s.submissionRepo.CreateSubmission(ctx, knowledgedb.CreateSubmissionParams{
+			TestID: payload.TestID,
+			UserID: profile.UserID,
+			Score:  s.Float64ToPgNumeric(score),
+		})
+	}
 	if err != nil {
 		return nil, knowledge.InvalidInput("Failed to create submission: " + err.Error())
 	}
 
 	// Create answer submissions
-	for _, answer := range payload.Answers {
-		question := questionMap[answer.QuestionID]
-		isCorrect := answer.SelectedAnswer == int(question.CorrectAnswer)
-
+	for i, answer := range payload.Answers {
+		shown := shownMap[answer.QuestionID]
 		err = s.answerRepo.CreateAnswerSubmission(ctx, knowledgedb.CreateAnswerSubmissionParams{
-			SubmissionID:   submission.ID,
-			QuestionID:     answer.QuestionID,
-			SelectedAnswer: int32(answer.SelectedAnswer),
-			IsCorrect:      isCorrect,
+			SubmissionID:    submission.ID,
+			QuestionID:      answer.QuestionID,
+			SelectedOptions: s.IntsToInt32s(graded[i].SelectedOptions),
+			NumericAnswer:   s.Float64PtrToPgFloat8(answer.NumericAnswer),
+			TextAnswer:      s.StringPtrToPgText(answer.TextAnswer),
+			Credit:          credits[i],
+			IsCorrect:       credits[i] == 1,
+			Position:        int32(shown.position),
+			OptionOrder:     s.IntsToInt32s(shown.optionOrder),
 		})
 		if err != nil {
 			return nil, knowledge.InvalidInput("Failed to create answer submission: " + err.Error())
@@ -507,10 +1302,11 @@ func (s *knowledgesvrc) SubmitTest(ctx context.Context, payload *knowledge.Submi
 	}
 
 	return &knowledge.SubmitResponse{
-		Success:      true,
-		Message:      "Test submitted successfully",
-		SubmissionID: submission.ID,
-		Score:        score,
+		Success:       true,
+		Message:       "Test submitted successfully",
+		SubmissionID:  submission.ID,
+		Score:         score,
+		AttemptNumber: int(submission.AttemptNumber),
 	}, nil
 }
 
@@ -531,28 +1327,11 @@ func (s *knowledgesvrc) GetMySubmissions(ctx context.Context, payload *knowledge
 		return nil, knowledge.InvalidInput("Failed to get submissions: " + err.Error())
 	}
 
-	var response []*knowledge.Submission
-	for _, submission := range submissions {
-		// Convert pgtype.Numeric to float64 for score
-		score := 0.0
-		if submission.Score.Valid {
-			scoreFloat, err := submission.Score.Float64Value()
-			if err == nil {
-				score = scoreFloat.Float64
-			}
-		}
-
-		response = append(response, &knowledge.Submission{
-			ID:          submission.ID,
-			TestID:      submission.TestID,
-			TestTitle:   submission.TestTitle,
-			Score:       score,
-			SubmittedAt: submission.SubmittedAt.Time.Unix(),
-		})
-	}
+	response, testScores := s.submissionsWithScores(submissions)
 
 	return &knowledge.SubmissionsResponse{
 		Submissions: response,
+		TestScores:  testScores,
 	}, nil
 }
 
@@ -579,6 +1358,10 @@ func (s *knowledgesvrc) GetSubmissionResult(ctx context.Context, payload *knowle
 		return nil, knowledge.Unauthorized("You can only view your own submissions")
 	}
 
+	if submission.Status == "in_progress" {
+		return nil, knowledge.InvalidInput("Submission is still in progress")
+	}
+
 	// Get test info
 	test, err := s.testRepo.GetTestById(ctx, submission.TestID)
 	if err != nil {
@@ -594,40 +1377,56 @@ func (s *knowledgesvrc) GetSubmissionResult(ctx context.Context, payload *knowle
 	// Convert answers to question results
 	var questionResults []*knowledge.QuestionResult
 	for _, answer := range answers {
+		question := s.questionToAPI(knowledgedb.Question{
+			ID:              answer.QuestionID,
+			TestID:          pgtype.Int8{Int64: submission.TestID, Valid: true},
+			QuestionText:    answer.QuestionText,
+			QuestionType:    answer.QuestionType,
+			Options:         answer.Options,
+			CorrectOptions:  answer.CorrectOptions,
+			NumericAnswer:   answer.CorrectNumericAnswer,
+			Tolerance:       answer.Tolerance,
+			AcceptedAnswers: answer.AcceptedAnswers,
+			QuestionOrder:   answer.QuestionOrder,
+		})
+		if !test.RevealAnswers {
+			hideAnswer(question)
+		}
+
 		questionResults = append(questionResults, &knowledge.QuestionResult{
-			Question: &knowledge.Question{
-				ID:            answer.QuestionID,
-				TestID:        submission.TestID,
-				QuestionText:  answer.QuestionText,
-				OptionA:       answer.OptionA,
-				OptionB:       answer.OptionB,
-				OptionC:       answer.OptionC,
-				OptionD:       answer.OptionD,
-				CorrectAnswer: int(answer.CorrectAnswer),
-			},
-			SelectedAnswer: int(answer.SelectedAnswer),
-			IsCorrect:      answer.IsCorrect,
+			Question:        question,
+			SelectedOptions: s.Int32sToInts(answer.SelectedOptions),
+			NumericAnswer:   s.PgFloat8ToFloat64Ptr(answer.NumericAnswer),
+			TextAnswer:      s.PgTextToStringPtr(answer.TextAnswer),
+			Credit:          answer.Credit,
+			IsCorrect:       answer.IsCorrect,
+			Position:        int(answer.Position),
+			OptionOrder:     s.Int32sToInts(answer.OptionOrder),
 		})
 	}
 
-	// Convert score
-	score := 0.0
-	if submission.Score.Valid {
-		scoreFloat, err := submission.Score.Float64Value()
-		if err == nil {
-			score = scoreFloat.Float64
-		}
+	// Get every attempt at the test to count its score
+	history, err := s.submissionRepo.GetUserTestSubmissions(ctx, knowledgedb.GetUserTestSubmissionsParams{
+		UserID: profile.UserID,
+		TestID: submission.TestID,
+	})
+	if err != nil {
+		return nil, knowledge.InvalidInput("Failed to get attempts: " + err.Error())
+	}
+
+	attempts := make([]*knowledge.Submission, 0, len(history))
+	scores := make([]float64, 0, len(history))
+	for _, attempt := range history {
+		attempts = append(attempts, s.submissionToAPI(attempt, test.Title))
+		scores = append(scores, s.PgNumericToFloat64(attempt.Score))
 	}
 
 	return &knowledge.SubmissionResult{
-		Submission: &knowledge.Submission{
-			ID:          submission.ID,
-			TestID:      submission.TestID,
-			TestTitle:   test.Title,
-			Score:       score,
-			SubmittedAt: submission.SubmittedAt.Time.Unix(),
-		},
-		Questions: questionResults,
+		Submission:      s.submissionToAPI(submission, test.Title),
+		Questions:       questionResults,
+		Attempts:        attempts,
+		CountedScore:    grading.CountedScore(grading.ScorePolicy(test.ScorePolicy), scores),
+		AnswersRevealed: test.RevealAnswers,
 	}, nil
 }
 
@@ -645,17 +1444,10 @@ func (s *knowledgesvrc) GetTestByID(ctx context.Context, payload *knowledge.GetT
 		return nil, knowledge.TestNotFound("Test not found")
 	}
 
-	// For students, they can only see tests they haven't submitted
+	// For students, they can only see tests they have attempts left at
 	if profile.Role == "student" {
-		hasSubmitted, err := s.submissionRepo.CheckUserCompletedTest(ctx, knowledgedb.CheckUserCompletedTestParams{
-			UserID: profile.UserID,
-			TestID: payload.TestID,
-		})
-		if err != nil {
-			return nil, knowledge.InvalidInput("Failed to check submission status: " + err.Error())
-		}
-		if hasSubmitted {
-			return nil, knowledge.TestAlreadySubmitted("Test already submitted")
+		if _, err := s.checkAttemptsLeft(ctx, profile.UserID, test); err != nil {
+			return nil, err
 		}
 	}
 
@@ -665,12 +1457,7 @@ func (s *knowledgesvrc) GetTestByID(ctx context.Context, payload *knowledge.GetT
 	}
 
 	return &knowledge.TestResponse{
-		Test: &knowledge.Test{
-			ID:        test.ID,
-			Title:     test.Title,
-			CreatedBy: test.CreatedBy,
-			CreatedAt: test.CreatedAt.Time.Unix(),
-		},
+		Test: s.testToAPI(test),
 	}, nil
 }
 
@@ -694,17 +1481,10 @@ func (s *knowledgesvrc) GetQuestionByID(ctx context.Context, payload *knowledge.
 		return nil, knowledge.Unauthorized("Access denied: You can only view questions from your own tests")
 	}
 
-	// For students, they can only see questions from tests they haven't submitted
+	// For students, they can only see questions from tests they have attempts left at
 	if profile.Role == "student" {
-		hasSubmitted, err := s.submissionRepo.CheckUserCompletedTest(ctx, knowledgedb.CheckUserCompletedTestParams{
-			UserID: profile.UserID,
-			TestID: payload.TestID,
-		})
-		if err != nil {
-			return nil, knowledge.InvalidInput("Failed to check submission status: " + err.Error())
-		}
-		if hasSubmitted {
-			return nil, knowledge.TestAlreadySubmitted("Test already submitted")
+		if _, err := s.checkAttemptsLeft(ctx, profile.UserID, test); err != nil {
+			return nil, err
 		}
 	}
 
@@ -714,22 +1494,18 @@ func (s *knowledgesvrc) GetQuestionByID(ctx context.Context, payload *knowledge.
 	}
 
 	// Verify the question belongs to the specified test
-	if question.TestID != payload.TestID {
+	if !question.TestID.Valid || question.TestID.Int64 != payload.TestID {
 		return nil, knowledge.QuestionNotFound("Question not found in the specified test")
 	}
 
+	// Only the teacher of the test sees its correct answer
+	result := s.questionToAPI(question)
+	if test.CreatedBy != profile.UserID {
+		hideAnswer(result)
+	}
+
 	return &knowledge.QuestionResponse{
-		Question: &knowledge.Question{
-			ID:            question.ID,
-			TestID:        question.TestID,
-			QuestionText:  question.QuestionText,
-			OptionA:       question.OptionA,
-			OptionB:       question.OptionB,
-			OptionC:       question.OptionC,
-			OptionD:       question.OptionD,
-			CorrectAnswer: int(question.CorrectAnswer),
-			QuestionOrder: int(question.QuestionOrder),
-		},
+		Question: result,
 	}, nil
 }
 
@@ -747,6 +1523,11 @@ func (s *knowledgesvrc) GetSubmissionByID(ctx context.Context, payload *knowledg
 		return nil, knowledge.SubmissionNotFound("Submission not found")
 	}
 
+	// Attempts in progress have not been submitted yet
+	if submission.Status == "in_progress" {
+		return nil, knowledge.SubmissionNotFound("Submission not found")
+	}
+
 	// Get the test to check ownership permissions
 	test, err := s.testRepo.GetTestById(ctx, submission.TestID)
 	if err != nil {
@@ -766,16 +1547,7 @@ func (s *knowledgesvrc) GetSubmissionByID(ctx context.Context, payload *knowledg
 		}
 	}
 
-	// Calculate score
-	score := s.PgNumericToFloat64(submission.Score)
-
 	return &knowledge.SubmissionResponse{
-		Submission: &knowledge.Submission{
-			ID:          submission.ID,
-			TestID:      submission.TestID,
-			TestTitle:   test.Title,
-			Score:       score,
-			SubmittedAt: submission.SubmittedAt.Time.Unix(),
-		},
+		Submission: s.submissionToAPI(submission, test.Title),
 	}, nil
 }
@@ -2,16 +2,20 @@ package controllers
 
 import (
 	"context"
+	"fmt"
+	"slices"
 	"testing"
 	"time"
 
 	"errors"
 
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	knowledgedb "github.com/ynoacamino/infra-sustainable-classrooms/services/knowledge/gen/database"
 	"github.com/ynoacamino/infra-sustainable-classrooms/services/knowledge/gen/knowledge"
+	"github.com/ynoacamino/infra-sustainable-classrooms/services/knowledge/internal/grading"
 	"github.com/ynoacamino/infra-sustainable-classrooms/services/knowledge/internal/repositories/mocks"
 	"github.com/ynoacamino/infra-sustainable-classrooms/services/profiles/gen/profiles"
 )
@@ -44,6 +48,7 @@ func setupTestService(
 	return &knowledgesvrc{
 		testRepo:            testRepo,
 		questionRepo:        questionRepo,
+		bankRepo:            &mocks.MockBankRepository{},
 		submissionRepo:      submissionRepo,
 		answerRepo:          answerRepo,
 		profilesServiceRepo: profilesServiceRepo,
@@ -71,27 +76,29 @@ func createTestCompleteProfile(role string) *profiles.CompleteProfileResponse {
 // createTestTest creates a test Test for use in tests
 func createTestTest() knowledgedb.Test {
 	return knowledgedb.Test{
-		ID:        1,
-		Title:     "Test Quiz",
-		CreatedBy: 1,
-		CreatedAt: pgtype.Timestamptz{Time: time.Now(), Valid: true},
+		ID:            1,
+		Title:         "Test Quiz",
+		CreatedBy:     1,
+		MaxAttempts:   1,
+		ScorePolicy:   "best",
+		RevealAnswers: true,
+		CreatedAt:     pgtype.Timestamptz{Time: time.Now(), Valid: true},
 	}
 }
 
 // createTestQuestion creates a test Question for use in tests
-// func createTestQuestion() knowledgedb.Question {
-// 	return knowledgedb.Question{
-// 		ID:            1,
-// 		TestID:        1,
-// 		QuestionText:  "What is 2+2?",
-// 		OptionA:       "3",
-// 		OptionB:       "4",
-// 		OptionC:       "5",
-// 		OptionD:       "6",
-// 		CorrectAnswer: 1, // B
-// 		QuestionOrder: 1,
-// 	}
-// }
+func createTestQuestion() knowledgedb.Question {
+	return knowledgedb.Question{
+		ID:              1,
+		TestID:          pgtype.Int8{Int64: 1, Valid: true},
+		QuestionText:    "What is 2+2?",
+		QuestionType:    "multiple_choice",
+		Options:         []string{"3", "4", "5", "6"},
+		CorrectOptions:  []int32{1}, // 4
+		AcceptedAnswers: []string{},
+		QuestionOrder:   1,
+	}
+}
 
 // Helper functions for tests
 func stringPtr(s string) *string {
@@ -305,14 +312,12 @@ func TestAddQuestion(t *testing.T) {
 		{
 			name: "successful question addition",
 			payload: &knowledge.AddQuestionPayload{
-				SessionToken:  "valid_token",
-				TestID:        1,
-				QuestionText:  "What is 2+2?",
-				OptionA:       "3",
-				OptionB:       "4",
-				OptionC:       "5",
-				OptionD:       "6",
-				CorrectAnswer: 1,
+				SessionToken:   "valid_token",
+				TestID:         1,
+				QuestionText:   "What is 2+2?",
+				QuestionType:   "multiple_choice",
+				Options:        []string{"3", "4", "5", "6"},
+				CorrectOptions: []int{1},
 			},
 			setupMocks: func(testRepo *mocks.MockTestRepository, questionRepo *mocks.MockQuestionRepository, profilesRepo *mocks.MockProfilesServiceRepository) {
 				profilesRepo.On("GetCompleteProfile", mock.Anything, &profiles.GetCompleteProfilePayload{
@@ -322,14 +327,13 @@ func TestAddQuestion(t *testing.T) {
 				testRepo.On("GetTestById", mock.Anything, int64(1)).Return(createTestTest(), nil)
 				questionRepo.On("GetQuestionsByTestId", mock.Anything, int64(1)).Return([]knowledgedb.Question{}, nil)
 				questionRepo.On("CreateQuestion", mock.Anything, knowledgedb.CreateQuestionParams{
-					TestID:        1,
-					QuestionText:  "What is 2+2?",
-					OptionA:       "3",
-					OptionB:       "4",
-					OptionC:       "5",
-					OptionD:       "6",
-					CorrectAnswer: 1,
-					QuestionOrder: 1,
+					TestID:          1,
+					QuestionText:    "What is 2+2?",
+					QuestionType:    "multiple_choice",
+					Options:         []string{"3", "4", "5", "6"},
+					CorrectOptions:  []int32{1},
+					AcceptedAnswers: []string{},
+					QuestionOrder:   1,
 				}).Return(nil)
 			},
 			expectedResult: &knowledge.SimpleResponse{
@@ -339,16 +343,69 @@ func TestAddQuestion(t *testing.T) {
 			expectedError: nil,
 		},
 		{
-			name: "unauthorized - not a teacher",
+			name: "numeric question with tolerance",
 			payload: &knowledge.AddQuestionPayload{
 				SessionToken:  "valid_token",
 				TestID:        1,
-				QuestionText:  "What is 2+2?",
-				OptionA:       "3",
-				OptionB:       "4",
-				OptionC:       "5",
-				OptionD:       "6",
-				CorrectAnswer: 1,
+				QuestionText:  "What is pi to two decimals?",
+				QuestionType:  "numeric",
+				NumericAnswer: float64Ptr(3.14),
+				Tolerance:     0.01,
+			},
+			setupMocks: func(testRepo *mocks.MockTestRepository, questionRepo *mocks.MockQuestionRepository, profilesRepo *mocks.MockProfilesServiceRepository) {
+				profilesRepo.On("GetCompleteProfile", mock.Anything, &profiles.GetCompleteProfilePayload{
+					SessionToken: "valid_token",
+				}).Return(createTestCompleteProfile("teacher"), nil)
+
+				testRepo.On("GetTestById", mock.Anything, int64(1)).Return(createTestTest(), nil)
+				questionRepo.On("GetQuestionsByTestId", mock.Anything, int64(1)).Return([]knowledgedb.Question{createTestQuestion()}, nil)
+				questionRepo.On("CreateQuestion", mock.Anything, knowledgedb.CreateQuestionParams{
+					TestID:          1,
+					QuestionText:    "What is pi to two decimals?",
+					QuestionType:    "numeric",
+					Options:         []string{},
+					CorrectOptions:  []int32{},
+					NumericAnswer:   pgtype.Float8{Float64: 3.14, Valid: true},
+					Tolerance:       0.01,
+					AcceptedAnswers: []string{},
+					QuestionOrder:   2,
+				}).Return(nil)
+			},
+			expectedResult: &knowledge.SimpleResponse{
+				Success: true,
+				Message: "Question added successfully",
+			},
+			expectedError: nil,
+		},
+		{
+			name: "invalid - ordering question missing an option",
+			payload: &knowledge.AddQuestionPayload{
+				SessionToken:   "valid_token",
+				TestID:         1,
+				QuestionText:   "Order the planets",
+				QuestionType:   "ordering",
+				Options:        []string{"Earth", "Mercury", "Venus"},
+				CorrectOptions: []int{1, 2},
+			},
+			setupMocks: func(testRepo *mocks.MockTestRepository, questionRepo *mocks.MockQuestionRepository, profilesRepo *mocks.MockProfilesServiceRepository) {
+				profilesRepo.On("GetCompleteProfile", mock.Anything, &profiles.GetCompleteProfilePayload{
+					SessionToken: "valid_token",
+				}).Return(createTestCompleteProfile("teacher"), nil)
+
+				testRepo.On("GetTestById", mock.Anything, int64(1)).Return(createTestTest(), nil)
+			},
+			expectedResult: nil,
+			expectedError:  knowledge.InvalidInput("Invalid question: the correct order must include every option once"),
+		},
+		{
+			name: "unauthorized - not a teacher",
+			payload: &knowledge.AddQuestionPayload{
+				SessionToken:   "valid_token",
+				TestID:         1,
+				QuestionText:   "What is 2+2?",
+				QuestionType:   "multiple_choice",
+				Options:        []string{"3", "4", "5", "6"},
+				CorrectOptions: []int{1},
 			},
 			setupMocks: func(testRepo *mocks.MockTestRepository, questionRepo *mocks.MockQuestionRepository, profilesRepo *mocks.MockProfilesServiceRepository) {
 				profilesRepo.On("GetCompleteProfile", mock.Anything, &profiles.GetCompleteProfilePayload{
@@ -361,14 +418,12 @@ func TestAddQuestion(t *testing.T) {
 		{
 			name: "unauthorized - test not owned by teacher",
 			payload: &knowledge.AddQuestionPayload{
-				SessionToken:  "valid_token",
-				TestID:        1,
-				QuestionText:  "What is 2+2?",
-				OptionA:       "3",
-				OptionB:       "4",
-				OptionC:       "5",
-				OptionD:       "6",
-				CorrectAnswer: 1,
+				SessionToken:   "valid_token",
+				TestID:         1,
+				QuestionText:   "What is 2+2?",
+				QuestionType:   "multiple_choice",
+				Options:        []string{"3", "4", "5", "6"},
+				CorrectOptions: []int{1},
 			},
 			setupMocks: func(testRepo *mocks.MockTestRepository, questionRepo *mocks.MockQuestionRepository, profilesRepo *mocks.MockProfilesServiceRepository) {
 				profilesRepo.On("GetCompleteProfile", mock.Anything, &profiles.GetCompleteProfilePayload{
@@ -416,6 +471,862 @@ func TestAddQuestion(t *testing.T) {
 	}
 }
 
+// Test SubmitTest endpoint
+func TestSubmitTest(t *testing.T) {
+	// A multiple select and a numeric question
+	questions := []knowledgedb.Question{
+		{
+			ID:              1,
+			TestID:          pgtype.Int8{Int64: 1, Valid: true},
+			QuestionText:    "Which are prime?",
+			QuestionType:    "multiple_select",
+			Options:         []string{"2", "4", "7", "9"},
+			CorrectOptions:  []int32{0, 2},
+			AcceptedAnswers: []string{},
+			QuestionOrder:   1,
+		},
+		{
+			ID:              2,
+			TestID:          pgtype.Int8{Int64: 1, Valid: true},
+			QuestionText:    "What is pi to two decimals?",
+			QuestionType:    "numeric",
+			Options:         []string{},
+			CorrectOptions:  []int32{},
+			NumericAnswer:   pgtype.Float8{Float64: 3.14, Valid: true},
+			Tolerance:       0.01,
+			AcceptedAnswers: []string{},
+			QuestionOrder:   2,
+		},
+	}
+
+	// A timed attempt started earlier, with its deadline relative to now
+	attemptWithDeadline := func(fromNow time.Duration) *knowledgedb.TestSubmission {
+		return &knowledgedb.TestSubmission{
+			ID:        10,
+			TestID:    1,
+			UserID:    1,
+			Status:    "in_progress",
+			StartedAt: pgtype.Timestamptz{Time: time.Now().Add(fromNow - 10*time.Minute), Valid: true},
+			Deadline:  pgtype.Timestamptz{Time: time.Now().Add(fromNow), Valid: true},
+		}
+	}
+	allCorrect := []*knowledge.Answer{
+		{QuestionID: 1, SelectedOptions: []int{0, 2}},
+		{QuestionID: 2, NumericAnswer: float64Ptr(3.14)},
+	}
+
+	// The questions of an attempt as stored when it started, with the option
+	// order of the first question
+	stored := func(firstOptionOrder []int32) []knowledgedb.GetAttemptQuestionsRow {
+		return []knowledgedb.GetAttemptQuestionsRow{
+			{Question: questions[0], Position: 1, OptionOrder: firstOptionOrder},
+			{Question: questions[1], Position: 2, OptionOrder: []int32{}},
+		}
+	}
+
+	// An attempt with shuffled options, answered by the positions shown. The
+	// seed is not used once the order is stored.
+	shuffledAttempt := &knowledgedb.TestSubmission{ID: 10, TestID: 1, UserID: 1, Status: "in_progress", ShuffleOptions: true, ShuffleSeed: 42}
+	optionOrder := []int32{2, 0, 3, 1}
+	shownCorrect := []int{slices.Index(optionOrder, 0), slices.Index(optionOrder, 2)}
+
+	// An attempt that drew a question from a question bank
+	drawRules := []knowledgedb.DrawRule{{ID: 1, TestID: 1, BankID: 3, QuestionCount: 1}}
+	drawnAttempt := &knowledgedb.TestSubmission{ID: 10, TestID: 1, UserID: 1, Status: "in_progress"}
+	drawn := []knowledgedb.GetAttemptQuestionsRow{
+		{
+			Question: knowledgedb.Question{
+				ID:              7,
+				BankID:          pgtype.Int8{Int64: 3, Valid: true},
+				QuestionText:    "What is 3+3?",
+				QuestionType:    "multiple_choice",
+				Options:         []string{"5", "6"},
+				CorrectOptions:  []int32{1},
+				AcceptedAnswers: []string{},
+				QuestionOrder:   4,
+				Topic:           "algebra",
+				Difficulty:      "easy",
+			},
+			Position:    1,
+			OptionOrder: []int32{},
+		},
+	}
+
+	tests := []struct {
+		name            string
+		durationMinutes pgtype.Int4
+		shuffleOptions  bool
+		drawRules       []knowledgedb.DrawRule
+		stored          []knowledgedb.GetAttemptQuestionsRow
+		maxAttempts     int32
+		cooldownMinutes int32
+		summary         knowledgedb.GetAttemptSummaryRow
+		attempt         *knowledgedb.TestSubmission
+		answers         []*knowledge.Answer
+		setupMocks      func(*mocks.MockSubmissionRepository, *mocks.MockAnswerRepository)
+		expectedScore   float64
+		expectedError   error
+	}{
+		{
+			name: "partial credit",
+			answers: []*knowledge.Answer{
+				{QuestionID: 1, SelectedOptions: []int{0}},
+				{QuestionID: 2, NumericAnswer: float64Ptr(3.141)},
+			},
+			setupMocks: func(submissionRepo *mocks.MockSubmissionRepository, answerRepo *mocks.MockAnswerRepository) {
+				submissionRepo.On("CreateSubmission", mock.Anything, mock.MatchedBy(func(params knowledgedb.CreateSubmissionParams) bool {
+					score, _ := params.Score.Float64Value()
+					return score.Float64 == 75
+				})).Return(knowledgedb.TestSubmission{ID: 10}, nil)
+				answerRepo.On("CreateAnswerSubmission", mock.Anything, knowledgedb.CreateAnswerSubmissionParams{
+					SubmissionID:    10,
+					QuestionID:      1,
+					SelectedOptions: []int32{0},
+					Credit:          0.5,
+					IsCorrect:       false,
+					Position:        1,
+					OptionOrder:     []int32{},
+				}).Return(nil)
+				answerRepo.On("CreateAnswerSubmission", mock.Anything, knowledgedb.CreateAnswerSubmissionParams{
+					SubmissionID:    10,
+					QuestionID:      2,
+					SelectedOptions: []int32{},
+					NumericAnswer:   pgtype.Float8{Float64: 3.141, Valid: true},
+					Credit:          1,
+					IsCorrect:       true,
+					Position:        2,
+					OptionOrder:     []int32{},
+				}).Return(nil)
+			},
+			expectedScore: 75,
+		},
+		{
+			name: "invalid - option out of range",
+			answers: []*knowledge.Answer{
+				{QuestionID: 1, SelectedOptions: []int{4}},
+			},
+			setupMocks:    func(*mocks.MockSubmissionRepository, *mocks.MockAnswerRepository) {},
+			expectedError: knowledge.InvalidInput("Invalid answer to question ID 1: option 4 does not exist"),
+		},
+		{
+			name: "invalid - question answered twice",
+			answers: []*knowledge.Answer{
+				{QuestionID: 2, NumericAnswer: float64Ptr(3)},
+				{QuestionID: 2, NumericAnswer: float64Ptr(3.14)},
+			},
+			setupMocks:    func(*mocks.MockSubmissionRepository, *mocks.MockAnswerRepository) {},
+			expectedError: knowledge.InvalidInput("Question ID 2 answered more than once"),
+		},
+		{
+			name:            "timed - submitted before the deadline",
+			durationMinutes: pgtype.Int4{Int32: 10, Valid: true},
+			attempt:         attemptWithDeadline(time.Minute),
+			stored:          stored([]int32{}),
+			answers:         allCorrect,
+			setupMocks: func(submissionRepo *mocks.MockSubmissionRepository, answerRepo *mocks.MockAnswerRepository) {
+				submissionRepo.On("FinishSubmission", mock.Anything, mock.MatchedBy(func(params knowledgedb.FinishSubmissionParams) bool {
+					score, _ := params.Score.Float64Value()
+					return params.ID == 10 && score.Float64 == 100
+				})).Return(knowledgedb.TestSubmission{ID: 10}, nil)
+				answerRepo.On("CreateAnswerSubmission", mock.Anything, mock.AnythingOfType("knowledgedb.CreateAnswerSubmissionParams")).Return(nil).Times(2)
+			},
+			expectedScore: 100,
+		},
+		{
+			name:            "timed - submitted within the grace period",
+			durationMinutes: pgtype.Int4{Int32: 10, Valid: true},
+			attempt:         attemptWithDeadline(-10 * time.Second),
+			stored:          stored([]int32{}),
+			answers:         allCorrect,
+			setupMocks: func(submissionRepo *mocks.MockSubmissionRepository, answerRepo *mocks.MockAnswerRepository) {
+				submissionRepo.On("FinishSubmission", mock.Anything, mock.AnythingOfType("knowledgedb.FinishSubmissionParams")).Return(knowledgedb.TestSubmission{ID: 10}, nil)
+				answerRepo.On("CreateAnswerSubmission", mock.Anything, mock.AnythingOfType("knowledgedb.CreateAnswerSubmissionParams")).Return(nil).Times(2)
+			},
+			expectedScore: 100,
+		},
+		{
+			name:            "timed - past the grace period closes the attempt",
+			durationMinutes: pgtype.Int4{Int32: 10, Valid: true},
+			attempt:         attemptWithDeadline(-time.Minute),
+			answers:         allCorrect,
+			setupMocks: func(submissionRepo *mocks.MockSubmissionRepository, answerRepo *mocks.MockAnswerRepository) {
+				submissionRepo.On("ExpireSubmission", mock.Anything, int64(10)).Return(nil)
+			},
+			expectedError: knowledge.TimeExpired("The time limit of this test has passed"),
+		},
+		{
+			name:            "timed - not started",
+			durationMinutes: pgtype.Int4{Int32: 10, Valid: true},
+			answers:         allCorrect,
+			setupMocks:      func(*mocks.MockSubmissionRepository, *mocks.MockAnswerRepository) {},
+			expectedError:   knowledge.InvalidInput("Start the test before submitting it"),
+		},
+		{
+			name:           "shuffled - answers mapped back to canonical options",
+			shuffleOptions: true,
+			attempt:        shuffledAttempt,
+			stored:         stored(optionOrder),
+			answers: []*knowledge.Answer{
+				{QuestionID: 1, SelectedOptions: shownCorrect},
+				{QuestionID: 2, NumericAnswer: float64Ptr(3.14)},
+			},
+			setupMocks: func(submissionRepo *mocks.MockSubmissionRepository, answerRepo *mocks.MockAnswerRepository) {
+				submissionRepo.On("FinishSubmission", mock.Anything, mock.AnythingOfType("knowledgedb.FinishSubmissionParams")).Return(knowledgedb.TestSubmission{ID: 10}, nil)
+				answerRepo.On("CreateAnswerSubmission", mock.Anything, mock.MatchedBy(func(params knowledgedb.CreateAnswerSubmissionParams) bool {
+					return params.QuestionID == 1 && slices.Equal(params.SelectedOptions, []int32{0, 2}) && slices.Equal(params.OptionOrder, optionOrder)
+				})).Return(nil)
+				answerRepo.On("CreateAnswerSubmission", mock.Anything, mock.MatchedBy(func(params knowledgedb.CreateAnswerSubmissionParams) bool {
+					return params.QuestionID == 2 && len(params.OptionOrder) == 0
+				})).Return(nil)
+			},
+			expectedScore: 100,
+		},
+		{
+			name:           "shuffled - order that no longer fits the question",
+			shuffleOptions: true,
+			attempt:        shuffledAttempt,
+			stored:         stored([]int32{2, 0, 1}),
+			answers:        allCorrect,
+			setupMocks: func(submissionRepo *mocks.MockSubmissionRepository, answerRepo *mocks.MockAnswerRepository) {
+				submissionRepo.On("FinishSubmission", mock.Anything, mock.AnythingOfType("knowledgedb.FinishSubmissionParams")).Return(knowledgedb.TestSubmission{ID: 10}, nil)
+				answerRepo.On("CreateAnswerSubmission", mock.Anything, mock.MatchedBy(func(params knowledgedb.CreateAnswerSubmissionParams) bool {
+					return slices.Equal(params.SelectedOptions, []int32{0, 2}) && len(params.OptionOrder) == 0
+				})).Return(nil)
+				answerRepo.On("CreateAnswerSubmission", mock.Anything, mock.AnythingOfType("knowledgedb.CreateAnswerSubmissionParams")).Return(nil)
+			},
+			expectedScore: 100,
+		},
+		{
+			name:           "shuffled - not started",
+			shuffleOptions: true,
+			answers:        allCorrect,
+			setupMocks:     func(*mocks.MockSubmissionRepository, *mocks.MockAnswerRepository) {},
+			expectedError:  knowledge.InvalidInput("Start the test before submitting it"),
+		},
+		{
+			name:      "drawn - graded on the questions drawn for the attempt",
+			drawRules: drawRules,
+			attempt:   drawnAttempt,
+			stored:    drawn,
+			answers: []*knowledge.Answer{
+				{QuestionID: 7, SelectedOptions: []int{1}},
+			},
+			setupMocks: func(submissionRepo *mocks.MockSubmissionRepository, answerRepo *mocks.MockAnswerRepository) {
+				submissionRepo.On("FinishSubmission", mock.Anything, mock.MatchedBy(func(params knowledgedb.FinishSubmissionParams) bool {
+					score, _ := params.Score.Float64Value()
+					return params.ID == 10 && score.Float64 == 100
+				})).Return(knowledgedb.TestSubmission{ID: 10}, nil)
+				answerRepo.On("CreateAnswerSubmission", mock.Anything, knowledgedb.CreateAnswerSubmissionParams{
+					SubmissionID:    10,
+					QuestionID:      7,
+					SelectedOptions: []int32{1},
+					Credit:          1,
+					IsCorrect:       true,
+					Position:        1,
+					OptionOrder:     []int32{},
+				}).Return(nil)
+			},
+			expectedScore: 100,
+		},
+		{
+			name:      "drawn - questions of the test that were not drawn",
+			drawRules: drawRules,
+			attempt:   drawnAttempt,
+			stored:    drawn,
+			answers: []*knowledge.Answer{
+				{QuestionID: 1, SelectedOptions: []int{0}},
+			},
+			setupMocks:    func(*mocks.MockSubmissionRepository, *mocks.MockAnswerRepository) {},
+			expectedError: knowledge.InvalidInput("Question ID 1 not found in this test"),
+		},
+		{
+			name:          "drawn - questions of the attempt not saved",
+			drawRules:     drawRules,
+			attempt:       drawnAttempt,
+			answers:       allCorrect,
+			setupMocks:    func(*mocks.MockSubmissionRepository, *mocks.MockAnswerRepository) {},
+			expectedError: knowledge.InternalError("The questions of this attempt were not saved"),
+		},
+		{
+			name:          "drawn - not started",
+			drawRules:     drawRules,
+			answers:       allCorrect,
+			setupMocks:    func(*mocks.MockSubmissionRepository, *mocks.MockAnswerRepository) {},
+			expectedError: knowledge.InvalidInput("Start the test before submitting it"),
+		},
+		{
+			name:        "retake - new numbered attempt",
+			maxAttempts: 3,
+			summary:     knowledgedb.GetAttemptSummaryRow{Attempts: 1, LastSubmittedAt: pgtype.Timestamptz{Time: time.Now().Add(-time.Hour), Valid: true}},
+			answers:     allCorrect,
+			setupMocks: func(submissionRepo *mocks.MockSubmissionRepository, answerRepo *mocks.MockAnswerRepository) {
+				submissionRepo.On("CreateSubmission", mock.Anything, mock.AnythingOfType("knowledgedb.CreateSubmissionParams")).Return(knowledgedb.TestSubmission{ID: 11, AttemptNumber: 2}, nil)
+				answerRepo.On("CreateAnswerSubmission", mock.Anything, mock.AnythingOfType("knowledgedb.CreateAnswerSubmissionParams")).Return(nil).Times(2)
+			},
+			expectedScore: 100,
+		},
+		{
+			name:          "retake - every attempt used",
+			maxAttempts:   2,
+			summary:       knowledgedb.GetAttemptSummaryRow{Attempts: 2, LastSubmittedAt: pgtype.Timestamptz{Time: time.Now().Add(-time.Hour), Valid: true}},
+			answers:       allCorrect,
+			setupMocks:    func(*mocks.MockSubmissionRepository, *mocks.MockAnswerRepository) {},
+			expectedError: knowledge.TestAlreadySubmitted("You have used all 2 attempts at this test"),
+		},
+		{
+			name:            "retake - within the cooldown",
+			cooldownMinutes: 10,
+			summary:         knowledgedb.GetAttemptSummaryRow{Attempts: 1, LastSubmittedAt: pgtype.Timestamptz{Time: time.Now().Add(-5 * time.Minute), Valid: true}},
+			answers:         allCorrect,
+			setupMocks:      func(*mocks.MockSubmissionRepository, *mocks.MockAnswerRepository) {},
+			expectedError:   knowledge.InvalidInput("You can retake this test in 5m0s"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup mocks
+			testRepo := &mocks.MockTestRepository{}
+			questionRepo := &mocks.MockQuestionRepository{}
+			submissionRepo := &mocks.MockSubmissionRepository{}
+			answerRepo := &mocks.MockAnswerRepository{}
+			profilesRepo := &mocks.MockProfilesServiceRepository{}
+			profilesRepo.On("GetCompleteProfile", mock.Anything, mock.AnythingOfType("*profiles.GetCompleteProfilePayload")).Return(createTestCompleteProfile("student"), nil)
+			test := createTestTest()
+			test.DurationMinutes = tt.durationMinutes
+			test.ShuffleOptions = tt.shuffleOptions
+			test.MaxAttempts = 0
+			if tt.maxAttempts > 0 {
+				test.MaxAttempts = tt.maxAttempts
+			}
+			test.CooldownMinutes = tt.cooldownMinutes
+			submissionRepo.On("GetAttemptSummary", mock.Anything, knowledgedb.GetAttemptSummaryParams{UserID: 1, TestID: 1}).Return(tt.summary, nil).Maybe()
+			testRepo.On("GetTestById", mock.Anything, int64(1)).Return(test, nil)
+			testRepo.On("GetDrawRulesByTestId", mock.Anything, int64(1)).Return(tt.drawRules, nil).Maybe()
+			submissionRepo.On("GetAttemptQuestions", mock.Anything, int64(10)).Return(tt.stored, nil).Maybe()
+			if tt.attempt != nil {
+				submissionRepo.On("GetInProgressSubmission", mock.Anything, knowledgedb.GetInProgressSubmissionParams{UserID: 1, TestID: 1}).Return(*tt.attempt, nil)
+			} else {
+				submissionRepo.On("GetInProgressSubmission", mock.Anything, mock.AnythingOfType("knowledgedb.GetInProgressSubmissionParams")).Return(knowledgedb.TestSubmission{}, pgx.ErrNoRows)
+			}
+			questionRepo.On("GetQuestionsByTestId", mock.Anything, int64(1)).Return(questions, nil).Maybe()
+			tt.setupMocks(submissionRepo, answerRepo)
+
+			// Create service
+			service := setupTestService(testRepo, questionRepo, submissionRepo, answerRepo, profilesRepo)
+			service.gracePeriod = 30 * time.Second
+
+			// Call method
+			result, err := service.SubmitTest(context.Background(), &knowledge.SubmitTestPayload{
+				SessionToken: "valid_token",
+				TestID:       1,
+				Answers:      tt.answers,
+			})
+
+			// Assertions
+			if tt.expectedError != nil {
+				assert.Error(t, err)
+				assert.Equal(t, tt.expectedError, err)
+				assert.Nil(t, result)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedScore, result.Score)
+			}
+
+			// Verify all expectations were met
+			submissionRepo.AssertExpectations(t)
+			answerRepo.AssertExpectations(t)
+		})
+	}
+}
+
+// Test StartTest endpoint
+func TestStartTest(t *testing.T) {
+	startedAt := time.Now()
+	attempt := knowledgedb.TestSubmission{
+		ID:        10,
+		TestID:    1,
+		UserID:    1,
+		Status:    "in_progress",
+		StartedAt: pgtype.Timestamptz{Time: startedAt, Valid: true},
+		Deadline:  pgtype.Timestamptz{Time: startedAt.Add(20 * time.Minute), Valid: true},
+	}
+	started := knowledgedb.StartSubmissionRow(attempt)
+
+	tests := []struct {
+		name           string
+		role           string
+		shuffleOptions bool
+		attempts       int64
+		drawRules      []knowledgedb.DrawRule
+		setupMocks     func(*mocks.MockSubmissionRepository, *mocks.MockQuestionRepository)
+		expectedResult *knowledge.TestAttempt
+		expectedError  error
+	}{
+		{
+			name: "starts a new attempt",
+			role: "student",
+			setupMocks: func(submissionRepo *mocks.MockSubmissionRepository, questionRepo *mocks.MockQuestionRepository) {
+				submissionRepo.On("GetInProgressSubmission", mock.Anything, mock.AnythingOfType("knowledgedb.GetInProgressSubmissionParams")).Return(knowledgedb.TestSubmission{}, pgx.ErrNoRows)
+				questionRepo.On("GetQuestionsByTestId", mock.Anything, int64(1)).Return([]knowledgedb.Question{createTestQuestion()}, nil)
+				submissionRepo.On("StartSubmission", mock.Anything, knowledgedb.StartSubmissionParams{
+					TestID:          1,
+					UserID:          1,
+					DurationMinutes: pgtype.Int4{Int32: 20, Valid: true},
+					QuestionIds:     []int64{1},
+					OptionOrders:    []string{""},
+				}).Return(started, nil)
+			},
+			expectedResult: &knowledge.TestAttempt{
+				SubmissionID:    10,
+				TestID:          1,
+				Status:          "in_progress",
+				StartedAt:       startedAt.Unix(),
+				Deadline:        func() *int64 { d := startedAt.Add(20 * time.Minute).Unix(); return &d }(),
+				DurationMinutes: intPtr(20),
+			},
+		},
+		{
+			name:           "saves the order the options are shown in",
+			role:           "student",
+			shuffleOptions: true,
+			setupMocks: func(submissionRepo *mocks.MockSubmissionRepository, questionRepo *mocks.MockQuestionRepository) {
+				submissionRepo.On("GetInProgressSubmission", mock.Anything, mock.AnythingOfType("knowledgedb.GetInProgressSubmissionParams")).Return(knowledgedb.TestSubmission{}, pgx.ErrNoRows)
+				questionRepo.On("GetQuestionsByTestId", mock.Anything, int64(1)).Return([]knowledgedb.Question{createTestQuestion()}, nil)
+				submissionRepo.On("StartSubmission", mock.Anything, mock.MatchedBy(func(arg knowledgedb.StartSubmissionParams) bool {
+					order := grading.Shuffle{Options: true, Seed: arg.ShuffleSeed}.OptionOrder(1, grading.Question{Type: grading.MultipleChoice, Options: createTestQuestion().Options})
+					return arg.ShuffleOptions && len(arg.OptionOrders) == 1 &&
+						arg.OptionOrders[0] == fmt.Sprintf("%d,%d,%d,%d", order[0], order[1], order[2], order[3])
+				})).Return(started, nil)
+			},
+			expectedResult: &knowledge.TestAttempt{
+				SubmissionID:    10,
+				TestID:          1,
+				Status:          "in_progress",
+				StartedAt:       startedAt.Unix(),
+				Deadline:        func() *int64 { d := startedAt.Add(20 * time.Minute).Unix(); return &d }(),
+				DurationMinutes: intPtr(20),
+			},
+		},
+		{
+			name: "test without questions",
+			role: "student",
+			setupMocks: func(submissionRepo *mocks.MockSubmissionRepository, questionRepo *mocks.MockQuestionRepository) {
+				submissionRepo.On("GetInProgressSubmission", mock.Anything, mock.AnythingOfType("knowledgedb.GetInProgressSubmissionParams")).Return(knowledgedb.TestSubmission{}, pgx.ErrNoRows)
+				questionRepo.On("GetQuestionsByTestId", mock.Anything, int64(1)).Return([]knowledgedb.Question{}, nil)
+			},
+			expectedError: knowledge.InvalidInput("Test has no questions"),
+		},
+		{
+			name: "resumes the attempt in progress",
+			role: "student",
+			setupMocks: func(submissionRepo *mocks.MockSubmissionRepository, _ *mocks.MockQuestionRepository) {
+				submissionRepo.On("GetInProgressSubmission", mock.Anything, knowledgedb.GetInProgressSubmissionParams{UserID: 1, TestID: 1}).Return(attempt, nil)
+			},
+			expectedResult: &knowledge.TestAttempt{
+				SubmissionID:    10,
+				TestID:          1,
+				Status:          "in_progress",
+				StartedAt:       startedAt.Unix(),
+				Deadline:        func() *int64 { d := startedAt.Add(20 * time.Minute).Unix(); return &d }(),
+				DurationMinutes: intPtr(20),
+			},
+		},
+		{
+			name: "expires a lapsed attempt and starts a new one",
+			role: "student",
+			setupMocks: func(submissionRepo *mocks.MockSubmissionRepository, questionRepo *mocks.MockQuestionRepository) {
+				lapsed := attempt
+				lapsed.ID = 9
+				lapsed.StartedAt = pgtype.Timestamptz{Time: startedAt.Add(-time.Hour), Valid: true}
+				lapsed.Deadline = pgtype.Timestamptz{Time: startedAt.Add(-40 * time.Minute), Valid: true}
+				submissionRepo.On("GetInProgressSubmission", mock.Anything, knowledgedb.GetInProgressSubmissionParams{UserID: 1, TestID: 1}).Return(lapsed, nil)
+				submissionRepo.On("ExpireSubmission", mock.Anything, int64(9)).Return(nil)
+				questionRepo.On("GetQuestionsByTestId", mock.Anything, int64(1)).Return([]knowledgedb.Question{createTestQuestion()}, nil)
+				submissionRepo.On("StartSubmission", mock.Anything, mock.AnythingOfType("knowledgedb.StartSubmissionParams")).Return(started, nil)
+			},
+			expectedResult: &knowledge.TestAttempt{
+				SubmissionID:    10,
+				TestID:          1,
+				Status:          "in_progress",
+				StartedAt:       startedAt.Unix(),
+				Deadline:        func() *int64 { d := startedAt.Add(20 * time.Minute).Unix(); return &d }(),
+				DurationMinutes: intPtr(20),
+			},
+		},
+		{
+			name:      "draws questions for the attempt",
+			role:      "student",
+			drawRules: []knowledgedb.DrawRule{{ID: 1, TestID: 1, BankID: 3, Topic: pgtype.Text{String: "algebra", Valid: true}, QuestionCount: 2}},
+			setupMocks: func(submissionRepo *mocks.MockSubmissionRepository, questionRepo *mocks.MockQuestionRepository) {
+				submissionRepo.On("GetInProgressSubmission", mock.Anything, mock.AnythingOfType("knowledgedb.GetInProgressSubmissionParams")).Return(knowledgedb.TestSubmission{}, pgx.ErrNoRows)
+				questionRepo.On("GetQuestionsByTestId", mock.Anything, int64(1)).Return([]knowledgedb.Question{createTestQuestion()}, nil)
+				questionRepo.On("DrawQuestions", mock.Anything, knowledgedb.DrawQuestionsParams{
+					BankID:        3,
+					Topic:         pgtype.Text{String: "algebra", Valid: true},
+					Excluded:      []int64{1},
+					QuestionCount: 2,
+				}).Return([]knowledgedb.Question{{ID: 8}, {ID: 5}}, nil)
+				submissionRepo.On("StartSubmission", mock.Anything, mock.MatchedBy(func(arg knowledgedb.StartSubmissionParams) bool {
+					return slices.Equal(arg.QuestionIds, []int64{1, 8, 5}) && len(arg.OptionOrders) == 3
+				})).Return(started, nil)
+			},
+			expectedResult: &knowledge.TestAttempt{
+				SubmissionID:    10,
+				TestID:          1,
+				Status:          "in_progress",
+				StartedAt:       startedAt.Unix(),
+				Deadline:        func() *int64 { d := startedAt.Add(20 * time.Minute).Unix(); return &d }(),
+				DurationMinutes: intPtr(20),
+			},
+		},
+		{
+			name:      "not enough questions to draw",
+			role:      "student",
+			drawRules: []knowledgedb.DrawRule{{ID: 1, TestID: 1, BankID: 3, QuestionCount: 2}},
+			setupMocks: func(submissionRepo *mocks.MockSubmissionRepository, questionRepo *mocks.MockQuestionRepository) {
+				submissionRepo.On("GetInProgressSubmission", mock.Anything, mock.AnythingOfType("knowledgedb.GetInProgressSubmissionParams")).Return(knowledgedb.TestSubmission{}, pgx.ErrNoRows)
+				questionRepo.On("GetQuestionsByTestId", mock.Anything, int64(1)).Return([]knowledgedb.Question{}, nil)
+				questionRepo.On("DrawQuestions", mock.Anything, mock.AnythingOfType("knowledgedb.DrawQuestionsParams")).Return([]knowledgedb.Question{{ID: 8}}, nil)
+			},
+			expectedError: knowledge.InvalidInput("The question banks of this test do not have enough questions to draw"),
+		},
+		{
+			name: "already completed",
+			role: "student",
+			setupMocks: func(submissionRepo *mocks.MockSubmissionRepository, _ *mocks.MockQuestionRepository) {
+				submissionRepo.On("GetInProgressSubmission", mock.Anything, mock.AnythingOfType("knowledgedb.GetInProgressSubmissionParams")).Return(knowledgedb.TestSubmission{}, pgx.ErrNoRows)
+			},
+			attempts:      1,
+			expectedError: knowledge.TestAlreadySubmitted("You have already completed this test"),
+		},
+		{
+			name:          "unauthorized - not a student",
+			role:          "teacher",
+			setupMocks:    func(*mocks.MockSubmissionRepository, *mocks.MockQuestionRepository) {},
+			expectedError: knowledge.Unauthorized("Only students can take tests"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup mocks
+			testRepo := &mocks.MockTestRepository{}
+			questionRepo := &mocks.MockQuestionRepository{}
+			submissionRepo := &mocks.MockSubmissionRepository{}
+			profilesRepo := &mocks.MockProfilesServiceRepository{}
+			profilesRepo.On("GetCompleteProfile", mock.Anything, mock.AnythingOfType("*profiles.GetCompleteProfilePayload")).Return(createTestCompleteProfile(tt.role), nil)
+			test := createTestTest()
+			test.DurationMinutes = pgtype.Int4{Int32: 20, Valid: true}
+			test.ShuffleOptions = tt.shuffleOptions
+			testRepo.On("GetTestById", mock.Anything, int64(1)).Return(test, nil).Maybe()
+			testRepo.On("GetDrawRulesByTestId", mock.Anything, int64(1)).Return(tt.drawRules, nil).Maybe()
+			submissionRepo.On("GetAttemptSummary", mock.Anything, mock.AnythingOfType("knowledgedb.GetAttemptSummaryParams")).Return(knowledgedb.GetAttemptSummaryRow{Attempts: tt.attempts}, nil).Maybe()
+			tt.setupMocks(submissionRepo, questionRepo)
+
+			// Create service
+			service := setupTestService(testRepo, questionRepo, submissionRepo, nil, profilesRepo)
+
+			// Call method
+			result, err := service.StartTest(context.Background(), &knowledge.StartTestPayload{
+				SessionToken: "valid_token",
+				TestID:       1,
+			})
+
+			// Assertions
+			if tt.expectedError != nil {
+				assert.Error(t, err)
+				assert.Equal(t, tt.expectedError.Error(), err.Error())
+				assert.Nil(t, result)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedResult, result)
+			}
+
+			// Verify all expectations were met
+			submissionRepo.AssertExpectations(t)
+			questionRepo.AssertExpectations(t)
+		})
+	}
+}
+
+// Test AddDrawRule endpoint
+func TestAddDrawRule(t *testing.T) {
+	easy := knowledge.Difficulty("easy")
+
+	tests := []struct {
+		name          string
+		payload       *knowledge.AddDrawRulePayload
+		bank          knowledgedb.QuestionBank
+		available     int64
+		setupMocks    func(*mocks.MockTestRepository)
+		expectedError error
+	}{
+		{
+			name:      "successful creation",
+			payload:   &knowledge.AddDrawRulePayload{SessionToken: "valid_token", TestID: 1, BankID: 3, Topic: stringPtr(" algebra "), Difficulty: &easy, QuestionCount: 5},
+			bank:      knowledgedb.QuestionBank{ID: 3, CreatedBy: 1},
+			available: 8,
+			setupMocks: func(testRepo *mocks.MockTestRepository) {
+				testRepo.On("CreateDrawRule", mock.Anything, knowledgedb.CreateDrawRuleParams{
+					TestID:        1,
+					BankID:        3,
+					Topic:         pgtype.Text{String: "algebra", Valid: true},
+					Difficulty:    pgtype.Text{String: "easy", Valid: true},
+					QuestionCount: 5,
+				}).Return(nil)
+			},
+		},
+		{
+			name:          "not enough questions in the bank",
+			payload:       &knowledge.AddDrawRulePayload{SessionToken: "valid_token", TestID: 1, BankID: 3, QuestionCount: 5},
+			bank:          knowledgedb.QuestionBank{ID: 3, CreatedBy: 1},
+			available:     3,
+			setupMocks:    func(*mocks.MockTestRepository) {},
+			expectedError: knowledge.InvalidInput("The question bank only has 3 matching questions"),
+		},
+		{
+			name:          "unauthorized - bank of another teacher",
+			payload:       &knowledge.AddDrawRulePayload{SessionToken: "valid_token", TestID: 1, BankID: 3, QuestionCount: 5},
+			bank:          knowledgedb.QuestionBank{ID: 3, CreatedBy: 2},
+			setupMocks:    func(*mocks.MockTestRepository) {},
+			expectedError: knowledge.Unauthorized("You can only use your own question banks"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup mocks
+			testRepo := &mocks.MockTestRepository{}
+			questionRepo := &mocks.MockQuestionRepository{}
+			bankRepo := &mocks.MockBankRepository{}
+			profilesRepo := &mocks.MockProfilesServiceRepository{}
+			profilesRepo.On("GetCompleteProfile", mock.Anything, mock.AnythingOfType("*profiles.GetCompleteProfilePayload")).Return(createTestCompleteProfile("teacher"), nil)
+			testRepo.On("GetTestById", mock.Anything, int64(1)).Return(createTestTest(), nil)
+			bankRepo.On("GetQuestionBankById", mock.Anything, int64(3)).Return(tt.bank, nil)
+			questionRepo.On("CountBankQuestions", mock.Anything, mock.AnythingOfType("knowledgedb.CountBankQuestionsParams")).Return(tt.available, nil).Maybe()
+			tt.setupMocks(testRepo)
+
+			// Create service
+			service := setupTestService(testRepo, questionRepo, nil, nil, profilesRepo)
+			service.bankRepo = bankRepo
+
+			// Call method
+			result, err := service.AddDrawRule(context.Background(), tt.payload)
+
+			// Assertions
+			if tt.expectedError != nil {
+				assert.Error(t, err)
+				assert.Equal(t, tt.expectedError, err)
+				assert.Nil(t, result)
+			} else {
+				assert.NoError(t, err)
+				assert.True(t, result.Success)
+			}
+
+			// Verify all expectations were met
+			testRepo.AssertExpectations(t)
+			bankRepo.AssertExpectations(t)
+		})
+	}
+}
+
+// Test GetTestAnalytics endpoint
+func TestGetTestAnalytics(t *testing.T) {
+	tests := []struct {
+		name          string
+		payload       *knowledge.GetTestAnalyticsPayload
+		role          string
+		test          knowledgedb.Test
+		readErr       error
+		expectedError error
+	}{
+		{
+			name:    "successful analysis",
+			payload: &knowledge.GetTestAnalyticsPayload{SessionToken: "valid_token", TestID: 1},
+			role:    "teacher",
+			test:    createTestTest(),
+		},
+		{
+			name:          "failing to read submissions",
+			payload:       &knowledge.GetTestAnalyticsPayload{SessionToken: "valid_token", TestID: 1},
+			role:          "teacher",
+			test:          createTestTest(),
+			readErr:       errors.New("connection refused"),
+			expectedError: knowledge.InternalError("Failed to get submissions"),
+		},
+		{
+			name:          "unauthorized - student",
+			payload:       &knowledge.GetTestAnalyticsPayload{SessionToken: "valid_token", TestID: 1},
+			role:          "student",
+			test:          createTestTest(),
+			expectedError: knowledge.Unauthorized("Only teachers can view test analytics"),
+		},
+		{
+			name:          "unauthorized - test of another teacher",
+			payload:       &knowledge.GetTestAnalyticsPayload{SessionToken: "valid_token", TestID: 1},
+			role:          "teacher",
+			test:          knowledgedb.Test{ID: 1, CreatedBy: 2},
+			expectedError: knowledge.Unauthorized("You can only view analytics of your own tests"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup mocks
+			testRepo := &mocks.MockTestRepository{}
+			questionRepo := &mocks.MockQuestionRepository{}
+			submissionRepo := &mocks.MockSubmissionRepository{}
+			answerRepo := &mocks.MockAnswerRepository{}
+			profilesRepo := &mocks.MockProfilesServiceRepository{}
+			service := setupTestService(testRepo, questionRepo, submissionRepo, answerRepo, profilesRepo)
+
+			profilesRepo.On("GetCompleteProfile", mock.Anything, mock.AnythingOfType("*profiles.GetCompleteProfilePayload")).Return(createTestCompleteProfile(tt.role), nil)
+			testRepo.On("GetTestById", mock.Anything, int64(1)).Return(tt.test, nil).Maybe()
+
+			// The first attempt of student 1 was given the questions of the
+			// test, the first attempt of student 2 drew question 5
+			numeric := knowledgedb.Question{ID: 2, TestID: pgtype.Int8{Int64: 1, Valid: true}, QuestionText: "2+2?", QuestionType: "numeric",
+				NumericAnswer: pgtype.Float8{Float64: 4, Valid: true}, QuestionOrder: 2}
+			drawn := knowledgedb.Question{ID: 5, BankID: pgtype.Int8{Int64: 3, Valid: true}, QuestionText: "Is 7 prime?", QuestionType: "multiple_choice",
+				Options: []string{"Yes", "No"}, CorrectOptions: []int32{0}}
+			submissionRepo.On("GetFirstSubmissions", mock.Anything, int64(1)).Return([]knowledgedb.TestSubmission{
+				{ID: 10, TestID: 1, UserID: 1, AttemptNumber: 1, Score: service.Float64ToPgNumeric(100), Status: "submitted"},
+				{ID: 11, TestID: 1, UserID: 2, AttemptNumber: 1, Score: service.Float64ToPgNumeric(50), Status: "submitted"},
+			}, tt.readErr).Maybe()
+			questionRepo.On("GetQuestionsByTestId", mock.Anything, int64(1)).Return([]knowledgedb.Question{createTestQuestion(), numeric}, nil).Maybe()
+			submissionRepo.On("GetAttemptQuestionsByTest", mock.Anything, int64(1)).Return([]knowledgedb.AttemptQuestion{
+				{SubmissionID: 11, QuestionID: 1, Position: 1},
+				{SubmissionID: 11, QuestionID: 5, Position: 2},
+			}, nil).Maybe()
+			questionRepo.On("GetQuestionsByIds", mock.Anything, []int64{5}).Return([]knowledgedb.Question{drawn}, nil).Maybe()
+			answerRepo.On("GetAnswersByTest", mock.Anything, int64(1)).Return([]knowledgedb.AnswerSubmission{
+				{SubmissionID: 10, QuestionID: 1, SelectedOptions: []int32{1}, Credit: 1, IsCorrect: true},
+				{SubmissionID: 10, QuestionID: 2, NumericAnswer: pgtype.Float8{Float64: 4, Valid: true}, Credit: 1, IsCorrect: true},
+				{SubmissionID: 11, QuestionID: 1, SelectedOptions: []int32{0}},
+				{SubmissionID: 11, QuestionID: 5, SelectedOptions: []int32{0}, Credit: 1, IsCorrect: true},
+				// A retake of student 1 is not analyzed
+				{SubmissionID: 12, QuestionID: 1, SelectedOptions: []int32{2}},
+			}, nil).Maybe()
+
+			// Call method
+			result, err := service.GetTestAnalytics(context.Background(), tt.payload)
+
+			// Assertions
+			if tt.expectedError != nil {
+				assert.Error(t, err)
+				assert.Equal(t, tt.expectedError, err)
+				assert.Nil(t, result)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, 2, result.Attempts)
+			assert.InDelta(t, 75.0, result.Mean, 1e-9)
+			assert.InDelta(t, 75.0, result.Median, 1e-9)
+			assert.InDelta(t, 25.0, result.StdDev, 1e-9)
+			assert.Len(t, result.ScoreHistogram, 10)
+			assert.Equal(t, 1, result.ScoreHistogram[5].Count)
+			assert.Equal(t, 1, result.ScoreHistogram[9].Count)
+			assert.Nil(t, result.Reliability)
+
+			assert.Len(t, result.Questions, 3)
+			first := result.Questions[0]
+			assert.Equal(t, int64(1), first.QuestionID)
+			assert.Equal(t, 2, first.Attempts)
+			assert.InDelta(t, 0.5, first.DifficultyIndex, 1e-9)
+			assert.NotNil(t, first.DiscriminationIndex)
+			assert.InDelta(t, 1.0, *first.DiscriminationIndex, 1e-9)
+			assert.Equal(t, []*knowledge.OptionSelection{
+				{Index: 0, Option: "3", Count: 1, IsCorrect: false},
+				{Index: 1, Option: "4", Count: 1, IsCorrect: true},
+				{Index: 2, Option: "5", Count: 0, IsCorrect: false},
+				{Index: 3, Option: "6", Count: 0, IsCorrect: false},
+			}, first.OptionSelections)
+
+			assert.Equal(t, int64(2), result.Questions[1].QuestionID)
+			assert.Equal(t, 1, result.Questions[1].Attempts)
+			assert.Empty(t, result.Questions[1].OptionSelections)
+			assert.Equal(t, 0, result.Questions[1].Unanswered)
+
+			assert.Equal(t, int64(5), result.Questions[2].QuestionID)
+			assert.Equal(t, 1, result.Questions[2].Attempts)
+			assert.InDelta(t, 1.0, result.Questions[2].DifficultyIndex, 1e-9)
+
+			// Verify all expectations were met
+			submissionRepo.AssertExpectations(t)
+			questionRepo.AssertExpectations(t)
+			answerRepo.AssertExpectations(t)
+		})
+	}
+}
+
+// Test GetQuestionByID endpoint
+func TestGetQuestionByID(t *testing.T) {
+	tests := []struct {
+		name                   string
+		role                   string
+		createdBy              int64
+		expectedCorrectOptions []int
+		expectedError          error
+	}{
+		{
+			name:                   "teacher of the test sees the answer",
+			role:                   "teacher",
+			createdBy:              1,
+			expectedCorrectOptions: []int{1},
+		},
+		{
+			name:                   "student does not see the answer",
+			role:                   "student",
+			createdBy:              2,
+			expectedCorrectOptions: []int{},
+		},
+		{
+			name:          "unauthorized - teacher of another test",
+			role:          "teacher",
+			createdBy:     2,
+			expectedError: knowledge.Unauthorized("Access denied: You can only view questions from your own tests"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup mocks
+			testRepo := &mocks.MockTestRepository{}
+			questionRepo := &mocks.MockQuestionRepository{}
+			submissionRepo := &mocks.MockSubmissionRepository{}
+			profilesRepo := &mocks.MockProfilesServiceRepository{}
+			profilesRepo.On("GetCompleteProfile", mock.Anything, mock.AnythingOfType("*profiles.GetCompleteProfilePayload")).Return(createTestCompleteProfile(tt.role), nil)
+			test := createTestTest()
+			test.CreatedBy = tt.createdBy
+			testRepo.On("GetTestById", mock.Anything, int64(1)).Return(test, nil)
+			submissionRepo.On("GetAttemptSummary", mock.Anything, mock.AnythingOfType("knowledgedb.GetAttemptSummaryParams")).Return(knowledgedb.GetAttemptSummaryRow{}, nil).Maybe()
+			questionRepo.On("GetQuestionById", mock.Anything, int64(1)).Return(createTestQuestion(), nil).Maybe()
+
+			// Create service
+			service := setupTestService(testRepo, questionRepo, submissionRepo, nil, profilesRepo)
+
+			// Call method
+			result, err := service.GetQuestionByID(context.Background(), &knowledge.GetQuestionByIDPayload{
+				SessionToken: "valid_token",
+				TestID:       1,
+				QuestionID:   1,
+			})
+
+			// Assertions
+			if tt.expectedError != nil {
+				assert.Equal(t, tt.expectedError, err)
+				assert.Nil(t, result)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedCorrectOptions, result.Question.CorrectOptions)
+				assert.Equal(t, []string{"3", "4", "5", "6"}, result.Question.Options)
+			}
+		})
+	}
+}
+
 // Test GetAvailableTests endpoint
 func TestGetAvailableTests(t *testing.T) {
 	tests := []struct {
@@ -569,6 +1480,33 @@ func TestGetMySubmissions(t *testing.T) {
 			},
 			expectedError: nil,
 		},
+		{
+			name: "attempt history counts the latest score",
+			payload: &knowledge.GetMySubmissionsPayload{
+				SessionToken: "valid_token",
+			},
+			setupMocks: func(submissionRepo *mocks.MockSubmissionRepository, profilesRepo *mocks.MockProfilesServiceRepository) {
+				profilesRepo.On("GetCompleteProfile", mock.Anything, &profiles.GetCompleteProfilePayload{
+					SessionToken: "valid_token",
+				}).Return(createTestCompleteProfile("student"), nil)
+
+				service := &knowledgesvrc{}
+				submissionData := []knowledgedb.GetUserSubmissionsRow{
+					{ID: 2, TestID: 1, UserID: 1, AttemptNumber: 2, Score: service.Float64ToPgNumeric(60), Status: "submitted", TestTitle: "Test Quiz", ScorePolicy: "latest"},
+					{ID: 1, TestID: 1, UserID: 1, AttemptNumber: 1, Score: service.Float64ToPgNumeric(80), Status: "submitted", TestTitle: "Test Quiz", ScorePolicy: "latest"},
+				}
+				submissionRepo.On("GetUserSubmissions", mock.Anything, int64(1)).Return(submissionData, nil)
+			},
+			expectedResult: &knowledge.SubmissionsResponse{
+				Submissions: []*knowledge.Submission{
+					{ID: 2, TestID: 1, TestTitle: "Test Quiz", Score: 60, AttemptNumber: 2},
+					{ID: 1, TestID: 1, TestTitle: "Test Quiz", Score: 80, AttemptNumber: 1},
+				},
+				TestScores: []*knowledge.TestScore{
+					{TestID: 1, TestTitle: "Test Quiz", ScorePolicy: "latest", Attempts: 2, Score: 60},
+				},
+			},
+		},
 		{
 			name: "unauthorized - not a student",
 			payload: &knowledge.GetMySubmissionsPayload{
@@ -611,6 +1549,9 @@ func TestGetMySubmissions(t *testing.T) {
 					assert.Equal(t, tt.expectedResult.Submissions[0].TestID, result.Submissions[0].TestID)
 					assert.Equal(t, tt.expectedResult.Submissions[0].TestTitle, result.Submissions[0].TestTitle)
 				}
+				if tt.expectedResult.TestScores != nil {
+					assert.Equal(t, tt.expectedResult.TestScores, result.TestScores)
+				}
 			}
 
 			// Verify all expectations were met
@@ -624,3 +1565,233 @@ func TestGetMySubmissions(t *testing.T) {
 func intPtr(i int) *int {
 	return &i
 }
+
+// Helper function for float64 pointer
+func float64Ptr(f float64) *float64 {
+	return &f
+}
+
+// Test GetTestGradebook endpoint
+func TestGetTestGradebook(t *testing.T) {
+	submitted := knowledge.AttemptStatus("submitted")
+
+	tests := []struct {
+		name          string
+		payload       *knowledge.GetTestGradebookPayload
+		role          string
+		test          knowledgedb.Test
+		readErr       error
+		expectedIDs   []int64
+		expectedError error
+	}{
+		{
+			name:        "newest first by default",
+			payload:     &knowledge.GetTestGradebookPayload{SessionToken: "valid_token", TestID: 1, SortBy: "submitted_at", SortOrder: "desc"},
+			role:        "teacher",
+			test:        createTestTest(),
+			expectedIDs: []int64{3, 2, 1},
+		},
+		{
+			name:        "sorted by score",
+			payload:     &knowledge.GetTestGradebookPayload{SessionToken: "valid_token", TestID: 1, SortBy: "score", SortOrder: "asc"},
+			role:        "teacher",
+			test:        createTestTest(),
+			expectedIDs: []int64{2, 1, 3},
+		},
+		{
+			name:        "sorted by student name",
+			payload:     &knowledge.GetTestGradebookPayload{SessionToken: "valid_token", TestID: 1, SortBy: "student_name", SortOrder: "asc"},
+			role:        "teacher",
+			test:        createTestTest(),
+			expectedIDs: []int64{2, 1, 3},
+		},
+		{
+			name:        "filtered by status",
+			payload:     &knowledge.GetTestGradebookPayload{SessionToken: "valid_token", TestID: 1, SortBy: "submitted_at", SortOrder: "desc", Status: &submitted},
+			role:        "teacher",
+			test:        createTestTest(),
+			expectedIDs: []int64{3, 1},
+		},
+		{
+			name:        "filtered by student name",
+			payload:     &knowledge.GetTestGradebookPayload{SessionToken: "valid_token", TestID: 1, SortBy: "submitted_at", SortOrder: "desc", Student: stringPtr(" ana ")},
+			role:        "teacher",
+			test:        createTestTest(),
+			expectedIDs: []int64{2, 1},
+		},
+		{
+			name:          "failing to read submissions",
+			payload:       &knowledge.GetTestGradebookPayload{SessionToken: "valid_token", TestID: 1, SortBy: "submitted_at", SortOrder: "desc"},
+			role:          "teacher",
+			test:          createTestTest(),
+			readErr:       errors.New("connection refused"),
+			expectedError: knowledge.InternalError("Failed to get submissions"),
+		},
+		{
+			name:          "unauthorized - student",
+			payload:       &knowledge.GetTestGradebookPayload{SessionToken: "valid_token", TestID: 1, SortBy: "submitted_at", SortOrder: "desc"},
+			role:          "student",
+			test:          createTestTest(),
+			expectedError: knowledge.Unauthorized("Only teachers can view the gradebook"),
+		},
+		{
+			name:          "unauthorized - test of another teacher",
+			payload:       &knowledge.GetTestGradebookPayload{SessionToken: "valid_token", TestID: 1, SortBy: "submitted_at", SortOrder: "desc"},
+			role:          "teacher",
+			test:          knowledgedb.Test{ID: 1, CreatedBy: 2},
+			expectedError: knowledge.Unauthorized("You can only view the gradebook of your own tests"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup mocks
+			testRepo := &mocks.MockTestRepository{}
+			submissionRepo := &mocks.MockSubmissionRepository{}
+			profilesRepo := &mocks.MockProfilesServiceRepository{}
+			service := setupTestService(testRepo, nil, submissionRepo, nil, profilesRepo)
+
+			profilesRepo.On("GetCompleteProfile", mock.Anything, mock.AnythingOfType("*profiles.GetCompleteProfilePayload")).Return(createTestCompleteProfile(tt.role), nil)
+			testRepo.On("GetTestById", mock.Anything, int64(1)).Return(tt.test, nil).Maybe()
+
+			// Student 2 took the test twice, and the profile of student 3
+			// cannot be retrieved
+			now := time.Now()
+			submissionRepo.On("GetTestSubmissions", mock.Anything, int64(1)).Return([]knowledgedb.TestSubmission{
+				{ID: 3, TestID: 1, UserID: 3, AttemptNumber: 1, Score: service.Float64ToPgNumeric(90), Status: "submitted",
+					SubmittedAt: pgtype.Timestamptz{Time: now, Valid: true}},
+				{ID: 2, TestID: 1, UserID: 2, AttemptNumber: 2, Score: service.Float64ToPgNumeric(0), Status: "expired",
+					SubmittedAt: pgtype.Timestamptz{Time: now.Add(-time.Hour), Valid: true}},
+				{ID: 1, TestID: 1, UserID: 2, AttemptNumber: 1, Score: service.Float64ToPgNumeric(70), Status: "submitted",
+					SubmittedAt: pgtype.Timestamptz{Time: now.Add(-2 * time.Hour), Valid: true}},
+			}, tt.readErr).Maybe()
+			profilesRepo.On("GetPublicProfilesByIds", mock.Anything, mock.MatchedBy(func(payload *profiles.GetPublicProfilesByIdsPayload) bool {
+				return slices.Equal(slices.Sorted(slices.Values(payload.UserIds)), []int64{2, 3})
+			})).Return(&profiles.PublicProfilesResponse{Profiles: []*profiles.PublicProfileResponse{
+				{UserID: 2, FirstName: "Ana", LastName: "Lopez"},
+			}}, nil).Maybe()
+
+			// Call method
+			result, err := service.GetTestGradebook(context.Background(), tt.payload)
+
+			// Assertions
+			if tt.expectedError != nil {
+				assert.Error(t, err)
+				assert.Equal(t, tt.expectedError, err)
+				assert.Nil(t, result)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, int64(1), result.Test.ID)
+			var ids []int64
+			for _, entry := range result.Submissions {
+				ids = append(ids, entry.Submission.ID)
+				if entry.UserID == 2 {
+					assert.Equal(t, "Ana Lopez", entry.StudentName)
+				} else {
+					assert.Equal(t, "User_3", entry.StudentName)
+				}
+			}
+			assert.Equal(t, tt.expectedIDs, ids)
+
+			// Every student is looked up in a single call
+			profilesRepo.AssertNumberOfCalls(t, "GetPublicProfilesByIds", 1)
+		})
+	}
+}
+
+// Test GetStudentHistory endpoint
+func TestGetStudentHistory(t *testing.T) {
+	tests := []struct {
+		name           string
+		role           string
+		userID         int64
+		expectedResult *knowledge.StudentHistory
+		expectedError  error
+	}{
+		{
+			name:   "successful retrieval",
+			role:   "teacher",
+			userID: 2,
+			expectedResult: &knowledge.StudentHistory{
+				UserID:      2,
+				StudentName: "Ana Lopez",
+				Submissions: []*knowledge.Submission{
+					{ID: 3, TestID: 5, TestTitle: "Algebra", Score: 40, Status: "submitted", AttemptNumber: 1},
+					{ID: 2, TestID: 1, TestTitle: "Test Quiz", Score: 90, Status: "submitted", AttemptNumber: 2},
+					{ID: 1, TestID: 1, TestTitle: "Test Quiz", Score: 70, Status: "submitted", AttemptNumber: 1},
+				},
+				TestScores: []*knowledge.TestScore{
+					{TestID: 5, TestTitle: "Algebra", ScorePolicy: "average", Attempts: 1, Score: 40},
+					{TestID: 1, TestTitle: "Test Quiz", ScorePolicy: "best", Attempts: 2, Score: 90},
+				},
+			},
+		},
+		{
+			name:          "student without submissions at the tests of the teacher",
+			role:          "teacher",
+			userID:        4,
+			expectedError: knowledge.SubmissionNotFound("This student has no submissions at your tests"),
+		},
+		{
+			name:          "failing to read submissions",
+			role:          "teacher",
+			userID:        5,
+			expectedError: knowledge.InternalError("Failed to get submissions"),
+		},
+		{
+			name:          "unauthorized - student",
+			role:          "student",
+			userID:        2,
+			expectedError: knowledge.Unauthorized("Only teachers can view student history"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup mocks
+			submissionRepo := &mocks.MockSubmissionRepository{}
+			profilesRepo := &mocks.MockProfilesServiceRepository{}
+			service := setupTestService(nil, nil, submissionRepo, nil, profilesRepo)
+
+			profilesRepo.On("GetCompleteProfile", mock.Anything, mock.AnythingOfType("*profiles.GetCompleteProfilePayload")).Return(createTestCompleteProfile(tt.role), nil)
+			profilesRepo.On("GetPublicProfilesByIds", mock.Anything, &profiles.GetPublicProfilesByIdsPayload{UserIds: []int64{2}}).
+				Return(&profiles.PublicProfilesResponse{Profiles: []*profiles.PublicProfileResponse{
+					{UserID: 2, FirstName: "Ana", LastName: "Lopez"},
+				}}, nil).Maybe()
+
+			// Only the tests of teacher 1 are asked for, and student 4 took
+			// none of them
+			submissionRepo.On("GetStudentSubmissionsByTeacher", mock.Anything, knowledgedb.GetStudentSubmissionsByTeacherParams{UserID: 2, CreatedBy: 1}).
+				Return([]knowledgedb.GetStudentSubmissionsByTeacherRow{
+					{ID: 3, TestID: 5, UserID: 2, AttemptNumber: 1, Score: service.Float64ToPgNumeric(40), Status: "submitted", TestTitle: "Algebra", ScorePolicy: "average"},
+					{ID: 2, TestID: 1, UserID: 2, AttemptNumber: 2, Score: service.Float64ToPgNumeric(90), Status: "submitted", TestTitle: "Test Quiz", ScorePolicy: "best"},
+					{ID: 1, TestID: 1, UserID: 2, AttemptNumber: 1, Score: service.Float64ToPgNumeric(70), Status: "submitted", TestTitle: "Test Quiz", ScorePolicy: "best"},
+				}, nil).Maybe()
+			submissionRepo.On("GetStudentSubmissionsByTeacher", mock.Anything, knowledgedb.GetStudentSubmissionsByTeacherParams{UserID: 4, CreatedBy: 1}).
+				Return([]knowledgedb.GetStudentSubmissionsByTeacherRow{}, nil).Maybe()
+			submissionRepo.On("GetStudentSubmissionsByTeacher", mock.Anything, knowledgedb.GetStudentSubmissionsByTeacherParams{UserID: 5, CreatedBy: 1}).
+				Return([]knowledgedb.GetStudentSubmissionsByTeacherRow(nil), errors.New("connection refused")).Maybe()
+
+			// Call method
+			result, err := service.GetStudentHistory(context.Background(), &knowledge.GetStudentHistoryPayload{
+				SessionToken: "valid_token",
+				UserID:       tt.userID,
+			})
+
+			// Assertions
+			if tt.expectedError != nil {
+				assert.Error(t, err)
+				assert.Equal(t, tt.expectedError, err)
+				assert.Nil(t, result)
+			} else {
+				assert.NoError(t, err)
+				for _, submission := range result.Submissions {
+					submission.SubmittedAt, submission.StartedAt = 0, 0
+				}
+				assert.Equal(t, tt.expectedResult, result)
+			}
+		})
+	}
+}
@@ -1,10 +1,23 @@
 package controllers
 
 import (
+	"cmp"
+	"context"
+	"errors"
 	"fmt"
+	"slices"
+	"strconv"
+	"strings"
 	"time"
 
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/jackc/pgx/v5/pgtype"
+	knowledgedb "github.com/ynoacamino/infra-sustainable-classrooms/services/knowledge/gen/database"
+	"github.com/ynoacamino/infra-sustainable-classrooms/services/knowledge/gen/knowledge"
+	"github.com/ynoacamino/infra-sustainable-classrooms/services/knowledge/internal/analytics"
+	"github.com/ynoacamino/infra-sustainable-classrooms/services/knowledge/internal/grading"
+	"github.com/ynoacamino/infra-sustainable-classrooms/services/profiles/gen/profiles"
 )
 
 // Helper to convert int64 timestamp to pgtype.Timestamptz
@@ -42,6 +55,26 @@ func (s *knowledgesvrc) PgInt4ToInt32(i pgtype.Int4) int32 {
 	return i.Int32
 }
 
+// Helper to convert *int to pgtype.Int4
+func (s *knowledgesvrc) IntPtrToPgInt4(i *int) pgtype.Int4 {
+	if i == nil {
+		return pgtype.Int4{Valid: false}
+	}
+	return pgtype.Int4{
+		Int32: int32(*i),
+		Valid: true,
+	}
+}
+
+// Helper to convert pgtype.Int4 to *int
+func (s *knowledgesvrc) PgInt4ToIntPtr(i pgtype.Int4) *int {
+	if !i.Valid {
+		return nil
+	}
+	v := int(i.Int32)
+	return &v
+}
+
 // Helper to convert bool to pgtype.Bool
 func (s *knowledgesvrc) BoolToPgBool(b bool) pgtype.Bool {
 	return pgtype.Bool{
@@ -83,3 +116,567 @@ func (s *knowledgesvrc) PgNumericToFloat64(num pgtype.Numeric) float64 {
 	}
 	return f.Float64
 }
+
+// Helper to convert *float64 to pgtype.Float8
+func (s *knowledgesvrc) Float64PtrToPgFloat8(f *float64) pgtype.Float8 {
+	if f == nil {
+		return pgtype.Float8{Valid: false}
+	}
+	return pgtype.Float8{
+		Float64: *f,
+		Valid:   true,
+	}
+}
+
+// Helper to convert pgtype.Float8 to *float64
+func (s *knowledgesvrc) PgFloat8ToFloat64Ptr(f pgtype.Float8) *float64 {
+	if !f.Valid {
+		return nil
+	}
+	return &f.Float64
+}
+
+// Helper to convert *string to pgtype.Text
+func (s *knowledgesvrc) StringPtrToPgText(str *string) pgtype.Text {
+	if str == nil {
+		return pgtype.Text{Valid: false}
+	}
+	return pgtype.Text{
+		String: *str,
+		Valid:  true,
+	}
+}
+
+// Helper to convert pgtype.Text to *string
+func (s *knowledgesvrc) PgTextToStringPtr(t pgtype.Text) *string {
+	if !t.Valid {
+		return nil
+	}
+	return &t.String
+}
+
+// Helper to convert []int to []int32, never nil as arrays are stored NOT NULL
+func (s *knowledgesvrc) IntsToInt32s(values []int) []int32 {
+	result := make([]int32, len(values))
+	for i, v := range values {
+		result[i] = int32(v)
+	}
+	return result
+}
+
+// Helper to convert []int32 to []int
+func (s *knowledgesvrc) Int32sToInts(values []int32) []int {
+	result := make([]int, len(values))
+	for i, v := range values {
+		result[i] = int(v)
+	}
+	return result
+}
+
+// gradingQuestion returns what is needed to grade answers to a stored question
+func (s *knowledgesvrc) gradingQuestion(q knowledgedb.Question) grading.Question {
+	return grading.Question{
+		Type:            grading.Type(q.QuestionType),
+		Options:         q.Options,
+		CorrectOptions:  s.Int32sToInts(q.CorrectOptions),
+		NumericAnswer:   s.PgFloat8ToFloat64Ptr(q.NumericAnswer),
+		Tolerance:       q.Tolerance,
+		AcceptedAnswers: q.AcceptedAnswers,
+	}
+}
+
+// gradingAnswer returns the answer of a student to be graded
+func gradingAnswer(a *knowledge.Answer) grading.Answer {
+	return grading.Answer{
+		SelectedOptions: a.SelectedOptions,
+		NumericAnswer:   a.NumericAnswer,
+		TextAnswer:      a.TextAnswer,
+	}
+}
+
+// questionToAPI converts a stored question, with its correct answer
+func (s *knowledgesvrc) questionToAPI(q knowledgedb.Question) *knowledge.Question {
+	return &knowledge.Question{
+		ID:              q.ID,
+		TestID:          q.TestID.Int64,
+		QuestionText:    q.QuestionText,
+		QuestionType:    knowledge.QuestionType(q.QuestionType),
+		Options:         q.Options,
+		CorrectOptions:  s.Int32sToInts(q.CorrectOptions),
+		NumericAnswer:   s.PgFloat8ToFloat64Ptr(q.NumericAnswer),
+		Tolerance:       q.Tolerance,
+		AcceptedAnswers: q.AcceptedAnswers,
+		QuestionOrder:   int(q.QuestionOrder),
+	}
+}
+
+// getActiveAttempt returns the attempt of the user at the test that is still
+// in progress, or nil when there is none. An attempt whose deadline passed more
+// than the grace period ago is closed as expired, and reported as time_expired
+// when reportExpired is set or as no attempt otherwise.
+func (s *knowledgesvrc) getActiveAttempt(ctx context.Context, userID, testID int64, reportExpired bool) (*knowledgedb.TestSubmission, error) {
+	attempt, err := s.submissionRepo.GetInProgressSubmission(ctx, knowledgedb.GetInProgressSubmissionParams{
+		UserID: userID,
+		TestID: testID,
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, knowledge.InternalError("Failed to get attempt")
+	}
+
+	if attempt.Deadline.Valid && time.Now().After(attempt.Deadline.Time.Add(s.gracePeriod)) {
+		if err := s.submissionRepo.ExpireSubmission(ctx, attempt.ID); err != nil {
+			return nil, knowledge.InternalError("Failed to close expired attempt")
+		}
+		if !reportExpired {
+			return nil, nil
+		}
+		return nil, knowledge.TimeExpired("The time limit of this test has passed")
+	}
+	return &attempt, nil
+}
+
+// attemptToAPI converts an attempt at a test
+func (s *knowledgesvrc) attemptToAPI(attempt knowledgedb.TestSubmission, test knowledgedb.Test) *knowledge.TestAttempt {
+	res := &knowledge.TestAttempt{
+		SubmissionID:    attempt.ID,
+		TestID:          attempt.TestID,
+		Status:          knowledge.AttemptStatus(attempt.Status),
+		StartedAt:       attempt.StartedAt.Time.Unix(),
+		DurationMinutes: s.PgInt4ToIntPtr(test.DurationMinutes),
+	}
+	if attempt.Deadline.Valid {
+		deadline := attempt.Deadline.Time.Unix()
+		res.Deadline = &deadline
+	}
+	return res
+}
+
+// testToAPI converts a stored test, without its question count
+func (s *knowledgesvrc) testToAPI(test knowledgedb.Test) *knowledge.Test {
+	return &knowledge.Test{
+		ID:               test.ID,
+		Title:            test.Title,
+		CreatedBy:        test.CreatedBy,
+		CreatedAt:        test.CreatedAt.Time.Unix(),
+		DurationMinutes:  s.PgInt4ToIntPtr(test.DurationMinutes),
+		MaxAttempts:      int(test.MaxAttempts),
+		CooldownMinutes:  int(test.CooldownMinutes),
+		ScorePolicy:      knowledge.ScorePolicy(test.ScorePolicy),
+		RevealAnswers:    test.RevealAnswers,
+		ShuffleQuestions: test.ShuffleQuestions,
+		ShuffleOptions:   test.ShuffleOptions,
+	}
+}
+
+// submissionToAPI converts a finished attempt at a test
+func (s *knowledgesvrc) submissionToAPI(submission knowledgedb.TestSubmission, testTitle string) *knowledge.Submission {
+	return &knowledge.Submission{
+		ID:            submission.ID,
+		TestID:        submission.TestID,
+		TestTitle:     testTitle,
+		Score:         s.PgNumericToFloat64(submission.Score),
+		SubmittedAt:   submission.SubmittedAt.Time.Unix(),
+		Status:        knowledge.AttemptStatus(submission.Status),
+		StartedAt:     submission.StartedAt.Time.Unix(),
+		AttemptNumber: int(submission.AttemptNumber),
+	}
+}
+
+// submissionsWithScores converts submissions, which come newest first, and
+// counts the score of every test taken over its attempts
+func (s *knowledgesvrc) submissionsWithScores(submissions []knowledgedb.GetUserSubmissionsRow) ([]*knowledge.Submission, []*knowledge.TestScore) {
+	var response []*knowledge.Submission
+	var testScores []*knowledge.TestScore
+	scoresByTest := make(map[int64][]float64)
+	for _, submission := range submissions {
+		response = append(response, &knowledge.Submission{
+			ID:            submission.ID,
+			TestID:        submission.TestID,
+			TestTitle:     submission.TestTitle,
+			Score:         s.PgNumericToFloat64(submission.Score),
+			SubmittedAt:   submission.SubmittedAt.Time.Unix(),
+			Status:        knowledge.AttemptStatus(submission.Status),
+			StartedAt:     submission.StartedAt.Time.Unix(),
+			AttemptNumber: int(submission.AttemptNumber),
+		})
+
+		if _, seen := scoresByTest[submission.TestID]; !seen {
+			testScores = append(testScores, &knowledge.TestScore{
+				TestID:      submission.TestID,
+				TestTitle:   submission.TestTitle,
+				ScorePolicy: knowledge.ScorePolicy(submission.ScorePolicy),
+			})
+		}
+		scoresByTest[submission.TestID] = append(scoresByTest[submission.TestID], s.PgNumericToFloat64(submission.Score))
+	}
+
+	for _, testScore := range testScores {
+		scores := scoresByTest[testScore.TestID]
+		slices.Reverse(scores)
+		testScore.Attempts = len(scores)
+		testScore.Score = grading.CountedScore(grading.ScorePolicy(testScore.ScorePolicy), scores)
+	}
+
+	return response, testScores
+}
+
+// hideAnswer removes the correct answer from a question
+func hideAnswer(q *knowledge.Question) {
+	q.CorrectOptions = []int{}
+	q.NumericAnswer = nil
+	q.Tolerance = 0
+	q.AcceptedAnswers = []string{}
+}
+
+// checkAttemptsLeft returns a test_already_submitted error when the user has
+// used every attempt the test allows
+func (s *knowledgesvrc) checkAttemptsLeft(ctx context.Context, userID int64, test knowledgedb.Test) (knowledgedb.GetAttemptSummaryRow, error) {
+	summary, err := s.submissionRepo.GetAttemptSummary(ctx, knowledgedb.GetAttemptSummaryParams{
+		UserID: userID,
+		TestID: test.ID,
+	})
+	if err != nil {
+		return summary, knowledge.InvalidInput("Failed to check completion status: " + err.Error())
+	}
+
+	if test.MaxAttempts > 0 && summary.Attempts >= int64(test.MaxAttempts) {
+		if test.MaxAttempts == 1 {
+			return summary, knowledge.TestAlreadySubmitted("You have already completed this test")
+		}
+		return summary, knowledge.TestAlreadySubmitted(fmt.Sprintf("You have used all %d attempts at this test", test.MaxAttempts))
+	}
+	return summary, nil
+}
+
+// checkCanStartAttempt returns an error when the policy of the test does not
+// allow the user a new attempt yet
+func (s *knowledgesvrc) checkCanStartAttempt(ctx context.Context, userID int64, test knowledgedb.Test) error {
+	summary, err := s.checkAttemptsLeft(ctx, userID, test)
+	if err != nil {
+		return err
+	}
+
+	if test.CooldownMinutes > 0 && summary.LastSubmittedAt.Valid {
+		next := summary.LastSubmittedAt.Time.Add(time.Duration(test.CooldownMinutes) * time.Minute)
+		if wait := time.Until(next); wait > 0 {
+			return knowledge.InvalidInput(fmt.Sprintf("You can retake this test in %s", wait.Round(time.Second)))
+		}
+	}
+	return nil
+}
+
+// startRequired reports whether the test is only shown in an attempt started
+// with StartTest, because it is timed, shuffled or draws its questions from
+// question banks
+func (s *knowledgesvrc) startRequired(ctx context.Context, test knowledgedb.Test) (bool, error) {
+	if test.DurationMinutes.Valid || test.ShuffleQuestions || test.ShuffleOptions {
+		return true, nil
+	}
+	rules, err := s.testRepo.GetDrawRulesByTestId(ctx, test.ID)
+	if err != nil {
+		return false, knowledge.InvalidInput("Failed to get draw rules: " + err.Error())
+	}
+	return len(rules) > 0, nil
+}
+
+// drawQuestions picks the questions of a new attempt at a test: the questions
+// of the test itself followed by those drawn at random for each of its draw
+// rules, never the same one twice
+func (s *knowledgesvrc) drawQuestions(ctx context.Context, test knowledgedb.Test) ([]knowledgedb.Question, error) {
+	questions, err := s.questionRepo.GetQuestionsByTestId(ctx, test.ID)
+	if err != nil {
+		return nil, knowledge.InvalidInput("Failed to get questions: " + err.Error())
+	}
+
+	rules, err := s.testRepo.GetDrawRulesByTestId(ctx, test.ID)
+	if err != nil {
+		return nil, knowledge.InvalidInput("Failed to get draw rules: " + err.Error())
+	}
+	for _, rule := range rules {
+		questionIDs := make([]int64, 0, len(questions))
+		for _, question := range questions {
+			questionIDs = append(questionIDs, question.ID)
+		}
+		drawn, err := s.questionRepo.DrawQuestions(ctx, knowledgedb.DrawQuestionsParams{
+			BankID:        rule.BankID,
+			Topic:         rule.Topic,
+			Difficulty:    rule.Difficulty,
+			Excluded:      questionIDs,
+			QuestionCount: rule.QuestionCount,
+		})
+		if err != nil {
+			return nil, knowledge.InvalidInput("Failed to draw questions: " + err.Error())
+		}
+		if len(drawn) < int(rule.QuestionCount) {
+			return nil, knowledge.InvalidInput("The question banks of this test do not have enough questions to draw")
+		}
+		questions = append(questions, drawn...)
+	}
+	return questions, nil
+}
+
+// attemptQuestions returns the questions of an attempt at the test as they
+// are shown. A started attempt shows the questions and order stored when it
+// started, while a test opened without one shows its own questions in order.
+func (s *knowledgesvrc) attemptQuestions(ctx context.Context, test knowledgedb.Test, attempt *knowledgedb.TestSubmission) ([]shownQuestion, error) {
+	if attempt == nil {
+		questions, err := s.questionRepo.GetQuestionsByTestId(ctx, test.ID)
+		if err != nil {
+			return nil, knowledge.InvalidInput("Failed to get questions: " + err.Error())
+		}
+		return s.showQuestions(questions, grading.Shuffle{}), nil
+	}
+
+	stored, err := s.submissionRepo.GetAttemptQuestions(ctx, attempt.ID)
+	if err != nil {
+		return nil, knowledge.InternalError("Failed to get questions: " + err.Error())
+	}
+	if len(stored) == 0 {
+		return nil, knowledge.InternalError("The questions of this attempt were not saved")
+	}
+	shown := make([]shownQuestion, len(stored))
+	for i, row := range stored {
+		shown[i] = shownQuestion{
+			question: row.Question,
+			position: int(row.Position),
+		}
+		// An order that no longer fits the options, because the question was
+		// edited during the attempt, gives way to showing them in order
+		order := s.Int32sToInts(row.OptionOrder)
+		if len(order) > 0 && grading.IsOrder(order, len(row.Question.Options)) {
+			shown[i].optionOrder = order
+		}
+	}
+	return shown, nil
+}
+
+// shownQuestion is a question as shown in an attempt
+type shownQuestion struct {
+	question knowledgedb.Question
+	// position of the question in the attempt, from 1
+	position int
+	// optionOrder holds the canonical index of each option as shown, or is
+	// nil when the options are shown in order
+	optionOrder []int
+}
+
+// options returns the options of the question in the order they are shown
+func (q shownQuestion) options() []string {
+	if q.optionOrder == nil {
+		return q.question.Options
+	}
+	options := make([]string, len(q.optionOrder))
+	for i, index := range q.optionOrder {
+		options[i] = q.question.Options[index]
+	}
+	return options
+}
+
+// optionOrderText is the option order as stored with a new attempt, its
+// indexes separated by commas
+func (q shownQuestion) optionOrderText() string {
+	indexes := make([]string, len(q.optionOrder))
+	for i, index := range q.optionOrder {
+		indexes[i] = strconv.Itoa(index)
+	}
+	return strings.Join(indexes, ",")
+}
+
+// showQuestions puts the questions of a test, sorted by question order, in the
+// order the shuffle shows them
+func (s *knowledgesvrc) showQuestions(questions []knowledgedb.Question, shuffle grading.Shuffle) []shownQuestion {
+	shown := make([]shownQuestion, len(questions))
+	for i, question := range questions {
+		shown[i] = shownQuestion{
+			question:    question,
+			position:    int(question.QuestionOrder),
+			optionOrder: shuffle.OptionOrder(question.ID, s.gradingQuestion(question)),
+		}
+	}
+
+	order := shuffle.QuestionOrder(len(questions))
+	if order == nil {
+		return shown
+	}
+	reordered := make([]shownQuestion, len(shown))
+	for position, index := range order {
+		reordered[position] = shown[index]
+		reordered[position].position = position + 1
+	}
+	return reordered
+}
+
+// getOwnQuestionBank returns a question bank of the user
+func (s *knowledgesvrc) getOwnQuestionBank(ctx context.Context, bankID, userID int64) (knowledgedb.QuestionBank, error) {
+	bank, err := s.bankRepo.GetQuestionBankById(ctx, bankID)
+	if err != nil {
+		return bank, knowledge.InvalidInput("Question bank not found: " + err.Error())
+	}
+	if bank.CreatedBy != userID {
+		return bank, knowledge.Unauthorized("You can only use your own question banks")
+	}
+	return bank, nil
+}
+
+// isStillReferenced reports whether a delete failed because attempts still
+// refer to the questions it would remove
+func isStillReferenced(err error) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.Code == "23503"
+}
+
+// bankQuestionToAPI converts a stored question of a question bank, with its
+// correct answer
+func (s *knowledgesvrc) bankQuestionToAPI(q knowledgedb.Question) *knowledge.BankQuestion {
+	return &knowledge.BankQuestion{
+		ID:              q.ID,
+		BankID:          q.BankID.Int64,
+		QuestionText:    q.QuestionText,
+		QuestionType:    knowledge.QuestionType(q.QuestionType),
+		Options:         q.Options,
+		CorrectOptions:  s.Int32sToInts(q.CorrectOptions),
+		NumericAnswer:   s.PgFloat8ToFloat64Ptr(q.NumericAnswer),
+		Tolerance:       q.Tolerance,
+		AcceptedAnswers: q.AcceptedAnswers,
+		QuestionOrder:   int(q.QuestionOrder),
+		Topic:           q.Topic,
+		Difficulty:      knowledge.Difficulty(q.Difficulty),
+	}
+}
+
+// drawRuleToAPI converts a stored draw rule
+func (s *knowledgesvrc) drawRuleToAPI(rule knowledgedb.DrawRule) *knowledge.DrawRule {
+	res := &knowledge.DrawRule{
+		ID:            rule.ID,
+		TestID:        rule.TestID,
+		BankID:        rule.BankID,
+		Topic:         s.PgTextToStringPtr(rule.Topic),
+		QuestionCount: int(rule.QuestionCount),
+	}
+	if rule.Difficulty.Valid {
+		difficulty := knowledge.Difficulty(rule.Difficulty.String)
+		res.Difficulty = &difficulty
+	}
+	return res
+}
+
+// topicText returns a topic without surrounding spaces, empty when not set
+func topicText(topic *string) string {
+	if topic == nil {
+		return ""
+	}
+	return strings.TrimSpace(*topic)
+}
+
+// answered reports whether a stored answer gives an answer to its question
+func answered(a knowledgedb.AnswerSubmission) bool {
+	return len(a.SelectedOptions) > 0 || a.NumericAnswer.Valid || (a.TextAnswer.Valid && strings.TrimSpace(a.TextAnswer.String) != "")
+}
+
+// analyticsQuestion returns what is needed to analyze a stored question. Only
+// the options of choice questions are counted, since ordering answers select
+// every option.
+func analyticsQuestion(q knowledgedb.Question) analytics.Question {
+	question := analytics.Question{ID: q.ID}
+	switch grading.Type(q.QuestionType) {
+	case grading.MultipleChoice, grading.MultipleSelect, grading.TrueFalse:
+		question.Options = len(q.Options)
+	}
+	return question
+}
+
+// testAnalyticsToAPI converts the analysis of a test given the questions it
+// analyzed, in the same order
+func (s *knowledgesvrc) testAnalyticsToAPI(testID int64, questions []knowledgedb.Question, report analytics.Report) *knowledge.TestAnalytics {
+	res := &knowledge.TestAnalytics{
+		TestID:         testID,
+		Attempts:       report.Attempts,
+		Mean:           report.Scores.Mean,
+		Median:         report.Scores.Median,
+		StdDev:         report.Scores.StdDev,
+		ScoreHistogram: make([]*knowledge.ScoreBin, len(report.Scores.Histogram)),
+		Questions:      make([]*knowledge.QuestionAnalytics, len(report.Items)),
+		Reliability:    report.Reliability,
+	}
+	if report.ReliabilityMethod != "" {
+		res.ReliabilityMethod = &report.ReliabilityMethod
+	}
+	for i, bin := range report.Scores.Histogram {
+		res.ScoreHistogram[i] = &knowledge.ScoreBin{From: bin.From, To: bin.To, Count: bin.Count}
+	}
+	for i, item := range report.Items {
+		question := questions[i]
+		selections := make([]*knowledge.OptionSelection, len(item.OptionCounts))
+		for option, count := range item.OptionCounts {
+			selections[option] = &knowledge.OptionSelection{
+				Index:     option,
+				Option:    question.Options[option],
+				Count:     count,
+				IsCorrect: slices.Contains(question.CorrectOptions, int32(option)),
+			}
+		}
+		res.Questions[i] = &knowledge.QuestionAnalytics{
+			QuestionID:          question.ID,
+			QuestionText:        question.QuestionText,
+			QuestionType:        knowledge.QuestionType(question.QuestionType),
+			Attempts:            item.Attempts,
+			DifficultyIndex:     item.Difficulty,
+			DiscriminationIndex: item.Discrimination,
+			OptionSelections:    selections,
+			Unanswered:          item.Unanswered,
+		}
+	}
+	return res
+}
+
+// studentNames returns the full name of every user, looked up in a single
+// call, falling back to a placeholder for users whose profile cannot be
+// retrieved
+func (s *knowledgesvrc) studentNames(ctx context.Context, userIDs []int64) map[int64]string {
+	names := make(map[int64]string, len(userIDs))
+	var unique []int64
+	for _, userID := range userIDs {
+		if _, seen := names[userID]; seen {
+			continue
+		}
+		names[userID] = fmt.Sprintf("User_%d", userID) // Default fallback
+		unique = append(unique, userID)
+	}
+	if len(unique) == 0 {
+		return names
+	}
+
+	publicProfiles, err := s.profilesServiceRepo.GetPublicProfilesByIds(ctx, &profiles.GetPublicProfilesByIdsPayload{
+		UserIds: unique,
+	})
+	if err != nil {
+		return names
+	}
+	for _, publicProfile := range publicProfiles.Profiles {
+		names[publicProfile.UserID] = fmt.Sprintf("%s %s", publicProfile.FirstName, publicProfile.LastName)
+	}
+	return names
+}
+
+// sortStudentSubmissions sorts submissions by a field of the gradebook,
+// keeping the newest first among equal ones
+func sortStudentSubmissions(submissions []*knowledge.StudentSubmission, sortBy, sortOrder string) {
+	slices.SortStableFunc(submissions, func(a, b *knowledge.StudentSubmission) int {
+		var c int
+		switch sortBy {
+		case "score":
+			c = cmp.Compare(a.Submission.Score, b.Submission.Score)
+		case "student_name":
+			c = strings.Compare(strings.ToLower(a.StudentName), strings.ToLower(b.StudentName))
+		default:
+			c = cmp.Compare(a.Submission.SubmittedAt, b.Submission.SubmittedAt)
+		}
+		if sortOrder == "desc" {
+			return -c
+		}
+		return c
+	})
+}
@@ -1,6 +1,8 @@
 package controllers
 
 import (
+	"time"
+
 	"github.com/ynoacamino/infra-sustainable-classrooms/services/knowledge/gen/knowledge"
 	"github.com/ynoacamino/infra-sustainable-classrooms/services/knowledge/internal/ports"
 	"github.com/ynoacamino/infra-sustainable-classrooms/services/knowledge/internal/repositories"
@@ -10,20 +12,27 @@ type knowledgesvrc struct {
 	authServiceRepo     ports.AuthServiceRepository
 	profilesServiceRepo ports.ProfilesServiceRepository
 	questionRepo        ports.QuestionRepository
+	bankRepo            ports.BankRepository
 	submissionRepo      ports.SubmissionRepository
 	testRepo            ports.TestRepository
 	answerRepo          ports.AnswerRepository
+	// gracePeriod is how long after the deadline of a timed test answers are
+	// still accepted, to allow for network delays
+	gracePeriod time.Duration
 }
 
 func NewKnowledge(
 	repoManager *repositories.RepositoryManager,
+	gracePeriod time.Duration,
 ) knowledge.Service {
 	return &knowledgesvrc{
 		authServiceRepo:     repoManager.AuthServiceRepo,
 		profilesServiceRepo: repoManager.ProfilesServiceRepo,
 		questionRepo:        repoManager.QuestionRepo,
+		bankRepo:            repoManager.BankRepo,
 		submissionRepo:      repoManager.SubmissionRepo,
 		testRepo:            repoManager.TestRepo,
 		answerRepo:          repoManager.AnswerRepo,
+		gracePeriod:         gracePeriod,
 	}
 }
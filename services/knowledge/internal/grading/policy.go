@@ -0,0 +1,38 @@
+package grading
+
+// ScorePolicy decides which score counts for a test taken more than once
+type ScorePolicy string
+
+const (
+	// ScoreBest counts the highest score of every attempt
+	ScoreBest ScorePolicy = "best"
+	// ScoreLatest counts the score of the last attempt
+	ScoreLatest ScorePolicy = "latest"
+	// ScoreAverage counts the mean score of every attempt
+	ScoreAverage ScorePolicy = "average"
+)
+
+// CountedScore returns the score that counts for a test given the scores of
+// every attempt, oldest first. It is 0 when there are no attempts.
+func CountedScore(policy ScorePolicy, scores []float64) float64 {
+	if len(scores) == 0 {
+		return 0
+	}
+
+	switch policy {
+	case ScoreLatest:
+		return scores[len(scores)-1]
+	case ScoreAverage:
+		total := 0.0
+		for _, score := range scores {
+			total += score
+		}
+		return total / float64(len(scores))
+	default:
+		best := scores[0]
+		for _, score := range scores[1:] {
+			best = max(best, score)
+		}
+		return best
+	}
+}
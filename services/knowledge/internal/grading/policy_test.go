@@ -0,0 +1,30 @@
+package grading
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCountedScore(t *testing.T) {
+	scores := []float64{60, 90, 75}
+
+	tests := []struct {
+		name     string
+		policy   ScorePolicy
+		scores   []float64
+		expected float64
+	}{
+		{"best", ScoreBest, scores, 90},
+		{"latest", ScoreLatest, scores, 75},
+		{"average", ScoreAverage, scores, 75},
+		{"no attempts", ScoreBest, nil, 0},
+		{"expired attempt counts as zero", ScoreAverage, []float64{80, 0}, 40},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, CountedScore(tt.policy, tt.scores))
+		})
+	}
+}
@@ -0,0 +1,268 @@
+// Package grading checks questions of every type and grades the answers to
+// them. A question is worth one point, of which an answer earns a fraction
+// called its credit. It also decides the order each attempt is shown in.
+package grading
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+	"strings"
+)
+
+// Type is the kind of question, which decides how it is answered
+type Type string
+
+const (
+	// MultipleChoice has a single correct option
+	MultipleChoice Type = "multiple_choice"
+	// MultipleSelect has one or more correct options, with partial credit
+	MultipleSelect Type = "multiple_select"
+	// TrueFalse is a choice between two options
+	TrueFalse Type = "true_false"
+	// Numeric is answered with a number within a tolerance
+	Numeric Type = "numeric"
+	// ShortText is answered with text matching one of the accepted answers
+	ShortText Type = "short_text"
+	// Ordering is answered by putting every option in the correct order
+	Ordering Type = "ordering"
+)
+
+// maxOptions is the most options a question can have
+const maxOptions = 20
+
+// trueFalseOptions are the options of a true/false question when none are given
+var trueFalseOptions = []string{"True", "False"}
+
+// Question is a question and its correct answer. Only the fields used by its
+// type are set.
+type Question struct {
+	Type Type
+	// Options to choose from or to order
+	Options []string
+	// CorrectOptions holds the indexes of the correct options, or of every
+	// option in the correct order for ordering questions
+	CorrectOptions []int
+	NumericAnswer  *float64
+	Tolerance      float64
+	// AcceptedAnswers are patterns where * matches any text
+	AcceptedAnswers []string
+}
+
+// Answer is the answer of a student to a question. Any field may be empty
+// when the question was not answered.
+type Answer struct {
+	SelectedOptions []int
+	NumericAnswer   *float64
+	TextAnswer      *string
+}
+
+// Normalize trims the question, empties the fields its type does not use and
+// gives true/false questions their default options. Lists are never left nil.
+func (q *Question) Normalize() {
+	q.Options = trimAll(q.Options)
+	q.AcceptedAnswers = trimAll(q.AcceptedAnswers)
+
+	switch q.Type {
+	case MultipleChoice, MultipleSelect, TrueFalse, Ordering:
+		q.NumericAnswer = nil
+		q.Tolerance = 0
+		q.AcceptedAnswers = []string{}
+		if q.Type == TrueFalse && len(q.Options) == 0 {
+			q.Options = slices.Clone(trueFalseOptions)
+		}
+		q.CorrectOptions = append([]int{}, q.CorrectOptions...)
+		if q.Type == MultipleSelect {
+			slices.Sort(q.CorrectOptions)
+		}
+	case Numeric:
+		q.Options, q.CorrectOptions, q.AcceptedAnswers = []string{}, []int{}, []string{}
+	case ShortText:
+		q.Options, q.CorrectOptions, q.NumericAnswer, q.Tolerance = []string{}, []int{}, nil, 0
+	}
+}
+
+// Validate checks that the question can be answered and graded
+func (q Question) Validate() error {
+	switch q.Type {
+	case MultipleChoice, MultipleSelect, TrueFalse, Ordering:
+		if err := q.validateOptions(); err != nil {
+			return err
+		}
+	}
+
+	switch q.Type {
+	case MultipleChoice:
+		if len(q.CorrectOptions) != 1 {
+			return errors.New("multiple choice questions must have exactly one correct option")
+		}
+	case TrueFalse:
+		if len(q.Options) != 2 {
+			return errors.New("true/false questions must have exactly two options")
+		}
+		if len(q.CorrectOptions) != 1 {
+			return errors.New("true/false questions must have exactly one correct option")
+		}
+	case MultipleSelect:
+		if len(q.CorrectOptions) == 0 {
+			return errors.New("multiple select questions must have at least one correct option")
+		}
+	case Ordering:
+		if len(q.CorrectOptions) != len(q.Options) {
+			return errors.New("the correct order must include every option once")
+		}
+	case Numeric:
+		if q.NumericAnswer == nil {
+			return errors.New("numeric questions must have a numeric answer")
+		}
+		if q.Tolerance < 0 {
+			return errors.New("tolerance cannot be negative")
+		}
+	case ShortText:
+		if len(q.AcceptedAnswers) == 0 {
+			return errors.New("short text questions must have at least one accepted answer")
+		}
+		if slices.Contains(q.AcceptedAnswers, "") {
+			return errors.New("accepted answers cannot be empty")
+		}
+	default:
+		return fmt.Errorf("unknown question type %q", q.Type)
+	}
+	return nil
+}
+
+func (q Question) validateOptions() error {
+	if len(q.Options) < 2 {
+		return errors.New("questions with options must have at least two options")
+	}
+	if len(q.Options) > maxOptions {
+		return fmt.Errorf("questions cannot have more than %d options", maxOptions)
+	}
+	seen := make(map[string]bool, len(q.Options))
+	for _, option := range q.Options {
+		if option == "" {
+			return errors.New("options cannot be empty")
+		}
+		if seen[option] {
+			return fmt.Errorf("option %q is repeated", option)
+		}
+		seen[option] = true
+	}
+	return validateIndexes(q.CorrectOptions, len(q.Options))
+}
+
+// ValidateAnswer checks that the answer fits the question, without grading it
+func (q Question) ValidateAnswer(a Answer) error {
+	switch q.Type {
+	case MultipleChoice, MultipleSelect, TrueFalse, Ordering:
+		if err := validateIndexes(a.SelectedOptions, len(q.Options)); err != nil {
+			return err
+		}
+	}
+	switch q.Type {
+	case MultipleChoice, TrueFalse:
+		if len(a.SelectedOptions) > 1 {
+			return errors.New("only one option can be selected")
+		}
+	case Ordering:
+		if len(a.SelectedOptions) > 0 && len(a.SelectedOptions) != len(q.Options) {
+			return errors.New("every option must be put in order")
+		}
+	}
+	return nil
+}
+
+// Grade returns the credit earned by the answer, from 0 to 1. The answer
+// must have passed ValidateAnswer.
+func (q Question) Grade(a Answer) float64 {
+	switch q.Type {
+	case MultipleChoice, TrueFalse, Ordering:
+		if len(a.SelectedOptions) > 0 && slices.Equal(a.SelectedOptions, q.CorrectOptions) {
+			return 1
+		}
+	case MultipleSelect:
+		// Every wrong option takes away what a right one gives
+		right, wrong := 0, 0
+		for _, option := range a.SelectedOptions {
+			if slices.Contains(q.CorrectOptions, option) {
+				right++
+			} else {
+				wrong++
+			}
+		}
+		return max(float64(right-wrong)/float64(len(q.CorrectOptions)), 0)
+	case Numeric:
+		if a.NumericAnswer != nil && q.NumericAnswer != nil && withinTolerance(*a.NumericAnswer, *q.NumericAnswer, q.Tolerance) {
+			return 1
+		}
+	case ShortText:
+		if a.TextAnswer == nil {
+			return 0
+		}
+		text := normalizeText(*a.TextAnswer)
+		for _, pattern := range q.AcceptedAnswers {
+			if matchPattern(normalizeText(pattern), text) {
+				return 1
+			}
+		}
+	}
+	return 0
+}
+
+// validateIndexes checks that indexes are distinct options out of count
+func validateIndexes(indexes []int, count int) error {
+	seen := make(map[int]bool, len(indexes))
+	for _, i := range indexes {
+		if i < 0 || i >= count {
+			return fmt.Errorf("option %d does not exist", i)
+		}
+		if seen[i] {
+			return fmt.Errorf("option %d is repeated", i)
+		}
+		seen[i] = true
+	}
+	return nil
+}
+
+func withinTolerance(answer, expected, tolerance float64) bool {
+	// Leeway for answers like 0.1+0.2 that cannot be represented exactly
+	const epsilon = 1e-9
+	return math.Abs(answer-expected) <= tolerance+epsilon
+}
+
+// normalizeText makes text comparable regardless of case and spacing
+func normalizeText(s string) string {
+	return strings.ToLower(strings.Join(strings.Fields(s), " "))
+}
+
+// matchPattern reports whether text matches pattern, where * matches any
+// text, including none
+func matchPattern(pattern, text string) bool {
+	parts := strings.Split(pattern, "*")
+	if len(parts) == 1 {
+		return pattern == text
+	}
+
+	first, last := parts[0], parts[len(parts)-1]
+	if !strings.HasPrefix(text, first) {
+		return false
+	}
+	text = text[len(first):]
+	for _, part := range parts[1 : len(parts)-1] {
+		i := strings.Index(text, part)
+		if i < 0 {
+			return false
+		}
+		text = text[i+len(part):]
+	}
+	return strings.HasSuffix(text, last)
+}
+
+func trimAll(values []string) []string {
+	trimmed := make([]string, len(values))
+	for i, value := range values {
+		trimmed[i] = strings.TrimSpace(value)
+	}
+	return trimmed
+}
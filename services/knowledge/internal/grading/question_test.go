@@ -0,0 +1,132 @@
+package grading
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func float64Ptr(f float64) *float64 {
+	return &f
+}
+
+func stringPtr(s string) *string {
+	return &s
+}
+
+func TestNormalize(t *testing.T) {
+	q := Question{Type: TrueFalse, CorrectOptions: []int{1}, AcceptedAnswers: []string{"ignored"}}
+	q.Normalize()
+	assert.Equal(t, []string{"True", "False"}, q.Options)
+	assert.Empty(t, q.AcceptedAnswers)
+	assert.NoError(t, q.Validate())
+
+	q = Question{Type: Numeric, Options: []string{"a", "b"}, CorrectOptions: []int{0}, NumericAnswer: float64Ptr(3)}
+	q.Normalize()
+	assert.NotNil(t, q.Options)
+	assert.Empty(t, q.Options)
+	assert.Empty(t, q.CorrectOptions)
+
+	q = Question{Type: MultipleSelect, Options: []string{" a ", "b", "c"}, CorrectOptions: []int{2, 0}}
+	q.Normalize()
+	assert.Equal(t, []string{"a", "b", "c"}, q.Options)
+	assert.Equal(t, []int{0, 2}, q.CorrectOptions)
+}
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name     string
+		question Question
+		valid    bool
+	}{
+		{"multiple choice", Question{Type: MultipleChoice, Options: []string{"a", "b", "c"}, CorrectOptions: []int{1}}, true},
+		{"multiple choice with two correct options", Question{Type: MultipleChoice, Options: []string{"a", "b"}, CorrectOptions: []int{0, 1}}, false},
+		{"single option", Question{Type: MultipleChoice, Options: []string{"a"}, CorrectOptions: []int{0}}, false},
+		{"repeated option", Question{Type: MultipleChoice, Options: []string{"a", "a"}, CorrectOptions: []int{0}}, false},
+		{"correct option out of range", Question{Type: MultipleChoice, Options: []string{"a", "b"}, CorrectOptions: []int{2}}, false},
+		{"multiple select", Question{Type: MultipleSelect, Options: []string{"a", "b", "c"}, CorrectOptions: []int{0, 2}}, true},
+		{"multiple select without correct options", Question{Type: MultipleSelect, Options: []string{"a", "b"}, CorrectOptions: []int{}}, false},
+		{"true/false with three options", Question{Type: TrueFalse, Options: []string{"a", "b", "c"}, CorrectOptions: []int{0}}, false},
+		{"ordering", Question{Type: Ordering, Options: []string{"a", "b", "c"}, CorrectOptions: []int{2, 0, 1}}, true},
+		{"ordering missing an option", Question{Type: Ordering, Options: []string{"a", "b", "c"}, CorrectOptions: []int{2, 0}}, false},
+		{"numeric", Question{Type: Numeric, NumericAnswer: float64Ptr(3.14), Tolerance: 0.01}, true},
+		{"numeric without answer", Question{Type: Numeric}, false},
+		{"short text", Question{Type: ShortText, AcceptedAnswers: []string{"paris"}}, true},
+		{"short text without answers", Question{Type: ShortText, AcceptedAnswers: []string{}}, false},
+		{"unknown type", Question{Type: "essay"}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.question.Validate()
+			if tt.valid {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateAnswer(t *testing.T) {
+	choice := Question{Type: MultipleChoice, Options: []string{"a", "b", "c"}, CorrectOptions: []int{1}}
+	assert.NoError(t, choice.ValidateAnswer(Answer{}))
+	assert.NoError(t, choice.ValidateAnswer(Answer{SelectedOptions: []int{2}}))
+	assert.Error(t, choice.ValidateAnswer(Answer{SelectedOptions: []int{0, 1}}))
+	assert.Error(t, choice.ValidateAnswer(Answer{SelectedOptions: []int{3}}))
+
+	ordering := Question{Type: Ordering, Options: []string{"a", "b", "c"}, CorrectOptions: []int{2, 0, 1}}
+	assert.NoError(t, ordering.ValidateAnswer(Answer{SelectedOptions: []int{0, 1, 2}}))
+	assert.Error(t, ordering.ValidateAnswer(Answer{SelectedOptions: []int{0, 1}}))
+	assert.Error(t, ordering.ValidateAnswer(Answer{SelectedOptions: []int{0, 0, 1}}))
+}
+
+func TestGrade(t *testing.T) {
+	choice := Question{Type: MultipleChoice, Options: []string{"a", "b", "c"}, CorrectOptions: []int{1}}
+	selectMany := Question{Type: MultipleSelect, Options: []string{"a", "b", "c", "d"}, CorrectOptions: []int{0, 1, 2}}
+	ordering := Question{Type: Ordering, Options: []string{"a", "b", "c"}, CorrectOptions: []int{2, 0, 1}}
+	numeric := Question{Type: Numeric, NumericAnswer: float64Ptr(3.14), Tolerance: 0.01}
+	exact := Question{Type: Numeric, NumericAnswer: float64Ptr(0.3)}
+	text := Question{Type: ShortText, AcceptedAnswers: []string{"Paris", "*revolution*1789"}}
+
+	tests := []struct {
+		name     string
+		question Question
+		answer   Answer
+		credit   float64
+	}{
+		{"right choice", choice, Answer{SelectedOptions: []int{1}}, 1},
+		{"wrong choice", choice, Answer{SelectedOptions: []int{0}}, 0},
+		{"no choice", choice, Answer{}, 0},
+		{"every right option", selectMany, Answer{SelectedOptions: []int{2, 0, 1}}, 1},
+		{"some right options", selectMany, Answer{SelectedOptions: []int{0, 1}}, 2.0 / 3},
+		{"right and wrong options", selectMany, Answer{SelectedOptions: []int{0, 1, 3}}, 1.0 / 3},
+		{"more wrong than right options", selectMany, Answer{SelectedOptions: []int{0, 3}}, 0},
+		{"right order", ordering, Answer{SelectedOptions: []int{2, 0, 1}}, 1},
+		{"wrong order", ordering, Answer{SelectedOptions: []int{0, 1, 2}}, 0},
+		{"number within tolerance", numeric, Answer{NumericAnswer: float64Ptr(3.145)}, 1},
+		{"number out of tolerance", numeric, Answer{NumericAnswer: float64Ptr(3.2)}, 0},
+		{"inexact float", exact, Answer{NumericAnswer: float64Ptr(0.1 + 0.2)}, 1},
+		{"no number", numeric, Answer{}, 0},
+		{"text ignoring case and spaces", text, Answer{TextAnswer: stringPtr("  paRIS ")}, 1},
+		{"text matching pattern", text, Answer{TextAnswer: stringPtr("The French  Revolution of 1789")}, 1},
+		{"text not matching", text, Answer{TextAnswer: stringPtr("1789 revolution")}, 0},
+		{"no text", text, Answer{}, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.InDelta(t, tt.credit, tt.question.Grade(tt.answer), 1e-9)
+		})
+	}
+}
+
+func TestMatchPattern(t *testing.T) {
+	assert.True(t, matchPattern("abc", "abc"))
+	assert.False(t, matchPattern("abc", "abcd"))
+	assert.True(t, matchPattern("*", ""))
+	assert.True(t, matchPattern("a*c", "ac"))
+	assert.True(t, matchPattern("a*b*c", "axxbyyc"))
+	assert.False(t, matchPattern("a*b*c", "axxcyyb"))
+	assert.False(t, matchPattern("ab*ba", "aba"))
+}
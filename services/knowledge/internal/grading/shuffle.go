@@ -0,0 +1,82 @@
+package grading
+
+import "math/rand/v2"
+
+// Shuffle picks the order a test is shown in for one attempt. The same
+// shuffle always gives the same order. The order is picked once when the
+// attempt starts and stored with it, so it does not depend on the questions
+// being edited afterwards.
+type Shuffle struct {
+	Questions bool
+	Options   bool
+	Seed      int64
+}
+
+// QuestionOrder returns the indexes of count questions in the order they are
+// shown, or nil when questions are not shuffled
+func (s Shuffle) QuestionOrder(count int) []int {
+	if !s.Questions {
+		return nil
+	}
+	// The stream differs from every question stream, keyed by positive IDs
+	return permutation(rand.NewPCG(uint64(s.Seed), 0), count)
+}
+
+// OptionOrder returns the canonical index of each option of the question in
+// the order they are shown, or nil when its options are not shuffled.
+// True/false options keep their conventional order.
+func (s Shuffle) OptionOrder(questionID int64, q Question) []int {
+	if !s.Options || len(q.Options) == 0 || q.Type == TrueFalse {
+		return nil
+	}
+	return permutation(rand.NewPCG(uint64(s.Seed), uint64(questionID)), len(q.Options))
+}
+
+// ToCanonical maps options selected by their shown position back to their
+// canonical index. Positions out of range are kept for ValidateAnswer to
+// reject.
+func ToCanonical(order []int, selected []int) []int {
+	if order == nil {
+		return selected
+	}
+	canonical := make([]int, len(selected))
+	for i, position := range selected {
+		if position >= 0 && position < len(order) {
+			canonical[i] = order[position]
+		} else {
+			canonical[i] = position
+		}
+	}
+	return canonical
+}
+
+// IsOrder reports whether order holds the index of each of n options exactly
+// once
+func IsOrder(order []int, n int) bool {
+	if len(order) != n {
+		return false
+	}
+	seen := make([]bool, n)
+	for _, index := range order {
+		if index < 0 || index >= n || seen[index] {
+			return false
+		}
+		seen[index] = true
+	}
+	return true
+}
+
+// permutation is a Fisher-Yates shuffle of 0..n-1. It draws straight from the
+// source rather than using rand.Perm, whose algorithm is not guaranteed to
+// stay the same, so a seed keeps giving the same order.
+func permutation(src rand.Source, n int) []int {
+	perm := make([]int, n)
+	for i := range perm {
+		perm[i] = i
+	}
+	for i := n - 1; i > 0; i-- {
+		j := int(src.Uint64() % uint64(i+1))
+		perm[i], perm[j] = perm[j], perm[i]
+	}
+	return perm
+}
@@ -0,0 +1,50 @@
+package grading
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestShuffle(t *testing.T) {
+	question := Question{Type: MultipleChoice, Options: []string{"a", "b", "c", "d", "e", "f"}}
+
+	s := Shuffle{Questions: true, Options: true, Seed: 42}
+	order := s.OptionOrder(7, question)
+	assert.Len(t, order, 6)
+	sorted := slices.Sorted(slices.Values(order))
+	assert.Equal(t, []int{0, 1, 2, 3, 4, 5}, sorted)
+
+	// The same seed always gives the same order
+	assert.Equal(t, order, s.OptionOrder(7, question))
+	assert.Equal(t, s.QuestionOrder(10), s.QuestionOrder(10))
+
+	// Other seeds give other orders
+	differs := false
+	for seed := int64(1); seed <= 10 && !differs; seed++ {
+		differs = !slices.Equal(order, Shuffle{Options: true, Seed: seed}.OptionOrder(7, question))
+	}
+	assert.True(t, differs)
+
+	// Nothing is shuffled unless enabled, and true/false keeps its order
+	assert.Nil(t, Shuffle{Seed: 42}.QuestionOrder(10))
+	assert.Nil(t, Shuffle{Seed: 42}.OptionOrder(7, question))
+	assert.Nil(t, s.OptionOrder(7, Question{Type: TrueFalse, Options: []string{"True", "False"}}))
+}
+
+func TestToCanonical(t *testing.T) {
+	order := []int{2, 0, 3, 1}
+
+	assert.Equal(t, []int{2, 1}, ToCanonical(order, []int{0, 3}))
+	assert.Equal(t, []int{3, 4, -1}, ToCanonical(order, []int{2, 4, -1}))
+	assert.Equal(t, []int{0, 3}, ToCanonical(nil, []int{0, 3}))
+}
+
+func TestIsOrder(t *testing.T) {
+	assert.True(t, IsOrder([]int{2, 0, 3, 1}, 4))
+	assert.True(t, IsOrder([]int{}, 0))
+	assert.False(t, IsOrder([]int{2, 0, 1}, 4))
+	assert.False(t, IsOrder([]int{2, 0, 2, 1}, 4))
+	assert.False(t, IsOrder([]int{2, 0, 4, 1}, 4))
+}
@@ -10,4 +10,5 @@ type AnswerRepository interface {
 	// Simplified answer operations
 	CreateAnswerSubmission(ctx context.Context, params knowledgedb.CreateAnswerSubmissionParams) error
 	GetAnswersBySubmission(ctx context.Context, submissionID int64) ([]knowledgedb.GetAnswersBySubmissionRow, error)
+	GetAnswersByTest(ctx context.Context, testID int64) ([]knowledgedb.AnswerSubmission, error)
 }
@@ -0,0 +1,15 @@
+package ports
+
+import (
+	"context"
+
+	knowledgedb "github.com/ynoacamino/infra-sustainable-classrooms/services/knowledge/gen/database"
+)
+
+type BankRepository interface {
+	// Question bank operations
+	CreateQuestionBank(ctx context.Context, params knowledgedb.CreateQuestionBankParams) error
+	GetQuestionBankById(ctx context.Context, id int64) (knowledgedb.QuestionBank, error)
+	GetMyQuestionBanks(ctx context.Context, createdBy int64) ([]knowledgedb.GetMyQuestionBanksRow, error)
+	DeleteQuestionBank(ctx context.Context, id int64) error
+}
@@ -9,5 +9,6 @@ import (
 type ProfilesServiceRepository interface {
 	GetCompleteProfile(ctx context.Context, in *profiles.GetCompleteProfilePayload) (*profiles.CompleteProfileResponse, error)
 	GetPublicProfileByID(ctx context.Context, in *profiles.GetPublicProfileByIDPayload) (*profiles.PublicProfileResponse, error)
+	GetPublicProfilesByIds(ctx context.Context, in *profiles.GetPublicProfilesByIdsPayload) (*profiles.PublicProfilesResponse, error)
 	ValidateUserRole(ctx context.Context, in *profiles.ValidateUserRolePayload) (*profiles.RoleValidationResponse, error)
 }
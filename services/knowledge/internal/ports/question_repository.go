@@ -11,6 +11,14 @@ type QuestionRepository interface {
 	CreateQuestion(ctx context.Context, params knowledgedb.CreateQuestionParams) error
 	GetQuestionById(ctx context.Context, id int64) (knowledgedb.Question, error)
 	GetQuestionsByTestId(ctx context.Context, testID int64) ([]knowledgedb.Question, error)
+	GetQuestionsByIds(ctx context.Context, ids []int64) ([]knowledgedb.Question, error)
 	UpdateQuestion(ctx context.Context, params knowledgedb.UpdateQuestionParams) error
 	DeleteQuestion(ctx context.Context, id int64) error
+
+	// Questions of question banks
+	CreateBankQuestion(ctx context.Context, params knowledgedb.CreateBankQuestionParams) error
+	GetQuestionsByBankId(ctx context.Context, bankID int64) ([]knowledgedb.Question, error)
+	UpdateBankQuestion(ctx context.Context, params knowledgedb.UpdateBankQuestionParams) error
+	CountBankQuestions(ctx context.Context, params knowledgedb.CountBankQuestionsParams) (int64, error)
+	DrawQuestions(ctx context.Context, params knowledgedb.DrawQuestionsParams) ([]knowledgedb.Question, error)
 }
@@ -11,5 +11,23 @@ type SubmissionRepository interface {
 	CreateSubmission(ctx context.Context, params knowledgedb.CreateSubmissionParams) (knowledgedb.TestSubmission, error)
 	GetSubmissionById(ctx context.Context, id int64) (knowledgedb.TestSubmission, error)
 	GetUserSubmissions(ctx context.Context, userID int64) ([]knowledgedb.GetUserSubmissionsRow, error)
-	CheckUserCompletedTest(ctx context.Context, params knowledgedb.CheckUserCompletedTestParams) (bool, error)
+	GetUserTestSubmissions(ctx context.Context, params knowledgedb.GetUserTestSubmissionsParams) ([]knowledgedb.TestSubmission, error)
+	GetAttemptSummary(ctx context.Context, params knowledgedb.GetAttemptSummaryParams) (knowledgedb.GetAttemptSummaryRow, error)
+
+	// Timed attempts
+	StartSubmission(ctx context.Context, params knowledgedb.StartSubmissionParams) (knowledgedb.StartSubmissionRow, error)
+	GetInProgressSubmission(ctx context.Context, params knowledgedb.GetInProgressSubmissionParams) (knowledgedb.TestSubmission, error)
+	FinishSubmission(ctx context.Context, params knowledgedb.FinishSubmissionParams) (knowledgedb.TestSubmission, error)
+	ExpireSubmission(ctx context.Context, id int64) error
+
+	// Questions of attempts in the order they are shown
+	GetAttemptQuestions(ctx context.Context, submissionID int64) ([]knowledgedb.GetAttemptQuestionsRow, error)
+
+	// Analytics
+	GetFirstSubmissions(ctx context.Context, testID int64) ([]knowledgedb.TestSubmission, error)
+	GetAttemptQuestionsByTest(ctx context.Context, testID int64) ([]knowledgedb.AttemptQuestion, error)
+
+	// Gradebook
+	GetTestSubmissions(ctx context.Context, testID int64) ([]knowledgedb.TestSubmission, error)
+	GetStudentSubmissionsByTeacher(ctx context.Context, params knowledgedb.GetStudentSubmissionsByTeacherParams) ([]knowledgedb.GetStudentSubmissionsByTeacherRow, error)
 }
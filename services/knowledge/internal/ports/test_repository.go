@@ -14,4 +14,10 @@ type TestRepository interface {
 	GetAvailableTests(ctx context.Context, createdBy int64) ([]knowledgedb.GetAvailableTestsRow, error)
 	UpdateTest(ctx context.Context, params knowledgedb.UpdateTestParams) error
 	DeleteTest(ctx context.Context, id int64) error
+
+	// Draw rules
+	CreateDrawRule(ctx context.Context, params knowledgedb.CreateDrawRuleParams) error
+	GetDrawRuleById(ctx context.Context, id int64) (knowledgedb.DrawRule, error)
+	GetDrawRulesByTestId(ctx context.Context, testID int64) ([]knowledgedb.DrawRule, error)
+	DeleteDrawRule(ctx context.Context, id int64) error
 }
@@ -25,3 +25,7 @@ func (r *answerRepository) CreateAnswerSubmission(ctx context.Context, params kn
 func (r *answerRepository) GetAnswersBySubmission(ctx context.Context, submissionID int64) ([]knowledgedb.GetAnswersBySubmissionRow, error) {
 	return r.queries.GetAnswersBySubmission(ctx, submissionID)
 }
+
+func (r *answerRepository) GetAnswersByTest(ctx context.Context, testID int64) ([]knowledgedb.AnswerSubmission, error) {
+	return r.queries.GetAnswersByTest(ctx, testID)
+}
@@ -0,0 +1,17 @@
+package repositories
+
+import (
+	"github.com/jackc/pgx/v5/pgxpool"
+	knowledgedb "github.com/ynoacamino/infra-sustainable-classrooms/services/knowledge/gen/database"
+	"github.com/ynoacamino/infra-sustainable-classrooms/services/knowledge/internal/ports"
+)
+
+type BankRepository struct {
+	*knowledgedb.Queries
+}
+
+func NewBankRepository(pool *pgxpool.Pool) ports.BankRepository {
+	return &BankRepository{
+		Queries: knowledgedb.New(pool),
+	}
+}
@@ -21,3 +21,8 @@ func (m *MockAnswerRepository) GetAnswersBySubmission(ctx context.Context, submi
 	args := m.Called(ctx, submissionID)
 	return args.Get(0).([]knowledgedb.GetAnswersBySubmissionRow), args.Error(1)
 }
+
+func (m *MockAnswerRepository) GetAnswersByTest(ctx context.Context, testID int64) ([]knowledgedb.AnswerSubmission, error) {
+	args := m.Called(ctx, testID)
+	return args.Get(0).([]knowledgedb.AnswerSubmission), args.Error(1)
+}
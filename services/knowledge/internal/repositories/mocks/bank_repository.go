@@ -0,0 +1,33 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/stretchr/testify/mock"
+	knowledgedb "github.com/ynoacamino/infra-sustainable-classrooms/services/knowledge/gen/database"
+)
+
+// MockBankRepository is a mock implementation of BankRepository
+type MockBankRepository struct {
+	mock.Mock
+}
+
+func (m *MockBankRepository) CreateQuestionBank(ctx context.Context, params knowledgedb.CreateQuestionBankParams) error {
+	args := m.Called(ctx, params)
+	return args.Error(0)
+}
+
+func (m *MockBankRepository) GetQuestionBankById(ctx context.Context, id int64) (knowledgedb.QuestionBank, error) {
+	args := m.Called(ctx, id)
+	return args.Get(0).(knowledgedb.QuestionBank), args.Error(1)
+}
+
+func (m *MockBankRepository) GetMyQuestionBanks(ctx context.Context, createdBy int64) ([]knowledgedb.GetMyQuestionBanksRow, error) {
+	args := m.Called(ctx, createdBy)
+	return args.Get(0).([]knowledgedb.GetMyQuestionBanksRow), args.Error(1)
+}
+
+func (m *MockBankRepository) DeleteQuestionBank(ctx context.Context, id int64) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
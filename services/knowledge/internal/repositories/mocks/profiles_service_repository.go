@@ -22,6 +22,11 @@ func (m *MockProfilesServiceRepository) GetPublicProfileByID(ctx context.Context
 	return args.Get(0).(*profiles.PublicProfileResponse), args.Error(1)
 }
 
+func (m *MockProfilesServiceRepository) GetPublicProfilesByIds(ctx context.Context, payload *profiles.GetPublicProfilesByIdsPayload) (*profiles.PublicProfilesResponse, error) {
+	args := m.Called(ctx, payload)
+	return args.Get(0).(*profiles.PublicProfilesResponse), args.Error(1)
+}
+
 func (m *MockProfilesServiceRepository) ValidateUserRole(ctx context.Context, payload *profiles.ValidateUserRolePayload) (*profiles.RoleValidationResponse, error) {
 	args := m.Called(ctx, payload)
 	return args.Get(0).(*profiles.RoleValidationResponse), args.Error(1)
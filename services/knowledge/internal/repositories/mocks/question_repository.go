@@ -27,6 +27,11 @@ func (m *MockQuestionRepository) GetQuestionsByTestId(ctx context.Context, testI
 	return args.Get(0).([]knowledgedb.Question), args.Error(1)
 }
 
+func (m *MockQuestionRepository) GetQuestionsByIds(ctx context.Context, ids []int64) ([]knowledgedb.Question, error) {
+	args := m.Called(ctx, ids)
+	return args.Get(0).([]knowledgedb.Question), args.Error(1)
+}
+
 func (m *MockQuestionRepository) UpdateQuestion(ctx context.Context, params knowledgedb.UpdateQuestionParams) error {
 	args := m.Called(ctx, params)
 	return args.Error(0)
@@ -36,3 +41,28 @@ func (m *MockQuestionRepository) DeleteQuestion(ctx context.Context, id int64) e
 	args := m.Called(ctx, id)
 	return args.Error(0)
 }
+
+func (m *MockQuestionRepository) CreateBankQuestion(ctx context.Context, params knowledgedb.CreateBankQuestionParams) error {
+	args := m.Called(ctx, params)
+	return args.Error(0)
+}
+
+func (m *MockQuestionRepository) GetQuestionsByBankId(ctx context.Context, bankID int64) ([]knowledgedb.Question, error) {
+	args := m.Called(ctx, bankID)
+	return args.Get(0).([]knowledgedb.Question), args.Error(1)
+}
+
+func (m *MockQuestionRepository) UpdateBankQuestion(ctx context.Context, params knowledgedb.UpdateBankQuestionParams) error {
+	args := m.Called(ctx, params)
+	return args.Error(0)
+}
+
+func (m *MockQuestionRepository) CountBankQuestions(ctx context.Context, params knowledgedb.CountBankQuestionsParams) (int64, error) {
+	args := m.Called(ctx, params)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockQuestionRepository) DrawQuestions(ctx context.Context, params knowledgedb.DrawQuestionsParams) ([]knowledgedb.Question, error) {
+	args := m.Called(ctx, params)
+	return args.Get(0).([]knowledgedb.Question), args.Error(1)
+}
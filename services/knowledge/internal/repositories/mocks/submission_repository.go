@@ -27,7 +27,57 @@ func (m *MockSubmissionRepository) GetUserSubmissions(ctx context.Context, userI
 	return args.Get(0).([]knowledgedb.GetUserSubmissionsRow), args.Error(1)
 }
 
-func (m *MockSubmissionRepository) CheckUserCompletedTest(ctx context.Context, params knowledgedb.CheckUserCompletedTestParams) (bool, error) {
+func (m *MockSubmissionRepository) GetUserTestSubmissions(ctx context.Context, params knowledgedb.GetUserTestSubmissionsParams) ([]knowledgedb.TestSubmission, error) {
 	args := m.Called(ctx, params)
-	return args.Get(0).(bool), args.Error(1)
+	return args.Get(0).([]knowledgedb.TestSubmission), args.Error(1)
+}
+
+func (m *MockSubmissionRepository) GetAttemptSummary(ctx context.Context, params knowledgedb.GetAttemptSummaryParams) (knowledgedb.GetAttemptSummaryRow, error) {
+	args := m.Called(ctx, params)
+	return args.Get(0).(knowledgedb.GetAttemptSummaryRow), args.Error(1)
+}
+
+func (m *MockSubmissionRepository) StartSubmission(ctx context.Context, params knowledgedb.StartSubmissionParams) (knowledgedb.StartSubmissionRow, error) {
+	args := m.Called(ctx, params)
+	return args.Get(0).(knowledgedb.StartSubmissionRow), args.Error(1)
+}
+
+func (m *MockSubmissionRepository) GetInProgressSubmission(ctx context.Context, params knowledgedb.GetInProgressSubmissionParams) (knowledgedb.TestSubmission, error) {
+	args := m.Called(ctx, params)
+	return args.Get(0).(knowledgedb.TestSubmission), args.Error(1)
+}
+
+func (m *MockSubmissionRepository) FinishSubmission(ctx context.Context, params knowledgedb.FinishSubmissionParams) (knowledgedb.TestSubmission, error) {
+	args := m.Called(ctx, params)
+	return args.Get(0).(knowledgedb.TestSubmission), args.Error(1)
+}
+
+func (m *MockSubmissionRepository) ExpireSubmission(ctx context.Context, id int64) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+func (m *MockSubmissionRepository) GetAttemptQuestions(ctx context.Context, submissionID int64) ([]knowledgedb.GetAttemptQuestionsRow, error) {
+	args := m.Called(ctx, submissionID)
+	return args.Get(0).([]knowledgedb.GetAttemptQuestionsRow), args.Error(1)
+}
+
+func (m *MockSubmissionRepository) GetFirstSubmissions(ctx context.Context, testID int64) ([]knowledgedb.TestSubmission, error) {
+	args := m.Called(ctx, testID)
+	return args.Get(0).([]knowledgedb.TestSubmission), args.Error(1)
+}
+
+func (m *MockSubmissionRepository) GetAttemptQuestionsByTest(ctx context.Context, testID int64) ([]knowledgedb.AttemptQuestion, error) {
+	args := m.Called(ctx, testID)
+	return args.Get(0).([]knowledgedb.AttemptQuestion), args.Error(1)
+}
+
+func (m *MockSubmissionRepository) GetTestSubmissions(ctx context.Context, testID int64) ([]knowledgedb.TestSubmission, error) {
+	args := m.Called(ctx, testID)
+	return args.Get(0).([]knowledgedb.TestSubmission), args.Error(1)
+}
+
+func (m *MockSubmissionRepository) GetStudentSubmissionsByTeacher(ctx context.Context, params knowledgedb.GetStudentSubmissionsByTeacherParams) ([]knowledgedb.GetStudentSubmissionsByTeacherRow, error) {
+	args := m.Called(ctx, params)
+	return args.Get(0).([]knowledgedb.GetStudentSubmissionsByTeacherRow), args.Error(1)
 }
@@ -41,3 +41,23 @@ func (m *MockTestRepository) DeleteTest(ctx context.Context, id int64) error {
 	args := m.Called(ctx, id)
 	return args.Error(0)
 }
+
+func (m *MockTestRepository) CreateDrawRule(ctx context.Context, params knowledgedb.CreateDrawRuleParams) error {
+	args := m.Called(ctx, params)
+	return args.Error(0)
+}
+
+func (m *MockTestRepository) GetDrawRuleById(ctx context.Context, id int64) (knowledgedb.DrawRule, error) {
+	args := m.Called(ctx, id)
+	return args.Get(0).(knowledgedb.DrawRule), args.Error(1)
+}
+
+func (m *MockTestRepository) GetDrawRulesByTestId(ctx context.Context, testID int64) ([]knowledgedb.DrawRule, error) {
+	args := m.Called(ctx, testID)
+	return args.Get(0).([]knowledgedb.DrawRule), args.Error(1)
+}
+
+func (m *MockTestRepository) DeleteDrawRule(ctx context.Context, id int64) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
@@ -15,9 +15,10 @@ func NewProfilesServiceRepository(grpcConn *grpc.ClientConn) ports.ProfilesServi
 	grpcClient := genProfilesclient.NewClient(grpcConn)
 
 	client := &genprofiles.Client{
-		GetCompleteProfileEndpoint:   grpcClient.GetCompleteProfile(),
-		GetPublicProfileByIDEndpoint: grpcClient.GetPublicProfileByID(),
-		ValidateUserRoleEndpoint:     grpcClient.ValidateUserRole(),
+		GetCompleteProfileEndpoint:     grpcClient.GetCompleteProfile(),
+		GetPublicProfileByIDEndpoint:   grpcClient.GetPublicProfileByID(),
+		GetPublicProfilesByIdsEndpoint: grpcClient.GetPublicProfilesByIds(),
+		ValidateUserRoleEndpoint:       grpcClient.ValidateUserRole(),
 	}
 
 	return client
@@ -9,6 +9,7 @@ import (
 type RepositoryManager struct {
 	TestRepo            ports.TestRepository
 	QuestionRepo        ports.QuestionRepository
+	BankRepo            ports.BankRepository
 	SubmissionRepo      ports.SubmissionRepository
 	AnswerRepo          ports.AnswerRepository
 	AuthServiceRepo     ports.AuthServiceRepository
@@ -20,6 +21,7 @@ func NewRepositoryManager(pool *pgxpool.Pool, profilesGrpcConn *grpc.ClientConn)
 	return &RepositoryManager{
 		TestRepo:            NewTestRepository(pool),
 		QuestionRepo:        NewQuestionRepository(pool),
+		BankRepo:            NewBankRepository(pool),
 		SubmissionRepo:      NewSubmissionRepository(pool),
 		AnswerRepo:          NewAnswerRepository(pool),
 		ProfilesServiceRepo: NewProfilesServiceRepository(profilesGrpcConn),
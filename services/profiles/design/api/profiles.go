@@ -190,6 +190,23 @@ var _ = Service("profiles", func() {
 
 	// === INTER-SERVICE COMMUNICATION (gRPC only) ===
 	// Not used in frontend, only for inter-service communication
+	Method("GetPublicProfilesByIds", func() {
+		Description("Get public profile information of several users at once, leaving out users without an active profile")
+
+		Payload(func() {
+			Field(1, "user_ids", ArrayOf(Int64), "User IDs to retrieve profiles for")
+
+			Required("user_ids")
+		})
+
+		Result(PublicProfilesResponse)
+
+		GRPC(func() {
+			Response(CodeOK)
+			Response("invalid_input", CodeInvalidArgument)
+		})
+	})
+
 	Method("ValidateUserRole", func() {
 		Description("Validate user role for inter-service communication")
 
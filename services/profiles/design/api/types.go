@@ -37,6 +37,14 @@ var PublicProfileResponse = Type("PublicProfileResponse", func() {
 	Required("user_id", "role", "first_name", "last_name", "is_active")
 })
 
+var PublicProfilesResponse = Type("PublicProfilesResponse", func() {
+	Description("Public profile information of several users")
+
+	Field(1, "profiles", ArrayOf(PublicProfileResponse), "Public profiles of the users found")
+
+	Required("profiles")
+})
+
 // === STUDENT SPECIFIC TYPES ===
 var StudentProfileResponse = Type("StudentProfileResponse", func() {
 	Description("Student profile information")
@@ -18,6 +18,11 @@ SELECT user_id, role, first_name, last_name, avatar_url, bio, is_active
 FROM profiles
 WHERE user_id = $1 AND is_active = true;
 
+-- name: GetPublicProfilesByUserIds :many
+SELECT user_id, role, first_name, last_name, avatar_url, bio, is_active
+FROM profiles
+WHERE user_id = ANY(@user_ids::BIGINT[]) AND is_active = true;
+
 -- name: UpdateProfile :one
 UPDATE profiles 
 SET 
@@ -173,6 +173,50 @@ func (q *Queries) GetPublicProfileByUserId(ctx context.Context, userID int64) (G
 	return i, err
 }
 
+const getPublicProfilesByUserIds = `-- name: GetPublicProfilesByUserIds :many
+SELECT user_id, role, first_name, last_name, avatar_url, bio, is_active
+FROM profiles
+WHERE user_id = ANY($1::BIGINT[]) AND is_active = true
+`
+
+type GetPublicProfilesByUserIdsRow struct {
+	UserID    int64
+	Role      string
+	FirstName string
+	LastName  string
+	AvatarUrl pgtype.Text
+	Bio       pgtype.Text
+	IsActive  bool
+}
+
+func (q *Queries) GetPublicProfilesByUserIds(ctx context.Context, userIds []int64) ([]GetPublicProfilesByUserIdsRow, error) {
+	rows, err := q.db.Query(ctx, getPublicProfilesByUserIds, userIds)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetPublicProfilesByUserIdsRow
+	for rows.Next() {
+		var i GetPublicProfilesByUserIdsRow
+		if err := rows.Scan(
+			&i.UserID,
+			&i.Role,
+			&i.FirstName,
+			&i.LastName,
+			&i.AvatarUrl,
+			&i.Bio,
+			&i.IsActive,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const updateProfile = `-- name: UpdateProfile :one
 UPDATE profiles 
 SET 
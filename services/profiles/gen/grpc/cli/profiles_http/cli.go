@@ -23,14 +23,14 @@ import (
 //
 //	command (subcommand1|subcommand2|...)
 func UsageCommands() string {
-	return `profiles (get-complete-profile|get-public-profile-by-id|validate-user-role)
+	return `profiles (get-complete-profile|get-public-profile-by-id|get-public-profiles-by-ids|validate-user-role)
 `
 }
 
 // UsageExamples produces an example of a valid invocation of the CLI tool.
 func UsageExamples() string {
 	return os.Args[0] + ` profiles get-complete-profile --message '{
-      "session_token": "Rem blanditiis."
+      "session_token": "Dolorem veritatis."
    }'` + "\n" +
 		""
 }
@@ -50,12 +50,16 @@ func ParseEndpoint(
 		profilesGetPublicProfileByIDFlags       = flag.NewFlagSet("get-public-profile-by-id", flag.ExitOnError)
 		profilesGetPublicProfileByIDMessageFlag = profilesGetPublicProfileByIDFlags.String("message", "", "")
 
+		profilesGetPublicProfilesByIdsFlags       = flag.NewFlagSet("get-public-profiles-by-ids", flag.ExitOnError)
+		profilesGetPublicProfilesByIdsMessageFlag = profilesGetPublicProfilesByIdsFlags.String("message", "", "")
+
 		profilesValidateUserRoleFlags       = flag.NewFlagSet("validate-user-role", flag.ExitOnError)
 		profilesValidateUserRoleMessageFlag = profilesValidateUserRoleFlags.String("message", "", "")
 	)
 	profilesFlags.Usage = profilesUsage
 	profilesGetCompleteProfileFlags.Usage = profilesGetCompleteProfileUsage
 	profilesGetPublicProfileByIDFlags.Usage = profilesGetPublicProfileByIDUsage
+	profilesGetPublicProfilesByIdsFlags.Usage = profilesGetPublicProfilesByIdsUsage
 	profilesValidateUserRoleFlags.Usage = profilesValidateUserRoleUsage
 
 	if err := flag.CommandLine.Parse(os.Args[1:]); err != nil {
@@ -98,6 +102,9 @@ func ParseEndpoint(
 			case "get-public-profile-by-id":
 				epf = profilesGetPublicProfileByIDFlags
 
+			case "get-public-profiles-by-ids":
+				epf = profilesGetPublicProfilesByIdsFlags
+
 			case "validate-user-role":
 				epf = profilesValidateUserRoleFlags
 
@@ -132,6 +139,9 @@ func ParseEndpoint(
 			case "get-public-profile-by-id":
 				endpoint = c.GetPublicProfileByID()
 				data, err = profilesc.BuildGetPublicProfileByIDPayload(*profilesGetPublicProfileByIDMessageFlag)
+			case "get-public-profiles-by-ids":
+				endpoint = c.GetPublicProfilesByIds()
+				data, err = profilesc.BuildGetPublicProfilesByIdsPayload(*profilesGetPublicProfilesByIdsMessageFlag)
 			case "validate-user-role":
 				endpoint = c.ValidateUserRole()
 				data, err = profilesc.BuildValidateUserRolePayload(*profilesValidateUserRoleMessageFlag)
@@ -154,6 +164,7 @@ Usage:
 COMMAND:
     get-complete-profile: Get user's complete profile
     get-public-profile-by-id: Get public profile information by user ID
+    get-public-profiles-by-ids: Get public profile information of several users at once, leaving out users without an active profile
     validate-user-role: Validate user role for inter-service communication
 
 Additional help:
@@ -168,7 +179,7 @@ Get user's complete profile
 
 Example:
     %[1]s profiles get-complete-profile --message '{
-      "session_token": "Rem blanditiis."
+      "session_token": "Dolorem veritatis."
    }'
 `, os.Args[0])
 }
@@ -181,7 +192,24 @@ Get public profile information by user ID
 
 Example:
     %[1]s profiles get-public-profile-by-id --message '{
-      "user_id": 2388931305053140188
+      "user_id": 5447506034870183409
+   }'
+`, os.Args[0])
+}
+
+func profilesGetPublicProfilesByIdsUsage() {
+	fmt.Fprintf(os.Stderr, `%[1]s [flags] profiles get-public-profiles-by-ids -message JSON
+
+Get public profile information of several users at once, leaving out users without an active profile
+    -message JSON: 
+
+Example:
+    %[1]s profiles get-public-profiles-by-ids --message '{
+      "user_ids": [
+         5297943778609458200,
+         393264893395617358,
+         2400499798207838649
+      ]
    }'
 `, os.Args[0])
 }
@@ -194,7 +222,7 @@ Validate user role for inter-service communication
 
 Example:
     %[1]s profiles validate-user-role --message '{
-      "user_id": 7845699967840725710
+      "user_id": 5070801960227293979
    }'
 `, os.Args[0])
 }
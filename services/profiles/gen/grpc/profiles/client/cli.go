@@ -26,7 +26,7 @@ func BuildGetCompleteProfilePayload(profilesGetCompleteProfileMessage string) (*
 		if profilesGetCompleteProfileMessage != "" {
 			err = json.Unmarshal([]byte(profilesGetCompleteProfileMessage), &message)
 			if err != nil {
-				return nil, fmt.Errorf("invalid JSON for message, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"session_token\": \"Rem blanditiis.\"\n   }'")
+				return nil, fmt.Errorf("invalid JSON for message, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"session_token\": \"Dolorem veritatis.\"\n   }'")
 			}
 		}
 	}
@@ -46,7 +46,7 @@ func BuildGetPublicProfileByIDPayload(profilesGetPublicProfileByIDMessage string
 		if profilesGetPublicProfileByIDMessage != "" {
 			err = json.Unmarshal([]byte(profilesGetPublicProfileByIDMessage), &message)
 			if err != nil {
-				return nil, fmt.Errorf("invalid JSON for message, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"user_id\": 2388931305053140188\n   }'")
+				return nil, fmt.Errorf("invalid JSON for message, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"user_id\": 5447506034870183409\n   }'")
 			}
 		}
 	}
@@ -57,6 +57,30 @@ func BuildGetPublicProfileByIDPayload(profilesGetPublicProfileByIDMessage string
 	return v, nil
 }
 
+// BuildGetPublicProfilesByIdsPayload builds the payload for the profiles
+// GetPublicProfilesByIds endpoint from CLI flags.
+func BuildGetPublicProfilesByIdsPayload(profilesGetPublicProfilesByIdsMessage string) (*profiles.GetPublicProfilesByIdsPayload, error) {
+	var err error
+	var message profilespb.GetPublicProfilesByIdsRequest
+	{
+		if profilesGetPublicProfilesByIdsMessage != "" {
+			err = json.Unmarshal([]byte(profilesGetPublicProfilesByIdsMessage), &message)
+			if err != nil {
+				return nil, fmt.Errorf("invalid JSON for message, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"user_ids\": [\n         5297943778609458200,\n         393264893395617358,\n         2400499798207838649\n      ]\n   }'")
+			}
+		}
+	}
+	v := &profiles.GetPublicProfilesByIdsPayload{}
+	if message.UserIds != nil {
+		v.UserIds = make([]int64, len(message.UserIds))
+		for i, val := range message.UserIds {
+			v.UserIds[i] = val
+		}
+	}
+
+	return v, nil
+}
+
 // BuildValidateUserRolePayload builds the payload for the profiles
 // ValidateUserRole endpoint from CLI flags.
 func BuildValidateUserRolePayload(profilesValidateUserRoleMessage string) (*profiles.ValidateUserRolePayload, error) {
@@ -66,7 +90,7 @@ func BuildValidateUserRolePayload(profilesValidateUserRoleMessage string) (*prof
 		if profilesValidateUserRoleMessage != "" {
 			err = json.Unmarshal([]byte(profilesValidateUserRoleMessage), &message)
 			if err != nil {
-				return nil, fmt.Errorf("invalid JSON for message, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"user_id\": 7845699967840725710\n   }'")
+				return nil, fmt.Errorf("invalid JSON for message, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"user_id\": 5070801960227293979\n   }'")
 			}
 		}
 	}
@@ -77,6 +77,28 @@ func (c *Client) GetPublicProfileByID() goa.Endpoint {
 	}
 }
 
+// GetPublicProfilesByIds calls the "GetPublicProfilesByIds" function in
+// profilespb.ProfilesClient interface.
+func (c *Client) GetPublicProfilesByIds() goa.Endpoint {
+	return func(ctx context.Context, v any) (any, error) {
+		inv := goagrpc.NewInvoker(
+			BuildGetPublicProfilesByIdsFunc(c.grpccli, c.opts...),
+			EncodeGetPublicProfilesByIdsRequest,
+			DecodeGetPublicProfilesByIdsResponse)
+		res, err := inv.Invoke(ctx, v)
+		if err != nil {
+			resp := goagrpc.DecodeError(err)
+			switch message := resp.(type) {
+			case *goapb.ErrorResponse:
+				return nil, goagrpc.NewServiceError(message)
+			default:
+				return nil, goa.Fault("%s", err.Error())
+			}
+		}
+		return res, nil
+	}
+}
+
 // ValidateUserRole calls the "ValidateUserRole" function in
 // profilespb.ProfilesClient interface.
 func (c *Client) ValidateUserRole() goa.Endpoint {
@@ -89,6 +89,44 @@ func DecodeGetPublicProfileByIDResponse(ctx context.Context, v any, hdr, trlr me
 	return res, nil
 }
 
+// BuildGetPublicProfilesByIdsFunc builds the remote method to invoke for
+// "profiles" service "GetPublicProfilesByIds" endpoint.
+func BuildGetPublicProfilesByIdsFunc(grpccli profilespb.ProfilesClient, cliopts ...grpc.CallOption) goagrpc.RemoteFunc {
+	return func(ctx context.Context, reqpb any, opts ...grpc.CallOption) (any, error) {
+		for _, opt := range cliopts {
+			opts = append(opts, opt)
+		}
+		if reqpb != nil {
+			return grpccli.GetPublicProfilesByIds(ctx, reqpb.(*profilespb.GetPublicProfilesByIdsRequest), opts...)
+		}
+		return grpccli.GetPublicProfilesByIds(ctx, &profilespb.GetPublicProfilesByIdsRequest{}, opts...)
+	}
+}
+
+// EncodeGetPublicProfilesByIdsRequest encodes requests sent to profiles
+// GetPublicProfilesByIds endpoint.
+func EncodeGetPublicProfilesByIdsRequest(ctx context.Context, v any, md *metadata.MD) (any, error) {
+	payload, ok := v.(*profiles.GetPublicProfilesByIdsPayload)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("profiles", "GetPublicProfilesByIds", "*profiles.GetPublicProfilesByIdsPayload", v)
+	}
+	return NewProtoGetPublicProfilesByIdsRequest(payload), nil
+}
+
+// DecodeGetPublicProfilesByIdsResponse decodes responses from the profiles
+// GetPublicProfilesByIds endpoint.
+func DecodeGetPublicProfilesByIdsResponse(ctx context.Context, v any, hdr, trlr metadata.MD) (any, error) {
+	message, ok := v.(*profilespb.GetPublicProfilesByIdsResponse)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("profiles", "GetPublicProfilesByIds", "*profilespb.GetPublicProfilesByIdsResponse", v)
+	}
+	if err := ValidateGetPublicProfilesByIdsResponse(message); err != nil {
+		return nil, err
+	}
+	res := NewGetPublicProfilesByIdsResult(message)
+	return res, nil
+}
+
 // BuildValidateUserRoleFunc builds the remote method to invoke for "profiles"
 // service "ValidateUserRole" endpoint.
 func BuildValidateUserRoleFunc(grpccli profilespb.ProfilesClient, cliopts ...grpc.CallOption) goagrpc.RemoteFunc {
@@ -12,6 +12,7 @@ package client
 import (
 	profilespb "github.com/ynoacamino/infra-sustainable-classrooms/services/profiles/gen/grpc/profiles/pb"
 	profiles "github.com/ynoacamino/infra-sustainable-classrooms/services/profiles/gen/profiles"
+	goa "goa.design/goa/v3/pkg"
 )
 
 // NewProtoGetCompleteProfileRequest builds the gRPC request type from the
@@ -71,6 +72,41 @@ func NewGetPublicProfileByIDResult(message *profilespb.GetPublicProfileByIDRespo
 	return result
 }
 
+// NewProtoGetPublicProfilesByIdsRequest builds the gRPC request type from the
+// payload of the "GetPublicProfilesByIds" endpoint of the "profiles" service.
+func NewProtoGetPublicProfilesByIdsRequest(payload *profiles.GetPublicProfilesByIdsPayload) *profilespb.GetPublicProfilesByIdsRequest {
+	message := &profilespb.GetPublicProfilesByIdsRequest{}
+	if payload.UserIds != nil {
+		message.UserIds = make([]int64, len(payload.UserIds))
+		for i, val := range payload.UserIds {
+			message.UserIds[i] = val
+		}
+	}
+	return message
+}
+
+// NewGetPublicProfilesByIdsResult builds the result type of the
+// "GetPublicProfilesByIds" endpoint of the "profiles" service from the gRPC
+// response type.
+func NewGetPublicProfilesByIdsResult(message *profilespb.GetPublicProfilesByIdsResponse) *profiles.PublicProfilesResponse {
+	result := &profiles.PublicProfilesResponse{}
+	if message.Profiles != nil {
+		result.Profiles = make([]*profiles.PublicProfileResponse, len(message.Profiles))
+		for i, val := range message.Profiles {
+			result.Profiles[i] = &profiles.PublicProfileResponse{
+				UserID:    val.UserId,
+				Role:      val.Role,
+				FirstName: val.FirstName,
+				LastName:  val.LastName,
+				AvatarURL: val.AvatarUrl,
+				Bio:       val.Bio,
+				IsActive:  val.IsActive,
+			}
+		}
+	}
+	return result
+}
+
 // NewProtoValidateUserRoleRequest builds the gRPC request type from the
 // payload of the "ValidateUserRole" endpoint of the "profiles" service.
 func NewProtoValidateUserRoleRequest(payload *profiles.ValidateUserRolePayload) *profilespb.ValidateUserRoleRequest {
@@ -89,3 +125,12 @@ func NewValidateUserRoleResult(message *profilespb.ValidateUserRoleResponse) *pr
 	}
 	return result
 }
+
+// ValidateGetPublicProfilesByIdsResponse runs the validations defined on
+// GetPublicProfilesByIdsResponse.
+func ValidateGetPublicProfilesByIdsResponse(message *profilespb.GetPublicProfilesByIdsResponse) (err error) {
+	if message.Profiles == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("profiles", "message"))
+	}
+	return
+}
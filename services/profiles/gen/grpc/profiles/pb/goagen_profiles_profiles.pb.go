@@ -381,6 +381,196 @@ func (x *GetPublicProfileByIDResponse) GetIsActive() bool {
 	return false
 }
 
+type GetPublicProfilesByIdsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// User IDs to retrieve profiles for
+	UserIds       []int64 `protobuf:"zigzag64,1,rep,packed,name=user_ids,json=userIds,proto3" json:"user_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPublicProfilesByIdsRequest) Reset() {
+	*x = GetPublicProfilesByIdsRequest{}
+	mi := &file_goagen_profiles_profiles_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPublicProfilesByIdsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPublicProfilesByIdsRequest) ProtoMessage() {}
+
+func (x *GetPublicProfilesByIdsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_goagen_profiles_profiles_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPublicProfilesByIdsRequest.ProtoReflect.Descriptor instead.
+func (*GetPublicProfilesByIdsRequest) Descriptor() ([]byte, []int) {
+	return file_goagen_profiles_profiles_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetPublicProfilesByIdsRequest) GetUserIds() []int64 {
+	if x != nil {
+		return x.UserIds
+	}
+	return nil
+}
+
+type GetPublicProfilesByIdsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Public profiles of the users found
+	Profiles      []*PublicProfileResponse `protobuf:"bytes,1,rep,name=profiles,proto3" json:"profiles,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPublicProfilesByIdsResponse) Reset() {
+	*x = GetPublicProfilesByIdsResponse{}
+	mi := &file_goagen_profiles_profiles_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPublicProfilesByIdsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPublicProfilesByIdsResponse) ProtoMessage() {}
+
+func (x *GetPublicProfilesByIdsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_goagen_profiles_profiles_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPublicProfilesByIdsResponse.ProtoReflect.Descriptor instead.
+func (*GetPublicProfilesByIdsResponse) Descriptor() ([]byte, []int) {
+	return file_goagen_profiles_profiles_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetPublicProfilesByIdsResponse) GetProfiles() []*PublicProfileResponse {
+	if x != nil {
+		return x.Profiles
+	}
+	return nil
+}
+
+// Public profile information (limited data)
+type PublicProfileResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// User identifier
+	UserId int64 `protobuf:"zigzag64,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// User role
+	Role string `protobuf:"bytes,2,opt,name=role,proto3" json:"role,omitempty"`
+	// First name
+	FirstName string `protobuf:"bytes,3,opt,name=first_name,json=firstName,proto3" json:"first_name,omitempty"`
+	// Last name
+	LastName string `protobuf:"bytes,4,opt,name=last_name,json=lastName,proto3" json:"last_name,omitempty"`
+	// Profile picture URL
+	AvatarUrl *string `protobuf:"bytes,5,opt,name=avatar_url,json=avatarUrl,proto3,oneof" json:"avatar_url,omitempty"`
+	// Public biography
+	Bio *string `protobuf:"bytes,6,opt,name=bio,proto3,oneof" json:"bio,omitempty"`
+	// Whether profile is active
+	IsActive      bool `protobuf:"varint,7,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PublicProfileResponse) Reset() {
+	*x = PublicProfileResponse{}
+	mi := &file_goagen_profiles_profiles_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PublicProfileResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PublicProfileResponse) ProtoMessage() {}
+
+func (x *PublicProfileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_goagen_profiles_profiles_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PublicProfileResponse.ProtoReflect.Descriptor instead.
+func (*PublicProfileResponse) Descriptor() ([]byte, []int) {
+	return file_goagen_profiles_profiles_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *PublicProfileResponse) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *PublicProfileResponse) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *PublicProfileResponse) GetFirstName() string {
+	if x != nil {
+		return x.FirstName
+	}
+	return ""
+}
+
+func (x *PublicProfileResponse) GetLastName() string {
+	if x != nil {
+		return x.LastName
+	}
+	return ""
+}
+
+func (x *PublicProfileResponse) GetAvatarUrl() string {
+	if x != nil && x.AvatarUrl != nil {
+		return *x.AvatarUrl
+	}
+	return ""
+}
+
+func (x *PublicProfileResponse) GetBio() string {
+	if x != nil && x.Bio != nil {
+		return *x.Bio
+	}
+	return ""
+}
+
+func (x *PublicProfileResponse) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
 type ValidateUserRoleRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// User ID to validate
@@ -391,7 +581,7 @@ type ValidateUserRoleRequest struct {
 
 func (x *ValidateUserRoleRequest) Reset() {
 	*x = ValidateUserRoleRequest{}
-	mi := &file_goagen_profiles_profiles_proto_msgTypes[4]
+	mi := &file_goagen_profiles_profiles_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -403,7 +593,7 @@ func (x *ValidateUserRoleRequest) String() string {
 func (*ValidateUserRoleRequest) ProtoMessage() {}
 
 func (x *ValidateUserRoleRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_goagen_profiles_profiles_proto_msgTypes[4]
+	mi := &file_goagen_profiles_profiles_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -416,7 +606,7 @@ func (x *ValidateUserRoleRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ValidateUserRoleRequest.ProtoReflect.Descriptor instead.
 func (*ValidateUserRoleRequest) Descriptor() ([]byte, []int) {
-	return file_goagen_profiles_profiles_proto_rawDescGZIP(), []int{4}
+	return file_goagen_profiles_profiles_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *ValidateUserRoleRequest) GetUserId() int64 {
@@ -438,7 +628,7 @@ type ValidateUserRoleResponse struct {
 
 func (x *ValidateUserRoleResponse) Reset() {
 	*x = ValidateUserRoleResponse{}
-	mi := &file_goagen_profiles_profiles_proto_msgTypes[5]
+	mi := &file_goagen_profiles_profiles_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -450,7 +640,7 @@ func (x *ValidateUserRoleResponse) String() string {
 func (*ValidateUserRoleResponse) ProtoMessage() {}
 
 func (x *ValidateUserRoleResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_goagen_profiles_profiles_proto_msgTypes[5]
+	mi := &file_goagen_profiles_profiles_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -463,7 +653,7 @@ func (x *ValidateUserRoleResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ValidateUserRoleResponse.ProtoReflect.Descriptor instead.
 func (*ValidateUserRoleResponse) Descriptor() ([]byte, []int) {
-	return file_goagen_profiles_profiles_proto_rawDescGZIP(), []int{5}
+	return file_goagen_profiles_profiles_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *ValidateUserRoleResponse) GetUserId() int64 {
@@ -528,15 +718,32 @@ const file_goagen_profiles_profiles_proto_rawDesc = "" +
 	"\x03bio\x18\x06 \x01(\tH\x01R\x03bio\x88\x01\x01\x12\x1b\n" +
 	"\tis_active\x18\a \x01(\bR\bisActiveB\r\n" +
 	"\v_avatar_urlB\x06\n" +
+	"\x04_bio\":\n" +
+	"\x1dGetPublicProfilesByIdsRequest\x12\x19\n" +
+	"\buser_ids\x18\x01 \x03(\x12R\auserIds\"]\n" +
+	"\x1eGetPublicProfilesByIdsResponse\x12;\n" +
+	"\bprofiles\x18\x01 \x03(\v2\x1f.profiles.PublicProfileResponseR\bprofiles\"\xef\x01\n" +
+	"\x15PublicProfileResponse\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x12R\x06userId\x12\x12\n" +
+	"\x04role\x18\x02 \x01(\tR\x04role\x12\x1d\n" +
+	"\n" +
+	"first_name\x18\x03 \x01(\tR\tfirstName\x12\x1b\n" +
+	"\tlast_name\x18\x04 \x01(\tR\blastName\x12\"\n" +
+	"\n" +
+	"avatar_url\x18\x05 \x01(\tH\x00R\tavatarUrl\x88\x01\x01\x12\x15\n" +
+	"\x03bio\x18\x06 \x01(\tH\x01R\x03bio\x88\x01\x01\x12\x1b\n" +
+	"\tis_active\x18\a \x01(\bR\bisActiveB\r\n" +
+	"\v_avatar_urlB\x06\n" +
 	"\x04_bio\"2\n" +
 	"\x17ValidateUserRoleRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\x12R\x06userId\"G\n" +
 	"\x18ValidateUserRoleResponse\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\x12R\x06userId\x12\x12\n" +
-	"\x04role\x18\x02 \x01(\tR\x04role2\xad\x02\n" +
+	"\x04role\x18\x02 \x01(\tR\x04role2\x9a\x03\n" +
 	"\bProfiles\x12_\n" +
 	"\x12GetCompleteProfile\x12#.profiles.GetCompleteProfileRequest\x1a$.profiles.GetCompleteProfileResponse\x12e\n" +
-	"\x14GetPublicProfileByID\x12%.profiles.GetPublicProfileByIDRequest\x1a&.profiles.GetPublicProfileByIDResponse\x12Y\n" +
+	"\x14GetPublicProfileByID\x12%.profiles.GetPublicProfileByIDRequest\x1a&.profiles.GetPublicProfileByIDResponse\x12k\n" +
+	"\x16GetPublicProfilesByIds\x12'.profiles.GetPublicProfilesByIdsRequest\x1a(.profiles.GetPublicProfilesByIdsResponse\x12Y\n" +
 	"\x10ValidateUserRole\x12!.profiles.ValidateUserRoleRequest\x1a\".profiles.ValidateUserRoleResponseB\rZ\v/profilespbb\x06proto3"
 
 var (
@@ -551,27 +758,33 @@ func file_goagen_profiles_profiles_proto_rawDescGZIP() []byte {
 	return file_goagen_profiles_profiles_proto_rawDescData
 }
 
-var file_goagen_profiles_profiles_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_goagen_profiles_profiles_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
 var file_goagen_profiles_profiles_proto_goTypes = []any{
-	(*GetCompleteProfileRequest)(nil),    // 0: profiles.GetCompleteProfileRequest
-	(*GetCompleteProfileResponse)(nil),   // 1: profiles.GetCompleteProfileResponse
-	(*GetPublicProfileByIDRequest)(nil),  // 2: profiles.GetPublicProfileByIDRequest
-	(*GetPublicProfileByIDResponse)(nil), // 3: profiles.GetPublicProfileByIDResponse
-	(*ValidateUserRoleRequest)(nil),      // 4: profiles.ValidateUserRoleRequest
-	(*ValidateUserRoleResponse)(nil),     // 5: profiles.ValidateUserRoleResponse
+	(*GetCompleteProfileRequest)(nil),      // 0: profiles.GetCompleteProfileRequest
+	(*GetCompleteProfileResponse)(nil),     // 1: profiles.GetCompleteProfileResponse
+	(*GetPublicProfileByIDRequest)(nil),    // 2: profiles.GetPublicProfileByIDRequest
+	(*GetPublicProfileByIDResponse)(nil),   // 3: profiles.GetPublicProfileByIDResponse
+	(*GetPublicProfilesByIdsRequest)(nil),  // 4: profiles.GetPublicProfilesByIdsRequest
+	(*GetPublicProfilesByIdsResponse)(nil), // 5: profiles.GetPublicProfilesByIdsResponse
+	(*PublicProfileResponse)(nil),          // 6: profiles.PublicProfileResponse
+	(*ValidateUserRoleRequest)(nil),        // 7: profiles.ValidateUserRoleRequest
+	(*ValidateUserRoleResponse)(nil),       // 8: profiles.ValidateUserRoleResponse
 }
 var file_goagen_profiles_profiles_proto_depIdxs = []int32{
-	0, // 0: profiles.Profiles.GetCompleteProfile:input_type -> profiles.GetCompleteProfileRequest
-	2, // 1: profiles.Profiles.GetPublicProfileByID:input_type -> profiles.GetPublicProfileByIDRequest
-	4, // 2: profiles.Profiles.ValidateUserRole:input_type -> profiles.ValidateUserRoleRequest
-	1, // 3: profiles.Profiles.GetCompleteProfile:output_type -> profiles.GetCompleteProfileResponse
-	3, // 4: profiles.Profiles.GetPublicProfileByID:output_type -> profiles.GetPublicProfileByIDResponse
-	5, // 5: profiles.Profiles.ValidateUserRole:output_type -> profiles.ValidateUserRoleResponse
-	3, // [3:6] is the sub-list for method output_type
-	0, // [0:3] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	6, // 0: profiles.GetPublicProfilesByIdsResponse.profiles:type_name -> profiles.PublicProfileResponse
+	0, // 1: profiles.Profiles.GetCompleteProfile:input_type -> profiles.GetCompleteProfileRequest
+	2, // 2: profiles.Profiles.GetPublicProfileByID:input_type -> profiles.GetPublicProfileByIDRequest
+	4, // 3: profiles.Profiles.GetPublicProfilesByIds:input_type -> profiles.GetPublicProfilesByIdsRequest
+	7, // 4: profiles.Profiles.ValidateUserRole:input_type -> profiles.ValidateUserRoleRequest
+	1, // 5: profiles.Profiles.GetCompleteProfile:output_type -> profiles.GetCompleteProfileResponse
+	3, // 6: profiles.Profiles.GetPublicProfileByID:output_type -> profiles.GetPublicProfileByIDResponse
+	5, // 7: profiles.Profiles.GetPublicProfilesByIds:output_type -> profiles.GetPublicProfilesByIdsResponse
+	8, // 8: profiles.Profiles.ValidateUserRole:output_type -> profiles.ValidateUserRoleResponse
+	5, // [5:9] is the sub-list for method output_type
+	1, // [1:5] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
 }
 
 func init() { file_goagen_profiles_profiles_proto_init() }
@@ -581,13 +794,14 @@ func file_goagen_profiles_profiles_proto_init() {
 	}
 	file_goagen_profiles_profiles_proto_msgTypes[1].OneofWrappers = []any{}
 	file_goagen_profiles_profiles_proto_msgTypes[3].OneofWrappers = []any{}
+	file_goagen_profiles_profiles_proto_msgTypes[6].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_goagen_profiles_profiles_proto_rawDesc), len(file_goagen_profiles_profiles_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   6,
+			NumMessages:   9,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -28,9 +28,10 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Profiles_GetCompleteProfile_FullMethodName   = "/profiles.Profiles/GetCompleteProfile"
-	Profiles_GetPublicProfileByID_FullMethodName = "/profiles.Profiles/GetPublicProfileByID"
-	Profiles_ValidateUserRole_FullMethodName     = "/profiles.Profiles/ValidateUserRole"
+	Profiles_GetCompleteProfile_FullMethodName     = "/profiles.Profiles/GetCompleteProfile"
+	Profiles_GetPublicProfileByID_FullMethodName   = "/profiles.Profiles/GetPublicProfileByID"
+	Profiles_GetPublicProfilesByIds_FullMethodName = "/profiles.Profiles/GetPublicProfilesByIds"
+	Profiles_ValidateUserRole_FullMethodName       = "/profiles.Profiles/ValidateUserRole"
 )
 
 // ProfilesClient is the client API for Profiles service.
@@ -43,6 +44,9 @@ type ProfilesClient interface {
 	GetCompleteProfile(ctx context.Context, in *GetCompleteProfileRequest, opts ...grpc.CallOption) (*GetCompleteProfileResponse, error)
 	// Get public profile information by user ID
 	GetPublicProfileByID(ctx context.Context, in *GetPublicProfileByIDRequest, opts ...grpc.CallOption) (*GetPublicProfileByIDResponse, error)
+	// Get public profile information of several users at once, leaving out users
+	// without an active profile
+	GetPublicProfilesByIds(ctx context.Context, in *GetPublicProfilesByIdsRequest, opts ...grpc.CallOption) (*GetPublicProfilesByIdsResponse, error)
 	// Validate user role for inter-service communication
 	ValidateUserRole(ctx context.Context, in *ValidateUserRoleRequest, opts ...grpc.CallOption) (*ValidateUserRoleResponse, error)
 }
@@ -75,6 +79,16 @@ func (c *profilesClient) GetPublicProfileByID(ctx context.Context, in *GetPublic
 	return out, nil
 }
 
+func (c *profilesClient) GetPublicProfilesByIds(ctx context.Context, in *GetPublicProfilesByIdsRequest, opts ...grpc.CallOption) (*GetPublicProfilesByIdsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPublicProfilesByIdsResponse)
+	err := c.cc.Invoke(ctx, Profiles_GetPublicProfilesByIds_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *profilesClient) ValidateUserRole(ctx context.Context, in *ValidateUserRoleRequest, opts ...grpc.CallOption) (*ValidateUserRoleResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ValidateUserRoleResponse)
@@ -95,6 +109,9 @@ type ProfilesServer interface {
 	GetCompleteProfile(context.Context, *GetCompleteProfileRequest) (*GetCompleteProfileResponse, error)
 	// Get public profile information by user ID
 	GetPublicProfileByID(context.Context, *GetPublicProfileByIDRequest) (*GetPublicProfileByIDResponse, error)
+	// Get public profile information of several users at once, leaving out users
+	// without an active profile
+	GetPublicProfilesByIds(context.Context, *GetPublicProfilesByIdsRequest) (*GetPublicProfilesByIdsResponse, error)
 	// Validate user role for inter-service communication
 	ValidateUserRole(context.Context, *ValidateUserRoleRequest) (*ValidateUserRoleResponse, error)
 	mustEmbedUnimplementedProfilesServer()
@@ -113,6 +130,9 @@ func (UnimplementedProfilesServer) GetCompleteProfile(context.Context, *GetCompl
 func (UnimplementedProfilesServer) GetPublicProfileByID(context.Context, *GetPublicProfileByIDRequest) (*GetPublicProfileByIDResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetPublicProfileByID not implemented")
 }
+func (UnimplementedProfilesServer) GetPublicProfilesByIds(context.Context, *GetPublicProfilesByIdsRequest) (*GetPublicProfilesByIdsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPublicProfilesByIds not implemented")
+}
 func (UnimplementedProfilesServer) ValidateUserRole(context.Context, *ValidateUserRoleRequest) (*ValidateUserRoleResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ValidateUserRole not implemented")
 }
@@ -173,6 +193,24 @@ func _Profiles_GetPublicProfileByID_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Profiles_GetPublicProfilesByIds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPublicProfilesByIdsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfilesServer).GetPublicProfilesByIds(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Profiles_GetPublicProfilesByIds_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfilesServer).GetPublicProfilesByIds(ctx, req.(*GetPublicProfilesByIdsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Profiles_ValidateUserRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ValidateUserRoleRequest)
 	if err := dec(in); err != nil {
@@ -206,6 +244,10 @@ var Profiles_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetPublicProfileByID",
 			Handler:    _Profiles_GetPublicProfileByID_Handler,
 		},
+		{
+			MethodName: "GetPublicProfilesByIds",
+			Handler:    _Profiles_GetPublicProfilesByIds_Handler,
+		},
 		{
 			MethodName: "ValidateUserRole",
 			Handler:    _Profiles_ValidateUserRole_Handler,
@@ -78,6 +78,39 @@ func DecodeGetPublicProfileByIDRequest(ctx context.Context, v any, md metadata.M
 	return payload, nil
 }
 
+// EncodeGetPublicProfilesByIdsResponse encodes responses from the "profiles"
+// service "GetPublicProfilesByIds" endpoint.
+func EncodeGetPublicProfilesByIdsResponse(ctx context.Context, v any, hdr, trlr *metadata.MD) (any, error) {
+	result, ok := v.(*profiles.PublicProfilesResponse)
+	if !ok {
+		return nil, goagrpc.ErrInvalidType("profiles", "GetPublicProfilesByIds", "*profiles.PublicProfilesResponse", v)
+	}
+	resp := NewProtoGetPublicProfilesByIdsResponse(result)
+	return resp, nil
+}
+
+// DecodeGetPublicProfilesByIdsRequest decodes requests sent to "profiles"
+// service "GetPublicProfilesByIds" endpoint.
+func DecodeGetPublicProfilesByIdsRequest(ctx context.Context, v any, md metadata.MD) (any, error) {
+	var (
+		message *profilespb.GetPublicProfilesByIdsRequest
+		ok      bool
+	)
+	{
+		if message, ok = v.(*profilespb.GetPublicProfilesByIdsRequest); !ok {
+			return nil, goagrpc.ErrInvalidType("profiles", "GetPublicProfilesByIds", "*profilespb.GetPublicProfilesByIdsRequest", v)
+		}
+		if err := ValidateGetPublicProfilesByIdsRequest(message); err != nil {
+			return nil, err
+		}
+	}
+	var payload *profiles.GetPublicProfilesByIdsPayload
+	{
+		payload = NewGetPublicProfilesByIdsPayload(message)
+	}
+	return payload, nil
+}
+
 // EncodeValidateUserRoleResponse encodes responses from the "profiles" service
 // "ValidateUserRole" endpoint.
 func EncodeValidateUserRoleResponse(ctx context.Context, v any, hdr, trlr *metadata.MD) (any, error) {
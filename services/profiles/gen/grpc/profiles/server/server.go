@@ -22,18 +22,20 @@ import (
 
 // Server implements the profilespb.ProfilesServer interface.
 type Server struct {
-	GetCompleteProfileH   goagrpc.UnaryHandler
-	GetPublicProfileByIDH goagrpc.UnaryHandler
-	ValidateUserRoleH     goagrpc.UnaryHandler
+	GetCompleteProfileH     goagrpc.UnaryHandler
+	GetPublicProfileByIDH   goagrpc.UnaryHandler
+	GetPublicProfilesByIdsH goagrpc.UnaryHandler
+	ValidateUserRoleH       goagrpc.UnaryHandler
 	profilespb.UnimplementedProfilesServer
 }
 
 // New instantiates the server struct with the profiles service endpoints.
 func New(e *profiles.Endpoints, uh goagrpc.UnaryHandler) *Server {
 	return &Server{
-		GetCompleteProfileH:   NewGetCompleteProfileHandler(e.GetCompleteProfile, uh),
-		GetPublicProfileByIDH: NewGetPublicProfileByIDHandler(e.GetPublicProfileByID, uh),
-		ValidateUserRoleH:     NewValidateUserRoleHandler(e.ValidateUserRole, uh),
+		GetCompleteProfileH:     NewGetCompleteProfileHandler(e.GetCompleteProfile, uh),
+		GetPublicProfileByIDH:   NewGetPublicProfileByIDHandler(e.GetPublicProfileByID, uh),
+		GetPublicProfilesByIdsH: NewGetPublicProfilesByIdsHandler(e.GetPublicProfilesByIds, uh),
+		ValidateUserRoleH:       NewValidateUserRoleHandler(e.ValidateUserRole, uh),
 	}
 }
 
@@ -97,6 +99,34 @@ func (s *Server) GetPublicProfileByID(ctx context.Context, message *profilespb.G
 	return resp.(*profilespb.GetPublicProfileByIDResponse), nil
 }
 
+// NewGetPublicProfilesByIdsHandler creates a gRPC handler which serves the
+// "profiles" service "GetPublicProfilesByIds" endpoint.
+func NewGetPublicProfilesByIdsHandler(endpoint goa.Endpoint, h goagrpc.UnaryHandler) goagrpc.UnaryHandler {
+	if h == nil {
+		h = goagrpc.NewUnaryHandler(endpoint, DecodeGetPublicProfilesByIdsRequest, EncodeGetPublicProfilesByIdsResponse)
+	}
+	return h
+}
+
+// GetPublicProfilesByIds implements the "GetPublicProfilesByIds" method in
+// profilespb.ProfilesServer interface.
+func (s *Server) GetPublicProfilesByIds(ctx context.Context, message *profilespb.GetPublicProfilesByIdsRequest) (*profilespb.GetPublicProfilesByIdsResponse, error) {
+	ctx = context.WithValue(ctx, goa.MethodKey, "GetPublicProfilesByIds")
+	ctx = context.WithValue(ctx, goa.ServiceKey, "profiles")
+	resp, err := s.GetPublicProfilesByIdsH.Handle(ctx, message)
+	if err != nil {
+		var en goa.GoaErrorNamer
+		if errors.As(err, &en) {
+			switch en.GoaErrorName() {
+			case "invalid_input":
+				return nil, goagrpc.NewStatusError(codes.InvalidArgument, err, goagrpc.NewErrorResponse(err))
+			}
+		}
+		return nil, goagrpc.EncodeError(err)
+	}
+	return resp.(*profilespb.GetPublicProfilesByIdsResponse), nil
+}
+
 // NewValidateUserRoleHandler creates a gRPC handler which serves the
 // "profiles" service "ValidateUserRole" endpoint.
 func NewValidateUserRoleHandler(endpoint goa.Endpoint, h goagrpc.UnaryHandler) goagrpc.UnaryHandler {
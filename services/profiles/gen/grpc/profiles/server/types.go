@@ -12,6 +12,7 @@ package server
 import (
 	profilespb "github.com/ynoacamino/infra-sustainable-classrooms/services/profiles/gen/grpc/profiles/pb"
 	profiles "github.com/ynoacamino/infra-sustainable-classrooms/services/profiles/gen/profiles"
+	goa "goa.design/goa/v3/pkg"
 )
 
 // NewGetCompleteProfilePayload builds the payload of the "GetCompleteProfile"
@@ -70,6 +71,42 @@ func NewProtoGetPublicProfileByIDResponse(result *profiles.PublicProfileResponse
 	return message
 }
 
+// NewGetPublicProfilesByIdsPayload builds the payload of the
+// "GetPublicProfilesByIds" endpoint of the "profiles" service from the gRPC
+// request type.
+func NewGetPublicProfilesByIdsPayload(message *profilespb.GetPublicProfilesByIdsRequest) *profiles.GetPublicProfilesByIdsPayload {
+	v := &profiles.GetPublicProfilesByIdsPayload{}
+	if message.UserIds != nil {
+		v.UserIds = make([]int64, len(message.UserIds))
+		for i, val := range message.UserIds {
+			v.UserIds[i] = val
+		}
+	}
+	return v
+}
+
+// NewProtoGetPublicProfilesByIdsResponse builds the gRPC response type from
+// the result of the "GetPublicProfilesByIds" endpoint of the "profiles"
+// service.
+func NewProtoGetPublicProfilesByIdsResponse(result *profiles.PublicProfilesResponse) *profilespb.GetPublicProfilesByIdsResponse {
+	message := &profilespb.GetPublicProfilesByIdsResponse{}
+	if result.Profiles != nil {
+		message.Profiles = make([]*profilespb.PublicProfileResponse, len(result.Profiles))
+		for i, val := range result.Profiles {
+			message.Profiles[i] = &profilespb.PublicProfileResponse{
+				UserId:    val.UserID,
+				Role:      val.Role,
+				FirstName: val.FirstName,
+				LastName:  val.LastName,
+				AvatarUrl: val.AvatarURL,
+				Bio:       val.Bio,
+				IsActive:  val.IsActive,
+			}
+		}
+	}
+	return message
+}
+
 // NewValidateUserRolePayload builds the payload of the "ValidateUserRole"
 // endpoint of the "profiles" service from the gRPC request type.
 func NewValidateUserRolePayload(message *profilespb.ValidateUserRoleRequest) *profiles.ValidateUserRolePayload {
@@ -88,3 +125,12 @@ func NewProtoValidateUserRoleResponse(result *profiles.RoleValidationResponse) *
 	}
 	return message
 }
+
+// ValidateGetPublicProfilesByIdsRequest runs the validations defined on
+// GetPublicProfilesByIdsRequest.
+func ValidateGetPublicProfilesByIdsRequest(message *profilespb.GetPublicProfilesByIdsRequest) (err error) {
+	if message.UserIds == nil {
+		err = goa.MergeErrors(err, goa.MissingFieldError("user_ids", "message"))
+	}
+	return
+}
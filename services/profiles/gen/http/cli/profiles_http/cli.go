@@ -31,15 +31,15 @@ func UsageCommands() string {
 // UsageExamples produces an example of a valid invocation of the CLI tool.
 func UsageExamples() string {
 	return os.Args[0] + ` profiles create-student-profile --body '{
-      "avatar_url": "Nemo corporis ratione quo.",
-      "bio": "Qui ut aut et facilis excepturi.",
-      "email": "lera@farrelljast.name",
-      "first_name": "Dolorem hic sapiente ea.",
-      "grade_level": "Harum cum velit laboriosam tenetur maxime maxime.",
-      "last_name": "Consequatur id facilis eaque incidunt.",
-      "major": "Voluptatem ab.",
-      "phone": "Totam laboriosam veniam."
-   }' --session-token "Deleniti rerum aliquid in ea."` + "\n" +
+      "avatar_url": "Qui ut aut et facilis excepturi.",
+      "bio": "Harum cum velit laboriosam tenetur maxime maxime.",
+      "email": "vallie@harvey.biz",
+      "first_name": "Facilis eaque.",
+      "grade_level": "Voluptatem ab.",
+      "last_name": "Illo aut.",
+      "major": "Deleniti rerum aliquid in ea.",
+      "phone": "Nemo corporis ratione quo."
+   }' --session-token "Nihil reprehenderit dignissimos quas maxime."` + "\n" +
 		""
 }
 
@@ -205,15 +205,15 @@ Create a new student profile with basic information
 
 Example:
     %[1]s profiles create-student-profile --body '{
-      "avatar_url": "Nemo corporis ratione quo.",
-      "bio": "Qui ut aut et facilis excepturi.",
-      "email": "lera@farrelljast.name",
-      "first_name": "Dolorem hic sapiente ea.",
-      "grade_level": "Harum cum velit laboriosam tenetur maxime maxime.",
-      "last_name": "Consequatur id facilis eaque incidunt.",
-      "major": "Voluptatem ab.",
-      "phone": "Totam laboriosam veniam."
-   }' --session-token "Deleniti rerum aliquid in ea."
+      "avatar_url": "Qui ut aut et facilis excepturi.",
+      "bio": "Harum cum velit laboriosam tenetur maxime maxime.",
+      "email": "vallie@harvey.biz",
+      "first_name": "Facilis eaque.",
+      "grade_level": "Voluptatem ab.",
+      "last_name": "Illo aut.",
+      "major": "Deleniti rerum aliquid in ea.",
+      "phone": "Nemo corporis ratione quo."
+   }' --session-token "Nihil reprehenderit dignissimos quas maxime."
 `, os.Args[0])
 }
 
@@ -226,14 +226,14 @@ Create a new teacher profile with basic information
 
 Example:
     %[1]s profiles create-teacher-profile --body '{
-      "avatar_url": "Omnis alias dolor voluptatibus occaecati nemo.",
-      "bio": "Qui consectetur perferendis tempore porro ut velit.",
-      "email": "rosalinda@osinskigoodwin.com",
-      "first_name": "Quaerat officia ut velit odit architecto.",
-      "last_name": "Voluptatibus voluptas sequi voluptatibus.",
-      "phone": "Voluptatem adipisci in.",
-      "position": "Quaerat amet et consectetur."
-   }' --session-token "Et sed hic omnis ipsam qui eum."
+      "avatar_url": "Quaerat amet et consectetur.",
+      "bio": "Et sed hic omnis ipsam qui eum.",
+      "email": "ransom_walter@ullrich.org",
+      "first_name": "Aut ullam cum.",
+      "last_name": "Eveniet et rerum id molestiae inventore.",
+      "phone": "Qui consectetur perferendis tempore porro ut velit.",
+      "position": "Quibusdam totam aut."
+   }' --session-token "Et voluptas soluta quibusdam voluptatem deserunt mollitia."
 `, os.Args[0])
 }
 
@@ -244,7 +244,7 @@ Get user's complete profile
     -session-token STRING: 
 
 Example:
-    %[1]s profiles get-complete-profile --session-token "Ab nisi in."
+    %[1]s profiles get-complete-profile --session-token "Itaque et nihil deserunt reprehenderit sed."
 `, os.Args[0])
 }
 
@@ -255,7 +255,7 @@ Get public profile information by user ID
     -user-id INT64: User ID to retrieve profile for
 
 Example:
-    %[1]s profiles get-public-profile-by-id --user-id 2757743622984225050
+    %[1]s profiles get-public-profile-by-id --user-id 2526475297161644307
 `, os.Args[0])
 }
 
@@ -268,14 +268,14 @@ Update basic profile information
 
 Example:
     %[1]s profiles update-profile --body '{
-      "avatar_url": "Qui alias provident vel minima saepe delectus.",
-      "bio": "In ut id aut fuga blanditiis.",
-      "email": "aglae.hudson@mosciski.name",
-      "first_name": "Repudiandae rem quia.",
-      "last_name": "Consequatur aperiam ad suscipit aut distinctio et.",
-      "major": "Error rerum.",
-      "phone": "Magni itaque non quae assumenda.",
-      "position": "Est quaerat commodi."
-   }' --session-token "Consectetur accusantium consequatur odio doloremque et facere."
+      "avatar_url": "Odio doloremque et facere repudiandae quia.",
+      "bio": "Cum aut deserunt.",
+      "email": "arjun_schmitt@ritchiehuel.org",
+      "first_name": "Ut est earum rerum aliquam.",
+      "last_name": "Maiores commodi cum omnis et.",
+      "major": "Minima quidem corrupti.",
+      "phone": "Quaerat commodi voluptas consectetur accusantium.",
+      "position": "Rerum nulla ipsum voluptates veniam nulla."
+   }' --session-token "Aut enim est est autem accusantium."
 `, os.Args[0])
 }
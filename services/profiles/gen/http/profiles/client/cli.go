@@ -26,7 +26,7 @@ func BuildCreateStudentProfilePayload(profilesCreateStudentProfileBody string, p
 	{
 		err = json.Unmarshal([]byte(profilesCreateStudentProfileBody), &body)
 		if err != nil {
-			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"avatar_url\": \"Nemo corporis ratione quo.\",\n      \"bio\": \"Qui ut aut et facilis excepturi.\",\n      \"email\": \"lera@farrelljast.name\",\n      \"first_name\": \"Dolorem hic sapiente ea.\",\n      \"grade_level\": \"Harum cum velit laboriosam tenetur maxime maxime.\",\n      \"last_name\": \"Consequatur id facilis eaque incidunt.\",\n      \"major\": \"Voluptatem ab.\",\n      \"phone\": \"Totam laboriosam veniam.\"\n   }'")
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"avatar_url\": \"Qui ut aut et facilis excepturi.\",\n      \"bio\": \"Harum cum velit laboriosam tenetur maxime maxime.\",\n      \"email\": \"vallie@harvey.biz\",\n      \"first_name\": \"Facilis eaque.\",\n      \"grade_level\": \"Voluptatem ab.\",\n      \"last_name\": \"Illo aut.\",\n      \"major\": \"Deleniti rerum aliquid in ea.\",\n      \"phone\": \"Nemo corporis ratione quo.\"\n   }'")
 		}
 		err = goa.MergeErrors(err, goa.ValidateFormat("body.email", body.Email, goa.FormatEmail))
 		if err != nil {
@@ -60,7 +60,7 @@ func BuildCreateTeacherProfilePayload(profilesCreateTeacherProfileBody string, p
 	{
 		err = json.Unmarshal([]byte(profilesCreateTeacherProfileBody), &body)
 		if err != nil {
-			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"avatar_url\": \"Omnis alias dolor voluptatibus occaecati nemo.\",\n      \"bio\": \"Qui consectetur perferendis tempore porro ut velit.\",\n      \"email\": \"rosalinda@osinskigoodwin.com\",\n      \"first_name\": \"Quaerat officia ut velit odit architecto.\",\n      \"last_name\": \"Voluptatibus voluptas sequi voluptatibus.\",\n      \"phone\": \"Voluptatem adipisci in.\",\n      \"position\": \"Quaerat amet et consectetur.\"\n   }'")
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"avatar_url\": \"Quaerat amet et consectetur.\",\n      \"bio\": \"Et sed hic omnis ipsam qui eum.\",\n      \"email\": \"ransom_walter@ullrich.org\",\n      \"first_name\": \"Aut ullam cum.\",\n      \"last_name\": \"Eveniet et rerum id molestiae inventore.\",\n      \"phone\": \"Qui consectetur perferendis tempore porro ut velit.\",\n      \"position\": \"Quibusdam totam aut.\"\n   }'")
 		}
 		err = goa.MergeErrors(err, goa.ValidateFormat("body.email", body.Email, goa.FormatEmail))
 		if err != nil {
@@ -123,7 +123,7 @@ func BuildUpdateProfilePayload(profilesUpdateProfileBody string, profilesUpdateP
 	{
 		err = json.Unmarshal([]byte(profilesUpdateProfileBody), &body)
 		if err != nil {
-			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"avatar_url\": \"Qui alias provident vel minima saepe delectus.\",\n      \"bio\": \"In ut id aut fuga blanditiis.\",\n      \"email\": \"aglae.hudson@mosciski.name\",\n      \"first_name\": \"Repudiandae rem quia.\",\n      \"last_name\": \"Consequatur aperiam ad suscipit aut distinctio et.\",\n      \"major\": \"Error rerum.\",\n      \"phone\": \"Magni itaque non quae assumenda.\",\n      \"position\": \"Est quaerat commodi.\"\n   }'")
+			return nil, fmt.Errorf("invalid JSON for body, \nerror: %s, \nexample of valid JSON:\n%s", err, "'{\n      \"avatar_url\": \"Odio doloremque et facere repudiandae quia.\",\n      \"bio\": \"Cum aut deserunt.\",\n      \"email\": \"arjun_schmitt@ritchiehuel.org\",\n      \"first_name\": \"Ut est earum rerum aliquam.\",\n      \"last_name\": \"Maiores commodi cum omnis et.\",\n      \"major\": \"Minima quidem corrupti.\",\n      \"phone\": \"Quaerat commodi voluptas consectetur accusantium.\",\n      \"position\": \"Rerum nulla ipsum voluptates veniam nulla.\"\n   }'")
 		}
 		if body.Email != nil {
 			err = goa.MergeErrors(err, goa.ValidateFormat("body.email", *body.Email, goa.FormatEmail))
@@ -17,23 +17,25 @@ import (
 
 // Client is the "profiles" service client.
 type Client struct {
-	CreateStudentProfileEndpoint goa.Endpoint
-	CreateTeacherProfileEndpoint goa.Endpoint
-	GetCompleteProfileEndpoint   goa.Endpoint
-	GetPublicProfileByIDEndpoint goa.Endpoint
-	UpdateProfileEndpoint        goa.Endpoint
-	ValidateUserRoleEndpoint     goa.Endpoint
+	CreateStudentProfileEndpoint   goa.Endpoint
+	CreateTeacherProfileEndpoint   goa.Endpoint
+	GetCompleteProfileEndpoint     goa.Endpoint
+	GetPublicProfileByIDEndpoint   goa.Endpoint
+	UpdateProfileEndpoint          goa.Endpoint
+	GetPublicProfilesByIdsEndpoint goa.Endpoint
+	ValidateUserRoleEndpoint       goa.Endpoint
 }
 
 // NewClient initializes a "profiles" service client given the endpoints.
-func NewClient(createStudentProfile, createTeacherProfile, getCompleteProfile, getPublicProfileByID, updateProfile, validateUserRole goa.Endpoint) *Client {
+func NewClient(createStudentProfile, createTeacherProfile, getCompleteProfile, getPublicProfileByID, updateProfile, getPublicProfilesByIds, validateUserRole goa.Endpoint) *Client {
 	return &Client{
-		CreateStudentProfileEndpoint: createStudentProfile,
-		CreateTeacherProfileEndpoint: createTeacherProfile,
-		GetCompleteProfileEndpoint:   getCompleteProfile,
-		GetPublicProfileByIDEndpoint: getPublicProfileByID,
-		UpdateProfileEndpoint:        updateProfile,
-		ValidateUserRoleEndpoint:     validateUserRole,
+		CreateStudentProfileEndpoint:   createStudentProfile,
+		CreateTeacherProfileEndpoint:   createTeacherProfile,
+		GetCompleteProfileEndpoint:     getCompleteProfile,
+		GetPublicProfileByIDEndpoint:   getPublicProfileByID,
+		UpdateProfileEndpoint:          updateProfile,
+		GetPublicProfilesByIdsEndpoint: getPublicProfilesByIds,
+		ValidateUserRoleEndpoint:       validateUserRole,
 	}
 }
 
@@ -131,6 +133,25 @@ func (c *Client) UpdateProfile(ctx context.Context, p *UpdateProfilePayload) (re
 	return ires.(*ProfileResponse), nil
 }
 
+// GetPublicProfilesByIds calls the "GetPublicProfilesByIds" endpoint of the
+// "profiles" service.
+// GetPublicProfilesByIds may return the following errors:
+//   - "invalid_input" (type InvalidInput)
+//   - "unauthorized" (type Unauthorized)
+//   - "profile_not_found" (type ProfileNotFound)
+//   - "permission_denied" (type PermissionDenied)
+//   - "profile_already_exists" (type ProfileAlreadyExists)
+//   - "invalid_role" (type InvalidRole)
+//   - error: internal error
+func (c *Client) GetPublicProfilesByIds(ctx context.Context, p *GetPublicProfilesByIdsPayload) (res *PublicProfilesResponse, err error) {
+	var ires any
+	ires, err = c.GetPublicProfilesByIdsEndpoint(ctx, p)
+	if err != nil {
+		return
+	}
+	return ires.(*PublicProfilesResponse), nil
+}
+
 // ValidateUserRole calls the "ValidateUserRole" endpoint of the "profiles"
 // service.
 // ValidateUserRole may return the following errors:
@@ -17,23 +17,25 @@ import (
 
 // Endpoints wraps the "profiles" service endpoints.
 type Endpoints struct {
-	CreateStudentProfile goa.Endpoint
-	CreateTeacherProfile goa.Endpoint
-	GetCompleteProfile   goa.Endpoint
-	GetPublicProfileByID goa.Endpoint
-	UpdateProfile        goa.Endpoint
-	ValidateUserRole     goa.Endpoint
+	CreateStudentProfile   goa.Endpoint
+	CreateTeacherProfile   goa.Endpoint
+	GetCompleteProfile     goa.Endpoint
+	GetPublicProfileByID   goa.Endpoint
+	UpdateProfile          goa.Endpoint
+	GetPublicProfilesByIds goa.Endpoint
+	ValidateUserRole       goa.Endpoint
 }
 
 // NewEndpoints wraps the methods of the "profiles" service with endpoints.
 func NewEndpoints(s Service) *Endpoints {
 	return &Endpoints{
-		CreateStudentProfile: NewCreateStudentProfileEndpoint(s),
-		CreateTeacherProfile: NewCreateTeacherProfileEndpoint(s),
-		GetCompleteProfile:   NewGetCompleteProfileEndpoint(s),
-		GetPublicProfileByID: NewGetPublicProfileByIDEndpoint(s),
-		UpdateProfile:        NewUpdateProfileEndpoint(s),
-		ValidateUserRole:     NewValidateUserRoleEndpoint(s),
+		CreateStudentProfile:   NewCreateStudentProfileEndpoint(s),
+		CreateTeacherProfile:   NewCreateTeacherProfileEndpoint(s),
+		GetCompleteProfile:     NewGetCompleteProfileEndpoint(s),
+		GetPublicProfileByID:   NewGetPublicProfileByIDEndpoint(s),
+		UpdateProfile:          NewUpdateProfileEndpoint(s),
+		GetPublicProfilesByIds: NewGetPublicProfilesByIdsEndpoint(s),
+		ValidateUserRole:       NewValidateUserRoleEndpoint(s),
 	}
 }
 
@@ -44,6 +46,7 @@ func (e *Endpoints) Use(m func(goa.Endpoint) goa.Endpoint) {
 	e.GetCompleteProfile = m(e.GetCompleteProfile)
 	e.GetPublicProfileByID = m(e.GetPublicProfileByID)
 	e.UpdateProfile = m(e.UpdateProfile)
+	e.GetPublicProfilesByIds = m(e.GetPublicProfilesByIds)
 	e.ValidateUserRole = m(e.ValidateUserRole)
 }
 
@@ -92,6 +95,15 @@ func NewUpdateProfileEndpoint(s Service) goa.Endpoint {
 	}
 }
 
+// NewGetPublicProfilesByIdsEndpoint returns an endpoint function that calls
+// the method "GetPublicProfilesByIds" of service "profiles".
+func NewGetPublicProfilesByIdsEndpoint(s Service) goa.Endpoint {
+	return func(ctx context.Context, req any) (any, error) {
+		p := req.(*GetPublicProfilesByIdsPayload)
+		return s.GetPublicProfilesByIds(ctx, p)
+	}
+}
+
 // NewValidateUserRoleEndpoint returns an endpoint function that calls the
 // method "ValidateUserRole" of service "profiles".
 func NewValidateUserRoleEndpoint(s Service) goa.Endpoint {
@@ -25,6 +25,9 @@ type Service interface {
 	GetPublicProfileByID(context.Context, *GetPublicProfileByIDPayload) (res *PublicProfileResponse, err error)
 	// Update basic profile information
 	UpdateProfile(context.Context, *UpdateProfilePayload) (res *ProfileResponse, err error)
+	// Get public profile information of several users at once, leaving out users
+	// without an active profile
+	GetPublicProfilesByIds(context.Context, *GetPublicProfilesByIdsPayload) (res *PublicProfilesResponse, err error)
 	// Validate user role for inter-service communication
 	ValidateUserRole(context.Context, *ValidateUserRolePayload) (res *RoleValidationResponse, err error)
 }
@@ -43,7 +46,7 @@ const ServiceName = "profiles"
 // MethodNames lists the service method names as defined in the design. These
 // are the same values that are set in the endpoint request contexts under the
 // MethodKey key.
-var MethodNames = [6]string{"CreateStudentProfile", "CreateTeacherProfile", "GetCompleteProfile", "GetPublicProfileById", "UpdateProfile", "ValidateUserRole"}
+var MethodNames = [7]string{"CreateStudentProfile", "CreateTeacherProfile", "GetCompleteProfile", "GetPublicProfileById", "UpdateProfile", "GetPublicProfilesByIds", "ValidateUserRole"}
 
 // CompleteProfileResponse is the result type of the profiles service
 // GetCompleteProfile method.
@@ -136,6 +139,13 @@ type GetPublicProfileByIDPayload struct {
 	UserID int64
 }
 
+// GetPublicProfilesByIdsPayload is the payload type of the profiles service
+// GetPublicProfilesByIds method.
+type GetPublicProfilesByIdsPayload struct {
+	// User IDs to retrieve profiles for
+	UserIds []int64
+}
+
 // ProfileResponse is the result type of the profiles service UpdateProfile
 // method.
 type ProfileResponse struct {
@@ -182,6 +192,13 @@ type PublicProfileResponse struct {
 	IsActive bool
 }
 
+// PublicProfilesResponse is the result type of the profiles service
+// GetPublicProfilesByIds method.
+type PublicProfilesResponse struct {
+	// Public profiles of the users found
+	Profiles []*PublicProfileResponse
+}
+
 // RoleValidationResponse is the result type of the profiles service
 // ValidateUserRole method.
 type RoleValidationResponse struct {
@@ -194,6 +194,23 @@ func (s *profilessrvc) UpdateProfile(ctx context.Context, payload *profiles.Upda
 	return mapToProfileResponse(updatedProfile), nil
 }
 
+func (s *profilessrvc) GetPublicProfilesByIds(ctx context.Context, payload *profiles.GetPublicProfilesByIdsPayload) (res *profiles.PublicProfilesResponse, err error) {
+	// This endpoint is for microservice communication, no session validation needed
+	publicProfiles, err := s.profileRepo.GetPublicProfilesByUserIds(ctx, payload.UserIds)
+	if err != nil {
+		return nil, profiles.InvalidInput("failed to get profiles")
+	}
+
+	// Map to response, leaving out users without an active profile
+	res = &profiles.PublicProfilesResponse{
+		Profiles: make([]*profiles.PublicProfileResponse, 0, len(publicProfiles)),
+	}
+	for _, publicProfile := range publicProfiles {
+		res.Profiles = append(res.Profiles, mapToPublicProfileResponse(profilesdb.GetPublicProfileByUserIdRow(publicProfile)))
+	}
+	return res, nil
+}
+
 func (s *profilessrvc) ValidateUserRole(ctx context.Context, payload *profiles.ValidateUserRolePayload) (res *profiles.RoleValidationResponse, err error) {
 	// This endpoint is for microservice communication, no session validation needed
 	// Get profile by user ID
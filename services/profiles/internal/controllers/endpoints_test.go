@@ -491,6 +491,50 @@ func TestGetPublicProfileByID_ProfileNotFound(t *testing.T) {
 	mockProfileRepo.AssertExpectations(t)
 }
 
+func TestGetPublicProfilesByIds_Success(t *testing.T) {
+	// Arrange
+	mockProfileRepo := &mocks.MockProfileRepository{}
+	service := setupTestService(mockProfileRepo, nil, nil, nil)
+
+	// User 999 has no active profile and is left out
+	mockProfileRepo.On("GetPublicProfilesByUserIds", mock.Anything, []int64{1, 999}).Return([]profilesdb.GetPublicProfilesByUserIdsRow{
+		profilesdb.GetPublicProfilesByUserIdsRow(createTestPublicProfile()),
+	}, nil)
+
+	// Act
+	result, err := service.GetPublicProfilesByIds(context.Background(), &profiles.GetPublicProfilesByIdsPayload{
+		UserIds: []int64{1, 999},
+	})
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Len(t, result.Profiles, 1)
+	assert.Equal(t, int64(1), result.Profiles[0].UserID)
+	assert.Equal(t, "John", result.Profiles[0].FirstName)
+	assert.Equal(t, "Doe", result.Profiles[0].LastName)
+
+	mockProfileRepo.AssertExpectations(t)
+}
+
+func TestGetPublicProfilesByIds_RepositoryError(t *testing.T) {
+	// Arrange
+	mockProfileRepo := &mocks.MockProfileRepository{}
+	service := setupTestService(mockProfileRepo, nil, nil, nil)
+
+	mockProfileRepo.On("GetPublicProfilesByUserIds", mock.Anything, []int64{1}).Return([]profilesdb.GetPublicProfilesByUserIdsRow(nil), errors.New("database error"))
+
+	// Act
+	result, err := service.GetPublicProfilesByIds(context.Background(), &profiles.GetPublicProfilesByIdsPayload{
+		UserIds: []int64{1},
+	})
+
+	// Assert
+	assert.Error(t, err)
+	assert.Nil(t, result)
+
+	mockProfileRepo.AssertExpectations(t)
+}
+
 func TestUpdateProfile_Success(t *testing.T) {
 	// Arrange
 	mockProfileRepo := &mocks.MockProfileRepository{}
@@ -11,6 +11,7 @@ type ProfileRepository interface {
 	GetProfileByUserId(ctx context.Context, userID int64) (profilesdb.Profile, error)
 	GetProfileByEmail(ctx context.Context, email string) (profilesdb.Profile, error)
 	GetPublicProfileByUserId(ctx context.Context, userID int64) (profilesdb.GetPublicProfileByUserIdRow, error)
+	GetPublicProfilesByUserIds(ctx context.Context, userIds []int64) ([]profilesdb.GetPublicProfilesByUserIdsRow, error)
 	UpdateProfile(ctx context.Context, params profilesdb.UpdateProfileParams) (profilesdb.Profile, error)
 	DeactivateProfile(ctx context.Context, userID int64) error
 	CheckProfileExists(ctx context.Context, userID int64) (bool, error)
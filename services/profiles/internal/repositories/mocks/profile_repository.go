@@ -32,6 +32,11 @@ func (m *MockProfileRepository) GetPublicProfileByUserId(ctx context.Context, us
 	return args.Get(0).(profilesdb.GetPublicProfileByUserIdRow), args.Error(1)
 }
 
+func (m *MockProfileRepository) GetPublicProfilesByUserIds(ctx context.Context, userIds []int64) ([]profilesdb.GetPublicProfilesByUserIdsRow, error) {
+	args := m.Called(ctx, userIds)
+	return args.Get(0).([]profilesdb.GetPublicProfilesByUserIdsRow), args.Error(1)
+}
+
 func (m *MockProfileRepository) UpdateProfile(ctx context.Context, params profilesdb.UpdateProfileParams) (profilesdb.Profile, error) {
 	args := m.Called(ctx, params)
 	return args.Get(0).(profilesdb.Profile), args.Error(1)